@@ -22,12 +22,16 @@ import (
 	"io"
 	"net"
 	"net/http"
+	"os"
+	"strconv"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 	"golang.org/x/sync/errgroup"
+	"k8s.io/apimachinery/pkg/labels"
 	_ "k8s.io/client-go/plugin/pkg/client/auth"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -44,6 +48,7 @@ import (
 // InjectorControllerOptions is a struct having injector controller options values
 type InjectorControllerOptions struct {
 	Namespace               string
+	Namespaces              []string
 	LeaderElect             bool
 	LeaderElectionNamespace string
 	LeaseDuration           time.Duration
@@ -59,6 +64,29 @@ type InjectorControllerOptions struct {
 	// The profiler should never be exposed on a public address.
 	PprofAddr string
 
+	// EnableCertificatesDataSource determines whether CAs are injected from
+	// Certificates. If false, Certificates are not cached.
+	EnableCertificatesDataSource bool
+
+	// EnableMutatingWebhookConfigurationsInjectable, and the options below,
+	// determine whether CAs are injected into each type of injectable.
+	EnableMutatingWebhookConfigurationsInjectable   bool
+	EnableValidatingWebhookConfigurationsInjectable bool
+	EnableAPIServicesInjectable                     bool
+	EnableCustomResourceDefinitionsInjectable       bool
+
+	// CustomResourceDefinitionSelector is a label selector restricting the
+	// CustomResourceDefinitions which are cached and injected into.
+	CustomResourceDefinitionSelector string
+
+	// ShardCount is the number of shards the injectables are split into, so
+	// that multiple replicas can inject CAs at the same time.
+	ShardCount int
+	// ShardIndex is the shard reconciled by this replica. If it is negative,
+	// it is taken from the ordinal suffix of the hostname, as set for the
+	// pods of a StatefulSet.
+	ShardIndex int
+
 	// logger to be used by this controller
 	log logr.Logger
 }
@@ -69,6 +97,9 @@ func (o *InjectorControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"If set, this limits the scope of cainjector to a single namespace. "+
 		"If set, cainjector will not update resources with certificates outside of the "+
 		"configured namespace.")
+	fs.StringSliceVar(&o.Namespaces, "namespaces", nil, ""+
+		"If set, this limits the scope of cainjector to the given namespaces, in the same way as "+
+		"--namespace. Only one of --namespace and --namespaces may be set.")
 	fs.BoolVar(&o.LeaderElect, "leader-elect", cmdutil.DefaultLeaderElect, ""+
 		"If true, cainjector will perform leader election between instances to ensure no more "+
 		"than one instance of cainjector operates at a time")
@@ -88,6 +119,33 @@ func (o *InjectorControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"The duration the clients should wait between attempting acquisition and renewal "+
 		"of a leadership. This is only applicable if leader election is enabled.")
 
+	fs.BoolVar(&o.EnableCertificatesDataSource, "enable-certificates-data-source", true, ""+
+		"If false, CAs are not injected from Certificates using the cert-manager.io/inject-ca-from "+
+		"annotation, and Certificates are not cached. This also means that cainjector can run "+
+		"without the cert-manager CRDs being installed.")
+	fs.BoolVar(&o.EnableMutatingWebhookConfigurationsInjectable, "enable-mutatingwebhookconfigurations-injectable", true, ""+
+		"If false, CAs are not injected into MutatingWebhookConfigurations, and they are not cached.")
+	fs.BoolVar(&o.EnableValidatingWebhookConfigurationsInjectable, "enable-validatingwebhookconfigurations-injectable", true, ""+
+		"If false, CAs are not injected into ValidatingWebhookConfigurations, and they are not cached.")
+	fs.BoolVar(&o.EnableAPIServicesInjectable, "enable-apiservices-injectable", true, ""+
+		"If false, CAs are not injected into APIServices, and they are not cached.")
+	fs.BoolVar(&o.EnableCustomResourceDefinitionsInjectable, "enable-customresourcedefinitions-injectable", true, ""+
+		"If false, CAs are not injected into CustomResourceDefinitions, and they are not cached.")
+	fs.StringVar(&o.CustomResourceDefinitionSelector, "customresourcedefinition-selector", "", ""+
+		"If set, a label selector restricting the CustomResourceDefinitions which are cached and "+
+		"injected into, for example 'app.kubernetes.io/instance=cert-manager'. Caching every "+
+		"CustomResourceDefinition can use a lot of memory on clusters with many of them.")
+
+	fs.IntVar(&o.ShardCount, "shard-count", 1, ""+
+		"The number of shards the injectables are split into, by a hash of their namespace and name. "+
+		"If greater than 1, multiple replicas of cainjector can inject CAs at the same time, each into "+
+		"the injectables of its own shard, and leader election is only performed between the replicas "+
+		"of the same shard.")
+	fs.IntVar(&o.ShardIndex, "shard-index", -1, ""+
+		"The shard reconciled by this replica, from 0 to --shard-count minus 1. If not set, it is taken "+
+		"from the ordinal suffix of the hostname, so that cainjector can be sharded by running it as a "+
+		"StatefulSet with --shard-count replicas. Only used if --shard-count is greater than 1.")
+
 	fs.BoolVar(&o.EnablePprof, "enable-profiling", cmdutil.DefaultEnableProfiling, "Enable profiling for cainjector")
 	fs.StringVar(&o.PprofAddr, "profiler-address", cmdutil.DefaultProfilerAddr, "Address of the Go profiler (pprof) if enabled. This should never be exposed on a public interface.")
 
@@ -134,13 +192,86 @@ servers and webhook servers.`,
 	return cmd
 }
 
+// setupOptions returns the options restricting which resources the
+// injectors cache and reconcile.
+func (o InjectorControllerOptions) setupOptions() (cainjector.SetupOptions, error) {
+	if o.Namespace != "" && len(o.Namespaces) > 0 {
+		return cainjector.SetupOptions{}, fmt.Errorf("only one of --namespace and --namespaces may be set")
+	}
+
+	opts := cainjector.SetupOptions{
+		Namespaces: o.Namespaces,
+		EnableMutatingWebhookConfigurationsInjectable:   o.EnableMutatingWebhookConfigurationsInjectable,
+		EnableValidatingWebhookConfigurationsInjectable: o.EnableValidatingWebhookConfigurationsInjectable,
+		EnableAPIServicesInjectable:                     o.EnableAPIServicesInjectable,
+		EnableCustomResourceDefinitionsInjectable:       o.EnableCustomResourceDefinitionsInjectable,
+	}
+	if o.Namespace != "" {
+		opts.Namespaces = []string{o.Namespace}
+	}
+
+	if o.CustomResourceDefinitionSelector != "" {
+		selector, err := labels.Parse(o.CustomResourceDefinitionSelector)
+		if err != nil {
+			return cainjector.SetupOptions{}, fmt.Errorf("invalid --customresourcedefinition-selector: %v", err)
+		}
+		opts.CustomResourceDefinitionSelector = selector
+	}
+
+	if o.ShardCount < 1 {
+		return cainjector.SetupOptions{}, fmt.Errorf("--shard-count must be at least 1")
+	}
+	if o.ShardCount > 1 {
+		shardIndex, err := o.shardIndex()
+		if err != nil {
+			return cainjector.SetupOptions{}, err
+		}
+		opts.ShardCount = o.ShardCount
+		opts.ShardIndex = shardIndex
+	}
+
+	return opts, nil
+}
+
+// shardIndex returns the shard reconciled by this replica, taking it from
+// the ordinal suffix of the hostname if --shard-index is not set.
+func (o InjectorControllerOptions) shardIndex() (int, error) {
+	shardIndex := o.ShardIndex
+	if shardIndex < 0 {
+		hostname, err := os.Hostname()
+		if err != nil {
+			return 0, fmt.Errorf("failed to get the hostname to determine the shard index: %v", err)
+		}
+		shardIndex, err = strconv.Atoi(hostname[strings.LastIndex(hostname, "-")+1:])
+		if err != nil {
+			return 0, fmt.Errorf("--shard-index is not set and the hostname %q does not have an ordinal suffix", hostname)
+		}
+	}
+	if shardIndex >= o.ShardCount {
+		return 0, fmt.Errorf("the shard index %d must be less than --shard-count %d", shardIndex, o.ShardCount)
+	}
+	return shardIndex, nil
+}
+
 func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) error {
+	setupOptions, err := o.setupOptions()
+	if err != nil {
+		return err
+	}
+
+	// Each shard performs leader election separately, so that one replica
+	// of every shard is active at once.
+	leaderElectionID := "cert-manager-cainjector-leader-election"
+	if setupOptions.ShardCount > 1 {
+		leaderElectionID = fmt.Sprintf("%s-shard-%d", leaderElectionID, setupOptions.ShardIndex)
+	}
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:                        api.Scheme,
 		Namespace:                     o.Namespace,
 		LeaderElection:                o.LeaderElect,
 		LeaderElectionNamespace:       o.LeaderElectionNamespace,
-		LeaderElectionID:              "cert-manager-cainjector-leader-election",
+		LeaderElectionID:              leaderElectionID,
 		LeaderElectionReleaseOnCancel: true,
 		LeaderElectionResourceLock:    resourcelock.LeasesResourceLock,
 		LeaseDuration:                 &o.LeaseDuration,
@@ -213,20 +344,22 @@ func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) er
 	// goroutine.
 	// When shutting down, return the last error if there is one.
 	// Never retry if the controller exits cleanly.
-	g.Go(func() (err error) {
-		for {
-			err = cainjector.RegisterCertificateBased(gctx, mgr)
-			if err == nil {
-				return
-			}
-			o.log.Error(err, "Error registering certificate based controllers. Retrying after 5 seconds.")
-			select {
-			case <-time.After(time.Second * 5):
-			case <-gctx.Done():
-				return
+	if o.EnableCertificatesDataSource {
+		g.Go(func() (err error) {
+			for {
+				err = cainjector.RegisterCertificateBased(gctx, mgr, setupOptions)
+				if err == nil {
+					return
+				}
+				o.log.Error(err, "Error registering certificate based controllers. Retrying after 5 seconds.")
+				select {
+				case <-time.After(time.Second * 5):
+				case <-gctx.Done():
+					return
+				}
 			}
-		}
-	})
+		})
+	}
 
 	// Secrets based controller is started in its own goroutine so that it can
 	// perform injection of the CA bundle into any webhooks required by the
@@ -234,7 +367,7 @@ func (o InjectorControllerOptions) RunInjectorController(ctx context.Context) er
 	// We do not retry this controller because it only interacts with core APIs
 	// which should always be in a working state.
 	g.Go(func() (err error) {
-		if err = cainjector.RegisterSecretBased(gctx, mgr); err != nil {
+		if err = cainjector.RegisterSecretBased(gctx, mgr, setupOptions); err != nil {
 			return fmt.Errorf("error registering secret controller: %v", err)
 		}
 		return
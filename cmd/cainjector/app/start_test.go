@@ -0,0 +1,96 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSetupOptions(t *testing.T) {
+	tests := map[string]struct {
+		opts InjectorControllerOptions
+
+		expectedNamespaces  []string
+		expectedCRDSelector string
+		expectedShardCount  int
+		expectedShardIndex  int
+		expectErr           bool
+	}{
+		"all namespaces are cached by default": {
+			opts: InjectorControllerOptions{ShardCount: 1},
+		},
+		"--namespace restricts the cache to a single namespace": {
+			opts:               InjectorControllerOptions{Namespace: "cert-manager", ShardCount: 1},
+			expectedNamespaces: []string{"cert-manager"},
+		},
+		"--namespaces restricts the cache to the given namespaces": {
+			opts:               InjectorControllerOptions{Namespaces: []string{"cert-manager", "kube-system"}, ShardCount: 1},
+			expectedNamespaces: []string{"cert-manager", "kube-system"},
+		},
+		"--namespace and --namespaces cannot both be set": {
+			opts:      InjectorControllerOptions{Namespace: "cert-manager", Namespaces: []string{"kube-system"}, ShardCount: 1},
+			expectErr: true,
+		},
+		"--customresourcedefinition-selector restricts the cached CustomResourceDefinitions": {
+			opts:                InjectorControllerOptions{CustomResourceDefinitionSelector: "app=cert-manager", ShardCount: 1},
+			expectedCRDSelector: "app=cert-manager",
+		},
+		"an invalid --customresourcedefinition-selector is rejected": {
+			opts:      InjectorControllerOptions{CustomResourceDefinitionSelector: "app in (", ShardCount: 1},
+			expectErr: true,
+		},
+		"sharding is configured if --shard-count is greater than 1": {
+			opts:               InjectorControllerOptions{ShardCount: 3, ShardIndex: 2},
+			expectedShardCount: 3,
+			expectedShardIndex: 2,
+		},
+		"--shard-count must be at least 1": {
+			opts:      InjectorControllerOptions{ShardCount: 0},
+			expectErr: true,
+		},
+		"--shard-index must be less than --shard-count": {
+			opts:      InjectorControllerOptions{ShardCount: 3, ShardIndex: 3},
+			expectErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts, err := test.opts.setupOptions()
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error=%t, got %v", test.expectErr, err)
+			}
+			if err != nil {
+				return
+			}
+
+			if !reflect.DeepEqual(opts.Namespaces, test.expectedNamespaces) {
+				t.Errorf("unexpected namespaces, exp=%v, got=%v", test.expectedNamespaces, opts.Namespaces)
+			}
+			var crdSelector string
+			if opts.CustomResourceDefinitionSelector != nil {
+				crdSelector = opts.CustomResourceDefinitionSelector.String()
+			}
+			if crdSelector != test.expectedCRDSelector {
+				t.Errorf("unexpected CustomResourceDefinition selector, exp=%q, got=%q", test.expectedCRDSelector, crdSelector)
+			}
+			if opts.ShardCount != test.expectedShardCount || opts.ShardIndex != test.expectedShardIndex {
+				t.Errorf("unexpected shard, exp=%d/%d, got=%d/%d", test.expectedShardIndex, test.expectedShardCount, opts.ShardIndex, opts.ShardCount)
+			}
+		})
+	}
+}
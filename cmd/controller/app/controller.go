@@ -23,11 +23,14 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
+	"github.com/go-logr/logr"
 	"golang.org/x/sync/errgroup"
 	"k8s.io/apimachinery/pkg/api/resource"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/tools/leaderelection"
 	"k8s.io/client-go/tools/leaderelection/resourcelock"
@@ -36,14 +39,26 @@ import (
 
 	"github.com/cert-manager/cert-manager/cmd/controller/app/options"
 	cmdutil "github.com/cert-manager/cert-manager/cmd/util"
+	"github.com/cert-manager/cert-manager/internal/approvalwebhook"
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/issuancecache"
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	"github.com/cert-manager/cert-manager/internal/issuanceratelimit"
+	"github.com/cert-manager/cert-manager/internal/vault"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/controller"
+	shimhelper "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim"
 	"github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
+	"github.com/cert-manager/cert-manager/pkg/controller/crl"
+	"github.com/cert-manager/cert-manager/pkg/controller/debug"
+	"github.com/cert-manager/cert-manager/pkg/controller/ingressclassmigration"
 	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/ct"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/lint"
 	"github.com/cert-manager/cert-manager/pkg/util/profiling"
 )
 
@@ -54,6 +69,18 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	log := logf.FromContext(rootCtx)
 	g, rootCtx := errgroup.WithContext(rootCtx)
 
+	// The debug registry must be set as the workqueue metrics provider
+	// before any controller constructs its queue.
+	if len(opts.DebugListenAddress) > 0 {
+		debug.Default.SetWorkqueueProvider()
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.DeterministicIssuance) {
+		if err := enableDeterministicIssuance(log, opts.DeterministicIssuanceSeed); err != nil {
+			return err
+		}
+	}
+
 	ctxFactory, err := buildControllerContextFactory(rootCtx, opts)
 	if err != nil {
 		return err
@@ -128,6 +155,79 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		})
 	}
 
+	// Start the controller debug endpoint if it is enabled
+	if len(opts.DebugListenAddress) > 0 {
+		debugLn, err := net.Listen("tcp", opts.DebugListenAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on debug address %s: %v", opts.DebugListenAddress, err)
+		}
+		debugMux := http.NewServeMux()
+		debug.Install(debugMux, debug.Default)
+		debugServer := &http.Server{
+			Handler: debugMux,
+		}
+
+		g.Go(func() error {
+			<-rootCtx.Done()
+			// allow a timeout for graceful shutdown
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := debugServer.Shutdown(ctx); err != nil {
+				return err
+			}
+			return nil
+		})
+		g.Go(func() error {
+			log.V(logf.InfoLevel).Info("starting controller debug server", "address", debugLn.Addr(), "path", debug.Path)
+			if err := debugServer.Serve(debugLn); err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+	}
+
+	// Start the CRL server if it is enabled. It is started on every replica,
+	// not just the leader, as it reads CRLs from the API server.
+	if len(opts.CRLServerListenAddress) > 0 {
+		crlLn, err := net.Listen("tcp", opts.CRLServerListenAddress)
+		if err != nil {
+			return fmt.Errorf("failed to listen on CRL server address %s: %v", opts.CRLServerListenAddress, err)
+		}
+		crlServer := &http.Server{
+			Handler: &crl.Handler{
+				Client:        ctx.CMClient,
+				KubeClient:    ctx.Client,
+				IssuerOptions: ctx.IssuerOptions,
+			},
+		}
+
+		g.Go(func() error {
+			<-rootCtx.Done()
+			// allow a timeout for graceful shutdown
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+
+			if err := crlServer.Shutdown(ctx); err != nil {
+				return err
+			}
+			return nil
+		})
+		g.Go(func() error {
+			log.V(logf.InfoLevel).Info("starting CRL server", "address", crlLn.Addr())
+			if err := crlServer.Serve(crlLn); err != http.ErrServerClosed {
+				return err
+			}
+			return nil
+		})
+	}
+
+	// The leader election lease is held until controllers have finished
+	// processing in-flight items, so that a new leader does not start working
+	// on the same resources while this instance is still shutting down.
+	leaderElectionCtx, cancelLeaderElection := context.WithCancel(context.Background())
+	defer cancelLeaderElection()
+
 	elected := make(chan struct{})
 	if opts.LeaderElect {
 		g.Go(func() error {
@@ -138,7 +238,7 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			}
 
 			errorCh := make(chan error, 1)
-			if err := startLeaderElection(rootCtx, opts, ctx.Client, ctx.Recorder, leaderelection.LeaderCallbacks{
+			if err := startLeaderElection(leaderElectionCtx, opts, ctx.Client, ctx.Recorder, leaderelection.LeaderCallbacks{
 				OnStartedLeading: func(_ context.Context) {
 					close(elected)
 				},
@@ -166,34 +266,37 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 		close(elected)
 	}
 
-	select {
-	case <-rootCtx.Done(): // Exit early if we are shutting down or if the errgroup has already exited with an error
-		// Wait for error group to complete and return
-		return g.Wait()
-	case <-elected: // Don't launch the controllers unless we have been elected leader
-		// Continue with setting up controller
-	}
-
-	for n, fn := range controller.Known() {
-		log := log.WithValues("controller", n)
-
-		// only run a controller if it's been enabled
-		if !enabledControllers.Has(n) {
-			log.V(logf.InfoLevel).Info("not starting controller as it's disabled")
-			continue
+	// In hot standby mode, controllers are constructed and their informer
+	// caches synced before this instance is elected leader, so that a failover
+	// only has to wait for the lease to be acquired before resuming work.
+	hotStandby := opts.LeaderElect && opts.LeaderElectionHotStandby
+
+	if !hotStandby {
+		select {
+		case <-rootCtx.Done(): // Exit early if we are shutting down or if the errgroup has already exited with an error
+			// Wait for error group to complete and return
+			cancelLeaderElection()
+			return g.Wait()
+		case <-elected: // Don't launch the controllers unless we have been elected leader
+			// Continue with setting up controller
 		}
+	}
 
-		// don't run clusterissuers controller if scoped to a single namespace
-		if ctx.Namespace != "" && n == clusterissuers.ControllerName {
-			log.V(logf.InfoLevel).Info("not starting controller as cert-manager has been scoped to a single namespace")
-			continue
+	controllers, err := buildControllers(log, ctxFactory, enabledControllers, ctx.Namespace)
+	if err != nil {
+		cancelContext()
+		cancelLeaderElection()
+		err2 := g.Wait() // Don't process errors, we already have an error
+		if err2 != nil {
+			return utilerrors.NewAggregate([]error{err, err2})
 		}
+		return err
+	}
 
-		iface, err := fn(ctxFactory)
-		if err != nil {
-			err = fmt.Errorf("error starting controller: %v", err)
-
+	if hotStandby {
+		if err := startStandby(rootCtx, ctxFactory, ctx); err != nil {
 			cancelContext()
+			cancelLeaderElection()
 			err2 := g.Wait() // Don't process errors, we already have an error
 			if err2 != nil {
 				return utilerrors.NewAggregate([]error{err, err2})
@@ -201,7 +304,24 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			return err
 		}
 
+		select {
+		case <-rootCtx.Done(): // Exit early if we are shutting down or if the errgroup has already exited with an error
+			// Wait for error group to complete and return
+			cancelLeaderElection()
+			return g.Wait()
+		case <-elected: // Don't launch the controllers unless we have been elected leader
+			// Continue with starting the controllers
+		}
+	}
+
+	var controllersWG sync.WaitGroup
+	for n, iface := range controllers {
+		log := log.WithValues("controller", n)
+		iface := iface
+
+		controllersWG.Add(1)
 		g.Go(func() error {
+			defer controllersWG.Done()
 			log.V(logf.InfoLevel).Info("starting controller")
 
 			// TODO: make this either a constant or a command line flag
@@ -209,14 +329,15 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 			return iface.Run(workers, rootCtx.Done())
 		})
 	}
+	g.Go(func() error {
+		controllersWG.Wait()
+		log.V(logf.DebugLevel).Info("all controllers have stopped, releasing leader election lease")
+		cancelLeaderElection()
+		return nil
+	})
 
 	log.V(logf.DebugLevel).Info("starting shared informer factories")
-	ctx.SharedInformerFactory.Start(rootCtx.Done())
-	ctx.KubeSharedInformerFactory.Start(rootCtx.Done())
-
-	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) {
-		ctx.GWShared.Start(rootCtx.Done())
-	}
+	startInformerFactories(rootCtx, ctx)
 
 	err = g.Wait()
 	if err != nil {
@@ -227,6 +348,69 @@ func Run(opts *options.ControllerOptions, stopCh <-chan struct{}) error {
 	return nil
 }
 
+// startInformerFactories starts all shared informer factories in the given
+// controller context. Starting a factory that has already been started only
+// starts informers that have been requested since.
+// buildControllers constructs the enabled controllers. Constructing a
+// controller only registers its informers and event handlers, which add items
+// to its workqueue; no items are processed until the controller is run.
+func buildControllers(log logr.Logger, ctxFactory *controller.ContextFactory, enabledControllers sets.String, namespace string) (map[string]controller.Interface, error) {
+	controllers := make(map[string]controller.Interface)
+	for n, fn := range controller.Known() {
+		log := log.WithValues("controller", n)
+
+		// only run a controller if it's been enabled
+		if !enabledControllers.Has(n) {
+			log.V(logf.InfoLevel).Info("not starting controller as it's disabled")
+			continue
+		}
+
+		// don't run cluster scoped controllers if scoped to a single namespace
+		if namespace != "" && (n == clusterissuers.ControllerName || n == ingressclassmigration.ControllerName) {
+			log.V(logf.InfoLevel).Info("not starting controller as cert-manager has been scoped to a single namespace")
+			continue
+		}
+
+		iface, err := fn(ctxFactory)
+		if err != nil {
+			return nil, fmt.Errorf("error starting controller: %v", err)
+		}
+		controllers[n] = iface
+	}
+	return controllers, nil
+}
+
+// startStandby starts the shared informer factories of an instance which has
+// not yet been elected leader, and warms it to take over from the leader.
+// Controllers built before it is called do not process any items until they
+// are run, so an instance in standby only reads from the API server.
+func startStandby(rootCtx context.Context, ctxFactory *controller.ContextFactory, ctx *controller.Context) error {
+	log := logf.FromContext(rootCtx)
+
+	warmer, err := newStandbyWarmer(ctxFactory)
+	if err != nil {
+		return err
+	}
+
+	log.V(logf.InfoLevel).Info("starting shared informer factories in hot standby mode")
+	startInformerFactories(rootCtx, ctx)
+	if err := warmer.warm(rootCtx); err != nil {
+		log.Error(err, "failed to warm caches in hot standby mode, controllers will start cold")
+	} else {
+		log.V(logf.InfoLevel).Info("caches warmed, waiting to be elected leader")
+	}
+	return nil
+}
+
+func startInformerFactories(rootCtx context.Context, ctx *controller.Context) {
+	ctx.SharedInformerFactory.Start(rootCtx.Done())
+	ctx.KubeSharedInformerFactory.Start(rootCtx.Done())
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ExperimentalGatewayAPISupport) {
+		ctx.GWShared.Start(rootCtx.Done())
+	}
+}
+
 // buildControllerContextFactory builds a new controller ContextFactory which
 // can build controller contexts for each component.
 func buildControllerContextFactory(ctx context.Context, opts *options.ControllerOptions) (*controller.ContextFactory, error) {
@@ -261,8 +445,56 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverResourceLimitsMemory: %w", err)
 	}
 
+	http01SolverPodTemplate, err := opts.HTTP01SolverPodTemplate()
+	if err != nil {
+		return nil, fmt.Errorf("error parsing ACMEHTTP01SolverPodTemplate: %w", err)
+	}
+
+	extraDNSNameTemplates, err := shimhelper.ParseDNSNameTemplates(opts.IngressShimExtraDNSNameTemplates)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing IngressShimExtraDNSNameTemplates: %w", err)
+	}
+
+	var certificateLinter *lint.Linter
+	if len(opts.CertificateLints) > 0 {
+		certificateLinter, err = lint.NewLinter(opts.CertificateLints)
+		if err != nil {
+			return nil, fmt.Errorf("error configuring certificate lints: %w", err)
+		}
+	}
+
+	var ctLogs *ct.LogList
+	if len(opts.CertificateTransparencyLogList) > 0 {
+		ctLogs, err = ct.LoadLogList(opts.CertificateTransparencyLogList)
+		if err != nil {
+			return nil, fmt.Errorf("error loading certificate transparency log list: %w", err)
+		}
+	}
+
+	var approvalWebhook *approvalwebhook.Client
+	if len(opts.ApprovalWebhookURL) > 0 {
+		approvalWebhook, err = approvalwebhook.New(approvalwebhook.Options{
+			URL:            opts.ApprovalWebhookURL,
+			CAFile:         opts.ApprovalWebhookCAFile,
+			ClientCertFile: opts.ApprovalWebhookClientCertFile,
+			ClientKeyFile:  opts.ApprovalWebhookClientKeyFile,
+			TokenFile:      opts.ApprovalWebhookTokenFile,
+			Timeout:        opts.ApprovalWebhookTimeout,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error configuring approval webhook: %w", err)
+		}
+	}
+
 	acmeAccountRegistry := accounts.NewDefaultRegistry()
 
+	controllerMetrics := metrics.New(log, clock.RealClock{})
+	issuerCircuitBreakers := circuitbreaker.NewRegistry(circuitbreaker.Options{
+		FailureThreshold:      opts.IssuerCircuitBreakerFailureThreshold,
+		OpenDuration:          opts.IssuerCircuitBreakerOpenDuration,
+		MaxConcurrentRequests: opts.IssuerMaxConcurrentRequests,
+	}, clock.RealClock{}, controllerMetrics)
+
 	ctxFactory, err := controller.NewContextFactory(ctx, controller.ContextOptions{
 		Kubeconfig:         opts.Kubeconfig,
 		KubernetesAPIQPS:   opts.KubernetesAPIQPS,
@@ -272,7 +504,9 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 		Namespace: opts.Namespace,
 
 		Clock:   clock.RealClock{},
-		Metrics: metrics.New(log, clock.RealClock{}),
+		Metrics: controllerMetrics,
+
+		ShutdownGracePeriod: opts.ShutdownGracePeriod,
 
 		ACMEOptions: controller.ACMEOptions{
 			HTTP01SolverResourceRequestCPU:    http01SolverResourceRequestCPU,
@@ -282,6 +516,7 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 			HTTP01SolverImage:                 opts.ACMEHTTP01SolverImage,
 			// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
 			HTTP01SolverNameservers: opts.ACMEHTTP01SolverNameservers,
+			HTTP01SolverPodTemplate: http01SolverPodTemplate,
 
 			DNS01Nameservers:        nameservers,
 			DNS01CheckRetryPeriod:   opts.DNS01CheckRetryPeriod,
@@ -298,6 +533,12 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 			ClusterIssuerAmbientCredentials: opts.ClusterIssuerAmbientCredentials,
 			IssuerAmbientCredentials:        opts.IssuerAmbientCredentials,
 			ClusterResourceNamespace:        opts.ClusterResourceNamespace,
+			CircuitBreakers:                 issuerCircuitBreakers,
+			IssuanceCache:                   issuancecache.New(opts.IssuanceCacheTTL, clock.RealClock{}),
+			IssuanceLatency:                 issuancelatency.New(),
+			IssuanceRateLimiter:             issuanceratelimit.New(clock.RealClock{}),
+			HealthCheckInterval:             opts.IssuerHealthCheckInterval,
+			VaultTokens:                     vault.NewTokenCache(clock.RealClock{}),
 		},
 
 		IngressShimOptions: controller.IngressShimOptions{
@@ -305,11 +546,26 @@ func buildControllerContextFactory(ctx context.Context, opts *options.Controller
 			DefaultIssuerKind:                 opts.DefaultIssuerKind,
 			DefaultIssuerGroup:                opts.DefaultIssuerGroup,
 			DefaultAutoCertificateAnnotations: opts.DefaultAutoCertificateAnnotations,
+			ExtraDNSNameTemplates:             extraDNSNameTemplates,
 		},
 
 		CertificateOptions: controller.CertificateOptions{
-			EnableOwnerRef:           opts.EnableCertificateOwnerRef,
-			CopiedAnnotationPrefixes: opts.CopiedAnnotationPrefixes,
+			EnableOwnerRef:                opts.EnableCertificateOwnerRef,
+			CopiedAnnotationPrefixes:      opts.CopiedAnnotationPrefixes,
+			Linter:                        certificateLinter,
+			StrictLinting:                 opts.CertificateLintStrict,
+			CertificateTransparencyLogs:   ctLogs,
+			StrictCertificateTransparency: opts.CertificateTransparencyStrict,
+			SecretDriftCheckInterval:      opts.SecretDriftCheckInterval,
+			SecretDriftAutoRepair:         opts.SecretDriftAutoRepair,
+			RevocationStatusCheckInterval: opts.RevocationStatusCheckInterval,
+			SoftDeleteRetention:           opts.CertificateSoftDeleteRetention,
+			DefaultRenewalJitter:          opts.DefaultRenewalJitter,
+			DefaultRevisionHistoryTTL:     opts.DefaultRevisionHistoryTTL,
+		},
+
+		ApproverOptions: controller.ApproverOptions{
+			ApprovalWebhook: approvalWebhook,
 		},
 	})
 	if err != nil {
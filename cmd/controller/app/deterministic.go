@@ -0,0 +1,34 @@
+//go:build deterministic_issuance
+
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"github.com/go-logr/logr"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/deterministic"
+)
+
+// enableDeterministicIssuance derives all private keys and serial numbers
+// generated by the controller from seed.
+func enableDeterministicIssuance(log logr.Logger, seed string) error {
+	log.Info("WARNING: deterministic issuance is enabled, private keys and serial numbers are predictable and must not be used outside of testing")
+	pki.SetEntropySource(deterministic.NewEntropySource([]byte(seed)))
+	return nil
+}
@@ -0,0 +1,32 @@
+//go:build !deterministic_issuance
+
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"errors"
+
+	"github.com/go-logr/logr"
+)
+
+// enableDeterministicIssuance always fails, as the deterministic entropy
+// source is not compiled into controllers built without the
+// deterministic_issuance build tag.
+func enableDeterministicIssuance(_ logr.Logger, _ string) error {
+	return errors.New("the DeterministicIssuance feature gate is only supported by controllers built with the deterministic_issuance build tag")
+}
@@ -17,9 +17,12 @@ limitations under the License.
 package options
 
 import (
+	"bytes"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"net"
+	"net/url"
 	"strings"
 	"time"
 
@@ -28,23 +31,39 @@ import (
 
 	cmdutil "github.com/cert-manager/cert-manager/cmd/util"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cm "github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	challengescontroller "github.com/cert-manager/cert-manager/pkg/controller/acmechallenges"
 	orderscontroller "github.com/cert-manager/cert-manager/pkg/controller/acmeorders"
+	bundlecontroller "github.com/cert-manager/cert-manager/pkg/controller/bundle"
+	shimhelper "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim"
 	shimgatewaycontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/gateways"
 	shimingresscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificate-shim/ingresses"
 	cracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/acme"
 	crapprovercontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/approver"
+	crawspcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/awspca"
+	crazurekeyvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/azurekeyvault"
 	crcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ca"
+	crejbcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/ejbca"
+	crgooglecascontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/googlecas"
+	crkubernetescsrcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/kubernetescsr"
+	crplugincontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/plugin"
 	crselfsignedcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/selfsigned"
+	crspirecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/spire"
+	crstepcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/stepca"
 	crvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/vault"
 	crvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/venafi"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/canary"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/keymanager"
 	certificatesmetricscontroller "github.com/cert-manager/cert-manager/pkg/controller/certificates/metrics"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/readiness"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/requestmanager"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revisionmanager"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/revocationstatus"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretdrift"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/secretreplication"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/softdelete"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/trigger"
 	csracmecontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/acme"
 	csrcacontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/ca"
@@ -52,10 +71,17 @@ import (
 	csrvaultcontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/vault"
 	csrvenaficontroller "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/venafi"
 	clusterissuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
+	crlcontroller "github.com/cert-manager/cert-manager/pkg/controller/crl"
+	"github.com/cert-manager/cert-manager/pkg/controller/ingressclassmigration"
+	issuerhealthcontroller "github.com/cert-manager/cert-manager/pkg/controller/issuerhealth"
 	issuerscontroller "github.com/cert-manager/cert-manager/pkg/controller/issuers"
+	ocspcontroller "github.com/cert-manager/cert-manager/pkg/controller/ocspresponder"
+	revocationcontroller "github.com/cert-manager/cert-manager/pkg/controller/revocation"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/lint"
 )
 
 type ControllerOptions struct {
@@ -72,6 +98,11 @@ type ControllerOptions struct {
 	LeaderElectionLeaseDuration time.Duration
 	LeaderElectionRenewDeadline time.Duration
 	LeaderElectionRetryPeriod   time.Duration
+	LeaderElectionHotStandby    bool
+
+	// ShutdownGracePeriod is how long items that are already being processed
+	// are given to complete when the controller is asked to shut down.
+	ShutdownGracePeriod time.Duration
 
 	controllers []string
 
@@ -82,6 +113,8 @@ type ControllerOptions struct {
 	ACMEHTTP01SolverResourceLimitsMemory  string
 	// Allows specifying a list of custom nameservers to perform HTTP01 checks on.
 	ACMEHTTP01SolverNameservers []string
+	// A JSON encoded pod template applied to all HTTP01 solver pods.
+	ACMEHTTP01SolverPodTemplate string
 
 	ClusterIssuerAmbientCredentials bool
 	IssuerAmbientCredentials        bool
@@ -91,6 +124,9 @@ type ControllerOptions struct {
 	DefaultIssuerKind                 string
 	DefaultIssuerGroup                string
 	DefaultAutoCertificateAnnotations []string
+	// IngressShimExtraDNSNameTemplates are Go templates used to compute
+	// additional DNS names for Certificates created by ingress-shim.
+	IngressShimExtraDNSNameTemplates []string
 
 	// Allows specifying a list of custom nameservers to perform DNS checks on.
 	DNS01RecursiveNameservers []string
@@ -102,6 +138,24 @@ type ControllerOptions struct {
 
 	MaxConcurrentChallenges int
 
+	// IssuerCircuitBreakerFailureThreshold is the number of consecutive
+	// failed requests to an issuer's upstream API after which requests to it
+	// are rejected without being sent.
+	IssuerCircuitBreakerFailureThreshold int
+	// IssuerCircuitBreakerOpenDuration is how long requests to a failing
+	// upstream are rejected for before a probe request is let through.
+	IssuerCircuitBreakerOpenDuration time.Duration
+	// IssuerMaxConcurrentRequests is the maximum number of requests that may
+	// be in flight to a single issuer's upstream API at once.
+	IssuerMaxConcurrentRequests int
+	// IssuanceCacheTTL is how long certificates issued by Vault and Venafi
+	// issuers are returned for identical requests instead of signing them
+	// again.
+	IssuanceCacheTTL time.Duration
+	// IssuerHealthCheckInterval is how often the issuer-health controller
+	// checks the connectivity and credentials of each issuer.
+	IssuerHealthCheckInterval time.Duration
+
 	// The host and port address, separated by a ':', that the Prometheus server
 	// should expose metrics on.
 	MetricsListenAddress string
@@ -110,6 +164,13 @@ type ControllerOptions struct {
 	PprofAddress string
 	// EnablePprof determines whether pprof should be enabled.
 	EnablePprof bool
+	// DebugListenAddress is the loopback address on which the state of each
+	// controller is served at /debug/controllers. If empty, the endpoint is
+	// disabled.
+	DebugListenAddress string
+	// CRLServerListenAddress is the address on which the CRLs published by
+	// the crl controller are served. If empty, CRLs are not served.
+	CRLServerListenAddress string
 
 	DNS01CheckRetryPeriod time.Duration
 
@@ -117,6 +178,74 @@ type ControllerOptions struct {
 	// CertificateRequest -> Order. Slice of string literals that are
 	// treated as prefixes for annotation keys.
 	CopiedAnnotationPrefixes []string
+
+	// CertificateLints is the list of lints run against newly issued
+	// certificates. If empty, issued certificates are not linted.
+	CertificateLints []string
+	// CertificateLintStrict causes certificates that fail any of the
+	// CertificateLints to be treated as failed issuances and re-issued,
+	// rather than only reported with a Warning event.
+	CertificateLintStrict bool
+
+	// CertificateTransparencyLogList is the path to a Certificate
+	// Transparency log list, used to verify the SCTs embedded in
+	// certificates issued by ACME issuers. If empty, SCTs are not verified.
+	CertificateTransparencyLogList string
+	// CertificateTransparencyStrict causes certificates without the SCTs
+	// required by browsers to be treated as failed issuances and re-issued,
+	// rather than only reported.
+	CertificateTransparencyStrict bool
+
+	// SecretDriftCheckInterval is how often the certificates-secret-drift
+	// controller re-checks each Certificate's Secret.
+	SecretDriftCheckInterval time.Duration
+	// SecretDriftAutoRepair causes Certificates whose Secret has drifted to
+	// be re-issued, rather than only reported.
+	SecretDriftAutoRepair bool
+
+	// RevocationStatusCheckInterval is how often the
+	// certificates-revocation-status controller checks the revocation status
+	// of each Certificate's certificate.
+	RevocationStatusCheckInterval time.Duration
+
+	// CertificateSoftDeleteRetention is how long the Secret of a deleted
+	// Certificate is kept for when the CertificateSoftDelete feature is
+	// enabled.
+	CertificateSoftDeleteRetention time.Duration
+
+	// DefaultRenewalJitter is the maximum amount of time by which the
+	// renewal of Certificates which do not set spec.renewalJitter is brought
+	// forward.
+	DefaultRenewalJitter time.Duration
+
+	// DefaultRevisionHistoryTTL is the maximum age of the CertificateRequest
+	// revisions of Certificates which do not set spec.revisionHistoryTTL.
+	// Zero disables garbage collecting these by age.
+	DefaultRevisionHistoryTTL time.Duration
+
+	// ApprovalWebhookURL is the URL of an external endpoint which is asked
+	// to approve or deny CertificateRequests which are not denied by a
+	// CertificateRequestPolicy. If empty, they are approved.
+	ApprovalWebhookURL string
+	// ApprovalWebhookCAFile is the path to the CAs trusted to serve the
+	// approval webhook. If empty, the system trust store is used.
+	ApprovalWebhookCAFile string
+	// ApprovalWebhookClientCertFile and ApprovalWebhookClientKeyFile are the
+	// paths to the client certificate and key presented to the approval
+	// webhook.
+	ApprovalWebhookClientCertFile string
+	ApprovalWebhookClientKeyFile  string
+	// ApprovalWebhookTokenFile is the path to a bearer token sent to the
+	// approval webhook.
+	ApprovalWebhookTokenFile string
+	// ApprovalWebhookTimeout is the timeout of each request to the approval
+	// webhook.
+	ApprovalWebhookTimeout time.Duration
+
+	// DeterministicIssuanceSeed is the seed from which private keys and
+	// serial numbers are derived when the DeterministicIssuance feature gate
+	// is enabled.
+	DeterministicIssuanceSeed string
 }
 
 const (
@@ -140,9 +269,30 @@ const (
 
 	defaultMaxConcurrentChallenges = 60
 
+	defaultIssuerCircuitBreakerFailureThreshold = 5
+	defaultIssuerCircuitBreakerOpenDuration     = 30 * time.Second
+	defaultIssuanceCacheTTL                     = 0
+	defaultIssuerMaxConcurrentRequests          = 0
+
 	defaultPrometheusMetricsServerAddress = "0.0.0.0:9402"
 
 	defaultDNS01CheckRetryPeriod = 10 * time.Second
+
+	defaultShutdownGracePeriod = 20 * time.Second
+
+	defaultSecretDriftCheckInterval = time.Hour
+
+	defaultRevocationStatusCheckInterval = time.Hour
+
+	defaultIssuerHealthCheckInterval = 10 * time.Minute
+
+	defaultCertificateSoftDeleteRetention = 7 * 24 * time.Hour
+
+	defaultRenewalJitter = 0
+
+	defaultRevisionHistoryTTL = 0
+
+	defaultApprovalWebhookTimeout = 10 * time.Second
 )
 
 var (
@@ -157,6 +307,7 @@ var (
 	allControllers = []string{
 		issuerscontroller.ControllerName,
 		clusterissuerscontroller.ControllerName,
+		issuerhealthcontroller.ControllerName,
 		certificatesmetricscontroller.ControllerName,
 		shimingresscontroller.ControllerName,
 		shimgatewaycontroller.ControllerName,
@@ -168,6 +319,14 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crawspcacontroller.CRControllerName,
+		crgooglecascontroller.CRControllerName,
+		crazurekeyvaultcontroller.CRControllerName,
+		crejbcacontroller.CRControllerName,
+		crstepcacontroller.CRControllerName,
+		crkubernetescsrcontroller.CRControllerName,
+		crspirecontroller.CRControllerName,
+		crplugincontroller.CRControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -175,11 +334,22 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		canary.ControllerName,
+		secretdrift.ControllerName,
+		secretreplication.ControllerName,
+		revocationstatus.ControllerName,
+		softdelete.ControllerName,
+		ingressclassmigration.ControllerName,
+		crlcontroller.ControllerName,
+		ocspcontroller.ControllerName,
+		revocationcontroller.ControllerName,
+		bundlecontroller.ControllerName,
 	}
 
 	defaultEnabledControllers = []string{
 		issuerscontroller.ControllerName,
 		clusterissuerscontroller.ControllerName,
+		issuerhealthcontroller.ControllerName,
 		certificatesmetricscontroller.ControllerName,
 		shimingresscontroller.ControllerName,
 		orderscontroller.ControllerName,
@@ -190,6 +360,14 @@ var (
 		crselfsignedcontroller.CRControllerName,
 		crvaultcontroller.CRControllerName,
 		crvenaficontroller.CRControllerName,
+		crawspcacontroller.CRControllerName,
+		crgooglecascontroller.CRControllerName,
+		crazurekeyvaultcontroller.CRControllerName,
+		crejbcacontroller.CRControllerName,
+		crstepcacontroller.CRControllerName,
+		crkubernetescsrcontroller.CRControllerName,
+		crspirecontroller.CRControllerName,
+		crplugincontroller.CRControllerName,
 		// certificate controllers
 		trigger.ControllerName,
 		issuing.ControllerName,
@@ -197,6 +375,11 @@ var (
 		requestmanager.ControllerName,
 		readiness.ControllerName,
 		revisionmanager.ControllerName,
+		canary.ControllerName,
+		softdelete.ControllerName,
+		crlcontroller.ControllerName,
+		ocspcontroller.ControllerName,
+		revocationcontroller.ControllerName,
 	}
 
 	experimentalCertificateSigningRequestControllers = []string{
@@ -218,31 +401,43 @@ var (
 
 func NewControllerOptions() *ControllerOptions {
 	return &ControllerOptions{
-		APIServerHost:                     defaultAPIServerHost,
-		ClusterResourceNamespace:          defaultClusterResourceNamespace,
-		KubernetesAPIQPS:                  defaultKubernetesAPIQPS,
-		KubernetesAPIBurst:                defaultKubernetesAPIBurst,
-		Namespace:                         defaultNamespace,
-		LeaderElect:                       cmdutil.DefaultLeaderElect,
-		LeaderElectionNamespace:           cmdutil.DefaultLeaderElectionNamespace,
-		LeaderElectionLeaseDuration:       cmdutil.DefaultLeaderElectionLeaseDuration,
-		LeaderElectionRenewDeadline:       cmdutil.DefaultLeaderElectionRenewDeadline,
-		LeaderElectionRetryPeriod:         cmdutil.DefaultLeaderElectionRetryPeriod,
-		controllers:                       defaultEnabledControllers,
-		ClusterIssuerAmbientCredentials:   defaultClusterIssuerAmbientCredentials,
-		IssuerAmbientCredentials:          defaultIssuerAmbientCredentials,
-		DefaultIssuerName:                 defaultTLSACMEIssuerName,
-		DefaultIssuerKind:                 defaultTLSACMEIssuerKind,
-		DefaultIssuerGroup:                defaultTLSACMEIssuerGroup,
-		DefaultAutoCertificateAnnotations: defaultAutoCertificateAnnotations,
-		ACMEHTTP01SolverNameservers:       []string{},
-		DNS01RecursiveNameservers:         []string{},
-		DNS01RecursiveNameserversOnly:     defaultDNS01RecursiveNameserversOnly,
-		EnableCertificateOwnerRef:         defaultEnableCertificateOwnerRef,
-		MetricsListenAddress:              defaultPrometheusMetricsServerAddress,
-		DNS01CheckRetryPeriod:             defaultDNS01CheckRetryPeriod,
-		EnablePprof:                       cmdutil.DefaultEnableProfiling,
-		PprofAddress:                      cmdutil.DefaultProfilerAddr,
+		APIServerHost:                        defaultAPIServerHost,
+		ClusterResourceNamespace:             defaultClusterResourceNamespace,
+		KubernetesAPIQPS:                     defaultKubernetesAPIQPS,
+		KubernetesAPIBurst:                   defaultKubernetesAPIBurst,
+		Namespace:                            defaultNamespace,
+		LeaderElect:                          cmdutil.DefaultLeaderElect,
+		LeaderElectionNamespace:              cmdutil.DefaultLeaderElectionNamespace,
+		LeaderElectionLeaseDuration:          cmdutil.DefaultLeaderElectionLeaseDuration,
+		LeaderElectionRenewDeadline:          cmdutil.DefaultLeaderElectionRenewDeadline,
+		LeaderElectionRetryPeriod:            cmdutil.DefaultLeaderElectionRetryPeriod,
+		ShutdownGracePeriod:                  defaultShutdownGracePeriod,
+		controllers:                          defaultEnabledControllers,
+		ClusterIssuerAmbientCredentials:      defaultClusterIssuerAmbientCredentials,
+		IssuerAmbientCredentials:             defaultIssuerAmbientCredentials,
+		DefaultIssuerName:                    defaultTLSACMEIssuerName,
+		DefaultIssuerKind:                    defaultTLSACMEIssuerKind,
+		DefaultIssuerGroup:                   defaultTLSACMEIssuerGroup,
+		DefaultAutoCertificateAnnotations:    defaultAutoCertificateAnnotations,
+		ACMEHTTP01SolverNameservers:          []string{},
+		DNS01RecursiveNameservers:            []string{},
+		DNS01RecursiveNameserversOnly:        defaultDNS01RecursiveNameserversOnly,
+		EnableCertificateOwnerRef:            defaultEnableCertificateOwnerRef,
+		MetricsListenAddress:                 defaultPrometheusMetricsServerAddress,
+		DNS01CheckRetryPeriod:                defaultDNS01CheckRetryPeriod,
+		IssuerCircuitBreakerFailureThreshold: defaultIssuerCircuitBreakerFailureThreshold,
+		IssuerCircuitBreakerOpenDuration:     defaultIssuerCircuitBreakerOpenDuration,
+		IssuanceCacheTTL:                     defaultIssuanceCacheTTL,
+		IssuerMaxConcurrentRequests:          defaultIssuerMaxConcurrentRequests,
+		IssuerHealthCheckInterval:            defaultIssuerHealthCheckInterval,
+		SecretDriftCheckInterval:             defaultSecretDriftCheckInterval,
+		RevocationStatusCheckInterval:        defaultRevocationStatusCheckInterval,
+		CertificateSoftDeleteRetention:       defaultCertificateSoftDeleteRetention,
+		DefaultRenewalJitter:                 defaultRenewalJitter,
+		DefaultRevisionHistoryTTL:            defaultRevisionHistoryTTL,
+		ApprovalWebhookTimeout:               defaultApprovalWebhookTimeout,
+		EnablePprof:                          cmdutil.DefaultEnableProfiling,
+		PprofAddress:                         cmdutil.DefaultProfilerAddr,
 	}
 }
 
@@ -278,6 +473,18 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.DurationVar(&s.LeaderElectionRetryPeriod, "leader-election-retry-period", cmdutil.DefaultLeaderElectionRetryPeriod, ""+
 		"The duration the clients should wait between attempting acquisition and renewal "+
 		"of a leadership. This is only applicable if leader election is enabled.")
+	fs.BoolVar(&s.LeaderElectionHotStandby, "leader-election-hot-standby", false, ""+
+		"If true, instances that are not the leader will sync their informer caches and "+
+		"initialise ACME clients for ready issuers while waiting to be elected, so that "+
+		"controllers can resume work immediately after a failover. This increases the "+
+		"memory usage and API server load of standby instances to match the leader. "+
+		"This is only applicable if leader election is enabled.")
+
+	fs.DurationVar(&s.ShutdownGracePeriod, "shutdown-grace-period", defaultShutdownGracePeriod, ""+
+		"The maximum time to wait for items that are already being processed to complete "+
+		"when the controller receives a termination signal. No new items are processed once "+
+		"shutdown has begun, and the leader election lease is held until in-flight items "+
+		"have completed. This should be shorter than the pod's termination grace period.")
 
 	fs.StringSliceVar(&s.controllers, "controllers", []string{"*"}, fmt.Sprintf(""+
 		"A list of controllers to enable. '--controllers=*' enables all "+
@@ -307,6 +514,11 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 			"ACME HTTP01 check requests. This should be a list containing host and "+
 			"port, for example 8.8.8.8:53,8.8.4.4:53")
 
+	fs.StringVar(&s.ACMEHTTP01SolverPodTemplate, "acme-http01-solver-pod-template", "", ""+
+		"A JSON encoded pod template, using the same schema as the podTemplate field of an "+
+		"ACME HTTP01 ingress solver, that is applied to every ACME HTTP01 challenge solver pod. "+
+		"A podTemplate configured on an individual solver takes precedence over this template.")
+
 	fs.BoolVar(&s.ClusterIssuerAmbientCredentials, "cluster-issuer-ambient-credentials", defaultClusterIssuerAmbientCredentials, ""+
 		"Whether a cluster-issuer may make use of ambient credentials for issuers. 'Ambient Credentials' are credentials drawn from the environment, metadata services, or local files which are not explicitly configured in the ClusterIssuer API object. "+
 		"When this flag is enabled, the following sources for credentials are also used: "+
@@ -317,6 +529,11 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"AWS - All sources the Go SDK defaults to, notably including any EC2 IAM roles available via instance metadata.")
 	fs.StringSliceVar(&s.DefaultAutoCertificateAnnotations, "auto-certificate-annotations", defaultAutoCertificateAnnotations, ""+
 		"The annotation consumed by the ingress-shim controller to indicate a ingress is requesting a certificate")
+	fs.StringArrayVar(&s.IngressShimExtraDNSNameTemplates, "ingress-shim-extra-dns-name-templates", nil, ""+
+		"Go templates used to compute additional DNS names for Certificates created by ingress-shim, e.g. "+
+		"'{{ .Host | trimSuffix \".example.com\" }}.internal.corp'. Each template is executed for every host with "+
+		"the fields Host, Kind, Namespace, Name, Labels and Annotations of the Ingress or Gateway, and may render an "+
+		"empty string to add nothing for a host. May be specified multiple times.")
 
 	fs.StringVar(&s.DefaultIssuerName, "default-issuer-name", defaultTLSACMEIssuerName, ""+
 		"Name of the Issuer to use when the tls is requested but issuer name is not specified on the ingress resource.")
@@ -327,7 +544,10 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 	fs.StringSliceVar(&s.DNS01RecursiveNameservers, "dns01-recursive-nameservers",
 		[]string{}, "A list of comma separated dns server endpoints used for "+
 			"DNS01 check requests. This should be a list containing host and "+
-			"port, for example 8.8.8.8:53,8.8.4.4:53")
+			"port, for example 8.8.8.8:53,8.8.4.4:53, or https:// URLs of "+
+			"DNS-over-HTTPS (RFC 8484) resolvers, for example https://1.1.1.1/dns-query. "+
+			"When DNS-over-HTTPS resolvers are used in environments that block "+
+			"port 53, --dns01-recursive-nameservers-only should also be set.")
 	fs.BoolVar(&s.DNS01RecursiveNameserversOnly, "dns01-recursive-nameservers-only",
 		defaultDNS01RecursiveNameserversOnly,
 		"When true, cert-manager will only ever query the configured DNS resolvers "+
@@ -344,8 +564,92 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"A prefix starting with a dash(-) specifies an annotation that shouldn't be copied. Example: '*,-kubectl.kuberenetes.io/'- all annotations"+
 		"will be copied apart from the ones where the key is prefixed with 'kubectl.kubernetes.io/'.")
 
+	fs.StringSliceVar(&s.CertificateLints, "certificate-lints", nil, ""+
+		"A list of lints to run against newly issued certificates, to catch certificates mis-issued by "+
+		"misconfigured CAs. Lints use zlint names. Available lints: "+strings.Join(lint.Names(), ", ")+".")
+	fs.BoolVar(&s.CertificateLintStrict, "certificate-lint-strict", false, ""+
+		"If true, certificates that fail any of the --certificate-lints are discarded and re-issued after the "+
+		"usual issuance failure back-off. If false, failures are only reported with a Warning event on the Certificate.")
+
+	fs.StringVar(&s.CertificateTransparencyLogList, "certificate-transparency-log-list", "", ""+
+		"Path to a Certificate Transparency log list, in the format of https://www.gstatic.com/ct/log_list/v3/log_list.json. "+
+		"If set, the SCTs embedded in certificates issued by ACME issuers are verified against the listed logs, and the "+
+		"result is recorded using the CertificateTransparency condition on the Certificate.")
+	fs.BoolVar(&s.CertificateTransparencyStrict, "certificate-transparency-strict", false, ""+
+		"If true, certificates issued by ACME issuers which do not have the SCTs required by the Certificate Transparency "+
+		"policies of browsers are discarded and re-issued after the usual issuance failure back-off. If false, they are only "+
+		"reported with a Warning event on the Certificate.")
+
+	fs.DurationVar(&s.SecretDriftCheckInterval, "secret-drift-check-interval", defaultSecretDriftCheckInterval, ""+
+		"How often the "+secretdrift.ControllerName+" controller re-checks that each Certificate's Secret still "+
+		"matches the certificate issued for it and the Certificate's spec. The controller is disabled by default, "+
+		"and can be enabled with --controllers=*,"+secretdrift.ControllerName+".")
+	fs.BoolVar(&s.SecretDriftAutoRepair, "secret-drift-auto-repair", false, ""+
+		"If true, Certificates whose Secret has drifted are re-issued by the "+secretdrift.ControllerName+" controller. "+
+		"If false, drift is only reported using the SecretDrift condition, a Warning event and the "+
+		"certmanager_certificate_secret_drift_status metric.")
+
+	fs.DurationVar(&s.RevocationStatusCheckInterval, "revocation-status-check-interval", defaultRevocationStatusCheckInterval, ""+
+		"How often the "+revocationstatus.ControllerName+" controller checks the revocation status of each Certificate's "+
+		"certificate using the OCSP responders and CRL distribution points listed in it. Revoked certificates are reported "+
+		"using the Revoked condition, a Warning event and the certmanager_certificate_revoked_status metric, and are re-issued. "+
+		"The controller is disabled by default, and can be enabled with --controllers=*,"+revocationstatus.ControllerName+".")
+
+	fs.DurationVar(&s.CertificateSoftDeleteRetention, "certificate-soft-delete-retention", defaultCertificateSoftDeleteRetention, ""+
+		"How long the Secret of a deleted Certificate is kept for when the CertificateSoftDelete feature gate is enabled. "+
+		"Until then, the Certificate can be restored using 'cmctl restore certificate'.")
+
+	fs.DurationVar(&s.DefaultRenewalJitter, "default-renewal-jitter", defaultRenewalJitter, ""+
+		"The maximum amount of time by which the renewal of a Certificate is brought forward, so that "+
+		"certificates issued together do not all renew at the same time. Each Certificate is renewed by an "+
+		"amount that is random but stable for the certificate. Certificates can override this with spec.renewalJitter.")
+
+	fs.DurationVar(&s.DefaultRevisionHistoryTTL, "default-revision-history-ttl", defaultRevisionHistoryTTL, ""+
+		"The maximum age of the CertificateRequests kept in the revision history of a Certificate. Older "+
+		"CertificateRequests are garbage collected, apart from the latest revision of each Certificate. "+
+		"Certificates can override this with spec.revisionHistoryTTL. If zero, CertificateRequests are only "+
+		"garbage collected according to spec.revisionHistoryLimit.")
+
+	fs.StringVar(&s.ApprovalWebhookURL, "approval-webhook-url", "", ""+
+		"The https URL of an external endpoint which the "+crapprovercontroller.ControllerName+" controller "+
+		"POSTs pending CertificateRequests to, with the details of their decoded signing request, to decide whether they "+
+		"are approved or denied. CertificateRequests denied by a CertificateRequestPolicy are not sent. The endpoint "+
+		"responds with an Approve, Deny or Pending decision; Pending CertificateRequests are sent again later. "+
+		"If empty, CertificateRequests which are not denied by a CertificateRequestPolicy are approved.")
+	fs.StringVar(&s.ApprovalWebhookCAFile, "approval-webhook-ca-file", "", ""+
+		"Path to a PEM encoded bundle of the CAs trusted to serve the --approval-webhook-url. "+
+		"If empty, the system trust store is used.")
+	fs.StringVar(&s.ApprovalWebhookClientCertFile, "approval-webhook-client-cert-file", "", ""+
+		"Path to a PEM encoded client certificate presented to the --approval-webhook-url. Use this if the "+
+		"endpoint verifies client certificates. Requires --approval-webhook-client-key-file.")
+	fs.StringVar(&s.ApprovalWebhookClientKeyFile, "approval-webhook-client-key-file", "", ""+
+		"Path to the PEM encoded private key of the --approval-webhook-client-cert-file.")
+	fs.StringVar(&s.ApprovalWebhookTokenFile, "approval-webhook-token-file", "", ""+
+		"Path to a file holding a bearer token sent to the --approval-webhook-url in the Authorization header. "+
+		"Use this if the endpoint is behind a proxy which terminates TLS and authenticates requests, for example "+
+		"with a projected ServiceAccount token. The file is read for every request.")
+	fs.DurationVar(&s.ApprovalWebhookTimeout, "approval-webhook-timeout", defaultApprovalWebhookTimeout, ""+
+		"The timeout of each request to the --approval-webhook-url.")
+
 	fs.IntVar(&s.MaxConcurrentChallenges, "max-concurrent-challenges", defaultMaxConcurrentChallenges, ""+
 		"The maximum number of challenges that can be scheduled as 'processing' at once.")
+	fs.IntVar(&s.IssuerCircuitBreakerFailureThreshold, "issuer-circuit-breaker-failure-threshold", defaultIssuerCircuitBreakerFailureThreshold, ""+
+		"The number of consecutive failed requests to a Vault or Venafi issuer's API after which further "+
+		"requests to it are rejected immediately. Set to 0 to disable the circuit breaker.")
+	fs.DurationVar(&s.IssuerCircuitBreakerOpenDuration, "issuer-circuit-breaker-open-duration", defaultIssuerCircuitBreakerOpenDuration, ""+
+		"The duration for which requests to a failing issuer's API are rejected before a single probe request is "+
+		"let through to check whether it has recovered.")
+	fs.IntVar(&s.IssuerMaxConcurrentRequests, "issuer-max-concurrent-requests", defaultIssuerMaxConcurrentRequests, ""+
+		"The maximum number of requests that may be in flight to a single Vault or Venafi issuer's API at once. "+
+		"Defaults to 0, which means no limit.")
+	fs.DurationVar(&s.IssuanceCacheTTL, "issuance-cache-ttl", defaultIssuanceCacheTTL, ""+
+		"How long a certificate issued by a Vault or Venafi issuer is reused for identical requests (same "+
+		"key, subject, SANs and issuer) instead of asking the issuer to sign them again. Protects the upstream "+
+		"from duplicate requests caused by reconcile loops. Defaults to 0, which disables the cache.")
+	fs.DurationVar(&s.IssuerHealthCheckInterval, "issuer-health-check-interval", defaultIssuerHealthCheckInterval, ""+
+		"How often the "+issuerhealthcontroller.ControllerName+" controller checks that each ACME, Vault and Venafi "+
+		"issuer can still reach its server and authenticate with it. The result is reported using the Healthy "+
+		"condition of the issuer and the certmanager_issuer_healthy metric.")
 	fs.DurationVar(&s.DNS01CheckRetryPeriod, "dns01-check-retry-period", defaultDNS01CheckRetryPeriod, ""+
 		"The duration the controller should wait between checking if a ACME dns entry exists."+
 		"This should be a valid duration string, for example 180s or 1h")
@@ -356,6 +660,19 @@ func (s *ControllerOptions) AddFlags(fs *pflag.FlagSet) {
 		"Enable profiling for controller.")
 	fs.StringVar(&s.PprofAddress, "profiler-address", cmdutil.DefaultProfilerAddr,
 		"The host and port that Go profiler should listen on, i.e localhost:6060. Ensure that profiler is not exposed on a public address. Profiler will be served at /debug/pprof.")
+	fs.StringVar(&s.DebugListenAddress, "debug-listen-address", "", ""+
+		"The loopback host and port that the controller debug endpoint should listen on, i.e localhost:6061. "+
+		"The workqueue depth, oldest item age, retries and most recent errors of each controller will be served as JSON at /debug/controllers. "+
+		"If empty, the debug endpoint is disabled.")
+	fs.StringVar(&s.CRLServerListenAddress, "crl-server-listen-address", "", ""+
+		"The host and port that the CRLs published by the "+crlcontroller.ControllerName+" controller should be served on, i.e :8080. "+
+		"The CRL of an Issuer is served in DER form at /issuers/<namespace>/<name>, and of a ClusterIssuer at /clusterissuers/<name>. "+
+		"If empty, CRLs are not served.")
+
+	fs.StringVar(&s.DeterministicIssuanceSeed, "deterministic-issuance-seed", "", ""+
+		"The seed from which private keys and serial numbers are derived when the DeterministicIssuance "+
+		"feature gate is enabled. Only intended for reproducible test environments, and only supported by "+
+		"controllers built with the deterministic_issuance build tag.")
 }
 
 func (o *ControllerOptions) Validate() error {
@@ -375,7 +692,120 @@ func (o *ControllerOptions) Validate() error {
 		return fmt.Errorf("invalid value for kube-api-burst: %v must be higher or equal to kube-api-qps: %v", o.KubernetesAPIQPS, o.KubernetesAPIQPS)
 	}
 
-	for _, server := range append(o.DNS01RecursiveNameservers, o.ACMEHTTP01SolverNameservers...) {
+	if o.IssuerCircuitBreakerFailureThreshold < 0 {
+		return fmt.Errorf("invalid value for issuer-circuit-breaker-failure-threshold: %v must not be negative", o.IssuerCircuitBreakerFailureThreshold)
+	}
+
+	if o.ShutdownGracePeriod < 0 {
+		return fmt.Errorf("invalid value for shutdown-grace-period: %v must not be negative", o.ShutdownGracePeriod)
+	}
+
+	if o.IssuerMaxConcurrentRequests < 0 {
+		return fmt.Errorf("invalid value for issuer-max-concurrent-requests: %v must not be negative", o.IssuerMaxConcurrentRequests)
+	}
+
+	if o.IssuanceCacheTTL < 0 {
+		return fmt.Errorf("invalid value for issuance-cache-ttl: %v must not be negative", o.IssuanceCacheTTL)
+	}
+
+	if o.DefaultRenewalJitter < 0 {
+		return fmt.Errorf("invalid value for default-renewal-jitter: %v must not be negative", o.DefaultRenewalJitter)
+	}
+
+	if o.DefaultRevisionHistoryTTL < 0 {
+		return fmt.Errorf("invalid value for default-revision-history-ttl: %v must not be negative", o.DefaultRevisionHistoryTTL)
+	}
+
+	if o.CertificateSoftDeleteRetention <= 0 {
+		return fmt.Errorf("invalid value for certificate-soft-delete-retention: %v must be greater than zero", o.CertificateSoftDeleteRetention)
+	}
+
+	if o.IssuerHealthCheckInterval <= 0 {
+		return fmt.Errorf("invalid value for issuer-health-check-interval: %v must be greater than zero", o.IssuerHealthCheckInterval)
+	}
+
+	if o.SecretDriftCheckInterval <= 0 {
+		return fmt.Errorf("invalid value for secret-drift-check-interval: %v must be greater than zero", o.SecretDriftCheckInterval)
+	}
+
+	if o.RevocationStatusCheckInterval <= 0 {
+		return fmt.Errorf("invalid value for revocation-status-check-interval: %v must be greater than zero", o.RevocationStatusCheckInterval)
+	}
+
+	if _, err := lint.NewLinter(o.CertificateLints); err != nil {
+		return fmt.Errorf("invalid value for certificate-lints: %w", err)
+	}
+
+	if o.CertificateLintStrict && len(o.CertificateLints) == 0 {
+		return errors.New("the --certificate-lint-strict flag requires --certificate-lints to be set")
+	}
+
+	if o.ApprovalWebhookTimeout <= 0 {
+		return fmt.Errorf("invalid value for approval-webhook-timeout: %v must be greater than zero", o.ApprovalWebhookTimeout)
+	}
+
+	if len(o.ApprovalWebhookCAFile) > 0 && len(o.ApprovalWebhookURL) == 0 {
+		return errors.New("the --approval-webhook-ca-file flag requires --approval-webhook-url to be set")
+	}
+
+	if (len(o.ApprovalWebhookClientCertFile) > 0 || len(o.ApprovalWebhookTokenFile) > 0) && len(o.ApprovalWebhookURL) == 0 {
+		return errors.New("the --approval-webhook-client-cert-file and --approval-webhook-token-file flags require --approval-webhook-url to be set")
+	}
+
+	if (len(o.ApprovalWebhookClientCertFile) > 0) != (len(o.ApprovalWebhookClientKeyFile) > 0) {
+		return errors.New("the --approval-webhook-client-cert-file and --approval-webhook-client-key-file flags must be set together")
+	}
+
+	if o.CertificateTransparencyStrict && len(o.CertificateTransparencyLogList) == 0 {
+		return errors.New("the --certificate-transparency-strict flag requires --certificate-transparency-log-list to be set")
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.DeterministicIssuance) {
+		if len(o.DeterministicIssuanceSeed) == 0 {
+			return errors.New("the --deterministic-issuance-seed flag must be set when the DeterministicIssuance feature gate is enabled")
+		}
+	}
+
+	if len(o.CRLServerListenAddress) > 0 {
+		if _, _, err := net.SplitHostPort(o.CRLServerListenAddress); err != nil {
+			return fmt.Errorf("invalid value for crl-server-listen-address: %v", err)
+		}
+	}
+
+	if len(o.DebugListenAddress) > 0 {
+		host, _, err := net.SplitHostPort(o.DebugListenAddress)
+		if err != nil {
+			return fmt.Errorf("invalid value for debug-listen-address: %v", err)
+		}
+		if ip := net.ParseIP(host); host != "localhost" && (ip == nil || !ip.IsLoopback()) {
+			return fmt.Errorf("invalid value for debug-listen-address: %q is not a loopback address", host)
+		}
+	}
+
+	if _, err := o.HTTP01SolverPodTemplate(); err != nil {
+		return fmt.Errorf("invalid value for acme-http01-solver-pod-template: %v", err)
+	}
+
+	if _, err := shimhelper.ParseDNSNameTemplates(o.IngressShimExtraDNSNameTemplates); err != nil {
+		return fmt.Errorf("invalid value for ingress-shim-extra-dns-name-templates: %v", err)
+	}
+
+	for _, server := range o.DNS01RecursiveNameservers {
+		// DNS-over-HTTPS resolvers are specified as URLs rather than a host and port
+		if dnsutil.IsDoHNameserver(server) {
+			if u, err := url.Parse(server); err != nil || u.Host == "" {
+				return fmt.Errorf("invalid DNS-over-HTTPS server URL: %v", server)
+			}
+			continue
+		}
+		// ensure all servers have a port number
+		_, _, err := net.SplitHostPort(server)
+		if err != nil {
+			return fmt.Errorf("invalid DNS server (%v): %v", err, server)
+		}
+	}
+
+	for _, server := range o.ACMEHTTP01SolverNameservers {
 		// ensure all servers have a port number
 		_, _, err := net.SplitHostPort(server)
 		if err != nil {
@@ -403,6 +833,24 @@ func (o *ControllerOptions) Validate() error {
 	return nil
 }
 
+// HTTP01SolverPodTemplate decodes the pod template given with
+// --acme-http01-solver-pod-template. It returns nil if no template is set.
+func (o *ControllerOptions) HTTP01SolverPodTemplate() (*cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate, error) {
+	if len(o.ACMEHTTP01SolverPodTemplate) == 0 {
+		return nil, nil
+	}
+
+	dec := json.NewDecoder(bytes.NewBufferString(o.ACMEHTTP01SolverPodTemplate))
+	dec.DisallowUnknownFields()
+
+	podTemplate := new(cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate)
+	if err := dec.Decode(podTemplate); err != nil {
+		return nil, err
+	}
+
+	return podTemplate, nil
+}
+
 func (o *ControllerOptions) EnabledControllers() sets.String {
 	var disabled []string
 	enabled := sets.NewString()
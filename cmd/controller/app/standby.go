@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"crypto/rsa"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/issuers"
+	acmeissuer "github.com/cert-manager/cert-manager/pkg/issuer/acme"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// standbyWarmer prepares a non-leader instance to take over from the leader
+// with as little delay as possible. It waits for the informer caches used by
+// the controllers to sync, and registers ACME clients for issuers that have
+// already been set up by the leader so that orders and challenges can be
+// processed before the issuers controller has re-verified every account.
+type standbyWarmer struct {
+	ctx *controller.Context
+
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	secretLister        corelisters.SecretLister
+
+	mustSync []cache.InformerSynced
+}
+
+// newStandbyWarmer must be called before the shared informer factories are
+// started, so that the informers it depends on are started with them.
+func newStandbyWarmer(ctxFactory *controller.ContextFactory) (*standbyWarmer, error) {
+	// Use the issuers controller's context so that warmed ACME clients are
+	// identical to the ones the issuers controller would register.
+	ctx, err := ctxFactory.Build(issuers.ControllerName)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	secretInformer := ctx.KubeSharedInformerFactory.Core().V1().Secrets()
+	w := &standbyWarmer{
+		ctx:          ctx,
+		issuerLister: issuerInformer.Lister(),
+		secretLister: secretInformer.Lister(),
+		mustSync:     []cache.InformerSynced{issuerInformer.Informer().HasSynced, secretInformer.Informer().HasSynced},
+	}
+
+	// ClusterIssuers are not watched if cert-manager is scoped to a single
+	// namespace.
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		w.clusterIssuerLister = clusterIssuerInformer.Lister()
+		w.mustSync = append(w.mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return w, nil
+}
+
+// warm blocks until all started informer caches have synced, and then
+// registers ACME clients for all ready ACME issuers. It does not make any
+// requests to the API server or to ACME servers, as only the leader may act on
+// the state it observes.
+func (w *standbyWarmer) warm(ctx context.Context) error {
+	log := logf.FromContext(ctx, "hot-standby")
+
+	for typ, synced := range w.ctx.SharedInformerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("error waiting for %v informer cache to sync", typ)
+		}
+	}
+	for typ, synced := range w.ctx.KubeSharedInformerFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("error waiting for %v informer cache to sync", typ)
+		}
+	}
+	if !cache.WaitForCacheSync(ctx.Done(), w.mustSync...) {
+		return fmt.Errorf("error waiting for issuer informer caches to sync")
+	}
+
+	var genericIssuers []cmapi.GenericIssuer
+	issuers, err := w.issuerLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, iss := range issuers {
+		genericIssuers = append(genericIssuers, iss)
+	}
+	if w.clusterIssuerLister != nil {
+		clusterIssuers, err := w.clusterIssuerLister.List(labels.Everything())
+		if err != nil {
+			return err
+		}
+		for _, iss := range clusterIssuers {
+			genericIssuers = append(genericIssuers, iss)
+		}
+	}
+
+	warmed := 0
+	for _, iss := range genericIssuers {
+		log := logf.WithResource(log, iss)
+		ok, err := w.warmACMEClient(iss)
+		if err != nil {
+			// The issuers controller will retry setting up the issuer once
+			// this instance has been elected, so failures are not fatal.
+			log.V(logf.DebugLevel).Info("not warming ACME client for issuer", "reason", err.Error())
+			continue
+		}
+		if ok {
+			warmed++
+		}
+	}
+	log.V(logf.InfoLevel).Info("warmed ACME clients", "count", warmed)
+
+	return nil
+}
+
+// warmACMEClient registers the ACME client for the primary account of iss, if
+// iss is an ACME issuer whose account has been registered and verified for
+// its current generation.
+// Additional accounts are registered by the issuers controller once this
+// instance has been elected.
+func (w *standbyWarmer) warmACMEClient(iss cmapi.GenericIssuer) (bool, error) {
+	spec := iss.GetSpec().ACME
+	if spec == nil {
+		return false, nil
+	}
+
+	// Only warm clients for accounts which the leader has verified against
+	// the current spec. Otherwise the issuers controller must register the
+	// account again once this instance has been elected, and a client built
+	// now could be used for a stale account in the meantime.
+	if !acmeissuer.CachedRegistrationIsCurrent(iss) {
+		return false, nil
+	}
+	if !readyForCurrentGeneration(iss) {
+		return false, nil
+	}
+
+	ns, err := w.ctx.IssuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		return false, err
+	}
+
+	sel := acme.PrivateKeySelector(spec.PrivateKey)
+	secret, err := w.secretLister.Secrets(ns).Get(sel.Name)
+	if err != nil {
+		return false, err
+	}
+	keyBytes, ok := secret.Data[sel.Key]
+	if !ok {
+		return false, fmt.Errorf("no data for %q in secret '%s/%s'", sel.Key, ns, sel.Name)
+	}
+	pk, err := pki.DecodePrivateKeyBytes(keyBytes)
+	if err != nil {
+		return false, err
+	}
+	rsaPk, ok := pk.(*rsa.PrivateKey)
+	if !ok {
+		return false, fmt.Errorf("ACME private key in %q is not of type RSA", sel.Name)
+	}
+
+	httpClient := accounts.BuildHTTPClient(w.ctx.Metrics, spec.SkipTLSVerify)
+	w.ctx.AccountRegistry.AddClient(httpClient, string(iss.GetUID()), *spec, rsaPk, w.ctx.RESTConfig.UserAgent)
+
+	return true, nil
+}
+
+// readyForCurrentGeneration returns true if iss has a Ready condition with
+// status True that was set for its current generation.
+func readyForCurrentGeneration(iss cmapi.GenericIssuer) bool {
+	for _, c := range iss.GetStatus().Conditions {
+		if c.Type == cmapi.IssuerConditionReady {
+			return c.Status == cmmeta.ConditionTrue && c.ObservedGeneration == iss.GetObjectMeta().Generation
+		}
+	}
+	return false
+}
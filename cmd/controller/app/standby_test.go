@@ -0,0 +1,302 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"github.com/spf13/pflag"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cert-manager/cert-manager/cmd/controller/app/options"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+)
+
+func TestReadyForCurrentGeneration(t *testing.T) {
+	tests := map[string]struct {
+		generation int64
+		conditions []cmapi.IssuerCondition
+		expected   bool
+	}{
+		"no Ready condition": {
+			generation: 2,
+			expected:   false,
+		},
+		"Ready for the current generation": {
+			generation: 2,
+			conditions: []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 2}},
+			expected:   true,
+		},
+		"Ready for a previous generation": {
+			generation: 2,
+			conditions: []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 1}},
+			expected:   false,
+		},
+		"not Ready for the current generation": {
+			generation: 2,
+			conditions: []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionFalse, ObservedGeneration: 2}},
+			expected:   false,
+		},
+		"Ready status unknown for the current generation": {
+			generation: 2,
+			conditions: []cmapi.IssuerCondition{{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionUnknown, ObservedGeneration: 2}},
+			expected:   false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			iss := gen.Issuer("test", setIssuerGeneration(test.generation))
+			iss.Status.Conditions = test.conditions
+			if got := readyForCurrentGeneration(iss); got != test.expected {
+				t.Errorf("expected %t, got %t", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestStandbyWarmerWarm(t *testing.T) {
+	const (
+		namespace                = "default-unit-test-ns"
+		clusterResourceNamespace = "cert-manager"
+		server                   = "https://acme.example.com/directory"
+		accountURL               = "https://acme.example.com/account/1"
+		email                    = "test@example.com"
+	)
+
+	pkData := testcrypto.MustCreatePEMPrivateKey(t)
+	keySecret := func(namespace string) *corev1.Secret {
+		return &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "account-key", Namespace: namespace},
+			Data:       map[string][]byte{corev1.TLSPrivateKeyKey: pkData},
+		}
+	}
+
+	acmeIssuer := func(name string, ready cmmeta.ConditionStatus, observedGeneration int64) *cmapi.Issuer {
+		return gen.Issuer(name,
+			gen.SetIssuerNamespace(namespace),
+			setIssuerUID(name),
+			setIssuerGeneration(2),
+			gen.SetIssuerACMEURL(server),
+			gen.SetIssuerACMEEmail(email),
+			gen.SetIssuerACMEPrivKeyRef("account-key"),
+			gen.SetIssuerACMEAccountURL(accountURL),
+			gen.SetIssuerACMELastRegisteredEmail(email),
+			gen.AddIssuerCondition(cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: ready, ObservedGeneration: observedGeneration}),
+		)
+	}
+
+	clusterIssuer := gen.ClusterIssuer("cluster-ready",
+		setIssuerUID("cluster-ready"),
+		setIssuerGeneration(1),
+		gen.SetIssuerACMEURL(server),
+		gen.SetIssuerACMEEmail(email),
+		gen.SetIssuerACMEPrivKeyRef("account-key"),
+		gen.SetIssuerACMEAccountURL(accountURL),
+		gen.SetIssuerACMELastRegisteredEmail(email),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{Type: cmapi.IssuerConditionReady, Status: cmmeta.ConditionTrue, ObservedGeneration: 1}),
+	)
+
+	emailChanged := acmeIssuer("email-changed", cmmeta.ConditionTrue, 2)
+	emailChanged.Spec.ACME.Email = "new@example.com"
+
+	missingKey := acmeIssuer("missing-key", cmmeta.ConditionTrue, 2)
+	missingKey.Spec.ACME.PrivateKey.Name = "does-not-exist"
+
+	builder := &testpkg.Builder{
+		T: t,
+		KubeObjects: []runtime.Object{keySecret(namespace), keySecret(clusterResourceNamespace)},
+		CertManagerObjects: []runtime.Object{
+			acmeIssuer("ready", cmmeta.ConditionTrue, 2),
+			acmeIssuer("not-ready", cmmeta.ConditionFalse, 2),
+			acmeIssuer("stale", cmmeta.ConditionTrue, 1),
+			emailChanged,
+			missingKey,
+			gen.Issuer("not-acme", gen.SetIssuerNamespace(namespace), gen.SetIssuerSelfSigned(cmapi.SelfSignedIssuer{})),
+			clusterIssuer,
+		},
+	}
+	builder.InitWithRESTConfig()
+	defer builder.Stop()
+	builder.AccountRegistry = accounts.NewDefaultRegistry()
+	builder.IssuerOptions.ClusterResourceNamespace = clusterResourceNamespace
+
+	issuerInformer := builder.SharedInformerFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := builder.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+	secretInformer := builder.KubeSharedInformerFactory.Core().V1().Secrets()
+	w := &standbyWarmer{
+		ctx:                 builder.Context,
+		issuerLister:        issuerInformer.Lister(),
+		clusterIssuerLister: clusterIssuerInformer.Lister(),
+		secretLister:        secretInformer.Lister(),
+		mustSync: []cache.InformerSynced{
+			issuerInformer.Informer().HasSynced,
+			clusterIssuerInformer.Informer().HasSynced,
+			secretInformer.Informer().HasSynced,
+		},
+	}
+	builder.Start()
+
+	if err := w.warm(context.Background()); err != nil {
+		t.Fatalf("unexpected error warming caches: %v", err)
+	}
+
+	// Only the ACME clients for accounts which are Ready and verified for the
+	// current generation should have been registered.
+	clients := builder.AccountRegistry.ListClients()
+	for _, uid := range []string{"ready", "cluster-ready"} {
+		if _, ok := clients[uid]; !ok {
+			t.Errorf("expected an ACME client to be registered for issuer %q", uid)
+		}
+	}
+	if len(clients) != 2 {
+		var uids []string
+		for uid := range clients {
+			uids = append(uids, uid)
+		}
+		t.Errorf("expected 2 ACME clients to be registered, got %v", uids)
+	}
+
+	// Warming must not write to the API server, as only the leader may act
+	// on the state that it observes.
+	for _, action := range append(builder.FakeCMClient().Actions(), builder.FakeKubeClient().Actions()...) {
+		if verb := action.GetVerb(); verb != "list" && verb != "watch" {
+			t.Errorf("unexpected %s %s action while in standby", verb, action.GetResource().Resource)
+		}
+	}
+}
+
+func setIssuerGeneration(generation int64) gen.IssuerModifier {
+	return func(iss cmapi.GenericIssuer) {
+		iss.GetObjectMeta().Generation = generation
+	}
+}
+
+func setIssuerUID(uid string) gen.IssuerModifier {
+	return func(iss cmapi.GenericIssuer) {
+		iss.GetObjectMeta().UID = types.UID(uid)
+	}
+}
+
+// Test to ensure that controllers built before this instance is elected
+// leader, whose informers are started by startStandby, do not write to the API
+// server while this instance is in standby.
+func TestStartStandbyDoesNotWrite(t *testing.T) {
+	var (
+		lock   sync.Mutex
+		writes []string
+	)
+	apiServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.Method != http.MethodGet {
+			lock.Lock()
+			writes = append(writes, r.Method+" "+r.URL.Path)
+			lock.Unlock()
+			w.WriteHeader(http.StatusForbidden)
+			fmt.Fprint(w, `{"kind":"Status","apiVersion":"v1","status":"Failure","reason":"Forbidden","code":403}`)
+			return
+		}
+		if r.URL.Query().Get("watch") == "true" {
+			w.WriteHeader(http.StatusOK)
+			w.(http.Flusher).Flush()
+			<-r.Context().Done()
+			return
+		}
+		switch r.URL.Path {
+		case "/version":
+			fmt.Fprint(w, `{"major":"1","minor":"23","gitVersion":"v1.23.0"}`)
+		case "/api":
+			fmt.Fprint(w, `{"kind":"APIVersions","versions":["v1"]}`)
+		case "/apis":
+			fmt.Fprint(w, `{"kind":"APIGroupList","groups":[]}`)
+		case "/apis/networking.k8s.io/v1":
+			fmt.Fprint(w, `{"kind":"APIResourceList","groupVersion":"networking.k8s.io/v1","resources":[{"name":"ingresses","namespaced":true,"kind":"Ingress","verbs":["get","list","watch"]}]}`)
+		case "/apis/cert-manager.io/v1/certificates":
+			fmt.Fprint(w, `{"metadata":{"resourceVersion":"1"},"items":[{"metadata":{"name":"test","namespace":"default","uid":"crt","generation":1},"spec":{"secretName":"test-tls","dnsNames":["example.com"],"issuerRef":{"name":"test"}}}]}`)
+		case "/apis/cert-manager.io/v1/certificaterequests":
+			fmt.Fprint(w, `{"metadata":{"resourceVersion":"1"},"items":[{"metadata":{"name":"test-1","namespace":"default","uid":"cr","ownerReferences":[{"apiVersion":"cert-manager.io/v1","kind":"Certificate","name":"test","uid":"crt","controller":true}]},"spec":{"request":"","issuerRef":{"name":"test"}}}]}`)
+		case "/apis/cert-manager.io/v1/issuers":
+			fmt.Fprint(w, `{"metadata":{"resourceVersion":"1"},"items":[{"metadata":{"name":"test","namespace":"default","uid":"iss","generation":1},"spec":{"selfSigned":{}}}]}`)
+		case "/api/v1/secrets":
+			fmt.Fprint(w, `{"metadata":{"resourceVersion":"1"},"items":[{"metadata":{"name":"test-tls","namespace":"default","annotations":{"cert-manager.io/certificate-name":"test"}},"type":"kubernetes.io/tls","data":{"tls.crt":"","tls.key":""}}]}`)
+		default:
+			// Every other informer starts by listing its resource, which is
+			// empty.
+			fmt.Fprint(w, `{"metadata":{"resourceVersion":"1"},"items":[]}`)
+		}
+	}))
+	defer apiServer.Close()
+
+	rootCtx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+
+	opts := options.NewControllerOptions()
+	fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+	opts.AddFlags(fs)
+	if err := fs.Parse([]string{"--master=" + apiServer.URL}); err != nil {
+		t.Fatal(err)
+	}
+	ctxFactory, err := buildControllerContextFactory(rootCtx, opts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ctx, err := ctxFactory.Build()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Build every known controller, not only those enabled by default.
+	enabledControllers := sets.StringKeySet(controller.Known())
+	controllers, err := buildControllers(logf.Log, ctxFactory, enabledControllers, ctx.Namespace)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(controllers) == 0 {
+		t.Fatal("expected controllers to be built")
+	}
+
+	if err := startStandby(rootCtx, ctxFactory, ctx); err != nil {
+		t.Fatal(err)
+	}
+	// Give the event handlers of every controller time to act on the
+	// objects in the synced caches, none of which are up to date.
+	time.Sleep(time.Second)
+
+	lock.Lock()
+	defer lock.Unlock()
+	if len(writes) > 0 {
+		t.Errorf("expected no writes to the API server while in standby, got %v", writes)
+	}
+}
@@ -32,8 +32,16 @@ import (
 	_ "github.com/cert-manager/cert-manager/pkg/controller/clusterissuers"
 	_ "github.com/cert-manager/cert-manager/pkg/controller/issuers"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/acme"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/awspca"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/ca"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/ejbca"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/googlecas"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/kubernetescsr"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/plugin"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/selfsigned"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/spire"
+	_ "github.com/cert-manager/cert-manager/pkg/issuer/stepca"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/vault"
 	_ "github.com/cert-manager/cert-manager/pkg/issuer/venafi"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	"k8s.io/client-go/tools/clientcmd"
+
+	"github.com/cert-manager/cert-manager/cmd/util"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	"github.com/cert-manager/cert-manager/pkg/csi/driver"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+type csiDriverOptions struct {
+	APIServerHost      string
+	Kubeconfig         string
+	DriverName         string
+	NodeID             string
+	Endpoint           string
+	DataRoot           string
+	RenewalRetryPeriod time.Duration
+}
+
+func NewCSIDriverCommand(stopCh <-chan struct{}) *cobra.Command {
+	o := new(csiDriverOptions)
+
+	cmd := &cobra.Command{
+		Use:   "csidriver",
+		Short: "CSI driver mounting cert-manager certificates into pods.",
+		Long: `CSI driver mounting cert-manager certificates into pods.
+
+Serves CSI ephemeral inline volumes holding a certificate and private key
+unique to the pod they are mounted into. The certificate is requested with a
+CertificateRequest in the namespace of the pod, configured by the
+csi.cert-manager.io/* volume attributes, and renewed in place before it
+expires. The private key never leaves the node.
+
+The driver must run on every node as a privileged container, with the kubelet
+pods directory mounted with bidirectional mount propagation.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCtx := util.ContextWithStopCh(context.Background(), stopCh)
+			rootCtx = logf.NewContext(rootCtx, logf.Log, "csidriver")
+			return o.Run(rootCtx)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.APIServerHost, "master", "", ""+
+		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
+		"will be attempted.")
+	cmd.Flags().StringVar(&o.Kubeconfig, "kubeconfig", "", ""+
+		"Paths to a kubeconfig. Only required if out-of-cluster.")
+	cmd.Flags().StringVar(&o.DriverName, "driver-name", "csi.cert-manager.io", ""+
+		"Name of the CSIDriver the driver serves volumes for.")
+	cmd.Flags().StringVar(&o.NodeID, "node-id", "", ""+
+		"Name of the node the driver runs on.")
+	cmd.Flags().StringVar(&o.Endpoint, "endpoint", "unix:///plugin/csi.sock", ""+
+		"Unix domain socket the CSI services are served on, which is registered with the kubelet.")
+	cmd.Flags().StringVar(&o.DataRoot, "data-root", "/csi-data-dir", ""+
+		"Directory the certificates and private keys of volumes are stored in. This should be "+
+		"backed by memory so that private keys are never written to disk.")
+	cmd.Flags().DurationVar(&o.RenewalRetryPeriod, "renewal-retry-period", time.Minute, ""+
+		"How long renewing the certificate of a volume is delayed after it failed.")
+
+	return cmd
+}
+
+func (o *csiDriverOptions) Run(ctx context.Context) error {
+	if o.NodeID == "" {
+		return errors.New("--node-id must be set")
+	}
+	socket := strings.TrimPrefix(o.Endpoint, "unix://")
+	if socket == o.Endpoint || socket == "" {
+		return fmt.Errorf("--endpoint must be a unix:// address, got %q", o.Endpoint)
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.APIServerHost, o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error creating rest config: %w", err)
+	}
+	cmClient, err := cmclient.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating cert-manager client: %w", err)
+	}
+
+	d, err := driver.New(driver.Options{
+		DriverName:         o.DriverName,
+		NodeID:             o.NodeID,
+		DataRoot:           o.DataRoot,
+		RenewalRetryPeriod: o.RenewalRetryPeriod,
+	}, cmClient)
+	if err != nil {
+		return err
+	}
+
+	// The socket of a previous instance of the driver is left behind if it
+	// was not shut down gracefully.
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove socket %s: %w", socket, err)
+	}
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", socket, err)
+	}
+	return d.Run(ctx, ln)
+}
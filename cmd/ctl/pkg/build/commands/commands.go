@@ -32,6 +32,7 @@ import (
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/experimental"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/inspect"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/renew"
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/restore"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/status"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/upgrade"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/version"
@@ -52,6 +53,7 @@ func Commands() []RegisterCommandFunc {
 		convert.NewCmdConvert,
 		create.NewCmdCreate,
 		renew.NewCmdRenew,
+		restore.NewCmdRestore,
 		status.NewCmdStatus,
 		inspect.NewCmdInspect,
 		approve.NewCmdApprove,
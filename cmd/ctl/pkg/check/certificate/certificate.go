@@ -0,0 +1,252 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/cli-runtime/pkg/resource"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/build"
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/factory"
+	"github.com/cert-manager/cert-manager/internal/issuercheck"
+	"github.com/cert-manager/cert-manager/internal/vault"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/ctl"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+var (
+	long = templates.LongDesc(i18n.T(`
+Check that a Certificate is valid without creating anything.
+
+The spec of the Certificate is validated as the cert-manager webhook would.
+With --against-issuer, the Certificate is also checked against the constraints
+of the issuer it references: the identifiers an ACME server can validate, the
+role a Vault issuer signs with and the policy of a Venafi zone. The Vault role
+and Venafi zone policy are read using the issuer's credentials, so the
+Secrets referenced by the issuer must be readable.
+
+The command exits with a non-zero status if any check fails.`))
+
+	example = templates.Examples(i18n.T(build.WithTemplate(`
+# Check the Certificate 'my-crt' in namespace 'my-namespace' against its issuer.
+{{.BuildName}} check certificate my-crt --namespace my-namespace --against-issuer
+
+# Check a Certificate manifest against its issuer before applying it.
+{{.BuildName}} check certificate --from-certificate-file my-certificate.yaml --against-issuer
+`)))
+)
+
+var (
+	// Dedicated scheme used by the ctl tool that has the internal cert-manager types,
+	// and their conversion functions registered
+	scheme = ctl.Scheme
+)
+
+// Options is a struct to support check certificate command
+type Options struct {
+	// Path to a file containing the Certificate resource to check, used
+	// instead of a Certificate in the cluster
+	InputFilename string
+	// If true, the Certificate is also checked against the constraints of
+	// the issuer it references
+	AgainstIssuer bool
+	// Namespace in which the credentials of ClusterIssuers are read
+	ClusterResourceNamespace string
+
+	genericclioptions.IOStreams
+	*factory.Factory
+}
+
+// NewOptions returns initialized Options
+func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
+	return &Options{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdCheckCertificate returns a cobra command for check certificate
+func NewCmdCheckCertificate(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:               "certificate",
+		Short:             "Check a cert-manager Certificate resource against the constraints of its issuer",
+		Long:              long,
+		Example:           example,
+		ValidArgsFunction: factory.ValidArgsListCertificates(ctx, &o.Factory),
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Validate(args))
+			cmdutil.CheckErr(o.Run(ctx, args))
+		},
+	}
+	cmd.Flags().StringVar(&o.InputFilename, "from-certificate-file", o.InputFilename,
+		"Path to a file containing the Certificate resource to check instead of a Certificate in the cluster")
+	cmd.Flags().BoolVar(&o.AgainstIssuer, "against-issuer", o.AgainstIssuer,
+		"If set to true, the Certificate is also checked against the constraints of the issuer it references")
+	cmd.Flags().StringVar(&o.ClusterResourceNamespace, "cluster-resource-namespace", "kube-system",
+		"Namespace in which the credentials of ClusterIssuers are stored, as configured on the cert-manager controller")
+
+	o.Factory = factory.New(ctx, cmd)
+
+	return cmd
+}
+
+// Validate validates the provided options
+func (o *Options) Validate(args []string) error {
+	if len(args) > 1 {
+		return errors.New("only one argument can be passed in: the name of the Certificate")
+	}
+	if len(args) == 0 && o.InputFilename == "" {
+		return errors.New("either the name of the Certificate has to be provided as argument or a file has to be specified by using --from-certificate-file flag")
+	}
+	if len(args) == 1 && o.InputFilename != "" {
+		return errors.New("cannot check both a Certificate in the cluster and a Certificate read from --from-certificate-file")
+	}
+	return nil
+}
+
+// Run executes check certificate command
+func (o *Options) Run(ctx context.Context, args []string) error {
+	var crt *cmapi.Certificate
+	var err error
+	if o.InputFilename != "" {
+		crt, err = o.readCertificate()
+	} else {
+		crt, err = o.CMClient.CertmanagerV1().Certificates(o.Namespace).Get(ctx, args[0], metav1.GetOptions{})
+	}
+	if err != nil {
+		return err
+	}
+	if crt.Namespace == "" {
+		crt.Namespace = o.Namespace
+	}
+
+	var errs field.ErrorList
+	if o.AgainstIssuer {
+		errs, err = o.checkAgainstIssuer(ctx, crt)
+	} else {
+		errs, err = issuercheck.ValidateSpec(crt)
+	}
+	if err != nil {
+		return err
+	}
+
+	if len(errs) == 0 {
+		fmt.Fprintf(o.Out, "Certificate %s in namespace %s passed all checks\n", crt.Name, crt.Namespace)
+		return nil
+	}
+	for _, e := range errs {
+		fmt.Fprintf(o.Out, "- %s\n", e.Error())
+	}
+	return fmt.Errorf("Certificate %s in namespace %s failed %d check(s)", crt.Name, crt.Namespace, len(errs))
+}
+
+// checkAgainstIssuer checks the Certificate against the constraints of the
+// issuer it references.
+func (o *Options) checkAgainstIssuer(ctx context.Context, crt *cmapi.Certificate) (field.ErrorList, error) {
+	if crt.Spec.IssuerRef.Group != "" && crt.Spec.IssuerRef.Group != cmapi.SchemeGroupVersion.Group {
+		return nil, fmt.Errorf("the issuer of the Certificate is of the group %s, this command does not support third party issuers", crt.Spec.IssuerRef.Group)
+	}
+
+	var issuerObj cmapi.GenericIssuer
+	var err error
+	kind := crt.Spec.IssuerRef.Kind
+	resourceNamespace := crt.Namespace
+	switch kind {
+	case "", cmapi.IssuerKind:
+		kind = cmapi.IssuerKind
+		issuerObj, err = o.CMClient.CertmanagerV1().Issuers(crt.Namespace).Get(ctx, crt.Spec.IssuerRef.Name, metav1.GetOptions{})
+	case cmapi.ClusterIssuerKind:
+		issuerObj, err = o.CMClient.CertmanagerV1().ClusterIssuers().Get(ctx, crt.Spec.IssuerRef.Name, metav1.GetOptions{})
+		resourceNamespace = o.ClusterResourceNamespace
+	default:
+		return nil, fmt.Errorf("unsupported issuer kind %q", kind)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("error when getting %s %q: %w", kind, crt.Spec.IssuerRef.Name, err)
+	}
+
+	return issuercheck.Check(crt, issuerObj, issuercheck.Options{
+		ResourceNamespace:   resourceNamespace,
+		SecretsLister:       &secretLister{ctx: ctx, client: o.KubeClient},
+		VaultClientBuilder:  vault.New,
+		VenafiClientBuilder: venaficlient.New,
+		Metrics:             metrics.New(logr.Discard(), clock.RealClock{}),
+		Log:                 logr.Discard(),
+	})
+}
+
+// readCertificate reads the Certificate from the file given by
+// --from-certificate-file.
+func (o *Options) readCertificate() (*cmapi.Certificate, error) {
+	builder := new(resource.Builder)
+
+	// Read file as internal API version
+	r := builder.
+		WithScheme(scheme, schema.GroupVersion{Group: cmapi.SchemeGroupVersion.Group, Version: runtime.APIVersionInternal}).
+		LocalParam(true).ContinueOnError().
+		NamespaceParam(o.Namespace).DefaultNamespace().
+		FilenameParam(o.EnforceNamespace, &resource.FilenameOptions{Filenames: []string{o.InputFilename}}).Flatten().Do()
+
+	if err := r.Err(); err != nil {
+		return nil, err
+	}
+
+	singleItemImplied := false
+	infos, err := r.IntoSingleItemImplied(&singleItemImplied).Infos()
+	if err != nil {
+		return nil, err
+	}
+
+	// Ensure only one object per command
+	if len(infos) == 0 {
+		return nil, fmt.Errorf("no objects found in manifest file %q. Expected one Certificate object", o.InputFilename)
+	}
+	if len(infos) > 1 {
+		return nil, fmt.Errorf("multiple objects found in manifest file %q. Expected only one Certificate object", o.InputFilename)
+	}
+	info := infos[0]
+	// Convert to v1 because that version is needed for functions that follow
+	crtObj, err := scheme.ConvertToVersion(info.Object, cmapi.SchemeGroupVersion)
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert object into version v1: %w", err)
+	}
+
+	// Cast Object into Certificate
+	crt, ok := crtObj.(*cmapi.Certificate)
+	if !ok {
+		return nil, errors.New("decoded object is not a v1 Certificate")
+	}
+
+	return crt.DeepCopy(), nil
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"testing"
+)
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		inputFile string
+		inputArgs []string
+
+		expErr    bool
+		expErrMsg string
+	}{
+		"neither a name nor a file throws error": {
+			inputArgs: []string{},
+			expErr:    true,
+			expErrMsg: "either the name of the Certificate has to be provided as argument or a file has to be specified by using --from-certificate-file flag",
+		},
+		"more than one arg throws error": {
+			inputArgs: []string{"hello", "World"},
+			expErr:    true,
+			expErrMsg: "only one argument can be passed in: the name of the Certificate",
+		},
+		"both a name and a file throws error": {
+			inputFile: "example.yaml",
+			inputArgs: []string{"hello"},
+			expErr:    true,
+			expErrMsg: "cannot check both a Certificate in the cluster and a Certificate read from --from-certificate-file",
+		},
+		"a name is valid": {
+			inputArgs: []string{"hello"},
+		},
+		"a file is valid": {
+			inputFile: "example.yaml",
+			inputArgs: []string{},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			opts := &Options{
+				InputFilename: test.inputFile,
+			}
+
+			err := opts.Validate(test.inputArgs)
+			if err != nil {
+				if !test.expErr {
+					t.Fatalf("got unexpected error when validating args and flags: %v", err)
+				}
+				if err.Error() != test.expErrMsg {
+					t.Fatalf("got unexpected error when validating args and flags, expected: %v; actual: %v", test.expErrMsg, err)
+				}
+			} else if test.expErr {
+				t.Errorf("expected but got no error validating args and flags")
+			}
+		})
+	}
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+)
+
+// secretLister implements corelisters.SecretLister by getting Secrets from
+// the API server, as the issuer clients expect a lister but cmctl runs
+// without informers.
+type secretLister struct {
+	ctx    context.Context
+	client kubernetes.Interface
+}
+
+var _ corelisters.SecretLister = &secretLister{}
+
+func (l *secretLister) List(selector labels.Selector) ([]*corev1.Secret, error) {
+	return l.Secrets(metav1.NamespaceAll).List(selector)
+}
+
+func (l *secretLister) Secrets(namespace string) corelisters.SecretNamespaceLister {
+	return &secretNamespaceLister{ctx: l.ctx, client: l.client, namespace: namespace}
+}
+
+type secretNamespaceLister struct {
+	ctx       context.Context
+	client    kubernetes.Interface
+	namespace string
+}
+
+func (l *secretNamespaceLister) List(selector labels.Selector) ([]*corev1.Secret, error) {
+	list, err := l.client.CoreV1().Secrets(l.namespace).List(l.ctx, metav1.ListOptions{LabelSelector: selector.String()})
+	if err != nil {
+		return nil, err
+	}
+	secrets := make([]*corev1.Secret, len(list.Items))
+	for i := range list.Items {
+		secrets[i] = &list.Items[i]
+	}
+	return secrets, nil
+}
+
+func (l *secretNamespaceLister) Get(name string) (*corev1.Secret, error) {
+	return l.client.CoreV1().Secrets(l.namespace).Get(l.ctx, name, metav1.GetOptions{})
+}
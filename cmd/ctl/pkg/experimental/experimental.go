@@ -25,6 +25,7 @@ import (
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/create"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/create/certificatesigningrequest"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/install"
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/reencryptsecrets"
 	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/uninstall"
 )
 
@@ -41,6 +42,7 @@ func NewCmdExperimental(ctx context.Context, ioStreams genericclioptions.IOStrea
 	cmds.AddCommand(create)
 	cmds.AddCommand(install.NewCmdInstall(ctx, ioStreams))
 	cmds.AddCommand(uninstall.NewCmd(ctx, ioStreams))
+	cmds.AddCommand(reencryptsecrets.NewCmdReencryptSecrets(ctx, ioStreams))
 
 	return cmds
 }
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sort"
+	"text/template"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+)
+
+const consumersTemplate = `Used by:
+	Workloads: {{ .Workloads }}
+	Pods: {{ .Pods }}
+	Ingresses: {{ .Ingresses }}`
+
+// consumers holds the resources found to be referencing a Secret.
+type consumers struct {
+	// Workloads are the top level controllers of the Pods that reference
+	// the Secret, in the form Kind/name.
+	Workloads []string
+	// Pods are the names of the Pods that reference the Secret.
+	Pods []string
+	// Ingresses are the names of the Ingresses that reference the Secret in
+	// their TLS configuration.
+	Ingresses []string
+}
+
+// findConsumers lists the Pods and Ingresses in the given namespace that
+// currently reference the named Secret. Pods are resolved to the workload
+// that controls them, following ReplicaSets through to their Deployment.
+func findConsumers(ctx context.Context, cl kubernetes.Interface, namespace, secretName string) (*consumers, error) {
+	result := &consumers{}
+
+	pods, err := cl.CoreV1().Pods(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Pods: %w", err)
+	}
+	workloads := map[string]struct{}{}
+	for _, pod := range pods.Items {
+		if !podReferencesSecret(&pod.Spec, secretName) {
+			continue
+		}
+		result.Pods = append(result.Pods, pod.Name)
+
+		workload, err := workloadForPod(ctx, cl, &pod)
+		if err != nil {
+			return nil, err
+		}
+		if workload != "" {
+			workloads[workload] = struct{}{}
+		}
+	}
+	for workload := range workloads {
+		result.Workloads = append(result.Workloads, workload)
+	}
+
+	ingresses, err := cl.NetworkingV1().Ingresses(namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("error listing Ingresses: %w", err)
+	}
+	for _, ing := range ingresses.Items {
+		for _, tls := range ing.Spec.TLS {
+			if tls.SecretName == secretName {
+				result.Ingresses = append(result.Ingresses, ing.Name)
+				break
+			}
+		}
+	}
+
+	sort.Strings(result.Workloads)
+	sort.Strings(result.Pods)
+	sort.Strings(result.Ingresses)
+
+	return result, nil
+}
+
+// podReferencesSecret returns true if the Pod mounts the Secret as a volume,
+// either directly or through a projected volume, or reads it into the
+// environment of any of its containers.
+func podReferencesSecret(spec *corev1.PodSpec, secretName string) bool {
+	for _, vol := range spec.Volumes {
+		if vol.Secret != nil && vol.Secret.SecretName == secretName {
+			return true
+		}
+		if vol.Projected != nil {
+			for _, src := range vol.Projected.Sources {
+				if src.Secret != nil && src.Secret.Name == secretName {
+					return true
+				}
+			}
+		}
+	}
+
+	containers := append(append([]corev1.Container{}, spec.InitContainers...), spec.Containers...)
+	for _, c := range containers {
+		for _, envFrom := range c.EnvFrom {
+			if envFrom.SecretRef != nil && envFrom.SecretRef.Name == secretName {
+				return true
+			}
+		}
+		for _, env := range c.Env {
+			if env.ValueFrom != nil && env.ValueFrom.SecretKeyRef != nil && env.ValueFrom.SecretKeyRef.Name == secretName {
+				return true
+			}
+		}
+	}
+
+	return false
+}
+
+// workloadForPod returns the workload controlling the Pod in the form
+// Kind/name, or an empty string if the Pod is not controlled by anything.
+func workloadForPod(ctx context.Context, cl kubernetes.Interface, pod *corev1.Pod) (string, error) {
+	ref := metav1.GetControllerOf(pod)
+	if ref == nil {
+		return "", nil
+	}
+	if ref.Kind != "ReplicaSet" {
+		return ref.Kind + "/" + ref.Name, nil
+	}
+
+	rs, err := cl.AppsV1().ReplicaSets(pod.Namespace).Get(ctx, ref.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return ref.Kind + "/" + ref.Name, nil
+	}
+	if err != nil {
+		return "", fmt.Errorf("error getting ReplicaSet %q: %w", ref.Name, err)
+	}
+	if rsRef := metav1.GetControllerOf(rs); rsRef != nil {
+		return rsRef.Kind + "/" + rsRef.Name, nil
+	}
+	return ref.Kind + "/" + ref.Name, nil
+}
+
+func describeConsumers(c *consumers) string {
+	var b bytes.Buffer
+	template.Must(template.New("consumersTemplate").Parse(consumersTemplate)).Execute(&b, struct {
+		Workloads string
+		Pods      string
+		Ingresses string
+	}{
+		Workloads: printSlice(c.Workloads),
+		Pods:      printSlice(c.Pods),
+		Ingresses: printSlice(c.Ingresses),
+	})
+
+	return b.String()
+}
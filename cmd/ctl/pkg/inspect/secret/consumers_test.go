@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secret
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/utils/pointer"
+)
+
+func Test_findConsumers(t *testing.T) {
+	controlledBy := func(kind, name string) []metav1.OwnerReference {
+		return []metav1.OwnerReference{{Kind: kind, Name: name, Controller: pointer.Bool(true)}}
+	}
+
+	objects := []runtime.Object{
+		&appsv1.ReplicaSet{ObjectMeta: metav1.ObjectMeta{
+			Name: "web-5d8f", Namespace: "ns", OwnerReferences: controlledBy("Deployment", "web"),
+		}},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "web-5d8f-abcde", Namespace: "ns", OwnerReferences: controlledBy("ReplicaSet", "web-5d8f")},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name:         "tls",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "my-crt"}},
+			}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "db-0", Namespace: "ns", OwnerReferences: controlledBy("StatefulSet", "db")},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name: "tls",
+				VolumeSource: corev1.VolumeSource{Projected: &corev1.ProjectedVolumeSource{Sources: []corev1.VolumeProjection{{
+					Secret: &corev1.SecretProjection{LocalObjectReference: corev1.LocalObjectReference{Name: "my-crt"}},
+				}}}},
+			}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "standalone", Namespace: "ns"},
+			Spec: corev1.PodSpec{InitContainers: []corev1.Container{{
+				Name: "init",
+				Env: []corev1.EnvVar{{Name: "KEY", ValueFrom: &corev1.EnvVarSource{SecretKeyRef: &corev1.SecretKeySelector{
+					LocalObjectReference: corev1.LocalObjectReference{Name: "my-crt"}, Key: "tls.key",
+				}}}},
+			}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "ns"},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{
+				Name:    "app",
+				EnvFrom: []corev1.EnvFromSource{{SecretRef: &corev1.SecretEnvSource{LocalObjectReference: corev1.LocalObjectReference{Name: "other"}}}},
+			}}},
+		},
+		&corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-namespace", Namespace: "other"},
+			Spec: corev1.PodSpec{Volumes: []corev1.Volume{{
+				Name:         "tls",
+				VolumeSource: corev1.VolumeSource{Secret: &corev1.SecretVolumeSource{SecretName: "my-crt"}},
+			}}},
+		},
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "ns"},
+			Spec:       networkingv1.IngressSpec{TLS: []networkingv1.IngressTLS{{SecretName: "other"}, {SecretName: "my-crt"}}},
+		},
+		&networkingv1.Ingress{
+			ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "ns"},
+			Spec:       networkingv1.IngressSpec{TLS: []networkingv1.IngressTLS{{SecretName: "other"}}},
+		},
+	}
+
+	got, err := findConsumers(context.Background(), fake.NewSimpleClientset(objects...), "ns", "my-crt")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := &consumers{
+		Workloads: []string{"Deployment/web", "StatefulSet/db"},
+		Pods:      []string{"db-0", "standalone", "web-5d8f-abcde"},
+		Ingresses: []string{"web"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("findConsumers() = %+v, want %+v", got, want)
+	}
+}
+
+func Test_describeConsumers(t *testing.T) {
+	tests := []struct {
+		name      string
+		consumers *consumers
+		want      string
+	}{
+		{
+			name:      "Describe no consumers",
+			consumers: &consumers{},
+			want: `Used by:
+	Workloads: <none>
+	Pods: <none>
+	Ingresses: <none>`,
+		},
+		{
+			name: "Describe consumers",
+			consumers: &consumers{
+				Workloads: []string{"Deployment/web"},
+				Pods:      []string{"web-5d8f-abcde"},
+				Ingresses: []string{"web"},
+			},
+			want: `Used by:
+	Workloads: 
+		- Deployment/web
+	Pods: 
+		- web-5d8f-abcde
+	Ingresses: 
+		- web`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := describeConsumers(tt.consumers); got != tt.want {
+				t.Errorf("describeConsumers() = %v, want %v", makeInvisibleVisible(got), makeInvisibleVisible(tt.want))
+			}
+		})
+	}
+}
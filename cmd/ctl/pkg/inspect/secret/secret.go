@@ -88,6 +88,9 @@ Get details about a kubernetes.io/tls typed secret`))
 	example = templates.Examples(i18n.T(build.WithTemplate(`
 # Query information about a secret with name 'my-crt' in namespace 'my-namespace'
 {{.BuildName}} inspect secret my-crt --namespace my-namespace
+
+# Also list the workloads, Pods and Ingresses currently using the secret
+{{.BuildName}} inspect secret my-crt --namespace my-namespace --show-consumers
 `)))
 )
 
@@ -95,6 +98,10 @@ Get details about a kubernetes.io/tls typed secret`))
 type Options struct {
 	genericclioptions.IOStreams
 	*factory.Factory
+
+	// ShowConsumers lists the resources referencing the Secret, so the impact
+	// of rotating or deleting it is known up front.
+	ShowConsumers bool
 }
 
 // NewOptions returns initialized Options
@@ -120,6 +127,9 @@ func NewCmdInspectSecret(ctx context.Context, ioStreams genericclioptions.IOStre
 		},
 	}
 
+	cmd.Flags().BoolVar(&o.ShowConsumers, "show-consumers", o.ShowConsumers,
+		"List the workloads, Pods and Ingresses in the namespace that currently reference the Secret")
+
 	o.Factory = factory.New(ctx, cmd)
 
 	return cmd
@@ -172,6 +182,14 @@ func (o *Options) Run(ctx context.Context, args []string) error {
 		describeDebugging(x509Cert, intermediates, secret.Data[cmmeta.TLSCAKey]),
 	}
 
+	if o.ShowConsumers {
+		c, err := findConsumers(ctx, o.KubeClient, o.Namespace, secret.Name)
+		if err != nil {
+			return err
+		}
+		out = append(out, describeConsumers(c))
+	}
+
 	fmt.Println(strings.Join(out, "\n\n"))
 
 	return nil
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryptsecrets
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/client-go/kubernetes"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/build"
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/factory"
+)
+
+var (
+	long = templates.LongDesc(i18n.T(`
+Rewrite all Secrets managed by cert-manager so that they are re-encrypted by the kube-apiserver.
+
+When encryption at rest is enabled and the encryption key (or KMS key) is rotated, existing
+Secrets remain encrypted with the old key until they are next written. This command reads and
+writes back every Secret containing a certificate issued by cert-manager, without changing its
+contents, so that the kube-apiserver stores it encrypted with the current primary key.
+
+Only Secrets carrying the 'cert-manager.io/certificate-name' annotation are rewritten.`))
+
+	example = templates.Examples(i18n.T(build.WithTemplate(`
+# Re-encrypt all cert-manager Secrets in all namespaces after rotating the encryption key.
+{{.BuildName}} x reencrypt-secrets --all-namespaces
+
+# Re-encrypt cert-manager Secrets in the 'my-app' namespace only.
+{{.BuildName}} x reencrypt-secrets --namespace my-app
+
+# List the Secrets that would be rewritten, without writing them.
+{{.BuildName}} x reencrypt-secrets --all-namespaces --dry-run
+`)))
+)
+
+// Options is a struct to support the reencrypt-secrets command
+type Options struct {
+	AllNamespaces bool
+	DryRun        bool
+	LabelSelector string
+
+	qps      float32
+	burst    int
+	pageSize int64
+
+	client kubernetes.Interface
+
+	genericclioptions.IOStreams
+	*factory.Factory
+}
+
+// NewOptions returns initialized Options
+func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
+	return &Options{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdReencryptSecrets returns a cobra command for rewriting all
+// cert-manager managed Secrets in order to force them to be re-encrypted.
+func NewCmdReencryptSecrets(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewOptions(ioStreams)
+	cmd := &cobra.Command{
+		Use:     "reencrypt-secrets",
+		Short:   "Rewrite all cert-manager Secrets to re-encrypt them after an encryption key rotation",
+		Long:    long,
+		Example: example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Validate(args))
+			cmdutil.CheckErr(o.Complete())
+			cmdutil.CheckErr(o.Run(ctx))
+		},
+	}
+
+	cmd.Flags().BoolVarP(&o.AllNamespaces, "all-namespaces", "A", o.AllNamespaces, "If present, rewrite Secrets across all namespaces. Namespace in current context is ignored even if specified with --namespace.")
+	cmd.Flags().BoolVar(&o.DryRun, "dry-run", o.DryRun, "If true, only print the Secrets that would be rewritten.")
+	cmd.Flags().StringVarP(&o.LabelSelector, "selector", "l", o.LabelSelector, "Selector (label query) to further filter the Secrets to rewrite, supports '=', '==', and '!='.(e.g. -l key1=value1,key2=value2)")
+	cmd.Flags().Float32Var(&o.qps, "qps", 5, "Indicates the maximum QPS to the apiserver from the client.")
+	cmd.Flags().IntVar(&o.burst, "burst", 10, "Maximum burst value for queries set to the apiserver from the client.")
+	cmd.Flags().Int64Var(&o.pageSize, "page-size", 500, "The number of Secrets to fetch from the apiserver in each list request.")
+	o.Factory = factory.New(ctx, cmd)
+
+	return cmd
+}
+
+// Validate validates the provided options
+func (o *Options) Validate(args []string) error {
+	if len(args) > 0 {
+		return errors.New("reencrypt-secrets does not accept arguments")
+	}
+	if o.pageSize <= 0 {
+		return errors.New("--page-size must be greater than 0")
+	}
+	return nil
+}
+
+// Complete takes the command arguments and factory and infers any remaining options.
+func (o *Options) Complete() error {
+	if o.qps != 0 {
+		o.RESTConfig.QPS = o.qps
+	}
+	if o.burst != 0 {
+		o.RESTConfig.Burst = o.burst
+	}
+
+	var err error
+	o.client, err = kubernetes.NewForConfig(o.RESTConfig)
+	if err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// Run executes the reencrypt-secrets command
+func (o *Options) Run(ctx context.Context) error {
+	namespace := o.Namespace
+	if o.AllNamespaces {
+		namespace = metav1.NamespaceAll
+	}
+
+	r := NewReencryptor(o.client, o.pageSize, o.DryRun, o.Out, o.ErrOut)
+	_, err := r.Run(ctx, namespace, o.LabelSelector)
+	return err
+}
@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryptsecrets
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/util/retry"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Reencryptor rewrites cert-manager managed Secrets without modifying them.
+// The kube-apiserver always persists an update to a Secret whose stored data
+// was encrypted with a key other than the current primary key, even when the
+// contents are unchanged, so writing a Secret back forces it to be
+// re-encrypted.
+type Reencryptor struct {
+	// Client used for API interactions
+	Client kubernetes.Interface
+
+	// PageSize is the number of Secrets to request in each list call.
+	PageSize int64
+
+	// If true, Secrets are only printed and not written.
+	DryRun bool
+
+	// Writers to write informational & error messages to
+	Out, ErrOut io.Writer
+}
+
+// NewReencryptor creates a new Reencryptor with the given API client.
+// If either of out or errOut are nil, log messages will be discarded.
+func NewReencryptor(client kubernetes.Interface, pageSize int64, dryRun bool, out, errOut io.Writer) *Reencryptor {
+	if out == nil {
+		out = io.Discard
+	}
+	if errOut == nil {
+		errOut = io.Discard
+	}
+
+	return &Reencryptor{
+		Client:   client,
+		PageSize: pageSize,
+		DryRun:   dryRun,
+		Out:      out,
+		ErrOut:   errOut,
+	}
+}
+
+// Run rewrites all cert-manager managed Secrets in the given namespace that
+// match the label selector. An empty namespace means all namespaces.
+// Secrets are listed in pages so that clusters with many thousands of
+// Secrets can be processed without loading them all into memory at once.
+// Returns the number of Secrets that were rewritten.
+func (r *Reencryptor) Run(ctx context.Context, namespace, labelSelector string) (int, error) {
+	startTime := time.Now()
+	timeFormat := "15:04:05"
+	fmt.Fprintf(r.Out, "Rewriting cert-manager Secrets - this may take a while (started at %s)...\n", startTime.Format(timeFormat))
+
+	var rewritten, skipped int
+	opts := metav1.ListOptions{
+		LabelSelector: labelSelector,
+		Limit:         r.PageSize,
+	}
+	for {
+		list, err := r.Client.CoreV1().Secrets(namespace).List(ctx, opts)
+		if err != nil {
+			fmt.Fprintf(r.ErrOut, "Failed to list Secrets: %v\n", err)
+			return rewritten, err
+		}
+
+		for i := range list.Items {
+			secret := &list.Items[i]
+			if !isManagedByCertManager(secret) {
+				skipped++
+				continue
+			}
+
+			if r.DryRun {
+				fmt.Fprintf(r.Out, " Would rewrite Secret %s/%s\n", secret.Namespace, secret.Name)
+				rewritten++
+				continue
+			}
+
+			if err := r.rewriteSecret(ctx, secret); err != nil {
+				fmt.Fprintf(r.ErrOut, "Failed to rewrite Secret %s/%s: %v\n", secret.Namespace, secret.Name, err)
+				return rewritten, err
+			}
+			rewritten++
+		}
+
+		if list.Continue == "" {
+			break
+		}
+		opts.Continue = list.Continue
+	}
+
+	// add 500ms to the duration to ensure we always round up
+	duration := time.Since(startTime) + (time.Millisecond * 500)
+	if r.DryRun {
+		fmt.Fprintf(r.Out, "Found %d cert-manager Secrets that would be rewritten (%d other Secrets skipped)\n", rewritten, skipped)
+	} else {
+		fmt.Fprintf(r.Out, "Successfully rewrote %d cert-manager Secrets in %s (%d other Secrets skipped)\n", rewritten, duration.Round(time.Second), skipped)
+	}
+	return rewritten, nil
+}
+
+func (r *Reencryptor) rewriteSecret(ctx context.Context, secret *corev1.Secret) error {
+	// retry on any kind of error to handle cases where e.g. the network connection to the apiserver fails
+	err := retry.OnError(wait.Backoff{
+		Duration: time.Second, // wait 1s between attempts
+		Steps:    3,           // allow up to 3 attempts per object
+	}, func(err error) bool {
+		// Retry on any errors that are not otherwise skipped/ignored
+		return handleUpdateErr(err) != nil
+	}, func() error {
+		_, err := r.Client.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		return err
+	})
+	return handleUpdateErr(err)
+}
+
+// isManagedByCertManager returns true if the Secret contains a certificate
+// issued by cert-manager.
+func isManagedByCertManager(secret *corev1.Secret) bool {
+	_, ok := secret.Annotations[cmapi.CertificateNameKey]
+	return ok
+}
+
+// handleUpdateErr will absorb certain types of errors that we know can be
+// skipped when rewriting a Secret.
+func handleUpdateErr(err error) error {
+	if err == nil {
+		return nil
+	}
+	// If the Secret no longer exists, there is nothing left to re-encrypt.
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	// If there was a conflict, another client must have written the Secret
+	// already which means it has been re-encrypted with the current key.
+	if apierrors.IsConflict(err) {
+		return nil
+	}
+	return err
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reencryptsecrets
+
+import (
+	"context"
+	"reflect"
+	"sort"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func secret(namespace, name string, annotations, labels map[string]string) *corev1.Secret {
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Annotations: annotations,
+			Labels:      labels,
+		},
+		Data: map[string][]byte{"tls.crt": []byte("cert")},
+	}
+}
+
+var certAnnotations = map[string]string{cmapi.CertificateNameKey: "crt"}
+
+func TestReencryptorRun(t *testing.T) {
+	tests := map[string]struct {
+		objects       []runtime.Object
+		namespace     string
+		labelSelector string
+		dryRun        bool
+		updateErr     error
+
+		expRewritten int
+		expUpdated   []string
+		expErr       bool
+	}{
+		"should only rewrite Secrets managed by cert-manager": {
+			objects: []runtime.Object{
+				secret("ns1", "managed", certAnnotations, nil),
+				secret("ns1", "unmanaged", nil, nil),
+				secret("ns2", "managed", certAnnotations, nil),
+			},
+			expRewritten: 2,
+			expUpdated:   []string{"ns1/managed", "ns2/managed"},
+		},
+		"should only rewrite Secrets in the given namespace": {
+			objects: []runtime.Object{
+				secret("ns1", "managed", certAnnotations, nil),
+				secret("ns2", "managed", certAnnotations, nil),
+			},
+			namespace:    "ns2",
+			expRewritten: 1,
+			expUpdated:   []string{"ns2/managed"},
+		},
+		"should only rewrite Secrets matching the label selector": {
+			objects: []runtime.Object{
+				secret("ns1", "a", certAnnotations, map[string]string{"app": "a"}),
+				secret("ns1", "b", certAnnotations, map[string]string{"app": "b"}),
+			},
+			labelSelector: "app=b",
+			expRewritten:  1,
+			expUpdated:    []string{"ns1/b"},
+		},
+		"should not write Secrets in dry-run mode": {
+			objects: []runtime.Object{
+				secret("ns1", "managed", certAnnotations, nil),
+			},
+			dryRun:       true,
+			expRewritten: 1,
+		},
+		"should ignore conflicts as the Secret has already been rewritten": {
+			objects: []runtime.Object{
+				secret("ns1", "managed", certAnnotations, nil),
+			},
+			updateErr:    apierrors.NewConflict(schema.GroupResource{Resource: "secrets"}, "managed", nil),
+			expRewritten: 1,
+			expUpdated:   []string{"ns1/managed"},
+		},
+		"should return an error if a Secret cannot be written": {
+			objects: []runtime.Object{
+				secret("ns1", "managed", certAnnotations, nil),
+			},
+			updateErr:  apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "managed", nil),
+			expUpdated: []string{"ns1/managed", "ns1/managed", "ns1/managed"},
+			expErr:     true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewSimpleClientset(test.objects...)
+			var updated []string
+			cl.PrependReactor("update", "secrets", func(action coretesting.Action) (bool, runtime.Object, error) {
+				obj := action.(coretesting.UpdateAction).GetObject().(*corev1.Secret)
+				updated = append(updated, obj.Namespace+"/"+obj.Name)
+				if test.updateErr != nil {
+					return true, nil, test.updateErr
+				}
+				return false, nil, nil
+			})
+
+			r := NewReencryptor(cl, 500, test.dryRun, nil, nil)
+			rewritten, err := r.Run(context.Background(), test.namespace, test.labelSelector)
+			if (err != nil) != test.expErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if rewritten != test.expRewritten {
+				t.Errorf("unexpected number of rewritten Secrets, exp=%d got=%d", test.expRewritten, rewritten)
+			}
+			sort.Strings(updated)
+			if !reflect.DeepEqual(updated, test.expUpdated) {
+				t.Errorf("unexpected updates, exp=%v got=%v", test.expUpdated, updated)
+			}
+		})
+	}
+}
@@ -0,0 +1,155 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	cmdutil "k8s.io/kubectl/pkg/cmd/util"
+	"k8s.io/kubectl/pkg/util/i18n"
+	"k8s.io/kubectl/pkg/util/templates"
+
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/build"
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/factory"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var (
+	long = templates.LongDesc(i18n.T(`
+Restore a cert-manager Certificate that was deleted whilst the CertificateSoftDelete feature was enabled.
+
+The Certificate is re-created from the record kept on its Secret, which is retained for the
+controller's --certificate-soft-delete-retention period after the Certificate was deleted.`))
+
+	example = templates.Examples(i18n.T(build.WithTemplate(`
+# Restore the deleted Certificate with name 'my-crt' in namespace 'my-namespace'
+{{.BuildName}} restore certificate my-crt --namespace my-namespace
+`)))
+)
+
+// Options is a struct to support restore certificate command
+type Options struct {
+	genericclioptions.IOStreams
+	*factory.Factory
+}
+
+// NewOptions returns initialized Options
+func NewOptions(ioStreams genericclioptions.IOStreams) *Options {
+	return &Options{
+		IOStreams: ioStreams,
+	}
+}
+
+// NewCmdRestoreCert returns a cobra command for restore certificate
+func NewCmdRestoreCert(ctx context.Context, ioStreams genericclioptions.IOStreams) *cobra.Command {
+	o := NewOptions(ioStreams)
+
+	cmd := &cobra.Command{
+		Use:     "certificate",
+		Short:   "Restore a deleted cert-manager Certificate from its retained Secret",
+		Long:    long,
+		Example: example,
+		Run: func(cmd *cobra.Command, args []string) {
+			cmdutil.CheckErr(o.Validate(args))
+			cmdutil.CheckErr(o.Run(ctx, args))
+		},
+	}
+
+	o.Factory = factory.New(ctx, cmd)
+
+	return cmd
+}
+
+// Validate validates the provided options
+func (o *Options) Validate(args []string) error {
+	if len(args) < 1 {
+		return errors.New("the name of the Certificate has to be provided as argument")
+	}
+	if len(args) > 1 {
+		return errors.New("only one argument can be passed in: the name of the Certificate")
+	}
+	return nil
+}
+
+// Run executes restore certificate command
+func (o *Options) Run(ctx context.Context, args []string) error {
+	crtName := args[0]
+
+	secret, err := o.findRetiredSecret(ctx, crtName)
+	if err != nil {
+		return err
+	}
+
+	crt := new(cmapi.Certificate)
+	if err := json.Unmarshal([]byte(secret.Annotations[cmapi.RetiredCertificateAnnotationKey]), crt); err != nil {
+		return fmt.Errorf("failed to decode the deleted Certificate recorded on Secret %s/%s: %v", secret.Namespace, secret.Name, err)
+	}
+	crt.Namespace = o.Namespace
+
+	if _, err := o.CMClient.CertmanagerV1().Certificates(o.Namespace).Create(ctx, crt, metav1.CreateOptions{}); err != nil {
+		return fmt.Errorf("failed to restore Certificate %s/%s: %w", o.Namespace, crtName, err)
+	}
+
+	// The Secret belongs to the restored Certificate again, so must no longer
+	// be deleted once the retention period has passed.
+	secret = secret.DeepCopy()
+	delete(secret.Annotations, cmapi.RetiredCertificateAnnotationKey)
+	delete(secret.Annotations, cmapi.RetiredAtAnnotationKey)
+	if _, err := o.KubeClient.CoreV1().Secrets(o.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to remove the record of the deleted Certificate from Secret %s/%s: %w", secret.Namespace, secret.Name, err)
+	}
+
+	fmt.Fprintf(o.Out, "Restored Certificate %s/%s from Secret %s\n", o.Namespace, crtName, secret.Name)
+	return nil
+}
+
+// findRetiredSecret returns the Secret retained for the named deleted
+// Certificate. If the Certificate was deleted more than once, the Secret
+// which was retired most recently is returned.
+func (o *Options) findRetiredSecret(ctx context.Context, crtName string) (*corev1.Secret, error) {
+	secrets, err := o.KubeClient.CoreV1().Secrets(o.Namespace).List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var found *corev1.Secret
+	for i, secret := range secrets.Items {
+		if secret.Annotations[cmapi.CertificateNameKey] != crtName {
+			continue
+		}
+		if _, ok := secret.Annotations[cmapi.RetiredCertificateAnnotationKey]; !ok {
+			continue
+		}
+		// Times are recorded in UTC using RFC3339, so can be compared as
+		// strings.
+		if found == nil || secret.Annotations[cmapi.RetiredAtAnnotationKey] > found.Annotations[cmapi.RetiredAtAnnotationKey] {
+			found = &secrets.Items[i]
+		}
+	}
+
+	if found == nil {
+		return nil, fmt.Errorf("no Secret retained for a deleted Certificate %s/%s was found, it may not have been deleted whilst the CertificateSoftDelete feature was enabled or its retention period may have passed", o.Namespace, crtName)
+	}
+	return found, nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificate
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/cli-runtime/pkg/genericclioptions"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/cert-manager/cert-manager/cmd/ctl/pkg/factory"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+)
+
+func TestRun(t *testing.T) {
+	retiredSecret := func(name, dnsName, retiredAt string) *corev1.Secret {
+		tombstone, err := json.Marshal(&cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Name: "my-crt", Labels: map[string]string{"app": "test"}},
+			Spec:       cmapi.CertificateSpec{SecretName: name, DNSNames: []string{dnsName}},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: "my-namespace",
+			Annotations: map[string]string{
+				cmapi.CertificateNameKey:              "my-crt",
+				cmapi.RetiredCertificateAnnotationKey: string(tombstone),
+				cmapi.RetiredAtAnnotationKey:          retiredAt,
+			},
+		}}
+	}
+
+	tests := map[string]struct {
+		secrets       []*corev1.Secret
+		expDNSName    string
+		expSecretName string
+		expErr        string
+	}{
+		"restore the certificate from its retired secret": {
+			secrets: []*corev1.Secret{
+				retiredSecret("my-crt-tls", "example.com", "2022-06-01T12:00:00Z"),
+				{ObjectMeta: metav1.ObjectMeta{Name: "other-tls", Namespace: "my-namespace", Annotations: map[string]string{cmapi.CertificateNameKey: "my-crt"}}},
+			},
+			expDNSName:    "example.com",
+			expSecretName: "my-crt-tls",
+		},
+		"restore the most recently deleted certificate": {
+			secrets: []*corev1.Secret{
+				retiredSecret("old-tls", "old.example.com", "2022-06-01T12:00:00Z"),
+				retiredSecret("new-tls", "new.example.com", "2022-06-02T12:00:00Z"),
+			},
+			expDNSName:    "new.example.com",
+			expSecretName: "new-tls",
+		},
+		"error if no secret has been retired for the certificate": {
+			secrets: []*corev1.Secret{
+				{ObjectMeta: metav1.ObjectMeta{Name: "my-crt-tls", Namespace: "my-namespace", Annotations: map[string]string{cmapi.CertificateNameKey: "my-crt"}}},
+			},
+			expErr: "no Secret retained for a deleted Certificate my-namespace/my-crt was found, it may not have been deleted whilst the CertificateSoftDelete feature was enabled or its retention period may have passed",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset()
+			for _, s := range test.secrets {
+				_, err := kubeClient.CoreV1().Secrets(s.Namespace).Create(context.TODO(), s, metav1.CreateOptions{})
+				assert.NoError(t, err)
+			}
+			cmClient := cmfake.NewSimpleClientset()
+
+			out := new(bytes.Buffer)
+			o := &Options{
+				IOStreams: genericclioptions.IOStreams{Out: out},
+				Factory: &factory.Factory{
+					Namespace:  "my-namespace",
+					KubeClient: kubeClient,
+					CMClient:   cmClient,
+				},
+			}
+
+			err := o.Run(context.TODO(), []string{"my-crt"})
+			if test.expErr != "" {
+				assert.EqualError(t, err, test.expErr)
+				return
+			}
+			assert.NoError(t, err)
+
+			crt, err := cmClient.CertmanagerV1().Certificates("my-namespace").Get(context.TODO(), "my-crt", metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, []string{test.expDNSName}, crt.Spec.DNSNames)
+			assert.Equal(t, map[string]string{"app": "test"}, crt.Labels)
+
+			secret, err := kubeClient.CoreV1().Secrets("my-namespace").Get(context.TODO(), test.expSecretName, metav1.GetOptions{})
+			assert.NoError(t, err)
+			assert.Equal(t, map[string]string{cmapi.CertificateNameKey: "my-crt"}, secret.Annotations)
+
+			assert.Equal(t, "Restored Certificate my-namespace/my-crt from Secret "+test.expSecretName+"\n", out.String())
+		})
+	}
+}
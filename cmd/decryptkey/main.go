@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+	"github.com/cert-manager/cert-manager/pkg/util"
+)
+
+// decryptkey copies the files of a mounted Certificate Secret into another
+// directory, decrypting the private key if it was encrypted using
+// spec.privateKeyEncryption. This is intended to run as an init container
+// which writes to an in-memory emptyDir volume shared with the workload.
+// The key management service is accessed using the ambient credentials of
+// the pod, or for Vault using the standard VAULT_* environment variables.
+
+func main() {
+	sourceDir := flag.String("source-dir", "", "Directory that the Certificate's Secret is mounted at.")
+	targetDir := flag.String("target-dir", "", "Directory to write the Secret's files to, with the private key decrypted.")
+	timeout := flag.Duration("timeout", time.Minute, "Maximum time to spend decrypting the private key.")
+	flag.Parse()
+
+	if *sourceDir == "" || *targetDir == "" {
+		fmt.Fprintln(os.Stderr, "--source-dir and --target-dir must be specified")
+		os.Exit(2)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), *timeout)
+	defer cancel()
+
+	if err := run(ctx, *sourceDir, *targetDir); err != nil {
+		fmt.Fprintf(os.Stderr, "%s\n", err)
+		os.Exit(1)
+	}
+}
+
+func run(ctx context.Context, sourceDir, targetDir string) error {
+	userAgent := util.RestConfigWithUserAgent(&rest.Config{}, "decrypt-key").UserAgent
+
+	entries, err := os.ReadDir(sourceDir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		// Secret volumes hold their data in hidden directories, with each
+		// key being a symlink into them.
+		if strings.HasPrefix(entry.Name(), "..") || entry.IsDir() {
+			continue
+		}
+
+		data, err := os.ReadFile(filepath.Join(sourceDir, entry.Name()))
+		if err != nil {
+			return err
+		}
+		if entry.Name() == corev1.TLSPrivateKeyKey && keyencryption.IsEncrypted(data) {
+			data, err = keyencryption.Decrypt(ctx, data, userAgent)
+			if err != nil {
+				return fmt.Errorf("failed to decrypt %s: %w", entry.Name(), err)
+			}
+		}
+
+		if err := os.WriteFile(filepath.Join(targetDir, entry.Name()), data, 0600); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"time"
+
+	"github.com/spf13/cobra"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/clientcmd"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/cmd/util"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/ocspresponder"
+)
+
+type ocspResponderOptions struct {
+	APIServerHost            string
+	Kubeconfig               string
+	Namespace                string
+	ClusterResourceNamespace string
+	ListenAddress            string
+}
+
+func NewOCSPResponderCommand(stopCh <-chan struct{}) *cobra.Command {
+	o := new(ocspResponderOptions)
+
+	cmd := &cobra.Command{
+		Use:   "ocspresponder",
+		Short: "OCSP responder for cert-manager CA issuers.",
+		Long: `OCSP responder for cert-manager CA issuers.
+
+Answers OCSP requests for the certificates issued by CA Issuers and
+ClusterIssuers with OCSP configured, using the responder certificate that
+cert-manager issues for them. Requests for an Issuer are served at
+/issuers/<namespace>/<name>, and for a ClusterIssuer at /clusterissuers/<name>.
+Certificates are reported as revoked once their CertificateRequest has the
+cert-manager.io/revoke annotation.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCtx := util.ContextWithStopCh(context.Background(), stopCh)
+			rootCtx = logf.NewContext(rootCtx, logf.Log, "ocspresponder")
+			return o.Run(rootCtx)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.APIServerHost, "master", "", ""+
+		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
+		"will be attempted.")
+	cmd.Flags().StringVar(&o.Kubeconfig, "kubeconfig", "", ""+
+		"Paths to a kubeconfig. Only required if out-of-cluster.")
+	cmd.Flags().StringVar(&o.Namespace, "namespace", "", ""+
+		"If set, only Issuers in this namespace are served and ClusterIssuers are disabled. "+
+		"If not specified, all namespaces will be watched")
+	cmd.Flags().StringVar(&o.ClusterResourceNamespace, "cluster-resource-namespace", "kube-system", ""+
+		"Namespace the Secrets of ClusterIssuers are stored in. This must match the cert-manager controller's flag.")
+	cmd.Flags().StringVar(&o.ListenAddress, "listen-address", ":8080", ""+
+		"The host and port that OCSP requests are served on.")
+
+	return cmd
+}
+
+func (o *ocspResponderOptions) Run(ctx context.Context) error {
+	log := logf.FromContext(ctx)
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.APIServerHost, o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error creating rest config: %w", err)
+	}
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+	cmClient, err := cmclient.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating cert-manager client: %w", err)
+	}
+
+	cmFactory := cminformers.NewSharedInformerFactoryWithOptions(cmClient, 10*time.Hour, cminformers.WithNamespace(o.Namespace))
+	kubeFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, 10*time.Hour, kubeinformers.WithNamespace(o.Namespace))
+
+	crInformer := cmFactory.Certmanager().V1().CertificateRequests().Informer()
+	if err := crInformer.AddIndexers(cache.Indexers{ocspresponder.SerialNumberIndex: ocspresponder.IndexBySerialNumber}); err != nil {
+		return err
+	}
+	responder := &ocspresponder.Responder{
+		IssuerLister:        cmFactory.Certmanager().V1().Issuers().Lister(),
+		CertificateRequests: crInformer.GetIndexer(),
+		SecretLister:        kubeFactory.Core().V1().Secrets().Lister(),
+		IssuerOptions: controllerpkg.IssuerOptions{
+			ClusterResourceNamespace: o.ClusterResourceNamespace,
+		},
+		Clock: clock.RealClock{},
+	}
+	if o.Namespace == "" {
+		responder.ClusterIssuerLister = cmFactory.Certmanager().V1().ClusterIssuers().Lister()
+		responder.IssuerOptions.CredentialGrantLister = cmFactory.Certmanager().V1().CredentialGrants().Lister()
+	}
+
+	cmFactory.Start(ctx.Done())
+	kubeFactory.Start(ctx.Done())
+	for informer, synced := range cmFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync %v informer", informer)
+		}
+	}
+	for informer, synced := range kubeFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync %v informer", informer)
+		}
+	}
+
+	ln, err := net.Listen("tcp", o.ListenAddress)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", o.ListenAddress, err)
+	}
+	server := &http.Server{
+		Handler:           responder,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	go func() {
+		<-ctx.Done()
+		// allow a timeout for graceful shutdown
+		shutdownCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		if err := server.Shutdown(shutdownCtx); err != nil {
+			log.Error(err, "error shutting down OCSP responder")
+		}
+	}()
+
+	log.V(logf.InfoLevel).Info("starting OCSP responder", "address", ln.Addr())
+	if err := server.Serve(ln); err != http.ErrServerClosed {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/spf13/cobra"
+	kubeinformers "k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/clientcmd"
+
+	cmdutil "github.com/cert-manager/cert-manager/cmd/util"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/sds"
+	"github.com/cert-manager/cert-manager/pkg/util"
+)
+
+type sdsServerOptions struct {
+	APIServerHost string
+	Kubeconfig    string
+	Namespace     string
+	Endpoint      string
+}
+
+func NewSDSServerCommand(stopCh <-chan struct{}) *cobra.Command {
+	o := new(sdsServerOptions)
+
+	cmd := &cobra.Command{
+		Use:   "sdsserver",
+		Short: "Envoy Secret Discovery Service server for cert-manager certificates.",
+		Long: `Envoy Secret Discovery Service server for cert-manager certificates.
+
+Serves the certificates stored in the Secrets of cert-manager Certificates to
+Envoy proxies with the Secret Discovery Service (SDS), pushing renewed
+certificates to the proxies subscribed to them as soon as they are stored.
+Resources are named <namespace>/<secret name>, or just <secret name> if
+--namespace is set, and are served as TLS certificates. Resource names
+prefixed with "ca:" are served as validation contexts holding the CA
+certificate of the Secret.
+
+The server has no authentication, so the socket must only be shared with the
+proxies allowed to read the Secrets, usually by running the server as a
+sidecar of the proxy with --namespace set.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rootCtx := cmdutil.ContextWithStopCh(context.Background(), stopCh)
+			rootCtx = logf.NewContext(rootCtx, logf.Log, "sdsserver")
+			return o.Run(rootCtx)
+		},
+	}
+
+	cmd.Flags().StringVar(&o.APIServerHost, "master", "", ""+
+		"Optional apiserver host address to connect to. If not specified, autoconfiguration "+
+		"will be attempted.")
+	cmd.Flags().StringVar(&o.Kubeconfig, "kubeconfig", "", ""+
+		"Paths to a kubeconfig. Only required if out-of-cluster.")
+	cmd.Flags().StringVar(&o.Namespace, "namespace", "", ""+
+		"If set, only Secrets in this namespace are served. "+
+		"If not specified, Secrets in all namespaces are served")
+	cmd.Flags().StringVar(&o.Endpoint, "endpoint", "unix:///var/run/cert-manager/sds.sock", ""+
+		"Unix domain socket the Secret Discovery Service is served on.")
+
+	return cmd
+}
+
+func (o *sdsServerOptions) Run(ctx context.Context) error {
+	socket := strings.TrimPrefix(o.Endpoint, "unix://")
+	if socket == o.Endpoint || socket == "" {
+		return fmt.Errorf("--endpoint must be a unix:// address, got %q", o.Endpoint)
+	}
+
+	restConfig, err := clientcmd.BuildConfigFromFlags(o.APIServerHost, o.Kubeconfig)
+	if err != nil {
+		return fmt.Errorf("error creating rest config: %w", err)
+	}
+	restConfig = util.RestConfigWithUserAgent(restConfig, "sds-server")
+	kubeClient, err := kubernetes.NewForConfig(restConfig)
+	if err != nil {
+		return fmt.Errorf("error creating kubernetes client: %w", err)
+	}
+
+	kubeFactory := kubeinformers.NewSharedInformerFactoryWithOptions(kubeClient, 10*time.Hour, kubeinformers.WithNamespace(o.Namespace))
+	secrets := kubeFactory.Core().V1().Secrets()
+	server := sds.New(secrets.Lister(), o.Namespace, restConfig.UserAgent)
+	secrets.Informer().AddEventHandler(server.EventHandler())
+
+	kubeFactory.Start(ctx.Done())
+	for informer, synced := range kubeFactory.WaitForCacheSync(ctx.Done()) {
+		if !synced {
+			return fmt.Errorf("failed to sync %v informer", informer)
+		}
+	}
+
+	// The socket of a previous instance of the server is left behind if it
+	// was not shut down gracefully.
+	if err := os.Remove(socket); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("failed to remove socket %s: %w", socket, err)
+	}
+	ln, err := net.Listen("unix", socket)
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", socket, err)
+	}
+	return server.Run(ctx, ln)
+}
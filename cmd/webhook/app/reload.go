@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"context"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+// configReloadInterval is how often the webhook config file is checked for
+// changes to settings that can be applied at runtime.
+const configReloadInterval = 10 * time.Second
+
+// runtimeConfig applies the settings in a WebhookConfiguration that may be
+// changed without restarting the webhook: the log level, and feature gates
+// that have been marked as runtime toggleable. Changes to any other feature
+// gate are logged, and require a restart to take effect.
+type runtimeConfig struct {
+	log logr.Logger
+
+	// logLevelFlagSet is true if the log level was set using the --v flag,
+	// in which case it takes precedence over the config file.
+	logLevelFlagSet bool
+
+	featureGates map[string]bool
+	logLevel     *int32
+}
+
+func newRuntimeConfig(log logr.Logger, logLevelFlagSet bool, cfg *config.WebhookConfiguration) *runtimeConfig {
+	return &runtimeConfig{
+		log:             log.WithName("config-reload"),
+		logLevelFlagSet: logLevelFlagSet,
+		// feature gates have already been set from the initial config
+		featureGates: cfg.FeatureGates,
+	}
+}
+
+// apply applies any runtime settings in cfg that have changed since they were
+// last applied.
+func (r *runtimeConfig) apply(cfg *config.WebhookConfiguration) {
+	if !reflect.DeepEqual(r.featureGates, cfg.FeatureGates) {
+		if err := utilfeature.SetRuntimeFromMap(cfg.FeatureGates); err != nil {
+			r.log.Error(err, "not all feature gate changes could be applied")
+		} else {
+			r.log.V(logf.InfoLevel).Info("applied feature gate changes", "featureGates", cfg.FeatureGates)
+		}
+		r.featureGates = cfg.FeatureGates
+	}
+
+	if r.logLevelFlagSet || cfg.LogLevel == nil {
+		return
+	}
+	if r.logLevel != nil && *r.logLevel == *cfg.LogLevel {
+		return
+	}
+	if err := logf.SetVerbosity(*cfg.LogLevel); err != nil {
+		r.log.Error(err, "failed to set log level", "logLevel", *cfg.LogLevel)
+		return
+	}
+	r.log.V(logf.InfoLevel).Info("set log level", "logLevel", *cfg.LogLevel)
+	logLevel := *cfg.LogLevel
+	r.logLevel = &logLevel
+}
+
+// watch periodically re-reads the config file at path, applying any runtime
+// settings that have changed, until ctx is cancelled. Flags given in args
+// continue to take precedence over values in the config file.
+func (r *runtimeConfig) watch(ctx context.Context, path string, args []string) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		cfg, err := loadConfigFile(path)
+		if err != nil {
+			r.log.Error(err, "failed to reload webhook config file", "path", path)
+			return
+		}
+		if err := webhookConfigFlagPrecedence(cfg, args); err != nil {
+			r.log.Error(err, "failed to merge flags with reloaded config file values")
+			return
+		}
+		r.apply(cfg)
+	}, configReloadInterval)
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package app
+
+import (
+	"testing"
+
+	"github.com/go-logr/logr"
+
+	config "github.com/cert-manager/cert-manager/internal/apis/config/webhook"
+	"github.com/cert-manager/cert-manager/internal/webhook/feature"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+// Test to ensure runtime toggleable feature gates are applied when the config
+// file changes, and reset to their default when removed from it.
+func TestRuntimeConfigApply_FeatureGates(t *testing.T) {
+	defer func() {
+		if err := utilfeature.DefaultMutableFeatureGate.SetFromMap(map[string]bool{
+			string(feature.LiteralCertificateSubject): false,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	r := newRuntimeConfig(logr.Discard(), false, &config.WebhookConfiguration{})
+
+	r.apply(&config.WebhookConfiguration{
+		FeatureGates: map[string]bool{string(feature.LiteralCertificateSubject): true},
+	})
+	if !utilfeature.DefaultFeatureGate.Enabled(feature.LiteralCertificateSubject) {
+		t.Errorf("expected %s to be enabled after reload", feature.LiteralCertificateSubject)
+	}
+
+	r.apply(&config.WebhookConfiguration{})
+	if utilfeature.DefaultFeatureGate.Enabled(feature.LiteralCertificateSubject) {
+		t.Errorf("expected %s to be reset to its default after being removed from the config", feature.LiteralCertificateSubject)
+	}
+}
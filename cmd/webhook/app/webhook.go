@@ -115,6 +115,12 @@ func NewServerCommand(stopCh <-chan struct{}) *cobra.Command {
 					log.Error(err, "Failed to set feature gates from config file")
 					os.Exit(1)
 				}
+
+				// apply the log level from the config file, and watch it for
+				// changes to settings that can be applied without a restart
+				runtimeCfg := newRuntimeConfig(log, cleanFlagSet.Changed("v"), webhookConfig)
+				runtimeCfg.apply(webhookConfig)
+				go runtimeCfg.watch(ctx, configFile, args)
 			}
 
 			srv, err := cmwebhook.NewCertManagerWebhookServer(log, *webhookFlags, *webhookConfig)
@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// annotationgen writes the JSON schema for the annotations understood by
+// cert-manager on ingress-like resources, for example:
+//
+//   go run ./hack/annotationgen pkg/api/annotations/schema.json
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/cert-manager/cert-manager/pkg/api/annotations"
+)
+
+func main() {
+	logger := log.New(os.Stderr, "", 0)
+
+	if len(os.Args) != 2 {
+		logger.Printf("usage: %s <path-to-schema.json>", os.Args[0])
+		os.Exit(1)
+	}
+
+	schema, err := annotations.JSONSchema()
+	if err != nil {
+		logger.Printf("failed to generate annotations schema: %s", err)
+		os.Exit(1)
+	}
+
+	if err := os.WriteFile(os.Args[1], schema, 0644); err != nil {
+		logger.Printf("failed to write %q: %s", os.Args[1], err)
+		os.Exit(1)
+	}
+}
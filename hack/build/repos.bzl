@@ -70,6 +70,15 @@ def go_repositories():
         sum = "h1:UQZhZ2O0vMHr2cI+DC1Mbh0TJxzA3RcLoMsFw+aXw7E=",
         version = "v0.0.0-20190924025748-f65c72e2690d",
     )
+    go_repository(
+        name = "com_github_alexbrainman_sspi",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/alexbrainman/sspi",
+        sum = "h1:P5U+E4x5OkVEKQDklVPmzs71WM56RTTRqV4OrDC//Y4=",
+        version = "v0.0.0-20180613141037-e580b900e9f5",
+    )
+
     go_repository(
         name = "com_github_alexflint_go_filemutex",
         build_file_generation = "on",
@@ -337,6 +346,15 @@ def go_repositories():
         version = "v0.0.0-20160611221934-b7ed37b82869",
     )
 
+    go_repository(
+        name = "com_github_bodgit_tsig",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/bodgit/tsig",
+        sum = "h1:RgxTCr8UFUHyU4D8Ygb2UtXtS4niw4B6XYYBpgCjl0k=",
+        version = "v1.2.2",
+    )
+
     go_repository(
         name = "com_github_bshuster_repo_logrus_logstash_hook",
         build_file_generation = "on",
@@ -594,6 +612,15 @@ def go_repositories():
         version = "v0.0.0-20190617123548-eb05cc24525f",
     )
 
+    go_repository(
+        name = "com_github_container_storage_interface_spec",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/container-storage-interface/spec",
+        sum = "h1:lvKxe3uLgqQeVQcrnL2CPQKISoKjTJxojEs9cBk+HXo=",
+        version = "v1.5.0",
+    )
+
     go_repository(
         name = "com_github_containerd_aufs",
         build_file_generation = "on",
@@ -1381,8 +1408,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/go-logr/logr",
-        sum = "h1:QK40JKJyMdUDz+h+xvCsru/bJhvG0UxvePV0ufL/AcE=",
-        version = "v1.2.0",
+        sum = "h1:2DntVwHkVopvECVRSlL5PSo9eG+cAkDCuckLubN+rq0=",
+        version = "v1.2.3",
     )
 
     go_repository(
@@ -1719,8 +1746,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/google/cel-go",
-        sum = "h1:u1hg7lcZ/XWw2d3aV1jFS30ijQQ6q0/h1C2ZBeBD1gY=",
-        version = "v0.9.0",
+        sum = "h1:MQBGSZGnDwh7T/un+mzGKOMz3x+4E/GDPprWjDL+1Jg=",
+        version = "v0.10.1",
     )
     go_repository(
         name = "com_github_google_cel_spec",
@@ -1731,6 +1758,15 @@ def go_repositories():
         version = "v0.6.0",
     )
 
+    go_repository(
+        name = "com_github_google_certificate_transparency_go",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/google/certificate-transparency-go",
+        sum = "h1:jc2UWq7CbdszqeH6qu1ougXMIUBfSy8Pbh/anURYbGI=",
+        version = "v1.0.10-0.20180222191210-5ab67e519c93",
+    )
+
     go_repository(
         name = "com_github_google_go_cmp",
         build_file_generation = "on",
@@ -2019,8 +2055,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/hashicorp/go-multierror",
-        sum = "h1:B9UzwGQJehnUY1yNrnwREHc3fGbC2xefo8g4TbElacI=",
-        version = "v1.1.0",
+        sum = "h1:H5DkEtf6CXdFp0N0Em5UCwQpXMWke8IA0+lD48awMYo=",
+        version = "v1.1.1",
     )
 
     go_repository(
@@ -2082,8 +2118,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/hashicorp/go-uuid",
-        sum = "h1:cfejS+Tpcp13yd5nYHWDI6qVCny6wyX2Mt5SGur2IGE=",
-        version = "v1.0.2",
+        sum = "h1:2gKiV6YVmrJ1i2CKKa9obLvRieoRGviZFL26PcT/Co8=",
+        version = "v1.0.3",
     )
 
     go_repository(
@@ -2247,6 +2283,60 @@ def go_repositories():
         version = "v0.0.0-20160618110441-2cf9dc699c56",
     )
 
+    go_repository(
+        name = "com_github_jcmturner_aescts_v2",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/aescts/v2",
+        sum = "h1:9YKLH6ey7H4eDBXW8khjYslgyqG2xZikXP0EQFKrle8=",
+        version = "v2.0.0",
+    )
+
+    go_repository(
+        name = "com_github_jcmturner_dnsutils_v2",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/dnsutils/v2",
+        sum = "h1:lltnkeZGL0wILNvrNiVCR6Ro5PGU/SeBvVO/8c/iPbo=",
+        version = "v2.0.0",
+    )
+
+    go_repository(
+        name = "com_github_jcmturner_gofork",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/gofork",
+        sum = "h1:QH0l3hzAU1tfT3rZCnW5zXl+orbkNMMRGJfdJjHVETg=",
+        version = "v1.7.6",
+    )
+
+    go_repository(
+        name = "com_github_jcmturner_goidentity_v6",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/goidentity/v6",
+        sum = "h1:VKnZd2oEIMorCTsFBnJWbExfNN7yZr3EhJAxwOkZg6o=",
+        version = "v6.0.1",
+    )
+
+    go_repository(
+        name = "com_github_jcmturner_gokrb5_v8",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/gokrb5/v8",
+        sum = "h1:iTonLeSJOn7MVUtyMT+arAn5AKAPrkilzhGw8wE/Tq8=",
+        version = "v8.4.3",
+    )
+
+    go_repository(
+        name = "com_github_jcmturner_rpc_v2",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jcmturner/rpc/v2",
+        sum = "h1:7FXXj8Ti1IaVFpSAziCZWNzbNuZmnvw/i6CqLNdWfZY=",
+        version = "v2.0.3",
+    )
+
     go_repository(
         name = "com_github_jessevdk_go_flags",
         build_file_generation = "on",
@@ -2256,6 +2346,15 @@ def go_repositories():
         version = "v1.4.0",
     )
 
+    go_repository(
+        name = "com_github_jinzhu_copier",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/jinzhu/copier",
+        sum = "h1:GlvfUwHk62RokgqVNvYsku0TATCF7bAHVwEXoBh3iJg=",
+        version = "v0.3.5",
+    )
+
     go_repository(
         name = "com_github_jmespath_go_jmespath",
         build_file_generation = "on",
@@ -2734,8 +2833,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/miekg/dns",
-        sum = "h1:J9bWiXbqMbnZPcY8Qi2E3EWIBsIm6MZzzJB9VRg5gL8=",
-        version = "v1.1.47",
+        sum = "h1:DQUfb9uc6smULcREF09Uc+/Gd46YWqJd5DbpPE9xkcA=",
+        version = "v1.1.50",
     )
     go_repository(
         name = "com_github_miekg_pkcs11",
@@ -3117,6 +3216,15 @@ def go_repositories():
         version = "v1.8.2",
     )
 
+    go_repository(
+        name = "com_github_openshift_gssapi",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/openshift/gssapi",
+        sum = "h1:it0YPE/evO6/m8t8wxis9KFI2F/aleOKsI6d9uz0cEk=",
+        version = "v0.0.0-20161010215902-5fb4217df13b",
+    )
+
     go_repository(
         name = "com_github_opentracing_opentracing_go",
         build_file_generation = "on",
@@ -3569,6 +3677,15 @@ def go_repositories():
         sum = "h1:mXH0UwHS4D2HwWZa75im4xIQynLfblmWV7qcWpfv0yk=",
         version = "v1.10.0",
     )
+
+    go_repository(
+        name = "com_github_spiffe_spire_api_sdk",
+        build_file_generation = "on",
+        build_file_proto_mode = "disable",
+        importpath = "github.com/spiffe/spire-api-sdk",
+        sum = "h1:QK+hRuUYRWLo7Jlb6k8SoYoorStsX21aQb3OHxU+Vig=",
+        version = "v1.2.0",
+    )
     go_repository(
         name = "com_github_stefanberger_go_pkcs11uri",
         build_file_generation = "on",
@@ -3592,8 +3709,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/stretchr/objx",
-        sum = "h1:Hbg2NidpLE8veEBkEZTL3CvlkUIVzuU9jDplZO54c48=",
-        version = "v0.2.0",
+        sum = "h1:1zr/of2m5FGMsad5YfcqgdqdWrIhu+EBEJRhR1U7z/c=",
+        version = "v0.5.0",
     )
 
     go_repository(
@@ -3601,8 +3718,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "github.com/stretchr/testify",
-        sum = "h1:nwc3DEeHmmLAfoZucVR881uASk0Mfjw8xYJ99tb5CcY=",
-        version = "v1.7.0",
+        sum = "h1:w7B6lhMri9wdJUVmEZPGGhZzrYTPvgJArz7wNPgYKsk=",
+        version = "v1.8.1",
     )
 
     go_repository(
@@ -4056,8 +4173,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "gopkg.in/yaml.v3",
-        sum = "h1:h8qDotaEPuJATrMmW04NCwg7v22aHH28wwpauUhK9Oo=",
-        version = "v3.0.0-20210107192922-496545a6307b",
+        sum = "h1:fxVm/GzAzEWqLHuvctI91KS9hhNmmWOoWu0XTYJS7CA=",
+        version = "v3.0.1",
     )
 
     go_repository(
@@ -4656,8 +4773,8 @@ def go_repositories():
         build_file_generation = "on",
         build_file_proto_mode = "disable",
         importpath = "golang.org/x/crypto",
-        sum = "h1:kUhD7nTDoI3fVd9G4ORWrbV5NY0liEs/Jg2pv5f+bBA=",
-        version = "v0.0.0-20220411220226-7b82a4e95df4",
+        sum = "h1:zuSxTR4o9y82ebqCUJYNGJbGPo6sKVl54f/TVDObg1c=",
+        version = "v0.0.0-20220722155217-630584e8d5aa",
     )
 
     go_repository(
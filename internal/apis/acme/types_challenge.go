@@ -128,4 +128,10 @@ type ChallengeStatus struct {
 	// State contains the current 'state' of the challenge.
 	// If not set, the state of the challenge is unknown.
 	State State
+
+	// RetryAfter is the time before which the ACME server has asked
+	// cert-manager not to retry requests for this challenge, for example
+	// because of a rate limit. It is set from the Retry-After header of
+	// the ACME server's response and cleared once it has passed.
+	RetryAfter *metav1.Time
 }
@@ -42,11 +42,25 @@ type ACMEIssuer struct {
 	// Only ACME v2 endpoints (i.e. RFC 8555) are supported.
 	Server string
 
+	// URLRewrites is a list of rewrites applied to the URLs of every request
+	// made to the ACME server, including the URLs returned by the server in
+	// its directory and responses. This allows ACME to be used in air-gapped
+	// networks where the ACME server can only be reached through an internal
+	// mirror or egress proxy. Requests are sent to the rewritten URL, but are
+	// still signed using the original URL as expected by the ACME server.
+	// If more than one rewrite matches a URL, the one with the longest
+	// `from` prefix is used.
+	URLRewrites []ACMEURLRewrite
+
 	// PreferredChain is the chain to use if the ACME server outputs multiple.
 	// PreferredChain is no guarantee that this one gets delivered by the ACME
 	// endpoint.
 	// For example, for Let's Encrypt's DST crosssign you would use:
 	// "DST Root CA X3" or "ISRG Root X1" for the newer Let's Encrypt root CA.
+	// When a CA has multiple roots with the same CN, the chain can instead be
+	// selected by the SHA-256 fingerprint of one of its certificates, e.g.
+	// "sha256:<hex>", or by the Subject Key Identifier of its root, e.g.
+	// "ski:<hex>".
 	PreferredChain string
 
 	// Enables or disables validation of the ACME server TLS certificate.
@@ -71,6 +85,15 @@ type ACMEIssuer struct {
 	// If `key` is not specified, a default of `tls.key` will be used.
 	PrivateKey cmmeta.SecretKeySelector
 
+	// AdditionalAccounts is a list of further ACME accounts that will be
+	// registered with the ACME server alongside the issuer's primary account.
+	// Each account has its own contact email address, so that expiry notices
+	// and other messages from the CA for Certificates issued using that
+	// account reach the owning team rather than the address in spec.acme.email.
+	// A Certificate selects one of these accounts using the
+	// `acme.cert-manager.io/account-email` annotation.
+	AdditionalAccounts []ACMEAdditionalAccount
+
 	// Solvers is a list of challenge solvers that will be used to solve
 	// ACME challenges for the matching domains.
 	// Solver configurations must be provided in order to obtain certificates
@@ -92,6 +115,14 @@ type ACMEIssuer struct {
 	// it it will create an error on the Order.
 	// Defaults to false.
 	EnableDurationFeature bool
+
+	// Enables checking the CAA records of every DNS name in a CertificateRequest
+	// before an ACME order is created. If the CAA records do not authorize the
+	// CAA identities advertised in the ACME server's directory, the
+	// CertificateRequest is failed without creating an order. This avoids
+	// using up rate limits on orders that the ACME server would reject.
+	// Defaults to false.
+	EnableCAAPreflightCheck bool
 }
 
 // ACMEExternalAccountBinding is a reference to a CA external account of the ACME
@@ -116,6 +147,35 @@ type ACMEExternalAccountBinding struct {
 	KeyAlgorithm HMACKeyAlgorithm
 }
 
+// ACMEAdditionalAccount is an additional ACME account registered by an
+// issuer using a contact email address that differs from the one in
+// spec.acme.email.
+type ACMEAdditionalAccount struct {
+	// Email is the email address to be associated with this ACME account.
+	// Certificates select this account by setting the
+	// `acme.cert-manager.io/account-email` annotation to this value.
+	Email string
+
+	// PrivateKey is the name of a Kubernetes Secret resource that will be used to
+	// store the automatically generated private key for this ACME account.
+	// Optionally, a `key` may be specified to select a specific entry within
+	// the named Secret resource.
+	// If `key` is not specified, a default of `tls.key` will be used.
+	// The Secret must not be shared with any other ACME account.
+	PrivateKey cmmeta.SecretKeySelector
+}
+
+// ACMEURLRewrite rewrites the URLs of requests made to an ACME server so that
+// they are sent through a mirror.
+type ACMEURLRewrite struct {
+	// From is the URL prefix to be replaced, e.g. "https://acme-v02.api.letsencrypt.org/".
+	From string
+
+	// To is the URL prefix that replaces From, e.g.
+	// "https://acme-mirror.internal.example.com/letsencrypt/".
+	To string
+}
+
 // HMACKeyAlgorithm is the name of a key algorithm used for HMAC encryption
 type HMACKeyAlgorithm string
 
@@ -194,6 +254,14 @@ type ACMEChallengeSolverHTTP01 struct {
 	// This solver is experimental, and fields / behaviour may change in the future.
 	// +optional
 	GatewayHTTPRoute *ACMEChallengeSolverHTTP01GatewayHTTPRoute
+
+	// The Istio VirtualService solver will solve challenges by creating
+	// VirtualService resources that route requests for
+	// '/.well-known/acme-challenge/XYZ' to 'challenge solver' pods. This is
+	// useful in service meshes where creating Ingress resources is not
+	// permitted. The VirtualService is created in the same namespace as
+	// the challenge.
+	IstioVirtualService *ACMEChallengeSolverHTTP01IstioVirtualService
 }
 
 type ACMEChallengeSolverHTTP01Ingress struct {
@@ -203,10 +271,18 @@ type ACMEChallengeSolverHTTP01Ingress struct {
 	ServiceType corev1.ServiceType
 
 	// The ingress class to use when creating Ingress resources to solve ACME
-	// challenges that use this challenge solver.
-	// Only one of 'class' or 'name' may be specified.
+	// challenges that use this challenge solver, set using the deprecated
+	// `kubernetes.io/ingress.class` annotation. Use 'ingressClassName'
+	// instead.
+	// Only one of 'class', 'ingressClassName' or 'name' may be specified.
 	Class *string
 
+	// The name of the IngressClass to use when creating Ingress resources to
+	// solve ACME challenges that use this challenge solver, set using the
+	// `spec.ingressClassName` field of the Ingress.
+	// Only one of 'class', 'ingressClassName' or 'name' may be specified.
+	IngressClassName *string
+
 	// The name of the ingress resource that should have ACME challenge solving
 	// routes inserted into it in order to solve HTTP01 challenges.
 	// This is typically used in conjunction with ingress controllers like
@@ -234,13 +310,43 @@ type ACMEChallengeSolverHTTP01GatewayHTTPRoute struct {
 	// +optional
 	Labels map[string]string
 
+	// Custom annotations that will be applied to HTTPRoutes created by
+	// cert-manager while solving HTTP-01 challenges. Some Gateway
+	// implementations are configured using annotations on routes.
+	// +optional
+	Annotations map[string]string
+
 	// When solving an HTTP-01 challenge, cert-manager creates an HTTPRoute.
 	// cert-manager needs to know which parentRefs should be used when creating
 	// the HTTPRoute. Usually, the parentRef references a Gateway. See:
 	// https://gateway-api.sigs.k8s.io/v1alpha2/api-types/httproute/#attaching-to-gateways
+	// A parentRef may set namespace to attach to a Gateway in another
+	// namespace, and sectionName to attach to a single listener of the Gateway.
 	ParentRefs []gwapi.ParentRef
 }
 
+// The ACMEChallengeSolverHTTP01IstioVirtualService solver will create Istio
+// VirtualService objects routing to an ACME challenge solver pod.
+type ACMEChallengeSolverHTTP01IstioVirtualService struct {
+	// Optional service type for Kubernetes solver service. Supported values
+	// are NodePort or ClusterIP. If unset, defaults to NodePort.
+	ServiceType corev1.ServiceType
+
+	// The Istio Gateways that the VirtualService created to solve the
+	// challenge should be bound to, in the form <namespace>/<name>. A
+	// Gateway specified without a namespace refers to a Gateway in the
+	// same namespace as the challenge.
+	Gateways []string
+
+	// Custom labels that will be applied to VirtualServices created by
+	// cert-manager while solving HTTP-01 challenges.
+	Labels map[string]string
+
+	// Custom annotations that will be applied to VirtualServices created by
+	// cert-manager while solving HTTP-01 challenges.
+	Annotations map[string]string
+}
+
 type ACMEChallengeSolverHTTP01IngressPodTemplate struct {
 	// ObjectMeta overrides for the pod used to solve HTTP01 challenges.
 	// Only the 'labels' and 'annotations' fields may be set.
@@ -282,6 +388,24 @@ type ACMEChallengeSolverHTTP01IngressPodSpec struct {
 	// If specified, the pod's service account
 	// +optional
 	ServiceAccountName string
+
+	// If specified, the resource requirements of the solver container.
+	// Requests and limits set here override the defaults configured on
+	// the controller.
+	Resources *corev1.ResourceRequirements
+
+	// If specified, the pod's security context. This replaces the
+	// default security context of the solver pod.
+	SecurityContext *corev1.PodSecurityContext
+
+	// If specified, the pod's topology spread constraints.
+	TopologySpreadConstraints []corev1.TopologySpreadConstraint
+
+	// If specified, the pod's runtimeClassName.
+	RuntimeClassName string
+
+	// If specified, the pod's imagePullSecrets
+	ImagePullSecrets []corev1.LocalObjectReference
 }
 
 type ACMEChallengeSolverHTTP01IngressTemplate struct {
@@ -308,6 +432,12 @@ type ACMEChallengeSolverDNS01 struct {
 	// records when found in DNS zones.
 	CNAMEStrategy CNAMEStrategy
 
+	// CheckNameservers overrides the nameservers and self check strategy used
+	// for DNS01 challenges solved by this solver. By default the
+	// controller's --dns01-recursive-nameservers and
+	// --dns01-recursive-nameservers-only flags are used.
+	CheckNameservers *ACMEChallengeSolverDNS01CheckNameservers
+
 	// Use the Akamai DNS zone management API to manage DNS01 challenge records.
 	Akamai *ACMEIssuerDNS01ProviderAkamai
 
@@ -334,11 +464,57 @@ type ACMEChallengeSolverDNS01 struct {
 	// to manage DNS01 challenge records.
 	RFC2136 *ACMEIssuerDNS01ProviderRFC2136
 
+	// Use the Gandi LiveDNS API to manage DNS01 challenge records.
+	Gandi *ACMEIssuerDNS01ProviderGandi
+
+	// Use the Linode DNS Manager API to manage DNS01 challenge records.
+	Linode *ACMEIssuerDNS01ProviderLinode
+
+	// Use the NS1 API to manage DNS01 challenge records.
+	NS1 *ACMEIssuerDNS01ProviderNS1
+
+	// Use the PowerDNS Authoritative Server HTTP API to manage DNS01 challenge records.
+	PowerDNS *ACMEIssuerDNS01ProviderPowerDNS
+
+	// Use a zone file stored in a ConfigMap and served by CoreDNS to manage
+	// DNS01 challenge records.
+	CoreDNS *ACMEIssuerDNS01ProviderCoreDNS
+
+	// Use the Oracle Cloud Infrastructure DNS API to manage DNS01 challenge records.
+	OCI *ACMEIssuerDNS01ProviderOCI
+
+	// Use the Alibaba Cloud DNS API to manage DNS01 challenge records.
+	AliDNS *ACMEIssuerDNS01ProviderAliDNS
+
+	// Use the IBM Cloud Internet Services API to manage DNS01 challenge records.
+	IBMCIS *ACMEIssuerDNS01ProviderIBMCIS
+
+	// Use the DNSimple API to manage DNS01 challenge records.
+	DNSimple *ACMEIssuerDNS01ProviderDNSimple
+
 	// Configure an external webhook based DNS01 challenge solver to manage
 	// DNS01 challenge records.
 	Webhook *ACMEIssuerDNS01ProviderWebhook
 }
 
+// ACMEChallengeSolverDNS01CheckNameservers configures the nameservers used
+// to look up DNS01 challenge records and to check that they have
+// propagated, overriding the controller's global configuration.
+type ACMEChallengeSolverDNS01CheckNameservers struct {
+	// Nameservers is a list of recursive nameservers, in host:port form,
+	// for example 10.0.0.53:53. If set, these are used instead of the
+	// nameservers given by the --dns01-recursive-nameservers flag.
+	Nameservers []string
+
+	// RecursiveOnly, if true, performs the DNS01 self check using only the
+	// recursive nameservers rather than the authoritative nameservers of
+	// the zone. This is useful for split-horizon DNS, where the records
+	// visible to the ACME server are not served by the authoritative
+	// nameservers that cert-manager can reach. If not set, the
+	// --dns01-recursive-nameservers-only flag is used.
+	RecursiveOnly *bool
+}
+
 // CNAMEStrategy configures how the DNS01 provider should handle CNAME records
 // when found in DNS zones.
 // By default, the None strategy will be applied (i.e. do not follow CNAMEs).
@@ -411,6 +587,13 @@ type ACMEIssuerDNS01ProviderRoute53 struct {
 	// or the inferred credentials from environment variables, shared credentials file or AWS Instance metadata
 	Role string
 
+	// AssumeRoleChain is a list of roles which the Route53 provider will
+	// assume in order after Role, if set, each using the credentials obtained
+	// from the previous one. This allows the credentials of one AWS account,
+	// such as an IRSA role, to be used to manage records in a hosted zone
+	// owned by a separate DNS account.
+	AssumeRoleChain []ACMEIssuerDNS01ProviderRoute53AssumeRole
+
 	// If set, the provider will manage only this zone in Route53 and will not do an lookup using the route53:ListHostedZonesByName api call.
 	HostedZoneID string
 
@@ -418,6 +601,16 @@ type ACMEIssuerDNS01ProviderRoute53 struct {
 	Region string
 }
 
+// ACMEIssuerDNS01ProviderRoute53AssumeRole is a role assumed by the Route53
+// provider as part of a role chain.
+type ACMEIssuerDNS01ProviderRoute53AssumeRole struct {
+	// The ARN of the role to assume.
+	Role string
+
+	// The external ID required by the trust policy of the role, if any.
+	ExternalID string
+}
+
 // ACMEIssuerDNS01ProviderAzureDNS is a structure containing the
 // configuration for Azure DNS
 type ACMEIssuerDNS01ProviderAzureDNS struct {
@@ -439,6 +632,8 @@ type ACMEIssuerDNS01ProviderAzureDNS struct {
 	Environment AzureDNSEnvironment
 
 	ManagedIdentity *AzureManagedIdentity
+
+	ServiceAccountRef *AzureServiceAccountRef
 }
 
 type AzureManagedIdentity struct {
@@ -447,6 +642,12 @@ type AzureManagedIdentity struct {
 	ResourceID string
 }
 
+type AzureServiceAccountRef struct {
+	Name string
+
+	Audiences []string
+}
+
 type AzureDNSEnvironment string
 
 const (
@@ -486,6 +687,203 @@ type ACMEIssuerDNS01ProviderRFC2136 struct {
 	// Supported values are (case-insensitive): ``HMACMD5`` (default),
 	// ``HMACSHA1``, ``HMACSHA256`` or ``HMACSHA512``.
 	TSIGAlgorithm string
+
+	// Use GSS-TSIG (RFC 3645) to authenticate updates with a Kerberos
+	// principal, as required by Active Directory integrated zones which
+	// refuse updates signed with a plain TSIG key. The nameserver must be a
+	// hostname, as the service principal ``DNS/<nameserver host>`` is used.
+	// May not be specified together with ``tsigKeyName``.
+	GSSTSIG *ACMEIssuerDNS01ProviderRFC2136GSSTSIG
+}
+
+// ACMEIssuerDNS01ProviderRFC2136GSSTSIG is a structure containing the
+// Kerberos configuration used to authenticate RFC2136 updates with GSS-TSIG.
+type ACMEIssuerDNS01ProviderRFC2136GSSTSIG struct {
+	// The Kerberos realm of the principal, e.g. ``EXAMPLE.COM``.
+	Realm string
+
+	// The name of the Kerberos principal to authenticate as, without the
+	// realm, e.g. ``cert-manager``.
+	Username string
+
+	// A reference to a Secret containing a keytab with the AES keys of the
+	// principal. If the key is not specified, ``keytab`` is used.
+	KeytabSecretRef cmmeta.SecretKeySelector
+
+	// The Kerberos KDCs to authenticate with, in the form host:port, where
+	// the port defaults to 88. If not specified the nameserver is used, as
+	// Active Directory domain controllers usually run both services.
+	KDCs []string
+}
+
+// ACMEIssuerDNS01ProviderGandi is a structure containing the DNS
+// configuration for Gandi LiveDNS
+type ACMEIssuerDNS01ProviderGandi struct {
+	// A reference to a Secret containing a Gandi personal access token
+	// with permission to manage DNS records of the domain.
+	APIToken cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderLinode is a structure containing the DNS
+// configuration for Linode DNS Manager
+type ACMEIssuerDNS01ProviderLinode struct {
+	// A reference to a Secret containing a Linode API token with
+	// read/write access to Domains. The domain containing the challenge
+	// record is discovered automatically from the domains in the account.
+	APIToken cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderNS1 is a structure containing the DNS
+// configuration for NS1
+type ACMEIssuerDNS01ProviderNS1 struct {
+	// A reference to a Secret containing an NS1 API key with
+	// permission to manage records in the zone.
+	APIKey cmmeta.SecretKeySelector
+
+	// Endpoint is the base URL of the NS1 API, including the API version,
+	// e.g. https://ns1.example.com/v1. Only needs to be set for private
+	// NS1 deployments. Defaults to https://api.nsone.net/v1.
+	Endpoint string
+}
+
+// ACMEIssuerDNS01ProviderPowerDNS is a structure containing the DNS
+// configuration for the PowerDNS Authoritative Server HTTP API
+type ACMEIssuerDNS01ProviderPowerDNS struct {
+	// Host is the base URL of the PowerDNS Authoritative Server HTTP API,
+	// without the /api/v1 path, e.g. https://pdns.example.com:8081.
+	Host string
+
+	// A reference to a Secret containing the PowerDNS API key.
+	APIKey cmmeta.SecretKeySelector
+
+	// ServerID is the ID of the server whose zones will be managed.
+	// Defaults to localhost, which is the only server ID used by the
+	// PowerDNS Authoritative Server.
+	ServerID string
+}
+
+// ACMEIssuerDNS01ProviderCoreDNS is a structure containing the
+// configuration for managing DNS01 challenge records in a zone file that
+// is stored in a ConfigMap and served by the CoreDNS file plugin.
+// The zone is re-serialised when records are added or removed, so comments
+// and formatting in the zone file are not preserved.
+// The Helm chart only grants the cert-manager controller get and update
+// access to the zone ConfigMaps listed in the dns01CoreDNSConfigMapNames
+// value.
+type ACMEIssuerDNS01ProviderCoreDNS struct {
+	// Zone is the name of the zone, e.g. example.internal.
+	// The zone file must contain an SOA record for the zone, whose serial
+	// is incremented whenever the zone is updated so that CoreDNS reloads it.
+	Zone string
+
+	// ConfigMapName is the name of the ConfigMap containing the zone file.
+	ConfigMapName string
+
+	// ConfigMapNamespace is the namespace of the ConfigMap containing the
+	// zone file. It may only be set on a ClusterIssuer, and defaults to the
+	// cluster resource namespace. An Issuer always uses its own namespace.
+	ConfigMapNamespace string
+
+	// Key is the key in the ConfigMap holding the zone file.
+	// Defaults to db.<zone>.
+	Key string
+}
+
+// ACMEIssuerDNS01ProviderOCI is a structure containing the DNS
+// configuration for Oracle Cloud Infrastructure DNS
+type ACMEIssuerDNS01ProviderOCI struct {
+	// Region is the identifier of the OCI region to use, e.g. us-ashburn-1.
+	// Required when using API key authentication. Defaults to the region of
+	// the instance when using instance principal authentication, and to
+	// OCI_RESOURCE_PRINCIPAL_REGION when using workload identity.
+	Region string
+
+	// CompartmentOCID is the OCID of the compartment containing the zone.
+	CompartmentOCID string
+
+	// AuthType is the method used to authenticate to OCI. One of APIKey,
+	// InstancePrincipal or WorkloadIdentity. Defaults to APIKey.
+	// InstancePrincipal authenticates as the compute instance cert-manager
+	// is running on, and WorkloadIdentity as cert-manager's service account
+	// using OKE workload identity. Both use ambient credentials, so are
+	// only permitted for Issuers if ambient credentials are enabled for them.
+	AuthType string
+
+	// APIKey holds the API signing key to authenticate with. Required when
+	// using API key authentication.
+	APIKey *ACMEIssuerDNS01ProviderOCIAPIKey
+}
+
+// ACMEIssuerDNS01ProviderOCIAPIKey holds the API signing key of an OCI
+// user to authenticate to OCI DNS with.
+type ACMEIssuerDNS01ProviderOCIAPIKey struct {
+	// TenancyOCID is the OCID of the tenancy the user belongs to.
+	TenancyOCID string
+
+	// UserOCID is the OCID of the user the API signing key belongs to.
+	UserOCID string
+
+	// Fingerprint is the fingerprint of the API signing key.
+	Fingerprint string
+
+	// A reference to a Secret containing the unencrypted PEM encoded
+	// RSA private key of the API signing key.
+	PrivateKey cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderAliDNS is a structure containing the DNS
+// configuration for Alibaba Cloud DNS
+type ACMEIssuerDNS01ProviderAliDNS struct {
+	// The ID of the AccessKey pair used for authentication. If neither this
+	// nor AccessKeySecret are set, the RAM role attached to the ECS instance
+	// cert-manager is running on is used, if ambient credentials are enabled.
+	AccessKeyID string
+
+	// A reference to a Secret containing the secret of the AccessKey pair
+	// used for authentication.
+	AccessKeySecret *cmmeta.SecretKeySelector
+
+	// RAMRole is the name of the RAM role attached to the ECS instance to
+	// use when no AccessKey pair is set. If not set, the role attached to
+	// the instance is discovered from the instance metadata.
+	RAMRole string
+
+	// RoleARN is the ARN of a RAM role which will be assumed using the
+	// AccessKey pair or the ECS instance RAM role.
+	RoleARN string
+
+	// RegionID is the ID of the region whose DNS API endpoint is used, e.g.
+	// ap-southeast-1. Defaults to the global endpoint alidns.aliyuncs.com.
+	RegionID string
+}
+
+// ACMEIssuerDNS01ProviderIBMCIS is a structure containing the DNS
+// configuration for IBM Cloud Internet Services
+type ACMEIssuerDNS01ProviderIBMCIS struct {
+	// CRN is the Cloud Resource Name of the IBM Cloud Internet Services
+	// instance hosting the DNS zones, e.g.
+	// crn:v1:bluemix:public:internet-svcs:global:a/<account>:<instance>::
+	CRN string
+
+	// A reference to a Secret containing an IBM Cloud IAM API key with
+	// access to manage DNS records in the instance.
+	APIKey cmmeta.SecretKeySelector
+}
+
+// ACMEIssuerDNS01ProviderDNSimple is a structure containing the DNS
+// configuration for DNSimple
+type ACMEIssuerDNS01ProviderDNSimple struct {
+	// A reference to a Secret containing a DNSimple API access token.
+	APIToken cmmeta.SecretKeySelector
+
+	// AccountID is the ID of the DNSimple account hosting the DNS zones.
+	// If not set, the account that the API token belongs to is used, which
+	// requires the token to be an account token rather than a user token.
+	AccountID string
+
+	// Sandbox configures the solver to use the DNSimple sandbox environment
+	// at api.sandbox.dnsimple.com, which is useful for testing.
+	Sandbox bool
 }
 
 // ACMEIssuerDNS01ProviderWebhook specifies configuration for a webhook DNS01
@@ -495,6 +893,7 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	// resources to the webhook apiserver.
 	// This should be the same as the GroupName specified in the webhook
 	// provider implementation.
+	// Required unless grpc is set.
 	GroupName string
 
 	// The name of the solver to use, as defined in the webhook provider
@@ -511,6 +910,32 @@ type ACMEIssuerDNS01ProviderWebhook struct {
 	// For details on the schema of this field, consult the webhook provider
 	// implementation's documentation.
 	Config *apiextensionsv1.JSON
+
+	// GRPC configures the solver to be called using the gRPC DNS01 solver
+	// protocol at the given endpoint, rather than through an APIService
+	// registered with the Kubernetes API server. If set, groupName is not used.
+	GRPC *ACMEIssuerDNS01ProviderWebhookGRPC
+}
+
+// ACMEIssuerDNS01ProviderWebhookGRPC configures a webhook DNS01 provider
+// that is called using the gRPC DNS01 solver protocol.
+type ACMEIssuerDNS01ProviderWebhookGRPC struct {
+	// Address of the gRPC endpoint serving the DNS01Solver service, in the
+	// form host:port.
+	Address string
+
+	// PEM encoded CA bundle used to verify the endpoint's serving certificate.
+	// If not set, the system trust roots are used.
+	CABundle []byte
+
+	// ServerName is the name used to verify the endpoint's serving
+	// certificate. If not set, the host part of the address is used.
+	ServerName string
+
+	// Insecure disables TLS when connecting to the endpoint. This should only
+	// be used when the endpoint is only reachable from within the
+	// cert-manager pod, for example a sidecar listening on localhost.
+	Insecure bool
 }
 
 type ACMEIssuerStatus struct {
@@ -522,4 +947,11 @@ type ACMEIssuerStatus struct {
 	// ACME account, in order to track changes made to registered account
 	// associated with the  Issuer
 	LastRegisteredEmail string
+
+	// LastRegisteredEABKeyID is the key ID of the External Account Binding
+	// that the ACME account was last registered with. When the key ID in
+	// spec.acme.externalAccountBinding changes, the account is registered
+	// again using the new credentials. As the account private key is kept,
+	// the ACME server returns the existing account and its orders.
+	LastRegisteredEABKeyID string
 }
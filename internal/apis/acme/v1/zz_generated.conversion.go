@@ -44,6 +44,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEAdditionalAccount)(nil), (*acme.ACMEAdditionalAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(a.(*v1.ACMEAdditionalAccount), b.(*acme.ACMEAdditionalAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAdditionalAccount)(nil), (*v1.ACMEAdditionalAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount(a.(*acme.ACMEAdditionalAccount), b.(*v1.ACMEAdditionalAccount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEAuthorization)(nil), (*acme.ACMEAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(a.(*v1.ACMEAuthorization), b.(*acme.ACMEAuthorization), scope)
 	}); err != nil {
@@ -84,6 +94,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverDNS01CheckNameservers)(nil), (*acme.ACMEChallengeSolverDNS01CheckNameservers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(a.(*v1.ACMEChallengeSolverDNS01CheckNameservers), b.(*acme.ACMEChallengeSolverDNS01CheckNameservers), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverDNS01CheckNameservers)(nil), (*v1.ACMEChallengeSolverDNS01CheckNameservers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1_ACMEChallengeSolverDNS01CheckNameservers(a.(*acme.ACMEChallengeSolverDNS01CheckNameservers), b.(*v1.ACMEChallengeSolverDNS01CheckNameservers), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01)(nil), (*acme.ACMEChallengeSolverHTTP01)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(a.(*v1.ACMEChallengeSolverHTTP01), b.(*acme.ACMEChallengeSolverHTTP01), scope)
 	}); err != nil {
@@ -164,6 +184,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), (*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(a.(*v1.ACMEChallengeSolverHTTP01IstioVirtualService), b.(*acme.ACMEChallengeSolverHTTP01IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), (*v1.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1_ACMEChallengeSolverHTTP01IstioVirtualService(a.(*acme.ACMEChallengeSolverHTTP01IstioVirtualService), b.(*v1.ACMEChallengeSolverHTTP01IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*v1.ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -194,6 +224,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderAliDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAliDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(a.(*v1.ACMEIssuerDNS01ProviderAliDNS), b.(*acme.ACMEIssuerDNS01ProviderAliDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderAliDNS)(nil), (*v1.ACMEIssuerDNS01ProviderAliDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS(a.(*acme.ACMEIssuerDNS01ProviderAliDNS), b.(*v1.ACMEIssuerDNS01ProviderAliDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderAzureDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAzureDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01ProviderAzureDNS(a.(*v1.ACMEIssuerDNS01ProviderAzureDNS), b.(*acme.ACMEIssuerDNS01ProviderAzureDNS), scope)
 	}); err != nil {
@@ -224,6 +264,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderCoreDNS)(nil), (*acme.ACMEIssuerDNS01ProviderCoreDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(a.(*v1.ACMEIssuerDNS01ProviderCoreDNS), b.(*acme.ACMEIssuerDNS01ProviderCoreDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderCoreDNS)(nil), (*v1.ACMEIssuerDNS01ProviderCoreDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1_ACMEIssuerDNS01ProviderCoreDNS(a.(*acme.ACMEIssuerDNS01ProviderCoreDNS), b.(*v1.ACMEIssuerDNS01ProviderCoreDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderDNSimple)(nil), (*acme.ACMEIssuerDNS01ProviderDNSimple)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(a.(*v1.ACMEIssuerDNS01ProviderDNSimple), b.(*acme.ACMEIssuerDNS01ProviderDNSimple), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderDNSimple)(nil), (*v1.ACMEIssuerDNS01ProviderDNSimple)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple(a.(*acme.ACMEIssuerDNS01ProviderDNSimple), b.(*v1.ACMEIssuerDNS01ProviderDNSimple), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderDigitalOcean)(nil), (*acme.ACMEIssuerDNS01ProviderDigitalOcean)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(a.(*v1.ACMEIssuerDNS01ProviderDigitalOcean), b.(*acme.ACMEIssuerDNS01ProviderDigitalOcean), scope)
 	}); err != nil {
@@ -234,6 +294,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderGandi)(nil), (*acme.ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(a.(*v1.ACMEIssuerDNS01ProviderGandi), b.(*acme.ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderGandi)(nil), (*v1.ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi(a.(*acme.ACMEIssuerDNS01ProviderGandi), b.(*v1.ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderIBMCIS)(nil), (*acme.ACMEIssuerDNS01ProviderIBMCIS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(a.(*v1.ACMEIssuerDNS01ProviderIBMCIS), b.(*acme.ACMEIssuerDNS01ProviderIBMCIS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderIBMCIS)(nil), (*v1.ACMEIssuerDNS01ProviderIBMCIS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS(a.(*acme.ACMEIssuerDNS01ProviderIBMCIS), b.(*v1.ACMEIssuerDNS01ProviderIBMCIS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderLinode)(nil), (*acme.ACMEIssuerDNS01ProviderLinode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(a.(*v1.ACMEIssuerDNS01ProviderLinode), b.(*acme.ACMEIssuerDNS01ProviderLinode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderLinode)(nil), (*v1.ACMEIssuerDNS01ProviderLinode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode(a.(*acme.ACMEIssuerDNS01ProviderLinode), b.(*v1.ACMEIssuerDNS01ProviderLinode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderNS1)(nil), (*acme.ACMEIssuerDNS01ProviderNS1)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(a.(*v1.ACMEIssuerDNS01ProviderNS1), b.(*acme.ACMEIssuerDNS01ProviderNS1), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderNS1)(nil), (*v1.ACMEIssuerDNS01ProviderNS1)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1(a.(*acme.ACMEIssuerDNS01ProviderNS1), b.(*v1.ACMEIssuerDNS01ProviderNS1), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderOCI)(nil), (*acme.ACMEIssuerDNS01ProviderOCI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(a.(*v1.ACMEIssuerDNS01ProviderOCI), b.(*acme.ACMEIssuerDNS01ProviderOCI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderOCI)(nil), (*v1.ACMEIssuerDNS01ProviderOCI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI(a.(*acme.ACMEIssuerDNS01ProviderOCI), b.(*v1.ACMEIssuerDNS01ProviderOCI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), (*acme.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(a.(*v1.ACMEIssuerDNS01ProviderOCIAPIKey), b.(*acme.ACMEIssuerDNS01ProviderOCIAPIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), (*v1.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey(a.(*acme.ACMEIssuerDNS01ProviderOCIAPIKey), b.(*v1.ACMEIssuerDNS01ProviderOCIAPIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderPowerDNS)(nil), (*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(a.(*v1.ACMEIssuerDNS01ProviderPowerDNS), b.(*acme.ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), (*v1.ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(a.(*acme.ACMEIssuerDNS01ProviderPowerDNS), b.(*v1.ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*v1.ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -244,6 +374,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderRoute53)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(a.(*v1.ACMEIssuerDNS01ProviderRoute53), b.(*acme.ACMEIssuerDNS01ProviderRoute53), scope)
 	}); err != nil {
@@ -254,6 +394,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(a.(*v1.ACMEIssuerDNS01ProviderRoute53AssumeRole), b.(*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), (*v1.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole(a.(*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole), b.(*v1.ACMEIssuerDNS01ProviderRoute53AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderWebhook)(nil), (*acme.ACMEIssuerDNS01ProviderWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerDNS01ProviderWebhook_To_acme_ACMEIssuerDNS01ProviderWebhook(a.(*v1.ACMEIssuerDNS01ProviderWebhook), b.(*acme.ACMEIssuerDNS01ProviderWebhook), scope)
 	}); err != nil {
@@ -264,6 +414,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), (*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(a.(*v1.ACMEIssuerDNS01ProviderWebhookGRPC), b.(*acme.ACMEIssuerDNS01ProviderWebhookGRPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), (*v1.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1_ACMEIssuerDNS01ProviderWebhookGRPC(a.(*acme.ACMEIssuerDNS01ProviderWebhookGRPC), b.(*v1.ACMEIssuerDNS01ProviderWebhookGRPC), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.ACMEIssuerStatus)(nil), (*acme.ACMEIssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(a.(*v1.ACMEIssuerStatus), b.(*acme.ACMEIssuerStatus), scope)
 	}); err != nil {
@@ -274,6 +434,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.ACMEURLRewrite)(nil), (*acme.ACMEURLRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_ACMEURLRewrite_To_acme_ACMEURLRewrite(a.(*v1.ACMEURLRewrite), b.(*acme.ACMEURLRewrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEURLRewrite)(nil), (*v1.ACMEURLRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEURLRewrite_To_v1_ACMEURLRewrite(a.(*acme.ACMEURLRewrite), b.(*v1.ACMEURLRewrite), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.AzureManagedIdentity)(nil), (*acme.AzureManagedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_AzureManagedIdentity_To_acme_AzureManagedIdentity(a.(*v1.AzureManagedIdentity), b.(*acme.AzureManagedIdentity), scope)
 	}); err != nil {
@@ -284,6 +454,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.AzureServiceAccountRef)(nil), (*acme.AzureServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(a.(*v1.AzureServiceAccountRef), b.(*acme.AzureServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.AzureServiceAccountRef)(nil), (*v1.AzureServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_AzureServiceAccountRef_To_v1_AzureServiceAccountRef(a.(*acme.AzureServiceAccountRef), b.(*v1.AzureServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateDNSNameSelector)(nil), (*acme.CertificateDNSNameSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelector(a.(*v1.CertificateDNSNameSelector), b.(*acme.CertificateDNSNameSelector), scope)
 	}); err != nil {
@@ -387,11 +567,39 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in *v1.ACMEAdditionalAccount, out *acme.ACMEAdditionalAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount is an autogenerated conversion function.
+func Convert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in *v1.ACMEAdditionalAccount, out *acme.ACMEAdditionalAccount, s conversion.Scope) error {
+	return autoConvert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in, out, s)
+}
+
+func autoConvert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount(in *acme.ACMEAdditionalAccount, out *v1.ACMEAdditionalAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount is an autogenerated conversion function.
+func Convert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount(in *acme.ACMEAdditionalAccount, out *v1.ACMEAdditionalAccount, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount(in, out, s)
+}
+
 func autoConvert_v1_ACMEAuthorization_To_acme_ACMEAuthorization(in *v1.ACMEAuthorization, out *acme.ACMEAuthorization, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = acme.State(in.InitialState)
+	out.Expires = (*pkgapismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.ReusedFrom = in.ReusedFrom
 	out.Challenges = *(*[]acme.ACMEChallenge)(unsafe.Pointer(&in.Challenges))
 	return nil
 }
@@ -406,6 +614,8 @@ func autoConvert_acme_ACMEAuthorization_To_v1_ACMEAuthorization(in *acme.ACMEAut
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = v1.State(in.InitialState)
+	out.Expires = (*pkgapismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.ReusedFrom = in.ReusedFrom
 	out.Challenges = *(*[]v1.ACMEChallenge)(unsafe.Pointer(&in.Challenges))
 	return nil
 }
@@ -481,6 +691,7 @@ func Convert_acme_ACMEChallengeSolver_To_v1_ACMEChallengeSolver(in *acme.ACMECha
 
 func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1.ACMEChallengeSolverDNS01, out *acme.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	out.CheckNameservers = (*acme.ACMEChallengeSolverDNS01CheckNameservers)(unsafe.Pointer(in.CheckNameservers))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acme.ACMEIssuerDNS01ProviderAkamai)
@@ -553,6 +764,79 @@ func autoConvert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in
 	} else {
 		out.RFC2136 = nil
 	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(acme.ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Linode != nil {
+		in, out := &in.Linode, &out.Linode
+		*out = new(acme.ACMEIssuerDNS01ProviderLinode)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Linode = nil
+	}
+	if in.NS1 != nil {
+		in, out := &in.NS1, &out.NS1
+		*out = new(acme.ACMEIssuerDNS01ProviderNS1)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NS1 = nil
+	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(acme.ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
+	out.CoreDNS = (*acme.ACMEIssuerDNS01ProviderCoreDNS)(unsafe.Pointer(in.CoreDNS))
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(acme.ACMEIssuerDNS01ProviderOCI)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCI = nil
+	}
+	if in.AliDNS != nil {
+		in, out := &in.AliDNS, &out.AliDNS
+		*out = new(acme.ACMEIssuerDNS01ProviderAliDNS)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AliDNS = nil
+	}
+	if in.IBMCIS != nil {
+		in, out := &in.IBMCIS, &out.IBMCIS
+		*out = new(acme.ACMEIssuerDNS01ProviderIBMCIS)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IBMCIS = nil
+	}
+	if in.DNSimple != nil {
+		in, out := &in.DNSimple, &out.DNSimple
+		*out = new(acme.ACMEIssuerDNS01ProviderDNSimple)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DNSimple = nil
+	}
 	out.Webhook = (*acme.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -564,6 +848,7 @@ func Convert_v1_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *v1
 
 func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *acme.ACMEChallengeSolverDNS01, out *v1.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = v1.CNAMEStrategy(in.CNAMEStrategy)
+	out.CheckNameservers = (*v1.ACMEChallengeSolverDNS01CheckNameservers)(unsafe.Pointer(in.CheckNameservers))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(v1.ACMEIssuerDNS01ProviderAkamai)
@@ -636,6 +921,79 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in
 	} else {
 		out.RFC2136 = nil
 	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(v1.ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Linode != nil {
+		in, out := &in.Linode, &out.Linode
+		*out = new(v1.ACMEIssuerDNS01ProviderLinode)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Linode = nil
+	}
+	if in.NS1 != nil {
+		in, out := &in.NS1, &out.NS1
+		*out = new(v1.ACMEIssuerDNS01ProviderNS1)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NS1 = nil
+	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(v1.ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
+	out.CoreDNS = (*v1.ACMEIssuerDNS01ProviderCoreDNS)(unsafe.Pointer(in.CoreDNS))
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(v1.ACMEIssuerDNS01ProviderOCI)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCI = nil
+	}
+	if in.AliDNS != nil {
+		in, out := &in.AliDNS, &out.AliDNS
+		*out = new(v1.ACMEIssuerDNS01ProviderAliDNS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AliDNS = nil
+	}
+	if in.IBMCIS != nil {
+		in, out := &in.IBMCIS, &out.IBMCIS
+		*out = new(v1.ACMEIssuerDNS01ProviderIBMCIS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IBMCIS = nil
+	}
+	if in.DNSimple != nil {
+		in, out := &in.DNSimple, &out.DNSimple
+		*out = new(v1.ACMEIssuerDNS01ProviderDNSimple)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DNSimple = nil
+	}
 	out.Webhook = (*v1.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -645,9 +1003,32 @@ func Convert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in *ac
 	return autoConvert_acme_ACMEChallengeSolverDNS01_To_v1_ACMEChallengeSolverDNS01(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in *v1.ACMEChallengeSolverDNS01CheckNameservers, out *acme.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.RecursiveOnly = (*bool)(unsafe.Pointer(in.RecursiveOnly))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in *v1.ACMEChallengeSolverDNS01CheckNameservers, out *acme.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1_ACMEChallengeSolverDNS01CheckNameservers(in *acme.ACMEChallengeSolverDNS01CheckNameservers, out *v1.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.RecursiveOnly = (*bool)(unsafe.Pointer(in.RecursiveOnly))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1_ACMEChallengeSolverDNS01CheckNameservers is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1_ACMEChallengeSolverDNS01CheckNameservers(in *acme.ACMEChallengeSolverDNS01CheckNameservers, out *v1.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1_ACMEChallengeSolverDNS01CheckNameservers(in, out, s)
+}
+
 func autoConvert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *v1.ACMEChallengeSolverHTTP01, out *acme.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*acme.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.IstioVirtualService = (*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(unsafe.Pointer(in.IstioVirtualService))
 	return nil
 }
 
@@ -659,6 +1040,7 @@ func Convert_v1_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *
 func autoConvert_acme_ACMEChallengeSolverHTTP01_To_v1_ACMEChallengeSolverHTTP01(in *acme.ACMEChallengeSolverHTTP01, out *v1.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*v1.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*v1.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.IstioVirtualService = (*v1.ACMEChallengeSolverHTTP01IstioVirtualService)(unsafe.Pointer(in.IstioVirtualService))
 	return nil
 }
 
@@ -670,6 +1052,7 @@ func Convert_acme_ACMEChallengeSolverHTTP01_To_v1_ACMEChallengeSolverHTTP01(in *
 func autoConvert_v1_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute(in *v1.ACMEChallengeSolverHTTP01GatewayHTTPRoute, out *acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute, s conversion.Scope) error {
 	out.ServiceType = corev1.ServiceType(in.ServiceType)
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ParentRefs = *(*[]v1alpha2.ParentRef)(unsafe.Pointer(&in.ParentRefs))
 	return nil
 }
@@ -682,6 +1065,7 @@ func Convert_v1_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_acme_ACMEChallengeS
 func autoConvert_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_v1_ACMEChallengeSolverHTTP01GatewayHTTPRoute(in *acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute, out *v1.ACMEChallengeSolverHTTP01GatewayHTTPRoute, s conversion.Scope) error {
 	out.ServiceType = corev1.ServiceType(in.ServiceType)
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ParentRefs = *(*[]v1alpha2.ParentRef)(unsafe.Pointer(&in.ParentRefs))
 	return nil
 }
@@ -694,6 +1078,7 @@ func Convert_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_v1_ACMEChallengeS
 func autoConvert_v1_ACMEChallengeSolverHTTP01Ingress_To_acme_ACMEChallengeSolverHTTP01Ingress(in *v1.ACMEChallengeSolverHTTP01Ingress, out *acme.ACMEChallengeSolverHTTP01Ingress, s conversion.Scope) error {
 	out.ServiceType = corev1.ServiceType(in.ServiceType)
 	out.Class = (*string)(unsafe.Pointer(in.Class))
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
 	out.Name = in.Name
 	out.PodTemplate = (*acme.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*acme.ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
@@ -708,6 +1093,7 @@ func Convert_v1_ACMEChallengeSolverHTTP01Ingress_To_acme_ACMEChallengeSolverHTTP
 func autoConvert_acme_ACMEChallengeSolverHTTP01Ingress_To_v1_ACMEChallengeSolverHTTP01Ingress(in *acme.ACMEChallengeSolverHTTP01Ingress, out *v1.ACMEChallengeSolverHTTP01Ingress, s conversion.Scope) error {
 	out.ServiceType = corev1.ServiceType(in.ServiceType)
 	out.Class = (*string)(unsafe.Pointer(in.Class))
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
 	out.Name = in.Name
 	out.PodTemplate = (*v1.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*v1.ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
@@ -769,6 +1155,11 @@ func autoConvert_v1_ACMEChallengeSolverHTTP01IngressPodSpec_To_acme_ACMEChalleng
 	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.PriorityClassName = in.PriorityClassName
 	out.ServiceAccountName = in.ServiceAccountName
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*corev1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.TopologySpreadConstraints = *(*[]corev1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.RuntimeClassName = in.RuntimeClassName
+	out.ImagePullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.ImagePullSecrets))
 	return nil
 }
 
@@ -783,6 +1174,11 @@ func autoConvert_acme_ACMEChallengeSolverHTTP01IngressPodSpec_To_v1_ACMEChalleng
 	out.Tolerations = *(*[]corev1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.PriorityClassName = in.PriorityClassName
 	out.ServiceAccountName = in.ServiceAccountName
+	out.Resources = (*corev1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*corev1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.TopologySpreadConstraints = *(*[]corev1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.RuntimeClassName = in.RuntimeClassName
+	out.ImagePullSecrets = *(*[]corev1.LocalObjectReference)(unsafe.Pointer(&in.ImagePullSecrets))
 	return nil
 }
 
@@ -845,6 +1241,32 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSo
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in *v1.ACMEChallengeSolverHTTP01IstioVirtualService, out *acme.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.Gateways = *(*[]string)(unsafe.Pointer(&in.Gateways))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService is an autogenerated conversion function.
+func Convert_v1_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in *v1.ACMEChallengeSolverHTTP01IstioVirtualService, out *acme.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_v1_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1_ACMEChallengeSolverHTTP01IstioVirtualService(in *acme.ACMEChallengeSolverHTTP01IstioVirtualService, out *v1.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	out.ServiceType = corev1.ServiceType(in.ServiceType)
+	out.Gateways = *(*[]string)(unsafe.Pointer(&in.Gateways))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1_ACMEChallengeSolverHTTP01IstioVirtualService is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1_ACMEChallengeSolverHTTP01IstioVirtualService(in *acme.ACMEChallengeSolverHTTP01IstioVirtualService, out *v1.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1_ACMEChallengeSolverHTTP01IstioVirtualService(in, out, s)
+}
+
 func autoConvert_v1_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *v1.ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
@@ -876,6 +1298,7 @@ func Convert_acme_ACMEExternalAccountBinding_To_v1_ACMEExternalAccountBinding(in
 func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	out.URLRewrites = *(*[]acme.ACMEURLRewrite)(unsafe.Pointer(&in.URLRewrites))
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -890,6 +1313,17 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.A
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AdditionalAccounts != nil {
+		in, out := &in.AdditionalAccounts, &out.AdditionalAccounts
+		*out = make([]acme.ACMEAdditionalAccount, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalAccounts = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]acme.ACMEChallengeSolver, len(*in))
@@ -903,12 +1337,14 @@ func autoConvert_v1_ACMEIssuer_To_acme_ACMEIssuer(in *v1.ACMEIssuer, out *acme.A
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.EnableCAAPreflightCheck = in.EnableCAAPreflightCheck
 	return nil
 }
 
 func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	out.URLRewrites = *(*[]v1.ACMEURLRewrite)(unsafe.Pointer(&in.URLRewrites))
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -923,6 +1359,17 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.A
 	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AdditionalAccounts != nil {
+		in, out := &in.AdditionalAccounts, &out.AdditionalAccounts
+		*out = make([]v1.ACMEAdditionalAccount, len(*in))
+		for i := range *in {
+			if err := Convert_acme_ACMEAdditionalAccount_To_v1_ACMEAdditionalAccount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalAccounts = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]v1.ACMEChallengeSolver, len(*in))
@@ -936,6 +1383,7 @@ func autoConvert_acme_ACMEIssuer_To_v1_ACMEIssuer(in *acme.ACMEIssuer, out *v1.A
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.EnableCAAPreflightCheck = in.EnableCAAPreflightCheck
 	return nil
 }
 
@@ -1003,6 +1451,50 @@ func Convert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1_ACMEIssuerDNS01ProviderAka
 	return autoConvert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1_ACMEIssuerDNS01ProviderAkamai(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in *v1.ACMEIssuerDNS01ProviderAliDNS, out *acme.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	out.AccessKeyID = in.AccessKeyID
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccessKeySecret = nil
+	}
+	out.RAMRole = in.RAMRole
+	out.RoleARN = in.RoleARN
+	out.RegionID = in.RegionID
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in *v1.ACMEIssuerDNS01ProviderAliDNS, out *acme.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS(in *acme.ACMEIssuerDNS01ProviderAliDNS, out *v1.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	out.AccessKeyID = in.AccessKeyID
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccessKeySecret = nil
+	}
+	out.RAMRole = in.RAMRole
+	out.RoleARN = in.RoleARN
+	out.RegionID = in.RegionID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS(in *acme.ACMEIssuerDNS01ProviderAliDNS, out *v1.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1_ACMEIssuerDNS01ProviderAliDNS(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01ProviderAzureDNS(in *v1.ACMEIssuerDNS01ProviderAzureDNS, out *acme.ACMEIssuerDNS01ProviderAzureDNS, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	if in.ClientSecret != nil {
@@ -1020,6 +1512,7 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01Provi
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = acme.AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*acme.AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.ServiceAccountRef = (*acme.AzureServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
 	return nil
 }
 
@@ -1045,6 +1538,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1_ACMEIssuerDNS01Provi
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = v1.AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*v1.AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.ServiceAccountRef = (*v1.AzureServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
 	return nil
 }
 
@@ -1149,6 +1643,60 @@ func Convert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01Provide
 	return autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1_ACMEIssuerDNS01ProviderCloudflare(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in *v1.ACMEIssuerDNS01ProviderCoreDNS, out *acme.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapNamespace = in.ConfigMapNamespace
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in *v1.ACMEIssuerDNS01ProviderCoreDNS, out *acme.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1_ACMEIssuerDNS01ProviderCoreDNS(in *acme.ACMEIssuerDNS01ProviderCoreDNS, out *v1.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapNamespace = in.ConfigMapNamespace
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1_ACMEIssuerDNS01ProviderCoreDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1_ACMEIssuerDNS01ProviderCoreDNS(in *acme.ACMEIssuerDNS01ProviderCoreDNS, out *v1.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1_ACMEIssuerDNS01ProviderCoreDNS(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in *v1.ACMEIssuerDNS01ProviderDNSimple, out *acme.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	out.AccountID = in.AccountID
+	out.Sandbox = in.Sandbox
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in *v1.ACMEIssuerDNS01ProviderDNSimple, out *acme.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple(in *acme.ACMEIssuerDNS01ProviderDNSimple, out *v1.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	out.AccountID = in.AccountID
+	out.Sandbox = in.Sandbox
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple(in *acme.ACMEIssuerDNS01ProviderDNSimple, out *v1.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1_ACMEIssuerDNS01ProviderDNSimple(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(in *v1.ACMEIssuerDNS01ProviderDigitalOcean, out *acme.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
@@ -1173,6 +1721,206 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01Provi
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *v1.ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *v1.ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *v1.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *v1.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in *v1.ACMEIssuerDNS01ProviderIBMCIS, out *acme.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	out.CRN = in.CRN
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in *v1.ACMEIssuerDNS01ProviderIBMCIS, out *acme.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS(in *acme.ACMEIssuerDNS01ProviderIBMCIS, out *v1.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	out.CRN = in.CRN
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS(in *acme.ACMEIssuerDNS01ProviderIBMCIS, out *v1.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1_ACMEIssuerDNS01ProviderIBMCIS(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in *v1.ACMEIssuerDNS01ProviderLinode, out *acme.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in *v1.ACMEIssuerDNS01ProviderLinode, out *acme.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode(in *acme.ACMEIssuerDNS01ProviderLinode, out *v1.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode(in *acme.ACMEIssuerDNS01ProviderLinode, out *v1.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderLinode_To_v1_ACMEIssuerDNS01ProviderLinode(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in *v1.ACMEIssuerDNS01ProviderNS1, out *acme.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1 is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in *v1.ACMEIssuerDNS01ProviderNS1, out *acme.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1(in *acme.ACMEIssuerDNS01ProviderNS1, out *v1.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1 is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1(in *acme.ACMEIssuerDNS01ProviderNS1, out *v1.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderNS1_To_v1_ACMEIssuerDNS01ProviderNS1(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in *v1.ACMEIssuerDNS01ProviderOCI, out *acme.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	out.Region = in.Region
+	out.CompartmentOCID = in.CompartmentOCID
+	out.AuthType = in.AuthType
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(acme.ACMEIssuerDNS01ProviderOCIAPIKey)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APIKey = nil
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in *v1.ACMEIssuerDNS01ProviderOCI, out *acme.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI(in *acme.ACMEIssuerDNS01ProviderOCI, out *v1.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	out.Region = in.Region
+	out.CompartmentOCID = in.CompartmentOCID
+	out.AuthType = in.AuthType
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(v1.ACMEIssuerDNS01ProviderOCIAPIKey)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APIKey = nil
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI(in *acme.ACMEIssuerDNS01ProviderOCI, out *v1.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderOCI_To_v1_ACMEIssuerDNS01ProviderOCI(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in *v1.ACMEIssuerDNS01ProviderOCIAPIKey, out *acme.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	out.TenancyOCID = in.TenancyOCID
+	out.UserOCID = in.UserOCID
+	out.Fingerprint = in.Fingerprint
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in *v1.ACMEIssuerDNS01ProviderOCIAPIKey, out *acme.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey(in *acme.ACMEIssuerDNS01ProviderOCIAPIKey, out *v1.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	out.TenancyOCID = in.TenancyOCID
+	out.UserOCID = in.UserOCID
+	out.Fingerprint = in.Fingerprint
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey(in *acme.ACMEIssuerDNS01ProviderOCIAPIKey, out *v1.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1_ACMEIssuerDNS01ProviderOCIAPIKey(in, out, s)
+}
+
+func autoConvert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *v1.ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.ServerID = in.ServerID
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *v1.ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *v1.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.ServerID = in.ServerID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *v1.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *v1.ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
@@ -1180,6 +1928,15 @@ func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01Provid
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
 	return nil
 }
 
@@ -1195,6 +1952,15 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01Provid
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
 	return nil
 }
 
@@ -1203,12 +1969,43 @@ func Convert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRF
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1_ACMEIssuerDNS01ProviderRFC2136(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *v1.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(in *v1.ACMEIssuerDNS01ProviderRoute53, out *acme.ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
 	out.AccessKeyID = in.AccessKeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
+	out.AssumeRoleChain = *(*[]acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
 	return nil
@@ -1225,6 +2022,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01Provid
 		return err
 	}
 	out.Role = in.Role
+	out.AssumeRoleChain = *(*[]v1.ACMEIssuerDNS01ProviderRoute53AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
 	return nil
@@ -1235,10 +2033,33 @@ func Convert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01ProviderRo
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1_ACMEIssuerDNS01ProviderRoute53(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *v1.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *v1.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *v1.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *v1.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1_ACMEIssuerDNS01ProviderRoute53AssumeRole(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerDNS01ProviderWebhook_To_acme_ACMEIssuerDNS01ProviderWebhook(in *v1.ACMEIssuerDNS01ProviderWebhook, out *acme.ACMEIssuerDNS01ProviderWebhook, s conversion.Scope) error {
 	out.GroupName = in.GroupName
 	out.SolverName = in.SolverName
 	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	out.GRPC = (*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(unsafe.Pointer(in.GRPC))
 	return nil
 }
 
@@ -1251,6 +2072,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1_ACMEIssuerDNS01Provid
 	out.GroupName = in.GroupName
 	out.SolverName = in.SolverName
 	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	out.GRPC = (*v1.ACMEIssuerDNS01ProviderWebhookGRPC)(unsafe.Pointer(in.GRPC))
 	return nil
 }
 
@@ -1259,9 +2081,36 @@ func Convert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1_ACMEIssuerDNS01ProviderWe
 	return autoConvert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1_ACMEIssuerDNS01ProviderWebhook(in, out, s)
 }
 
+func autoConvert_v1_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in *v1.ACMEIssuerDNS01ProviderWebhookGRPC, out *acme.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	out.Address = in.Address
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerName = in.ServerName
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_v1_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC is an autogenerated conversion function.
+func Convert_v1_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in *v1.ACMEIssuerDNS01ProviderWebhookGRPC, out *acme.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	return autoConvert_v1_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1_ACMEIssuerDNS01ProviderWebhookGRPC(in *acme.ACMEIssuerDNS01ProviderWebhookGRPC, out *v1.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	out.Address = in.Address
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerName = in.ServerName
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1_ACMEIssuerDNS01ProviderWebhookGRPC is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1_ACMEIssuerDNS01ProviderWebhookGRPC(in *acme.ACMEIssuerDNS01ProviderWebhookGRPC, out *v1.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1_ACMEIssuerDNS01ProviderWebhookGRPC(in, out, s)
+}
+
 func autoConvert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *v1.ACMEIssuerStatus, out *acme.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredEABKeyID = in.LastRegisteredEABKeyID
 	return nil
 }
 
@@ -1273,6 +2122,7 @@ func Convert_v1_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *v1.ACMEIssuerStatu
 func autoConvert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in *acme.ACMEIssuerStatus, out *v1.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredEABKeyID = in.LastRegisteredEABKeyID
 	return nil
 }
 
@@ -1281,6 +2131,28 @@ func Convert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in *acme.ACMEIssuerSta
 	return autoConvert_acme_ACMEIssuerStatus_To_v1_ACMEIssuerStatus(in, out, s)
 }
 
+func autoConvert_v1_ACMEURLRewrite_To_acme_ACMEURLRewrite(in *v1.ACMEURLRewrite, out *acme.ACMEURLRewrite, s conversion.Scope) error {
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_v1_ACMEURLRewrite_To_acme_ACMEURLRewrite is an autogenerated conversion function.
+func Convert_v1_ACMEURLRewrite_To_acme_ACMEURLRewrite(in *v1.ACMEURLRewrite, out *acme.ACMEURLRewrite, s conversion.Scope) error {
+	return autoConvert_v1_ACMEURLRewrite_To_acme_ACMEURLRewrite(in, out, s)
+}
+
+func autoConvert_acme_ACMEURLRewrite_To_v1_ACMEURLRewrite(in *acme.ACMEURLRewrite, out *v1.ACMEURLRewrite, s conversion.Scope) error {
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_acme_ACMEURLRewrite_To_v1_ACMEURLRewrite is an autogenerated conversion function.
+func Convert_acme_ACMEURLRewrite_To_v1_ACMEURLRewrite(in *acme.ACMEURLRewrite, out *v1.ACMEURLRewrite, s conversion.Scope) error {
+	return autoConvert_acme_ACMEURLRewrite_To_v1_ACMEURLRewrite(in, out, s)
+}
+
 func autoConvert_v1_AzureManagedIdentity_To_acme_AzureManagedIdentity(in *v1.AzureManagedIdentity, out *acme.AzureManagedIdentity, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	out.ResourceID = in.ResourceID
@@ -1303,6 +2175,28 @@ func Convert_acme_AzureManagedIdentity_To_v1_AzureManagedIdentity(in *acme.Azure
 	return autoConvert_acme_AzureManagedIdentity_To_v1_AzureManagedIdentity(in, out, s)
 }
 
+func autoConvert_v1_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in *v1.AzureServiceAccountRef, out *acme.AzureServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_v1_AzureServiceAccountRef_To_acme_AzureServiceAccountRef is an autogenerated conversion function.
+func Convert_v1_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in *v1.AzureServiceAccountRef, out *acme.AzureServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_v1_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in, out, s)
+}
+
+func autoConvert_acme_AzureServiceAccountRef_To_v1_AzureServiceAccountRef(in *acme.AzureServiceAccountRef, out *v1.AzureServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_acme_AzureServiceAccountRef_To_v1_AzureServiceAccountRef is an autogenerated conversion function.
+func Convert_acme_AzureServiceAccountRef_To_v1_AzureServiceAccountRef(in *acme.AzureServiceAccountRef, out *v1.AzureServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_acme_AzureServiceAccountRef_To_v1_AzureServiceAccountRef(in, out, s)
+}
+
 func autoConvert_v1_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelector(in *v1.CertificateDNSNameSelector, out *acme.CertificateDNSNameSelector, s conversion.Scope) error {
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
@@ -1450,6 +2344,7 @@ func autoConvert_v1_ChallengeStatus_To_acme_ChallengeStatus(in *v1.ChallengeStat
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1463,6 +2358,7 @@ func autoConvert_acme_ChallengeStatus_To_v1_ChallengeStatus(in *acme.ChallengeSt
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = v1.State(in.State)
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1587,6 +2483,7 @@ func autoConvert_v1_OrderStatus_To_acme_OrderStatus(in *v1.OrderStatus, out *acm
 	out.State = acme.State(in.State)
 	out.Reason = in.Reason
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1603,6 +2500,7 @@ func autoConvert_acme_OrderStatus_To_v1_OrderStatus(in *acme.OrderStatus, out *v
 	out.Reason = in.Reason
 	out.Authorizations = *(*[]v1.ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -43,6 +43,16 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*ACMEAdditionalAccount)(nil), (*acme.ACMEAdditionalAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(a.(*ACMEAdditionalAccount), b.(*acme.ACMEAdditionalAccount), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEAdditionalAccount)(nil), (*ACMEAdditionalAccount)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount(a.(*acme.ACMEAdditionalAccount), b.(*ACMEAdditionalAccount), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEAuthorization)(nil), (*acme.ACMEAuthorization)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEAuthorization_To_acme_ACMEAuthorization(a.(*ACMEAuthorization), b.(*acme.ACMEAuthorization), scope)
 	}); err != nil {
@@ -83,6 +93,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEChallengeSolverDNS01CheckNameservers)(nil), (*acme.ACMEChallengeSolverDNS01CheckNameservers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(a.(*ACMEChallengeSolverDNS01CheckNameservers), b.(*acme.ACMEChallengeSolverDNS01CheckNameservers), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverDNS01CheckNameservers)(nil), (*ACMEChallengeSolverDNS01CheckNameservers)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers(a.(*acme.ACMEChallengeSolverDNS01CheckNameservers), b.(*ACMEChallengeSolverDNS01CheckNameservers), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEChallengeSolverHTTP01)(nil), (*acme.ACMEChallengeSolverHTTP01)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(a.(*ACMEChallengeSolverHTTP01), b.(*acme.ACMEChallengeSolverHTTP01), scope)
 	}); err != nil {
@@ -163,6 +183,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEChallengeSolverHTTP01IstioVirtualService)(nil), (*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(a.(*ACMEChallengeSolverHTTP01IstioVirtualService), b.(*acme.ACMEChallengeSolverHTTP01IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(nil), (*ACMEChallengeSolverHTTP01IstioVirtualService)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService(a.(*acme.ACMEChallengeSolverHTTP01IstioVirtualService), b.(*ACMEChallengeSolverHTTP01IstioVirtualService), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEExternalAccountBinding)(nil), (*acme.ACMEExternalAccountBinding)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(a.(*ACMEExternalAccountBinding), b.(*acme.ACMEExternalAccountBinding), scope)
 	}); err != nil {
@@ -193,6 +223,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderAliDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAliDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(a.(*ACMEIssuerDNS01ProviderAliDNS), b.(*acme.ACMEIssuerDNS01ProviderAliDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderAliDNS)(nil), (*ACMEIssuerDNS01ProviderAliDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS(a.(*acme.ACMEIssuerDNS01ProviderAliDNS), b.(*ACMEIssuerDNS01ProviderAliDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderAzureDNS)(nil), (*acme.ACMEIssuerDNS01ProviderAzureDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01ProviderAzureDNS(a.(*ACMEIssuerDNS01ProviderAzureDNS), b.(*acme.ACMEIssuerDNS01ProviderAzureDNS), scope)
 	}); err != nil {
@@ -223,6 +263,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderCoreDNS)(nil), (*acme.ACMEIssuerDNS01ProviderCoreDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(a.(*ACMEIssuerDNS01ProviderCoreDNS), b.(*acme.ACMEIssuerDNS01ProviderCoreDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderCoreDNS)(nil), (*ACMEIssuerDNS01ProviderCoreDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS(a.(*acme.ACMEIssuerDNS01ProviderCoreDNS), b.(*ACMEIssuerDNS01ProviderCoreDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderDNSimple)(nil), (*acme.ACMEIssuerDNS01ProviderDNSimple)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(a.(*ACMEIssuerDNS01ProviderDNSimple), b.(*acme.ACMEIssuerDNS01ProviderDNSimple), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderDNSimple)(nil), (*ACMEIssuerDNS01ProviderDNSimple)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple(a.(*acme.ACMEIssuerDNS01ProviderDNSimple), b.(*ACMEIssuerDNS01ProviderDNSimple), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderDigitalOcean)(nil), (*acme.ACMEIssuerDNS01ProviderDigitalOcean)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(a.(*ACMEIssuerDNS01ProviderDigitalOcean), b.(*acme.ACMEIssuerDNS01ProviderDigitalOcean), scope)
 	}); err != nil {
@@ -233,6 +293,76 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderGandi)(nil), (*acme.ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(a.(*ACMEIssuerDNS01ProviderGandi), b.(*acme.ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderGandi)(nil), (*ACMEIssuerDNS01ProviderGandi)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi(a.(*acme.ACMEIssuerDNS01ProviderGandi), b.(*ACMEIssuerDNS01ProviderGandi), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderIBMCIS)(nil), (*acme.ACMEIssuerDNS01ProviderIBMCIS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(a.(*ACMEIssuerDNS01ProviderIBMCIS), b.(*acme.ACMEIssuerDNS01ProviderIBMCIS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderIBMCIS)(nil), (*ACMEIssuerDNS01ProviderIBMCIS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS(a.(*acme.ACMEIssuerDNS01ProviderIBMCIS), b.(*ACMEIssuerDNS01ProviderIBMCIS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderLinode)(nil), (*acme.ACMEIssuerDNS01ProviderLinode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(a.(*ACMEIssuerDNS01ProviderLinode), b.(*acme.ACMEIssuerDNS01ProviderLinode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderLinode)(nil), (*ACMEIssuerDNS01ProviderLinode)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode(a.(*acme.ACMEIssuerDNS01ProviderLinode), b.(*ACMEIssuerDNS01ProviderLinode), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderNS1)(nil), (*acme.ACMEIssuerDNS01ProviderNS1)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(a.(*ACMEIssuerDNS01ProviderNS1), b.(*acme.ACMEIssuerDNS01ProviderNS1), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderNS1)(nil), (*ACMEIssuerDNS01ProviderNS1)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1(a.(*acme.ACMEIssuerDNS01ProviderNS1), b.(*ACMEIssuerDNS01ProviderNS1), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderOCI)(nil), (*acme.ACMEIssuerDNS01ProviderOCI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(a.(*ACMEIssuerDNS01ProviderOCI), b.(*acme.ACMEIssuerDNS01ProviderOCI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderOCI)(nil), (*ACMEIssuerDNS01ProviderOCI)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI(a.(*acme.ACMEIssuerDNS01ProviderOCI), b.(*ACMEIssuerDNS01ProviderOCI), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderOCIAPIKey)(nil), (*acme.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(a.(*ACMEIssuerDNS01ProviderOCIAPIKey), b.(*acme.ACMEIssuerDNS01ProviderOCIAPIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderOCIAPIKey)(nil), (*ACMEIssuerDNS01ProviderOCIAPIKey)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey(a.(*acme.ACMEIssuerDNS01ProviderOCIAPIKey), b.(*ACMEIssuerDNS01ProviderOCIAPIKey), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderPowerDNS)(nil), (*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(a.(*ACMEIssuerDNS01ProviderPowerDNS), b.(*acme.ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderPowerDNS)(nil), (*ACMEIssuerDNS01ProviderPowerDNS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS(a.(*acme.ACMEIssuerDNS01ProviderPowerDNS), b.(*ACMEIssuerDNS01ProviderPowerDNS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderRFC2136)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(a.(*ACMEIssuerDNS01ProviderRFC2136), b.(*acme.ACMEIssuerDNS01ProviderRFC2136), scope)
 	}); err != nil {
@@ -243,6 +373,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), (*ACMEIssuerDNS01ProviderRFC2136GSSTSIG)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(a.(*acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG), b.(*ACMEIssuerDNS01ProviderRFC2136GSSTSIG), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderRoute53)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(a.(*ACMEIssuerDNS01ProviderRoute53), b.(*acme.ACMEIssuerDNS01ProviderRoute53), scope)
 	}); err != nil {
@@ -253,6 +393,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), (*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(a.(*ACMEIssuerDNS01ProviderRoute53AssumeRole), b.(*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), (*ACMEIssuerDNS01ProviderRoute53AssumeRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole(a.(*acme.ACMEIssuerDNS01ProviderRoute53AssumeRole), b.(*ACMEIssuerDNS01ProviderRoute53AssumeRole), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderWebhook)(nil), (*acme.ACMEIssuerDNS01ProviderWebhook)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerDNS01ProviderWebhook_To_acme_ACMEIssuerDNS01ProviderWebhook(a.(*ACMEIssuerDNS01ProviderWebhook), b.(*acme.ACMEIssuerDNS01ProviderWebhook), scope)
 	}); err != nil {
@@ -263,6 +413,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEIssuerDNS01ProviderWebhookGRPC)(nil), (*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(a.(*ACMEIssuerDNS01ProviderWebhookGRPC), b.(*acme.ACMEIssuerDNS01ProviderWebhookGRPC), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(nil), (*ACMEIssuerDNS01ProviderWebhookGRPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC(a.(*acme.ACMEIssuerDNS01ProviderWebhookGRPC), b.(*ACMEIssuerDNS01ProviderWebhookGRPC), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ACMEIssuerStatus)(nil), (*acme.ACMEIssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(a.(*ACMEIssuerStatus), b.(*acme.ACMEIssuerStatus), scope)
 	}); err != nil {
@@ -273,6 +433,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*ACMEURLRewrite)(nil), (*acme.ACMEURLRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_ACMEURLRewrite_To_acme_ACMEURLRewrite(a.(*ACMEURLRewrite), b.(*acme.ACMEURLRewrite), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.ACMEURLRewrite)(nil), (*ACMEURLRewrite)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_ACMEURLRewrite_To_v1alpha3_ACMEURLRewrite(a.(*acme.ACMEURLRewrite), b.(*ACMEURLRewrite), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*AzureManagedIdentity)(nil), (*acme.AzureManagedIdentity)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_AzureManagedIdentity_To_acme_AzureManagedIdentity(a.(*AzureManagedIdentity), b.(*acme.AzureManagedIdentity), scope)
 	}); err != nil {
@@ -283,6 +453,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*AzureServiceAccountRef)(nil), (*acme.AzureServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha3_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(a.(*AzureServiceAccountRef), b.(*acme.AzureServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*acme.AzureServiceAccountRef)(nil), (*AzureServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_acme_AzureServiceAccountRef_To_v1alpha3_AzureServiceAccountRef(a.(*acme.AzureServiceAccountRef), b.(*AzureServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateDNSNameSelector)(nil), (*acme.CertificateDNSNameSelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha3_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelector(a.(*CertificateDNSNameSelector), b.(*acme.CertificateDNSNameSelector), scope)
 	}); err != nil {
@@ -386,11 +566,39 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
+func autoConvert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in *ACMEAdditionalAccount, out *acme.ACMEAdditionalAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in *ACMEAdditionalAccount, out *acme.ACMEAdditionalAccount, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(in, out, s)
+}
+
+func autoConvert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount(in *acme.ACMEAdditionalAccount, out *ACMEAdditionalAccount, s conversion.Scope) error {
+	out.Email = in.Email
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount is an autogenerated conversion function.
+func Convert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount(in *acme.ACMEAdditionalAccount, out *ACMEAdditionalAccount, s conversion.Scope) error {
+	return autoConvert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEAuthorization_To_acme_ACMEAuthorization(in *ACMEAuthorization, out *acme.ACMEAuthorization, s conversion.Scope) error {
 	out.URL = in.URL
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = acme.State(in.InitialState)
+	out.Expires = (*pkgapismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.ReusedFrom = in.ReusedFrom
 	out.Challenges = *(*[]acme.ACMEChallenge)(unsafe.Pointer(&in.Challenges))
 	return nil
 }
@@ -405,6 +613,8 @@ func autoConvert_acme_ACMEAuthorization_To_v1alpha3_ACMEAuthorization(in *acme.A
 	out.Identifier = in.Identifier
 	out.Wildcard = (*bool)(unsafe.Pointer(in.Wildcard))
 	out.InitialState = State(in.InitialState)
+	out.Expires = (*pkgapismetav1.Time)(unsafe.Pointer(in.Expires))
+	out.ReusedFrom = in.ReusedFrom
 	out.Challenges = *(*[]ACMEChallenge)(unsafe.Pointer(&in.Challenges))
 	return nil
 }
@@ -480,6 +690,7 @@ func Convert_acme_ACMEChallengeSolver_To_v1alpha3_ACMEChallengeSolver(in *acme.A
 
 func autoConvert_v1alpha3_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(in *ACMEChallengeSolverDNS01, out *acme.ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = acme.CNAMEStrategy(in.CNAMEStrategy)
+	out.CheckNameservers = (*acme.ACMEChallengeSolverDNS01CheckNameservers)(unsafe.Pointer(in.CheckNameservers))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(acme.ACMEIssuerDNS01ProviderAkamai)
@@ -552,6 +763,79 @@ func autoConvert_v1alpha3_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDN
 	} else {
 		out.RFC2136 = nil
 	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(acme.ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Linode != nil {
+		in, out := &in.Linode, &out.Linode
+		*out = new(acme.ACMEIssuerDNS01ProviderLinode)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Linode = nil
+	}
+	if in.NS1 != nil {
+		in, out := &in.NS1, &out.NS1
+		*out = new(acme.ACMEIssuerDNS01ProviderNS1)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NS1 = nil
+	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(acme.ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
+	out.CoreDNS = (*acme.ACMEIssuerDNS01ProviderCoreDNS)(unsafe.Pointer(in.CoreDNS))
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(acme.ACMEIssuerDNS01ProviderOCI)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCI = nil
+	}
+	if in.AliDNS != nil {
+		in, out := &in.AliDNS, &out.AliDNS
+		*out = new(acme.ACMEIssuerDNS01ProviderAliDNS)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AliDNS = nil
+	}
+	if in.IBMCIS != nil {
+		in, out := &in.IBMCIS, &out.IBMCIS
+		*out = new(acme.ACMEIssuerDNS01ProviderIBMCIS)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IBMCIS = nil
+	}
+	if in.DNSimple != nil {
+		in, out := &in.DNSimple, &out.DNSimple
+		*out = new(acme.ACMEIssuerDNS01ProviderDNSimple)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DNSimple = nil
+	}
 	out.Webhook = (*acme.ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -563,6 +847,7 @@ func Convert_v1alpha3_ACMEChallengeSolverDNS01_To_acme_ACMEChallengeSolverDNS01(
 
 func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1alpha3_ACMEChallengeSolverDNS01(in *acme.ACMEChallengeSolverDNS01, out *ACMEChallengeSolverDNS01, s conversion.Scope) error {
 	out.CNAMEStrategy = CNAMEStrategy(in.CNAMEStrategy)
+	out.CheckNameservers = (*ACMEChallengeSolverDNS01CheckNameservers)(unsafe.Pointer(in.CheckNameservers))
 	if in.Akamai != nil {
 		in, out := &in.Akamai, &out.Akamai
 		*out = new(ACMEIssuerDNS01ProviderAkamai)
@@ -635,6 +920,79 @@ func autoConvert_acme_ACMEChallengeSolverDNS01_To_v1alpha3_ACMEChallengeSolverDN
 	} else {
 		out.RFC2136 = nil
 	}
+	if in.Gandi != nil {
+		in, out := &in.Gandi, &out.Gandi
+		*out = new(ACMEIssuerDNS01ProviderGandi)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Gandi = nil
+	}
+	if in.Linode != nil {
+		in, out := &in.Linode, &out.Linode
+		*out = new(ACMEIssuerDNS01ProviderLinode)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Linode = nil
+	}
+	if in.NS1 != nil {
+		in, out := &in.NS1, &out.NS1
+		*out = new(ACMEIssuerDNS01ProviderNS1)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NS1 = nil
+	}
+	if in.PowerDNS != nil {
+		in, out := &in.PowerDNS, &out.PowerDNS
+		*out = new(ACMEIssuerDNS01ProviderPowerDNS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PowerDNS = nil
+	}
+	out.CoreDNS = (*ACMEIssuerDNS01ProviderCoreDNS)(unsafe.Pointer(in.CoreDNS))
+	if in.OCI != nil {
+		in, out := &in.OCI, &out.OCI
+		*out = new(ACMEIssuerDNS01ProviderOCI)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCI = nil
+	}
+	if in.AliDNS != nil {
+		in, out := &in.AliDNS, &out.AliDNS
+		*out = new(ACMEIssuerDNS01ProviderAliDNS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AliDNS = nil
+	}
+	if in.IBMCIS != nil {
+		in, out := &in.IBMCIS, &out.IBMCIS
+		*out = new(ACMEIssuerDNS01ProviderIBMCIS)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.IBMCIS = nil
+	}
+	if in.DNSimple != nil {
+		in, out := &in.DNSimple, &out.DNSimple
+		*out = new(ACMEIssuerDNS01ProviderDNSimple)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.DNSimple = nil
+	}
 	out.Webhook = (*ACMEIssuerDNS01ProviderWebhook)(unsafe.Pointer(in.Webhook))
 	return nil
 }
@@ -644,9 +1002,32 @@ func Convert_acme_ACMEChallengeSolverDNS01_To_v1alpha3_ACMEChallengeSolverDNS01(
 	return autoConvert_acme_ACMEChallengeSolverDNS01_To_v1alpha3_ACMEChallengeSolverDNS01(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in *ACMEChallengeSolverDNS01CheckNameservers, out *acme.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.RecursiveOnly = (*bool)(unsafe.Pointer(in.RecursiveOnly))
+	return nil
+}
+
+// Convert_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in *ACMEChallengeSolverDNS01CheckNameservers, out *acme.ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers_To_acme_ACMEChallengeSolverDNS01CheckNameservers(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers(in *acme.ACMEChallengeSolverDNS01CheckNameservers, out *ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	out.Nameservers = *(*[]string)(unsafe.Pointer(&in.Nameservers))
+	out.RecursiveOnly = (*bool)(unsafe.Pointer(in.RecursiveOnly))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers(in *acme.ACMEChallengeSolverDNS01CheckNameservers, out *ACMEChallengeSolverDNS01CheckNameservers, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverDNS01CheckNameservers_To_v1alpha3_ACMEChallengeSolverDNS01CheckNameservers(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP01(in *ACMEChallengeSolverHTTP01, out *acme.ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*acme.ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.IstioVirtualService = (*acme.ACMEChallengeSolverHTTP01IstioVirtualService)(unsafe.Pointer(in.IstioVirtualService))
 	return nil
 }
 
@@ -658,6 +1039,7 @@ func Convert_v1alpha3_ACMEChallengeSolverHTTP01_To_acme_ACMEChallengeSolverHTTP0
 func autoConvert_acme_ACMEChallengeSolverHTTP01_To_v1alpha3_ACMEChallengeSolverHTTP01(in *acme.ACMEChallengeSolverHTTP01, out *ACMEChallengeSolverHTTP01, s conversion.Scope) error {
 	out.Ingress = (*ACMEChallengeSolverHTTP01Ingress)(unsafe.Pointer(in.Ingress))
 	out.GatewayHTTPRoute = (*ACMEChallengeSolverHTTP01GatewayHTTPRoute)(unsafe.Pointer(in.GatewayHTTPRoute))
+	out.IstioVirtualService = (*ACMEChallengeSolverHTTP01IstioVirtualService)(unsafe.Pointer(in.IstioVirtualService))
 	return nil
 }
 
@@ -669,6 +1051,7 @@ func Convert_acme_ACMEChallengeSolverHTTP01_To_v1alpha3_ACMEChallengeSolverHTTP0
 func autoConvert_v1alpha3_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute(in *ACMEChallengeSolverHTTP01GatewayHTTPRoute, out *acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute, s conversion.Scope) error {
 	out.ServiceType = v1.ServiceType(in.ServiceType)
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ParentRefs = *(*[]v1alpha2.ParentRef)(unsafe.Pointer(&in.ParentRefs))
 	return nil
 }
@@ -681,6 +1064,7 @@ func Convert_v1alpha3_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_acme_ACMEChal
 func autoConvert_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_v1alpha3_ACMEChallengeSolverHTTP01GatewayHTTPRoute(in *acme.ACMEChallengeSolverHTTP01GatewayHTTPRoute, out *ACMEChallengeSolverHTTP01GatewayHTTPRoute, s conversion.Scope) error {
 	out.ServiceType = v1.ServiceType(in.ServiceType)
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.ParentRefs = *(*[]v1alpha2.ParentRef)(unsafe.Pointer(&in.ParentRefs))
 	return nil
 }
@@ -693,6 +1077,7 @@ func Convert_acme_ACMEChallengeSolverHTTP01GatewayHTTPRoute_To_v1alpha3_ACMEChal
 func autoConvert_v1alpha3_ACMEChallengeSolverHTTP01Ingress_To_acme_ACMEChallengeSolverHTTP01Ingress(in *ACMEChallengeSolverHTTP01Ingress, out *acme.ACMEChallengeSolverHTTP01Ingress, s conversion.Scope) error {
 	out.ServiceType = v1.ServiceType(in.ServiceType)
 	out.Class = (*string)(unsafe.Pointer(in.Class))
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
 	out.Name = in.Name
 	out.PodTemplate = (*acme.ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*acme.ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
@@ -707,6 +1092,7 @@ func Convert_v1alpha3_ACMEChallengeSolverHTTP01Ingress_To_acme_ACMEChallengeSolv
 func autoConvert_acme_ACMEChallengeSolverHTTP01Ingress_To_v1alpha3_ACMEChallengeSolverHTTP01Ingress(in *acme.ACMEChallengeSolverHTTP01Ingress, out *ACMEChallengeSolverHTTP01Ingress, s conversion.Scope) error {
 	out.ServiceType = v1.ServiceType(in.ServiceType)
 	out.Class = (*string)(unsafe.Pointer(in.Class))
+	out.IngressClassName = (*string)(unsafe.Pointer(in.IngressClassName))
 	out.Name = in.Name
 	out.PodTemplate = (*ACMEChallengeSolverHTTP01IngressPodTemplate)(unsafe.Pointer(in.PodTemplate))
 	out.IngressTemplate = (*ACMEChallengeSolverHTTP01IngressTemplate)(unsafe.Pointer(in.IngressTemplate))
@@ -768,6 +1154,11 @@ func autoConvert_v1alpha3_ACMEChallengeSolverHTTP01IngressPodSpec_To_acme_ACMECh
 	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.PriorityClassName = in.PriorityClassName
 	out.ServiceAccountName = in.ServiceAccountName
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*v1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.TopologySpreadConstraints = *(*[]v1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.RuntimeClassName = in.RuntimeClassName
+	out.ImagePullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.ImagePullSecrets))
 	return nil
 }
 
@@ -782,6 +1173,11 @@ func autoConvert_acme_ACMEChallengeSolverHTTP01IngressPodSpec_To_v1alpha3_ACMECh
 	out.Tolerations = *(*[]v1.Toleration)(unsafe.Pointer(&in.Tolerations))
 	out.PriorityClassName = in.PriorityClassName
 	out.ServiceAccountName = in.ServiceAccountName
+	out.Resources = (*v1.ResourceRequirements)(unsafe.Pointer(in.Resources))
+	out.SecurityContext = (*v1.PodSecurityContext)(unsafe.Pointer(in.SecurityContext))
+	out.TopologySpreadConstraints = *(*[]v1.TopologySpreadConstraint)(unsafe.Pointer(&in.TopologySpreadConstraints))
+	out.RuntimeClassName = in.RuntimeClassName
+	out.ImagePullSecrets = *(*[]v1.LocalObjectReference)(unsafe.Pointer(&in.ImagePullSecrets))
 	return nil
 }
 
@@ -844,6 +1240,32 @@ func Convert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1alpha3_ACMEChall
 	return autoConvert_acme_ACMEChallengeSolverHTTP01IngressTemplate_To_v1alpha3_ACMEChallengeSolverHTTP01IngressTemplate(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in *ACMEChallengeSolverHTTP01IstioVirtualService, out *acme.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	out.ServiceType = v1.ServiceType(in.ServiceType)
+	out.Gateways = *(*[]string)(unsafe.Pointer(&in.Gateways))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in *ACMEChallengeSolverHTTP01IstioVirtualService, out *acme.ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService_To_acme_ACMEChallengeSolverHTTP01IstioVirtualService(in, out, s)
+}
+
+func autoConvert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService(in *acme.ACMEChallengeSolverHTTP01IstioVirtualService, out *ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	out.ServiceType = v1.ServiceType(in.ServiceType)
+	out.Gateways = *(*[]string)(unsafe.Pointer(&in.Gateways))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	return nil
+}
+
+// Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService is an autogenerated conversion function.
+func Convert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService(in *acme.ACMEChallengeSolverHTTP01IstioVirtualService, out *ACMEChallengeSolverHTTP01IstioVirtualService, s conversion.Scope) error {
+	return autoConvert_acme_ACMEChallengeSolverHTTP01IstioVirtualService_To_v1alpha3_ACMEChallengeSolverHTTP01IstioVirtualService(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEExternalAccountBinding_To_acme_ACMEExternalAccountBinding(in *ACMEExternalAccountBinding, out *acme.ACMEExternalAccountBinding, s conversion.Scope) error {
 	out.KeyID = in.KeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Key, &out.Key, s); err != nil {
@@ -875,6 +1297,7 @@ func Convert_acme_ACMEExternalAccountBinding_To_v1alpha3_ACMEExternalAccountBind
 func autoConvert_v1alpha3_ACMEIssuer_To_acme_ACMEIssuer(in *ACMEIssuer, out *acme.ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	out.URLRewrites = *(*[]acme.ACMEURLRewrite)(unsafe.Pointer(&in.URLRewrites))
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -889,6 +1312,17 @@ func autoConvert_v1alpha3_ACMEIssuer_To_acme_ACMEIssuer(in *ACMEIssuer, out *acm
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AdditionalAccounts != nil {
+		in, out := &in.AdditionalAccounts, &out.AdditionalAccounts
+		*out = make([]acme.ACMEAdditionalAccount, len(*in))
+		for i := range *in {
+			if err := Convert_v1alpha3_ACMEAdditionalAccount_To_acme_ACMEAdditionalAccount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalAccounts = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]acme.ACMEChallengeSolver, len(*in))
@@ -902,12 +1336,14 @@ func autoConvert_v1alpha3_ACMEIssuer_To_acme_ACMEIssuer(in *ACMEIssuer, out *acm
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.EnableCAAPreflightCheck = in.EnableCAAPreflightCheck
 	return nil
 }
 
 func autoConvert_acme_ACMEIssuer_To_v1alpha3_ACMEIssuer(in *acme.ACMEIssuer, out *ACMEIssuer, s conversion.Scope) error {
 	out.Email = in.Email
 	out.Server = in.Server
+	out.URLRewrites = *(*[]ACMEURLRewrite)(unsafe.Pointer(&in.URLRewrites))
 	out.PreferredChain = in.PreferredChain
 	out.SkipTLSVerify = in.SkipTLSVerify
 	if in.ExternalAccountBinding != nil {
@@ -922,6 +1358,17 @@ func autoConvert_acme_ACMEIssuer_To_v1alpha3_ACMEIssuer(in *acme.ACMEIssuer, out
 	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
 		return err
 	}
+	if in.AdditionalAccounts != nil {
+		in, out := &in.AdditionalAccounts, &out.AdditionalAccounts
+		*out = make([]ACMEAdditionalAccount, len(*in))
+		for i := range *in {
+			if err := Convert_acme_ACMEAdditionalAccount_To_v1alpha3_ACMEAdditionalAccount(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.AdditionalAccounts = nil
+	}
 	if in.Solvers != nil {
 		in, out := &in.Solvers, &out.Solvers
 		*out = make([]ACMEChallengeSolver, len(*in))
@@ -935,6 +1382,7 @@ func autoConvert_acme_ACMEIssuer_To_v1alpha3_ACMEIssuer(in *acme.ACMEIssuer, out
 	}
 	out.DisableAccountKeyGeneration = in.DisableAccountKeyGeneration
 	out.EnableDurationFeature = in.EnableDurationFeature
+	out.EnableCAAPreflightCheck = in.EnableCAAPreflightCheck
 	return nil
 }
 
@@ -1002,6 +1450,50 @@ func Convert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1alpha3_ACMEIssuerDNS01Provi
 	return autoConvert_acme_ACMEIssuerDNS01ProviderAkamai_To_v1alpha3_ACMEIssuerDNS01ProviderAkamai(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in *ACMEIssuerDNS01ProviderAliDNS, out *acme.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	out.AccessKeyID = in.AccessKeyID
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(meta.SecretKeySelector)
+		if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccessKeySecret = nil
+	}
+	out.RAMRole = in.RAMRole
+	out.RoleARN = in.RoleARN
+	out.RegionID = in.RegionID
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in *ACMEIssuerDNS01ProviderAliDNS, out *acme.ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderAliDNS_To_acme_ACMEIssuerDNS01ProviderAliDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS(in *acme.ACMEIssuerDNS01ProviderAliDNS, out *ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	out.AccessKeyID = in.AccessKeyID
+	if in.AccessKeySecret != nil {
+		in, out := &in.AccessKeySecret, &out.AccessKeySecret
+		*out = new(apismetav1.SecretKeySelector)
+		if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AccessKeySecret = nil
+	}
+	out.RAMRole = in.RAMRole
+	out.RoleARN = in.RoleARN
+	out.RegionID = in.RegionID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS(in *acme.ACMEIssuerDNS01ProviderAliDNS, out *ACMEIssuerDNS01ProviderAliDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderAliDNS_To_v1alpha3_ACMEIssuerDNS01ProviderAliDNS(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS01ProviderAzureDNS(in *ACMEIssuerDNS01ProviderAzureDNS, out *acme.ACMEIssuerDNS01ProviderAzureDNS, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	if in.ClientSecret != nil {
@@ -1019,6 +1511,7 @@ func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderAzureDNS_To_acme_ACMEIssuerDNS0
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = acme.AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*acme.AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.ServiceAccountRef = (*acme.AzureServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
 	return nil
 }
 
@@ -1044,6 +1537,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderAzureDNS_To_v1alpha3_ACMEIssuerDNS0
 	out.HostedZoneName = in.HostedZoneName
 	out.Environment = AzureDNSEnvironment(in.Environment)
 	out.ManagedIdentity = (*AzureManagedIdentity)(unsafe.Pointer(in.ManagedIdentity))
+	out.ServiceAccountRef = (*AzureServiceAccountRef)(unsafe.Pointer(in.ServiceAccountRef))
 	return nil
 }
 
@@ -1148,6 +1642,60 @@ func Convert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha3_ACMEIssuerDNS01P
 	return autoConvert_acme_ACMEIssuerDNS01ProviderCloudflare_To_v1alpha3_ACMEIssuerDNS01ProviderCloudflare(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in *ACMEIssuerDNS01ProviderCoreDNS, out *acme.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapNamespace = in.ConfigMapNamespace
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in *ACMEIssuerDNS01ProviderCoreDNS, out *acme.ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS_To_acme_ACMEIssuerDNS01ProviderCoreDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS(in *acme.ACMEIssuerDNS01ProviderCoreDNS, out *ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.ConfigMapName = in.ConfigMapName
+	out.ConfigMapNamespace = in.ConfigMapNamespace
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS(in *acme.ACMEIssuerDNS01ProviderCoreDNS, out *ACMEIssuerDNS01ProviderCoreDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderCoreDNS_To_v1alpha3_ACMEIssuerDNS01ProviderCoreDNS(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in *ACMEIssuerDNS01ProviderDNSimple, out *acme.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	out.AccountID = in.AccountID
+	out.Sandbox = in.Sandbox
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in *ACMEIssuerDNS01ProviderDNSimple, out *acme.ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderDNSimple_To_acme_ACMEIssuerDNS01ProviderDNSimple(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple(in *acme.ACMEIssuerDNS01ProviderDNSimple, out *ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	out.AccountID = in.AccountID
+	out.Sandbox = in.Sandbox
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple(in *acme.ACMEIssuerDNS01ProviderDNSimple, out *ACMEIssuerDNS01ProviderDNSimple, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderDNSimple_To_v1alpha3_ACMEIssuerDNS01ProviderDNSimple(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderDigitalOcean_To_acme_ACMEIssuerDNS01ProviderDigitalOcean(in *ACMEIssuerDNS01ProviderDigitalOcean, out *acme.ACMEIssuerDNS01ProviderDigitalOcean, s conversion.Scope) error {
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.Token, &out.Token, s); err != nil {
 		return err
@@ -1172,6 +1720,206 @@ func Convert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha3_ACMEIssuerDNS0
 	return autoConvert_acme_ACMEIssuerDNS01ProviderDigitalOcean_To_v1alpha3_ACMEIssuerDNS01ProviderDigitalOcean(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in *ACMEIssuerDNS01ProviderGandi, out *acme.ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderGandi_To_acme_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi(in *acme.ACMEIssuerDNS01ProviderGandi, out *ACMEIssuerDNS01ProviderGandi, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderGandi_To_v1alpha3_ACMEIssuerDNS01ProviderGandi(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in *ACMEIssuerDNS01ProviderIBMCIS, out *acme.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	out.CRN = in.CRN
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in *ACMEIssuerDNS01ProviderIBMCIS, out *acme.ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS_To_acme_ACMEIssuerDNS01ProviderIBMCIS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS(in *acme.ACMEIssuerDNS01ProviderIBMCIS, out *ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	out.CRN = in.CRN
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS(in *acme.ACMEIssuerDNS01ProviderIBMCIS, out *ACMEIssuerDNS01ProviderIBMCIS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderIBMCIS_To_v1alpha3_ACMEIssuerDNS01ProviderIBMCIS(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in *ACMEIssuerDNS01ProviderLinode, out *acme.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in *ACMEIssuerDNS01ProviderLinode, out *acme.ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderLinode_To_acme_ACMEIssuerDNS01ProviderLinode(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode(in *acme.ACMEIssuerDNS01ProviderLinode, out *ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIToken, &out.APIToken, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode(in *acme.ACMEIssuerDNS01ProviderLinode, out *ACMEIssuerDNS01ProviderLinode, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderLinode_To_v1alpha3_ACMEIssuerDNS01ProviderLinode(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in *ACMEIssuerDNS01ProviderNS1, out *acme.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1 is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in *ACMEIssuerDNS01ProviderNS1, out *acme.ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderNS1_To_acme_ACMEIssuerDNS01ProviderNS1(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1(in *acme.ACMEIssuerDNS01ProviderNS1, out *ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.Endpoint = in.Endpoint
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1 is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1(in *acme.ACMEIssuerDNS01ProviderNS1, out *ACMEIssuerDNS01ProviderNS1, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderNS1_To_v1alpha3_ACMEIssuerDNS01ProviderNS1(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in *ACMEIssuerDNS01ProviderOCI, out *acme.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	out.Region = in.Region
+	out.CompartmentOCID = in.CompartmentOCID
+	out.AuthType = in.AuthType
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(acme.ACMEIssuerDNS01ProviderOCIAPIKey)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APIKey = nil
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in *ACMEIssuerDNS01ProviderOCI, out *acme.ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderOCI_To_acme_ACMEIssuerDNS01ProviderOCI(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI(in *acme.ACMEIssuerDNS01ProviderOCI, out *ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	out.Region = in.Region
+	out.CompartmentOCID = in.CompartmentOCID
+	out.AuthType = in.AuthType
+	if in.APIKey != nil {
+		in, out := &in.APIKey, &out.APIKey
+		*out = new(ACMEIssuerDNS01ProviderOCIAPIKey)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.APIKey = nil
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI(in *acme.ACMEIssuerDNS01ProviderOCI, out *ACMEIssuerDNS01ProviderOCI, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderOCI_To_v1alpha3_ACMEIssuerDNS01ProviderOCI(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in *ACMEIssuerDNS01ProviderOCIAPIKey, out *acme.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	out.TenancyOCID = in.TenancyOCID
+	out.UserOCID = in.UserOCID
+	out.Fingerprint = in.Fingerprint
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in *ACMEIssuerDNS01ProviderOCIAPIKey, out *acme.ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey_To_acme_ACMEIssuerDNS01ProviderOCIAPIKey(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey(in *acme.ACMEIssuerDNS01ProviderOCIAPIKey, out *ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	out.TenancyOCID = in.TenancyOCID
+	out.UserOCID = in.UserOCID
+	out.Fingerprint = in.Fingerprint
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PrivateKey, &out.PrivateKey, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey(in *acme.ACMEIssuerDNS01ProviderOCIAPIKey, out *ACMEIssuerDNS01ProviderOCIAPIKey, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderOCIAPIKey_To_v1alpha3_ACMEIssuerDNS01ProviderOCIAPIKey(in, out, s)
+}
+
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.ServerID = in.ServerID
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in *ACMEIssuerDNS01ProviderPowerDNS, out *acme.ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS_To_acme_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	out.Host = in.Host
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.APIKey, &out.APIKey, s); err != nil {
+		return err
+	}
+	out.ServerID = in.ServerID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS(in *acme.ACMEIssuerDNS01ProviderPowerDNS, out *ACMEIssuerDNS01ProviderPowerDNS, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderPowerDNS_To_v1alpha3_ACMEIssuerDNS01ProviderPowerDNS(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01ProviderRFC2136(in *ACMEIssuerDNS01ProviderRFC2136, out *acme.ACMEIssuerDNS01ProviderRFC2136, s conversion.Scope) error {
 	out.Nameserver = in.Nameserver
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.TSIGSecret, &out.TSIGSecret, s); err != nil {
@@ -1179,6 +1927,15 @@ func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136_To_acme_ACMEIssuerDNS01
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
 	return nil
 }
 
@@ -1194,6 +1951,15 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha3_ACMEIssuerDNS01
 	}
 	out.TSIGKeyName = in.TSIGKeyName
 	out.TSIGAlgorithm = in.TSIGAlgorithm
+	if in.GSSTSIG != nil {
+		in, out := &in.GSSTSIG, &out.GSSTSIG
+		*out = new(ACMEIssuerDNS01ProviderRFC2136GSSTSIG)
+		if err := Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GSSTSIG = nil
+	}
 	return nil
 }
 
@@ -1202,12 +1968,43 @@ func Convert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha3_ACMEIssuerDNS01Prov
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	out.Realm = in.Realm
+	out.Username = in.Username
+	if err := metav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.KeytabSecretRef, &out.KeytabSecretRef, s); err != nil {
+		return err
+	}
+	out.KDCs = *(*[]string)(unsafe.Pointer(&in.KDCs))
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in *acme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG, out *ACMEIssuerDNS01ProviderRFC2136GSSTSIG, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRFC2136GSSTSIG_To_v1alpha3_ACMEIssuerDNS01ProviderRFC2136GSSTSIG(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRoute53_To_acme_ACMEIssuerDNS01ProviderRoute53(in *ACMEIssuerDNS01ProviderRoute53, out *acme.ACMEIssuerDNS01ProviderRoute53, s conversion.Scope) error {
 	out.AccessKeyID = in.AccessKeyID
 	if err := metav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKey, &out.SecretAccessKey, s); err != nil {
 		return err
 	}
 	out.Role = in.Role
+	out.AssumeRoleChain = *(*[]acme.ACMEIssuerDNS01ProviderRoute53AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
 	return nil
@@ -1224,6 +2021,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1alpha3_ACMEIssuerDNS01
 		return err
 	}
 	out.Role = in.Role
+	out.AssumeRoleChain = *(*[]ACMEIssuerDNS01ProviderRoute53AssumeRole)(unsafe.Pointer(&in.AssumeRoleChain))
 	out.HostedZoneID = in.HostedZoneID
 	out.Region = in.Region
 	return nil
@@ -1234,10 +2032,33 @@ func Convert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1alpha3_ACMEIssuerDNS01Prov
 	return autoConvert_acme_ACMEIssuerDNS01ProviderRoute53_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *ACMEIssuerDNS01ProviderRoute53AssumeRole, out *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *ACMEIssuerDNS01ProviderRoute53AssumeRole, out *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	out.Role = in.Role
+	out.ExternalID = in.ExternalID
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole(in *acme.ACMEIssuerDNS01ProviderRoute53AssumeRole, out *ACMEIssuerDNS01ProviderRoute53AssumeRole, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderRoute53AssumeRole_To_v1alpha3_ACMEIssuerDNS01ProviderRoute53AssumeRole(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderWebhook_To_acme_ACMEIssuerDNS01ProviderWebhook(in *ACMEIssuerDNS01ProviderWebhook, out *acme.ACMEIssuerDNS01ProviderWebhook, s conversion.Scope) error {
 	out.GroupName = in.GroupName
 	out.SolverName = in.SolverName
 	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	out.GRPC = (*acme.ACMEIssuerDNS01ProviderWebhookGRPC)(unsafe.Pointer(in.GRPC))
 	return nil
 }
 
@@ -1250,6 +2071,7 @@ func autoConvert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1alpha3_ACMEIssuerDNS01
 	out.GroupName = in.GroupName
 	out.SolverName = in.SolverName
 	out.Config = (*apiextensionsv1.JSON)(unsafe.Pointer(in.Config))
+	out.GRPC = (*ACMEIssuerDNS01ProviderWebhookGRPC)(unsafe.Pointer(in.GRPC))
 	return nil
 }
 
@@ -1258,9 +2080,36 @@ func Convert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1alpha3_ACMEIssuerDNS01Prov
 	return autoConvert_acme_ACMEIssuerDNS01ProviderWebhook_To_v1alpha3_ACMEIssuerDNS01ProviderWebhook(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in *ACMEIssuerDNS01ProviderWebhookGRPC, out *acme.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	out.Address = in.Address
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerName = in.ServerName
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in *ACMEIssuerDNS01ProviderWebhookGRPC, out *acme.ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC_To_acme_ACMEIssuerDNS01ProviderWebhookGRPC(in, out, s)
+}
+
+func autoConvert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC(in *acme.ACMEIssuerDNS01ProviderWebhookGRPC, out *ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	out.Address = in.Address
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerName = in.ServerName
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC is an autogenerated conversion function.
+func Convert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC(in *acme.ACMEIssuerDNS01ProviderWebhookGRPC, out *ACMEIssuerDNS01ProviderWebhookGRPC, s conversion.Scope) error {
+	return autoConvert_acme_ACMEIssuerDNS01ProviderWebhookGRPC_To_v1alpha3_ACMEIssuerDNS01ProviderWebhookGRPC(in, out, s)
+}
+
 func autoConvert_v1alpha3_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *ACMEIssuerStatus, out *acme.ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredEABKeyID = in.LastRegisteredEABKeyID
 	return nil
 }
 
@@ -1272,6 +2121,7 @@ func Convert_v1alpha3_ACMEIssuerStatus_To_acme_ACMEIssuerStatus(in *ACMEIssuerSt
 func autoConvert_acme_ACMEIssuerStatus_To_v1alpha3_ACMEIssuerStatus(in *acme.ACMEIssuerStatus, out *ACMEIssuerStatus, s conversion.Scope) error {
 	out.URI = in.URI
 	out.LastRegisteredEmail = in.LastRegisteredEmail
+	out.LastRegisteredEABKeyID = in.LastRegisteredEABKeyID
 	return nil
 }
 
@@ -1280,6 +2130,28 @@ func Convert_acme_ACMEIssuerStatus_To_v1alpha3_ACMEIssuerStatus(in *acme.ACMEIss
 	return autoConvert_acme_ACMEIssuerStatus_To_v1alpha3_ACMEIssuerStatus(in, out, s)
 }
 
+func autoConvert_v1alpha3_ACMEURLRewrite_To_acme_ACMEURLRewrite(in *ACMEURLRewrite, out *acme.ACMEURLRewrite, s conversion.Scope) error {
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_v1alpha3_ACMEURLRewrite_To_acme_ACMEURLRewrite is an autogenerated conversion function.
+func Convert_v1alpha3_ACMEURLRewrite_To_acme_ACMEURLRewrite(in *ACMEURLRewrite, out *acme.ACMEURLRewrite, s conversion.Scope) error {
+	return autoConvert_v1alpha3_ACMEURLRewrite_To_acme_ACMEURLRewrite(in, out, s)
+}
+
+func autoConvert_acme_ACMEURLRewrite_To_v1alpha3_ACMEURLRewrite(in *acme.ACMEURLRewrite, out *ACMEURLRewrite, s conversion.Scope) error {
+	out.From = in.From
+	out.To = in.To
+	return nil
+}
+
+// Convert_acme_ACMEURLRewrite_To_v1alpha3_ACMEURLRewrite is an autogenerated conversion function.
+func Convert_acme_ACMEURLRewrite_To_v1alpha3_ACMEURLRewrite(in *acme.ACMEURLRewrite, out *ACMEURLRewrite, s conversion.Scope) error {
+	return autoConvert_acme_ACMEURLRewrite_To_v1alpha3_ACMEURLRewrite(in, out, s)
+}
+
 func autoConvert_v1alpha3_AzureManagedIdentity_To_acme_AzureManagedIdentity(in *AzureManagedIdentity, out *acme.AzureManagedIdentity, s conversion.Scope) error {
 	out.ClientID = in.ClientID
 	out.ResourceID = in.ResourceID
@@ -1302,6 +2174,28 @@ func Convert_acme_AzureManagedIdentity_To_v1alpha3_AzureManagedIdentity(in *acme
 	return autoConvert_acme_AzureManagedIdentity_To_v1alpha3_AzureManagedIdentity(in, out, s)
 }
 
+func autoConvert_v1alpha3_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in *AzureServiceAccountRef, out *acme.AzureServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_v1alpha3_AzureServiceAccountRef_To_acme_AzureServiceAccountRef is an autogenerated conversion function.
+func Convert_v1alpha3_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in *AzureServiceAccountRef, out *acme.AzureServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_v1alpha3_AzureServiceAccountRef_To_acme_AzureServiceAccountRef(in, out, s)
+}
+
+func autoConvert_acme_AzureServiceAccountRef_To_v1alpha3_AzureServiceAccountRef(in *acme.AzureServiceAccountRef, out *AzureServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_acme_AzureServiceAccountRef_To_v1alpha3_AzureServiceAccountRef is an autogenerated conversion function.
+func Convert_acme_AzureServiceAccountRef_To_v1alpha3_AzureServiceAccountRef(in *acme.AzureServiceAccountRef, out *AzureServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_acme_AzureServiceAccountRef_To_v1alpha3_AzureServiceAccountRef(in, out, s)
+}
+
 func autoConvert_v1alpha3_CertificateDNSNameSelector_To_acme_CertificateDNSNameSelector(in *CertificateDNSNameSelector, out *acme.CertificateDNSNameSelector, s conversion.Scope) error {
 	out.MatchLabels = *(*map[string]string)(unsafe.Pointer(&in.MatchLabels))
 	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
@@ -1439,6 +2333,7 @@ func autoConvert_v1alpha3_ChallengeStatus_To_acme_ChallengeStatus(in *ChallengeS
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = acme.State(in.State)
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1452,6 +2347,7 @@ func autoConvert_acme_ChallengeStatus_To_v1alpha3_ChallengeStatus(in *acme.Chall
 	out.Presented = in.Presented
 	out.Reason = in.Reason
 	out.State = State(in.State)
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1566,6 +2462,7 @@ func autoConvert_v1alpha3_OrderStatus_To_acme_OrderStatus(in *OrderStatus, out *
 	out.State = acme.State(in.State)
 	out.Reason = in.Reason
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -1582,6 +2479,7 @@ func autoConvert_acme_OrderStatus_To_v1alpha3_OrderStatus(in *acme.OrderStatus,
 	out.Reason = in.Reason
 	out.Authorizations = *(*[]ACMEAuthorization)(unsafe.Pointer(&in.Authorizations))
 	out.FailureTime = (*pkgapismetav1.Time)(unsafe.Pointer(in.FailureTime))
+	out.RetryAfter = (*pkgapismetav1.Time)(unsafe.Pointer(in.RetryAfter))
 	return nil
 }
 
@@ -123,6 +123,13 @@ type OrderStatus struct {
 	// This is used to influence garbage collection and back-off.
 	// +optional
 	FailureTime *metav1.Time `json:"failureTime,omitempty"`
+
+	// RetryAfter is the time before which the ACME server has asked
+	// cert-manager not to retry requests for this order, for example
+	// because of a rate limit. It is set from the Retry-After header of
+	// the ACME server's response and cleared once it has passed.
+	// +optional
+	RetryAfter *metav1.Time `json:"retryAfter,omitempty"`
 }
 
 // ACMEAuthorization contains data returned from the ACME server on an
@@ -155,6 +162,19 @@ type ACMEAuthorization struct {
 	// +optional
 	InitialState State `json:"initialState,omitempty"`
 
+	// Expires is the time at which the authorization expires, as reported by
+	// the ACME server. Once an authorization is valid, it can be reused by
+	// later Orders for the same identifier until this time.
+	// +optional
+	Expires *metav1.Time `json:"expires,omitempty"`
+
+	// ReusedFrom is the name of an earlier Order in the same namespace whose
+	// valid authorization was reused for this Order. If set, the metadata
+	// for the authorization was not fetched from the ACME server and no
+	// Challenge will be created for it.
+	// +optional
+	ReusedFrom string `json:"reusedFrom,omitempty"`
+
 	// Challenges specifies the challenge types offered by the ACME server.
 	// One of these challenge types will be selected when validating the DNS
 	// name and an appropriate Challenge resource will be created to perform
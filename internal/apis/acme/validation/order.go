@@ -98,6 +98,9 @@ func ValidateOrderStatusUpdate(old, new cmacme.OrderStatus, fldPath *field.Path)
 			if old.InitialState != "" && (old.InitialState != new.InitialState) {
 				el = append(el, field.Forbidden(fldPath.Child("initialState"), "field is immutable once set"))
 			}
+			if old.ReusedFrom != "" && (old.ReusedFrom != new.ReusedFrom) {
+				el = append(el, field.Forbidden(fldPath.Child("reusedFrom"), "field is immutable once set"))
+			}
 
 			if len(old.Challenges) > 0 {
 				fldPath := fldPath.Child("challenges")
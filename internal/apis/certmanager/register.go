@@ -47,6 +47,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterIssuerList{},
 		&CertificateRequest{},
 		&CertificateRequestList{},
+		&Bundle{},
+		&BundleList{},
+		&CertificateProtectionPolicy{},
+		&CertificateProtectionPolicyList{},
+		&CertificateRequestPolicy{},
+		&CertificateRequestPolicyList{},
+		&CredentialGrant{},
+		&CredentialGrantList{},
 	)
 	return nil
 }
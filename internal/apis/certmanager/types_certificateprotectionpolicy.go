@@ -0,0 +1,83 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CertificateProtectionPolicy protects critical Certificates and Secrets,
+// such as those containing wildcard or root-level certificates, from being
+// deleted by accident.
+// Deleting a resource matched by a policy is denied by the cert-manager
+// webhook unless the resource is first annotated with
+// `cert-manager.io/deletion-justification` explaining why it is being
+// deleted.
+type CertificateProtectionPolicy struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the CertificateProtectionPolicy resource.
+	Spec CertificateProtectionPolicySpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateProtectionPolicyList is a list of CertificateProtectionPolicies
+type CertificateProtectionPolicyList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []CertificateProtectionPolicy
+}
+
+// CertificateProtectionPolicySpec defines which resources are protected from
+// deletion. A resource is protected if it matches every selector that is set.
+// At least one of `selector` or `dnsNames` must be set.
+type CertificateProtectionPolicySpec struct {
+	// Resources is the list of kinds of resource protected by this policy.
+	// If not set, both Certificates and Secrets are protected.
+	Resources []ProtectedResourceKind
+
+	// NamespaceSelector selects the namespaces in which resources are
+	// protected. If not set, resources in all namespaces are protected.
+	NamespaceSelector *metav1.LabelSelector
+
+	// Selector selects protected resources by their labels.
+	Selector *metav1.LabelSelector
+
+	// DNSNames protects resources containing a certificate for any of the
+	// given DNS names, for example `*.example.com` to protect wildcard
+	// certificates.
+	// Certificates are matched against `spec.dnsNames`, and Secrets against
+	// the `cert-manager.io/alt-names` annotation. Names must match exactly.
+	DNSNames []string
+}
+
+// ProtectedResourceKind is the kind of a resource which can be protected by
+// a CertificateProtectionPolicy.
+type ProtectedResourceKind string
+
+const (
+	// ProtectedResourceCertificate protects cert-manager Certificate resources.
+	ProtectedResourceCertificate ProtectedResourceKind = "Certificate"
+
+	// ProtectedResourceSecret protects Secret resources.
+	ProtectedResourceSecret ProtectedResourceKind = "Secret"
+)
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certmanager
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// A CredentialGrant allows ClusterIssuers to read credential Secrets from
+// the namespace that the CredentialGrant is in.
+// A ClusterIssuer which sets `spec.credentialsNamespace` to a namespace
+// other than the cluster resource namespace will only become ready once a
+// CredentialGrant in that namespace names it.
+type CredentialGrant struct {
+	metav1.TypeMeta
+	metav1.ObjectMeta
+
+	// Desired state of the CredentialGrant resource.
+	Spec CredentialGrantSpec
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CredentialGrantList is a list of CredentialGrants
+type CredentialGrantList struct {
+	metav1.TypeMeta
+	metav1.ListMeta
+
+	Items []CredentialGrant
+}
+
+// CredentialGrantSpec defines which ClusterIssuers may read credential
+// Secrets from the namespace of the CredentialGrant.
+type CredentialGrantSpec struct {
+	// ClusterIssuers is the list of names of ClusterIssuers which may read
+	// credential Secrets from this namespace.
+	ClusterIssuers []string
+}
@@ -44,6 +44,186 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*v1.AWSKMSKeyEncryption)(nil), (*certmanager.AWSKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(a.(*v1.AWSKMSKeyEncryption), b.(*certmanager.AWSKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSKMSKeyEncryption)(nil), (*v1.AWSKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption(a.(*certmanager.AWSKMSKeyEncryption), b.(*v1.AWSKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AWSKMSPrivateKeyBackend)(nil), (*certmanager.AWSKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(a.(*v1.AWSKMSPrivateKeyBackend), b.(*certmanager.AWSKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSKMSPrivateKeyBackend)(nil), (*v1.AWSKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend(a.(*certmanager.AWSKMSPrivateKeyBackend), b.(*v1.AWSKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AWSPCAAuth)(nil), (*certmanager.AWSPCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(a.(*v1.AWSPCAAuth), b.(*certmanager.AWSPCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSPCAAuth)(nil), (*v1.AWSPCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(a.(*certmanager.AWSPCAAuth), b.(*v1.AWSPCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AWSPCAIssuer)(nil), (*certmanager.AWSPCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(a.(*v1.AWSPCAIssuer), b.(*certmanager.AWSPCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSPCAIssuer)(nil), (*v1.AWSPCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer(a.(*certmanager.AWSPCAIssuer), b.(*v1.AWSPCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AWSSecretsManagerSecretStore)(nil), (*certmanager.AWSSecretsManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(a.(*v1.AWSSecretsManagerSecretStore), b.(*certmanager.AWSSecretsManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSSecretsManagerSecretStore)(nil), (*v1.AWSSecretsManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore(a.(*certmanager.AWSSecretsManagerSecretStore), b.(*v1.AWSSecretsManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AzureKeyVaultIssuer)(nil), (*certmanager.AzureKeyVaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(a.(*v1.AzureKeyVaultIssuer), b.(*certmanager.AzureKeyVaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AzureKeyVaultIssuer)(nil), (*v1.AzureKeyVaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer(a.(*certmanager.AzureKeyVaultIssuer), b.(*v1.AzureKeyVaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.AzureKeyVaultServiceAccountRef)(nil), (*certmanager.AzureKeyVaultServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(a.(*v1.AzureKeyVaultServiceAccountRef), b.(*certmanager.AzureKeyVaultServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AzureKeyVaultServiceAccountRef)(nil), (*v1.AzureKeyVaultServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef(a.(*certmanager.AzureKeyVaultServiceAccountRef), b.(*v1.AzureKeyVaultServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.Bundle)(nil), (*certmanager.Bundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Bundle_To_certmanager_Bundle(a.(*v1.Bundle), b.(*certmanager.Bundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.Bundle)(nil), (*v1.Bundle)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_Bundle_To_v1_Bundle(a.(*certmanager.Bundle), b.(*v1.Bundle), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleCondition)(nil), (*certmanager.BundleCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleCondition_To_certmanager_BundleCondition(a.(*v1.BundleCondition), b.(*certmanager.BundleCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleCondition)(nil), (*v1.BundleCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleCondition_To_v1_BundleCondition(a.(*certmanager.BundleCondition), b.(*v1.BundleCondition), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleList)(nil), (*certmanager.BundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleList_To_certmanager_BundleList(a.(*v1.BundleList), b.(*certmanager.BundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleList)(nil), (*v1.BundleList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleList_To_v1_BundleList(a.(*certmanager.BundleList), b.(*v1.BundleList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleSource)(nil), (*certmanager.BundleSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleSource_To_certmanager_BundleSource(a.(*v1.BundleSource), b.(*certmanager.BundleSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleSource)(nil), (*v1.BundleSource)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleSource_To_v1_BundleSource(a.(*certmanager.BundleSource), b.(*v1.BundleSource), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleSourceIssuerRef)(nil), (*certmanager.BundleSourceIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleSourceIssuerRef_To_certmanager_BundleSourceIssuerRef(a.(*v1.BundleSourceIssuerRef), b.(*certmanager.BundleSourceIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleSourceIssuerRef)(nil), (*v1.BundleSourceIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleSourceIssuerRef_To_v1_BundleSourceIssuerRef(a.(*certmanager.BundleSourceIssuerRef), b.(*v1.BundleSourceIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleSourceKeySelector)(nil), (*certmanager.BundleSourceKeySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleSourceKeySelector_To_certmanager_BundleSourceKeySelector(a.(*v1.BundleSourceKeySelector), b.(*certmanager.BundleSourceKeySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleSourceKeySelector)(nil), (*v1.BundleSourceKeySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleSourceKeySelector_To_v1_BundleSourceKeySelector(a.(*certmanager.BundleSourceKeySelector), b.(*v1.BundleSourceKeySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleSpec)(nil), (*certmanager.BundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleSpec_To_certmanager_BundleSpec(a.(*v1.BundleSpec), b.(*certmanager.BundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleSpec)(nil), (*v1.BundleSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleSpec_To_v1_BundleSpec(a.(*certmanager.BundleSpec), b.(*v1.BundleSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleStatus)(nil), (*certmanager.BundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleStatus_To_certmanager_BundleStatus(a.(*v1.BundleStatus), b.(*certmanager.BundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleStatus)(nil), (*v1.BundleStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleStatus_To_v1_BundleStatus(a.(*certmanager.BundleStatus), b.(*v1.BundleStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleTarget)(nil), (*certmanager.BundleTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleTarget_To_certmanager_BundleTarget(a.(*v1.BundleTarget), b.(*certmanager.BundleTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleTarget)(nil), (*v1.BundleTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleTarget_To_v1_BundleTarget(a.(*certmanager.BundleTarget), b.(*v1.BundleTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.BundleTargetConfigMap)(nil), (*certmanager.BundleTargetConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap(a.(*v1.BundleTargetConfigMap), b.(*certmanager.BundleTargetConfigMap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.BundleTargetConfigMap)(nil), (*v1.BundleTargetConfigMap)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap(a.(*certmanager.BundleTargetConfigMap), b.(*v1.BundleTargetConfigMap), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CACRL)(nil), (*certmanager.CACRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CACRL_To_certmanager_CACRL(a.(*v1.CACRL), b.(*certmanager.CACRL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CACRL)(nil), (*v1.CACRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CACRL_To_v1_CACRL(a.(*certmanager.CACRL), b.(*v1.CACRL), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CAIssuer)(nil), (*certmanager.CAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CAIssuer_To_certmanager_CAIssuer(a.(*v1.CAIssuer), b.(*certmanager.CAIssuer), scope)
 	}); err != nil {
@@ -54,6 +234,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CAOCSP)(nil), (*certmanager.CAOCSP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CAOCSP_To_certmanager_CAOCSP(a.(*v1.CAOCSP), b.(*certmanager.CAOCSP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAOCSP)(nil), (*v1.CAOCSP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAOCSP_To_v1_CAOCSP(a.(*certmanager.CAOCSP), b.(*v1.CAOCSP), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_Certificate_To_certmanager_Certificate(a.(*v1.Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -84,6 +274,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateExtension)(nil), (*certmanager.CertificateExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateExtension_To_certmanager_CertificateExtension(a.(*v1.CertificateExtension), b.(*certmanager.CertificateExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExtension)(nil), (*v1.CertificateExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExtension_To_v1_CertificateExtension(a.(*certmanager.CertificateExtension), b.(*v1.CertificateExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateExternalSecretStore)(nil), (*certmanager.CertificateExternalSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(a.(*v1.CertificateExternalSecretStore), b.(*certmanager.CertificateExternalSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalSecretStore)(nil), (*v1.CertificateExternalSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore(a.(*certmanager.CertificateExternalSecretStore), b.(*v1.CertificateExternalSecretStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*v1.CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -114,6 +324,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificatePrivateKeyEncryption)(nil), (*certmanager.CertificatePrivateKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(a.(*v1.CertificatePrivateKeyEncryption), b.(*certmanager.CertificatePrivateKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePrivateKeyEncryption)(nil), (*v1.CertificatePrivateKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption(a.(*certmanager.CertificatePrivateKeyEncryption), b.(*v1.CertificatePrivateKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateProtectionPolicy)(nil), (*certmanager.CertificateProtectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateProtectionPolicy_To_certmanager_CertificateProtectionPolicy(a.(*v1.CertificateProtectionPolicy), b.(*certmanager.CertificateProtectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateProtectionPolicy)(nil), (*v1.CertificateProtectionPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateProtectionPolicy_To_v1_CertificateProtectionPolicy(a.(*certmanager.CertificateProtectionPolicy), b.(*v1.CertificateProtectionPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateProtectionPolicyList)(nil), (*certmanager.CertificateProtectionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateProtectionPolicyList_To_certmanager_CertificateProtectionPolicyList(a.(*v1.CertificateProtectionPolicyList), b.(*certmanager.CertificateProtectionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateProtectionPolicyList)(nil), (*v1.CertificateProtectionPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateProtectionPolicyList_To_v1_CertificateProtectionPolicyList(a.(*certmanager.CertificateProtectionPolicyList), b.(*v1.CertificateProtectionPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateProtectionPolicySpec)(nil), (*certmanager.CertificateProtectionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec(a.(*v1.CertificateProtectionPolicySpec), b.(*certmanager.CertificateProtectionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateProtectionPolicySpec)(nil), (*v1.CertificateProtectionPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec(a.(*certmanager.CertificateProtectionPolicySpec), b.(*v1.CertificateProtectionPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(a.(*v1.CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -144,6 +394,66 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicy)(nil), (*certmanager.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(a.(*v1.CertificateRequestPolicy), b.(*certmanager.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicy)(nil), (*v1.CertificateRequestPolicy)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(a.(*certmanager.CertificateRequestPolicy), b.(*v1.CertificateRequestPolicy), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyIssuerRef)(nil), (*certmanager.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(a.(*v1.CertificateRequestPolicyIssuerRef), b.(*certmanager.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyIssuerRef)(nil), (*v1.CertificateRequestPolicyIssuerRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(a.(*certmanager.CertificateRequestPolicyIssuerRef), b.(*v1.CertificateRequestPolicyIssuerRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyList)(nil), (*certmanager.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(a.(*v1.CertificateRequestPolicyList), b.(*certmanager.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyList)(nil), (*v1.CertificateRequestPolicyList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(a.(*certmanager.CertificateRequestPolicyList), b.(*v1.CertificateRequestPolicyList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicyRule)(nil), (*certmanager.CertificateRequestPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicyRule_To_certmanager_CertificateRequestPolicyRule(a.(*v1.CertificateRequestPolicyRule), b.(*certmanager.CertificateRequestPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicyRule)(nil), (*v1.CertificateRequestPolicyRule)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicyRule_To_v1_CertificateRequestPolicyRule(a.(*certmanager.CertificateRequestPolicyRule), b.(*v1.CertificateRequestPolicyRule), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySelector)(nil), (*certmanager.CertificateRequestPolicySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(a.(*v1.CertificateRequestPolicySelector), b.(*certmanager.CertificateRequestPolicySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySelector)(nil), (*v1.CertificateRequestPolicySelector)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(a.(*certmanager.CertificateRequestPolicySelector), b.(*v1.CertificateRequestPolicySelector), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestPolicySpec)(nil), (*certmanager.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(a.(*v1.CertificateRequestPolicySpec), b.(*certmanager.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateRequestPolicySpec)(nil), (*v1.CertificateRequestPolicySpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(a.(*certmanager.CertificateRequestPolicySpec), b.(*v1.CertificateRequestPolicySpec), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateRequestSpec)(nil), (*certmanager.CertificateRequestSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(a.(*v1.CertificateRequestSpec), b.(*certmanager.CertificateRequestSpec), scope)
 	}); err != nil {
@@ -164,6 +474,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*v1.CertificateSecretTargets)(nil), (*certmanager.CertificateSecretTargets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(a.(*v1.CertificateSecretTargets), b.(*certmanager.CertificateSecretTargets), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTargets)(nil), (*v1.CertificateSecretTargets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets(a.(*certmanager.CertificateSecretTargets), b.(*v1.CertificateSecretTargets), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*v1.CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*v1.CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -204,138 +524,388 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_Issuer_To_certmanager_Issuer(a.(*v1.Issuer), b.(*certmanager.Issuer), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.CredentialGrant)(nil), (*certmanager.CredentialGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CredentialGrant_To_certmanager_CredentialGrant(a.(*v1.CredentialGrant), b.(*certmanager.CredentialGrant), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.Issuer)(nil), (*v1.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_Issuer_To_v1_Issuer(a.(*certmanager.Issuer), b.(*v1.Issuer), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.CredentialGrant)(nil), (*v1.CredentialGrant)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CredentialGrant_To_v1_CredentialGrant(a.(*certmanager.CredentialGrant), b.(*v1.CredentialGrant), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.CredentialGrantList)(nil), (*certmanager.CredentialGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CredentialGrantList_To_certmanager_CredentialGrantList(a.(*v1.CredentialGrantList), b.(*certmanager.CredentialGrantList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCondition)(nil), (*v1.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_IssuerCondition_To_v1_IssuerCondition(a.(*certmanager.IssuerCondition), b.(*v1.IssuerCondition), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.CredentialGrantList)(nil), (*v1.CredentialGrantList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CredentialGrantList_To_v1_CredentialGrantList(a.(*certmanager.CredentialGrantList), b.(*v1.CredentialGrantList), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.IssuerConfig)(nil), (*certmanager.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(a.(*v1.IssuerConfig), b.(*certmanager.IssuerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.CredentialGrantSpec)(nil), (*certmanager.CredentialGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec(a.(*v1.CredentialGrantSpec), b.(*certmanager.CredentialGrantSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerConfig)(nil), (*v1.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(a.(*certmanager.IssuerConfig), b.(*v1.IssuerConfig), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.CredentialGrantSpec)(nil), (*v1.CredentialGrantSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec(a.(*certmanager.CredentialGrantSpec), b.(*v1.CredentialGrantSpec), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.IssuerList)(nil), (*certmanager.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IssuerList_To_certmanager_IssuerList(a.(*v1.IssuerList), b.(*certmanager.IssuerList), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.EJBCAAuth)(nil), (*certmanager.EJBCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EJBCAAuth_To_certmanager_EJBCAAuth(a.(*v1.EJBCAAuth), b.(*certmanager.EJBCAAuth), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerList)(nil), (*v1.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_IssuerList_To_v1_IssuerList(a.(*certmanager.IssuerList), b.(*v1.IssuerList), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.EJBCAAuth)(nil), (*v1.EJBCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_EJBCAAuth_To_v1_EJBCAAuth(a.(*certmanager.EJBCAAuth), b.(*v1.EJBCAAuth), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.EJBCAIssuer)(nil), (*certmanager.EJBCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer(a.(*v1.EJBCAIssuer), b.(*certmanager.EJBCAIssuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerSpec)(nil), (*v1.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(a.(*certmanager.IssuerSpec), b.(*v1.IssuerSpec), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.EJBCAIssuer)(nil), (*v1.EJBCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer(a.(*certmanager.EJBCAIssuer), b.(*v1.EJBCAIssuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.IssuerStatus)(nil), (*certmanager.IssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(a.(*v1.IssuerStatus), b.(*certmanager.IssuerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.GCPKMSKeyEncryption)(nil), (*certmanager.GCPKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(a.(*v1.GCPKMSKeyEncryption), b.(*certmanager.GCPKMSKeyEncryption), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerStatus)(nil), (*v1.IssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(a.(*certmanager.IssuerStatus), b.(*v1.IssuerStatus), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPKMSKeyEncryption)(nil), (*v1.GCPKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption(a.(*certmanager.GCPKMSKeyEncryption), b.(*v1.GCPKMSKeyEncryption), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.JKSKeystore)(nil), (*certmanager.JKSKeystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(a.(*v1.JKSKeystore), b.(*certmanager.JKSKeystore), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.GCPKMSPrivateKeyBackend)(nil), (*certmanager.GCPKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(a.(*v1.GCPKMSPrivateKeyBackend), b.(*certmanager.GCPKMSPrivateKeyBackend), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.JKSKeystore)(nil), (*v1.JKSKeystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(a.(*certmanager.JKSKeystore), b.(*v1.JKSKeystore), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPKMSPrivateKeyBackend)(nil), (*v1.GCPKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend(a.(*certmanager.GCPKMSPrivateKeyBackend), b.(*v1.GCPKMSPrivateKeyBackend), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*v1.PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.GCPSecretManagerSecretStore)(nil), (*certmanager.GCPSecretManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(a.(*v1.GCPSecretManagerSecretStore), b.(*certmanager.GCPSecretManagerSecretStore), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.PKCS12Keystore)(nil), (*v1.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(a.(*certmanager.PKCS12Keystore), b.(*v1.PKCS12Keystore), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPSecretManagerSecretStore)(nil), (*v1.GCPSecretManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore(a.(*certmanager.GCPSecretManagerSecretStore), b.(*v1.GCPSecretManagerSecretStore), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.GoogleCASIssuer)(nil), (*certmanager.GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(a.(*v1.GoogleCASIssuer), b.(*certmanager.GoogleCASIssuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.SelfSignedIssuer)(nil), (*v1.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(a.(*certmanager.SelfSignedIssuer), b.(*v1.SelfSignedIssuer), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.GoogleCASIssuer)(nil), (*v1.GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(a.(*certmanager.GoogleCASIssuer), b.(*v1.GoogleCASIssuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(a.(*v1.VaultAppRole), b.(*certmanager.VaultAppRole), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceLatencyBudget)(nil), (*certmanager.IssuanceLatencyBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(a.(*v1.IssuanceLatencyBudget), b.(*certmanager.IssuanceLatencyBudget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.VaultAppRole)(nil), (*v1.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_VaultAppRole_To_v1_VaultAppRole(a.(*certmanager.VaultAppRole), b.(*v1.VaultAppRole), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceLatencyBudget)(nil), (*v1.IssuanceLatencyBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceLatencyBudget_To_v1_IssuanceLatencyBudget(a.(*certmanager.IssuanceLatencyBudget), b.(*v1.IssuanceLatencyBudget), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.VaultAuth)(nil), (*certmanager.VaultAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VaultAuth_To_certmanager_VaultAuth(a.(*v1.VaultAuth), b.(*certmanager.VaultAuth), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.IssuanceRateLimit)(nil), (*certmanager.IssuanceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(a.(*v1.IssuanceRateLimit), b.(*certmanager.IssuanceRateLimit), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.VaultAuth)(nil), (*v1.VaultAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_VaultAuth_To_v1_VaultAuth(a.(*certmanager.VaultAuth), b.(*v1.VaultAuth), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceRateLimit)(nil), (*v1.IssuanceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit(a.(*certmanager.IssuanceRateLimit), b.(*v1.IssuanceRateLimit), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.VaultIssuer)(nil), (*certmanager.VaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VaultIssuer_To_certmanager_VaultIssuer(a.(*v1.VaultIssuer), b.(*certmanager.VaultIssuer), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_Issuer_To_certmanager_Issuer(a.(*v1.Issuer), b.(*certmanager.Issuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.VaultIssuer)(nil), (*v1.VaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(a.(*certmanager.VaultIssuer), b.(*v1.VaultIssuer), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.Issuer)(nil), (*v1.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_Issuer_To_v1_Issuer(a.(*certmanager.Issuer), b.(*v1.Issuer), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.VaultKubernetesAuth)(nil), (*certmanager.VaultKubernetesAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(a.(*v1.VaultKubernetesAuth), b.(*certmanager.VaultKubernetesAuth), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerCondition)(nil), (*certmanager.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(a.(*v1.IssuerCondition), b.(*certmanager.IssuerCondition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*certmanager.VaultKubernetesAuth)(nil), (*v1.VaultKubernetesAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(a.(*certmanager.VaultKubernetesAuth), b.(*v1.VaultKubernetesAuth), scope)
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerCondition)(nil), (*v1.IssuerCondition)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerCondition_To_v1_IssuerCondition(a.(*certmanager.IssuerCondition), b.(*v1.IssuerCondition), scope)
 	}); err != nil {
 		return err
 	}
-	if err := s.AddGeneratedConversionFunc((*v1.VenafiCloud)(nil), (*certmanager.VenafiCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
-		return Convert_v1_VenafiCloud_To_certmanager_VenafiCloud(a.(*v1.VenafiCloud), b.(*certmanager.VenafiCloud), scope)
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerConfig)(nil), (*certmanager.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(a.(*v1.IssuerConfig), b.(*certmanager.IssuerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerConfig)(nil), (*v1.IssuerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(a.(*certmanager.IssuerConfig), b.(*v1.IssuerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerList)(nil), (*certmanager.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerList_To_certmanager_IssuerList(a.(*v1.IssuerList), b.(*certmanager.IssuerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerList)(nil), (*v1.IssuerList)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerList_To_v1_IssuerList(a.(*certmanager.IssuerList), b.(*v1.IssuerList), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerSpec)(nil), (*certmanager.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(a.(*v1.IssuerSpec), b.(*certmanager.IssuerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerSpec)(nil), (*v1.IssuerSpec)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(a.(*certmanager.IssuerSpec), b.(*v1.IssuerSpec), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.IssuerStatus)(nil), (*certmanager.IssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(a.(*v1.IssuerStatus), b.(*certmanager.IssuerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuerStatus)(nil), (*v1.IssuerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(a.(*certmanager.IssuerStatus), b.(*v1.IssuerStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.JKSKeystore)(nil), (*certmanager.JKSKeystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(a.(*v1.JKSKeystore), b.(*certmanager.JKSKeystore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.JKSKeystore)(nil), (*v1.JKSKeystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(a.(*certmanager.JKSKeystore), b.(*v1.JKSKeystore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.KubernetesCSRIssuer)(nil), (*certmanager.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(a.(*v1.KubernetesCSRIssuer), b.(*certmanager.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.KubernetesCSRIssuer)(nil), (*v1.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(a.(*certmanager.KubernetesCSRIssuer), b.(*v1.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NameConstraintItem)(nil), (*certmanager.NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(a.(*v1.NameConstraintItem), b.(*certmanager.NameConstraintItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NameConstraintItem)(nil), (*v1.NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(a.(*certmanager.NameConstraintItem), b.(*v1.NameConstraintItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.NameConstraints)(nil), (*certmanager.NameConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_NameConstraints_To_certmanager_NameConstraints(a.(*v1.NameConstraints), b.(*certmanager.NameConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NameConstraints)(nil), (*v1.NameConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NameConstraints_To_v1_NameConstraints(a.(*certmanager.NameConstraints), b.(*v1.NameConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.OtherName)(nil), (*certmanager.OtherName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_OtherName_To_certmanager_OtherName(a.(*v1.OtherName), b.(*certmanager.OtherName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.OtherName)(nil), (*v1.OtherName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_OtherName_To_v1_OtherName(a.(*certmanager.OtherName), b.(*v1.OtherName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*v1.PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PKCS12Keystore)(nil), (*v1.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(a.(*certmanager.PKCS12Keystore), b.(*v1.PKCS12Keystore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PluginIssuer)(nil), (*certmanager.PluginIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PluginIssuer_To_certmanager_PluginIssuer(a.(*v1.PluginIssuer), b.(*certmanager.PluginIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PluginIssuer)(nil), (*v1.PluginIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PluginIssuer_To_v1_PluginIssuer(a.(*certmanager.PluginIssuer), b.(*v1.PluginIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.PrivateKeyBackend)(nil), (*certmanager.PrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(a.(*v1.PrivateKeyBackend), b.(*certmanager.PrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PrivateKeyBackend)(nil), (*v1.PrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend(a.(*certmanager.PrivateKeyBackend), b.(*v1.PrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SPIREIssuer)(nil), (*certmanager.SPIREIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SPIREIssuer_To_certmanager_SPIREIssuer(a.(*v1.SPIREIssuer), b.(*certmanager.SPIREIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SPIREIssuer)(nil), (*v1.SPIREIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SPIREIssuer_To_v1_SPIREIssuer(a.(*certmanager.SPIREIssuer), b.(*v1.SPIREIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*v1.SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SelfSignedIssuer)(nil), (*v1.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(a.(*certmanager.SelfSignedIssuer), b.(*v1.SelfSignedIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.StepCAIssuer)(nil), (*certmanager.StepCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StepCAIssuer_To_certmanager_StepCAIssuer(a.(*v1.StepCAIssuer), b.(*certmanager.StepCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAIssuer)(nil), (*v1.StepCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAIssuer_To_v1_StepCAIssuer(a.(*certmanager.StepCAIssuer), b.(*v1.StepCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.StepCAJWKProvisioner)(nil), (*certmanager.StepCAJWKProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(a.(*v1.StepCAJWKProvisioner), b.(*certmanager.StepCAJWKProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAJWKProvisioner)(nil), (*v1.StepCAJWKProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner(a.(*certmanager.StepCAJWKProvisioner), b.(*v1.StepCAJWKProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.StepCAProvisioner)(nil), (*certmanager.StepCAProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner(a.(*v1.StepCAProvisioner), b.(*certmanager.StepCAProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAProvisioner)(nil), (*v1.StepCAProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner(a.(*certmanager.StepCAProvisioner), b.(*v1.StepCAProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.StepCAX5CProvisioner)(nil), (*certmanager.StepCAX5CProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(a.(*v1.StepCAX5CProvisioner), b.(*certmanager.StepCAX5CProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAX5CProvisioner)(nil), (*v1.StepCAX5CProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner(a.(*certmanager.StepCAX5CProvisioner), b.(*v1.StepCAX5CProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultAppRole_To_certmanager_VaultAppRole(a.(*v1.VaultAppRole), b.(*certmanager.VaultAppRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultAppRole)(nil), (*v1.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultAppRole_To_v1_VaultAppRole(a.(*certmanager.VaultAppRole), b.(*v1.VaultAppRole), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultAuth)(nil), (*certmanager.VaultAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultAuth_To_certmanager_VaultAuth(a.(*v1.VaultAuth), b.(*certmanager.VaultAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultAuth)(nil), (*v1.VaultAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultAuth_To_v1_VaultAuth(a.(*certmanager.VaultAuth), b.(*v1.VaultAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultClientCertificateAuth)(nil), (*certmanager.VaultClientCertificateAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(a.(*v1.VaultClientCertificateAuth), b.(*certmanager.VaultClientCertificateAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultClientCertificateAuth)(nil), (*v1.VaultClientCertificateAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth(a.(*certmanager.VaultClientCertificateAuth), b.(*v1.VaultClientCertificateAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultIssuer)(nil), (*certmanager.VaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultIssuer_To_certmanager_VaultIssuer(a.(*v1.VaultIssuer), b.(*certmanager.VaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultIssuer)(nil), (*v1.VaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(a.(*certmanager.VaultIssuer), b.(*v1.VaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultKubernetesAuth)(nil), (*certmanager.VaultKubernetesAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultKubernetesAuth_To_certmanager_VaultKubernetesAuth(a.(*v1.VaultKubernetesAuth), b.(*certmanager.VaultKubernetesAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultKubernetesAuth)(nil), (*v1.VaultKubernetesAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(a.(*certmanager.VaultKubernetesAuth), b.(*v1.VaultKubernetesAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultSecretStore)(nil), (*certmanager.VaultSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultSecretStore_To_certmanager_VaultSecretStore(a.(*v1.VaultSecretStore), b.(*certmanager.VaultSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultSecretStore)(nil), (*v1.VaultSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultSecretStore_To_v1_VaultSecretStore(a.(*certmanager.VaultSecretStore), b.(*v1.VaultSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VaultTransitKeyEncryption)(nil), (*certmanager.VaultTransitKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(a.(*v1.VaultTransitKeyEncryption), b.(*certmanager.VaultTransitKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultTransitKeyEncryption)(nil), (*v1.VaultTransitKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption(a.(*certmanager.VaultTransitKeyEncryption), b.(*v1.VaultTransitKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*v1.VenafiCloud)(nil), (*certmanager.VenafiCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1_VenafiCloud_To_certmanager_VenafiCloud(a.(*v1.VenafiCloud), b.(*certmanager.VenafiCloud), scope)
 	}); err != nil {
 		return err
 	}
@@ -387,381 +957,1391 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
-func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
-	out.SecretName = in.SecretName
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
-	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+func autoConvert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in *v1.AWSKMSKeyEncryption, out *certmanager.AWSKMSKeyEncryption, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_v1_CAIssuer_To_certmanager_CAIssuer is an autogenerated conversion function.
-func Convert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
-	return autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in, out, s)
+// Convert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption is an autogenerated conversion function.
+func Convert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in *v1.AWSKMSKeyEncryption, out *certmanager.AWSKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in, out, s)
 }
 
-func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *v1.CAIssuer, s conversion.Scope) error {
-	out.SecretName = in.SecretName
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
-	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+func autoConvert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption(in *certmanager.AWSKMSKeyEncryption, out *v1.AWSKMSKeyEncryption, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1.AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_certmanager_CAIssuer_To_v1_CAIssuer is an autogenerated conversion function.
-func Convert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *v1.CAIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in, out, s)
+// Convert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption(in *certmanager.AWSKMSKeyEncryption, out *v1.AWSKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption(in, out, s)
 }
 
-func autoConvert_v1_Certificate_To_certmanager_Certificate(in *v1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
+func autoConvert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in *v1.AWSKMSPrivateKeyBackend, out *certmanager.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
 	}
-	if err := Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+	return nil
+}
+
+// Convert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in *v1.AWSKMSPrivateKeyBackend, out *certmanager.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend(in *certmanager.AWSKMSPrivateKeyBackend, out *v1.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1.AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
 	}
 	return nil
 }
 
-// Convert_v1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
-func Convert_v1_Certificate_To_certmanager_Certificate(in *v1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	return autoConvert_v1_Certificate_To_certmanager_Certificate(in, out, s)
+// Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend(in *certmanager.AWSKMSPrivateKeyBackend, out *v1.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certificate, out *v1.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateSpec_To_v1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+func autoConvert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in *v1.AWSPCAAuth, out *certmanager.AWSPCAAuth, s conversion.Scope) error {
+	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef, s); err != nil {
 		return err
 	}
-	if err := Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_Certificate_To_v1_Certificate is an autogenerated conversion function.
-func Convert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certificate, out *v1.Certificate, s conversion.Scope) error {
-	return autoConvert_certmanager_Certificate_To_v1_Certificate(in, out, s)
+// Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth is an autogenerated conversion function.
+func Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in *v1.AWSPCAAuth, out *certmanager.AWSPCAAuth, s conversion.Scope) error {
+	return autoConvert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in, out, s)
 }
 
-func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
-	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+func autoConvert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(in *certmanager.AWSPCAAuth, out *v1.AWSPCAAuth, s conversion.Scope) error {
+	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef, s); err != nil {
+		return err
+	}
+	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
-func Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
-	return autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+// Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth is an autogenerated conversion function.
+func Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(in *certmanager.AWSPCAAuth, out *v1.AWSPCAAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
-	out.Type = v1.CertificateOutputFormatType(in.Type)
-	return nil
-}
-
-// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
-func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
-}
-
-func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	out.Type = certmanager.CertificateConditionType(in.Type)
-	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in *v1.AWSPCAIssuer, out *certmanager.AWSPCAIssuer, s conversion.Scope) error {
+	out.Arn = in.Arn
+	out.Region = in.Region
+	out.TemplateArn = in.TemplateArn
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_v1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
-func Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+// Convert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer is an autogenerated conversion function.
+func Convert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in *v1.AWSPCAIssuer, out *certmanager.AWSPCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
-	out.Type = v1.CertificateConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer(in *certmanager.AWSPCAIssuer, out *v1.AWSPCAIssuer, s conversion.Scope) error {
+	out.Arn = in.Arn
+	out.Region = in.Region
+	out.TemplateArn = in.TemplateArn
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1.AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_certmanager_CertificateCondition_To_v1_CertificateCondition is an autogenerated conversion function.
-func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
+// Convert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer(in *certmanager.AWSPCAIssuer, out *v1.AWSPCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer(in, out, s)
 }
 
-func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
-	if in.JKS != nil {
-		in, out := &in.JKS, &out.JKS
-		*out = new(certmanager.JKSKeystore)
-		if err := Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.JKS = nil
-	}
-	if in.PKCS12 != nil {
-		in, out := &in.PKCS12, &out.PKCS12
-		*out = new(certmanager.PKCS12Keystore)
-		if err := Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(*in, *out, s); err != nil {
+func autoConvert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in *v1.AWSSecretsManagerSecretStore, out *certmanager.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretID = in.SecretID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.PKCS12 = nil
+		out.Auth = nil
 	}
 	return nil
 }
 
-// Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores is an autogenerated conversion function.
-func Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
-	return autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in, out, s)
+// Convert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore is an autogenerated conversion function.
+func Convert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in *v1.AWSSecretsManagerSecretStore, out *certmanager.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in *certmanager.CertificateKeystores, out *v1.CertificateKeystores, s conversion.Scope) error {
-	if in.JKS != nil {
-		in, out := &in.JKS, &out.JKS
-		*out = new(v1.JKSKeystore)
-		if err := Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.JKS = nil
-	}
-	if in.PKCS12 != nil {
-		in, out := &in.PKCS12, &out.PKCS12
-		*out = new(v1.PKCS12Keystore)
-		if err := Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(*in, *out, s); err != nil {
+func autoConvert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore(in *certmanager.AWSSecretsManagerSecretStore, out *v1.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretID = in.SecretID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(v1.AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1_AWSPCAAuth(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.PKCS12 = nil
+		out.Auth = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores is an autogenerated conversion function.
-func Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in *certmanager.CertificateKeystores, out *v1.CertificateKeystores, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in, out, s)
+// Convert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore is an autogenerated conversion function.
+func Convert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore(in *certmanager.AWSSecretsManagerSecretStore, out *v1.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore(in, out, s)
 }
 
-func autoConvert_v1_CertificateList_To_certmanager_CertificateList(in *v1.CertificateList, out *certmanager.CertificateList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]certmanager.Certificate, len(*in))
-		for i := range *in {
-			if err := Convert_v1_Certificate_To_certmanager_Certificate(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+func autoConvert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in *v1.AzureKeyVaultIssuer, out *certmanager.AzureKeyVaultIssuer, s conversion.Scope) error {
+	out.VaultURL = in.VaultURL
+	out.CertificateName = in.CertificateName
+	out.Environment = in.Environment
+	out.TenantID = in.TenantID
+	out.ClientID = in.ClientID
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(certmanager.AzureKeyVaultServiceAccountRef)
+		if err := Convert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.Items = nil
+		out.ServiceAccountRef = nil
 	}
 	return nil
 }
 
-// Convert_v1_CertificateList_To_certmanager_CertificateList is an autogenerated conversion function.
-func Convert_v1_CertificateList_To_certmanager_CertificateList(in *v1.CertificateList, out *certmanager.CertificateList, s conversion.Scope) error {
-	return autoConvert_v1_CertificateList_To_certmanager_CertificateList(in, out, s)
+// Convert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer is an autogenerated conversion function.
+func Convert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in *v1.AzureKeyVaultIssuer, out *certmanager.AzureKeyVaultIssuer, s conversion.Scope) error {
+	return autoConvert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.CertificateList, out *v1.CertificateList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]v1.Certificate, len(*in))
-		for i := range *in {
-			if err := Convert_certmanager_Certificate_To_v1_Certificate(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+func autoConvert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer(in *certmanager.AzureKeyVaultIssuer, out *v1.AzureKeyVaultIssuer, s conversion.Scope) error {
+	out.VaultURL = in.VaultURL
+	out.CertificateName = in.CertificateName
+	out.Environment = in.Environment
+	out.TenantID = in.TenantID
+	out.ClientID = in.ClientID
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(v1.AzureKeyVaultServiceAccountRef)
+		if err := Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.Items = nil
+		out.ServiceAccountRef = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_CertificateList_To_v1_CertificateList is an autogenerated conversion function.
-func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.CertificateList, out *v1.CertificateList, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateList_To_v1_CertificateList(in, out, s)
+// Convert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer is an autogenerated conversion function.
+func Convert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer(in *certmanager.AzureKeyVaultIssuer, out *v1.AzureKeyVaultIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer(in, out, s)
 }
 
-func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
-	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
-	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
-	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
-	out.Size = in.Size
+func autoConvert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in *v1.AzureKeyVaultServiceAccountRef, out *certmanager.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
 	return nil
 }
 
-// Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey is an autogenerated conversion function.
-func Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
-	return autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in, out, s)
+// Convert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef is an autogenerated conversion function.
+func Convert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in *v1.AzureKeyVaultServiceAccountRef, out *certmanager.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_v1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in, out, s)
 }
 
-func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
-	out.RotationPolicy = v1.PrivateKeyRotationPolicy(in.RotationPolicy)
-	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
-	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
-	out.Size = in.Size
+func autoConvert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef(in *certmanager.AzureKeyVaultServiceAccountRef, out *v1.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
 	return nil
 }
 
-// Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey is an autogenerated conversion function.
-func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
+// Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef is an autogenerated conversion function.
+func Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef(in *certmanager.AzureKeyVaultServiceAccountRef, out *v1.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_certmanager_AzureKeyVaultServiceAccountRef_To_v1_AzureKeyVaultServiceAccountRef(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+func autoConvert_v1_Bundle_To_certmanager_Bundle(in *v1.Bundle, out *certmanager.Bundle, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_v1_BundleSpec_To_certmanager_BundleSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_v1_BundleStatus_To_certmanager_BundleStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_CertificateRequest_To_certmanager_CertificateRequest is an autogenerated conversion function.
-func Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
-	return autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in, out, s)
+// Convert_v1_Bundle_To_certmanager_Bundle is an autogenerated conversion function.
+func Convert_v1_Bundle_To_certmanager_Bundle(in *v1.Bundle, out *certmanager.Bundle, s conversion.Scope) error {
+	return autoConvert_v1_Bundle_To_certmanager_Bundle(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certmanager.CertificateRequest, out *v1.CertificateRequest, s conversion.Scope) error {
+func autoConvert_certmanager_Bundle_To_v1_Bundle(in *certmanager.Bundle, out *v1.Bundle, s conversion.Scope) error {
 	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+	if err := Convert_certmanager_BundleSpec_To_v1_BundleSpec(&in.Spec, &out.Spec, s); err != nil {
 		return err
 	}
-	if err := Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+	if err := Convert_certmanager_BundleStatus_To_v1_BundleStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_CertificateRequest_To_v1_CertificateRequest is an autogenerated conversion function.
-func Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certmanager.CertificateRequest, out *v1.CertificateRequest, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRequest_To_v1_CertificateRequest(in, out, s)
+// Convert_certmanager_Bundle_To_v1_Bundle is an autogenerated conversion function.
+func Convert_certmanager_Bundle_To_v1_Bundle(in *certmanager.Bundle, out *v1.Bundle, s conversion.Scope) error {
+	return autoConvert_certmanager_Bundle_To_v1_Bundle(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
-	out.Type = certmanager.CertificateRequestConditionType(in.Type)
+func autoConvert_v1_BundleCondition_To_certmanager_BundleCondition(in *v1.BundleCondition, out *certmanager.BundleCondition, s conversion.Scope) error {
+	out.Type = certmanager.BundleConditionType(in.Type)
 	out.Status = meta.ConditionStatus(in.Status)
 	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
-// Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition is an autogenerated conversion function.
-func Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
-	return autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in, out, s)
+// Convert_v1_BundleCondition_To_certmanager_BundleCondition is an autogenerated conversion function.
+func Convert_v1_BundleCondition_To_certmanager_BundleCondition(in *v1.BundleCondition, out *certmanager.BundleCondition, s conversion.Scope) error {
+	return autoConvert_v1_BundleCondition_To_certmanager_BundleCondition(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1.CertificateRequestCondition, s conversion.Scope) error {
-	out.Type = v1.CertificateRequestConditionType(in.Type)
+func autoConvert_certmanager_BundleCondition_To_v1_BundleCondition(in *certmanager.BundleCondition, out *v1.BundleCondition, s conversion.Scope) error {
+	out.Type = v1.BundleConditionType(in.Type)
 	out.Status = apismetav1.ConditionStatus(in.Status)
 	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
 	out.Reason = in.Reason
 	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
 	return nil
 }
 
-// Convert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition is an autogenerated conversion function.
-func Convert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1.CertificateRequestCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in, out, s)
+// Convert_certmanager_BundleCondition_To_v1_BundleCondition is an autogenerated conversion function.
+func Convert_certmanager_BundleCondition_To_v1_BundleCondition(in *certmanager.BundleCondition, out *v1.BundleCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleCondition_To_v1_BundleCondition(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in *v1.CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
+func autoConvert_v1_BundleList_To_certmanager_BundleList(in *v1.BundleList, out *certmanager.BundleList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]certmanager.CertificateRequest, len(*in))
-		for i := range *in {
-			if err := Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
-	}
+	out.Items = *(*[]certmanager.Bundle)(unsafe.Pointer(&in.Items))
 	return nil
 }
 
-// Convert_v1_CertificateRequestList_To_certmanager_CertificateRequestList is an autogenerated conversion function.
-func Convert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in *v1.CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
-	return autoConvert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in, out, s)
+// Convert_v1_BundleList_To_certmanager_BundleList is an autogenerated conversion function.
+func Convert_v1_BundleList_To_certmanager_BundleList(in *v1.BundleList, out *certmanager.BundleList, s conversion.Scope) error {
+	return autoConvert_v1_BundleList_To_certmanager_BundleList(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in *certmanager.CertificateRequestList, out *v1.CertificateRequestList, s conversion.Scope) error {
+func autoConvert_certmanager_BundleList_To_v1_BundleList(in *certmanager.BundleList, out *v1.BundleList, s conversion.Scope) error {
 	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]v1.CertificateRequest, len(*in))
-		for i := range *in {
-			if err := Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+	out.Items = *(*[]v1.Bundle)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_BundleList_To_v1_BundleList is an autogenerated conversion function.
+func Convert_certmanager_BundleList_To_v1_BundleList(in *certmanager.BundleList, out *v1.BundleList, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleList_To_v1_BundleList(in, out, s)
+}
+
+func autoConvert_v1_BundleSource_To_certmanager_BundleSource(in *v1.BundleSource, out *certmanager.BundleSource, s conversion.Scope) error {
+	out.ConfigMap = (*certmanager.BundleSourceKeySelector)(unsafe.Pointer(in.ConfigMap))
+	out.Secret = (*certmanager.BundleSourceKeySelector)(unsafe.Pointer(in.Secret))
+	out.Issuer = (*certmanager.BundleSourceIssuerRef)(unsafe.Pointer(in.Issuer))
+	out.InLine = (*string)(unsafe.Pointer(in.InLine))
+	return nil
+}
+
+// Convert_v1_BundleSource_To_certmanager_BundleSource is an autogenerated conversion function.
+func Convert_v1_BundleSource_To_certmanager_BundleSource(in *v1.BundleSource, out *certmanager.BundleSource, s conversion.Scope) error {
+	return autoConvert_v1_BundleSource_To_certmanager_BundleSource(in, out, s)
+}
+
+func autoConvert_certmanager_BundleSource_To_v1_BundleSource(in *certmanager.BundleSource, out *v1.BundleSource, s conversion.Scope) error {
+	out.ConfigMap = (*v1.BundleSourceKeySelector)(unsafe.Pointer(in.ConfigMap))
+	out.Secret = (*v1.BundleSourceKeySelector)(unsafe.Pointer(in.Secret))
+	out.Issuer = (*v1.BundleSourceIssuerRef)(unsafe.Pointer(in.Issuer))
+	out.InLine = (*string)(unsafe.Pointer(in.InLine))
+	return nil
+}
+
+// Convert_certmanager_BundleSource_To_v1_BundleSource is an autogenerated conversion function.
+func Convert_certmanager_BundleSource_To_v1_BundleSource(in *certmanager.BundleSource, out *v1.BundleSource, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleSource_To_v1_BundleSource(in, out, s)
+}
+
+func autoConvert_v1_BundleSourceIssuerRef_To_certmanager_BundleSourceIssuerRef(in *v1.BundleSourceIssuerRef, out *certmanager.BundleSourceIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_v1_BundleSourceIssuerRef_To_certmanager_BundleSourceIssuerRef is an autogenerated conversion function.
+func Convert_v1_BundleSourceIssuerRef_To_certmanager_BundleSourceIssuerRef(in *v1.BundleSourceIssuerRef, out *certmanager.BundleSourceIssuerRef, s conversion.Scope) error {
+	return autoConvert_v1_BundleSourceIssuerRef_To_certmanager_BundleSourceIssuerRef(in, out, s)
+}
+
+func autoConvert_certmanager_BundleSourceIssuerRef_To_v1_BundleSourceIssuerRef(in *certmanager.BundleSourceIssuerRef, out *v1.BundleSourceIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Namespace = in.Namespace
+	return nil
+}
+
+// Convert_certmanager_BundleSourceIssuerRef_To_v1_BundleSourceIssuerRef is an autogenerated conversion function.
+func Convert_certmanager_BundleSourceIssuerRef_To_v1_BundleSourceIssuerRef(in *certmanager.BundleSourceIssuerRef, out *v1.BundleSourceIssuerRef, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleSourceIssuerRef_To_v1_BundleSourceIssuerRef(in, out, s)
+}
+
+func autoConvert_v1_BundleSourceKeySelector_To_certmanager_BundleSourceKeySelector(in *v1.BundleSourceKeySelector, out *certmanager.BundleSourceKeySelector, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_BundleSourceKeySelector_To_certmanager_BundleSourceKeySelector is an autogenerated conversion function.
+func Convert_v1_BundleSourceKeySelector_To_certmanager_BundleSourceKeySelector(in *v1.BundleSourceKeySelector, out *certmanager.BundleSourceKeySelector, s conversion.Scope) error {
+	return autoConvert_v1_BundleSourceKeySelector_To_certmanager_BundleSourceKeySelector(in, out, s)
+}
+
+func autoConvert_certmanager_BundleSourceKeySelector_To_v1_BundleSourceKeySelector(in *certmanager.BundleSourceKeySelector, out *v1.BundleSourceKeySelector, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_certmanager_BundleSourceKeySelector_To_v1_BundleSourceKeySelector is an autogenerated conversion function.
+func Convert_certmanager_BundleSourceKeySelector_To_v1_BundleSourceKeySelector(in *certmanager.BundleSourceKeySelector, out *v1.BundleSourceKeySelector, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleSourceKeySelector_To_v1_BundleSourceKeySelector(in, out, s)
+}
+
+func autoConvert_v1_BundleSpec_To_certmanager_BundleSpec(in *v1.BundleSpec, out *certmanager.BundleSpec, s conversion.Scope) error {
+	out.Sources = *(*[]certmanager.BundleSource)(unsafe.Pointer(&in.Sources))
+	if err := Convert_v1_BundleTarget_To_certmanager_BundleTarget(&in.Target, &out.Target, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList is an autogenerated conversion function.
-func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in *certmanager.CertificateRequestList, out *v1.CertificateRequestList, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in, out, s)
+// Convert_v1_BundleSpec_To_certmanager_BundleSpec is an autogenerated conversion function.
+func Convert_v1_BundleSpec_To_certmanager_BundleSpec(in *v1.BundleSpec, out *certmanager.BundleSpec, s conversion.Scope) error {
+	return autoConvert_v1_BundleSpec_To_certmanager_BundleSpec(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+func autoConvert_certmanager_BundleSpec_To_v1_BundleSpec(in *certmanager.BundleSpec, out *v1.BundleSpec, s conversion.Scope) error {
+	out.Sources = *(*[]v1.BundleSource)(unsafe.Pointer(&in.Sources))
+	if err := Convert_certmanager_BundleTarget_To_v1_BundleTarget(&in.Target, &out.Target, s); err != nil {
 		return err
 	}
-	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	out.IsCA = in.IsCA
-	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.Username = in.Username
-	out.UID = in.UID
-	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
-	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
 	return nil
 }
 
-// Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec is an autogenerated conversion function.
-func Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
-	return autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in, out, s)
+// Convert_certmanager_BundleSpec_To_v1_BundleSpec is an autogenerated conversion function.
+func Convert_certmanager_BundleSpec_To_v1_BundleSpec(in *certmanager.BundleSpec, out *v1.BundleSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleSpec_To_v1_BundleSpec(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1.CertificateRequestSpec, s conversion.Scope) error {
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+func autoConvert_v1_BundleStatus_To_certmanager_BundleStatus(in *v1.BundleStatus, out *certmanager.BundleStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.BundleCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_v1_BundleStatus_To_certmanager_BundleStatus is an autogenerated conversion function.
+func Convert_v1_BundleStatus_To_certmanager_BundleStatus(in *v1.BundleStatus, out *certmanager.BundleStatus, s conversion.Scope) error {
+	return autoConvert_v1_BundleStatus_To_certmanager_BundleStatus(in, out, s)
+}
+
+func autoConvert_certmanager_BundleStatus_To_v1_BundleStatus(in *certmanager.BundleStatus, out *v1.BundleStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.BundleCondition)(unsafe.Pointer(&in.Conditions))
+	return nil
+}
+
+// Convert_certmanager_BundleStatus_To_v1_BundleStatus is an autogenerated conversion function.
+func Convert_certmanager_BundleStatus_To_v1_BundleStatus(in *certmanager.BundleStatus, out *v1.BundleStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleStatus_To_v1_BundleStatus(in, out, s)
+}
+
+func autoConvert_v1_BundleTarget_To_certmanager_BundleTarget(in *v1.BundleTarget, out *certmanager.BundleTarget, s conversion.Scope) error {
+	if err := Convert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap(&in.ConfigMap, &out.ConfigMap, s); err != nil {
 		return err
 	}
-	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
-	out.IsCA = in.IsCA
-	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.Username = in.Username
-	out.UID = in.UID
-	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
-	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
 	return nil
 }
 
-// Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec is an autogenerated conversion function.
-func Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1.CertificateRequestSpec, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in, out, s)
+// Convert_v1_BundleTarget_To_certmanager_BundleTarget is an autogenerated conversion function.
+func Convert_v1_BundleTarget_To_certmanager_BundleTarget(in *v1.BundleTarget, out *certmanager.BundleTarget, s conversion.Scope) error {
+	return autoConvert_v1_BundleTarget_To_certmanager_BundleTarget(in, out, s)
 }
 
-func autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(in *v1.CertificateRequestStatus, out *certmanager.CertificateRequestStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
-	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
-	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+func autoConvert_certmanager_BundleTarget_To_v1_BundleTarget(in *certmanager.BundleTarget, out *v1.BundleTarget, s conversion.Scope) error {
+	if err := Convert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap(&in.ConfigMap, &out.ConfigMap, s); err != nil {
+		return err
+	}
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_certmanager_BundleTarget_To_v1_BundleTarget is an autogenerated conversion function.
+func Convert_certmanager_BundleTarget_To_v1_BundleTarget(in *certmanager.BundleTarget, out *v1.BundleTarget, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleTarget_To_v1_BundleTarget(in, out, s)
+}
+
+func autoConvert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap(in *v1.BundleTargetConfigMap, out *certmanager.BundleTargetConfigMap, s conversion.Scope) error {
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap is an autogenerated conversion function.
+func Convert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap(in *v1.BundleTargetConfigMap, out *certmanager.BundleTargetConfigMap, s conversion.Scope) error {
+	return autoConvert_v1_BundleTargetConfigMap_To_certmanager_BundleTargetConfigMap(in, out, s)
+}
+
+func autoConvert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap(in *certmanager.BundleTargetConfigMap, out *v1.BundleTargetConfigMap, s conversion.Scope) error {
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap is an autogenerated conversion function.
+func Convert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap(in *certmanager.BundleTargetConfigMap, out *v1.BundleTargetConfigMap, s conversion.Scope) error {
+	return autoConvert_certmanager_BundleTargetConfigMap_To_v1_BundleTargetConfigMap(in, out, s)
+}
+
+func autoConvert_v1_CACRL_To_certmanager_CACRL(in *v1.CACRL, out *certmanager.CACRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ConfigMapName = in.ConfigMapName
+	out.Validity = (*metav1.Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_v1_CACRL_To_certmanager_CACRL is an autogenerated conversion function.
+func Convert_v1_CACRL_To_certmanager_CACRL(in *v1.CACRL, out *certmanager.CACRL, s conversion.Scope) error {
+	return autoConvert_v1_CACRL_To_certmanager_CACRL(in, out, s)
+}
+
+func autoConvert_certmanager_CACRL_To_v1_CACRL(in *certmanager.CACRL, out *v1.CACRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ConfigMapName = in.ConfigMapName
+	out.Validity = (*metav1.Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_certmanager_CACRL_To_v1_CACRL is an autogenerated conversion function.
+func Convert_certmanager_CACRL_To_v1_CACRL(in *certmanager.CACRL, out *v1.CACRL, s conversion.Scope) error {
+	return autoConvert_certmanager_CACRL_To_v1_CACRL(in, out, s)
+}
+
+func autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	out.ChainSecretNames = *(*[]string)(unsafe.Pointer(&in.ChainSecretNames))
+	if in.CRL != nil {
+		in, out := &in.CRL, &out.CRL
+		*out = new(certmanager.CACRL)
+		if err := Convert_v1_CACRL_To_certmanager_CACRL(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRL = nil
+	}
+	if in.OCSP != nil {
+		in, out := &in.OCSP, &out.OCSP
+		*out = new(certmanager.CAOCSP)
+		if err := Convert_v1_CAOCSP_To_certmanager_CAOCSP(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCSP = nil
+	}
+	return nil
+}
+
+// Convert_v1_CAIssuer_To_certmanager_CAIssuer is an autogenerated conversion function.
+func Convert_v1_CAIssuer_To_certmanager_CAIssuer(in *v1.CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
+	return autoConvert_v1_CAIssuer_To_certmanager_CAIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *v1.CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	out.ChainSecretNames = *(*[]string)(unsafe.Pointer(&in.ChainSecretNames))
+	if in.CRL != nil {
+		in, out := &in.CRL, &out.CRL
+		*out = new(v1.CACRL)
+		if err := Convert_certmanager_CACRL_To_v1_CACRL(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRL = nil
+	}
+	if in.OCSP != nil {
+		in, out := &in.OCSP, &out.OCSP
+		*out = new(v1.CAOCSP)
+		if err := Convert_certmanager_CAOCSP_To_v1_CAOCSP(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCSP = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuer_To_v1_CAIssuer is an autogenerated conversion function.
+func Convert_certmanager_CAIssuer_To_v1_CAIssuer(in *certmanager.CAIssuer, out *v1.CAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuer_To_v1_CAIssuer(in, out, s)
+}
+
+func autoConvert_v1_CAOCSP_To_certmanager_CAOCSP(in *v1.CAOCSP, out *certmanager.CAOCSP, s conversion.Scope) error {
+	out.ResponderSecretName = in.ResponderSecretName
+	out.ResponseValidity = (*metav1.Duration)(unsafe.Pointer(in.ResponseValidity))
+	return nil
+}
+
+// Convert_v1_CAOCSP_To_certmanager_CAOCSP is an autogenerated conversion function.
+func Convert_v1_CAOCSP_To_certmanager_CAOCSP(in *v1.CAOCSP, out *certmanager.CAOCSP, s conversion.Scope) error {
+	return autoConvert_v1_CAOCSP_To_certmanager_CAOCSP(in, out, s)
+}
+
+func autoConvert_certmanager_CAOCSP_To_v1_CAOCSP(in *certmanager.CAOCSP, out *v1.CAOCSP, s conversion.Scope) error {
+	out.ResponderSecretName = in.ResponderSecretName
+	out.ResponseValidity = (*metav1.Duration)(unsafe.Pointer(in.ResponseValidity))
+	return nil
+}
+
+// Convert_certmanager_CAOCSP_To_v1_CAOCSP is an autogenerated conversion function.
+func Convert_certmanager_CAOCSP_To_v1_CAOCSP(in *certmanager.CAOCSP, out *v1.CAOCSP, s conversion.Scope) error {
+	return autoConvert_certmanager_CAOCSP_To_v1_CAOCSP(in, out, s)
+}
+
+func autoConvert_v1_Certificate_To_certmanager_Certificate(in *v1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
+func Convert_v1_Certificate_To_certmanager_Certificate(in *v1.Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	return autoConvert_v1_Certificate_To_certmanager_Certificate(in, out, s)
+}
+
+func autoConvert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certificate, out *v1.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateSpec_To_v1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_Certificate_To_v1_Certificate is an autogenerated conversion function.
+func Convert_certmanager_Certificate_To_v1_Certificate(in *certmanager.Certificate, out *v1.Certificate, s conversion.Scope) error {
+	return autoConvert_certmanager_Certificate_To_v1_Certificate(in, out, s)
+}
+
+func autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *v1.CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_v1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	out.Type = v1.CertificateOutputFormatType(in.Type)
+	out.Key = in.Key
+	return nil
+}
+
+// Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat is an autogenerated conversion function.
+func Convert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *v1.CertificateAdditionalOutputFormat, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1_CertificateAdditionalOutputFormat(in, out, s)
+}
+
+func autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	out.Type = certmanager.CertificateConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1_CertificateCondition_To_certmanager_CertificateCondition is an autogenerated conversion function.
+func Convert_v1_CertificateCondition_To_certmanager_CertificateCondition(in *v1.CertificateCondition, out *certmanager.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_v1_CertificateCondition_To_certmanager_CertificateCondition(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
+	out.Type = v1.CertificateConditionType(in.Type)
+	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_CertificateCondition_To_v1_CertificateCondition is an autogenerated conversion function.
+func Convert_certmanager_CertificateCondition_To_v1_CertificateCondition(in *certmanager.CertificateCondition, out *v1.CertificateCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateCondition_To_v1_CertificateCondition(in, out, s)
+}
+
+func autoConvert_v1_CertificateExtension_To_certmanager_CertificateExtension(in *v1.CertificateExtension, out *certmanager.CertificateExtension, s conversion.Scope) error {
+	out.OID = in.OID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_v1_CertificateExtension_To_certmanager_CertificateExtension is an autogenerated conversion function.
+func Convert_v1_CertificateExtension_To_certmanager_CertificateExtension(in *v1.CertificateExtension, out *certmanager.CertificateExtension, s conversion.Scope) error {
+	return autoConvert_v1_CertificateExtension_To_certmanager_CertificateExtension(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExtension_To_v1_CertificateExtension(in *certmanager.CertificateExtension, out *v1.CertificateExtension, s conversion.Scope) error {
+	out.OID = in.OID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_certmanager_CertificateExtension_To_v1_CertificateExtension is an autogenerated conversion function.
+func Convert_certmanager_CertificateExtension_To_v1_CertificateExtension(in *certmanager.CertificateExtension, out *v1.CertificateExtension, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExtension_To_v1_CertificateExtension(in, out, s)
+}
+
+func autoConvert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in *v1.CertificateExternalSecretStore, out *certmanager.CertificateExternalSecretStore, s conversion.Scope) error {
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(certmanager.VaultSecretStore)
+		if err := Convert_v1_VaultSecretStore_To_certmanager_VaultSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	if in.AWSSecretsManager != nil {
+		in, out := &in.AWSSecretsManager, &out.AWSSecretsManager
+		*out = new(certmanager.AWSSecretsManagerSecretStore)
+		if err := Convert_v1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSSecretsManager = nil
+	}
+	if in.GCPSecretManager != nil {
+		in, out := &in.GCPSecretManager, &out.GCPSecretManager
+		*out = new(certmanager.GCPSecretManagerSecretStore)
+		if err := Convert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPSecretManager = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore is an autogenerated conversion function.
+func Convert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in *v1.CertificateExternalSecretStore, out *certmanager.CertificateExternalSecretStore, s conversion.Scope) error {
+	return autoConvert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore(in *certmanager.CertificateExternalSecretStore, out *v1.CertificateExternalSecretStore, s conversion.Scope) error {
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(v1.VaultSecretStore)
+		if err := Convert_certmanager_VaultSecretStore_To_v1_VaultSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	if in.AWSSecretsManager != nil {
+		in, out := &in.AWSSecretsManager, &out.AWSSecretsManager
+		*out = new(v1.AWSSecretsManagerSecretStore)
+		if err := Convert_certmanager_AWSSecretsManagerSecretStore_To_v1_AWSSecretsManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSSecretsManager = nil
+	}
+	if in.GCPSecretManager != nil {
+		in, out := &in.GCPSecretManager, &out.GCPSecretManager
+		*out = new(v1.GCPSecretManagerSecretStore)
+		if err := Convert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPSecretManager = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore(in *certmanager.CertificateExternalSecretStore, out *v1.CertificateExternalSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore(in, out, s)
+}
+
+func autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
+	if in.JKS != nil {
+		in, out := &in.JKS, &out.JKS
+		*out = new(certmanager.JKSKeystore)
+		if err := Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JKS = nil
+	}
+	if in.PKCS12 != nil {
+		in, out := &in.PKCS12, &out.PKCS12
+		*out = new(certmanager.PKCS12Keystore)
+		if err := Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS12 = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores is an autogenerated conversion function.
+func Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in *v1.CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
+	return autoConvert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in *certmanager.CertificateKeystores, out *v1.CertificateKeystores, s conversion.Scope) error {
+	if in.JKS != nil {
+		in, out := &in.JKS, &out.JKS
+		*out = new(v1.JKSKeystore)
+		if err := Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JKS = nil
+	}
+	if in.PKCS12 != nil {
+		in, out := &in.PKCS12, &out.PKCS12
+		*out = new(v1.PKCS12Keystore)
+		if err := Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PKCS12 = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores is an autogenerated conversion function.
+func Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in *certmanager.CertificateKeystores, out *v1.CertificateKeystores, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(in, out, s)
+}
+
+func autoConvert_v1_CertificateList_To_certmanager_CertificateList(in *v1.CertificateList, out *certmanager.CertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.Certificate, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Certificate_To_certmanager_Certificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificateList_To_certmanager_CertificateList is an autogenerated conversion function.
+func Convert_v1_CertificateList_To_certmanager_CertificateList(in *v1.CertificateList, out *certmanager.CertificateList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateList_To_certmanager_CertificateList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.CertificateList, out *v1.CertificateList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.Certificate, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_Certificate_To_v1_Certificate(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateList_To_v1_CertificateList is an autogenerated conversion function.
+func Convert_certmanager_CertificateList_To_v1_CertificateList(in *certmanager.CertificateList, out *v1.CertificateList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateList_To_v1_CertificateList(in, out, s)
+}
+
+func autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
+	out.RotationPolicy = certmanager.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
+	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	out.KeyRotationInterval = (*metav1.Duration)(unsafe.Pointer(in.KeyRotationInterval))
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(certmanager.PrivateKeyBackend)
+		if err := Convert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backend = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey is an autogenerated conversion function.
+func Convert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in *v1.CertificatePrivateKey, out *certmanager.CertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_v1_CertificatePrivateKey_To_certmanager_CertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
+	out.RotationPolicy = v1.PrivateKeyRotationPolicy(in.RotationPolicy)
+	out.Encoding = v1.PrivateKeyEncoding(in.Encoding)
+	out.Algorithm = v1.PrivateKeyAlgorithm(in.Algorithm)
+	out.Size = in.Size
+	out.KeyRotationInterval = (*metav1.Duration)(unsafe.Pointer(in.KeyRotationInterval))
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(v1.PrivateKeyBackend)
+		if err := Convert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backend = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey is an autogenerated conversion function.
+func Convert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in *certmanager.CertificatePrivateKey, out *v1.CertificatePrivateKey, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePrivateKey_To_v1_CertificatePrivateKey(in, out, s)
+}
+
+func autoConvert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in *v1.CertificatePrivateKeyEncryption, out *certmanager.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(certmanager.AWSKMSKeyEncryption)
+		if err := Convert_v1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSKMS = nil
+	}
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(certmanager.GCPKMSKeyEncryption)
+		if err := Convert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPKMS = nil
+	}
+	if in.VaultTransit != nil {
+		in, out := &in.VaultTransit, &out.VaultTransit
+		*out = new(certmanager.VaultTransitKeyEncryption)
+		if err := Convert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VaultTransit = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption is an autogenerated conversion function.
+func Convert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in *v1.CertificatePrivateKeyEncryption, out *certmanager.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in, out, s)
+}
+
+func autoConvert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption(in *certmanager.CertificatePrivateKeyEncryption, out *v1.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(v1.AWSKMSKeyEncryption)
+		if err := Convert_certmanager_AWSKMSKeyEncryption_To_v1_AWSKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSKMS = nil
+	}
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(v1.GCPKMSKeyEncryption)
+		if err := Convert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPKMS = nil
+	}
+	if in.VaultTransit != nil {
+		in, out := &in.VaultTransit, &out.VaultTransit
+		*out = new(v1.VaultTransitKeyEncryption)
+		if err := Convert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VaultTransit = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption(in *certmanager.CertificatePrivateKeyEncryption, out *v1.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption(in, out, s)
+}
+
+func autoConvert_v1_CertificateProtectionPolicy_To_certmanager_CertificateProtectionPolicy(in *v1.CertificateProtectionPolicy, out *certmanager.CertificateProtectionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateProtectionPolicy_To_certmanager_CertificateProtectionPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateProtectionPolicy_To_certmanager_CertificateProtectionPolicy(in *v1.CertificateProtectionPolicy, out *certmanager.CertificateProtectionPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateProtectionPolicy_To_certmanager_CertificateProtectionPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateProtectionPolicy_To_v1_CertificateProtectionPolicy(in *certmanager.CertificateProtectionPolicy, out *v1.CertificateProtectionPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateProtectionPolicy_To_v1_CertificateProtectionPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateProtectionPolicy_To_v1_CertificateProtectionPolicy(in *certmanager.CertificateProtectionPolicy, out *v1.CertificateProtectionPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateProtectionPolicy_To_v1_CertificateProtectionPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateProtectionPolicyList_To_certmanager_CertificateProtectionPolicyList(in *v1.CertificateProtectionPolicyList, out *certmanager.CertificateProtectionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateProtectionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateProtectionPolicyList_To_certmanager_CertificateProtectionPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateProtectionPolicyList_To_certmanager_CertificateProtectionPolicyList(in *v1.CertificateProtectionPolicyList, out *certmanager.CertificateProtectionPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateProtectionPolicyList_To_certmanager_CertificateProtectionPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateProtectionPolicyList_To_v1_CertificateProtectionPolicyList(in *certmanager.CertificateProtectionPolicyList, out *v1.CertificateProtectionPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.CertificateProtectionPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateProtectionPolicyList_To_v1_CertificateProtectionPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateProtectionPolicyList_To_v1_CertificateProtectionPolicyList(in *certmanager.CertificateProtectionPolicyList, out *v1.CertificateProtectionPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateProtectionPolicyList_To_v1_CertificateProtectionPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec(in *v1.CertificateProtectionPolicySpec, out *certmanager.CertificateProtectionPolicySpec, s conversion.Scope) error {
+	out.Resources = *(*[]certmanager.ProtectedResourceKind)(unsafe.Pointer(&in.Resources))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	return nil
+}
+
+// Convert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec(in *v1.CertificateProtectionPolicySpec, out *certmanager.CertificateProtectionPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateProtectionPolicySpec_To_certmanager_CertificateProtectionPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec(in *certmanager.CertificateProtectionPolicySpec, out *v1.CertificateProtectionPolicySpec, s conversion.Scope) error {
+	out.Resources = *(*[]v1.ProtectedResourceKind)(unsafe.Pointer(&in.Resources))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	out.Selector = (*metav1.LabelSelector)(unsafe.Pointer(in.Selector))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	return nil
+}
+
+// Convert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec(in *certmanager.CertificateProtectionPolicySpec, out *v1.CertificateProtectionPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateProtectionPolicySpec_To_v1_CertificateProtectionPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequest_To_certmanager_CertificateRequest is an autogenerated conversion function.
+func Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(in *v1.CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequest_To_certmanager_CertificateRequest(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certmanager.CertificateRequest, out *v1.CertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequest_To_v1_CertificateRequest is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(in *certmanager.CertificateRequest, out *v1.CertificateRequest, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequest_To_v1_CertificateRequest(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
+	out.Type = certmanager.CertificateRequestConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition is an autogenerated conversion function.
+func Convert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in *v1.CertificateRequestCondition, out *certmanager.CertificateRequestCondition, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestCondition_To_certmanager_CertificateRequestCondition(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1.CertificateRequestCondition, s conversion.Scope) error {
+	out.Type = v1.CertificateRequestConditionType(in.Type)
+	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in *certmanager.CertificateRequestCondition, out *v1.CertificateRequestCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestCondition_To_v1_CertificateRequestCondition(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in *v1.CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.CertificateRequest, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateRequest_To_certmanager_CertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestList_To_certmanager_CertificateRequestList is an autogenerated conversion function.
+func Convert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in *v1.CertificateRequestList, out *certmanager.CertificateRequestList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestList_To_certmanager_CertificateRequestList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in *certmanager.CertificateRequestList, out *v1.CertificateRequestList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.CertificateRequest, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateRequest_To_v1_CertificateRequest(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in *certmanager.CertificateRequestList, out *v1.CertificateRequestList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestList_To_v1_CertificateRequestList(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in *v1.CertificateRequestPolicy, out *certmanager.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicy_To_certmanager_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in *certmanager.CertificateRequestPolicy, out *v1.CertificateRequestPolicy, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicy_To_v1_CertificateRequestPolicy(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *v1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in *v1.CertificateRequestPolicyIssuerRef, out *certmanager.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *v1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Kind = in.Kind
+	out.Group = in.Group
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in *certmanager.CertificateRequestPolicyIssuerRef, out *v1.CertificateRequestPolicyIssuerRef, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in *v1.CertificateRequestPolicyList, out *certmanager.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyList_To_certmanager_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.CertificateRequestPolicy)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in *certmanager.CertificateRequestPolicyList, out *v1.CertificateRequestPolicyList, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyList_To_v1_CertificateRequestPolicyList(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicyRule_To_certmanager_CertificateRequestPolicyRule(in *v1.CertificateRequestPolicyRule, out *certmanager.CertificateRequestPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicyRule_To_certmanager_CertificateRequestPolicyRule is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicyRule_To_certmanager_CertificateRequestPolicyRule(in *v1.CertificateRequestPolicyRule, out *certmanager.CertificateRequestPolicyRule, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicyRule_To_certmanager_CertificateRequestPolicyRule(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicyRule_To_v1_CertificateRequestPolicyRule(in *certmanager.CertificateRequestPolicyRule, out *v1.CertificateRequestPolicyRule, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Expression = in.Expression
+	out.Message = in.Message
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicyRule_To_v1_CertificateRequestPolicyRule is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicyRule_To_v1_CertificateRequestPolicyRule(in *certmanager.CertificateRequestPolicyRule, out *v1.CertificateRequestPolicyRule, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicyRule_To_v1_CertificateRequestPolicyRule(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in *v1.CertificateRequestPolicySelector, out *certmanager.CertificateRequestPolicySelector, s conversion.Scope) error {
+	if err := Convert_v1_CertificateRequestPolicyIssuerRef_To_certmanager_CertificateRequestPolicyIssuerRef(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in *v1.CertificateRequestPolicySelector, out *certmanager.CertificateRequestPolicySelector, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in *certmanager.CertificateRequestPolicySelector, out *v1.CertificateRequestPolicySelector, s conversion.Scope) error {
+	if err := Convert_certmanager_CertificateRequestPolicyIssuerRef_To_v1_CertificateRequestPolicyIssuerRef(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in *certmanager.CertificateRequestPolicySelector, out *v1.CertificateRequestPolicySelector, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	if err := Convert_v1_CertificateRequestPolicySelector_To_certmanager_CertificateRequestPolicySelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Rules = *(*[]certmanager.CertificateRequestPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in *v1.CertificateRequestPolicySpec, out *certmanager.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestPolicySpec_To_certmanager_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	if err := Convert_certmanager_CertificateRequestPolicySelector_To_v1_CertificateRequestPolicySelector(&in.Selector, &out.Selector, s); err != nil {
+		return err
+	}
+	out.Rules = *(*[]v1.CertificateRequestPolicyRule)(unsafe.Pointer(&in.Rules))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in *certmanager.CertificateRequestPolicySpec, out *v1.CertificateRequestPolicySpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestPolicySpec_To_v1_CertificateRequestPolicySpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.IsCA = in.IsCA
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec is an autogenerated conversion function.
+func Convert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in *v1.CertificateRequestSpec, out *certmanager.CertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_v1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1.CertificateRequestSpec, s conversion.Scope) error {
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.Request = *(*[]byte)(unsafe.Pointer(&in.Request))
+	out.IsCA = in.IsCA
+	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.Username = in.Username
+	out.UID = in.UID
+	out.Groups = *(*[]string)(unsafe.Pointer(&in.Groups))
+	out.Extra = *(*map[string][]string)(unsafe.Pointer(&in.Extra))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in *certmanager.CertificateRequestSpec, out *v1.CertificateRequestSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestSpec_To_v1_CertificateRequestSpec(in, out, s)
+}
+
+func autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(in *v1.CertificateRequestStatus, out *certmanager.CertificateRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
 	return nil
 }
 
@@ -770,511 +2350,1615 @@ func Convert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus
 	return autoConvert_v1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in *certmanager.CertificateRequestStatus, out *v1.CertificateRequestStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]v1.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
-	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
-	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
-	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+func autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in *certmanager.CertificateRequestStatus, out *v1.CertificateRequestStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.CertificateRequestCondition)(unsafe.Pointer(&in.Conditions))
+	out.Certificate = *(*[]byte)(unsafe.Pointer(&in.Certificate))
+	out.CA = *(*[]byte)(unsafe.Pointer(&in.CA))
+	out.FailureTime = (*metav1.Time)(unsafe.Pointer(in.FailureTime))
+	return nil
+}
+
+// Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in *certmanager.CertificateRequestStatus, out *v1.CertificateRequestStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
+}
+
+func autoConvert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in *v1.CertificateSecretTargets, out *certmanager.CertificateSecretTargets, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets is an autogenerated conversion function.
+func Convert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in *v1.CertificateSecretTargets, out *certmanager.CertificateSecretTargets, s conversion.Scope) error {
+	return autoConvert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets(in *certmanager.CertificateSecretTargets, out *v1.CertificateSecretTargets, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*metav1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets(in *certmanager.CertificateSecretTargets, out *v1.CertificateSecretTargets, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets(in, out, s)
+}
+
+func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
+	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
+	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in, out, s)
+}
+
+func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
+	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
+	out.LiteralSubject = in.LiteralSubject
+	out.CommonName = in.CommonName
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
+	// WARNING: in.EmailAddresses requires manual conversion: does not exist in peer-type
+	out.SecretName = in.SecretName
+	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	if in.Keystores != nil {
+		in, out := &in.Keystores, &out.Keystores
+		*out = new(certmanager.CertificateKeystores)
+		if err := Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Keystores = nil
+	}
+	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.IsCA = in.IsCA
+	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
+	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.RevisionHistoryTTL = (*metav1.Duration)(unsafe.Pointer(in.RevisionHistoryTTL))
+	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CanaryRenewal = in.CanaryRenewal
+	out.MaxPathLen = (*int32)(unsafe.Pointer(in.MaxPathLen))
+	if in.NameConstraints != nil {
+		in, out := &in.NameConstraints, &out.NameConstraints
+		*out = new(certmanager.NameConstraints)
+		if err := Convert_v1_NameConstraints_To_certmanager_NameConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NameConstraints = nil
+	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.FailoverAttempts = (*int32)(unsafe.Pointer(in.FailoverAttempts))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	if in.SecretTargets != nil {
+		in, out := &in.SecretTargets, &out.SecretTargets
+		*out = new(certmanager.CertificateSecretTargets)
+		if err := Convert_v1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTargets = nil
+	}
+	if in.ExternalSecretStores != nil {
+		in, out := &in.ExternalSecretStores, &out.ExternalSecretStores
+		*out = make([]certmanager.CertificateExternalSecretStore, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalSecretStores = nil
+	}
+	if in.OtherNames != nil {
+		in, out := &in.OtherNames, &out.OtherNames
+		*out = make([]certmanager.OtherName, len(*in))
+		for i := range *in {
+			if err := Convert_v1_OtherName_To_certmanager_OtherName(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OtherNames = nil
+	}
+	if in.ExtraExtensions != nil {
+		in, out := &in.ExtraExtensions, &out.ExtraExtensions
+		*out = make([]certmanager.CertificateExtension, len(*in))
+		for i := range *in {
+			if err := Convert_v1_CertificateExtension_To_certmanager_CertificateExtension(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraExtensions = nil
+	}
+	out.CertificatePolicies = *(*[]string)(unsafe.Pointer(&in.CertificatePolicies))
+	out.ExtendedKeyUsageOIDs = *(*[]string)(unsafe.Pointer(&in.ExtendedKeyUsageOIDs))
+	if in.PrivateKeyEncryption != nil {
+		in, out := &in.PrivateKeyEncryption, &out.PrivateKeyEncryption
+		*out = new(certmanager.CertificatePrivateKeyEncryption)
+		if err := Convert_v1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKeyEncryption = nil
+	}
+	return nil
+}
+
+func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanager.CertificateSpec, out *v1.CertificateSpec, s conversion.Scope) error {
+	out.Subject = (*v1.X509Subject)(unsafe.Pointer(in.Subject))
+	out.LiteralSubject = in.LiteralSubject
+	out.CommonName = in.CommonName
+	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
+	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
+	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
+	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
+	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
+	// WARNING: in.EmailSANs requires manual conversion: does not exist in peer-type
+	out.SecretName = in.SecretName
+	out.SecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
+	if in.Keystores != nil {
+		in, out := &in.Keystores, &out.Keystores
+		*out = new(v1.CertificateKeystores)
+		if err := Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Keystores = nil
+	}
+	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+		return err
+	}
+	out.IsCA = in.IsCA
+	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
+	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
+	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
+	out.SignatureAlgorithm = v1.SignatureAlgorithm(in.SignatureAlgorithm)
+	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.RevisionHistoryTTL = (*metav1.Duration)(unsafe.Pointer(in.RevisionHistoryTTL))
+	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CanaryRenewal = in.CanaryRenewal
+	out.MaxPathLen = (*int32)(unsafe.Pointer(in.MaxPathLen))
+	if in.NameConstraints != nil {
+		in, out := &in.NameConstraints, &out.NameConstraints
+		*out = new(v1.NameConstraints)
+		if err := Convert_certmanager_NameConstraints_To_v1_NameConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NameConstraints = nil
+	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]apismetav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.FailoverAttempts = (*int32)(unsafe.Pointer(in.FailoverAttempts))
+	out.RenewalJitter = (*metav1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	if in.SecretTargets != nil {
+		in, out := &in.SecretTargets, &out.SecretTargets
+		*out = new(v1.CertificateSecretTargets)
+		if err := Convert_certmanager_CertificateSecretTargets_To_v1_CertificateSecretTargets(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTargets = nil
+	}
+	if in.ExternalSecretStores != nil {
+		in, out := &in.ExternalSecretStores, &out.ExternalSecretStores
+		*out = make([]v1.CertificateExternalSecretStore, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateExternalSecretStore_To_v1_CertificateExternalSecretStore(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalSecretStores = nil
+	}
+	if in.OtherNames != nil {
+		in, out := &in.OtherNames, &out.OtherNames
+		*out = make([]v1.OtherName, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_OtherName_To_v1_OtherName(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OtherNames = nil
+	}
+	if in.ExtraExtensions != nil {
+		in, out := &in.ExtraExtensions, &out.ExtraExtensions
+		*out = make([]v1.CertificateExtension, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateExtension_To_v1_CertificateExtension(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraExtensions = nil
+	}
+	out.CertificatePolicies = *(*[]string)(unsafe.Pointer(&in.CertificatePolicies))
+	out.ExtendedKeyUsageOIDs = *(*[]string)(unsafe.Pointer(&in.ExtendedKeyUsageOIDs))
+	if in.PrivateKeyEncryption != nil {
+		in, out := &in.PrivateKeyEncryption, &out.PrivateKeyEncryption
+		*out = new(v1.CertificatePrivateKeyEncryption)
+		if err := Convert_certmanager_CertificatePrivateKeyEncryption_To_v1_CertificatePrivateKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKeyEncryption = nil
+	}
+	return nil
+}
+
+func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	return nil
+}
+
+// Convert_v1_CertificateStatus_To_certmanager_CertificateStatus is an autogenerated conversion function.
+func Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
+	return autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
+	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
+	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
+	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
+	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
+	out.Revision = (*int)(unsafe.Pointer(in.Revision))
+	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
+	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+	return nil
+}
+
+// Convert_certmanager_CertificateStatus_To_v1_CertificateStatus is an autogenerated conversion function.
+func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
+}
+
+func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer is an autogenerated conversion function.
+func Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
+	return autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in *certmanager.ClusterIssuer, out *v1.ClusterIssuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer is an autogenerated conversion function.
+func Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in *certmanager.ClusterIssuer, out *v1.ClusterIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in, out, s)
+}
+
+func autoConvert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in *v1.ClusterIssuerList, out *certmanager.ClusterIssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.ClusterIssuer, len(*in))
+		for i := range *in {
+			if err := Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList is an autogenerated conversion function.
+func Convert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in *v1.ClusterIssuerList, out *certmanager.ClusterIssuerList, s conversion.Scope) error {
+	return autoConvert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in, out, s)
+}
+
+func autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanager.ClusterIssuerList, out *v1.ClusterIssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.ClusterIssuer, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList is an autogenerated conversion function.
+func Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanager.ClusterIssuerList, out *v1.ClusterIssuerList, s conversion.Scope) error {
+	return autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in, out, s)
+}
+
+func autoConvert_v1_CredentialGrant_To_certmanager_CredentialGrant(in *v1.CredentialGrant, out *certmanager.CredentialGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_CredentialGrant_To_certmanager_CredentialGrant is an autogenerated conversion function.
+func Convert_v1_CredentialGrant_To_certmanager_CredentialGrant(in *v1.CredentialGrant, out *certmanager.CredentialGrant, s conversion.Scope) error {
+	return autoConvert_v1_CredentialGrant_To_certmanager_CredentialGrant(in, out, s)
+}
+
+func autoConvert_certmanager_CredentialGrant_To_v1_CredentialGrant(in *certmanager.CredentialGrant, out *v1.CredentialGrant, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_CredentialGrant_To_v1_CredentialGrant is an autogenerated conversion function.
+func Convert_certmanager_CredentialGrant_To_v1_CredentialGrant(in *certmanager.CredentialGrant, out *v1.CredentialGrant, s conversion.Scope) error {
+	return autoConvert_certmanager_CredentialGrant_To_v1_CredentialGrant(in, out, s)
+}
+
+func autoConvert_v1_CredentialGrantList_To_certmanager_CredentialGrantList(in *v1.CredentialGrantList, out *certmanager.CredentialGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]certmanager.CredentialGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_v1_CredentialGrantList_To_certmanager_CredentialGrantList is an autogenerated conversion function.
+func Convert_v1_CredentialGrantList_To_certmanager_CredentialGrantList(in *v1.CredentialGrantList, out *certmanager.CredentialGrantList, s conversion.Scope) error {
+	return autoConvert_v1_CredentialGrantList_To_certmanager_CredentialGrantList(in, out, s)
+}
+
+func autoConvert_certmanager_CredentialGrantList_To_v1_CredentialGrantList(in *certmanager.CredentialGrantList, out *v1.CredentialGrantList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	out.Items = *(*[]v1.CredentialGrant)(unsafe.Pointer(&in.Items))
+	return nil
+}
+
+// Convert_certmanager_CredentialGrantList_To_v1_CredentialGrantList is an autogenerated conversion function.
+func Convert_certmanager_CredentialGrantList_To_v1_CredentialGrantList(in *certmanager.CredentialGrantList, out *v1.CredentialGrantList, s conversion.Scope) error {
+	return autoConvert_certmanager_CredentialGrantList_To_v1_CredentialGrantList(in, out, s)
+}
+
+func autoConvert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec(in *v1.CredentialGrantSpec, out *certmanager.CredentialGrantSpec, s conversion.Scope) error {
+	out.ClusterIssuers = *(*[]string)(unsafe.Pointer(&in.ClusterIssuers))
+	return nil
+}
+
+// Convert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec is an autogenerated conversion function.
+func Convert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec(in *v1.CredentialGrantSpec, out *certmanager.CredentialGrantSpec, s conversion.Scope) error {
+	return autoConvert_v1_CredentialGrantSpec_To_certmanager_CredentialGrantSpec(in, out, s)
+}
+
+func autoConvert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec(in *certmanager.CredentialGrantSpec, out *v1.CredentialGrantSpec, s conversion.Scope) error {
+	out.ClusterIssuers = *(*[]string)(unsafe.Pointer(&in.ClusterIssuers))
+	return nil
+}
+
+// Convert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec is an autogenerated conversion function.
+func Convert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec(in *certmanager.CredentialGrantSpec, out *v1.CredentialGrantSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_CredentialGrantSpec_To_v1_CredentialGrantSpec(in, out, s)
+}
+
+func autoConvert_v1_EJBCAAuth_To_certmanager_EJBCAAuth(in *v1.EJBCAAuth, out *certmanager.EJBCAAuth, s conversion.Scope) error {
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.LocalObjectReference)
+		if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TokenSecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_EJBCAAuth_To_certmanager_EJBCAAuth is an autogenerated conversion function.
+func Convert_v1_EJBCAAuth_To_certmanager_EJBCAAuth(in *v1.EJBCAAuth, out *certmanager.EJBCAAuth, s conversion.Scope) error {
+	return autoConvert_v1_EJBCAAuth_To_certmanager_EJBCAAuth(in, out, s)
+}
+
+func autoConvert_certmanager_EJBCAAuth_To_v1_EJBCAAuth(in *certmanager.EJBCAAuth, out *v1.EJBCAAuth, s conversion.Scope) error {
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(apismetav1.LocalObjectReference)
+		if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TokenSecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_EJBCAAuth_To_v1_EJBCAAuth is an autogenerated conversion function.
+func Convert_certmanager_EJBCAAuth_To_v1_EJBCAAuth(in *certmanager.EJBCAAuth, out *v1.EJBCAAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_EJBCAAuth_To_v1_EJBCAAuth(in, out, s)
+}
+
+func autoConvert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in *v1.EJBCAIssuer, out *certmanager.EJBCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.CertificateAuthorityName = in.CertificateAuthorityName
+	out.CertificateProfileName = in.CertificateProfileName
+	out.EndEntityProfileName = in.EndEntityProfileName
+	out.EndEntityName = in.EndEntityName
+	if err := Convert_v1_EJBCAAuth_To_certmanager_EJBCAAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer is an autogenerated conversion function.
+func Convert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in *v1.EJBCAIssuer, out *certmanager.EJBCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer(in *certmanager.EJBCAIssuer, out *v1.EJBCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.CertificateAuthorityName = in.CertificateAuthorityName
+	out.CertificateProfileName = in.CertificateProfileName
+	out.EndEntityProfileName = in.EndEntityProfileName
+	out.EndEntityName = in.EndEntityName
+	if err := Convert_certmanager_EJBCAAuth_To_v1_EJBCAAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer(in *certmanager.EJBCAIssuer, out *v1.EJBCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer(in, out, s)
+}
+
+func autoConvert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in *v1.GCPKMSKeyEncryption, out *certmanager.GCPKMSKeyEncryption, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption is an autogenerated conversion function.
+func Convert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in *v1.GCPKMSKeyEncryption, out *certmanager.GCPKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in, out, s)
+}
+
+func autoConvert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption(in *certmanager.GCPKMSKeyEncryption, out *v1.GCPKMSKeyEncryption, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption(in *certmanager.GCPKMSKeyEncryption, out *v1.GCPKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPKMSKeyEncryption_To_v1_GCPKMSKeyEncryption(in, out, s)
+}
+
+func autoConvert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in *v1.GCPKMSPrivateKeyBackend, out *certmanager.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.KeyVersionName = in.KeyVersionName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in *v1.GCPKMSPrivateKeyBackend, out *certmanager.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend(in *certmanager.GCPKMSPrivateKeyBackend, out *v1.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.KeyVersionName = in.KeyVersionName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend(in *certmanager.GCPKMSPrivateKeyBackend, out *v1.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in *v1.GCPSecretManagerSecretStore, out *certmanager.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	out.Project = in.Project
+	out.SecretID = in.SecretID
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore is an autogenerated conversion function.
+func Convert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in *v1.GCPSecretManagerSecretStore, out *certmanager.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_v1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore(in *certmanager.GCPSecretManagerSecretStore, out *v1.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	out.Project = in.Project
+	out.SecretID = in.SecretID
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore is an autogenerated conversion function.
+func Convert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore(in *certmanager.GCPSecretManagerSecretStore, out *v1.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPSecretManagerSecretStore_To_v1_GCPSecretManagerSecretStore(in, out, s)
+}
+
+func autoConvert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *v1.GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPoolID = in.CAPoolID
+	out.CertificateAuthorityID = in.CertificateAuthorityID
+	out.CertificateTemplate = in.CertificateTemplate
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *v1.GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *v1.GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPoolID = in.CAPoolID
+	out.CertificateAuthorityID = in.CertificateAuthorityID
+	out.CertificateTemplate = in.CertificateTemplate
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(apismetav1.SecretKeySelector)
+		if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *v1.GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_v1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in *v1.IssuanceLatencyBudget, out *certmanager.IssuanceLatencyBudget, s conversion.Scope) error {
+	out.Average = (*metav1.Duration)(unsafe.Pointer(in.Average))
+	out.P95 = (*metav1.Duration)(unsafe.Pointer(in.P95))
+	out.Samples = in.Samples
+	return nil
+}
+
+// Convert_v1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget is an autogenerated conversion function.
+func Convert_v1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in *v1.IssuanceLatencyBudget, out *certmanager.IssuanceLatencyBudget, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceLatencyBudget_To_v1_IssuanceLatencyBudget(in *certmanager.IssuanceLatencyBudget, out *v1.IssuanceLatencyBudget, s conversion.Scope) error {
+	out.Average = (*metav1.Duration)(unsafe.Pointer(in.Average))
+	out.P95 = (*metav1.Duration)(unsafe.Pointer(in.P95))
+	out.Samples = in.Samples
+	return nil
+}
+
+// Convert_certmanager_IssuanceLatencyBudget_To_v1_IssuanceLatencyBudget is an autogenerated conversion function.
+func Convert_certmanager_IssuanceLatencyBudget_To_v1_IssuanceLatencyBudget(in *certmanager.IssuanceLatencyBudget, out *v1.IssuanceLatencyBudget, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceLatencyBudget_To_v1_IssuanceLatencyBudget(in, out, s)
+}
+
+func autoConvert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in *v1.IssuanceRateLimit, out *certmanager.IssuanceRateLimit, s conversion.Scope) error {
+	out.MaxRequests = in.MaxRequests
+	out.Period = (*metav1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit is an autogenerated conversion function.
+func Convert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in *v1.IssuanceRateLimit, out *certmanager.IssuanceRateLimit, s conversion.Scope) error {
+	return autoConvert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit(in *certmanager.IssuanceRateLimit, out *v1.IssuanceRateLimit, s conversion.Scope) error {
+	out.MaxRequests = in.MaxRequests
+	out.Period = (*metav1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit is an autogenerated conversion function.
+func Convert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit(in *certmanager.IssuanceRateLimit, out *v1.IssuanceRateLimit, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit(in, out, s)
+}
+
+func autoConvert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_Issuer_To_certmanager_Issuer is an autogenerated conversion function.
+func Convert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
+	return autoConvert_v1_Issuer_To_certmanager_Issuer(in, out, s)
+}
+
+func autoConvert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Issuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_Issuer_To_v1_Issuer is an autogenerated conversion function.
+func Convert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Issuer, s conversion.Scope) error {
+	return autoConvert_certmanager_Issuer_To_v1_Issuer(in, out, s)
+}
+
+func autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
+	out.Type = certmanager.IssuerConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1_IssuerCondition_To_certmanager_IssuerCondition is an autogenerated conversion function.
+func Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
+	return autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *v1.IssuerCondition, s conversion.Scope) error {
+	out.Type = v1.IssuerConditionType(in.Type)
+	out.Status = apismetav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_IssuerCondition_To_v1_IssuerCondition is an autogenerated conversion function.
+func Convert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *v1.IssuerCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in, out, s)
+}
+
+func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acme.ACMEIssuer)
+		if err := acmev1.Convert_v1_ACMEIssuer_To_acme_ACMEIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ACME = nil
+	}
+	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(certmanager.VaultIssuer)
+		if err := Convert_v1_VaultIssuer_To_certmanager_VaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	out.SelfSigned = (*certmanager.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(certmanager.VenafiIssuer)
+		if err := Convert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Venafi = nil
+	}
+	if in.AWSPCA != nil {
+		in, out := &in.AWSPCA, &out.AWSPCA
+		*out = new(certmanager.AWSPCAIssuer)
+		if err := Convert_v1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSPCA = nil
+	}
+	if in.GoogleCAS != nil {
+		in, out := &in.GoogleCAS, &out.GoogleCAS
+		*out = new(certmanager.GoogleCASIssuer)
+		if err := Convert_v1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCAS = nil
+	}
+	if in.AzureKeyVault != nil {
+		in, out := &in.AzureKeyVault, &out.AzureKeyVault
+		*out = new(certmanager.AzureKeyVaultIssuer)
+		if err := Convert_v1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AzureKeyVault = nil
+	}
+	if in.EJBCA != nil {
+		in, out := &in.EJBCA, &out.EJBCA
+		*out = new(certmanager.EJBCAIssuer)
+		if err := Convert_v1_EJBCAIssuer_To_certmanager_EJBCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EJBCA = nil
+	}
+	if in.StepCA != nil {
+		in, out := &in.StepCA, &out.StepCA
+		*out = new(certmanager.StepCAIssuer)
+		if err := Convert_v1_StepCAIssuer_To_certmanager_StepCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StepCA = nil
+	}
+	if in.KubernetesCSR != nil {
+		in, out := &in.KubernetesCSR, &out.KubernetesCSR
+		*out = new(certmanager.KubernetesCSRIssuer)
+		if err := Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubernetesCSR = nil
+	}
+	if in.SPIRE != nil {
+		in, out := &in.SPIRE, &out.SPIRE
+		*out = new(certmanager.SPIREIssuer)
+		if err := Convert_v1_SPIREIssuer_To_certmanager_SPIREIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SPIRE = nil
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(certmanager.PluginIssuer)
+		if err := Convert_v1_PluginIssuer_To_certmanager_PluginIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugin = nil
+	}
+	return nil
+}
+
+// Convert_v1_IssuerConfig_To_certmanager_IssuerConfig is an autogenerated conversion function.
+func Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
+	return autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.IssuerConfig, out *v1.IssuerConfig, s conversion.Scope) error {
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(apisacmev1.ACMEIssuer)
+		if err := acmev1.Convert_acme_ACMEIssuer_To_v1_ACMEIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ACME = nil
+	}
+	out.CA = (*v1.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(v1.VaultIssuer)
+		if err := Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	out.SelfSigned = (*v1.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(v1.VenafiIssuer)
+		if err := Convert_certmanager_VenafiIssuer_To_v1_VenafiIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Venafi = nil
+	}
+	if in.AWSPCA != nil {
+		in, out := &in.AWSPCA, &out.AWSPCA
+		*out = new(v1.AWSPCAIssuer)
+		if err := Convert_certmanager_AWSPCAIssuer_To_v1_AWSPCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSPCA = nil
+	}
+	if in.GoogleCAS != nil {
+		in, out := &in.GoogleCAS, &out.GoogleCAS
+		*out = new(v1.GoogleCASIssuer)
+		if err := Convert_certmanager_GoogleCASIssuer_To_v1_GoogleCASIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCAS = nil
+	}
+	if in.AzureKeyVault != nil {
+		in, out := &in.AzureKeyVault, &out.AzureKeyVault
+		*out = new(v1.AzureKeyVaultIssuer)
+		if err := Convert_certmanager_AzureKeyVaultIssuer_To_v1_AzureKeyVaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AzureKeyVault = nil
+	}
+	if in.EJBCA != nil {
+		in, out := &in.EJBCA, &out.EJBCA
+		*out = new(v1.EJBCAIssuer)
+		if err := Convert_certmanager_EJBCAIssuer_To_v1_EJBCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EJBCA = nil
+	}
+	if in.StepCA != nil {
+		in, out := &in.StepCA, &out.StepCA
+		*out = new(v1.StepCAIssuer)
+		if err := Convert_certmanager_StepCAIssuer_To_v1_StepCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StepCA = nil
+	}
+	if in.KubernetesCSR != nil {
+		in, out := &in.KubernetesCSR, &out.KubernetesCSR
+		*out = new(v1.KubernetesCSRIssuer)
+		if err := Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubernetesCSR = nil
+	}
+	if in.SPIRE != nil {
+		in, out := &in.SPIRE, &out.SPIRE
+		*out = new(v1.SPIREIssuer)
+		if err := Convert_certmanager_SPIREIssuer_To_v1_SPIREIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SPIRE = nil
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(v1.PluginIssuer)
+		if err := Convert_certmanager_PluginIssuer_To_v1_PluginIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugin = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuerConfig_To_v1_IssuerConfig is an autogenerated conversion function.
+func Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.IssuerConfig, out *v1.IssuerConfig, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in, out, s)
+}
+
+func autoConvert_v1_IssuerList_To_certmanager_IssuerList(in *v1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.Issuer, len(*in))
+		for i := range *in {
+			if err := Convert_v1_Issuer_To_certmanager_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1_IssuerList_To_certmanager_IssuerList is an autogenerated conversion function.
+func Convert_v1_IssuerList_To_certmanager_IssuerList(in *v1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
+	return autoConvert_v1_IssuerList_To_certmanager_IssuerList(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList, out *v1.IssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]v1.Issuer, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_Issuer_To_v1_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuerList_To_v1_IssuerList is an autogenerated conversion function.
+func Convert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList, out *v1.IssuerList, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerList_To_v1_IssuerList(in, out, s)
+}
+
+func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
+	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
+		return err
+	}
+	out.IssuanceLatencyBudget = (*certmanager.IssuanceLatencyBudget)(unsafe.Pointer(in.IssuanceLatencyBudget))
+	out.CredentialsNamespace = in.CredentialsNamespace
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(certmanager.IssuanceRateLimit)
+		if err := Convert_v1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RateLimit = nil
+	}
+	return nil
+}
+
+// Convert_v1_IssuerSpec_To_certmanager_IssuerSpec is an autogenerated conversion function.
+func Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
+	return autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerSpec, out *v1.IssuerSpec, s conversion.Scope) error {
+	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
+		return err
+	}
+	out.IssuanceLatencyBudget = (*v1.IssuanceLatencyBudget)(unsafe.Pointer(in.IssuanceLatencyBudget))
+	out.CredentialsNamespace = in.CredentialsNamespace
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(v1.IssuanceRateLimit)
+		if err := Convert_certmanager_IssuanceRateLimit_To_v1_IssuanceRateLimit(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RateLimit = nil
+	}
 	return nil
 }
 
-// Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus is an autogenerated conversion function.
-func Convert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in *certmanager.CertificateRequestStatus, out *v1.CertificateRequestStatus, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateRequestStatus_To_v1_CertificateRequestStatus(in, out, s)
+// Convert_certmanager_IssuerSpec_To_v1_IssuerSpec is an autogenerated conversion function.
+func Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerSpec, out *v1.IssuerSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in, out, s)
 }
 
-func autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
-	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+func autoConvert_v1_IssuerStatus_To_certmanager_IssuerStatus(in *v1.IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.IssuerCondition)(unsafe.Pointer(&in.Conditions))
+	out.ACME = (*acme.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
-// Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate is an autogenerated conversion function.
-func Convert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *v1.CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
-	return autoConvert_v1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in, out, s)
+// Convert_v1_IssuerStatus_To_certmanager_IssuerStatus is an autogenerated conversion function.
+func Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(in *v1.IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
+	return autoConvert_v1_IssuerStatus_To_certmanager_IssuerStatus(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
-	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
-	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
+func autoConvert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerStatus, out *v1.IssuerStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]v1.IssuerCondition)(unsafe.Pointer(&in.Conditions))
+	out.ACME = (*apisacmev1.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
 	return nil
 }
 
-// Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate is an autogenerated conversion function.
-func Convert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in *certmanager.CertificateSecretTemplate, out *v1.CertificateSecretTemplate, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateSecretTemplate_To_v1_CertificateSecretTemplate(in, out, s)
+// Convert_certmanager_IssuerStatus_To_v1_IssuerStatus is an autogenerated conversion function.
+func Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerStatus, out *v1.IssuerStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerStatus_To_v1_IssuerStatus(in, out, s)
 }
 
-func autoConvert_v1_CertificateSpec_To_certmanager_CertificateSpec(in *v1.CertificateSpec, out *certmanager.CertificateSpec, s conversion.Scope) error {
-	out.Subject = (*certmanager.X509Subject)(unsafe.Pointer(in.Subject))
-	out.LiteralSubject = in.LiteralSubject
-	out.CommonName = in.CommonName
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
-	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
-	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	// WARNING: in.URIs requires manual conversion: does not exist in peer-type
-	// WARNING: in.EmailAddresses requires manual conversion: does not exist in peer-type
-	out.SecretName = in.SecretName
-	out.SecretTemplate = (*certmanager.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
-	if in.Keystores != nil {
-		in, out := &in.Keystores, &out.Keystores
-		*out = new(certmanager.CertificateKeystores)
-		if err := Convert_v1_CertificateKeystores_To_certmanager_CertificateKeystores(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Keystores = nil
-	}
-	if err := internalapismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+func autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
-	out.IsCA = in.IsCA
-	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
-	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
-	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
-	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
 	return nil
 }
 
-func autoConvert_certmanager_CertificateSpec_To_v1_CertificateSpec(in *certmanager.CertificateSpec, out *v1.CertificateSpec, s conversion.Scope) error {
-	out.Subject = (*v1.X509Subject)(unsafe.Pointer(in.Subject))
-	out.LiteralSubject = in.LiteralSubject
-	out.CommonName = in.CommonName
-	out.Duration = (*metav1.Duration)(unsafe.Pointer(in.Duration))
-	out.RenewBefore = (*metav1.Duration)(unsafe.Pointer(in.RenewBefore))
-	out.DNSNames = *(*[]string)(unsafe.Pointer(&in.DNSNames))
-	out.IPAddresses = *(*[]string)(unsafe.Pointer(&in.IPAddresses))
-	// WARNING: in.URISANs requires manual conversion: does not exist in peer-type
-	// WARNING: in.EmailSANs requires manual conversion: does not exist in peer-type
-	out.SecretName = in.SecretName
-	out.SecretTemplate = (*v1.CertificateSecretTemplate)(unsafe.Pointer(in.SecretTemplate))
-	if in.Keystores != nil {
-		in, out := &in.Keystores, &out.Keystores
-		*out = new(v1.CertificateKeystores)
-		if err := Convert_certmanager_CertificateKeystores_To_v1_CertificateKeystores(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.Keystores = nil
-	}
-	if err := internalapismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&in.IssuerRef, &out.IssuerRef, s); err != nil {
+// Convert_v1_JKSKeystore_To_certmanager_JKSKeystore is an autogenerated conversion function.
+func Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
+	return autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in, out, s)
+}
+
+func autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *v1.JKSKeystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
-	out.IsCA = in.IsCA
-	out.Usages = *(*[]v1.KeyUsage)(unsafe.Pointer(&in.Usages))
-	out.PrivateKey = (*v1.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
-	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
-	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
-	out.AdditionalOutputFormats = *(*[]v1.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
 	return nil
 }
 
-func autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]certmanager.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
-	out.Revision = (*int)(unsafe.Pointer(in.Revision))
-	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
-	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+// Convert_certmanager_JKSKeystore_To_v1_JKSKeystore is an autogenerated conversion function.
+func Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *v1.JKSKeystore, s conversion.Scope) error {
+	return autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in, out, s)
+}
+
+func autoConvert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *v1.KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
 	return nil
 }
 
-// Convert_v1_CertificateStatus_To_certmanager_CertificateStatus is an autogenerated conversion function.
-func Convert_v1_CertificateStatus_To_certmanager_CertificateStatus(in *v1.CertificateStatus, out *certmanager.CertificateStatus, s conversion.Scope) error {
-	return autoConvert_v1_CertificateStatus_To_certmanager_CertificateStatus(in, out, s)
+// Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *v1.KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_v1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]v1.CertificateCondition)(unsafe.Pointer(&in.Conditions))
-	out.LastFailureTime = (*metav1.Time)(unsafe.Pointer(in.LastFailureTime))
-	out.NotBefore = (*metav1.Time)(unsafe.Pointer(in.NotBefore))
-	out.NotAfter = (*metav1.Time)(unsafe.Pointer(in.NotAfter))
-	out.RenewalTime = (*metav1.Time)(unsafe.Pointer(in.RenewalTime))
-	out.Revision = (*int)(unsafe.Pointer(in.Revision))
-	out.NextPrivateKeySecretName = (*string)(unsafe.Pointer(in.NextPrivateKeySecretName))
-	out.FailedIssuanceAttempts = (*int)(unsafe.Pointer(in.FailedIssuanceAttempts))
+func autoConvert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *v1.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
 	return nil
 }
 
-// Convert_certmanager_CertificateStatus_To_v1_CertificateStatus is an autogenerated conversion function.
-func Convert_certmanager_CertificateStatus_To_v1_CertificateStatus(in *certmanager.CertificateStatus, out *v1.CertificateStatus, s conversion.Scope) error {
-	return autoConvert_certmanager_CertificateStatus_To_v1_CertificateStatus(in, out, s)
+// Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *v1.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_KubernetesCSRIssuer_To_v1_KubernetesCSRIssuer(in, out, s)
 }
 
-func autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(in *v1.NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
+	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.URIDomains = *(*[]string)(unsafe.Pointer(&in.URIDomains))
 	return nil
 }
 
-// Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer is an autogenerated conversion function.
-func Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in *v1.ClusterIssuer, out *certmanager.ClusterIssuer, s conversion.Scope) error {
-	return autoConvert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(in, out, s)
+// Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem is an autogenerated conversion function.
+func Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(in *v1.NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
+	return autoConvert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(in, out, s)
 }
 
-func autoConvert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in *certmanager.ClusterIssuer, out *v1.ClusterIssuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(in *certmanager.NameConstraintItem, out *v1.NameConstraintItem, s conversion.Scope) error {
+	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.URIDomains = *(*[]string)(unsafe.Pointer(&in.URIDomains))
 	return nil
 }
 
-// Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer is an autogenerated conversion function.
-func Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in *certmanager.ClusterIssuer, out *v1.ClusterIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(in, out, s)
+// Convert_certmanager_NameConstraintItem_To_v1_NameConstraintItem is an autogenerated conversion function.
+func Convert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(in *certmanager.NameConstraintItem, out *v1.NameConstraintItem, s conversion.Scope) error {
+	return autoConvert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(in, out, s)
 }
 
-func autoConvert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in *v1.ClusterIssuerList, out *certmanager.ClusterIssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]certmanager.ClusterIssuer, len(*in))
-		for i := range *in {
-			if err := Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+func autoConvert_v1_NameConstraints_To_certmanager_NameConstraints(in *v1.NameConstraints, out *certmanager.NameConstraints, s conversion.Scope) error {
+	out.Critical = in.Critical
+	if in.Permitted != nil {
+		in, out := &in.Permitted, &out.Permitted
+		*out = new(certmanager.NameConstraintItem)
+		if err := Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.Items = nil
+		out.Permitted = nil
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = new(certmanager.NameConstraintItem)
+		if err := Convert_v1_NameConstraintItem_To_certmanager_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Excluded = nil
 	}
 	return nil
 }
 
-// Convert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList is an autogenerated conversion function.
-func Convert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in *v1.ClusterIssuerList, out *certmanager.ClusterIssuerList, s conversion.Scope) error {
-	return autoConvert_v1_ClusterIssuerList_To_certmanager_ClusterIssuerList(in, out, s)
+// Convert_v1_NameConstraints_To_certmanager_NameConstraints is an autogenerated conversion function.
+func Convert_v1_NameConstraints_To_certmanager_NameConstraints(in *v1.NameConstraints, out *certmanager.NameConstraints, s conversion.Scope) error {
+	return autoConvert_v1_NameConstraints_To_certmanager_NameConstraints(in, out, s)
 }
 
-func autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanager.ClusterIssuerList, out *v1.ClusterIssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]v1.ClusterIssuer, len(*in))
-		for i := range *in {
-			if err := Convert_certmanager_ClusterIssuer_To_v1_ClusterIssuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
+func autoConvert_certmanager_NameConstraints_To_v1_NameConstraints(in *certmanager.NameConstraints, out *v1.NameConstraints, s conversion.Scope) error {
+	out.Critical = in.Critical
+	if in.Permitted != nil {
+		in, out := &in.Permitted, &out.Permitted
+		*out = new(v1.NameConstraintItem)
+		if err := Convert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(*in, *out, s); err != nil {
+			return err
 		}
 	} else {
-		out.Items = nil
+		out.Permitted = nil
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = new(v1.NameConstraintItem)
+		if err := Convert_certmanager_NameConstraintItem_To_v1_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Excluded = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList is an autogenerated conversion function.
-func Convert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in *certmanager.ClusterIssuerList, out *v1.ClusterIssuerList, s conversion.Scope) error {
-	return autoConvert_certmanager_ClusterIssuerList_To_v1_ClusterIssuerList(in, out, s)
+// Convert_certmanager_NameConstraints_To_v1_NameConstraints is an autogenerated conversion function.
+func Convert_certmanager_NameConstraints_To_v1_NameConstraints(in *certmanager.NameConstraints, out *v1.NameConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_NameConstraints_To_v1_NameConstraints(in, out, s)
 }
 
-func autoConvert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
-		return err
-	}
+func autoConvert_v1_OtherName_To_certmanager_OtherName(in *v1.OtherName, out *certmanager.OtherName, s conversion.Scope) error {
+	out.OID = in.OID
+	out.UTF8Value = in.UTF8Value
 	return nil
 }
 
-// Convert_v1_Issuer_To_certmanager_Issuer is an autogenerated conversion function.
-func Convert_v1_Issuer_To_certmanager_Issuer(in *v1.Issuer, out *certmanager.Issuer, s conversion.Scope) error {
-	return autoConvert_v1_Issuer_To_certmanager_Issuer(in, out, s)
+// Convert_v1_OtherName_To_certmanager_OtherName is an autogenerated conversion function.
+func Convert_v1_OtherName_To_certmanager_OtherName(in *v1.OtherName, out *certmanager.OtherName, s conversion.Scope) error {
+	return autoConvert_v1_OtherName_To_certmanager_OtherName(in, out, s)
 }
 
-func autoConvert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Issuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+func autoConvert_certmanager_OtherName_To_v1_OtherName(in *certmanager.OtherName, out *v1.OtherName, s conversion.Scope) error {
+	out.OID = in.OID
+	out.UTF8Value = in.UTF8Value
+	return nil
+}
+
+// Convert_certmanager_OtherName_To_v1_OtherName is an autogenerated conversion function.
+func Convert_certmanager_OtherName_To_v1_OtherName(in *certmanager.OtherName, out *v1.OtherName, s conversion.Scope) error {
+	return autoConvert_certmanager_OtherName_To_v1_OtherName(in, out, s)
+}
+
+func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_Issuer_To_v1_Issuer is an autogenerated conversion function.
-func Convert_certmanager_Issuer_To_v1_Issuer(in *certmanager.Issuer, out *v1.Issuer, s conversion.Scope) error {
-	return autoConvert_certmanager_Issuer_To_v1_Issuer(in, out, s)
+// Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore is an autogenerated conversion function.
+func Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
+	return autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in, out, s)
 }
 
-func autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
-	out.Type = certmanager.IssuerConditionType(in.Type)
-	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1.PKCS12Keystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_IssuerCondition_To_certmanager_IssuerCondition is an autogenerated conversion function.
-func Convert_v1_IssuerCondition_To_certmanager_IssuerCondition(in *v1.IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
-	return autoConvert_v1_IssuerCondition_To_certmanager_IssuerCondition(in, out, s)
+// Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore is an autogenerated conversion function.
+func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1.PKCS12Keystore, s conversion.Scope) error {
+	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *v1.IssuerCondition, s conversion.Scope) error {
-	out.Type = v1.IssuerConditionType(in.Type)
-	out.Status = apismetav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*metav1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_v1_PluginIssuer_To_certmanager_PluginIssuer(in *v1.PluginIssuer, out *certmanager.PluginIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Name = in.Name
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.LocalObjectReference)
+		if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	out.Insecure = in.Insecure
 	return nil
 }
 
-// Convert_certmanager_IssuerCondition_To_v1_IssuerCondition is an autogenerated conversion function.
-func Convert_certmanager_IssuerCondition_To_v1_IssuerCondition(in *certmanager.IssuerCondition, out *v1.IssuerCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerCondition_To_v1_IssuerCondition(in, out, s)
+// Convert_v1_PluginIssuer_To_certmanager_PluginIssuer is an autogenerated conversion function.
+func Convert_v1_PluginIssuer_To_certmanager_PluginIssuer(in *v1.PluginIssuer, out *certmanager.PluginIssuer, s conversion.Scope) error {
+	return autoConvert_v1_PluginIssuer_To_certmanager_PluginIssuer(in, out, s)
 }
 
-func autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acme.ACMEIssuer)
-		if err := acmev1.Convert_v1_ACMEIssuer_To_acme_ACMEIssuer(*in, *out, s); err != nil {
+func autoConvert_certmanager_PluginIssuer_To_v1_PluginIssuer(in *certmanager.PluginIssuer, out *v1.PluginIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Name = in.Name
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(apismetav1.LocalObjectReference)
+		if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.ACME = nil
+		out.ClientCertSecretRef = nil
 	}
-	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
-	if in.Vault != nil {
-		in, out := &in.Vault, &out.Vault
-		*out = new(certmanager.VaultIssuer)
-		if err := Convert_v1_VaultIssuer_To_certmanager_VaultIssuer(*in, *out, s); err != nil {
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_certmanager_PluginIssuer_To_v1_PluginIssuer is an autogenerated conversion function.
+func Convert_certmanager_PluginIssuer_To_v1_PluginIssuer(in *certmanager.PluginIssuer, out *v1.PluginIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_PluginIssuer_To_v1_PluginIssuer(in, out, s)
+}
+
+func autoConvert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in *v1.PrivateKeyBackend, out *certmanager.PrivateKeyBackend, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(certmanager.AWSKMSPrivateKeyBackend)
+		if err := Convert_v1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Vault = nil
+		out.AWSKMS = nil
 	}
-	out.SelfSigned = (*certmanager.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
-	if in.Venafi != nil {
-		in, out := &in.Venafi, &out.Venafi
-		*out = new(certmanager.VenafiIssuer)
-		if err := Convert_v1_VenafiIssuer_To_certmanager_VenafiIssuer(*in, *out, s); err != nil {
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(certmanager.GCPKMSPrivateKeyBackend)
+		if err := Convert_v1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Venafi = nil
+		out.GCPKMS = nil
 	}
 	return nil
 }
 
-// Convert_v1_IssuerConfig_To_certmanager_IssuerConfig is an autogenerated conversion function.
-func Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(in *v1.IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
-	return autoConvert_v1_IssuerConfig_To_certmanager_IssuerConfig(in, out, s)
+// Convert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in *v1.PrivateKeyBackend, out *certmanager.PrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.IssuerConfig, out *v1.IssuerConfig, s conversion.Scope) error {
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(apisacmev1.ACMEIssuer)
-		if err := acmev1.Convert_acme_ACMEIssuer_To_v1_ACMEIssuer(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.ACME = nil
-	}
-	out.CA = (*v1.CAIssuer)(unsafe.Pointer(in.CA))
-	if in.Vault != nil {
-		in, out := &in.Vault, &out.Vault
-		*out = new(v1.VaultIssuer)
-		if err := Convert_certmanager_VaultIssuer_To_v1_VaultIssuer(*in, *out, s); err != nil {
+func autoConvert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend(in *certmanager.PrivateKeyBackend, out *v1.PrivateKeyBackend, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(v1.AWSKMSPrivateKeyBackend)
+		if err := Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1_AWSKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Vault = nil
+		out.AWSKMS = nil
 	}
-	out.SelfSigned = (*v1.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
-	if in.Venafi != nil {
-		in, out := &in.Venafi, &out.Venafi
-		*out = new(v1.VenafiIssuer)
-		if err := Convert_certmanager_VenafiIssuer_To_v1_VenafiIssuer(*in, *out, s); err != nil {
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(v1.GCPKMSPrivateKeyBackend)
+		if err := Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1_GCPKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Venafi = nil
+		out.GCPKMS = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_IssuerConfig_To_v1_IssuerConfig is an autogenerated conversion function.
-func Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(in *certmanager.IssuerConfig, out *v1.IssuerConfig, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerConfig_To_v1_IssuerConfig(in, out, s)
+// Convert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend(in *certmanager.PrivateKeyBackend, out *v1.PrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_PrivateKeyBackend_To_v1_PrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_v1_IssuerList_To_certmanager_IssuerList(in *v1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]certmanager.Issuer, len(*in))
-		for i := range *in {
-			if err := Convert_v1_Issuer_To_certmanager_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+func autoConvert_v1_SPIREIssuer_To_certmanager_SPIREIssuer(in *v1.SPIREIssuer, out *certmanager.SPIREIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TrustDomain = in.TrustDomain
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerSPIFFEID = in.ServerSPIFFEID
+	if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.ClientCertSecretRef, &out.ClientCertSecretRef, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_v1_IssuerList_To_certmanager_IssuerList is an autogenerated conversion function.
-func Convert_v1_IssuerList_To_certmanager_IssuerList(in *v1.IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
-	return autoConvert_v1_IssuerList_To_certmanager_IssuerList(in, out, s)
+// Convert_v1_SPIREIssuer_To_certmanager_SPIREIssuer is an autogenerated conversion function.
+func Convert_v1_SPIREIssuer_To_certmanager_SPIREIssuer(in *v1.SPIREIssuer, out *certmanager.SPIREIssuer, s conversion.Scope) error {
+	return autoConvert_v1_SPIREIssuer_To_certmanager_SPIREIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList, out *v1.IssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]v1.Issuer, len(*in))
-		for i := range *in {
-			if err := Convert_certmanager_Issuer_To_v1_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+func autoConvert_certmanager_SPIREIssuer_To_v1_SPIREIssuer(in *certmanager.SPIREIssuer, out *v1.SPIREIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TrustDomain = in.TrustDomain
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerSPIFFEID = in.ServerSPIFFEID
+	if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.ClientCertSecretRef, &out.ClientCertSecretRef, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_IssuerList_To_v1_IssuerList is an autogenerated conversion function.
-func Convert_certmanager_IssuerList_To_v1_IssuerList(in *certmanager.IssuerList, out *v1.IssuerList, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerList_To_v1_IssuerList(in, out, s)
+// Convert_certmanager_SPIREIssuer_To_v1_SPIREIssuer is an autogenerated conversion function.
+func Convert_certmanager_SPIREIssuer_To_v1_SPIREIssuer(in *certmanager.SPIREIssuer, out *v1.SPIREIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SPIREIssuer_To_v1_SPIREIssuer(in, out, s)
 }
 
-func autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
-	if err := Convert_v1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
-		return err
-	}
+func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
 }
 
-// Convert_v1_IssuerSpec_To_certmanager_IssuerSpec is an autogenerated conversion function.
-func Convert_v1_IssuerSpec_To_certmanager_IssuerSpec(in *v1.IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
-	return autoConvert_v1_IssuerSpec_To_certmanager_IssuerSpec(in, out, s)
+// Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer is an autogenerated conversion function.
+func Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
+	return autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerSpec, out *v1.IssuerSpec, s conversion.Scope) error {
-	if err := Convert_certmanager_IssuerConfig_To_v1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
-		return err
-	}
+func autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
 }
 
-// Convert_certmanager_IssuerSpec_To_v1_IssuerSpec is an autogenerated conversion function.
-func Convert_certmanager_IssuerSpec_To_v1_IssuerSpec(in *certmanager.IssuerSpec, out *v1.IssuerSpec, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerSpec_To_v1_IssuerSpec(in, out, s)
+// Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer is an autogenerated conversion function.
+func Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in, out, s)
 }
 
-func autoConvert_v1_IssuerStatus_To_certmanager_IssuerStatus(in *v1.IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]certmanager.IssuerCondition)(unsafe.Pointer(&in.Conditions))
-	out.ACME = (*acme.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+func autoConvert_v1_StepCAIssuer_To_certmanager_StepCAIssuer(in *v1.StepCAIssuer, out *certmanager.StepCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner(&in.Provisioner, &out.Provisioner, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_IssuerStatus_To_certmanager_IssuerStatus is an autogenerated conversion function.
-func Convert_v1_IssuerStatus_To_certmanager_IssuerStatus(in *v1.IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
-	return autoConvert_v1_IssuerStatus_To_certmanager_IssuerStatus(in, out, s)
+// Convert_v1_StepCAIssuer_To_certmanager_StepCAIssuer is an autogenerated conversion function.
+func Convert_v1_StepCAIssuer_To_certmanager_StepCAIssuer(in *v1.StepCAIssuer, out *certmanager.StepCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1_StepCAIssuer_To_certmanager_StepCAIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerStatus, out *v1.IssuerStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]v1.IssuerCondition)(unsafe.Pointer(&in.Conditions))
-	out.ACME = (*apisacmev1.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+func autoConvert_certmanager_StepCAIssuer_To_v1_StepCAIssuer(in *certmanager.StepCAIssuer, out *v1.StepCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner(&in.Provisioner, &out.Provisioner, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_IssuerStatus_To_v1_IssuerStatus is an autogenerated conversion function.
-func Convert_certmanager_IssuerStatus_To_v1_IssuerStatus(in *certmanager.IssuerStatus, out *v1.IssuerStatus, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerStatus_To_v1_IssuerStatus(in, out, s)
+// Convert_certmanager_StepCAIssuer_To_v1_StepCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_StepCAIssuer_To_v1_StepCAIssuer(in *certmanager.StepCAIssuer, out *v1.StepCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAIssuer_To_v1_StepCAIssuer(in, out, s)
 }
 
-func autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
-	out.Create = in.Create
+func autoConvert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in *v1.StepCAJWKProvisioner, out *certmanager.StepCAJWKProvisioner, s conversion.Scope) error {
+	out.KeyID = in.KeyID
 	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1_JKSKeystore_To_certmanager_JKSKeystore is an autogenerated conversion function.
-func Convert_v1_JKSKeystore_To_certmanager_JKSKeystore(in *v1.JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
-	return autoConvert_v1_JKSKeystore_To_certmanager_JKSKeystore(in, out, s)
+// Convert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner is an autogenerated conversion function.
+func Convert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in *v1.StepCAJWKProvisioner, out *certmanager.StepCAJWKProvisioner, s conversion.Scope) error {
+	return autoConvert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *v1.JKSKeystore, s conversion.Scope) error {
-	out.Create = in.Create
+func autoConvert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner(in *certmanager.StepCAJWKProvisioner, out *v1.StepCAJWKProvisioner, s conversion.Scope) error {
+	out.KeyID = in.KeyID
 	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_JKSKeystore_To_v1_JKSKeystore is an autogenerated conversion function.
-func Convert_certmanager_JKSKeystore_To_v1_JKSKeystore(in *certmanager.JKSKeystore, out *v1.JKSKeystore, s conversion.Scope) error {
-	return autoConvert_certmanager_JKSKeystore_To_v1_JKSKeystore(in, out, s)
+// Convert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner(in *certmanager.StepCAJWKProvisioner, out *v1.StepCAJWKProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner(in, out, s)
 }
 
-func autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
-	out.Create = in.Create
-	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
-		return err
+func autoConvert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in *v1.StepCAProvisioner, out *certmanager.StepCAProvisioner, s conversion.Scope) error {
+	out.Name = in.Name
+	if in.JWK != nil {
+		in, out := &in.JWK, &out.JWK
+		*out = new(certmanager.StepCAJWKProvisioner)
+		if err := Convert_v1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWK = nil
+	}
+	if in.X5C != nil {
+		in, out := &in.X5C, &out.X5C
+		*out = new(certmanager.StepCAX5CProvisioner)
+		if err := Convert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.X5C = nil
 	}
 	return nil
 }
 
-// Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore is an autogenerated conversion function.
-func Convert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *v1.PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
-	return autoConvert_v1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in, out, s)
+// Convert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner is an autogenerated conversion function.
+func Convert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in *v1.StepCAProvisioner, out *certmanager.StepCAProvisioner, s conversion.Scope) error {
+	return autoConvert_v1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1.PKCS12Keystore, s conversion.Scope) error {
-	out.Create = in.Create
-	if err := internalapismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
-		return err
+func autoConvert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner(in *certmanager.StepCAProvisioner, out *v1.StepCAProvisioner, s conversion.Scope) error {
+	out.Name = in.Name
+	if in.JWK != nil {
+		in, out := &in.JWK, &out.JWK
+		*out = new(v1.StepCAJWKProvisioner)
+		if err := Convert_certmanager_StepCAJWKProvisioner_To_v1_StepCAJWKProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWK = nil
+	}
+	if in.X5C != nil {
+		in, out := &in.X5C, &out.X5C
+		*out = new(v1.StepCAX5CProvisioner)
+		if err := Convert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.X5C = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore is an autogenerated conversion function.
-func Convert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *v1.PKCS12Keystore, s conversion.Scope) error {
-	return autoConvert_certmanager_PKCS12Keystore_To_v1_PKCS12Keystore(in, out, s)
+// Convert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner(in *certmanager.StepCAProvisioner, out *v1.StepCAProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAProvisioner_To_v1_StepCAProvisioner(in, out, s)
 }
 
-func autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+func autoConvert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in *v1.StepCAX5CProvisioner, out *certmanager.StepCAX5CProvisioner, s conversion.Scope) error {
+	if err := internalapismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer is an autogenerated conversion function.
-func Convert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *v1.SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
-	return autoConvert_v1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in, out, s)
+// Convert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner is an autogenerated conversion function.
+func Convert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in *v1.StepCAX5CProvisioner, out *certmanager.StepCAX5CProvisioner, s conversion.Scope) error {
+	return autoConvert_v1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+func autoConvert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner(in *certmanager.StepCAX5CProvisioner, out *v1.StepCAX5CProvisioner, s conversion.Scope) error {
+	if err := internalapismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer is an autogenerated conversion function.
-func Convert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *v1.SelfSignedIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_SelfSignedIssuer_To_v1_SelfSignedIssuer(in, out, s)
+// Convert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner(in *certmanager.StepCAX5CProvisioner, out *v1.StepCAX5CProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAX5CProvisioner_To_v1_StepCAX5CProvisioner(in, out, s)
 }
 
 func autoConvert_v1_VaultAppRole_To_certmanager_VaultAppRole(in *v1.VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
@@ -1333,6 +4017,15 @@ func autoConvert_v1_VaultAuth_To_certmanager_VaultAuth(in *v1.VaultAuth, out *ce
 	} else {
 		out.Kubernetes = nil
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(certmanager.VaultClientCertificateAuth)
+		if err := Convert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificate = nil
+	}
 	return nil
 }
 
@@ -1369,6 +4062,15 @@ func autoConvert_certmanager_VaultAuth_To_v1_VaultAuth(in *certmanager.VaultAuth
 	} else {
 		out.Kubernetes = nil
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(v1.VaultClientCertificateAuth)
+		if err := Convert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificate = nil
+	}
 	return nil
 }
 
@@ -1377,6 +4079,30 @@ func Convert_certmanager_VaultAuth_To_v1_VaultAuth(in *certmanager.VaultAuth, ou
 	return autoConvert_certmanager_VaultAuth_To_v1_VaultAuth(in, out, s)
 }
 
+func autoConvert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in *v1.VaultClientCertificateAuth, out *certmanager.VaultClientCertificateAuth, s conversion.Scope) error {
+	out.Path = in.Path
+	out.SecretName = in.SecretName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth is an autogenerated conversion function.
+func Convert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in *v1.VaultClientCertificateAuth, out *certmanager.VaultClientCertificateAuth, s conversion.Scope) error {
+	return autoConvert_v1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in, out, s)
+}
+
+func autoConvert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth(in *certmanager.VaultClientCertificateAuth, out *v1.VaultClientCertificateAuth, s conversion.Scope) error {
+	out.Path = in.Path
+	out.SecretName = in.SecretName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth is an autogenerated conversion function.
+func Convert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth(in *certmanager.VaultClientCertificateAuth, out *v1.VaultClientCertificateAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultClientCertificateAuth_To_v1_VaultClientCertificateAuth(in, out, s)
+}
+
 func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *v1.VaultIssuer, out *certmanager.VaultIssuer, s conversion.Scope) error {
 	if err := Convert_v1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
 		return err
@@ -1385,6 +4111,7 @@ func autoConvert_v1_VaultIssuer_To_certmanager_VaultIssuer(in *v1.VaultIssuer, o
 	out.Path = in.Path
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.SignVerbatim = in.SignVerbatim
 	return nil
 }
 
@@ -1401,6 +4128,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1_VaultIssuer(in *certmanager.Vault
 	out.Path = in.Path
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.SignVerbatim = in.SignVerbatim
 	return nil
 }
 
@@ -1437,6 +4165,74 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in *certm
 	return autoConvert_certmanager_VaultKubernetesAuth_To_v1_VaultKubernetesAuth(in, out, s)
 }
 
+func autoConvert_v1_VaultSecretStore_To_certmanager_VaultSecretStore(in *v1.VaultSecretStore, out *certmanager.VaultSecretStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.Path = in.Path
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_VaultSecretStore_To_certmanager_VaultSecretStore is an autogenerated conversion function.
+func Convert_v1_VaultSecretStore_To_certmanager_VaultSecretStore(in *v1.VaultSecretStore, out *certmanager.VaultSecretStore, s conversion.Scope) error {
+	return autoConvert_v1_VaultSecretStore_To_certmanager_VaultSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_VaultSecretStore_To_v1_VaultSecretStore(in *certmanager.VaultSecretStore, out *v1.VaultSecretStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.Path = in.Path
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_VaultAuth_To_v1_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_VaultSecretStore_To_v1_VaultSecretStore is an autogenerated conversion function.
+func Convert_certmanager_VaultSecretStore_To_v1_VaultSecretStore(in *certmanager.VaultSecretStore, out *v1.VaultSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultSecretStore_To_v1_VaultSecretStore(in, out, s)
+}
+
+func autoConvert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in *v1.VaultTransitKeyEncryption, out *certmanager.VaultTransitKeyEncryption, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.KeyName = in.KeyName
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption is an autogenerated conversion function.
+func Convert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in *v1.VaultTransitKeyEncryption, out *certmanager.VaultTransitKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in, out, s)
+}
+
+func autoConvert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption(in *certmanager.VaultTransitKeyEncryption, out *v1.VaultTransitKeyEncryption, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.KeyName = in.KeyName
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_VaultAuth_To_v1_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption(in *certmanager.VaultTransitKeyEncryption, out *v1.VaultTransitKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultTransitKeyEncryption_To_v1_VaultTransitKeyEncryption(in, out, s)
+}
+
 func autoConvert_v1_VenafiCloud_To_certmanager_VenafiCloud(in *v1.VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
 	if err := internalapismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
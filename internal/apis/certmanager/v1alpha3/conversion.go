@@ -37,6 +37,8 @@ func Convert_v1alpha3_CertificateSpec_To_certmanager_CertificateSpec(in *Certifi
 			out.PrivateKey.Algorithm = certmanager.ECDSAKeyAlgorithm
 		case RSAKeyAlgorithm:
 			out.PrivateKey.Algorithm = certmanager.RSAKeyAlgorithm
+		case Ed25519KeyAlgorithm:
+			out.PrivateKey.Algorithm = certmanager.Ed25519KeyAlgorithm
 		default:
 			out.PrivateKey.Algorithm = certmanager.PrivateKeyAlgorithm(in.KeyAlgorithm)
 		}
@@ -67,6 +69,8 @@ func Convert_certmanager_CertificateSpec_To_v1alpha3_CertificateSpec(in *certman
 			out.KeyAlgorithm = ECDSAKeyAlgorithm
 		case certmanager.RSAKeyAlgorithm:
 			out.KeyAlgorithm = RSAKeyAlgorithm
+		case certmanager.Ed25519KeyAlgorithm:
+			out.KeyAlgorithm = Ed25519KeyAlgorithm
 		default:
 			out.KeyAlgorithm = KeyAlgorithm(in.PrivateKey.Algorithm)
 		}
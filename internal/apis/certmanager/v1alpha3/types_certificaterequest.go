@@ -204,4 +204,12 @@ const (
 	// denied, and must never be signed. Condition must never have a status of
 	// `False`, and cannot be modified once set.
 	CertificateRequestConditionDenied CertificateRequestConditionType = "Denied"
+
+	// CertificateRequestConditionRevoked indicates that the certificate issued
+	// for a certificate request has been revoked, following the addition of
+	// the `cert-manager.io/revoke` annotation. The condition's
+	// `lastTransitionTime` is the time of the revocation. A status of `False`
+	// indicates that the issuer failed to revoke the certificate, and that
+	// the revocation is being retried.
+	CertificateRequestConditionRevoked CertificateRequestConditionType = "Revoked"
 )
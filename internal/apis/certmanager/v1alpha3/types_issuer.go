@@ -87,6 +87,64 @@ type IssuerList struct {
 // configuration required for the issuer.
 type IssuerSpec struct {
 	IssuerConfig `json:",inline"`
+
+	// IssuanceLatencyBudget configures the expected issuance latency of this
+	// issuer. When the observed latency exceeds the budget, the issuer is
+	// given a Degraded condition and an Event is emitted, so that a slowly
+	// degrading upstream CA is noticed before certificates start to expire.
+	// +optional
+	IssuanceLatencyBudget *IssuanceLatencyBudget `json:"issuanceLatencyBudget,omitempty"`
+
+	// CredentialsNamespace is the namespace that a ClusterIssuer reads the
+	// Secrets referenced by its configuration from. It defaults to the cluster
+	// resource namespace. Any other namespace must contain a CredentialGrant
+	// naming the ClusterIssuer, otherwise the ClusterIssuer is not ready.
+	// It must not be set on Issuers, which always read Secrets from their own
+	// namespace.
+	// +optional
+	CredentialsNamespace string `json:"credentialsNamespace,omitempty"`
+
+	// RateLimit limits how many CertificateRequests this issuer sends to its
+	// upstream CA within a period of time, protecting the CA from renewal
+	// storms. CertificateRequests over the limit are left pending until
+	// capacity is available. If unset, issuance is not rate limited.
+	// +optional
+	RateLimit *IssuanceRateLimit `json:"rateLimit,omitempty"`
+}
+
+// IssuanceLatencyBudget configures how long an issuer is expected to take
+// to issue certificates. The latency of a CertificateRequest is the time
+// between its creation and it becoming Ready.
+type IssuanceLatencyBudget struct {
+	// Average is the maximum acceptable mean latency of the most recently
+	// issued CertificateRequests.
+	// +optional
+	Average *metav1.Duration `json:"average,omitempty"`
+
+	// P95 is the maximum acceptable 95th percentile latency of the most
+	// recently issued CertificateRequests.
+	// +optional
+	P95 *metav1.Duration `json:"p95,omitempty"`
+
+	// Samples is the number of most recently issued CertificateRequests
+	// that the average and percentile are computed over.
+	// Defaults to 20.
+	// +optional
+	Samples int32 `json:"samples,omitempty"`
+}
+
+// IssuanceRateLimit configures the maximum rate at which an issuer signs
+// CertificateRequests. A CertificateRequest counts against the limit from
+// the time it is first sent to the upstream CA until it has not been
+// worked on for a whole period.
+type IssuanceRateLimit struct {
+	// MaxRequests is the maximum number of CertificateRequests that may be
+	// sent to the upstream CA within the period.
+	MaxRequests int32 `json:"maxRequests"`
+
+	// Period is the length of the time window that requests are counted
+	// over, for example `1h`.
+	Period *metav1.Duration `json:"period"`
 }
 
 // The configuration for the issuer.
@@ -117,6 +175,45 @@ type IssuerConfig struct {
 	// or Venafi Cloud policy zone.
 	// +optional
 	Venafi *VenafiIssuer `json:"venafi,omitempty"`
+
+	// AWSPCA configures this issuer to sign certificates using an AWS
+	// Certificate Manager Private Certificate Authority.
+	// +optional
+	AWSPCA *AWSPCAIssuer `json:"awspca,omitempty"`
+
+	// GoogleCAS configures this issuer to sign certificates using a Google
+	// Cloud Certificate Authority Service CA pool.
+	// +optional
+	GoogleCAS *GoogleCASIssuer `json:"googleCAS,omitempty"`
+
+	// AzureKeyVault configures this issuer to sign certificates using a CA
+	// key held in Azure Key Vault.
+	// +optional
+	AzureKeyVault *AzureKeyVaultIssuer `json:"azureKeyVault,omitempty"`
+
+	// EJBCA configures this issuer to request certificates from an EJBCA or
+	// Keyfactor Command CA using the EJBCA REST API.
+	// +optional
+	EJBCA *EJBCAIssuer `json:"ejbca,omitempty"`
+
+	// StepCA configures this issuer to request certificates from a Smallstep
+	// step-ca instance.
+	// +optional
+	StepCA *StepCAIssuer `json:"stepCA,omitempty"`
+
+	// KubernetesCSR configures this issuer to request certificates from a
+	// signer of the Kubernetes certificates.k8s.io API.
+	// +optional
+	KubernetesCSR *KubernetesCSRIssuer `json:"kubernetesCSR,omitempty"`
+
+	// SPIRE configures this issuer to request X.509 SVIDs from a SPIRE server.
+	// +optional
+	SPIRE *SPIREIssuer `json:"spire,omitempty"`
+
+	// Plugin configures this issuer to sign certificates using an out-of-tree
+	// issuer plugin served over gRPC.
+	// +optional
+	Plugin *PluginIssuer `json:"plugin,omitempty"`
 }
 
 // Configures an issuer to sign certificates using a Venafi TPP
@@ -205,6 +302,16 @@ type VaultIssuer struct {
 	// system root certificates are used to validate the TLS connection.
 	// +optional
 	CABundle []byte `json:"caBundle,omitempty"`
+
+	// SignVerbatim configures the issuer to sign requests with the PKI
+	// backend's `sign-verbatim` endpoint instead of `sign/:role`, so that every
+	// extension requested in the CSR (URI and otherName SANs, custom extended
+	// key usages) is preserved rather than filtered by the role. The `sign`
+	// segment of Path is replaced with `sign-verbatim`, keeping the role name
+	// if one is given. The Vault policy used by cert-manager must grant access
+	// to that endpoint.
+	// +optional
+	SignVerbatim bool `json:"signVerbatim,omitempty"`
 }
 
 // Configuration used to authenticate with a Vault server.
@@ -223,6 +330,12 @@ type VaultAuth struct {
 	// token stored in the named Secret resource to the Vault server.
 	// +optional
 	Kubernetes *VaultKubernetesAuth `json:"kubernetes,omitempty"`
+
+	// ClientCertificate authenticates with Vault by presenting a client
+	// certificate during the TLS handshake. Works only when using the HTTPS
+	// protocol.
+	// +optional
+	ClientCertificate *VaultClientCertificateAuth `json:"clientCertificate,omitempty"`
 }
 
 // VaultAppRole authenticates with Vault using the App Role auth mechanism,
@@ -263,6 +376,31 @@ type VaultKubernetesAuth struct {
 	Role string `json:"role"`
 }
 
+// VaultClientCertificateAuth authenticates against Vault using the TLS
+// certificate auth method, with the client certificate and private key
+// stored in a Kubernetes Secret resource.
+type VaultClientCertificateAuth struct {
+	// The Vault mountPath here is the mount path to use when authenticating with
+	// Vault. For example, setting a value to `/v1/auth/foo`, will use the path
+	// `/v1/auth/foo/login` to authenticate with Vault. If unspecified, the
+	// default value "/v1/auth/cert" will be used.
+	// +optional
+	Path string `json:"mountPath,omitempty"`
+
+	// SecretName is the name of a Secret of type kubernetes.io/tls, in the
+	// same namespace as the Issuer or in the cluster resource namespace for a
+	// ClusterIssuer, holding the client certificate and private key in the
+	// `tls.crt` and `tls.key` entries. The Secret may be the one populated by a
+	// cert-manager Certificate resource.
+	SecretName string `json:"secretName"`
+
+	// Name of the certificate role to authenticate against. If unspecified,
+	// Vault tries all certificate roles and uses the one matching the
+	// presented client certificate.
+	// +optional
+	Name string `json:"name,omitempty"`
+}
+
 type CAIssuer struct {
 	// SecretName is the name of the secret used to sign Certificates issued
 	// by this Issuer.
@@ -281,12 +419,399 @@ type CAIssuer struct {
 	// OCSP server URL could be "http://ocsp.int-x3.letsencrypt.org".
 	// +optional
 	OCSPServers []string `json:"ocspServers,omitempty"`
+
+	// ChainSecretNames is an ordered list of names of Secrets containing the
+	// certificates of the CAs above the one in SecretName, starting with its
+	// issuer and ending with the root CA. Certificates are read from the
+	// `tls.crt` and `ca.crt` keys of each Secret, which need not contain a
+	// private key. They are used to complete the chain of issued certificates,
+	// so that a full chain is served even if SecretName contains only the
+	// issuing intermediate CA.
+	// +optional
+	ChainSecretNames []string `json:"chainSecretNames,omitempty"`
+
+	// CRL configures the issuer to maintain a certificate revocation list
+	// signed by the CA, listing the certificates it has issued which have been
+	// revoked using the `cert-manager.io/revoke` CertificateRequest annotation.
+	// The CA certificate must have the `crl sign` key usage. If not set, no
+	// CRL is generated.
+	// +optional
+	CRL *CACRL `json:"crl,omitempty"`
+
+	// OCSP configures cert-manager to manage the certificate of an OCSP
+	// responder for this issuer, which is used by the cert-manager OCSP
+	// responder to answer requests for the status of certificates issued by
+	// the CA. If not set, no OCSP responder certificate is issued.
+	// +optional
+	OCSP *CAOCSP `json:"ocsp,omitempty"`
+}
+
+// CAOCSP configures the OCSP responder of a CA issuer. cert-manager creates
+// a Certificate, issued by the issuer with the `ocsp signing` usage, whose
+// key pair signs OCSP responses on behalf of the CA. The Certificate is
+// created in the same namespace as the Issuer, or in the cluster resource
+// namespace for a ClusterIssuer.
+type CAOCSP struct {
+	// ResponderSecretName is the name of the Secret the OCSP responder
+	// certificate and private key are stored in. The Certificate managing it
+	// has the same name.
+	ResponderSecretName string `json:"responderSecretName"`
+
+	// ResponseValidity is how long OCSP responses may be cached by clients
+	// for, i.e. the time between their thisUpdate and nextUpdate fields.
+	// Defaults to 1 hour. Minimum accepted value is 1 minute.
+	// +optional
+	ResponseValidity *metav1.Duration `json:"responseValidity,omitempty"`
+}
+
+// CACRL configures where the certificate revocation list of a CA issuer
+// is published. The CRL is PEM encoded and stored in the `ca.crl` entry of
+// a Secret and/or ConfigMap, in the same namespace as the Issuer or in the
+// cluster resource namespace for a ClusterIssuer. At least one of SecretName
+// and ConfigMapName must be set.
+type CACRL struct {
+	// SecretName is the name of the Secret the CRL is published to. The
+	// Secret is created if it does not exist.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+
+	// ConfigMapName is the name of the ConfigMap the CRL is published to.
+	// The ConfigMap is created if it does not exist.
+	// +optional
+	ConfigMapName string `json:"configMapName,omitempty"`
+
+	// Validity is how long each CRL is valid for, i.e. the time between its
+	// thisUpdate and nextUpdate fields. A new CRL is published once two thirds
+	// of it have elapsed, or as soon as a certificate is revoked. Defaults to
+	// 24 hours. Minimum accepted value is 1 hour.
+	// +optional
+	Validity *metav1.Duration `json:"validity,omitempty"`
+}
+
+// Configures an issuer to sign certificates using an AWS Certificate Manager
+// Private Certificate Authority (ACM PCA).
+type AWSPCAIssuer struct {
+	// Arn is the Amazon Resource Name of the private certificate authority
+	// used to sign certificates.
+	Arn string `json:"arn"`
+
+	// Region is the AWS region of the private certificate authority.
+	// If not set, the region in the ARN of the certificate authority is used.
+	// +optional
+	Region string `json:"region,omitempty"`
+
+	// TemplateArn is the ARN of the ACM PCA certificate template used to
+	// issue certificates. If not set, the EndEntityCertificate/V1 template
+	// is used, or the SubordinateCACertificate_PathLen0/V1 template for CA
+	// certificates.
+	// +optional
+	TemplateArn string `json:"templateArn,omitempty"`
+
+	// Role is the ARN of a role which is assumed using the credentials in
+	// Auth, or the ambient credentials if Auth is not set, before requesting
+	// certificates.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Auth configures static credentials used to authenticate with AWS.
+	// If not set, ambient credentials are used, such as those of an IAM role
+	// for the cert-manager service account (IRSA). Ambient credentials can
+	// only be used by Issuers if the controller is started with
+	// --issuer-ambient-credentials.
+	// +optional
+	Auth *AWSPCAAuth `json:"auth,omitempty"`
+}
+
+// AWSPCAAuth configures static AWS credentials stored in Secret resources.
+type AWSPCAAuth struct {
+	// AccessKeyIDSecretRef is a reference to a key in a Secret that contains
+	// the AWS access key ID.
+	AccessKeyIDSecretRef cmmeta.SecretKeySelector `json:"accessKeyIDSecretRef"`
+
+	// SecretAccessKeySecretRef is a reference to a key in a Secret that
+	// contains the AWS secret access key.
+	SecretAccessKeySecretRef cmmeta.SecretKeySelector `json:"secretAccessKeySecretRef"`
+}
+
+// GoogleCASIssuer configures an issuer to sign certificates using a
+// Google Cloud Certificate Authority Service CA pool.
+type GoogleCASIssuer struct {
+	// Project is the ID of the Google Cloud project that the CA pool belongs
+	// to.
+	Project string `json:"project"`
+
+	// Location is the Google Cloud region of the CA pool, for example
+	// `europe-west1`.
+	Location string `json:"location"`
+
+	// CAPoolID is the ID of the CA pool used to sign certificates.
+	CAPoolID string `json:"caPoolId"`
+
+	// CertificateAuthorityID is the ID of a certificate authority in the CA
+	// pool that should sign certificates. If not set, Google Cloud will
+	// choose an enabled certificate authority from the pool.
+	// +optional
+	CertificateAuthorityID string `json:"certificateAuthorityId,omitempty"`
+
+	// CertificateTemplate is the full resource name of a certificate template
+	// applied to certificates issued by this issuer, for example
+	// `projects/my-project/locations/europe-west1/certificateTemplates/my-template`.
+	// It can be overridden per CertificateRequest using the
+	// `googlecas.cert-manager.io/certificate-template` annotation.
+	// +optional
+	CertificateTemplate string `json:"certificateTemplate,omitempty"`
+
+	// ServiceAccountKeySecretRef is a reference to a key in a Secret that
+	// contains a Google Cloud service account JSON key. If not set, ambient
+	// credentials such as GKE workload identity are used, provided that the
+	// issuer is allowed to use ambient credentials.
+	// +optional
+	ServiceAccountKeySecretRef *cmmeta.SecretKeySelector `json:"serviceAccountKeySecretRef,omitempty"`
+}
+
+// AzureKeyVaultIssuer configures an issuer to sign certificates using the
+// key of a CA certificate stored in Azure Key Vault. The private key never
+// leaves Key Vault; certificates are signed using the Key Vault sign
+// operation.
+type AzureKeyVaultIssuer struct {
+	// VaultURL is the URL of the Key Vault, for example
+	// `https://my-vault.vault.azure.net/`.
+	VaultURL string `json:"vaultURL"`
+
+	// CertificateName is the name of the Key Vault certificate of the CA.
+	// The current version of the certificate and its key are used to sign
+	// certificates.
+	CertificateName string `json:"certificateName"`
+
+	// Environment is the Azure cloud that the Key Vault belongs to. One of
+	// AzurePublicCloud, AzureChinaCloud, AzureGermanCloud or
+	// AzureUSGovernmentCloud. Defaults to AzurePublicCloud.
+	// +optional
+	Environment string `json:"environment,omitempty"`
+
+	// TenantID is the ID of the Azure AD tenant of the application that
+	// ServiceAccountRef is federated with. Required if ServiceAccountRef is
+	// set.
+	// +optional
+	TenantID string `json:"tenantID,omitempty"`
+
+	// ClientID is the client ID of the application that ServiceAccountRef is
+	// federated with. If ServiceAccountRef is not set, it selects the
+	// user-assigned managed identity used to authenticate.
+	// +optional
+	ClientID string `json:"clientID,omitempty"`
+
+	// ServiceAccountRef is a reference to a Kubernetes ServiceAccount in the
+	// resource namespace of the issuer whose tokens are exchanged for Azure
+	// AD tokens using workload identity federation. If not set, the managed
+	// identity of the cert-manager Pod is used, provided that the issuer is
+	// allowed to use ambient credentials.
+	// +optional
+	ServiceAccountRef *AzureKeyVaultServiceAccountRef `json:"serviceAccountRef,omitempty"`
+}
+
+// AzureKeyVaultServiceAccountRef is a reference to a Kubernetes
+// ServiceAccount used for Azure AD workload identity federation.
+type AzureKeyVaultServiceAccountRef struct {
+	// Name of the ServiceAccount.
+	Name string `json:"name"`
+
+	// Audiences of the requested token. Defaults to
+	// api://AzureADTokenExchange.
+	// +optional
+	Audiences []string `json:"audiences,omitempty"`
+}
+
+// EJBCAIssuer configures an issuer to enroll certificates using the PKCS#10
+// enrollment endpoint of the EJBCA REST API.
+type EJBCAIssuer struct {
+	// URL is the base URL of the EJBCA REST API, for example
+	// `https://ejbca.example.com/ejbca/ejbca-rest-api`.
+	URL string `json:"url"`
+
+	// PEM-encoded CA bundle (base64-encoded) used to validate the EJBCA
+	// server certificate. If not set the system root certificates are used to
+	// validate the TLS connection.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// CertificateAuthorityName is the name of the EJBCA CA that signs
+	// certificates.
+	CertificateAuthorityName string `json:"certificateAuthorityName"`
+
+	// CertificateProfileName is the name of the certificate profile used for
+	// enrollment.
+	CertificateProfileName string `json:"certificateProfileName"`
+
+	// EndEntityProfileName is the name of the end entity profile used for
+	// enrollment.
+	EndEntityProfileName string `json:"endEntityProfileName"`
+
+	// EndEntityName is the username of the end entity that certificates are
+	// enrolled for. If not set, the namespace and name of the Certificate
+	// that the request belongs to are used, so that renewals of a Certificate
+	// reuse the same end entity.
+	// +optional
+	EndEntityName string `json:"endEntityName,omitempty"`
+
+	// Auth configures how cert-manager authenticates with the EJBCA REST API.
+	Auth EJBCAAuth `json:"auth"`
+}
+
+// EJBCAAuth configures authentication with the EJBCA REST API. Exactly one
+// of ClientCertSecretRef or TokenSecretRef must be set.
+type EJBCAAuth struct {
+	// ClientCertSecretRef is a reference to a `kubernetes.io/tls` Secret
+	// containing the client certificate and private key used for mutual TLS
+	// authentication, in the `tls.crt` and `tls.key` entries.
+	// +optional
+	ClientCertSecretRef *cmmeta.LocalObjectReference `json:"clientCertSecretRef,omitempty"`
+
+	// TokenSecretRef is a reference to a key in a Secret containing an OAuth
+	// bearer token used to authenticate.
+	// +optional
+	TokenSecretRef *cmmeta.SecretKeySelector `json:"tokenSecretRef,omitempty"`
+}
+
+// StepCAIssuer configures an issuer to request certificates from a step-ca
+// instance. Every request is authorised by a one-time token issued by a JWK
+// or X5C provisioner of the CA.
+type StepCAIssuer struct {
+	// URL is the base URL of the step-ca instance, for example
+	// `https://ca.example.com`.
+	URL string `json:"url"`
+
+	// PEM-encoded CA bundle (base64-encoded) used to validate the step-ca
+	// server certificate, usually the root certificate of the CA. If not set
+	// the system root certificates are used to validate the TLS connection.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Provisioner configures the step-ca provisioner used to authorise
+	// certificate requests.
+	Provisioner StepCAProvisioner `json:"provisioner"`
+}
+
+// StepCAProvisioner configures a step-ca provisioner. Exactly one of JWK or
+// X5C must be set.
+type StepCAProvisioner struct {
+	// Name of the provisioner.
+	Name string `json:"name"`
+
+	// JWK configures a JWK provisioner, whose tokens are signed with the
+	// encrypted provisioner key stored by the CA.
+	// +optional
+	JWK *StepCAJWKProvisioner `json:"jwk,omitempty"`
+
+	// X5C configures an X5C provisioner, whose tokens are signed with a
+	// certificate trusted by the provisioner.
+	// +optional
+	X5C *StepCAX5CProvisioner `json:"x5c,omitempty"`
+}
+
+// StepCAJWKProvisioner configures a step-ca JWK provisioner.
+type StepCAJWKProvisioner struct {
+	// KeyID is the ID of the provisioner key. It only needs to be set if
+	// several JWK provisioners share the same name.
+	// +optional
+	KeyID string `json:"kid,omitempty"`
+
+	// PasswordSecretRef is a reference to a key in a Secret containing the
+	// password used to decrypt the provisioner key.
+	PasswordSecretRef cmmeta.SecretKeySelector `json:"passwordSecretRef"`
+}
+
+// StepCAX5CProvisioner configures a step-ca X5C provisioner.
+type StepCAX5CProvisioner struct {
+	// SecretRef is a reference to a `kubernetes.io/tls` Secret containing a
+	// certificate chained to a root trusted by the provisioner, and its
+	// private key, in the `tls.crt` and `tls.key` entries. The certificate may
+	// itself be managed by cert-manager and renewed like any other
+	// Certificate; it is read every time a token is signed.
+	SecretRef cmmeta.LocalObjectReference `json:"secretRef"`
+}
+
+// KubernetesCSRIssuer configures an issuer to forward CertificateRequests to
+// the Kubernetes CertificateSigningRequest API. The certificate is issued once
+// the CertificateSigningRequest has been approved and signed by the signer.
+type KubernetesCSRIssuer struct {
+	// SignerName is the name of the signer the CertificateSigningRequests
+	// are addressed to, for example `kubernetes.io/kubelet-serving` or
+	// `example.com/my-signer`. Signers of cert-manager Issuers and
+	// ClusterIssuers cannot be used.
+	SignerName string `json:"signerName"`
+}
+
+// SPIREIssuer configures an issuer to mint X.509 SPIFFE Verifiable Identity
+// Documents (SVIDs) using the SVID API of a SPIRE server.
+// Each CertificateRequest must contain exactly one URI SAN, the SPIFFE ID of
+// a ServiceAccount in the namespace of the request, in the form
+// `spiffe://<trust domain>/ns/<namespace>/sa/<service account>`.
+type SPIREIssuer struct {
+	// Address is the host and port of the SPIRE server API, for example
+	// `spire-server.spire.svc:8081`.
+	Address string `json:"address"`
+
+	// TrustDomain is the SPIFFE trust domain of the SPIRE server, for example
+	// `example.org`.
+	TrustDomain string `json:"trustDomain"`
+
+	// PEM-encoded X.509 bundle (base64-encoded) of the trust domain, used to
+	// authenticate the SPIRE server.
+	CABundle []byte `json:"caBundle"`
+
+	// ServerSPIFFEID is the SPIFFE ID the SPIRE server must present.
+	// Defaults to `spiffe://<trust domain>/spire/server`.
+	// +optional
+	ServerSPIFFEID string `json:"serverSPIFFEID,omitempty"`
+
+	// ClientCertSecretRef is a reference to a `kubernetes.io/tls` Secret
+	// containing an X.509 SVID and its private key, in the `tls.crt` and
+	// `tls.key` entries, used to authenticate to the SPIRE server. The
+	// registration entry of the SVID must be marked as admin.
+	ClientCertSecretRef cmmeta.LocalObjectReference `json:"clientCertSecretRef"`
+}
+
+// PluginIssuer configures an issuer to delegate signing to an issuer plugin,
+// a gRPC server implementing the IssuerPlugin service defined in
+// `pkg/issuer/plugin/pluginapi/issuerplugin.proto`. Plugins only implement
+// signing; cert-manager handles the Issuer and CertificateRequest resources.
+type PluginIssuer struct {
+	// Address is the gRPC target of the plugin server, for example
+	// `my-plugin.my-namespace.svc:8443` or `unix:///run/plugin/plugin.sock`.
+	Address string `json:"address"`
+
+	// Name of the plugin to call. A single server may host several plugins,
+	// which are selected by name.
+	Name string `json:"name"`
+
+	// Config is passed verbatim to the plugin with every call. Its meaning is
+	// defined by the plugin.
+	// +optional
+	Config map[string]string `json:"config,omitempty"`
+
+	// PEM-encoded CA bundle (base64-encoded) used to validate the certificate
+	// of the plugin server. If not set, the system root CAs are used.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// ClientCertSecretRef is a reference to a `kubernetes.io/tls` Secret
+	// containing a client certificate and its private key, in the `tls.crt`
+	// and `tls.key` entries, used to authenticate to the plugin server.
+	// +optional
+	ClientCertSecretRef *cmmeta.LocalObjectReference `json:"clientCertSecretRef,omitempty"`
+
+	// Insecure connects to the plugin server without TLS. It should only be
+	// used for plugins reachable on a Unix socket or the loopback interface,
+	// and may not be combined with caBundle or clientCertSecretRef.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
 }
 
 // IssuerStatus contains status information about an Issuer
 type IssuerStatus struct {
 	// List of status conditions to indicate the status of a CertificateRequest.
-	// Known condition types are `Ready`.
+	// Known condition types are `Ready`, `Degraded` and `Healthy`.
 	// +listType=map
 	// +listMapKey=type
 	// +optional
@@ -340,4 +865,15 @@ const (
 	// If the `status` of this condition is `False`, CertificateRequest controllers
 	// should prevent attempts to sign certificates.
 	IssuerConditionReady IssuerConditionType = "Ready"
+
+	// IssuerConditionDegraded is set to `True` when the issuer is still able
+	// to issue certificates, but is not performing as expected, for example
+	// because its issuance latency exceeds its configured budget.
+	IssuerConditionDegraded IssuerConditionType = "Degraded"
+
+	// IssuerConditionHealthy reflects the result of the most recent periodic
+	// health check of the issuer's connectivity and credentials, for issuers
+	// which depend on a remote service. It is `False` if the remote service
+	// could not be reached or rejected the issuer's credentials.
+	IssuerConditionHealthy IssuerConditionType = "Healthy"
 )
@@ -30,6 +30,14 @@ const (
 	// Annotation key for certificate common name.
 	CommonNameAnnotationKey = "cert-manager.io/common-name"
 
+	// Annotation key for the time at which the certificate expires, in RFC3339
+	// format.
+	ExpiresAtAnnotationKey = "cert-manager.io/expires-at"
+
+	// Annotation key for the time at which cert-manager will attempt to renew
+	// the certificate, in RFC3339 format.
+	RenewalAtAnnotationKey = "cert-manager.io/renewal-at"
+
 	// Annotation key the 'name' of the Issuer resource.
 	IssuerNameAnnotationKey = "cert-manager.io/issuer-name"
 
@@ -83,6 +91,31 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to CertificateRequest resources created for a canary
+	// renewal of a Certificate. Its value is the renewal time, in RFC3339
+	// format, that the canary issuance is testing ahead of.
+	CertificateRequestCanaryRenewalAnnotationKey = "cert-manager.io/canary-renewal"
+
+	// Annotation added to CertificateRequest resources to request the
+	// signature algorithm used by the CA and SelfSigned issuers to sign the
+	// certificate, e.g. `SHA384WithRSA`. It is copied from the
+	// Certificate's `spec.signatureAlgorithm`.
+	CertificateRequestSignatureAlgorithmAnnotationKey = "cert-manager.io/signature-algorithm"
+
+	// Annotation added to CertificateRequest resources to list the OIDs,
+	// separated by commas, of the extensions in the CSR that the CA and
+	// SelfSigned issuers copy into the certificate. It is set from the
+	// Certificate's `spec.extraExtensions`.
+	CertificateRequestExtraExtensionsAnnotationKey = "cert-manager.io/extra-extensions"
+
+	// Annotations added to CertificateRequest resources to list the
+	// certificate policy and extended key usage OIDs, separated by commas,
+	// that the CA and SelfSigned issuers set on the certificate. They are set
+	// from the Certificate's `spec.certificatePolicies` and
+	// `spec.extendedKeyUsageOIDs`.
+	CertificateRequestCertificatePoliciesAnnotationKey  = "cert-manager.io/certificate-policies"
+	CertificateRequestExtendedKeyUsageOIDsAnnotationKey = "cert-manager.io/extended-key-usage-oids"
 )
 
 const (
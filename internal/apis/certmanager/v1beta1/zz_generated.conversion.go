@@ -42,6 +42,86 @@ func init() {
 // RegisterConversions adds conversion functions to the given scheme.
 // Public to allow building arbitrary schemes.
 func RegisterConversions(s *runtime.Scheme) error {
+	if err := s.AddGeneratedConversionFunc((*AWSKMSKeyEncryption)(nil), (*certmanager.AWSKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(a.(*AWSKMSKeyEncryption), b.(*certmanager.AWSKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSKMSKeyEncryption)(nil), (*AWSKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption(a.(*certmanager.AWSKMSKeyEncryption), b.(*AWSKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AWSKMSPrivateKeyBackend)(nil), (*certmanager.AWSKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(a.(*AWSKMSPrivateKeyBackend), b.(*certmanager.AWSKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSKMSPrivateKeyBackend)(nil), (*AWSKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend(a.(*certmanager.AWSKMSPrivateKeyBackend), b.(*AWSKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AWSPCAAuth)(nil), (*certmanager.AWSPCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(a.(*AWSPCAAuth), b.(*certmanager.AWSPCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSPCAAuth)(nil), (*AWSPCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(a.(*certmanager.AWSPCAAuth), b.(*AWSPCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AWSPCAIssuer)(nil), (*certmanager.AWSPCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(a.(*AWSPCAIssuer), b.(*certmanager.AWSPCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSPCAIssuer)(nil), (*AWSPCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer(a.(*certmanager.AWSPCAIssuer), b.(*AWSPCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AWSSecretsManagerSecretStore)(nil), (*certmanager.AWSSecretsManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(a.(*AWSSecretsManagerSecretStore), b.(*certmanager.AWSSecretsManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AWSSecretsManagerSecretStore)(nil), (*AWSSecretsManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore(a.(*certmanager.AWSSecretsManagerSecretStore), b.(*AWSSecretsManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzureKeyVaultIssuer)(nil), (*certmanager.AzureKeyVaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(a.(*AzureKeyVaultIssuer), b.(*certmanager.AzureKeyVaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AzureKeyVaultIssuer)(nil), (*AzureKeyVaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer(a.(*certmanager.AzureKeyVaultIssuer), b.(*AzureKeyVaultIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*AzureKeyVaultServiceAccountRef)(nil), (*certmanager.AzureKeyVaultServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(a.(*AzureKeyVaultServiceAccountRef), b.(*certmanager.AzureKeyVaultServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.AzureKeyVaultServiceAccountRef)(nil), (*AzureKeyVaultServiceAccountRef)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef(a.(*certmanager.AzureKeyVaultServiceAccountRef), b.(*AzureKeyVaultServiceAccountRef), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CACRL)(nil), (*certmanager.CACRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CACRL_To_certmanager_CACRL(a.(*CACRL), b.(*certmanager.CACRL), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CACRL)(nil), (*CACRL)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CACRL_To_v1beta1_CACRL(a.(*certmanager.CACRL), b.(*CACRL), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CAIssuer)(nil), (*certmanager.CAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer(a.(*CAIssuer), b.(*certmanager.CAIssuer), scope)
 	}); err != nil {
@@ -52,6 +132,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CAOCSP)(nil), (*certmanager.CAOCSP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CAOCSP_To_certmanager_CAOCSP(a.(*CAOCSP), b.(*certmanager.CAOCSP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CAOCSP)(nil), (*CAOCSP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CAOCSP_To_v1beta1_CAOCSP(a.(*certmanager.CAOCSP), b.(*CAOCSP), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Certificate)(nil), (*certmanager.Certificate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Certificate_To_certmanager_Certificate(a.(*Certificate), b.(*certmanager.Certificate), scope)
 	}); err != nil {
@@ -82,6 +172,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExtension)(nil), (*certmanager.CertificateExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension(a.(*CertificateExtension), b.(*certmanager.CertificateExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExtension)(nil), (*CertificateExtension)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension(a.(*certmanager.CertificateExtension), b.(*CertificateExtension), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CertificateExternalSecretStore)(nil), (*certmanager.CertificateExternalSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(a.(*CertificateExternalSecretStore), b.(*certmanager.CertificateExternalSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateExternalSecretStore)(nil), (*CertificateExternalSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore(a.(*certmanager.CertificateExternalSecretStore), b.(*CertificateExternalSecretStore), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateKeystores)(nil), (*certmanager.CertificateKeystores)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(a.(*CertificateKeystores), b.(*certmanager.CertificateKeystores), scope)
 	}); err != nil {
@@ -112,6 +222,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificatePrivateKeyEncryption)(nil), (*certmanager.CertificatePrivateKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(a.(*CertificatePrivateKeyEncryption), b.(*certmanager.CertificatePrivateKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificatePrivateKeyEncryption)(nil), (*CertificatePrivateKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption(a.(*certmanager.CertificatePrivateKeyEncryption), b.(*CertificatePrivateKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateRequest)(nil), (*certmanager.CertificateRequest)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(a.(*CertificateRequest), b.(*certmanager.CertificateRequest), scope)
 	}); err != nil {
@@ -162,6 +282,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CertificateSecretTargets)(nil), (*certmanager.CertificateSecretTargets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(a.(*CertificateSecretTargets), b.(*certmanager.CertificateSecretTargets), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.CertificateSecretTargets)(nil), (*CertificateSecretTargets)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets(a.(*certmanager.CertificateSecretTargets), b.(*CertificateSecretTargets), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CertificateSecretTemplate)(nil), (*certmanager.CertificateSecretTemplate)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(a.(*CertificateSecretTemplate), b.(*certmanager.CertificateSecretTemplate), scope)
 	}); err != nil {
@@ -212,6 +342,86 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*EJBCAAuth)(nil), (*certmanager.EJBCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth(a.(*EJBCAAuth), b.(*certmanager.EJBCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.EJBCAAuth)(nil), (*EJBCAAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth(a.(*certmanager.EJBCAAuth), b.(*EJBCAAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*EJBCAIssuer)(nil), (*certmanager.EJBCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer(a.(*EJBCAIssuer), b.(*certmanager.EJBCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.EJBCAIssuer)(nil), (*EJBCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer(a.(*certmanager.EJBCAIssuer), b.(*EJBCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPKMSKeyEncryption)(nil), (*certmanager.GCPKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(a.(*GCPKMSKeyEncryption), b.(*certmanager.GCPKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPKMSKeyEncryption)(nil), (*GCPKMSKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption(a.(*certmanager.GCPKMSKeyEncryption), b.(*GCPKMSKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPKMSPrivateKeyBackend)(nil), (*certmanager.GCPKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(a.(*GCPKMSPrivateKeyBackend), b.(*certmanager.GCPKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPKMSPrivateKeyBackend)(nil), (*GCPKMSPrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend(a.(*certmanager.GCPKMSPrivateKeyBackend), b.(*GCPKMSPrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GCPSecretManagerSecretStore)(nil), (*certmanager.GCPSecretManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(a.(*GCPSecretManagerSecretStore), b.(*certmanager.GCPSecretManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GCPSecretManagerSecretStore)(nil), (*GCPSecretManagerSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore(a.(*certmanager.GCPSecretManagerSecretStore), b.(*GCPSecretManagerSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*GoogleCASIssuer)(nil), (*certmanager.GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(a.(*GoogleCASIssuer), b.(*certmanager.GoogleCASIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.GoogleCASIssuer)(nil), (*GoogleCASIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer(a.(*certmanager.GoogleCASIssuer), b.(*GoogleCASIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IssuanceLatencyBudget)(nil), (*certmanager.IssuanceLatencyBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(a.(*IssuanceLatencyBudget), b.(*certmanager.IssuanceLatencyBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceLatencyBudget)(nil), (*IssuanceLatencyBudget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceLatencyBudget_To_v1beta1_IssuanceLatencyBudget(a.(*certmanager.IssuanceLatencyBudget), b.(*IssuanceLatencyBudget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IssuanceRateLimit)(nil), (*certmanager.IssuanceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(a.(*IssuanceRateLimit), b.(*certmanager.IssuanceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.IssuanceRateLimit)(nil), (*IssuanceRateLimit)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit(a.(*certmanager.IssuanceRateLimit), b.(*IssuanceRateLimit), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*Issuer)(nil), (*certmanager.Issuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_Issuer_To_certmanager_Issuer(a.(*Issuer), b.(*certmanager.Issuer), scope)
 	}); err != nil {
@@ -282,6 +492,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*KubernetesCSRIssuer)(nil), (*certmanager.KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(a.(*KubernetesCSRIssuer), b.(*certmanager.KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.KubernetesCSRIssuer)(nil), (*KubernetesCSRIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer(a.(*certmanager.KubernetesCSRIssuer), b.(*KubernetesCSRIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NameConstraintItem)(nil), (*certmanager.NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(a.(*NameConstraintItem), b.(*certmanager.NameConstraintItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NameConstraintItem)(nil), (*NameConstraintItem)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(a.(*certmanager.NameConstraintItem), b.(*NameConstraintItem), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NameConstraints)(nil), (*certmanager.NameConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_NameConstraints_To_certmanager_NameConstraints(a.(*NameConstraints), b.(*certmanager.NameConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.NameConstraints)(nil), (*NameConstraints)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_NameConstraints_To_v1beta1_NameConstraints(a.(*certmanager.NameConstraints), b.(*NameConstraints), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*OtherName)(nil), (*certmanager.OtherName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_OtherName_To_certmanager_OtherName(a.(*OtherName), b.(*certmanager.OtherName), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.OtherName)(nil), (*OtherName)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_OtherName_To_v1beta1_OtherName(a.(*certmanager.OtherName), b.(*OtherName), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*PKCS12Keystore)(nil), (*certmanager.PKCS12Keystore)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(a.(*PKCS12Keystore), b.(*certmanager.PKCS12Keystore), scope)
 	}); err != nil {
@@ -292,6 +542,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*PluginIssuer)(nil), (*certmanager.PluginIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer(a.(*PluginIssuer), b.(*certmanager.PluginIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PluginIssuer)(nil), (*PluginIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer(a.(*certmanager.PluginIssuer), b.(*PluginIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*PrivateKeyBackend)(nil), (*certmanager.PrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(a.(*PrivateKeyBackend), b.(*certmanager.PrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.PrivateKeyBackend)(nil), (*PrivateKeyBackend)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend(a.(*certmanager.PrivateKeyBackend), b.(*PrivateKeyBackend), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*SPIREIssuer)(nil), (*certmanager.SPIREIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer(a.(*SPIREIssuer), b.(*certmanager.SPIREIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.SPIREIssuer)(nil), (*SPIREIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer(a.(*certmanager.SPIREIssuer), b.(*SPIREIssuer), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*SelfSignedIssuer)(nil), (*certmanager.SelfSignedIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(a.(*SelfSignedIssuer), b.(*certmanager.SelfSignedIssuer), scope)
 	}); err != nil {
@@ -302,6 +582,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*StepCAIssuer)(nil), (*certmanager.StepCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer(a.(*StepCAIssuer), b.(*certmanager.StepCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAIssuer)(nil), (*StepCAIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer(a.(*certmanager.StepCAIssuer), b.(*StepCAIssuer), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StepCAJWKProvisioner)(nil), (*certmanager.StepCAJWKProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(a.(*StepCAJWKProvisioner), b.(*certmanager.StepCAJWKProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAJWKProvisioner)(nil), (*StepCAJWKProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner(a.(*certmanager.StepCAJWKProvisioner), b.(*StepCAJWKProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StepCAProvisioner)(nil), (*certmanager.StepCAProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner(a.(*StepCAProvisioner), b.(*certmanager.StepCAProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAProvisioner)(nil), (*StepCAProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner(a.(*certmanager.StepCAProvisioner), b.(*StepCAProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StepCAX5CProvisioner)(nil), (*certmanager.StepCAX5CProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(a.(*StepCAX5CProvisioner), b.(*certmanager.StepCAX5CProvisioner), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.StepCAX5CProvisioner)(nil), (*StepCAX5CProvisioner)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner(a.(*certmanager.StepCAX5CProvisioner), b.(*StepCAX5CProvisioner), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VaultAppRole)(nil), (*certmanager.VaultAppRole)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VaultAppRole_To_certmanager_VaultAppRole(a.(*VaultAppRole), b.(*certmanager.VaultAppRole), scope)
 	}); err != nil {
@@ -322,6 +642,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultClientCertificateAuth)(nil), (*certmanager.VaultClientCertificateAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(a.(*VaultClientCertificateAuth), b.(*certmanager.VaultClientCertificateAuth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultClientCertificateAuth)(nil), (*VaultClientCertificateAuth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth(a.(*certmanager.VaultClientCertificateAuth), b.(*VaultClientCertificateAuth), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VaultIssuer)(nil), (*certmanager.VaultIssuer)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(a.(*VaultIssuer), b.(*certmanager.VaultIssuer), scope)
 	}); err != nil {
@@ -342,6 +672,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VaultSecretStore)(nil), (*certmanager.VaultSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore(a.(*VaultSecretStore), b.(*certmanager.VaultSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultSecretStore)(nil), (*VaultSecretStore)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore(a.(*certmanager.VaultSecretStore), b.(*VaultSecretStore), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*VaultTransitKeyEncryption)(nil), (*certmanager.VaultTransitKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(a.(*VaultTransitKeyEncryption), b.(*certmanager.VaultTransitKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*certmanager.VaultTransitKeyEncryption)(nil), (*VaultTransitKeyEncryption)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption(a.(*certmanager.VaultTransitKeyEncryption), b.(*VaultTransitKeyEncryption), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VenafiCloud)(nil), (*certmanager.VenafiCloud)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1beta1_VenafiCloud_To_certmanager_VenafiCloud(a.(*VenafiCloud), b.(*certmanager.VenafiCloud), scope)
 	}); err != nil {
@@ -385,50 +735,402 @@ func RegisterConversions(s *runtime.Scheme) error {
 	return nil
 }
 
-func autoConvert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
-	out.SecretName = in.SecretName
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
-	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+func autoConvert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in *AWSKMSKeyEncryption, out *certmanager.AWSKMSKeyEncryption, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer is an autogenerated conversion function.
-func Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
-	return autoConvert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in, out, s)
+// Convert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption is an autogenerated conversion function.
+func Convert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in *AWSKMSKeyEncryption, out *certmanager.AWSKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(in, out, s)
 }
 
-func autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssuer, out *CAIssuer, s conversion.Scope) error {
-	out.SecretName = in.SecretName
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
-	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+func autoConvert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption(in *certmanager.AWSKMSKeyEncryption, out *AWSKMSKeyEncryption, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
 	return nil
 }
 
-// Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer is an autogenerated conversion function.
-func Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssuer, out *CAIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in, out, s)
+// Convert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption(in *certmanager.AWSKMSKeyEncryption, out *AWSKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption(in, out, s)
 }
 
-func autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in *Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
-	if err := Convert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+func autoConvert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in *AWSKMSPrivateKeyBackend, out *certmanager.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
-func Convert_v1beta1_Certificate_To_certmanager_Certificate(in *Certificate, out *certmanager.Certificate, s conversion.Scope) error {
-	return autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in, out, s)
+// Convert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in *AWSKMSPrivateKeyBackend, out *certmanager.AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *Certificate, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
+func autoConvert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend(in *certmanager.AWSKMSPrivateKeyBackend, out *AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.Region = in.Region
+	out.KeyID = in.KeyID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend(in *certmanager.AWSKMSPrivateKeyBackend, out *AWSKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in *AWSPCAAuth, out *certmanager.AWSPCAAuth, s conversion.Scope) error {
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef, s); err != nil {
+		return err
+	}
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth is an autogenerated conversion function.
+func Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in *AWSPCAAuth, out *certmanager.AWSPCAAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(in, out, s)
+}
+
+func autoConvert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(in *certmanager.AWSPCAAuth, out *AWSPCAAuth, s conversion.Scope) error {
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.AccessKeyIDSecretRef, &out.AccessKeyIDSecretRef, s); err != nil {
+		return err
+	}
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.SecretAccessKeySecretRef, &out.SecretAccessKeySecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth is an autogenerated conversion function.
+func Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(in *certmanager.AWSPCAAuth, out *AWSPCAAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(in, out, s)
+}
+
+func autoConvert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in *AWSPCAIssuer, out *certmanager.AWSPCAIssuer, s conversion.Scope) error {
+	out.Arn = in.Arn
+	out.Region = in.Region
+	out.TemplateArn = in.TemplateArn
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer is an autogenerated conversion function.
+func Convert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in *AWSPCAIssuer, out *certmanager.AWSPCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer(in *certmanager.AWSPCAIssuer, out *AWSPCAIssuer, s conversion.Scope) error {
+	out.Arn = in.Arn
+	out.Region = in.Region
+	out.TemplateArn = in.TemplateArn
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer(in *certmanager.AWSPCAIssuer, out *AWSPCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in *AWSSecretsManagerSecretStore, out *certmanager.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretID = in.SecretID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(certmanager.AWSPCAAuth)
+		if err := Convert_v1beta1_AWSPCAAuth_To_certmanager_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore is an autogenerated conversion function.
+func Convert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in *AWSSecretsManagerSecretStore, out *certmanager.AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore(in *certmanager.AWSSecretsManagerSecretStore, out *AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	out.Region = in.Region
+	out.SecretID = in.SecretID
+	out.Role = in.Role
+	if in.Auth != nil {
+		in, out := &in.Auth, &out.Auth
+		*out = new(AWSPCAAuth)
+		if err := Convert_certmanager_AWSPCAAuth_To_v1beta1_AWSPCAAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Auth = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore is an autogenerated conversion function.
+func Convert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore(in *certmanager.AWSSecretsManagerSecretStore, out *AWSSecretsManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore(in, out, s)
+}
+
+func autoConvert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in *AzureKeyVaultIssuer, out *certmanager.AzureKeyVaultIssuer, s conversion.Scope) error {
+	out.VaultURL = in.VaultURL
+	out.CertificateName = in.CertificateName
+	out.Environment = in.Environment
+	out.TenantID = in.TenantID
+	out.ClientID = in.ClientID
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(certmanager.AzureKeyVaultServiceAccountRef)
+		if err := Convert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRef = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer is an autogenerated conversion function.
+func Convert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in *AzureKeyVaultIssuer, out *certmanager.AzureKeyVaultIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer(in *certmanager.AzureKeyVaultIssuer, out *AzureKeyVaultIssuer, s conversion.Scope) error {
+	out.VaultURL = in.VaultURL
+	out.CertificateName = in.CertificateName
+	out.Environment = in.Environment
+	out.TenantID = in.TenantID
+	out.ClientID = in.ClientID
+	if in.ServiceAccountRef != nil {
+		in, out := &in.ServiceAccountRef, &out.ServiceAccountRef
+		*out = new(AzureKeyVaultServiceAccountRef)
+		if err := Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer is an autogenerated conversion function.
+func Convert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer(in *certmanager.AzureKeyVaultIssuer, out *AzureKeyVaultIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in *AzureKeyVaultServiceAccountRef, out *certmanager.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef is an autogenerated conversion function.
+func Convert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in *AzureKeyVaultServiceAccountRef, out *certmanager.AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_v1beta1_AzureKeyVaultServiceAccountRef_To_certmanager_AzureKeyVaultServiceAccountRef(in, out, s)
+}
+
+func autoConvert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef(in *certmanager.AzureKeyVaultServiceAccountRef, out *AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Audiences = *(*[]string)(unsafe.Pointer(&in.Audiences))
+	return nil
+}
+
+// Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef is an autogenerated conversion function.
+func Convert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef(in *certmanager.AzureKeyVaultServiceAccountRef, out *AzureKeyVaultServiceAccountRef, s conversion.Scope) error {
+	return autoConvert_certmanager_AzureKeyVaultServiceAccountRef_To_v1beta1_AzureKeyVaultServiceAccountRef(in, out, s)
+}
+
+func autoConvert_v1beta1_CACRL_To_certmanager_CACRL(in *CACRL, out *certmanager.CACRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ConfigMapName = in.ConfigMapName
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_v1beta1_CACRL_To_certmanager_CACRL is an autogenerated conversion function.
+func Convert_v1beta1_CACRL_To_certmanager_CACRL(in *CACRL, out *certmanager.CACRL, s conversion.Scope) error {
+	return autoConvert_v1beta1_CACRL_To_certmanager_CACRL(in, out, s)
+}
+
+func autoConvert_certmanager_CACRL_To_v1beta1_CACRL(in *certmanager.CACRL, out *CACRL, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.ConfigMapName = in.ConfigMapName
+	out.Validity = (*v1.Duration)(unsafe.Pointer(in.Validity))
+	return nil
+}
+
+// Convert_certmanager_CACRL_To_v1beta1_CACRL is an autogenerated conversion function.
+func Convert_certmanager_CACRL_To_v1beta1_CACRL(in *certmanager.CACRL, out *CACRL, s conversion.Scope) error {
+	return autoConvert_certmanager_CACRL_To_v1beta1_CACRL(in, out, s)
+}
+
+func autoConvert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	out.ChainSecretNames = *(*[]string)(unsafe.Pointer(&in.ChainSecretNames))
+	if in.CRL != nil {
+		in, out := &in.CRL, &out.CRL
+		*out = new(certmanager.CACRL)
+		if err := Convert_v1beta1_CACRL_To_certmanager_CACRL(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRL = nil
+	}
+	if in.OCSP != nil {
+		in, out := &in.OCSP, &out.OCSP
+		*out = new(certmanager.CAOCSP)
+		if err := Convert_v1beta1_CAOCSP_To_certmanager_CAOCSP(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCSP = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer is an autogenerated conversion function.
+func Convert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in *CAIssuer, out *certmanager.CAIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAIssuer_To_certmanager_CAIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssuer, out *CAIssuer, s conversion.Scope) error {
+	out.SecretName = in.SecretName
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+	out.OCSPServers = *(*[]string)(unsafe.Pointer(&in.OCSPServers))
+	out.ChainSecretNames = *(*[]string)(unsafe.Pointer(&in.ChainSecretNames))
+	if in.CRL != nil {
+		in, out := &in.CRL, &out.CRL
+		*out = new(CACRL)
+		if err := Convert_certmanager_CACRL_To_v1beta1_CACRL(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.CRL = nil
+	}
+	if in.OCSP != nil {
+		in, out := &in.OCSP, &out.OCSP
+		*out = new(CAOCSP)
+		if err := Convert_certmanager_CAOCSP_To_v1beta1_CAOCSP(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.OCSP = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer is an autogenerated conversion function.
+func Convert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in *certmanager.CAIssuer, out *CAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_CAIssuer_To_v1beta1_CAIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_CAOCSP_To_certmanager_CAOCSP(in *CAOCSP, out *certmanager.CAOCSP, s conversion.Scope) error {
+	out.ResponderSecretName = in.ResponderSecretName
+	out.ResponseValidity = (*v1.Duration)(unsafe.Pointer(in.ResponseValidity))
+	return nil
+}
+
+// Convert_v1beta1_CAOCSP_To_certmanager_CAOCSP is an autogenerated conversion function.
+func Convert_v1beta1_CAOCSP_To_certmanager_CAOCSP(in *CAOCSP, out *certmanager.CAOCSP, s conversion.Scope) error {
+	return autoConvert_v1beta1_CAOCSP_To_certmanager_CAOCSP(in, out, s)
+}
+
+func autoConvert_certmanager_CAOCSP_To_v1beta1_CAOCSP(in *certmanager.CAOCSP, out *CAOCSP, s conversion.Scope) error {
+	out.ResponderSecretName = in.ResponderSecretName
+	out.ResponseValidity = (*v1.Duration)(unsafe.Pointer(in.ResponseValidity))
+	return nil
+}
+
+// Convert_certmanager_CAOCSP_To_v1beta1_CAOCSP is an autogenerated conversion function.
+func Convert_certmanager_CAOCSP_To_v1beta1_CAOCSP(in *certmanager.CAOCSP, out *CAOCSP, s conversion.Scope) error {
+	return autoConvert_certmanager_CAOCSP_To_v1beta1_CAOCSP(in, out, s)
+}
+
+func autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in *Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_CertificateStatus_To_certmanager_CertificateStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Certificate_To_certmanager_Certificate is an autogenerated conversion function.
+func Convert_v1beta1_Certificate_To_certmanager_Certificate(in *Certificate, out *certmanager.Certificate, s conversion.Scope) error {
+	return autoConvert_v1beta1_Certificate_To_certmanager_Certificate(in, out, s)
+}
+
+func autoConvert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Certificate, out *Certificate, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
 	}
 	if err := Convert_certmanager_CertificateStatus_To_v1beta1_CertificateStatus(&in.Status, &out.Status, s); err != nil {
 		return err
@@ -443,6 +1145,7 @@ func Convert_certmanager_Certificate_To_v1beta1_Certificate(in *certmanager.Cert
 
 func autoConvert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_CertificateAdditionalOutputFormat(in *CertificateAdditionalOutputFormat, out *certmanager.CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = certmanager.CertificateOutputFormatType(in.Type)
+	out.Key = in.Key
 	return nil
 }
 
@@ -453,6 +1156,7 @@ func Convert_v1beta1_CertificateAdditionalOutputFormat_To_certmanager_Certificat
 
 func autoConvert_certmanager_CertificateAdditionalOutputFormat_To_v1beta1_CertificateAdditionalOutputFormat(in *certmanager.CertificateAdditionalOutputFormat, out *CertificateAdditionalOutputFormat, s conversion.Scope) error {
 	out.Type = CertificateOutputFormatType(in.Type)
+	out.Key = in.Key
 	return nil
 }
 
@@ -491,6 +1195,102 @@ func Convert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in
 	return autoConvert_certmanager_CertificateCondition_To_v1beta1_CertificateCondition(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension(in *CertificateExtension, out *certmanager.CertificateExtension, s conversion.Scope) error {
+	out.OID = in.OID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension is an autogenerated conversion function.
+func Convert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension(in *CertificateExtension, out *certmanager.CertificateExtension, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension(in *certmanager.CertificateExtension, out *CertificateExtension, s conversion.Scope) error {
+	out.OID = in.OID
+	out.Critical = in.Critical
+	out.Value = *(*[]byte)(unsafe.Pointer(&in.Value))
+	return nil
+}
+
+// Convert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension is an autogenerated conversion function.
+func Convert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension(in *certmanager.CertificateExtension, out *CertificateExtension, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in *CertificateExternalSecretStore, out *certmanager.CertificateExternalSecretStore, s conversion.Scope) error {
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(certmanager.VaultSecretStore)
+		if err := Convert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	if in.AWSSecretsManager != nil {
+		in, out := &in.AWSSecretsManager, &out.AWSSecretsManager
+		*out = new(certmanager.AWSSecretsManagerSecretStore)
+		if err := Convert_v1beta1_AWSSecretsManagerSecretStore_To_certmanager_AWSSecretsManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSSecretsManager = nil
+	}
+	if in.GCPSecretManager != nil {
+		in, out := &in.GCPSecretManager, &out.GCPSecretManager
+		*out = new(certmanager.GCPSecretManagerSecretStore)
+		if err := Convert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPSecretManager = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore is an autogenerated conversion function.
+func Convert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in *CertificateExternalSecretStore, out *certmanager.CertificateExternalSecretStore, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore(in *certmanager.CertificateExternalSecretStore, out *CertificateExternalSecretStore, s conversion.Scope) error {
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultSecretStore)
+		if err := Convert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	if in.AWSSecretsManager != nil {
+		in, out := &in.AWSSecretsManager, &out.AWSSecretsManager
+		*out = new(AWSSecretsManagerSecretStore)
+		if err := Convert_certmanager_AWSSecretsManagerSecretStore_To_v1beta1_AWSSecretsManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSSecretsManager = nil
+	}
+	if in.GCPSecretManager != nil {
+		in, out := &in.GCPSecretManager, &out.GCPSecretManager
+		*out = new(GCPSecretManagerSecretStore)
+		if err := Convert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPSecretManager = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore is an autogenerated conversion function.
+func Convert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore(in *certmanager.CertificateExternalSecretStore, out *CertificateExternalSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateKeystores_To_certmanager_CertificateKeystores(in *CertificateKeystores, out *certmanager.CertificateKeystores, s conversion.Scope) error {
 	if in.JKS != nil {
 		in, out := &in.JKS, &out.JKS
@@ -592,6 +1392,16 @@ func autoConvert_v1beta1_CertificatePrivateKey_To_certmanager_CertificatePrivate
 	out.Encoding = certmanager.PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = certmanager.PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.KeyRotationInterval = (*v1.Duration)(unsafe.Pointer(in.KeyRotationInterval))
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(certmanager.PrivateKeyBackend)
+		if err := Convert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backend = nil
+	}
 	return nil
 }
 
@@ -605,6 +1415,16 @@ func autoConvert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivate
 	out.Encoding = PrivateKeyEncoding(in.Encoding)
 	out.Algorithm = PrivateKeyAlgorithm(in.Algorithm)
 	out.Size = in.Size
+	out.KeyRotationInterval = (*v1.Duration)(unsafe.Pointer(in.KeyRotationInterval))
+	if in.Backend != nil {
+		in, out := &in.Backend, &out.Backend
+		*out = new(PrivateKeyBackend)
+		if err := Convert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Backend = nil
+	}
 	return nil
 }
 
@@ -613,27 +1433,99 @@ func Convert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(
 	return autoConvert_certmanager_CertificatePrivateKey_To_v1beta1_CertificatePrivateKey(in, out, s)
 }
 
-func autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
+func autoConvert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in *CertificatePrivateKeyEncryption, out *certmanager.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(certmanager.AWSKMSKeyEncryption)
+		if err := Convert_v1beta1_AWSKMSKeyEncryption_To_certmanager_AWSKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSKMS = nil
 	}
-	if err := Convert_v1beta1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(certmanager.GCPKMSKeyEncryption)
+		if err := Convert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPKMS = nil
+	}
+	if in.VaultTransit != nil {
+		in, out := &in.VaultTransit, &out.VaultTransit
+		*out = new(certmanager.VaultTransitKeyEncryption)
+		if err := Convert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VaultTransit = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest is an autogenerated conversion function.
-func Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
-	return autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in, out, s)
+// Convert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption is an autogenerated conversion function.
+func Convert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in *CertificatePrivateKeyEncryption, out *certmanager.CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(in, out, s)
 }
 
-func autoConvert_certmanager_CertificateRequest_To_v1beta1_CertificateRequest(in *certmanager.CertificateRequest, out *CertificateRequest, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_CertificateRequestSpec_To_v1beta1_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
-	}
+func autoConvert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption(in *certmanager.CertificatePrivateKeyEncryption, out *CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(AWSKMSKeyEncryption)
+		if err := Convert_certmanager_AWSKMSKeyEncryption_To_v1beta1_AWSKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSKMS = nil
+	}
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(GCPKMSKeyEncryption)
+		if err := Convert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GCPKMS = nil
+	}
+	if in.VaultTransit != nil {
+		in, out := &in.VaultTransit, &out.VaultTransit
+		*out = new(VaultTransitKeyEncryption)
+		if err := Convert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.VaultTransit = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption(in *certmanager.CertificatePrivateKeyEncryption, out *CertificatePrivateKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption(in, out, s)
+}
+
+func autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_CertificateRequestSpec_To_certmanager_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_CertificateRequestStatus_To_certmanager_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest is an autogenerated conversion function.
+func Convert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in *CertificateRequest, out *certmanager.CertificateRequest, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateRequest_To_certmanager_CertificateRequest(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateRequest_To_v1beta1_CertificateRequest(in *certmanager.CertificateRequest, out *CertificateRequest, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_CertificateRequestSpec_To_v1beta1_CertificateRequestSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
 	if err := Convert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestStatus(&in.Status, &out.Status, s); err != nil {
 		return err
 	}
@@ -781,6 +1673,28 @@ func Convert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestS
 	return autoConvert_certmanager_CertificateRequestStatus_To_v1beta1_CertificateRequestStatus(in, out, s)
 }
 
+func autoConvert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in *CertificateSecretTargets, out *certmanager.CertificateSecretTargets, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets is an autogenerated conversion function.
+func Convert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in *CertificateSecretTargets, out *certmanager.CertificateSecretTargets, s conversion.Scope) error {
+	return autoConvert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(in, out, s)
+}
+
+func autoConvert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets(in *certmanager.CertificateSecretTargets, out *CertificateSecretTargets, s conversion.Scope) error {
+	out.Namespaces = *(*[]string)(unsafe.Pointer(&in.Namespaces))
+	out.NamespaceSelector = (*v1.LabelSelector)(unsafe.Pointer(in.NamespaceSelector))
+	return nil
+}
+
+// Convert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets is an autogenerated conversion function.
+func Convert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets(in *certmanager.CertificateSecretTargets, out *CertificateSecretTargets, s conversion.Scope) error {
+	return autoConvert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets(in, out, s)
+}
+
 func autoConvert_v1beta1_CertificateSecretTemplate_To_certmanager_CertificateSecretTemplate(in *CertificateSecretTemplate, out *certmanager.CertificateSecretTemplate, s conversion.Scope) error {
 	out.Annotations = *(*map[string]string)(unsafe.Pointer(&in.Annotations))
 	out.Labels = *(*map[string]string)(unsafe.Pointer(&in.Labels))
@@ -831,8 +1745,88 @@ func autoConvert_v1beta1_CertificateSpec_To_certmanager_CertificateSpec(in *Cert
 	out.Usages = *(*[]certmanager.KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*certmanager.CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
+	out.SignatureAlgorithm = certmanager.SignatureAlgorithm(in.SignatureAlgorithm)
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.RevisionHistoryTTL = (*v1.Duration)(unsafe.Pointer(in.RevisionHistoryTTL))
 	out.AdditionalOutputFormats = *(*[]certmanager.CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CanaryRenewal = in.CanaryRenewal
+	out.MaxPathLen = (*int32)(unsafe.Pointer(in.MaxPathLen))
+	if in.NameConstraints != nil {
+		in, out := &in.NameConstraints, &out.NameConstraints
+		*out = new(certmanager.NameConstraints)
+		if err := Convert_v1beta1_NameConstraints_To_certmanager_NameConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NameConstraints = nil
+	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]meta.ObjectReference, len(*in))
+		for i := range *in {
+			if err := apismetav1.Convert_v1_ObjectReference_To_meta_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.FailoverAttempts = (*int32)(unsafe.Pointer(in.FailoverAttempts))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	if in.SecretTargets != nil {
+		in, out := &in.SecretTargets, &out.SecretTargets
+		*out = new(certmanager.CertificateSecretTargets)
+		if err := Convert_v1beta1_CertificateSecretTargets_To_certmanager_CertificateSecretTargets(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTargets = nil
+	}
+	if in.ExternalSecretStores != nil {
+		in, out := &in.ExternalSecretStores, &out.ExternalSecretStores
+		*out = make([]certmanager.CertificateExternalSecretStore, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_CertificateExternalSecretStore_To_certmanager_CertificateExternalSecretStore(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalSecretStores = nil
+	}
+	if in.OtherNames != nil {
+		in, out := &in.OtherNames, &out.OtherNames
+		*out = make([]certmanager.OtherName, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_OtherName_To_certmanager_OtherName(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OtherNames = nil
+	}
+	if in.ExtraExtensions != nil {
+		in, out := &in.ExtraExtensions, &out.ExtraExtensions
+		*out = make([]certmanager.CertificateExtension, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_CertificateExtension_To_certmanager_CertificateExtension(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraExtensions = nil
+	}
+	out.CertificatePolicies = *(*[]string)(unsafe.Pointer(&in.CertificatePolicies))
+	out.ExtendedKeyUsageOIDs = *(*[]string)(unsafe.Pointer(&in.ExtendedKeyUsageOIDs))
+	if in.PrivateKeyEncryption != nil {
+		in, out := &in.PrivateKeyEncryption, &out.PrivateKeyEncryption
+		*out = new(certmanager.CertificatePrivateKeyEncryption)
+		if err := Convert_v1beta1_CertificatePrivateKeyEncryption_To_certmanager_CertificatePrivateKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKeyEncryption = nil
+	}
 	return nil
 }
 
@@ -869,8 +1863,88 @@ func autoConvert_certmanager_CertificateSpec_To_v1beta1_CertificateSpec(in *cert
 	out.Usages = *(*[]KeyUsage)(unsafe.Pointer(&in.Usages))
 	out.PrivateKey = (*CertificatePrivateKey)(unsafe.Pointer(in.PrivateKey))
 	out.EncodeUsagesInRequest = (*bool)(unsafe.Pointer(in.EncodeUsagesInRequest))
+	out.SignatureAlgorithm = SignatureAlgorithm(in.SignatureAlgorithm)
 	out.RevisionHistoryLimit = (*int32)(unsafe.Pointer(in.RevisionHistoryLimit))
+	out.RevisionHistoryTTL = (*v1.Duration)(unsafe.Pointer(in.RevisionHistoryTTL))
 	out.AdditionalOutputFormats = *(*[]CertificateAdditionalOutputFormat)(unsafe.Pointer(&in.AdditionalOutputFormats))
+	out.CanaryRenewal = in.CanaryRenewal
+	out.MaxPathLen = (*int32)(unsafe.Pointer(in.MaxPathLen))
+	if in.NameConstraints != nil {
+		in, out := &in.NameConstraints, &out.NameConstraints
+		*out = new(NameConstraints)
+		if err := Convert_certmanager_NameConstraints_To_v1beta1_NameConstraints(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.NameConstraints = nil
+	}
+	if in.FallbackIssuerRefs != nil {
+		in, out := &in.FallbackIssuerRefs, &out.FallbackIssuerRefs
+		*out = make([]metav1.ObjectReference, len(*in))
+		for i := range *in {
+			if err := apismetav1.Convert_meta_ObjectReference_To_v1_ObjectReference(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.FallbackIssuerRefs = nil
+	}
+	out.FailoverAttempts = (*int32)(unsafe.Pointer(in.FailoverAttempts))
+	out.RenewalJitter = (*v1.Duration)(unsafe.Pointer(in.RenewalJitter))
+	out.RenewBeforePercentage = (*int32)(unsafe.Pointer(in.RenewBeforePercentage))
+	if in.SecretTargets != nil {
+		in, out := &in.SecretTargets, &out.SecretTargets
+		*out = new(CertificateSecretTargets)
+		if err := Convert_certmanager_CertificateSecretTargets_To_v1beta1_CertificateSecretTargets(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SecretTargets = nil
+	}
+	if in.ExternalSecretStores != nil {
+		in, out := &in.ExternalSecretStores, &out.ExternalSecretStores
+		*out = make([]CertificateExternalSecretStore, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateExternalSecretStore_To_v1beta1_CertificateExternalSecretStore(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExternalSecretStores = nil
+	}
+	if in.OtherNames != nil {
+		in, out := &in.OtherNames, &out.OtherNames
+		*out = make([]OtherName, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_OtherName_To_v1beta1_OtherName(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.OtherNames = nil
+	}
+	if in.ExtraExtensions != nil {
+		in, out := &in.ExtraExtensions, &out.ExtraExtensions
+		*out = make([]CertificateExtension, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_CertificateExtension_To_v1beta1_CertificateExtension(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.ExtraExtensions = nil
+	}
+	out.CertificatePolicies = *(*[]string)(unsafe.Pointer(&in.CertificatePolicies))
+	out.ExtendedKeyUsageOIDs = *(*[]string)(unsafe.Pointer(&in.ExtendedKeyUsageOIDs))
+	if in.PrivateKeyEncryption != nil {
+		in, out := &in.PrivateKeyEncryption, &out.PrivateKeyEncryption
+		*out = new(CertificatePrivateKeyEncryption)
+		if err := Convert_certmanager_CertificatePrivateKeyEncryption_To_v1beta1_CertificatePrivateKeyEncryption(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.PrivateKeyEncryption = nil
+	}
 	return nil
 }
 
@@ -987,302 +2061,1156 @@ func Convert_certmanager_ClusterIssuerList_To_v1beta1_ClusterIssuerList(in *cert
 	return autoConvert_certmanager_ClusterIssuerList_To_v1beta1_ClusterIssuerList(in, out, s)
 }
 
-func autoConvert_v1beta1_Issuer_To_certmanager_Issuer(in *Issuer, out *certmanager.Issuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
-		return err
+func autoConvert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth(in *EJBCAAuth, out *certmanager.EJBCAAuth, s conversion.Scope) error {
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.LocalObjectReference)
+		if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
 	}
-	if err := Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
-		return err
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TokenSecretRef = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_Issuer_To_certmanager_Issuer is an autogenerated conversion function.
-func Convert_v1beta1_Issuer_To_certmanager_Issuer(in *Issuer, out *certmanager.Issuer, s conversion.Scope) error {
-	return autoConvert_v1beta1_Issuer_To_certmanager_Issuer(in, out, s)
+// Convert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth is an autogenerated conversion function.
+func Convert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth(in *EJBCAAuth, out *certmanager.EJBCAAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth(in, out, s)
 }
 
-func autoConvert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *Issuer, s conversion.Scope) error {
-	out.ObjectMeta = in.ObjectMeta
-	if err := Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+func autoConvert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth(in *certmanager.EJBCAAuth, out *EJBCAAuth, s conversion.Scope) error {
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.LocalObjectReference)
+		if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	if in.TokenSecretRef != nil {
+		in, out := &in.TokenSecretRef, &out.TokenSecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.TokenSecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth is an autogenerated conversion function.
+func Convert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth(in *certmanager.EJBCAAuth, out *EJBCAAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth(in, out, s)
+}
+
+func autoConvert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in *EJBCAIssuer, out *certmanager.EJBCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.CertificateAuthorityName = in.CertificateAuthorityName
+	out.CertificateProfileName = in.CertificateProfileName
+	out.EndEntityProfileName = in.EndEntityProfileName
+	out.EndEntityName = in.EndEntityName
+	if err := Convert_v1beta1_EJBCAAuth_To_certmanager_EJBCAAuth(&in.Auth, &out.Auth, s); err != nil {
 		return err
 	}
-	if err := Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+	return nil
+}
+
+// Convert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer is an autogenerated conversion function.
+func Convert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in *EJBCAIssuer, out *certmanager.EJBCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer(in *certmanager.EJBCAIssuer, out *EJBCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.CertificateAuthorityName = in.CertificateAuthorityName
+	out.CertificateProfileName = in.CertificateProfileName
+	out.EndEntityProfileName = in.EndEntityProfileName
+	out.EndEntityName = in.EndEntityName
+	if err := Convert_certmanager_EJBCAAuth_To_v1beta1_EJBCAAuth(&in.Auth, &out.Auth, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_Issuer_To_v1beta1_Issuer is an autogenerated conversion function.
-func Convert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *Issuer, s conversion.Scope) error {
-	return autoConvert_certmanager_Issuer_To_v1beta1_Issuer(in, out, s)
+// Convert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer(in *certmanager.EJBCAIssuer, out *EJBCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer(in, out, s)
 }
 
-func autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
-	out.Type = certmanager.IssuerConditionType(in.Type)
-	out.Status = meta.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in *GCPKMSKeyEncryption, out *certmanager.GCPKMSKeyEncryption, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
 	return nil
 }
 
-// Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition is an autogenerated conversion function.
-func Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
-	return autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in, out, s)
+// Convert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption is an autogenerated conversion function.
+func Convert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in *GCPKMSKeyEncryption, out *certmanager.GCPKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPKMSKeyEncryption_To_certmanager_GCPKMSKeyEncryption(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmanager.IssuerCondition, out *IssuerCondition, s conversion.Scope) error {
-	out.Type = IssuerConditionType(in.Type)
-	out.Status = metav1.ConditionStatus(in.Status)
-	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
-	out.Reason = in.Reason
-	out.Message = in.Message
-	out.ObservedGeneration = in.ObservedGeneration
+func autoConvert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption(in *certmanager.GCPKMSKeyEncryption, out *GCPKMSKeyEncryption, s conversion.Scope) error {
+	out.KeyName = in.KeyName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption(in *certmanager.GCPKMSKeyEncryption, out *GCPKMSKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPKMSKeyEncryption_To_v1beta1_GCPKMSKeyEncryption(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in *GCPKMSPrivateKeyBackend, out *certmanager.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.KeyVersionName = in.KeyVersionName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in *GCPKMSPrivateKeyBackend, out *certmanager.GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend(in *certmanager.GCPKMSPrivateKeyBackend, out *GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	out.KeyVersionName = in.KeyVersionName
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend(in *certmanager.GCPKMSPrivateKeyBackend, out *GCPKMSPrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend(in, out, s)
+}
+
+func autoConvert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in *GCPSecretManagerSecretStore, out *certmanager.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	out.Project = in.Project
+	out.SecretID = in.SecretID
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore is an autogenerated conversion function.
+func Convert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in *GCPSecretManagerSecretStore, out *certmanager.GCPSecretManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_v1beta1_GCPSecretManagerSecretStore_To_certmanager_GCPSecretManagerSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore(in *certmanager.GCPSecretManagerSecretStore, out *GCPSecretManagerSecretStore, s conversion.Scope) error {
+	out.Project = in.Project
+	out.SecretID = in.SecretID
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore is an autogenerated conversion function.
+func Convert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore(in *certmanager.GCPSecretManagerSecretStore, out *GCPSecretManagerSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_GCPSecretManagerSecretStore_To_v1beta1_GCPSecretManagerSecretStore(in, out, s)
+}
+
+func autoConvert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPoolID = in.CAPoolID
+	out.CertificateAuthorityID = in.CertificateAuthorityID
+	out.CertificateTemplate = in.CertificateTemplate
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(meta.SecretKeySelector)
+		if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in *GoogleCASIssuer, out *certmanager.GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *GoogleCASIssuer, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Location = in.Location
+	out.CAPoolID = in.CAPoolID
+	out.CertificateAuthorityID = in.CertificateAuthorityID
+	out.CertificateTemplate = in.CertificateTemplate
+	if in.ServiceAccountKeySecretRef != nil {
+		in, out := &in.ServiceAccountKeySecretRef, &out.ServiceAccountKeySecretRef
+		*out = new(metav1.SecretKeySelector)
+		if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ServiceAccountKeySecretRef = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer is an autogenerated conversion function.
+func Convert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer(in *certmanager.GoogleCASIssuer, out *GoogleCASIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in *IssuanceLatencyBudget, out *certmanager.IssuanceLatencyBudget, s conversion.Scope) error {
+	out.Average = (*v1.Duration)(unsafe.Pointer(in.Average))
+	out.P95 = (*v1.Duration)(unsafe.Pointer(in.P95))
+	out.Samples = in.Samples
+	return nil
+}
+
+// Convert_v1beta1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget is an autogenerated conversion function.
+func Convert_v1beta1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in *IssuanceLatencyBudget, out *certmanager.IssuanceLatencyBudget, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuanceLatencyBudget_To_certmanager_IssuanceLatencyBudget(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceLatencyBudget_To_v1beta1_IssuanceLatencyBudget(in *certmanager.IssuanceLatencyBudget, out *IssuanceLatencyBudget, s conversion.Scope) error {
+	out.Average = (*v1.Duration)(unsafe.Pointer(in.Average))
+	out.P95 = (*v1.Duration)(unsafe.Pointer(in.P95))
+	out.Samples = in.Samples
+	return nil
+}
+
+// Convert_certmanager_IssuanceLatencyBudget_To_v1beta1_IssuanceLatencyBudget is an autogenerated conversion function.
+func Convert_certmanager_IssuanceLatencyBudget_To_v1beta1_IssuanceLatencyBudget(in *certmanager.IssuanceLatencyBudget, out *IssuanceLatencyBudget, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceLatencyBudget_To_v1beta1_IssuanceLatencyBudget(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in *IssuanceRateLimit, out *certmanager.IssuanceRateLimit, s conversion.Scope) error {
+	out.MaxRequests = in.MaxRequests
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit is an autogenerated conversion function.
+func Convert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in *IssuanceRateLimit, out *certmanager.IssuanceRateLimit, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(in, out, s)
+}
+
+func autoConvert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit(in *certmanager.IssuanceRateLimit, out *IssuanceRateLimit, s conversion.Scope) error {
+	out.MaxRequests = in.MaxRequests
+	out.Period = (*v1.Duration)(unsafe.Pointer(in.Period))
+	return nil
+}
+
+// Convert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit is an autogenerated conversion function.
+func Convert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit(in *certmanager.IssuanceRateLimit, out *IssuanceRateLimit, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit(in, out, s)
+}
+
+func autoConvert_v1beta1_Issuer_To_certmanager_Issuer(in *Issuer, out *certmanager.Issuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_Issuer_To_certmanager_Issuer is an autogenerated conversion function.
+func Convert_v1beta1_Issuer_To_certmanager_Issuer(in *Issuer, out *certmanager.Issuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_Issuer_To_certmanager_Issuer(in, out, s)
+}
+
+func autoConvert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *Issuer, s conversion.Scope) error {
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_Issuer_To_v1beta1_Issuer is an autogenerated conversion function.
+func Convert_certmanager_Issuer_To_v1beta1_Issuer(in *certmanager.Issuer, out *Issuer, s conversion.Scope) error {
+	return autoConvert_certmanager_Issuer_To_v1beta1_Issuer(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
+	out.Type = certmanager.IssuerConditionType(in.Type)
+	out.Status = meta.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition is an autogenerated conversion function.
+func Convert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in *IssuerCondition, out *certmanager.IssuerCondition, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerCondition_To_certmanager_IssuerCondition(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmanager.IssuerCondition, out *IssuerCondition, s conversion.Scope) error {
+	out.Type = IssuerConditionType(in.Type)
+	out.Status = metav1.ConditionStatus(in.Status)
+	out.LastTransitionTime = (*v1.Time)(unsafe.Pointer(in.LastTransitionTime))
+	out.Reason = in.Reason
+	out.Message = in.Message
+	out.ObservedGeneration = in.ObservedGeneration
+	return nil
+}
+
+// Convert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition is an autogenerated conversion function.
+func Convert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmanager.IssuerCondition, out *IssuerCondition, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acme.ACMEIssuer)
+		if err := acmev1beta1.Convert_v1beta1_ACMEIssuer_To_acme_ACMEIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ACME = nil
+	}
+	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(certmanager.VaultIssuer)
+		if err := Convert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	out.SelfSigned = (*certmanager.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(certmanager.VenafiIssuer)
+		if err := Convert_v1beta1_VenafiIssuer_To_certmanager_VenafiIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Venafi = nil
+	}
+	if in.AWSPCA != nil {
+		in, out := &in.AWSPCA, &out.AWSPCA
+		*out = new(certmanager.AWSPCAIssuer)
+		if err := Convert_v1beta1_AWSPCAIssuer_To_certmanager_AWSPCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSPCA = nil
+	}
+	if in.GoogleCAS != nil {
+		in, out := &in.GoogleCAS, &out.GoogleCAS
+		*out = new(certmanager.GoogleCASIssuer)
+		if err := Convert_v1beta1_GoogleCASIssuer_To_certmanager_GoogleCASIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCAS = nil
+	}
+	if in.AzureKeyVault != nil {
+		in, out := &in.AzureKeyVault, &out.AzureKeyVault
+		*out = new(certmanager.AzureKeyVaultIssuer)
+		if err := Convert_v1beta1_AzureKeyVaultIssuer_To_certmanager_AzureKeyVaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AzureKeyVault = nil
+	}
+	if in.EJBCA != nil {
+		in, out := &in.EJBCA, &out.EJBCA
+		*out = new(certmanager.EJBCAIssuer)
+		if err := Convert_v1beta1_EJBCAIssuer_To_certmanager_EJBCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EJBCA = nil
+	}
+	if in.StepCA != nil {
+		in, out := &in.StepCA, &out.StepCA
+		*out = new(certmanager.StepCAIssuer)
+		if err := Convert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StepCA = nil
+	}
+	if in.KubernetesCSR != nil {
+		in, out := &in.KubernetesCSR, &out.KubernetesCSR
+		*out = new(certmanager.KubernetesCSRIssuer)
+		if err := Convert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubernetesCSR = nil
+	}
+	if in.SPIRE != nil {
+		in, out := &in.SPIRE, &out.SPIRE
+		*out = new(certmanager.SPIREIssuer)
+		if err := Convert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SPIRE = nil
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(certmanager.PluginIssuer)
+		if err := Convert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugin = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig is an autogenerated conversion function.
+func Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanager.IssuerConfig, out *IssuerConfig, s conversion.Scope) error {
+	if in.ACME != nil {
+		in, out := &in.ACME, &out.ACME
+		*out = new(acmev1beta1.ACMEIssuer)
+		if err := acmev1beta1.Convert_acme_ACMEIssuer_To_v1beta1_ACMEIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ACME = nil
+	}
+	out.CA = (*CAIssuer)(unsafe.Pointer(in.CA))
+	if in.Vault != nil {
+		in, out := &in.Vault, &out.Vault
+		*out = new(VaultIssuer)
+		if err := Convert_certmanager_VaultIssuer_To_v1beta1_VaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Vault = nil
+	}
+	out.SelfSigned = (*SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
+	if in.Venafi != nil {
+		in, out := &in.Venafi, &out.Venafi
+		*out = new(VenafiIssuer)
+		if err := Convert_certmanager_VenafiIssuer_To_v1beta1_VenafiIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Venafi = nil
+	}
+	if in.AWSPCA != nil {
+		in, out := &in.AWSPCA, &out.AWSPCA
+		*out = new(AWSPCAIssuer)
+		if err := Convert_certmanager_AWSPCAIssuer_To_v1beta1_AWSPCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AWSPCA = nil
+	}
+	if in.GoogleCAS != nil {
+		in, out := &in.GoogleCAS, &out.GoogleCAS
+		*out = new(GoogleCASIssuer)
+		if err := Convert_certmanager_GoogleCASIssuer_To_v1beta1_GoogleCASIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.GoogleCAS = nil
+	}
+	if in.AzureKeyVault != nil {
+		in, out := &in.AzureKeyVault, &out.AzureKeyVault
+		*out = new(AzureKeyVaultIssuer)
+		if err := Convert_certmanager_AzureKeyVaultIssuer_To_v1beta1_AzureKeyVaultIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.AzureKeyVault = nil
+	}
+	if in.EJBCA != nil {
+		in, out := &in.EJBCA, &out.EJBCA
+		*out = new(EJBCAIssuer)
+		if err := Convert_certmanager_EJBCAIssuer_To_v1beta1_EJBCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.EJBCA = nil
+	}
+	if in.StepCA != nil {
+		in, out := &in.StepCA, &out.StepCA
+		*out = new(StepCAIssuer)
+		if err := Convert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.StepCA = nil
+	}
+	if in.KubernetesCSR != nil {
+		in, out := &in.KubernetesCSR, &out.KubernetesCSR
+		*out = new(KubernetesCSRIssuer)
+		if err := Convert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.KubernetesCSR = nil
+	}
+	if in.SPIRE != nil {
+		in, out := &in.SPIRE, &out.SPIRE
+		*out = new(SPIREIssuer)
+		if err := Convert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.SPIRE = nil
+	}
+	if in.Plugin != nil {
+		in, out := &in.Plugin, &out.Plugin
+		*out = new(PluginIssuer)
+		if err := Convert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Plugin = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig is an autogenerated conversion function.
+func Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanager.IssuerConfig, out *IssuerConfig, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuerList_To_certmanager_IssuerList(in *IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]certmanager.Issuer, len(*in))
+		for i := range *in {
+			if err := Convert_v1beta1_Issuer_To_certmanager_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_IssuerList_To_certmanager_IssuerList is an autogenerated conversion function.
+func Convert_v1beta1_IssuerList_To_certmanager_IssuerList(in *IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerList_To_certmanager_IssuerList(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.IssuerList, out *IssuerList, s conversion.Scope) error {
+	out.ListMeta = in.ListMeta
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]Issuer, len(*in))
+		for i := range *in {
+			if err := Convert_certmanager_Issuer_To_v1beta1_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
+				return err
+			}
+		}
+	} else {
+		out.Items = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuerList_To_v1beta1_IssuerList is an autogenerated conversion function.
+func Convert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.IssuerList, out *IssuerList, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
+	if err := Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
+		return err
+	}
+	out.IssuanceLatencyBudget = (*certmanager.IssuanceLatencyBudget)(unsafe.Pointer(in.IssuanceLatencyBudget))
+	out.CredentialsNamespace = in.CredentialsNamespace
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(certmanager.IssuanceRateLimit)
+		if err := Convert_v1beta1_IssuanceRateLimit_To_certmanager_IssuanceRateLimit(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RateLimit = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec is an autogenerated conversion function.
+func Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.IssuerSpec, out *IssuerSpec, s conversion.Scope) error {
+	if err := Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
+		return err
+	}
+	out.IssuanceLatencyBudget = (*IssuanceLatencyBudget)(unsafe.Pointer(in.IssuanceLatencyBudget))
+	out.CredentialsNamespace = in.CredentialsNamespace
+	if in.RateLimit != nil {
+		in, out := &in.RateLimit, &out.RateLimit
+		*out = new(IssuanceRateLimit)
+		if err := Convert_certmanager_IssuanceRateLimit_To_v1beta1_IssuanceRateLimit(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.RateLimit = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec is an autogenerated conversion function.
+func Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.IssuerSpec, out *IssuerSpec, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in, out, s)
+}
+
+func autoConvert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in *IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]certmanager.IssuerCondition)(unsafe.Pointer(&in.Conditions))
+	out.ACME = (*acme.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+	return nil
+}
+
+// Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus is an autogenerated conversion function.
+func Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in *IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
+	return autoConvert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in, out, s)
+}
+
+func autoConvert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in *certmanager.IssuerStatus, out *IssuerStatus, s conversion.Scope) error {
+	out.Conditions = *(*[]IssuerCondition)(unsafe.Pointer(&in.Conditions))
+	out.ACME = (*acmev1beta1.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+	return nil
+}
+
+// Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus is an autogenerated conversion function.
+func Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in *certmanager.IssuerStatus, out *IssuerStatus, s conversion.Scope) error {
+	return autoConvert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in, out, s)
+}
+
+func autoConvert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore is an autogenerated conversion function.
+func Convert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
+	return autoConvert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in, out, s)
+}
+
+func autoConvert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSKeystore, out *JKSKeystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore is an autogenerated conversion function.
+func Convert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSKeystore, out *JKSKeystore, s conversion.Scope) error {
+	return autoConvert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in, out, s)
+}
+
+func autoConvert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in *KubernetesCSRIssuer, out *certmanager.KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_KubernetesCSRIssuer_To_certmanager_KubernetesCSRIssuer(in, out, s)
+}
+
+func autoConvert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *KubernetesCSRIssuer, s conversion.Scope) error {
+	out.SignerName = in.SignerName
+	return nil
+}
+
+// Convert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer is an autogenerated conversion function.
+func Convert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer(in *certmanager.KubernetesCSRIssuer, out *KubernetesCSRIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_KubernetesCSRIssuer_To_v1beta1_KubernetesCSRIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(in *NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
+	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.URIDomains = *(*[]string)(unsafe.Pointer(&in.URIDomains))
+	return nil
+}
+
+// Convert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem is an autogenerated conversion function.
+func Convert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(in *NameConstraintItem, out *certmanager.NameConstraintItem, s conversion.Scope) error {
+	return autoConvert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(in, out, s)
+}
+
+func autoConvert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(in *certmanager.NameConstraintItem, out *NameConstraintItem, s conversion.Scope) error {
+	out.DNSDomains = *(*[]string)(unsafe.Pointer(&in.DNSDomains))
+	out.IPRanges = *(*[]string)(unsafe.Pointer(&in.IPRanges))
+	out.EmailAddresses = *(*[]string)(unsafe.Pointer(&in.EmailAddresses))
+	out.URIDomains = *(*[]string)(unsafe.Pointer(&in.URIDomains))
+	return nil
+}
+
+// Convert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem is an autogenerated conversion function.
+func Convert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(in *certmanager.NameConstraintItem, out *NameConstraintItem, s conversion.Scope) error {
+	return autoConvert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(in, out, s)
+}
+
+func autoConvert_v1beta1_NameConstraints_To_certmanager_NameConstraints(in *NameConstraints, out *certmanager.NameConstraints, s conversion.Scope) error {
+	out.Critical = in.Critical
+	if in.Permitted != nil {
+		in, out := &in.Permitted, &out.Permitted
+		*out = new(certmanager.NameConstraintItem)
+		if err := Convert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permitted = nil
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = new(certmanager.NameConstraintItem)
+		if err := Convert_v1beta1_NameConstraintItem_To_certmanager_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Excluded = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_NameConstraints_To_certmanager_NameConstraints is an autogenerated conversion function.
+func Convert_v1beta1_NameConstraints_To_certmanager_NameConstraints(in *NameConstraints, out *certmanager.NameConstraints, s conversion.Scope) error {
+	return autoConvert_v1beta1_NameConstraints_To_certmanager_NameConstraints(in, out, s)
+}
+
+func autoConvert_certmanager_NameConstraints_To_v1beta1_NameConstraints(in *certmanager.NameConstraints, out *NameConstraints, s conversion.Scope) error {
+	out.Critical = in.Critical
+	if in.Permitted != nil {
+		in, out := &in.Permitted, &out.Permitted
+		*out = new(NameConstraintItem)
+		if err := Convert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Permitted = nil
+	}
+	if in.Excluded != nil {
+		in, out := &in.Excluded, &out.Excluded
+		*out = new(NameConstraintItem)
+		if err := Convert_certmanager_NameConstraintItem_To_v1beta1_NameConstraintItem(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.Excluded = nil
+	}
+	return nil
+}
+
+// Convert_certmanager_NameConstraints_To_v1beta1_NameConstraints is an autogenerated conversion function.
+func Convert_certmanager_NameConstraints_To_v1beta1_NameConstraints(in *certmanager.NameConstraints, out *NameConstraints, s conversion.Scope) error {
+	return autoConvert_certmanager_NameConstraints_To_v1beta1_NameConstraints(in, out, s)
+}
+
+func autoConvert_v1beta1_OtherName_To_certmanager_OtherName(in *OtherName, out *certmanager.OtherName, s conversion.Scope) error {
+	out.OID = in.OID
+	out.UTF8Value = in.UTF8Value
+	return nil
+}
+
+// Convert_v1beta1_OtherName_To_certmanager_OtherName is an autogenerated conversion function.
+func Convert_v1beta1_OtherName_To_certmanager_OtherName(in *OtherName, out *certmanager.OtherName, s conversion.Scope) error {
+	return autoConvert_v1beta1_OtherName_To_certmanager_OtherName(in, out, s)
+}
+
+func autoConvert_certmanager_OtherName_To_v1beta1_OtherName(in *certmanager.OtherName, out *OtherName, s conversion.Scope) error {
+	out.OID = in.OID
+	out.UTF8Value = in.UTF8Value
+	return nil
+}
+
+// Convert_certmanager_OtherName_To_v1beta1_OtherName is an autogenerated conversion function.
+func Convert_certmanager_OtherName_To_v1beta1_OtherName(in *certmanager.OtherName, out *OtherName, s conversion.Scope) error {
+	return autoConvert_certmanager_OtherName_To_v1beta1_OtherName(in, out, s)
+}
+
+func autoConvert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore is an autogenerated conversion function.
+func Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
+	return autoConvert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in, out, s)
+}
+
+func autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *PKCS12Keystore, s conversion.Scope) error {
+	out.Create = in.Create
+	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore is an autogenerated conversion function.
+func Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *PKCS12Keystore, s conversion.Scope) error {
+	return autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in, out, s)
+}
+
+func autoConvert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer(in *PluginIssuer, out *certmanager.PluginIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Name = in.Name
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(meta.LocalObjectReference)
+		if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertSecretRef = nil
+	}
+	out.Insecure = in.Insecure
 	return nil
 }
 
-// Convert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition is an autogenerated conversion function.
-func Convert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in *certmanager.IssuerCondition, out *IssuerCondition, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerCondition_To_v1beta1_IssuerCondition(in, out, s)
+// Convert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer is an autogenerated conversion function.
+func Convert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer(in *PluginIssuer, out *certmanager.PluginIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_PluginIssuer_To_certmanager_PluginIssuer(in, out, s)
 }
 
-func autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acme.ACMEIssuer)
-		if err := acmev1beta1.Convert_v1beta1_ACMEIssuer_To_acme_ACMEIssuer(*in, *out, s); err != nil {
+func autoConvert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer(in *certmanager.PluginIssuer, out *PluginIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.Name = in.Name
+	out.Config = *(*map[string]string)(unsafe.Pointer(&in.Config))
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if in.ClientCertSecretRef != nil {
+		in, out := &in.ClientCertSecretRef, &out.ClientCertSecretRef
+		*out = new(metav1.LocalObjectReference)
+		if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.ACME = nil
+		out.ClientCertSecretRef = nil
 	}
-	out.CA = (*certmanager.CAIssuer)(unsafe.Pointer(in.CA))
-	if in.Vault != nil {
-		in, out := &in.Vault, &out.Vault
-		*out = new(certmanager.VaultIssuer)
-		if err := Convert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(*in, *out, s); err != nil {
+	out.Insecure = in.Insecure
+	return nil
+}
+
+// Convert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer is an autogenerated conversion function.
+func Convert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer(in *certmanager.PluginIssuer, out *PluginIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_PluginIssuer_To_v1beta1_PluginIssuer(in, out, s)
+}
+
+func autoConvert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in *PrivateKeyBackend, out *certmanager.PrivateKeyBackend, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(certmanager.AWSKMSPrivateKeyBackend)
+		if err := Convert_v1beta1_AWSKMSPrivateKeyBackend_To_certmanager_AWSKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Vault = nil
+		out.AWSKMS = nil
 	}
-	out.SelfSigned = (*certmanager.SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
-	if in.Venafi != nil {
-		in, out := &in.Venafi, &out.Venafi
-		*out = new(certmanager.VenafiIssuer)
-		if err := Convert_v1beta1_VenafiIssuer_To_certmanager_VenafiIssuer(*in, *out, s); err != nil {
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(certmanager.GCPKMSPrivateKeyBackend)
+		if err := Convert_v1beta1_GCPKMSPrivateKeyBackend_To_certmanager_GCPKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Venafi = nil
+		out.GCPKMS = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig is an autogenerated conversion function.
-func Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in *IssuerConfig, out *certmanager.IssuerConfig, s conversion.Scope) error {
-	return autoConvert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(in, out, s)
+// Convert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend is an autogenerated conversion function.
+func Convert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in *PrivateKeyBackend, out *certmanager.PrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_v1beta1_PrivateKeyBackend_To_certmanager_PrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanager.IssuerConfig, out *IssuerConfig, s conversion.Scope) error {
-	if in.ACME != nil {
-		in, out := &in.ACME, &out.ACME
-		*out = new(acmev1beta1.ACMEIssuer)
-		if err := acmev1beta1.Convert_acme_ACMEIssuer_To_v1beta1_ACMEIssuer(*in, *out, s); err != nil {
-			return err
-		}
-	} else {
-		out.ACME = nil
-	}
-	out.CA = (*CAIssuer)(unsafe.Pointer(in.CA))
-	if in.Vault != nil {
-		in, out := &in.Vault, &out.Vault
-		*out = new(VaultIssuer)
-		if err := Convert_certmanager_VaultIssuer_To_v1beta1_VaultIssuer(*in, *out, s); err != nil {
+func autoConvert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend(in *certmanager.PrivateKeyBackend, out *PrivateKeyBackend, s conversion.Scope) error {
+	if in.AWSKMS != nil {
+		in, out := &in.AWSKMS, &out.AWSKMS
+		*out = new(AWSKMSPrivateKeyBackend)
+		if err := Convert_certmanager_AWSKMSPrivateKeyBackend_To_v1beta1_AWSKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Vault = nil
+		out.AWSKMS = nil
 	}
-	out.SelfSigned = (*SelfSignedIssuer)(unsafe.Pointer(in.SelfSigned))
-	if in.Venafi != nil {
-		in, out := &in.Venafi, &out.Venafi
-		*out = new(VenafiIssuer)
-		if err := Convert_certmanager_VenafiIssuer_To_v1beta1_VenafiIssuer(*in, *out, s); err != nil {
+	if in.GCPKMS != nil {
+		in, out := &in.GCPKMS, &out.GCPKMS
+		*out = new(GCPKMSPrivateKeyBackend)
+		if err := Convert_certmanager_GCPKMSPrivateKeyBackend_To_v1beta1_GCPKMSPrivateKeyBackend(*in, *out, s); err != nil {
 			return err
 		}
 	} else {
-		out.Venafi = nil
+		out.GCPKMS = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig is an autogenerated conversion function.
-func Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in *certmanager.IssuerConfig, out *IssuerConfig, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(in, out, s)
+// Convert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend is an autogenerated conversion function.
+func Convert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend(in *certmanager.PrivateKeyBackend, out *PrivateKeyBackend, s conversion.Scope) error {
+	return autoConvert_certmanager_PrivateKeyBackend_To_v1beta1_PrivateKeyBackend(in, out, s)
 }
 
-func autoConvert_v1beta1_IssuerList_To_certmanager_IssuerList(in *IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]certmanager.Issuer, len(*in))
-		for i := range *in {
-			if err := Convert_v1beta1_Issuer_To_certmanager_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+func autoConvert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer(in *SPIREIssuer, out *certmanager.SPIREIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TrustDomain = in.TrustDomain
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerSPIFFEID = in.ServerSPIFFEID
+	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.ClientCertSecretRef, &out.ClientCertSecretRef, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_v1beta1_IssuerList_To_certmanager_IssuerList is an autogenerated conversion function.
-func Convert_v1beta1_IssuerList_To_certmanager_IssuerList(in *IssuerList, out *certmanager.IssuerList, s conversion.Scope) error {
-	return autoConvert_v1beta1_IssuerList_To_certmanager_IssuerList(in, out, s)
+// Convert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer is an autogenerated conversion function.
+func Convert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer(in *SPIREIssuer, out *certmanager.SPIREIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_SPIREIssuer_To_certmanager_SPIREIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.IssuerList, out *IssuerList, s conversion.Scope) error {
-	out.ListMeta = in.ListMeta
-	if in.Items != nil {
-		in, out := &in.Items, &out.Items
-		*out = make([]Issuer, len(*in))
-		for i := range *in {
-			if err := Convert_certmanager_Issuer_To_v1beta1_Issuer(&(*in)[i], &(*out)[i], s); err != nil {
-				return err
-			}
-		}
-	} else {
-		out.Items = nil
+func autoConvert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer(in *certmanager.SPIREIssuer, out *SPIREIssuer, s conversion.Scope) error {
+	out.Address = in.Address
+	out.TrustDomain = in.TrustDomain
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.ServerSPIFFEID = in.ServerSPIFFEID
+	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.ClientCertSecretRef, &out.ClientCertSecretRef, s); err != nil {
+		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_IssuerList_To_v1beta1_IssuerList is an autogenerated conversion function.
-func Convert_certmanager_IssuerList_To_v1beta1_IssuerList(in *certmanager.IssuerList, out *IssuerList, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerList_To_v1beta1_IssuerList(in, out, s)
+// Convert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer is an autogenerated conversion function.
+func Convert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer(in *certmanager.SPIREIssuer, out *SPIREIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SPIREIssuer_To_v1beta1_SPIREIssuer(in, out, s)
 }
 
-func autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
-	if err := Convert_v1beta1_IssuerConfig_To_certmanager_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
-		return err
-	}
+func autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
 }
 
-// Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec is an autogenerated conversion function.
-func Convert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in *IssuerSpec, out *certmanager.IssuerSpec, s conversion.Scope) error {
-	return autoConvert_v1beta1_IssuerSpec_To_certmanager_IssuerSpec(in, out, s)
+// Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer is an autogenerated conversion function.
+func Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.IssuerSpec, out *IssuerSpec, s conversion.Scope) error {
-	if err := Convert_certmanager_IssuerConfig_To_v1beta1_IssuerConfig(&in.IssuerConfig, &out.IssuerConfig, s); err != nil {
-		return err
-	}
+func autoConvert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *SelfSignedIssuer, s conversion.Scope) error {
+	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
 	return nil
 }
 
-// Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec is an autogenerated conversion function.
-func Convert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in *certmanager.IssuerSpec, out *IssuerSpec, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerSpec_To_v1beta1_IssuerSpec(in, out, s)
+// Convert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer is an autogenerated conversion function.
+func Convert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *SelfSignedIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in, out, s)
 }
 
-func autoConvert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in *IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]certmanager.IssuerCondition)(unsafe.Pointer(&in.Conditions))
-	out.ACME = (*acme.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+func autoConvert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer(in *StepCAIssuer, out *certmanager.StepCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner(&in.Provisioner, &out.Provisioner, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus is an autogenerated conversion function.
-func Convert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in *IssuerStatus, out *certmanager.IssuerStatus, s conversion.Scope) error {
-	return autoConvert_v1beta1_IssuerStatus_To_certmanager_IssuerStatus(in, out, s)
+// Convert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer is an autogenerated conversion function.
+func Convert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer(in *StepCAIssuer, out *certmanager.StepCAIssuer, s conversion.Scope) error {
+	return autoConvert_v1beta1_StepCAIssuer_To_certmanager_StepCAIssuer(in, out, s)
 }
 
-func autoConvert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in *certmanager.IssuerStatus, out *IssuerStatus, s conversion.Scope) error {
-	out.Conditions = *(*[]IssuerCondition)(unsafe.Pointer(&in.Conditions))
-	out.ACME = (*acmev1beta1.ACMEIssuerStatus)(unsafe.Pointer(in.ACME))
+func autoConvert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer(in *certmanager.StepCAIssuer, out *StepCAIssuer, s conversion.Scope) error {
+	out.URL = in.URL
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner(&in.Provisioner, &out.Provisioner, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus is an autogenerated conversion function.
-func Convert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in *certmanager.IssuerStatus, out *IssuerStatus, s conversion.Scope) error {
-	return autoConvert_certmanager_IssuerStatus_To_v1beta1_IssuerStatus(in, out, s)
+// Convert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer is an autogenerated conversion function.
+func Convert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer(in *certmanager.StepCAIssuer, out *StepCAIssuer, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAIssuer_To_v1beta1_StepCAIssuer(in, out, s)
 }
 
-func autoConvert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
-	out.Create = in.Create
+func autoConvert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in *StepCAJWKProvisioner, out *certmanager.StepCAJWKProvisioner, s conversion.Scope) error {
+	out.KeyID = in.KeyID
 	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore is an autogenerated conversion function.
-func Convert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in *JKSKeystore, out *certmanager.JKSKeystore, s conversion.Scope) error {
-	return autoConvert_v1beta1_JKSKeystore_To_certmanager_JKSKeystore(in, out, s)
+// Convert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner is an autogenerated conversion function.
+func Convert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in *StepCAJWKProvisioner, out *certmanager.StepCAJWKProvisioner, s conversion.Scope) error {
+	return autoConvert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSKeystore, out *JKSKeystore, s conversion.Scope) error {
-	out.Create = in.Create
+func autoConvert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner(in *certmanager.StepCAJWKProvisioner, out *StepCAJWKProvisioner, s conversion.Scope) error {
+	out.KeyID = in.KeyID
 	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
 		return err
 	}
 	return nil
 }
 
-// Convert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore is an autogenerated conversion function.
-func Convert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in *certmanager.JKSKeystore, out *JKSKeystore, s conversion.Scope) error {
-	return autoConvert_certmanager_JKSKeystore_To_v1beta1_JKSKeystore(in, out, s)
+// Convert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner(in *certmanager.StepCAJWKProvisioner, out *StepCAJWKProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner(in, out, s)
 }
 
-func autoConvert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
-	out.Create = in.Create
-	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
-		return err
+func autoConvert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in *StepCAProvisioner, out *certmanager.StepCAProvisioner, s conversion.Scope) error {
+	out.Name = in.Name
+	if in.JWK != nil {
+		in, out := &in.JWK, &out.JWK
+		*out = new(certmanager.StepCAJWKProvisioner)
+		if err := Convert_v1beta1_StepCAJWKProvisioner_To_certmanager_StepCAJWKProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWK = nil
+	}
+	if in.X5C != nil {
+		in, out := &in.X5C, &out.X5C
+		*out = new(certmanager.StepCAX5CProvisioner)
+		if err := Convert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.X5C = nil
 	}
 	return nil
 }
 
-// Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore is an autogenerated conversion function.
-func Convert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in *PKCS12Keystore, out *certmanager.PKCS12Keystore, s conversion.Scope) error {
-	return autoConvert_v1beta1_PKCS12Keystore_To_certmanager_PKCS12Keystore(in, out, s)
+// Convert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner is an autogenerated conversion function.
+func Convert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in *StepCAProvisioner, out *certmanager.StepCAProvisioner, s conversion.Scope) error {
+	return autoConvert_v1beta1_StepCAProvisioner_To_certmanager_StepCAProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *PKCS12Keystore, s conversion.Scope) error {
-	out.Create = in.Create
-	if err := apismetav1.Convert_meta_SecretKeySelector_To_v1_SecretKeySelector(&in.PasswordSecretRef, &out.PasswordSecretRef, s); err != nil {
-		return err
+func autoConvert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner(in *certmanager.StepCAProvisioner, out *StepCAProvisioner, s conversion.Scope) error {
+	out.Name = in.Name
+	if in.JWK != nil {
+		in, out := &in.JWK, &out.JWK
+		*out = new(StepCAJWKProvisioner)
+		if err := Convert_certmanager_StepCAJWKProvisioner_To_v1beta1_StepCAJWKProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.JWK = nil
+	}
+	if in.X5C != nil {
+		in, out := &in.X5C, &out.X5C
+		*out = new(StepCAX5CProvisioner)
+		if err := Convert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.X5C = nil
 	}
 	return nil
 }
 
-// Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore is an autogenerated conversion function.
-func Convert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in *certmanager.PKCS12Keystore, out *PKCS12Keystore, s conversion.Scope) error {
-	return autoConvert_certmanager_PKCS12Keystore_To_v1beta1_PKCS12Keystore(in, out, s)
+// Convert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner(in *certmanager.StepCAProvisioner, out *StepCAProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAProvisioner_To_v1beta1_StepCAProvisioner(in, out, s)
 }
 
-func autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+func autoConvert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in *StepCAX5CProvisioner, out *certmanager.StepCAX5CProvisioner, s conversion.Scope) error {
+	if err := apismetav1.Convert_v1_LocalObjectReference_To_meta_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer is an autogenerated conversion function.
-func Convert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in *SelfSignedIssuer, out *certmanager.SelfSignedIssuer, s conversion.Scope) error {
-	return autoConvert_v1beta1_SelfSignedIssuer_To_certmanager_SelfSignedIssuer(in, out, s)
+// Convert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner is an autogenerated conversion function.
+func Convert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in *StepCAX5CProvisioner, out *certmanager.StepCAX5CProvisioner, s conversion.Scope) error {
+	return autoConvert_v1beta1_StepCAX5CProvisioner_To_certmanager_StepCAX5CProvisioner(in, out, s)
 }
 
-func autoConvert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *SelfSignedIssuer, s conversion.Scope) error {
-	out.CRLDistributionPoints = *(*[]string)(unsafe.Pointer(&in.CRLDistributionPoints))
+func autoConvert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner(in *certmanager.StepCAX5CProvisioner, out *StepCAX5CProvisioner, s conversion.Scope) error {
+	if err := apismetav1.Convert_meta_LocalObjectReference_To_v1_LocalObjectReference(&in.SecretRef, &out.SecretRef, s); err != nil {
+		return err
+	}
 	return nil
 }
 
-// Convert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer is an autogenerated conversion function.
-func Convert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in *certmanager.SelfSignedIssuer, out *SelfSignedIssuer, s conversion.Scope) error {
-	return autoConvert_certmanager_SelfSignedIssuer_To_v1beta1_SelfSignedIssuer(in, out, s)
+// Convert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner is an autogenerated conversion function.
+func Convert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner(in *certmanager.StepCAX5CProvisioner, out *StepCAX5CProvisioner, s conversion.Scope) error {
+	return autoConvert_certmanager_StepCAX5CProvisioner_To_v1beta1_StepCAX5CProvisioner(in, out, s)
 }
 
 func autoConvert_v1beta1_VaultAppRole_To_certmanager_VaultAppRole(in *VaultAppRole, out *certmanager.VaultAppRole, s conversion.Scope) error {
@@ -1341,6 +3269,15 @@ func autoConvert_v1beta1_VaultAuth_To_certmanager_VaultAuth(in *VaultAuth, out *
 	} else {
 		out.Kubernetes = nil
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(certmanager.VaultClientCertificateAuth)
+		if err := Convert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificate = nil
+	}
 	return nil
 }
 
@@ -1377,6 +3314,15 @@ func autoConvert_certmanager_VaultAuth_To_v1beta1_VaultAuth(in *certmanager.Vaul
 	} else {
 		out.Kubernetes = nil
 	}
+	if in.ClientCertificate != nil {
+		in, out := &in.ClientCertificate, &out.ClientCertificate
+		*out = new(VaultClientCertificateAuth)
+		if err := Convert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth(*in, *out, s); err != nil {
+			return err
+		}
+	} else {
+		out.ClientCertificate = nil
+	}
 	return nil
 }
 
@@ -1385,6 +3331,30 @@ func Convert_certmanager_VaultAuth_To_v1beta1_VaultAuth(in *certmanager.VaultAut
 	return autoConvert_certmanager_VaultAuth_To_v1beta1_VaultAuth(in, out, s)
 }
 
+func autoConvert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in *VaultClientCertificateAuth, out *certmanager.VaultClientCertificateAuth, s conversion.Scope) error {
+	out.Path = in.Path
+	out.SecretName = in.SecretName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth is an autogenerated conversion function.
+func Convert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in *VaultClientCertificateAuth, out *certmanager.VaultClientCertificateAuth, s conversion.Scope) error {
+	return autoConvert_v1beta1_VaultClientCertificateAuth_To_certmanager_VaultClientCertificateAuth(in, out, s)
+}
+
+func autoConvert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth(in *certmanager.VaultClientCertificateAuth, out *VaultClientCertificateAuth, s conversion.Scope) error {
+	out.Path = in.Path
+	out.SecretName = in.SecretName
+	out.Name = in.Name
+	return nil
+}
+
+// Convert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth is an autogenerated conversion function.
+func Convert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth(in *certmanager.VaultClientCertificateAuth, out *VaultClientCertificateAuth, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultClientCertificateAuth_To_v1beta1_VaultClientCertificateAuth(in, out, s)
+}
+
 func autoConvert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(in *VaultIssuer, out *certmanager.VaultIssuer, s conversion.Scope) error {
 	if err := Convert_v1beta1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
 		return err
@@ -1393,6 +3363,7 @@ func autoConvert_v1beta1_VaultIssuer_To_certmanager_VaultIssuer(in *VaultIssuer,
 	out.Path = in.Path
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.SignVerbatim = in.SignVerbatim
 	return nil
 }
 
@@ -1409,6 +3380,7 @@ func autoConvert_certmanager_VaultIssuer_To_v1beta1_VaultIssuer(in *certmanager.
 	out.Path = in.Path
 	out.Namespace = in.Namespace
 	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	out.SignVerbatim = in.SignVerbatim
 	return nil
 }
 
@@ -1445,6 +3417,74 @@ func Convert_certmanager_VaultKubernetesAuth_To_v1beta1_VaultKubernetesAuth(in *
 	return autoConvert_certmanager_VaultKubernetesAuth_To_v1beta1_VaultKubernetesAuth(in, out, s)
 }
 
+func autoConvert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore(in *VaultSecretStore, out *certmanager.VaultSecretStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.Path = in.Path
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1beta1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore is an autogenerated conversion function.
+func Convert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore(in *VaultSecretStore, out *certmanager.VaultSecretStore, s conversion.Scope) error {
+	return autoConvert_v1beta1_VaultSecretStore_To_certmanager_VaultSecretStore(in, out, s)
+}
+
+func autoConvert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore(in *certmanager.VaultSecretStore, out *VaultSecretStore, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.Path = in.Path
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_VaultAuth_To_v1beta1_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore is an autogenerated conversion function.
+func Convert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore(in *certmanager.VaultSecretStore, out *VaultSecretStore, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultSecretStore_To_v1beta1_VaultSecretStore(in, out, s)
+}
+
+func autoConvert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in *VaultTransitKeyEncryption, out *certmanager.VaultTransitKeyEncryption, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.KeyName = in.KeyName
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_v1beta1_VaultAuth_To_certmanager_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption is an autogenerated conversion function.
+func Convert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in *VaultTransitKeyEncryption, out *certmanager.VaultTransitKeyEncryption, s conversion.Scope) error {
+	return autoConvert_v1beta1_VaultTransitKeyEncryption_To_certmanager_VaultTransitKeyEncryption(in, out, s)
+}
+
+func autoConvert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption(in *certmanager.VaultTransitKeyEncryption, out *VaultTransitKeyEncryption, s conversion.Scope) error {
+	out.Server = in.Server
+	out.Mount = in.Mount
+	out.KeyName = in.KeyName
+	out.Namespace = in.Namespace
+	out.CABundle = *(*[]byte)(unsafe.Pointer(&in.CABundle))
+	if err := Convert_certmanager_VaultAuth_To_v1beta1_VaultAuth(&in.Auth, &out.Auth, s); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Convert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption is an autogenerated conversion function.
+func Convert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption(in *certmanager.VaultTransitKeyEncryption, out *VaultTransitKeyEncryption, s conversion.Scope) error {
+	return autoConvert_certmanager_VaultTransitKeyEncryption_To_v1beta1_VaultTransitKeyEncryption(in, out, s)
+}
+
 func autoConvert_v1beta1_VenafiCloud_To_certmanager_VenafiCloud(in *VenafiCloud, out *certmanager.VenafiCloud, s conversion.Scope) error {
 	out.URL = in.URL
 	if err := apismetav1.Convert_v1_SecretKeySelector_To_meta_SecretKeySelector(&in.APITokenSecretRef, &out.APITokenSecretRef, s); err != nil {
@@ -0,0 +1,129 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/internal/trustbundle"
+)
+
+// Validation functions for cert-manager Bundle types.
+
+func ValidateBundle(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	bundle := obj.(*cmapi.Bundle)
+	return ValidateBundleSpec(&bundle.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateBundle(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	bundle := obj.(*cmapi.Bundle)
+	return ValidateBundleSpec(&bundle.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateBundleSpec(spec *cmapi.BundleSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.Sources) == 0 {
+		el = append(el, field.Required(fldPath.Child("sources"), "at least one source must be set"))
+	}
+	for i, source := range spec.Sources {
+		el = append(el, validateBundleSource(&source, fldPath.Child("sources").Index(i))...)
+	}
+
+	targetPath := fldPath.Child("target")
+	el = append(el, validateBundleKey(spec.Target.ConfigMap.Key, targetPath.Child("configMap", "key"))...)
+	if spec.Target.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.Target.NamespaceSelector, targetPath.Child("namespaceSelector"))...)
+	}
+
+	return el
+}
+
+func validateBundleSource(source *cmapi.BundleSource, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	numSources := 0
+	if source.ConfigMap != nil {
+		numSources++
+		el = append(el, validateBundleSourceKeySelector(source.ConfigMap, fldPath.Child("configMap"))...)
+	}
+	if source.Secret != nil {
+		numSources++
+		el = append(el, validateBundleSourceKeySelector(source.Secret, fldPath.Child("secret"))...)
+	}
+	if source.Issuer != nil {
+		numSources++
+		el = append(el, validateBundleSourceIssuerRef(source.Issuer, fldPath.Child("issuer"))...)
+	}
+	if source.InLine != nil {
+		numSources++
+		if _, err := trustbundle.DecodeCertificates([]byte(*source.InLine)); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("inLine"), "", err.Error()))
+		}
+	}
+
+	if numSources != 1 {
+		el = append(el, field.Invalid(fldPath, "", "exactly one of configMap, secret, issuer or inLine must be set"))
+	}
+
+	return el
+}
+
+func validateBundleSourceKeySelector(selector *cmapi.BundleSourceKeySelector, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(selector.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("name"), "must be set"))
+	}
+	el = append(el, validateBundleKey(selector.Key, fldPath.Child("key"))...)
+	return el
+}
+
+func validateBundleSourceIssuerRef(ref *cmapi.BundleSourceIssuerRef, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(ref.Name) == 0 {
+		el = append(el, field.Required(fldPath.Child("name"), "must be set"))
+	}
+	switch ref.Kind {
+	case cmapi.IssuerKind:
+		if len(ref.Namespace) == 0 {
+			el = append(el, field.Required(fldPath.Child("namespace"), "must be set for Issuers"))
+		}
+	case "", cmapi.ClusterIssuerKind:
+		if len(ref.Namespace) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("namespace"), "must not be set for ClusterIssuers"))
+		}
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("kind"), ref.Kind, []string{cmapi.IssuerKind, cmapi.ClusterIssuerKind}))
+	}
+	return el
+}
+
+func validateBundleKey(key string, fldPath *field.Path) field.ErrorList {
+	if len(key) == 0 {
+		return field.ErrorList{field.Required(fldPath, "must be set")}
+	}
+	el := field.ErrorList{}
+	for _, msg := range validation.IsConfigMapKey(key) {
+		el = append(el, field.Invalid(fldPath, key, msg))
+	}
+	return el
+}
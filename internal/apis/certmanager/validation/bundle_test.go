@@ -0,0 +1,161 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func mustGenerateCACertificatePEM(t *testing.T) string {
+	key, err := utilpki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certPEM, _, err := utilpki.SignCertificate(template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return string(certPEM)
+}
+
+func TestValidateBundle(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	sourcesPath := fldPath.Child("sources")
+	caPEM := mustGenerateCACertificatePEM(t)
+	invalidPEM := "not a certificate"
+	target := cmapi.BundleTarget{ConfigMap: cmapi.BundleTargetConfigMap{Key: "ca.crt"}}
+
+	scenarios := map[string]struct {
+		spec      cmapi.BundleSpec
+		expectedE field.ErrorList
+	}{
+		"valid bundle": {
+			spec: cmapi.BundleSpec{
+				Sources: []cmapi.BundleSource{
+					{ConfigMap: &cmapi.BundleSourceKeySelector{Name: "cas", Key: "ca.crt"}},
+					{Secret: &cmapi.BundleSourceKeySelector{Name: "ca", Key: "tls.crt"}},
+					{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer"}},
+					{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer", Kind: "Issuer", Namespace: "team-a"}},
+					{InLine: &caPEM},
+				},
+				Target: cmapi.BundleTarget{
+					ConfigMap: cmapi.BundleTargetConfigMap{Key: "ca.crt"},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchLabels: map[string]string{"trust": "enabled"},
+					},
+				},
+			},
+		},
+		"at least one source and the target key must be set": {
+			spec: cmapi.BundleSpec{},
+			expectedE: field.ErrorList{
+				field.Required(sourcesPath, "at least one source must be set"),
+				field.Required(fldPath.Child("target", "configMap", "key"), "must be set"),
+			},
+		},
+		"exactly one field of a source must be set": {
+			spec: cmapi.BundleSpec{
+				Sources: []cmapi.BundleSource{
+					{},
+					{
+						ConfigMap: &cmapi.BundleSourceKeySelector{Name: "cas", Key: "ca.crt"},
+						InLine:    &caPEM,
+					},
+				},
+				Target: target,
+			},
+			expectedE: field.ErrorList{
+				field.Invalid(sourcesPath.Index(0), "", "exactly one of configMap, secret, issuer or inLine must be set"),
+				field.Invalid(sourcesPath.Index(1), "", "exactly one of configMap, secret, issuer or inLine must be set"),
+			},
+		},
+		"sources must be valid": {
+			spec: cmapi.BundleSpec{
+				Sources: []cmapi.BundleSource{
+					{ConfigMap: &cmapi.BundleSourceKeySelector{Key: "ca.crt"}},
+					{Secret: &cmapi.BundleSourceKeySelector{Name: "ca", Key: "invalid/key"}},
+					{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer", Kind: "Issuer"}},
+					{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer", Kind: "ClusterIssuer", Namespace: "team-a"}},
+					{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer", Kind: "ExternalIssuer"}},
+					{InLine: &invalidPEM},
+				},
+				Target: target,
+			},
+			expectedE: field.ErrorList{
+				field.Required(sourcesPath.Index(0).Child("configMap", "name"), "must be set"),
+				field.Invalid(sourcesPath.Index(1).Child("secret", "key"), "invalid/key", "a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+				field.Required(sourcesPath.Index(2).Child("issuer", "namespace"), "must be set for Issuers"),
+				field.Forbidden(sourcesPath.Index(3).Child("issuer", "namespace"), "must not be set for ClusterIssuers"),
+				field.NotSupported(sourcesPath.Index(4).Child("issuer", "kind"), "ExternalIssuer", []string{"Issuer", "ClusterIssuer"}),
+				field.Invalid(sourcesPath.Index(5).Child("inLine"), "", "data which is not PEM encoded was found"),
+			},
+		},
+		"the namespace selector must be valid": {
+			spec: cmapi.BundleSpec{
+				Sources: []cmapi.BundleSource{{InLine: &caPEM}},
+				Target: cmapi.BundleTarget{
+					ConfigMap: cmapi.BundleTargetConfigMap{Key: "ca.crt"},
+					NamespaceSelector: &metav1.LabelSelector{
+						MatchExpressions: []metav1.LabelSelectorRequirement{
+							{Key: "trust", Operator: metav1.LabelSelectorOpIn},
+						},
+					},
+				},
+			},
+			expectedE: field.ErrorList{
+				field.Required(fldPath.Child("target", "namespaceSelector", "matchExpressions").Index(0).Child("values"), "must be specified when `operator` is 'In' or 'NotIn'"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			gotE, gotW := ValidateBundle(nil, &cmapi.Bundle{Spec: s.spec})
+			if len(gotW) != 0 {
+				t.Errorf("Expected no warnings but got %v", gotW)
+			}
+			if len(gotE) != len(s.expectedE) {
+				t.Fatalf("Expected errors %v but got %v", s.expectedE, gotE)
+			}
+			for i, e := range gotE {
+				expectedErr := s.expectedE[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -17,16 +17,23 @@ limitations under the License.
 package validation
 
 import (
+	"crypto/x509"
+	"encoding/asn1"
 	"fmt"
 	"net"
 	"net/mail"
+	"path"
 	"strings"
+	"unicode/utf8"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
@@ -46,7 +53,8 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		el = append(el, field.Required(fldPath.Child("secretName"), "must be specified"))
 	}
 
-	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath)...)
+	el = append(el, validateIssuerRef(crt.IssuerRef, fldPath.Child("issuerRef"))...)
+	el = append(el, validateFallbackIssuerRefs(crt, fldPath)...)
 
 	var commonName = crt.CommonName
 	if crt.LiteralSubject != "" {
@@ -92,8 +100,8 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 
 	}
 
-	if len(commonName) == 0 && len(crt.DNSNames) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 && len(crt.IPAddresses) == 0 {
-		el = append(el, field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, or emailAddresses must be set"))
+	if len(commonName) == 0 && len(crt.DNSNames) == 0 && len(crt.URISANs) == 0 && len(crt.EmailSANs) == 0 && len(crt.IPAddresses) == 0 && len(crt.OtherNames) == 0 {
+		el = append(el, field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, emailAddresses, or otherNames must be set"))
 	}
 
 	// if a common name has been specified, ensure it is no longer than 64 chars
@@ -109,6 +117,17 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		el = append(el, validateEmailAddresses(crt, fldPath)...)
 	}
 
+	if len(crt.OtherNames) > 0 {
+		el = append(el, validateOtherNames(crt, fldPath)...)
+	}
+
+	if len(crt.ExtraExtensions) > 0 {
+		el = append(el, validateExtraExtensions(crt, fldPath)...)
+	}
+
+	el = append(el, validateObjectIdentifiers(crt.CertificatePolicies, fldPath.Child("certificatePolicies"))...)
+	el = append(el, validateObjectIdentifiers(crt.ExtendedKeyUsageOIDs, fldPath.Child("extendedKeyUsageOIDs"))...)
+
 	if crt.PrivateKey != nil {
 		switch crt.PrivateKey.Algorithm {
 		case "", internalcmapi.RSAKeyAlgorithm:
@@ -122,19 +141,38 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		case internalcmapi.Ed25519KeyAlgorithm:
 			break
 		default:
-			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa or ecdsa"))
+			el = append(el, field.Invalid(fldPath.Child("privateKey", "algorithm"), crt.PrivateKey.Algorithm, "must be either empty or one of rsa, ecdsa or ed25519"))
+		}
+		if crt.PrivateKey.KeyRotationInterval != nil {
+			el = append(el, validateKeyRotationInterval(crt.PrivateKey, fldPath.Child("privateKey"))...)
+		}
+		if crt.PrivateKey.Backend != nil {
+			el = append(el, validatePrivateKeyBackend(crt, fldPath)...)
 		}
 	}
 
+	if len(crt.SignatureAlgorithm) > 0 {
+		el = append(el, validateSignatureAlgorithm(crt, fldPath)...)
+	}
+
 	if crt.Duration != nil || crt.RenewBefore != nil {
 		el = append(el, ValidateDuration(crt, fldPath)...)
 	}
+	if crt.RenewBeforePercentage != nil {
+		el = append(el, validateRenewBeforePercentage(crt, fldPath)...)
+	}
+	if crt.RenewalJitter != nil {
+		el = append(el, validateRenewalJitter(crt, fldPath)...)
+	}
 	if len(crt.Usages) > 0 {
 		el = append(el, validateUsages(crt, fldPath)...)
 	}
 	if crt.RevisionHistoryLimit != nil && *crt.RevisionHistoryLimit < 1 {
 		el = append(el, field.Invalid(fldPath.Child("revisionHistoryLimit"), *crt.RevisionHistoryLimit, "must not be less than 1"))
 	}
+	if crt.RevisionHistoryTTL != nil && crt.RevisionHistoryTTL.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("revisionHistoryTTL"), crt.RevisionHistoryTTL.Duration, "must be greater than zero"))
+	}
 
 	if crt.SecretTemplate != nil {
 		if len(crt.SecretTemplate.Labels) > 0 {
@@ -145,8 +183,24 @@ func ValidateCertificateSpec(crt *internalcmapi.CertificateSpec, fldPath *field.
 		}
 	}
 
+	if crt.SecretTargets != nil {
+		el = append(el, validateSecretTargets(crt, fldPath)...)
+	}
+
+	for i := range crt.ExternalSecretStores {
+		el = append(el, validateExternalSecretStore(&crt.ExternalSecretStores[i], fldPath.Child("externalSecretStores").Index(i))...)
+	}
+
 	el = append(el, validateAdditionalOutputFormats(crt, fldPath)...)
 
+	if crt.PrivateKeyEncryption != nil {
+		el = append(el, validatePrivateKeyEncryption(crt, fldPath)...)
+	}
+
+	if crt.MaxPathLen != nil || crt.NameConstraints != nil {
+		el = append(el, validateCAConstraints(crt, fldPath)...)
+	}
+
 	return el
 }
 
@@ -162,10 +216,9 @@ func ValidateUpdateCertificate(a *admissionv1.AdmissionRequest, oldObj, obj runt
 	return allErrs, nil
 }
 
-func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) field.ErrorList {
+func validateIssuerRef(issuerRef cmmeta.ObjectReference, issuerRefPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
-	issuerRefPath := fldPath.Child("issuerRef")
 	if issuerRef.Name == "" {
 		el = append(el, field.Required(issuerRefPath.Child("name"), "must be specified"))
 	}
@@ -180,6 +233,23 @@ func validateIssuerRef(issuerRef cmmeta.ObjectReference, fldPath *field.Path) fi
 	return el
 }
 
+func validateFallbackIssuerRefs(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, ref := range crt.FallbackIssuerRefs {
+		el = append(el, validateIssuerRef(ref, fldPath.Child("fallbackIssuerRefs").Index(i))...)
+	}
+	if crt.FailoverAttempts != nil {
+		if len(crt.FallbackIssuerRefs) == 0 {
+			el = append(el, field.Invalid(fldPath.Child("failoverAttempts"), *crt.FailoverAttempts, "may only be set when fallbackIssuerRefs is set"))
+		} else if *crt.FailoverAttempts < 1 {
+			el = append(el, field.Invalid(fldPath.Child("failoverAttempts"), *crt.FailoverAttempts, "must not be less than 1"))
+		}
+	}
+
+	return el
+}
+
 func validateIPAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	if len(a.IPAddresses) <= 0 {
 		return nil
@@ -212,6 +282,64 @@ func validateEmailAddresses(a *internalcmapi.CertificateSpec, fldPath *field.Pat
 	return el
 }
 
+func validateOtherNames(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	for i, otherName := range a.OtherNames {
+		path := fldPath.Child("otherNames").Index(i)
+		if len(otherName.OID) == 0 {
+			el = append(el, field.Required(path.Child("oid"), "must be specified"))
+		} else if _, err := pki.ParseObjectIdentifier(otherName.OID); err != nil {
+			el = append(el, field.Invalid(path.Child("oid"), otherName.OID, err.Error()))
+		}
+		if len(otherName.UTF8Value) == 0 {
+			el = append(el, field.Required(path.Child("utf8Value"), "must be specified"))
+		} else if !utf8.ValidString(otherName.UTF8Value) {
+			el = append(el, field.Invalid(path.Child("utf8Value"), otherName.UTF8Value, "must be a valid UTF-8 string"))
+		}
+	}
+	return el
+}
+
+func validateObjectIdentifiers(oids []string, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	seen := sets.NewString()
+	for i, oid := range oids {
+		if _, err := pki.ParseObjectIdentifier(oid); err != nil {
+			el = append(el, field.Invalid(fldPath.Index(i), oid, err.Error()))
+		} else if seen.Has(oid) {
+			el = append(el, field.Duplicate(fldPath.Index(i), oid))
+		}
+		seen.Insert(oid)
+	}
+	return el
+}
+
+func validateExtraExtensions(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	oids := sets.NewString()
+	for i, ext := range a.ExtraExtensions {
+		path := fldPath.Child("extraExtensions").Index(i)
+		if len(ext.OID) == 0 {
+			el = append(el, field.Required(path.Child("oid"), "must be specified"))
+		} else if oid, err := pki.ParseObjectIdentifier(ext.OID); err != nil {
+			el = append(el, field.Invalid(path.Child("oid"), ext.OID, err.Error()))
+		} else if pki.IsReservedExtension(oid) {
+			el = append(el, field.Invalid(path.Child("oid"), ext.OID, "extension is set by cert-manager and cannot be requested as an extra extension"))
+		} else if oids.Has(ext.OID) {
+			el = append(el, field.Duplicate(path.Child("oid"), ext.OID))
+		}
+		oids.Insert(ext.OID)
+
+		var value asn1.RawValue
+		if len(ext.Value) == 0 {
+			el = append(el, field.Required(path.Child("value"), "must be specified"))
+		} else if rest, err := asn1.Unmarshal(ext.Value, &value); err != nil || len(rest) != 0 {
+			el = append(el, field.Invalid(path.Child("value"), ext.Value, "must be a single DER encoded value"))
+		}
+	}
+	return el
+}
+
 func validateUsages(a *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	for i, u := range a.Usages {
@@ -228,6 +356,259 @@ func validateSecretTemplateLabels(crt *internalcmapi.CertificateSpec, fldPath *f
 	return metavalidation.ValidateLabels(crt.SecretTemplate.Labels, fldPath.Child("secretTemplate", "labels"))
 }
 
+func validateSecretTargets(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	fldPath = fldPath.Child("secretTargets")
+
+	if len(crt.SecretTargets.Namespaces) == 0 && crt.SecretTargets.NamespaceSelector == nil {
+		el = append(el, field.Required(fldPath, "at least one of namespaces or namespaceSelector must be set"))
+	}
+	seen := sets.NewString()
+	for i, ns := range crt.SecretTargets.Namespaces {
+		for _, msg := range apivalidation.ValidateNamespaceName(ns, false) {
+			el = append(el, field.Invalid(fldPath.Child("namespaces").Index(i), ns, msg))
+		}
+		if seen.Has(ns) {
+			el = append(el, field.Duplicate(fldPath.Child("namespaces").Index(i), ns))
+		}
+		seen.Insert(ns)
+	}
+	if crt.SecretTargets.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(crt.SecretTargets.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+	return el
+}
+
+func validateExternalSecretStore(store *internalcmapi.CertificateExternalSecretStore, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	numStores := 0
+	if store.Vault != nil {
+		numStores++
+		vaultPath := fldPath.Child("vault")
+		// The server, path, CA bundle and authentication are validated in
+		// the same way as for a Vault issuer.
+		el = append(el, ValidateVaultIssuerConfig(&internalcmapi.VaultIssuer{
+			Auth:     store.Vault.Auth,
+			Server:   store.Vault.Server,
+			Path:     store.Vault.Path,
+			CABundle: store.Vault.CABundle,
+		}, vaultPath)...)
+		if store.Vault.Mount == "" {
+			el = append(el, field.Required(vaultPath.Child("mount"), ""))
+		}
+	}
+	if store.AWSSecretsManager != nil {
+		numStores++
+		awsPath := fldPath.Child("awsSecretsManager")
+		if store.AWSSecretsManager.Region == "" {
+			el = append(el, field.Required(awsPath.Child("region"), "region is a required field"))
+		}
+		if store.AWSSecretsManager.SecretID == "" {
+			el = append(el, field.Required(awsPath.Child("secretId"), "secret ID is a required field"))
+		}
+		if store.AWSSecretsManager.Role != "" {
+			if _, err := arn.Parse(store.AWSSecretsManager.Role); err != nil {
+				el = append(el, field.Invalid(awsPath.Child("role"), store.AWSSecretsManager.Role, err.Error()))
+			}
+		}
+		if auth := store.AWSSecretsManager.Auth; auth != nil {
+			el = append(el, ValidateSecretKeySelector(&auth.AccessKeyIDSecretRef, awsPath.Child("auth", "accessKeyIDSecretRef"))...)
+			el = append(el, ValidateSecretKeySelector(&auth.SecretAccessKeySecretRef, awsPath.Child("auth", "secretAccessKeySecretRef"))...)
+		}
+	}
+	if store.GCPSecretManager != nil {
+		numStores++
+		gcpPath := fldPath.Child("gcpSecretManager")
+		if store.GCPSecretManager.Project == "" {
+			el = append(el, field.Required(gcpPath.Child("project"), "project is a required field"))
+		}
+		if store.GCPSecretManager.SecretID == "" {
+			el = append(el, field.Required(gcpPath.Child("secretId"), "secret ID is a required field"))
+		}
+		if store.GCPSecretManager.ServiceAccountKeySecretRef != nil {
+			el = append(el, ValidateSecretKeySelector(store.GCPSecretManager.ServiceAccountKeySecretRef, gcpPath.Child("serviceAccountKeySecretRef"))...)
+		}
+	}
+
+	if numStores != 1 {
+		el = append(el, field.Invalid(fldPath, "", "exactly one of vault, awsSecretsManager or gcpSecretManager must be specified"))
+	}
+
+	return el
+}
+
+func validatePrivateKeyEncryption(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	encryptionPath := fldPath.Child("privateKeyEncryption")
+	encryption := crt.PrivateKeyEncryption
+
+	numServices := 0
+	if encryption.AWSKMS != nil {
+		numServices++
+		awsPath := encryptionPath.Child("awsKMS")
+		if encryption.AWSKMS.Region == "" {
+			el = append(el, field.Required(awsPath.Child("region"), "region is a required field"))
+		}
+		if encryption.AWSKMS.KeyID == "" {
+			el = append(el, field.Required(awsPath.Child("keyId"), "key ID is a required field"))
+		}
+		if encryption.AWSKMS.Role != "" {
+			if _, err := arn.Parse(encryption.AWSKMS.Role); err != nil {
+				el = append(el, field.Invalid(awsPath.Child("role"), encryption.AWSKMS.Role, err.Error()))
+			}
+		}
+		if auth := encryption.AWSKMS.Auth; auth != nil {
+			el = append(el, ValidateSecretKeySelector(&auth.AccessKeyIDSecretRef, awsPath.Child("auth", "accessKeyIDSecretRef"))...)
+			el = append(el, ValidateSecretKeySelector(&auth.SecretAccessKeySecretRef, awsPath.Child("auth", "secretAccessKeySecretRef"))...)
+		}
+	}
+	if encryption.GCPKMS != nil {
+		numServices++
+		gcpPath := encryptionPath.Child("gcpKMS")
+		if encryption.GCPKMS.KeyName == "" {
+			el = append(el, field.Required(gcpPath.Child("keyName"), "key name is a required field"))
+		} else if !isGCPKMSKeyName(encryption.GCPKMS.KeyName) {
+			el = append(el, field.Invalid(gcpPath.Child("keyName"), encryption.GCPKMS.KeyName, "must be of the form projects/*/locations/*/keyRings/*/cryptoKeys/*"))
+		}
+		if encryption.GCPKMS.ServiceAccountKeySecretRef != nil {
+			el = append(el, ValidateSecretKeySelector(encryption.GCPKMS.ServiceAccountKeySecretRef, gcpPath.Child("serviceAccountKeySecretRef"))...)
+		}
+	}
+	if encryption.VaultTransit != nil {
+		numServices++
+		vaultPath := encryptionPath.Child("vaultTransit")
+		// The server, CA bundle and authentication are validated in the same
+		// way as for a Vault issuer, whose path is the encrypt endpoint of
+		// the key.
+		el = append(el, ValidateVaultIssuerConfig(&internalcmapi.VaultIssuer{
+			Auth:     encryption.VaultTransit.Auth,
+			Server:   encryption.VaultTransit.Server,
+			Path:     path.Join(encryption.VaultTransit.Mount, "encrypt", encryption.VaultTransit.KeyName),
+			CABundle: encryption.VaultTransit.CABundle,
+		}, vaultPath)...)
+		if encryption.VaultTransit.Mount == "" {
+			el = append(el, field.Required(vaultPath.Child("mount"), ""))
+		}
+		if encryption.VaultTransit.KeyName == "" {
+			el = append(el, field.Required(vaultPath.Child("keyName"), ""))
+		}
+	}
+
+	if numServices != 1 {
+		el = append(el, field.Invalid(encryptionPath, "", "exactly one of awsKMS, gcpKMS or vaultTransit must be specified"))
+	}
+
+	// Private keys are encrypted when they are stored, so existing private
+	// keys cannot be reused and other encodings of them cannot be stored.
+	if crt.PrivateKey == nil || crt.PrivateKey.RotationPolicy != internalcmapi.RotationPolicyAlways {
+		var rotationPolicy internalcmapi.PrivateKeyRotationPolicy
+		if crt.PrivateKey != nil {
+			rotationPolicy = crt.PrivateKey.RotationPolicy
+		}
+		el = append(el, field.Invalid(fldPath.Child("privateKey", "rotationPolicy"), rotationPolicy, "must be Always when privateKeyEncryption is set"))
+	}
+	if ks := crt.Keystores; ks != nil &&
+		((ks.JKS != nil && ks.JKS.Create) || (ks.PKCS12 != nil && ks.PKCS12.Create)) {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "keystores cannot be created when privateKeyEncryption is set"))
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when privateKeyEncryption is set"))
+	}
+
+	return el
+}
+
+// isGCPKMSKeyName returns true if name is the full resource name of a Google
+// Cloud KMS key.
+func isGCPKMSKeyName(name string) bool {
+	return isGCPKMSResourceName(name, "projects", "locations", "keyRings", "cryptoKeys")
+}
+
+// isGCPKMSKeyVersionName returns true if name is the full resource name of a
+// Google Cloud KMS key version.
+func isGCPKMSKeyVersionName(name string) bool {
+	return isGCPKMSResourceName(name, "projects", "locations", "keyRings", "cryptoKeys", "cryptoKeyVersions")
+}
+
+func isGCPKMSResourceName(name string, collections ...string) bool {
+	segments := strings.Split(name, "/")
+	if len(segments) != 2*len(collections) {
+		return false
+	}
+	for i, collection := range collections {
+		if segments[2*i] != collection || segments[2*i+1] == "" {
+			return false
+		}
+	}
+	return true
+}
+
+func validatePrivateKeyBackend(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	pkPath := fldPath.Child("privateKey")
+	backendPath := pkPath.Child("backend")
+	backend := crt.PrivateKey.Backend
+
+	numServices := 0
+	if backend.AWSKMS != nil {
+		numServices++
+		awsPath := backendPath.Child("awsKMS")
+		if backend.AWSKMS.Region == "" {
+			el = append(el, field.Required(awsPath.Child("region"), "region is a required field"))
+		}
+		if backend.AWSKMS.KeyID == "" {
+			el = append(el, field.Required(awsPath.Child("keyId"), "key ID is a required field"))
+		}
+		if backend.AWSKMS.Role != "" {
+			if _, err := arn.Parse(backend.AWSKMS.Role); err != nil {
+				el = append(el, field.Invalid(awsPath.Child("role"), backend.AWSKMS.Role, err.Error()))
+			}
+		}
+		if auth := backend.AWSKMS.Auth; auth != nil {
+			el = append(el, ValidateSecretKeySelector(&auth.AccessKeyIDSecretRef, awsPath.Child("auth", "accessKeyIDSecretRef"))...)
+			el = append(el, ValidateSecretKeySelector(&auth.SecretAccessKeySecretRef, awsPath.Child("auth", "secretAccessKeySecretRef"))...)
+		}
+	}
+	if backend.GCPKMS != nil {
+		numServices++
+		gcpPath := backendPath.Child("gcpKMS")
+		if backend.GCPKMS.KeyVersionName == "" {
+			el = append(el, field.Required(gcpPath.Child("keyVersionName"), "key version name is a required field"))
+		} else if !isGCPKMSKeyVersionName(backend.GCPKMS.KeyVersionName) {
+			el = append(el, field.Invalid(gcpPath.Child("keyVersionName"), backend.GCPKMS.KeyVersionName, "must be of the form projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*"))
+		}
+		if backend.GCPKMS.ServiceAccountKeySecretRef != nil {
+			el = append(el, ValidateSecretKeySelector(backend.GCPKMS.ServiceAccountKeySecretRef, gcpPath.Child("serviceAccountKeySecretRef"))...)
+		}
+	}
+
+	if numServices != 1 {
+		el = append(el, field.Invalid(backendPath, "", "exactly one of awsKMS or gcpKMS must be specified"))
+	}
+
+	// The private key held by the backend is never regenerated or stored, so
+	// it has to be reused and cannot be read to encrypt or re-encode it.
+	if crt.PrivateKey.RotationPolicy != "" && crt.PrivateKey.RotationPolicy != internalcmapi.RotationPolicyNever {
+		el = append(el, field.Invalid(pkPath.Child("rotationPolicy"), crt.PrivateKey.RotationPolicy, "must be Never when backend is set"))
+	}
+	if crt.PrivateKey.Algorithm == internalcmapi.Ed25519KeyAlgorithm {
+		el = append(el, field.Forbidden(pkPath.Child("algorithm"), "ed25519 private keys cannot be held by a backend"))
+	}
+	if crt.PrivateKeyEncryption != nil {
+		el = append(el, field.Forbidden(fldPath.Child("privateKeyEncryption"), "private keys held by a backend cannot be encrypted"))
+	}
+	if ks := crt.Keystores; ks != nil &&
+		((ks.JKS != nil && ks.JKS.Create) || (ks.PKCS12 != nil && ks.PKCS12.Create)) {
+		el = append(el, field.Forbidden(fldPath.Child("keystores"), "keystores cannot be created when privateKey.backend is set"))
+	}
+	if len(crt.AdditionalOutputFormats) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when privateKey.backend is set"))
+	}
+
+	return el
+}
+
 func validateSecretTemplateAnnotations(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -260,6 +641,47 @@ func ValidateDuration(crt *internalcmapi.CertificateSpec, fldPath *field.Path) f
 	return el
 }
 
+// validateRenewBeforePercentage checks that the renewBeforePercentage is a
+// percentage strictly between 0 and 100, and is not set alongside
+// renewBefore.
+func validateRenewBeforePercentage(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if crt.RenewBefore != nil {
+		el = append(el, field.Forbidden(fldPath.Child("renewBeforePercentage"), "may not be set when renewBefore is set"))
+	}
+	if pct := *crt.RenewBeforePercentage; pct <= 0 || pct >= 100 {
+		el = append(el, field.Invalid(fldPath.Child("renewBeforePercentage"), pct, "must be greater than 0 and less than 100"))
+	}
+	return el
+}
+
+// validateRenewalJitter checks that the renewal jitter is not negative, and
+// does not span the whole lifetime of the certificate.
+func validateRenewalJitter(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	duration := util.DefaultCertDuration(crt.Duration)
+	if crt.RenewalJitter.Duration < 0 {
+		el = append(el, field.Invalid(fldPath.Child("renewalJitter"), crt.RenewalJitter.Duration, "must not be negative"))
+	} else if crt.RenewalJitter.Duration >= duration {
+		el = append(el, field.Invalid(fldPath.Child("renewalJitter"), crt.RenewalJitter.Duration, fmt.Sprintf("must be less than the certificate duration %s", duration)))
+	}
+	return el
+}
+
+// validateKeyRotationInterval checks that private keys are only rotated on a
+// schedule if they are regenerated on each issuance, and not so often that
+// the Certificate is continually re-issued.
+func validateKeyRotationInterval(pk *internalcmapi.CertificatePrivateKey, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if pk.RotationPolicy != internalcmapi.RotationPolicyAlways {
+		el = append(el, field.Forbidden(fldPath.Child("keyRotationInterval"), "may only be set when rotationPolicy is Always"))
+	}
+	if pk.KeyRotationInterval.Duration < cmapi.MinimumCertificateDuration {
+		el = append(el, field.Invalid(fldPath.Child("keyRotationInterval"), pk.KeyRotationInterval.Duration, fmt.Sprintf("must be at least %s", cmapi.MinimumCertificateDuration)))
+	}
+	return el
+}
+
 func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 
@@ -280,5 +702,122 @@ func validateAdditionalOutputFormats(crt *internalcmapi.CertificateSpec, fldPath
 		aofSet.Insert(string(val.Type))
 	}
 
+	// Ensure each output format is written to its own Secret data entry,
+	// which is not used for anything else.
+	keySet := sets.NewString()
+	for i, val := range crt.AdditionalOutputFormats {
+		if len(val.Key) > 0 {
+			keyPath := fldPath.Child("additionalOutputFormats").Index(i).Child("key")
+			for _, msg := range validation.IsConfigMapKey(val.Key) {
+				el = append(el, field.Invalid(keyPath, val.Key, msg))
+			}
+			if reservedSecretKeys.Has(val.Key) {
+				el = append(el, field.Invalid(keyPath, val.Key, "must not be a data entry used for the certificate, private key, CA or keystores"))
+			}
+		}
+
+		key := additionalOutputFormatKey(val)
+		if len(key) == 0 {
+			continue
+		}
+		if keySet.Has(key) {
+			el = append(el, field.Duplicate(fldPath.Child("additionalOutputFormats").Key("key"), key))
+			continue
+		}
+		keySet.Insert(key)
+	}
+
+	return el
+}
+
+// reservedSecretKeys are the data entries of a Certificate's target Secret
+// which cannot be used for additional output formats.
+var reservedSecretKeys = sets.NewString(
+	corev1.TLSCertKey, corev1.TLSPrivateKeyKey, cmmeta.TLSCAKey,
+	cmapi.PKCS12SecretKey, cmapi.PKCS12TruststoreKey, cmapi.JKSSecretKey, cmapi.JKSTruststoreKey,
+)
+
+// additionalOutputFormatKey returns the Secret data entry the additional
+// output format is written to, or an empty string for unknown format types.
+func additionalOutputFormatKey(format internalcmapi.CertificateAdditionalOutputFormat) string {
+	if len(format.Key) > 0 {
+		return format.Key
+	}
+
+	switch format.Type {
+	case internalcmapi.AdditionalCertificateOutputFormatDER:
+		return cmapi.CertificateOutputFormatDERKey
+	case internalcmapi.AdditionalCertificateOutputFormatCombinedPEM:
+		return cmapi.CertificateOutputFormatCombinedPEMKey
+	case internalcmapi.AdditionalCertificateOutputFormatDERCertificate:
+		return cmapi.CertificateOutputFormatDERCertificateKey
+	case internalcmapi.AdditionalCertificateOutputFormatPKCS7:
+		return cmapi.CertificateOutputFormatPKCS7Key
+	default:
+		return ""
+	}
+}
+
+// keyAlgorithms maps the public key algorithm required by a signature
+// algorithm to the private key algorithm of a Certificate.
+var keyAlgorithms = map[x509.PublicKeyAlgorithm]internalcmapi.PrivateKeyAlgorithm{
+	x509.RSA:     internalcmapi.RSAKeyAlgorithm,
+	x509.ECDSA:   internalcmapi.ECDSAKeyAlgorithm,
+	x509.Ed25519: internalcmapi.Ed25519KeyAlgorithm,
+}
+
+func validateSignatureAlgorithm(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	fldPath = fldPath.Child("signatureAlgorithm")
+
+	_, pubKeyAlgo, err := pki.ParseSignatureAlgorithm(cmapi.SignatureAlgorithm(crt.SignatureAlgorithm))
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, crt.SignatureAlgorithm, err.Error())}
+	}
+
+	keyAlgorithm := internalcmapi.RSAKeyAlgorithm
+	if crt.PrivateKey != nil && len(crt.PrivateKey.Algorithm) > 0 {
+		keyAlgorithm = crt.PrivateKey.Algorithm
+	}
+	if required := keyAlgorithms[pubKeyAlgo]; required != keyAlgorithm {
+		return field.ErrorList{field.Invalid(fldPath, crt.SignatureAlgorithm, fmt.Sprintf("requires a %s private key, but privateKey.algorithm is %s", required, keyAlgorithm))}
+	}
+
+	return nil
+}
+
+func validateCAConstraints(crt *internalcmapi.CertificateSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if crt.MaxPathLen != nil {
+		if !crt.IsCA {
+			el = append(el, field.Invalid(fldPath.Child("maxPathLen"), *crt.MaxPathLen, "may only be set when isCA is true"))
+		} else if *crt.MaxPathLen < 0 {
+			el = append(el, field.Invalid(fldPath.Child("maxPathLen"), *crt.MaxPathLen, "must not be negative"))
+		}
+	}
+
+	if crt.NameConstraints != nil {
+		ncPath := fldPath.Child("nameConstraints")
+		if !crt.IsCA {
+			el = append(el, field.Invalid(ncPath, "", "may only be set when isCA is true"))
+		}
+		for _, item := range []struct {
+			name  string
+			names *internalcmapi.NameConstraintItem
+		}{
+			{"permitted", crt.NameConstraints.Permitted},
+			{"excluded", crt.NameConstraints.Excluded},
+		} {
+			if item.names == nil {
+				continue
+			}
+			for i, cidr := range item.names.IPRanges {
+				if _, _, err := net.ParseCIDR(cidr); err != nil {
+					el = append(el, field.Invalid(ncPath.Child(item.name, "ipRanges").Index(i), cidr, "must be an IP address range in CIDR notation"))
+				}
+			}
+		}
+	}
+
 	return el
 }
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"fmt"
+	"strings"
 
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
@@ -63,9 +64,43 @@ func ValidateCertificateForACMEIssuer(crt *cmapi.CertificateSpec, issuer *cmapi.
 		el = append(el, field.Invalid(specPath.Child("ipAddresses"), crt.IPAddresses, "ACME does not support certificate ip addresses"))
 	}
 
+	if len(crt.URISANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("uris"), crt.URISANs, "ACME does not support certificate URIs"))
+	}
+
+	if len(crt.EmailSANs) != 0 {
+		el = append(el, field.Invalid(specPath.Child("emailAddresses"), crt.EmailSANs, "ACME does not support certificate email addresses"))
+	}
+
+	// ACME servers reject common names longer than the 64 bytes permitted
+	// by RFC 5280.
+	if len(crt.CommonName) > 64 {
+		el = append(el, field.TooLong(specPath.Child("commonName"), crt.CommonName, 64))
+	}
+
+	// Wildcard identifiers can only be validated using DNS01 challenges.
+	if issuer.ACME != nil && !hasDNS01Solver(issuer) {
+		for i, dnsName := range crt.DNSNames {
+			if strings.HasPrefix(dnsName, "*.") {
+				el = append(el, field.Invalid(specPath.Child("dnsNames").Index(i), dnsName, "wildcard names require the ACME issuer to have a DNS01 solver"))
+			}
+		}
+	}
+
 	return el
 }
 
+// hasDNS01Solver returns true if any solver of the ACME issuer solves DNS01
+// challenges.
+func hasDNS01Solver(issuer *cmapi.IssuerSpec) bool {
+	for _, solver := range issuer.ACME.Solvers {
+		if solver.DNS01 != nil {
+			return true
+		}
+	}
+	return false
+}
+
 func ValidateCertificateForVaultIssuer(crt *cmapi.CertificateSpec, issuer *cmapi.IssuerSpec, specPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -18,6 +18,7 @@ package validation
 
 import (
 	"reflect"
+	"strings"
 	"testing"
 	"time"
 
@@ -120,6 +121,63 @@ func TestValidateCertificateForIssuer(t *testing.T) {
 				field.Invalid(fldPath.Child("ipAddresses"), []string{"127.0.0.1"}, "ACME does not support certificate ip addresses"),
 			},
 		},
+		"acme certificate with uris and emailAddresses set": {
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					URISANs:   []string{"spiffe://cluster.local/ns/default/sa/foo"},
+					EmailSANs: []string{"alice@example.com"},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: acmeIssuer,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("uris"), []string{"spiffe://cluster.local/ns/default/sa/foo"}, "ACME does not support certificate URIs"),
+				field.Invalid(fldPath.Child("emailAddresses"), []string{"alice@example.com"}, "ACME does not support certificate email addresses"),
+			},
+		},
+		"acme certificate with commonName longer than 64 bytes": {
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: strings.Repeat("a", 61) + ".com",
+					IssuerRef:  validIssuerRef,
+				},
+			},
+			issuer: acmeIssuer,
+			errs: []*field.Error{
+				field.TooLong(fldPath.Child("commonName"), strings.Repeat("a", 61)+".com", 64),
+			},
+		},
+		"acme certificate with wildcard dnsName and no DNS01 solver": {
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					DNSNames:  []string{"example.com", "*.example.com"},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: acmeIssuer,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("dnsNames").Index(1), "*.example.com", "wildcard names require the ACME issuer to have a DNS01 solver"),
+			},
+		},
+		"acme certificate with wildcard dnsName and a DNS01 solver": {
+			crt: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					DNSNames:  []string{"*.example.com"},
+					IssuerRef: validIssuerRef,
+				},
+			},
+			issuer: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								{DNS01: &cmacme.ACMEChallengeSolverDNS01{}},
+							},
+						},
+					},
+				},
+			},
+		},
 		"acme certificate with renewBefore set": {
 			crt: &cmapi.Certificate{
 				Spec: cmapi.CertificateSpec{
@@ -23,6 +23,7 @@ import (
 	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
@@ -151,7 +152,106 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, or emailAddresses must be set"),
+				field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, emailAddresses, or otherNames must be set"),
+			},
+		},
+		"valid certificate with only an otherName": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					OtherNames: []internalcmapi.OtherName{
+						{OID: "1.3.6.1.4.1.311.20.2.3", UTF8Value: "user@example.com"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with invalid otherNames": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					OtherNames: []internalcmapi.OtherName{
+						{OID: "1.3.6.1.4.1.311.20.2.3"},
+						{OID: "upn", UTF8Value: "user@example.com"},
+						{UTF8Value: "\xff"},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("otherNames").Index(0).Child("utf8Value"), "must be specified"),
+				field.Invalid(fldPath.Child("otherNames").Index(1).Child("oid"), "upn", `"upn" is not an object identifier in dotted decimal notation`),
+				field.Required(fldPath.Child("otherNames").Index(2).Child("oid"), "must be specified"),
+				field.Invalid(fldPath.Child("otherNames").Index(2).Child("utf8Value"), "\xff", "must be a valid UTF-8 string"),
+			},
+		},
+		"certificate with extra extensions": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					ExtraExtensions: []internalcmapi.CertificateExtension{
+						{OID: "1.3.6.1.4.1.11129.2.4.3", Critical: true, Value: []byte{0x05, 0x00}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with invalid extra extensions": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					ExtraExtensions: []internalcmapi.CertificateExtension{
+						{OID: "1.2.3.4", Value: []byte{0x05, 0x00}},
+						{OID: "1.2.3.4", Value: []byte{0x05, 0x00}},
+						{OID: "2.5.29.17", Value: []byte{0x05, 0x00}},
+						{OID: "ext", Value: []byte{0x04}},
+						{},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("extraExtensions").Index(1).Child("oid"), "1.2.3.4"),
+				field.Invalid(fldPath.Child("extraExtensions").Index(2).Child("oid"), "2.5.29.17", "extension is set by cert-manager and cannot be requested as an extra extension"),
+				field.Invalid(fldPath.Child("extraExtensions").Index(3).Child("oid"), "ext", `"ext" is not an object identifier in dotted decimal notation`),
+				field.Invalid(fldPath.Child("extraExtensions").Index(3).Child("value"), []byte{0x04}, "must be a single DER encoded value"),
+				field.Required(fldPath.Child("extraExtensions").Index(4).Child("oid"), "must be specified"),
+				field.Required(fldPath.Child("extraExtensions").Index(4).Child("value"), "must be specified"),
+			},
+		},
+		"certificate with certificate policies and extended key usage OIDs": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					IssuerRef:            validIssuerRef,
+					CertificatePolicies:  []string{"2.23.140.1.2.1", "1.3.6.1.4.1.99999.1"},
+					ExtendedKeyUsageOIDs: []string{"1.3.6.1.4.1.99999.3.1"},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with invalid certificate policies and extended key usage OIDs": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:           "testcn",
+					SecretName:           "abc",
+					IssuerRef:            validIssuerRef,
+					CertificatePolicies:  []string{"2.23.140.1.2.1", "2.23.140.1.2.1"},
+					ExtendedKeyUsageOIDs: []string{"serverAuth"},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Duplicate(fldPath.Child("certificatePolicies").Index(1), "2.23.140.1.2.1"),
+				field.Invalid(fldPath.Child("extendedKeyUsageOIDs").Index(0), "serverAuth", `"serverAuth" is not an object identifier in dotted decimal notation`),
 			},
 		},
 		"certificate with no issuerRef": {
@@ -350,6 +450,19 @@ func TestValidateCertificate(t *testing.T) {
 				field.NotSupported(fldPath.Child("privateKey", "size"), 100, []string{"256", "384", "521"}),
 			},
 		},
+		"valid certificate with Ed25519 keyAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.Ed25519KeyAlgorithm,
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
 		"certificate with invalid keyAlgorithm": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -363,7 +476,191 @@ func TestValidateCertificate(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa or ecdsa"),
+				field.Invalid(fldPath.Child("privateKey", "algorithm"), internalcmapi.PrivateKeyAlgorithm("blah"), "must be either empty or one of rsa, ecdsa or ed25519"),
+			},
+		},
+		"valid certificate with signatureAlgorithm matching the keyAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+					},
+					SignatureAlgorithm: internalcmapi.ECDSAWithSHA384,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"valid certificate with RSA-PSS signatureAlgorithm and default keyAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:         "testcn",
+					SecretName:         "abc",
+					IssuerRef:          validIssuerRef,
+					SignatureAlgorithm: internalcmapi.SHA256WithRSAPSS,
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with signatureAlgorithm not matching the keyAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						Algorithm: internalcmapi.Ed25519KeyAlgorithm,
+					},
+					SignatureAlgorithm: internalcmapi.SHA512WithRSA,
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signatureAlgorithm"), internalcmapi.SHA512WithRSA, "requires a RSA private key, but privateKey.algorithm is Ed25519"),
+			},
+		},
+		"certificate with invalid signatureAlgorithm": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:         "testcn",
+					SecretName:         "abc",
+					IssuerRef:          validIssuerRef,
+					SignatureAlgorithm: internalcmapi.SignatureAlgorithm("MD5WithRSA"),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signatureAlgorithm"), internalcmapi.SignatureAlgorithm("MD5WithRSA"), `unsupported signature algorithm specified: "MD5WithRSA"`),
+			},
+		},
+		"valid certificate with keyRotationInterval": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:      internalcmapi.RotationPolicyAlways,
+						KeyRotationInterval: &metav1.Duration{Duration: 2160 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with keyRotationInterval without rotationPolicy Always": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:      internalcmapi.RotationPolicyNever,
+						KeyRotationInterval: &metav1.Duration{Duration: 2160 * time.Hour},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("privateKey", "keyRotationInterval"), "may only be set when rotationPolicy is Always"),
+			},
+		},
+		"valid certificate with renewBeforePercentage": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+					RenewBeforePercentage: int32Ptr(33),
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with renewBeforePercentage out of range": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+					RenewBeforePercentage: int32Ptr(100),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewBeforePercentage"), int32(100), "must be greater than 0 and less than 100"),
+			},
+		},
+		"certificate with both renewBefore and renewBeforePercentage": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:            "testcn",
+					SecretName:            "abc",
+					IssuerRef:             validIssuerRef,
+					RenewBefore:           &metav1.Duration{Duration: time.Hour},
+					RenewBeforePercentage: int32Ptr(33),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("renewBeforePercentage"), "may not be set when renewBefore is set"),
+			},
+		},
+		"valid certificate with renewalJitter": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+					RenewalJitter: &metav1.Duration{Duration: time.Hour * 24},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"certificate with negative renewalJitter": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+					RenewalJitter: &metav1.Duration{Duration: -time.Hour},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalJitter"), -time.Hour, "must not be negative"),
+			},
+		},
+		"certificate with renewalJitter longer than its duration": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:    "testcn",
+					SecretName:    "abc",
+					IssuerRef:     validIssuerRef,
+					Duration:      &metav1.Duration{Duration: time.Hour * 24},
+					RenewalJitter: &metav1.Duration{Duration: time.Hour * 24},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("renewalJitter"), time.Hour*24, "must be less than the certificate duration 24h0m0s"),
+			},
+		},
+		"certificate with too short keyRotationInterval": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "testcn",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					PrivateKey: &internalcmapi.CertificatePrivateKey{
+						RotationPolicy:      internalcmapi.RotationPolicyAlways,
+						KeyRotationInterval: &metav1.Duration{Duration: time.Minute},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("privateKey", "keyRotationInterval"), time.Minute, "must be at least 1h0m0s"),
 			},
 		},
 		"valid certificate with ipAddresses": {
@@ -564,6 +861,135 @@ func TestValidateCertificate(t *testing.T) {
 				field.Invalid(fldPath.Child("revisionHistoryLimit"), int32(0), "must not be less than 1"),
 			},
 		},
+		"valid certificate with revision history TTL": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:         "abc",
+					SecretName:         "abc",
+					IssuerRef:          validIssuerRef,
+					RevisionHistoryTTL: &metav1.Duration{Duration: 24 * time.Hour},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid certificate with revision history TTL of zero": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:         "abc",
+					SecretName:         "abc",
+					IssuerRef:          validIssuerRef,
+					RevisionHistoryTTL: &metav1.Duration{},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("revisionHistoryTTL"), time.Duration(0), "must be greater than zero"),
+			},
+		},
+		"valid certificate with fallback issuers": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					FallbackIssuerRefs: []cmmeta.ObjectReference{
+						{Name: "backup", Kind: "ClusterIssuer"},
+						{Name: "external", Kind: "ExternalIssuer", Group: "example.io"},
+					},
+					FailoverAttempts: int32Ptr(1),
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid fallback issuers and failover attempts": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					FallbackIssuerRefs: []cmmeta.ObjectReference{
+						{Name: "backup", Kind: "ClusterIssuer"},
+						{Kind: "Secret"},
+					},
+					FailoverAttempts: int32Ptr(0),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Required(fldPath.Child("fallbackIssuerRefs").Index(1).Child("name"), "must be specified"),
+				field.Invalid(fldPath.Child("fallbackIssuerRefs").Index(1).Child("kind"), "Secret", "must be one of Issuer or ClusterIssuer"),
+				field.Invalid(fldPath.Child("failoverAttempts"), int32(0), "must not be less than 1"),
+			},
+		},
+		"invalid failover attempts without fallback issuers": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName:       "abc",
+					SecretName:       "abc",
+					IssuerRef:        validIssuerRef,
+					FailoverAttempts: int32Ptr(2),
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("failoverAttempts"), int32(2), "may only be set when fallbackIssuerRefs is set"),
+			},
+		},
+		"valid CA certificate with path length and name constraints": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					IsCA:       true,
+					MaxPathLen: int32Ptr(0),
+					NameConstraints: &internalcmapi.NameConstraints{
+						Permitted: &internalcmapi.NameConstraintItem{
+							DNSDomains: []string{"example.com"},
+							IPRanges:   []string{"10.0.0.0/8"},
+						},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+		},
+		"invalid path length and name constraints on a non-CA certificate": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					MaxPathLen: int32Ptr(1),
+					NameConstraints: &internalcmapi.NameConstraints{
+						Permitted: &internalcmapi.NameConstraintItem{DNSDomains: []string{"example.com"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxPathLen"), int32(1), "may only be set when isCA is true"),
+				field.Invalid(fldPath.Child("nameConstraints"), "", "may only be set when isCA is true"),
+			},
+		},
+		"invalid negative path length and IP range name constraint": {
+			cfg: &internalcmapi.Certificate{
+				Spec: internalcmapi.CertificateSpec{
+					CommonName: "abc",
+					SecretName: "abc",
+					IssuerRef:  validIssuerRef,
+					IsCA:       true,
+					MaxPathLen: int32Ptr(-1),
+					NameConstraints: &internalcmapi.NameConstraints{
+						Excluded: &internalcmapi.NameConstraintItem{IPRanges: []string{"10.0.0.1"}},
+					},
+				},
+			},
+			a: someAdmissionRequest,
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("maxPathLen"), int32(-1), "must not be negative"),
+				field.Invalid(fldPath.Child("nameConstraints", "excluded", "ipRanges").Index(0), "10.0.0.1", "must be an IP address range in CIDR notation"),
+			},
+		},
 		"valid with empty secretTemplate": {
 			cfg: &internalcmapi.Certificate{
 				Spec: internalcmapi.CertificateSpec{
@@ -888,6 +1314,52 @@ func Test_validateAdditionalOutputFormats(t *testing.T) {
 				field.Duplicate(field.NewPath("spec", "additionalOutputFormats").Key("type"), "bar"),
 			},
 		},
+		"if feature enabled and formats written to custom keys, expect no error": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatDERCertificate, Key: "cert.der"},
+					{Type: internalcmapi.AdditionalCertificateOutputFormatPKCS7, Key: "chain.p7b"},
+				},
+			},
+			expErr: nil,
+		},
+		"if feature enabled and a format is written to an invalid key, expect error": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatPKCS7, Key: "chain/p7b"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "additionalOutputFormats").Index(0).Child("key"), "chain/p7b",
+					"a valid config key must consist of alphanumeric characters, '-', '_' or '.' (e.g. 'key.name',  or 'KEY_NAME',  or 'key-name', regex used for validation is '[-._a-zA-Z0-9]+')"),
+			},
+		},
+		"if feature enabled and a format is written to a reserved key, expect error": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatDERCertificate, Key: "tls.crt"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(field.NewPath("spec", "additionalOutputFormats").Index(0).Child("key"), "tls.crt",
+					"must not be a data entry used for the certificate, private key, CA or keystores"),
+			},
+		},
+		"if feature enabled and a custom key is the default key of another format, expect error": {
+			featureEnabled: true,
+			spec: &internalcmapi.CertificateSpec{
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatDER},
+					{Type: internalcmapi.AdditionalCertificateOutputFormatPKCS7, Key: "key.der"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Duplicate(field.NewPath("spec", "additionalOutputFormats").Key("key"), "key.der"),
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -899,6 +1371,340 @@ func Test_validateAdditionalOutputFormats(t *testing.T) {
 	}
 }
 
+func Test_validateSecretTargets(t *testing.T) {
+	fldPath := field.NewPath("spec", "secretTargets")
+	tests := map[string]struct {
+		targets *internalcmapi.CertificateSecretTargets
+		expErr  field.ErrorList
+	}{
+		"if namespaces are listed, expect no error": {
+			targets: &internalcmapi.CertificateSecretTargets{Namespaces: []string{"foo", "bar"}},
+		},
+		"if a namespace selector is set, expect no error": {
+			targets: &internalcmapi.CertificateSecretTargets{
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tls": "true"}},
+			},
+		},
+		"if neither namespaces nor a namespace selector are set, expect error": {
+			targets: &internalcmapi.CertificateSecretTargets{},
+			expErr: field.ErrorList{
+				field.Required(fldPath, "at least one of namespaces or namespaceSelector must be set"),
+			},
+		},
+		"if a namespace is not a valid namespace name, expect error": {
+			targets: &internalcmapi.CertificateSecretTargets{Namespaces: []string{"foo", "Not_Valid"}},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("namespaces").Index(1), "Not_Valid", apivalidation.ValidateNamespaceName("Not_Valid", false)[0]),
+			},
+		},
+		"if a namespace is listed twice, expect error": {
+			targets: &internalcmapi.CertificateSecretTargets{Namespaces: []string{"foo", "bar", "foo"}},
+			expErr: field.ErrorList{
+				field.Duplicate(fldPath.Child("namespaces").Index(2), "foo"),
+			},
+		},
+		"if the namespace selector is invalid, expect error": {
+			targets: &internalcmapi.CertificateSecretTargets{
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tls", Operator: metav1.LabelSelectorOpIn},
+				}},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("namespaceSelector", "matchExpressions").Index(0).Child("values"), "must be specified when `operator` is 'In' or 'NotIn'"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateSecretTargets(&internalcmapi.CertificateSpec{SecretTargets: test.targets}, field.NewPath("spec"))
+			if len(test.expErr) == 0 {
+				assert.Empty(t, gotErr)
+				return
+			}
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validateExternalSecretStore(t *testing.T) {
+	fldPath := field.NewPath("spec", "externalSecretStores").Index(0)
+	tokenRef := cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "vault-token"}, Key: "token"}
+	tests := map[string]struct {
+		store  *internalcmapi.CertificateExternalSecretStore
+		expErr field.ErrorList
+	}{
+		"if a vault store is configured, expect no error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				Vault: &internalcmapi.VaultSecretStore{
+					Server: "https://vault.example.com",
+					Mount:  "secret",
+					Path:   "my-app/tls",
+					Auth:   internalcmapi.VaultAuth{TokenSecretRef: &tokenRef},
+				},
+			},
+		},
+		"if a vault store has no mount or path, expect error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				Vault: &internalcmapi.VaultSecretStore{
+					Server: "https://vault.example.com",
+					Auth:   internalcmapi.VaultAuth{TokenSecretRef: &tokenRef},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("vault", "path"), ""),
+				field.Required(fldPath.Child("vault", "mount"), ""),
+			},
+		},
+		"if an AWS Secrets Manager store is configured, expect no error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				AWSSecretsManager: &internalcmapi.AWSSecretsManagerSecretStore{
+					Region:   "eu-west-1",
+					SecretID: "my-app/tls",
+					Role:     "arn:aws:iam::123456789012:role/cert-manager",
+				},
+			},
+		},
+		"if an AWS Secrets Manager store has an invalid role and incomplete credentials, expect error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				AWSSecretsManager: &internalcmapi.AWSSecretsManagerSecretStore{
+					Region:   "eu-west-1",
+					SecretID: "my-app/tls",
+					Role:     "cert-manager",
+					Auth: &internalcmapi.AWSPCAAuth{
+						AccessKeyIDSecretRef:     cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "aws"}, Key: "id"},
+						SecretAccessKeySecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "aws"}},
+					},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("awsSecretsManager", "role"), "cert-manager", "arn: invalid prefix"),
+				field.Required(fldPath.Child("awsSecretsManager", "auth", "secretAccessKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"if a GCP Secret Manager store has no project or secret ID, expect error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				GCPSecretManager: &internalcmapi.GCPSecretManagerSecretStore{},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("gcpSecretManager", "project"), "project is a required field"),
+				field.Required(fldPath.Child("gcpSecretManager", "secretId"), "secret ID is a required field"),
+			},
+		},
+		"if no store is configured, expect error": {
+			store: &internalcmapi.CertificateExternalSecretStore{},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath, "", "exactly one of vault, awsSecretsManager or gcpSecretManager must be specified"),
+			},
+		},
+		"if more than one store is configured, expect error": {
+			store: &internalcmapi.CertificateExternalSecretStore{
+				AWSSecretsManager: &internalcmapi.AWSSecretsManagerSecretStore{Region: "eu-west-1", SecretID: "my-app/tls"},
+				GCPSecretManager:  &internalcmapi.GCPSecretManagerSecretStore{Project: "my-project", SecretID: "my-app-tls"},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath, "", "exactly one of vault, awsSecretsManager or gcpSecretManager must be specified"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validateExternalSecretStore(test.store, fldPath)
+			if len(test.expErr) == 0 {
+				assert.Empty(t, gotErr)
+				return
+			}
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validatePrivateKeyEncryption(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	alwaysRotate := &internalcmapi.CertificatePrivateKey{RotationPolicy: internalcmapi.RotationPolicyAlways}
+	tokenRef := cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "vault-token"}, Key: "token"}
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"if an AWS KMS key is configured, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: alwaysRotate,
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					AWSKMS: &internalcmapi.AWSKMSKeyEncryption{
+						Region: "eu-west-1",
+						KeyID:  "alias/cert-manager",
+						Role:   "arn:aws:iam::123456789012:role/cert-manager",
+					},
+				},
+			},
+		},
+		"if a GCP KMS key is configured, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: alwaysRotate,
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					GCPKMS: &internalcmapi.GCPKMSKeyEncryption{
+						KeyName: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key",
+					},
+				},
+			},
+		},
+		"if a Vault transit key is configured, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: alwaysRotate,
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					VaultTransit: &internalcmapi.VaultTransitKeyEncryption{
+						Server:  "https://vault.example.com",
+						Mount:   "transit",
+						KeyName: "my-key",
+						Auth:    internalcmapi.VaultAuth{TokenSecretRef: &tokenRef},
+					},
+				},
+			},
+		},
+		"if keys are missing their required fields, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: alwaysRotate,
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					AWSKMS:       &internalcmapi.AWSKMSKeyEncryption{},
+					GCPKMS:       &internalcmapi.GCPKMSKeyEncryption{KeyName: "my-key"},
+					VaultTransit: &internalcmapi.VaultTransitKeyEncryption{Server: "https://vault.example.com"},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("privateKeyEncryption", "awsKMS", "region"), "region is a required field"),
+				field.Required(fldPath.Child("privateKeyEncryption", "awsKMS", "keyId"), "key ID is a required field"),
+				field.Invalid(fldPath.Child("privateKeyEncryption", "gcpKMS", "keyName"), "my-key", "must be of the form projects/*/locations/*/keyRings/*/cryptoKeys/*"),
+				field.Required(fldPath.Child("privateKeyEncryption", "vaultTransit", "mount"), ""),
+				field.Required(fldPath.Child("privateKeyEncryption", "vaultTransit", "keyName"), ""),
+				field.Invalid(fldPath.Child("privateKeyEncryption"), "", "exactly one of awsKMS, gcpKMS or vaultTransit must be specified"),
+			},
+		},
+		"if private keys are reused or stored in other formats, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					AWSKMS: &internalcmapi.AWSKMSKeyEncryption{Region: "eu-west-1", KeyID: "alias/cert-manager"},
+				},
+				Keystores: &internalcmapi.CertificateKeystores{
+					PKCS12: &internalcmapi.PKCS12Keystore{Create: true},
+				},
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatDER},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("privateKey", "rotationPolicy"), internalcmapi.PrivateKeyRotationPolicy(""), "must be Always when privateKeyEncryption is set"),
+				field.Forbidden(fldPath.Child("keystores"), "keystores cannot be created when privateKeyEncryption is set"),
+				field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when privateKeyEncryption is set"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validatePrivateKeyEncryption(test.spec, fldPath)
+			if len(test.expErr) == 0 {
+				assert.Empty(t, gotErr)
+				return
+			}
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
+func Test_validatePrivateKeyBackend(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	tests := map[string]struct {
+		spec   *internalcmapi.CertificateSpec
+		expErr field.ErrorList
+	}{
+		"if an AWS KMS key is configured, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: &internalcmapi.CertificatePrivateKey{
+					RotationPolicy: internalcmapi.RotationPolicyNever,
+					Backend: &internalcmapi.PrivateKeyBackend{
+						AWSKMS: &internalcmapi.AWSKMSPrivateKeyBackend{
+							Region: "eu-west-1",
+							KeyID:  "alias/cert-manager",
+							Role:   "arn:aws:iam::123456789012:role/cert-manager",
+						},
+					},
+				},
+			},
+		},
+		"if a GCP KMS key version is configured, expect no error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: &internalcmapi.CertificatePrivateKey{
+					Algorithm: internalcmapi.ECDSAKeyAlgorithm,
+					Backend: &internalcmapi.PrivateKeyBackend{
+						GCPKMS: &internalcmapi.GCPKMSPrivateKeyBackend{
+							KeyVersionName: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key/cryptoKeyVersions/1",
+						},
+					},
+				},
+			},
+		},
+		"if keys are missing their required fields, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: &internalcmapi.CertificatePrivateKey{
+					Backend: &internalcmapi.PrivateKeyBackend{
+						AWSKMS: &internalcmapi.AWSKMSPrivateKeyBackend{Role: "my-role"},
+						GCPKMS: &internalcmapi.GCPKMSPrivateKeyBackend{
+							KeyVersionName: "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key",
+						},
+					},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Required(fldPath.Child("privateKey", "backend", "awsKMS", "region"), "region is a required field"),
+				field.Required(fldPath.Child("privateKey", "backend", "awsKMS", "keyId"), "key ID is a required field"),
+				field.Invalid(fldPath.Child("privateKey", "backend", "awsKMS", "role"), "my-role", "arn: invalid prefix"),
+				field.Invalid(fldPath.Child("privateKey", "backend", "gcpKMS", "keyVersionName"), "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key", "must be of the form projects/*/locations/*/keyRings/*/cryptoKeys/*/cryptoKeyVersions/*"),
+				field.Invalid(fldPath.Child("privateKey", "backend"), "", "exactly one of awsKMS or gcpKMS must be specified"),
+			},
+		},
+		"if private keys are rotated, encrypted or stored in other formats, expect error": {
+			spec: &internalcmapi.CertificateSpec{
+				PrivateKey: &internalcmapi.CertificatePrivateKey{
+					Algorithm:      internalcmapi.Ed25519KeyAlgorithm,
+					RotationPolicy: internalcmapi.RotationPolicyAlways,
+					Backend: &internalcmapi.PrivateKeyBackend{
+						AWSKMS: &internalcmapi.AWSKMSPrivateKeyBackend{Region: "eu-west-1", KeyID: "alias/cert-manager"},
+					},
+				},
+				PrivateKeyEncryption: &internalcmapi.CertificatePrivateKeyEncryption{
+					AWSKMS: &internalcmapi.AWSKMSKeyEncryption{Region: "eu-west-1", KeyID: "alias/cert-manager"},
+				},
+				Keystores: &internalcmapi.CertificateKeystores{
+					JKS: &internalcmapi.JKSKeystore{Create: true},
+				},
+				AdditionalOutputFormats: []internalcmapi.CertificateAdditionalOutputFormat{
+					{Type: internalcmapi.AdditionalCertificateOutputFormatCombinedPEM},
+				},
+			},
+			expErr: field.ErrorList{
+				field.Invalid(fldPath.Child("privateKey", "rotationPolicy"), internalcmapi.RotationPolicyAlways, "must be Never when backend is set"),
+				field.Forbidden(fldPath.Child("privateKey", "algorithm"), "ed25519 private keys cannot be held by a backend"),
+				field.Forbidden(fldPath.Child("privateKeyEncryption"), "private keys held by a backend cannot be encrypted"),
+				field.Forbidden(fldPath.Child("keystores"), "keystores cannot be created when privateKey.backend is set"),
+				field.Forbidden(fldPath.Child("additionalOutputFormats"), "additional output formats cannot be used when privateKey.backend is set"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotErr := validatePrivateKeyBackend(test.spec, fldPath)
+			if len(test.expErr) == 0 {
+				assert.Empty(t, gotErr)
+				return
+			}
+			assert.Equal(t, test.expErr, gotErr)
+		})
+	}
+}
+
 func Test_validateLiteralSubject(t *testing.T) {
 	fldPath := field.NewPath("spec")
 	tests := map[string]struct {
@@ -955,7 +1761,7 @@ func Test_validateLiteralSubject(t *testing.T) {
 			},
 			a: someAdmissionRequest,
 			errs: []*field.Error{
-				field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, or emailAddresses must be set"),
+				field.Invalid(fldPath, "", "at least one of commonName, dnsNames, uris ipAddresses, emailAddresses, or otherNames must be set"),
 			},
 		},
 		"invalid with a `literalSubject` and any `Subject` other than serialNumber": {
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	metavalidation "k8s.io/apimachinery/pkg/apis/meta/v1/validation"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager CertificateProtectionPolicy types.
+
+func ValidateCertificateProtectionPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*cmapi.CertificateProtectionPolicy)
+	return ValidateCertificateProtectionPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateProtectionPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*cmapi.CertificateProtectionPolicy)
+	return ValidateCertificateProtectionPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateCertificateProtectionPolicySpec(spec *cmapi.CertificateProtectionPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	for i, r := range spec.Resources {
+		switch r {
+		case cmapi.ProtectedResourceCertificate, cmapi.ProtectedResourceSecret:
+		default:
+			el = append(el, field.NotSupported(fldPath.Child("resources").Index(i), r,
+				[]string{string(cmapi.ProtectedResourceCertificate), string(cmapi.ProtectedResourceSecret)}))
+		}
+	}
+
+	// A policy without a selector or any DNS names would protect every
+	// resource in the selected namespaces, which is almost certainly a
+	// mistake. An empty selector can be used if this really is intended.
+	if spec.Selector == nil && len(spec.DNSNames) == 0 {
+		el = append(el, field.Required(fldPath, "at least one of selector or dnsNames must be set"))
+	}
+
+	if spec.NamespaceSelector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.NamespaceSelector, fldPath.Child("namespaceSelector"))...)
+	}
+	if spec.Selector != nil {
+		el = append(el, metavalidation.ValidateLabelSelector(spec.Selector, fldPath.Child("selector"))...)
+	}
+
+	for i, name := range spec.DNSNames {
+		if name == "" {
+			el = append(el, field.Invalid(fldPath.Child("dnsNames").Index(i), name, "must not be empty"))
+		}
+	}
+
+	return el
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateProtectionPolicy(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	scenarios := map[string]struct {
+		spec      cmapi.CertificateProtectionPolicySpec
+		expectedE field.ErrorList
+	}{
+		"valid policy protecting wildcard certificates": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				DNSNames: []string{"*.example.com"},
+			},
+		},
+		"valid policy protecting labelled secrets in selected namespaces": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				Resources:         []cmapi.ProtectedResourceKind{cmapi.ProtectedResourceSecret},
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+				Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "critical"}},
+			},
+		},
+		"an empty selector protects every resource": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				Selector: &metav1.LabelSelector{},
+			},
+		},
+		"a selector or dnsNames must be set": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				Resources: []cmapi.ProtectedResourceKind{cmapi.ProtectedResourceCertificate},
+			},
+			expectedE: field.ErrorList{
+				field.Required(fldPath, "at least one of selector or dnsNames must be set"),
+			},
+		},
+		"unsupported resources and empty dnsNames are rejected": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				Resources: []cmapi.ProtectedResourceKind{"ConfigMap"},
+				DNSNames:  []string{"example.com", ""},
+			},
+			expectedE: field.ErrorList{
+				field.NotSupported(fldPath.Child("resources").Index(0), cmapi.ProtectedResourceKind("ConfigMap"), []string{"Certificate", "Secret"}),
+				field.Invalid(fldPath.Child("dnsNames").Index(1), "", "must not be empty"),
+			},
+		},
+		"invalid selectors are rejected": {
+			spec: cmapi.CertificateProtectionPolicySpec{
+				Selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "tier", Operator: metav1.LabelSelectorOpIn},
+				}},
+			},
+			expectedE: field.ErrorList{
+				field.Required(fldPath.Child("selector", "matchExpressions").Index(0).Child("values"), "must be specified when `operator` is 'In' or 'NotIn'"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			gotE, gotW := ValidateCertificateProtectionPolicy(nil, &cmapi.CertificateProtectionPolicy{Spec: s.spec})
+			if len(gotW) != 0 {
+				t.Errorf("Expected no warnings but got %v", gotW)
+			}
+			if len(gotE) != len(s.expectedE) {
+				t.Fatalf("Expected errors %v but got %v", s.expectedE, gotE)
+			}
+			for i, e := range gotE {
+				expectedErr := s.expectedE[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
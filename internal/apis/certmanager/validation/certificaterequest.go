@@ -43,6 +43,8 @@ func ValidateCertificateRequest(a *admissionv1.AdmissionRequest, obj runtime.Obj
 	allErrs := ValidateCertificateRequestSpec(&cr.Spec, field.NewPath("spec"), true)
 	allErrs = append(allErrs,
 		ValidateCertificateRequestApprovalCondition(cr.Status.Conditions, field.NewPath("status", "conditions"))...)
+	allErrs = append(allErrs,
+		validateCertificateRequestRevokeAnnotation(nil, cr, field.NewPath("metadata", "annotations"))...)
 
 	return allErrs, nil
 }
@@ -59,6 +61,7 @@ func ValidateUpdateCertificateRequest(a *admissionv1.AdmissionRequest, oldObj, n
 	annotationField := field.NewPath("metadata", "annotations")
 	el = append(el, validateCertificateRequestAnnotations(oldCR, newCR, annotationField)...)
 	el = append(el, validateCertificateRequestAnnotations(newCR, oldCR, annotationField)...)
+	el = append(el, validateCertificateRequestRevokeAnnotation(oldCR, newCR, annotationField)...)
 	el = append(el,
 		ValidateUpdateCertificateRequestApprovalCondition(oldCR.Status.Conditions, newCR.Status.Conditions, field.NewPath("status", "conditions"))...)
 
@@ -72,6 +75,11 @@ func ValidateUpdateCertificateRequest(a *admissionv1.AdmissionRequest, oldObj, n
 func validateCertificateRequestAnnotations(objA, objB *cmapi.CertificateRequest, fieldPath *field.Path) field.ErrorList {
 	var el field.ErrorList
 	for k, v := range objA.Annotations {
+		// The revoke annotation may be added after creation and is
+		// validated separately.
+		if k == cmapi.CertificateRequestRevokeAnnotationKey {
+			continue
+		}
 		if strings.HasPrefix(k, certmanager.GroupName) ||
 			strings.HasPrefix(k, acme.GroupName) {
 			if vnew, ok := objB.Annotations[k]; !ok || v != vnew {
@@ -83,10 +91,36 @@ func validateCertificateRequestAnnotations(objA, objB *cmapi.CertificateRequest,
 	return el
 }
 
+// validateCertificateRequestRevokeAnnotation ensures that the revoke
+// annotation holds a known revocation reason, and that once set it is neither
+// changed nor removed. oldCR is nil on creation.
+func validateCertificateRequestRevokeAnnotation(oldCR, newCR *cmapi.CertificateRequest, fieldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+	fldPath := fieldPath.Child(cmapi.CertificateRequestRevokeAnnotationKey)
+
+	newReason, newOK := newCR.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]
+	if oldCR != nil {
+		if oldReason, oldOK := oldCR.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]; oldOK {
+			if !newOK || oldReason != newReason {
+				el = append(el, field.Forbidden(fldPath, "cannot change or remove the revoke annotation once it has been set"))
+			}
+			return el
+		}
+	}
+
+	if newOK && newReason != "" {
+		if _, ok := pki.RevocationReasons[newReason]; !ok {
+			el = append(el, field.NotSupported(fldPath, newReason, pki.RevocationReasonNames()))
+		}
+	}
+
+	return el
+}
+
 func ValidateCertificateRequestSpec(crSpec *cmapi.CertificateRequestSpec, fldPath *field.Path, validateCSRContent bool) field.ErrorList {
 	el := field.ErrorList{}
 
-	el = append(el, validateIssuerRef(crSpec.IssuerRef, fldPath)...)
+	el = append(el, validateIssuerRef(crSpec.IssuerRef, fldPath.Child("issuerRef"))...)
 
 	if len(crSpec.Request) == 0 {
 		el = append(el, field.Required(fldPath.Child("request"), "must be specified"))
@@ -117,6 +117,37 @@ func TestValidateCertificateRequestUpdate(t *testing.T) {
 			a:     someAdmissionRequest,
 			wantE: nil,
 		},
+		"if the revoke annotation is added with a known reason, don't error": {
+			oldCR: baseCR.DeepCopy(),
+			newCR: withAnnotation(baseCR, cminternal.CertificateRequestRevokeAnnotationKey, "keyCompromise"),
+			a:     someAdmissionRequest,
+			wantE: nil,
+		},
+		"if the revoke annotation is added with an unknown reason, error": {
+			oldCR: baseCR.DeepCopy(),
+			newCR: withAnnotation(baseCR, cminternal.CertificateRequestRevokeAnnotationKey, "certificateHold"),
+			a:     someAdmissionRequest,
+			// the bad value is filtered out of non-Forbidden errors below
+			wantE: []*field.Error{
+				field.NotSupported(field.NewPath("metadata", "annotations", cminternal.CertificateRequestRevokeAnnotationKey), nil, utilpki.RevocationReasonNames()),
+			},
+		},
+		"if the revoke annotation is changed, error": {
+			oldCR: withAnnotation(baseCR, cminternal.CertificateRequestRevokeAnnotationKey, ""),
+			newCR: withAnnotation(baseCR, cminternal.CertificateRequestRevokeAnnotationKey, "superseded"),
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Forbidden(field.NewPath("metadata", "annotations", cminternal.CertificateRequestRevokeAnnotationKey), "cannot change or remove the revoke annotation once it has been set"),
+			},
+		},
+		"if the revoke annotation is removed, error": {
+			oldCR: withAnnotation(baseCR, cminternal.CertificateRequestRevokeAnnotationKey, "superseded"),
+			newCR: baseCR.DeepCopy(),
+			a:     someAdmissionRequest,
+			wantE: []*field.Error{
+				field.Forbidden(field.NewPath("metadata", "annotations", cminternal.CertificateRequestRevokeAnnotationKey), "cannot change or remove the revoke annotation once it has been set"),
+			},
+		},
 		"CertificateRequest with single Approved=true condition that doesn't change, shouldn't error": {
 			oldCR: &cminternal.CertificateRequest{
 				Spec: cminternal.CertificateRequestSpec{
@@ -862,3 +893,9 @@ func Test_patchDuplicateKeyUsage(t *testing.T) {
 		})
 	}
 }
+
+func withAnnotation(cr *cminternal.CertificateRequest, key, value string) *cminternal.CertificateRequest {
+	cr = cr.DeepCopy()
+	cr.Annotations[key] = value
+	return cr
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	"github.com/cert-manager/cert-manager/internal/approvalpolicy"
+)
+
+// Validation functions for cert-manager CertificateRequestPolicy types.
+
+// maxRuleExpressionLength is the maximum length of the expression of a rule.
+const maxRuleExpressionLength = 4096
+
+func ValidateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*cmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCertificateRequestPolicy(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	policy := obj.(*cmapi.CertificateRequestPolicy)
+	return ValidateCertificateRequestPolicySpec(&policy.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateCertificateRequestPolicySpec(spec *cmapi.CertificateRequestPolicySpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.Rules) == 0 {
+		el = append(el, field.Required(fldPath.Child("rules"), "at least one rule must be set"))
+	}
+
+	names := sets.NewString()
+	for i, rule := range spec.Rules {
+		rulePath := fldPath.Child("rules").Index(i)
+
+		switch {
+		case rule.Name == "":
+			el = append(el, field.Required(rulePath.Child("name"), "must be set"))
+		case names.Has(rule.Name):
+			el = append(el, field.Duplicate(rulePath.Child("name"), rule.Name))
+		}
+		names.Insert(rule.Name)
+
+		switch {
+		case rule.Expression == "":
+			el = append(el, field.Required(rulePath.Child("expression"), "must be set"))
+		case len(rule.Expression) > maxRuleExpressionLength:
+			el = append(el, field.TooLong(rulePath.Child("expression"), rule.Expression, maxRuleExpressionLength))
+		default:
+			if _, err := approvalpolicy.Compile(rule.Expression); err != nil {
+				el = append(el, field.Invalid(rulePath.Child("expression"), rule.Expression, err.Error()))
+			}
+		}
+	}
+
+	return el
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCertificateRequestPolicy(t *testing.T) {
+	fldPath := field.NewPath("spec")
+	longExpression := "request.namespace == '" + strings.Repeat("a", 4096) + "'"
+
+	scenarios := map[string]struct {
+		spec      cmapi.CertificateRequestPolicySpec
+		expectedE field.ErrorList
+	}{
+		"valid policy": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Selector: cmapi.CertificateRequestPolicySelector{
+					IssuerRef: cmapi.CertificateRequestPolicyIssuerRef{Name: "ca-*", Kind: "ClusterIssuer"},
+				},
+				Rules: []cmapi.CertificateRequestPolicyRule{
+					{Name: "example-only", Expression: "csr.dnsNames.all(n, n.endsWith('.example.com'))"},
+					{Name: "team-a", Expression: "request.namespace == 'team-a'", Message: "only team-a may use this issuer"},
+				},
+			},
+		},
+		"at least one rule must be set": {
+			spec: cmapi.CertificateRequestPolicySpec{},
+			expectedE: field.ErrorList{
+				field.Required(fldPath.Child("rules"), "at least one rule must be set"),
+			},
+		},
+		"rule names must be set and unique": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Rules: []cmapi.CertificateRequestPolicyRule{
+					{Name: "ca", Expression: "!request.isCA"},
+					{Name: "ca", Expression: "!request.isCA"},
+					{Expression: "!request.isCA"},
+				},
+			},
+			expectedE: field.ErrorList{
+				field.Duplicate(fldPath.Child("rules").Index(1).Child("name"), "ca"),
+				field.Required(fldPath.Child("rules").Index(2).Child("name"), "must be set"),
+			},
+		},
+		"expressions must be set, not too long and compile": {
+			spec: cmapi.CertificateRequestPolicySpec{
+				Rules: []cmapi.CertificateRequestPolicyRule{
+					{Name: "empty"},
+					{Name: "long", Expression: longExpression},
+					{Name: "undeclared", Expression: "certificate.isCA"},
+				},
+			},
+			expectedE: field.ErrorList{
+				field.Required(fldPath.Child("rules").Index(0).Child("expression"), "must be set"),
+				field.TooLong(fldPath.Child("rules").Index(1).Child("expression"), longExpression, 4096),
+				field.Invalid(fldPath.Child("rules").Index(2).Child("expression"), "certificate.isCA", "ERROR: <input>:1:1: undeclared reference to 'certificate' (in container '')\n | certificate.isCA\n | ^"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			gotE, gotW := ValidateCertificateRequestPolicy(nil, &cmapi.CertificateRequestPolicy{Spec: s.spec})
+			if len(gotW) != 0 {
+				t.Errorf("Expected no warnings but got %v", gotW)
+			}
+			if len(gotE) != len(s.expectedE) {
+				t.Fatalf("Expected errors %v but got %v", s.expectedE, gotE)
+			}
+			for i, e := range gotE {
+				expectedErr := s.expectedE[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
@@ -32,7 +33,31 @@ func TestValidateClusterIssuer(t *testing.T) {
 		a         *admissionv1.AdmissionRequest
 		expectedE []*field.Error
 		expectedW []string
-	}{}
+	}{
+		"valid credentialsNamespace": {
+			cfg: &cmapi.ClusterIssuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						SelfSigned: &cmapi.SelfSignedIssuer{},
+					},
+					CredentialsNamespace: "team-a",
+				},
+			},
+		},
+		"invalid credentialsNamespace": {
+			cfg: &cmapi.ClusterIssuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						SelfSigned: &cmapi.SelfSignedIssuer{},
+					},
+					CredentialsNamespace: "Team_A",
+				},
+			},
+			expectedE: []*field.Error{
+				field.Invalid(field.NewPath("spec", "credentialsNamespace"), "Team_A", validation.IsDNS1123Label("Team_A")[0]),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+// Validation functions for cert-manager CredentialGrant types.
+
+func ValidateCredentialGrant(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
+	grant := obj.(*cmapi.CredentialGrant)
+	return ValidateCredentialGrantSpec(&grant.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateUpdateCredentialGrant(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
+	grant := obj.(*cmapi.CredentialGrant)
+	return ValidateCredentialGrantSpec(&grant.Spec, field.NewPath("spec")), nil
+}
+
+func ValidateCredentialGrantSpec(spec *cmapi.CredentialGrantSpec, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if len(spec.ClusterIssuers) == 0 {
+		el = append(el, field.Required(fldPath.Child("clusterIssuers"), "at least one ClusterIssuer must be named"))
+	}
+
+	for i, name := range spec.ClusterIssuers {
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			el = append(el, field.Invalid(fldPath.Child("clusterIssuers").Index(i), name, msg))
+		}
+	}
+
+	return el
+}
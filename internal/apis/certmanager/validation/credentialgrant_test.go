@@ -0,0 +1,74 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"reflect"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+)
+
+func TestValidateCredentialGrant(t *testing.T) {
+	fldPath := field.NewPath("spec")
+
+	scenarios := map[string]struct {
+		spec      cmapi.CredentialGrantSpec
+		expectedE field.ErrorList
+	}{
+		"valid grant naming two ClusterIssuers": {
+			spec: cmapi.CredentialGrantSpec{
+				ClusterIssuers: []string{"team-a-vault", "team-a.acme"},
+			},
+		},
+		"at least one ClusterIssuer must be named": {
+			spec: cmapi.CredentialGrantSpec{},
+			expectedE: field.ErrorList{
+				field.Required(fldPath.Child("clusterIssuers"), "at least one ClusterIssuer must be named"),
+			},
+		},
+		"invalid ClusterIssuer names are rejected": {
+			spec: cmapi.CredentialGrantSpec{
+				ClusterIssuers: []string{"team-a-vault", "Team_A"},
+			},
+			expectedE: field.ErrorList{
+				field.Invalid(fldPath.Child("clusterIssuers").Index(1), "Team_A", validation.IsDNS1123Subdomain("Team_A")[0]),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			gotE, gotW := ValidateCredentialGrant(nil, &cmapi.CredentialGrant{Spec: s.spec})
+			if len(gotW) != 0 {
+				t.Errorf("Expected no warnings but got %v", gotW)
+			}
+			if len(gotE) != len(s.expectedE) {
+				t.Fatalf("Expected errors %v but got %v", s.expectedE, gotE)
+			}
+			for i, e := range gotE {
+				expectedErr := s.expectedE[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected error %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
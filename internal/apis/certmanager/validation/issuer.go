@@ -19,17 +19,25 @@ package validation
 import (
 	"crypto/x509"
 	"fmt"
+	"net"
+	"net/url"
+	"regexp"
+	"strconv"
 	"strings"
+	"time"
 
+	"github.com/aws/aws-sdk-go/aws/arn"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	cmacme "github.com/cert-manager/cert-manager/internal/apis/acme"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
 	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 // Validation functions for cert-manager Issuer types.
@@ -37,18 +45,71 @@ import (
 func ValidateIssuer(a *admissionv1.AdmissionRequest, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerCredentialsNamespace(&iss.Spec, field.NewPath("spec"))...)
 	return allErrs, warnings
 }
 
 func ValidateUpdateIssuer(a *admissionv1.AdmissionRequest, oldObj, obj runtime.Object) (field.ErrorList, []string) {
 	iss := obj.(*certmanager.Issuer)
 	allErrs, warnings := ValidateIssuerSpec(&iss.Spec, field.NewPath("spec"))
+	allErrs = append(allErrs, validateIssuerCredentialsNamespace(&iss.Spec, field.NewPath("spec"))...)
 	// Admission request should never be nil
 	return allErrs, warnings
 }
 
 func ValidateIssuerSpec(iss *certmanager.IssuerSpec, fldPath *field.Path) (field.ErrorList, []string) {
-	return ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	el, warnings := ValidateIssuerConfig(&iss.IssuerConfig, fldPath)
+	if iss.IssuanceLatencyBudget != nil {
+		el = append(el, ValidateIssuanceLatencyBudget(iss.IssuanceLatencyBudget, fldPath.Child("issuanceLatencyBudget"))...)
+	}
+	if iss.RateLimit != nil {
+		el = append(el, ValidateIssuanceRateLimit(iss.RateLimit, fldPath.Child("rateLimit"))...)
+	}
+	if iss.CredentialsNamespace != "" {
+		for _, msg := range validation.IsDNS1123Label(iss.CredentialsNamespace) {
+			el = append(el, field.Invalid(fldPath.Child("credentialsNamespace"), iss.CredentialsNamespace, msg))
+		}
+	}
+	return el, warnings
+}
+
+// validateIssuerCredentialsNamespace forbids credentialsNamespace on
+// namespaced Issuers, which always read Secrets from their own namespace.
+func validateIssuerCredentialsNamespace(iss *certmanager.IssuerSpec, fldPath *field.Path) field.ErrorList {
+	if iss.CredentialsNamespace == "" {
+		return nil
+	}
+	return field.ErrorList{field.Forbidden(fldPath.Child("credentialsNamespace"), "credentialsNamespace may only be set on ClusterIssuers")}
+}
+
+func ValidateIssuanceLatencyBudget(budget *certmanager.IssuanceLatencyBudget, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if budget.Average == nil && budget.P95 == nil {
+		el = append(el, field.Required(fldPath, "at least one of average or p95 must be set"))
+	}
+	if budget.Average != nil && budget.Average.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("average"), budget.Average.Duration, "must be greater than zero"))
+	}
+	if budget.P95 != nil && budget.P95.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("p95"), budget.P95.Duration, "must be greater than zero"))
+	}
+	if budget.Samples < 0 {
+		el = append(el, field.Invalid(fldPath.Child("samples"), budget.Samples, "must not be negative"))
+	}
+	return el
+}
+
+func ValidateIssuanceRateLimit(limit *certmanager.IssuanceRateLimit, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if limit.MaxRequests < 1 {
+		el = append(el, field.Invalid(fldPath.Child("maxRequests"), limit.MaxRequests, "must not be less than 1"))
+	}
+	if limit.Period == nil {
+		el = append(el, field.Required(fldPath.Child("period"), ""))
+	} else if limit.Period.Duration <= 0 {
+		el = append(el, field.Invalid(fldPath.Child("period"), limit.Period.Duration, "must be greater than zero"))
+	}
+	return el
 }
 
 func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (field.ErrorList, []string) {
@@ -96,6 +157,70 @@ func ValidateIssuerConfig(iss *certmanager.IssuerConfig, fldPath *field.Path) (f
 			el = append(el, ValidateVenafiIssuerConfig(iss.Venafi, fldPath.Child("venafi"))...)
 		}
 	}
+	if iss.AWSPCA != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("awspca"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateAWSPCAIssuerConfig(iss.AWSPCA, fldPath.Child("awspca"))...)
+		}
+	}
+	if iss.GoogleCAS != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("googleCAS"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateGoogleCASIssuerConfig(iss.GoogleCAS, fldPath.Child("googleCAS"))...)
+		}
+	}
+	if iss.AzureKeyVault != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("azureKeyVault"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateAzureKeyVaultIssuerConfig(iss.AzureKeyVault, fldPath.Child("azureKeyVault"))...)
+		}
+	}
+	if iss.EJBCA != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("ejbca"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateEJBCAIssuerConfig(iss.EJBCA, fldPath.Child("ejbca"))...)
+		}
+	}
+	if iss.StepCA != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("stepCA"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateStepCAIssuerConfig(iss.StepCA, fldPath.Child("stepCA"))...)
+		}
+	}
+	if iss.KubernetesCSR != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("kubernetesCSR"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateKubernetesCSRIssuerConfig(iss.KubernetesCSR, fldPath.Child("kubernetesCSR"))...)
+		}
+	}
+	if iss.SPIRE != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("spire"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidateSPIREIssuerConfig(iss.SPIRE, fldPath.Child("spire"))...)
+		}
+	}
+	if iss.Plugin != nil {
+		if numConfigs > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("plugin"), "may not specify more than one issuer type"))
+		} else {
+			numConfigs++
+			el = append(el, ValidatePluginIssuerConfig(iss.Plugin, fldPath.Child("plugin"))...)
+		}
+	}
 	if numConfigs == 0 {
 		el = append(el, field.Required(fldPath, "at least one issuer must be configured"))
 	}
@@ -113,6 +238,14 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		el = append(el, field.Required(fldPath.Child("server"), "acme server URL is a required field"))
 	}
 
+	el = append(el, ValidateACMEURLRewrites(iss.URLRewrites, fldPath.Child("urlRewrites"))...)
+
+	if len(iss.PreferredChain) > 0 {
+		if _, err := pki.ParseChainSelector(iss.PreferredChain); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("preferredChain"), iss.PreferredChain, err.Error()))
+		}
+	}
+
 	if eab := iss.ExternalAccountBinding; eab != nil {
 		eabFldPath := fldPath.Child("externalAccountBinding")
 		if len(eab.KeyID) == 0 {
@@ -126,13 +259,91 @@ func ValidateACMEIssuerConfig(iss *cmacme.ACMEIssuer, fldPath *field.Path) (fiel
 		}
 	}
 
+	el = append(el, ValidateACMEAdditionalAccounts(iss, fldPath.Child("additionalAccounts"))...)
+
+	usesIngressClass := false
 	for i, sol := range iss.Solvers {
 		el = append(el, ValidateACMEIssuerChallengeSolverConfig(&sol, fldPath.Child("solvers").Index(i))...)
+		if sol.HTTP01 != nil && sol.HTTP01.Ingress != nil && sol.HTTP01.Ingress.Class != nil {
+			usesIngressClass = true
+		}
+	}
+	if usesIngressClass {
+		warnings = append(warnings, deprecatedACMEHTTP01IngressClassField)
 	}
 
 	return el, warnings
 }
 
+// ValidateACMEAdditionalAccounts validates the additional accounts of an ACME
+// issuer. Each account must have a unique email, as this is used to select
+// the account, and must store its private key in its own Secret.
+func ValidateACMEAdditionalAccounts(iss *cmacme.ACMEIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	emails := make(map[string]bool)
+	secretNames := map[string]bool{iss.PrivateKey.Name: true}
+	for i, acc := range iss.AdditionalAccounts {
+		accPath := fldPath.Index(i)
+
+		email := strings.ToLower(acc.Email)
+		switch {
+		case len(email) == 0:
+			el = append(el, field.Required(accPath.Child("email"), "email is a required field"))
+		case emails[email]:
+			el = append(el, field.Duplicate(accPath.Child("email"), acc.Email))
+		}
+		emails[email] = true
+
+		name := acc.PrivateKey.Name
+		switch {
+		case len(name) == 0:
+			el = append(el, field.Required(accPath.Child("privateKeySecretRef", "name"), "private key secret name is a required field"))
+		case secretNames[name]:
+			el = append(el, field.Invalid(accPath.Child("privateKeySecretRef", "name"), name, "private key secret must not be shared with another ACME account"))
+		}
+		secretNames[name] = true
+	}
+
+	return el
+}
+
+// ValidateACMEURLRewrites validates the URL rewrites of an ACME issuer. Both
+// the prefix being replaced and its replacement must be absolute http or
+// https URLs, and each prefix may only be rewritten once.
+func ValidateACMEURLRewrites(rewrites []cmacme.ACMEURLRewrite, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	froms := make(map[string]bool)
+	for i, rewrite := range rewrites {
+		rewritePath := fldPath.Index(i)
+
+		el = append(el, validateACMEURLRewriteURL(rewrite.From, rewritePath.Child("from"))...)
+		if froms[rewrite.From] {
+			el = append(el, field.Duplicate(rewritePath.Child("from"), rewrite.From))
+		}
+		froms[rewrite.From] = true
+
+		el = append(el, validateACMEURLRewriteURL(rewrite.To, rewritePath.Child("to"))...)
+	}
+
+	return el
+}
+
+func validateACMEURLRewriteURL(rawURL string, fldPath *field.Path) field.ErrorList {
+	if len(rawURL) == 0 {
+		return field.ErrorList{field.Required(fldPath, "URL is a required field")}
+	}
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return field.ErrorList{field.Invalid(fldPath, rawURL, err.Error())}
+	}
+	if (u.Scheme != "http" && u.Scheme != "https") || len(u.Host) == 0 {
+		return field.ErrorList{field.Invalid(fldPath, rawURL, "must be an absolute http or https URL")}
+	}
+	return nil
+}
+
 func ValidateACMEIssuerChallengeSolverConfig(sol *cmacme.ACMEChallengeSolver, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 
@@ -168,6 +379,10 @@ func ValidateACMEIssuerChallengeSolverHTTP01Config(http01 *cmacme.ACMEChallengeS
 		numDefined++
 		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(http01.GatewayHTTPRoute, fldPath.Child("gateway"))...)
 	}
+	if http01.IstioVirtualService != nil {
+		numDefined++
+		el = append(el, ValidateACMEIssuerChallengeSolverHTTP01IstioVirtualServiceConfig(http01.IstioVirtualService, fldPath.Child("istioVirtualService"))...)
+	}
 	if numDefined == 0 {
 		el = append(el, field.Required(fldPath, "no HTTP01 solver type configured"))
 	}
@@ -184,6 +399,9 @@ func ValidateACMEIssuerChallengeSolverHTTP01IngressConfig(ingress *cmacme.ACMECh
 	if ingress.Class != nil && len(ingress.Name) > 0 {
 		el = append(el, field.Forbidden(fldPath, "only one of 'name' or 'class' should be specified"))
 	}
+	if ingress.IngressClassName != nil && (ingress.Class != nil || len(ingress.Name) > 0) {
+		el = append(el, field.Forbidden(fldPath, "only one of 'name', 'class' or 'ingressClassName' should be specified"))
+	}
 	switch ingress.ServiceType {
 	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort:
 	default:
@@ -207,6 +425,33 @@ func ValidateACMEIssuerChallengeSolverHTTP01GatewayConfig(gateway *cmacme.ACMECh
 	return el
 }
 
+func ValidateACMEIssuerChallengeSolverHTTP01IstioVirtualServiceConfig(vs *cmacme.ACMEChallengeSolverHTTP01IstioVirtualService, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch vs.ServiceType {
+	case "", corev1.ServiceTypeClusterIP, corev1.ServiceTypeNodePort:
+	default:
+		el = append(el, field.Invalid(fldPath.Child("serviceType"), vs.ServiceType, `must be empty, "ClusterIP" or "NodePort"`))
+	}
+	if len(vs.Gateways) == 0 {
+		el = append(el, field.Required(fldPath.Child("gateways"), "at least 1 gateway is required"))
+	}
+	for i, gateway := range vs.Gateways {
+		name := gateway
+		if parts := strings.SplitN(gateway, "/", 2); len(parts) == 2 {
+			name = parts[1]
+			for _, msg := range validation.IsDNS1123Label(parts[0]) {
+				el = append(el, field.Invalid(fldPath.Child("gateways").Index(i), gateway, "invalid namespace: "+msg))
+			}
+		}
+		for _, msg := range validation.IsDNS1123Subdomain(name) {
+			el = append(el, field.Invalid(fldPath.Child("gateways").Index(i), gateway, "invalid name: "+msg))
+		}
+	}
+
+	return el
+}
+
 func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if len(iss.SecretName) == 0 {
@@ -217,6 +462,38 @@ func ValidateCAIssuerConfig(iss *certmanager.CAIssuer, fldPath *field.Path) fiel
 			el = append(el, field.Invalid(fldPath.Child("ocspServer").Index(i), ocspURL, "must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org"))
 		}
 	}
+	if iss.CRL != nil {
+		el = append(el, ValidateCACRLConfig(iss.CRL, fldPath.Child("crl"))...)
+	}
+	if iss.OCSP != nil {
+		el = append(el, ValidateCAOCSPConfig(iss.OCSP, fldPath.Child("ocsp"))...)
+	}
+	return el
+}
+
+func ValidateCACRLConfig(crl *certmanager.CACRL, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(crl.SecretName) == 0 && len(crl.ConfigMapName) == 0 {
+		el = append(el, field.Required(fldPath, "at least one of secretName or configMapName must be set"))
+	}
+	if crl.Validity != nil && crl.Validity.Duration < time.Hour {
+		el = append(el, field.Invalid(fldPath.Child("validity"), crl.Validity.Duration, "must be at least 1h"))
+	}
+	return el
+}
+
+func ValidateCAOCSPConfig(ocsp *certmanager.CAOCSP, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(ocsp.ResponderSecretName) == 0 {
+		el = append(el, field.Required(fldPath.Child("responderSecretName"), ""))
+	} else {
+		for _, msg := range validation.IsDNS1123Subdomain(ocsp.ResponderSecretName) {
+			el = append(el, field.Invalid(fldPath.Child("responderSecretName"), ocsp.ResponderSecretName, msg))
+		}
+	}
+	if ocsp.ResponseValidity != nil && ocsp.ResponseValidity.Duration < time.Minute {
+		el = append(el, field.Invalid(fldPath.Child("responseValidity"), ocsp.ResponseValidity.Duration, "must be at least 1m"))
+	}
 	return el
 }
 
@@ -243,6 +520,16 @@ func ValidateVaultIssuerConfig(iss *certmanager.VaultIssuer, fldPath *field.Path
 		}
 	}
 
+	if certAuth := iss.Auth.ClientCertificate; certAuth != nil {
+		certAuthPath := fldPath.Child("auth", "clientCertificate")
+		if len(certAuth.SecretName) == 0 {
+			el = append(el, field.Required(certAuthPath.Child("secretName"), ""))
+		}
+		if len(iss.Server) > 0 && !strings.HasPrefix(iss.Server, "https://") {
+			el = append(el, field.Invalid(fldPath.Child("server"), iss.Server, "client certificate authentication requires an https server"))
+		}
+	}
+
 	return el
 	// TODO: add validation for Vault authentication types
 }
@@ -282,6 +569,268 @@ func ValidateVenafiIssuerConfig(iss *certmanager.VenafiIssuer, fldPath *field.Pa
 	return el
 }
 
+// ValidateAWSPCAIssuerConfig validates the configuration of an AWS Private CA
+// issuer. The ARN must be the ARN of an ACM PCA certificate authority, and
+// the optional template and role ARNs must be valid ARNs.
+func ValidateAWSPCAIssuerConfig(iss *certmanager.AWSPCAIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.Arn == "" {
+		el = append(el, field.Required(fldPath.Child("arn"), "certificate authority ARN is a required field"))
+	} else if a, err := arn.Parse(iss.Arn); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("arn"), iss.Arn, err.Error()))
+	} else if a.Service != "acm-pca" || !strings.HasPrefix(a.Resource, "certificate-authority/") {
+		el = append(el, field.Invalid(fldPath.Child("arn"), iss.Arn, "must be the ARN of an ACM Private CA certificate authority"))
+	}
+
+	if iss.TemplateArn != "" {
+		if _, err := arn.Parse(iss.TemplateArn); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("templateArn"), iss.TemplateArn, err.Error()))
+		}
+	}
+	if iss.Role != "" {
+		if _, err := arn.Parse(iss.Role); err != nil {
+			el = append(el, field.Invalid(fldPath.Child("role"), iss.Role, err.Error()))
+		}
+	}
+
+	if iss.Auth != nil {
+		el = append(el, ValidateSecretKeySelector(&iss.Auth.AccessKeyIDSecretRef, fldPath.Child("auth", "accessKeyIDSecretRef"))...)
+		el = append(el, ValidateSecretKeySelector(&iss.Auth.SecretAccessKeySecretRef, fldPath.Child("auth", "secretAccessKeySecretRef"))...)
+	}
+
+	return el
+}
+
+// ValidateGoogleCASIssuerConfig validates the configuration of a Google CA
+// Service issuer. The project, location and CA pool are required, and a
+// service account key Secret, if given, must name a key.
+func ValidateGoogleCASIssuerConfig(iss *certmanager.GoogleCASIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.Project == "" {
+		el = append(el, field.Required(fldPath.Child("project"), "project is a required field"))
+	}
+	if iss.Location == "" {
+		el = append(el, field.Required(fldPath.Child("location"), "location is a required field"))
+	}
+	if iss.CAPoolID == "" {
+		el = append(el, field.Required(fldPath.Child("caPoolId"), "CA pool ID is a required field"))
+	}
+
+	if iss.CertificateTemplate != "" && !googleCASTemplateRegexp.MatchString(iss.CertificateTemplate) {
+		el = append(el, field.Invalid(fldPath.Child("certificateTemplate"), iss.CertificateTemplate,
+			"must be the full resource name of a certificate template, i.e. projects/<project>/locations/<location>/certificateTemplates/<name>"))
+	}
+
+	if iss.ServiceAccountKeySecretRef != nil {
+		el = append(el, ValidateSecretKeySelector(iss.ServiceAccountKeySecretRef, fldPath.Child("serviceAccountKeySecretRef"))...)
+	}
+
+	return el
+}
+
+var googleCASTemplateRegexp = regexp.MustCompile(`^projects/[^/]+/locations/[^/]+/certificateTemplates/[^/]+$`)
+
+var supportedAzureEnvironments = []string{"AzurePublicCloud", "AzureChinaCloud", "AzureGermanCloud", "AzureUSGovernmentCloud"}
+
+// ValidateAzureKeyVaultIssuerConfig validates the configuration of an Azure
+// Key Vault issuer. Workload identity federation requires the tenant and
+// client ID of the application the ServiceAccount is federated with.
+func ValidateAzureKeyVaultIssuerConfig(iss *certmanager.AzureKeyVaultIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.VaultURL == "" {
+		el = append(el, field.Required(fldPath.Child("vaultURL"), "vault URL is a required field"))
+	} else if u, err := url.Parse(iss.VaultURL); err != nil || u.Scheme != "https" || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("vaultURL"), iss.VaultURL, "must be an https URL"))
+	}
+	if iss.CertificateName == "" {
+		el = append(el, field.Required(fldPath.Child("certificateName"), "certificate name is a required field"))
+	}
+
+	if len(iss.Environment) > 0 {
+		present := false
+		for _, env := range supportedAzureEnvironments {
+			if env == iss.Environment {
+				present = true
+			}
+		}
+		if !present {
+			el = append(el, field.NotSupported(fldPath.Child("environment"), iss.Environment, supportedAzureEnvironments))
+		}
+	}
+
+	if iss.ServiceAccountRef != nil {
+		if iss.ServiceAccountRef.Name == "" {
+			el = append(el, field.Required(fldPath.Child("serviceAccountRef", "name"), "service account name is required"))
+		}
+		if iss.TenantID == "" {
+			el = append(el, field.Required(fldPath.Child("tenantID"), "tenant ID is required when using workload identity federation"))
+		}
+		if iss.ClientID == "" {
+			el = append(el, field.Required(fldPath.Child("clientID"), "client ID is required when using workload identity federation"))
+		}
+	}
+
+	return el
+}
+
+// ValidateEJBCAIssuerConfig validates the configuration of an EJBCA issuer.
+// The REST API URL, CA and profiles are required, and exactly one
+// authentication method must be configured.
+func ValidateEJBCAIssuerConfig(iss *certmanager.EJBCAIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.URL == "" {
+		el = append(el, field.Required(fldPath.Child("url"), "URL is a required field"))
+	} else if u, err := url.Parse(iss.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, "must be an https URL"))
+	}
+	if iss.CertificateAuthorityName == "" {
+		el = append(el, field.Required(fldPath.Child("certificateAuthorityName"), "certificate authority name is a required field"))
+	}
+	if iss.CertificateProfileName == "" {
+		el = append(el, field.Required(fldPath.Child("certificateProfileName"), "certificate profile name is a required field"))
+	}
+	if iss.EndEntityProfileName == "" {
+		el = append(el, field.Required(fldPath.Child("endEntityProfileName"), "end entity profile name is a required field"))
+	}
+
+	authPath := fldPath.Child("auth")
+	switch {
+	case iss.Auth.ClientCertSecretRef != nil && iss.Auth.TokenSecretRef != nil:
+		el = append(el, field.Forbidden(authPath, "please supply one of: clientCertSecretRef, tokenSecretRef"))
+	case iss.Auth.ClientCertSecretRef != nil:
+		if iss.Auth.ClientCertSecretRef.Name == "" {
+			el = append(el, field.Required(authPath.Child("clientCertSecretRef", "name"), "secret name is required"))
+		}
+	case iss.Auth.TokenSecretRef != nil:
+		el = append(el, ValidateSecretKeySelector(iss.Auth.TokenSecretRef, authPath.Child("tokenSecretRef"))...)
+	default:
+		el = append(el, field.Required(authPath, "please supply one of: clientCertSecretRef, tokenSecretRef"))
+	}
+
+	return el
+}
+
+// ValidateStepCAIssuerConfig validates the configuration of a step-ca issuer.
+// The URL and provisioner name are required, and exactly one of the JWK or
+// X5C provisioner types must be configured.
+func ValidateStepCAIssuerConfig(iss *certmanager.StepCAIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.URL == "" {
+		el = append(el, field.Required(fldPath.Child("url"), "URL is a required field"))
+	} else if u, err := url.Parse(iss.URL); err != nil || u.Scheme != "https" || u.Host == "" {
+		el = append(el, field.Invalid(fldPath.Child("url"), iss.URL, "must be an https URL"))
+	}
+
+	provPath := fldPath.Child("provisioner")
+	if iss.Provisioner.Name == "" {
+		el = append(el, field.Required(provPath.Child("name"), "provisioner name is a required field"))
+	}
+	switch {
+	case iss.Provisioner.JWK != nil && iss.Provisioner.X5C != nil:
+		el = append(el, field.Forbidden(provPath, "please supply one of: jwk, x5c"))
+	case iss.Provisioner.JWK != nil:
+		el = append(el, ValidateSecretKeySelector(&iss.Provisioner.JWK.PasswordSecretRef, provPath.Child("jwk", "passwordSecretRef"))...)
+	case iss.Provisioner.X5C != nil:
+		if iss.Provisioner.X5C.SecretRef.Name == "" {
+			el = append(el, field.Required(provPath.Child("x5c", "secretRef", "name"), "secret name is required"))
+		}
+	default:
+		el = append(el, field.Required(provPath, "please supply one of: jwk, x5c"))
+	}
+
+	return el
+}
+
+// ValidateKubernetesCSRIssuerConfig validates the configuration of a
+// Kubernetes CertificateSigningRequest issuer. The signer name must be
+// qualified with a domain, and must not be the signer of a cert-manager Issuer
+// or ClusterIssuer since requests would be forwarded back to cert-manager.
+func ValidateKubernetesCSRIssuerConfig(iss *certmanager.KubernetesCSRIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.SignerName == "" {
+		el = append(el, field.Required(fldPath.Child("signerName"), "signer name is a required field"))
+		return el
+	}
+
+	domain, path, ok := strings.Cut(iss.SignerName, "/")
+	switch {
+	case !ok || domain == "" || path == "" || !strings.Contains(domain, "."):
+		el = append(el, field.Invalid(fldPath.Child("signerName"), iss.SignerName, "must be of the form <domain>/<path>"))
+	case domain == "issuers.cert-manager.io" || domain == "clusterissuers.cert-manager.io":
+		el = append(el, field.Forbidden(fldPath.Child("signerName"), "may not reference a cert-manager Issuer or ClusterIssuer"))
+	}
+
+	return el
+}
+
+// ValidateSPIREIssuerConfig validates the configuration of a SPIRE issuer.
+func ValidateSPIREIssuerConfig(iss *certmanager.SPIREIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.Address == "" {
+		el = append(el, field.Required(fldPath.Child("address"), "address is a required field"))
+	} else if _, _, err := net.SplitHostPort(iss.Address); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("address"), iss.Address, "must be of the form <host>:<port>"))
+	}
+
+	if iss.TrustDomain == "" {
+		el = append(el, field.Required(fldPath.Child("trustDomain"), "trust domain is a required field"))
+	} else if u, err := url.Parse("spiffe://" + iss.TrustDomain); err != nil || u.Host != iss.TrustDomain || iss.TrustDomain != strings.ToLower(iss.TrustDomain) {
+		el = append(el, field.Invalid(fldPath.Child("trustDomain"), iss.TrustDomain, "must be a lowercase trust domain name without a scheme or path"))
+	}
+
+	if len(iss.CABundle) == 0 {
+		el = append(el, field.Required(fldPath.Child("caBundle"), "CA bundle is a required field"))
+	}
+
+	if iss.ServerSPIFFEID != "" {
+		if u, err := url.Parse(iss.ServerSPIFFEID); err != nil || u.Scheme != "spiffe" || u.Host != iss.TrustDomain {
+			el = append(el, field.Invalid(fldPath.Child("serverSPIFFEID"), iss.ServerSPIFFEID, "must be a SPIFFE ID in the trust domain"))
+		}
+	}
+
+	if iss.ClientCertSecretRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("clientCertSecretRef", "name"), "secret name is required"))
+	}
+
+	return el
+}
+
+// ValidatePluginIssuerConfig validates the configuration of an issuer
+// plugin.
+func ValidatePluginIssuerConfig(iss *certmanager.PluginIssuer, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if iss.Address == "" {
+		el = append(el, field.Required(fldPath.Child("address"), "address is a required field"))
+	}
+
+	if iss.Name == "" {
+		el = append(el, field.Required(fldPath.Child("name"), "plugin name is a required field"))
+	}
+
+	if iss.ClientCertSecretRef != nil && iss.ClientCertSecretRef.Name == "" {
+		el = append(el, field.Required(fldPath.Child("clientCertSecretRef", "name"), "secret name is required"))
+	}
+
+	if iss.Insecure {
+		if len(iss.CABundle) > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("caBundle"), "may not be specified for an insecure connection"))
+		}
+		if iss.ClientCertSecretRef != nil {
+			el = append(el, field.Forbidden(fldPath.Child("clientCertSecretRef"), "may not be specified for an insecure connection"))
+		}
+	}
+
+	return el
+}
+
 // This list must be kept in sync with pkg/issuer/acme/dns/rfc2136/rfc2136.go
 var supportedTSIGAlgorithms = []string{
 	"HMACMD5",
@@ -303,6 +852,15 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, field.Invalid(fldPath.Child("cnameStrategy"), p.CNAMEStrategy, fmt.Sprintf("must be one of %q or %q", cmacme.NoneStrategy, cmacme.FollowStrategy)))
 		}
 	}
+
+	if p.CheckNameservers != nil {
+		for i, server := range p.CheckNameservers.Nameservers {
+			// ensure all servers have a port number
+			if _, _, err := net.SplitHostPort(server); err != nil {
+				el = append(el, field.Invalid(fldPath.Child("checkNameservers", "nameservers").Index(i), server, err.Error()))
+			}
+		}
+	}
 	numProviders := 0
 	if p.Akamai != nil {
 		numProviders++
@@ -320,7 +878,24 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			numProviders++
 			// if ClientID or ClientSecret or TenantID are defined then all of ClientID, ClientSecret and tenantID must be defined
 			// We check things separately because
-			if len(p.AzureDNS.ClientID) > 0 || len(p.AzureDNS.TenantID) > 0 || p.AzureDNS.ClientSecret != nil {
+			if p.AzureDNS.ServiceAccountRef != nil {
+				// using workload identity federation
+				if len(p.AzureDNS.ServiceAccountRef.Name) == 0 {
+					el = append(el, field.Required(fldPath.Child("azureDNS", "serviceAccountRef", "name"), ""))
+				}
+				if len(p.AzureDNS.ClientID) == 0 {
+					el = append(el, field.Required(fldPath.Child("azureDNS", "clientID"), "required when using serviceAccountRef"))
+				}
+				if len(p.AzureDNS.TenantID) == 0 {
+					el = append(el, field.Required(fldPath.Child("azureDNS", "tenantID"), "required when using serviceAccountRef"))
+				}
+				if p.AzureDNS.ClientSecret != nil {
+					el = append(el, field.Forbidden(fldPath.Child("azureDNS", "clientSecretSecretRef"), "clientSecretSecretRef can not be used at the same time as serviceAccountRef"))
+				}
+				if p.AzureDNS.ManagedIdentity != nil {
+					el = append(el, field.Forbidden(fldPath.Child("azureDNS", "managedIdentity"), "managed identity can not be used at the same time as serviceAccountRef"))
+				}
+			} else if len(p.AzureDNS.ClientID) > 0 || len(p.AzureDNS.TenantID) > 0 || p.AzureDNS.ClientSecret != nil {
 				if len(p.AzureDNS.ClientID) == 0 {
 					el = append(el, field.Required(fldPath.Child("azureDNS", "clientID"), ""))
 				}
@@ -404,6 +979,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.Route53.Region) == 0 {
 				el = append(el, field.Required(fldPath.Child("route53", "region"), ""))
 			}
+			for i, role := range p.Route53.AssumeRoleChain {
+				if len(role.Role) == 0 {
+					el = append(el, field.Required(fldPath.Child("route53", "assumeRoleChain").Index(i).Child("role"), ""))
+				}
+			}
 		}
 	}
 	if p.AcmeDNS != nil {
@@ -422,6 +1002,105 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			el = append(el, ValidateSecretKeySelector(&p.DigitalOcean.Token, fldPath.Child("digitalocean", "tokenSecretRef"))...)
 		}
 	}
+	if p.Gandi != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("gandi"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateSecretKeySelector(&p.Gandi.APIToken, fldPath.Child("gandi", "apiTokenSecretRef"))...)
+		}
+	}
+	if p.Linode != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("linode"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateSecretKeySelector(&p.Linode.APIToken, fldPath.Child("linode", "apiTokenSecretRef"))...)
+		}
+	}
+	if p.NS1 != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("ns1"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateSecretKeySelector(&p.NS1.APIKey, fldPath.Child("ns1", "apiKeySecretRef"))...)
+		}
+	}
+	if p.PowerDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("powerdns"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.PowerDNS.Host) == 0 {
+				el = append(el, field.Required(fldPath.Child("powerdns", "host"), ""))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.PowerDNS.APIKey, fldPath.Child("powerdns", "apiKeySecretRef"))...)
+		}
+	}
+	if p.CoreDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("coredns"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.CoreDNS.Zone) == 0 {
+				el = append(el, field.Required(fldPath.Child("coredns", "zone"), ""))
+			}
+			if len(p.CoreDNS.ConfigMapName) == 0 {
+				el = append(el, field.Required(fldPath.Child("coredns", "configMapName"), ""))
+			}
+		}
+	}
+	if p.OCI != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("oci"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateACMEIssuerDNS01ProviderOCI(p.OCI, fldPath.Child("oci"))...)
+		}
+	}
+	if p.AliDNS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("alidns"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			// the AccessKey pair may be omitted to use the ECS instance's RAM role,
+			// but it's always an error to set only one half of it
+			if p.AliDNS.AccessKeySecret != nil {
+				if len(p.AliDNS.AccessKeyID) == 0 {
+					el = append(el, field.Required(fldPath.Child("alidns", "accessKeyID"), "accessKeyID is required when accessKeySecretSecretRef is set"))
+				}
+				el = append(el, ValidateSecretKeySelector(p.AliDNS.AccessKeySecret, fldPath.Child("alidns", "accessKeySecretSecretRef"))...)
+			} else if len(p.AliDNS.AccessKeyID) > 0 {
+				el = append(el, field.Required(fldPath.Child("alidns", "accessKeySecretSecretRef"), "accessKeySecretSecretRef is required when accessKeyID is set"))
+			}
+		}
+	}
+	if p.IBMCIS != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("ibmcis"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			if len(p.IBMCIS.CRN) == 0 {
+				el = append(el, field.Required(fldPath.Child("ibmcis", "crn"), ""))
+			} else if !strings.HasPrefix(p.IBMCIS.CRN, "crn:") {
+				el = append(el, field.Invalid(fldPath.Child("ibmcis", "crn"), p.IBMCIS.CRN, "must be the CRN of an IBM Cloud Internet Services instance"))
+			}
+			el = append(el, ValidateSecretKeySelector(&p.IBMCIS.APIKey, fldPath.Child("ibmcis", "apiKeySecretRef"))...)
+		}
+	}
+	if p.DNSimple != nil {
+		if numProviders > 0 {
+			el = append(el, field.Forbidden(fldPath.Child("dnsimple"), "may not specify more than one provider type"))
+		} else {
+			numProviders++
+			el = append(el, ValidateSecretKeySelector(&p.DNSimple.APIToken, fldPath.Child("dnsimple", "apiTokenSecretRef"))...)
+			if len(p.DNSimple.AccountID) > 0 {
+				if _, err := strconv.ParseUint(p.DNSimple.AccountID, 10, 64); err != nil {
+					el = append(el, field.Invalid(fldPath.Child("dnsimple", "accountID"), p.DNSimple.AccountID, "must be a numeric DNSimple account ID"))
+				}
+			}
+		}
+	}
 	if p.RFC2136 != nil {
 		if numProviders > 0 {
 			el = append(el, field.Forbidden(fldPath.Child("rfc2136"), "may not specify more than one provider type"))
@@ -456,6 +1135,33 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 				}
 
 			}
+			if gss := p.RFC2136.GSSTSIG; gss != nil {
+				gssPath := fldPath.Child("rfc2136", "gssTSIG")
+				if len(p.RFC2136.TSIGKeyName) > 0 {
+					el = append(el, field.Forbidden(gssPath, "may not be specified together with tsigKeyName"))
+				}
+				// The service principal name is derived from the nameserver.
+				if ns, err := util.ValidNameserver(p.RFC2136.Nameserver); err == nil {
+					if host, _, err := net.SplitHostPort(ns); err == nil && net.ParseIP(host) != nil {
+						el = append(el, field.Invalid(fldPath.Child("rfc2136", "nameserver"), p.RFC2136.Nameserver, "nameserver must be a hostname when gssTSIG is used"))
+					}
+				}
+				if len(gss.Realm) == 0 {
+					el = append(el, field.Required(gssPath.Child("realm"), ""))
+				}
+				if len(gss.Username) == 0 {
+					el = append(el, field.Required(gssPath.Child("username"), ""))
+				}
+				// The key defaults to "keytab" so only the name is required.
+				if len(gss.KeytabSecretRef.Name) == 0 {
+					el = append(el, field.Required(gssPath.Child("keytabSecretRef", "name"), "secret name is required"))
+				}
+				for i, kdc := range gss.KDCs {
+					if len(kdc) == 0 {
+						el = append(el, field.Required(gssPath.Child("kdcs").Index(i), ""))
+					}
+				}
+			}
 		}
 	}
 	if p.Webhook != nil {
@@ -466,6 +1172,11 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 			if len(p.Webhook.SolverName) == 0 {
 				el = append(el, field.Required(fldPath.Child("webhook", "solverName"), "solver name must be specified"))
 			}
+			if p.Webhook.GRPC != nil {
+				el = append(el, validateACMEIssuerDNS01ProviderWebhookGRPC(p.Webhook.GRPC, fldPath.Child("webhook", "grpc"))...)
+			} else if len(p.Webhook.GroupName) == 0 {
+				el = append(el, field.Required(fldPath.Child("webhook", "groupName"), "group name must be specified unless grpc is set"))
+			}
 		}
 	}
 	if numProviders == 0 {
@@ -475,6 +1186,19 @@ func ValidateACMEChallengeSolverDNS01(p *cmacme.ACMEChallengeSolverDNS01, fldPat
 	return el
 }
 
+func validateACMEIssuerDNS01ProviderWebhookGRPC(cfg *cmacme.ACMEIssuerDNS01ProviderWebhookGRPC, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+	if len(cfg.Address) == 0 {
+		el = append(el, field.Required(fldPath.Child("address"), ""))
+	} else if _, _, err := net.SplitHostPort(cfg.Address); err != nil {
+		el = append(el, field.Invalid(fldPath.Child("address"), cfg.Address, fmt.Sprintf("must be of the form host:port: %v", err)))
+	}
+	if cfg.Insecure && len(cfg.CABundle) > 0 {
+		el = append(el, field.Forbidden(fldPath.Child("caBundle"), "may not be specified when insecure is true"))
+	}
+	return el
+}
+
 func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Path) field.ErrorList {
 	el := field.ErrorList{}
 	if sks.Name == "" {
@@ -485,3 +1209,39 @@ func ValidateSecretKeySelector(sks *cmmeta.SecretKeySelector, fldPath *field.Pat
 	}
 	return el
 }
+
+// ValidateACMEIssuerDNS01ProviderOCI validates the configuration of the OCI
+// DNS01 provider. API key authentication requires the API key and region to
+// be set, whereas the ambient authentication types must not set an API key.
+func ValidateACMEIssuerDNS01ProviderOCI(p *cmacme.ACMEIssuerDNS01ProviderOCI, fldPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	switch p.AuthType {
+	case "", "APIKey":
+		if len(p.Region) == 0 {
+			el = append(el, field.Required(fldPath.Child("region"), "region is required when using API key authentication"))
+		}
+		if p.APIKey == nil {
+			el = append(el, field.Required(fldPath.Child("apiKey"), "apiKey is required when using API key authentication"))
+			break
+		}
+		if len(p.APIKey.TenancyOCID) == 0 {
+			el = append(el, field.Required(fldPath.Child("apiKey", "tenancyOCID"), ""))
+		}
+		if len(p.APIKey.UserOCID) == 0 {
+			el = append(el, field.Required(fldPath.Child("apiKey", "userOCID"), ""))
+		}
+		if len(p.APIKey.Fingerprint) == 0 {
+			el = append(el, field.Required(fldPath.Child("apiKey", "fingerprint"), ""))
+		}
+		el = append(el, ValidateSecretKeySelector(&p.APIKey.PrivateKey, fldPath.Child("apiKey", "privateKeySecretRef"))...)
+	case "InstancePrincipal", "WorkloadIdentity":
+		if p.APIKey != nil {
+			el = append(el, field.Forbidden(fldPath.Child("apiKey"), fmt.Sprintf("apiKey may not be set when using %s authentication", p.AuthType)))
+		}
+	default:
+		el = append(el, field.NotSupported(fldPath.Child("authType"), p.AuthType, []string{"APIKey", "InstancePrincipal", "WorkloadIdentity"}))
+	}
+
+	return el
+}
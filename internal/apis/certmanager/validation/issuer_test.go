@@ -19,11 +19,15 @@ package validation
 import (
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/pointer"
 	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
 
 	cmacme "github.com/cert-manager/cert-manager/internal/apis/acme"
@@ -87,6 +91,30 @@ func TestValidateVaultIssuerConfig(t *testing.T) {
 				field.Invalid(fldPath.Child("caBundle"), "", "Specified CA bundle is invalid"),
 			},
 		},
+		"vault issuer with valid client certificate auth": {
+			spec: &cmapi.VaultIssuer{
+				Server: "https://vault.example.com:8200",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					ClientCertificate: &cmapi.VaultClientCertificateAuth{
+						SecretName: "vault-client-tls",
+					},
+				},
+			},
+		},
+		"vault issuer with invalid client certificate auth": {
+			spec: &cmapi.VaultIssuer{
+				Server: "http://vault.example.com:8200",
+				Path:   "a/b/c",
+				Auth: cmapi.VaultAuth{
+					ClientCertificate: &cmapi.VaultClientCertificateAuth{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("auth", "clientCertificate", "secretName"), ""),
+				field.Invalid(fldPath.Child("server"), "http://vault.example.com:8200", "client certificate authentication requires an https server"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -122,6 +150,25 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("server"), "acme server URL is a required field"),
 			},
 		},
+		"acme issuer with preferred chain fingerprint": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				PreferredChain: "sha256:96bcec06264976f37460779acf28c5a7cfe8a3c0aae11a8ffcee05c0bddf08c6",
+			},
+		},
+		"acme issuer with invalid preferred chain fingerprint": {
+			spec: &cmacme.ACMEIssuer{
+				Email:          "valid-email",
+				Server:         "valid-server",
+				PrivateKey:     validSecretKeyRef,
+				PreferredChain: "sha256:96bcec06",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("preferredChain"), "sha256:96bcec06", "invalid SHA-256 fingerprint: expected 32 bytes but got 4"),
+			},
+		},
 		"acme solver without any config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -135,6 +182,77 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				field.Required(fldPath.Child("solvers").Index(0), "no solver type configured"),
 			},
 		},
+		"acme issuer with valid additional accounts": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AdditionalAccounts: []cmacme.ACMEAdditionalAccount{
+					{
+						Email:      "team-a@example.com",
+						PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "team-a"}},
+					},
+					{
+						Email:      "team-b@example.com",
+						PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "team-b"}},
+					},
+				},
+			},
+		},
+		"acme issuer with invalid additional accounts": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				AdditionalAccounts: []cmacme.ACMEAdditionalAccount{
+					{
+						PrivateKey: validSecretKeyRef,
+					},
+					{
+						Email:      "team-a@example.com",
+						PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "team-a"}},
+					},
+					{
+						Email: "Team-A@example.com",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("additionalAccounts").Index(0).Child("email"), "email is a required field"),
+				field.Invalid(fldPath.Child("additionalAccounts").Index(0).Child("privateKeySecretRef", "name"), "valid", "private key secret must not be shared with another ACME account"),
+				field.Duplicate(fldPath.Child("additionalAccounts").Index(2).Child("email"), "Team-A@example.com"),
+				field.Required(fldPath.Child("additionalAccounts").Index(2).Child("privateKeySecretRef", "name"), "private key secret name is a required field"),
+			},
+		},
+		"acme issuer with valid url rewrites": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				URLRewrites: []cmacme.ACMEURLRewrite{
+					{From: "https://acme-v02.api.letsencrypt.org/", To: "https://mirror.example.com/letsencrypt/"},
+					{From: "https://acme.example.com", To: "http://mirror.example.com:8080/acme"},
+				},
+			},
+		},
+		"acme issuer with invalid url rewrites": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				URLRewrites: []cmacme.ACMEURLRewrite{
+					{To: "https://mirror.example.com/"},
+					{From: "https://acme.example.com/", To: "mirror.example.com"},
+					{From: "https://acme.example.com/", To: "ftp://mirror.example.com/"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("urlRewrites").Index(0).Child("from"), "URL is a required field"),
+				field.Invalid(fldPath.Child("urlRewrites").Index(1).Child("to"), "mirror.example.com", "must be an absolute http or https URL"),
+				field.Duplicate(fldPath.Child("urlRewrites").Index(2).Child("from"), "https://acme.example.com/"),
+				field.Invalid(fldPath.Child("urlRewrites").Index(2).Child("to"), "ftp://mirror.example.com/", "must be an absolute http or https URL"),
+			},
+		},
 		"acme solver with valid dns01 config": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -207,6 +325,26 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 			},
 			warnings: []string{deprecatedACMEEABKeyAlgorithmField},
 		},
+		"acme solver with deprecated http01 ingress class": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Class: strPtr("nginx")},
+						},
+					},
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{Class: strPtr("nginx-internal")},
+						},
+					},
+				},
+			},
+			warnings: []string{deprecatedACMEHTTP01IngressClassField},
+		},
 		"acme solver with missing http01 config type": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -276,6 +414,72 @@ func TestValidateACMEIssuerConfig(t *testing.T) {
 				),
 			},
 		},
+		"acme solver with valid http01 istio virtualservice config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							IstioVirtualService: &cmacme.ACMEChallengeSolverHTTP01IstioVirtualService{
+								Gateways: []string{"istio-system/ingressgateway", "mesh-gateway"},
+							},
+						},
+					},
+				},
+			},
+		},
+		"acme solver with invalid http01 istio virtualservice config": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							IstioVirtualService: &cmacme.ACMEChallengeSolverHTTP01IstioVirtualService{
+								ServiceType: corev1.ServiceTypeLoadBalancer,
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("solvers").Index(0).Child("http01", "istioVirtualService", "serviceType"),
+					corev1.ServiceTypeLoadBalancer,
+					`must be empty, "ClusterIP" or "NodePort"`,
+				),
+				field.Required(
+					fldPath.Child("solvers").Index(0).Child("http01", "istioVirtualService", "gateways"),
+					"at least 1 gateway is required",
+				),
+			},
+		},
+		"acme solver with invalid http01 istio virtualservice gateway": {
+			spec: &cmacme.ACMEIssuer{
+				Email:      "valid-email",
+				Server:     "valid-server",
+				PrivateKey: validSecretKeyRef,
+				Solvers: []cmacme.ACMEChallengeSolver{
+					{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							IstioVirtualService: &cmacme.ACMEChallengeSolverHTTP01IstioVirtualService{
+								Gateways: []string{"Istio_System/gateway"},
+							},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(
+					fldPath.Child("solvers").Index(0).Child("http01", "istioVirtualService", "gateways").Index(0),
+					"Istio_System/gateway",
+					"invalid namespace: "+validation.IsDNS1123Label("Istio_System")[0],
+				),
+			},
+		},
 		"acme solver with multiple http01 solver configs": {
 			spec: &cmacme.ACMEIssuer{
 				Email:      "valid-email",
@@ -500,6 +704,131 @@ func TestValidateIssuerSpec(t *testing.T) {
 				field.Invalid(fldPath.Child("ca", "ocspServer").Index(0), "", `must be a valid URL, e.g., http://ocsp.int-x3.letsencrypt.org`),
 			},
 		},
+		"valid ca issuer crl": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						CRL: &cmapi.CACRL{
+							ConfigMapName: "ca-crl",
+							Validity:      &metav1.Duration{Duration: 12 * time.Hour},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"ca issuer crl without a destination or with a short validity": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						CRL: &cmapi.CACRL{
+							Validity: &metav1.Duration{Duration: time.Minute},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "crl"), "at least one of secretName or configMapName must be set"),
+				field.Invalid(fldPath.Child("ca", "crl", "validity"), time.Minute, "must be at least 1h"),
+			},
+		},
+		"ca issuer ocsp without a responder secret name or with a short response validity": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					CA: &cmapi.CAIssuer{
+						SecretName: "valid",
+						OCSP: &cmapi.CAOCSP{
+							ResponseValidity: &metav1.Duration{Duration: time.Second},
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ca", "ocsp", "responderSecretName"), ""),
+				field.Invalid(fldPath.Child("ca", "ocsp", "responseValidity"), time.Second, "must be at least 1m"),
+			},
+		},
+		"valid issuance latency budget": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				IssuanceLatencyBudget: &cmapi.IssuanceLatencyBudget{
+					Average: &metav1.Duration{Duration: time.Minute},
+					P95:     &metav1.Duration{Duration: 5 * time.Minute},
+					Samples: 50,
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"issuance latency budget without average or p95": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				IssuanceLatencyBudget: &cmapi.IssuanceLatencyBudget{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("issuanceLatencyBudget"), "at least one of average or p95 must be set"),
+			},
+		},
+		"issuance latency budget with non-positive durations and negative samples": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				IssuanceLatencyBudget: &cmapi.IssuanceLatencyBudget{
+					Average: &metav1.Duration{Duration: 0},
+					P95:     &metav1.Duration{Duration: -time.Second},
+					Samples: -1,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("issuanceLatencyBudget", "average"), time.Duration(0), "must be greater than zero"),
+				field.Invalid(fldPath.Child("issuanceLatencyBudget", "p95"), -time.Second, "must be greater than zero"),
+				field.Invalid(fldPath.Child("issuanceLatencyBudget", "samples"), int32(-1), "must not be negative"),
+			},
+		},
+		"valid rate limit": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RateLimit: &cmapi.IssuanceRateLimit{
+					MaxRequests: 50,
+					Period:      &metav1.Duration{Duration: time.Hour},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rate limit with no requests and without a period": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RateLimit: &cmapi.IssuanceRateLimit{},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rateLimit", "maxRequests"), int32(0), "must not be less than 1"),
+				field.Required(fldPath.Child("rateLimit", "period"), ""),
+			},
+		},
+		"rate limit with a non-positive period": {
+			spec: &cmapi.IssuerSpec{
+				IssuerConfig: cmapi.IssuerConfig{
+					SelfSigned: &cmapi.SelfSignedIssuer{},
+				},
+				RateLimit: &cmapi.IssuanceRateLimit{
+					MaxRequests: 1,
+					Period:      &metav1.Duration{Duration: -time.Minute},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rateLimit", "period"), -time.Minute, "must be greater than zero"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -549,6 +878,22 @@ func TestValidateACMEIssuerHTTP01Config(t *testing.T) {
 				field.Forbidden(fldPath.Child("ingress"), "only one of 'name' or 'class' should be specified"),
 			},
 		},
+		"ingressClassName field specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: strPtr("abc")},
+			},
+		},
+		"both class and ingressClassName specified": {
+			cfg: &cmacme.ACMEChallengeSolverHTTP01{
+				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+					Class:            strPtr("abc"),
+					IngressClassName: strPtr("abc"),
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("ingress"), "only one of 'name', 'class' or 'ingressClassName' should be specified"),
+			},
+		},
 		"acme issuer with valid http01 service config serviceType ClusterIP": {
 			cfg: &cmacme.ACMEChallengeSolverHTTP01{
 				Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
@@ -705,70 +1050,257 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("cloudflare", "email"), ""),
 			},
 		},
-		"missing route53 region": {
+		"missing gandi api token fields": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
-				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{},
+				Gandi: &cmacme.ACMEIssuerDNS01ProviderGandi{},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("route53", "region"), ""),
+				field.Required(fldPath.Child("gandi", "apiTokenSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("gandi", "apiTokenSecretRef", "key"), "secret key is required"),
 			},
 		},
-		"missing provider config": {
-			cfg: &cmacme.ACMEChallengeSolverDNS01{},
+		"missing linode api token fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Linode: &cmacme.ACMEIssuerDNS01ProviderLinode{},
+			},
 			errs: []*field.Error{
-				field.Required(fldPath, "no DNS01 provider configured"),
+				field.Required(fldPath.Child("linode", "apiTokenSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("linode", "apiTokenSecretRef", "key"), "secret key is required"),
 			},
 		},
-		"missing azuredns config": {
+		"missing ns1 api key fields": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
-				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{},
+				NS1: &cmacme.ACMEIssuerDNS01ProviderNS1{},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
-				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
+				field.Required(fldPath.Child("ns1", "apiKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("ns1", "apiKeySecretRef", "key"), "secret key is required"),
 			},
 		},
-		"invalid azuredns environment": {
+		"missing powerdns host and api key fields": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
-				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
-					Environment: "an env",
-				},
+				PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
-				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
-				field.Invalid(fldPath.Child("azureDNS", "environment"), cmacme.AzureDNSEnvironment("an env"),
-					"must be either empty or one of AzurePublicCloud, AzureChinaCloud, AzureGermanCloud or AzureUSGovernmentCloud"),
+				field.Required(fldPath.Child("powerdns", "host"), ""),
+				field.Required(fldPath.Child("powerdns", "apiKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("powerdns", "apiKeySecretRef", "key"), "secret key is required"),
 			},
 		},
-		"invalid azuredns missing clientSecret and tenantID": {
+		"missing coredns zone and configmap name": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
-				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
-					ClientID: "some-client-id",
-				},
+				CoreDNS: &cmacme.ACMEIssuerDNS01ProviderCoreDNS{},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("azureDNS", "clientSecretSecretRef"), ""),
-				field.Required(fldPath.Child("azureDNS", "tenantID"), ""),
-				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
-				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
+				field.Required(fldPath.Child("coredns", "zone"), ""),
+				field.Required(fldPath.Child("coredns", "configMapName"), ""),
 			},
 		},
-		"invalid azuredns missing clientID and tenantID": {
+		"missing oci api key and region": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
-				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
-					ClientSecret: &cmmeta.SecretKeySelector{
-						Key: "some-key",
-						LocalObjectReference: cmmeta.LocalObjectReference{
-							Name: "some-secret-name",
-						},
-					},
-				},
+				OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{},
 			},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("azureDNS", "clientID"), ""),
-				field.Required(fldPath.Child("azureDNS", "tenantID"), ""),
-				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
+				field.Required(fldPath.Child("oci", "region"), "region is required when using API key authentication"),
+				field.Required(fldPath.Child("oci", "apiKey"), "apiKey is required when using API key authentication"),
+			},
+		},
+		"missing oci api key fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{
+					Region: "us-ashburn-1",
+					APIKey: &cmacme.ACMEIssuerDNS01ProviderOCIAPIKey{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("oci", "apiKey", "tenancyOCID"), ""),
+				field.Required(fldPath.Child("oci", "apiKey", "userOCID"), ""),
+				field.Required(fldPath.Child("oci", "apiKey", "fingerprint"), ""),
+				field.Required(fldPath.Child("oci", "apiKey", "privateKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("oci", "apiKey", "privateKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+		"oci workload identity with api key": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{
+					AuthType: "WorkloadIdentity",
+					APIKey:   &cmacme.ACMEIssuerDNS01ProviderOCIAPIKey{},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("oci", "apiKey"), "apiKey may not be set when using WorkloadIdentity authentication"),
+			},
+		},
+		"valid oci instance principal": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{
+					AuthType: "InstancePrincipal",
+				},
+			},
+		},
+		"alidns accesskey id without secret": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AliDNS: &cmacme.ACMEIssuerDNS01ProviderAliDNS{
+					AccessKeyID: "id",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("alidns", "accessKeySecretSecretRef"), "accessKeySecretSecretRef is required when accessKeyID is set"),
+			},
+		},
+		"alidns accesskey secret without id": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AliDNS: &cmacme.ACMEIssuerDNS01ProviderAliDNS{
+					AccessKeySecret: &cmmeta.SecretKeySelector{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("alidns", "accessKeyID"), "accessKeyID is required when accessKeySecretSecretRef is set"),
+				field.Required(fldPath.Child("alidns", "accessKeySecretSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("alidns", "accessKeySecretSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"valid alidns ecs ram role": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AliDNS: &cmacme.ACMEIssuerDNS01ProviderAliDNS{},
+			},
+		},
+		"missing ibmcis crn": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				IBMCIS: &cmacme.ACMEIssuerDNS01ProviderIBMCIS{
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("ibmcis", "crn"), ""),
+			},
+		},
+		"invalid ibmcis crn": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				IBMCIS: &cmacme.ACMEIssuerDNS01ProviderIBMCIS{
+					CRN:    "instance-id",
+					APIKey: validSecretKeyRef,
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("ibmcis", "crn"), "instance-id", "must be the CRN of an IBM Cloud Internet Services instance"),
+			},
+		},
+		"valid ibmcis": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				IBMCIS: &cmacme.ACMEIssuerDNS01ProviderIBMCIS{
+					CRN:    "crn:v1:bluemix:public:internet-svcs:global:a/abc123:instance-id::",
+					APIKey: validSecretKeyRef,
+				},
+			},
+		},
+		"missing dnsimple api token fields": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				DNSimple: &cmacme.ACMEIssuerDNS01ProviderDNSimple{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("dnsimple", "apiTokenSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("dnsimple", "apiTokenSecretRef", "key"), "secret key is required"),
+			},
+		},
+		"invalid dnsimple account id": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				DNSimple: &cmacme.ACMEIssuerDNS01ProviderDNSimple{
+					APIToken:  validSecretKeyRef,
+					AccountID: "example",
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("dnsimple", "accountID"), "example", "must be a numeric DNSimple account ID"),
+			},
+		},
+		"valid dnsimple sandbox": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				DNSimple: &cmacme.ACMEIssuerDNS01ProviderDNSimple{
+					APIToken:  validSecretKeyRef,
+					AccountID: "1010",
+					Sandbox:   true,
+				},
+			},
+		},
+		"missing route53 region": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("route53", "region"), ""),
+			},
+		},
+		"route53 role chain with missing role": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+					Region: "us-east-1",
+					AssumeRoleChain: []cmacme.ACMEIssuerDNS01ProviderRoute53AssumeRole{
+						{Role: "arn:aws:iam::123456789012:role/dns"},
+						{ExternalID: "cert-manager"},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("route53", "assumeRoleChain").Index(1).Child("role"), ""),
+			},
+		},
+		"missing provider config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{},
+			errs: []*field.Error{
+				field.Required(fldPath, "no DNS01 provider configured"),
+			},
+		},
+		"missing azuredns config": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
+				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
+			},
+		},
+		"invalid azuredns environment": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					Environment: "an env",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
+				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
+				field.Invalid(fldPath.Child("azureDNS", "environment"), cmacme.AzureDNSEnvironment("an env"),
+					"must be either empty or one of AzurePublicCloud, AzureChinaCloud, AzureGermanCloud or AzureUSGovernmentCloud"),
+			},
+		},
+		"invalid azuredns missing clientSecret and tenantID": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientID: "some-client-id",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "clientSecretSecretRef"), ""),
+				field.Required(fldPath.Child("azureDNS", "tenantID"), ""),
+				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
+				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
+			},
+		},
+		"invalid azuredns missing clientID and tenantID": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientSecret: &cmmeta.SecretKeySelector{
+						Key: "some-key",
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "some-secret-name",
+						},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "clientID"), ""),
+				field.Required(fldPath.Child("azureDNS", "tenantID"), ""),
+				field.Required(fldPath.Child("azureDNS", "subscriptionID"), ""),
 				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
 			},
 		},
@@ -803,6 +1335,40 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Required(fldPath.Child("azureDNS", "resourceGroupName"), ""),
 			},
 		},
+		"valid azuredns serviceAccountRef": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientID:          "some-client-id",
+					TenantID:          "some-tenant-id",
+					SubscriptionID:    "some-subscription-id",
+					ResourceGroupName: "some-resource-group",
+					ServiceAccountRef: &cmacme.AzureServiceAccountRef{Name: "some-service-account"},
+				},
+			},
+		},
+		"invalid azuredns serviceAccountRef with clientSecret and managedIdentity": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+					ClientSecret: &cmmeta.SecretKeySelector{
+						Key: "some-key",
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "some-secret-name",
+						},
+					},
+					SubscriptionID:    "some-subscription-id",
+					ResourceGroupName: "some-resource-group",
+					ManagedIdentity:   &cmacme.AzureManagedIdentity{},
+					ServiceAccountRef: &cmacme.AzureServiceAccountRef{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("azureDNS", "serviceAccountRef", "name"), ""),
+				field.Required(fldPath.Child("azureDNS", "clientID"), "required when using serviceAccountRef"),
+				field.Required(fldPath.Child("azureDNS", "tenantID"), "required when using serviceAccountRef"),
+				field.Forbidden(fldPath.Child("azureDNS", "clientSecretSecretRef"), "clientSecretSecretRef can not be used at the same time as serviceAccountRef"),
+				field.Forbidden(fldPath.Child("azureDNS", "managedIdentity"), "managed identity can not be used at the same time as serviceAccountRef"),
+			},
+		},
 		"invalid azuredns missing clientSecret": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
@@ -1057,6 +1623,53 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 			},
 			errs: []*field.Error{},
 		},
+		"rfc2136 provider with valid gssTSIG": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "dc1.example.com",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:           "EXAMPLE.COM",
+						Username:        "cert-manager",
+						KeytabSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "keytab"}},
+					},
+				},
+			},
+			errs: []*field.Error{},
+		},
+		"rfc2136 provider with invalid gssTSIG": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver:  "dc1.example.com",
+					TSIGKeyName: "key",
+					TSIGSecret:  cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "tsig"}, Key: "secret"},
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						KDCs: []string{"dc1.example.com", ""},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("rfc2136", "gssTSIG"), "may not be specified together with tsigKeyName"),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "realm"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "username"), ""),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "keytabSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("rfc2136", "gssTSIG", "kdcs").Index(1), ""),
+			},
+		},
+		"rfc2136 provider with gssTSIG and an IP address nameserver": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
+					Nameserver: "192.0.2.1:53",
+					GSSTSIG: &cmacme.ACMEIssuerDNS01ProviderRFC2136GSSTSIG{
+						Realm:           "EXAMPLE.COM",
+						Username:        "cert-manager",
+						KeytabSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "keytab"}},
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("rfc2136", "nameserver"), "192.0.2.1:53", "nameserver must be a hostname when gssTSIG is used"),
+			},
+		},
 		"rfc2136 provider with nameserver without host": {
 			cfg: &cmacme.ACMEChallengeSolverDNS01{
 				RFC2136: &cmacme.ACMEIssuerDNS01ProviderRFC2136{
@@ -1121,6 +1734,94 @@ func TestValidateACMEIssuerDNS01Config(t *testing.T) {
 				field.Forbidden(fldPath.Child("cloudflare"), "may not specify more than one provider type"),
 			},
 		},
+		"valid webhook": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					GroupName:  "acme.example.com",
+					SolverName: "example",
+				},
+			},
+		},
+		"webhook missing group name": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("webhook", "groupName"), "group name must be specified unless grpc is set"),
+			},
+		},
+		"valid grpc webhook": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+						Address:  "localhost:9443",
+						Insecure: true,
+					},
+				},
+			},
+		},
+		"grpc webhook missing address": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC:       &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("webhook", "grpc", "address"), ""),
+			},
+		},
+		"grpc webhook address without port": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+						Address: "solver.example.svc",
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("webhook", "grpc", "address"), "solver.example.svc", "must be of the form host:port: address solver.example.svc: missing port in address"),
+			},
+		},
+		"grpc webhook insecure with ca bundle": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				Webhook: &cmacme.ACMEIssuerDNS01ProviderWebhook{
+					SolverName: "example",
+					GRPC: &cmacme.ACMEIssuerDNS01ProviderWebhookGRPC{
+						Address:  "solver.example.svc:443",
+						CABundle: []byte("ca"),
+						Insecure: true,
+					},
+				},
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("webhook", "grpc", "caBundle"), "may not be specified when insecure is true"),
+			},
+		},
+		"valid check nameservers": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CheckNameservers: &cmacme.ACMEChallengeSolverDNS01CheckNameservers{
+					Nameservers:   []string{"10.0.0.53:53", "[fd00::53]:53"},
+					RecursiveOnly: pointer.Bool(true),
+				},
+				CloudDNS: &validCloudDNSProvider,
+			},
+		},
+		"check nameserver without a port": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CheckNameservers: &cmacme.ACMEChallengeSolverDNS01CheckNameservers{
+					Nameservers: []string{"10.0.0.53:53", "10.0.0.54"},
+				},
+				CloudDNS: &validCloudDNSProvider,
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("checkNameservers", "nameservers").Index(1), "10.0.0.54", "address 10.0.0.54: missing port in address"),
+			},
+		},
 	}
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -1267,28 +1968,64 @@ func TestValidateVenafiIssuerConfig(t *testing.T) {
 	}
 }
 
-func TestValidateVenafiTPP(t *testing.T) {
+func TestValidateAWSPCAIssuerConfig(t *testing.T) {
 	fldPath := field.NewPath("test")
+	caArn := "arn:aws:acm-pca:eu-west-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"
 	scenarios := map[string]struct {
-		cfg  *cmapi.VenafiTPP
+		cfg  *cmapi.AWSPCAIssuer
 		errs []*field.Error
 	}{
 		"valid": {
-			cfg: &cmapi.VenafiTPP{
-				URL: "https://tpp.example.com/vedsdk",
+			cfg: &cmapi.AWSPCAIssuer{
+				Arn: caArn,
 			},
 		},
-		"missing url": {
-			cfg: &cmapi.VenafiTPP{},
+		"valid with static credentials": {
+			cfg: &cmapi.AWSPCAIssuer{
+				Arn: caArn,
+				Auth: &cmapi.AWSPCAAuth{
+					AccessKeyIDSecretRef: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "aws"},
+						Key:                  "access-key-id",
+					},
+					SecretAccessKeySecretRef: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{Name: "aws"},
+						Key:                  "secret-access-key",
+					},
+				},
+			},
+		},
+		"missing arn": {
+			cfg: &cmapi.AWSPCAIssuer{},
 			errs: []*field.Error{
-				field.Required(fldPath.Child("url"), ""),
+				field.Required(fldPath.Child("arn"), "certificate authority ARN is a required field"),
+			},
+		},
+		"arn is not for a certificate authority": {
+			cfg: &cmapi.AWSPCAIssuer{
+				Arn: "arn:aws:s3:::my-bucket",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("arn"), "arn:aws:s3:::my-bucket", "must be the ARN of an ACM Private CA certificate authority"),
+			},
+		},
+		"missing secret names for static credentials": {
+			cfg: &cmapi.AWSPCAIssuer{
+				Arn:  caArn,
+				Auth: &cmapi.AWSPCAAuth{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("auth", "accessKeyIDSecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("auth", "accessKeyIDSecretRef", "key"), "secret key is required"),
+				field.Required(fldPath.Child("auth", "secretAccessKeySecretRef", "name"), "secret name is required"),
+				field.Required(fldPath.Child("auth", "secretAccessKeySecretRef", "key"), "secret key is required"),
 			},
 		},
 	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
-			errs := ValidateVenafiTPP(s.cfg, fldPath)
+			errs := ValidateAWSPCAIssuerConfig(s.cfg, fldPath)
 			if len(errs) != len(s.errs) {
 				t.Fatalf("Expected %v but got %v", s.errs, errs)
 			}
@@ -1302,13 +2039,556 @@ func TestValidateVenafiTPP(t *testing.T) {
 	}
 }
 
-func TestValidateIssuer(t *testing.T) {
+func TestValidateGoogleCASIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
 	scenarios := map[string]struct {
-		cfg       *cmapi.Issuer
-		a         *admissionv1.AdmissionRequest
-		expectedE []*field.Error
-		expectedW []string
-	}{}
+		cfg  *cmapi.GoogleCASIssuer
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:  "my-project",
+				Location: "europe-west1",
+				CAPoolID: "my-pool",
+			},
+		},
+		"valid with template and service account key": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:             "my-project",
+				Location:            "europe-west1",
+				CAPoolID:            "my-pool",
+				CertificateTemplate: "projects/my-project/locations/europe-west1/certificateTemplates/my-template",
+				ServiceAccountKeySecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "google"},
+					Key:                  "key.json",
+				},
+			},
+		},
+		"missing required fields": {
+			cfg: &cmapi.GoogleCASIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("project"), "project is a required field"),
+				field.Required(fldPath.Child("location"), "location is a required field"),
+				field.Required(fldPath.Child("caPoolId"), "CA pool ID is a required field"),
+			},
+		},
+		"template is not a full resource name": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:             "my-project",
+				Location:            "europe-west1",
+				CAPoolID:            "my-pool",
+				CertificateTemplate: "my-template",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("certificateTemplate"), "my-template",
+					"must be the full resource name of a certificate template, i.e. projects/<project>/locations/<location>/certificateTemplates/<name>"),
+			},
+		},
+		"missing key for service account key secret": {
+			cfg: &cmapi.GoogleCASIssuer{
+				Project:  "my-project",
+				Location: "europe-west1",
+				CAPoolID: "my-pool",
+				ServiceAccountKeySecretRef: &cmmeta.SecretKeySelector{
+					LocalObjectReference: cmmeta.LocalObjectReference{Name: "google"},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("serviceAccountKeySecretRef", "key"), "secret key is required"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateGoogleCASIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateAzureKeyVaultIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.AzureKeyVaultIssuer
+		errs []*field.Error
+	}{
+		"valid with managed identity": {
+			cfg: &cmapi.AzureKeyVaultIssuer{
+				VaultURL:        "https://my-vault.vault.azure.net/",
+				CertificateName: "my-ca",
+			},
+		},
+		"valid with workload identity": {
+			cfg: &cmapi.AzureKeyVaultIssuer{
+				VaultURL:          "https://my-vault.vault.azure.net/",
+				CertificateName:   "my-ca",
+				Environment:       "AzureChinaCloud",
+				TenantID:          "tenant",
+				ClientID:          "client",
+				ServiceAccountRef: &cmapi.AzureKeyVaultServiceAccountRef{Name: "cert-manager-kv"},
+			},
+		},
+		"missing required fields": {
+			cfg: &cmapi.AzureKeyVaultIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("vaultURL"), "vault URL is a required field"),
+				field.Required(fldPath.Child("certificateName"), "certificate name is a required field"),
+			},
+		},
+		"vault URL is not https and environment is unknown": {
+			cfg: &cmapi.AzureKeyVaultIssuer{
+				VaultURL:        "http://my-vault.vault.azure.net/",
+				CertificateName: "my-ca",
+				Environment:     "AzureMoonCloud",
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("vaultURL"), "http://my-vault.vault.azure.net/", "must be an https URL"),
+				field.NotSupported(fldPath.Child("environment"), "AzureMoonCloud", supportedAzureEnvironments),
+			},
+		},
+		"workload identity without tenant and client ID": {
+			cfg: &cmapi.AzureKeyVaultIssuer{
+				VaultURL:          "https://my-vault.vault.azure.net/",
+				CertificateName:   "my-ca",
+				ServiceAccountRef: &cmapi.AzureKeyVaultServiceAccountRef{},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("serviceAccountRef", "name"), "service account name is required"),
+				field.Required(fldPath.Child("tenantID"), "tenant ID is required when using workload identity federation"),
+				field.Required(fldPath.Child("clientID"), "client ID is required when using workload identity federation"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateAzureKeyVaultIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateEJBCAIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.EJBCAIssuer
+		errs []*field.Error
+	}{
+		"valid with client certificate": {
+			cfg: &cmapi.EJBCAIssuer{
+				URL:                      "https://ejbca.example.com/ejbca/ejbca-rest-api",
+				CertificateAuthorityName: "ManagementCA",
+				CertificateProfileName:   "SERVER",
+				EndEntityProfileName:     "TLS",
+				Auth: cmapi.EJBCAAuth{
+					ClientCertSecretRef: &cmmeta.LocalObjectReference{Name: "ejbca-client"},
+				},
+			},
+		},
+		"valid with token": {
+			cfg: &cmapi.EJBCAIssuer{
+				URL:                      "https://ejbca.example.com/ejbca/ejbca-rest-api",
+				CertificateAuthorityName: "ManagementCA",
+				CertificateProfileName:   "SERVER",
+				EndEntityProfileName:     "TLS",
+				Auth: cmapi.EJBCAAuth{
+					TokenSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ejbca-token"}, Key: "token"},
+				},
+			},
+		},
+		"missing required fields": {
+			cfg: &cmapi.EJBCAIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), "URL is a required field"),
+				field.Required(fldPath.Child("certificateAuthorityName"), "certificate authority name is a required field"),
+				field.Required(fldPath.Child("certificateProfileName"), "certificate profile name is a required field"),
+				field.Required(fldPath.Child("endEntityProfileName"), "end entity profile name is a required field"),
+				field.Required(fldPath.Child("auth"), "please supply one of: clientCertSecretRef, tokenSecretRef"),
+			},
+		},
+		"URL is not https and both authentication methods are set": {
+			cfg: &cmapi.EJBCAIssuer{
+				URL:                      "http://ejbca.example.com/ejbca/ejbca-rest-api",
+				CertificateAuthorityName: "ManagementCA",
+				CertificateProfileName:   "SERVER",
+				EndEntityProfileName:     "TLS",
+				Auth: cmapi.EJBCAAuth{
+					ClientCertSecretRef: &cmmeta.LocalObjectReference{Name: "ejbca-client"},
+					TokenSecretRef:      &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ejbca-token"}, Key: "token"},
+				},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("url"), "http://ejbca.example.com/ejbca/ejbca-rest-api", "must be an https URL"),
+				field.Forbidden(fldPath.Child("auth"), "please supply one of: clientCertSecretRef, tokenSecretRef"),
+			},
+		},
+		"token secret without a key": {
+			cfg: &cmapi.EJBCAIssuer{
+				URL:                      "https://ejbca.example.com/ejbca/ejbca-rest-api",
+				CertificateAuthorityName: "ManagementCA",
+				CertificateProfileName:   "SERVER",
+				EndEntityProfileName:     "TLS",
+				Auth: cmapi.EJBCAAuth{
+					TokenSecretRef: &cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "ejbca-token"}},
+				},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("auth", "tokenSecretRef", "key"), "secret key is required"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateEJBCAIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStepCAIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	jwk := &cmapi.StepCAJWKProvisioner{
+		PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "step-password"}, Key: "password"},
+	}
+	x5c := &cmapi.StepCAX5CProvisioner{SecretRef: cmmeta.LocalObjectReference{Name: "step-x5c"}}
+	scenarios := map[string]struct {
+		cfg  *cmapi.StepCAIssuer
+		errs []*field.Error
+	}{
+		"valid with a JWK provisioner": {
+			cfg: &cmapi.StepCAIssuer{
+				URL:         "https://ca.example.com",
+				Provisioner: cmapi.StepCAProvisioner{Name: "admin", JWK: jwk},
+			},
+		},
+		"valid with an X5C provisioner": {
+			cfg: &cmapi.StepCAIssuer{
+				URL:         "https://ca.example.com",
+				Provisioner: cmapi.StepCAProvisioner{Name: "x5c", X5C: x5c},
+			},
+		},
+		"missing required fields": {
+			cfg: &cmapi.StepCAIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), "URL is a required field"),
+				field.Required(fldPath.Child("provisioner", "name"), "provisioner name is a required field"),
+				field.Required(fldPath.Child("provisioner"), "please supply one of: jwk, x5c"),
+			},
+		},
+		"URL is not https and both provisioner types are set": {
+			cfg: &cmapi.StepCAIssuer{
+				URL:         "http://ca.example.com",
+				Provisioner: cmapi.StepCAProvisioner{Name: "admin", JWK: jwk, X5C: x5c},
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("url"), "http://ca.example.com", "must be an https URL"),
+				field.Forbidden(fldPath.Child("provisioner"), "please supply one of: jwk, x5c"),
+			},
+		},
+		"JWK password secret without a name": {
+			cfg: &cmapi.StepCAIssuer{
+				URL: "https://ca.example.com",
+				Provisioner: cmapi.StepCAProvisioner{Name: "admin", JWK: &cmapi.StepCAJWKProvisioner{
+					PasswordSecretRef: cmmeta.SecretKeySelector{Key: "password"},
+				}},
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("provisioner", "jwk", "passwordSecretRef", "name"), "secret name is required"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateStepCAIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateKubernetesCSRIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.KubernetesCSRIssuer
+		errs []*field.Error
+	}{
+		"valid kubernetes signer": {
+			cfg: &cmapi.KubernetesCSRIssuer{SignerName: "kubernetes.io/kubelet-serving"},
+		},
+		"valid custom signer": {
+			cfg: &cmapi.KubernetesCSRIssuer{SignerName: "example.com/my-signer"},
+		},
+		"missing signer name": {
+			cfg: &cmapi.KubernetesCSRIssuer{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("signerName"), "signer name is a required field"),
+			},
+		},
+		"signer name without a path": {
+			cfg: &cmapi.KubernetesCSRIssuer{SignerName: "example.com"},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signerName"), "example.com", "must be of the form <domain>/<path>"),
+			},
+		},
+		"signer name without a domain": {
+			cfg: &cmapi.KubernetesCSRIssuer{SignerName: "my-signer/path"},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("signerName"), "my-signer/path", "must be of the form <domain>/<path>"),
+			},
+		},
+		"cert-manager issuer signer": {
+			cfg: &cmapi.KubernetesCSRIssuer{SignerName: "clusterissuers.cert-manager.io/my-issuer"},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("signerName"), "may not reference a cert-manager Issuer or ClusterIssuer"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateKubernetesCSRIssuerConfig(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSPIREIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	valid := func() *cmapi.SPIREIssuer {
+		return &cmapi.SPIREIssuer{
+			Address:             "spire-server.spire.svc:8081",
+			TrustDomain:         "example.org",
+			CABundle:            []byte("bundle"),
+			ClientCertSecretRef: cmmeta.LocalObjectReference{Name: "spire-admin"},
+		}
+	}
+	scenarios := map[string]struct {
+		cfg  func(*cmapi.SPIREIssuer)
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: func(*cmapi.SPIREIssuer) {},
+		},
+		"valid with a server SPIFFE ID": {
+			cfg: func(iss *cmapi.SPIREIssuer) {
+				iss.ServerSPIFFEID = "spiffe://example.org/spire/server"
+			},
+		},
+		"missing required fields": {
+			cfg: func(iss *cmapi.SPIREIssuer) {
+				*iss = cmapi.SPIREIssuer{}
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("address"), "address is a required field"),
+				field.Required(fldPath.Child("trustDomain"), "trust domain is a required field"),
+				field.Required(fldPath.Child("caBundle"), "CA bundle is a required field"),
+				field.Required(fldPath.Child("clientCertSecretRef", "name"), "secret name is required"),
+			},
+		},
+		"address without a port and trust domain with a scheme": {
+			cfg: func(iss *cmapi.SPIREIssuer) {
+				iss.Address = "spire-server"
+				iss.TrustDomain = "spiffe://example.org"
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("address"), "spire-server", "must be of the form <host>:<port>"),
+				field.Invalid(fldPath.Child("trustDomain"), "spiffe://example.org", "must be a lowercase trust domain name without a scheme or path"),
+			},
+		},
+		"server SPIFFE ID in another trust domain": {
+			cfg: func(iss *cmapi.SPIREIssuer) {
+				iss.ServerSPIFFEID = "spiffe://example.com/spire/server"
+			},
+			errs: []*field.Error{
+				field.Invalid(fldPath.Child("serverSPIFFEID"), "spiffe://example.com/spire/server", "must be a SPIFFE ID in the trust domain"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			cfg := valid()
+			s.cfg(cfg)
+			errs := ValidateSPIREIssuerConfig(cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePluginIssuerConfig(t *testing.T) {
+	fldPath := field.NewPath("test")
+	valid := func() *cmapi.PluginIssuer {
+		return &cmapi.PluginIssuer{
+			Address: "my-plugin.my-namespace.svc:8443",
+			Name:    "my-plugin",
+		}
+	}
+	scenarios := map[string]struct {
+		cfg  func(*cmapi.PluginIssuer)
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: func(*cmapi.PluginIssuer) {},
+		},
+		"valid with TLS configuration": {
+			cfg: func(iss *cmapi.PluginIssuer) {
+				iss.CABundle = []byte("bundle")
+				iss.ClientCertSecretRef = &cmmeta.LocalObjectReference{Name: "plugin-client"}
+			},
+		},
+		"valid insecure connection to a unix socket": {
+			cfg: func(iss *cmapi.PluginIssuer) {
+				iss.Address = "unix:///run/plugin/plugin.sock"
+				iss.Insecure = true
+			},
+		},
+		"missing required fields": {
+			cfg: func(iss *cmapi.PluginIssuer) {
+				*iss = cmapi.PluginIssuer{ClientCertSecretRef: &cmmeta.LocalObjectReference{}}
+			},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("address"), "address is a required field"),
+				field.Required(fldPath.Child("name"), "plugin name is a required field"),
+				field.Required(fldPath.Child("clientCertSecretRef", "name"), "secret name is required"),
+			},
+		},
+		"TLS configuration for an insecure connection": {
+			cfg: func(iss *cmapi.PluginIssuer) {
+				iss.Insecure = true
+				iss.CABundle = []byte("bundle")
+				iss.ClientCertSecretRef = &cmmeta.LocalObjectReference{Name: "plugin-client"}
+			},
+			errs: []*field.Error{
+				field.Forbidden(fldPath.Child("caBundle"), "may not be specified for an insecure connection"),
+				field.Forbidden(fldPath.Child("clientCertSecretRef"), "may not be specified for an insecure connection"),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			cfg := valid()
+			s.cfg(cfg)
+			errs := ValidatePluginIssuerConfig(cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateVenafiTPP(t *testing.T) {
+	fldPath := field.NewPath("test")
+	scenarios := map[string]struct {
+		cfg  *cmapi.VenafiTPP
+		errs []*field.Error
+	}{
+		"valid": {
+			cfg: &cmapi.VenafiTPP{
+				URL: "https://tpp.example.com/vedsdk",
+			},
+		},
+		"missing url": {
+			cfg: &cmapi.VenafiTPP{},
+			errs: []*field.Error{
+				field.Required(fldPath.Child("url"), ""),
+			},
+		},
+	}
+
+	for n, s := range scenarios {
+		t.Run(n, func(t *testing.T) {
+			errs := ValidateVenafiTPP(s.cfg, fldPath)
+			if len(errs) != len(s.errs) {
+				t.Fatalf("Expected %v but got %v", s.errs, errs)
+			}
+			for i, e := range errs {
+				expectedErr := s.errs[i]
+				if !reflect.DeepEqual(e, expectedErr) {
+					t.Errorf("Expected %v but got %v", expectedErr, e)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateIssuer(t *testing.T) {
+	scenarios := map[string]struct {
+		cfg       *cmapi.Issuer
+		a         *admissionv1.AdmissionRequest
+		expectedE []*field.Error
+		expectedW []string
+	}{
+		"credentialsNamespace is forbidden on Issuers": {
+			cfg: &cmapi.Issuer{
+				Spec: cmapi.IssuerSpec{
+					IssuerConfig: cmapi.IssuerConfig{
+						SelfSigned: &cmapi.SelfSignedIssuer{},
+					},
+					CredentialsNamespace: "team-a",
+				},
+			},
+			expectedE: []*field.Error{
+				field.Forbidden(field.NewPath("spec", "credentialsNamespace"), "credentialsNamespace may only be set on ClusterIssuers"),
+			},
+		},
+	}
 
 	for n, s := range scenarios {
 		t.Run(n, func(t *testing.T) {
@@ -21,4 +21,7 @@ package validation
 const (
 	// deprecatedACMEEABKeyAlgorithmField is raised when the deprecated keyAlgorithm field for an ACME issuer's external account binding (EAB) is set.
 	deprecatedACMEEABKeyAlgorithmField = "ACME issuer spec field 'externalAccount.keyAlgorithm' is deprecated. The value of this field will be ignored."
+
+	// deprecatedACMEHTTP01IngressClassField is raised when an HTTP01 ingress solver sets the deprecated class field, which uses the kubernetes.io/ingress.class annotation.
+	deprecatedACMEHTTP01IngressClassField = "ACME issuer solver field 'http01.ingress.class' is deprecated as it sets the 'kubernetes.io/ingress.class' annotation. Use 'http01.ingress.ingressClassName' instead."
 )
@@ -171,6 +171,7 @@ func autoConvert_v1alpha1_WebhookConfiguration_To_webhook_WebhookConfiguration(i
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LogLevel = (*int32)(unsafe.Pointer(in.LogLevel))
 	return nil
 }
 
@@ -190,6 +191,7 @@ func autoConvert_webhook_WebhookConfiguration_To_v1alpha1_WebhookConfiguration(i
 	out.EnablePprof = in.EnablePprof
 	out.PprofAddress = in.PprofAddress
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
+	out.LogLevel = (*int32)(unsafe.Pointer(in.LogLevel))
 	return nil
 }
 
@@ -54,5 +54,8 @@ func ValidateWebhookConfiguration(cfg *config.WebhookConfiguration) error {
 	if cfg.SecurePort == nil {
 		allErrors = append(allErrors, fmt.Errorf("invalid configuration: securePort must be specified"))
 	}
+	if cfg.LogLevel != nil && *cfg.LogLevel < 0 {
+		allErrors = append(allErrors, fmt.Errorf("invalid configuration: logLevel must not be negative"))
+	}
 	return utilerrors.NewAggregate(allErrors)
 }
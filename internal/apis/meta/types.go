@@ -72,4 +72,8 @@ type SecretKeySelector struct {
 const (
 	// Used as a data key in Secret resources to store a CA certificate.
 	TLSCAKey = "ca.crt"
+
+	// Used as a data key in Secret and ConfigMap resources to store a PEM
+	// encoded certificate revocation list.
+	CRLKey = "ca.crl"
 )
@@ -0,0 +1,245 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approvalpolicy evaluates CertificateRequestPolicies, which decide
+// whether the CertificateRequests for the issuers they select are approved.
+package approvalpolicy
+
+import (
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/cert-manager/cert-manager/internal/cel"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const serviceAccountUsernamePrefix = "system:serviceaccount:"
+
+// Compile compiles the expression of a rule.
+func Compile(expression string) (*cel.Program, error) {
+	return cel.Compile(expression, "request", "csr")
+}
+
+// Decision is the outcome of evaluating the policies for a
+// CertificateRequest.
+type Decision struct {
+	// Selected is true if any policy selects the CertificateRequest.
+	Selected bool
+
+	// Approved is true if the CertificateRequest is approved, either because
+	// it is not selected by any policy or because a selecting policy
+	// approves it.
+	Approved bool
+
+	// Policy is the name of the policy which approved the CertificateRequest.
+	Policy string
+
+	// Message explains why the CertificateRequest was denied, naming each
+	// selecting policy and the rules it failed.
+	Message string
+}
+
+// Evaluate decides whether the CertificateRequest is approved by the given
+// policies. Rules which fail to compile or evaluate are treated as not
+// satisfied.
+func Evaluate(policies []*cmapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) Decision {
+	var selected []*cmapi.CertificateRequestPolicy
+	for _, policy := range policies {
+		if Selects(policy, cr) {
+			selected = append(selected, policy)
+		}
+	}
+	if len(selected) == 0 {
+		return Decision{Approved: true}
+	}
+	sort.Slice(selected, func(i, j int) bool {
+		return selected[i].Name < selected[j].Name
+	})
+
+	vars, err := Variables(cr)
+	if err != nil {
+		return Decision{Selected: true, Message: err.Error()}
+	}
+
+	var denials []string
+	for _, policy := range selected {
+		failed := failedRules(policy, vars)
+		if len(failed) == 0 {
+			return Decision{Selected: true, Approved: true, Policy: policy.Name}
+		}
+		denials = append(denials, fmt.Sprintf("policy %q: %s", policy.Name, strings.Join(failed, ", ")))
+	}
+
+	return Decision{Selected: true, Message: strings.Join(denials, "; ")}
+}
+
+// failedRules returns a description of each rule of the policy which is not
+// satisfied.
+func failedRules(policy *cmapi.CertificateRequestPolicy, vars map[string]interface{}) []string {
+	var failed []string
+	for _, rule := range policy.Spec.Rules {
+		message := rule.Message
+		if message == "" {
+			message = rule.Expression
+		}
+
+		program, err := Compile(rule.Expression)
+		if err != nil {
+			failed = append(failed, fmt.Sprintf("rule %q failed to compile: %v", rule.Name, err))
+			continue
+		}
+		ok, err := program.EvalBool(vars)
+		switch {
+		case err != nil:
+			failed = append(failed, fmt.Sprintf("rule %q failed to evaluate: %v", rule.Name, err))
+		case !ok:
+			failed = append(failed, fmt.Sprintf("rule %q: %s", rule.Name, message))
+		}
+	}
+	return failed
+}
+
+// Selects returns true if the policy applies to the CertificateRequest.
+func Selects(policy *cmapi.CertificateRequestPolicy, cr *cmapi.CertificateRequest) bool {
+	ref := policy.Spec.Selector.IssuerRef
+	kind, group := issuerKindAndGroup(cr)
+	return matchWildcard(ref.Name, cr.Spec.IssuerRef.Name) &&
+		matchWildcard(ref.Kind, kind) &&
+		matchWildcard(ref.Group, group)
+}
+
+// issuerKindAndGroup returns the kind and group of the issuer referenced by
+// the CertificateRequest, defaulting them as cert-manager does.
+func issuerKindAndGroup(cr *cmapi.CertificateRequest) (string, string) {
+	kind, group := cr.Spec.IssuerRef.Kind, cr.Spec.IssuerRef.Group
+	if kind == "" {
+		kind = cmapi.IssuerKind
+	}
+	if group == "" {
+		group = certmanager.GroupName
+	}
+	return kind, group
+}
+
+// matchWildcard returns true if the value matches the pattern, in which `*`
+// matches any number of characters. An empty pattern matches any value.
+func matchWildcard(pattern, value string) bool {
+	if pattern == "" {
+		return true
+	}
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+// Variables returns the values of the variables which rule expressions can
+// reference for the CertificateRequest.
+func Variables(cr *cmapi.CertificateRequest) (map[string]interface{}, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode certificate signing request: %w", err)
+	}
+	keyAlgorithm, keySize, err := publicKeyAlgorithmAndSize(csr)
+	if err != nil {
+		return nil, err
+	}
+
+	var saNamespace, saName string
+	if strings.HasPrefix(cr.Spec.Username, serviceAccountUsernamePrefix) {
+		parts := strings.Split(strings.TrimPrefix(cr.Spec.Username, serviceAccountUsernamePrefix), ":")
+		if len(parts) == 2 {
+			saNamespace, saName = parts[0], parts[1]
+		}
+	}
+
+	duration := cmapi.DefaultCertificateDuration
+	if cr.Spec.Duration != nil {
+		duration = cr.Spec.Duration.Duration
+	}
+
+	usages := make([]string, len(cr.Spec.Usages))
+	for i, u := range cr.Spec.Usages {
+		usages[i] = string(u)
+	}
+
+	kind, group := issuerKindAndGroup(cr)
+
+	return map[string]interface{}{
+		"request": map[string]interface{}{
+			"name":      cr.Name,
+			"namespace": cr.Namespace,
+			"username":  cr.Spec.Username,
+			"groups":    cr.Spec.Groups,
+			"serviceAccount": map[string]interface{}{
+				"namespace": saNamespace,
+				"name":      saName,
+			},
+			"issuerRef": map[string]interface{}{
+				"name":  cr.Spec.IssuerRef.Name,
+				"kind":  kind,
+				"group": group,
+			},
+			"isCA":     cr.Spec.IsCA,
+			"duration": int64(duration.Seconds()),
+			"usages":   usages,
+		},
+		"csr": map[string]interface{}{
+			"commonName":     csr.Subject.CommonName,
+			"organizations":  csr.Subject.Organization,
+			"dnsNames":       csr.DNSNames,
+			"ipAddresses":    pki.IPAddressesToString(csr.IPAddresses),
+			"uris":           pki.URLsToString(csr.URIs),
+			"emailAddresses": csr.EmailAddresses,
+			"keyAlgorithm":   keyAlgorithm,
+			"keySize":        keySize,
+		},
+	}, nil
+}
+
+// publicKeyAlgorithmAndSize returns the name of the algorithm of the public
+// key in the certificate signing request and its size in bits.
+func publicKeyAlgorithmAndSize(csr *x509.CertificateRequest) (string, int, error) {
+	switch pub := csr.PublicKey.(type) {
+	case *rsa.PublicKey:
+		return "RSA", pub.N.BitLen(), nil
+	case *ecdsa.PublicKey:
+		return "ECDSA", pub.Curve.Params().BitSize, nil
+	case ed25519.PublicKey:
+		return "Ed25519", 256, nil
+	default:
+		return "", 0, fmt.Errorf("unsupported public key type %T", csr.PublicKey)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalpolicy
+
+import (
+	"crypto/x509"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func policy(name string, ref cmapi.CertificateRequestPolicyIssuerRef, rules ...cmapi.CertificateRequestPolicyRule) *cmapi.CertificateRequestPolicy {
+	p := &cmapi.CertificateRequestPolicy{
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: cmapi.CertificateRequestPolicySelector{IssuerRef: ref},
+			Rules:    rules,
+		},
+	}
+	p.Name = name
+	return p
+}
+
+func TestEvaluate(t *testing.T) {
+	csr, _, err := gen.CSR(x509.ECDSA,
+		gen.SetCSRCommonName("app.example.com"),
+		gen.SetCSRDNSNames("app.example.com", "www.example.com"),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("team-a"),
+		gen.SetCertificateRequestCSR(csr),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer", Kind: "ClusterIssuer"}),
+		gen.SetCertificateRequestUsername("system:serviceaccount:team-a:deployer"),
+	)
+
+	exampleOnly := cmapi.CertificateRequestPolicyRule{
+		Name:       "example-only",
+		Expression: `csr.dnsNames.all(n, n.endsWith('.example.com'))`,
+	}
+	teamB := cmapi.CertificateRequestPolicyRule{
+		Name:       "team-b",
+		Expression: `request.namespace == 'team-b'`,
+		Message:    "only team-b may use this issuer",
+	}
+	strongKey := cmapi.CertificateRequestPolicyRule{
+		Name:       "strong-key",
+		Expression: `csr.keyAlgorithm == 'ECDSA' && csr.keySize >= 256 && request.serviceAccount.name == 'deployer'`,
+	}
+	broken := cmapi.CertificateRequestPolicyRule{
+		Name:       "broken",
+		Expression: `csr.commonName`,
+	}
+
+	tests := map[string]struct {
+		policies []*cmapi.CertificateRequestPolicy
+		exp      Decision
+	}{
+		"requests not selected by any policy are approved": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				policy("other", cmapi.CertificateRequestPolicyIssuerRef{Name: "other-issuer"}, teamB),
+				policy("issuers", cmapi.CertificateRequestPolicyIssuerRef{Kind: "Issuer"}, teamB),
+			},
+			exp: Decision{Approved: true},
+		},
+		"requests are approved by a policy whose rules are all satisfied": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				policy("ca", cmapi.CertificateRequestPolicyIssuerRef{Name: "ca-*", Group: "cert-manager.io"}, exampleOnly, strongKey),
+			},
+			exp: Decision{Selected: true, Approved: true, Policy: "ca"},
+		},
+		"requests are approved if any selecting policy approves them": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				policy("a", cmapi.CertificateRequestPolicyIssuerRef{}, teamB),
+				policy("b", cmapi.CertificateRequestPolicyIssuerRef{Kind: "ClusterIssuer"}, exampleOnly),
+			},
+			exp: Decision{Selected: true, Approved: true, Policy: "b"},
+		},
+		"requests are denied if every selecting policy denies them": {
+			policies: []*cmapi.CertificateRequestPolicy{
+				policy("b", cmapi.CertificateRequestPolicyIssuerRef{}, exampleOnly, broken),
+				policy("a", cmapi.CertificateRequestPolicyIssuerRef{Name: "*-issuer"}, teamB),
+			},
+			exp: Decision{
+				Selected: true,
+				Message:  `policy "a": rule "team-b": only team-b may use this issuer; policy "b": rule "broken" failed to evaluate: expression must evaluate to a bool, got string`,
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := Evaluate(test.policies, cr)
+			if got != test.exp {
+				t.Errorf("unexpected decision, exp=%+v, got=%+v", test.exp, got)
+			}
+		})
+	}
+}
+
+func TestEvaluateInvalidCSR(t *testing.T) {
+	cr := gen.CertificateRequest("test", gen.SetCertificateRequestCSR([]byte("not a csr")))
+	got := Evaluate([]*cmapi.CertificateRequestPolicy{policy("all", cmapi.CertificateRequestPolicyIssuerRef{})}, cr)
+	if !got.Selected || got.Approved || got.Message == "" {
+		t.Errorf("expected request with an invalid CSR to be denied, got=%+v", got)
+	}
+}
+
+func TestMatchWildcard(t *testing.T) {
+	tests := []struct {
+		pattern, value string
+		exp            bool
+	}{
+		{"", "anything", true},
+		{"issuer", "issuer", true},
+		{"issuer", "issuer-2", false},
+		{"*", "", true},
+		{"ca-*", "ca-issuer", true},
+		{"ca-*", "my-ca-issuer", false},
+		{"*.cert-manager.io", "cas.cert-manager.io", true},
+		{"*-*-issuer", "team-a-issuer", true},
+		{"a*a", "a", false},
+	}
+	for _, test := range tests {
+		if got := matchWildcard(test.pattern, test.value); got != test.exp {
+			t.Errorf("matchWildcard(%q, %q) = %t, expected %t", test.pattern, test.value, got, test.exp)
+		}
+	}
+}
@@ -0,0 +1,254 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package approvalwebhook asks an external HTTPS endpoint whether
+// CertificateRequests are approved, allowing approval to be integrated with
+// ticketing or custom policy systems.
+//
+// For each pending CertificateRequest the endpoint is sent a POST request
+// with a JSON encoded Review, holding the same details of the
+// CertificateRequest and its decoded signing request that are available to
+// CertificateRequestPolicy rules. The endpoint must respond with a 200 status
+// and a JSON encoded Response holding its Decision.
+//
+// The endpoint must be served over HTTPS, and should authenticate cert-manager
+// so that only cert-manager can ask it for decisions, and learn about pending
+// CertificateRequests. Use a client certificate if the endpoint terminates TLS
+// itself and can verify client certificates. Use a bearer token file if the
+// endpoint is behind a proxy or gateway which terminates TLS and checks the
+// Authorization header, for example with a projected ServiceAccount token.
+package approvalwebhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"time"
+
+	"github.com/cert-manager/cert-manager/internal/approvalpolicy"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// maxResponseSize is the maximum size of the body of a response read from
+// the endpoint.
+const maxResponseSize = 64 * 1024
+
+// Decision is the decision of the endpoint about a CertificateRequest.
+type Decision string
+
+const (
+	// DecisionApprove approves the CertificateRequest.
+	DecisionApprove Decision = "Approve"
+	// DecisionDeny denies the CertificateRequest.
+	DecisionDeny Decision = "Deny"
+	// DecisionPending leaves the CertificateRequest pending, for example
+	// while a ticket for it is reviewed, and causes it to be sent to the
+	// endpoint again later.
+	DecisionPending Decision = "Pending"
+)
+
+// Review is the body of the requests sent to the endpoint.
+type Review struct {
+	// UID of the CertificateRequest, which is the same for every review of
+	// the same CertificateRequest.
+	UID string `json:"uid"`
+
+	// Request holds the details of the CertificateRequest.
+	Request map[string]interface{} `json:"request"`
+
+	// CSR holds the details of the decoded certificate signing request.
+	CSR map[string]interface{} `json:"csr"`
+}
+
+// Response is the body of the responses of the endpoint.
+type Response struct {
+	// Decision about the CertificateRequest.
+	Decision Decision `json:"decision"`
+
+	// Message explains the decision, and is recorded on the condition set
+	// on the CertificateRequest.
+	Message string `json:"message,omitempty"`
+}
+
+// Options configures a Client.
+type Options struct {
+	// URL of the endpoint, which must use the https scheme.
+	URL string
+
+	// CAFile is the path to a PEM encoded bundle of the CAs trusted to
+	// serve the endpoint. If empty, the system trust store is used.
+	CAFile string
+
+	// ClientCertFile and ClientKeyFile are the paths to the PEM encoded
+	// certificate and private key presented to the endpoint. They are
+	// read again for every TLS handshake, so that they can be renewed
+	// without restarting cert-manager. Both or neither must be set.
+	ClientCertFile string
+	ClientKeyFile  string
+
+	// TokenFile is the path to a file holding a bearer token sent to the
+	// endpoint in the Authorization header. It is read again for every
+	// request, so that the token can be rotated without restarting
+	// cert-manager.
+	TokenFile string
+
+	// Timeout of each request to the endpoint.
+	Timeout time.Duration
+}
+
+// Client sends CertificateRequests to the endpoint for review.
+type Client struct {
+	url       string
+	tokenFile string
+	client    *http.Client
+}
+
+// New returns a Client for the endpoint configured by the options.
+func New(opts Options) (*Client, error) {
+	u, err := url.Parse(opts.URL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("invalid URL %q: scheme must be https", opts.URL)
+	}
+	if (len(opts.ClientCertFile) > 0) != (len(opts.ClientKeyFile) > 0) {
+		return nil, errors.New("both or neither of the client certificate and key files must be set")
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(opts.CAFile) > 0 {
+		caPEM, err := os.ReadFile(opts.CAFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read CA file: %w", err)
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caPEM) {
+			return nil, fmt.Errorf("no certificates found in CA file %q", opts.CAFile)
+		}
+		tlsConfig.RootCAs = pool
+	}
+	if len(opts.ClientCertFile) > 0 {
+		// Fail early if the key pair cannot be loaded, rather than on the
+		// first review.
+		if _, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile); err != nil {
+			return nil, fmt.Errorf("failed to load client certificate: %w", err)
+		}
+		tlsConfig.GetClientCertificate = func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, err := tls.LoadX509KeyPair(opts.ClientCertFile, opts.ClientKeyFile)
+			if err != nil {
+				return nil, fmt.Errorf("failed to load client certificate: %w", err)
+			}
+			return &cert, nil
+		}
+	}
+	if len(opts.TokenFile) > 0 {
+		if _, err := readToken(opts.TokenFile); err != nil {
+			return nil, err
+		}
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	return &Client{
+		url:       u.String(),
+		tokenFile: opts.TokenFile,
+		client: &http.Client{
+			Transport: transport,
+			Timeout:   opts.Timeout,
+		},
+	}, nil
+}
+
+// readToken returns the bearer token held in the file.
+func readToken(path string) (string, error) {
+	token, err := os.ReadFile(path)
+	if err != nil {
+		return "", fmt.Errorf("failed to read token file: %w", err)
+	}
+	if len(bytes.TrimSpace(token)) == 0 {
+		return "", fmt.Errorf("token file %q is empty", path)
+	}
+	return string(bytes.TrimSpace(token)), nil
+}
+
+// Review sends the CertificateRequest to the endpoint and returns its
+// response.
+func (c *Client) Review(ctx context.Context, cr *cmapi.CertificateRequest) (*Response, error) {
+	vars, err := approvalpolicy.Variables(cr)
+	if err != nil {
+		return nil, err
+	}
+	review := Review{
+		UID:     string(cr.UID),
+		Request: vars["request"].(map[string]interface{}),
+		CSR:     vars["csr"].(map[string]interface{}),
+	}
+	body, err := json.Marshal(review)
+	if err != nil {
+		return nil, err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.url, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if len(c.tokenFile) > 0 {
+		token, err := readToken(c.tokenFile)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to send CertificateRequest for approval: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("approval webhook responded with unexpected status %q", resp.Status)
+	}
+
+	respBody, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read approval webhook response: %w", err)
+	}
+	var response Response
+	if err := json.Unmarshal(respBody, &response); err != nil {
+		return nil, fmt.Errorf("failed to decode approval webhook response: %w", err)
+	}
+
+	switch response.Decision {
+	case DecisionApprove, DecisionDeny, DecisionPending:
+		return &response, nil
+	case "":
+		return nil, errors.New("approval webhook response has no decision")
+	default:
+		return nil, fmt.Errorf("approval webhook responded with unknown decision %q", response.Decision)
+	}
+}
@@ -0,0 +1,253 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package approvalwebhook
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestReview(t *testing.T) {
+	csr, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("app.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("team-a"),
+		gen.SetCertificateRequestCSR(csr),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+	)
+	cr.UID = "uid"
+
+	tests := map[string]struct {
+		status int
+		body   string
+		exp    *Response
+		err    string
+	}{
+		"approve": {
+			status: http.StatusOK,
+			body:   `{"decision": "Approve"}`,
+			exp:    &Response{Decision: DecisionApprove},
+		},
+		"deny with a message": {
+			status: http.StatusOK,
+			body:   `{"decision": "Deny", "message": "ticket CERT-1 was rejected"}`,
+			exp:    &Response{Decision: DecisionDeny, Message: "ticket CERT-1 was rejected"},
+		},
+		"pending": {
+			status: http.StatusOK,
+			body:   `{"decision": "Pending"}`,
+			exp:    &Response{Decision: DecisionPending},
+		},
+		"unknown decisions are rejected": {
+			status: http.StatusOK,
+			body:   `{"decision": "Maybe"}`,
+			err:    `approval webhook responded with unknown decision "Maybe"`,
+		},
+		"missing decisions are rejected": {
+			status: http.StatusOK,
+			body:   `{}`,
+			err:    "approval webhook response has no decision",
+		},
+		"error statuses are rejected": {
+			status: http.StatusInternalServerError,
+			body:   `{"decision": "Approve"}`,
+			err:    `approval webhook responded with unexpected status "500 Internal Server Error"`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var review Review
+				if err := json.NewDecoder(r.Body).Decode(&review); err != nil {
+					t.Errorf("failed to decode review: %v", err)
+				}
+				if review.UID != "uid" || review.Request["namespace"] != "team-a" || review.CSR["keyAlgorithm"] != "ECDSA" {
+					t.Errorf("unexpected review: %+v", review)
+				}
+				w.WriteHeader(test.status)
+				w.Write([]byte(test.body))
+			}))
+			defer server.Close()
+
+			client, err := New(Options{URL: server.URL, CAFile: serverCAFile(t, server), Timeout: time.Second})
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Review(context.Background(), cr)
+			if test.err != "" {
+				if err == nil || err.Error() != test.err {
+					t.Fatalf("expected error %q, got %v", test.err, err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if *resp != *test.exp {
+				t.Errorf("unexpected response, exp=%+v, got=%+v", test.exp, resp)
+			}
+		})
+	}
+}
+
+func TestReviewAuthentication(t *testing.T) {
+	csr, _, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("app.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("test", gen.SetCertificateRequestCSR(csr))
+
+	t.Run("bearer token", func(t *testing.T) {
+		server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if auth := r.Header.Get("Authorization"); auth != "Bearer secret-token" {
+				t.Errorf("unexpected Authorization header %q", auth)
+				w.WriteHeader(http.StatusUnauthorized)
+				return
+			}
+			w.Write([]byte(`{"decision": "Approve"}`))
+		}))
+		defer server.Close()
+
+		client, err := New(Options{
+			URL:       server.URL,
+			CAFile:    serverCAFile(t, server),
+			TokenFile: writeFile(t, "token", []byte("secret-token\n")),
+			Timeout:   time.Second,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := client.Review(context.Background(), cr); err != nil {
+			t.Fatal(err)
+		}
+	})
+
+	t.Run("client certificate", func(t *testing.T) {
+		certPEM, keyPEM, cert := clientCertificate(t)
+		server := httptest.NewUnstartedServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			w.Write([]byte(`{"decision": "Approve"}`))
+		}))
+		pool := x509.NewCertPool()
+		pool.AddCert(cert)
+		server.TLS = &tls.Config{ClientAuth: tls.RequireAndVerifyClientCert, ClientCAs: pool}
+		server.StartTLS()
+		defer server.Close()
+
+		withoutCert, err := New(Options{URL: server.URL, CAFile: serverCAFile(t, server), Timeout: time.Second})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := withoutCert.Review(context.Background(), cr); err == nil {
+			t.Error("expected the review to fail without a client certificate")
+		}
+
+		withCert, err := New(Options{
+			URL:            server.URL,
+			CAFile:         serverCAFile(t, server),
+			ClientCertFile: writeFile(t, "tls.crt", certPEM),
+			ClientKeyFile:  writeFile(t, "tls.key", keyPEM),
+			Timeout:        time.Second,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := withCert.Review(context.Background(), cr); err != nil {
+			t.Fatal(err)
+		}
+	})
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New(Options{URL: "http://example.com"}); err == nil {
+		t.Error("expected URLs without the https scheme to be rejected")
+	}
+	if _, err := New(Options{URL: "https://example.com", CAFile: "/does/not/exist"}); err == nil {
+		t.Error("expected a missing CA file to be rejected")
+	}
+	if _, err := New(Options{URL: "https://example.com", ClientCertFile: "/tls.crt"}); err == nil {
+		t.Error("expected a client certificate without a key to be rejected")
+	}
+	if _, err := New(Options{URL: "https://example.com", TokenFile: writeFile(t, "token", nil)}); err == nil {
+		t.Error("expected an empty token file to be rejected")
+	}
+}
+
+// writeFile writes the data to a file in a temporary directory and returns
+// its path.
+func writeFile(t *testing.T, name string, data []byte) string {
+	path := filepath.Join(t.TempDir(), name)
+	if err := os.WriteFile(path, data, 0600); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+// serverCAFile writes the certificate of the test server to a file, so that
+// it is trusted by the client.
+func serverCAFile(t *testing.T, server *httptest.Server) string {
+	return writeFile(t, "ca.crt", pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+}
+
+// clientCertificate returns a PEM encoded self-signed client certificate and
+// its key.
+func clientCertificate(t *testing.T) ([]byte, []byte, *x509.Certificate) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "cert-manager"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}),
+		cert
+}
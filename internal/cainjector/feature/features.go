@@ -30,6 +30,22 @@ const (
 // Alpha: vX.Y
 // Beta: ...
 //FeatureName featuregate.Feature = "FeatureName"
+
+	// alpha: v1.10.0
+	//
+	// InjectCAIntoConfigMapsAndSecrets enables the injection of CA data into
+	// the `ca.crt` key of ConfigMaps and Secrets which are annotated with
+	// `cert-manager.io/inject-ca-from` or `cert-manager.io/inject-ca-from-secret`.
+	// This requires the cainjector to cache all ConfigMaps in the cluster.
+	InjectCAIntoConfigMapsAndSecrets featuregate.Feature = "InjectCAIntoConfigMapsAndSecrets"
+
+	// alpha: v1.10.0
+	//
+	// InjectCAIntoClusterTrustBundles enables the injection of CA data into the
+	// trust bundle of ClusterTrustBundles (certificates.k8s.io/v1alpha1) which are
+	// annotated with `cert-manager.io/inject-ca-from` or `cert-manager.io/inject-ca-from-secret`.
+	// This requires the ClusterTrustBundle API to be enabled on the API server.
+	InjectCAIntoClusterTrustBundles featuregate.Feature = "InjectCAIntoClusterTrustBundles"
 )
 
 func init() {
@@ -41,4 +57,7 @@ func init() {
 // To check whether a feature is enabled, use:
 //   utilfeature.DefaultFeatureGate.Enabled(feature.FeatureName)
 // Where utilfeature is github.com/cert-manager/cert-manager/pkg/util/feature.
-var cainjectorFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{}
+var cainjectorFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
+	InjectCAIntoConfigMapsAndSecrets: {Default: false, PreRelease: featuregate.Alpha},
+	InjectCAIntoClusterTrustBundles:  {Default: false, PreRelease: featuregate.Alpha},
+}
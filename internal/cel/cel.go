@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cel compiles and evaluates Common Expression Language (CEL)
+// expressions over plain data, using the CEL runtime with its standard
+// library. Expressions may only reference the variables they are compiled
+// with, and the cost of evaluating them is limited.
+package cel
+
+import (
+	"fmt"
+
+	"github.com/google/cel-go/cel"
+	"github.com/google/cel-go/checker/decls"
+	"github.com/google/cel-go/common/types/ref"
+	exprpb "google.golang.org/genproto/googleapis/api/expr/v1alpha1"
+)
+
+// costLimit is the maximum runtime cost of a single evaluation of a program,
+// which bounds the time spent in comprehensions.
+const costLimit = 1000000
+
+// Program is a compiled expression.
+type Program struct {
+	expr    string
+	program cel.Program
+	vars    []string
+}
+
+// Compile compiles an expression which may reference the given variables,
+// which can hold values of any type.
+func Compile(expr string, vars ...string) (*Program, error) {
+	declarations := make([]*exprpb.Decl, len(vars))
+	for i, v := range vars {
+		declarations[i] = decls.NewVar(v, decls.Dyn)
+	}
+	env, err := cel.NewEnv(cel.Declarations(declarations...))
+	if err != nil {
+		return nil, err
+	}
+
+	ast, issues := env.Compile(expr)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	program, err := env.Program(ast, cel.CostLimit(costLimit))
+	if err != nil {
+		return nil, err
+	}
+	return &Program{expr: expr, program: program, vars: vars}, nil
+}
+
+// String returns the expression the program was compiled from.
+func (p *Program) String() string {
+	return p.expr
+}
+
+// Eval evaluates the program with the given values of its variables, which
+// must all be set. Values must be made of bools, integers, strings, slices
+// and maps with string keys.
+func (p *Program) Eval(vars map[string]interface{}) (interface{}, error) {
+	out, err := p.eval(vars)
+	if err != nil {
+		return nil, err
+	}
+	return out.Value(), nil
+}
+
+// EvalBool evaluates a program which must evaluate to a bool.
+func (p *Program) EvalBool(vars map[string]interface{}) (bool, error) {
+	out, err := p.eval(vars)
+	if err != nil {
+		return false, err
+	}
+	b, ok := out.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression must evaluate to a bool, got %s", out.Type().TypeName())
+	}
+	return b, nil
+}
+
+func (p *Program) eval(vars map[string]interface{}) (ref.Val, error) {
+	for _, name := range p.vars {
+		if _, ok := vars[name]; !ok {
+			return nil, fmt.Errorf("no value for variable %q", name)
+		}
+	}
+	out, _, err := p.program.Eval(vars)
+	return out, err
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cel
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEval(t *testing.T) {
+	vars := map[string]interface{}{
+		"csr": map[string]interface{}{
+			"commonName": "foo.example.com",
+			"dnsNames":   []string{"foo.example.com", "bar.example.com"},
+			"keySize":    2048,
+			"isCA":       false,
+		},
+		"team":  "team-a",
+		"empty": []string(nil),
+	}
+
+	tests := map[string]struct {
+		expr   string
+		exp    interface{}
+		expErr string
+	}{
+		"field selection":         {expr: `csr.commonName`, exp: "foo.example.com"},
+		"map index":               {expr: `csr['keySize']`, exp: int64(2048)},
+		"list index":              {expr: `csr.dnsNames[1]`, exp: "bar.example.com"},
+		"in list":                 {expr: `team in ['team-a', 'team-b']`, exp: true},
+		"size":                    {expr: `size(csr.dnsNames) == 2 && size(empty) == 0`, exp: true},
+		"has":                     {expr: `has(csr.commonName) && !has(csr.uris)`, exp: true},
+		"string functions":        {expr: `team.startsWith('team-') && csr.commonName.matches('^[a-z]+\\.example\\.com$')`, exp: true},
+		"macros":                  {expr: `csr.dnsNames.all(n, n.endsWith('.example.com')) && csr.dnsNames.exists_one(n, n.startsWith('bar.'))`, exp: true},
+		"missing keys":            {expr: `csr.missing`, expErr: "no such key: missing"},
+		"type errors":             {expr: `team + 1`, expErr: "no such overload"},
+		"cost limit exceeded":     {expr: strings.Repeat("[1, 2, 3, 4].all(x, ", 12) + "true" + strings.Repeat(")", 12), expErr: "cost limit exceeded"},
+		"macros over empty lists": {expr: `empty.exists(x, x == 'a')`, exp: false},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := Compile(test.expr, "csr", "team", "empty")
+			if err != nil {
+				t.Fatalf("unexpected compile error: %v", err)
+			}
+			v, err := p.Eval(vars)
+			if test.expErr != "" {
+				if err == nil || !strings.Contains(err.Error(), test.expErr) {
+					t.Fatalf("expected error containing %q, got %v (%v)", test.expErr, err, v)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(test.exp, v) {
+				t.Errorf("expected %#v, got %#v", test.exp, v)
+			}
+		})
+	}
+}
+
+func TestCompileErrors(t *testing.T) {
+	tests := map[string]struct {
+		expr   string
+		expErr string
+	}{
+		"empty expression":     {expr: ``, expErr: "Syntax error"},
+		"undeclared variable":  {expr: `foo == 1`, expErr: "undeclared reference to 'foo'"},
+		"undeclared function":  {expr: `foo(1)`, expErr: "undeclared reference to 'foo'"},
+		"macro variable scope": {expr: `csr.all(x, true) && x`, expErr: "undeclared reference to 'x'"},
+		"invalid macro":        {expr: `csr.all(1, true)`, expErr: "argument must be a simple name"},
+		"nested too deeply":    {expr: strings.Repeat("(", 300) + "1" + strings.Repeat(")", 300), expErr: "recursion limit exceeded"},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			_, err := Compile(test.expr, "csr")
+			if err == nil || !strings.Contains(err.Error(), test.expErr) {
+				t.Errorf("expected error containing %q, got %v", test.expErr, err)
+			}
+		})
+	}
+}
+
+func TestEvalBool(t *testing.T) {
+	p, err := Compile(`x > 1`, "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if ok, err := p.EvalBool(map[string]interface{}{"x": 2}); err != nil || !ok {
+		t.Errorf("expected true, got %v, %v", ok, err)
+	}
+	if _, err := p.EvalBool(map[string]interface{}{}); err == nil || !strings.Contains(err.Error(), `no value for variable "x"`) {
+		t.Errorf("expected missing variable error, got %v", err)
+	}
+
+	p, err = Compile(`x`, "x")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := p.EvalBool(map[string]interface{}{"x": "true"}); err == nil || !strings.Contains(err.Error(), "must evaluate to a bool, got string") {
+		t.Errorf("expected non-bool error, got %v", err)
+	}
+	if p.String() != "x" {
+		t.Errorf("unexpected String() %q", p.String())
+	}
+}
@@ -0,0 +1,341 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package circuitbreaker implements per-issuer circuit breakers which wrap the
+// HTTP clients used to talk to upstream certificate authorities such as Vault
+// and Venafi.
+// When an upstream repeatedly fails, requests to it are rejected immediately
+// rather than waiting for a timeout, so that controller workers are not tied
+// up and unrelated issuers are not delayed.
+package circuitbreaker
+
+import (
+	"fmt"
+	"net/http"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+// State is the state of a circuit breaker.
+type State int
+
+const (
+	// StateClosed means requests are passed through to the upstream.
+	StateClosed State = iota
+	// StateHalfOpen means a single probe request is passed through to the
+	// upstream to determine whether it has recovered.
+	StateHalfOpen
+	// StateOpen means all requests are rejected without contacting the
+	// upstream.
+	StateOpen
+)
+
+func (s State) String() string {
+	switch s {
+	case StateClosed:
+		return "closed"
+	case StateHalfOpen:
+		return "half-open"
+	case StateOpen:
+		return "open"
+	}
+	return fmt.Sprintf("unknown(%d)", int(s))
+}
+
+// Options configure the behaviour of every circuit breaker in a Registry.
+type Options struct {
+	// FailureThreshold is the number of consecutive failed requests after
+	// which the circuit is opened. A value of zero disables circuit breaking.
+	FailureThreshold int
+
+	// OpenDuration is how long a circuit stays open before a single probe
+	// request is let through to test whether the upstream has recovered.
+	OpenDuration time.Duration
+
+	// MaxConcurrentRequests is the maximum number of requests that may be in
+	// flight to a single issuer's upstream at once. Requests over this limit
+	// are rejected immediately. A value of zero means no limit.
+	MaxConcurrentRequests int
+}
+
+// Key identifies the issuer that a circuit breaker belongs to.
+type Key struct {
+	Kind      string
+	Namespace string
+	Name      string
+}
+
+func (k Key) String() string {
+	if k.Namespace == "" {
+		return k.Kind + "/" + k.Name
+	}
+	return k.Kind + "/" + k.Namespace + "/" + k.Name
+}
+
+// KeyForIssuer returns the Key for the given Issuer or ClusterIssuer.
+func KeyForIssuer(issuer cmapi.GenericIssuer) Key {
+	kind := cmapi.IssuerKind
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		kind = cmapi.ClusterIssuerKind
+	}
+	return Key{
+		Kind:      kind,
+		Namespace: issuer.GetObjectMeta().Namespace,
+		Name:      issuer.GetObjectMeta().Name,
+	}
+}
+
+// OpenError is returned when a request is rejected because the circuit for
+// an issuer is open.
+type OpenError struct {
+	Key        Key
+	RetryAfter time.Duration
+}
+
+func (e *OpenError) Error() string {
+	return fmt.Sprintf("circuit breaker for %s is open after repeated upstream failures, requests will be retried in %s", e.Key, e.RetryAfter.Round(time.Second))
+}
+
+// ConcurrencyLimitError is returned when a request is rejected because the
+// maximum number of concurrent requests to an issuer's upstream is reached.
+type ConcurrencyLimitError struct {
+	Key   Key
+	Limit int
+}
+
+func (e *ConcurrencyLimitError) Error() string {
+	return fmt.Sprintf("too many concurrent requests to the upstream of %s (limit %d)", e.Key, e.Limit)
+}
+
+// Registry holds a circuit breaker per issuer. It is shared across all
+// controllers so that the state for an issuer is consistent no matter which
+// controller is making requests.
+// A nil *Registry is valid and disables circuit breaking.
+type Registry struct {
+	opts    Options
+	clock   clock.Clock
+	metrics *metrics.Metrics
+
+	lock     sync.Mutex
+	breakers map[Key]*Breaker
+}
+
+// NewRegistry returns a Registry whose circuit breakers are configured with
+// the given options.
+func NewRegistry(opts Options, clock clock.Clock, metrics *metrics.Metrics) *Registry {
+	return &Registry{
+		opts:     opts,
+		clock:    clock,
+		metrics:  metrics,
+		breakers: make(map[Key]*Breaker),
+	}
+}
+
+// Breaker returns the circuit breaker for the given key, creating it if it
+// does not yet exist.
+func (r *Registry) Breaker(key Key) *Breaker {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	b, ok := r.breakers[key]
+	if !ok {
+		b = &Breaker{
+			key:     key,
+			opts:    r.opts,
+			clock:   r.clock,
+			metrics: r.metrics,
+		}
+		r.breakers[key] = b
+		b.setState(StateClosed)
+	}
+	return b
+}
+
+// Remove discards the circuit breaker for the given key, along with its
+// metrics. It is called when an issuer is deleted so that breakers for
+// deleted issuers do not accumulate. Clients built before the call keep
+// using the discarded breaker.
+func (r *Registry) Remove(key Key) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if _, ok := r.breakers[key]; !ok {
+		return
+	}
+	delete(r.breakers, key)
+	if r.metrics != nil {
+		r.metrics.RemoveIssuerCircuitBreaker(key.Kind, key.Namespace, key.Name)
+	}
+}
+
+// RoundTripper wraps next so that requests made through it are subject to
+// the circuit breaker for the given key. If the Registry is nil, next is
+// returned unmodified.
+func (r *Registry) RoundTripper(key Key, next http.RoundTripper) http.RoundTripper {
+	if r == nil {
+		return next
+	}
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &roundTripper{breaker: r.Breaker(key), next: next}
+}
+
+type outcome int
+
+const (
+	outcomeSuccess outcome = iota
+	outcomeFailure
+	// outcomeIgnored is used for requests that neither prove nor disprove
+	// that the upstream is healthy, for example because they were cancelled
+	// by the caller.
+	outcomeIgnored
+)
+
+// Breaker is a circuit breaker for a single issuer's upstream.
+type Breaker struct {
+	key     Key
+	opts    Options
+	clock   clock.Clock
+	metrics *metrics.Metrics
+
+	lock          sync.Mutex
+	state         State
+	failures      int
+	openedAt      time.Time
+	probeInFlight bool
+	inFlight      int
+}
+
+// State returns the current state of the circuit breaker.
+func (b *Breaker) State() State {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.state
+}
+
+// allow determines whether a request may be made. If it may, the returned
+// function must be called with the outcome of the request once it completes.
+func (b *Breaker) allow() (func(outcome), error) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	probe := false
+	if b.opts.FailureThreshold > 0 {
+		switch b.state {
+		case StateOpen:
+			if elapsed := b.clock.Since(b.openedAt); elapsed < b.opts.OpenDuration {
+				b.reject("open")
+				return nil, &OpenError{Key: b.key, RetryAfter: b.opts.OpenDuration - elapsed}
+			}
+			b.setState(StateHalfOpen)
+			fallthrough
+		case StateHalfOpen:
+			if b.probeInFlight {
+				b.reject("open")
+				return nil, &OpenError{Key: b.key}
+			}
+			probe = true
+		}
+	}
+
+	if b.opts.MaxConcurrentRequests > 0 && b.inFlight >= b.opts.MaxConcurrentRequests {
+		b.reject("concurrency_limit")
+		return nil, &ConcurrencyLimitError{Key: b.key, Limit: b.opts.MaxConcurrentRequests}
+	}
+
+	b.inFlight++
+	b.probeInFlight = b.probeInFlight || probe
+	return func(o outcome) { b.done(probe, o) }, nil
+}
+
+func (b *Breaker) done(probe bool, o outcome) {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+
+	b.inFlight--
+	if probe {
+		b.probeInFlight = false
+	}
+	if b.opts.FailureThreshold <= 0 {
+		return
+	}
+
+	switch o {
+	case outcomeSuccess:
+		b.failures = 0
+		if b.state != StateClosed {
+			b.setState(StateClosed)
+		}
+	case outcomeFailure:
+		b.failures++
+		if probe || (b.state == StateClosed && b.failures >= b.opts.FailureThreshold) {
+			b.openedAt = b.clock.Now()
+			b.setState(StateOpen)
+		}
+	case outcomeIgnored:
+		// A probe that was cancelled by the caller should not leave the
+		// circuit half-open forever, so allow another probe to be made.
+	}
+}
+
+// setState must be called with the lock held.
+func (b *Breaker) setState(state State) {
+	b.state = state
+	if b.metrics != nil {
+		b.metrics.SetIssuerCircuitBreakerState(float64(state), b.key.Kind, b.key.Namespace, b.key.Name)
+	}
+}
+
+// reject must be called with the lock held.
+func (b *Breaker) reject(reason string) {
+	if b.metrics != nil {
+		b.metrics.IncrementIssuerCircuitBreakerRejectedCount(b.key.Kind, b.key.Namespace, b.key.Name, reason)
+	}
+}
+
+type roundTripper struct {
+	breaker *Breaker
+	next    http.RoundTripper
+}
+
+func (rt *roundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	done, err := rt.breaker.allow()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := rt.next.RoundTrip(req)
+	switch {
+	case err != nil && req.Context().Err() != nil:
+		done(outcomeIgnored)
+	case err != nil:
+		done(outcomeFailure)
+	case resp.StatusCode >= http.StatusInternalServerError || resp.StatusCode == http.StatusTooManyRequests:
+		done(outcomeFailure)
+	default:
+		done(outcomeSuccess)
+	}
+	return resp, err
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package circuitbreaker
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	fakeclock "k8s.io/utils/clock/testing"
+)
+
+var testKey = Key{Kind: "Issuer", Namespace: "ns", Name: "vault"}
+
+// fakeTransport returns the configured status code, or error if set.
+type fakeTransport struct {
+	status int
+	err    error
+	calls  int
+	block  chan struct{}
+}
+
+func (f *fakeTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	f.calls++
+	if f.block != nil {
+		<-f.block
+	}
+	if f.err != nil {
+		return nil, f.err
+	}
+	return &http.Response{StatusCode: f.status, Body: http.NoBody}, nil
+}
+
+func (b *Breaker) inFlightCount() int {
+	b.lock.Lock()
+	defer b.lock.Unlock()
+	return b.inFlight
+}
+
+func doRequest(t *testing.T, rt http.RoundTripper) error {
+	t.Helper()
+	req, err := http.NewRequest(http.MethodGet, "https://vault.example.com/v1/sys/health", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = rt.RoundTrip(req)
+	return err
+}
+
+func TestBreakerOpensAfterConsecutiveFailures(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	r := NewRegistry(Options{FailureThreshold: 3, OpenDuration: time.Minute}, clock, nil)
+	upstream := &fakeTransport{status: http.StatusServiceUnavailable}
+	rt := r.RoundTripper(testKey, upstream)
+
+	for i := 0; i < 3; i++ {
+		if err := doRequest(t, rt); err != nil {
+			t.Fatalf("unexpected error on request %d: %v", i, err)
+		}
+	}
+	if state := r.Breaker(testKey).State(); state != StateOpen {
+		t.Fatalf("expected breaker to be open, got %s", state)
+	}
+
+	var openErr *OpenError
+	if err := doRequest(t, rt); !errors.As(err, &openErr) {
+		t.Fatalf("expected OpenError, got %v", err)
+	}
+	if upstream.calls != 3 {
+		t.Errorf("expected upstream to not be called while open, got %d calls", upstream.calls)
+	}
+}
+
+func TestBreakerSuccessResetsFailures(t *testing.T) {
+	r := NewRegistry(Options{FailureThreshold: 2, OpenDuration: time.Minute}, fakeclock.NewFakeClock(time.Now()), nil)
+	upstream := &fakeTransport{err: errors.New("connection refused")}
+	rt := r.RoundTripper(testKey, upstream)
+
+	doRequest(t, rt)
+	upstream.err, upstream.status = nil, http.StatusOK
+	doRequest(t, rt)
+	upstream.err = errors.New("connection refused")
+	doRequest(t, rt)
+
+	if state := r.Breaker(testKey).State(); state != StateClosed {
+		t.Fatalf("expected breaker to be closed, got %s", state)
+	}
+}
+
+func TestBreakerHalfOpenProbe(t *testing.T) {
+	tests := map[string]struct {
+		probeStatus int
+		expState    State
+	}{
+		"successful probe closes the circuit": {
+			probeStatus: http.StatusOK,
+			expState:    StateClosed,
+		},
+		"failed probe re-opens the circuit": {
+			probeStatus: http.StatusBadGateway,
+			expState:    StateOpen,
+		},
+		"client errors are not upstream failures": {
+			probeStatus: http.StatusForbidden,
+			expState:    StateClosed,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := fakeclock.NewFakeClock(time.Now())
+			r := NewRegistry(Options{FailureThreshold: 1, OpenDuration: time.Minute}, clock, nil)
+			upstream := &fakeTransport{status: http.StatusInternalServerError}
+			rt := r.RoundTripper(testKey, upstream)
+
+			doRequest(t, rt)
+			clock.Step(time.Minute)
+
+			upstream.status = test.probeStatus
+			if err := doRequest(t, rt); err != nil {
+				t.Fatalf("expected probe to be let through, got %v", err)
+			}
+			if state := r.Breaker(testKey).State(); state != test.expState {
+				t.Errorf("expected breaker to be %s, got %s", test.expState, state)
+			}
+		})
+	}
+}
+
+func TestBreakerAllowsSingleProbe(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	r := NewRegistry(Options{FailureThreshold: 1, OpenDuration: time.Minute}, clock, nil)
+	b := r.Breaker(testKey)
+
+	done, _ := b.allow()
+	done(outcomeFailure)
+	clock.Step(time.Minute)
+
+	probeDone, err := b.allow()
+	if err != nil {
+		t.Fatalf("expected probe to be allowed, got %v", err)
+	}
+	if _, err := b.allow(); err == nil {
+		t.Fatalf("expected second request to be rejected while probe is in flight")
+	}
+
+	// a cancelled probe should allow another probe to be made
+	probeDone(outcomeIgnored)
+	if _, err := b.allow(); err != nil {
+		t.Fatalf("expected another probe to be allowed, got %v", err)
+	}
+}
+
+func TestBreakerCancelledRequestsAreIgnored(t *testing.T) {
+	r := NewRegistry(Options{FailureThreshold: 1, OpenDuration: time.Minute}, fakeclock.NewFakeClock(time.Now()), nil)
+	rt := r.RoundTripper(testKey, &fakeTransport{err: context.Canceled})
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	req, _ := http.NewRequestWithContext(ctx, http.MethodGet, "https://vault.example.com", nil)
+	rt.RoundTrip(req)
+
+	if state := r.Breaker(testKey).State(); state != StateClosed {
+		t.Fatalf("expected breaker to be closed, got %s", state)
+	}
+}
+
+func TestBreakerConcurrencyLimit(t *testing.T) {
+	r := NewRegistry(Options{MaxConcurrentRequests: 1}, fakeclock.NewFakeClock(time.Now()), nil)
+	upstream := &fakeTransport{status: http.StatusOK, block: make(chan struct{})}
+	rt := r.RoundTripper(testKey, upstream)
+
+	errCh := make(chan error)
+	go func() { errCh <- doRequest(t, rt) }()
+	// wait for the first request to be in flight
+	for r.Breaker(testKey).inFlightCount() == 0 {
+		time.Sleep(time.Millisecond)
+	}
+
+	var limitErr *ConcurrencyLimitError
+	if err := doRequest(t, rt); !errors.As(err, &limitErr) {
+		t.Fatalf("expected ConcurrencyLimitError, got %v", err)
+	}
+	// requests to other issuers are unaffected
+	if err := doRequest(t, r.RoundTripper(Key{Kind: "ClusterIssuer", Name: "venafi"}, &fakeTransport{status: http.StatusOK})); err != nil {
+		t.Fatalf("expected request to another issuer to succeed, got %v", err)
+	}
+
+	close(upstream.block)
+	if err := <-errCh; err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+}
+
+func TestNilRegistry(t *testing.T) {
+	var r *Registry
+	upstream := &fakeTransport{status: http.StatusOK}
+	if rt := r.RoundTripper(testKey, upstream); rt != upstream {
+		t.Errorf("expected nil registry to return the transport unmodified")
+	}
+}
+
+func TestRegistryRemove(t *testing.T) {
+	r := NewRegistry(Options{FailureThreshold: 1, OpenDuration: time.Minute}, fakeclock.NewFakeClock(time.Now()), nil)
+	rt := r.RoundTripper(testKey, &fakeTransport{status: http.StatusServiceUnavailable})
+	doRequest(t, rt)
+	if state := r.Breaker(testKey).State(); state != StateOpen {
+		t.Fatalf("expected breaker to be open, got %s", state)
+	}
+
+	// A recreated issuer with the same name must start with a closed circuit.
+	r.Remove(testKey)
+	if len(r.breakers) != 0 {
+		t.Errorf("expected breaker to be removed from the registry")
+	}
+	if state := r.Breaker(testKey).State(); state != StateClosed {
+		t.Errorf("expected new breaker to be closed, got %s", state)
+	}
+
+	var nilRegistry *Registry
+	nilRegistry.Remove(testKey)
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clustertrustbundle publishes CA certificates as ClusterTrustBundles
+// (certificates.k8s.io/v1alpha1), which kubelet can project into pods.
+// The ClusterTrustBundle API types are not vendored, so ClusterTrustBundles
+// are handled as unstructured objects.
+package clustertrustbundle
+
+import (
+	"context"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic"
+)
+
+var (
+	// GroupVersionKind is the kind of ClusterTrustBundles.
+	GroupVersionKind = schema.GroupVersionKind{
+		Group:   "certificates.k8s.io",
+		Version: "v1alpha1",
+		Kind:    "ClusterTrustBundle",
+	}
+
+	// GroupVersionResource is the resource of ClusterTrustBundles.
+	GroupVersionResource = GroupVersionKind.GroupVersion().WithResource("clustertrustbundles")
+)
+
+// New returns an empty ClusterTrustBundle.
+func New() *unstructured.Unstructured {
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(GroupVersionKind)
+	return obj
+}
+
+// NewList returns an empty list of ClusterTrustBundles.
+func NewList() *unstructured.UnstructuredList {
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(GroupVersionKind.GroupVersion().WithKind(GroupVersionKind.Kind + "List"))
+	return list
+}
+
+// TrustBundle returns the PEM encoded certificates of the ClusterTrustBundle.
+func TrustBundle(obj *unstructured.Unstructured) string {
+	trustBundle, _, _ := unstructured.NestedString(obj.Object, "spec", "trustBundle")
+	return trustBundle
+}
+
+// SetTrustBundle sets the PEM encoded certificates of the ClusterTrustBundle.
+func SetTrustBundle(obj *unstructured.Unstructured, trustBundle string) {
+	// this can only fail if spec is not a map, in which case it is replaced
+	if err := unstructured.SetNestedField(obj.Object, trustBundle, "spec", "trustBundle"); err != nil {
+		obj.Object["spec"] = map[string]interface{}{"trustBundle": trustBundle}
+	}
+}
+
+// Publish creates a ClusterTrustBundle with the given name, labels and trust
+// bundle, or updates the trust bundle of the existing one.
+// A ClusterTrustBundle which exists but does not have all of the labels is
+// not managed by the caller, and is never overwritten.
+func Publish(ctx context.Context, client dynamic.Interface, name string, labels map[string]string, trustBundle string) error {
+	resource := client.Resource(GroupVersionResource)
+
+	existing, err := resource.Get(ctx, name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		obj := New()
+		obj.SetName(name)
+		obj.SetLabels(labels)
+		SetTrustBundle(obj, trustBundle)
+		_, err := resource.Create(ctx, obj, metav1.CreateOptions{})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	for key, value := range labels {
+		if existing.GetLabels()[key] != value {
+			return fmt.Errorf("ClusterTrustBundle %q already exists and is not managed by cert-manager", name)
+		}
+	}
+
+	if TrustBundle(existing) == trustBundle {
+		return nil
+	}
+
+	updated := existing.DeepCopy()
+	SetTrustBundle(updated, trustBundle)
+	_, err = resource.Update(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clustertrustbundle
+
+import (
+	"context"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+)
+
+func TestPublish(t *testing.T) {
+	labels := map[string]string{"cert-manager.io/issuer-name": "ca"}
+
+	bundle := func(labels map[string]string, trustBundle string) *unstructured.Unstructured {
+		obj := New()
+		obj.SetName("test")
+		obj.SetLabels(labels)
+		SetTrustBundle(obj, trustBundle)
+		return obj
+	}
+
+	tests := map[string]struct {
+		existing            []runtime.Object
+		expectedErr         bool
+		expectedTrustBundle string
+		expectedVerbs       []string
+	}{
+		"create a missing ClusterTrustBundle": {
+			expectedTrustBundle: "new",
+			expectedVerbs:       []string{"get", "create"},
+		},
+		"update a ClusterTrustBundle which is out of date": {
+			existing:            []runtime.Object{bundle(labels, "old")},
+			expectedTrustBundle: "new",
+			expectedVerbs:       []string{"get", "update"},
+		},
+		"do nothing if the ClusterTrustBundle is up to date": {
+			existing:            []runtime.Object{bundle(labels, "new")},
+			expectedTrustBundle: "new",
+			expectedVerbs:       []string{"get"},
+		},
+		"do not overwrite a ClusterTrustBundle which is not managed by cert-manager": {
+			existing:            []runtime.Object{bundle(nil, "other")},
+			expectedErr:         true,
+			expectedTrustBundle: "other",
+			expectedVerbs:       []string{"get"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(),
+				map[schema.GroupVersionResource]string{GroupVersionResource: "ClusterTrustBundleList"},
+				test.existing...,
+			)
+
+			err := Publish(context.Background(), client, "test", labels, "new")
+			if test.expectedErr != (err != nil) {
+				t.Errorf("expected error %v, got %v", test.expectedErr, err)
+			}
+
+			var verbs []string
+			for _, action := range client.Actions() {
+				verbs = append(verbs, action.GetVerb())
+			}
+			if len(verbs) != len(test.expectedVerbs) {
+				t.Fatalf("expected actions %v, got %v", test.expectedVerbs, verbs)
+			}
+			for i := range verbs {
+				if verbs[i] != test.expectedVerbs[i] {
+					t.Fatalf("expected actions %v, got %v", test.expectedVerbs, verbs)
+				}
+			}
+
+			obj, err := client.Resource(GroupVersionResource).Get(context.Background(), "test", metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if got := TrustBundle(obj); got != test.expectedTrustBundle {
+				t.Errorf("expected trust bundle %q, got %q", test.expectedTrustBundle, got)
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package policies
 
 import (
 	"bytes"
+	"crypto"
 	"crypto/tls"
 	"crypto/x509"
 	"fmt"
@@ -35,7 +36,10 @@ import (
 
 	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -64,6 +68,20 @@ func SecretIsMissingData(input Input) (string, string, bool) {
 func SecretPublicKeysDiffer(input Input) (string, string, bool) {
 	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
 	certData := input.Secret.Data[corev1.TLSCertKey]
+	if keyencryption.IsEncrypted(pkData) {
+		pub, err := keyencryption.PublicKey(pkData)
+		if err != nil {
+			return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid encrypted private key: %v", err), true
+		}
+		return storedPublicKeyDiffers(pub, certData)
+	}
+	if keybackend.IsReference(pkData) {
+		pub, err := keybackend.PublicKey(pkData)
+		if err != nil {
+			return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid private key reference: %v", err), true
+		}
+		return storedPublicKeyDiffers(pub, certData)
+	}
 	// TODO: replace this with a generic decoder that can handle different
 	//  formats such as JKS, P12 etc (i.e. add proper support for keystores)
 	_, err := tls.X509KeyPair(certData, pkData)
@@ -79,12 +97,33 @@ func SecretPrivateKeyMatchesSpec(input Input) (string, string, bool) {
 	}
 
 	pkBytes := input.Secret.Data[corev1.TLSPrivateKeyKey]
-	pk, err := pki.DecodePrivateKeyBytes(pkBytes)
-	if err != nil {
-		return SecretMismatch, fmt.Sprintf("Existing issued Secret contains invalid private key data: %v", err), true
+	var pub crypto.PublicKey
+	switch {
+	case keyencryption.IsEncrypted(pkBytes):
+		// Encrypted private keys can only be checked using the public key
+		// stored alongside them.
+		var err error
+		pub, err = keyencryption.PublicKey(pkBytes)
+		if err != nil {
+			return SecretMismatch, fmt.Sprintf("Existing issued Secret contains invalid encrypted private key data: %v", err), true
+		}
+	case keybackend.IsReference(pkBytes):
+		// Private keys held by a backend are never stored in the Secret,
+		// only a reference to them which includes the public key.
+		var err error
+		pub, err = keybackend.PublicKey(pkBytes)
+		if err != nil {
+			return SecretMismatch, fmt.Sprintf("Existing issued Secret contains an invalid private key reference: %v", err), true
+		}
+	default:
+		pk, err := pki.DecodePrivateKeyBytes(pkBytes)
+		if err != nil {
+			return SecretMismatch, fmt.Sprintf("Existing issued Secret contains invalid private key data: %v", err), true
+		}
+		pub = pk.Public()
 	}
 
-	violations, err := certificates.PrivateKeyMatchesSpec(pk, input.Certificate.Spec)
+	violations, err := certificates.PublicKeyMatchesSpec(pub, input.Certificate.Spec)
 	if err != nil {
 		return SecretMismatch, fmt.Sprintf("Failed to check private key is up to date: %v", err), true
 	}
@@ -94,6 +133,56 @@ func SecretPrivateKeyMatchesSpec(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// storedPublicKeyDiffers compares the certificate with the public key
+// stored alongside an encrypted private key or a reference to a private key
+// held by a backend, as the private key itself cannot be read.
+func storedPublicKeyDiffers(pub crypto.PublicKey, certData []byte) (string, string, bool) {
+	cert, err := pki.DecodeX509CertificateBytes(certData)
+	if err != nil {
+		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid key-pair: %v", err), true
+	}
+	equal, err := pki.PublicKeysEqual(cert.PublicKey, pub)
+	if err != nil {
+		return InvalidKeyPair, fmt.Sprintf("Issuing certificate as Secret contains an invalid key-pair: %v", err), true
+	}
+	if !equal {
+		return InvalidKeyPair, "Issuing certificate as Secret contains an invalid key-pair: private key does not match public key", true
+	}
+	return "", "", false
+}
+
+// SecretPrivateKeyEncryptionMismatch triggers an issuance if the private key
+// in the Secret is not encrypted as configured by spec.privateKeyEncryption,
+// so that enabling, disabling or changing the encryption key takes effect.
+// Private keys are only encrypted when they are stored, so a new private key
+// has to be issued.
+func SecretPrivateKeyEncryptionMismatch(input Input) (string, string, bool) {
+	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
+	cfg := input.Certificate.Spec.PrivateKeyEncryption
+	switch {
+	case cfg == nil && keyencryption.IsEncrypted(pkData):
+		return PrivateKeyEncryptionMismatch, "Issuing certificate as the private key in the Secret is encrypted but spec.privateKeyEncryption is not set", true
+	case cfg != nil && !keyencryption.EncryptedWith(pkData, cfg):
+		return PrivateKeyEncryptionMismatch, "Issuing certificate as the private key in the Secret is not encrypted with the key configured in spec.privateKeyEncryption", true
+	}
+	return "", "", false
+}
+
+// SecretPrivateKeyBackendMismatch triggers an issuance if the Secret does not
+// reference the private key held by spec.privateKey.backend, so that moving
+// the private key into or out of a backend takes effect.
+func SecretPrivateKeyBackendMismatch(input Input) (string, string, bool) {
+	pkData := input.Secret.Data[corev1.TLSPrivateKeyKey]
+	cfg := certificates.PrivateKeyBackend(input.Certificate)
+	switch {
+	case cfg == nil && keybackend.IsReference(pkData):
+		return PrivateKeyBackendMismatch, "Issuing certificate as the Secret references a private key held by a backend but spec.privateKey.backend is not set", true
+	case cfg != nil && !keybackend.References(pkData, cfg):
+		return PrivateKeyBackendMismatch, "Issuing certificate as the Secret does not reference the private key held by spec.privateKey.backend", true
+	}
+	return "", "", false
+}
+
 func SecretIssuerAnnotationsNotUpToDate(input Input) (string, string, bool) {
 	name := input.Secret.Annotations[cmapi.IssuerNameAnnotationKey]
 	kind := input.Secret.Annotations[cmapi.IssuerKindAnnotationKey]
@@ -151,8 +240,9 @@ func currentSecretValidForSpec(input Input) (string, string, bool) {
 
 // CurrentCertificateNearingExpiry returns a policy function that can be used to
 // check whether an X.509 cert currently issued for a Certificate should be
-// renewed.
-func CurrentCertificateNearingExpiry(c clock.Clock) Func {
+// renewed. defaultRenewalJitter is the renewal jitter used if the Certificate
+// does not set spec.renewalJitter.
+func CurrentCertificateNearingExpiry(c clock.Clock, defaultRenewalJitter time.Duration) Func {
 
 	return func(input Input) (string, string, bool) {
 
@@ -170,7 +260,7 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		crt := input.Certificate
-		renewalTime := certificates.RenewalTime(notBefore.Time, notAfter.Time, crt.Spec.RenewBefore)
+		renewalTime := certificates.CertificateRenewalTime(crt, notBefore.Time, notAfter.Time, defaultRenewalJitter)
 
 		renewIn := renewalTime.Time.Sub(c.Now())
 		if renewIn > 0 {
@@ -182,6 +272,35 @@ func CurrentCertificateNearingExpiry(c clock.Clock) Func {
 	}
 }
 
+// PrivateKeyRotationDue returns a policy function that can be used to check
+// whether the private key of the X.509 cert currently issued for a
+// Certificate has been in use for longer than the Certificate's
+// keyRotationInterval. The private key is assumed to have been generated
+// when the certificate was issued, as it is when the rotationPolicy is
+// Always.
+func PrivateKeyRotationDue(c clock.Clock) Func {
+	return func(input Input) (string, string, bool) {
+		privateKey := input.Certificate.Spec.PrivateKey
+		if privateKey == nil || privateKey.KeyRotationInterval == nil {
+			return "", "", false
+		}
+
+		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			// This case should never happen as it should always be caught by the
+			// secretPublicKeysMatch function beforehand, but handle it just in case.
+			return InvalidCertificate, fmt.Sprintf("Failed to decode stored certificate: %v", err), true
+		}
+
+		rotationTime := certificates.PrivateKeyRotationTime(x509cert.NotBefore, privateKey)
+		if rotationTime == nil || c.Now().Before(rotationTime.Time) {
+			return "", "", false
+		}
+
+		return KeyRotationDue, fmt.Sprintf("Rotating private key as it has been in use for longer than the key rotation interval of %s", privateKey.KeyRotationInterval.Duration), true
+	}
+}
+
 // CurrentCertificateHasExpired is used exclusively to check if the current
 // issued certificate has actually expired rather than just nearing expiry.
 func CurrentCertificateHasExpired(c clock.Clock) Func {
@@ -206,6 +325,71 @@ func CurrentCertificateHasExpired(c clock.Clock) Func {
 	}
 }
 
+// SecretCertificateChainInvalid checks that the certificates stored in the
+// Secret form a single unbroken chain and, if the Secret contains a CA
+// certificate, that the chain can be verified using that CA. Expiry is not
+// considered, as expired certificates are renewed by the trigger controller.
+func SecretCertificateChainInvalid(input Input) (string, string, bool) {
+	certData := input.Secret.Data[corev1.TLSCertKey]
+	if _, err := pki.ParseSingleCertificateChainPEM(certData); err != nil {
+		return InvalidCertificateChain, fmt.Sprintf("Secret contains an invalid certificate chain: %v", err), true
+	}
+	certs, err := pki.DecodeX509CertificateChainBytes(certData)
+	if err != nil {
+		return InvalidCertificateChain, fmt.Sprintf("Secret contains invalid certificate data: %v", err), true
+	}
+
+	caData := input.Secret.Data[cmmeta.TLSCAKey]
+	if len(caData) == 0 {
+		return "", "", false
+	}
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(caData) {
+		return InvalidCertificateChain, "Secret contains invalid CA certificate data", true
+	}
+	intermediates := x509.NewCertPool()
+	for _, cert := range certs[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := certs[0].Verify(x509.VerifyOptions{
+		Roots:         roots,
+		Intermediates: intermediates,
+		CurrentTime:   certs[0].NotBefore,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageAny},
+	}); err != nil {
+		return InvalidCertificateChain, fmt.Sprintf("Certificate chain in Secret cannot be verified using its CA certificate: %v", err), true
+	}
+	return "", "", false
+}
+
+// SecretCertificateNotIssuedForCurrentRevision checks that the certificate
+// stored in the Secret is the one issued by the CertificateRequest for the
+// Certificate's current revision. If that CertificateRequest is not
+// available, the certificate's subject alternative names are compared with
+// the Certificate's spec instead.
+func SecretCertificateNotIssuedForCurrentRevision(input Input) (string, string, bool) {
+	if input.CurrentRevisionRequest == nil || len(input.CurrentRevisionRequest.Status.Certificate) == 0 {
+		return currentSecretValidForSpec(input)
+	}
+
+	secretCert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return InvalidCertificate, fmt.Sprintf("Failed to decode stored certificate: %v", err), true
+	}
+	issuedCert, err := pki.DecodeX509CertificateBytes(input.CurrentRevisionRequest.Status.Certificate)
+	if err != nil {
+		// The issued certificate cannot be compared, so fall back to
+		// comparing the stored certificate with the spec.
+		return currentSecretValidForSpec(input)
+	}
+
+	if !secretCert.Equal(issuedCert) {
+		return IssuedCertificateMismatch, fmt.Sprintf("Secret contains a certificate with serial number %s that was not issued by CertificateRequest %q for the current revision",
+			secretCert.SerialNumber, input.CurrentRevisionRequest.Name), true
+	}
+	return "", "", false
+}
+
 func formatIssuerRef(name, kind, group string) string {
 	if group == "" {
 		group = "cert-manager.io"
@@ -266,6 +450,30 @@ func SecretTemplateMismatchesSecret(input Input) (string, string, bool) {
 	return "", "", false
 }
 
+// SecretExpiryAnnotationsNotUpToDate will inspect the given Secret's expires-at
+// and renewal-at annotations, and compare these against the stored
+// certificate. The renewal-at annotation depends on the Certificate's
+// renewBefore and renewal jitter, so it is kept up to date when those change.
+// Returns false if the certificate cannot be decoded, as that is handled by
+// earlier checks.
+func SecretExpiryAnnotationsNotUpToDate(defaultRenewalJitter time.Duration) Func {
+	return func(input Input) (string, string, bool) {
+		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
+		if err != nil {
+			return "", "", false
+		}
+
+		expected := internalcertificates.AnnotationsForCertificateSecret(input.Certificate, x509cert, defaultRenewalJitter)
+		for _, k := range []string{cmapi.ExpiresAtAnnotationKey, cmapi.RenewalAtAnnotationKey} {
+			if input.Secret.Annotations[k] != expected[k] {
+				return SecretExpiryAnnotationsMismatch, fmt.Sprintf("Secret annotation %q is missing or not up to date", k), true
+			}
+		}
+
+		return "", "", false
+	}
+}
+
 // SecretTemplateMismatchesSecretManagedFields will inspect the given Secret's
 // managed fields for its Annotations and Labels, and compare this against the
 // SecretTemplate on the given Certificate. Returns false if Annotations and
@@ -287,7 +495,9 @@ func SecretTemplateMismatchesSecretManagedFields(fieldManager string) Func {
 			}
 		}
 
-		baseAnnotations := internalcertificates.AnnotationsForCertificateSecret(input.Certificate, x509cert)
+		// Only the keys of the base annotations are used, so the renewal
+		// jitter does not matter.
+		baseAnnotations := internalcertificates.AnnotationsForCertificateSecret(input.Certificate, x509cert, 0)
 
 		managedLabels, managedAnnotations := sets.NewString(), sets.NewString()
 
@@ -380,10 +590,14 @@ func SecretTemplateMismatchesSecretManagedFields(fieldManager string) Func {
 func SecretAdditionalOutputFormatsDataMismatch(input Input) (string, string, bool) {
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret Data"
 	for _, format := range input.Certificate.Spec.AdditionalOutputFormats {
+		v, ok := input.Secret.Data[internalcertificates.OutputFormatKey(format)]
+		if !ok {
+			return AdditionalOutputFormatsMismatch, message, true
+		}
+
 		switch format.Type {
 		case cmapi.CertificateOutputFormatCombinedPEM:
-			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey]
-			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatCombinedPEM(
+			if !bytes.Equal(v, internalcertificates.OutputFormatCombinedPEM(
 				input.Secret.Data[corev1.TLSPrivateKeyKey],
 				input.Secret.Data[corev1.TLSCertKey],
 			)) {
@@ -391,8 +605,18 @@ func SecretAdditionalOutputFormatsDataMismatch(input Input) (string, string, boo
 			}
 
 		case cmapi.CertificateOutputFormatDER:
-			v, ok := input.Secret.Data[cmapi.CertificateOutputFormatDERKey]
-			if !ok || !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey])) {
+			if !bytes.Equal(v, internalcertificates.OutputFormatDER(input.Secret.Data[corev1.TLSPrivateKeyKey])) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+
+		case cmapi.CertificateOutputFormatDERCertificate:
+			if !bytes.Equal(v, internalcertificates.OutputFormatDERCertificate(input.Secret.Data[corev1.TLSCertKey])) {
+				return AdditionalOutputFormatsMismatch, message, true
+			}
+
+		case cmapi.CertificateOutputFormatPKCS7:
+			bundle, err := internalcertificates.OutputFormatPKCS7(input.Secret.Data[corev1.TLSCertKey])
+			if err != nil || !bytes.Equal(v, bundle) {
 				return AdditionalOutputFormatsMismatch, message, true
 			}
 		}
@@ -401,34 +625,37 @@ func SecretAdditionalOutputFormatsDataMismatch(input Input) (string, string, boo
 	return "", "", false
 }
 
+// nonOutputFormatSecretKeys are the data entries written to a Certificate's
+// target Secret which are not additional output formats.
+var nonOutputFormatSecretKeys = sets.NewString(
+	corev1.TLSCertKey, corev1.TLSPrivateKeyKey, cmmeta.TLSCAKey,
+	cmapi.PKCS12SecretKey, cmapi.PKCS12TruststoreKey, cmapi.JKSSecretKey, cmapi.JKSTruststoreKey,
+)
+
 // SecretAdditionalOutputFormatsOwnerMismatch validates that the field manager
 // owns the correct Certificate's AdditionalOutputFormats in the Secret.
 // Returns true (violation) if:
 //   * missing AdditionalOutputFormat key owned by the field manager
 //   * AdditionalOutputFormat key owned by the field manager shouldn't exist
+//
+// Any data entry owned by the field manager, other than those used for the
+// certificate, private key, CA and keystores, is considered to have been
+// written for an AdditionalOutputFormat.
 // A violation with the reason `ManagedFieldsParseError` should be considered a
 // non re-triable error.
 func SecretAdditionalOutputFormatsOwnerMismatch(fieldManager string) Func {
 	const message = "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields"
 	return func(input Input) (string, string, bool) {
-		var (
-			crtHasCombinedPEM, crtHasDER       bool
-			secretHasCombinedPEM, secretHasDER bool
-		)
-
-		// Gather which additional output formats have been defined on the
-		// Certificate.
+		// Gather the keys of the additional output formats which have been
+		// defined on the Certificate.
+		crtKeys := sets.NewString()
 		for _, format := range input.Certificate.Spec.AdditionalOutputFormats {
-			switch format.Type {
-			case cmapi.CertificateOutputFormatCombinedPEM:
-				crtHasCombinedPEM = true
-			case cmapi.CertificateOutputFormatDER:
-				crtHasDER = true
-			}
+			crtKeys.Insert(internalcertificates.OutputFormatKey(format))
 		}
 
-		// Determine whether an output format key exists on the Secret which is
-		// owned my the field manager.
+		// Determine which output format keys exist on the Secret which are
+		// owned by the field manager.
+		secretKeys := sets.NewString()
 		for _, managedField := range input.Secret.ManagedFields {
 			if managedField.Manager != fieldManager || managedField.FieldsV1 == nil {
 				continue
@@ -439,24 +666,18 @@ func SecretAdditionalOutputFormatsOwnerMismatch(fieldManager string) Func {
 				return ManagedFieldsParseError, fmt.Sprintf("failed to decode managed fields on Secret: %s", err), true
 			}
 
-			if fieldset.Has(fieldpath.Path{
-				{FieldName: pointer.String("data")},
-				{FieldName: pointer.String(cmapi.CertificateOutputFormatCombinedPEMKey)},
-			}) {
-				secretHasCombinedPEM = true
-			}
-
-			if fieldset.Has(fieldpath.Path{
-				{FieldName: pointer.String("data")},
-				{FieldName: pointer.String(cmapi.CertificateOutputFormatDERKey)},
-			}) {
-				secretHasDER = true
-			}
+			data := fieldset.Children.Descend(fieldpath.PathElement{FieldName: pointer.String("data")})
+			data.Members.Iterate(func(pe fieldpath.PathElement) {
+				if pe.FieldName == nil || nonOutputFormatSecretKeys.Has(*pe.FieldName) {
+					return
+				}
+				secretKeys.Insert(*pe.FieldName)
+			})
 		}
 
 		// Format present or missing on the Certificate should be reflected on the
 		// Secret.
-		if crtHasCombinedPEM != secretHasCombinedPEM || crtHasDER != secretHasDER {
+		if !crtKeys.Equal(secretKeys) {
 			return AdditionalOutputFormatsMismatch, message, true
 		}
 
@@ -17,6 +17,8 @@ limitations under the License.
 package policies
 
 import (
+	"crypto/x509"
+	"encoding/base64"
 	"encoding/pem"
 	"testing"
 	"time"
@@ -29,6 +31,9 @@ import (
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	"github.com/stretchr/testify/assert"
@@ -489,8 +494,81 @@ func Test_NewTriggerPolicyChain(t *testing.T) {
 				},
 			},
 		},
+		"trigger issuance if the private key is older than the key rotation interval": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationPolicy:      cmapi.RotationPolicyAlways,
+						KeyRotationInterval: &metav1.Duration{Duration: time.Hour * 2160},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						// issued 91 days ago
+						clock.Now().Add(time.Hour*24*-91),
+						// expires in a year's time
+						clock.Now().Add(time.Hour*24*365),
+					),
+				},
+			},
+			reason:  KeyRotationDue,
+			message: "Rotating private key as it has been in use for longer than the key rotation interval of 2160h0m0s",
+			reissue: true,
+		},
+		"does not trigger issuance if the private key is younger than the key rotation interval": {
+			certificate: &cmapi.Certificate{
+				Spec: cmapi.CertificateSpec{
+					CommonName: "example.com",
+					IssuerRef: cmmeta.ObjectReference{
+						Name:  "testissuer",
+						Kind:  "IssuerKind",
+						Group: "group.example.com",
+					},
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						RotationPolicy:      cmapi.RotationPolicyAlways,
+						KeyRotationInterval: &metav1.Duration{Duration: time.Hour * 2160},
+					},
+				},
+			},
+			secret: &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "something",
+					Annotations: map[string]string{
+						cmapi.IssuerNameAnnotationKey:  "testissuer",
+						cmapi.IssuerKindAnnotationKey:  "IssuerKind",
+						cmapi.IssuerGroupAnnotationKey: "group.example.com",
+					},
+				},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: staticFixedPrivateKey,
+					corev1.TLSCertKey: testcrypto.MustCreateCertWithNotBeforeAfter(t, staticFixedPrivateKey,
+						&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}},
+						// issued 30 days ago
+						clock.Now().Add(time.Hour*24*-30),
+						// expires in a year's time
+						clock.Now().Add(time.Hour*24*365),
+					),
+				},
+			},
+		},
 	}
-	policyChain := NewTriggerPolicyChain(clock)
+	policyChain := NewTriggerPolicyChain(clock, 0)
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			reason, message, reissue := policyChain.Evaluate(Input{
@@ -1038,6 +1116,17 @@ func Test_SecretAdditionalOutputFormatsDataMismatch(t *testing.T) {
 	block, _ := pem.Decode(pk)
 	pkDER := block.Bytes
 	combinedPEM := append(append(pk, '\n'), cert...)
+	x509Cert := testcrypto.MustCreateCert(t, pk, gen.Certificate("test", gen.SetCertificateDNSNames("example.com")))
+	block, _ = pem.Decode(x509Cert)
+	certDER := block.Bytes
+	parsedCert, err := pki.DecodeX509CertificateBytes(x509Cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPKCS7, err := pki.EncodePKCS7Certificates([]*x509.Certificate{parsedCert})
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	tests := map[string]struct {
 		input        Input
@@ -1285,6 +1374,65 @@ func Test_SecretAdditionalOutputFormatsDataMismatch(t *testing.T) {
 			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
 			expViolation: true,
 		},
+		"if additional output has der certificate and pkcs7 with a custom key, and Secret has correct values, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "DERCertificate"},
+						{Type: "PKCS7", Key: "chain.p7b"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt":   x509Cert,
+						"tls.key":   pk,
+						"tls.der":   certDER,
+						"chain.p7b": certPKCS7,
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output has pkcs7 with a custom key, and Secret has it under the default key, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7", Key: "chain.p7b"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": x509Cert,
+						"tls.key": pk,
+						"tls.p7b": certPKCS7,
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
+		"if additional output has pkcs7 and Secret has wrong pkcs7, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{
+						"tls.crt": x509Cert,
+						"tls.key": pk,
+						"tls.p7b": []byte("wrong"),
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret Data",
+			expViolation: true,
+		},
 	}
 
 	for name, test := range tests {
@@ -1717,6 +1865,82 @@ func Test_SecretAdditionalOutputFormatsOwnerMismatch(t *testing.T) {
 			expMessage:   "",
 			expViolation: false,
 		},
+		"if additional output formats has pkcs7 with a custom key, and secret has managed fields for the custom key and certificate, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7", Key: "chain.p7b"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.crt": {}, "f:tls.key": {}, "f:chain.p7b": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
+		"if additional output formats has pkcs7 with a custom key, and secret has managed fields for the default key, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+					AdditionalOutputFormats: []cmapi.CertificateAdditionalOutputFormat{
+						{Type: "PKCS7", Key: "chain.p7b"},
+					}},
+				},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.crt": {}, "f:tls.key": {}, "f:tls.p7b": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats is empty, and secret has managed fields for a custom key, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.crt": {}, "f:keystore.p12": {}, "f:chain.p7b": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "AdditionalOutputFormatsMismatch",
+			expMessage:   "Certificate's AdditionalOutputFormats doesn't match Secret ManagedFields",
+			expViolation: true,
+		},
+		"if additional output formats is empty, and secret has managed fields for the certificate and keystores only, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{
+						ManagedFields: []metav1.ManagedFieldsEntry{
+							{Manager: fieldManager, FieldsV1: &metav1.FieldsV1{
+								Raw: []byte(`{"f:data": {".": {}, "f:tls.crt": {}, "f:tls.key": {}, "f:ca.crt": {}, "f:keystore.p12": {}, "f:truststore.p12": {}}}`),
+							}},
+						},
+					},
+				},
+			},
+			expReason:    "",
+			expMessage:   "",
+			expViolation: false,
+		},
 	}
 
 	for name, test := range tests {
@@ -2164,3 +2388,415 @@ func Test_SecretOwnerReferenceValueMismatch(t *testing.T) {
 		})
 	}
 }
+
+func Test_SecretExpiryAnnotationsNotUpToDate(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCertWithNotBeforeAfter(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "test"}},
+		time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC), time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC))
+	// Without renewBefore, renewal happens 2/3 of the way through the
+	// certificate's 90 day duration.
+	expiresAt, renewalAt := "2022-04-01T00:00:00Z", "2022-03-02T00:00:00Z"
+
+	tests := map[string]struct {
+		input        Input
+		expReason    string
+		expMessage   string
+		expViolation bool
+	}{
+		"if the Secret has no certificate, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret:      &corev1.Secret{},
+			},
+			expViolation: false,
+		},
+		"if the Secret is missing the annotations, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{
+					Data: map[string][]byte{"tls.crt": cert},
+				},
+			},
+			expReason:    "SecretExpiryAnnotationsMismatch",
+			expMessage:   `Secret annotation "cert-manager.io/expires-at" is missing or not up to date`,
+			expViolation: true,
+		},
+		"if the renewal-at annotation does not account for renewBefore, should return true": {
+			input: Input{
+				Certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{RenewBefore: &metav1.Duration{Duration: time.Hour}}},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						"cert-manager.io/expires-at": expiresAt,
+						"cert-manager.io/renewal-at": renewalAt,
+					}},
+					Data: map[string][]byte{"tls.crt": cert},
+				},
+			},
+			expReason:    "SecretExpiryAnnotationsMismatch",
+			expMessage:   `Secret annotation "cert-manager.io/renewal-at" is missing or not up to date`,
+			expViolation: true,
+		},
+		"if the annotations are up to date, should return false": {
+			input: Input{
+				Certificate: &cmapi.Certificate{},
+				Secret: &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+						"cert-manager.io/expires-at": expiresAt,
+						"cert-manager.io/renewal-at": renewalAt,
+					}},
+					Data: map[string][]byte{"tls.crt": cert},
+				},
+			},
+			expViolation: false,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotReason, gotMessage, gotViolation := SecretExpiryAnnotationsNotUpToDate(0)(test.input)
+			assert.Equal(t, test.expReason, gotReason)
+			assert.Equal(t, test.expMessage, gotMessage)
+			assert.Equal(t, test.expViolation, gotViolation)
+		})
+	}
+}
+
+func Test_NewSecretDriftPolicyChain(t *testing.T) {
+	spec := cmapi.CertificateSpec{SecretName: "something", CommonName: "example.com", DNSNames: []string{"example.com"}}
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: spec})
+	otherCert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: spec})
+	otherCA := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t),
+		&cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "other-ca", IsCA: true}},
+	)
+
+	x509Cert, err := pki.DecodeX509CertificateBytes(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		certificate *cmapi.Certificate
+		request     *cmapi.CertificateRequest
+		secret      *corev1.Secret
+
+		reason, message string
+		drifted         bool
+	}{
+		"no drift if the Secret contains the certificate issued for the current revision": {
+			certificate: &cmapi.Certificate{Spec: spec},
+			request: &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Name: "something-1"},
+				Status: cmapi.CertificateRequestStatus{Certificate: cert},
+			},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+					cmmeta.TLSCAKey:         cert,
+				},
+			},
+		},
+		"no drift if the current revision's request is not available and the Secret matches the spec": {
+			certificate: &cmapi.Certificate{Spec: spec},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+				},
+			},
+		},
+		"drift if the certificate chain is invalid": {
+			certificate: &cmapi.Certificate{Spec: spec},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       []byte("test"),
+				},
+			},
+			reason:  InvalidCertificateChain,
+			message: "Secret contains an invalid certificate chain: error decoding certificate PEM block",
+			drifted: true,
+		},
+		"drift if the certificate chain cannot be verified using the CA certificate": {
+			certificate: &cmapi.Certificate{Spec: spec},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+					cmmeta.TLSCAKey:         otherCA,
+				},
+			},
+			reason:  InvalidCertificateChain,
+			message: "Certificate chain in Secret cannot be verified using its CA certificate: x509: certificate signed by unknown authority",
+			drifted: true,
+		},
+		"drift if the private key does not match the spec": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				SecretName: "something", CommonName: "example.com", DNSNames: []string{"example.com"},
+				PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+			}},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+				},
+			},
+			reason:  SecretMismatch,
+			message: "Existing private key is not up to date for spec: [spec.keyAlgorithm]",
+			drifted: true,
+		},
+		"drift if the Secret contains a certificate that was not issued for the current revision": {
+			certificate: &cmapi.Certificate{Spec: spec},
+			request: &cmapi.CertificateRequest{ObjectMeta: metav1.ObjectMeta{Name: "something-1"},
+				Status: cmapi.CertificateRequestStatus{Certificate: otherCert},
+			},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+				},
+			},
+			reason:  IssuedCertificateMismatch,
+			message: "Secret contains a certificate with serial number " + x509Cert.SerialNumber.String() + ` that was not issued by CertificateRequest "something-1" for the current revision`,
+			drifted: true,
+		},
+		"drift if the current revision's request is not available and the Secret does not match the spec": {
+			certificate: &cmapi.Certificate{Spec: cmapi.CertificateSpec{
+				SecretName: "something", CommonName: "example.com", DNSNames: []string{"example.com", "www.example.com"},
+			}},
+			secret: &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "something"},
+				Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: pk,
+					corev1.TLSCertKey:       cert,
+				},
+			},
+			reason:  SecretMismatch,
+			message: "Existing issued Secret is not up to date for spec: [spec.dnsNames]",
+			drifted: true,
+		},
+	}
+	policyChain := NewSecretDriftPolicyChain()
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, message, drifted := policyChain.Evaluate(Input{
+				Certificate:            test.certificate,
+				CurrentRevisionRequest: test.request,
+				Secret:                 test.secret,
+			})
+
+			assert.Equal(t, test.reason, reason)
+			assert.Equal(t, test.message, message)
+			assert.Equal(t, test.drifted, drifted)
+		})
+	}
+}
+
+// mustEncryptedPrivateKey returns a private key encrypted with the Vault
+// transit key "transit/my-key". Only the public key stored alongside the
+// encrypted private key is read by policy checks, so the encrypted data
+// itself is not valid.
+func mustEncryptedPrivateKey(t *testing.T, pkData []byte) []byte {
+	pk, err := pki.DecodePrivateKeyBytes(pkData)
+	if err != nil {
+		t.Fatal(err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(pk.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{
+		Type: keyencryption.PEMBlockType,
+		Headers: map[string]string{
+			"Provider":   "vault-transit",
+			"Mount":      "transit",
+			"Key":        "my-key",
+			"Public-Key": base64.StdEncoding.EncodeToString(publicKey),
+		},
+		Bytes: []byte("encrypted"),
+	})
+}
+
+func Test_SecretPrivateKeyEncryption(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	encryptedPK := mustEncryptedPrivateKey(t, pk)
+	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	otherCert := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	encryption := &cmapi.CertificatePrivateKeyEncryption{
+		VaultTransit: &cmapi.VaultTransitKeyEncryption{Mount: "transit", KeyName: "my-key"},
+	}
+
+	tests := map[string]struct {
+		spec      cmapi.CertificateSpec
+		pk, cert  []byte
+		policy    Func
+		reason    string
+		violation bool
+	}{
+		"an encrypted private key matching the certificate is a valid key-pair": {
+			pk: encryptedPK, cert: cert,
+			policy: SecretPublicKeysDiffer,
+		},
+		"an encrypted private key not matching the certificate is an invalid key-pair": {
+			pk: encryptedPK, cert: otherCert,
+			policy:    SecretPublicKeysDiffer,
+			reason:    InvalidKeyPair,
+			violation: true,
+		},
+		"an encrypted private key is checked against the spec using its public key": {
+			spec:   cmapi.CertificateSpec{PrivateKeyEncryption: encryption},
+			pk:     encryptedPK,
+			policy: SecretPrivateKeyMatchesSpec,
+		},
+		"an encrypted private key of the wrong algorithm does not match the spec": {
+			spec: cmapi.CertificateSpec{
+				PrivateKey:           &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+				PrivateKeyEncryption: encryption,
+			},
+			pk:        encryptedPK,
+			policy:    SecretPrivateKeyMatchesSpec,
+			reason:    SecretMismatch,
+			violation: true,
+		},
+		"a private key encrypted with the configured key is up to date": {
+			spec:   cmapi.CertificateSpec{PrivateKeyEncryption: encryption},
+			pk:     encryptedPK,
+			policy: SecretPrivateKeyEncryptionMismatch,
+		},
+		"an unencrypted private key is up to date if encryption is not configured": {
+			pk:     pk,
+			policy: SecretPrivateKeyEncryptionMismatch,
+		},
+		"an unencrypted private key is reissued if encryption is configured": {
+			spec:      cmapi.CertificateSpec{PrivateKeyEncryption: encryption},
+			pk:        pk,
+			policy:    SecretPrivateKeyEncryptionMismatch,
+			reason:    PrivateKeyEncryptionMismatch,
+			violation: true,
+		},
+		"an encrypted private key is reissued if encryption is no longer configured": {
+			pk:        encryptedPK,
+			policy:    SecretPrivateKeyEncryptionMismatch,
+			reason:    PrivateKeyEncryptionMismatch,
+			violation: true,
+		},
+		"a private key encrypted with a different key is reissued": {
+			spec: cmapi.CertificateSpec{PrivateKeyEncryption: &cmapi.CertificatePrivateKeyEncryption{
+				VaultTransit: &cmapi.VaultTransitKeyEncryption{Mount: "transit", KeyName: "other-key"},
+			}},
+			pk:        encryptedPK,
+			policy:    SecretPrivateKeyEncryptionMismatch,
+			reason:    PrivateKeyEncryptionMismatch,
+			violation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, _, violation := test.policy(Input{
+				Certificate: &cmapi.Certificate{Spec: test.spec},
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: test.pk,
+					corev1.TLSCertKey:       test.cert,
+				}},
+			})
+			assert.Equal(t, test.reason, reason)
+			assert.Equal(t, test.violation, violation)
+		})
+	}
+}
+
+func Test_SecretPrivateKeyBackend(t *testing.T) {
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCert(t, pk, &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	otherCert := testcrypto.MustCreateCert(t, testcrypto.MustCreatePEMPrivateKey(t), &cmapi.Certificate{Spec: cmapi.CertificateSpec{CommonName: "example.com"}})
+	signer, err := pki.DecodePrivateKeyBytes(pk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &cmapi.PrivateKeyBackend{
+		AWSKMS: &cmapi.AWSKMSPrivateKeyBackend{Region: "eu-west-1", KeyID: "alias/my-key"},
+	}
+	reference, err := keybackend.EncodeReference(backend, signer.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		spec      cmapi.CertificateSpec
+		pk, cert  []byte
+		policy    Func
+		reason    string
+		violation bool
+	}{
+		"a private key reference matching the certificate is a valid key-pair": {
+			pk: reference, cert: cert,
+			policy: SecretPublicKeysDiffer,
+		},
+		"a private key reference not matching the certificate is an invalid key-pair": {
+			pk: reference, cert: otherCert,
+			policy:    SecretPublicKeysDiffer,
+			reason:    InvalidKeyPair,
+			violation: true,
+		},
+		"a private key reference is checked against the spec using its public key": {
+			spec:   cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{Backend: backend}},
+			pk:     reference,
+			policy: SecretPrivateKeyMatchesSpec,
+		},
+		"a private key reference of the wrong algorithm does not match the spec": {
+			spec: cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{
+				Algorithm: cmapi.ECDSAKeyAlgorithm,
+				Backend:   backend,
+			}},
+			pk:        reference,
+			policy:    SecretPrivateKeyMatchesSpec,
+			reason:    SecretMismatch,
+			violation: true,
+		},
+		"a reference to the configured private key is up to date": {
+			spec:   cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{Backend: backend}},
+			pk:     reference,
+			policy: SecretPrivateKeyBackendMismatch,
+		},
+		"a private key is up to date if no backend is configured": {
+			pk:     pk,
+			policy: SecretPrivateKeyBackendMismatch,
+		},
+		"a private key is reissued if a backend is configured": {
+			spec:      cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{Backend: backend}},
+			pk:        pk,
+			policy:    SecretPrivateKeyBackendMismatch,
+			reason:    PrivateKeyBackendMismatch,
+			violation: true,
+		},
+		"a private key reference is reissued if a backend is no longer configured": {
+			pk:        reference,
+			policy:    SecretPrivateKeyBackendMismatch,
+			reason:    PrivateKeyBackendMismatch,
+			violation: true,
+		},
+		"a reference to a different private key is reissued": {
+			spec: cmapi.CertificateSpec{PrivateKey: &cmapi.CertificatePrivateKey{Backend: &cmapi.PrivateKeyBackend{
+				AWSKMS: &cmapi.AWSKMSPrivateKeyBackend{Region: "eu-west-1", KeyID: "alias/other-key"},
+			}}},
+			pk:        reference,
+			policy:    SecretPrivateKeyBackendMismatch,
+			reason:    PrivateKeyBackendMismatch,
+			violation: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			reason, _, violation := test.policy(Input{
+				Certificate: &cmapi.Certificate{Spec: test.spec},
+				Secret: &corev1.Secret{Data: map[string][]byte{
+					corev1.TLSPrivateKeyKey: test.pk,
+					corev1.TLSCertKey:       test.cert,
+				}},
+			})
+			assert.Equal(t, test.reason, reason)
+			assert.Equal(t, test.violation, violation)
+		})
+	}
+}
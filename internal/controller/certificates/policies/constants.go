@@ -29,9 +29,25 @@ const (
 	// InvalidCertificate is a policy violation whereby the signed certificate in
 	// the Input Secret could not be parsed or decoded.
 	InvalidCertificate string = "InvalidCertificate"
+	// InvalidCertificateChain is a policy violation whereby the certificate
+	// chain in the Input Secret is broken or cannot be verified using the CA
+	// certificate stored alongside it.
+	InvalidCertificateChain string = "InvalidCertificateChain"
+	// IssuedCertificateMismatch is a policy violation whereby the certificate
+	// in the Input Secret is not the one issued for the Certificate's current
+	// revision, e.g. because the Secret was edited or restored from a backup.
+	IssuedCertificateMismatch string = "IssuedCertificateMismatch"
 	// SecretMismatch is a policy violation reason for a scenario where Secret's
 	// private key does not match spec.
 	SecretMismatch string = "SecretMismatch"
+	// PrivateKeyEncryptionMismatch is a policy violation reason for a
+	// scenario where Secret's private key is not encrypted as configured by
+	// spec.privateKeyEncryption.
+	PrivateKeyEncryptionMismatch string = "PrivateKeyEncryptionMismatch"
+	// PrivateKeyBackendMismatch is a policy violation reason for a scenario
+	// where Secret does not reference the private key held by
+	// spec.privateKey.backend.
+	PrivateKeyBackendMismatch string = "PrivateKeyBackendMismatch"
 	// IncorrectIssuer is a policy violation reason for a scenario where
 	// Certificate has been issued by incorrect Issuer.
 	IncorrectIssuer string = "IncorrectIssuer"
@@ -41,6 +57,10 @@ const (
 	// Renewing is a policy violation reason for a scenario where
 	// Certificate's renewal time is now or in past.
 	Renewing string = "Renewing"
+	// KeyRotationDue is a policy violation reason for a scenario where the
+	// private key of the Certificate's current certificate has been in use
+	// for longer than the Certificate's keyRotationInterval.
+	KeyRotationDue string = "KeyRotationDue"
 	// Expired is a policy violation reason for a scenario where Certificate has
 	// expired.
 	Expired string = "Expired"
@@ -52,6 +72,10 @@ const (
 	// Certificate's AdditionalOutputFormats is not reflected on the target
 	// Secret, either by having extra, missing, or wrong values.
 	AdditionalOutputFormatsMismatch string = "AdditionalOutputFormatsMismatch"
+	// SecretExpiryAnnotationsMismatch is a policy violation whereby the
+	// expires-at or renewal-at annotations on the target Secret are missing
+	// or do not match the stored certificate and the Certificate's spec.
+	SecretExpiryAnnotationsMismatch string = "SecretExpiryAnnotationsMismatch"
 	// ManagedFieldsParseError is a policy violation whereby cert-manager was
 	// unable to decode the managed fields on a resource.
 	ManagedFieldsParseError string = "ManagedFieldsParseError"
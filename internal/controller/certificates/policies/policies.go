@@ -18,6 +18,8 @@ limitations under the License.
 package policies
 
 import (
+	"time"
+
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/clock"
 
@@ -64,16 +66,20 @@ func (c Chain) Evaluate(input Input) (string, string, bool) {
 }
 
 // NewTriggerPolicyChain includes trigger policy checks, which if return true,
-// should cause a Certificate to be marked for issuance.
-func NewTriggerPolicyChain(c clock.Clock) Chain {
+// should cause a Certificate to be marked for issuance. defaultRenewalJitter
+// is the renewal jitter of Certificates which do not set spec.renewalJitter.
+func NewTriggerPolicyChain(c clock.Clock, defaultRenewalJitter time.Duration) Chain {
 	return Chain{
 		SecretDoesNotExist,
 		SecretIsMissingData,
 		SecretPublicKeysDiffer,
 		SecretPrivateKeyMatchesSpec,
+		SecretPrivateKeyEncryptionMismatch,
+		SecretPrivateKeyBackendMismatch,
 		SecretIssuerAnnotationsNotUpToDate,
 		CurrentCertificateRequestNotValidForSpec,
-		CurrentCertificateNearingExpiry(c),
+		CurrentCertificateNearingExpiry(c, defaultRenewalJitter),
+		PrivateKeyRotationDue(c),
 	}
 }
 
@@ -92,10 +98,11 @@ func NewReadinessPolicyChain(c clock.Clock) Chain {
 // NewSecretPostIssuancePolicyChain includes policy checks that are to be
 // performed _after_ issuance has been successful, testing for the presence and
 // correctness of metadata and output formats of Certificate's Secrets.
-func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string) Chain {
+func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string, defaultRenewalJitter time.Duration) Chain {
 	return Chain{
 		SecretTemplateMismatchesSecret,
 		SecretTemplateMismatchesSecretManagedFields(fieldManager),
+		SecretExpiryAnnotationsNotUpToDate(defaultRenewalJitter),
 		SecretAdditionalOutputFormatsDataMismatch,
 		SecretAdditionalOutputFormatsOwnerMismatch(fieldManager),
 		SecretOwnerReferenceManagedFieldMismatch(ownerRefEnabled, fieldManager),
@@ -103,6 +110,19 @@ func NewSecretPostIssuancePolicyChain(ownerRefEnabled bool, fieldManager string)
 	}
 }
 
+// NewSecretDriftPolicyChain includes policy checks which, if they return true,
+// indicate that a Certificate's existing Secret has diverged from the
+// Certificate in a way that cannot be explained by an issuance, such as the
+// Secret being edited or restored from an old backup.
+// The Secret must exist when this chain is evaluated.
+func NewSecretDriftPolicyChain() Chain {
+	return Chain{
+		SecretCertificateChainInvalid,
+		SecretPrivateKeyMatchesSpec,
+		SecretCertificateNotIssuedForCurrentRevision,
+	}
+}
+
 // NewTemporaryCertificatePolicyChain includes policy checks for ensuing a
 // temporary certificate is valid.
 func NewTemporaryCertificatePolicyChain() Chain {
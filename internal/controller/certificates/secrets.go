@@ -21,9 +21,11 @@ import (
 	"crypto/x509"
 	"encoding/pem"
 	"strings"
+	"time"
 
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -31,8 +33,11 @@ import (
 // Certificate Secret's Annotations when issued. These annotations contain
 // information about the Issuer and Certificate.
 // If the X.509 certificate is not-nil, additional annotations will be added
-// relating to its Common Name and Subject Alternative Names.
-func AnnotationsForCertificateSecret(crt *cmapi.Certificate, certificate *x509.Certificate) map[string]string {
+// relating to its Common Name and Subject Alternative Names, and to when it
+// expires and will be renewed, so that consumers of the Secret can act on
+// them without access to the Certificate. defaultRenewalJitter is the renewal
+// jitter used if the Certificate does not set spec.renewalJitter.
+func AnnotationsForCertificateSecret(crt *cmapi.Certificate, certificate *x509.Certificate, defaultRenewalJitter time.Duration) map[string]string {
 	annotations := make(map[string]string)
 
 	annotations[cmapi.CertificateNameKey] = crt.Name
@@ -46,11 +51,34 @@ func AnnotationsForCertificateSecret(crt *cmapi.Certificate, certificate *x509.C
 		annotations[cmapi.AltNamesAnnotationKey] = strings.Join(certificate.DNSNames, ",")
 		annotations[cmapi.IPSANAnnotationKey] = strings.Join(utilpki.IPAddressesToString(certificate.IPAddresses), ",")
 		annotations[cmapi.URISANAnnotationKey] = strings.Join(utilpki.URLsToString(certificate.URIs), ",")
+		annotations[cmapi.ExpiresAtAnnotationKey] = certificate.NotAfter.UTC().Format(time.RFC3339)
+		annotations[cmapi.RenewalAtAnnotationKey] = certificates.CertificateRenewalTime(crt, certificate.NotBefore, certificate.NotAfter, defaultRenewalJitter).UTC().Format(time.RFC3339)
 	}
 
 	return annotations
 }
 
+// OutputFormatKey returns the name of the data entry in the Certificate's
+// target Secret that the additional output format is written to.
+func OutputFormatKey(format cmapi.CertificateAdditionalOutputFormat) string {
+	if len(format.Key) > 0 {
+		return format.Key
+	}
+
+	switch format.Type {
+	case cmapi.CertificateOutputFormatDER:
+		return cmapi.CertificateOutputFormatDERKey
+	case cmapi.CertificateOutputFormatCombinedPEM:
+		return cmapi.CertificateOutputFormatCombinedPEMKey
+	case cmapi.CertificateOutputFormatDERCertificate:
+		return cmapi.CertificateOutputFormatDERCertificateKey
+	case cmapi.CertificateOutputFormatPKCS7:
+		return cmapi.CertificateOutputFormatPKCS7Key
+	default:
+		return ""
+	}
+}
+
 // OutputFormatDER returns the byte slice of the private key in DER format. To
 // be used for Certificate's Additional Output Format DER.
 func OutputFormatDER(privateKey []byte) []byte {
@@ -64,3 +92,25 @@ func OutputFormatDER(privateKey []byte) []byte {
 func OutputFormatCombinedPEM(privateKey, certificate []byte) []byte {
 	return bytes.Join([][]byte{privateKey, certificate}, []byte("\n"))
 }
+
+// OutputFormatDERCertificate returns the byte slice of the first certificate
+// of the PEM encoded signed certificate chain, in DER format. To be used for
+// Certificate's Additional Output Format DER Certificate.
+func OutputFormatDERCertificate(certificate []byte) []byte {
+	block, _ := pem.Decode(certificate)
+	if block == nil {
+		return nil
+	}
+	return block.Bytes
+}
+
+// OutputFormatPKCS7 returns the byte slice of the PEM encoded signed
+// certificate chain as a DER encoded PKCS#7 bundle. To be used for
+// Certificate's Additional Output Format PKCS7.
+func OutputFormatPKCS7(certificate []byte) ([]byte, error) {
+	chain, err := utilpki.DecodeX509CertificateChainBytes(certificate)
+	if err != nil {
+		return nil, err
+	}
+	return utilpki.EncodePKCS7Certificates(chain)
+}
@@ -22,6 +22,7 @@ import (
 	"net"
 	"net/url"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
@@ -54,6 +55,8 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				DNSNames:    []string{"example.com", "cert-manager.io"},
 				IPAddresses: []net.IP{{1, 1, 1, 1}, {1, 2, 3, 4}},
 				URIs:        urls,
+				NotBefore:   time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				NotAfter:    time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
 			},
 			expAnnotations: map[string]string{
 				"cert-manager.io/certificate-name": "test-certificate",
@@ -64,6 +67,30 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				"cert-manager.io/alt-names":        "example.com,cert-manager.io",
 				"cert-manager.io/ip-sans":          "1.1.1.1,1.2.3.4",
 				"cert-manager.io/uri-sans":         "spiffe.io//cert-manager.io/test,spiffe.io//hello.world",
+				"cert-manager.io/expires-at":       "2022-04-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "2022-03-02T00:00:00Z",
+			},
+		},
+		"if pass non-nil certificate with renewBefore, expect renewal-at to respect it": {
+			crt: gen.Certificate("test-certificate",
+				gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "test-issuer", Kind: "Issuer", Group: "cert-manager.io"}),
+				gen.SetCertificateRenewBefore(time.Hour*24*7),
+			),
+			certificate: &x509.Certificate{
+				NotBefore: time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC),
+				NotAfter:  time.Date(2022, 4, 1, 0, 0, 0, 0, time.UTC),
+			},
+			expAnnotations: map[string]string{
+				"cert-manager.io/certificate-name": "test-certificate",
+				"cert-manager.io/issuer-name":      "test-issuer",
+				"cert-manager.io/issuer-kind":      "Issuer",
+				"cert-manager.io/issuer-group":     "cert-manager.io",
+				"cert-manager.io/common-name":      "",
+				"cert-manager.io/alt-names":        "",
+				"cert-manager.io/ip-sans":          "",
+				"cert-manager.io/uri-sans":         "",
+				"cert-manager.io/expires-at":       "2022-04-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "2022-03-25T00:00:00Z",
 			},
 		},
 		"if pass non-nil certificate with only CommonName, expect all Annotations to be present": {
@@ -84,6 +111,8 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				"cert-manager.io/alt-names":        "",
 				"cert-manager.io/ip-sans":          "",
 				"cert-manager.io/uri-sans":         "",
+				"cert-manager.io/expires-at":       "0001-01-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "0001-01-01T00:00:00Z",
 			},
 		},
 		"if pass non-nil certificate with only IP Addresses, expect all Annotations to be present": {
@@ -102,6 +131,8 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				"cert-manager.io/alt-names":        "",
 				"cert-manager.io/ip-sans":          "1.1.1.1,1.2.3.4",
 				"cert-manager.io/uri-sans":         "",
+				"cert-manager.io/expires-at":       "0001-01-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "0001-01-01T00:00:00Z",
 			},
 		},
 		"if pass non-nil certificate with only URI SANs, expect all Annotations to be present": {
@@ -120,6 +151,8 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				"cert-manager.io/alt-names":        "",
 				"cert-manager.io/ip-sans":          "",
 				"cert-manager.io/uri-sans":         "spiffe.io//cert-manager.io/test,spiffe.io//hello.world",
+				"cert-manager.io/expires-at":       "0001-01-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "0001-01-01T00:00:00Z",
 			},
 		},
 		"if pass non-nil certificate with only DNS names, expect all Annotations to be present": {
@@ -138,6 +171,8 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 				"cert-manager.io/alt-names":        "example.com,cert-manager.io",
 				"cert-manager.io/ip-sans":          "",
 				"cert-manager.io/uri-sans":         "",
+				"cert-manager.io/expires-at":       "0001-01-01T00:00:00Z",
+				"cert-manager.io/renewal-at":       "0001-01-01T00:00:00Z",
 			},
 		},
 		"if no certificate data, then expect no X.509 related annotations": {
@@ -156,8 +191,42 @@ func Test_AnnotationsForCertificateSecret(t *testing.T) {
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
-			gotAnnotations := AnnotationsForCertificateSecret(test.crt, test.certificate)
+			gotAnnotations := AnnotationsForCertificateSecret(test.crt, test.certificate, 0)
 			assert.Equal(t, test.expAnnotations, gotAnnotations)
 		})
 	}
 }
+
+func Test_OutputFormatKey(t *testing.T) {
+	tests := map[string]struct {
+		format cmapi.CertificateAdditionalOutputFormat
+		expKey string
+	}{
+		"DER should default to key.der": {
+			format: cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatDER},
+			expKey: "key.der",
+		},
+		"CombinedPEM should default to tls-combined.pem": {
+			format: cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatCombinedPEM},
+			expKey: "tls-combined.pem",
+		},
+		"DERCertificate should default to tls.der": {
+			format: cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatDERCertificate},
+			expKey: "tls.der",
+		},
+		"PKCS7 should default to tls.p7b": {
+			format: cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatPKCS7},
+			expKey: "tls.p7b",
+		},
+		"a configured key should be used instead of the default": {
+			format: cmapi.CertificateAdditionalOutputFormat{Type: cmapi.CertificateOutputFormatPKCS7, Key: "chain.p7b"},
+			expKey: "chain.p7b",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expKey, OutputFormatKey(test.format))
+		})
+	}
+}
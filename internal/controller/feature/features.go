@@ -58,6 +58,38 @@ const (
 	// This feature gate must be used together with LiteralCertificateSubject webhook feature gate.
 	// See https://github.com/cert-manager/cert-manager/issues/3203 and https://github.com/cert-manager/cert-manager/issues/4424 for context.
 	LiteralCertificateSubject featuregate.Feature = "LiteralCertificateSubject"
+
+	// alpha: v1.10.0
+	//
+	// DeterministicIssuance replaces the randomness used to generate private keys and
+	// serial numbers with a deterministic source seeded by the --deterministic-issuance-seed
+	// flag, so that test environments can reproduce the same certificates. It is only
+	// supported by controllers built with the deterministic_issuance build tag, and must
+	// never be enabled in production.
+	DeterministicIssuance featuregate.Feature = "DeterministicIssuance"
+
+	// alpha: v1.10.0
+	//
+	// CertificateSoftDelete adds a finalizer to Certificates so that deleting a Certificate
+	// retires it rather than removing it outright: its Secret is kept along with a record of
+	// the deleted Certificate for the --certificate-soft-delete-retention period, during which
+	// the Certificate can be restored using `cmctl restore certificate`.
+	CertificateSoftDelete featuregate.Feature = "CertificateSoftDelete"
+
+	// alpha: v1.10.0
+	//
+	// VenafiPolicyPreValidation validates Certificates which reference a Venafi issuer against
+	// the policy of the issuer's zone before a CertificateRequest is created for them. Violations
+	// are reported using the `PolicyViolation` Certificate condition, rather than as an error from
+	// the Venafi API once the request has been submitted.
+	VenafiPolicyPreValidation featuregate.Feature = "VenafiPolicyPreValidation"
+
+	// alpha: v1.10.0
+	//
+	// PublishClusterTrustBundles publishes the CA certificates of CA issuers as Kubernetes
+	// ClusterTrustBundles (certificates.k8s.io/v1alpha1), so that they can be projected into
+	// pods by kubelet. This requires the ClusterTrustBundle API to be enabled on the API server.
+	PublishClusterTrustBundles featuregate.Feature = "PublishClusterTrustBundles"
 )
 
 func init() {
@@ -74,4 +106,8 @@ var defaultCertManagerFeatureGates = map[featuregate.Feature]featuregate.Feature
 	AdditionalCertificateOutputFormats:               {Default: false, PreRelease: featuregate.Alpha},
 	ServerSideApply:                                  {Default: false, PreRelease: featuregate.Alpha},
 	LiteralCertificateSubject:                        {Default: false, PreRelease: featuregate.Alpha},
+	DeterministicIssuance:                            {Default: false, PreRelease: featuregate.Alpha},
+	CertificateSoftDelete:                            {Default: false, PreRelease: featuregate.Alpha},
+	VenafiPolicyPreValidation:                        {Default: false, PreRelease: featuregate.Alpha},
+	PublishClusterTrustBundles:                       {Default: false, PreRelease: featuregate.Alpha},
 }
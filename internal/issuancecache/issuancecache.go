@@ -0,0 +1,167 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuancecache implements a short lived cache of certificates issued
+// by upstream certificate authorities such as Vault and Venafi.
+// When identical certificate signing requests are submitted to the same
+// issuer in quick succession, for example because of a reconcile loop, the
+// certificate issued for the first request is returned for the others rather
+// than asking the upstream to sign the same request again.
+package issuancecache
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Key identifies a signing request to a particular issuer.
+type Key string
+
+// KeyFor returns the Key for a request to sign the given PEM encoded CSR with
+// the given issuer and duration.
+// The issuer's UID and generation are part of the key, so certificates issued
+// before an issuer was recreated or its spec changed (for example to point at
+// a different Vault role or Venafi zone) are never returned.
+// The key covers everything in the CSR that is signed by its private key, so
+// requests with the same public key, subject, SANs and extensions share a
+// Key regardless of the signature itself. Any extra values which change what
+// the upstream would issue, such as custom fields, must be passed as extra.
+func KeyFor(issuer cmapi.GenericIssuer, csrPEM []byte, duration time.Duration, extra ...string) (Key, error) {
+	csr, err := pki.DecodeX509CertificateRequestBytes(csrPEM)
+	if err != nil {
+		return "", fmt.Errorf("failed to decode CSR: %w", err)
+	}
+
+	kind := cmapi.IssuerKind
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		kind = cmapi.ClusterIssuerKind
+	}
+
+	meta := issuer.GetObjectMeta()
+	h := sha256.New()
+	for _, s := range append([]string{kind, meta.Namespace, meta.Name, string(meta.UID)}, extra...) {
+		writeField(h, []byte(s))
+	}
+	writeUint64(h, uint64(meta.Generation))
+	writeUint64(h, uint64(duration))
+	writeField(h, csr.RawTBSCertificateRequest)
+
+	return Key(hex.EncodeToString(h.Sum(nil))), nil
+}
+
+// writeField writes a length prefixed value so that the boundaries between
+// fields cannot be confused.
+func writeField(h hash.Hash, b []byte) {
+	var l [8]byte
+	binary.BigEndian.PutUint64(l[:], uint64(len(b)))
+	h.Write(l[:])
+	h.Write(b)
+}
+
+func writeUint64(h hash.Hash, v uint64) {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	writeField(h, b[:])
+}
+
+// Entry is a certificate issued by an upstream.
+type Entry struct {
+	// Certificate is the PEM encoded certificate chain.
+	Certificate []byte
+	// CA is the PEM encoded CA certificate, if known.
+	CA []byte
+}
+
+type cacheEntry struct {
+	Entry
+	expiresAt time.Time
+}
+
+// Cache holds recently issued certificates. It is shared across all
+// controllers so that duplicate requests are detected no matter which
+// controller handles them.
+// A nil *Cache is valid and disables caching.
+type Cache struct {
+	ttl   time.Duration
+	clock clock.Clock
+
+	lock    sync.Mutex
+	entries map[Key]cacheEntry
+}
+
+// New returns a Cache which returns issued certificates for identical
+// requests made within ttl of each other. If ttl is zero, nil is returned
+// and caching is disabled.
+func New(ttl time.Duration, clock clock.Clock) *Cache {
+	if ttl <= 0 {
+		return nil
+	}
+	return &Cache{
+		ttl:     ttl,
+		clock:   clock,
+		entries: make(map[Key]cacheEntry),
+	}
+}
+
+// Get returns the certificate issued for the given key, if one was added
+// within the cache's ttl.
+func (c *Cache) Get(key Key) (Entry, bool) {
+	if c == nil {
+		return Entry{}, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	e, ok := c.entries[key]
+	if !ok {
+		return Entry{}, false
+	}
+	if !c.clock.Now().Before(e.expiresAt) {
+		delete(c.entries, key)
+		return Entry{}, false
+	}
+	return e.Entry, true
+}
+
+// Add stores the certificate issued for the given key. Expired entries are
+// pruned at the same time so that the cache does not grow without bound.
+func (c *Cache) Add(key Key, entry Entry) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	c.entries[key] = cacheEntry{Entry: entry, expiresAt: now.Add(c.ttl)}
+}
@@ -0,0 +1,136 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuancecache
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"testing"
+	"time"
+
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func mustCSR(t *testing.T, key crypto.Signer, dnsNames ...string) []byte {
+	t.Helper()
+	csr, err := pki.EncodeCSR(&x509.CertificateRequest{
+		Subject:  pkix.Name{CommonName: "example.com"},
+		DNSNames: dnsNames,
+	}, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csr})
+}
+
+func TestKeyFor(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issuer := gen.Issuer("vault", gen.SetIssuerNamespace("ns"))
+	issuer.UID = "uid"
+	issuer.Generation = 1
+	clusterIssuer := gen.ClusterIssuer("vault")
+	recreatedIssuer := issuer.DeepCopy()
+	recreatedIssuer.UID = "other-uid"
+	updatedIssuer := issuer.DeepCopy()
+	updatedIssuer.Generation = 2
+
+	mustKey := func(issuer cmapi.GenericIssuer, csr []byte, duration time.Duration, extra ...string) Key {
+		t.Helper()
+		k, err := KeyFor(issuer, csr, duration, extra...)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return k
+	}
+
+	// ECDSA signatures are randomised, so two CSRs for the same key and
+	// contents differ but must share a key.
+	base := mustKey(issuer, mustCSR(t, key, "example.com"), time.Hour)
+	if k := mustKey(issuer, mustCSR(t, key, "example.com"), time.Hour); k != base {
+		t.Errorf("expected identical requests to share a key")
+	}
+
+	for name, k := range map[string]Key{
+		"different private key": mustKey(issuer, mustCSR(t, otherKey, "example.com"), time.Hour),
+		"different SANs":        mustKey(issuer, mustCSR(t, key, "example.org"), time.Hour),
+		"different duration":    mustKey(issuer, mustCSR(t, key, "example.com"), 2*time.Hour),
+		"different issuer kind": mustKey(clusterIssuer, mustCSR(t, key, "example.com"), time.Hour),
+		"recreated issuer":      mustKey(recreatedIssuer, mustCSR(t, key, "example.com"), time.Hour),
+		"updated issuer spec":   mustKey(updatedIssuer, mustCSR(t, key, "example.com"), time.Hour),
+		"different extra":       mustKey(issuer, mustCSR(t, key, "example.com"), time.Hour, `[{"name":"a","value":"b"}]`),
+	} {
+		if k == base {
+			t.Errorf("%s: expected a different key", name)
+		}
+	}
+
+	if _, err := KeyFor(issuer, []byte("not a csr"), time.Hour); err == nil {
+		t.Errorf("expected an error for an invalid CSR")
+	}
+}
+
+func TestCache(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	c := New(time.Minute, clock)
+	entry := Entry{Certificate: []byte("cert"), CA: []byte("ca")}
+
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected empty cache to miss")
+	}
+
+	c.Add("a", entry)
+	got, ok := c.Get("a")
+	if !ok || string(got.Certificate) != "cert" || string(got.CA) != "ca" {
+		t.Fatalf("expected cache hit, got %v %v", got, ok)
+	}
+
+	clock.Step(time.Minute)
+	if _, ok := c.Get("a"); ok {
+		t.Fatalf("expected entry to have expired")
+	}
+
+	c.Add("b", entry)
+	clock.Step(time.Minute)
+	c.Add("c", entry)
+	if len(c.entries) != 1 {
+		t.Errorf("expected expired entries to be pruned, got %d entries", len(c.entries))
+	}
+}
+
+func TestNilCache(t *testing.T) {
+	c := New(0, fakeclock.NewFakeClock(time.Now()))
+	if c != nil {
+		t.Fatalf("expected a zero ttl to disable the cache")
+	}
+	c.Add("a", Entry{Certificate: []byte("cert")})
+	if _, ok := c.Get("a"); ok {
+		t.Errorf("expected nil cache to always miss")
+	}
+}
@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuancelatency keeps track of how long issuers take to issue
+// certificates, so that the observed latency can be compared against the
+// IssuanceLatencyBudget configured on each issuer.
+package issuancelatency
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// DefaultSamples is the number of most recent issuances that latency
+	// statistics are computed over if an IssuanceLatencyBudget does not
+	// specify the number of samples.
+	DefaultSamples = 20
+
+	// MinSamples is the number of issuances that must have been observed
+	// before an issuer is compared against its budget, so that a single slow
+	// issuance does not mark an issuer as degraded.
+	MinSamples = 5
+)
+
+// Stats are the latency statistics of an issuer's most recent issuances.
+type Stats struct {
+	// Count is the number of issuances the statistics were computed over.
+	Count int
+	// Average is the mean issuance latency.
+	Average time.Duration
+	// P95 is the 95th percentile issuance latency.
+	P95 time.Duration
+}
+
+// Tracker records the issuance latencies of issuers.
+// A nil Tracker records nothing and always returns empty Stats.
+type Tracker struct {
+	lock      sync.Mutex
+	latencies map[string][]time.Duration
+}
+
+// New returns an empty Tracker.
+func New() *Tracker {
+	return &Tracker{latencies: make(map[string][]time.Duration)}
+}
+
+// KeyFor returns the key that the latencies of the given issuer are recorded
+// under.
+func KeyFor(issuer cmapi.GenericIssuer) string {
+	kind := cmapi.IssuerKind
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		kind = cmapi.ClusterIssuerKind
+	}
+	return strings.Join([]string{kind, issuer.GetNamespace(), issuer.GetName()}, "/")
+}
+
+// Observe records an issuance by the issuer with the given key that took
+// the given latency. Only the given number of most recent issuances are
+// kept for the issuer, and the Stats of those issuances are returned.
+// If samples is not positive, DefaultSamples is used.
+func (t *Tracker) Observe(key string, latency time.Duration, samples int) Stats {
+	if t == nil {
+		return Stats{}
+	}
+	if samples <= 0 {
+		samples = DefaultSamples
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	latencies := append(t.latencies[key], latency)
+	if len(latencies) > samples {
+		latencies = latencies[len(latencies)-samples:]
+	}
+	t.latencies[key] = latencies
+
+	return statsFor(latencies)
+}
+
+// Forget removes all recorded issuances of the issuer with the given key.
+func (t *Tracker) Forget(key string) {
+	if t == nil {
+		return
+	}
+
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	delete(t.latencies, key)
+}
+
+func statsFor(latencies []time.Duration) Stats {
+	if len(latencies) == 0 {
+		return Stats{}
+	}
+
+	sorted := make([]time.Duration, len(latencies))
+	copy(sorted, latencies)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+
+	var total time.Duration
+	for _, l := range sorted {
+		total += l
+	}
+
+	// nearest-rank percentile
+	rank := int(math.Ceil(0.95*float64(len(sorted)))) - 1
+
+	return Stats{
+		Count:   len(sorted),
+		Average: total / time.Duration(len(sorted)),
+		P95:     sorted[rank],
+	}
+}
+
+// Evaluate compares the given Stats against the budget. It returns whether
+// the budget has been exceeded, along with a message describing the
+// observed latency. If fewer than MinSamples issuances have been observed,
+// ok is false and the issuer should not be judged yet.
+func Evaluate(budget *cmapi.IssuanceLatencyBudget, stats Stats) (exceeded bool, message string, ok bool) {
+	if budget == nil || stats.Count < MinSamples {
+		return false, "", false
+	}
+
+	var reasons []string
+	if budget.Average != nil && stats.Average > budget.Average.Duration {
+		reasons = append(reasons, fmt.Sprintf("average latency %s exceeds budget of %s", stats.Average.Round(time.Second), budget.Average.Duration))
+	}
+	if budget.P95 != nil && stats.P95 > budget.P95.Duration {
+		reasons = append(reasons, fmt.Sprintf("95th percentile latency %s exceeds budget of %s", stats.P95.Round(time.Second), budget.P95.Duration))
+	}
+
+	if len(reasons) > 0 {
+		return true, fmt.Sprintf("Over the last %d issuances, %s", stats.Count, strings.Join(reasons, " and ")), true
+	}
+
+	return false, fmt.Sprintf("Over the last %d issuances, the average latency was %s and the 95th percentile latency was %s, within budget",
+		stats.Count, stats.Average.Round(time.Second), stats.P95.Round(time.Second)), true
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuancelatency
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestKeyFor(t *testing.T) {
+	issuer := gen.Issuer("ca", gen.SetIssuerNamespace("ns"))
+	clusterIssuer := gen.ClusterIssuer("ca")
+
+	if got := KeyFor(issuer); got != "Issuer/ns/ca" {
+		t.Errorf("unexpected key for Issuer: %q", got)
+	}
+	if got := KeyFor(clusterIssuer); got != "ClusterIssuer//ca" {
+		t.Errorf("unexpected key for ClusterIssuer: %q", got)
+	}
+}
+
+func TestObserve(t *testing.T) {
+	tracker := New()
+
+	var stats Stats
+	for i := 1; i <= 10; i++ {
+		stats = tracker.Observe("a", time.Duration(i)*time.Second, 4)
+	}
+	// only the 4 most recent latencies, 7s to 10s, are kept
+	if stats.Count != 4 {
+		t.Errorf("expected 4 samples, got %d", stats.Count)
+	}
+	if stats.Average != 8500*time.Millisecond {
+		t.Errorf("expected average of 8.5s, got %s", stats.Average)
+	}
+	if stats.P95 != 10*time.Second {
+		t.Errorf("expected p95 of 10s, got %s", stats.P95)
+	}
+
+	// latencies are recorded separately per key
+	if stats := tracker.Observe("b", time.Second, 4); stats.Count != 1 {
+		t.Errorf("expected 1 sample for a new key, got %d", stats.Count)
+	}
+
+	tracker.Forget("a")
+	if stats := tracker.Observe("a", time.Second, 4); stats.Count != 1 {
+		t.Errorf("expected 1 sample after forgetting key, got %d", stats.Count)
+	}
+
+	var nilTracker *Tracker
+	if stats := nilTracker.Observe("a", time.Second, 4); stats != (Stats{}) {
+		t.Errorf("expected empty stats from nil tracker, got %+v", stats)
+	}
+}
+
+func TestEvaluate(t *testing.T) {
+	budget := &cmapi.IssuanceLatencyBudget{
+		Average: &metav1.Duration{Duration: time.Minute},
+		P95:     &metav1.Duration{Duration: 5 * time.Minute},
+	}
+
+	tests := map[string]struct {
+		budget       *cmapi.IssuanceLatencyBudget
+		stats        Stats
+		wantExceeded bool
+		wantMessage  string
+		wantOK       bool
+	}{
+		"no budget": {
+			stats: Stats{Count: 10, Average: time.Hour, P95: time.Hour},
+		},
+		"too few samples": {
+			budget: budget,
+			stats:  Stats{Count: MinSamples - 1, Average: time.Hour, P95: time.Hour},
+		},
+		"within budget": {
+			budget:      budget,
+			stats:       Stats{Count: 10, Average: 30 * time.Second, P95: 2 * time.Minute},
+			wantMessage: "Over the last 10 issuances, the average latency was 30s and the 95th percentile latency was 2m0s, within budget",
+			wantOK:      true,
+		},
+		"average exceeded": {
+			budget:       budget,
+			stats:        Stats{Count: 10, Average: 90 * time.Second, P95: 2 * time.Minute},
+			wantExceeded: true,
+			wantMessage:  "Over the last 10 issuances, average latency 1m30s exceeds budget of 1m0s",
+			wantOK:       true,
+		},
+		"average and p95 exceeded": {
+			budget:       budget,
+			stats:        Stats{Count: 10, Average: 90 * time.Second, P95: 10 * time.Minute},
+			wantExceeded: true,
+			wantMessage:  "Over the last 10 issuances, average latency 1m30s exceeds budget of 1m0s and 95th percentile latency 10m0s exceeds budget of 5m0s",
+			wantOK:       true,
+		},
+		"only p95 configured and exceeded": {
+			budget:       &cmapi.IssuanceLatencyBudget{P95: &metav1.Duration{Duration: time.Minute}},
+			stats:        Stats{Count: 10, Average: time.Hour, P95: time.Hour},
+			wantExceeded: true,
+			wantMessage:  "Over the last 10 issuances, 95th percentile latency 1h0m0s exceeds budget of 1m0s",
+			wantOK:       true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			exceeded, message, ok := Evaluate(test.budget, test.stats)
+			if exceeded != test.wantExceeded {
+				t.Errorf("expected exceeded=%t, got %t", test.wantExceeded, exceeded)
+			}
+			if message != test.wantMessage {
+				t.Errorf("unexpected message:\nwant: %q\ngot:  %q", test.wantMessage, message)
+			}
+			if ok != test.wantOK {
+				t.Errorf("expected ok=%t, got %t", test.wantOK, ok)
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuanceratelimit limits the rate at which issuers send
+// CertificateRequests to their upstream CA, according to the RateLimit
+// configured on each issuer.
+package issuanceratelimit
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/utils/clock"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Limiter records the requests recently admitted for each issuer.
+// A nil Limiter admits every request.
+type Limiter struct {
+	clock clock.Clock
+
+	lock sync.Mutex
+	// admitted maps an issuer key to the IDs of its admitted requests and the
+	// time that each was last worked on.
+	admitted map[string]map[string]time.Time
+}
+
+// New returns a Limiter which has not admitted any requests.
+func New(clock clock.Clock) *Limiter {
+	return &Limiter{
+		clock:    clock,
+		admitted: make(map[string]map[string]time.Time),
+	}
+}
+
+// Allow reports whether the request with the given ID may be sent to the
+// issuer with the given key under the given limit. A request counts against
+// the limit until it has not been passed to Allow for a whole period, so
+// that requests which take several syncs to complete, such as ACME orders,
+// are not limited part way through.
+// If the request is not allowed, Allow also returns how long it will be
+// until the issuer has capacity for it.
+func (l *Limiter) Allow(key, id string, limit *cmapi.IssuanceRateLimit) (bool, time.Duration) {
+	if l == nil || limit == nil || limit.Period == nil {
+		return true, 0
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := l.clock.Now()
+	period := limit.Period.Duration
+
+	requests := l.admitted[key]
+	if requests == nil {
+		requests = make(map[string]time.Time)
+		l.admitted[key] = requests
+	}
+
+	var oldest time.Time
+	for reqID, seen := range requests {
+		if now.Sub(seen) >= period {
+			delete(requests, reqID)
+			continue
+		}
+		if oldest.IsZero() || seen.Before(oldest) {
+			oldest = seen
+		}
+	}
+
+	if _, ok := requests[id]; ok || len(requests) < int(limit.MaxRequests) {
+		requests[id] = now
+		return true, 0
+	}
+
+	return false, oldest.Add(period).Sub(now)
+}
+
+// Forget removes all admitted requests of the issuer with the given key.
+func (l *Limiter) Forget(key string) {
+	if l == nil {
+		return
+	}
+
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	delete(l.admitted, key)
+}
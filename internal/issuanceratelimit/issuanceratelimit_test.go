@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuanceratelimit
+
+import (
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestAllow(t *testing.T) {
+	clock := fakeclock.NewFakeClock(time.Now())
+	limiter := New(clock)
+	limit := &cmapi.IssuanceRateLimit{
+		MaxRequests: 2,
+		Period:      &metav1.Duration{Duration: time.Hour},
+	}
+
+	if ok, _ := limiter.Allow("a", "1", limit); !ok {
+		t.Errorf("expected first request to be allowed")
+	}
+	clock.Step(10 * time.Minute)
+	if ok, _ := limiter.Allow("a", "2", limit); !ok {
+		t.Errorf("expected second request to be allowed")
+	}
+
+	ok, wait := limiter.Allow("a", "3", limit)
+	if ok {
+		t.Errorf("expected third request to be limited")
+	}
+	if wait != 50*time.Minute {
+		t.Errorf("expected to wait 50m for capacity, got %s", wait)
+	}
+
+	// requests which have already been admitted are allowed again
+	if ok, _ := limiter.Allow("a", "1", limit); !ok {
+		t.Errorf("expected admitted request to be allowed again")
+	}
+
+	// requests are limited separately per key
+	if ok, _ := limiter.Allow("b", "3", limit); !ok {
+		t.Errorf("expected request for a different key to be allowed")
+	}
+
+	// requests are no longer counted once they have not been seen for a period
+	clock.Step(time.Hour)
+	if ok, _ := limiter.Allow("a", "3", limit); !ok {
+		t.Errorf("expected request to be allowed once the period has passed")
+	}
+
+	limiter.Forget("a")
+	if ok, _ := limiter.Allow("a", "4", limit); !ok {
+		t.Errorf("expected request to be allowed after forgetting key")
+	}
+
+	if ok, _ := limiter.Allow("c", "1", nil); !ok {
+		t.Errorf("expected request without a limit to be allowed")
+	}
+
+	var nilLimiter *Limiter
+	if ok, _ := nilLimiter.Allow("a", "5", &cmapi.IssuanceRateLimit{Period: &metav1.Duration{Duration: time.Hour}}); !ok {
+		t.Errorf("expected nil limiter to allow requests")
+	}
+}
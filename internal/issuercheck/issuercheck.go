@@ -0,0 +1,289 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package issuercheck validates a Certificate against the constraints of the
+// issuer it references without requesting a certificate, so that problems
+// can be reported before anything is created.
+package issuercheck
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	internalcmapi "github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	internalcmapiv1 "github.com/cert-manager/cert-manager/internal/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation"
+	"github.com/cert-manager/cert-manager/internal/vault"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Options configures how the issuer's upstream constraints are read.
+type Options struct {
+	// ResourceNamespace is the namespace in which the credentials of the
+	// issuer are read.
+	ResourceNamespace string
+
+	SecretsLister       corelisters.SecretLister
+	VaultClientBuilder  vault.ClientBuilder
+	VenafiClientBuilder venaficlient.VenafiClientBuilder
+	Metrics             *metrics.Metrics
+	Log                 logr.Logger
+}
+
+// ValidateSpec validates the Certificate's spec as the webhook does when it
+// is created.
+func ValidateSpec(crt *cmapi.Certificate) (field.ErrorList, error) {
+	internalCrt, err := convertCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	return validation.ValidateCertificateSpec(&internalCrt.Spec, field.NewPath("spec")), nil
+}
+
+// Check validates the Certificate's spec and checks it against the
+// constraints of the issuer: the identifiers ACME can validate, the role a
+// Vault issuer signs with and the policy of a Venafi zone. The role and zone
+// policy are read using the issuer's credentials. Each problem found is
+// returned as a field error; the returned error is only set if the issuer's
+// constraints could not be read.
+func Check(crt *cmapi.Certificate, issuerObj cmapi.GenericIssuer, opts Options) (field.ErrorList, error) {
+	internalCrt, err := convertCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	internalIssuer, err := convertIssuer(issuerObj)
+	if err != nil {
+		return nil, err
+	}
+
+	specPath := field.NewPath("spec")
+	el := validation.ValidateCertificateSpec(&internalCrt.Spec, specPath)
+	el = append(el, validation.ValidateCertificateForIssuer(internalCrt, internalIssuer)...)
+
+	switch {
+	case issuerObj.GetSpec().Vault != nil:
+		client, err := opts.VaultClientBuilder(opts.ResourceNamespace, opts.SecretsLister, issuerObj, nil, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialise Vault client: %w", err)
+		}
+		role, err := client.ReadRole()
+		if err != nil {
+			return nil, err
+		}
+		if role != nil {
+			el = append(el, vaultRoleViolations(crt, role.Data, specPath)...)
+		}
+
+	case issuerObj.GetSpec().Venafi != nil:
+		client, err := opts.VenafiClientBuilder(opts.ResourceNamespace, opts.SecretsLister, issuerObj, opts.Metrics, nil, opts.Log)
+		if err != nil {
+			return nil, fmt.Errorf("failed to initialise Venafi client: %w", err)
+		}
+		zoneConfig, err := client.ReadZoneConfiguration()
+		if err != nil {
+			return nil, fmt.Errorf("failed to read Venafi zone configuration: %w", err)
+		}
+
+		csr, err := pki.GenerateCSR(crt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate certificate request: %w", err)
+		}
+		// The zone policy may constrain the key, so a throwaway key of the
+		// configured algorithm and size is generated to check it.
+		key, err := pki.GeneratePrivateKeyForCertificate(crt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to generate private key: %w", err)
+		}
+
+		zone := issuerObj.GetSpec().Venafi.Zone
+		for _, violation := range venaficlient.ZonePolicyViolations(zoneConfig, csr, key.Public()) {
+			el = append(el, field.Forbidden(specPath, fmt.Sprintf("violates the policy of Venafi zone %q: %s", zone, violation)))
+		}
+	}
+
+	return el, nil
+}
+
+// convertCertificate converts the Certificate to its internal version.
+func convertCertificate(crt *cmapi.Certificate) (*internalcmapi.Certificate, error) {
+	out := &internalcmapi.Certificate{}
+	if err := internalcmapiv1.Convert_v1_Certificate_To_certmanager_Certificate(crt, out, nil); err != nil {
+		return nil, fmt.Errorf("failed to convert Certificate: %w", err)
+	}
+	return out, nil
+}
+
+// convertIssuer converts the issuer to its internal version.
+func convertIssuer(issuerObj cmapi.GenericIssuer) (internalcmapi.GenericIssuer, error) {
+	switch iss := issuerObj.(type) {
+	case *cmapi.Issuer:
+		out := &internalcmapi.Issuer{}
+		if err := internalcmapiv1.Convert_v1_Issuer_To_certmanager_Issuer(iss, out, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert Issuer: %w", err)
+		}
+		return out, nil
+	case *cmapi.ClusterIssuer:
+		out := &internalcmapi.ClusterIssuer{}
+		if err := internalcmapiv1.Convert_v1_ClusterIssuer_To_certmanager_ClusterIssuer(iss, out, nil); err != nil {
+			return nil, fmt.Errorf("failed to convert ClusterIssuer: %w", err)
+		}
+		return out, nil
+	default:
+		return nil, fmt.Errorf("unsupported issuer type %T", issuerObj)
+	}
+}
+
+// vaultRoleViolations returns an error for each way in which the Certificate
+// would be rejected or altered by a Vault PKI role with the given settings.
+// Only the settings constraining names, IP addresses, URIs and lifetimes are
+// checked.
+func vaultRoleViolations(crt *cmapi.Certificate, role map[string]interface{}, specPath *field.Path) field.ErrorList {
+	el := field.ErrorList{}
+
+	if !roleBool(role, "allow_any_name") {
+		allowedDomains := roleStrings(role, "allowed_domains")
+		allowed := func(name string) bool {
+			return vaultDomainAllowed(name, allowedDomains,
+				roleBool(role, "allow_bare_domains"), roleBool(role, "allow_subdomains"), roleBool(role, "allow_glob_domains"))
+		}
+		if cn := crt.Spec.CommonName; cn != "" && !allowed(cn) {
+			el = append(el, field.Forbidden(specPath.Child("commonName"), fmt.Sprintf("%q is not allowed by the Vault role's allowed_domains %v", cn, allowedDomains)))
+		}
+		for i, name := range crt.Spec.DNSNames {
+			if !allowed(name) {
+				el = append(el, field.Forbidden(specPath.Child("dnsNames").Index(i), fmt.Sprintf("%q is not allowed by the Vault role's allowed_domains %v", name, allowedDomains)))
+			}
+		}
+	}
+
+	if len(crt.Spec.IPAddresses) > 0 && !roleBool(role, "allow_ip_sans") {
+		el = append(el, field.Forbidden(specPath.Child("ipAddresses"), "IP addresses are not allowed by the Vault role, set allow_ip_sans to allow them"))
+	}
+
+	allowedURIs := roleStrings(role, "allowed_uri_sans")
+	for i, uri := range crt.Spec.URIs {
+		if !matchesAnyGlob(allowedURIs, uri) {
+			el = append(el, field.Forbidden(specPath.Child("uris").Index(i), fmt.Sprintf("%q is not allowed by the Vault role's allowed_uri_sans %v", uri, allowedURIs)))
+		}
+	}
+
+	duration := cmapi.DefaultCertificateDuration
+	if crt.Spec.Duration != nil {
+		duration = crt.Spec.Duration.Duration
+	}
+	if maxTTL := roleDuration(role, "max_ttl"); maxTTL > 0 && duration > maxTTL {
+		el = append(el, field.Invalid(specPath.Child("duration"), duration.String(), fmt.Sprintf("exceeds the Vault role's max_ttl of %s, Vault would issue a shorter certificate", maxTTL)))
+	}
+
+	return el
+}
+
+// vaultDomainAllowed returns true if the name may be requested from a Vault
+// role with the given allowed_domains, allow_bare_domains,
+// allow_subdomains and allow_glob_domains settings.
+func vaultDomainAllowed(name string, allowedDomains []string, allowBare, allowSubdomains, allowGlobs bool) bool {
+	for _, domain := range allowedDomains {
+		switch {
+		case allowBare && name == domain:
+			return true
+		case allowSubdomains && strings.HasSuffix(name, "."+domain):
+			return true
+		case allowGlobs && strings.Contains(domain, "*") && matchGlob(domain, name):
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyGlob returns true if the value matches one of the patterns.
+func matchesAnyGlob(patterns []string, value string) bool {
+	for _, pattern := range patterns {
+		if matchGlob(pattern, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// matchGlob returns true if the value matches the pattern, in which `*`
+// matches any number of characters as it does in Vault.
+func matchGlob(pattern, value string) bool {
+	parts := strings.Split(pattern, "*")
+	if len(parts) == 1 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, parts[0]) {
+		return false
+	}
+	value = value[len(parts[0]):]
+	for _, part := range parts[1 : len(parts)-1] {
+		i := strings.Index(value, part)
+		if i < 0 {
+			return false
+		}
+		value = value[i+len(part):]
+	}
+	return strings.HasSuffix(value, parts[len(parts)-1])
+}
+
+func roleBool(role map[string]interface{}, key string) bool {
+	b, _ := role[key].(bool)
+	return b
+}
+
+// roleStrings returns a list setting of the role, which Vault returns either
+// as a list or as a comma separated string.
+func roleStrings(role map[string]interface{}, key string) []string {
+	switch v := role[key].(type) {
+	case []interface{}:
+		var values []string
+		for _, value := range v {
+			if s, ok := value.(string); ok && s != "" {
+				values = append(values, s)
+			}
+		}
+		return values
+	case string:
+		if v == "" {
+			return nil
+		}
+		return strings.Split(v, ",")
+	default:
+		return nil
+	}
+}
+
+// roleDuration returns a TTL setting of the role, which Vault returns as a
+// number of seconds.
+func roleDuration(role map[string]interface{}, key string) time.Duration {
+	var seconds int64
+	switch v := role[key].(type) {
+	case json.Number:
+		seconds, _ = v.Int64()
+	case float64:
+		seconds = int64(v)
+	}
+	return time.Duration(seconds) * time.Second
+}
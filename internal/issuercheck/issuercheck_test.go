@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuercheck
+
+import (
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/Venafi/vcert/v4/pkg/endpoint"
+	"github.com/go-logr/logr"
+	vaultapi "github.com/hashicorp/vault/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
+	"github.com/cert-manager/cert-manager/internal/vault"
+	vaultfake "github.com/cert-manager/cert-manager/internal/vault/fake"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	venafifake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+func TestVaultRoleViolations(t *testing.T) {
+	specPath := field.NewPath("spec")
+	role := map[string]interface{}{
+		"allowed_domains":    []interface{}{"example.com", "*.example.org"},
+		"allow_subdomains":   true,
+		"allow_glob_domains": true,
+		"allowed_uri_sans":   "spiffe://example.com/*",
+		"max_ttl":            json.Number("86400"),
+	}
+
+	tests := map[string]struct {
+		spec cmapi.CertificateSpec
+		role map[string]interface{}
+		errs field.ErrorList
+	}{
+		"a certificate within the role's constraints": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "www.example.com",
+				DNSNames:   []string{"www.example.com", "api.example.org"},
+				URIs:       []string{"spiffe://example.com/ns/default"},
+				Duration:   &metav1.Duration{Duration: time.Hour},
+			},
+			role: role,
+			errs: field.ErrorList{},
+		},
+		"names outside allowed_domains are forbidden": {
+			spec: cmapi.CertificateSpec{
+				DNSNames: []string{"www.example.com", "example.com", "example.net"},
+				Duration: &metav1.Duration{Duration: time.Hour},
+			},
+			role: role,
+			errs: field.ErrorList{
+				field.Forbidden(specPath.Child("dnsNames").Index(1), `"example.com" is not allowed by the Vault role's allowed_domains [example.com *.example.org]`),
+				field.Forbidden(specPath.Child("dnsNames").Index(2), `"example.net" is not allowed by the Vault role's allowed_domains [example.com *.example.org]`),
+			},
+		},
+		"allow_any_name allows any name": {
+			spec: cmapi.CertificateSpec{
+				CommonName: "example.net",
+			},
+			role: map[string]interface{}{"allow_any_name": true},
+			errs: field.ErrorList{},
+		},
+		"IP addresses, URIs and durations are checked": {
+			spec: cmapi.CertificateSpec{
+				DNSNames:    []string{"www.example.com"},
+				IPAddresses: []string{"10.0.0.1"},
+				URIs:        []string{"spiffe://example.net/ns/default"},
+			},
+			role: role,
+			errs: field.ErrorList{
+				field.Forbidden(specPath.Child("ipAddresses"), "IP addresses are not allowed by the Vault role, set allow_ip_sans to allow them"),
+				field.Forbidden(specPath.Child("uris").Index(0), `"spiffe://example.net/ns/default" is not allowed by the Vault role's allowed_uri_sans [spiffe://example.com/*]`),
+				field.Invalid(specPath.Child("duration"), "2160h0m0s", "exceeds the Vault role's max_ttl of 24h0m0s, Vault would issue a shorter certificate"),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: test.spec}
+			errs := vaultRoleViolations(crt, test.role, specPath)
+			if !reflect.DeepEqual(errs, test.errs) {
+				t.Errorf("expected errors %v, got %v", test.errs, errs)
+			}
+		})
+	}
+}
+
+func TestCheck(t *testing.T) {
+	crt := &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "default"},
+		Spec: cmapi.CertificateSpec{
+			SecretName: "test-tls",
+			DNSNames:   []string{"www.example.net"},
+			IssuerRef:  cmmeta.ObjectReference{Name: "issuer"},
+		},
+	}
+	newIssuer := func(config cmapi.IssuerConfig) *cmapi.Issuer {
+		return &cmapi.Issuer{
+			ObjectMeta: metav1.ObjectMeta{Name: "issuer", Namespace: "default"},
+			Spec:       cmapi.IssuerSpec{IssuerConfig: config},
+		}
+	}
+
+	opts := Options{
+		ResourceNamespace: "default",
+		VaultClientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, *circuitbreaker.Registry, *vault.TokenCache) (vault.Interface, error) {
+			v := vaultfake.New()
+			v.ReadRoleFn = func() (*vaultapi.Secret, error) {
+				return &vaultapi.Secret{Data: map[string]interface{}{
+					"allowed_domains":  []interface{}{"example.com"},
+					"allow_subdomains": true,
+				}}, nil
+			}
+			return v, nil
+		},
+		VenafiClientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (venaficlient.Interface, error) {
+			return &venafifake.Venafi{
+				ReadZoneConfigurationFn: func() (*endpoint.ZoneConfiguration, error) {
+					return &endpoint.ZoneConfiguration{Policy: endpoint.Policy{
+						DnsSanRegExs: []string{`\.example\.com$`},
+					}}, nil
+				},
+			}, nil
+		},
+		Log: logr.Discard(),
+	}
+
+	tests := map[string]struct {
+		issuer *cmapi.Issuer
+		errs   field.ErrorList
+	}{
+		"a CA issuer has no constraints": {
+			issuer: newIssuer(cmapi.IssuerConfig{CA: &cmapi.CAIssuer{SecretName: "ca"}}),
+			errs:   field.ErrorList{},
+		},
+		"the role of a Vault issuer is checked": {
+			issuer: newIssuer(cmapi.IssuerConfig{Vault: &cmapi.VaultIssuer{Path: "pki/sign/my-role"}}),
+			errs: field.ErrorList{
+				field.Forbidden(field.NewPath("spec", "dnsNames").Index(0), `"www.example.net" is not allowed by the Vault role's allowed_domains [example.com]`),
+			},
+		},
+		"the zone policy of a Venafi issuer is checked": {
+			issuer: newIssuer(cmapi.IssuerConfig{Venafi: &cmapi.VenafiIssuer{Zone: "my-zone"}}),
+			errs: field.ErrorList{
+				field.Forbidden(field.NewPath("spec"), `violates the policy of Venafi zone "my-zone": DNS name "www.example.net" is not allowed`),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			errs, err := Check(crt, test.issuer, opts)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(errs, test.errs) {
+				t.Errorf("expected errors %v, got %v", test.errs, errs)
+			}
+		})
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reissuance
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+)
+
+const PluginName = "CertificateReissuanceWarning"
+
+// certificateReissuance returns admission warnings when an update to a
+// Certificate resource would cause it to be re-issued, so that users are
+// made aware of the consequences of a change before it is applied.
+type certificateReissuance struct {
+	*admission.Handler
+}
+
+// Register registers a plugin
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func() (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+var _ admission.ValidationInterface = &certificateReissuance{}
+
+func NewPlugin() admission.Interface {
+	return &certificateReissuance{
+		Handler: admission.NewHandler(admissionv1.Update),
+	}
+}
+
+func (p *certificateReissuance) Validate(_ context.Context, request admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+	// Only run this admission plugin for updates to the spec of Certificate
+	// resources.
+	if request.RequestResource.Group != "cert-manager.io" ||
+		request.RequestResource.Resource != "certificates" ||
+		request.SubResource != "" ||
+		request.Operation != admissionv1.Update {
+		return nil, nil
+	}
+
+	crt, ok := obj.(*certmanager.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("internal error: object in admission request is not of type *certmanager.Certificate")
+	}
+	oldCrt, ok := oldObj.(*certmanager.Certificate)
+	if !ok {
+		return nil, fmt.Errorf("internal error: oldObject in admission request is not of type *certmanager.Certificate")
+	}
+
+	return reissuanceWarnings(&oldCrt.Spec, &crt.Spec), nil
+}
+
+// reissuanceWarnings returns a list of human readable warnings describing
+// which fields changed between the old and new spec that will trigger a
+// re-issuance, and whether the private key will be rotated as a result.
+func reissuanceWarnings(oldSpec, newSpec *certmanager.CertificateSpec) []string {
+	fields := specFieldsCausingReissuance(oldSpec, newSpec)
+	keyFields := privateKeyFieldsChanged(oldSpec.PrivateKey, newSpec.PrivateKey)
+	if len(fields) == 0 && len(keyFields) == 0 {
+		return nil
+	}
+
+	all := append(fields, keyFields...)
+	warnings := []string{
+		fmt.Sprintf("changes to %s will cause the certificate to be re-issued", strings.Join(all, ", ")),
+	}
+
+	rotateAlways := newSpec.PrivateKey != nil && newSpec.PrivateKey.RotationPolicy == certmanager.RotationPolicyAlways
+	switch {
+	case rotateAlways:
+		warnings = append(warnings, "the private key will be rotated as spec.privateKey.rotationPolicy is Always")
+	case len(keyFields) > 0:
+		warnings = append(warnings, fmt.Sprintf("changes to %s require a new private key but spec.privateKey.rotationPolicy is Never; re-issuance will not succeed until rotationPolicy is set to Always", strings.Join(keyFields, ", ")))
+	default:
+		warnings = append(warnings, "the existing private key will be reused as spec.privateKey.rotationPolicy is Never")
+	}
+
+	return warnings
+}
+
+// specFieldsCausingReissuance mirrors the fields compared when checking whether
+// the current CertificateRequest is still valid for a Certificate's spec.
+// Unset fields are compared using their default values.
+func specFieldsCausingReissuance(oldSpec, newSpec *certmanager.CertificateSpec) []string {
+	var fields []string
+	if oldSpec.CommonName != newSpec.CommonName {
+		fields = append(fields, "spec.commonName")
+	}
+	if oldSpec.LiteralSubject != newSpec.LiteralSubject {
+		fields = append(fields, "spec.literalSubject")
+	}
+	if !util.EqualUnsorted(oldSpec.DNSNames, newSpec.DNSNames) {
+		fields = append(fields, "spec.dnsNames")
+	}
+	if !util.EqualUnsorted(oldSpec.IPAddresses, newSpec.IPAddresses) {
+		fields = append(fields, "spec.ipAddresses")
+	}
+	if !util.EqualUnsorted(oldSpec.URISANs, newSpec.URISANs) {
+		fields = append(fields, "spec.uris")
+	}
+	if !util.EqualUnsorted(oldSpec.EmailSANs, newSpec.EmailSANs) {
+		fields = append(fields, "spec.emailAddresses")
+	}
+	if !subjectsEqual(oldSpec.Subject, newSpec.Subject) {
+		fields = append(fields, "spec.subject")
+	}
+	if oldSpec.IsCA != newSpec.IsCA {
+		fields = append(fields, "spec.isCA")
+	}
+	if !keyUsagesEqualUnsorted(oldSpec.Usages, newSpec.Usages) {
+		fields = append(fields, "spec.usages")
+	}
+	if apiutil.DefaultCertDuration(oldSpec.Duration) != apiutil.DefaultCertDuration(newSpec.Duration) {
+		fields = append(fields, "spec.duration")
+	}
+	if !reflect.DeepEqual(oldSpec.IssuerRef, newSpec.IssuerRef) {
+		fields = append(fields, "spec.issuerRef")
+	}
+	return fields
+}
+
+// privateKeyFieldsChanged returns the private key fields that changed in a way
+// which means the existing private key no longer satisfies the spec.
+func privateKeyFieldsChanged(oldPK, newPK *certmanager.CertificatePrivateKey) []string {
+	if oldPK == nil {
+		oldPK = &certmanager.CertificatePrivateKey{}
+	}
+	if newPK == nil {
+		newPK = &certmanager.CertificatePrivateKey{}
+	}
+
+	var fields []string
+	if keyAlgorithm(oldPK) != keyAlgorithm(newPK) {
+		fields = append(fields, "spec.privateKey.algorithm")
+	}
+	// The default size depends on the algorithm, so a changed algorithm alone
+	// is not reported as a change to the size.
+	if oldPK.Size != newPK.Size && keySize(oldPK) != keySize(newPK) {
+		fields = append(fields, "spec.privateKey.size")
+	}
+	return fields
+}
+
+func keyAlgorithm(pk *certmanager.CertificatePrivateKey) certmanager.PrivateKeyAlgorithm {
+	if pk.Algorithm == "" {
+		return certmanager.RSAKeyAlgorithm
+	}
+	return pk.Algorithm
+}
+
+// keySize returns the size of the private key that will be generated for pk,
+// taking the defaults for each algorithm into account. Ed25519 keys have a
+// fixed size, so any configured size is ignored.
+func keySize(pk *certmanager.CertificatePrivateKey) int {
+	switch keyAlgorithm(pk) {
+	case certmanager.RSAKeyAlgorithm:
+		if pk.Size == 0 {
+			return pki.MinRSAKeySize
+		}
+	case certmanager.ECDSAKeyAlgorithm:
+		if pk.Size == 0 {
+			return pki.ECCurve256
+		}
+	case certmanager.Ed25519KeyAlgorithm:
+		return 0
+	}
+	return pk.Size
+}
+
+func subjectsEqual(s1, s2 *certmanager.X509Subject) bool {
+	if s1 == nil {
+		s1 = &certmanager.X509Subject{}
+	}
+	if s2 == nil {
+		s2 = &certmanager.X509Subject{}
+	}
+	return s1.SerialNumber == s2.SerialNumber &&
+		util.EqualUnsorted(s1.Organizations, s2.Organizations) &&
+		util.EqualUnsorted(s1.Countries, s2.Countries) &&
+		util.EqualUnsorted(s1.OrganizationalUnits, s2.OrganizationalUnits) &&
+		util.EqualUnsorted(s1.Localities, s2.Localities) &&
+		util.EqualUnsorted(s1.Provinces, s2.Provinces) &&
+		util.EqualUnsorted(s1.StreetAddresses, s2.StreetAddresses) &&
+		util.EqualUnsorted(s1.PostalCodes, s2.PostalCodes)
+}
+
+func keyUsagesEqualUnsorted(s1, s2 []certmanager.KeyUsage) bool {
+	toStrings := func(usages []certmanager.KeyUsage) []string {
+		var out []string
+		for _, u := range usages {
+			out = append(out, string(u))
+		}
+		return out
+	}
+	return util.EqualUnsorted(toStrings(s1), toStrings(s2))
+}
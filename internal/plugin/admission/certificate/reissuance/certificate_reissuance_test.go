@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package reissuance
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmmeta "github.com/cert-manager/cert-manager/internal/apis/meta"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var certificatesResource = &metav1.GroupVersionResource{
+	Group:    "cert-manager.io",
+	Version:  "v1",
+	Resource: "certificates",
+}
+
+func baseSpec() certmanager.CertificateSpec {
+	return certmanager.CertificateSpec{
+		SecretName: "example",
+		DNSNames:   []string{"example.com"},
+		IssuerRef:  cmmeta.ObjectReference{Name: "issuer"},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	tests := map[string]struct {
+		req      *admissionv1.AdmissionRequest
+		oldSpec  func(*certmanager.CertificateSpec)
+		newSpec  func(*certmanager.CertificateSpec)
+		warnings []string
+	}{
+		"should not warn for unrelated resources": {
+			req: &admissionv1.AdmissionRequest{
+				Operation: admissionv1.Update,
+				RequestResource: &metav1.GroupVersionResource{
+					Group:    "cert-manager.io",
+					Version:  "v1",
+					Resource: "issuers",
+				},
+			},
+		},
+		"should not warn for status updates": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+				SubResource:     "status",
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.DNSNames = append(spec.DNSNames, "www.example.com")
+			},
+		},
+		"should not warn if no issuance fields changed": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.SecretTemplate = &certmanager.CertificateSecretTemplate{Labels: map[string]string{"a": "b"}}
+				spec.DNSNames = []string{"example.com"}
+			},
+		},
+		"should warn and reuse the key when a SAN is added": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.DNSNames = append(spec.DNSNames, "www.example.com")
+			},
+			warnings: []string{
+				"changes to spec.dnsNames will cause the certificate to be re-issued",
+				"the existing private key will be reused as spec.privateKey.rotationPolicy is Never",
+			},
+		},
+		"should warn that the key will rotate when rotationPolicy is Always": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					RotationPolicy: certmanager.RotationPolicyAlways,
+					Algorithm:      certmanager.ECDSAKeyAlgorithm,
+				}
+				spec.IPAddresses = []string{"10.0.0.1"}
+			},
+			warnings: []string{
+				"changes to spec.ipAddresses, spec.privateKey.algorithm will cause the certificate to be re-issued",
+				"the private key will be rotated as spec.privateKey.rotationPolicy is Always",
+			},
+		},
+		"should warn that issuance will be blocked when the key algorithm changes with rotationPolicy Never": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					Algorithm: certmanager.ECDSAKeyAlgorithm,
+					Size:      256,
+				}
+			},
+			warnings: []string{
+				"changes to spec.privateKey.algorithm, spec.privateKey.size will cause the certificate to be re-issued",
+				"changes to spec.privateKey.algorithm, spec.privateKey.size require a new private key but spec.privateKey.rotationPolicy is Never; re-issuance will not succeed until rotationPolicy is set to Always",
+			},
+		},
+		"should treat an explicit RSA algorithm as equal to the default": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					Algorithm: certmanager.RSAKeyAlgorithm,
+				}
+			},
+		},
+		"should warn when a duration is set for the first time": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.Duration = &metav1.Duration{Duration: time.Hour * 24 * 30}
+			},
+			warnings: []string{
+				"changes to spec.duration will cause the certificate to be re-issued",
+				"the existing private key will be reused as spec.privateKey.rotationPolicy is Never",
+			},
+		},
+		"should warn when a duration is removed": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			oldSpec: func(spec *certmanager.CertificateSpec) {
+				spec.Duration = &metav1.Duration{Duration: time.Hour * 24 * 30}
+			},
+			warnings: []string{
+				"changes to spec.duration will cause the certificate to be re-issued",
+				"the existing private key will be reused as spec.privateKey.rotationPolicy is Never",
+			},
+		},
+		"should treat an explicit default duration as equal to the default": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.Duration = &metav1.Duration{Duration: cmapi.DefaultCertificateDuration}
+			},
+		},
+		"should treat an explicit default RSA key size as equal to the default": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					Size: 2048,
+				}
+			},
+		},
+		"should treat an explicit default ECDSA key size as equal to the default": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			oldSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					Algorithm: certmanager.ECDSAKeyAlgorithm,
+				}
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					Algorithm: certmanager.ECDSAKeyAlgorithm,
+					Size:      256,
+				}
+			},
+		},
+		"should warn when the RSA key size changes from the default": {
+			req: &admissionv1.AdmissionRequest{
+				Operation:       admissionv1.Update,
+				RequestResource: certificatesResource,
+			},
+			newSpec: func(spec *certmanager.CertificateSpec) {
+				spec.PrivateKey = &certmanager.CertificatePrivateKey{
+					RotationPolicy: certmanager.RotationPolicyAlways,
+					Size:           4096,
+				}
+			},
+			warnings: []string{
+				"changes to spec.privateKey.size will cause the certificate to be re-issued",
+				"the private key will be rotated as spec.privateKey.rotationPolicy is Always",
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			oldSpec, newSpec := baseSpec(), baseSpec()
+			if test.oldSpec != nil {
+				test.oldSpec(&oldSpec)
+			}
+			if test.newSpec != nil {
+				test.newSpec(&newSpec)
+			}
+
+			p := NewPlugin().(*certificateReissuance)
+			warnings, err := p.Validate(context.Background(), *test.req,
+				&certmanager.Certificate{Spec: oldSpec},
+				&certmanager.Certificate{Spec: newSpec},
+			)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(warnings, test.warnings) {
+				t.Errorf("unexpected warnings, exp=%q, got=%q", test.warnings, warnings)
+			}
+		})
+	}
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateprotection
+
+// CertificateProtection is a plugin that denies the deletion of Certificates
+// and Secrets matched by a CertificateProtectionPolicy, unless the resource
+// being deleted has been annotated with `cert-manager.io/deletion-justification`
+// explaining why it is being deleted.
+// The webhook only receives DELETE requests for these resources if the
+// deletion protection webhook has been enabled when installing cert-manager.
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission"
+	"github.com/cert-manager/cert-manager/pkg/webhook/admission/initializer"
+)
+
+const PluginName = "CertificateProtection"
+
+type certificateProtection struct {
+	*admission.Handler
+
+	cmClient   cmclient.Interface
+	kubeClient kubernetes.Interface
+}
+
+var _ admission.ValidationInterface = &certificateProtection{}
+var _ initializer.WantsExternalKubeClientSet = &certificateProtection{}
+var _ initializer.WantsExternalCertManagerClientSet = &certificateProtection{}
+
+func Register(plugins *admission.Plugins) {
+	plugins.Register(PluginName, func() (admission.Interface, error) {
+		return NewPlugin(), nil
+	})
+}
+
+func NewPlugin() admission.Interface {
+	return &certificateProtection{
+		Handler: admission.NewHandler(admissionv1.Delete),
+	}
+}
+
+// protectedResource is the subset of a Certificate or Secret that is
+// matched against CertificateProtectionPolicies.
+type protectedResource struct {
+	kind     cmapi.ProtectedResourceKind
+	meta     metav1.Object
+	dnsNames []string
+}
+
+func (p *certificateProtection) Validate(ctx context.Context, request admissionv1.AdmissionRequest, oldObj, _ runtime.Object) ([]string, error) {
+	resource, ok := protectedResourceFor(request, oldObj)
+	if !ok {
+		return nil, nil
+	}
+
+	if strings.TrimSpace(resource.meta.GetAnnotations()[cmapi.DeletionJustificationAnnotationKey]) != "" {
+		return nil, nil
+	}
+
+	policies, err := p.cmClient.CertmanagerV1().CertificateProtectionPolicies().List(ctx, metav1.ListOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to list CertificateProtectionPolicies: %w", err)
+	}
+
+	// namespaceLabels are only fetched if a policy has a namespace selector
+	var namespaceLabels labels.Set
+	for _, policy := range policies.Items {
+		if policy.Spec.NamespaceSelector != nil && namespaceLabels == nil {
+			ns, err := p.kubeClient.CoreV1().Namespaces().Get(ctx, resource.meta.GetNamespace(), metav1.GetOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to get namespace %q: %w", resource.meta.GetNamespace(), err)
+			}
+			namespaceLabels = labels.Set(ns.Labels)
+		}
+
+		protected, err := policyProtects(&policy.Spec, resource, namespaceLabels)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CertificateProtectionPolicy %q: %w", policy.Name, err)
+		}
+		if protected {
+			return nil, fmt.Errorf("%s %s/%s is protected by CertificateProtectionPolicy %q and can only be deleted once the %q annotation has been set to explain why it is being deleted",
+				resource.kind, resource.meta.GetNamespace(), resource.meta.GetName(), policy.Name, cmapi.DeletionJustificationAnnotationKey)
+		}
+	}
+
+	return nil, nil
+}
+
+// protectedResourceFor returns the resource being deleted in the request, or
+// false if it is not a kind of resource which can be protected.
+func protectedResourceFor(request admissionv1.AdmissionRequest, oldObj runtime.Object) (protectedResource, bool) {
+	switch {
+	case request.Resource.Group == cmapi.SchemeGroupVersion.Group && request.Resource.Resource == "certificates":
+		crt, ok := oldObj.(*certmanager.Certificate)
+		if !ok {
+			return protectedResource{}, false
+		}
+		return protectedResource{kind: cmapi.ProtectedResourceCertificate, meta: crt, dnsNames: crt.Spec.DNSNames}, true
+	case request.Resource.Group == "" && request.Resource.Resource == "secrets":
+		secret, ok := oldObj.(*metav1.PartialObjectMetadata)
+		if !ok {
+			return protectedResource{}, false
+		}
+		var dnsNames []string
+		if altNames := secret.Annotations[cmapi.AltNamesAnnotationKey]; altNames != "" {
+			dnsNames = strings.Split(altNames, ",")
+		}
+		return protectedResource{kind: cmapi.ProtectedResourceSecret, meta: secret, dnsNames: dnsNames}, true
+	}
+	return protectedResource{}, false
+}
+
+// policyProtects returns true if the resource is matched by every selector
+// set on the policy.
+func policyProtects(spec *cmapi.CertificateProtectionPolicySpec, resource protectedResource, namespaceLabels labels.Set) (bool, error) {
+	if len(spec.Resources) > 0 && !containsKind(spec.Resources, resource.kind) {
+		return false, nil
+	}
+
+	if spec.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(namespaceLabels) {
+			return false, nil
+		}
+	}
+
+	if spec.Selector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(spec.Selector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(resource.meta.GetLabels())) {
+			return false, nil
+		}
+	}
+
+	if len(spec.DNSNames) > 0 && !containsAny(spec.DNSNames, resource.dnsNames) {
+		return false, nil
+	}
+
+	// Policies must set at least a selector or DNS names, but check again in
+	// case validation was bypassed to avoid protecting every resource.
+	return spec.Selector != nil || len(spec.DNSNames) > 0, nil
+}
+
+func containsKind(kinds []cmapi.ProtectedResourceKind, kind cmapi.ProtectedResourceKind) bool {
+	for _, k := range kinds {
+		if k == kind {
+			return true
+		}
+	}
+	return false
+}
+
+func containsAny(names, dnsNames []string) bool {
+	for _, name := range names {
+		for _, dnsName := range dnsNames {
+			if name == dnsName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (p *certificateProtection) SetExternalKubeClientSet(client kubernetes.Interface) {
+	p.kubeClient = client
+}
+
+func (p *certificateProtection) SetExternalCertManagerClientSet(client cmclient.Interface) {
+	p.cmClient = client
+}
+
+func (p *certificateProtection) ValidateInitialization() error {
+	if p.kubeClient == nil {
+		return fmt.Errorf("kubernetes client not set")
+	}
+	if p.cmClient == nil {
+		return fmt.Errorf("cert-manager client not set")
+	}
+	return nil
+}
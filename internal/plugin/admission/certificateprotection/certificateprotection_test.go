@@ -0,0 +1,137 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificateprotection
+
+import (
+	"context"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	"github.com/cert-manager/cert-manager/internal/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+)
+
+var (
+	certificatesResource = metav1.GroupVersionResource{Group: "cert-manager.io", Version: "v1", Resource: "certificates"}
+	secretsResource      = metav1.GroupVersionResource{Group: "", Version: "v1", Resource: "secrets"}
+)
+
+func policy(name string, spec cmapi.CertificateProtectionPolicySpec) *cmapi.CertificateProtectionPolicy {
+	return &cmapi.CertificateProtectionPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec:       spec,
+	}
+}
+
+func certificate(annotations map[string]string, dnsNames ...string) *certmanager.Certificate {
+	return &certmanager.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "crt", Namespace: "prod", Annotations: annotations},
+		Spec:       certmanager.CertificateSpec{DNSNames: dnsNames},
+	}
+}
+
+func secret(labels, annotations map[string]string) *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		ObjectMeta: metav1.ObjectMeta{Name: "crt-tls", Namespace: "prod", Labels: labels, Annotations: annotations},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	wildcardPolicy := policy("wildcards", cmapi.CertificateProtectionPolicySpec{
+		DNSNames: []string{"*.example.com"},
+	})
+	criticalSecretsPolicy := policy("critical-secrets", cmapi.CertificateProtectionPolicySpec{
+		Resources:         []cmapi.ProtectedResourceKind{cmapi.ProtectedResourceSecret},
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+		Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"tier": "critical"}},
+	})
+	prodNamespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "prod", Labels: map[string]string{"env": "prod"}}}
+
+	tests := map[string]struct {
+		policies []runtime.Object
+		resource metav1.GroupVersionResource
+		oldObj   runtime.Object
+		wantErr  string
+	}{
+		"allow deleting resources not matched by any policy": {
+			policies: []runtime.Object{wildcardPolicy, criticalSecretsPolicy},
+			resource: certificatesResource,
+			oldObj:   certificate(nil, "www.example.com"),
+		},
+		"deny deleting a wildcard certificate": {
+			policies: []runtime.Object{wildcardPolicy},
+			resource: certificatesResource,
+			oldObj:   certificate(nil, "example.com", "*.example.com"),
+			wantErr:  `Certificate prod/crt is protected by CertificateProtectionPolicy "wildcards" and can only be deleted once the "cert-manager.io/deletion-justification" annotation has been set to explain why it is being deleted`,
+		},
+		"deny deleting the secret of a wildcard certificate": {
+			policies: []runtime.Object{wildcardPolicy},
+			resource: secretsResource,
+			oldObj:   secret(nil, map[string]string{cmapi.AltNamesAnnotationKey: "example.com,*.example.com"}),
+			wantErr:  `Secret prod/crt-tls is protected by CertificateProtectionPolicy "wildcards" and can only be deleted once the "cert-manager.io/deletion-justification" annotation has been set to explain why it is being deleted`,
+		},
+		"allow deleting a protected certificate with a justification": {
+			policies: []runtime.Object{wildcardPolicy},
+			resource: certificatesResource,
+			oldObj:   certificate(map[string]string{cmapi.DeletionJustificationAnnotationKey: "moving to a new issuer"}, "*.example.com"),
+		},
+		"deny deleting a protected certificate with an empty justification": {
+			policies: []runtime.Object{wildcardPolicy},
+			resource: certificatesResource,
+			oldObj:   certificate(map[string]string{cmapi.DeletionJustificationAnnotationKey: " "}, "*.example.com"),
+			wantErr:  `Certificate prod/crt is protected by CertificateProtectionPolicy "wildcards" and can only be deleted once the "cert-manager.io/deletion-justification" annotation has been set to explain why it is being deleted`,
+		},
+		"deny deleting a labelled secret in a selected namespace": {
+			policies: []runtime.Object{criticalSecretsPolicy},
+			resource: secretsResource,
+			oldObj:   secret(map[string]string{"tier": "critical"}, nil),
+			wantErr:  `Secret prod/crt-tls is protected by CertificateProtectionPolicy "critical-secrets" and can only be deleted once the "cert-manager.io/deletion-justification" annotation has been set to explain why it is being deleted`,
+		},
+		"allow deleting resources which are not protected by the policy": {
+			policies: []runtime.Object{criticalSecretsPolicy},
+			resource: certificatesResource,
+			oldObj:   &certmanager.Certificate{ObjectMeta: metav1.ObjectMeta{Name: "crt", Namespace: "prod", Labels: map[string]string{"tier": "critical"}}},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p := NewPlugin().(*certificateProtection)
+			p.SetExternalCertManagerClientSet(cmfake.NewSimpleClientset(test.policies...))
+			p.SetExternalKubeClientSet(kubefake.NewSimpleClientset(prodNamespace))
+			if err := p.ValidateInitialization(); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err := p.Validate(context.Background(), admissionv1.AdmissionRequest{
+				Operation: admissionv1.Delete,
+				Resource:  test.resource,
+			}, test.oldObj, nil)
+			switch {
+			case test.wantErr == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.wantErr != "" && (err == nil || err.Error() != test.wantErr):
+				t.Errorf("expected error %q but got %v", test.wantErr, err)
+			}
+		})
+	}
+}
@@ -52,6 +52,10 @@ var certificateGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificates
 var certificateRequestGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificaterequests")
 var issuerGVR = certmanagerv1.SchemeGroupVersion.WithResource("issuers")
 var clusterIssuerGVR = certmanagerv1.SchemeGroupVersion.WithResource("clusterissuers")
+var certificateProtectionPolicyGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificateprotectionpolicies")
+var certificateRequestPolicyGVR = certmanagerv1.SchemeGroupVersion.WithResource("certificaterequestpolicies")
+var credentialGrantGVR = certmanagerv1.SchemeGroupVersion.WithResource("credentialgrants")
+var bundleGVR = certmanagerv1.SchemeGroupVersion.WithResource("bundles")
 var orderGVR = acmev1.SchemeGroupVersion.WithResource("orders")
 var challengeGVR = acmev1.SchemeGroupVersion.WithResource("challenges")
 
@@ -68,12 +72,16 @@ func newValidationPair(create validateCreateFunc, update validateUpdateFunc) val
 }
 
 var validationMapping = map[schema.GroupVersionResource]validationPair{
-	certificateGVR:        newValidationPair(cmvalidation.ValidateCertificate, cmvalidation.ValidateUpdateCertificate),
-	certificateRequestGVR: newValidationPair(cmvalidation.ValidateCertificateRequest, cmvalidation.ValidateUpdateCertificateRequest),
-	issuerGVR:             newValidationPair(cmvalidation.ValidateIssuer, cmvalidation.ValidateUpdateIssuer),
-	clusterIssuerGVR:      newValidationPair(cmvalidation.ValidateClusterIssuer, cmvalidation.ValidateUpdateClusterIssuer),
-	orderGVR:              newValidationPair(acmevalidation.ValidateOrder, acmevalidation.ValidateOrderUpdate),
-	challengeGVR:          newValidationPair(acmevalidation.ValidateChallenge, acmevalidation.ValidateChallengeUpdate),
+	certificateGVR:                 newValidationPair(cmvalidation.ValidateCertificate, cmvalidation.ValidateUpdateCertificate),
+	certificateRequestGVR:          newValidationPair(cmvalidation.ValidateCertificateRequest, cmvalidation.ValidateUpdateCertificateRequest),
+	issuerGVR:                      newValidationPair(cmvalidation.ValidateIssuer, cmvalidation.ValidateUpdateIssuer),
+	clusterIssuerGVR:               newValidationPair(cmvalidation.ValidateClusterIssuer, cmvalidation.ValidateUpdateClusterIssuer),
+	certificateProtectionPolicyGVR: newValidationPair(cmvalidation.ValidateCertificateProtectionPolicy, cmvalidation.ValidateUpdateCertificateProtectionPolicy),
+	certificateRequestPolicyGVR:    newValidationPair(cmvalidation.ValidateCertificateRequestPolicy, cmvalidation.ValidateUpdateCertificateRequestPolicy),
+	credentialGrantGVR:             newValidationPair(cmvalidation.ValidateCredentialGrant, cmvalidation.ValidateUpdateCredentialGrant),
+	bundleGVR:                      newValidationPair(cmvalidation.ValidateBundle, cmvalidation.ValidateUpdateBundle),
+	orderGVR:                       newValidationPair(acmevalidation.ValidateOrder, acmevalidation.ValidateOrderUpdate),
+	challengeGVR:                   newValidationPair(acmevalidation.ValidateChallenge, acmevalidation.ValidateChallengeUpdate),
 }
 
 func NewPlugin() admission.Interface {
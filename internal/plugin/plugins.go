@@ -18,6 +18,8 @@ package plugin
 
 import (
 	"github.com/cert-manager/cert-manager/internal/plugin/admission/apideprecation"
+	certificatereissuance "github.com/cert-manager/cert-manager/internal/plugin/admission/certificate/reissuance"
+	"github.com/cert-manager/cert-manager/internal/plugin/admission/certificateprotection"
 	certificaterequestapproval "github.com/cert-manager/cert-manager/internal/plugin/admission/certificaterequest/approval"
 	certificaterequestidentity "github.com/cert-manager/cert-manager/internal/plugin/admission/certificaterequest/identity"
 	"github.com/cert-manager/cert-manager/internal/plugin/admission/resourcevalidation"
@@ -30,6 +32,8 @@ var AllOrderedPlugins = []string{
 	resourcevalidation.PluginName,
 	certificaterequestidentity.PluginName,
 	certificaterequestapproval.PluginName,
+	certificatereissuance.PluginName,
+	certificateprotection.PluginName,
 }
 
 func RegisterAllPlugins(plugins *admission.Plugins) {
@@ -37,6 +41,8 @@ func RegisterAllPlugins(plugins *admission.Plugins) {
 	certificaterequestidentity.Register(plugins)
 	certificaterequestapproval.Register(plugins)
 	resourcevalidation.Register(plugins)
+	certificatereissuance.Register(plugins)
+	certificateprotection.Register(plugins)
 }
 
 func DefaultOnAdmissionPlugins() sets.String {
@@ -45,6 +51,8 @@ func DefaultOnAdmissionPlugins() sets.String {
 		resourcevalidation.PluginName,
 		certificaterequestidentity.PluginName,
 		certificaterequestapproval.PluginName,
+		certificatereissuance.PluginName,
+		certificateprotection.PluginName,
 	)
 }
 
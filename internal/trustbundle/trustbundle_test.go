@@ -0,0 +1,124 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trustbundle
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+)
+
+func selfSignedCA(t *testing.T, commonName string) ([]byte, []byte) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+}
+
+func TestDecodeCertificates(t *testing.T) {
+	caA, keyA := selfSignedCA(t, "ca-a")
+	caB, _ := selfSignedCA(t, "ca-b")
+
+	tests := map[string]struct {
+		data     []byte
+		expNames []string
+		expErr   bool
+	}{
+		"a single certificate is decoded": {
+			data:     caA,
+			expNames: []string{"ca-a"},
+		},
+		"multiple certificates are decoded in order, ignoring surrounding whitespace": {
+			data:     append(append([]byte("\n"), caB...), append(caA, '\n')...),
+			expNames: []string{"ca-b", "ca-a"},
+		},
+		"private keys are not allowed": {
+			data:   append(caA, keyA...),
+			expErr: true,
+		},
+		"data which is not PEM encoded is not allowed": {
+			data:   append(caA, []byte("not a certificate")...),
+			expErr: true,
+		},
+		"data without certificates is not allowed": {
+			data:   []byte("\n"),
+			expErr: true,
+		},
+		"invalid certificates are not allowed": {
+			data:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: []byte("invalid")}),
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			certs, err := DecodeCertificates(test.data)
+			if (err != nil) != test.expErr {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if len(certs) != len(test.expNames) {
+				t.Fatalf("unexpected number of certificates, exp=%d got=%d", len(test.expNames), len(certs))
+			}
+			for i, cert := range certs {
+				if cert.Subject.CommonName != test.expNames[i] {
+					t.Errorf("unexpected certificate %d, exp=%q got=%q", i, test.expNames[i], cert.Subject.CommonName)
+				}
+			}
+		})
+	}
+}
+
+func TestEncode(t *testing.T) {
+	caA, _ := selfSignedCA(t, "ca-a")
+	caB, _ := selfSignedCA(t, "ca-b")
+
+	certs, err := DecodeCertificates(append(append(append([]byte{}, caB...), caA...), caB...))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if got, exp := Encode(certs), string(caB)+string(caA); got != exp {
+		t.Errorf("unexpected bundle, exp=%q got=%q", exp, got)
+	}
+	if got := Encode(nil); got != "" {
+		t.Errorf("expected an empty bundle, got=%q", got)
+	}
+}
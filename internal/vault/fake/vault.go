@@ -18,6 +18,7 @@ limitations under the License.
 package fake
 
 import (
+	"math/big"
 	"time"
 
 	vault "github.com/hashicorp/vault/api"
@@ -31,6 +32,11 @@ type Vault struct {
 	NewFn                           func(string, corelisters.SecretLister, v1.GenericIssuer) (*Vault, error)
 	SignFn                          func([]byte, time.Duration) ([]byte, []byte, error)
 	IsVaultInitializedAndUnsealedFn func() error
+	RevokeFn                        func(*big.Int) error
+	WriteKVFn                       func(string, string, map[string]string) error
+	TransitEncryptFn                func(string, string, []byte) (string, error)
+	TransitDecryptFn                func(string, string, string) ([]byte, error)
+	ReadRoleFn                      func() (*vault.Secret, error)
 }
 
 // New returns a new fake Vault
@@ -89,3 +95,50 @@ func (v *Vault) Sys() *vault.Sys {
 func (v *Vault) IsVaultInitializedAndUnsealed() error {
 	return nil
 }
+
+// CheckToken always returns nil
+func (v *Vault) CheckToken() error {
+	return nil
+}
+
+// Revoke calls RevokeFn if set, otherwise returns nil.
+func (v *Vault) Revoke(serialNumber *big.Int) error {
+	if v.RevokeFn != nil {
+		return v.RevokeFn(serialNumber)
+	}
+	return nil
+}
+
+// WriteKV calls WriteKVFn if set, otherwise returns nil.
+func (v *Vault) WriteKV(mount, secretPath string, data map[string]string) error {
+	if v.WriteKVFn != nil {
+		return v.WriteKVFn(mount, secretPath, data)
+	}
+	return nil
+}
+
+// TransitEncrypt calls TransitEncryptFn if set, otherwise returns an empty
+// ciphertext.
+func (v *Vault) TransitEncrypt(mount, keyName string, plaintext []byte) (string, error) {
+	if v.TransitEncryptFn != nil {
+		return v.TransitEncryptFn(mount, keyName, plaintext)
+	}
+	return "", nil
+}
+
+// TransitDecrypt calls TransitDecryptFn if set, otherwise returns an empty
+// plaintext.
+func (v *Vault) TransitDecrypt(mount, keyName, ciphertext string) ([]byte, error) {
+	if v.TransitDecryptFn != nil {
+		return v.TransitDecryptFn(mount, keyName, ciphertext)
+	}
+	return nil, nil
+}
+
+// ReadRole calls ReadRoleFn if set, otherwise returns nil.
+func (v *Vault) ReadRole() (*vault.Secret, error) {
+	if v.ReadRoleFn != nil {
+		return v.ReadRoleFn()
+	}
+	return nil, nil
+}
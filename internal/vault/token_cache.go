@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/clock"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// clientToken is a Vault client token obtained by logging in to Vault.
+type clientToken struct {
+	id string
+
+	// ttl is the lease duration of the token. Tokens with no ttl do not
+	// expire.
+	ttl time.Duration
+
+	// renewable is true if the token's lease can be extended using the
+	// token self-renew endpoint.
+	renewable bool
+}
+
+// tokenCacheKey identifies the issuer a token was obtained for. The issuer's
+// UID and generation are part of the key so that a token is never reused once
+// an issuer has been recreated or its auth configuration changed.
+type tokenCacheKey struct {
+	kind       string
+	namespace  string
+	name       string
+	uid        types.UID
+	generation int64
+}
+
+func tokenCacheKeyForIssuer(issuer v1.GenericIssuer) tokenCacheKey {
+	kind := v1.IssuerKind
+	if _, ok := issuer.(*v1.ClusterIssuer); ok {
+		kind = v1.ClusterIssuerKind
+	}
+
+	meta := issuer.GetObjectMeta()
+	return tokenCacheKey{
+		kind:       kind,
+		namespace:  meta.Namespace,
+		name:       meta.Name,
+		uid:        meta.UID,
+		generation: meta.Generation,
+	}
+}
+
+type cachedToken struct {
+	clientToken
+
+	// refreshAt is the time after which the token should be renewed, or
+	// replaced by logging in again if it is not renewable.
+	refreshAt time.Time
+	// expiresAt is the time the token's lease ends.
+	expiresAt time.Time
+}
+
+// TokenCache holds the Vault client tokens obtained by logging in with each
+// Vault issuer, so that signing a request does not require a new login. It is
+// shared across all controllers.
+// A nil *TokenCache is valid and disables caching.
+type TokenCache struct {
+	clock clock.Clock
+
+	lock   sync.Mutex
+	tokens map[tokenCacheKey]cachedToken
+}
+
+// NewTokenCache returns an empty TokenCache.
+func NewTokenCache(clock clock.Clock) *TokenCache {
+	return &TokenCache{
+		clock:  clock,
+		tokens: make(map[tokenCacheKey]cachedToken),
+	}
+}
+
+// get returns the token cached for the given key, and whether the token is
+// due to be refreshed. Expired tokens are never returned.
+func (c *TokenCache) get(key tokenCacheKey) (clientToken, bool, bool) {
+	if c == nil {
+		return clientToken{}, false, false
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	t, ok := c.tokens[key]
+	if !ok {
+		return clientToken{}, false, false
+	}
+
+	now := c.clock.Now()
+	if t.ttl > 0 && !now.Before(t.expiresAt) {
+		delete(c.tokens, key)
+		return clientToken{}, false, false
+	}
+
+	return t.clientToken, t.ttl > 0 && !now.Before(t.refreshAt), true
+}
+
+// add stores a token which has just been obtained or renewed. The token is
+// due to be refreshed once two thirds of its ttl have passed, leaving time to
+// renew it before it expires. Expired tokens are pruned at the same time so
+// that the cache does not grow without bound.
+func (c *TokenCache) add(key tokenCacheKey, t clientToken) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	now := c.clock.Now()
+	for k, cached := range c.tokens {
+		if cached.ttl > 0 && !now.Before(cached.expiresAt) {
+			delete(c.tokens, k)
+		}
+	}
+	c.tokens[key] = cachedToken{
+		clientToken: t,
+		refreshAt:   now.Add(t.ttl * 2 / 3),
+		expiresAt:   now.Add(t.ttl),
+	}
+}
+
+// remove drops the token cached for the given key, for example because Vault
+// rejected it.
+func (c *TokenCache) remove(key tokenCacheKey) {
+	if c == nil {
+		return
+	}
+
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	delete(c.tokens, key)
+}
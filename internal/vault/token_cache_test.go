@@ -0,0 +1,171 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	vault "github.com/hashicorp/vault/api"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	vaultfake "github.com/cert-manager/cert-manager/internal/vault/fake"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/cert-manager/cert-manager/test/unit/listers"
+)
+
+// responder returns the response to a request made to Vault.
+type responder func(r *vault.Request) (*vault.Response, error)
+
+func TestSetTokenCache(t *testing.T) {
+	issuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerNamespace("test-namespace"),
+		gen.SetIssuerVault(cmapi.VaultIssuer{
+			Auth: cmapi.VaultAuth{
+				Kubernetes: &cmapi.VaultKubernetesAuth{
+					Role: "kube-vault-role",
+					SecretRef: cmmeta.SecretKeySelector{
+						LocalObjectReference: cmmeta.LocalObjectReference{
+							Name: "secret-ref-name",
+						},
+					},
+				},
+			},
+		}),
+	)
+	issuer.Generation = 1
+	lister := listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+		listers.SetFakeSecretNamespaceListerGet(&corev1.Secret{
+			Data: map[string][]byte{"token": []byte("my-secret-kube-token")},
+		}, nil),
+	)
+
+	authResponse := func(token string, ttl time.Duration, renewable bool) responder {
+		return func(r *vault.Request) (*vault.Response, error) {
+			body := fmt.Sprintf(`{"auth":{"client_token":%q,"lease_duration":%d,"renewable":%t}}`, token, int(ttl.Seconds()), renewable)
+			return &vault.Response{
+				Response: &http.Response{Body: io.NopCloser(strings.NewReader(body))},
+			}, nil
+		}
+	}
+	failRequest := func(r *vault.Request) (*vault.Response, error) {
+		return nil, errors.New("unexpected request")
+	}
+
+	type step struct {
+		// advance is how long to step the clock before calling setToken.
+		advance time.Duration
+		// generation, if set, replaces the issuer's generation.
+		generation int64
+		// requests returns the responses to the requests made by setToken in
+		// order.
+		requests []responder
+
+		expectedToken string
+	}
+
+	tests := map[string][]step{
+		"a token is reused until it is due to be refreshed": {
+			{requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{advance: 30 * time.Minute, expectedToken: "login-1"},
+		},
+		"a renewable token is renewed once two thirds of its ttl have passed": {
+			{requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{advance: 41 * time.Minute, requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{advance: 30 * time.Minute, expectedToken: "login-1"},
+		},
+		"a token that fails to renew is replaced by logging in": {
+			{requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{advance: 41 * time.Minute, requests: []responder{failRequest, authResponse("login-2", time.Hour, true)}, expectedToken: "login-2"},
+		},
+		"a token that is not renewable is replaced by logging in": {
+			{requests: []responder{authResponse("login-1", time.Hour, false)}, expectedToken: "login-1"},
+			{advance: 41 * time.Minute, requests: []responder{authResponse("login-2", time.Hour, false)}, expectedToken: "login-2"},
+		},
+		"an expired token is not used": {
+			{requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{advance: 2 * time.Hour, requests: []responder{authResponse("login-2", time.Hour, true)}, expectedToken: "login-2"},
+		},
+		"a token without a ttl is never refreshed": {
+			{requests: []responder{authResponse("login-1", 0, false)}, expectedToken: "login-1"},
+			{advance: 24 * time.Hour, expectedToken: "login-1"},
+		},
+		"a token is not reused once the issuer has changed": {
+			{requests: []responder{authResponse("login-1", time.Hour, true)}, expectedToken: "login-1"},
+			{generation: 2, requests: []responder{authResponse("login-2", time.Hour, true)}, expectedToken: "login-2"},
+		},
+	}
+
+	for name, steps := range tests {
+		t.Run(name, func(t *testing.T) {
+			clock := fakeclock.NewFakeClock(time.Now())
+			tokens := NewTokenCache(clock)
+			for i, s := range steps {
+				clock.Step(s.advance)
+				iss := issuer.DeepCopy()
+				if s.generation != 0 {
+					iss.Generation = s.generation
+				}
+
+				requests := s.requests
+				client := vaultfake.NewFakeClient()
+				client.RawRequestFn = func(r *vault.Request) (*vault.Response, error) {
+					if len(requests) == 0 {
+						return failRequest(r)
+					}
+					fn := requests[0]
+					requests = requests[1:]
+					return fn(r)
+				}
+
+				v := &Vault{
+					namespace:     "test-namespace",
+					secretsLister: lister,
+					issuer:        iss,
+					tokens:        tokens,
+				}
+				if err := v.setToken(client); err != nil {
+					t.Fatalf("step %d: unexpected error: %v", i, err)
+				}
+				if len(requests) != 0 {
+					t.Errorf("step %d: expected %d more requests to Vault", i, len(requests))
+				}
+				if client.Token() != s.expectedToken {
+					t.Errorf("step %d: unexpected token, exp=%s got=%s", i, s.expectedToken, client.Token())
+				}
+			}
+		})
+	}
+}
+
+func TestTokenCacheKeyForIssuer(t *testing.T) {
+	meta := metav1.ObjectMeta{Name: "vault", Namespace: "ns", UID: "uid", Generation: 1}
+	issuerKey := tokenCacheKeyForIssuer(&cmapi.Issuer{ObjectMeta: meta})
+	clusterIssuerKey := tokenCacheKeyForIssuer(&cmapi.ClusterIssuer{ObjectMeta: meta})
+	if issuerKey == clusterIssuerKey {
+		t.Errorf("expected Issuer and ClusterIssuer with the same name to have different keys, got %v", issuerKey)
+	}
+}
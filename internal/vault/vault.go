@@ -17,9 +17,12 @@ limitations under the License.
 package vault
 
 import (
+	"crypto/tls"
 	"crypto/x509"
+	"encoding/base64"
 	"errors"
 	"fmt"
+	"math/big"
 	"net/http"
 	"path"
 	"path/filepath"
@@ -28,8 +31,10 @@ import (
 
 	vault "github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/sdk/helper/certutil"
+	corev1 "k8s.io/api/core/v1"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
@@ -39,7 +44,7 @@ var _ Interface = &Vault{}
 // ClientBuilder is a function type that returns a new Interface.
 // Can be used in tests to create a mock signer of Vault certificate requests.
 type ClientBuilder func(namespace string, secretsLister corelisters.SecretLister,
-	issuer v1.GenericIssuer) (Interface, error)
+	issuer v1.GenericIssuer, breakers *circuitbreaker.Registry, tokens *TokenCache) (Interface, error)
 
 // Interface implements various high level functionality related to connecting
 // with a Vault server, verifying its status and signing certificate request for
@@ -49,6 +54,12 @@ type Interface interface {
 	Sign(csrPEM []byte, duration time.Duration) (certPEM []byte, caPEM []byte, err error)
 	Sys() *vault.Sys
 	IsVaultInitializedAndUnsealed() error
+	CheckToken() error
+	Revoke(serialNumber *big.Int) error
+	WriteKV(mount, secretPath string, data map[string]string) error
+	TransitEncrypt(mount, keyName string, plaintext []byte) (string, error)
+	TransitDecrypt(mount, keyName, ciphertext string) ([]byte, error)
+	ReadRole() (*vault.Secret, error)
 }
 
 // Client implements functionality to talk to a Vault server.
@@ -66,25 +77,31 @@ type Vault struct {
 	secretsLister corelisters.SecretLister
 	issuer        v1.GenericIssuer
 	namespace     string
+	tokens        *TokenCache
 
 	client Client
 }
 
 // New returns a new Vault instance with the given namespace, issuer and
-// secrets lister.
+// secrets lister. Requests to the Vault server are made through the issuer's
+// circuit breaker in breakers, which may be nil to disable circuit breaking.
+// Tokens obtained by logging in are reused from tokens, which may be nil to
+// log in every time.
 // Returned errors may be network failures and should be considered for
 // retrying.
-func New(namespace string, secretsLister corelisters.SecretLister, issuer v1.GenericIssuer) (Interface, error) {
+func New(namespace string, secretsLister corelisters.SecretLister, issuer v1.GenericIssuer, breakers *circuitbreaker.Registry, tokens *TokenCache) (Interface, error) {
 	v := &Vault{
 		secretsLister: secretsLister,
 		namespace:     namespace,
 		issuer:        issuer,
+		tokens:        tokens,
 	}
 
 	cfg, err := v.newConfig()
 	if err != nil {
 		return nil, err
 	}
+	cfg.HttpClient.Transport = breakers.RoundTripper(circuitbreaker.KeyForIssuer(issuer), cfg.HttpClient.Transport)
 
 	client, err := vault.NewClient(cfg)
 	if err != nil {
@@ -107,19 +124,31 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 		return nil, nil, fmt.Errorf("failed to decode CSR for signing: %s", err)
 	}
 
-	parameters := map[string]string{
-		"common_name": csr.Subject.CommonName,
-		"alt_names":   strings.Join(csr.DNSNames, ","),
-		"ip_sans":     strings.Join(pki.IPAddressesToString(csr.IPAddresses), ","),
-		"uri_sans":    strings.Join(pki.URLsToString(csr.URIs), ","),
-		"ttl":         duration.String(),
-		"csr":         string(csrPEM),
+	vaultIssuer := v.issuer.GetSpec().Vault
 
-		"exclude_cn_from_sans": "true",
+	parameters := map[string]string{
+		"ttl": duration.String(),
+		"csr": string(csrPEM),
+	}
+	// sign-verbatim takes every value from the CSR itself, whereas sign/:role
+	// only uses the CSR for its public key.
+	if !vaultIssuer.SignVerbatim {
+		parameters["common_name"] = csr.Subject.CommonName
+		parameters["alt_names"] = strings.Join(csr.DNSNames, ",")
+		parameters["ip_sans"] = strings.Join(pki.IPAddressesToString(csr.IPAddresses), ",")
+		parameters["uri_sans"] = strings.Join(pki.URLsToString(csr.URIs), ",")
+		parameters["exclude_cn_from_sans"] = "true"
+
+		otherNames, err := pki.OtherNamesFromExtensions(csr.Extensions)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to decode otherName SANs of CSR: %s", err)
+		}
+		if len(otherNames) > 0 {
+			parameters["other_sans"] = otherSANs(otherNames)
+		}
 	}
 
-	vaultIssuer := v.issuer.GetSpec().Vault
-	url := path.Join("/v1", vaultIssuer.Path)
+	url := signURL(vaultIssuer)
 
 	request := v.client.NewRequest("POST", url)
 
@@ -131,6 +160,10 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 
 	resp, err := v.client.RawRequest(request)
 	if err != nil {
+		// The cached token may have been revoked, so log in again next time.
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
 		return nil, nil, fmt.Errorf("failed to sign certificate by vault: %s", err)
 	}
 
@@ -145,6 +178,222 @@ func (v *Vault) Sign(csrPEM []byte, duration time.Duration) (cert []byte, ca []b
 	return extractCertificatesFromVaultCertificateSecret(&vaultResult)
 }
 
+// otherSANs formats otherName SANs as expected by the `other_sans` parameter
+// of Vault's sign endpoint, e.g. `1.3.6.1.4.1.311.20.2.3;UTF8:user@example.com`.
+func otherSANs(otherNames []v1.OtherName) string {
+	var sans []string
+	for _, otherName := range otherNames {
+		sans = append(sans, otherName.OID+";UTF8:"+otherName.UTF8Value)
+	}
+	return strings.Join(sans, ",")
+}
+
+// signURL returns the URL of the PKI endpoint used to sign requests. For
+// sign-verbatim issuers the last `sign` segment of the configured path is
+// replaced, so "pki/sign/role" becomes "pki/sign-verbatim/role" and a bare
+// mount such as "pki" becomes "pki/sign-verbatim".
+func signURL(vaultIssuer *v1.VaultIssuer) string {
+	if !vaultIssuer.SignVerbatim {
+		return path.Join("/v1", vaultIssuer.Path)
+	}
+
+	segments := strings.Split(strings.Trim(vaultIssuer.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		switch segments[i] {
+		case "sign-verbatim":
+			return path.Join("/v1", vaultIssuer.Path)
+		case "sign":
+			segments[i] = "sign-verbatim"
+			return path.Join(append([]string{"/v1"}, segments...)...)
+		}
+	}
+
+	return path.Join("/v1", vaultIssuer.Path, "sign-verbatim")
+}
+
+// Revoke asks Vault to revoke the certificate with the given serial number,
+// which must have been issued by the PKI mount the issuer signs with.
+func (v *Vault) Revoke(serialNumber *big.Int) error {
+	request := v.client.NewRequest("POST", revokeURL(v.issuer.GetSpec().Vault))
+
+	v.addVaultNamespaceToRequest(request)
+
+	parameters := map[string]string{
+		"serial_number": certutil.GetHexFormatted(serialNumber.Bytes(), ":"),
+	}
+	if err := request.SetJSONBody(parameters); err != nil {
+		return fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
+		return fmt.Errorf("failed to revoke certificate by vault: %s", err)
+	}
+
+	return nil
+}
+
+// revokeURL returns the URL of the revoke endpoint of the PKI mount the
+// issuer signs with, which is the part of the configured path before its
+// last `sign`, `sign-verbatim` or `issue` segment. A path without any of
+// these segments is a bare mount, as used by sign-verbatim issuers.
+func revokeURL(vaultIssuer *v1.VaultIssuer) string {
+	segments := strings.Split(strings.Trim(vaultIssuer.Path, "/"), "/")
+	for i := len(segments) - 1; i >= 0; i-- {
+		switch segments[i] {
+		case "sign", "sign-verbatim", "issue":
+			return path.Join(append(append([]string{"/v1"}, segments[:i]...), "revoke")...)
+		}
+	}
+
+	return path.Join("/v1", vaultIssuer.Path, "revoke")
+}
+
+// ReadRole reads the settings of the role of the PKI secrets engine which
+// the issuer signs with. It returns nil if the issuer signs without a role,
+// as sign-verbatim issuers do.
+func (v *Vault) ReadRole() (*vault.Secret, error) {
+	url, ok := roleURL(v.issuer.GetSpec().Vault)
+	if !ok {
+		return nil, nil
+	}
+
+	request := v.client.NewRequest("GET", url)
+
+	v.addVaultNamespaceToRequest(request)
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
+		return nil, fmt.Errorf("failed to read role from vault: %s", err)
+	}
+
+	role, err := vault.ParseSecret(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode role read from vault: %s", err)
+	}
+
+	return role, nil
+}
+
+// roleURL returns the URL of the role the issuer signs with, replacing the
+// last `sign` or `issue` segment of the configured path with `roles`, so
+// "pki/sign/role" becomes "pki/roles/role". It returns false for
+// sign-verbatim issuers and paths which don't name a role.
+func roleURL(vaultIssuer *v1.VaultIssuer) (string, bool) {
+	if vaultIssuer.SignVerbatim {
+		return "", false
+	}
+
+	segments := strings.Split(strings.Trim(vaultIssuer.Path, "/"), "/")
+	for i := len(segments) - 2; i >= 0; i-- {
+		switch segments[i] {
+		case "sign-verbatim":
+			return "", false
+		case "sign", "issue":
+			segments[i] = "roles"
+			return path.Join(append([]string{"/v1"}, segments...)...), true
+		}
+	}
+
+	return "", false
+}
+
+// WriteKV writes data as a new version of the secret at secretPath in the KV
+// version 2 secrets engine mounted at mount.
+func (v *Vault) WriteKV(mount, secretPath string, data map[string]string) error {
+	request := v.client.NewRequest("POST", path.Join("/v1", mount, "data", secretPath))
+
+	v.addVaultNamespaceToRequest(request)
+
+	if err := request.SetJSONBody(map[string]interface{}{"data": data}); err != nil {
+		return fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
+		return fmt.Errorf("failed to write secret to vault: %s", err)
+	}
+
+	return nil
+}
+
+// TransitEncrypt encrypts plaintext with the named key of the transit
+// secrets engine mounted at mount, returning Vault's ciphertext.
+func (v *Vault) TransitEncrypt(mount, keyName string, plaintext []byte) (string, error) {
+	var result struct {
+		Data struct {
+			Ciphertext string `json:"ciphertext"`
+		} `json:"data"`
+	}
+	parameters := map[string]string{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	}
+	if err := v.transitRequest(path.Join("/v1", mount, "encrypt", keyName), parameters, &result); err != nil {
+		return "", fmt.Errorf("failed to encrypt data by vault: %s", err)
+	}
+
+	return result.Data.Ciphertext, nil
+}
+
+// TransitDecrypt decrypts ciphertext returned by TransitEncrypt with the
+// named key of the transit secrets engine mounted at mount.
+func (v *Vault) TransitDecrypt(mount, keyName, ciphertext string) ([]byte, error) {
+	var result struct {
+		Data struct {
+			Plaintext string `json:"plaintext"`
+		} `json:"data"`
+	}
+	parameters := map[string]string{
+		"ciphertext": ciphertext,
+	}
+	if err := v.transitRequest(path.Join("/v1", mount, "decrypt", keyName), parameters, &result); err != nil {
+		return nil, fmt.Errorf("failed to decrypt data by vault: %s", err)
+	}
+
+	return base64.StdEncoding.DecodeString(result.Data.Plaintext)
+}
+
+func (v *Vault) transitRequest(url string, parameters map[string]string, result interface{}) error {
+	request := v.client.NewRequest("POST", url)
+
+	v.addVaultNamespaceToRequest(request)
+
+	if err := request.SetJSONBody(parameters); err != nil {
+		return fmt.Errorf("failed to build vault request: %s", err)
+	}
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
+		return err
+	}
+
+	return resp.DecodeJSON(result)
+}
+
 func (v *Vault) setToken(client Client) error {
 	tokenRef := v.issuer.GetSpec().Vault.Auth.TokenSecretRef
 	if tokenRef != nil {
@@ -157,50 +406,158 @@ func (v *Vault) setToken(client Client) error {
 		return nil
 	}
 
-	appRole := v.issuer.GetSpec().Vault.Auth.AppRole
-	if appRole != nil {
-		token, err := v.requestTokenWithAppRoleRef(client, appRole)
-		if err != nil {
-			return err
+	// Tokens obtained by logging in are cached and renewed while possible,
+	// so that a login is not needed for every request.
+	key := tokenCacheKeyForIssuer(v.issuer)
+	if cached, refresh, ok := v.tokens.get(key); ok {
+		if !refresh {
+			client.SetToken(cached.id)
+			return nil
 		}
-		client.SetToken(token)
 
-		return nil
+		if cached.renewable {
+			renewed, err := v.renewToken(client, cached.id)
+			if err == nil {
+				v.tokens.add(key, renewed)
+				client.SetToken(renewed.id)
+				return nil
+			}
+		}
+
+		v.tokens.remove(key)
+	}
+
+	token, err := v.login(client)
+	if err != nil {
+		return err
+	}
+	v.tokens.add(key, token)
+	client.SetToken(token.id)
+
+	return nil
+}
+
+func (v *Vault) login(client Client) (clientToken, error) {
+	appRole := v.issuer.GetSpec().Vault.Auth.AppRole
+	if appRole != nil {
+		return v.requestTokenWithAppRoleRef(client, appRole)
 	}
 
 	kubernetesAuth := v.issuer.GetSpec().Vault.Auth.Kubernetes
 	if kubernetesAuth != nil {
 		token, err := v.requestTokenWithKubernetesAuth(client, kubernetesAuth)
 		if err != nil {
-			return fmt.Errorf("error reading Kubernetes service account token from %s: %s", kubernetesAuth.SecretRef.Name, err.Error())
+			return clientToken{}, fmt.Errorf("error reading Kubernetes service account token from %s: %s", kubernetesAuth.SecretRef.Name, err.Error())
 		}
-		client.SetToken(token)
-		return nil
+		return token, nil
 	}
 
-	return fmt.Errorf("error initializing Vault client: tokenSecretRef, appRoleSecretRef, or Kubernetes auth role not set")
+	clientCertificateAuth := v.issuer.GetSpec().Vault.Auth.ClientCertificate
+	if clientCertificateAuth != nil {
+		token, err := v.requestTokenWithClientCertificate(client, clientCertificateAuth)
+		if err != nil {
+			return clientToken{}, fmt.Errorf("error authenticating with client certificate from %s: %s", clientCertificateAuth.SecretName, err.Error())
+		}
+		return token, nil
+	}
+
+	return clientToken{}, fmt.Errorf("error initializing Vault client: tokenSecretRef, appRoleSecretRef, Kubernetes auth role, or clientCertificate not set")
+}
+
+// renewToken extends the lease of the given token using the token self-renew
+// endpoint.
+func (v *Vault) renewToken(client Client, id string) (clientToken, error) {
+	client.SetToken(id)
+	request := client.NewRequest("POST", "/v1/auth/token/renew-self")
+
+	v.addVaultNamespaceToRequest(request)
+
+	resp, err := client.RawRequest(request)
+	if err != nil {
+		return clientToken{}, fmt.Errorf("error renewing Vault token: %s", err.Error())
+	}
+
+	defer resp.Body.Close()
+	vaultResult := vault.Secret{}
+	if err := resp.DecodeJSON(&vaultResult); err != nil {
+		return clientToken{}, fmt.Errorf("unable to decode JSON payload: %s", err.Error())
+	}
+
+	token, err := vaultResult.TokenID()
+	if err != nil {
+		return clientToken{}, fmt.Errorf("unable to read token: %s", err.Error())
+	}
+
+	if token == "" {
+		return clientToken{}, errors.New("no token returned")
+	}
+
+	return clientTokenFromSecret(&vaultResult, token)
+}
+
+// clientTokenFromSecret returns the token with the given id along with the
+// lease returned with it by Vault.
+func clientTokenFromSecret(secret *vault.Secret, id string) (clientToken, error) {
+	ttl, err := secret.TokenTTL()
+	if err != nil {
+		return clientToken{}, fmt.Errorf("unable to read token ttl: %s", err.Error())
+	}
+
+	renewable, err := secret.TokenIsRenewable()
+	if err != nil {
+		return clientToken{}, fmt.Errorf("unable to read token renewability: %s", err.Error())
+	}
+
+	return clientToken{id: id, ttl: ttl, renewable: renewable}, nil
 }
 
 func (v *Vault) newConfig() (*vault.Config, error) {
 	cfg := vault.DefaultConfig()
 	cfg.Address = v.issuer.GetSpec().Vault.Server
 
+	tlsConfig := cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig
+
 	certs := v.issuer.GetSpec().Vault.CABundle
-	if len(certs) == 0 {
-		return cfg, nil
-	}
+	if len(certs) > 0 {
+		caCertPool := x509.NewCertPool()
+		ok := caCertPool.AppendCertsFromPEM(certs)
+		if !ok {
+			return nil, fmt.Errorf("error loading Vault CA bundle")
+		}
 
-	caCertPool := x509.NewCertPool()
-	ok := caCertPool.AppendCertsFromPEM(certs)
-	if !ok {
-		return nil, fmt.Errorf("error loading Vault CA bundle")
+		tlsConfig.RootCAs = caCertPool
 	}
 
-	cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.RootCAs = caCertPool
+	// The cert auth method authenticates the TLS connection itself, so the
+	// client certificate has to be presented on every request.
+	clientCertificateAuth := v.issuer.GetSpec().Vault.Auth.ClientCertificate
+	if clientCertificateAuth != nil {
+		cert, err := v.clientCertificate(clientCertificateAuth)
+		if err != nil {
+			return nil, fmt.Errorf("error loading Vault client certificate: %s", err.Error())
+		}
+
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	}
 
 	return cfg, nil
 }
 
+func (v *Vault) clientCertificate(clientCertificateAuth *v1.VaultClientCertificateAuth) (tls.Certificate, error) {
+	secret, err := v.secretsLister.Secrets(v.namespace).Get(clientCertificateAuth.SecretName)
+	if err != nil {
+		return tls.Certificate{}, err
+	}
+
+	for _, key := range []string{corev1.TLSCertKey, corev1.TLSPrivateKeyKey} {
+		if len(secret.Data[key]) == 0 {
+			return tls.Certificate{}, fmt.Errorf("no data for %q in secret '%s/%s'", key, v.namespace, clientCertificateAuth.SecretName)
+		}
+	}
+
+	return tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+}
+
 func (v *Vault) tokenRef(name, namespace, key string) (string, error) {
 	secret, err := v.secretsLister.Secrets(namespace).Get(name)
 	if err != nil {
@@ -243,10 +600,10 @@ func (v *Vault) appRoleRef(appRole *v1.VaultAppRole) (roleId, secretId string, e
 	return roleId, secretId, nil
 }
 
-func (v *Vault) requestTokenWithAppRoleRef(client Client, appRole *v1.VaultAppRole) (string, error) {
+func (v *Vault) requestTokenWithAppRoleRef(client Client, appRole *v1.VaultAppRole) (clientToken, error) {
 	roleId, secretId, err := v.appRoleRef(appRole)
 	if err != nil {
-		return "", err
+		return clientToken{}, err
 	}
 
 	parameters := map[string]string{
@@ -265,39 +622,39 @@ func (v *Vault) requestTokenWithAppRoleRef(client Client, appRole *v1.VaultAppRo
 
 	err = request.SetJSONBody(parameters)
 	if err != nil {
-		return "", fmt.Errorf("error encoding Vault parameters: %s", err.Error())
+		return clientToken{}, fmt.Errorf("error encoding Vault parameters: %s", err.Error())
 	}
 
 	v.addVaultNamespaceToRequest(request)
 
 	resp, err := client.RawRequest(request)
 	if err != nil {
-		return "", fmt.Errorf("error logging in to Vault server: %s", err.Error())
+		return clientToken{}, fmt.Errorf("error logging in to Vault server: %s", err.Error())
 	}
 
 	defer resp.Body.Close()
 
 	vaultResult := vault.Secret{}
 	if err := resp.DecodeJSON(&vaultResult); err != nil {
-		return "", fmt.Errorf("unable to decode JSON payload: %s", err.Error())
+		return clientToken{}, fmt.Errorf("unable to decode JSON payload: %s", err.Error())
 	}
 
 	token, err := vaultResult.TokenID()
 	if err != nil {
-		return "", fmt.Errorf("unable to read token: %s", err.Error())
+		return clientToken{}, fmt.Errorf("unable to read token: %s", err.Error())
 	}
 
 	if token == "" {
-		return "", errors.New("no token returned")
+		return clientToken{}, errors.New("no token returned")
 	}
 
-	return token, nil
+	return clientTokenFromSecret(&vaultResult, token)
 }
 
-func (v *Vault) requestTokenWithKubernetesAuth(client Client, kubernetesAuth *v1.VaultKubernetesAuth) (string, error) {
+func (v *Vault) requestTokenWithKubernetesAuth(client Client, kubernetesAuth *v1.VaultKubernetesAuth) (clientToken, error) {
 	secret, err := v.secretsLister.Secrets(v.namespace).Get(kubernetesAuth.SecretRef.Name)
 	if err != nil {
-		return "", err
+		return clientToken{}, err
 	}
 
 	key := kubernetesAuth.SecretRef.Key
@@ -307,7 +664,7 @@ func (v *Vault) requestTokenWithKubernetesAuth(client Client, kubernetesAuth *v1
 
 	keyBytes, ok := secret.Data[key]
 	if !ok {
-		return "", fmt.Errorf("no data for %q in secret '%s/%s'", key, v.namespace, kubernetesAuth.SecretRef.Name)
+		return clientToken{}, fmt.Errorf("no data for %q in secret '%s/%s'", key, v.namespace, kubernetesAuth.SecretRef.Name)
 	}
 
 	jwt := string(keyBytes)
@@ -326,29 +683,73 @@ func (v *Vault) requestTokenWithKubernetesAuth(client Client, kubernetesAuth *v1
 	request := client.NewRequest("POST", url)
 	err = request.SetJSONBody(parameters)
 	if err != nil {
-		return "", fmt.Errorf("error encoding Vault parameters: %s", err.Error())
+		return clientToken{}, fmt.Errorf("error encoding Vault parameters: %s", err.Error())
 	}
 
 	v.addVaultNamespaceToRequest(request)
 
 	resp, err := client.RawRequest(request)
 	if err != nil {
-		return "", fmt.Errorf("error calling Vault server: %s", err.Error())
+		return clientToken{}, fmt.Errorf("error calling Vault server: %s", err.Error())
 	}
 
 	defer resp.Body.Close()
 	vaultResult := vault.Secret{}
 	err = resp.DecodeJSON(&vaultResult)
 	if err != nil {
-		return "", fmt.Errorf("unable to decode JSON payload: %s", err.Error())
+		return clientToken{}, fmt.Errorf("unable to decode JSON payload: %s", err.Error())
 	}
 
 	token, err := vaultResult.TokenID()
 	if err != nil {
-		return "", fmt.Errorf("unable to read token: %s", err.Error())
+		return clientToken{}, fmt.Errorf("unable to read token: %s", err.Error())
 	}
 
-	return token, nil
+	return clientTokenFromSecret(&vaultResult, token)
+}
+
+func (v *Vault) requestTokenWithClientCertificate(client Client, clientCertificateAuth *v1.VaultClientCertificateAuth) (clientToken, error) {
+	parameters := map[string]string{}
+	if clientCertificateAuth.Name != "" {
+		parameters["name"] = clientCertificateAuth.Name
+	}
+
+	mountPath := clientCertificateAuth.Path
+	if mountPath == "" {
+		mountPath = v1.DefaultVaultClientCertificateAuthMountPath
+	}
+
+	url := path.Join(mountPath, "login")
+	request := client.NewRequest("POST", url)
+	err := request.SetJSONBody(parameters)
+	if err != nil {
+		return clientToken{}, fmt.Errorf("error encoding Vault parameters: %s", err.Error())
+	}
+
+	v.addVaultNamespaceToRequest(request)
+
+	resp, err := client.RawRequest(request)
+	if err != nil {
+		return clientToken{}, fmt.Errorf("error calling Vault server: %s", err.Error())
+	}
+
+	defer resp.Body.Close()
+	vaultResult := vault.Secret{}
+	err = resp.DecodeJSON(&vaultResult)
+	if err != nil {
+		return clientToken{}, fmt.Errorf("unable to decode JSON payload: %s", err.Error())
+	}
+
+	token, err := vaultResult.TokenID()
+	if err != nil {
+		return clientToken{}, fmt.Errorf("unable to read token: %s", err.Error())
+	}
+
+	if token == "" {
+		return clientToken{}, errors.New("no token returned")
+	}
+
+	return clientTokenFromSecret(&vaultResult, token)
 }
 
 func (v *Vault) Sys() *vault.Sys {
@@ -405,6 +806,27 @@ func (v *Vault) IsVaultInitializedAndUnsealed() error {
 	return nil
 }
 
+// CheckToken verifies that the Vault server accepts the client token, by
+// looking the token up. If the token is rejected it is dropped from the
+// token cache, so that the next client logs in again.
+func (v *Vault) CheckToken() error {
+	request := v.client.NewRequest("GET", "/v1/auth/token/lookup-self")
+	v.addVaultNamespaceToRequest(request)
+
+	resp, err := v.client.RawRequest(request)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		if resp != nil && resp.StatusCode == http.StatusForbidden {
+			v.tokens.remove(tokenCacheKeyForIssuer(v.issuer))
+		}
+		return fmt.Errorf("failed to look up Vault token: %s", err)
+	}
+
+	return nil
+}
+
 func (v *Vault) addVaultNamespaceToRequest(request *vault.Request) {
 	vaultIssuer := v.issuer.GetSpec().Vault
 	if vaultIssuer != nil && vaultIssuer.Namespace != "" {
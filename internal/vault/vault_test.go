@@ -28,6 +28,7 @@ import (
 	"errors"
 	"fmt"
 	"io"
+	"math/big"
 	"net/http"
 	"strings"
 	"testing"
@@ -37,6 +38,7 @@ import (
 	"github.com/hashicorp/vault/sdk/helper/certutil"
 	"github.com/hashicorp/vault/sdk/helper/jsonutil"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/utils/clock"
 
 	vaultfake "github.com/cert-manager/cert-manager/internal/vault/fake"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -155,6 +157,28 @@ func generateRSAPrivateKey(t *testing.T) *rsa.PrivateKey {
 	return pk
 }
 
+func generateClientCertificateSecret(t *testing.T) *corev1.Secret {
+	pk := generateRSAPrivateKey(t)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "vault-client"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	certPEM, _, err := pki.SignCertificate(template, template, pk.Public(), pk)
+	if err != nil {
+		t.Fatalf("failed to sign client certificate: %v", err)
+	}
+	return &corev1.Secret{
+		Type: corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: pki.EncodePKCS1PrivateKey(pk),
+		},
+	}
+}
+
 func generateCSR(t *testing.T, secretKey crypto.Signer) []byte {
 	asn1Subj, _ := asn1.Marshal(pkix.Name{
 		CommonName: "test",
@@ -275,6 +299,32 @@ func TestSign(t *testing.T) {
 			expectedCA:   testRootCa,
 		},
 
+		"sign-verbatim only sends the csr and ttl": {
+			csrPEM: csrPEM,
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{Path: "pki/sign/role", SignVerbatim: true}),
+			),
+			fakeClient: &vaultfake.Client{
+				NewRequestS: new(vault.Request),
+				RawRequestFn: func(r *vault.Request) (*vault.Response, error) {
+					var parameters map[string]string
+					if err := jsonutil.DecodeJSON(r.BodyBytes, &parameters); err != nil {
+						return nil, err
+					}
+					if len(parameters) != 2 || parameters["csr"] != string(csrPEM) || parameters["ttl"] != time.Minute.String() {
+						return nil, fmt.Errorf("unexpected sign-verbatim parameters: %v", parameters)
+					}
+					return &vault.Response{
+						Response: &http.Response{
+							Body: io.NopCloser(bytes.NewReader(bundleData))},
+					}, nil
+				},
+			},
+			expectedErr:  nil,
+			expectedCert: testLeafCertificate + testIntermediateCa,
+			expectedCA:   testIntermediateCa,
+		},
+
 		"vault issuer with namespace specified": {
 			csrPEM: csrPEM,
 			issuer: gen.Issuer("vault-issuer",
@@ -337,6 +387,84 @@ type testExtractCertificatesFromVaultCertT struct {
 	expectedCA   string
 }
 
+func TestSignURL(t *testing.T) {
+	tests := map[string]struct {
+		issuer      cmapi.VaultIssuer
+		expectedURL string
+	}{
+		"sign with a role": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign/my-role"},
+			expectedURL: "/v1/pki/sign/my-role",
+		},
+		"sign-verbatim replaces the sign segment and keeps the role": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign/my-role", SignVerbatim: true},
+			expectedURL: "/v1/pki/sign-verbatim/my-role",
+		},
+		"sign-verbatim on a nested mount named sign only replaces the last segment": {
+			issuer:      cmapi.VaultIssuer{Path: "/sign/pki/sign/my-role/", SignVerbatim: true},
+			expectedURL: "/v1/sign/pki/sign-verbatim/my-role",
+		},
+		"sign-verbatim path is kept as is": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign-verbatim", SignVerbatim: true},
+			expectedURL: "/v1/pki/sign-verbatim",
+		},
+		"sign-verbatim on a bare mount appends the endpoint": {
+			issuer:      cmapi.VaultIssuer{Path: "pki", SignVerbatim: true},
+			expectedURL: "/v1/pki/sign-verbatim",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if url := signURL(&test.issuer); url != test.expectedURL {
+				t.Errorf("unexpected sign URL, exp=%s got=%s", test.expectedURL, url)
+			}
+		})
+	}
+}
+
+func TestOtherSANs(t *testing.T) {
+	sans := otherSANs([]cmapi.OtherName{
+		{OID: "1.3.6.1.4.1.311.20.2.3", UTF8Value: "user@example.com"},
+		{OID: "1.2.3.4", UTF8Value: "value"},
+	})
+	if exp := "1.3.6.1.4.1.311.20.2.3;UTF8:user@example.com,1.2.3.4;UTF8:value"; sans != exp {
+		t.Errorf("unexpected other_sans, exp=%s got=%s", exp, sans)
+	}
+}
+
+func TestRevokeURL(t *testing.T) {
+	tests := map[string]struct {
+		issuer      cmapi.VaultIssuer
+		expectedURL string
+	}{
+		"sign with a role": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign/my-role"},
+			expectedURL: "/v1/pki/revoke",
+		},
+		"sign-verbatim with a role": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign-verbatim/my-role", SignVerbatim: true},
+			expectedURL: "/v1/pki/revoke",
+		},
+		"nested mount named sign": {
+			issuer:      cmapi.VaultIssuer{Path: "/sign/pki/sign/my-role/"},
+			expectedURL: "/v1/sign/pki/revoke",
+		},
+		"bare mount": {
+			issuer:      cmapi.VaultIssuer{Path: "pki", SignVerbatim: true},
+			expectedURL: "/v1/pki/revoke",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if url := revokeURL(&test.issuer); url != test.expectedURL {
+				t.Errorf("unexpected revoke URL, exp=%s got=%s", test.expectedURL, url)
+			}
+		})
+	}
+}
+
 func TestExtractCertificatesFromVaultCertificateSecret(t *testing.T) {
 	tests := map[string]testExtractCertificatesFromVaultCertT{
 		"when a Vault engine is a root CA": {
@@ -410,7 +538,7 @@ func TestSetToken(t *testing.T) {
 			fakeClient:    vaultfake.NewFakeClient(),
 			expectedToken: "",
 			expectedErr: errors.New(
-				"error initializing Vault client: tokenSecretRef, appRoleSecretRef, or Kubernetes auth role not set",
+				"error initializing Vault client: tokenSecretRef, appRoleSecretRef, Kubernetes auth role, or clientCertificate not set",
 			),
 		},
 
@@ -621,6 +749,48 @@ func TestSetToken(t *testing.T) {
 			expectedErr:   nil,
 		},
 
+		"if client certificate auth set, return client using the token returned by Vault": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{
+					CABundle: []byte(testLeafCertificate),
+					Auth: cmapi.VaultAuth{
+						ClientCertificate: &cmapi.VaultClientCertificateAuth{
+							SecretName: "client-tls",
+							Name:       "my-cert-role",
+						},
+					},
+				}),
+			),
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister()),
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					Body: io.NopCloser(
+						strings.NewReader(
+							`{"request_id":"","lease_id":"","lease_duration":0,"renewable":false,"data":null,"warnings":null,"auth":{"client_token":"my-cert-token"}}`),
+					),
+				},
+			}, nil),
+			expectedToken: "my-cert-token",
+			expectedErr:   nil,
+		},
+
+		"if client certificate auth set but Vault fails to return a token, error": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{
+					CABundle: []byte(testLeafCertificate),
+					Auth: cmapi.VaultAuth{
+						ClientCertificate: &cmapi.VaultClientCertificateAuth{
+							SecretName: "client-tls",
+						},
+					},
+				}),
+			),
+			fakeLister:    listers.FakeSecretListerFrom(listers.NewFakeSecretLister()),
+			fakeClient:    vaultfake.NewFakeClient().WithRawRequest(nil, errors.New("raw request error")),
+			expectedToken: "",
+			expectedErr:   errors.New("error authenticating with client certificate from client-tls: error calling Vault server: raw request error"),
+		},
+
 		"if app role secret ref and token secret set, take preference on token secret": {
 			issuer: gen.Issuer("vault-issuer",
 				gen.SetIssuerVault(cmapi.VaultIssuer{
@@ -879,9 +1049,13 @@ type testNewConfigT struct {
 	expectedErr error
 	issuer      *cmapi.Issuer
 	checkFunc   func(cfg *vault.Config) error
+
+	fakeLister *listers.FakeSecretLister
 }
 
 func TestNewConfig(t *testing.T) {
+	clientCertificateSecret := generateClientCertificateSecret(t)
+
 	tests := map[string]testNewConfigT{
 		"no CA bundle set in issuer should return nil": {
 			issuer: gen.Issuer("vault-issuer",
@@ -927,13 +1101,57 @@ func TestNewConfig(t *testing.T) {
 				return nil
 			},
 		},
+
+		"a client certificate should be added to the config": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{
+					Auth: cmapi.VaultAuth{
+						ClientCertificate: &cmapi.VaultClientCertificateAuth{
+							SecretName: "client-tls",
+						},
+					},
+				}),
+			),
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretNamespaceListerGet(clientCertificateSecret, nil),
+			),
+			expectedErr: nil,
+			checkFunc: func(cfg *vault.Config) error {
+				certs := cfg.HttpClient.Transport.(*http.Transport).TLSClientConfig.Certificates
+				if len(certs) != 1 {
+					return fmt.Errorf("expected 1 client certificate in config, got %d", len(certs))
+				}
+
+				return nil
+			},
+		},
+
+		"a client certificate secret without a private key should error": {
+			issuer: gen.Issuer("vault-issuer",
+				gen.SetIssuerVault(cmapi.VaultIssuer{
+					Auth: cmapi.VaultAuth{
+						ClientCertificate: &cmapi.VaultClientCertificateAuth{
+							SecretName: "client-tls",
+						},
+					},
+				}),
+			),
+			fakeLister: listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+				listers.SetFakeSecretNamespaceListerGet(&corev1.Secret{
+					Data: map[string][]byte{
+						corev1.TLSCertKey: clientCertificateSecret.Data[corev1.TLSCertKey],
+					},
+				}, nil),
+			),
+			expectedErr: errors.New(`error loading Vault client certificate: no data for "tls.key" in secret 'test-namespace/client-tls'`),
+		},
 	}
 
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
 			v := &Vault{
 				namespace:     "test-namespace",
-				secretsLister: nil,
+				secretsLister: test.fakeLister,
 				issuer:        test.issuer,
 			}
 
@@ -1074,10 +1292,230 @@ func TestRequestTokenWithAppRoleRef(t *testing.T) {
 					test.expectedErr, err)
 			}
 
-			if test.expectedToken != token {
+			if test.expectedToken != token.id {
 				t.Errorf("got unexpected token, exp=%s got=%s",
-					test.expectedToken, token)
+					test.expectedToken, token.id)
+			}
+		})
+	}
+}
+
+func TestCheckToken(t *testing.T) {
+	issuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{}),
+	)
+
+	tests := map[string]struct {
+		fakeClient        *vaultfake.Client
+		expectedErr       bool
+		expectTokenCached bool
+	}{
+		"an accepted token is kept in the cache": {
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("{}"))},
+			}, nil),
+			expectTokenCached: true,
+		},
+		"a rejected token is removed from the cache": {
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       io.NopCloser(strings.NewReader("{}"))},
+			}, errors.New("permission denied")),
+			expectedErr: true,
+		},
+		"a network failure keeps the token in the cache": {
+			fakeClient:        vaultfake.NewFakeClient().WithRawRequest(nil, errors.New("connection refused")),
+			expectedErr:       true,
+			expectTokenCached: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokens := NewTokenCache(clock.RealClock{})
+			tokens.add(tokenCacheKeyForIssuer(issuer), clientToken{id: "token"})
+
+			v := &Vault{
+				issuer: issuer,
+				tokens: tokens,
+				client: test.fakeClient,
+			}
+
+			err := v.CheckToken()
+			if (err != nil) != test.expectedErr {
+				t.Errorf("expected error %t, got %v", test.expectedErr, err)
+			}
+
+			if _, _, cached := tokens.get(tokenCacheKeyForIssuer(issuer)); cached != test.expectTokenCached {
+				t.Errorf("expected token cached %t, got %t", test.expectTokenCached, cached)
+			}
+		})
+	}
+}
+
+func TestWriteKV(t *testing.T) {
+	issuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{}),
+	)
+
+	tests := map[string]struct {
+		fakeClient        *vaultfake.Client
+		expectedErr       bool
+		expectTokenCached bool
+	}{
+		"a successful write keeps the token in the cache": {
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader("{}"))},
+			}, nil),
+			expectTokenCached: true,
+		},
+		"a rejected token is removed from the cache": {
+			fakeClient: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					StatusCode: http.StatusForbidden,
+					Body:       io.NopCloser(strings.NewReader("{}"))},
+			}, errors.New("permission denied")),
+			expectedErr: true,
+		},
+		"a network failure keeps the token in the cache": {
+			fakeClient:        vaultfake.NewFakeClient().WithRawRequest(nil, errors.New("connection refused")),
+			expectedErr:       true,
+			expectTokenCached: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			tokens := NewTokenCache(clock.RealClock{})
+			tokens.add(tokenCacheKeyForIssuer(issuer), clientToken{id: "token"})
+
+			v := &Vault{
+				issuer: issuer,
+				tokens: tokens,
+				client: test.fakeClient,
+			}
+
+			err := v.WriteKV("secret", "my-app/tls", map[string]string{"tls.crt": "cert"})
+			if (err != nil) != test.expectedErr {
+				t.Errorf("expected error %t, got %v", test.expectedErr, err)
+			}
+
+			if _, _, cached := tokens.get(tokenCacheKeyForIssuer(issuer)); cached != test.expectTokenCached {
+				t.Errorf("expected token cached %t, got %t", test.expectTokenCached, cached)
+			}
+		})
+	}
+}
+
+func TestTransit(t *testing.T) {
+	issuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{}),
+	)
+
+	newVault := func(body string) *Vault {
+		return &Vault{
+			issuer: issuer,
+			tokens: NewTokenCache(clock.RealClock{}),
+			client: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+				Response: &http.Response{
+					StatusCode: http.StatusOK,
+					Body:       io.NopCloser(strings.NewReader(body))},
+			}, nil),
+		}
+	}
+
+	ciphertext, err := newVault(`{"data":{"ciphertext":"vault:v1:abcd"}}`).TransitEncrypt("transit", "my-key", []byte("data key"))
+	if err != nil {
+		t.Fatalf("unexpected error encrypting: %v", err)
+	}
+	if ciphertext != "vault:v1:abcd" {
+		t.Errorf("expected ciphertext %q, got %q", "vault:v1:abcd", ciphertext)
+	}
+
+	plaintext, err := newVault(`{"data":{"plaintext":"ZGF0YSBrZXk="}}`).TransitDecrypt("transit", "my-key", ciphertext)
+	if err != nil {
+		t.Fatalf("unexpected error decrypting: %v", err)
+	}
+	if string(plaintext) != "data key" {
+		t.Errorf("expected plaintext %q, got %q", "data key", plaintext)
+	}
+}
+
+func TestRoleURL(t *testing.T) {
+	tests := map[string]struct {
+		issuer      cmapi.VaultIssuer
+		expectedURL string
+		expectedOK  bool
+	}{
+		"sign with a role": {
+			issuer:      cmapi.VaultIssuer{Path: "pki/sign/my-role"},
+			expectedURL: "/v1/pki/roles/my-role",
+			expectedOK:  true,
+		},
+		"issue with a role": {
+			issuer:      cmapi.VaultIssuer{Path: "/pki/issue/my-role/"},
+			expectedURL: "/v1/pki/roles/my-role",
+			expectedOK:  true,
+		},
+		"nested mount named sign": {
+			issuer:      cmapi.VaultIssuer{Path: "sign/pki/sign/my-role"},
+			expectedURL: "/v1/sign/pki/roles/my-role",
+			expectedOK:  true,
+		},
+		"sign-verbatim": {
+			issuer: cmapi.VaultIssuer{Path: "pki/sign/my-role", SignVerbatim: true},
+		},
+		"sign-verbatim path": {
+			issuer: cmapi.VaultIssuer{Path: "pki/sign-verbatim/my-role"},
+		},
+		"bare mount": {
+			issuer: cmapi.VaultIssuer{Path: "pki"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			url, ok := roleURL(&test.issuer)
+			if url != test.expectedURL || ok != test.expectedOK {
+				t.Errorf("unexpected role URL, exp=%s,%t got=%s,%t", test.expectedURL, test.expectedOK, url, ok)
 			}
 		})
 	}
 }
+
+func TestReadRole(t *testing.T) {
+	issuer := gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{Path: "pki/sign/my-role"}),
+	)
+
+	v := &Vault{
+		issuer: issuer,
+		tokens: NewTokenCache(clock.RealClock{}),
+		client: vaultfake.NewFakeClient().WithRawRequest(&vault.Response{
+			Response: &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       io.NopCloser(strings.NewReader(`{"data":{"allowed_domains":["example.com"],"max_ttl":3600}}`))},
+		}, nil),
+	}
+
+	role, err := v.ReadRole()
+	if err != nil {
+		t.Fatalf("unexpected error reading role: %v", err)
+	}
+	if domains, ok := role.Data["allowed_domains"].([]interface{}); !ok || len(domains) != 1 || domains[0] != "example.com" {
+		t.Errorf("unexpected allowed_domains %v", role.Data["allowed_domains"])
+	}
+
+	v.issuer = gen.Issuer("vault-issuer",
+		gen.SetIssuerVault(cmapi.VaultIssuer{Path: "pki", SignVerbatim: true}),
+	)
+	role, err = v.ReadRole()
+	if err != nil || role != nil {
+		t.Errorf("expected no role for a sign-verbatim issuer, got %v, %v", role, err)
+	}
+}
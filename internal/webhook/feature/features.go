@@ -47,6 +47,7 @@ const (
 
 func init() {
 	utilfeature.DefaultMutableFeatureGate.Add(webhookFeatureGates)
+	utilfeature.SetRuntimeToggleable(webhookRuntimeToggleableFeatureGates...)
 }
 
 // webhookFeatureGates defines all feature gates for the webhook component.
@@ -58,3 +59,12 @@ var webhookFeatureGates = map[featuregate.Feature]featuregate.FeatureSpec{
 	AdditionalCertificateOutputFormats: {Default: false, PreRelease: featuregate.Alpha},
 	LiteralCertificateSubject:          {Default: false, PreRelease: featuregate.Alpha},
 }
+
+// webhookRuntimeToggleableFeatureGates are the webhook feature gates that may
+// be changed in the webhook config file without restarting the webhook. They
+// are only checked when validating each admission request, and do not change
+// which resources or versions are served, or any state computed at startup.
+var webhookRuntimeToggleableFeatureGates = []featuregate.Feature{
+	AdditionalCertificateOutputFormats,
+	LiteralCertificateSubject,
+}
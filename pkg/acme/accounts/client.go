@@ -41,7 +41,7 @@ var _ NewClientFunc = NewClient
 func NewClient(client *http.Client, config cmacme.ACMEIssuer, privateKey *rsa.PrivateKey, userAgent string) acmecl.Interface {
 	return middleware.NewLogger(&acmeapi.Client{
 		Key:          privateKey,
-		HTTPClient:   client,
+		HTTPClient:   withURLRewrites(client, config.URLRewrites),
 		DirectoryURL: config.Server,
 		UserAgent:    userAgent,
 		RetryBackoff: acmeutil.RetryBackoff,
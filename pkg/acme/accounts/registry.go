@@ -19,7 +19,9 @@ package accounts
 import (
 	"crypto/rsa"
 	"errors"
+	"fmt"
 	"net/http"
+	"strings"
 	"sync"
 
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
@@ -29,6 +31,40 @@ import (
 // ErrNotFound is returned by GetClient if there is no ACME client registered.
 var ErrNotFound = errors.New("ACME client for issuer not initialised/available")
 
+// ClientKey returns the key under which the ACME client for an issuer's
+// account is stored in a Registry. The issuer's primary account, which has no
+// email override, is keyed by the issuer's UID alone. Additional accounts are
+// keyed by the issuer's UID and the account's email address.
+func ClientKey(issuerUID, email string) string {
+	if email == "" {
+		return issuerUID
+	}
+	return issuerUID + "/" + strings.ToLower(email)
+}
+
+// GetClientForAccount fetches the registered client for the issuer account
+// with the given email, as selected using the
+// acme.cert-manager.io/account-email annotation. An empty email selects the
+// issuer's primary account.
+func GetClientForAccount(g Getter, issuerUID, email string) (acmecl.Interface, error) {
+	cl, err := g.GetClient(ClientKey(issuerUID, email))
+	if err == ErrNotFound && email != "" {
+		return nil, fmt.Errorf("ACME client for account %q not initialised/available, it must be listed in the issuer's spec.acme.additionalAccounts", email)
+	}
+	return cl, err
+}
+
+// RemoveIssuerClients removes the clients for the issuer's primary account
+// and all of its additional accounts from the registry. It is called when the
+// issuer is deleted.
+func RemoveIssuerClients(r Registry, issuerUID string) {
+	for key := range r.ListClients() {
+		if key == issuerUID || strings.HasPrefix(key, issuerUID+"/") {
+			r.RemoveClient(key)
+		}
+	}
+}
+
 // A registry provides a means to store and access ACME clients using an issuer
 // objects UID.
 // This is used as a shared cache of ACME clients across various controllers.
@@ -78,6 +114,7 @@ type registry struct {
 // options that should trigger a re-initialisation of a client have changed.
 type stableOptions struct {
 	serverURL     string
+	urlRewrites   string
 	skipVerifyTLS bool
 	issuerUID     string
 	publicKey     string
@@ -93,6 +130,7 @@ func newStableOptions(uid string, config cmacme.ACMEIssuer, privateKey *rsa.Priv
 	publicNBytes, _ := privateKey.PublicKey.N.GobEncode()
 	return stableOptions{
 		serverURL:     config.Server,
+		urlRewrites:   urlRewritesString(config.URLRewrites),
 		skipVerifyTLS: config.SkipTLSVerify,
 		issuerUID:     uid,
 		publicKey:     string(publicNBytes),
@@ -83,6 +83,28 @@ func TestRegistry_RemoveClient_EmptyRegistry(t *testing.T) {
 	}
 }
 
+func TestRemoveIssuerClients(t *testing.T) {
+	r := NewDefaultRegistry()
+	pk, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r.AddClient(http.DefaultClient, "abc", cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+	r.AddClient(http.DefaultClient, ClientKey("abc", "team@example.com"), cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+	r.AddClient(http.DefaultClient, "abcd", cmacme.ACMEIssuer{}, pk, "cert-manager-test")
+
+	RemoveIssuerClients(r, "abc")
+
+	l := r.ListClients()
+	if len(l) != 1 {
+		t.Fatalf("expected only the client of the other issuer to remain, got %v", l)
+	}
+	if _, ok := l["abcd"]; !ok {
+		t.Errorf("expected client of the other issuer to remain")
+	}
+}
+
 func TestRegistry_ListClients(t *testing.T) {
 	r := NewDefaultRegistry()
 	pk, err := pki.GenerateRSAPrivateKey(2048)
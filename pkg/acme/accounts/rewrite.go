@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounts
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+// urlRewriteTransport is a http.RoundTripper that sends requests made to an
+// ACME server to a mirror instead, according to an issuer's URL rewrites.
+// Only the URL the request is sent to is changed, so that the URLs in the
+// signed request bodies still match those expected by the ACME server.
+type urlRewriteTransport struct {
+	// rewrites are sorted so that the longest matching prefix is found first
+	rewrites []cmacme.ACMEURLRewrite
+
+	wrappedRT http.RoundTripper
+}
+
+// withURLRewrites returns a copy of client that rewrites the URL of each
+// request using the given rewrites.
+func withURLRewrites(client *http.Client, rewrites []cmacme.ACMEURLRewrite) *http.Client {
+	if len(rewrites) == 0 {
+		return client
+	}
+
+	sorted := make([]cmacme.ACMEURLRewrite, len(rewrites))
+	copy(sorted, rewrites)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return len(sorted[i].From) > len(sorted[j].From)
+	})
+
+	if client == nil {
+		client = http.DefaultClient
+	}
+	wrappedRT := client.Transport
+	if wrappedRT == nil {
+		wrappedRT = http.DefaultTransport
+	}
+
+	rewritingClient := *client
+	rewritingClient.Transport = &urlRewriteTransport{
+		rewrites:  sorted,
+		wrappedRT: wrappedRT,
+	}
+	return &rewritingClient
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *urlRewriteTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	reqURL := req.URL.String()
+	for _, rewrite := range t.rewrites {
+		if !strings.HasPrefix(reqURL, rewrite.From) {
+			continue
+		}
+
+		rewrittenURL, err := url.Parse(rewrite.To + strings.TrimPrefix(reqURL, rewrite.From))
+		if err != nil {
+			return nil, fmt.Errorf("failed to rewrite ACME server URL %q: %w", reqURL, err)
+		}
+
+		// a RoundTripper must not modify the request it is given
+		req = req.Clone(req.Context())
+		req.URL = rewrittenURL
+		req.Host = rewrittenURL.Host
+		break
+	}
+
+	return t.wrappedRT.RoundTrip(req)
+}
+
+// urlRewritesString returns a string representation of rewrites which can be
+// used to compare the rewrites configured for two clients.
+func urlRewritesString(rewrites []cmacme.ACMEURLRewrite) string {
+	s := make([]string, len(rewrites))
+	for i, rewrite := range rewrites {
+		s[i] = rewrite.From + "=" + rewrite.To
+	}
+	return strings.Join(s, ",")
+}
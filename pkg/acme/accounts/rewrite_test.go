@@ -0,0 +1,80 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accounts
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+type roundTripFunc func(req *http.Request) (*http.Response, error)
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
+
+func TestURLRewriteTransport(t *testing.T) {
+	rewrites := []cmacme.ACMEURLRewrite{
+		{From: "https://acme.example.com/", To: "https://mirror.internal/acme/"},
+		{From: "https://acme.example.com/cert/", To: "https://certs.mirror.internal/"},
+	}
+
+	tests := map[string]string{
+		"https://acme.example.com/directory":       "https://mirror.internal/acme/directory",
+		"https://acme.example.com/acme/order/1234": "https://mirror.internal/acme/acme/order/1234",
+		"https://acme.example.com/cert/abcd":       "https://certs.mirror.internal/abcd",
+		"https://other.example.com/directory":      "https://other.example.com/directory",
+	}
+	for reqURL, expectedURL := range tests {
+		t.Run(reqURL, func(t *testing.T) {
+			var gotURL, gotHost string
+			client := withURLRewrites(&http.Client{
+				Transport: roundTripFunc(func(req *http.Request) (*http.Response, error) {
+					gotURL, gotHost = req.URL.String(), req.Host
+					return &http.Response{StatusCode: http.StatusOK, Body: http.NoBody}, nil
+				}),
+			}, rewrites)
+
+			req, err := http.NewRequest(http.MethodGet, reqURL, nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			resp.Body.Close()
+
+			if gotURL != expectedURL {
+				t.Errorf("expected request to be sent to %q but got %q", expectedURL, gotURL)
+			}
+			expected, err := url.Parse(expectedURL)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if gotHost != expected.Host {
+				t.Errorf("expected request to be sent with Host %q but got %q", expected.Host, gotHost)
+			}
+			if req.URL.String() != reqURL {
+				t.Errorf("expected original request to not be modified but got %q", req.URL.String())
+			}
+		})
+	}
+}
@@ -18,6 +18,7 @@ package middleware
 
 import (
 	"context"
+	"crypto"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -181,3 +182,12 @@ func (l *Logger) UpdateReg(ctx context.Context, a *acme.Account) (*acme.Account,
 
 	return l.baseCl.UpdateReg(ctx, a)
 }
+
+func (l *Logger) RevokeCert(ctx context.Context, key crypto.Signer, cert []byte, reason acme.CRLReasonCode) error {
+	l.log.V(logf.TraceLevel).Info("Calling RevokeCert")
+
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	return l.baseCl.RevokeCert(ctx, key, cert, reason)
+}
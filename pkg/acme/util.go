@@ -17,6 +17,12 @@ limitations under the License.
 package acme
 
 import (
+	"errors"
+	"net/http"
+	"strconv"
+	"time"
+
+	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
@@ -51,3 +57,30 @@ func PrivateKeySelector(sel cmmeta.SecretKeySelector) cmmeta.SecretKeySelector {
 	}
 	return sel
 }
+
+// RetryAfter returns the time before which the ACME server has asked for a
+// request not to be retried, if the given error is an ACME error response
+// with a Retry-After header. The header may either be a number of seconds
+// relative to now or an HTTP date.
+func RetryAfter(err error, now time.Time) (time.Time, bool) {
+	var acmeErr *acmeapi.Error
+	if !errors.As(err, &acmeErr) || acmeErr.Header == nil {
+		return time.Time{}, false
+	}
+
+	v := acmeErr.Header.Get("Retry-After")
+	if v == "" {
+		return time.Time{}, false
+	}
+	if secs, err := strconv.Atoi(v); err == nil {
+		if secs < 0 {
+			return time.Time{}, false
+		}
+		return now.Add(time.Duration(secs) * time.Second), true
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return t, true
+	}
+
+	return time.Time{}, false
+}
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcsolver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+)
+
+// Client calls a DNS01Solver service.
+type Client struct {
+	api DNS01SolverClient
+}
+
+// NewClient returns a Client that calls the DNS01Solver service using the
+// given connection.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{api: NewDNS01SolverClient(conn)}
+}
+
+// Present asks the named solver to present the challenge.
+func (c *Client) Present(ctx context.Context, solverName string, ch *whapi.ChallengeRequest) error {
+	_, err := c.api.Present(ctx, challengeRequest(solverName, ch))
+	return err
+}
+
+// CleanUp asks the named solver to clean up the challenge.
+func (c *Client) CleanUp(ctx context.Context, solverName string, ch *whapi.ChallengeRequest) error {
+	_, err := c.api.CleanUp(ctx, challengeRequest(solverName, ch))
+	return err
+}
+
+// challengeRequest returns the ChallengeRequest message for ch. The action
+// of the request is implied by the method that is called.
+func challengeRequest(solverName string, ch *whapi.ChallengeRequest) *ChallengeRequest {
+	req := &ChallengeRequest{
+		Uid:                     string(ch.UID),
+		SolverName:              solverName,
+		DnsName:                 ch.DNSName,
+		Key:                     ch.Key,
+		ResourceNamespace:       ch.ResourceNamespace,
+		ResolvedFqdn:            ch.ResolvedFQDN,
+		ResolvedZone:            ch.ResolvedZone,
+		AllowAmbientCredentials: ch.AllowAmbientCredentials,
+	}
+	if ch.Config != nil {
+		req.Config = ch.Config.Raw
+	}
+	return req
+}
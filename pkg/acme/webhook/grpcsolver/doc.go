@@ -0,0 +1,21 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package grpcsolver implements the gRPC DNS01 solver protocol defined in
+// dns01solver.proto. It allows DNS01 webhook solvers to be served and called
+// over gRPC rather than through an APIService registered with the Kubernetes
+// API server.
+package grpcsolver
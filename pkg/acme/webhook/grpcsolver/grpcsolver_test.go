@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcsolver
+
+import (
+	"context"
+	"errors"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/client-go/rest"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+)
+
+type fakeSolver struct {
+	name string
+	err  error
+	got  []*whapi.ChallengeRequest
+}
+
+var _ webhook.Solver = &fakeSolver{}
+
+func (f *fakeSolver) Name() string { return f.name }
+
+func (f *fakeSolver) Present(ch *whapi.ChallengeRequest) error {
+	f.got = append(f.got, ch)
+	return f.err
+}
+
+func (f *fakeSolver) CleanUp(ch *whapi.ChallengeRequest) error {
+	f.got = append(f.got, ch)
+	return f.err
+}
+
+func (f *fakeSolver) Initialize(*rest.Config, <-chan struct{}) error { return nil }
+
+func TestClientServer(t *testing.T) {
+	good := &fakeSolver{name: "good"}
+	bad := &fakeSolver{name: "bad", err: errors.New("zone not found")}
+
+	lis := bufconn.Listen(1 << 16)
+	srv := NewServer([]webhook.Solver{good, bad})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	cl := NewClient(conn)
+	ctx := context.Background()
+
+	ch := &whapi.ChallengeRequest{
+		UID:                     "uid",
+		DNSName:                 "example.com",
+		Key:                     "key",
+		ResourceNamespace:       "ns",
+		ResolvedFQDN:            "_acme-challenge.example.com.",
+		ResolvedZone:            "example.com.",
+		AllowAmbientCredentials: true,
+		Config:                  &apiextensionsv1.JSON{Raw: []byte(`{"a":"b"}`)},
+	}
+	if err := cl.Present(ctx, "good", ch); err != nil {
+		t.Fatalf("unexpected Present error: %v", err)
+	}
+	if err := cl.CleanUp(ctx, "good", ch); err != nil {
+		t.Fatalf("unexpected CleanUp error: %v", err)
+	}
+	if len(good.got) != 2 {
+		t.Fatalf("expected 2 calls, got %d", len(good.got))
+	}
+	if good.got[0].Action != whapi.ChallengeActionPresent || good.got[1].Action != whapi.ChallengeActionCleanUp {
+		t.Errorf("unexpected actions %q, %q", good.got[0].Action, good.got[1].Action)
+	}
+	exp := ch.DeepCopy()
+	exp.Action = whapi.ChallengeActionPresent
+	exp.Type = "dns-01"
+	if !reflect.DeepEqual(good.got[0], exp) {
+		t.Errorf("expected %+v, got %+v", exp, good.got[0])
+	}
+
+	err = cl.Present(ctx, "bad", ch)
+	if err == nil || !strings.Contains(err.Error(), "zone not found") {
+		t.Errorf("expected solver error, got %v", err)
+	}
+
+	err = cl.Present(ctx, "missing", ch)
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
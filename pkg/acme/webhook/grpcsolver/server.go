@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package grpcsolver
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
+	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+)
+
+// NewServer returns a gRPC server serving the DNS01Solver service for the
+// given solvers. Requests are routed to the solver whose Name matches the
+// solver name in the request.
+// The solvers must already have been initialized.
+func NewServer(solvers []webhook.Solver, opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	Register(srv, solvers...)
+	return srv
+}
+
+// Register registers the DNS01Solver service for the given solvers with a
+// gRPC server.
+func Register(srv grpc.ServiceRegistrar, solvers ...webhook.Solver) {
+	s := &server{solvers: make(map[string]webhook.Solver, len(solvers))}
+	for _, solver := range solvers {
+		s.solvers[solver.Name()] = solver
+	}
+	RegisterDNS01SolverServer(srv, s)
+}
+
+type server struct {
+	UnimplementedDNS01SolverServer
+
+	solvers map[string]webhook.Solver
+}
+
+var _ DNS01SolverServer = &server{}
+
+func (s *server) Present(_ context.Context, req *ChallengeRequest) (*ChallengeResponse, error) {
+	return &ChallengeResponse{}, s.call(req, whapi.ChallengeActionPresent)
+}
+
+func (s *server) CleanUp(_ context.Context, req *ChallengeRequest) (*ChallengeResponse, error) {
+	return &ChallengeResponse{}, s.call(req, whapi.ChallengeActionCleanUp)
+}
+
+func (s *server) call(req *ChallengeRequest, action whapi.ChallengeAction) error {
+	solver, ok := s.solvers[req.SolverName]
+	if !ok {
+		return status.Errorf(codes.NotFound, "no solver named %q", req.SolverName)
+	}
+
+	ch := &whapi.ChallengeRequest{
+		UID:                     types.UID(req.Uid),
+		Action:                  action,
+		Type:                    "dns-01",
+		DNSName:                 req.DnsName,
+		Key:                     req.Key,
+		ResourceNamespace:       req.ResourceNamespace,
+		ResolvedFQDN:            req.ResolvedFqdn,
+		ResolvedZone:            req.ResolvedZone,
+		AllowAmbientCredentials: req.AllowAmbientCredentials,
+	}
+	if len(req.Config) > 0 {
+		ch.Config = &apiextensionsv1.JSON{Raw: req.Config}
+	}
+
+	var err error
+	switch action {
+	case whapi.ChallengeActionPresent:
+		err = solver.Present(ch)
+	case whapi.ChallengeActionCleanUp:
+		err = solver.CleanUp(ch)
+	}
+	if err != nil {
+		return status.Error(codes.Unknown, err.Error())
+	}
+	return nil
+}
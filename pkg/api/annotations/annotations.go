@@ -0,0 +1,237 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package annotations enumerates the annotations that cert-manager reads from
+// ingress-like resources (Ingresses and Gateways), along with the type of
+// value each one holds and a function to validate it.
+//
+// The IngressLike table is the single source of truth for these annotations:
+// the certificate-shim uses it to translate annotations into Certificate
+// fields, and the JSON schema in schema.json is generated from it so that
+// external tooling can validate annotations in the same way cert-manager does.
+package annotations
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Type is the type of value held by an annotation.
+type Type string
+
+const (
+	// TypeString is a free-form string.
+	TypeString Type = "string"
+
+	// TypeBoolean is the string "true" or "false".
+	TypeBoolean Type = "boolean"
+
+	// TypeDuration is a duration as accepted by time.ParseDuration, for
+	// example "2160h".
+	TypeDuration Type = "duration"
+
+	// TypePositiveInteger is a base 10 integer that is at least 1 and fits
+	// in an int32.
+	TypePositiveInteger Type = "positiveInteger"
+
+	// TypeKeyUsageList is a comma separated list of key usage names, for
+	// example "digital signature,key encipherment".
+	TypeKeyUsageList Type = "keyUsageList"
+)
+
+// Annotation describes a single annotation.
+type Annotation struct {
+	// Key is the annotation key, for example "cert-manager.io/duration".
+	Key string
+
+	// Type is the type of value that the annotation holds.
+	Type Type
+
+	// Description is a human readable description of the annotation.
+	Description string
+}
+
+// Validate returns an error if value is not a valid value for the
+// annotation.
+func (a Annotation) Validate(value string) error {
+	var err error
+	switch a.Type {
+	case TypeString:
+	case TypeBoolean:
+		_, err = ParseBoolean(value)
+	case TypeDuration:
+		_, err = ParseDuration(value)
+	case TypePositiveInteger:
+		_, err = ParsePositiveInteger(value)
+	case TypeKeyUsageList:
+		_, err = ParseKeyUsageList(value)
+	default:
+		err = fmt.Errorf("unknown annotation type %q", a.Type)
+	}
+	return err
+}
+
+// IngressLike is the list of annotations that are understood by cert-manager
+// on ingress-like resources.
+var IngressLike = []Annotation{
+	{
+		Key:         cmapi.IngressIssuerNameAnnotationKey,
+		Type:        TypeString,
+		Description: "Name of the Issuer, in the same namespace, used to issue the Certificate.",
+	},
+	{
+		Key:         cmapi.IngressClusterIssuerNameAnnotationKey,
+		Type:        TypeString,
+		Description: "Name of the ClusterIssuer used to issue the Certificate.",
+	},
+	{
+		Key:         cmapi.IssuerKindAnnotationKey,
+		Type:        TypeString,
+		Description: "Kind of the external issuer named by the issuer annotation.",
+	},
+	{
+		Key:         cmapi.IssuerGroupAnnotationKey,
+		Type:        TypeString,
+		Description: "API group of the external issuer named by the issuer annotation.",
+	},
+	{
+		Key:         cmapi.CommonNameAnnotationKey,
+		Type:        TypeString,
+		Description: "Common name to set on the Certificate.",
+	},
+	{
+		Key:         cmapi.DurationAnnotationKey,
+		Type:        TypeDuration,
+		Description: "Requested duration of the Certificate.",
+	},
+	{
+		Key:         cmapi.RenewBeforeAnnotationKey,
+		Type:        TypeDuration,
+		Description: "How long before expiry the Certificate should be renewed.",
+	},
+	{
+		Key:         cmapi.RenewBeforePercentageAnnotationKey,
+		Type:        TypePositiveInteger,
+		Description: "Percentage of the Certificate's duration before expiry at which it should be renewed.",
+	},
+	{
+		Key:         cmapi.UsagesAnnotationKey,
+		Type:        TypeKeyUsageList,
+		Description: "Comma separated list of key usages to request for the Certificate.",
+	},
+	{
+		Key:         cmapi.RevisionHistoryLimitAnnotationKey,
+		Type:        TypePositiveInteger,
+		Description: "Maximum number of CertificateRequest revisions kept for the Certificate.",
+	},
+	{
+		Key:         cmapi.IngressACMEIssuerHTTP01IngressClassAnnotationKey,
+		Type:        TypeString,
+		Description: "Ingress class used to solve ACME HTTP01 challenges for the Certificate.",
+	},
+	{
+		Key:         cmacme.IngressEditInPlaceAnnotationKey,
+		Type:        TypeBoolean,
+		Description: "If true, ACME HTTP01 challenges are solved by editing this Ingress rather than creating a new one.",
+	},
+}
+
+var ingressLikeByKey = func() map[string]Annotation {
+	m := make(map[string]Annotation, len(IngressLike))
+	for _, a := range IngressLike {
+		m[a.Key] = a
+	}
+	return m
+}()
+
+// Lookup returns the ingress-like annotation with the given key, if it is
+// known.
+func Lookup(key string) (Annotation, bool) {
+	a, ok := ingressLikeByKey[key]
+	return a, ok
+}
+
+// ValidateIngressLike validates the values of all known annotations in the
+// given map. Unknown annotations are ignored. Errors are returned in key order.
+func ValidateIngressLike(annotations map[string]string) []error {
+	keys := make([]string, 0, len(annotations))
+	for k := range annotations {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var errs []error
+	for _, k := range keys {
+		a, ok := Lookup(k)
+		if !ok {
+			continue
+		}
+		if err := a.Validate(annotations[k]); err != nil {
+			errs = append(errs, fmt.Errorf("%q: %w", k, err))
+		}
+	}
+	return errs
+}
+
+// ParseBoolean parses the value of a TypeBoolean annotation.
+func ParseBoolean(value string) (bool, error) {
+	switch value {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("invalid boolean %q, must be \"true\" or \"false\"", value)
+}
+
+// ParseDuration parses the value of a TypeDuration annotation.
+func ParseDuration(value string) (time.Duration, error) {
+	return time.ParseDuration(value)
+}
+
+// ParsePositiveInteger parses the value of a TypePositiveInteger annotation.
+func ParsePositiveInteger(value string) (int32, error) {
+	i, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return 0, err
+	}
+	if i < 1 {
+		return 0, fmt.Errorf("must be a positive number %q", value)
+	}
+	return int32(i), nil
+}
+
+// ParseKeyUsageList parses the value of a TypeKeyUsageList annotation.
+func ParseKeyUsageList(value string) ([]cmapi.KeyUsage, error) {
+	var usages []cmapi.KeyUsage
+	for _, usageName := range strings.Split(value, ",") {
+		usage := cmapi.KeyUsage(strings.Trim(usageName, " "))
+		_, isKU := apiutil.KeyUsageType(usage)
+		_, isEKU := apiutil.ExtKeyUsageType(usage)
+		if !isKU && !isEKU {
+			return nil, fmt.Errorf("invalid key usage name %q", usageName)
+		}
+		usages = append(usages, usage)
+	}
+	return usages, nil
+}
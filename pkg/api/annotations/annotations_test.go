@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"testing"
+)
+
+// validationCases are shared with the schema tests to check that the
+// generated patterns agree with the Go validation functions.
+var validationCases = map[Type]map[string]bool{
+	TypeString: {
+		"":            true,
+		"example.com": true,
+	},
+	TypeBoolean: {
+		"true":  true,
+		"false": true,
+		"True":  false,
+		"":      false,
+	},
+	TypeDuration: {
+		"2160h":   true,
+		"1h30m":   true,
+		"1.5h":    true,
+		"0":       true,
+		"-10m":    true,
+		"10":      false,
+		"1d":      false,
+		"":        false,
+		"h":       false,
+		"1h 30m":  false,
+		"2160h\n": false,
+	},
+	TypePositiveInteger: {
+		"1":   true,
+		"7":   true,
+		"+3":  true,
+		"010": true,
+		"0":   false,
+		"-1":  false,
+		"1.5": false,
+		"":    false,
+	},
+	TypeKeyUsageList: {
+		"server auth":                          true,
+		"digital signature,key encipherment":   true,
+		"digital signature, key encipherment ": true,
+		"s/mime,client auth":                   true,
+		"digital signature,,key encipherment":  false,
+		"digital signature,key encipherment,":  false,
+		"bogus":                                false,
+		"":                                     false,
+		"Server Auth":                          false,
+	},
+}
+
+func TestValidate(t *testing.T) {
+	for typ, cases := range validationCases {
+		for value, valid := range cases {
+			err := Annotation{Key: "test", Type: typ}.Validate(value)
+			if valid && err != nil {
+				t.Errorf("%s %q: unexpected error: %v", typ, value, err)
+			}
+			if !valid && err == nil {
+				t.Errorf("%s %q: expected an error but got none", typ, value)
+			}
+		}
+	}
+}
+
+func TestIngressLikeKeysAreUnique(t *testing.T) {
+	if len(ingressLikeByKey) != len(IngressLike) {
+		t.Errorf("expected %d unique annotation keys, got %d", len(IngressLike), len(ingressLikeByKey))
+	}
+}
+
+func TestValidateIngressLike(t *testing.T) {
+	errs := ValidateIngressLike(map[string]string{
+		"cert-manager.io/duration":               "1d",
+		"cert-manager.io/renew-before":           "1h",
+		"cert-manager.io/revision-history-limit": "0",
+		"cert-manager.io/issuer":                 "ca-issuer",
+		"example.com/unknown":                    "anything",
+	})
+
+	expected := []string{
+		`"cert-manager.io/duration": time: unknown unit "d" in duration "1d"`,
+		`"cert-manager.io/revision-history-limit": must be a positive number "0"`,
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i := range errs {
+		if errs[i].Error() != expected[i] {
+			t.Errorf("expected error %q, got %q", expected[i], errs[i].Error())
+		}
+	}
+}
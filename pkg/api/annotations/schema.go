@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+)
+
+const (
+	// durationPattern matches the values accepted by time.ParseDuration.
+	durationPattern = `^[-+]?(0|(([0-9]+(\.[0-9]*)?|\.[0-9]+)(ns|us|µs|μs|ms|s|m|h))+)$`
+
+	// positiveIntegerPattern matches positive base 10 integers. The int32
+	// range cannot be expressed in the pattern and is only checked by
+	// ParsePositiveInteger.
+	positiveIntegerPattern = `^\+?0*[1-9][0-9]*$`
+)
+
+// schema is the subset of JSON schema used to describe annotations.
+type schema struct {
+	Schema               string             `json:"$schema,omitempty"`
+	Title                string             `json:"title,omitempty"`
+	Description          string             `json:"description,omitempty"`
+	Type                 string             `json:"type"`
+	Format               Type               `json:"x-cert-manager-type,omitempty"`
+	Enum                 []string           `json:"enum,omitempty"`
+	Pattern              string             `json:"pattern,omitempty"`
+	Properties           map[string]*schema `json:"properties,omitempty"`
+	AdditionalProperties *schema            `json:"additionalProperties,omitempty"`
+}
+
+// JSONSchema returns a JSON schema describing a map of annotations on an
+// ingress-like resource. The schema is generated from IngressLike; the
+// generated copy is checked in as schema.json.
+func JSONSchema() ([]byte, error) {
+	s := &schema{
+		Schema:      "http://json-schema.org/draft-07/schema#",
+		Title:       "cert-manager ingress-like annotations",
+		Description: "Annotations understood by cert-manager on Ingress and Gateway resources.",
+		Type:        "object",
+		Properties:  make(map[string]*schema, len(IngressLike)),
+		AdditionalProperties: &schema{
+			Type: "string",
+		},
+	}
+
+	for _, a := range IngressLike {
+		prop, err := propertySchema(a)
+		if err != nil {
+			return nil, err
+		}
+		s.Properties[a.Key] = prop
+	}
+
+	b, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return nil, err
+	}
+	return append(b, '\n'), nil
+}
+
+func propertySchema(a Annotation) (*schema, error) {
+	s := &schema{
+		Description: a.Description,
+		Type:        "string",
+		Format:      a.Type,
+	}
+
+	switch a.Type {
+	case TypeString:
+	case TypeBoolean:
+		s.Enum = []string{"true", "false"}
+	case TypeDuration:
+		s.Pattern = durationPattern
+	case TypePositiveInteger:
+		s.Pattern = positiveIntegerPattern
+	case TypeKeyUsageList:
+		s.Pattern = keyUsageListPattern()
+	default:
+		return nil, fmt.Errorf("unknown type %q for annotation %q", a.Type, a.Key)
+	}
+
+	return s, nil
+}
+
+// keyUsageListPattern matches comma separated lists of known key usage
+// names, each optionally surrounded by spaces.
+func keyUsageListPattern() string {
+	var names []string
+	for _, u := range apiutil.KeyUsageNames() {
+		names = append(names, regexp.QuoteMeta(string(u)))
+	}
+	usage := " *(" + strings.Join(names, "|") + ") *"
+	return "^" + usage + "(," + usage + ")*$"
+}
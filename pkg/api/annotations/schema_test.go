@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package annotations
+
+import (
+	"bytes"
+	"os"
+	"regexp"
+	"testing"
+)
+
+func TestSchemaIsUpToDate(t *testing.T) {
+	generated, err := JSONSchema()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	checkedIn, err := os.ReadFile("schema.json")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !bytes.Equal(generated, checkedIn) {
+		t.Errorf("schema.json is out of date, regenerate it with: go run ./hack/annotationgen pkg/api/annotations/schema.json")
+	}
+}
+
+func TestSchemaPatternsMatchValidation(t *testing.T) {
+	for typ, cases := range validationCases {
+		prop, err := propertySchema(Annotation{Key: "test", Type: typ})
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		for value, valid := range cases {
+			matches := true
+			if prop.Pattern != "" {
+				matches = regexp.MustCompile(prop.Pattern).MatchString(value)
+			}
+			if len(prop.Enum) > 0 {
+				matches = false
+				for _, e := range prop.Enum {
+					matches = matches || e == value
+				}
+			}
+
+			if matches != valid {
+				t.Errorf("%s %q: schema match = %t but validation = %t", typ, value, matches, valid)
+			}
+		}
+	}
+}
@@ -370,3 +370,41 @@ func CertificateRequestIsDenied(cr *cmapi.CertificateRequest) bool {
 
 	return false
 }
+
+// SetBundleCondition will set a 'condition' on the given Bundle.
+// - If no condition of the same type already exists, the condition will be
+//   inserted with the LastTransitionTime set to the current time.
+// - If a condition of the same type and state already exists, the condition
+//   will be updated but the LastTransitionTime will not be modified.
+// - If a condition of the same type and different state already exists, the
+//   condition will be updated and the LastTransitionTime set to the current
+//   time.
+func SetBundleCondition(bundle *cmapi.Bundle, observedGeneration int64, conditionType cmapi.BundleConditionType, status cmmeta.ConditionStatus, reason, message string) {
+	newCondition := cmapi.BundleCondition{
+		Type:               conditionType,
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: observedGeneration,
+	}
+
+	nowTime := metav1.NewTime(Clock.Now())
+	newCondition.LastTransitionTime = &nowTime
+
+	for idx, cond := range bundle.Status.Conditions {
+		if cond.Type != conditionType {
+			continue
+		}
+
+		// If this update doesn't contain a state transition, we don't update
+		// the conditions LastTransitionTime to Now()
+		if cond.Status == status {
+			newCondition.LastTransitionTime = cond.LastTransitionTime
+		}
+
+		bundle.Status.Conditions[idx] = newCondition
+		return
+	}
+
+	bundle.Status.Conditions = append(bundle.Status.Conditions, newCondition)
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"fmt"
 
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
@@ -34,6 +35,22 @@ const (
 	IssuerSelfSigned string = "selfsigned"
 	// IssuerVenafi uses Venafi Trust Protection Platform and Venafi Cloud
 	IssuerVenafi string = "venafi"
+	// IssuerAWSPCA uses AWS Certificate Manager Private Certificate Authority
+	IssuerAWSPCA string = "awspca"
+	// IssuerGoogleCAS uses Google Cloud Certificate Authority Service
+	IssuerGoogleCAS string = "googlecas"
+	// IssuerAzureKeyVault signs certificates using a CA key in Azure Key Vault
+	IssuerAzureKeyVault string = "azurekeyvault"
+	// IssuerEJBCA uses the EJBCA REST API
+	IssuerEJBCA string = "ejbca"
+	// IssuerStepCA uses a Smallstep step-ca instance
+	IssuerStepCA string = "stepca"
+	// IssuerKubernetesCSR forwards requests to a Kubernetes certificates.k8s.io signer
+	IssuerKubernetesCSR string = "kubernetescsr"
+	// IssuerSPIRE mints X.509 SVIDs using a SPIRE server
+	IssuerSPIRE string = "spire"
+	// IssuerPlugin delegates signing to an out-of-tree gRPC issuer plugin
+	IssuerPlugin string = "plugin"
 )
 
 // NameForIssuer determines the name of the Issuer implementation given an
@@ -50,6 +67,22 @@ func NameForIssuer(i cmapi.GenericIssuer) (string, error) {
 		return IssuerSelfSigned, nil
 	case i.GetSpec().Venafi != nil:
 		return IssuerVenafi, nil
+	case i.GetSpec().AWSPCA != nil:
+		return IssuerAWSPCA, nil
+	case i.GetSpec().GoogleCAS != nil:
+		return IssuerGoogleCAS, nil
+	case i.GetSpec().AzureKeyVault != nil:
+		return IssuerAzureKeyVault, nil
+	case i.GetSpec().EJBCA != nil:
+		return IssuerEJBCA, nil
+	case i.GetSpec().StepCA != nil:
+		return IssuerStepCA, nil
+	case i.GetSpec().KubernetesCSR != nil:
+		return IssuerKubernetesCSR, nil
+	case i.GetSpec().SPIRE != nil:
+		return IssuerSPIRE, nil
+	case i.GetSpec().Plugin != nil:
+		return IssuerPlugin, nil
 	}
 	return "", fmt.Errorf("no issuer specified for Issuer '%s/%s'", i.GetObjectMeta().Namespace, i.GetObjectMeta().Name)
 }
@@ -61,3 +94,16 @@ func IssuerKind(ref cmmeta.ObjectReference) string {
 	}
 	return ref.Kind
 }
+
+// CertificateRequestReferencesIssuer returns true if the CertificateRequest's
+// issuerRef refers to the given Issuer or ClusterIssuer.
+func CertificateRequestReferencesIssuer(cr *cmapi.CertificateRequest, iss cmapi.GenericIssuer) bool {
+	ref := cr.Spec.IssuerRef
+	if ref.Name != iss.GetName() || (ref.Group != "" && ref.Group != certmanager.GroupName) {
+		return false
+	}
+	if iss.GetNamespace() == "" {
+		return ref.Kind == cmapi.ClusterIssuerKind
+	}
+	return IssuerKind(ref) == cmapi.IssuerKind && cr.Namespace == iss.GetNamespace()
+}
@@ -19,6 +19,7 @@ package util
 import (
 	"crypto/x509"
 	"math/bits"
+	"sort"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
@@ -112,3 +113,17 @@ func extKeyUsageString(usage x509.ExtKeyUsage) cmapi.KeyUsage {
 
 	return "unknown"
 }
+
+// KeyUsageNames returns the names of all known key usages and extended key
+// usages, sorted alphabetically.
+func KeyUsageNames() []cmapi.KeyUsage {
+	names := make([]cmapi.KeyUsage, 0, len(keyUsages)+len(extKeyUsages))
+	for u := range keyUsages {
+		names = append(names, u)
+	}
+	for u := range extKeyUsages {
+		names = append(names, u)
+	}
+	sort.Slice(names, func(i, j int) bool { return names[i] < names[j] })
+	return names
+}
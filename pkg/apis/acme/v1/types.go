@@ -34,6 +34,15 @@ const (
 	// solver for each ingress class.
 	ACMECertificateHTTP01IngressClassOverride = "acme.cert-manager.io/http01-override-ingress-class"
 
+	// ACMECertificateAccountEmailAnnotationKey is an annotation that selects
+	// which of an ACME issuer's accounts is used to obtain a certificate.
+	// If this annotation is specified on a Certificate or Order resource, its
+	// value must match the email of one of the issuer's
+	// spec.acme.additionalAccounts. Orders and Challenges will then be
+	// created and solved using that account rather than the issuer's primary
+	// account, so that messages from the CA reach the given contact.
+	ACMECertificateAccountEmailAnnotationKey = "acme.cert-manager.io/account-email"
+
 	// IngressEditInPlaceAnnotationKey is used to toggle the use of ingressClass instead
 	// of ingress on the created Certificate resource
 	IngressEditInPlaceAnnotationKey = "acme.cert-manager.io/http01-edit-in-place"
@@ -143,4 +143,11 @@ type ChallengeStatus struct {
 	// If not set, the state of the challenge is unknown.
 	// +optional
 	State State `json:"state,omitempty"`
+
+	// RetryAfter is the time before which the ACME server has asked
+	// cert-manager not to retry requests for this challenge, for example
+	// because of a rate limit. It is set from the Retry-After header of
+	// the ACME server's response and cleared once it has passed.
+	// +optional
+	RetryAfter *metav1.Time `json:"retryAfter,omitempty"`
 }
@@ -56,6 +56,14 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ClusterIssuerList{},
 		&CertificateRequest{},
 		&CertificateRequestList{},
+		&Bundle{},
+		&BundleList{},
+		&CertificateProtectionPolicy{},
+		&CertificateProtectionPolicyList{},
+		&CertificateRequestPolicy{},
+		&CertificateRequestPolicyList{},
+		&CredentialGrant{},
+		&CredentialGrantList{},
 	)
 	metav1.AddToGroupVersion(scheme, SchemeGroupVersion)
 	return nil
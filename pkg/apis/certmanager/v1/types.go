@@ -30,12 +30,23 @@ const (
 	// Annotation key for certificate common name.
 	CommonNameAnnotationKey = "cert-manager.io/common-name"
 
+	// Annotation key for the time at which the certificate expires, in RFC3339
+	// format.
+	ExpiresAtAnnotationKey = "cert-manager.io/expires-at"
+
+	// Annotation key for the time at which cert-manager will attempt to renew
+	// the certificate, in RFC3339 format.
+	RenewalAtAnnotationKey = "cert-manager.io/renewal-at"
+
 	// Duration key for certificate duration.
 	DurationAnnotationKey = "cert-manager.io/duration"
 
 	// Annotation key for certificate renewBefore.
 	RenewBeforeAnnotationKey = "cert-manager.io/renew-before"
 
+	// Annotation key for certificate renewBeforePercentage.
+	RenewBeforePercentageAnnotationKey = "cert-manager.io/renew-before-percentage"
+
 	// Annotation key for certificate key usages.
 	UsagesAnnotationKey = "cert-manager.io/usages"
 
@@ -59,6 +70,50 @@ const (
 	// Minimum value is 1.
 	// If unset all CertificateRequests will be kept.
 	RevisionHistoryLimitAnnotationKey = "cert-manager.io/revision-history-limit"
+
+	// Annotation key used to explain why a resource protected by a
+	// CertificateProtectionPolicy is being deleted. Protected resources can
+	// only be deleted once this annotation has been set to a non-empty value.
+	DeletionJustificationAnnotationKey = "cert-manager.io/deletion-justification"
+
+	// Annotation key used to record a deleted Certificate on the Secret it
+	// was stored in, so that the Certificate can be restored. The value is
+	// the JSON encoded name, labels, annotations and spec of the Certificate.
+	RetiredCertificateAnnotationKey = "cert-manager.io/retired-certificate"
+
+	// Annotation key used to record the time at which the Certificate that a
+	// Secret was stored in was deleted, in RFC3339 format. Retired Secrets are
+	// deleted once the soft-delete retention period has passed.
+	RetiredAtAnnotationKey = "cert-manager.io/retired-at"
+
+	// Annotation key used to record the Certificate that a replicated Secret
+	// was copied from, as `<namespace>/<name>`.
+	SecretReplicaOfAnnotationKey = "cert-manager.io/secret-replica-of"
+
+	// Label key used to denote that a Secret is a replica of a Certificate's
+	// Secret in another namespace.
+	IsSecretReplicaLabelKey = "cert-manager.io/secret-replica"
+
+	// Annotation key set on a Namespace to allow the Secrets of Certificates
+	// in other namespaces to be replicated into it. The value is a comma
+	// separated list of namespaces, or `*` to allow all namespaces.
+	AllowSecretReplicationFromAnnotationKey = "cert-manager.io/allow-secret-replication-from"
+
+	// Annotation key set on a Certificate to suspend its reconciliation when
+	// set to "true". A paused Certificate is not renewed or re-issued, even
+	// if its spec changes, until the annotation is removed.
+	CertificatePausedAnnotationKey = "cert-manager.io/paused"
+
+	// Label key set on the ConfigMaps that a Bundle is synced into, holding
+	// the name of the Bundle.
+	BundleLabelKey = "cert-manager.io/bundle"
+)
+
+const (
+	// CertificateSoftDeleteFinalizer is added to Certificates when the
+	// CertificateSoftDelete feature is enabled, so that their Secret can be
+	// retired before the Certificate is removed.
+	CertificateSoftDeleteFinalizer = "cert-manager.io/soft-delete"
 )
 
 const (
@@ -90,6 +145,38 @@ const (
 
 	// Annotation to declare the CertificateRequest "revision", belonging to a Certificate Resource
 	CertificateRequestRevisionAnnotationKey = "cert-manager.io/certificate-revision"
+
+	// Annotation added to CertificateRequest resources created for a canary
+	// renewal of a Certificate. Its value is the renewal time, in RFC3339
+	// format, that the canary issuance is testing ahead of.
+	CertificateRequestCanaryRenewalAnnotationKey = "cert-manager.io/canary-renewal"
+
+	// Annotation added to CertificateRequest resources to request the
+	// signature algorithm used by the CA and SelfSigned issuers to sign the
+	// certificate, e.g. `SHA384WithRSA`. It is copied from the
+	// Certificate's `spec.signatureAlgorithm`.
+	CertificateRequestSignatureAlgorithmAnnotationKey = "cert-manager.io/signature-algorithm"
+
+	// Annotation added to CertificateRequest resources to list the OIDs,
+	// separated by commas, of the extensions in the CSR that the CA and
+	// SelfSigned issuers copy into the certificate. It is set from the
+	// Certificate's `spec.extraExtensions`.
+	CertificateRequestExtraExtensionsAnnotationKey = "cert-manager.io/extra-extensions"
+
+	// Annotations added to CertificateRequest resources to list the
+	// certificate policy and extended key usage OIDs, separated by commas,
+	// that the CA and SelfSigned issuers set on the certificate. They are set
+	// from the Certificate's `spec.certificatePolicies` and
+	// `spec.extendedKeyUsageOIDs`.
+	CertificateRequestCertificatePoliciesAnnotationKey  = "cert-manager.io/certificate-policies"
+	CertificateRequestExtendedKeyUsageOIDsAnnotationKey = "cert-manager.io/extended-key-usage-oids"
+
+	// Annotation added to a CertificateRequest to revoke the certificate that
+	// was issued for it. Its value is the RFC 5280 reason for the revocation,
+	// e.g. `keyCompromise`, or empty for `unspecified`. Unlike other
+	// cert-manager annotations, it may be added after the CertificateRequest
+	// has been created, but cannot be changed or removed once set.
+	CertificateRequestRevokeAnnotationKey = "cert-manager.io/revoke"
 )
 
 const (
@@ -107,6 +194,11 @@ const (
 	IssuerKind             = "Issuer"
 	CertificateKind        = "Certificate"
 	CertificateRequestKind = "CertificateRequest"
+
+	BundleKind                      = "Bundle"
+	CertificateProtectionPolicyKind = "CertificateProtectionPolicy"
+	CertificateRequestPolicyKind    = "CertificateRequestPolicy"
+	CredentialGrantKind             = "CredentialGrant"
 )
 
 const (
@@ -134,6 +226,21 @@ const (
 	AllowsInjectionFromSecretAnnotation = "cert-manager.io/allow-direct-injection"
 )
 
+// Keystore data entries written to a Certificate's target Secret.
+const (
+	// PKCS12SecretKey is the name of the data entry in the Secret resource
+	// used to store the p12 file.
+	PKCS12SecretKey = "keystore.p12"
+	// Data Entry Name in the Secret resource for PKCS12 containing Certificate Authority
+	PKCS12TruststoreKey = "truststore.p12"
+
+	// JKSSecretKey is the name of the data entry in the Secret resource
+	// used to store the jks file.
+	JKSSecretKey = "keystore.jks"
+	// Data Entry Name in the Secret resource for JKS containing Certificate Authority
+	JKSTruststoreKey = "truststore.jks"
+)
+
 // Issuer specific Annotations
 const (
 	// VenafiCustomFieldsAnnotationKey is the annotation that passes on JSON encoded custom fields to the Venafi issuer
@@ -146,6 +253,37 @@ const (
 	// Venafi Pickup ID of a certificate signing request that has been submitted
 	// to the Venafi API for collection later.
 	VenafiPickupIDAnnotationKey = "venafi.cert-manager.io/pickup-id"
+
+	// AWSPCACertificateArnAnnotationKey is the annotation key used to record
+	// the ARN of a certificate that has been requested from an AWS Private CA
+	// so that it can be collected once it has been issued.
+	AWSPCACertificateArnAnnotationKey = "awspca.cert-manager.io/certificate-arn"
+
+	// GoogleCASCertificateTemplateAnnotationKey is the annotation key used to
+	// override the certificate template of a Google CA Service issuer for a
+	// single CertificateRequest. The value is the full resource name of the
+	// certificate template.
+	GoogleCASCertificateTemplateAnnotationKey = "googlecas.cert-manager.io/certificate-template"
+
+	// GoogleCASLabelAnnotationPrefix is the prefix of annotations on a
+	// CertificateRequest that are passed on as labels of the certificate
+	// created in Google CA Service. For example the annotation
+	// `labels.googlecas.cert-manager.io/team: payments` results in the label
+	// `team: payments`.
+	GoogleCASLabelAnnotationPrefix = "labels.googlecas.cert-manager.io/"
+
+	// KubernetesCSRNameAnnotationKey is the annotation key used to record the
+	// name of the Kubernetes CertificateSigningRequest created for a
+	// CertificateRequest so that the certificate can be collected once the
+	// CertificateSigningRequest has been approved and signed.
+	KubernetesCSRNameAnnotationKey = "kubernetescsr.cert-manager.io/csr-name"
+
+	// KubernetesCSRRequestNamespaceAnnotationKey and
+	// KubernetesCSRRequestNameAnnotationKey are the annotation keys used to
+	// record the CertificateRequest a Kubernetes CertificateSigningRequest was
+	// created for, so that approvers can trace it back to its origin.
+	KubernetesCSRRequestNamespaceAnnotationKey = "kubernetescsr.cert-manager.io/certificate-request-namespace"
+	KubernetesCSRRequestNameAnnotationKey      = "kubernetescsr.cert-manager.io/certificate-request-name"
 )
 
 // KeyUsage specifies valid usage contexts for keys.
@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// A Bundle is a trust bundle which concatenates the CA certificates of its
+// sources, and syncs them into a ConfigMap in each namespace it targets.
+// The ConfigMaps are named after the Bundle, and are updated whenever one of
+// the sources changes, for example when a CA is rotated.
+type Bundle struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the Bundle resource.
+	Spec BundleSpec `json:"spec"`
+
+	// Status of the Bundle. This is set and managed automatically.
+	// +optional
+	Status BundleStatus `json:"status,omitempty"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BundleList is a list of Bundles
+type BundleList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []Bundle `json:"items"`
+}
+
+// BundleSpec defines the sources of the CA certificates of a Bundle, and
+// the namespaces they are synced into.
+type BundleSpec struct {
+	// Sources are the sources of the CA certificates in the bundle.
+	// +kubebuilder:validation:MinItems=1
+	Sources []BundleSource `json:"sources"`
+
+	// Target is the ConfigMap the bundle is synced into in each selected
+	// namespace.
+	Target BundleTarget `json:"target"`
+}
+
+// BundleSource is a source of CA certificates. Exactly one field must be
+// set.
+// Only the PEM encoded certificates of a source are added to the bundle,
+// and a source which contains anything else is not valid.
+type BundleSource struct {
+	// ConfigMap is a key of a ConfigMap in the cluster resource namespace.
+	// +optional
+	ConfigMap *BundleSourceKeySelector `json:"configMap,omitempty"`
+
+	// Secret is a key of a Secret in the cluster resource namespace.
+	// +optional
+	Secret *BundleSourceKeySelector `json:"secret,omitempty"`
+
+	// Issuer is a CA issuer, whose root CA certificate is added to the
+	// bundle.
+	// +optional
+	Issuer *BundleSourceIssuerRef `json:"issuer,omitempty"`
+
+	// InLine is a PEM encoded bundle of CA certificates.
+	// +optional
+	InLine *string `json:"inLine,omitempty"`
+}
+
+// BundleSourceKeySelector selects a key of a ConfigMap or Secret.
+type BundleSourceKeySelector struct {
+	// Name of the ConfigMap or Secret.
+	Name string `json:"name"`
+
+	// Key of the ConfigMap or Secret holding the PEM encoded certificates.
+	Key string `json:"key"`
+}
+
+// BundleSourceIssuerRef references a CA Issuer or ClusterIssuer.
+type BundleSourceIssuerRef struct {
+	// Name of the issuer.
+	Name string `json:"name"`
+
+	// Kind of the issuer, either `Issuer` or `ClusterIssuer`. Defaults to
+	// `ClusterIssuer`.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Namespace of the Issuer. Must be set if and only if the kind is
+	// `Issuer`.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// BundleTarget defines the ConfigMaps a Bundle is synced into.
+type BundleTarget struct {
+	// ConfigMap is the ConfigMap, named after the Bundle, which the bundle is
+	// synced into.
+	ConfigMap BundleTargetConfigMap `json:"configMap"`
+
+	// NamespaceSelector selects the namespaces the bundle is synced into.
+	// If not set, the bundle is synced into all namespaces.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// BundleTargetConfigMap defines the ConfigMap a Bundle is synced into.
+type BundleTargetConfigMap struct {
+	// Key of the ConfigMap the bundle is written to.
+	Key string `json:"key"`
+}
+
+// BundleStatus defines the observed state of a Bundle.
+type BundleStatus struct {
+	// List of status conditions to indicate the status of a Bundle.
+	// Known condition types are `Synced`.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []BundleCondition `json:"conditions,omitempty"`
+}
+
+// BundleCondition contains condition information for a Bundle.
+type BundleCondition struct {
+	// Type of the condition, known values are (`Synced`).
+	Type BundleConditionType `json:"type"`
+
+	// Status of the condition, one of (`True`, `False`, `Unknown`).
+	Status cmmeta.ConditionStatus `json:"status"`
+
+	// LastTransitionTime is the timestamp corresponding to the last status
+	// change of this condition.
+	// +optional
+	LastTransitionTime *metav1.Time `json:"lastTransitionTime,omitempty"`
+
+	// Reason is a brief machine readable explanation for the condition's last
+	// transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human readable description of the details of the last
+	// transition, complementing reason.
+	// +optional
+	Message string `json:"message,omitempty"`
+
+	// If set, this represents the .metadata.generation that the condition was
+	// set based upon.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+}
+
+// BundleConditionType represents a Bundle condition value.
+type BundleConditionType string
+
+const (
+	// BundleConditionSynced indicates that the bundle has been built from all
+	// of its sources and synced into all of the namespaces it targets.
+	BundleConditionSynced BundleConditionType = "Synced"
+)
@@ -83,6 +83,23 @@ const (
 	PKCS8 PrivateKeyEncoding = "PKCS8"
 )
 
+// SignatureAlgorithm is the algorithm used to sign a CSR or certificate.
+// +kubebuilder:validation:Enum=SHA256WithRSA;SHA384WithRSA;SHA512WithRSA;SHA256WithRSAPSS;SHA384WithRSAPSS;SHA512WithRSAPSS;ECDSAWithSHA256;ECDSAWithSHA384;ECDSAWithSHA512;PureEd25519
+type SignatureAlgorithm string
+
+const (
+	SHA256WithRSA    SignatureAlgorithm = "SHA256WithRSA"
+	SHA384WithRSA    SignatureAlgorithm = "SHA384WithRSA"
+	SHA512WithRSA    SignatureAlgorithm = "SHA512WithRSA"
+	SHA256WithRSAPSS SignatureAlgorithm = "SHA256WithRSAPSS"
+	SHA384WithRSAPSS SignatureAlgorithm = "SHA384WithRSAPSS"
+	SHA512WithRSAPSS SignatureAlgorithm = "SHA512WithRSAPSS"
+	ECDSAWithSHA256  SignatureAlgorithm = "ECDSAWithSHA256"
+	ECDSAWithSHA384  SignatureAlgorithm = "ECDSAWithSHA384"
+	ECDSAWithSHA512  SignatureAlgorithm = "ECDSAWithSHA512"
+	PureEd25519      SignatureAlgorithm = "PureEd25519"
+)
+
 // CertificateSpec defines the desired state of Certificate.
 // A valid Certificate requires at least one of a CommonName, DNSName, or
 // URISAN to be valid.
@@ -184,6 +201,16 @@ type CertificateSpec struct {
 	// +optional
 	EncodeUsagesInRequest *bool `json:"encodeUsagesInRequest,omitempty"`
 
+	// SignatureAlgorithm overrides the signature algorithm that is otherwise
+	// chosen based on the private key algorithm and size. It is used to sign
+	// the CSR, and is honoured by the CA and SelfSigned issuers when signing
+	// the certificate. Other issuers may ignore it.
+	// It must be compatible with the private key algorithm, e.g.
+	// `ECDSAWithSHA384` requires an `ECDSA` private key. The RSA-PSS
+	// algorithms require an `RSA` private key.
+	// +optional
+	SignatureAlgorithm SignatureAlgorithm `json:"signatureAlgorithm,omitempty"`
+
 	// revisionHistoryLimit is the maximum number of CertificateRequest revisions
 	// that are maintained in the Certificate's history. Each revision represents
 	// a single `CertificateRequest` created by this Certificate, either when it
@@ -195,6 +222,17 @@ type CertificateSpec struct {
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"` // Validated by the validating webhook.
 
+	// revisionHistoryTTL is the maximum age of the CertificateRequest
+	// revisions that are maintained in the Certificate's history. Revisions
+	// created longer ago than this are removed, apart from the latest revision
+	// which is always kept. If both revisionHistoryLimit and revisionHistoryTTL
+	// are set, revisions are removed once they exceed either of them. If unset
+	// (`nil`), the default configured on the controller with
+	// `--default-revision-history-ttl` is used, and revisions are not removed
+	// by age if that is also unset.
+	// +optional
+	RevisionHistoryTTL *metav1.Duration `json:"revisionHistoryTTL,omitempty"`
+
 	// AdditionalOutputFormats defines extra output formats of the private key
 	// and signed certificate chain to be written to this Certificate's target
 	// Secret. This is an Alpha Feature and is only enabled with the
@@ -202,6 +240,129 @@ type CertificateSpec struct {
 	// the controller and webhook components.
 	// +optional
 	AdditionalOutputFormats []CertificateAdditionalOutputFormat `json:"additionalOutputFormats,omitempty"`
+
+	// CanaryRenewal enables a trial issuance ahead of each renewal. Some days
+	// before the Certificate is due to be renewed, a CertificateRequest is
+	// created and its result is stored in a shadow Secret named
+	// `<secretName>-canary`, leaving the Secret in `secretName` untouched.
+	// The outcome is reported by the `CanaryRenewal` condition so problems
+	// with the issuer or policy can be fixed before the real renewal.
+	// +optional
+	CanaryRenewal bool `json:"canaryRenewal,omitempty"`
+
+	// MaxPathLen sets the pathLenConstraint of the basicConstraints extension
+	// of a CA certificate, i.e. the maximum number of intermediate CAs that may
+	// follow it in a certificate chain. A value of 0 means that the CA may only
+	// issue end-entity certificates. It may only be set when isCA is true, and
+	// is honoured by the CA and SelfSigned issuers. The CA issuer rejects path
+	// lengths that are not shorter than that of its own CA certificate, and
+	// defaults to the longest path length it allows. Otherwise, if unset, the
+	// path length is not constrained.
+	// +optional
+	MaxPathLen *int32 `json:"maxPathLen,omitempty"`
+
+	// NameConstraints sets the x509 nameConstraints extension of a CA
+	// certificate, restricting the names that certificates issued by this CA
+	// may contain. It may only be set when isCA is true, and is honoured by the
+	// CA and SelfSigned issuers.
+	// More info: https://datatracker.ietf.org/doc/html/rfc5280#section-4.2.1.10
+	// +optional
+	NameConstraints *NameConstraints `json:"nameConstraints,omitempty"`
+
+	// FallbackIssuerRefs is an ordered list of issuers to request this
+	// certificate from when issuance from `issuerRef` repeatedly fails, so that
+	// the certificate can still be issued during an outage of the primary CA.
+	// After every `failoverAttempts` consecutive failed issuances the next issuer
+	// in the list is used, wrapping back around to `issuerRef` once all of them
+	// have failed. Issuance starts from `issuerRef` again once it has succeeded.
+	// Issuers are referenced in the same way as `issuerRef`.
+	// +optional
+	FallbackIssuerRefs []cmmeta.ObjectReference `json:"fallbackIssuerRefs,omitempty"`
+
+	// FailoverAttempts is the number of consecutive failed issuances after
+	// which the next issuer in `fallbackIssuerRefs` is used. Defaults to 2.
+	// Minimum value is 1.
+	// +optional
+	FailoverAttempts *int32 `json:"failoverAttempts,omitempty"`
+
+	// RenewalJitter is the maximum amount of time by which the renewal of
+	// this certificate is brought forward. Each certificate is renewed up to
+	// this long before its renewal time, by an amount that is random but
+	// stable for the certificate, so that certificates issued together do
+	// not all renew at the same time. If unset, the controller's default
+	// renewal jitter is used, which is zero unless configured.
+	// +optional
+	RenewalJitter *metav1.Duration `json:"renewalJitter,omitempty"`
+
+	// RenewBeforePercentage is like `renewBefore`, except it is a percentage
+	// of the issued certificate's duration rather than an absolute duration.
+	// For example, a value of 33 causes the certificate to be renewed once a
+	// third of its lifetime remains, regardless of the duration the issuer
+	// actually granted. Value must be greater than 0 and less than 100.
+	// Cannot be set together with `renewBefore`.
+	// +optional
+	RenewBeforePercentage *int32 `json:"renewBeforePercentage,omitempty"`
+
+	// SecretTargets configures other namespaces that the Secret named by
+	// `secretName` is replicated into. Replicas have the same name, data and
+	// type as the Secret, and are kept up to date as the certificate is renewed.
+	// Replicas are deleted once their namespace is no longer targeted, or the
+	// Certificate is deleted.
+	// A namespace only accepts replicas from namespaces listed in its
+	// `cert-manager.io/allow-secret-replication-from` annotation.
+	// +optional
+	SecretTargets *CertificateSecretTargets `json:"secretTargets,omitempty"`
+
+	// ExternalSecretStores is a list of secret stores outside of the cluster
+	// that the certificate, private key and CA are published to each time the
+	// certificate is issued, in addition to the Secret named by `secretName`.
+	// This allows workloads outside of the cluster to share the same PKI.
+	// The certificate is not marked as issued until it has been published to
+	// every store.
+	// +optional
+	ExternalSecretStores []CertificateExternalSecretStore `json:"externalSecretStores,omitempty"`
+
+	// OtherNames is a list of otherName subjectAltNames to be set on the
+	// Certificate, e.g. a Microsoft User Principal Name (UPN) with the OID
+	// `1.3.6.1.4.1.311.20.2.3` for certificates used for Active Directory
+	// smartcard or client authentication.
+	// +optional
+	OtherNames []OtherName `json:"otherNames,omitempty"`
+
+	// ExtraExtensions is a list of additional X.509 extensions to be requested
+	// in the CSR, such as QC statements or TCG extensions that cert-manager has
+	// no dedicated field for. The CA and SelfSigned issuers copy them into the
+	// signed certificate; other issuers may ignore them.
+	// Extensions that cert-manager sets itself, such as subjectAltName,
+	// keyUsage or basicConstraints, cannot be specified.
+	// +optional
+	ExtraExtensions []CertificateExtension `json:"extraExtensions,omitempty"`
+
+	// CertificatePolicies is a list of certificate policy OIDs in dotted decimal
+	// notation, e.g. `2.23.140.1.2.1`, to be set in the certificatePolicies
+	// extension of the Certificate (RFC 5280, section 4.2.1.4).
+	// +optional
+	CertificatePolicies []string `json:"certificatePolicies,omitempty"`
+
+	// ExtendedKeyUsageOIDs is a list of extended key usage OIDs in dotted
+	// decimal notation to be requested in addition to those named by `usages`,
+	// for organization-specific purposes that have no named usage.
+	// +optional
+	ExtendedKeyUsageOIDs []string `json:"extendedKeyUsageOIDs,omitempty"`
+
+	// PrivateKeyEncryption configures envelope encryption of the private key
+	// stored in the `tls.key` key of the Secret. If set, the private key is
+	// encrypted with a random data key which is itself encrypted by a key
+	// management service, and `tls.key` holds an `ENVELOPE ENCRYPTED PRIVATE
+	// KEY` PEM block that workloads must decrypt before use, e.g. using the
+	// `cert-manager-decrypt-key` init container.
+	// Only the private key in the Certificate's Secret is encrypted; the
+	// temporary Secret holding the next private key, the Secret of canary
+	// issuances and external secret stores receive the unencrypted key.
+	// Requires `privateKey.rotationPolicy` to be `Always`, and cannot be
+	// combined with `keystores` or `additionalOutputFormats`.
+	// +optional
+	PrivateKeyEncryption *CertificatePrivateKeyEncryption `json:"privateKeyEncryption,omitempty"`
 }
 
 // CertificatePrivateKey contains configuration options for private keys
@@ -247,6 +408,84 @@ type CertificatePrivateKey struct {
 	// No other values are allowed.
 	// +optional
 	Size int `json:"size,omitempty"` // Validated by webhook. Be mindful of adding OpenAPI validation- see https://github.com/cert-manager/cert-manager/issues/3644
+
+	// KeyRotationInterval is the maximum length of time that a private key
+	// is used for. Once the private key of the current certificate is older
+	// than this, the certificate is re-issued with a new private key, even if
+	// it is not yet due to be renewed. It may only be set when rotationPolicy
+	// is Always. If unset, private keys are only rotated when the certificate
+	// is renewed.
+	// +optional
+	KeyRotationInterval *metav1.Duration `json:"keyRotationInterval,omitempty"`
+
+	// Backend configures an external signer, such as a key management service,
+	// that holds the private key of this certificate. If set, cert-manager does
+	// not generate a private key; the CSR is instead signed by the external
+	// signer, and the `tls.key` key of the Secret holds an `EXTERNAL PRIVATE
+	// KEY` PEM block which references the key and contains its public key.
+	// The private key is never stored in a Secret.
+	// The key must already exist and match `algorithm` and `size`. Requires
+	// `rotationPolicy` to be `Never`, and cannot be combined with the `Ed25519`
+	// algorithm, `privateKeyEncryption`, `keystores` or
+	// `additionalOutputFormats`. Temporary certificates are not issued for
+	// Certificates with an external private key, and the SelfSigned issuer
+	// cannot sign them.
+	// +optional
+	Backend *PrivateKeyBackend `json:"backend,omitempty"`
+}
+
+// PrivateKeyBackend configures the external signer holding the private key
+// of a Certificate.
+// Exactly one of `awsKMS` or `gcpKMS` must be specified.
+// Credentials are read from Secrets in the namespace of the Certificate.
+type PrivateKeyBackend struct {
+	// AWSKMS signs using an asymmetric AWS KMS key.
+	// +optional
+	AWSKMS *AWSKMSPrivateKeyBackend `json:"awsKMS,omitempty"`
+
+	// GCPKMS signs using an asymmetric Google Cloud KMS key version.
+	// +optional
+	GCPKMS *GCPKMSPrivateKeyBackend `json:"gcpKMS,omitempty"`
+}
+
+// AWSKMSPrivateKeyBackend holds a private key in AWS KMS. The key must have
+// the `SIGN_VERIFY` key usage.
+type AWSKMSPrivateKeyBackend struct {
+	// Region is the AWS region of the key.
+	Region string `json:"region"`
+
+	// KeyID is the ID, ARN, alias name or alias ARN of the key.
+	KeyID string `json:"keyId"`
+
+	// Role is the ARN of a role which is assumed using the credentials in
+	// Auth, or the ambient credentials if Auth is not set, before using the
+	// key.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Auth configures static credentials used to authenticate with AWS.
+	// If not set, ambient credentials are used, which is only permitted if the
+	// controller is started with --issuer-ambient-credentials.
+	// +optional
+	Auth *AWSPCAAuth `json:"auth,omitempty"`
+}
+
+// GCPKMSPrivateKeyBackend holds a private key in Google Cloud KMS. The key
+// must have the `ASYMMETRIC_SIGN` purpose. The key version fixes the
+// signature algorithm, so the signature algorithm of the Certificate must
+// match it, which may require setting `signatureAlgorithm`.
+type GCPKMSPrivateKeyBackend struct {
+	// KeyVersionName is the full resource name of the key version, e.g:
+	// "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key/cryptoKeyVersions/1".
+	KeyVersionName string `json:"keyVersionName"`
+
+	// ServiceAccountKeySecretRef is a reference to a key in a Secret that
+	// contains a Google Cloud service account JSON key. If not set, ambient
+	// credentials such as GKE workload identity are used, which is only
+	// permitted if the controller is started with
+	// --issuer-ambient-credentials.
+	// +optional
+	ServiceAccountKeySecretRef *cmmeta.SecretKeySelector `json:"serviceAccountKeySecretRef,omitempty"`
 }
 
 // Denotes how private keys should be generated or sourced when a Certificate
@@ -267,13 +506,19 @@ var (
 
 // CertificateOutputFormatType specifies which additional output formats should
 // be written to the Certificate's target Secret.
-// Allowed values are `DER` or `CombinedPEM`.
+// Allowed values are `DER`, `CombinedPEM`, `DERCertificate` or `PKCS7`.
 // When Type is set to `DER` an additional entry `key.der` will be written to
 // the Secret, containing the binary format of the private key.
 // When Type is set to `CombinedPEM` an additional entry `tls-combined.pem`
 // will be written to the Secret, containing the PEM formatted private key and
 // signed certificate chain (tls.key + tls.crt concatenated).
-// +kubebuilder:validation:Enum=DER;CombinedPEM
+// When Type is set to `DERCertificate` an additional entry `tls.der` will be
+// written to the Secret, containing the binary format of the signed
+// certificate.
+// When Type is set to `PKCS7` an additional entry `tls.p7b` will be written to
+// the Secret, containing the signed certificate chain as a binary PKCS#7
+// bundle.
+// +kubebuilder:validation:Enum=DER;CombinedPEM;DERCertificate;PKCS7
 type CertificateOutputFormatType string
 
 const (
@@ -296,6 +541,24 @@ const (
 	// character, followed by the chain of signed certificate PEM documents
 	// (`<private key> + \n + <signed certificate chain>`).
 	CertificateOutputFormatCombinedPEM CertificateOutputFormatType = "CombinedPEM"
+
+	// CertificateOutputFormatDERCertificateKey is the name of the data entry in
+	// the Secret resource used to store the DER formatted signed certificate.
+	CertificateOutputFormatDERCertificateKey string = "tls.der"
+
+	// CertificateOutputFormatDERCertificate writes the Certificate's signed
+	// certificate, without the rest of its chain, in DER binary format to the
+	// `tls.der` target Secret Data key.
+	CertificateOutputFormatDERCertificate CertificateOutputFormatType = "DERCertificate"
+
+	// CertificateOutputFormatPKCS7Key is the name of the data entry in the
+	// Secret resource used to store the PKCS#7 certificate chain bundle.
+	CertificateOutputFormatPKCS7Key string = "tls.p7b"
+
+	// CertificateOutputFormatPKCS7 writes the Certificate's signed certificate
+	// chain as a DER encoded, certs-only PKCS#7 bundle to the `tls.p7b` target
+	// Secret Data key.
+	CertificateOutputFormatPKCS7 CertificateOutputFormatType = "PKCS7"
 )
 
 // CertificateAdditionalOutputFormat defines an additional output format of a
@@ -305,6 +568,13 @@ type CertificateAdditionalOutputFormat struct {
 	// Type is the name of the format type that should be written to the
 	// Certificate's target Secret.
 	Type CertificateOutputFormatType `json:"type"`
+
+	// Key is the name of the data entry in the Certificate's target Secret
+	// that the format is written to. Defaults to the data entry documented for
+	// the format type, for example `key.der` for `DER`. Must not be one of the
+	// data entries used for the certificate, private key, CA or keystores.
+	// +optional
+	Key string `json:"key,omitempty"`
 }
 
 // X509Subject Full X509 name specification
@@ -335,6 +605,77 @@ type X509Subject struct {
 	SerialNumber string `json:"serialNumber,omitempty"`
 }
 
+// OtherName is an otherName subjectAltName (RFC 5280, section 4.2.1.6)
+// whose value is a UTF8String.
+type OtherName struct {
+	// OID is the object identifier of the otherName type in dotted decimal
+	// notation, e.g. `1.3.6.1.4.1.311.20.2.3` for a UPN.
+	OID string `json:"oid"`
+
+	// UTF8Value is the value of the otherName, which is encoded as a
+	// UTF8String, e.g. `user@example.com` for a UPN.
+	UTF8Value string `json:"utf8Value"`
+}
+
+// CertificateExtension is an X.509 extension, RFC 5280, section 4.2, whose
+// value is given as DER.
+type CertificateExtension struct {
+	// OID is the object identifier of the extension in dotted decimal notation,
+	// e.g. `1.3.6.1.5.5.7.1.3` for QC statements.
+	OID string `json:"oid"`
+
+	// Critical marks the extension as critical, meaning that relying parties
+	// which do not recognise it must reject the certificate.
+	// +optional
+	Critical bool `json:"critical,omitempty"`
+
+	// Value is the DER encoded value of the extension (base64-encoded).
+	Value []byte `json:"value"`
+}
+
+// NameConstraints is a type to represent the x509 nameConstraints extension.
+type NameConstraints struct {
+	// If true then the nameConstraints extension is marked as critical.
+	// +optional
+	Critical bool `json:"critical,omitempty"`
+
+	// Permitted contains the names which certificates issued by the CA
+	// are allowed to contain.
+	// +optional
+	Permitted *NameConstraintItem `json:"permitted,omitempty"`
+
+	// Excluded contains the names which certificates issued by the CA
+	// are not allowed to contain. Excluded names take precedence over
+	// permitted ones.
+	// +optional
+	Excluded *NameConstraintItem `json:"excluded,omitempty"`
+}
+
+// NameConstraintItem is a set of names of each type to which a name
+// constraint applies.
+type NameConstraintItem struct {
+	// DNSDomains is a list of DNS domains. A domain matches itself and all
+	// of its subdomains.
+	// +optional
+	DNSDomains []string `json:"dnsDomains,omitempty"`
+
+	// IPRanges is a list of IP address ranges in CIDR notation, for example
+	// `10.0.0.0/8` or `2001:db8::/32`.
+	// +optional
+	IPRanges []string `json:"ipRanges,omitempty"`
+
+	// EmailAddresses is a list of email addresses, domains (matching all
+	// mailboxes on exactly that host) or domains prefixed with a `.` (matching
+	// all mailboxes on its subdomains).
+	// +optional
+	EmailAddresses []string `json:"emailAddresses,omitempty"`
+
+	// URIDomains is a list of domains which the host part of URIs must
+	// match. A domain prefixed with a `.` matches only its subdomains.
+	// +optional
+	URIDomains []string `json:"uriDomains,omitempty"`
+}
+
 // CertificateKeystores configures additional keystore output formats to be
 // created in the Certificate's output Secret.
 type CertificateKeystores struct {
@@ -514,6 +855,43 @@ const (
 	//
 	// It will be removed by the 'issuing' controller upon completing issuance.
 	CertificateConditionIssuing CertificateConditionType = "Issuing"
+
+	// CertificateConditionCanaryRenewal indicates whether the most recent
+	// canary issuance for a Certificate with `spec.canaryRenewal` set
+	// succeeded. It is managed by the 'certificates-canary' controller.
+	CertificateConditionCanaryRenewal CertificateConditionType = "CanaryRenewal"
+
+	// CertificateConditionSecretDrift indicates that the Certificate's Secret
+	// has diverged from the Certificate in a way that cannot be explained by
+	// an issuance, e.g. because it was edited or restored from a backup.
+	// It is managed by the 'certificates-secret-drift' controller.
+	CertificateConditionSecretDrift CertificateConditionType = "SecretDrift"
+
+	// CertificateConditionRevoked indicates that the certificate stored in
+	// the Certificate's Secret has been revoked by its issuer, as reported
+	// by an OCSP responder or CRL listed in the certificate.
+	// It is managed by the 'certificates-revocation-status' controller, which
+	// also triggers a re-issuance of revoked certificates.
+	CertificateConditionRevoked CertificateConditionType = "Revoked"
+
+	// CertificateConditionCertificateTransparency indicates whether the
+	// current certificate, issued by an ACME issuer, has the Signed
+	// Certificate Timestamps (SCTs) required by the Certificate Transparency
+	// policies of browsers. It is managed by the 'certificates-issuing'
+	// controller when SCT verification is configured.
+	CertificateConditionCertificateTransparency CertificateConditionType = "CertificateTransparency"
+
+	// CertificateConditionPolicyViolation indicates that the Certificate
+	// violates the policy of the zone of the Venafi issuer it references, so
+	// no CertificateRequest will be created for it until it is corrected.
+	// It is managed by the 'certificates-request-manager' controller when the
+	// VenafiPolicyPreValidation feature gate is enabled.
+	CertificateConditionPolicyViolation CertificateConditionType = "PolicyViolation"
+
+	// CertificateConditionPaused indicates that reconciliation of the
+	// Certificate has been suspended by the `cert-manager.io/paused`
+	// annotation. It is managed by the 'certificates-readiness' controller.
+	CertificateConditionPaused CertificateConditionType = "Paused"
 )
 
 // CertificateSecretTemplate defines the default labels and annotations
@@ -527,3 +905,203 @@ type CertificateSecretTemplate struct {
 	// +optional
 	Labels map[string]string `json:"labels,omitempty"`
 }
+
+// CertificateSecretTargets selects the namespaces that a Certificate's
+// Secret is replicated into. A namespace is targeted if it is listed in
+// `namespaces` or matches `namespaceSelector`.
+type CertificateSecretTargets struct {
+	// Namespaces is a list of namespaces that the Secret is replicated into.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector selects namespaces that the Secret is replicated into
+	// by their labels.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+}
+
+// CertificateExternalSecretStore configures a secret store outside of the
+// cluster that a Certificate is published to.
+// Exactly one of `vault`, `awsSecretsManager` or `gcpSecretManager` must be
+// specified.
+// Credentials are read from Secrets in the namespace of the Certificate.
+type CertificateExternalSecretStore struct {
+	// Vault publishes the certificate to a HashiCorp Vault KV version 2
+	// secrets engine.
+	// +optional
+	Vault *VaultSecretStore `json:"vault,omitempty"`
+
+	// AWSSecretsManager publishes the certificate to AWS Secrets Manager.
+	// +optional
+	AWSSecretsManager *AWSSecretsManagerSecretStore `json:"awsSecretsManager,omitempty"`
+
+	// GCPSecretManager publishes the certificate to Google Cloud Secret
+	// Manager.
+	// +optional
+	GCPSecretManager *GCPSecretManagerSecretStore `json:"gcpSecretManager,omitempty"`
+}
+
+// VaultSecretStore publishes a certificate to a secret in a HashiCorp Vault
+// KV version 2 secrets engine. The secret holds the `tls.crt`, `tls.key`
+// and `ca.crt` keys, and a new version of it is written each time the
+// certificate is issued.
+type VaultSecretStore struct {
+	// Server is the connection address for the Vault server, e.g:
+	// "https://vault.example.com:8200".
+	Server string `json:"server"`
+
+	// Mount is the path that the KV version 2 secrets engine is mounted at,
+	// e.g: "secret".
+	Mount string `json:"mount"`
+
+	// Path is the path of the secret within the secrets engine, e.g:
+	// "my-app/tls".
+	Path string `json:"path"`
+
+	// Name of the Vault Enterprise namespace that the secrets engine belongs
+	// to, e.g: "ns1".
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PEM-encoded CA bundle (base64-encoded) used to validate Vault server
+	// certificate. If not set the system root certificates are used to
+	// validate the TLS connection.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how cert-manager authenticates with the Vault server.
+	Auth VaultAuth `json:"auth"`
+}
+
+// AWSSecretsManagerSecretStore publishes a certificate to a secret in AWS
+// Secrets Manager. The secret string is a JSON object holding the
+// `tls.crt`, `tls.key` and `ca.crt` keys, and a new version of it is
+// written each time the certificate is issued.
+type AWSSecretsManagerSecretStore struct {
+	// Region is the AWS region of the secret.
+	Region string `json:"region"`
+
+	// SecretID is the name or ARN of the secret. If no secret with the given
+	// name exists, it is created.
+	SecretID string `json:"secretId"`
+
+	// Role is the ARN of a role which is assumed using the credentials in
+	// Auth, or the ambient credentials if Auth is not set, before writing the
+	// secret.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Auth configures static credentials used to authenticate with AWS.
+	// If not set, ambient credentials are used, which is only permitted if the
+	// controller is started with --issuer-ambient-credentials.
+	// +optional
+	Auth *AWSPCAAuth `json:"auth,omitempty"`
+}
+
+// GCPSecretManagerSecretStore publishes a certificate to a secret in Google
+// Cloud Secret Manager. The secret payload is a JSON object holding the
+// `tls.crt`, `tls.key` and `ca.crt` keys, and a new version of it is
+// added each time the certificate is issued.
+type GCPSecretManagerSecretStore struct {
+	// Project is the ID of the Google Cloud project that the secret belongs
+	// to.
+	Project string `json:"project"`
+
+	// SecretID is the ID of the secret. If the secret does not exist, it is
+	// created with automatic replication.
+	SecretID string `json:"secretId"`
+
+	// ServiceAccountKeySecretRef is a reference to a key in a Secret that
+	// contains a Google Cloud service account JSON key. If not set, ambient
+	// credentials such as GKE workload identity are used, which is only
+	// permitted if the controller is started with
+	// --issuer-ambient-credentials.
+	// +optional
+	ServiceAccountKeySecretRef *cmmeta.SecretKeySelector `json:"serviceAccountKeySecretRef,omitempty"`
+}
+
+// CertificatePrivateKeyEncryption configures the key management service that
+// encrypts the data key used to encrypt a Certificate's private key.
+// Exactly one of `awsKMS`, `gcpKMS` or `vaultTransit` must be specified.
+// Credentials are read from Secrets in the namespace of the Certificate.
+type CertificatePrivateKeyEncryption struct {
+	// AWSKMS encrypts the data key with an AWS KMS key.
+	// +optional
+	AWSKMS *AWSKMSKeyEncryption `json:"awsKMS,omitempty"`
+
+	// GCPKMS encrypts the data key with a Google Cloud KMS key.
+	// +optional
+	GCPKMS *GCPKMSKeyEncryption `json:"gcpKMS,omitempty"`
+
+	// VaultTransit encrypts the data key with a key of a HashiCorp Vault
+	// transit secrets engine.
+	// +optional
+	VaultTransit *VaultTransitKeyEncryption `json:"vaultTransit,omitempty"`
+}
+
+// AWSKMSKeyEncryption encrypts data keys with a symmetric AWS KMS key.
+type AWSKMSKeyEncryption struct {
+	// Region is the AWS region of the key.
+	Region string `json:"region"`
+
+	// KeyID is the ID, ARN, alias name or alias ARN of the key.
+	KeyID string `json:"keyId"`
+
+	// Role is the ARN of a role which is assumed using the credentials in
+	// Auth, or the ambient credentials if Auth is not set, before using the
+	// key.
+	// +optional
+	Role string `json:"role,omitempty"`
+
+	// Auth configures static credentials used to authenticate with AWS.
+	// If not set, ambient credentials are used, which is only permitted if the
+	// controller is started with --issuer-ambient-credentials.
+	// +optional
+	Auth *AWSPCAAuth `json:"auth,omitempty"`
+}
+
+// GCPKMSKeyEncryption encrypts data keys with a symmetric Google Cloud KMS
+// key.
+type GCPKMSKeyEncryption struct {
+	// KeyName is the full resource name of the key, e.g:
+	// "projects/my-project/locations/global/keyRings/my-ring/cryptoKeys/my-key".
+	KeyName string `json:"keyName"`
+
+	// ServiceAccountKeySecretRef is a reference to a key in a Secret that
+	// contains a Google Cloud service account JSON key. If not set, ambient
+	// credentials such as GKE workload identity are used, which is only
+	// permitted if the controller is started with
+	// --issuer-ambient-credentials.
+	// +optional
+	ServiceAccountKeySecretRef *cmmeta.SecretKeySelector `json:"serviceAccountKeySecretRef,omitempty"`
+}
+
+// VaultTransitKeyEncryption encrypts data keys with a key of a HashiCorp
+// Vault transit secrets engine.
+type VaultTransitKeyEncryption struct {
+	// Server is the connection address for the Vault server, e.g:
+	// "https://vault.example.com:8200".
+	Server string `json:"server"`
+
+	// Mount is the path that the transit secrets engine is mounted at, e.g:
+	// "transit".
+	Mount string `json:"mount"`
+
+	// KeyName is the name of the encryption key in the transit secrets
+	// engine.
+	KeyName string `json:"keyName"`
+
+	// Name of the Vault Enterprise namespace that the secrets engine belongs
+	// to, e.g: "ns1".
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PEM-encoded CA bundle (base64-encoded) used to validate Vault server
+	// certificate. If not set the system root certificates are used to
+	// validate the TLS connection.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Auth configures how cert-manager authenticates with the Vault server.
+	Auth VaultAuth `json:"auth"`
+}
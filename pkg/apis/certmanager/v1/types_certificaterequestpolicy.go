@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +k8s:openapi-gen=true
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+// +kubebuilder:storageversion
+
+// A CertificateRequestPolicy decides whether the CertificateRequests for the
+// issuers it selects are approved, using CEL expressions evaluated over the
+// decoded certificate signing request and the requester.
+// A CertificateRequest is approved by the cert-manager approver if any policy
+// selecting it approves it, and denied if it is selected by policies which
+// all deny it. CertificateRequests which are not selected by any policy are
+// always approved.
+type CertificateRequestPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Desired state of the CertificateRequestPolicy resource.
+	Spec CertificateRequestPolicySpec `json:"spec"`
+}
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// CertificateRequestPolicyList is a list of CertificateRequestPolicies
+type CertificateRequestPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata"`
+
+	Items []CertificateRequestPolicy `json:"items"`
+}
+
+// CertificateRequestPolicySpec defines the CertificateRequests a policy
+// applies to, and the rules they must satisfy to be approved.
+type CertificateRequestPolicySpec struct {
+	// Selector selects the CertificateRequests this policy applies to.
+	Selector CertificateRequestPolicySelector `json:"selector"`
+
+	// Rules are the rules a CertificateRequest must all satisfy to be
+	// approved by this policy.
+	// +kubebuilder:validation:MinItems=1
+	Rules []CertificateRequestPolicyRule `json:"rules"`
+}
+
+// CertificateRequestPolicySelector selects CertificateRequests by the issuer
+// they reference. Requests are not selected by namespace, so that a policy
+// cannot be bypassed by requesting a certificate from another namespace;
+// rules can restrict `request.namespace` instead.
+type CertificateRequestPolicySelector struct {
+	// IssuerRef selects CertificateRequests referencing a matching issuer.
+	IssuerRef CertificateRequestPolicyIssuerRef `json:"issuerRef"`
+}
+
+// CertificateRequestPolicyIssuerRef matches the issuer referenced by a
+// CertificateRequest. Fields which are not set match any value, and values
+// may contain `*` wildcards matching any number of characters.
+type CertificateRequestPolicyIssuerRef struct {
+	// Name of the issuer.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Kind of the issuer, which is `Issuer` for CertificateRequests which do
+	// not set it.
+	// +optional
+	Kind string `json:"kind,omitempty"`
+
+	// Group of the issuer, which is `cert-manager.io` for
+	// CertificateRequests which do not set it.
+	// +optional
+	Group string `json:"group,omitempty"`
+}
+
+// CertificateRequestPolicyRule is a CEL expression which must evaluate to
+// true for a CertificateRequest to be approved.
+// Expressions can reference two variables:
+// `request`, holding the `name`, `namespace`, `username`, `groups`,
+// `serviceAccount` (with its `namespace` and `name`, which are empty unless
+// the request was created by a ServiceAccount), `issuerRef` (with its `name`,
+// `kind` and `group`), `isCA`, `duration` (in seconds) and `usages` of the
+// CertificateRequest; and `csr`, holding the `commonName`, `organizations`,
+// `dnsNames`, `ipAddresses`, `uris`, `emailAddresses`, `keyAlgorithm` (`RSA`,
+// `ECDSA` or `Ed25519`) and `keySize` (in bits) of its signing request.
+// The CEL standard library can be used, and the cost of evaluating an
+// expression is limited.
+type CertificateRequestPolicyRule struct {
+	// Name of the rule, which identifies it in the message of
+	// CertificateRequests that are denied.
+	Name string `json:"name"`
+
+	// Expression is the CEL expression evaluated for CertificateRequests,
+	// for example `csr.dnsNames.all(n, n.endsWith('.example.com'))`.
+	// CertificateRequests for which it fails to evaluate are denied.
+	// +kubebuilder:validation:MaxLength=4096
+	Expression string `json:"expression"`
+
+	// Message explains why CertificateRequests not satisfying the rule are
+	// denied. If not set, the expression is used.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
@@ -54,6 +54,13 @@ type WebhookConfiguration struct {
 	// Default: nil
 	// +optional
 	FeatureGates map[string]bool `json:"featureGates,omitempty"`
+
+	// logLevel is the verbosity of the webhook's logs, equivalent to the
+	// --v flag. If the webhook is started with a config file, changes to this
+	// field are applied without restarting the webhook, as are changes to
+	// feature gates that are safe to toggle at runtime.
+	// +optional
+	LogLevel *int32 `json:"logLevel,omitempty"`
 }
 
 // TLSConfig configures how TLS certificates are sourced for serving.
@@ -28,9 +28,13 @@ import (
 
 type CertmanagerV1Interface interface {
 	RESTClient() rest.Interface
+	BundlesGetter
 	CertificatesGetter
+	CertificateProtectionPoliciesGetter
 	CertificateRequestsGetter
+	CertificateRequestPoliciesGetter
 	ClusterIssuersGetter
+	CredentialGrantsGetter
 	IssuersGetter
 }
 
@@ -39,18 +43,34 @@ type CertmanagerV1Client struct {
 	restClient rest.Interface
 }
 
+func (c *CertmanagerV1Client) Bundles() BundleInterface {
+	return newBundles(c)
+}
+
 func (c *CertmanagerV1Client) Certificates(namespace string) CertificateInterface {
 	return newCertificates(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateProtectionPolicies() CertificateProtectionPolicyInterface {
+	return newCertificateProtectionPolicies(c)
+}
+
 func (c *CertmanagerV1Client) CertificateRequests(namespace string) CertificateRequestInterface {
 	return newCertificateRequests(c, namespace)
 }
 
+func (c *CertmanagerV1Client) CertificateRequestPolicies() CertificateRequestPolicyInterface {
+	return newCertificateRequestPolicies(c)
+}
+
 func (c *CertmanagerV1Client) ClusterIssuers() ClusterIssuerInterface {
 	return newClusterIssuers(c)
 }
 
+func (c *CertmanagerV1Client) CredentialGrants(namespace string) CredentialGrantInterface {
+	return newCredentialGrants(c, namespace)
+}
+
 func (c *CertmanagerV1Client) Issuers(namespace string) IssuerInterface {
 	return newIssuers(c, namespace)
 }
@@ -28,18 +28,34 @@ type FakeCertmanagerV1 struct {
 	*testing.Fake
 }
 
+func (c *FakeCertmanagerV1) Bundles() v1.BundleInterface {
+	return &FakeBundles{c}
+}
+
 func (c *FakeCertmanagerV1) Certificates(namespace string) v1.CertificateInterface {
 	return &FakeCertificates{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) CertificateProtectionPolicies() v1.CertificateProtectionPolicyInterface {
+	return &FakeCertificateProtectionPolicies{c}
+}
+
 func (c *FakeCertmanagerV1) CertificateRequests(namespace string) v1.CertificateRequestInterface {
 	return &FakeCertificateRequests{c, namespace}
 }
 
+func (c *FakeCertmanagerV1) CertificateRequestPolicies() v1.CertificateRequestPolicyInterface {
+	return &FakeCertificateRequestPolicies{c}
+}
+
 func (c *FakeCertmanagerV1) ClusterIssuers() v1.ClusterIssuerInterface {
 	return &FakeClusterIssuers{c}
 }
 
+func (c *FakeCertmanagerV1) CredentialGrants(namespace string) v1.CredentialGrantInterface {
+	return &FakeCredentialGrants{c, namespace}
+}
+
 func (c *FakeCertmanagerV1) Issuers(namespace string) v1.IssuerInterface {
 	return &FakeIssuers{c, namespace}
 }
@@ -18,10 +18,18 @@ limitations under the License.
 
 package v1
 
+type BundleExpansion interface{}
+
 type CertificateExpansion interface{}
 
+type CertificateProtectionPolicyExpansion interface{}
+
 type CertificateRequestExpansion interface{}
 
+type CertificateRequestPolicyExpansion interface{}
+
 type ClusterIssuerExpansion interface{}
 
+type CredentialGrantExpansion interface{}
+
 type IssuerExpansion interface{}
@@ -24,12 +24,20 @@ import (
 
 // Interface provides access to all the informers in this group version.
 type Interface interface {
+	// Bundles returns a BundleInformer.
+	Bundles() BundleInformer
 	// Certificates returns a CertificateInformer.
 	Certificates() CertificateInformer
+	// CertificateProtectionPolicies returns a CertificateProtectionPolicyInformer.
+	CertificateProtectionPolicies() CertificateProtectionPolicyInformer
 	// CertificateRequests returns a CertificateRequestInformer.
 	CertificateRequests() CertificateRequestInformer
+	// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+	CertificateRequestPolicies() CertificateRequestPolicyInformer
 	// ClusterIssuers returns a ClusterIssuerInformer.
 	ClusterIssuers() ClusterIssuerInformer
+	// CredentialGrants returns a CredentialGrantInformer.
+	CredentialGrants() CredentialGrantInformer
 	// Issuers returns a IssuerInformer.
 	Issuers() IssuerInformer
 }
@@ -45,21 +53,41 @@ func New(f internalinterfaces.SharedInformerFactory, namespace string, tweakList
 	return &version{factory: f, namespace: namespace, tweakListOptions: tweakListOptions}
 }
 
+// Bundles returns a BundleInformer.
+func (v *version) Bundles() BundleInformer {
+	return &bundleInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // Certificates returns a CertificateInformer.
 func (v *version) Certificates() CertificateInformer {
 	return &certificateInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificateProtectionPolicies returns a CertificateProtectionPolicyInformer.
+func (v *version) CertificateProtectionPolicies() CertificateProtectionPolicyInformer {
+	return &certificateProtectionPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // CertificateRequests returns a CertificateRequestInformer.
 func (v *version) CertificateRequests() CertificateRequestInformer {
 	return &certificateRequestInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
 }
 
+// CertificateRequestPolicies returns a CertificateRequestPolicyInformer.
+func (v *version) CertificateRequestPolicies() CertificateRequestPolicyInformer {
+	return &certificateRequestPolicyInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
+}
+
 // ClusterIssuers returns a ClusterIssuerInformer.
 func (v *version) ClusterIssuers() ClusterIssuerInformer {
 	return &clusterIssuerInformer{factory: v.factory, tweakListOptions: v.tweakListOptions}
 }
 
+// CredentialGrants returns a CredentialGrantInformer.
+func (v *version) CredentialGrants() CredentialGrantInformer {
+	return &credentialGrantInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
+}
+
 // Issuers returns a IssuerInformer.
 func (v *version) Issuers() IssuerInformer {
 	return &issuerInformer{factory: v.factory, namespace: v.namespace, tweakListOptions: v.tweakListOptions}
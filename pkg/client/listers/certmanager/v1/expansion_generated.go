@@ -18,6 +18,10 @@ limitations under the License.
 
 package v1
 
+// BundleListerExpansion allows custom methods to be added to
+// BundleLister.
+type BundleListerExpansion interface{}
+
 // CertificateListerExpansion allows custom methods to be added to
 // CertificateLister.
 type CertificateListerExpansion interface{}
@@ -26,6 +30,10 @@ type CertificateListerExpansion interface{}
 // CertificateNamespaceLister.
 type CertificateNamespaceListerExpansion interface{}
 
+// CertificateProtectionPolicyListerExpansion allows custom methods to be added to
+// CertificateProtectionPolicyLister.
+type CertificateProtectionPolicyListerExpansion interface{}
+
 // CertificateRequestListerExpansion allows custom methods to be added to
 // CertificateRequestLister.
 type CertificateRequestListerExpansion interface{}
@@ -34,10 +42,22 @@ type CertificateRequestListerExpansion interface{}
 // CertificateRequestNamespaceLister.
 type CertificateRequestNamespaceListerExpansion interface{}
 
+// CertificateRequestPolicyListerExpansion allows custom methods to be added to
+// CertificateRequestPolicyLister.
+type CertificateRequestPolicyListerExpansion interface{}
+
 // ClusterIssuerListerExpansion allows custom methods to be added to
 // ClusterIssuerLister.
 type ClusterIssuerListerExpansion interface{}
 
+// CredentialGrantListerExpansion allows custom methods to be added to
+// CredentialGrantLister.
+type CredentialGrantListerExpansion interface{}
+
+// CredentialGrantNamespaceListerExpansion allows custom methods to be added to
+// CredentialGrantNamespaceLister.
+type CredentialGrantNamespaceListerExpansion interface{}
+
 // IssuerListerExpansion allows custom methods to be added to
 // IssuerLister.
 type IssuerListerExpansion interface{}
@@ -20,13 +20,17 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"time"
 
 	acmeapi "golang.org/x/crypto/acme"
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -84,6 +88,16 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		return nil
 	}
 
+	// if the ACME server has asked us to back off, wait until the requested
+	// time has passed before talking to it again.
+	if ch.Status.RetryAfter != nil {
+		if c.clock.Now().Before(ch.Status.RetryAfter.Time) {
+			log.V(logf.DebugLevel).Info("Waiting until the time requested by the ACME server before retrying", "retryAfter", ch.Status.RetryAfter.Time)
+			return c.scheduleRetryAfter(ch)
+		}
+		ch.Status.RetryAfter = nil
+	}
+
 	// This finalizer ensures that the challenge is not garbage collected before
 	// cert-manager has a chance to clean up resources created for the
 	// challenge.
@@ -123,7 +137,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		return nil
 	}
 
-	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	cl, err := accounts.GetClientForAccount(c.accountRegistry, string(genericIssuer.GetUID()), ch.Annotations[cmacme.ACMECertificateAccountEmailAnnotationKey])
 	if err != nil {
 		return err
 	}
@@ -131,7 +145,7 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 	if ch.Status.State == "" {
 		err := c.syncChallengeStatus(ctx, cl, ch)
 		if err != nil {
-			return handleError(ch, err)
+			return c.handleError(ch, err)
 		}
 
 		// if the state has not changed, return an error
@@ -154,13 +168,18 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 		// Find out which identity the ACME server says it will use.
 		dir, err := cl.Discover(ctx)
 		if err != nil {
-			return handleError(ch, err)
+			return c.handleError(ch, err)
 		}
 		// TODO(dmo): figure out if missing CAA identity in directory
 		// means no CAA check is performed by ACME server or if any valid
 		// CAA would stop issuance (strongly suspect the former)
-		if len(dir.CAA) != 0 {
-			err := dnsutil.ValidateCAA(ch.Spec.DNSName, dir.CAA, ch.Spec.Wildcard, c.dns01Nameservers)
+		// CAA records only apply to domain names (RFC 8738, section 7)
+		if len(dir.CAA) != 0 && net.ParseIP(ch.Spec.DNSName) == nil {
+			nameservers := c.dns01Nameservers
+			if dns01 := ch.Spec.Solver.DNS01; dns01 != nil && dns01.CheckNameservers != nil && len(dns01.CheckNameservers.Nameservers) > 0 {
+				nameservers = dns01.CheckNameservers.Nameservers
+			}
+			err := dnsutil.ValidateCAA(ch.Spec.DNSName, dir.CAA, ch.Spec.Wildcard, nameservers)
 			if err != nil {
 				ch.Status.Reason = fmt.Sprintf("CAA self-check failed: %s", err)
 				return err
@@ -211,12 +230,20 @@ func (c *controller) Sync(ctx context.Context, chOriginal *cmacme.Challenge) (er
 
 // handleError will handle ACME error types, updating the challenge resource
 // with any new information found whilst inspecting the error response.
-// This may include marking the challenge as expired.
-func handleError(ch *cmacme.Challenge, err error) error {
+// This may include marking the challenge as expired, or recording the time
+// before which the ACME server has asked not to be contacted again.
+func (c *controller) handleError(ch *cmacme.Challenge, err error) error {
 	if err == nil {
 		return nil
 	}
 
+	if retryAfter, ok := acme.RetryAfter(err, c.clock.Now()); ok {
+		t := metav1.NewTime(retryAfter)
+		ch.Status.RetryAfter = &t
+		ch.Status.Reason = fmt.Sprintf("Waiting until %s before retrying as requested by the ACME server: %v", retryAfter.Format(time.RFC3339), err)
+		return c.scheduleRetryAfter(ch)
+	}
+
 	var acmeErr *acmeapi.Error
 	var ok bool
 	if acmeErr, ok = err.(*acmeapi.Error); !ok {
@@ -370,7 +397,7 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 	if err != nil {
 		log.Error(err, "error accepting challenge")
 		ch.Status.Reason = fmt.Sprintf("Error accepting challenge: %v", err)
-		return handleError(ch, err)
+		return c.handleError(ch, err)
 	}
 
 	log.V(logf.DebugLevel).Info("waiting for authorization for domain")
@@ -390,7 +417,7 @@ func (c *controller) acceptChallenge(ctx context.Context, cl acmecl.Interface, c
 func (c *controller) handleAuthorizationError(ch *cmacme.Challenge, err error) error {
 	authErr, ok := err.(*acmeapi.AuthorizationError)
 	if !ok {
-		return handleError(ch, err)
+		return c.handleError(ch, err)
 	}
 
 	// TODO: the AuthorizationError above could technically contain the final
@@ -408,6 +435,17 @@ func (c *controller) handleAuthorizationError(ch *cmacme.Challenge, err error) e
 	return nil
 }
 
+// scheduleRetryAfter re-queues the Challenge to be processed once the time
+// stored in its status.retryAfter field has passed.
+func (c *controller) scheduleRetryAfter(ch *cmacme.Challenge) error {
+	key, err := controllerpkg.KeyFunc(ch)
+	if err != nil {
+		return err
+	}
+	c.queue.AddAfter(key, ch.Status.RetryAfter.Time.Sub(c.clock.Now()))
+	return nil
+}
+
 func (c *controller) solverFor(challengeType cmacme.ACMEChallengeType) (solver, error) {
 	switch challengeType {
 	case cmacme.ACMEChallengeTypeHTTP01:
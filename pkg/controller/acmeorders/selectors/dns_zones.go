@@ -17,6 +17,8 @@ limitations under the License.
 package selectors
 
 import (
+	"net"
+
 	"github.com/miekg/dns"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -38,6 +40,11 @@ func (s *dnsZonesSelector) Matches(meta metav1.ObjectMeta, dnsName string) (bool
 		return true, 0
 	}
 
+	// IP addresses are not part of any DNS zone
+	if net.ParseIP(dnsName) != nil {
+		return false, 0
+	}
+
 	maxMatchingLabels := 0
 	for _, zone := range s.allowedDNSZones {
 		numMatchingLabels := dns.CompareDomainName(zone, dnsName)
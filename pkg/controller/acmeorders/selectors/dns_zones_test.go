@@ -58,6 +58,15 @@ func TestDNSZones(t *testing.T) {
 			matches: true,
 			score:   2,
 		},
+		{
+			name: "not matching an IP address with a zone",
+			selector: cmacme.CertificateDNSNameSelector{
+				DNSZones: []string{"0.1"},
+			},
+			dnsName: "10.0.0.1",
+			matches: false,
+			score:   0,
+		},
 	}
 
 	for _, test := range tests {
@@ -33,15 +33,18 @@ import (
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalorders "github.com/cert-manager/cert-manager/internal/controller/orders"
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
@@ -55,6 +58,14 @@ var (
 	RequeuePeriod time.Duration = time.Second * 5
 )
 
+// MaxConcurrentRequests is the maximum number of requests made concurrently
+// when fetching authorizations from the ACME server, or creating and deleting
+// Challenge resources, for a single Order.
+// Orders for certificates with many subject alternative names would otherwise
+// take a long time to progress, as each identifier requires its own requests.
+// TODO: make this configurable
+const MaxConcurrentRequests = 10
+
 func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	log := logf.FromContext(ctx)
 	dbg := log.V(logf.DebugLevel)
@@ -81,7 +92,7 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	if err != nil {
 		return fmt.Errorf("error reading (cluster)issuer %q: %v", o.Spec.IssuerRef.Name, err)
 	}
-	cl, err := c.accountRegistry.GetClient(string(genericIssuer.GetUID()))
+	cl, err := accounts.GetClientForAccount(c.accountRegistry, string(genericIssuer.GetUID()), o.Annotations[cmacme.ACMECertificateAccountEmailAnnotationKey])
 	if err != nil {
 		return err
 	}
@@ -91,12 +102,24 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		log.V(logf.DebugLevel).Info("Doing nothing as Order is in a failed state")
 		// if the Order is failed there's nothing left for us to do, return nil
 		return nil
+	case o.Status.RetryAfter != nil && c.clock.Now().Before(o.Status.RetryAfter.Time):
+		log.V(logf.DebugLevel).Info("Waiting until the time requested by the ACME server before retrying", "retryAfter", o.Status.RetryAfter.Time)
+		return c.scheduleRetryAfter(o)
+	case o.Status.RetryAfter != nil:
+		// the Retry-After time has passed, so clear it and carry on syncing
+		o.Status.RetryAfter = nil
+	}
+
+	switch {
 	case o.Status.URL == "":
 		log.V(logf.DebugLevel).Info("Creating new ACME order as status.url is not set")
 		return c.createOrder(ctx, cl, o)
 	case o.Status.FinalizeURL == "":
 		log.V(logf.DebugLevel).Info("Updating Order status as status.finalizeURL is not set")
 		_, err := c.updateOrderStatus(ctx, cl, o)
+		if c.backoffFromRetryAfter(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -166,6 +189,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	// if the new code does not need this ACME order, try to place it above
 	// this call to avoid extra calls to ACME.
 	acmeOrder, err := getACMEOrder(ctx, cl, o)
+	if c.backoffFromRetryAfter(ctx, o, err) {
+		return nil
+	}
 	// Order probably has been deleted, we cannot recover here.
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
@@ -186,6 +212,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 		//  no way that we will attempt and continue the order anyway.
 		log.V(logf.DebugLevel).Info("Update Order status as at least one Challenge has failed")
 		_, err := c.updateOrderStatusFromACMEOrder(ctx, cl, o, acmeOrder)
+		if c.backoffFromRetryAfter(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -226,6 +255,9 @@ func (c *controller) Sync(ctx context.Context, o *cmacme.Order) (err error) {
 	case !anyChallengesFailed(challenges) && allChallengesFinal(challenges):
 		log.V(logf.DebugLevel).Info("All challenges are in a final state, updating order state")
 		_, err := c.updateOrderStatusFromACMEOrder(ctx, cl, o, acmeOrder)
+		if c.backoffFromRetryAfter(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -268,6 +300,9 @@ func (c *controller) createOrder(ctx context.Context, cl acmecl.Interface, o *cm
 		options = append(options, acmeapi.WithOrderNotAfter(c.clock.Now().Add(o.Spec.Duration.Duration)))
 	}
 	acmeOrder, err := cl.AuthorizeOrder(ctx, authzIDs, options...)
+	if c.backoffFromRetryAfter(ctx, o, err) {
+		return nil
+	}
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to create Order resource due to bad request, marking Order as failed")
@@ -317,6 +352,38 @@ func (c *controller) updateOrderStatusFromACMEOrder(ctx context.Context, cl acme
 	return acmeOrder, nil
 }
 
+// backoffFromRetryAfter checks whether err is an ACME error response carrying
+// a Retry-After header, such as a rate limit error. If it is, the time is
+// recorded on the Order's status and the Order is scheduled to be processed
+// again once it has passed, and true is returned.
+func (c *controller) backoffFromRetryAfter(ctx context.Context, o *cmacme.Order, err error) bool {
+	retryAfter, ok := acme.RetryAfter(err, c.clock.Now())
+	if !ok {
+		return false
+	}
+
+	logf.FromContext(ctx).Error(err, "ACME server asked for the request to be retried later", "retryAfter", retryAfter)
+	t := metav1.NewTime(retryAfter)
+	o.Status.RetryAfter = &t
+	o.Status.Reason = fmt.Sprintf("Waiting until %s before retrying as requested by the ACME server: %v", retryAfter.Format(time.RFC3339), err)
+	if err := c.scheduleRetryAfter(o); err != nil {
+		logf.FromContext(ctx).Error(err, "failed to construct key for Order")
+	}
+
+	return true
+}
+
+// scheduleRetryAfter re-queues the Order to be processed once the time stored
+// in its status.retryAfter field has passed.
+func (c *controller) scheduleRetryAfter(o *cmacme.Order) error {
+	key, err := cache.MetaNamespaceKeyFunc(o)
+	if err != nil {
+		return err
+	}
+	c.scheduledWorkQueue.Add(key, o.Status.RetryAfter.Time.Sub(c.clock.Now()))
+	return nil
+}
+
 // setOrderState will set the 'State' field of the given Order to 's'.
 // It will set the Orders failureTime field if the state provided is classed as
 // a failure state.
@@ -353,13 +420,50 @@ func anyAuthorizationsMissingMetadata(o *cmacme.Order) bool {
 
 func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmacme.Order, cl acmecl.Interface) error {
 	log := logf.FromContext(ctx)
+
+	// Authorizations which are still valid on an earlier Order do not need
+	// to be fetched again.
+	reusable, err := c.reusableAuthorizations(o)
+	if err != nil {
+		return err
+	}
 	for i, authz := range o.Status.Authorizations {
+		if authz.Identifier != "" {
+			continue
+		}
+		if reused, ok := reusable[authz.URL]; ok {
+			log.V(logf.DebugLevel).Info("Reusing valid authorization from an earlier Order", "identifier", reused.Identifier, "order", reused.ReusedFrom)
+			o.Status.Authorizations[i] = reused
+		}
+	}
+
+	// Authorizations are fetched concurrently, but the responses are then
+	// handled in order so that the first error encountered is the one which
+	// is recorded on the Order.
+	acmeAuthzs := make([]*acmeapi.Authorization, len(o.Status.Authorizations))
+	errs := make([]error, len(o.Status.Authorizations))
+	workqueue.ParallelizeUntil(ctx, MaxConcurrentRequests, len(o.Status.Authorizations), func(i int) {
 		// only fetch metadata for each authorization once
+		if o.Status.Authorizations[i].Identifier != "" {
+			return
+		}
+		acmeAuthzs[i], errs[i] = cl.GetAuthorization(ctx, o.Status.Authorizations[i].URL)
+	})
+	// ParallelizeUntil stops processing authorizations if the context is
+	// cancelled, so not every authorization may have been fetched.
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	for i, authz := range o.Status.Authorizations {
 		if authz.Identifier != "" {
 			continue
 		}
 
-		acmeAuthz, err := cl.GetAuthorization(ctx, authz.URL)
+		acmeAuthz, err := acmeAuthzs[i], errs[i]
+		if c.backoffFromRetryAfter(ctx, o, err) {
+			return nil
+		}
 		if acmeErr, ok := err.(*acmeapi.Error); ok {
 			if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 				log.Error(err, "failed to fetch authorization metadata from acme server")
@@ -375,6 +479,10 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 		authz.InitialState = cmacme.State(acmeAuthz.Status)
 		authz.Identifier = acmeAuthz.Identifier.Value
 		authz.Wildcard = &acmeAuthz.Wildcard
+		if !acmeAuthz.Expires.IsZero() {
+			expires := metav1.NewTime(acmeAuthz.Expires)
+			authz.Expires = &expires
+		}
 		authz.Challenges = make([]cmacme.ACMEChallenge, len(acmeAuthz.Challenges))
 		for i, acmech := range acmeAuthz.Challenges {
 			authz.Challenges[i].URL = acmech.URI
@@ -386,6 +494,47 @@ func (c *controller) fetchMetadataForAuthorizations(ctx context.Context, o *cmac
 	return nil
 }
 
+// reusableAuthorizations returns the valid authorizations held by other
+// Orders for the same issuer in the Order's namespace, keyed by URL.
+// ACME servers may return the URL of an authorization that has already been
+// completed when a new order contains the same identifier, in which case the
+// authorization can be reused without fetching it again or solving a
+// challenge for it.
+// An authorization is only reused until the expiry time reported by the ACME
+// server, and each reused authorization records the name of the Order it was
+// copied from.
+func (c *controller) reusableAuthorizations(o *cmacme.Order) (map[string]cmacme.ACMEAuthorization, error) {
+	orders, err := c.orderLister.Orders(o.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil, err
+	}
+
+	now := c.clock.Now()
+	reusable := make(map[string]cmacme.ACMEAuthorization)
+	for _, other := range orders {
+		if other.Name == o.Name || other.Spec.IssuerRef != o.Spec.IssuerRef {
+			continue
+		}
+		for _, authz := range other.Status.Authorizations {
+			if authz.Identifier == "" || authz.Expires == nil || !now.Before(authz.Expires.Time) {
+				continue
+			}
+			// Every authorization on a valid Order has been completed.
+			if authz.InitialState != cmacme.Valid && other.Status.State != cmacme.Valid {
+				continue
+			}
+			if existing, ok := reusable[authz.URL]; ok && !existing.Expires.Before(authz.Expires) {
+				continue
+			}
+			authz = *authz.DeepCopy()
+			authz.InitialState = cmacme.Valid
+			authz.ReusedFrom = other.Name
+			reusable[authz.URL] = authz
+		}
+	}
+	return reusable, nil
+}
+
 func (c *controller) anyRequiredChallengesDoNotExist(requiredChallenges []cmacme.Challenge) (bool, error) {
 	for _, ch := range requiredChallenges {
 		_, err := c.challengeLister.Challenges(ch.Namespace).Get(ch.Name)
@@ -400,17 +549,28 @@ func (c *controller) anyRequiredChallengesDoNotExist(requiredChallenges []cmacme
 }
 
 func (c *controller) createRequiredChallenges(ctx context.Context, o *cmacme.Order, requiredChallenges []cmacme.Challenge) error {
-	for _, ch := range requiredChallenges {
-		_, err := c.cmClient.AcmeV1().Challenges(ch.Namespace).Create(ctx, &ch, metav1.CreateOptions{})
+	// Challenges are created concurrently so that they can all be scheduled
+	// and presented at the same time.
+	created := make([]bool, len(requiredChallenges))
+	errs := make([]error, len(requiredChallenges))
+	workqueue.ParallelizeUntil(ctx, MaxConcurrentRequests, len(requiredChallenges), func(i int) {
+		ch := &requiredChallenges[i]
+		_, err := c.cmClient.AcmeV1().Challenges(ch.Namespace).Create(ctx, ch, metav1.CreateOptions{})
 		if apierrors.IsAlreadyExists(err) {
-			continue
+			return
 		}
-		if err != nil {
-			return err
+		created[i], errs[i] = err == nil, err
+	})
+
+	for i, ch := range requiredChallenges {
+		if created[i] {
+			c.recorder.Eventf(o, corev1.EventTypeNormal, reasonCreated, "Created Challenge resource %q for domain %q", ch.Name, ch.Spec.DNSName)
 		}
-		c.recorder.Eventf(o, corev1.EventTypeNormal, reasonCreated, "Created Challenge resource %q for domain %q", ch.Name, ch.Spec.DNSName)
 	}
-	return nil
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return err
+	}
+	return ctx.Err()
 }
 
 func (c *controller) anyLeftoverChallengesExist(o *cmacme.Order, requiredChallenges []cmacme.Challenge) (bool, error) {
@@ -428,13 +588,7 @@ func (c *controller) deleteLeftoverChallenges(ctx context.Context, o *cmacme.Ord
 		return err
 	}
 
-	for _, ch := range leftover {
-		if err := c.cmClient.AcmeV1().Challenges(ch.Namespace).Delete(ctx, ch.Name, metav1.DeleteOptions{}); err != nil {
-			return err
-		}
-	}
-
-	return nil
+	return c.deleteChallenges(ctx, leftover)
 }
 
 func (c *controller) deleteAllChallenges(ctx context.Context, o *cmacme.Order) error {
@@ -443,13 +597,19 @@ func (c *controller) deleteAllChallenges(ctx context.Context, o *cmacme.Order) e
 		return err
 	}
 
-	for _, ch := range challenges {
-		if err := c.cmClient.AcmeV1().Challenges(ch.Namespace).Delete(ctx, ch.Name, metav1.DeleteOptions{}); err != nil {
-			return err
-		}
-	}
+	return c.deleteChallenges(ctx, challenges)
+}
 
-	return nil
+// deleteChallenges concurrently deletes the given Challenge resources.
+func (c *controller) deleteChallenges(ctx context.Context, challenges []*cmacme.Challenge) error {
+	errs := make([]error, len(challenges))
+	workqueue.ParallelizeUntil(ctx, MaxConcurrentRequests, len(challenges), func(i int) {
+		errs[i] = c.cmClient.AcmeV1().Challenges(challenges[i].Namespace).Delete(ctx, challenges[i].Name, metav1.DeleteOptions{})
+	})
+	if err := utilerrors.NewAggregate(errs); err != nil {
+		return err
+	}
+	return ctx.Err()
 }
 
 func (c *controller) determineLeftoverChallenges(o *cmacme.Order, requiredChallenges []cmacme.Challenge) ([]*cmacme.Challenge, error) {
@@ -512,6 +672,10 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	// Call to CreateOrderCert finalizes the ACME order. This call can only be made once.
 	certSlice, certURL, err := cl.CreateOrderCert(ctx, o.Status.FinalizeURL, derBytes, true)
 
+	if c.backoffFromRetryAfter(ctx, o, err) {
+		return nil
+	}
+
 	acmeErr, ok := err.(*acmeapi.Error)
 
 	// If finalizing the order returns a 403 error, the order may already be finalized.
@@ -522,6 +686,9 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	if ok && acmeErr.StatusCode == 403 {
 
 		acmeOrder, getOrderErr := getACMEOrder(ctx, cl, o)
+		if c.backoffFromRetryAfter(ctx, o, getOrderErr) {
+			return nil
+		}
 		acmeGetOrderErr, ok := getOrderErr.(*acmeapi.Error)
 		if ok && acmeGetOrderErr.StatusCode >= 400 && acmeGetOrderErr.StatusCode < 500 {
 			log.Error(err, "failed to retrieve the ACME order (4xx error) marking Order as failed")
@@ -551,6 +718,9 @@ func (c *controller) finalizeOrder(ctx context.Context, cl acmecl.Interface, o *
 	// Before checking whether the call to CreateOrderCert returned a
 	// non-4xx error, ensure the order status is up-to-date.
 	_, errUpdate := c.updateOrderStatus(ctx, cl, o)
+	if c.backoffFromRetryAfter(ctx, o, errUpdate) {
+		return nil
+	}
 	if acmeErr, ok := errUpdate.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -609,6 +779,9 @@ func (c *controller) storeCertificateOnStatus(ctx context.Context, o *cmacme.Ord
 func (c *controller) syncCertificateData(ctx context.Context, cl acmecl.Interface, o *cmacme.Order, issuer cmapi.GenericIssuer) error {
 	log := logf.FromContext(ctx)
 	acmeOrder, err := c.updateOrderStatus(ctx, cl, o)
+	if c.backoffFromRetryAfter(ctx, o, err) {
+		return nil
+	}
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to update Order status due to a 4xx error, marking Order as failed")
@@ -646,6 +819,9 @@ func (c *controller) syncCertificateDataWithOrder(ctx context.Context, cl acmecl
 
 	}
 	certs, err := cl.FetchCert(ctx, acmeOrder.CertURL, true)
+	if c.backoffFromRetryAfter(ctx, o, err) {
+		return nil
+	}
 	if acmeErr, ok := err.(*acmeapi.Error); ok {
 		if acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
 			log.Error(err, "failed to retrieve issued certificate from ACME server")
@@ -683,8 +859,16 @@ func getACMEOrder(ctx context.Context, cl acmecl.Interface, o *cmacme.Order) (*a
 	return acmeOrder, nil
 }
 
+// getAltCertChain returns the first alternative chain offered by the ACME
+// server that matches preferredChain. preferredChain is either the Common Name
+// of the issuing CA, or a SHA-256 fingerprint or Subject Key Identifier as
+// understood by pki.ParseChainSelector.
 func getAltCertChain(ctx context.Context, cl acmecl.Interface, certURL string, preferredChain string) (bool, [][]byte, error) {
 	log := logf.FromContext(ctx)
+	selector, err := pki.ParseChainSelector(preferredChain)
+	if err != nil {
+		return false, nil, fmt.Errorf("invalid preferred chain %q: %w", preferredChain, err)
+	}
 	altURLs, err := cl.ListCertAlternates(ctx, certURL)
 	if err != nil {
 		return false, nil, fmt.Errorf("error listing alternate certificate URLs: %w", err)
@@ -695,19 +879,20 @@ func getAltCertChain(ctx context.Context, cl acmecl.Interface, certURL string, p
 		if err != nil {
 			return false, nil, fmt.Errorf("error fetching alternate certificate chain from %s: %w", altURL, err)
 		}
-		// Loop over each cert in this alternative chain
+		certs := make([]*x509.Certificate, 0, len(altChain))
 		for _, altCert := range altChain {
 			cert, err := x509.ParseCertificate(altCert)
 			if err != nil {
 				return false, nil, fmt.Errorf("error parsing alternate certificate chain: %w", err)
 			}
 			log.V(logf.DebugLevel).WithValues("Issuer CN", cert.Issuer.CommonName).Info("Found alternative ACME bundle")
-			if cert.Issuer.CommonName == preferredChain {
-				// if the issuer's CN matched the preferred chain it means this bundle is
-				// signed by the requested chain
-				log.V(logf.DebugLevel).WithValues("Issuer CN", cert.Issuer.CommonName).Info("Selecting alternative ACME bundle with a matching Common Name from %s", altURL)
-				return true, altChain, nil
-			}
+			certs = append(certs, cert)
+		}
+		if selector.MatchesChain(certs) {
+			// if the chain matched the preferred chain it means this bundle is
+			// signed by the requested chain
+			log.V(logf.DebugLevel).WithValues("preferredChain", selector.String()).Info("Selecting matching alternative ACME bundle", "url", altURL)
+			return true, altChain, nil
 		}
 	}
 	return false, nil, nil
@@ -21,6 +21,9 @@ import (
 	"encoding/pem"
 	"errors"
 	"fmt"
+	"net/http"
+	"strings"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -28,12 +31,14 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/cache"
 	fakeclock "k8s.io/utils/clock/testing"
 
 	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
 	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmacmelisters "github.com/cert-manager/cert-manager/pkg/client/listers/acme/v1"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
 	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
@@ -139,6 +144,12 @@ func TestSync(t *testing.T) {
 		StatusCode: 429,
 		Detail:     "some error",
 	}
+	acmeError429RetryAfter := acmeapi.Error{
+		StatusCode: 429,
+		Detail:     "some error",
+		Header:     http.Header{"Retry-After": []string{"60"}},
+	}
+	retryAfterMetaTime := metav1.NewTime(nowTime.Add(time.Minute))
 	acmeError403 := acmeapi.Error{
 		StatusCode: 403,
 		Detail:     "some error",
@@ -161,6 +172,24 @@ dGVzdA==
 	testOrderReady := testOrderPending.DeepCopy()
 	testOrderReady.Status.State = cmacme.Ready
 
+	// an Order whose authorization metadata has not been fetched yet
+	testOrderUnfetched := gen.OrderFrom(testOrder, gen.SetOrderStatus(cmacme.OrderStatus{
+		State:          cmacme.Pending,
+		URL:            "http://testurl.com/abcde",
+		FinalizeURL:    "http://testurl.com/abcde/finalize",
+		Authorizations: []cmacme.ACMEAuthorization{{URL: "http://authzurl"}},
+	}))
+	authzExpires := metav1.NewTime(nowTime.Add(time.Hour))
+	testPreviousOrderValid := testOrderValid.DeepCopy()
+	testPreviousOrderValid.Name = "previousorder"
+	testPreviousOrderValid.Status.Authorizations[0].Expires = &authzExpires
+	testPreviousOrderExpired := testPreviousOrderValid.DeepCopy()
+	testPreviousOrderExpired.Status.Authorizations[0].Expires = &nowMetaTime
+	reusedAuthorization := *testPreviousOrderValid.Status.Authorizations[0].DeepCopy()
+	reusedAuthorization.InitialState = cmacme.Valid
+	reusedAuthorization.ReusedFrom = "previousorder"
+	notWildcard := false
+
 	testCert := []byte(`-----BEGIN CERTIFICATE-----
 MIIFjTCCA3WgAwIBAgIRANOxciY0IzLc9AUoUSrsnGowDQYJKoZIhvcNAQELBQAw
 TzELMAkGA1UEBhMCVVMxKTAnBgNVBAoTIEludGVybmV0IFNlY3VyaXR5IFJlc2Vh
@@ -330,6 +359,63 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 				},
 			},
 		},
+		"reuse a valid authorization from an earlier order instead of fetching it": {
+			order: testOrderUnfetched,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderUnfetched, testPreviousOrderValid},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderUnfetched.Namespace,
+						gen.OrderFrom(testOrderUnfetched, gen.SetOrderStatus(cmacme.OrderStatus{
+							State:          cmacme.Pending,
+							URL:            "http://testurl.com/abcde",
+							FinalizeURL:    "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{reusedAuthorization},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return nil, errors.New("unexpected request to fetch a reusable authorization")
+				},
+			},
+		},
+		"fetch an authorization if it has expired on an earlier order": {
+			order: testOrderUnfetched,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, testOrderUnfetched, testPreviousOrderExpired},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderUnfetched.Namespace,
+						gen.OrderFrom(testOrderUnfetched, gen.SetOrderStatus(cmacme.OrderStatus{
+							State:       cmacme.Pending,
+							URL:         "http://testurl.com/abcde",
+							FinalizeURL: "http://testurl.com/abcde/finalize",
+							Authorizations: []cmacme.ACMEAuthorization{
+								{
+									URL:          "http://authzurl",
+									Identifier:   "test.com",
+									Wildcard:     &notWildcard,
+									InitialState: cmacme.Pending,
+									Challenges: []cmacme.ACMEChallenge{
+										{
+											Token: "token",
+											Type:  "http-01",
+										},
+									},
+								},
+							},
+						})))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+					return testACMEAuthorizationPending, nil
+				},
+			},
+		},
 		"create a challenge resource for the test.com dnsName on the order": {
 			order: testOrderPending,
 			builder: &testpkg.Builder{
@@ -569,6 +655,41 @@ rUCGwbCUDI0mxadJ3Bz4WxR6fyNpBK2yAinWEsikxqEt
 				},
 			},
 		},
+		"call FinalizeOrder and wait until the Retry-After time if finalize fails with a Retry-After header": {
+			order: gen.OrderFrom(testOrderErroredWithDetail, gen.SetOrderState(cmacme.Ready)),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrderErroredWithDetail, gen.SetOrderState(cmacme.Ready))},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmacme.SchemeGroupVersion.WithResource("orders"),
+						"status",
+						testOrderErroredWithDetail.Namespace,
+						gen.OrderFrom(testOrderErroredWithDetail,
+							gen.SetOrderState(cmacme.Ready),
+							gen.SetOrderRetryAfter(retryAfterMetaTime),
+							gen.SetOrderReason(fmt.Sprintf("Waiting until %s before retrying as requested by the ACME server: 429 : some error", retryAfterMetaTime.Format(time.RFC3339))),
+						))),
+				},
+			},
+			acmeClient: &acmecl.FakeACME{
+				FakeCreateOrderCert: func(_ context.Context, url string, csr []byte, bundle bool) ([][]byte, string, error) {
+					return nil, "", &acmeError429RetryAfter
+				},
+				FakeHTTP01ChallengeResponse: func(s string) (string, error) {
+					// TODO: assert s = "token"
+					return "key", nil
+				},
+			},
+			shouldSchedule: true,
+		},
+		"do nothing and re-queue the order if the Retry-After time has not yet passed": {
+			order: gen.OrderFrom(testOrderPending, gen.SetOrderRetryAfter(retryAfterMetaTime)),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{testIssuerHTTP01TestCom, gen.OrderFrom(testOrderPending, gen.SetOrderRetryAfter(retryAfterMetaTime))},
+				ExpectedActions:    []testpkg.Action{},
+			},
+			acmeClient:     &acmecl.FakeACME{},
+			shouldSchedule: true,
+		},
 		"call FinalizeOrder, return error if finalize fails with an unspecified error": {
 			order: gen.OrderFrom(testOrderErroredWithDetail, gen.SetOrderState(cmacme.Ready)),
 			builder: &testpkg.Builder{
@@ -838,3 +959,51 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestFetchMetadataForAuthorizationsConcurrently(t *testing.T) {
+	const numAuthorizations = 50
+	order := &cmacme.Order{}
+	for i := 0; i < numAuthorizations; i++ {
+		order.Status.Authorizations = append(order.Status.Authorizations, cmacme.ACMEAuthorization{
+			URL: fmt.Sprintf("http://authzurl/%d", i),
+		})
+	}
+
+	var inFlight, maxInFlight int32
+	cl := &acmecl.FakeACME{
+		FakeGetAuthorization: func(ctx context.Context, url string) (*acmeapi.Authorization, error) {
+			n := atomic.AddInt32(&inFlight, 1)
+			defer atomic.AddInt32(&inFlight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInFlight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInFlight, max, n) {
+					break
+				}
+			}
+			time.Sleep(10 * time.Millisecond)
+			return &acmeapi.Authorization{
+				Status:     acmeapi.StatusPending,
+				Identifier: acmeapi.AuthzID{Type: "dns", Value: strings.TrimPrefix(url, "http://authzurl/") + ".example.com"},
+			}, nil
+		},
+	}
+
+	c := &controller{
+		orderLister: cmacmelisters.NewOrderLister(cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})),
+		clock:       fakeclock.NewFakeClock(time.Now()),
+	}
+	if err := c.fetchMetadataForAuthorizations(context.Background(), order, cl); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	for i, authz := range order.Status.Authorizations {
+		if expected := fmt.Sprintf("%d.example.com", i); authz.Identifier != expected {
+			t.Errorf("expected authorization %d to have identifier %q but got %q", i, expected, authz.Identifier)
+		}
+	}
+	if maxInFlight > MaxConcurrentRequests {
+		t.Errorf("expected at most %d concurrent requests but got %d", MaxConcurrentRequests, maxInFlight)
+	}
+	if maxInFlight < 2 {
+		t.Errorf("expected authorizations to be fetched concurrently")
+	}
+}
@@ -19,6 +19,7 @@ package acmeorders
 import (
 	"context"
 	"fmt"
+	"net"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 
@@ -68,14 +69,21 @@ func buildChallenge(ctx context.Context, cl acmecl.Interface, issuer cmapi.Gener
 		return nil, err
 	}
 
-	return &cmacme.Challenge{
+	ch := &cmacme.Challenge{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:            chName,
 			Namespace:       o.Namespace,
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(o, orderGvk)},
 		},
 		Spec: *chSpec,
-	}, nil
+	}
+	// The Challenge must be accepted using the same ACME account that
+	// created the Order.
+	if email, ok := o.Annotations[cmacme.ACMECertificateAccountEmailAnnotationKey]; ok {
+		ch.Annotations = map[string]string{cmacme.ACMECertificateAccountEmailAnnotationKey: email}
+	}
+
+	return ch, nil
 }
 
 func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, issuer cmapi.GenericIssuer, o *cmacme.Order, authz cmacme.ACMEAuthorization) (*cmacme.ChallengeSpec, error) {
@@ -100,12 +108,15 @@ func challengeSpecForAuthorization(ctx context.Context, cl acmecl.Interface, iss
 	selectedNumDNSNamesMatch := 0
 	selectedNumDNSZonesMatch := 0
 
+	// IP identifiers cannot be validated using DNS01 (RFC 8738, section 7)
+	isIP := net.ParseIP(authz.Identifier) != nil
+
 	challengeForSolver := func(solver *cmacme.ACMEChallengeSolver) *cmacme.ACMEChallenge {
 		for _, ch := range authz.Challenges {
 			switch {
 			case ch.Type == "http-01" && solver.HTTP01 != nil:
 				return &ch
-			case ch.Type == "dns-01" && solver.DNS01 != nil:
+			case ch.Type == "dns-01" && solver.DNS01 != nil && !isIP:
 				return &ch
 			}
 		}
@@ -310,6 +321,7 @@ func applyIngressParameterAnnotationOverrides(o *cmacme.Order, s *cmacme.ACMECha
 	// config
 	if hasManualIngressClass || hasManualIngressName {
 		s.HTTP01.Ingress.Class = nil
+		s.HTTP01.Ingress.IngressClassName = nil
 		s.HTTP01.Ingress.Name = ""
 	}
 	if hasManualIngressName {
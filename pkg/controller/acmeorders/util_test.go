@@ -371,6 +371,37 @@ func TestChallengeSpecForAuthorization(t *testing.T) {
 				Solver:  emptySelectorSolverDNS01,
 			},
 		},
+		"should not use DNS01 solver for an IP address identifier": {
+			acmeClient: basicACMEClient,
+			issuer: &v1.Issuer{
+				Spec: v1.IssuerSpec{
+					IssuerConfig: v1.IssuerConfig{
+						ACME: &cmacme.ACMEIssuer{
+							Solvers: []cmacme.ACMEChallengeSolver{
+								emptySelectorSolverDNS01,
+								emptySelectorSolverHTTP01,
+							},
+						},
+					},
+				},
+			},
+			order: &cmacme.Order{
+				Spec: cmacme.OrderSpec{
+					IPAddresses: []string{"10.0.0.1"},
+				},
+			},
+			authz: &cmacme.ACMEAuthorization{
+				Identifier: "10.0.0.1",
+				Challenges: []cmacme.ACMEChallenge{*acmeChallengeDNS01, *acmeChallengeHTTP01},
+			},
+			expectedChallengeSpec: &cmacme.ChallengeSpec{
+				Type:    cmacme.ACMEChallengeTypeHTTP01,
+				DNSName: "10.0.0.1",
+				Token:   acmeChallengeHTTP01.Token,
+				Key:     "http01",
+				Solver:  emptySelectorSolverHTTP01,
+			},
+		},
 		"should return an error if none match": {
 			acmeClient: basicACMEClient,
 			issuer: &v1.Issuer{
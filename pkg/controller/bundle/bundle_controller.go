@@ -0,0 +1,530 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/cert-manager/cert-manager/internal/trustbundle"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the name of the bundle controller.
+	ControllerName = "bundles"
+
+	reasonSynced         = "Synced"
+	reasonSourceError    = "SourceError"
+	reasonTargetConflict = "TargetConflict"
+)
+
+// This controller builds the trust bundle of each Bundle by concatenating
+// the CA certificates of its sources, and syncs it into a ConfigMap named
+// after the Bundle in each namespace selected by its target.
+// The ConfigMaps are owned by the Bundle, so that they are garbage collected
+// once it is deleted, and labelled with its name, so that those in namespaces
+// which are no longer selected can be found and deleted.
+//
+// ConfigMap and Secret sources are read from the cluster resource namespace,
+// as otherwise anyone able to create a Bundle could read from any namespace.
+type controller struct {
+	bundleLister        cmlisters.BundleLister
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	configMapLister     corelisters.ConfigMapLister
+	secretLister        corelisters.SecretLister
+	namespaceLister     corelisters.NamespaceLister
+	kubeClient          kubernetes.Interface
+	cmClient            cmclient.Interface
+	recorder            record.EventRecorder
+
+	// clusterResourceNamespace is the namespace ConfigMap and Secret sources,
+	// and the Secrets of ClusterIssuers, are read from.
+	clusterResourceNamespace string
+}
+
+// NewController returns a new bundle controller.
+func NewController(
+	log logr.Logger,
+	kubeClient kubernetes.Interface,
+	cmClient cmclient.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clusterResourceNamespace string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	bundleInformer := cmFactory.Certmanager().V1().Bundles()
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+	configMapsInformer := factory.Core().V1().ConfigMaps()
+	secretsInformer := factory.Core().V1().Secrets()
+	namespacesInformer := factory.Core().V1().Namespaces()
+
+	c := &controller{
+		bundleLister:             bundleInformer.Lister(),
+		issuerLister:             issuerInformer.Lister(),
+		clusterIssuerLister:      clusterIssuerInformer.Lister(),
+		configMapLister:          configMapsInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		namespaceLister:          namespacesInformer.Lister(),
+		kubeClient:               kubeClient,
+		cmClient:                 cmClient,
+		recorder:                 recorder,
+		clusterResourceNamespace: clusterResourceNamespace,
+	}
+
+	bundleInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// enqueueBundles returns a function which enqueues all Bundle resources
+	// which the predicate returns true for.
+	enqueueBundles := func(predicate func(bundle *cmapi.Bundle, obj metav1.Object) bool) func(obj interface{}) {
+		return func(obj interface{}) {
+			metaObj, ok := obj.(metav1.Object)
+			if !ok {
+				return
+			}
+			bundles, err := bundleInformer.Lister().List(labels.Everything())
+			if err != nil {
+				log.Error(err, "failed listing Bundle resources")
+				return
+			}
+			for _, bundle := range bundles {
+				if predicate(bundle, metaObj) {
+					queue.Add(bundle.Name)
+				}
+			}
+		}
+	}
+
+	// When a ConfigMap resource changes, enqueue the Bundle it is a target
+	// of, or the Bundles which may use it as a source.
+	enqueueBundlesForConfigMap := enqueueBundles(func(bundle *cmapi.Bundle, obj metav1.Object) bool {
+		return obj.GetNamespace() == clusterResourceNamespace && hasSource(bundle, func(source cmapi.BundleSource) bool {
+			return source.ConfigMap != nil && source.ConfigMap.Name == obj.GetName()
+		})
+	})
+	configMapsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			if metaObj, ok := obj.(metav1.Object); ok {
+				if name, ok := metaObj.GetLabels()[cmapi.BundleLabelKey]; ok {
+					queue.Add(name)
+				}
+			}
+			enqueueBundlesForConfigMap(obj)
+		},
+	})
+
+	// When a Secret resource changes, enqueue the Bundles which may use it as
+	// a source, either directly or as the Secret of a CA issuer.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueBundles(func(bundle *cmapi.Bundle, obj metav1.Object) bool {
+			return hasSource(bundle, func(source cmapi.BundleSource) bool {
+				switch {
+				case source.Secret != nil:
+					return obj.GetNamespace() == clusterResourceNamespace && source.Secret.Name == obj.GetName()
+				case source.Issuer != nil:
+					return obj.GetNamespace() == c.issuerNamespace(source.Issuer)
+				}
+				return false
+			})
+		}),
+	})
+
+	// When an Issuer or ClusterIssuer resource changes, enqueue the Bundles
+	// which use issuers as a source.
+	enqueueBundlesForIssuer := &controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueBundles(func(bundle *cmapi.Bundle, _ metav1.Object) bool {
+			return hasSource(bundle, func(source cmapi.BundleSource) bool {
+				return source.Issuer != nil
+			})
+		}),
+	}
+	issuerInformer.Informer().AddEventHandler(enqueueBundlesForIssuer)
+	clusterIssuerInformer.Informer().AddEventHandler(enqueueBundlesForIssuer)
+
+	// When a Namespace resource changes, it may have started or stopped being
+	// targeted, so enqueue all Bundle resources.
+	namespacesInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueBundles(func(*cmapi.Bundle, metav1.Object) bool {
+			return true
+		}),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		bundleInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+		clusterIssuerInformer.Informer().HasSynced,
+		configMapsInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		namespacesInformer.Informer().HasSynced,
+	}
+
+	return c, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Bundle to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	_, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	bundle, err := c.bundleLister.Get(name)
+	if apierrors.IsNotFound(err) {
+		// The ConfigMaps of deleted Bundles are garbage collected, as they are
+		// owned by the Bundle.
+		log.V(logf.DebugLevel).Info("bundle not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if bundle.DeletionTimestamp != nil {
+		return nil
+	}
+
+	data, err := c.buildBundle(bundle)
+	if err != nil {
+		// Sources are re-read whenever they change, so do not retry.
+		c.recorder.Eventf(bundle, corev1.EventTypeWarning, reasonSourceError, "Failed to build bundle: %v", err)
+		return c.setSyncedCondition(ctx, bundle, cmmeta.ConditionFalse, reasonSourceError, fmt.Sprintf("Failed to build bundle: %v", err))
+	}
+
+	targets, err := c.targetNamespaces(bundle)
+	if err != nil {
+		return err
+	}
+
+	var conflicts []string
+	for _, ns := range targets.List() {
+		synced, err := c.sync(ctx, bundle, ns, data)
+		if err != nil {
+			return err
+		}
+		if !synced {
+			conflicts = append(conflicts, ns)
+		}
+	}
+
+	if err := c.deleteStaleConfigMaps(ctx, bundle, targets); err != nil {
+		return err
+	}
+
+	if len(conflicts) > 0 {
+		message := fmt.Sprintf("Bundle is not synced into namespaces %s as a ConfigMap named %q which is not owned by this Bundle already exists",
+			strings.Join(conflicts, ", "), bundle.Name)
+		c.recorder.Event(bundle, corev1.EventTypeWarning, reasonTargetConflict, message)
+		return c.setSyncedCondition(ctx, bundle, cmmeta.ConditionFalse, reasonTargetConflict, message)
+	}
+
+	return c.setSyncedCondition(ctx, bundle, cmmeta.ConditionTrue, reasonSynced, fmt.Sprintf("Bundle is synced into %d namespaces", targets.Len()))
+}
+
+// buildBundle returns the PEM encoded certificates of all of the sources of
+// the Bundle.
+func (c *controller) buildBundle(bundle *cmapi.Bundle) (string, error) {
+	var certs []*x509.Certificate
+	for i, source := range bundle.Spec.Sources {
+		sourceCerts, err := c.sourceCertificates(source)
+		if err != nil {
+			return "", fmt.Errorf("source %d: %w", i, err)
+		}
+		certs = append(certs, sourceCerts...)
+	}
+	return trustbundle.Encode(certs), nil
+}
+
+// sourceCertificates returns the certificates of the source.
+func (c *controller) sourceCertificates(source cmapi.BundleSource) ([]*x509.Certificate, error) {
+	var data []byte
+	switch {
+	case source.ConfigMap != nil:
+		cm, err := c.configMapLister.ConfigMaps(c.clusterResourceNamespace).Get(source.ConfigMap.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := cm.Data[source.ConfigMap.Key]
+		if !ok {
+			return nil, fmt.Errorf("ConfigMap %q has no key %q", source.ConfigMap.Name, source.ConfigMap.Key)
+		}
+		data = []byte(value)
+
+	case source.Secret != nil:
+		secret, err := c.secretLister.Secrets(c.clusterResourceNamespace).Get(source.Secret.Name)
+		if err != nil {
+			return nil, err
+		}
+		value, ok := secret.Data[source.Secret.Key]
+		if !ok {
+			return nil, fmt.Errorf("Secret %q has no key %q", source.Secret.Name, source.Secret.Key)
+		}
+		data = value
+
+	case source.Issuer != nil:
+		caPEM, err := c.issuerCA(source.Issuer)
+		if err != nil {
+			return nil, err
+		}
+		data = caPEM
+
+	case source.InLine != nil:
+		data = []byte(*source.InLine)
+
+	default:
+		return nil, fmt.Errorf("no source is set")
+	}
+
+	return trustbundle.DecodeCertificates(data)
+}
+
+// issuerCA returns the PEM encoded root CA certificate of the CA issuer
+// referenced by the source.
+func (c *controller) issuerCA(ref *cmapi.BundleSourceIssuerRef) ([]byte, error) {
+	var iss cmapi.GenericIssuer
+	var err error
+	if ref.Kind == cmapi.IssuerKind {
+		iss, err = c.issuerLister.Issuers(ref.Namespace).Get(ref.Name)
+	} else {
+		iss, err = c.clusterIssuerLister.Get(ref.Name)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if iss.GetSpec().CA == nil {
+		return nil, fmt.Errorf("issuer %q is not a CA issuer", ref.Name)
+	}
+
+	secret, err := c.secretLister.Secrets(c.issuerNamespace(ref)).Get(iss.GetSpec().CA.SecretName)
+	if err != nil {
+		return nil, err
+	}
+
+	bundle, err := pki.ParseSingleCertificateChainPEM(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the certificate of issuer %q: %w", ref.Name, err)
+	}
+	// The top of the chain is not a root CA if the issuer is an
+	// intermediate CA whose Secret does not hold the root CA.
+	if len(bundle.CAPEM) == 0 {
+		return bundle.ChainPEM, nil
+	}
+	return bundle.CAPEM, nil
+}
+
+// issuerNamespace returns the namespace the Secret of the issuer referenced
+// by the source is read from.
+func (c *controller) issuerNamespace(ref *cmapi.BundleSourceIssuerRef) string {
+	if ref.Kind == cmapi.IssuerKind {
+		return ref.Namespace
+	}
+	return c.clusterResourceNamespace
+}
+
+// targetNamespaces returns the namespaces that the Bundle should be synced
+// into.
+func (c *controller) targetNamespaces(bundle *cmapi.Bundle) (sets.String, error) {
+	selector := labels.Everything()
+	if bundle.Spec.Target.NamespaceSelector != nil {
+		var err error
+		selector, err = metav1.LabelSelectorAsSelector(bundle.Spec.Target.NamespaceSelector)
+		if err != nil {
+			// The selector is validated by the webhook, so do not retry.
+			return sets.NewString(), nil
+		}
+	}
+
+	namespaces, err := c.namespaceLister.List(selector)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := sets.NewString()
+	for _, ns := range namespaces {
+		if ns.DeletionTimestamp != nil {
+			continue
+		}
+		targets.Insert(ns.Name)
+	}
+	return targets, nil
+}
+
+// sync creates or updates the ConfigMap of the Bundle in the namespace.
+// ConfigMaps which are not owned by the Bundle are never overwritten, in
+// which case false is returned.
+func (c *controller) sync(ctx context.Context, bundle *cmapi.Bundle, namespace, data string) (bool, error) {
+	log := logf.FromContext(ctx).WithValues("namespace", namespace)
+
+	target := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            bundle.Name,
+			Namespace:       namespace,
+			Labels:          map[string]string{cmapi.BundleLabelKey: bundle.Name},
+			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(bundle, cmapi.SchemeGroupVersion.WithKind(cmapi.BundleKind))},
+		},
+		Data: map[string]string{bundle.Spec.Target.ConfigMap.Key: data},
+	}
+
+	existing, err := c.configMapLister.ConfigMaps(namespace).Get(bundle.Name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("creating bundle ConfigMap")
+		if _, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Create(ctx, target, metav1.CreateOptions{}); err != nil {
+			return false, fmt.Errorf("failed to create bundle ConfigMap in namespace %q: %w", namespace, err)
+		}
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	if !metav1.IsControlledBy(existing, bundle) {
+		return false, nil
+	}
+
+	updated := existing.DeepCopy()
+	updated.Data = target.Data
+	if updated.Labels == nil {
+		updated.Labels = make(map[string]string)
+	}
+	updated.Labels[cmapi.BundleLabelKey] = bundle.Name
+	if apiequality.Semantic.DeepEqual(existing, updated) {
+		return true, nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating bundle ConfigMap")
+	if _, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return false, fmt.Errorf("failed to update bundle ConfigMap in namespace %q: %w", namespace, err)
+	}
+	return true, nil
+}
+
+// deleteStaleConfigMaps deletes the ConfigMaps of the Bundle which are not
+// in the target namespaces.
+func (c *controller) deleteStaleConfigMaps(ctx context.Context, bundle *cmapi.Bundle, targets sets.String) error {
+	log := logf.FromContext(ctx)
+
+	configMaps, err := c.configMapLister.List(labels.SelectorFromSet(labels.Set{cmapi.BundleLabelKey: bundle.Name}))
+	if err != nil {
+		return err
+	}
+
+	for _, cm := range configMaps {
+		if targets.Has(cm.Namespace) || !metav1.IsControlledBy(cm, bundle) {
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("deleting bundle ConfigMap as its namespace is no longer targeted", "namespace", cm.Namespace)
+		err := c.kubeClient.CoreV1().ConfigMaps(cm.Namespace).Delete(ctx, cm.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(cm.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete bundle ConfigMap in namespace %q: %w", cm.Namespace, err)
+		}
+	}
+	return nil
+}
+
+// setSyncedCondition updates the Synced condition of the Bundle, if it has
+// changed.
+func (c *controller) setSyncedCondition(ctx context.Context, bundle *cmapi.Bundle, status cmmeta.ConditionStatus, reason, message string) error {
+	updated := bundle.DeepCopy()
+	apiutil.SetBundleCondition(updated, updated.Generation, cmapi.BundleConditionSynced, status, reason, message)
+	if apiequality.Semantic.DeepEqual(bundle.Status, updated.Status) {
+		return nil
+	}
+	_, err := c.cmClient.CertmanagerV1().Bundles().UpdateStatus(ctx, updated, metav1.UpdateOptions{})
+	return err
+}
+
+// hasSource returns true if the predicate returns true for any source of the
+// Bundle.
+func hasSource(bundle *cmapi.Bundle, predicate func(source cmapi.BundleSource) bool) bool {
+	for _, source := range bundle.Spec.Sources {
+		if predicate(source) {
+			return true
+		}
+	}
+	return false
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.Client,
+		ctx.CMClient,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.IssuerOptions.ClusterResourceNamespace,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
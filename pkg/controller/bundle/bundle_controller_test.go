@@ -0,0 +1,240 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bundle
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/trustbundle"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func mustGenerateCACertificate(t *testing.T, name string) (*x509.Certificate, []byte) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: name},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	certPEM, cert, err := pki.SignCertificate(template, template, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return cert, certPEM
+}
+
+func TestProcessItem(t *testing.T) {
+	fixedClock := fakeclock.NewFakeClock(time.Now())
+	fixedNow := metav1.NewTime(fixedClock.Now())
+
+	namespace := func(name string, labels map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+	}
+	nsA := namespace("a", map[string]string{"trust": "enabled"})
+	nsB := namespace("b", nil)
+
+	rootCert, rootPEM := mustGenerateCACertificate(t, "root")
+	issuerCert, issuerPEM := mustGenerateCACertificate(t, "issuer")
+	rootBundle := trustbundle.Encode([]*x509.Certificate{rootCert})
+	fullBundle := trustbundle.Encode([]*x509.Certificate{rootCert, issuerCert})
+
+	sourceCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "roots", Namespace: "cert-manager"},
+		Data:       map[string]string{"ca.crt": string(rootPEM)},
+	}
+	issuerSecret := gen.Secret("issuer-ca",
+		gen.SetSecretNamespace("cert-manager"),
+		gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: issuerPEM}),
+	)
+	issuer := gen.ClusterIssuer("ca-issuer", gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "issuer-ca"}))
+
+	bundle := &cmapi.Bundle{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", UID: "bundle-uid", Generation: 1},
+		Spec: cmapi.BundleSpec{
+			Sources: []cmapi.BundleSource{
+				{ConfigMap: &cmapi.BundleSourceKeySelector{Name: "roots", Key: "ca.crt"}},
+			},
+			Target: cmapi.BundleTarget{ConfigMap: cmapi.BundleTargetConfigMap{Key: "ca.crt"}},
+		},
+	}
+	withSelector := bundle.DeepCopy()
+	withSelector.Spec.Target.NamespaceSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"trust": "enabled"}}
+	withIssuer := bundle.DeepCopy()
+	withIssuer.Spec.Sources = append(withIssuer.Spec.Sources, cmapi.BundleSource{Issuer: &cmapi.BundleSourceIssuerRef{Name: "ca-issuer"}})
+	withMissingSource := bundle.DeepCopy()
+	withMissingSource.Spec.Sources[0].ConfigMap.Name = "missing"
+
+	withCondition := func(bundle *cmapi.Bundle, status cmmeta.ConditionStatus, reason, message string) *cmapi.Bundle {
+		bundle = bundle.DeepCopy()
+		bundle.Status.Conditions = []cmapi.BundleCondition{{
+			Type:               cmapi.BundleConditionSynced,
+			Status:             status,
+			LastTransitionTime: &fixedNow,
+			Reason:             reason,
+			Message:            message,
+			ObservedGeneration: 1,
+		}}
+		return bundle
+	}
+
+	target := func(bundle *cmapi.Bundle, namespace, data string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:            bundle.Name,
+				Namespace:       namespace,
+				Labels:          map[string]string{cmapi.BundleLabelKey: bundle.Name},
+				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(bundle, cmapi.SchemeGroupVersion.WithKind(cmapi.BundleKind))},
+			},
+			Data: map[string]string{"ca.crt": data},
+		}
+	}
+
+	configMapsGVR := corev1.SchemeGroupVersion.WithResource("configmaps")
+	bundlesGVR := cmapi.SchemeGroupVersion.WithResource("bundles")
+
+	tests := map[string]struct {
+		existingCM      []runtime.Object
+		existingKube    []runtime.Object
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+	}{
+		"sync the bundle into all namespaces": {
+			existingCM:   []runtime.Object{bundle},
+			existingKube: []runtime.Object{nsA, nsB, sourceCM},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(configMapsGVR, "a", target(bundle, "a", rootBundle))),
+				testpkg.NewAction(coretesting.NewCreateAction(configMapsGVR, "b", target(bundle, "b", rootBundle))),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(bundle, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 2 namespaces"))),
+			},
+		},
+		"sync the bundle into namespaces matching the selector": {
+			existingCM:   []runtime.Object{withSelector},
+			existingKube: []runtime.Object{nsA, nsB, sourceCM},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(configMapsGVR, "a", target(withSelector, "a", rootBundle))),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(withSelector, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 1 namespaces"))),
+			},
+		},
+		"include the CA of a CA issuer in the bundle": {
+			existingCM:   []runtime.Object{withIssuer, issuer},
+			existingKube: []runtime.Object{nsA, sourceCM, issuerSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(configMapsGVR, "a", target(withIssuer, "a", fullBundle))),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(withIssuer, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 1 namespaces"))),
+			},
+		},
+		"do nothing if the bundle is up to date": {
+			existingCM:   []runtime.Object{withCondition(withSelector, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 1 namespaces")},
+			existingKube: []runtime.Object{nsA, nsB, sourceCM, target(withSelector, "a", rootBundle)},
+		},
+		"update a ConfigMap which is out of date": {
+			existingCM:   []runtime.Object{withSelector},
+			existingKube: []runtime.Object{nsA, sourceCM, target(withSelector, "a", "old")},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(configMapsGVR, "a", target(withSelector, "a", rootBundle))),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(withSelector, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 1 namespaces"))),
+			},
+		},
+		"do not overwrite a ConfigMap which is not owned by the bundle": {
+			existingCM: []runtime.Object{withSelector},
+			existingKube: []runtime.Object{nsA, sourceCM, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: "test", Namespace: "a"},
+				Data:       map[string]string{"foo": "bar"},
+			}},
+			expectedEvents: []string{`Warning TargetConflict Bundle is not synced into namespaces a as a ConfigMap named "test" which is not owned by this Bundle already exists`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(withSelector, cmmeta.ConditionFalse, "TargetConflict",
+						`Bundle is not synced into namespaces a as a ConfigMap named "test" which is not owned by this Bundle already exists`))),
+			},
+		},
+		"delete a ConfigMap in a namespace which is no longer targeted": {
+			existingCM:   []runtime.Object{withCondition(withSelector, cmmeta.ConditionTrue, "Synced", "Bundle is synced into 1 namespaces")},
+			existingKube: []runtime.Object{nsA, nsB, sourceCM, target(withSelector, "a", rootBundle), target(withSelector, "b", rootBundle)},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(configMapsGVR, "b", "test")),
+			},
+		},
+		"report a source which cannot be read": {
+			existingCM:     []runtime.Object{withMissingSource},
+			existingKube:   []runtime.Object{nsA, sourceCM},
+			expectedEvents: []string{`Warning SourceError Failed to build bundle: source 0: configmap "missing" not found`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(bundlesGVR, "status", "",
+					withCondition(withMissingSource, cmmeta.ConditionFalse, "SourceError", `Failed to build bundle: source 0: configmap "missing" not found`))),
+			},
+		},
+		"do nothing if the bundle does not exist": {
+			existingKube: []runtime.Object{nsA, sourceCM},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fixedClock,
+				CertManagerObjects: test.existingCM,
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.IssuerOptions.ClusterResourceNamespace = "cert-manager"
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "test")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
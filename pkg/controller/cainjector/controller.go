@@ -22,7 +22,6 @@ import (
 	"strings"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -47,14 +46,14 @@ func dropNotFound(err error) error {
 // given secret, returning nil if no such object exists.
 // Right now, this actually uses a label instead of owner refs,
 // since certmanager doesn't set owner refs on secrets.
-func OwningCertForSecret(secret *corev1.Secret) *types.NamespacedName {
-	lblVal, hasLbl := secret.Annotations[certmanager.CertificateNameKey]
+func OwningCertForSecret(secret metav1.Object) *types.NamespacedName {
+	lblVal, hasLbl := secret.GetAnnotations()[certmanager.CertificateNameKey]
 	if !hasLbl {
 		return nil
 	}
 	return &types.NamespacedName{
 		Name:      lblVal,
-		Namespace: secret.Namespace,
+		Namespace: secret.GetNamespace(),
 	}
 }
 
@@ -107,6 +106,9 @@ type genericInjectReconciler struct {
 	client.Client
 
 	resourceName string // just used for logging
+
+	// inShard returns true if the injectable is reconciled by this replica.
+	inShard func(types.NamespacedName) bool
 }
 
 // splitNamespacedName turns the string form of a namespaced name
@@ -125,6 +127,12 @@ func (r *genericInjectReconciler) Reconcile(_ context.Context, req ctrl.Request)
 	ctx := context.Background()
 	log := r.log.WithValues(r.resourceName, req.NamespacedName)
 
+	// ignore injectables which are reconciled by other replicas
+	if r.inShard != nil && !r.inShard(req.NamespacedName) {
+		log.V(logf.DebugLevel).Info("ignoring", "reason", "injectable is in another shard")
+		return ctrl.Result{}, nil
+	}
+
 	// fetch the target object
 	target := r.injector.NewTarget()
 	if err := r.Client.Get(ctx, req.NamespacedName, target.AsObject()); err != nil {
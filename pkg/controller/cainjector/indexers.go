@@ -20,7 +20,6 @@ import (
 	"context"
 
 	"github.com/go-logr/logr"
-	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
@@ -77,7 +76,7 @@ type secretForCertificateMapper struct {
 
 func (m *secretForCertificateMapper) Map(obj client.Object) []ctrl.Request {
 	// grab the certificate, if it exists
-	certName := OwningCertForSecret(obj.(*corev1.Secret))
+	certName := OwningCertForSecret(obj)
 	if certName == nil {
 		return nil
 	}
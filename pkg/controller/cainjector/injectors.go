@@ -18,9 +18,14 @@ package cainjector
 
 import (
 	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/cert-manager/cert-manager/internal/clustertrustbundle"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 )
 
 // this contains implementations of CertInjector (and dependents)
@@ -140,3 +145,87 @@ func (t *crdConversionTarget) SetCA(data []byte) {
 	}
 	t.obj.Spec.Conversion.Webhook.ClientConfig.CABundle = data
 }
+
+// configMapInjector knows how to create an InjectTarget for a ConfigMap.
+type configMapInjector struct{}
+
+func (i configMapInjector) NewTarget() InjectTarget {
+	return &configMapTarget{}
+}
+
+func (i configMapInjector) IsAlpha() bool {
+	return false
+}
+
+// configMapTarget knows how to set CA data for the `ca.crt` key of a
+// ConfigMap.
+type configMapTarget struct {
+	obj corev1.ConfigMap
+}
+
+func (t *configMapTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *configMapTarget) SetCA(data []byte) {
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string]string)
+	}
+	t.obj.Data[cmmeta.TLSCAKey] = string(data)
+}
+
+// secretInjector knows how to create an InjectTarget for a Secret.
+type secretInjector struct{}
+
+func (i secretInjector) NewTarget() InjectTarget {
+	return &secretTarget{}
+}
+
+func (i secretInjector) IsAlpha() bool {
+	return false
+}
+
+// secretTarget knows how to set CA data for the `ca.crt` key of a Secret.
+type secretTarget struct {
+	obj corev1.Secret
+}
+
+func (t *secretTarget) AsObject() client.Object {
+	return &t.obj
+}
+
+func (t *secretTarget) SetCA(data []byte) {
+	if t.obj.Data == nil {
+		t.obj.Data = make(map[string][]byte)
+	}
+	t.obj.Data[cmmeta.TLSCAKey] = data
+}
+
+// clusterTrustBundleInjector knows how to create an InjectTarget for a
+// ClusterTrustBundle.
+type clusterTrustBundleInjector struct{}
+
+func (i clusterTrustBundleInjector) NewTarget() InjectTarget {
+	return &clusterTrustBundleTarget{obj: clustertrustbundle.New()}
+}
+
+// IsAlpha is true as the ClusterTrustBundle API is alpha, and is only
+// served by API servers which have enabled it.
+func (i clusterTrustBundleInjector) IsAlpha() bool {
+	return true
+}
+
+// clusterTrustBundleTarget knows how to set CA data for the trust bundle of
+// a ClusterTrustBundle. The ClusterTrustBundle API types are not vendored, so
+// it is handled as an unstructured object.
+type clusterTrustBundleTarget struct {
+	obj *unstructured.Unstructured
+}
+
+func (t *clusterTrustBundleTarget) AsObject() client.Object {
+	return t.obj
+}
+
+func (t *clusterTrustBundleTarget) SetCA(data []byte) {
+	clustertrustbundle.SetTrustBundle(t.obj, string(data))
+}
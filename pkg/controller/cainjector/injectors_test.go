@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/cert-manager/cert-manager/internal/cainjector/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+var testCAData = []byte("-----BEGIN CERTIFICATE-----\ntest\n-----END CERTIFICATE-----\n")
+
+// caDataOf returns the data of the `ca.crt` key of a ConfigMap or Secret.
+func caDataOf(obj client.Object) ([]byte, bool) {
+	switch o := obj.(type) {
+	case *corev1.ConfigMap:
+		data, ok := o.Data[cmmeta.TLSCAKey]
+		return []byte(data), ok
+	case *corev1.Secret:
+		data, ok := o.Data[cmmeta.TLSCAKey]
+		return data, ok
+	}
+	return nil, false
+}
+
+func TestConfigMapAndSecretInjection(t *testing.T) {
+	caSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "cert-manager",
+			Name:        "ca",
+			Annotations: map[string]string{cmapi.AllowsInjectionFromSecretAnnotation: "true"},
+		},
+		Data: map[string][]byte{cmmeta.TLSCAKey: testCAData},
+	}
+	injectFromSecret := map[string]string{cmapi.WantInjectFromSecretAnnotation: "cert-manager/ca"}
+
+	tests := map[string]struct {
+		setup injectorSetup
+		// newObject returns a ConfigMap or Secret in the default namespace
+		// with the given name and annotations.
+		newObject func(name string, annotations map[string]string) client.Object
+	}{
+		"configmap": {
+			setup: ConfigMapSetup,
+			newObject: func(name string, annotations map[string]string) client.Object {
+				return &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Annotations: annotations}}
+			},
+		},
+		"secret": {
+			setup: SecretSetup,
+			newObject: func(name string, annotations map[string]string) client.Object {
+				return &corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Annotations: annotations}}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := fake.NewClientBuilder().
+				WithObjects(caSecret.DeepCopy(),
+					test.newObject("annotated", injectFromSecret),
+					test.newObject("unannotated", nil),
+				).
+				Build()
+
+			r := &genericInjectReconciler{
+				injector:     test.setup.injector,
+				sources:      []caDataSource{&secretDataSource{client: cl, apiReader: cl}},
+				log:          logf.Log,
+				Client:       cl,
+				resourceName: test.setup.resourceName,
+			}
+
+			for _, objName := range []string{"annotated", "unannotated"} {
+				req := ctrl.Request{NamespacedName: types.NamespacedName{Namespace: "default", Name: objName}}
+				if _, err := r.Reconcile(context.Background(), req); err != nil {
+					t.Fatalf("unexpected error reconciling %s: %v", objName, err)
+				}
+			}
+
+			annotated := test.setup.injector.NewTarget().AsObject()
+			if err := cl.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "annotated"}, annotated); err != nil {
+				t.Fatal(err)
+			}
+			if data, _ := caDataOf(annotated); !bytes.Equal(data, testCAData) {
+				t.Errorf("expected the CA to be injected into the annotated %s, got %q", test.setup.resourceName, data)
+			}
+
+			unannotated := test.setup.injector.NewTarget().AsObject()
+			if err := cl.Get(context.Background(), types.NamespacedName{Namespace: "default", Name: "unannotated"}, unannotated); err != nil {
+				t.Fatal(err)
+			}
+			if data, ok := caDataOf(unannotated); ok {
+				t.Errorf("expected the unannotated %s to be left alone, got CA %q", test.setup.resourceName, data)
+			}
+			if unannotated.GetResourceVersion() != "999" {
+				t.Errorf("expected the unannotated %s not to be updated, got resourceVersion %q", test.setup.resourceName, unannotated.GetResourceVersion())
+			}
+		})
+	}
+}
+
+func TestEnabledInjectorSetups(t *testing.T) {
+	allInjectables := SetupOptions{
+		EnableMutatingWebhookConfigurationsInjectable:   true,
+		EnableValidatingWebhookConfigurationsInjectable: true,
+		EnableAPIServicesInjectable:                     true,
+		EnableCustomResourceDefinitionsInjectable:       true,
+	}
+
+	tests := map[string]struct {
+		opts                           SetupOptions
+		injectIntoConfigMapsAndSecrets bool
+		injectIntoClusterTrustBundles  bool
+		expectedResourceNames          []string
+	}{
+		"no injectables enabled": {},
+		"only the enabled injectables are set up": {
+			opts: SetupOptions{
+				EnableValidatingWebhookConfigurationsInjectable: true,
+				EnableCustomResourceDefinitionsInjectable:       true,
+			},
+			expectedResourceNames: []string{"validatingwebhookconfiguration", "customresourcedefinition"},
+		},
+		"configmaps and secrets are not set up unless their feature gate is enabled": {
+			opts:                  allInjectables,
+			expectedResourceNames: []string{"mutatingwebhookconfiguration", "validatingwebhookconfiguration", "apiservice", "customresourcedefinition"},
+		},
+		"configmaps and secrets are set up if their feature gate is enabled": {
+			opts:                           allInjectables,
+			injectIntoConfigMapsAndSecrets: true,
+			expectedResourceNames:          []string{"mutatingwebhookconfiguration", "validatingwebhookconfiguration", "apiservice", "customresourcedefinition", "configmap", "secret"},
+		},
+		"clustertrustbundles are set up if their feature gate is enabled": {
+			injectIntoClusterTrustBundles: true,
+			expectedResourceNames:         []string{"clustertrustbundle"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.InjectCAIntoConfigMapsAndSecrets, test.injectIntoConfigMapsAndSecrets)()
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.InjectCAIntoClusterTrustBundles, test.injectIntoClusterTrustBundles)()
+
+			var resourceNames []string
+			for _, setup := range enabledInjectorSetups(test.opts) {
+				resourceNames = append(resourceNames, setup.resourceName)
+			}
+			if !reflect.DeepEqual(resourceNames, test.expectedResourceNames) {
+				t.Errorf("unexpected setups, exp=%v, got=%v", test.expectedResourceNames, resourceNames)
+			}
+		})
+	}
+}
@@ -21,11 +21,16 @@ import (
 	"fmt"
 	"os"
 
+	"github.com/cert-manager/cert-manager/internal/cainjector/feature"
+	"github.com/cert-manager/cert-manager/internal/clustertrustbundle"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"golang.org/x/sync/errgroup"
 	admissionreg "k8s.io/api/admissionregistration/v1"
+	corev1 "k8s.io/api/core/v1"
 	apiext "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	apireg "k8s.io/kube-aggregator/pkg/apis/apiregistration/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -69,16 +74,93 @@ var (
 		listType:     &apiext.CustomResourceDefinitionList{},
 	}
 
-	injectorSetups  = []injectorSetup{MutatingWebhookSetup, ValidatingWebhookSetup, APIServiceSetup, CRDSetup}
+	ConfigMapSetup = injectorSetup{
+		resourceName: "configmap",
+		injector:     configMapInjector{},
+		listType:     &corev1.ConfigMapList{},
+	}
+
+	SecretSetup = injectorSetup{
+		resourceName: "secret",
+		injector:     secretInjector{},
+		listType:     &corev1.SecretList{},
+	}
+
+	ClusterTrustBundleSetup = injectorSetup{
+		resourceName: "clustertrustbundle",
+		injector:     clusterTrustBundleInjector{},
+		listType:     clustertrustbundle.NewList(),
+	}
+
 	ControllerNames []string
 )
 
+// SetupOptions restricts which resources the injectors cache and reconcile,
+// so that the memory footprint of cainjector can be kept small on large
+// clusters.
+type SetupOptions struct {
+	// Namespaces restricts the namespaced resources which are cached and
+	// reconciled to these namespaces, including the Certificates and Secrets
+	// CAs are read from. All namespaces are cached if it is empty.
+	Namespaces []string
+
+	// EnableMutatingWebhookConfigurationsInjectable, and the options below,
+	// determine whether CAs are injected into each type of injectable.
+	// Injectables which are disabled are not cached.
+	EnableMutatingWebhookConfigurationsInjectable   bool
+	EnableValidatingWebhookConfigurationsInjectable bool
+	EnableAPIServicesInjectable                     bool
+	EnableCustomResourceDefinitionsInjectable       bool
+
+	// CustomResourceDefinitionSelector restricts the
+	// CustomResourceDefinitions which are cached and reconciled to those
+	// matching it. All CustomResourceDefinitions are cached if it is nil.
+	CustomResourceDefinitionSelector labels.Selector
+
+	// ShardCount is the number of shards the injectables are split into, so
+	// that they can be reconciled by multiple replicas at once. Sharding is
+	// disabled if it is less than 2.
+	ShardCount int
+	// ShardIndex is the shard of the injectables reconciled by this replica,
+	// from 0 to ShardCount-1.
+	ShardIndex int
+}
+
+// enabledInjectorSetups returns the setups of the injectables enabled by the
+// options, along with the ConfigMap and Secret setups if the
+// InjectCAIntoConfigMapsAndSecrets feature gate is enabled, and the
+// ClusterTrustBundle setup if the InjectCAIntoClusterTrustBundles feature
+// gate is enabled.
+func enabledInjectorSetups(opts SetupOptions) []injectorSetup {
+	var setups []injectorSetup
+	if opts.EnableMutatingWebhookConfigurationsInjectable {
+		setups = append(setups, MutatingWebhookSetup)
+	}
+	if opts.EnableValidatingWebhookConfigurationsInjectable {
+		setups = append(setups, ValidatingWebhookSetup)
+	}
+	if opts.EnableAPIServicesInjectable {
+		setups = append(setups, APIServiceSetup)
+	}
+	if opts.EnableCustomResourceDefinitionsInjectable {
+		setups = append(setups, CRDSetup)
+	}
+	if utilfeature.DefaultFeatureGate.Enabled(feature.InjectCAIntoConfigMapsAndSecrets) {
+		setups = append(setups, ConfigMapSetup, SecretSetup)
+	}
+	if utilfeature.DefaultFeatureGate.Enabled(feature.InjectCAIntoClusterTrustBundles) {
+		setups = append(setups, ClusterTrustBundleSetup)
+	}
+	return setups
+}
+
 // registerAllInjectors registers all injectors and based on the
 // graduation state of the injector decides how to log no kind/resource match errors
-func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manager, sources []caDataSource, client client.Client, ca cache.Cache) error {
-	controllers := make([]controller.Controller, len(injectorSetups))
-	for i, setup := range injectorSetups {
-		controller, err := newGenericInjectionController(ctx, groupName, mgr, setup, sources, ca, client)
+func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manager, opts SetupOptions, sources []caDataSource, client client.Client, ca cache.Cache) error {
+	setups := enabledInjectorSetups(opts)
+	var controllers []controller.Controller
+	for _, setup := range setups {
+		controller, err := newGenericInjectionController(ctx, groupName, mgr, opts, setup, sources, ca, client)
 		if err != nil {
 			if !meta.IsNoMatchError(err) || !setup.injector.IsAlpha() {
 				return err
@@ -86,8 +168,9 @@ func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manage
 			ctrl.Log.V(logf.WarnLevel).Info("unable to register injector which is still in an alpha phase."+
 				" Enable the feature on the API server in order to use this injector",
 				"injector", setup.resourceName)
+			continue
 		}
-		controllers[i] = controller
+		controllers = append(controllers, controller)
 	}
 	g, gctx := errgroup.WithContext(ctx)
 
@@ -123,7 +206,7 @@ func registerAllInjectors(ctx context.Context, groupName string, mgr ctrl.Manage
 // improvements which might make this easier:
 // * https://github.com/kubernetes-sigs/controller-runtime/issues/764
 func newGenericInjectionController(ctx context.Context, groupName string, mgr ctrl.Manager,
-	setup injectorSetup, sources []caDataSource, ca cache.Cache,
+	opts SetupOptions, setup injectorSetup, sources []caDataSource, ca cache.Cache,
 	client client.Client) (controller.Controller, error) {
 	log := ctrl.Log.WithName(groupName).WithName(setup.resourceName)
 	typ := setup.injector.NewTarget().AsObject()
@@ -138,13 +221,16 @@ func newGenericInjectionController(ctx context.Context, groupName string, mgr ct
 				log:          log.WithName("generic-inject-reconciler"),
 				resourceName: setup.resourceName,
 				injector:     setup.injector,
+				inShard:      opts.inShard,
 			},
 			Log: log,
 		})
 	if err != nil {
 		return nil, err
 	}
-	if err := c.Watch(source.NewKindWithCache(typ, ca), &handler.EnqueueRequestForObject{}); err != nil {
+	// injectables in other shards are filtered out here, so that they are not
+	// queued, and by the reconciler for requests mapped from data sources
+	if err := c.Watch(source.NewKindWithCache(typ, ca), &handler.EnqueueRequestForObject{}, opts.shardPredicate()); err != nil {
 		return nil, err
 	}
 
@@ -178,8 +264,8 @@ func dataFromSliceOrFile(data []byte, file string) ([]byte, error) {
 // indices.
 // The registered controllers require the cert-manager API to be available
 // in order to run.
-func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager) error {
-	cache, client, err := newIndependentCacheAndDelegatingClient(mgr)
+func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager, opts SetupOptions) error {
+	cache, client, err := newIndependentCacheAndDelegatingClient(mgr, opts)
 	if err != nil {
 		return err
 	}
@@ -187,8 +273,9 @@ func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager) error {
 		ctx,
 		"certificate",
 		mgr,
+		opts,
 		[]caDataSource{
-			&certificateDataSource{client: cache},
+			&certificateDataSource{client: cache, apiReader: mgr.GetAPIReader()},
 		},
 		client,
 		cache,
@@ -200,8 +287,8 @@ func RegisterCertificateBased(ctx context.Context, mgr ctrl.Manager) error {
 // indices.
 // The registered controllers only require the corev1 APi to be available in
 // order to run.
-func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager) error {
-	cache, client, err := newIndependentCacheAndDelegatingClient(mgr)
+func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager, opts SetupOptions) error {
+	cache, client, err := newIndependentCacheAndDelegatingClient(mgr, opts)
 	if err != nil {
 		return err
 	}
@@ -209,8 +296,9 @@ func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager) error {
 		ctx,
 		"secret",
 		mgr,
+		opts,
 		[]caDataSource{
-			&secretDataSource{client: cache},
+			&secretDataSource{client: cache, apiReader: mgr.GetAPIReader()},
 			&kubeconfigDataSource{},
 		},
 		client,
@@ -224,12 +312,20 @@ func RegisterSecretBased(ctx context.Context, mgr ctrl.Manager) error {
 // cert-manager Certificates CRDs have been installed and before the CA bundles
 // have been injected into the cert-manager CRDs, by the secrets based injector,
 // which is running in a separate goroutine.
-func newIndependentCacheAndDelegatingClient(mgr ctrl.Manager) (cache.Cache, client.Client, error) {
+// The cache is restricted to the namespaces and CustomResourceDefinitions
+// selected by the options.
+func newIndependentCacheAndDelegatingClient(mgr ctrl.Manager, opts SetupOptions) (cache.Cache, client.Client, error) {
 	cacheOptions := cache.Options{
 		Scheme: mgr.GetScheme(),
 		Mapper: mgr.GetRESTMapper(),
 	}
-	ca, err := cache.New(mgr.GetConfig(), cacheOptions)
+	if opts.CustomResourceDefinitionSelector != nil {
+		cacheOptions.SelectorsByObject = cache.SelectorsByObject{
+			&apiext.CustomResourceDefinition{}: {Label: opts.CustomResourceDefinitionSelector},
+		}
+	}
+
+	ca, err := newCacheFunc(opts)(mgr.GetConfig(), cacheOptions)
 	if err != nil {
 		return nil, nil, err
 	}
@@ -245,3 +341,15 @@ func newIndependentCacheAndDelegatingClient(mgr ctrl.Manager) (cache.Cache, clie
 	}
 	return ca, client, nil
 }
+
+// newCacheFunc returns the function creating a cache restricted to the
+// namespaces selected by the options.
+// A multi-namespace cache is used even if only one namespace is selected, as
+// it fails reads of objects outside of its namespaces with an error, whereas
+// a cache restricted to a single namespace reports them as not found.
+func newCacheFunc(opts SetupOptions) cache.NewCacheFunc {
+	if len(opts.Namespaces) == 0 {
+		return cache.New
+	}
+	return cache.MultiNamespacedCacheBuilder(opts.Namespaces)
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"hash/fnv"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+)
+
+// inShard returns true if the injectable is in the shard reconciled by this
+// replica. Injectables are assigned to shards by a hash of their namespace
+// and name, so that multiple replicas can inject CAs concurrently without
+// coordinating with each other.
+func (o SetupOptions) inShard(name types.NamespacedName) bool {
+	if o.ShardCount <= 1 {
+		return true
+	}
+	h := fnv.New32a()
+	// writing to a hash never returns an error
+	_, _ = h.Write([]byte(name.String()))
+	return int(h.Sum32()%uint32(o.ShardCount)) == o.ShardIndex
+}
+
+// shardPredicate filters out events for injectables in other shards.
+func (o SetupOptions) shardPredicate() predicate.Predicate {
+	return predicate.NewPredicateFuncs(func(obj client.Object) bool {
+		return o.inShard(types.NamespacedName{Namespace: obj.GetNamespace(), Name: obj.GetName()})
+	})
+}
@@ -0,0 +1,61 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"fmt"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestInShard(t *testing.T) {
+	names := make([]types.NamespacedName, 100)
+	for i := range names {
+		names[i] = types.NamespacedName{Name: fmt.Sprintf("webhook-%d", i)}
+	}
+
+	t.Run("all injectables are in the shard if sharding is disabled", func(t *testing.T) {
+		for _, name := range names {
+			if !(SetupOptions{}).inShard(name) {
+				t.Errorf("expected %s to be in the shard", name)
+			}
+		}
+	})
+
+	t.Run("each injectable is in exactly one shard", func(t *testing.T) {
+		const shardCount = 3
+		counts := make([]int, shardCount)
+		for _, name := range names {
+			var shards int
+			for i := 0; i < shardCount; i++ {
+				if (SetupOptions{ShardCount: shardCount, ShardIndex: i}).inShard(name) {
+					shards++
+					counts[i]++
+				}
+			}
+			if shards != 1 {
+				t.Errorf("expected %s to be in exactly one shard, got %d", name, shards)
+			}
+		}
+		for i, count := range counts {
+			if count == 0 {
+				t.Errorf("expected shard %d to have injectables", i)
+			}
+		}
+	})
+}
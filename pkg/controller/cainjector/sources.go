@@ -83,11 +83,42 @@ func (c *kubeconfigDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, se
 	return nil
 }
 
+// newSecretMetadata returns an empty PartialObjectMetadata for a Secret.
+// Secrets are only cached as metadata, as caching every Secret in full uses
+// too much memory on large clusters, and the data of the few Secrets that CAs
+// are read from is fetched from the API server instead.
+func newSecretMetadata() *metav1.PartialObjectMetadata {
+	return &metav1.PartialObjectMetadata{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Secret",
+		},
+	}
+}
+
+// readSecret fetches the Secret from the API server, once its metadata has
+// been found in the cache.
+// Looking up the metadata first avoids a request to the API server for
+// Secrets which do not exist, or which are outside of the cached namespaces.
+func readSecret(ctx context.Context, cache, apiReader client.Reader, name types.NamespacedName) (*corev1.Secret, error) {
+	if err := cache.Get(ctx, name, newSecretMetadata()); err != nil {
+		return nil, err
+	}
+	var secret corev1.Secret
+	if err := apiReader.Get(ctx, name, &secret); err != nil {
+		return nil, err
+	}
+	return &secret, nil
+}
+
 // certificateDataSource reads a CA bundle by fetching the Certificate named in
 // the 'cert-manager.io/inject-ca-from' annotation in the form
 // 'namespace/name'.
 type certificateDataSource struct {
 	client client.Reader
+	// apiReader reads the Secrets of Certificates, of which only the
+	// metadata is cached.
+	apiReader client.Reader
 }
 
 func (c *certificateDataSource) Configured(log logr.Logger, metaObj metav1.Object) bool {
@@ -119,15 +150,15 @@ func (c *certificateDataSource) ReadCA(ctx context.Context, log logr.Logger, met
 	secretName := &types.NamespacedName{Namespace: cert.Namespace, Name: cert.Spec.SecretName}
 	// grab the associated secret, and ensure it's owned by the cert
 	log = log.WithValues("secret", secretName)
-	var secret corev1.Secret
-	if err := c.client.Get(ctx, *secretName, &secret); err != nil {
+	secret, err := readSecret(ctx, c.client, c.apiReader, *secretName)
+	if err != nil {
 		log.Error(err, "unable to fetch associated secret")
 		// don't requeue if we're just not found, we'll get called when the secret gets created
 		return nil, dropNotFound(err)
 	}
-	owner := OwningCertForSecret(&secret)
+	owner := OwningCertForSecret(secret)
 	if owner == nil || *owner != certName {
-		log.V(logf.WarnLevel).Info("refusing to target secret not owned by certificate", "owner", metav1.GetControllerOf(&secret))
+		log.V(logf.WarnLevel).Info("refusing to target secret not owned by certificate", "owner", metav1.GetControllerOf(secret))
 		return nil, nil
 	}
 
@@ -157,7 +188,7 @@ func (c *certificateDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, s
 	); err != nil {
 		return err
 	}
-	if err := controller.Watch(source.NewKindWithCache(&corev1.Secret{}, ca),
+	if err := controller.Watch(source.NewKindWithCache(newSecretMetadata(), ca),
 		handler.EnqueueRequestsFromMapFunc((&secretForCertificateMapper{
 			Client:                  ca,
 			log:                     ctrl.Log.WithName("secret-for-certificate-mapper"),
@@ -174,6 +205,9 @@ func (c *certificateDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, s
 // 'namespace/name'.
 type secretDataSource struct {
 	client client.Reader
+	// apiReader reads the Secrets CAs are injected from, of which only the
+	// metadata is cached.
+	apiReader client.Reader
 }
 
 func (c *secretDataSource) Configured(log logr.Logger, metaObj metav1.Object) bool {
@@ -196,8 +230,8 @@ func (c *secretDataSource) ReadCA(ctx context.Context, log logr.Logger, metaObj
 	}
 
 	// grab the associated secret
-	var secret corev1.Secret
-	if err := c.client.Get(ctx, secretName, &secret); err != nil {
+	secret, err := readSecret(ctx, c.client, c.apiReader, secretName)
+	if err != nil {
 		log.Error(err, "unable to fetch associated secret")
 		// don't requeue if we're just not found, we'll get called when the secret gets created
 		return nil, dropNotFound(err)
@@ -224,7 +258,7 @@ func (c *secretDataSource) ApplyTo(ctx context.Context, mgr ctrl.Manager, setup
 	if err := ca.IndexField(ctx, typ, injectFromSecretPath, injectableCAFromSecretIndexer); err != nil {
 		return err
 	}
-	if err := controller.Watch(source.NewKindWithCache(&corev1.Secret{}, ca),
+	if err := controller.Watch(source.NewKindWithCache(newSecretMetadata(), ca),
 		handler.EnqueueRequestsFromMapFunc((&secretForInjectableMapper{
 			Client:             ca,
 			log:                ctrl.Log.WithName("secret-mapper"),
@@ -0,0 +1,105 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cainjector
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/rest"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/cert-manager/cert-manager/pkg/api"
+)
+
+// countingReader counts the reads made through it.
+type countingReader struct {
+	client.Reader
+	gets int
+}
+
+func (r *countingReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object) error {
+	r.gets++
+	return r.Reader.Get(ctx, key, obj)
+}
+
+func TestReadSecret(t *testing.T) {
+	secrets := []client.Object{
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "cert-manager", Name: "ca"}},
+		&corev1.Secret{ObjectMeta: metav1.ObjectMeta{Namespace: "other", Name: "ca"}},
+	}
+
+	t.Run("secrets are read from the API server once found in the cache", func(t *testing.T) {
+		apiReader := &countingReader{Reader: fake.NewClientBuilder().WithObjects(secrets...).Build()}
+		ca := fake.NewClientBuilder().WithObjects(secrets...).Build()
+
+		secret, err := readSecret(context.Background(), ca, apiReader, types.NamespacedName{Namespace: "cert-manager", Name: "ca"})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if secret.Namespace != "cert-manager" || secret.Name != "ca" {
+			t.Errorf("unexpected secret %s/%s", secret.Namespace, secret.Name)
+		}
+		if apiReader.gets != 1 {
+			t.Errorf("expected the secret to be read from the API server once, got %d reads", apiReader.gets)
+		}
+	})
+
+	for name, namespaces := range map[string][]string{
+		"one namespace":       {"cert-manager"},
+		"multiple namespaces": {"cert-manager", "kube-system"},
+	} {
+		t.Run("secrets outside of the cached namespaces are not read with "+name, func(t *testing.T) {
+			mapper := meta.NewDefaultRESTMapper([]schema.GroupVersion{corev1.SchemeGroupVersion})
+			mapper.Add(corev1.SchemeGroupVersion.WithKind("Secret"), meta.RESTScopeNamespace)
+
+			// The cache is never started, and the API server is unreachable,
+			// so any read which is not rejected by the namespace restriction
+			// would block or fail with a different error.
+			ca, err := newCacheFunc(SetupOptions{Namespaces: namespaces})(&rest.Config{Host: "https://127.0.0.1:1"}, cache.Options{
+				Scheme: api.Scheme,
+				Mapper: mapper,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			apiReader := &countingReader{Reader: fake.NewClientBuilder().WithObjects(secrets...).Build()}
+
+			ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+			defer cancel()
+			_, err = readSecret(ctx, ca, apiReader, types.NamespacedName{Namespace: "other", Name: "ca"})
+			if err == nil || !strings.Contains(err.Error(), "unknown namespace for the cache") {
+				t.Errorf("expected an unknown namespace error, got %v", err)
+			}
+			if ctx.Err() != nil {
+				t.Errorf("expected the read to fail immediately, but it timed out")
+			}
+			if apiReader.gets != 0 {
+				t.Errorf("expected the secret not to be read from the API server, got %d reads", apiReader.gets)
+			}
+		})
+	}
+}
@@ -19,14 +19,11 @@ package shimhelper
 import (
 	"errors"
 	"fmt"
-	"strconv"
-	"strings"
-	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/utils/pointer"
 
-	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/api/annotations"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
@@ -36,7 +33,8 @@ var (
 )
 
 // translateAnnotations updates the Certificate spec using the ingress-like
-// annotations. For example, the following Ingress:
+// annotations, which are parsed as described by the annotations package.
+// For example, the following Ingress:
 //
 //   kind: Ingress
 //   metadata:
@@ -68,7 +66,7 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 	}
 
 	if duration, found := ingLikeAnnotations[cmapi.DurationAnnotationKey]; found {
-		duration, err := time.ParseDuration(duration)
+		duration, err := annotations.ParseDuration(duration)
 		if err != nil {
 			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.DurationAnnotationKey, err)
 		}
@@ -76,38 +74,35 @@ func translateAnnotations(crt *cmapi.Certificate, ingLikeAnnotations map[string]
 	}
 
 	if renewBefore, found := ingLikeAnnotations[cmapi.RenewBeforeAnnotationKey]; found {
-		duration, err := time.ParseDuration(renewBefore)
+		duration, err := annotations.ParseDuration(renewBefore)
 		if err != nil {
 			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.RenewBeforeAnnotationKey, err)
 		}
 		crt.Spec.RenewBefore = &metav1.Duration{Duration: duration}
 	}
 
+	if renewBeforePercentage, found := ingLikeAnnotations[cmapi.RenewBeforePercentageAnnotationKey]; found {
+		pct, err := annotations.ParsePositiveInteger(renewBeforePercentage)
+		if err != nil {
+			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.RenewBeforePercentageAnnotationKey, err)
+		}
+		crt.Spec.RenewBeforePercentage = pointer.Int32(pct)
+	}
+
 	if usages, found := ingLikeAnnotations[cmapi.UsagesAnnotationKey]; found {
-		var newUsages []cmapi.KeyUsage
-		for _, usageName := range strings.Split(usages, ",") {
-			usage := cmapi.KeyUsage(strings.Trim(usageName, " "))
-			_, isKU := apiutil.KeyUsageType(usage)
-			_, isEKU := apiutil.ExtKeyUsageType(usage)
-			if !isKU && !isEKU {
-				return fmt.Errorf("%w %q: invalid key usage name %q", errInvalidIngressAnnotation, cmapi.UsagesAnnotationKey, usageName)
-			}
-			newUsages = append(newUsages, usage)
+		newUsages, err := annotations.ParseKeyUsageList(usages)
+		if err != nil {
+			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.UsagesAnnotationKey, err)
 		}
 		crt.Spec.Usages = newUsages
 	}
 
 	if revisionHistoryLimit, found := ingLikeAnnotations[cmapi.RevisionHistoryLimitAnnotationKey]; found {
-		limit, err := strconv.ParseInt(revisionHistoryLimit, 10, 32)
+		limit, err := annotations.ParsePositiveInteger(revisionHistoryLimit)
 		if err != nil {
 			return fmt.Errorf("%w %q: %v", errInvalidIngressAnnotation, cmapi.RevisionHistoryLimitAnnotationKey, err)
 		}
-
-		if limit < 1 {
-			return fmt.Errorf("%w %q: revision history limit must be a positive number %q", errInvalidIngressAnnotation, cmapi.RevisionHistoryLimitAnnotationKey, revisionHistoryLimit)
-		}
-
-		crt.Spec.RevisionHistoryLimit = pointer.Int32(int32(limit))
+		crt.Spec.RevisionHistoryLimit = pointer.Int32(limit)
 	}
 
 	return nil
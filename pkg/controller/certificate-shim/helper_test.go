@@ -89,6 +89,24 @@ func Test_translateAnnotations(t *testing.T) {
 			},
 			expectedError: errInvalidIngressAnnotation,
 		},
+		"success with renewBeforePercentage": {
+			crt: gen.Certificate("example-cert"),
+			annotations: map[string]string{
+				cmapi.RenewBeforePercentageAnnotationKey: "33",
+			},
+			check: func(a *assert.Assertions, crt *cmapi.Certificate) {
+				a.Nil(crt.Spec.RenewBefore)
+				a.Equal(pointer.Int32(33), crt.Spec.RenewBeforePercentage)
+			},
+		},
+		"bad renewBeforePercentage": {
+			crt:         gen.Certificate("example-cert"),
+			annotations: validAnnotations(),
+			mutate: func(tc *testCase) {
+				tc.annotations[cmapi.RenewBeforePercentageAnnotationKey] = "a third"
+			},
+			expectedError: errInvalidIngressAnnotation,
+		},
 		"bad usages": {
 			crt:         gen.Certificate("example-cert"),
 			annotations: validAnnotations(),
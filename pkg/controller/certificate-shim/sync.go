@@ -23,6 +23,7 @@ import (
 	"reflect"
 	"strconv"
 	"strings"
+	"text/template"
 
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
@@ -119,7 +120,7 @@ func SyncFnFor(
 			return nil
 		}
 
-		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup)
+		newCrts, updateCrts, err := buildCertificates(rec, log, cmLister, ingLike, issuerName, issuerKind, issuerGroup, defaults.ExtraDNSNameTemplates)
 		if err != nil {
 			return err
 		}
@@ -296,6 +297,7 @@ func buildCertificates(
 	cmLister cmlisters.CertificateLister,
 	ingLike metav1.Object,
 	issuerName, issuerKind, issuerGroup string,
+	extraDNSNameTemplates []*template.Template,
 ) (new, update []*cmapi.Certificate, _ error) {
 
 	var newCrts []*cmapi.Certificate
@@ -360,6 +362,14 @@ func buildCertificates(
 			controllerGVK = gatewayGVK
 		}
 
+		dnsNames, err := withTemplatedDNSNames(extraDNSNameTemplates, ingLike, controllerGVK.Kind, hosts)
+		if err != nil {
+			if ingLikeObj, ok := ingLike.(runtime.Object); ok {
+				rec.Eventf(ingLikeObj, corev1.EventTypeWarning, reasonBadConfig, "Skipped Certificate %q: %s", secretRef.Name, err)
+			}
+			continue
+		}
+
 		crt := &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:            secretRef.Name,
@@ -368,7 +378,7 @@ func buildCertificates(
 				OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ingLike, controllerGVK)},
 			},
 			Spec: cmapi.CertificateSpec{
-				DNSNames:   hosts,
+				DNSNames:   dnsNames,
 				SecretName: secretRef.Name,
 				IssuerRef: cmmeta.ObjectReference{
 					Name:  issuerName,
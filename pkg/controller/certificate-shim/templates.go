@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shimhelper
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"text/template"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+// DNSNameTemplateData is the data that extra DNS name templates are executed
+// with. A template is executed once for every host of a TLS block or
+// listener, and can use the identity of the Ingress or Gateway to compute
+// the DNS names to add.
+type DNSNameTemplateData struct {
+	// Host is the host from the TLS block or listener, e.g. "www.example.com".
+	Host string
+
+	// Kind is the kind of the Ingress-like object, i.e. Ingress or Gateway.
+	Kind string
+
+	// Namespace and Name of the Ingress-like object.
+	Namespace string
+	Name      string
+
+	// Labels and Annotations of the Ingress-like object.
+	Labels      map[string]string
+	Annotations map[string]string
+}
+
+var dnsNameTemplateFuncs = template.FuncMap{
+	"lower":      strings.ToLower,
+	"replace":    func(old, new, s string) string { return strings.ReplaceAll(s, old, new) },
+	"trimPrefix": func(prefix, s string) string { return strings.TrimPrefix(s, prefix) },
+	"trimSuffix": func(suffix, s string) string { return strings.TrimSuffix(s, suffix) },
+	"hasSuffix":  func(suffix, s string) bool { return strings.HasSuffix(s, suffix) },
+}
+
+// ParseDNSNameTemplates parses the Go templates used to compute extra DNS
+// names for Certificates created by ingress-shim.
+// Besides the builtin functions, templates can use lower, replace, trimPrefix,
+// trimSuffix and hasSuffix, whose string argument comes last so that they can
+// be used in pipelines, e.g. `{{ .Host | trimSuffix ".example.com" }}.internal.corp`.
+func ParseDNSNameTemplates(texts []string) ([]*template.Template, error) {
+	var templates []*template.Template
+	for i, text := range texts {
+		tmpl, err := template.New(fmt.Sprintf("dns-name-%d", i)).
+			Option("missingkey=error").
+			Funcs(dnsNameTemplateFuncs).
+			Parse(text)
+		if err != nil {
+			return nil, err
+		}
+		templates = append(templates, tmpl)
+	}
+	return templates, nil
+}
+
+// withTemplatedDNSNames returns hosts followed by the DNS names computed by
+// executing every template for every host. Templates which render to an
+// empty string for a host are ignored, so that a template can choose which
+// hosts it applies to. Computed names which are already present are not
+// added again.
+func withTemplatedDNSNames(templates []*template.Template, ingLike metav1.Object, kind string, hosts []string) ([]string, error) {
+	if len(templates) == 0 {
+		return hosts, nil
+	}
+
+	dnsNames := append([]string(nil), hosts...)
+	seen := sets.NewString(hosts...)
+	for _, host := range hosts {
+		data := DNSNameTemplateData{
+			Host:        host,
+			Kind:        kind,
+			Namespace:   ingLike.GetNamespace(),
+			Name:        ingLike.GetName(),
+			Labels:      ingLike.GetLabels(),
+			Annotations: ingLike.GetAnnotations(),
+		}
+		for _, tmpl := range templates {
+			var buf bytes.Buffer
+			if err := tmpl.Execute(&buf, data); err != nil {
+				return nil, fmt.Errorf("failed to execute extra DNS name template %q for host %q: %w", tmpl.Root.String(), host, err)
+			}
+
+			dnsName := strings.TrimSpace(buf.String())
+			if dnsName == "" || seen.Has(dnsName) {
+				continue
+			}
+			if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(dnsName, "*.")); len(errs) > 0 {
+				return nil, fmt.Errorf("extra DNS name template %q rendered invalid DNS name %q for host %q: %s", tmpl.Root.String(), dnsName, host, strings.Join(errs, ", "))
+			}
+
+			seen.Insert(dnsName)
+			dnsNames = append(dnsNames, dnsName)
+		}
+	}
+	return dnsNames, nil
+}
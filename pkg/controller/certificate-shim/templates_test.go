@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package shimhelper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_withTemplatedDNSNames(t *testing.T) {
+	ing := &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "shop",
+			Name:      "frontend",
+			Labels:    map[string]string{"team": "payments"},
+		},
+	}
+
+	tests := map[string]struct {
+		templates []string
+		hosts     []string
+		expected  []string
+		expErr    bool
+	}{
+		"no templates returns the hosts unchanged": {
+			hosts:    []string{"www.example.com"},
+			expected: []string{"www.example.com"},
+		},
+		"appends a suffix to every host": {
+			templates: []string{"{{ .Host }}.internal.corp"},
+			hosts:     []string{"www.example.com", "api.example.com"},
+			expected:  []string{"www.example.com", "api.example.com", "www.example.com.internal.corp", "api.example.com.internal.corp"},
+		},
+		"can use functions and the identity of the object": {
+			templates: []string{`{{ .Host | trimSuffix ".example.com" }}.{{ .Name }}.{{ .Namespace }}.{{ .Labels.team }}.svc`},
+			hosts:     []string{"www.example.com"},
+			expected:  []string{"www.example.com", "www.frontend.shop.payments.svc"},
+		},
+		"templates rendering an empty string add nothing": {
+			templates: []string{`{{ if hasSuffix ".example.com" .Host }}{{ .Host | replace ".example.com" ".example.internal" }}{{ end }}`},
+			hosts:     []string{"www.example.com", "www.example.org"},
+			expected:  []string{"www.example.com", "www.example.org", "www.example.internal"},
+		},
+		"duplicate names are only added once": {
+			templates: []string{"internal.corp", "internal.corp"},
+			hosts:     []string{"www.example.com", "api.example.com"},
+			expected:  []string{"www.example.com", "api.example.com", "internal.corp"},
+		},
+		"wildcard hosts are preserved": {
+			templates: []string{"{{ .Host }}.internal.corp"},
+			hosts:     []string{"*.example.com"},
+			expected:  []string{"*.example.com", "*.example.com.internal.corp"},
+		},
+		"invalid rendered DNS names are an error": {
+			templates: []string{"{{ .Host }}_internal"},
+			hosts:     []string{"www.example.com"},
+			expErr:    true,
+		},
+		"missing map keys are an error": {
+			templates: []string{"{{ .Labels.missing }}.internal.corp"},
+			hosts:     []string{"www.example.com"},
+			expErr:    true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			templates, err := ParseDNSNameTemplates(test.templates)
+			if err != nil {
+				t.Fatalf("unexpected error parsing templates: %v", err)
+			}
+			dnsNames, err := withTemplatedDNSNames(templates, ing, "Ingress", test.hosts)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, test.expected, dnsNames)
+		})
+	}
+}
+
+func TestParseDNSNameTemplates(t *testing.T) {
+	_, err := ParseDNSNameTemplates([]string{"{{ .Host "})
+	assert.Error(t, err)
+}
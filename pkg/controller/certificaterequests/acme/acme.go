@@ -19,13 +19,17 @@ package acme
 import (
 	"context"
 	"crypto/x509"
+	"errors"
 	"fmt"
+	"net"
+	"strings"
 
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/tools/record"
 
 	"github.com/cert-manager/cert-manager/pkg/acme"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -35,6 +39,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -58,6 +63,14 @@ type ACME struct {
 
 	reporter *crutil.Reporter
 
+	// accountRegistry is used to discover the CAA identities of the ACME
+	// server when an issuer has the CAA pre-flight check enabled.
+	accountRegistry accounts.Getter
+	// dns01Nameservers are the nameservers used to look up CAA records.
+	dns01Nameservers []string
+	// validateCAA is used to be able to mock dnsutil.ValidateCAA in tests.
+	validateCAA func(domain string, issuerID []string, iswildcard bool, nameservers []string) error
+
 	// fieldManager is the manager name used for Create and Apply operations.
 	fieldManager string
 }
@@ -86,6 +99,10 @@ func NewACME(ctx *controllerpkg.Context) certificaterequests.Issuer {
 		acmeClientV:   ctx.CMClient.AcmeV1(),
 		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
 		fieldManager:  ctx.FieldManager,
+
+		accountRegistry:  ctx.ACMEOptions.AccountRegistry,
+		dns01Nameservers: ctx.ACMEOptions.DNS01Nameservers,
+		validateCAA:      dnsutil.ValidateCAA,
 	}
 }
 
@@ -134,6 +151,28 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 
 	order, err := a.orderLister.Orders(expectedOrder.Namespace).Get(expectedOrder.Name)
 	if k8sErrors.IsNotFound(err) {
+		if issuer.GetSpec().ACME.EnableCAAPreflightCheck {
+			err := a.checkCAA(ctx, issuer, expectedOrder.Spec.DNSNames)
+			if errors.Is(err, dnsutil.ErrCAARecordMismatch) {
+				message := "CAA records do not authorize the ACME server to issue for the requested DNS names"
+
+				a.reporter.Failed(cr, err, "CAAPreflightCheckFailed", message)
+				log.V(logf.DebugLevel).Info(fmt.Sprintf("%s: %s", message, err))
+
+				return nil, nil
+			}
+			if err != nil {
+				// Failing to look up the CAA records is most likely network
+				// related, so we should backoff and keep trying.
+				message := "Failed to check CAA records before creating order"
+
+				a.reporter.Pending(cr, err, "CAAPreflightCheckError", message)
+				log.Error(err, message)
+
+				return nil, err
+			}
+		}
+
 		// Failing to create the order here is most likely network related.
 		// We should backoff and keep trying.
 		_, err = a.acmeClientV.Orders(expectedOrder.Namespace).Create(ctx, expectedOrder, metav1.CreateOptions{FieldManager: a.fieldManager})
@@ -221,6 +260,42 @@ func (a *ACME) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuer cm
 	}, nil
 }
 
+// checkCAA verifies that the CAA records of each of the given DNS names
+// authorize at least one of the CAA identities advertised in the ACME
+// server's directory. If a DNS name is not authorized, the returned error
+// wraps dnsutil.ErrCAARecordMismatch.
+func (a *ACME) checkCAA(ctx context.Context, issuer cmapi.GenericIssuer, dnsNames []string) error {
+	cl, err := accounts.GetClientForAccount(a.accountRegistry, string(issuer.GetUID()), "")
+	if err != nil {
+		return err
+	}
+
+	dir, err := cl.Discover(ctx)
+	if err != nil {
+		return err
+	}
+	// An ACME server that does not advertise any CAA identities gives us
+	// nothing to check the records against.
+	if len(dir.CAA) == 0 {
+		return nil
+	}
+
+	for _, dnsName := range dnsNames {
+		// CAA records only apply to domain names (RFC 8738, section 7)
+		if net.ParseIP(dnsName) != nil {
+			continue
+		}
+
+		wildcard := strings.HasPrefix(dnsName, "*.")
+		domain := strings.TrimPrefix(dnsName, "*.")
+		if err := a.validateCAA(domain, dir.CAA, wildcard, a.dns01Nameservers); err != nil {
+			return fmt.Errorf("%s: %w", dnsName, err)
+		}
+	}
+
+	return nil
+}
+
 // Build order. If we error here it is a terminating failure.
 func buildOrder(cr *cmapi.CertificateRequest, csr *x509.CertificateRequest, enableDurationFeature bool) (*cmacme.Order, error) {
 	var ipAddresses []string
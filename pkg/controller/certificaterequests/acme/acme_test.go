@@ -30,11 +30,14 @@ import (
 	"testing"
 	"time"
 
+	acmeapi "golang.org/x/crypto/acme"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
@@ -45,6 +48,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	dnsutil "github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 	testlisters "github.com/cert-manager/cert-manager/test/unit/listers"
@@ -113,6 +117,10 @@ func TestSign(t *testing.T) {
 		}),
 	)
 
+	caaIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerACME(cmacme.ACMEIssuer{EnableCAAPreflightCheck: true}),
+	)
+
 	rootPK, err := pki.GenerateECPrivateKey(256)
 	if err != nil {
 		t.Fatal()
@@ -377,6 +385,101 @@ func TestSign(t *testing.T) {
 			},
 		},
 
+		"if the CAA pre-flight check passes then attempt to create an order": {
+			certificateRequest: baseCR.DeepCopy(),
+			validateCAA: func(domain string, issuerID []string, iswildcard bool, nameservers []string) error {
+				return nil
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), caaIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal OrderCreated Created Order resource default-unit-test-ns/test-cr-1733622556",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewCreateAction(
+						cmacme.SchemeGroupVersion.WithResource("orders"),
+						gen.DefaultTestNamespace,
+						baseOrder,
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Created Order resource default-unit-test-ns/test-cr-1733622556",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the CAA records do not authorize the ACME server then hard fail without creating an order": {
+			certificateRequest: baseCR.DeepCopy(),
+			validateCAA: func(domain string, issuerID []string, iswildcard bool, nameservers []string) error {
+				if domain == "foo.com" {
+					return dnsutil.ErrCAARecordMismatch
+				}
+				return nil
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), caaIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning CAAPreflightCheckFailed CAA records do not authorize the ACME server to issue for the requested DNS names: foo.com: CAA record does not match issuer",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "CAA records do not authorize the ACME server to issue for the requested DNS names: foo.com: CAA record does not match issuer",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"if the CAA records cannot be looked up then report pending and retry": {
+			certificateRequest: baseCR.DeepCopy(),
+			validateCAA: func(domain string, issuerID []string, iswildcard bool, nameservers []string) error {
+				return errors.New("simulated lookup failure")
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), caaIssuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CAAPreflightCheckError Failed to check CAA records before creating order: example.com: simulated lookup failure",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to check CAA records before creating order: example.com: simulated lookup failure",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+
 		//TODO: Think of a creative way to get `buildOrder` to fail :thinking_face:
 
 		"if order doesn't exist then attempt to create one": {
@@ -646,6 +749,8 @@ type testT struct {
 	expectedErr bool
 
 	fakeOrderLister *testlisters.FakeOrderLister
+
+	validateCAA func(domain string, issuerID []string, iswildcard bool, nameservers []string) error
 }
 
 func runTest(t *testing.T, test testT) {
@@ -657,6 +762,18 @@ func runTest(t *testing.T, test testT) {
 	if test.fakeOrderLister != nil {
 		ac.orderLister = test.fakeOrderLister
 	}
+	if test.validateCAA != nil {
+		ac.validateCAA = test.validateCAA
+		ac.accountRegistry = &accountstest.FakeRegistry{
+			GetClientFunc: func(string) (acmecl.Interface, error) {
+				return &acmecl.FakeACME{
+					FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+						return acmeapi.Directory{CAA: []string{"example-ca.com"}}, nil
+					},
+				}, nil
+			},
+		}
+	}
 
 	controller := certificaterequests.New(
 		apiutil.IssuerACME,
@@ -26,6 +26,7 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/cert-manager/cert-manager/internal/approvalwebhook"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -37,17 +38,24 @@ const (
 )
 
 // Controller is a CertificateRequest controller which manages the "Approved"
-// condition. In the absence of any automated policy engine, this controller
-// will _always_ set the "Approved" condition to True. All CertificateRequest
-// signing controllers should wait until the "Approved" condition is set to
-// True before processing.
+// condition. CertificateRequests are denied if the
+// CertificateRequestPolicies selecting them all deny them. Otherwise they are
+// approved, unless an approval webhook is configured, which then decides
+// whether they are approved. All CertificateRequest signing controllers
+// should wait until the "Approved" condition is set to True before
+// processing.
 type Controller struct {
 	// logger to be used by this controller
 	log logr.Logger
 
-	certificateRequestLister cmlisters.CertificateRequestLister
-	cmClient                 cmclient.Interface
-	fieldManager             string
+	certificateRequestLister       cmlisters.CertificateRequestLister
+	certificateRequestPolicyLister cmlisters.CertificateRequestPolicyLister
+	cmClient                       cmclient.Interface
+	fieldManager                   string
+
+	// approvalWebhook, if set, is asked to approve CertificateRequests which
+	// are not denied by a CertificateRequestPolicy.
+	approvalWebhook *approvalwebhook.Client
 
 	recorder record.EventRecorder
 
@@ -70,12 +78,18 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.queue = workqueue.NewNamedRateLimitingQueue(controllerpkg.DefaultItemBasedRateLimiter(), ControllerName)
 
 	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
-	mustSync := []cache.InformerSynced{certificateRequestInformer.Informer().HasSynced}
+	certificateRequestPolicyInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequestPolicies()
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		certificateRequestPolicyInformer.Informer().HasSynced,
+	}
 	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
 
 	c.certificateRequestLister = certificateRequestInformer.Lister()
+	c.certificateRequestPolicyLister = certificateRequestPolicyInformer.Lister()
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.approvalWebhook = ctx.ApprovalWebhook
 	c.recorder = ctx.Recorder
 
 	c.log.V(logf.DebugLevel).Info("certificate request approver controller registered")
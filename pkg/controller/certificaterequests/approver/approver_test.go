@@ -18,6 +18,12 @@ package approver
 
 import (
 	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
 	"testing"
 	"time"
 
@@ -25,16 +31,43 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/approvalwebhook"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func TestProcessItem(t *testing.T) {
 	// now time is the current time at the start of the test (the clock is fixed)
 	now := time.Now()
 	metaNow := metav1.NewTime(now)
+
+	csr, _, err := gen.CSR(x509.RSA, gen.SetCSRDNSNames("app.example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	examplePolicy := &cmapi.CertificateRequestPolicy{
+		ObjectMeta: metav1.ObjectMeta{Name: "example"},
+		Spec: cmapi.CertificateRequestPolicySpec{
+			Selector: cmapi.CertificateRequestPolicySelector{
+				IssuerRef: cmapi.CertificateRequestPolicyIssuerRef{Name: "ca-issuer"},
+			},
+			Rules: []cmapi.CertificateRequestPolicyRule{
+				{
+					Name:       "example-only",
+					Expression: "csr.dnsNames.all(n, n.endsWith('.example.com'))",
+				},
+				{
+					Name:       "team-a",
+					Expression: "request.namespace == 'team-a'",
+					Message:    "only team-a may use this issuer",
+				},
+			},
+		},
+	}
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'CertificateRequest' field will be used.
@@ -45,6 +78,13 @@ func TestProcessItem(t *testing.T) {
 		// if not set, the 'key' will be passed to ProcessItem instead.
 		request *cmapi.CertificateRequest
 
+		// policies are the CertificateRequestPolicies which exist for the test.
+		policies []*cmapi.CertificateRequestPolicy
+
+		// webhookResponse, if set, is the body of the responses of the
+		// approval webhook, which is only configured if it is set.
+		webhookResponse string
+
 		// expectedEvent, if set, is an 'event string' that is expected to be fired.
 		expectedEvent string
 
@@ -165,6 +205,123 @@ func TestProcessItem(t *testing.T) {
 			},
 			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
 		},
+		"approve CertificateRequest not selected by any policy": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "other-issuer"}),
+			),
+			policies: []*cmapi.CertificateRequestPolicy{examplePolicy},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            ApprovedMessage,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io",
+		},
+		"approve CertificateRequest satisfying a selecting policy": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("team-a"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+			),
+			policies: []*cmapi.CertificateRequestPolicy{examplePolicy},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `Certificate request has been approved by cert-manager.io according to CertificateRequestPolicy "example"`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Normal cert-manager.io Certificate request has been approved by cert-manager.io according to CertificateRequestPolicy "example"`,
+		},
+		"deny CertificateRequest not satisfying a selecting policy": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+			),
+			policies: []*cmapi.CertificateRequestPolicy{examplePolicy},
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `Certificate request has been denied by cert-manager.io: policy "example": rule "team-a": only team-a may use this issuer`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io Certificate request has been denied by cert-manager.io: policy "example": rule "team-a": only team-a may use this issuer`,
+		},
+		"deny CertificateRequest denied by the approval webhook": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "other-issuer"}),
+			),
+			webhookResponse: `{"decision": "Deny", "message": "ticket CERT-1 was rejected"}`,
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            "Certificate request has been denied by cert-manager.io: approval webhook: ticket CERT-1 was rejected",
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Warning cert-manager.io Certificate request has been denied by cert-manager.io: approval webhook: ticket CERT-1 was rejected",
+		},
+		"approve CertificateRequest approved by the approval webhook": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "other-issuer"}),
+			),
+			webhookResponse: `{"decision": "Approve", "message": "ticket CERT-2 was approved"}`,
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionApproved,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            "Certificate request has been approved by cert-manager.io according to the approval webhook: ticket CERT-2 was approved",
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: "Normal cert-manager.io Certificate request has been approved by cert-manager.io according to the approval webhook: ticket CERT-2 was approved",
+		},
+		"do nothing if the approval webhook leaves the CertificateRequest pending": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "other-issuer"}),
+			),
+			webhookResponse: `{"decision": "Pending"}`,
+		},
+		"do not send CertificateRequests denied by a policy to the approval webhook": {
+			request: gen.CertificateRequest("test",
+				gen.SetCertificateRequestNamespace("testns"),
+				gen.SetCertificateRequestCSR(csr),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca-issuer"}),
+			),
+			policies:        []*cmapi.CertificateRequestPolicy{examplePolicy},
+			webhookResponse: `{"decision": "Approve"}`,
+			expectedConditions: []cmapi.CertificateRequestCondition{
+				{
+					Type:               cmapi.CertificateRequestConditionDenied,
+					Status:             cmmeta.ConditionTrue,
+					Reason:             "cert-manager.io",
+					Message:            `Certificate request has been denied by cert-manager.io: policy "example": rule "team-a": only team-a may use this issuer`,
+					LastTransitionTime: &metaNow,
+				},
+			},
+			expectedEvent: `Warning cert-manager.io Certificate request has been denied by cert-manager.io: policy "example": rule "team-a": only team-a may use this issuer`,
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -176,8 +333,30 @@ func TestProcessItem(t *testing.T) {
 			if test.request != nil {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, test.request)
 			}
+			for _, policy := range test.policies {
+				builder.CertManagerObjects = append(builder.CertManagerObjects, policy)
+			}
 			builder.Init()
 
+			if test.webhookResponse != "" {
+				server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+					w.Write([]byte(test.webhookResponse))
+				}))
+				defer server.Close()
+
+				caFile := filepath.Join(t.TempDir(), "ca.crt")
+				caPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw})
+				if err := os.WriteFile(caFile, caPEM, 0600); err != nil {
+					t.Fatal(err)
+				}
+
+				webhook, err := approvalwebhook.New(approvalwebhook.Options{URL: server.URL, CAFile: caFile, Timeout: time.Second})
+				if err != nil {
+					t.Fatal(err)
+				}
+				builder.Context.ApprovalWebhook = webhook
+			}
+
 			c := new(Controller)
 			_, _, err := c.Register(builder.Context)
 			if err != nil {
@@ -18,25 +18,38 @@ package approver
 
 import (
 	"context"
+	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 
+	"github.com/cert-manager/cert-manager/internal/approvalpolicy"
+	"github.com/cert-manager/cert-manager/internal/approvalwebhook"
 	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 const (
 	ApprovedMessage = "Certificate request has been approved by cert-manager.io"
+	DeniedMessage   = "Certificate request has been denied by cert-manager.io"
+
+	// pendingRequeueDelay is how long CertificateRequests left pending by the
+	// approval webhook wait before being sent to it again.
+	pendingRequeueDelay = time.Minute
 )
 
 // Sync will set the "Approved" condition to True on synced
-// CertificateRequests. If the "Denied", "Approved" or "Ready" condition
+// CertificateRequests, or the "Denied" condition to True if they are
+// selected by CertificateRequestPolicies which all deny them or are denied
+// by the approval webhook. If the "Denied", "Approved" or "Ready" condition
 // already exists, exit early.
 func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (err error) {
 	log := logf.FromContext(ctx, "approver")
@@ -55,26 +68,90 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	policies, err := c.certificateRequestPolicyLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	decision := approvalpolicy.Evaluate(policies, cr)
+	if !decision.Approved {
+		return c.deny(ctx, cr, decision.Message)
+	}
+
+	message := ApprovedMessage
+	if decision.Selected {
+		message = fmt.Sprintf("%s according to CertificateRequestPolicy %q", ApprovedMessage, decision.Policy)
+	}
+
+	if c.approvalWebhook != nil {
+		resp, err := c.approvalWebhook.Review(ctx, cr)
+		if err != nil {
+			return err
+		}
+
+		switch resp.Decision {
+		case approvalwebhook.DecisionDeny:
+			return c.deny(ctx, cr, "approval webhook: "+resp.Message)
+		case approvalwebhook.DecisionPending:
+			log.V(logf.DebugLevel).Info("certificate request is pending approval by the approval webhook", "message", resp.Message)
+			key, err := controllerpkg.KeyFunc(cr)
+			if err != nil {
+				return err
+			}
+			c.queue.AddAfter(key, pendingRequeueDelay)
+			return nil
+		}
+
+		message = fmt.Sprintf("%s according to the approval webhook", ApprovedMessage)
+		if len(resp.Message) > 0 {
+			message = fmt.Sprintf("%s: %s", message, resp.Message)
+		}
+	}
+
 	// Update the CertificateRequest approved condition to true.
 	cr = cr.DeepCopy()
 	apiutil.SetCertificateRequestCondition(cr,
 		cmapi.CertificateRequestConditionApproved,
 		cmmeta.ConditionTrue,
 		"cert-manager.io",
-		ApprovedMessage,
+		message,
 	)
 
 	// Update CertificateRequest with
 	if err := c.updateStatusOrApply(ctx, cr); err != nil {
 		return err
 	}
-	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", ApprovedMessage)
+	c.recorder.Event(cr, corev1.EventTypeNormal, "cert-manager.io", message)
 
 	log.V(logf.DebugLevel).Info("approved certificate request")
 
 	return nil
 }
 
+// deny sets the "Denied" condition to True on the CertificateRequest, with a
+// message explaining why it was denied.
+func (c *Controller) deny(ctx context.Context, cr *cmapi.CertificateRequest, reason string) error {
+	log := logf.FromContext(ctx, "approver")
+
+	// Update the CertificateRequest denied condition to true.
+	message := fmt.Sprintf("%s: %s", DeniedMessage, reason)
+	cr = cr.DeepCopy()
+	apiutil.SetCertificateRequestCondition(cr,
+		cmapi.CertificateRequestConditionDenied,
+		cmmeta.ConditionTrue,
+		"cert-manager.io",
+		message,
+	)
+
+	if err := c.updateStatusOrApply(ctx, cr); err != nil {
+		return err
+	}
+	c.recorder.Event(cr, corev1.EventTypeWarning, "cert-manager.io", message)
+
+	log.V(logf.DebugLevel).Info("denied certificate request", "reason", reason)
+
+	return nil
+}
+
 func (c *Controller) updateStatusOrApply(ctx context.Context, cr *cmapi.CertificateRequest) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
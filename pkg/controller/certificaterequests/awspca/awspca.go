@@ -0,0 +1,235 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awspca
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	awspcaclient "github.com/cert-manager/cert-manager/pkg/issuer/awspca/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-awspca"
+
+	// The templates used when the issuer does not specify one. Templates are
+	// global resources, so their ARNs have no region or account.
+	endEntityTemplate = "template/EndEntityCertificate/V1"
+	caTemplate        = "template/SubordinateCACertificate_PathLen0/V1"
+)
+
+type AWSPCA struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	clock         clock.Clock
+	userAgent     string
+
+	clientBuilder awspcaclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the AWS Private CA issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerAWSPCA, NewAWSPCA)).
+			Complete()
+	})
+}
+
+func NewAWSPCA(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &AWSPCA{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		clock:         ctx.Clock,
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: awspcaclient.New,
+	}
+}
+
+// Sign submits the CertificateRequest to the AWS Private CA, recording the
+// ARN of the requested certificate on the CertificateRequest. Issuance is
+// asynchronous, so the certificate is collected using that ARN on a later
+// sync.
+func (a *AWSPCA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().AWSPCA
+	resourceNamespace, err := a.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		a.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := a.clientBuilder(resourceNamespace, a.secretsLister, issuerObj,
+		a.issuerOptions.CanUseAmbientCredentials(issuerObj), a.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		a.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise AWS Private CA client for signing"
+
+		a.reporter.Pending(cr, err, "AWSPCAInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	certificateArn := cr.ObjectMeta.Annotations[cmapi.AWSPCACertificateArnAnnotationKey]
+	if certificateArn == "" {
+		input, err := a.issueCertificateInput(ctx, client, cfg, cr)
+		if err != nil {
+			message := "Failed to describe AWS Private CA"
+
+			a.reporter.Pending(cr, err, "AWSPCAError", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+
+		out, err := client.IssueCertificateWithContext(ctx, input)
+		if err != nil {
+			message := "Failed to request certificate from AWS Private CA"
+
+			a.reporter.Failed(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+
+		a.reporter.Pending(cr, nil, "IssuancePending", "AWS Private CA certificate is requested")
+
+		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, cmapi.AWSPCACertificateArnAnnotationKey, aws.StringValue(out.CertificateArn))
+
+		return nil, nil
+	}
+
+	out, err := client.GetCertificateWithContext(ctx, &acmpca.GetCertificateInput{
+		CertificateArn:          aws.String(certificateArn),
+		CertificateAuthorityArn: aws.String(cfg.Arn),
+	})
+	if err != nil {
+		if aerr, ok := err.(awserr.Error); ok && aerr.Code() == acmpca.ErrCodeRequestInProgressException {
+			message := "AWS Private CA certificate still in a pending state, the request will be retried"
+
+			a.reporter.Pending(cr, err, "IssuancePending", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+
+		message := "Failed to obtain AWS Private CA certificate"
+
+		a.reporter.Failed(cr, err, "RetrieveError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	certPEM := []byte(aws.StringValue(out.Certificate) + "\n" + aws.StringValue(out.CertificateChain))
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(certPEM)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		a.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// issueCertificateInput builds the request for a certificate from the
+// CertificateRequest. The signing algorithm must match the key type of the
+// certificate authority, so it is read from the certificate authority itself.
+func (a *AWSPCA) issueCertificateInput(ctx context.Context, client acmpcaiface.ACMPCAAPI, cfg *cmapi.AWSPCAIssuer, cr *cmapi.CertificateRequest) (*acmpca.IssueCertificateInput, error) {
+	out, err := client.DescribeCertificateAuthorityWithContext(ctx, &acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(cfg.Arn),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if out.CertificateAuthority.CertificateAuthorityConfiguration == nil {
+		return nil, fmt.Errorf("certificate authority %q has no configuration", cfg.Arn)
+	}
+
+	templateArn := cfg.TemplateArn
+	if templateArn == "" {
+		caArn, err := arn.Parse(cfg.Arn)
+		if err != nil {
+			return nil, err
+		}
+		resource := endEntityTemplate
+		if cr.Spec.IsCA {
+			resource = caTemplate
+		}
+		templateArn = arn.ARN{
+			Partition: caArn.Partition,
+			Service:   caArn.Service,
+			Resource:  resource,
+		}.String()
+	}
+
+	notAfter := a.clock.Now().Add(apiutil.DefaultCertDuration(cr.Spec.Duration))
+	return &acmpca.IssueCertificateInput{
+		CertificateAuthorityArn: aws.String(cfg.Arn),
+		Csr:                     cr.Spec.Request,
+		SigningAlgorithm:        out.CertificateAuthority.CertificateAuthorityConfiguration.SigningAlgorithm,
+		TemplateArn:             aws.String(templateArn),
+		Validity: &acmpca.Validity{
+			Type:  aws.String(acmpca.ValidityPeriodTypeAbsolute),
+			Value: aws.Int64(notAfter.Unix()),
+		},
+		// The UID ensures that retrying a request does not issue a second
+		// certificate.
+		IdempotencyToken: aws.String(string(cr.UID)),
+	}, nil
+}
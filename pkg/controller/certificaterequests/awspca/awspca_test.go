@@ -0,0 +1,324 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awspca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/awspca/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+const (
+	caArn          = "arn:aws:acm-pca:eu-west-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"
+	certificateArn = caArn + "/certificate/0123456789abcdef"
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerAWSPCA(cmapi.AWSPCAIssuer{Arn: caArn}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+	requestedCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{cmapi.AWSPCACertificateArnAnnotationKey: certificateArn}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, _, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	describeCA := func(*acmpca.DescribeCertificateAuthorityInput) (*acmpca.DescribeCertificateAuthorityOutput, error) {
+		return &acmpca.DescribeCertificateAuthorityOutput{
+			CertificateAuthority: &acmpca.CertificateAuthority{
+				Status: aws.String(acmpca.CertificateAuthorityStatusActive),
+				CertificateAuthorityConfiguration: &acmpca.CertificateAuthorityConfiguration{
+					SigningAlgorithm: aws.String(acmpca.SigningAlgorithmSha256withecdsa),
+				},
+			},
+		}, nil
+	}
+
+	tests := map[string]struct {
+		certificateRequest *cmapi.CertificateRequest
+		client             *fake.ACMPCA
+		builder            *controllertest.Builder
+		expectedErr        bool
+	}{
+		"request a certificate and record its ARN if one has not been requested yet": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.ACMPCA{
+				DescribeCertificateAuthorityFn: describeCA,
+				IssueCertificateFn: func(in *acmpca.IssueCertificateInput) (*acmpca.IssueCertificateOutput, error) {
+					if got := aws.StringValue(in.SigningAlgorithm); got != acmpca.SigningAlgorithmSha256withecdsa {
+						t.Errorf("unexpected signing algorithm %q", got)
+					}
+					if got := aws.StringValue(in.TemplateArn); got != "arn:aws:acm-pca:::template/EndEntityCertificate/V1" {
+						t.Errorf("unexpected template ARN %q", got)
+					}
+					notAfter := fixedClockStart.Add(cmapi.DefaultCertificateDuration).Unix()
+					if got := aws.Int64Value(in.Validity.Value); got != notAfter {
+						t.Errorf("unexpected validity, exp=%d got=%d", notAfter, got)
+					}
+					return &acmpca.IssueCertificateOutput{CertificateArn: aws.String(certificateArn)}, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending AWS Private CA certificate is requested",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "AWS Private CA certificate is requested",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if requesting the certificate fails then set failed and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.ACMPCA{
+				DescribeCertificateAuthorityFn: describeCA,
+				IssueCertificateFn: func(*acmpca.IssueCertificateInput) (*acmpca.IssueCertificateOutput, error) {
+					return nil, errors.New("this is an error")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError Failed to request certificate from AWS Private CA: this is an error",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Failed to request certificate from AWS Private CA: this is an error",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if the certificate is still being issued then set pending and return error": {
+			certificateRequest: requestedCR.DeepCopy(),
+			client: &fake.ACMPCA{
+				GetCertificateFn: func(*acmpca.GetCertificateInput) (*acmpca.GetCertificateOutput, error) {
+					return nil, awserr.New(acmpca.ErrCodeRequestInProgressException, "in progress", nil)
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{requestedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal IssuancePending AWS Private CA certificate still in a pending state, the request will be retried: RequestInProgressException: in progress",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "AWS Private CA certificate still in a pending state, the request will be retried: RequestInProgressException: in progress",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if the certificate has been issued then return it": {
+			certificateRequest: requestedCR.DeepCopy(),
+			client: &fake.ACMPCA{
+				GetCertificateFn: func(in *acmpca.GetCertificateInput) (*acmpca.GetCertificateOutput, error) {
+					if got := aws.StringValue(in.CertificateArn); got != certificateArn {
+						t.Errorf("unexpected certificate ARN %q", got)
+					}
+					return &acmpca.GetCertificateOutput{
+						Certificate:      aws.String(string(certPEM)),
+						CertificateChain: aws.String(string(rootPEM)),
+					}, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{requestedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			a := NewAWSPCA(test.builder.Context).(*AWSPCA)
+			a.clientBuilder = func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (acmpcaiface.ACMPCAAPI, error) {
+				return test.client, nil
+			}
+
+			controller := certificaterequests.New(
+				apiutil.IssuerAWSPCA,
+				func(*controller.Context) certificaterequests.Issuer { return a },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), test.certificateRequest)
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurekeyvault
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	azurekeyvaultclient "github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-azurekeyvault"
+)
+
+type templateGenerator func(*cmapi.CertificateRequest) (*x509.Certificate, error)
+type signingFn func([]*x509.Certificate, crypto.Signer, *x509.Certificate) (pki.PEMBundle, error)
+
+type AzureKeyVault struct {
+	issuerOptions   controllerpkg.IssuerOptions
+	serviceAccounts corev1client.ServiceAccountsGetter
+	reporter        *crutil.Reporter
+	userAgent       string
+
+	clientBuilder     azurekeyvaultclient.Builder
+	templateGenerator templateGenerator
+	signingFn         signingFn
+}
+
+func init() {
+	// create certificate request controller for the Azure Key Vault issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerAzureKeyVault, NewAzureKeyVault)).
+			Complete()
+	})
+}
+
+func NewAzureKeyVault(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &AzureKeyVault{
+		issuerOptions:     ctx.IssuerOptions,
+		serviceAccounts:   ctx.Client.CoreV1(),
+		reporter:          crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:         ctx.RESTConfig.UserAgent,
+		clientBuilder:     azurekeyvaultclient.New,
+		templateGenerator: pki.GenerateTemplateFromCertificateRequest,
+		signingFn:         pki.SignCSRTemplate,
+	}
+}
+
+// Sign signs the CertificateRequest using the key of the CA certificate in
+// Azure Key Vault. The certificate is built locally and only its digest is
+// sent to Key Vault to be signed.
+func (a *AzureKeyVault) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().AzureKeyVault
+	resourceNamespace, err := a.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		a.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := a.clientBuilder(resourceNamespace, a.serviceAccounts, issuerObj,
+		a.issuerOptions.CanUseAmbientCredentials(issuerObj), a.userAgent)
+	if err != nil {
+		message := "Failed to initialise Azure Key Vault client for signing"
+
+		a.reporter.Pending(cr, err, "AzureKeyVaultInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	caCert, keyID, err := client.GetCertificate(ctx, cfg.CertificateName)
+	if err != nil {
+		message := "Failed to get CA certificate from Azure Key Vault"
+
+		a.reporter.Pending(cr, err, "AzureKeyVaultError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	template, err := a.templateGenerator(cr)
+	if err != nil {
+		message := "Error generating certificate template"
+		a.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
+	signer := azurekeyvaultclient.NewSigner(ctx, client, keyID, caCert.PublicKey)
+	bundle, err := a.signingFn([]*x509.Certificate{caCert}, signer, template)
+	if err != nil {
+		message := "Error signing certificate"
+		a.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
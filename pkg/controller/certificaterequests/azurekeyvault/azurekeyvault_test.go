@@ -0,0 +1,349 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurekeyvault
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	azurekeyvaultclient "github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+const keyID = "https://my-vault.vault.azure.net/keys/my-ca/0123456789abcdef"
+
+// keyVaultCA returns a CA certificate together with a fake Key Vault which
+// holds its key and signs digests in the same format as Key Vault does.
+func keyVaultCA(t *testing.T) (*x509.Certificate, []byte, *fake.KeyVault) {
+	caPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             caPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "key-vault-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Hour),
+		KeyUsage:  x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	caPEM, caCert, err := pki.SignCertificate(caTmpl, caTmpl, caPK.Public(), caPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return caCert, caPEM, &fake.KeyVault{
+		GetCertificateFn: func(name string) (*x509.Certificate, string, error) {
+			if name != "my-ca" {
+				t.Errorf("unexpected certificate name %q", name)
+			}
+			return caCert, keyID, nil
+		},
+		SignFn: func(id string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error) {
+			if id != keyID || algorithm != keyvault.ES256 {
+				t.Errorf("unexpected sign request for key %q with algorithm %q", id, algorithm)
+			}
+			r, s, err := ecdsa.Sign(rand.Reader, caPK, digest)
+			if err != nil {
+				return nil, err
+			}
+			sig := make([]byte, 64)
+			r.FillBytes(sig[:32])
+			s.FillBytes(sig[32:])
+			return sig, nil
+		},
+	}
+}
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	caCert, caPEM, kv := keyVaultCA(t)
+
+	csrPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerAzureKeyVault(cmapi.AzureKeyVaultIssuer{
+			VaultURL:        "https://my-vault.vault.azure.net/",
+			CertificateName: "my-ca",
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+
+	pendingCR := func(message string) *cmapi.CertificateRequest {
+		return gen.CertificateRequestFrom(baseCR,
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionReady,
+				Status:             cmmeta.ConditionFalse,
+				Reason:             cmapi.CertificateRequestReasonPending,
+				Message:            message,
+				LastTransitionTime: &metaFixedClockStart,
+			}),
+		)
+	}
+
+	tests := map[string]struct {
+		client      azurekeyvaultclient.Interface
+		clientErr   error
+		signingFn   signingFn
+		builder     *controllertest.Builder
+		expectedErr bool
+	}{
+		"if the client cannot be built then set pending and return error": {
+			clientErr: errors.New("no service account configured and ambient credentials are not permitted for this issuer"),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal AzureKeyVaultInitError Failed to initialise Azure Key Vault client for signing: no service account configured and ambient credentials are not permitted for this issuer",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						pendingCR("Failed to initialise Azure Key Vault client for signing: no service account configured and ambient credentials are not permitted for this issuer"),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if the CA certificate cannot be read then set pending and return error": {
+			client: &fake.KeyVault{
+				GetCertificateFn: func(string) (*x509.Certificate, string, error) {
+					return nil, "", errors.New("forbidden")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal AzureKeyVaultError Failed to get CA certificate from Azure Key Vault: forbidden",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						pendingCR("Failed to get CA certificate from Azure Key Vault: forbidden"),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"sign the certificate using the Key Vault key": {
+			client: kv,
+			signingFn: func(caCerts []*x509.Certificate, signer crypto.Signer, _ *x509.Certificate) (pki.PEMBundle, error) {
+				if len(caCerts) != 1 || !caCerts[0].Equal(caCert) {
+					t.Errorf("unexpected CA certificates %v", caCerts)
+				}
+				if _, ok := signer.(*azurekeyvaultclient.Signer); !ok {
+					t.Errorf("expected a Key Vault signer, got %T", signer)
+				}
+				// the returned chain only has to decode, so return the CA itself
+				return pki.PEMBundle{ChainPEM: caPEM, CAPEM: caPEM}, nil
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(caPEM),
+							gen.SetCertificateRequestCA(caPEM),
+						),
+					)),
+				},
+			},
+		},
+		"if Key Vault fails to sign then set failed and return error": {
+			client: &fake.KeyVault{
+				GetCertificateFn: kv.GetCertificateFn,
+				SignFn: func(string, keyvault.JSONWebKeySignatureAlgorithm, []byte) ([]byte, error) {
+					return nil, errors.New("key is disabled")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning SigningError Error signing certificate: error creating x509 certificate: key is disabled",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Error signing certificate: error creating x509 certificate: key is disabled",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			a := NewAzureKeyVault(test.builder.Context).(*AzureKeyVault)
+			a.clientBuilder = func(string, corev1client.ServiceAccountsGetter, cmapi.GenericIssuer, bool, string) (azurekeyvaultclient.Interface, error) {
+				if test.clientErr != nil {
+					return nil, test.clientErr
+				}
+				return test.client, nil
+			}
+			if test.signingFn != nil {
+				a.signingFn = test.signingFn
+			}
+
+			controller := certificaterequests.New(
+				apiutil.IssuerAzureKeyVault,
+				func(*controller.Context) certificaterequests.Issuer { return a },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), baseCR.DeepCopy())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestAzureKeyVault_Sign(t *testing.T) {
+	caCert, _, kv := keyVaultCA(t)
+
+	csrPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestCSR(csrPEM))
+	issuer := gen.Issuer("test-issuer", gen.SetIssuerAzureKeyVault(cmapi.AzureKeyVaultIssuer{
+		VaultURL:        "https://my-vault.vault.azure.net/",
+		CertificateName: "my-ca",
+	}))
+
+	builder := &controllertest.Builder{T: t, Clock: fixedClock}
+	builder.InitWithRESTConfig()
+	defer builder.Stop()
+
+	a := NewAzureKeyVault(builder.Context).(*AzureKeyVault)
+	a.clientBuilder = func(string, corev1client.ServiceAccountsGetter, cmapi.GenericIssuer, bool, string) (azurekeyvaultclient.Interface, error) {
+		return kv, nil
+	}
+
+	resp, err := a.Sign(context.Background(), cr, issuer)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(resp.Certificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := cert.CheckSignatureFrom(caCert); err != nil {
+		t.Errorf("certificate is not signed by the Key Vault CA: %v", err)
+	}
+	if cert.Subject.CommonName != "test-common-name" {
+		t.Errorf("unexpected common name %q", cert.Subject.CommonName)
+	}
+}
@@ -81,7 +81,15 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	log := logf.FromContext(ctx, "sign")
 
 	secretName := issuerObj.GetSpec().CA.SecretName
-	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := c.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		c.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
 
 	// get a copy of the CA certificate named on the Issuer
 	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
@@ -110,6 +118,36 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 		return nil, err
 	}
 
+	// complete the chain with the certificates of the CAs above the signing CA
+	if chainSecretNames := issuerObj.GetSpec().CA.ChainSecretNames; len(chainSecretNames) > 0 {
+		chain, err := kube.SecretCACertificates(ctx, c.secretsLister, resourceNamespace, chainSecretNames)
+		if k8sErrors.IsNotFound(err) {
+			message := fmt.Sprintf("Referenced CA chain secret not found in namespace %s", resourceNamespace)
+
+			c.reporter.Pending(cr, err, "SecretMissing", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+
+		if cmerrors.IsInvalidData(err) {
+			message := fmt.Sprintf("Failed to parse CA chain certificates from secrets in namespace %s", resourceNamespace)
+
+			c.reporter.Pending(cr, err, "SecretInvalidData", message)
+			log.Error(err, message)
+			return nil, nil
+		}
+
+		if err != nil {
+			message := fmt.Sprintf("Failed to get CA chain certificates from secrets in namespace %s", resourceNamespace)
+			c.reporter.Pending(cr, err, "SecretGetError", message)
+			log.Error(err, message)
+			return nil, err
+		}
+
+		caCerts = append(caCerts, chain...)
+	}
+
 	template, err := c.templateGenerator(cr)
 	if err != nil {
 		message := "Error generating certificate template"
@@ -121,6 +159,22 @@ func (c *CA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj c
 	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 
+	// honour the path length and name constraints requested for a CA, within
+	// the path length allowed by the signing CA
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err == nil {
+		err = pki.ApplyCAConstraints(template, csr)
+	}
+	if err == nil {
+		err = pki.ConstrainPathLenToIssuer(template, caCerts[0])
+	}
+	if err != nil {
+		message := "Error applying CA constraints to certificate template"
+		c.reporter.Failed(cr, err, "SigningError", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := "Error signing certificate"
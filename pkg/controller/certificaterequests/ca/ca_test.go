@@ -34,6 +34,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	clientcorev1 "k8s.io/client-go/listers/core/v1"
@@ -467,6 +468,8 @@ func TestCA_Sign(t *testing.T) {
 		t.Fatal(err)
 	}
 	rootCert, _ := generateSelfSignedCACert(t, rootPK, "root")
+	constrainedRootCert := *rootCert
+	constrainedRootCert.MaxPathLen = 1
 
 	// Build test CSR
 	testpk, err := pki.GenerateECPrivateKey(256)
@@ -541,6 +544,26 @@ func TestCA_Sign(t *testing.T) {
 				assert.Equal(t, true, got.IsCA)
 			},
 		},
+		"when the Issuer's CA has a path length, it should constrain the signed ca": {
+			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, &constrainedRootCert))),
+			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+				SecretName: "secret-1",
+			})),
+			givenCR: gen.CertificateRequest("cr-1",
+				gen.SetCertificateRequestCSR(testCSR),
+				gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+					Name:  "issuer-1",
+					Group: certmanager.GroupName,
+					Kind:  "Issuer",
+				}),
+				gen.SetCertificateRequestIsCA(true),
+			),
+			assertSignedCert: func(t *testing.T, got *x509.Certificate) {
+				assert.Equal(t, true, got.IsCA)
+				assert.Equal(t, 0, got.MaxPathLen)
+				assert.Equal(t, true, got.MaxPathLenZero)
+			},
+		},
 		"when the Issuer has ocspServers set, it should appear on the signed ca": {
 			givenCASecret: gen.SecretFrom(gen.Secret("secret-1"), gen.SetSecretNamespace("default"), gen.SetSecretData(secretDataFor(t, rootPK, rootCert))),
 			givenCAIssuer: gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
@@ -630,3 +653,113 @@ func secretDataFor(t *testing.T, caKey *ecdsa.PrivateKey, caCrt *x509.Certificat
 		"tls.crt": caCrtPEM,
 	}
 }
+
+func TestCA_SignWithChainSecrets(t *testing.T) {
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootCert, rootCertPEM := generateSelfSignedCACert(t, rootPK, "root")
+
+	intermediatePK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediatePKPEM, err := pki.EncodeECPrivateKey(intermediatePK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	intermediatePEM, intermediateCert, err := pki.SignCertificate(&x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "intermediate"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Minute),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		PublicKey:             intermediatePK.Public(),
+		IsCA:                  true,
+	}, rootCert, intermediatePK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testpk, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cr := gen.CertificateRequest("cr-1",
+		gen.SetCertificateRequestCSR(generateCSR(t, testpk, x509.ECDSAWithSHA256)),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Name:  "issuer-1",
+			Group: certmanager.GroupName,
+			Kind:  "Issuer",
+		}),
+	)
+	issuer := gen.Issuer("issuer-1", gen.SetIssuerCA(cmapi.CAIssuer{
+		SecretName:       "intermediate",
+		ChainSecretNames: []string{"root"},
+	}))
+
+	intermediateSecret := gen.Secret("intermediate",
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{
+			corev1.TLSPrivateKeyKey: intermediatePKPEM,
+			corev1.TLSCertKey:       intermediatePEM,
+		}),
+	)
+	rootSecret := gen.Secret("root",
+		gen.SetSecretNamespace("default"),
+		gen.SetSecretData(map[string][]byte{cmmeta.TLSCAKey: rootCertPEM}),
+	)
+
+	tests := map[string]struct {
+		givenSecrets []*corev1.Secret
+		wantResponse bool
+	}{
+		"the chain should be completed using the certificates in the chain secrets": {
+			givenSecrets: []*corev1.Secret{intermediateSecret, rootSecret},
+			wantResponse: true,
+		},
+		"a missing chain secret should set the condition to pending and wait for a re-sync": {
+			givenSecrets: []*corev1.Secret{intermediateSecret},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			c := &CA{
+				reporter: util.NewReporter(fixedClock, &testpkg.FakeRecorder{}),
+				secretsLister: testlisters.FakeSecretListerFrom(testlisters.NewFakeSecretLister(),
+					testlisters.SetFakeSecretListerSecret(func(string) clientcorev1.SecretNamespaceLister {
+						return &testlisters.FakeSecretNamespaceLister{
+							GetFn: func(name string) (*corev1.Secret, error) {
+								for _, secret := range test.givenSecrets {
+									if secret.Name == name {
+										return secret, nil
+									}
+								}
+								return nil, apierrors.NewNotFound(corev1.Resource("secrets"), name)
+							},
+						}
+					}),
+				),
+				templateGenerator: pki.GenerateTemplateFromCertificateRequest,
+				signingFn:         pki.SignCSRTemplate,
+			}
+
+			gotIssueResp, err := c.Sign(context.Background(), cr, issuer)
+			require.NoError(t, err)
+			if !test.wantResponse {
+				require.Nil(t, gotIssueResp)
+				return
+			}
+
+			require.NotNil(t, gotIssueResp)
+			gotChain, err := pki.DecodeX509CertificateChainBytes(gotIssueResp.Certificate)
+			require.NoError(t, err)
+			require.Len(t, gotChain, 2)
+			assert.Equal(t, intermediateCert.Raw, gotChain[1].Raw)
+			assert.Equal(t, rootCertPEM, gotIssueResp.CA)
+		})
+	}
+}
@@ -29,6 +29,8 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	"github.com/cert-manager/cert-manager/internal/issuanceratelimit"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
@@ -36,6 +38,7 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 var keyFunc = controllerpkg.KeyFunc
@@ -94,6 +97,14 @@ type Controller struct {
 	clock clock.Clock
 
 	reporter *util.Reporter
+
+	// latencyTracker records the issuance latency of each issuer to compare
+	// against its IssuanceLatencyBudget.
+	latencyTracker *issuancelatency.Tracker
+	metrics        *metrics.Metrics
+
+	// rateLimiter enforces the RateLimit of each issuer.
+	rateLimiter *issuanceratelimit.Limiter
 }
 
 // New will construct a new certificaterequest controller using the given
@@ -195,6 +206,9 @@ func (c *Controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.reporter = util.NewReporter(c.clock, c.recorder)
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
+	c.latencyTracker = ctx.IssuanceLatency
+	c.metrics = ctx.Metrics
+	c.rateLimiter = ctx.IssuanceRateLimiter
 
 	// Construct the issuer implementation with the built component context.
 	c.issuer = c.issuerConstructor(ctx)
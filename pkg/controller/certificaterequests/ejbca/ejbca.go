@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ejbca
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	ejbcaclient "github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-ejbca"
+)
+
+type EJBCA struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	userAgent     string
+
+	clientBuilder ejbcaclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the EJBCA issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerEJBCA, NewEJBCA)).
+			Complete()
+	})
+}
+
+func NewEJBCA(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &EJBCA{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: ejbcaclient.New,
+	}
+}
+
+// Sign enrolls a certificate for the CertificateRequest using the PKCS#10
+// enrollment endpoint of the EJBCA REST API. A new enrollment code is
+// generated for every request, so the end entity can be enrolled again when
+// the Certificate is renewed.
+func (e *EJBCA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().EJBCA
+	resourceNamespace, err := e.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		e.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := e.clientBuilder(resourceNamespace, e.secretsLister, issuerObj, e.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		e.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise EJBCA client for signing"
+
+		e.reporter.Pending(cr, err, "EJBCAInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	password, err := enrollmentCode()
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := client.EnrollPKCS10(ctx, &ejbcaclient.EnrollPKCS10Request{
+		CertificateRequest:       string(cr.Spec.Request),
+		CertificateProfileName:   cfg.CertificateProfileName,
+		EndEntityProfileName:     cfg.EndEntityProfileName,
+		CertificateAuthorityName: cfg.CertificateAuthorityName,
+		Username:                 endEntityName(cfg, cr),
+		Password:                 password,
+		IncludeChain:             true,
+	})
+	if ejbcaclient.IsBadRequest(err) {
+		message := "EJBCA rejected the certificate request"
+
+		e.reporter.Failed(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+	if err != nil {
+		message := "Failed to request certificate from EJBCA"
+
+		e.reporter.Pending(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := bundleFor(resp)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		e.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// bundleFor decodes the certificate and chain of an enrollment response.
+func bundleFor(resp *ejbcaclient.EnrollPKCS10Response) (utilpki.PEMBundle, error) {
+	certs, err := resp.Certificates()
+	if err != nil {
+		return utilpki.PEMBundle{}, err
+	}
+	return utilpki.ParseSingleCertificateChain(certs)
+}
+
+// endEntityName returns the username of the end entity to enroll the
+// CertificateRequest for. Unless configured on the issuer, the Certificate
+// that the request belongs to is used, falling back to the request itself.
+func endEntityName(cfg *cmapi.EJBCAIssuer, cr *cmapi.CertificateRequest) string {
+	if cfg.EndEntityName != "" {
+		return cfg.EndEntityName
+	}
+	if name := cr.Annotations[cmapi.CertificateNameKey]; name != "" {
+		return cr.Namespace + "_" + name
+	}
+	return cr.Namespace + "_" + cr.Name
+}
+
+// enrollmentCode generates a random enrollment code for an end entity.
+func enrollmentCode() (string, error) {
+	b := make([]byte, 16)
+	if _, err := rand.Read(b); err != nil {
+		return "", fmt.Errorf("failed to generate enrollment code: %v", err)
+	}
+	return hex.EncodeToString(b), nil
+}
@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ejbca
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"net/http"
+	"testing"
+	"time"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	ejbcaclient "github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "ManagementCA",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerEJBCA(cmapi.EJBCAIssuer{
+			URL:                      "https://ejbca.example.com/ejbca/ejbca-rest-api",
+			CertificateAuthorityName: "ManagementCA",
+			CertificateProfileName:   "SERVER",
+			EndEntityProfileName:     "TLS",
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateNameKey: "test-cert",
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, cert, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		client      *fake.EJBCA
+		clientErr   error
+		builder     *controllertest.Builder
+		expectedErr bool
+	}{
+		"if the client certificate secret does not exist then set pending": {
+			clientErr: k8sErrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "ejbca-client"),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Normal SecretMissing Required secret resource not found: secrets "ejbca-client" not found`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            `Required secret resource not found: secrets "ejbca-client" not found`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"enroll the certificate for the end entity of the certificate": {
+			client: &fake.EJBCA{
+				EnrollPKCS10Fn: func(req *ejbcaclient.EnrollPKCS10Request) (*ejbcaclient.EnrollPKCS10Response, error) {
+					if req.CertificateRequest != string(csrPEM) ||
+						req.CertificateAuthorityName != "ManagementCA" ||
+						req.CertificateProfileName != "SERVER" ||
+						req.EndEntityProfileName != "TLS" ||
+						req.Username != gen.DefaultTestNamespace+"_test-cert" ||
+						req.Password == "" ||
+						!req.IncludeChain {
+						t.Errorf("unexpected enrollment request %+v", req)
+					}
+					return &ejbcaclient.EnrollPKCS10Response{
+						Certificate:      base64.StdEncoding.EncodeToString(cert.Raw),
+						ResponseFormat:   "DER",
+						CertificateChain: []string{base64.StdEncoding.EncodeToString(rootCert.Raw)},
+					}, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+		},
+		"if EJBCA rejects the request then set failed": {
+			client: &fake.EJBCA{
+				EnrollPKCS10Fn: func(*ejbcaclient.EnrollPKCS10Request) (*ejbcaclient.EnrollPKCS10Response, error) {
+					return nil, &ejbcaclient.Error{StatusCode: http.StatusBadRequest, Message: "Key length not allowed"}
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError EJBCA rejected the certificate request: EJBCA returned status 400: Key length not allowed",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "EJBCA rejected the certificate request: EJBCA returned status 400: Key length not allowed",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"if EJBCA cannot be reached then set pending and return error": {
+			client: &fake.EJBCA{
+				EnrollPKCS10Fn: func(*ejbcaclient.EnrollPKCS10Request) (*ejbcaclient.EnrollPKCS10Response, error) {
+					return nil, errors.New("connection refused")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal RequestError Failed to request certificate from EJBCA: connection refused",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to request certificate from EJBCA: connection refused",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			e := NewEJBCA(test.builder.Context).(*EJBCA)
+			e.clientBuilder = func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (ejbcaclient.Interface, error) {
+				if test.clientErr != nil {
+					return nil, test.clientErr
+				}
+				return test.client, nil
+			}
+
+			controller := certificaterequests.New(
+				apiutil.IssuerEJBCA,
+				func(*controller.Context) certificaterequests.Issuer { return e },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), baseCR.DeepCopy())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
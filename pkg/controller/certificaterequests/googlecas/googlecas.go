@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"google.golang.org/api/googleapi"
+	privateca "google.golang.org/api/privateca/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	googlecasclient "github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-googlecas"
+)
+
+type GoogleCAS struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	userAgent     string
+
+	clientBuilder googlecasclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the Google CA Service issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerGoogleCAS, NewGoogleCAS)).
+			Complete()
+	})
+}
+
+func NewGoogleCAS(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &GoogleCAS{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: googlecasclient.New,
+	}
+}
+
+// Sign requests a certificate for the CertificateRequest from the Google CA
+// Service CA pool. The UID of the CertificateRequest is used as the ID of the
+// certificate, so a request which is retried after the certificate has been
+// created collects the existing certificate rather than issuing another one.
+func (g *GoogleCAS) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().GoogleCAS
+	resourceNamespace, err := g.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		g.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := g.clientBuilder(resourceNamespace, g.secretsLister, issuerObj,
+		g.issuerOptions.CanUseAmbientCredentials(issuerObj), g.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		g.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise Google CA Service client for signing"
+
+		g.reporter.Pending(cr, err, "GoogleCASInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	parent := googlecasclient.CaPoolName(cfg)
+	certificateID := string(cr.UID)
+	cert, err := client.CreateCertificate(ctx, parent, certificateID, cfg.CertificateAuthorityID, certificateID, certificateFor(cfg, cr))
+	var apiErr *googleapi.Error
+	if errors.As(err, &apiErr) && apiErr.Code == http.StatusConflict {
+		log.V(logf.DebugLevel).Info("certificate already exists, fetching it")
+		cert, err = client.GetCertificate(ctx, fmt.Sprintf("%s/certificates/%s", parent, certificateID))
+	}
+	if err != nil {
+		message := "Failed to request certificate from Google CA Service"
+
+		g.reporter.Failed(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	certPEM := []byte(cert.PemCertificate + "\n" + strings.Join(cert.PemCertificateChain, "\n"))
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(certPEM)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		g.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// certificateFor builds the certificate to create for the CertificateRequest.
+// The certificate template of the issuer may be overridden by an annotation
+// on the CertificateRequest, and annotations with the label prefix are passed
+// on as labels of the certificate.
+func certificateFor(cfg *cmapi.GoogleCASIssuer, cr *cmapi.CertificateRequest) *privateca.Certificate {
+	template := cfg.CertificateTemplate
+	if t, ok := cr.Annotations[cmapi.GoogleCASCertificateTemplateAnnotationKey]; ok {
+		template = t
+	}
+
+	var labels map[string]string
+	for k, v := range cr.Annotations {
+		key := strings.TrimPrefix(k, cmapi.GoogleCASLabelAnnotationPrefix)
+		if key == k || key == "" {
+			continue
+		}
+		if labels == nil {
+			labels = make(map[string]string)
+		}
+		labels[key] = v
+	}
+
+	return &privateca.Certificate{
+		PemCsr:              string(cr.Spec.Request),
+		Lifetime:            fmt.Sprintf("%ds", int64(apiutil.DefaultCertDuration(cr.Spec.Duration).Seconds())),
+		CertificateTemplate: template,
+		Labels:              labels,
+	}
+}
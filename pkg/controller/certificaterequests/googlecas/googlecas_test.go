@@ -0,0 +1,357 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"google.golang.org/api/googleapi"
+	privateca "google.golang.org/api/privateca/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	googlecasclient "github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+const (
+	poolName  = "projects/my-project/locations/europe-west1/caPools/my-pool"
+	crUID     = "0e8d5e3a-8d3c-4c5c-9a8e-3c1a2b3c4d5e"
+	templateA = "projects/my-project/locations/europe-west1/certificateTemplates/a"
+	templateB = "projects/my-project/locations/europe-west1/certificateTemplates/b"
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRCommonName("test-common-name"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerGoogleCAS(cmapi.GoogleCASIssuer{
+			Project:             "my-project",
+			Location:            "europe-west1",
+			CAPoolID:            "my-pool",
+			CertificateTemplate: templateA,
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+	baseCR.UID = crUID
+	annotatedCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.GoogleCASCertificateTemplateAnnotationKey:   templateB,
+			cmapi.GoogleCASLabelAnnotationPrefix + "team":     "payments",
+			cmapi.GoogleCASLabelAnnotationPrefix + "cost-ctr": "1234",
+			"unrelated.example.com/annotation":                "ignored",
+		}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, _, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	issued := &privateca.Certificate{
+		PemCertificate:      string(certPEM),
+		PemCertificateChain: []string{string(rootPEM)},
+	}
+
+	issuedCR := func(cr *cmapi.CertificateRequest) *cmapi.CertificateRequest {
+		return gen.CertificateRequestFrom(cr,
+			gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+				Type:               cmapi.CertificateRequestConditionReady,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             cmapi.CertificateRequestReasonIssued,
+				Message:            "Certificate fetched from issuer successfully",
+				LastTransitionTime: &metaFixedClockStart,
+			}),
+			gen.SetCertificateRequestCertificate(certPEM),
+			gen.SetCertificateRequestCA(rootPEM),
+		)
+	}
+
+	tests := map[string]struct {
+		certificateRequest *cmapi.CertificateRequest
+		client             *fake.GoogleCAS
+		clientErr          error
+		builder            *controllertest.Builder
+		expectedErr        bool
+	}{
+		"if the service account key secret does not exist then set pending": {
+			certificateRequest: baseCR.DeepCopy(),
+			clientErr:          k8sErrors.NewNotFound(schema.GroupResource{Resource: "secrets"}, "google"),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Normal SecretMissing Required secret resource not found: secrets "google" not found`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            `Required secret resource not found: secrets "google" not found`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"create a certificate using the issuer's template": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.GoogleCAS{
+				CreateCertificateFn: func(parent, certificateID, caID, requestID string, cert *privateca.Certificate) (*privateca.Certificate, error) {
+					if parent != poolName || certificateID != crUID || caID != "" || requestID != crUID {
+						t.Errorf("unexpected request parent=%q certificateID=%q caID=%q requestID=%q", parent, certificateID, caID, requestID)
+					}
+					exp := &privateca.Certificate{
+						PemCsr:              string(csrPEM),
+						Lifetime:            "3600s",
+						CertificateTemplate: templateA,
+					}
+					if !reflect.DeepEqual(cert, exp) {
+						t.Errorf("unexpected certificate, exp=%+v got=%+v", exp, cert)
+					}
+					return issued, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						issuedCR(baseCR),
+					)),
+				},
+			},
+		},
+		"pass the template and labels from the certificate request annotations": {
+			certificateRequest: annotatedCR.DeepCopy(),
+			client: &fake.GoogleCAS{
+				CreateCertificateFn: func(_, _, _, _ string, cert *privateca.Certificate) (*privateca.Certificate, error) {
+					if cert.CertificateTemplate != templateB {
+						t.Errorf("unexpected certificate template %q", cert.CertificateTemplate)
+					}
+					exp := map[string]string{"team": "payments", "cost-ctr": "1234"}
+					if !reflect.DeepEqual(cert.Labels, exp) {
+						t.Errorf("unexpected labels, exp=%v got=%v", exp, cert.Labels)
+					}
+					return issued, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{annotatedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						issuedCR(annotatedCR),
+					)),
+				},
+			},
+		},
+		"if the certificate already exists then fetch it": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.GoogleCAS{
+				CreateCertificateFn: func(string, string, string, string, *privateca.Certificate) (*privateca.Certificate, error) {
+					return nil, &googleapi.Error{Code: http.StatusConflict, Message: "already exists"}
+				},
+				GetCertificateFn: func(name string) (*privateca.Certificate, error) {
+					if exp := poolName + "/certificates/" + crUID; name != exp {
+						t.Errorf("unexpected certificate name, exp=%q got=%q", exp, name)
+					}
+					return issued, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						issuedCR(baseCR),
+					)),
+				},
+			},
+		},
+		"if creating the certificate fails then set failed and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.GoogleCAS{
+				CreateCertificateFn: func(string, string, string, string, *privateca.Certificate) (*privateca.Certificate, error) {
+					return nil, errors.New("this is an error")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError Failed to request certificate from Google CA Service: this is an error",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "Failed to request certificate from Google CA Service: this is an error",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			g := NewGoogleCAS(test.builder.Context).(*GoogleCAS)
+			g.clientBuilder = func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (googlecasclient.Interface, error) {
+				if test.clientErr != nil {
+					return nil, test.clientErr
+				}
+				return test.client, nil
+			}
+
+			controller := certificaterequests.New(
+				apiutil.IssuerGoogleCAS,
+				func(*controller.Context) certificaterequests.Issuer { return g },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), test.certificateRequest)
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
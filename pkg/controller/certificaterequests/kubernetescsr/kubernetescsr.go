@@ -0,0 +1,231 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/utils/pointer"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	ctrlutil "github.com/cert-manager/cert-manager/pkg/controller/certificatesigningrequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-kubernetescsr"
+)
+
+type KubernetesCSR struct {
+	kubeClient kubernetes.Interface
+	reporter   *crutil.Reporter
+}
+
+func init() {
+	// create certificate request controller for the Kubernetes CSR issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerKubernetesCSR, NewKubernetesCSR)).
+			Complete()
+	})
+}
+
+func NewKubernetesCSR(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &KubernetesCSR{
+		kubeClient: ctx.Client,
+		reporter:   crutil.NewReporter(ctx.Clock, ctx.Recorder),
+	}
+}
+
+// Sign forwards the CertificateRequest to the configured signer by creating a
+// Kubernetes CertificateSigningRequest, and returns the certificate once the
+// CertificateSigningRequest has been approved and signed.
+func (k *KubernetesCSR) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().KubernetesCSR
+
+	csrName := cr.ObjectMeta.Annotations[cmapi.KubernetesCSRNameAnnotationKey]
+	if csrName == "" {
+		csr, err := certificateSigningRequest(cr, cfg.SignerName)
+		if err != nil {
+			message := "Failed to build Kubernetes CertificateSigningRequest"
+
+			k.reporter.Failed(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+
+		// The name is derived from the CertificateRequest, so a
+		// CertificateSigningRequest that already exists was created by a
+		// previous sync whose annotation could not be persisted.
+		_, err = k.kubeClient.CertificatesV1().CertificateSigningRequests().Create(ctx, csr, metav1.CreateOptions{})
+		if k8sErrors.IsInvalid(err) {
+			message := "Kubernetes rejected the CertificateSigningRequest"
+
+			k.reporter.Failed(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+		if err != nil && !k8sErrors.IsAlreadyExists(err) {
+			message := "Failed to create Kubernetes CertificateSigningRequest"
+
+			k.reporter.Pending(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+
+		k.reporter.Pending(cr, nil, "IssuancePending", fmt.Sprintf("Kubernetes CertificateSigningRequest %q is requested", csr.Name))
+
+		metav1.SetMetaDataAnnotation(&cr.ObjectMeta, cmapi.KubernetesCSRNameAnnotationKey, csr.Name)
+
+		return nil, nil
+	}
+
+	csr, err := k.kubeClient.CertificatesV1().CertificateSigningRequests().Get(ctx, csrName, metav1.GetOptions{})
+	if k8sErrors.IsNotFound(err) {
+		message := fmt.Sprintf("Kubernetes CertificateSigningRequest %q no longer exists", csrName)
+
+		k.reporter.Failed(cr, err, "RetrieveError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+	if err != nil {
+		message := "Failed to get Kubernetes CertificateSigningRequest"
+
+		k.reporter.Pending(cr, err, "RetrieveError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	if ctrlutil.CertificateSigningRequestIsDenied(csr) {
+		message := fmt.Sprintf("Kubernetes CertificateSigningRequest %q has been denied", csrName)
+
+		k.reporter.Failed(cr, conditionError(csr, certificatesv1.CertificateDenied), "Denied", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	if ctrlutil.CertificateSigningRequestIsFailed(csr) {
+		message := fmt.Sprintf("Kubernetes CertificateSigningRequest %q has failed", csrName)
+
+		k.reporter.Failed(cr, conditionError(csr, certificatesv1.CertificateFailed), "SigningError", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, nil
+	}
+
+	if len(csr.Status.Certificate) == 0 {
+		err := fmt.Errorf("CertificateSigningRequest %q has not been signed yet", csrName)
+		message := "Kubernetes CertificateSigningRequest is waiting to be approved and signed, the request will be retried"
+
+		k.reporter.Pending(cr, err, "IssuancePending", message)
+		log.V(logf.DebugLevel).Info(message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(csr.Status.Certificate)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		k.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// certificateSigningRequest builds the Kubernetes CertificateSigningRequest
+// for cr. Its name is derived from the namespace, name and spec of cr so that
+// it is stable across syncs. The requested duration is passed on as the
+// expirationSeconds of the request, which signers may choose to honour.
+func certificateSigningRequest(cr *cmapi.CertificateRequest, signerName string) (*certificatesv1.CertificateSigningRequest, error) {
+	name, err := apiutil.ComputeName(cr.Namespace+"-"+cr.Name, cr.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	usages := cr.Spec.Usages
+	if len(usages) == 0 {
+		usages = cmapi.DefaultKeyUsages()
+	}
+	var kubeUsages []certificatesv1.KeyUsage
+	for _, usage := range usages {
+		kubeUsages = append(kubeUsages, certificatesv1.KeyUsage(usage))
+	}
+
+	var expirationSeconds *int32
+	if cr.Spec.Duration != nil {
+		expirationSeconds = pointer.Int32(int32(cr.Spec.Duration.Seconds()))
+	}
+
+	return &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				cmapi.KubernetesCSRRequestNamespaceAnnotationKey: cr.Namespace,
+				cmapi.KubernetesCSRRequestNameAnnotationKey:      cr.Name,
+			},
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           cr.Spec.Request,
+			SignerName:        signerName,
+			Usages:            kubeUsages,
+			ExpirationSeconds: expirationSeconds,
+		},
+	}, nil
+}
+
+// conditionError returns the message of the condition of the given type on
+// csr as an error, falling back to its reason if it has no message.
+func conditionError(csr *certificatesv1.CertificateSigningRequest, condType certificatesv1.RequestConditionType) error {
+	for _, cond := range csr.Status.Conditions {
+		if cond.Type != condType {
+			continue
+		}
+		if cond.Message != "" {
+			return errors.New(cond.Message)
+		}
+		return errors.New(cond.Reason)
+	}
+	return fmt.Errorf("condition %s is not set", condType)
+}
@@ -0,0 +1,348 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	certificatesv1 "k8s.io/api/certificates/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+	"k8s.io/utils/pointer"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+const signerName = "example.com/my-signer"
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	_, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRDNSNames("example.com"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerKubernetesCSR(cmapi.KubernetesCSRIssuer{SignerName: signerName}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
+		gen.SetCertificateRequestKeyUsages(cmapi.UsageDigitalSignature, cmapi.UsageServerAuth),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+
+	csrName, err := apiutil.ComputeName(gen.DefaultTestNamespace+"-test-cr", baseCR.Spec)
+	if err != nil {
+		t.Fatal(err)
+	}
+	requestedCR := gen.CertificateRequestFrom(baseCR,
+		gen.AddCertificateRequestAnnotations(map[string]string{cmapi.KubernetesCSRNameAnnotationKey: csrName}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, _, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expectedCSR := &certificatesv1.CertificateSigningRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: csrName,
+			Annotations: map[string]string{
+				cmapi.KubernetesCSRRequestNamespaceAnnotationKey: gen.DefaultTestNamespace,
+				cmapi.KubernetesCSRRequestNameAnnotationKey:      "test-cr",
+			},
+		},
+		Spec: certificatesv1.CertificateSigningRequestSpec{
+			Request:           csrPEM,
+			SignerName:        signerName,
+			Usages:            []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageServerAuth},
+			ExpirationSeconds: pointer.Int32(3600),
+		},
+	}
+	approvedCSR := gen.CertificateSigningRequestFrom(expectedCSR,
+		gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+			Type:   certificatesv1.CertificateApproved,
+			Status: corev1.ConditionTrue,
+		}),
+	)
+
+	tests := map[string]struct {
+		certificateRequest *cmapi.CertificateRequest
+		builder            *controllertest.Builder
+		expectedErr        bool
+	}{
+		"create a CertificateSigningRequest for the signer and set pending": {
+			certificateRequest: baseCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Normal IssuancePending Kubernetes CertificateSigningRequest "` + csrName + `" is requested`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewCreateAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"",
+						expectedCSR,
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            `Kubernetes CertificateSigningRequest "` + csrName + `" is requested`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the CertificateSigningRequest has not been signed then set pending and return error": {
+			certificateRequest: requestedCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects:        []runtime.Object{approvedCSR.DeepCopy()},
+				CertManagerObjects: []runtime.Object{requestedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Normal IssuancePending Kubernetes CertificateSigningRequest is waiting to be approved and signed, the request will be retried: CertificateSigningRequest "` + csrName + `" has not been signed yet`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewGetAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"",
+						csrName,
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            `Kubernetes CertificateSigningRequest is waiting to be approved and signed, the request will be retried: CertificateSigningRequest "` + csrName + `" has not been signed yet`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+		"if the CertificateSigningRequest has been denied then set failed": {
+			certificateRequest: requestedCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects: []runtime.Object{gen.CertificateSigningRequestFrom(expectedCSR,
+					gen.SetCertificateSigningRequestStatusCondition(certificatesv1.CertificateSigningRequestCondition{
+						Type:    certificatesv1.CertificateDenied,
+						Status:  corev1.ConditionTrue,
+						Reason:  "PolicyViolation",
+						Message: "example.com is not allowed",
+					}),
+				)},
+				CertManagerObjects: []runtime.Object{requestedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning Denied Kubernetes CertificateSigningRequest "` + csrName + `" has been denied: example.com is not allowed`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewGetAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"",
+						csrName,
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `Kubernetes CertificateSigningRequest "` + csrName + `" has been denied: example.com is not allowed`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"if the CertificateSigningRequest has been signed then return the certificate": {
+			certificateRequest: requestedCR.DeepCopy(),
+			builder: &controllertest.Builder{
+				KubeObjects: []runtime.Object{gen.CertificateSigningRequestFrom(approvedCSR,
+					gen.SetCertificateSigningRequestCertificate(certPEM),
+				)},
+				CertManagerObjects: []runtime.Object{requestedCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewGetAction(
+						certificatesv1.SchemeGroupVersion.WithResource("certificatesigningrequests"),
+						"",
+						csrName,
+					)),
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(requestedCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+						),
+					)),
+				},
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			k := NewKubernetesCSR(test.builder.Context)
+
+			controller := certificaterequests.New(
+				apiutil.IssuerKubernetesCSR,
+				func(*controller.Context) certificaterequests.Issuer { return k },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), test.certificateRequest)
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestCertificateSigningRequestDefaults(t *testing.T) {
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestCSR([]byte("csr")))
+
+	csr, err := certificateSigningRequest(cr, signerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if csr.Spec.ExpirationSeconds != nil {
+		t.Errorf("expected no expirationSeconds without a duration, got %d", *csr.Spec.ExpirationSeconds)
+	}
+	exp := []certificatesv1.KeyUsage{certificatesv1.UsageDigitalSignature, certificatesv1.UsageKeyEncipherment}
+	if len(csr.Spec.Usages) != len(exp) || csr.Spec.Usages[0] != exp[0] || csr.Spec.Usages[1] != exp[1] {
+		t.Errorf("unexpected usages, exp=%v got=%v", exp, csr.Spec.Usages)
+	}
+
+	cr.Spec.Duration = &metav1.Duration{Duration: 24 * time.Hour}
+	csr, err = certificateSigningRequest(cr, signerName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exp := pointer.Int32(86400); *csr.Spec.ExpirationSeconds != *exp {
+		t.Errorf("unexpected expirationSeconds, exp=%d got=%d", *exp, *csr.Spec.ExpirationSeconds)
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+const (
+	reasonIssuanceLatencyBudgetExceeded = "IssuanceLatencyBudgetExceeded"
+	reasonIssuanceLatencyWithinBudget   = "IssuanceLatencyWithinBudget"
+)
+
+// observeIssuanceLatency records the time taken for the given issuer to issue
+// the CertificateRequest. If the issuer has an IssuanceLatencyBudget and its
+// recent issuances have crossed the budget in either direction, the
+// issuer's Degraded condition is updated and an Event is emitted.
+// Failing to update the issuer is logged rather than returned, as the
+// CertificateRequest itself has been issued successfully.
+func (c *Controller) observeIssuanceLatency(ctx context.Context, issuer cmapi.GenericIssuer, cr *cmapi.CertificateRequest) {
+	log := logf.FromContext(ctx, "issuanceLatency")
+
+	latency := c.clock.Since(cr.CreationTimestamp.Time)
+	labels := []string{issuerKind(issuer), issuer.GetNamespace(), issuer.GetName()}
+	if c.metrics != nil {
+		c.metrics.ObserveIssuerIssuanceLatency(latency, labels...)
+	}
+
+	budget := issuer.GetSpec().IssuanceLatencyBudget
+	if budget == nil || c.latencyTracker == nil {
+		return
+	}
+
+	stats := c.latencyTracker.Observe(issuancelatency.KeyFor(issuer), latency, int(budget.Samples))
+	exceeded, message, ok := issuancelatency.Evaluate(budget, stats)
+	if !ok {
+		return
+	}
+
+	if c.metrics != nil {
+		c.metrics.SetIssuerIssuanceLatencyBudgetExceeded(exceeded, labels...)
+	}
+
+	status, reason, eventType := cmmeta.ConditionFalse, reasonIssuanceLatencyWithinBudget, corev1.EventTypeNormal
+	if exceeded {
+		status, reason, eventType = cmmeta.ConditionTrue, reasonIssuanceLatencyBudgetExceeded, corev1.EventTypeWarning
+	}
+
+	// Only update the issuer when the budget has been crossed, rather than
+	// after every issuance.
+	if apiutil.IssuerHasCondition(issuer, cmapi.IssuerCondition{Type: cmapi.IssuerConditionDegraded, Status: status}) {
+		return
+	}
+
+	if err := c.setIssuerDegradedCondition(ctx, issuer, status, reason, message); err != nil {
+		log.Error(err, "failed to update issuer Degraded condition")
+		return
+	}
+
+	c.recorder.Event(issuer, eventType, reason, message)
+}
+
+func (c *Controller) setIssuerDegradedCondition(ctx context.Context, issuer cmapi.GenericIssuer, status cmmeta.ConditionStatus, reason, message string) error {
+	switch iss := issuer.(type) {
+	case *cmapi.Issuer:
+		iss = iss.DeepCopy()
+		apiutil.SetIssuerCondition(iss, iss.Generation, cmapi.IssuerConditionDegraded, status, reason, message)
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+			// Only apply the Degraded condition, so that the Ready condition
+			// stays owned by the issuers controller.
+			iss.Status = cmapi.IssuerStatus{Conditions: degradedConditions(iss.Status.Conditions)}
+			return internalissuers.ApplyIssuerStatus(ctx, c.cmClient, c.fieldManager, iss)
+		}
+		_, err := c.cmClient.CertmanagerV1().Issuers(iss.Namespace).UpdateStatus(ctx, iss, metav1.UpdateOptions{})
+		return err
+
+	case *cmapi.ClusterIssuer:
+		iss = iss.DeepCopy()
+		apiutil.SetIssuerCondition(iss, iss.Generation, cmapi.IssuerConditionDegraded, status, reason, message)
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+			iss.Status = cmapi.IssuerStatus{Conditions: degradedConditions(iss.Status.Conditions)}
+			return internalissuers.ApplyClusterIssuerStatus(ctx, c.cmClient, c.fieldManager, iss)
+		}
+		_, err := c.cmClient.CertmanagerV1().ClusterIssuers().UpdateStatus(ctx, iss, metav1.UpdateOptions{})
+		return err
+	}
+
+	return nil
+}
+
+func degradedConditions(conditions []cmapi.IssuerCondition) []cmapi.IssuerCondition {
+	for _, cond := range conditions {
+		if cond.Type == cmapi.IssuerConditionDegraded {
+			return []cmapi.IssuerCondition{cond}
+		}
+	}
+	return nil
+}
+
+func issuerKind(issuer cmapi.GenericIssuer) string {
+	if _, ok := issuer.(*cmapi.ClusterIssuer); ok {
+		return cmapi.ClusterIssuerKind
+	}
+	return cmapi.IssuerKind
+}
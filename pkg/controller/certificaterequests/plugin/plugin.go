@@ -0,0 +1,181 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	pluginclient "github.com/cert-manager/cert-manager/pkg/issuer/plugin/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/pluginapi"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-plugin"
+)
+
+type Plugin struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	userAgent     string
+
+	clientBuilder pluginclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the plugin issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerPlugin, NewPlugin)).
+			Complete()
+	})
+}
+
+func NewPlugin(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &Plugin{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: pluginclient.New,
+	}
+}
+
+// Sign forwards the CertificateRequest to the issuer plugin configured on
+// the issuer.
+func (p *Plugin) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	resourceNamespace, err := p.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		p.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := p.clientBuilder(resourceNamespace, p.secretsLister, issuerObj, p.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		p.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise issuer plugin client for signing"
+
+		p.reporter.Pending(cr, err, "PluginInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+	defer client.Close()
+
+	if cr.Spec.IsCA {
+		caps, err := client.Capabilities(ctx)
+		if err != nil {
+			message := "Failed to get issuer plugin capabilities"
+
+			p.reporter.Pending(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, err
+		}
+		if !caps.Ca {
+			message := "The issuer plugin does not support CA certificates"
+
+			err := errors.New("isCA is not supported")
+			p.reporter.Failed(cr, err, "RequestError", message)
+			log.Error(err, message)
+
+			return nil, nil
+		}
+	}
+
+	usages := make([]string, len(cr.Spec.Usages))
+	for i, u := range cr.Spec.Usages {
+		usages[i] = string(u)
+	}
+
+	resp, err := client.Sign(ctx, &pluginapi.SignRequest{
+		Request:         cr.Spec.Request,
+		DurationSeconds: int64(apiutil.DefaultCertDuration(cr.Spec.Duration) / time.Second),
+		IsCa:            cr.Spec.IsCA,
+		Usages:          usages,
+		Uid:             string(cr.UID),
+		Namespace:       cr.Namespace,
+		Name:            cr.Name,
+	})
+	switch status.Code(err) {
+	case codes.OK:
+	case codes.InvalidArgument, codes.FailedPrecondition, codes.PermissionDenied:
+		message := "The issuer plugin rejected the certificate request"
+
+		p.reporter.Failed(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	default:
+		message := "Failed to sign certificate with issuer plugin"
+
+		p.reporter.Pending(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(resp.Certificate)
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		p.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	ca := resp.Ca
+	if len(ca) == 0 {
+		ca = bundle.CAPEM
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          ca,
+	}, nil
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificaterequests
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const reasonRateLimited = "RateLimited"
+
+// enforceRateLimit returns true if sending the CertificateRequest to the
+// issuer would exceed the issuer's RateLimit. In that case the
+// CertificateRequest is marked as pending and re-queued for when the issuer
+// has capacity for it.
+func (c *Controller) enforceRateLimit(ctx context.Context, issuer cmapi.GenericIssuer, cr *cmapi.CertificateRequest) bool {
+	limit := issuer.GetSpec().RateLimit
+	if limit == nil {
+		return false
+	}
+
+	ok, wait := c.rateLimiter.Allow(issuancelatency.KeyFor(issuer), string(cr.UID), limit)
+	if ok {
+		return false
+	}
+
+	log := logf.FromContext(ctx)
+	log.V(logf.DebugLevel).Info("issuer rate limit exceeded, waiting for capacity", "wait", wait.Round(time.Second))
+
+	c.reporter.Pending(cr, nil, reasonRateLimited,
+		fmt.Sprintf("Referenced issuer has reached its limit of %d requests per %s", limit.MaxRequests, limit.Period.Duration))
+
+	key, err := keyFunc(cr)
+	if err != nil {
+		log.Error(err, "error computing key for resource")
+		return true
+	}
+	c.queue.AddAfter(key, wait)
+
+	return true
+}
@@ -80,7 +80,15 @@ func NewSelfSigned(ctx *controllerpkg.Context) certificaterequests.Issuer {
 func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
 	log := logf.FromContext(ctx, "sign")
 
-	resourceNamespace := s.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := s.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		s.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
 
 	secretName, ok := cr.ObjectMeta.Annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey]
 	if !ok || secretName == "" {
@@ -132,6 +140,18 @@ func (s *SelfSigned) Sign(ctx context.Context, cr *cmapi.CertificateRequest, iss
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
 
+	// honour the path length and name constraints requested for a CA
+	csr, err := pki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err == nil {
+		err = pki.ApplyCAConstraints(template, csr)
+	}
+	if err != nil {
+		message := "Error applying CA constraints to certificate template"
+		s.reporter.Failed(cr, err, "ErrorGenerating", message)
+		log.Error(err, message)
+		return nil, nil
+	}
+
 	if template.Subject.String() == "" {
 		// RFC 5280 (https://tools.ietf.org/html/rfc5280#section-4.1.2.4) says that:
 		// "The issuer field MUST contain a non-empty distinguished name (DN)."
@@ -0,0 +1,209 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spire
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	spireclient "github.com/cert-manager/cert-manager/pkg/issuer/spire/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-spire"
+)
+
+type SPIRE struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	userAgent     string
+
+	clientBuilder spireclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the SPIRE issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerSPIRE, NewSPIRE)).
+			Complete()
+	})
+}
+
+func NewSPIRE(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &SPIRE{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: spireclient.New,
+	}
+}
+
+// Sign mints an X.509 SVID for the CertificateRequest. The SPIFFE ID of the
+// request must identify a ServiceAccount in the namespace of the
+// CertificateRequest, so that users can only obtain the identities of
+// workloads in their own namespace.
+func (s *SPIRE) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	cfg := issuerObj.GetSpec().SPIRE
+
+	// If we can't decode the CSR PEM we have to hard fail
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		message := "Failed to decode CSR in spec.request"
+
+		s.reporter.Failed(cr, err, "RequestParsingError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err := validateSPIFFEID(csr, cfg.TrustDomain, cr.Namespace); err != nil {
+		message := "The CertificateRequest does not request a valid SPIFFE ID"
+
+		s.reporter.Failed(cr, err, "SPIFFEIDError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	resourceNamespace, err := s.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		s.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := s.clientBuilder(resourceNamespace, s.secretsLister, issuerObj, s.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		s.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise SPIRE client for signing"
+
+		s.reporter.Pending(cr, err, "SPIREInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+	defer client.Close()
+
+	svid, err := client.MintX509SVID(ctx, csr.Raw, apiutil.DefaultCertDuration(cr.Spec.Duration))
+	if status.Code(err) == codes.InvalidArgument {
+		message := "SPIRE server rejected the certificate request"
+
+		s.reporter.Failed(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+	if err != nil {
+		message := "Failed to mint X.509 SVID"
+
+		s.reporter.Pending(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	bundle, err := client.GetBundle(ctx)
+	if err != nil {
+		message := "Failed to get SPIRE trust bundle"
+
+		s.reporter.Pending(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued", "spiffe_id", svid.ID)
+
+	chain, err := utilpki.ParseSingleCertificateChainPEM(encodeCertificates(svid.CertChain))
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		s.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	// The chain of an SVID ends at an intermediate or the leaf, so the CA is
+	// the X.509 bundle of the trust domain.
+	return &issuerpkg.IssueResponse{
+		Certificate: chain.ChainPEM,
+		CA:          encodeCertificates(bundle.X509Authorities),
+	}, nil
+}
+
+// validateSPIFFEID returns an error unless csr requests exactly one SPIFFE
+// ID, identifying a ServiceAccount in namespace in trustDomain.
+func validateSPIFFEID(csr *x509.CertificateRequest, trustDomain, namespace string) error {
+	if len(csr.URIs) != 1 {
+		return fmt.Errorf("expected exactly one URI SAN, got %d", len(csr.URIs))
+	}
+	id := csr.URIs[0]
+	if id.Scheme != "spiffe" || id.Host != trustDomain || id.RawQuery != "" || id.Fragment != "" {
+		return fmt.Errorf("%q is not a SPIFFE ID in trust domain %q", id, trustDomain)
+	}
+
+	prefix := fmt.Sprintf("/ns/%s/sa/", namespace)
+	serviceAccount := strings.TrimPrefix(id.Path, prefix)
+	if serviceAccount == id.Path || len(validation.IsDNS1123Subdomain(serviceAccount)) > 0 {
+		return fmt.Errorf("%q does not identify a ServiceAccount in namespace %q, expected %s", id, namespace, "spiffe://"+trustDomain+prefix+"<service account>")
+	}
+	return nil
+}
+
+// encodeCertificates PEM encodes the ASN.1 DER encoded certificates.
+func encodeCertificates(certs [][]byte) []byte {
+	var buf bytes.Buffer
+	for _, cert := range certs {
+		// Encoding to a bytes.Buffer cannot fail.
+		_ = pem.Encode(&buf, &pem.Block{Type: "CERTIFICATE", Bytes: cert})
+	}
+	return buf.Bytes()
+}
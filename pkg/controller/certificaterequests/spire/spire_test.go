@@ -0,0 +1,309 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spire
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"net/url"
+	"testing"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	spireclient "github.com/cert-manager/cert-manager/pkg/issuer/spire/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/spire/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+var (
+	fixedClockStart = time.Now()
+	fixedClock      = fakeclock.NewFakeClock(fixedClockStart)
+)
+
+func TestSign(t *testing.T) {
+	metaFixedClockStart := metav1.NewTime(fixedClockStart)
+
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	serialNumber, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          serialNumber,
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             rootPK.Public(),
+		IsCA:                  true,
+		Subject: pkix.Name{
+			CommonName: "root-ca",
+		},
+		NotBefore: time.Now(),
+		NotAfter:  time.Now().Add(time.Minute),
+		KeyUsage:  x509.KeyUsageKeyEncipherment | x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+	}
+	rootPEM, rootCert, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	spiffeID, err := url.Parse("spiffe://example.org/ns/" + gen.DefaultTestNamespace + "/sa/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM, testPK, err := gen.CSR(x509.ECDSA, gen.SetCSRURIs(spiffeID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherID, err := url.Parse("spiffe://example.org/ns/other/sa/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	otherCSRPEM, _, err := gen.CSR(x509.ECDSA, gen.SetCSRURIs(otherID))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer",
+		gen.SetIssuerSPIRE(cmapi.SPIREIssuer{
+			Address:             "spire-server.spire.svc:8081",
+			TrustDomain:         "example.org",
+			CABundle:            rootPEM,
+			ClientCertSecretRef: cmmeta.LocalObjectReference{Name: "spire-admin"},
+		}),
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionReady,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+
+	baseCR := gen.CertificateRequest("test-cr",
+		gen.SetCertificateRequestCSR(csrPEM),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{
+			Group: certmanager.GroupName,
+			Name:  issuer.Name,
+			Kind:  issuer.Kind,
+		}),
+		gen.SetCertificateRequestDuration(&metav1.Duration{Duration: time.Hour}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionApproved,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "cert-manager.io",
+			Message:            "Certificate request has been approved by cert-manager.io",
+			LastTransitionTime: &metaFixedClockStart,
+		}),
+	)
+
+	template, err := pki.GenerateTemplateFromCertificateRequest(baseCR)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM, cert, err := pki.SignCertificate(template, rootCert, testPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bundle := func() (*spireclient.Bundle, error) {
+		return &spireclient.Bundle{TrustDomain: "example.org", X509Authorities: [][]byte{rootCert.Raw}}, nil
+	}
+
+	tests := map[string]struct {
+		certificateRequest *cmapi.CertificateRequest
+		client             *fake.SPIRE
+		builder            *controllertest.Builder
+		expectedErr        bool
+	}{
+		"if the SPIFFE ID is in another namespace then set failed": {
+			certificateRequest: gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestCSR(otherCSRPEM)),
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestCSR(otherCSRPEM)), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					`Warning SPIFFEIDError The CertificateRequest does not request a valid SPIFFE ID: "spiffe://example.org/ns/other/sa/app" does not identify a ServiceAccount in namespace "default-unit-test-ns", expected spiffe://example.org/ns/default-unit-test-ns/sa/<service account>`,
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCSR(otherCSRPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            `The CertificateRequest does not request a valid SPIFFE ID: "spiffe://example.org/ns/other/sa/app" does not identify a ServiceAccount in namespace "default-unit-test-ns", expected spiffe://example.org/ns/default-unit-test-ns/sa/<service account>`,
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"mint an SVID and return the trust bundle as the CA": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.SPIRE{
+				GetBundleFn: bundle,
+				MintX509SVIDFn: func(csr []byte, ttl time.Duration) (*spireclient.X509SVID, error) {
+					if ttl != time.Hour {
+						t.Errorf("unexpected ttl %v", ttl)
+					}
+					return &spireclient.X509SVID{ID: spiffeID.String(), CertChain: [][]byte{cert.Raw}}, nil
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             cmapi.CertificateRequestReasonIssued,
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestCertificate(certPEM),
+							gen.SetCertificateRequestCA(rootPEM),
+						),
+					)),
+				},
+			},
+		},
+		"if the SPIRE server rejects the request then set failed": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.SPIRE{
+				MintX509SVIDFn: func([]byte, time.Duration) (*spireclient.X509SVID, error) {
+					return nil, status.Error(codes.InvalidArgument, "invalid CSR")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Warning RequestError SPIRE server rejected the certificate request: rpc error: code = InvalidArgument desc = invalid CSR",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonFailed,
+								Message:            "SPIRE server rejected the certificate request: rpc error: code = InvalidArgument desc = invalid CSR",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+							gen.SetCertificateRequestFailureTime(metaFixedClockStart),
+						),
+					)),
+				},
+			},
+		},
+		"if the SPIRE server is unavailable then set pending and return error": {
+			certificateRequest: baseCR.DeepCopy(),
+			client: &fake.SPIRE{
+				MintX509SVIDFn: func([]byte, time.Duration) (*spireclient.X509SVID, error) {
+					return nil, errors.New("connection refused")
+				},
+			},
+			builder: &controllertest.Builder{
+				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), issuer.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal RequestError Failed to mint X.509 SVID: connection refused",
+				},
+				ExpectedActions: []controllertest.Action{
+					controllertest.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             cmapi.CertificateRequestReasonPending,
+								Message:            "Failed to mint X.509 SVID: connection refused",
+								LastTransitionTime: &metaFixedClockStart,
+							}),
+						),
+					)),
+				},
+			},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			test.builder.T = t
+			test.builder.Clock = fixedClock
+			test.builder.InitWithRESTConfig()
+			defer test.builder.Stop()
+
+			s := NewSPIRE(test.builder.Context).(*SPIRE)
+			s.clientBuilder = func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (spireclient.Interface, error) {
+				return test.client, nil
+			}
+
+			controller := certificaterequests.New(
+				apiutil.IssuerSPIRE,
+				func(*controller.Context) certificaterequests.Issuer { return s },
+			)
+			controller.Register(test.builder.Context)
+			test.builder.Start()
+
+			err := controller.Sync(context.Background(), test.certificateRequest)
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			test.builder.CheckAndFinish(err)
+		})
+	}
+}
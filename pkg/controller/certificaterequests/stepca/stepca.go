@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stepca
+
+import (
+	"context"
+	"crypto/x509"
+
+	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificaterequests"
+	crutil "github.com/cert-manager/cert-manager/pkg/controller/certificaterequests/util"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	stepcaclient "github.com/cert-manager/cert-manager/pkg/issuer/stepca/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	CRControllerName = "certificaterequests-issuer-stepca"
+)
+
+type StepCA struct {
+	issuerOptions controllerpkg.IssuerOptions
+	secretsLister corelisters.SecretLister
+	reporter      *crutil.Reporter
+	userAgent     string
+
+	clientBuilder stepcaclient.Builder
+}
+
+func init() {
+	// create certificate request controller for the step-ca issuer
+	controllerpkg.Register(CRControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, CRControllerName).
+			For(certificaterequests.New(apiutil.IssuerStepCA, NewStepCA)).
+			Complete()
+	})
+}
+
+func NewStepCA(ctx *controllerpkg.Context) certificaterequests.Issuer {
+	return &StepCA{
+		issuerOptions: ctx.IssuerOptions,
+		secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+		reporter:      crutil.NewReporter(ctx.Clock, ctx.Recorder),
+		userAgent:     ctx.RESTConfig.UserAgent,
+		clientBuilder: stepcaclient.New,
+	}
+}
+
+// Sign requests a certificate for the CertificateRequest from step-ca. Each
+// request, including those for renewals, is authorised by a new one-time
+// token for the subject and SANs of the CSR.
+func (s *StepCA) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerObj cmapi.GenericIssuer) (*issuerpkg.IssueResponse, error) {
+	log := logf.FromContext(ctx, "sign")
+	log = logf.WithRelatedResource(log, issuerObj)
+
+	// If we can't decode the CSR PEM we have to hard fail
+	csr, err := utilpki.DecodeX509CertificateRequestBytes(cr.Spec.Request)
+	if err != nil {
+		message := "Failed to decode CSR in spec.request"
+
+		s.reporter.Failed(cr, err, "RequestParsingError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	resourceNamespace, err := s.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		s.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := s.clientBuilder(resourceNamespace, s.secretsLister, issuerObj, s.userAgent)
+	if k8sErrors.IsNotFound(err) {
+		message := "Required secret resource not found"
+
+		s.reporter.Pending(cr, err, "SecretMissing", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	if err != nil {
+		message := "Failed to initialise step-ca client for signing"
+
+		s.reporter.Pending(cr, err, "StepCAInitError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	subject, sans := subjectAndSANs(csr)
+	token, err := client.Token(ctx, subject, sans)
+	if err != nil {
+		message := "Failed to sign step-ca provisioner token"
+
+		s.reporter.Pending(cr, err, "TokenError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	resp, err := client.Sign(ctx, &stepcaclient.SignRequest{
+		CSR:      string(cr.Spec.Request),
+		OTT:      token,
+		NotAfter: apiutil.DefaultCertDuration(cr.Spec.Duration).String(),
+	})
+	if stepcaclient.IsBadRequest(err) {
+		message := "step-ca rejected the certificate request"
+
+		s.reporter.Failed(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+	if err != nil {
+		message := "Failed to request certificate from step-ca"
+
+		s.reporter.Pending(cr, err, "RequestError", message)
+		log.Error(err, message)
+
+		return nil, err
+	}
+
+	log.V(logf.DebugLevel).Info("certificate issued")
+
+	bundle, err := utilpki.ParseSingleCertificateChainPEM(resp.PEM())
+	if err != nil {
+		message := "Failed to parse returned certificate bundle"
+		s.reporter.Failed(cr, err, "ParseError", message)
+		log.Error(err, message)
+		return nil, err
+	}
+
+	return &issuerpkg.IssueResponse{
+		Certificate: bundle.ChainPEM,
+		CA:          bundle.CAPEM,
+	}, nil
+}
+
+// subjectAndSANs returns the subject and SANs of the one-time token for csr.
+// step-ca requires the SANs of the token to match those of the CSR; the
+// subject is the common name, or the first SAN if there is none.
+func subjectAndSANs(csr *x509.CertificateRequest) (string, []string) {
+	var sans []string
+	sans = append(sans, csr.DNSNames...)
+	sans = append(sans, utilpki.IPAddressesToString(csr.IPAddresses)...)
+	sans = append(sans, csr.EmailAddresses...)
+	sans = append(sans, utilpki.URLsToString(csr.URIs)...)
+
+	subject := csr.Subject.CommonName
+	if subject == "" && len(sans) > 0 {
+		subject = sans[0]
+	}
+	return subject, sans
+}
@@ -131,6 +131,10 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 		return nil
 	}
 
+	if limited := c.enforceRateLimit(ctx, issuerObj, crCopy); limited {
+		return nil
+	}
+
 	dbg.Info("invoking sign function as existing certificate does not exist")
 
 	// Attempt to call the Sign function on our issuer
@@ -161,6 +165,8 @@ func (c *Controller) Sync(ctx context.Context, cr *cmapi.CertificateRequest) (er
 	// Set condition to Ready.
 	c.reporter.Ready(crCopy)
 
+	c.observeIssuanceLatency(ctx, issuerObj, crCopy)
+
 	return nil
 }
 
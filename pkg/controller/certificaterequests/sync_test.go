@@ -34,6 +34,8 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	"github.com/cert-manager/cert-manager/internal/issuanceratelimit"
 	"github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -163,6 +165,27 @@ func TestSync(t *testing.T) {
 		}),
 	)
 
+	budgetIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerIssuanceLatencyBudget(cmapi.IssuanceLatencyBudget{
+			Average: &metav1.Duration{Duration: time.Minute},
+		}),
+	)
+	degradedIssuer := gen.IssuerFrom(budgetIssuer,
+		gen.AddIssuerCondition(cmapi.IssuerCondition{
+			Type:   cmapi.IssuerConditionDegraded,
+			Status: cmmeta.ConditionTrue,
+		}),
+	)
+	rateLimitedIssuer := gen.IssuerFrom(baseIssuer,
+		gen.SetIssuerRateLimit(cmapi.IssuanceRateLimit{
+			MaxRequests: 1,
+			Period:      &metav1.Duration{Duration: time.Hour},
+		}),
+	)
+	slowCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(fixedClockStart.Add(-10*time.Minute))),
+	)
+
 	certRSAPEM := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart, fixedClockStart.Add(time.Hour*12))
 	certRSAPEMExpired := generateSelfSignedCert(t, baseCR, skRSA, fixedClockStart.Add(-time.Hour*13), fixedClockStart.Add(-time.Hour*12))
 
@@ -731,11 +754,155 @@ func TestSync(t *testing.T) {
 				},
 			},
 		},
+		"if issuance latency exceeds the issuer's budget then set the issuer Degraded condition": {
+			certificateRequest: slowCR.DeepCopy(),
+			latencyTracker:     newLatencyTracker(budgetIssuer, 4, 10*time.Minute),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{budgetIssuer, slowCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+					"Warning IssuanceLatencyBudgetExceeded Over the last 5 issuances, average latency 10m0s exceeds budget of 1m0s",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("issuers"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.IssuerFrom(budgetIssuer,
+							gen.AddIssuerCondition(cmapi.IssuerCondition{
+								Type:               cmapi.IssuerConditionDegraded,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "IssuanceLatencyBudgetExceeded",
+								Message:            "Over the last 5 issuances, average latency 10m0s exceeds budget of 1m0s",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(slowCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the issuer has reached its rate limit then mark the request as pending without signing": {
+			certificateRequest: baseCR.DeepCopy(),
+			rateLimiter:        newRateLimiter(rateLimitedIssuer, "other-request"),
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{rateLimitedIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal RateLimited Referenced issuer has reached its limit of 1 requests per 1h0m0s",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "Pending",
+								Message:            "Referenced issuer has reached its limit of 1 requests per 1h0m0s",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the issuer has capacity under its rate limit then sign the request": {
+			certificateRequest: baseCR.DeepCopy(),
+			rateLimiter:        newRateLimiter(rateLimitedIssuer),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{rateLimitedIssuer, baseCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(baseCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
+		"if the issuer is already marked as Degraded then do not update it again": {
+			certificateRequest: slowCR.DeepCopy(),
+			latencyTracker:     newLatencyTracker(budgetIssuer, 4, 10*time.Minute),
+			issuerImpl: &fake.Issuer{
+				FakeSign: func(context.Context, *cmapi.CertificateRequest, cmapi.GenericIssuer) (*issuer.IssueResponse, error) {
+					return &issuer.IssueResponse{
+						Certificate: certRSAPEM,
+					}, nil
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{degradedIssuer, slowCR.DeepCopy()},
+				ExpectedEvents: []string{
+					"Normal CertificateIssued Certificate fetched from issuer successfully",
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						"status",
+						gen.DefaultTestNamespace,
+						gen.CertificateRequestFrom(slowCR,
+							gen.SetCertificateRequestCertificate(certRSAPEM),
+							gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+								Type:               cmapi.CertificateRequestConditionReady,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "Issued",
+								Message:            "Certificate fetched from issuer successfully",
+								LastTransitionTime: &nowMetaTime,
+							}),
+						),
+					)),
+				},
+			},
+		},
 	}
 
 	for n, test := range tests {
 		t.Run(n, func(t *testing.T) {
 			fixedClock.SetTime(fixedClockStart)
+			util.Clock = fixedClock
 			runTest(t, test)
 		})
 	}
@@ -746,13 +913,37 @@ type testT struct {
 	issuerImpl         Issuer
 	certificateRequest *cmapi.CertificateRequest
 	helper             *issuerfake.Helper
+	latencyTracker     *issuancelatency.Tracker
+	rateLimiter        *issuanceratelimit.Limiter
 	expectedErr        bool
 }
 
+// newLatencyTracker returns a Tracker that has already observed n issuances
+// by the given issuer, each taking the given latency.
+func newLatencyTracker(iss cmapi.GenericIssuer, n int, latency time.Duration) *issuancelatency.Tracker {
+	tracker := issuancelatency.New()
+	for i := 0; i < n; i++ {
+		tracker.Observe(issuancelatency.KeyFor(iss), latency, 0)
+	}
+	return tracker
+}
+
+// newRateLimiter returns a Limiter that has already admitted requests with
+// the given IDs for the given issuer.
+func newRateLimiter(iss cmapi.GenericIssuer, ids ...string) *issuanceratelimit.Limiter {
+	limiter := issuanceratelimit.New(fixedClock)
+	for _, id := range ids {
+		limiter.Allow(issuancelatency.KeyFor(iss), id, iss.GetSpec().RateLimit)
+	}
+	return limiter
+}
+
 func runTest(t *testing.T, test testT) {
 	test.builder.T = t
 	test.builder.Clock = fixedClock
 	test.builder.Init()
+	test.builder.Context.IssuanceLatency = test.latencyTracker
+	test.builder.Context.IssuanceRateLimiter = test.rateLimiter
 
 	defer test.builder.Stop()
 
@@ -22,6 +22,7 @@ import (
 	k8sErrors "k8s.io/apimachinery/pkg/api/errors"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/cert-manager/cert-manager/internal/issuancecache"
 	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -72,9 +73,31 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
-	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
+	certDuration := apiutil.DefaultCertDuration(cr.Spec.Duration)
+
+	// Malformed requests are never cached, the error is surfaced by Vault.
+	cacheKey, cacheKeyErr := issuancecache.KeyFor(issuerObj, cr.Spec.Request, certDuration)
+	if cacheKeyErr == nil {
+		if entry, ok := v.issuerOptions.IssuanceCache.Get(cacheKey); ok {
+			log.V(logf.DebugLevel).Info("identical request was recently signed, reusing issued certificate")
+			return &issuer.IssueResponse{
+				Certificate: entry.Certificate,
+				CA:          entry.CA,
+			}, nil
+		}
+	}
+
+	resourceNamespace, err := v.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		v.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
 
-	client, err := v.vaultClientBuilder(resourceNamespace, v.secretsLister, issuerObj)
+		return nil, nil
+	}
+
+	client, err := v.vaultClientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.issuerOptions.CircuitBreakers, v.issuerOptions.VaultTokens)
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 
@@ -91,7 +114,6 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 		return nil, nil
 	}
 
-	certDuration := apiutil.DefaultCertDuration(cr.Spec.Duration)
 	certPem, caPem, err := client.Sign(cr.Spec.Request, certDuration)
 	if err != nil {
 		message := "Vault failed to sign certificate"
@@ -104,6 +126,13 @@ func (v *Vault) Sign(ctx context.Context, cr *v1.CertificateRequest, issuerObj v
 
 	log.V(logf.DebugLevel).Info("certificate issued")
 
+	if cacheKeyErr == nil {
+		v.issuerOptions.IssuanceCache.Add(cacheKey, issuancecache.Entry{
+			Certificate: certPem,
+			CA:          caPem,
+		})
+	}
+
 	return &issuer.IssueResponse{
 		Certificate: certPem,
 		CA:          caPem,
@@ -37,6 +37,8 @@ import (
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
+	"github.com/cert-manager/cert-manager/internal/issuancecache"
 	internalvault "github.com/cert-manager/cert-manager/internal/vault"
 	fakevault "github.com/cert-manager/cert-manager/internal/vault/fake"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
@@ -208,7 +210,7 @@ func TestSign(t *testing.T) {
 				KubeObjects:        []runtime.Object{},
 				CertManagerObjects: []runtime.Object{baseCR.DeepCopy(), baseIssuer.DeepCopy()},
 				ExpectedEvents: []string{
-					"Normal VaultInitError Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, or Kubernetes auth role not set",
+					"Normal VaultInitError Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, Kubernetes auth role, or clientCertificate not set",
 				},
 				ExpectedActions: []testpkg.Action{
 					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
@@ -220,7 +222,7 @@ func TestSign(t *testing.T) {
 								Type:               cmapi.CertificateRequestConditionReady,
 								Status:             cmmeta.ConditionFalse,
 								Reason:             cmapi.CertificateRequestReasonPending,
-								Message:            "Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, or Kubernetes auth role not set",
+								Message:            "Failed to initialise vault client for signing: error initializing Vault client: tokenSecretRef, appRoleSecretRef, Kubernetes auth role, or clientCertificate not set",
 								LastTransitionTime: &metaFixedClockStart,
 							}),
 						),
@@ -527,7 +529,7 @@ func runTest(t *testing.T, test testT) {
 
 	if test.fakeVault != nil {
 		vault.vaultClientBuilder = func(ns string, sl corelisters.SecretLister,
-			iss cmapi.GenericIssuer) (internalvault.Interface, error) {
+			iss cmapi.GenericIssuer, _ *circuitbreaker.Registry, _ *internalvault.TokenCache) (internalvault.Interface, error) {
 			return test.fakeVault.New(ns, sl, iss)
 		}
 	}
@@ -553,3 +555,38 @@ func runTest(t *testing.T, test testT) {
 
 	test.builder.CheckAndFinish(err)
 }
+
+func TestSignReusesCachedCertificate(t *testing.T) {
+	rsaSK, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	csrPEM := generateCSR(t, rsaSK)
+	issuer := gen.Issuer("vault-issuer", gen.SetIssuerVault(cmapi.VaultIssuer{}))
+	cr := gen.CertificateRequest("test-cr", gen.SetCertificateRequestCSR(csrPEM))
+
+	calls := 0
+	vault := &Vault{
+		issuerOptions: controller.IssuerOptions{
+			IssuanceCache: issuancecache.New(time.Minute, fixedClock),
+		},
+		vaultClientBuilder: func(ns string, sl corelisters.SecretLister,
+			iss cmapi.GenericIssuer, _ *circuitbreaker.Registry, _ *internalvault.TokenCache) (internalvault.Interface, error) {
+			calls++
+			return fakevault.New().WithSign([]byte("cert"), []byte("ca"), nil).New(ns, sl, iss)
+		},
+	}
+
+	for i := 0; i < 2; i++ {
+		resp, err := vault.Sign(context.Background(), cr.DeepCopy(), issuer)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if resp == nil || string(resp.Certificate) != "cert" || string(resp.CA) != "ca" {
+			t.Fatalf("unexpected response: %+v", resp)
+		}
+	}
+	if calls != 1 {
+		t.Errorf("expected Vault to be called once, got %d calls", calls)
+	}
+}
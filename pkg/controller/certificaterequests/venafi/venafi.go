@@ -27,6 +27,7 @@ import (
 
 	"github.com/Venafi/vcert/v4/pkg/endpoint"
 
+	"github.com/cert-manager/cert-manager/internal/issuancecache"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	clientset "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
@@ -80,7 +81,17 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
-	client, err := v.clientBuilder(v.issuerOptions.ResourceNamespace(issuerObj), v.secretsLister, issuerObj, v.metrics, log)
+	resourceNamespace, err := v.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := "Issuer is not allowed to read its credentials"
+
+		v.reporter.Pending(cr, err, "CredentialsNotGranted", message)
+		log.Error(err, message)
+
+		return nil, nil
+	}
+
+	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.metrics, v.issuerOptions.CircuitBreakers, log)
 	if k8sErrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 
@@ -115,6 +126,20 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 	duration := apiutil.DefaultCertDuration(cr.Spec.Duration)
 	pickupID := cr.ObjectMeta.Annotations[cmapi.VenafiPickupIDAnnotationKey]
 
+	// Custom fields change what Venafi issues, so they are part of the cache
+	// key. Malformed requests are never cached, the error is surfaced by
+	// Venafi.
+	cacheKey, cacheKeyErr := issuancecache.KeyFor(issuerObj, cr.Spec.Request, duration, cr.GetAnnotations()[cmapi.VenafiCustomFieldsAnnotationKey])
+	if cacheKeyErr == nil && pickupID == "" {
+		if entry, ok := v.issuerOptions.IssuanceCache.Get(cacheKey); ok {
+			log.V(logf.DebugLevel).Info("identical request was recently signed, reusing issued certificate")
+			return &issuerpkg.IssueResponse{
+				Certificate: entry.Certificate,
+				CA:          entry.CA,
+			}, nil
+		}
+	}
+
 	// check if the pickup ID annotation is there, if not set it up.
 	if pickupID == "" {
 		pickupID, err = client.RequestCertificate(cr.Spec.Request, duration, customFields)
@@ -175,6 +200,13 @@ func (v *Venafi) Sign(ctx context.Context, cr *cmapi.CertificateRequest, issuerO
 		return nil, err
 	}
 
+	if cacheKeyErr == nil {
+		v.issuerOptions.IssuanceCache.Add(cacheKey, issuancecache.Entry{
+			Certificate: bundle.ChainPEM,
+			CA:          bundle.CAPEM,
+		})
+	}
+
 	return &issuerpkg.IssueResponse{
 		Certificate: bundle.ChainPEM,
 		CA:          bundle.CAPEM,
@@ -0,0 +1,474 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"bytes"
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate canary controller.
+	ControllerName = "certificates-canary"
+
+	// LeadTime is how long before a Certificate's renewal time the canary
+	// issuance is attempted.
+	LeadTime = 7 * 24 * time.Hour
+
+	// SecretNameSuffix is appended to a Certificate's spec.secretName to form
+	// the name of the shadow Secret that canary issuances are written to.
+	SecretNameSuffix = "-canary"
+
+	reasonCanaryRequested = "CanaryRequested"
+	reasonCanaryIssued    = "CanaryIssued"
+	reasonCanaryFailed    = "CanaryFailed"
+)
+
+var (
+	certificateGvk = cmapi.SchemeGroupVersion.WithKind("Certificate")
+)
+
+// This controller performs a trial issuance for Certificates with
+// `spec.canaryRenewal` set, some time before they are due to be renewed.
+// The result is written to a shadow Secret so that the Secret named in
+// `spec.secretName` is never modified, and the outcome is reported using the
+// `CanaryRenewal` status condition.
+// Canary CertificateRequests are not controlled by the Certificate, so the
+// other certificates controllers do not consider them part of the issuance
+// flow.
+type controller struct {
+	certificateLister        cmlisters.CertificateLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	secretLister             corelisters.SecretLister
+	client                   cmclient.Interface
+	kubeClient               kubernetes.Interface
+	recorder                 record.EventRecorder
+	scheduledWorkQueue       scheduler.ScheduledWorkQueue
+	clock                    clock.Clock
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Create or Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate canary controller.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	fieldManager string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// When a canary CertificateRequest changes, enqueue the Certificate it
+	// was created for.
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: enqueueCanaryRequestCertificate(log, queue),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:        certificateInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		client:                   client,
+		kubeClient:               kubeClient,
+		recorder:                 recorder,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		clock:                    clock,
+		fieldManager:             fieldManager,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	if !crt.Spec.CanaryRenewal || crt.Status.RenewalTime == nil {
+		return nil
+	}
+
+	// Only run a canary issuance in the window leading up to the renewal.
+	// Once the renewal time has been reached the real renewal takes over.
+	now := c.clock.Now()
+	renewalTime := crt.Status.RenewalTime.Time
+	canaryTime := renewalTime.Add(-LeadTime)
+	if now.Before(canaryTime) {
+		log.V(logf.DebugLevel).Info("scheduling canary renewal", "canaryTime", canaryTime)
+		c.scheduledWorkQueue.Add(key, canaryTime.Sub(now))
+		return nil
+	}
+	if !now.Before(renewalTime) {
+		return nil
+	}
+
+	renewal := renewalTime.UTC().Format(time.RFC3339)
+	reqs, err := certificates.ListCertificateRequestsMatchingPredicates(c.certificateRequestLister.CertificateRequests(crt.Namespace),
+		labels.Everything(),
+		canaryRequestFor(crt, renewal),
+	)
+	if err != nil {
+		return err
+	}
+
+	if len(reqs) == 0 {
+		return c.createCanaryRequest(ctx, crt, renewal)
+	}
+
+	return c.syncCanaryRequest(ctx, crt, reqs[0])
+}
+
+// createCanaryRequest generates a new private key, stores it in the shadow
+// Secret and creates a CertificateRequest for the Certificate using it.
+func (c *controller) createCanaryRequest(ctx context.Context, crt *cmapi.Certificate, renewal string) error {
+	log := logf.FromContext(ctx)
+
+	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		log.Error(err, "Failed to generate private key for canary renewal - will not retry")
+		return nil
+	}
+	pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
+	if err != nil {
+		return err
+	}
+
+	x509CSR, err := pki.GenerateCSR(crt)
+	if err != nil {
+		log.Error(err, "Failed to generate CSR for canary renewal - will not retry")
+		return nil
+	}
+	csrDER, err := pki.EncodeCSR(x509CSR, pk)
+	if err != nil {
+		return err
+	}
+	csrPEM := bytes.NewBuffer([]byte{})
+	if err := pem.Encode(csrPEM, &pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}); err != nil {
+		return err
+	}
+
+	secretName := crt.Spec.SecretName + SecretNameSuffix
+	if err := c.writeSecret(ctx, crt, secretName, map[string][]byte{corev1.TLSPrivateKeyKey: pkData}); err != nil {
+		return err
+	}
+
+	cr := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:    crt.Namespace,
+			GenerateName: apiutil.DNSSafeShortenTo52Characters(crt.Name+SecretNameSuffix) + "-",
+			Annotations: map[string]string{
+				cmapi.CertificateRequestCanaryRenewalAnnotationKey: renewal,
+				cmapi.CertificateRequestPrivateKeyAnnotationKey:    secretName,
+				cmapi.CertificateNameKey:                           crt.Name,
+			},
+			Labels:          crt.Labels,
+			OwnerReferences: []metav1.OwnerReference{ownerRef(crt)},
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Duration:  crt.Spec.Duration,
+			IssuerRef: crt.Spec.IssuerRef,
+			Request:   csrPEM.Bytes(),
+			IsCA:      crt.Spec.IsCA,
+			Usages:    crt.Spec.Usages,
+		},
+	}
+	if len(crt.Spec.SignatureAlgorithm) > 0 {
+		cr.Annotations[cmapi.CertificateRequestSignatureAlgorithmAnnotationKey] = string(crt.Spec.SignatureAlgorithm)
+	}
+	if len(crt.Spec.ExtraExtensions) > 0 {
+		cr.Annotations[cmapi.CertificateRequestExtraExtensionsAnnotationKey] = pki.ExtraExtensionsAnnotationValue(crt.Spec.ExtraExtensions)
+	}
+	if len(crt.Spec.CertificatePolicies) > 0 {
+		cr.Annotations[cmapi.CertificateRequestCertificatePoliciesAnnotationKey] = pki.ObjectIdentifiersAnnotationValue(crt.Spec.CertificatePolicies)
+	}
+	if len(crt.Spec.ExtendedKeyUsageOIDs) > 0 {
+		cr.Annotations[cmapi.CertificateRequestExtendedKeyUsageOIDsAnnotationKey] = pki.ObjectIdentifiersAnnotationValue(crt.Spec.ExtendedKeyUsageOIDs)
+	}
+
+	cr, err = c.client.CertmanagerV1().CertificateRequests(cr.Namespace).Create(ctx, cr, metav1.CreateOptions{FieldManager: c.fieldManager})
+	if err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonCanaryRequested, "Created canary CertificateRequest resource %q", cr.Name)
+	return c.setCondition(ctx, crt, cmmeta.ConditionUnknown, reasonCanaryRequested,
+		fmt.Sprintf("Canary issuance ahead of renewal at %s is in progress", renewal))
+}
+
+// syncCanaryRequest inspects an existing canary CertificateRequest, writing
+// the issued certificate to the shadow Secret and reporting the result on
+// the Certificate once the request has completed.
+func (c *controller) syncCanaryRequest(ctx context.Context, crt *cmapi.Certificate, req *cmapi.CertificateRequest) error {
+	log := logf.WithResource(logf.FromContext(ctx), req)
+
+	readyCond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionReady)
+	switch {
+	case readyCond == nil && apiutil.CertificateRequestIsDenied(req):
+		deniedCond := apiutil.GetCertificateRequestCondition(req, cmapi.CertificateRequestConditionDenied)
+		return c.failCanary(ctx, crt, fmt.Sprintf("Canary CertificateRequest %q was denied: %s", req.Name, deniedCond.Message))
+
+	case readyCond == nil:
+		log.V(logf.DebugLevel).Info("canary CertificateRequest does not have Ready condition, waiting...")
+		return nil
+
+	case readyCond.Reason == cmapi.CertificateRequestReasonFailed:
+		return c.failCanary(ctx, crt, fmt.Sprintf("Canary CertificateRequest %q failed: %s", req.Name, readyCond.Message))
+
+	case readyCond.Status != cmmeta.ConditionTrue || len(req.Status.Certificate) == 0:
+		log.V(logf.DebugLevel).Info("canary CertificateRequest is not yet ready, waiting...")
+		return nil
+	}
+
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryRenewal); cond != nil &&
+		cond.Status == cmmeta.ConditionTrue && cond.ObservedGeneration == crt.Generation {
+		return nil
+	}
+
+	secretName := crt.Spec.SecretName + SecretNameSuffix
+	data := map[string][]byte{
+		corev1.TLSCertKey: req.Status.Certificate,
+		cmmeta.TLSCAKey:   req.Status.CA,
+	}
+	if err := c.writeSecret(ctx, crt, secretName, data); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonCanaryIssued, "Canary issuance succeeded and was stored in Secret %q", secretName)
+	return c.setCondition(ctx, crt, cmmeta.ConditionTrue, reasonCanaryIssued,
+		fmt.Sprintf("Canary issuance succeeded and was stored in Secret %q", secretName))
+}
+
+func (c *controller) failCanary(ctx context.Context, crt *cmapi.Certificate, message string) error {
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryRenewal); cond != nil &&
+		cond.Status == cmmeta.ConditionFalse && cond.Message == message {
+		return nil
+	}
+	c.recorder.Event(crt, corev1.EventTypeWarning, reasonCanaryFailed, message)
+	return c.setCondition(ctx, crt, cmmeta.ConditionFalse, reasonCanaryFailed, message)
+}
+
+// writeSecret creates or updates the shadow Secret, merging the given data
+// into any data it already holds.
+func (c *controller) writeSecret(ctx context.Context, crt *cmapi.Certificate, name string, data map[string][]byte) error {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: crt.Namespace,
+				Name:      name,
+				Annotations: map[string]string{
+					cmapi.CertificateNameKey: crt.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{ownerRef(crt)},
+			},
+			Type: corev1.SecretTypeTLS,
+			Data: data,
+		}
+		_, err := c.kubeClient.CoreV1().Secrets(crt.Namespace).Create(ctx, secret, metav1.CreateOptions{FieldManager: c.fieldManager})
+		return err
+	}
+	if err != nil {
+		return err
+	}
+
+	secret = secret.DeepCopy()
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	for k, v := range data {
+		secret.Data[k] = v
+	}
+	_, err = c.kubeClient.CoreV1().Secrets(crt.Namespace).Update(ctx, secret, metav1.UpdateOptions{FieldManager: c.fieldManager})
+	return err
+}
+
+func (c *controller) setCondition(ctx context.Context, crt *cmapi.Certificate, status cmmeta.ConditionStatus, reason, message string) error {
+	oldCrt := crt
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCanaryRenewal, status, reason, message)
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+	return c.updateOrApplyStatus(ctx, crt)
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		var conditions []cmapi.CertificateCondition
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCanaryRenewal); cond != nil {
+			conditions = []cmapi.CertificateCondition{*cond}
+		}
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: conditions},
+		})
+	} else {
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+}
+
+// ownerRef returns a non-controller owner reference to the Certificate. It
+// ensures canary resources are garbage collected with the Certificate without
+// them being picked up by controllers that act on resources it controls.
+func ownerRef(crt *cmapi.Certificate) metav1.OwnerReference {
+	ref := *metav1.NewControllerRef(crt, certificateGvk)
+	ref.Controller = nil
+	ref.BlockOwnerDeletion = nil
+	return ref
+}
+
+// canaryRequestFor returns a predicate that matches canary CertificateRequests
+// created for the given Certificate ahead of the given renewal time.
+func canaryRequestFor(crt *cmapi.Certificate, renewal string) predicate.Func {
+	return func(obj runtime.Object) bool {
+		req := obj.(*cmapi.CertificateRequest)
+		return req.Annotations[cmapi.CertificateNameKey] == crt.Name &&
+			req.Annotations[cmapi.CertificateRequestCanaryRenewalAnnotationKey] == renewal
+	}
+}
+
+// enqueueCanaryRequestCertificate returns a function that enqueues the
+// Certificate named on canary CertificateRequests.
+func enqueueCanaryRequestCertificate(log logr.Logger, queue workqueue.Interface) func(obj interface{}) {
+	return func(obj interface{}) {
+		req, ok := obj.(*cmapi.CertificateRequest)
+		if !ok {
+			log.Error(nil, "object is not a CertificateRequest", "object", obj)
+			return
+		}
+		if _, ok := req.Annotations[cmapi.CertificateRequestCanaryRenewalAnnotationKey]; !ok {
+			return
+		}
+		crtName := req.Annotations[cmapi.CertificateNameKey]
+		if crtName == "" {
+			return
+		}
+		queue.Add(req.Namespace + "/" + crtName)
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		ctx.FieldManager,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,236 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package canary
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	fixedNow := metav1.NewTime(now)
+	renewalTime := metav1.NewTime(now.Add(LeadTime / 2))
+	renewal := renewalTime.UTC().Format(time.RFC3339)
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("uid"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateCanaryRenewal(true),
+		gen.SetCertificateRenewalTime(renewalTime),
+	)
+	canarySecret := gen.Secret("test-tls-canary",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretData(map[string][]byte{corev1.TLSPrivateKeyKey: []byte("key")}),
+	)
+	baseReq := gen.CertificateRequest("test-canary-abcde",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestCanaryRenewalAnnotationKey: renewal,
+			cmapi.CertificateRequestPrivateKeyAnnotationKey:    "test-tls-canary",
+			cmapi.CertificateNameKey:                           "test",
+		}),
+	)
+
+	tests := map[string]struct {
+		crt            *cmapi.Certificate
+		existingCM     []runtime.Object
+		existingKube   []runtime.Object
+		expectedEvents []string
+		expectedAction []testpkg.Action
+		shouldSchedule bool
+	}{
+		"do nothing if canary renewal is not enabled": {
+			crt: gen.CertificateFrom(baseCrt, gen.SetCertificateCanaryRenewal(false)),
+		},
+		"do nothing if the certificate has no renewal time": {
+			crt: gen.CertificateFrom(baseCrt, func(crt *cmapi.Certificate) { crt.Status.RenewalTime = nil }),
+		},
+		"schedule the certificate if the canary time has not been reached": {
+			crt:            gen.CertificateFrom(baseCrt, gen.SetCertificateRenewalTime(metav1.NewTime(now.Add(LeadTime*2)))),
+			shouldSchedule: true,
+		},
+		"do nothing if the renewal time has already passed": {
+			crt: gen.CertificateFrom(baseCrt, gen.SetCertificateRenewalTime(metav1.NewTime(now.Add(-time.Minute)))),
+		},
+		"do nothing whilst the canary request is pending": {
+			crt:        baseCrt,
+			existingCM: []runtime.Object{baseReq},
+		},
+		"write the issued certificate to the shadow secret and mark the canary as succeeded": {
+			crt: baseCrt,
+			existingCM: []runtime.Object{gen.CertificateRequestFrom(baseReq,
+				gen.SetCertificateRequestCertificate([]byte("cert")),
+				gen.SetCertificateRequestCA([]byte("ca")),
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:   cmapi.CertificateRequestConditionReady,
+					Status: cmmeta.ConditionTrue,
+					Reason: cmapi.CertificateRequestReasonIssued,
+				}),
+			)},
+			existingKube:   []runtime.Object{canarySecret},
+			expectedEvents: []string{`Normal CanaryIssued Canary issuance succeeded and was stored in Secret "test-tls-canary"`},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"), "testns",
+					gen.SecretFrom(canarySecret, gen.SetSecretData(map[string][]byte{
+						corev1.TLSPrivateKeyKey: []byte("key"),
+						corev1.TLSCertKey:       []byte("cert"),
+						cmmeta.TLSCAKey:         []byte("ca"),
+					})),
+				)),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+						Type:               cmapi.CertificateConditionCanaryRenewal,
+						Status:             cmmeta.ConditionTrue,
+						Reason:             reasonCanaryIssued,
+						Message:            `Canary issuance succeeded and was stored in Secret "test-tls-canary"`,
+						LastTransitionTime: &fixedNow,
+					})),
+				)),
+			},
+		},
+		"mark the canary as failed if the canary request failed": {
+			crt: baseCrt,
+			existingCM: []runtime.Object{gen.CertificateRequestFrom(baseReq,
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:    cmapi.CertificateRequestConditionReady,
+					Status:  cmmeta.ConditionFalse,
+					Reason:  cmapi.CertificateRequestReasonFailed,
+					Message: "issuer is not ready",
+				}),
+			)},
+			expectedEvents: []string{`Warning CanaryFailed Canary CertificateRequest "test-canary-abcde" failed: issuer is not ready`},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+						Type:               cmapi.CertificateConditionCanaryRenewal,
+						Status:             cmmeta.ConditionFalse,
+						Reason:             reasonCanaryFailed,
+						Message:            `Canary CertificateRequest "test-canary-abcde" failed: issuer is not ready`,
+						LastTransitionTime: &fixedNow,
+					})),
+				)),
+			},
+		},
+		"ignore canary requests created for a different renewal": {
+			crt: baseCrt,
+			existingCM: []runtime.Object{gen.CertificateRequestFrom(baseReq,
+				gen.AddCertificateRequestAnnotations(map[string]string{
+					cmapi.CertificateRequestCanaryRenewalAnnotationKey: "2000-01-01T00:00:00Z",
+				}),
+				gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+					Type:   cmapi.CertificateRequestConditionReady,
+					Status: cmmeta.ConditionFalse,
+					Reason: cmapi.CertificateRequestReasonFailed,
+				}),
+			)},
+			expectedEvents: []string{`Normal CanaryRequested Created canary CertificateRequest resource "test-canary-notrandom"`},
+			expectedAction: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", nil),
+					func(exp, got coretesting.Action) error {
+						secret := got.(coretesting.CreateAction).GetObject().(*corev1.Secret)
+						if secret.Name != "test-tls-canary" || len(secret.Data[corev1.TLSPrivateKeyKey]) == 0 {
+							return fmt.Errorf("expected shadow Secret with a private key, got %#v", secret)
+						}
+						return nil
+					}),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", nil),
+					func(exp, got coretesting.Action) error {
+						req := got.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+						if req.Annotations[cmapi.CertificateRequestCanaryRenewalAnnotationKey] != renewal {
+							return fmt.Errorf("unexpected canary renewal annotation on CertificateRequest: %v", req.Annotations)
+						}
+						if len(req.OwnerReferences) != 1 || req.OwnerReferences[0].Controller != nil {
+							return fmt.Errorf("expected a single non-controller owner reference, got %v", req.OwnerReferences)
+						}
+						return nil
+					}),
+				testpkg.NewCustomMatch(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns", nil),
+					func(exp, got coretesting.Action) error {
+						crt := got.(coretesting.UpdateAction).GetObject().(*cmapi.Certificate)
+						if len(crt.Status.Conditions) != 1 || crt.Status.Conditions[0].Reason != reasonCanaryRequested {
+							return fmt.Errorf("expected %s condition, got %v", reasonCanaryRequested, crt.Status.Conditions)
+						}
+						return nil
+					}),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				StringGenerator:    func(i int) string { return "notrandom" },
+				CertManagerObjects: append([]runtime.Object{test.crt}, test.existingCM...),
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedAction,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			gotScheduled := false
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = true
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.controller.ProcessItem(context.Background(), key)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != test.shouldSchedule {
+				t.Errorf("expected scheduled=%v, got=%v", test.shouldSchedule, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"reflect"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// DefaultFailoverAttempts is the number of consecutive failed issuances
+// after which the next fallback issuer of a Certificate is used, if the
+// Certificate does not set `spec.failoverAttempts`.
+const DefaultFailoverAttempts = 2
+
+// IssuerRefForIssuance returns the issuer that the next issuance of the given
+// Certificate should be requested from. This is `spec.issuerRef` unless
+// issuance has repeatedly failed, in which case the issuers in
+// `spec.fallbackIssuerRefs` are used in turn, each for `spec.failoverAttempts`
+// failed issuances, before starting again with `spec.issuerRef`.
+func IssuerRefForIssuance(crt *cmapi.Certificate) cmmeta.ObjectReference {
+	if len(crt.Spec.FallbackIssuerRefs) == 0 || crt.Status.FailedIssuanceAttempts == nil {
+		return crt.Spec.IssuerRef
+	}
+
+	attempts := DefaultFailoverAttempts
+	if crt.Spec.FailoverAttempts != nil && *crt.Spec.FailoverAttempts > 0 {
+		attempts = int(*crt.Spec.FailoverAttempts)
+	}
+
+	i := (*crt.Status.FailedIssuanceAttempts / attempts) % (len(crt.Spec.FallbackIssuerRefs) + 1)
+	if i == 0 {
+		return crt.Spec.IssuerRef
+	}
+	return crt.Spec.FallbackIssuerRefs[i-1]
+}
+
+// IssuerRefAllowed returns whether the given issuer is either the issuer or
+// one of the fallback issuers of the Certificate spec, i.e. whether a
+// CertificateRequest for the Certificate may be issued by it.
+func IssuerRefAllowed(spec cmapi.CertificateSpec, ref cmmeta.ObjectReference) bool {
+	if reflect.DeepEqual(spec.IssuerRef, ref) {
+		return true
+	}
+	for _, fallback := range spec.FallbackIssuerRefs {
+		if reflect.DeepEqual(fallback, ref) {
+			return true
+		}
+	}
+	return false
+}
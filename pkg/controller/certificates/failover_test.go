@@ -0,0 +1,102 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+func TestIssuerRefForIssuance(t *testing.T) {
+	primary := cmmeta.ObjectReference{Name: "primary"}
+	backup := cmmeta.ObjectReference{Name: "backup", Kind: "ClusterIssuer"}
+	external := cmmeta.ObjectReference{Name: "external", Kind: "ExternalIssuer", Group: "example.io"}
+	intPtr := func(i int) *int { return &i }
+	int32Ptr := func(i int32) *int32 { return &i }
+
+	tests := map[string]struct {
+		spec           cmapi.CertificateSpec
+		failedAttempts *int
+		expected       cmmeta.ObjectReference
+	}{
+		"no fallback issuers always uses the issuerRef": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary},
+			failedAttempts: intPtr(10),
+			expected:       primary,
+		},
+		"no failed issuances uses the issuerRef": {
+			spec:     cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup}},
+			expected: primary,
+		},
+		"issuerRef is retried until failoverAttempts is reached": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup}},
+			failedAttempts: intPtr(1),
+			expected:       primary,
+		},
+		"first fallback issuer is used once failoverAttempts is reached": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup, external}},
+			failedAttempts: intPtr(2),
+			expected:       backup,
+		},
+		"second fallback issuer is used once the first has failed": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup, external}},
+			failedAttempts: intPtr(4),
+			expected:       external,
+		},
+		"issuerRef is used again once all fallback issuers have failed": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup, external}},
+			failedAttempts: intPtr(6),
+			expected:       primary,
+		},
+		"failoverAttempts is honoured": {
+			spec:           cmapi.CertificateSpec{IssuerRef: primary, FallbackIssuerRefs: []cmmeta.ObjectReference{backup, external}, FailoverAttempts: int32Ptr(1)},
+			failedAttempts: intPtr(2),
+			expected:       external,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{
+				Spec:   test.spec,
+				Status: cmapi.CertificateStatus{FailedIssuanceAttempts: test.failedAttempts},
+			}
+			if got := IssuerRefForIssuance(crt); got != test.expected {
+				t.Errorf("expected issuer %v, got %v", test.expected, got)
+			}
+		})
+	}
+}
+
+func TestIssuerRefAllowed(t *testing.T) {
+	spec := cmapi.CertificateSpec{
+		IssuerRef:          cmmeta.ObjectReference{Name: "primary"},
+		FallbackIssuerRefs: []cmmeta.ObjectReference{{Name: "backup", Kind: "ClusterIssuer"}},
+	}
+
+	if !IssuerRefAllowed(spec, cmmeta.ObjectReference{Name: "primary"}) {
+		t.Errorf("expected the issuerRef to be allowed")
+	}
+	if !IssuerRefAllowed(spec, cmmeta.ObjectReference{Name: "backup", Kind: "ClusterIssuer"}) {
+		t.Errorf("expected a fallback issuer to be allowed")
+	}
+	if IssuerRefAllowed(spec, cmmeta.ObjectReference{Name: "backup"}) {
+		t.Errorf("expected an issuer not referenced by the spec to not be allowed")
+	}
+}
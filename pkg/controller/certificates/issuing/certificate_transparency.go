@@ -0,0 +1,160 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/ct"
+)
+
+const (
+	// reasonSCTsVerified is the reason used when an issued certificate has
+	// the SCTs required by browsers.
+	reasonSCTsVerified = "SCTsVerified"
+
+	// reasonSCTVerificationFailed is the reason used when an issued
+	// certificate does not have the SCTs required by browsers.
+	reasonSCTVerificationFailed = "SCTVerificationFailed"
+)
+
+// sctVerifier verifies the SCTs embedded in a certificate.
+type sctVerifier interface {
+	Verify(cert, issuer *x509.Certificate) ct.Result
+}
+
+// certificateTransparency verifies the SCTs embedded in certificates issued
+// by ACME issuers, which are expected to be publicly trusted.
+type certificateTransparency struct {
+	issuerHelper issuer.Helper
+	verifier     sctVerifier
+
+	// strict causes certificates without the required SCTs to be treated as
+	// failed issuances rather than only reported.
+	strict bool
+}
+
+// verifyCertificateTransparency verifies the SCTs embedded in the certificate
+// issued for the CertificateRequest by an ACME issuer, recording the result
+// using the CertificateTransparency condition on the returned copy of the
+// Certificate. Certificates without the required SCTs are reported with a
+// Warning event. In strict mode, the CertificateRequest is also deleted and
+// the issuance failed, in the same way as for certificates failing lints. It
+// returns true if the certificate must not be stored.
+func (c *controller) verifyCertificateTransparency(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, req *cmapi.CertificateRequest) (*cmapi.Certificate, bool, error) {
+	if c.certificateTransparency == nil {
+		return crt, false, nil
+	}
+
+	crt = crt.DeepCopy()
+	isACME, err := c.issuedByACME(req)
+	if err != nil {
+		return crt, false, err
+	}
+	if !isACME {
+		// Only ACME issuers are expected to issue publicly trusted
+		// certificates, so remove any result from a previous issuance.
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionCertificateTransparency)
+		return crt, false, nil
+	}
+
+	chain, err := utilpki.DecodeX509CertificateChainBytes(req.Status.Certificate)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to decode issued certificate, skipping SCT verification", "error", err.Error())
+		return crt, false, nil
+	}
+
+	var message string
+	if issuerCert := certificateIssuer(chain, req.Status.CA); issuerCert == nil {
+		message = "The issued certificate's SCTs cannot be verified as the certificate of its issuer is not available"
+	} else if result := c.certificateTransparency.verifier.Verify(chain[0], issuerCert); result.Compliant() {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCertificateTransparency, cmmeta.ConditionTrue,
+			reasonSCTsVerified, fmt.Sprintf("The issued certificate has the SCTs required by browsers: %s", result))
+		return crt, false, nil
+	} else {
+		message = fmt.Sprintf("The issued certificate does not have the SCTs required by browsers: %s", result)
+	}
+
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionCertificateTransparency, cmmeta.ConditionFalse,
+		reasonSCTVerificationFailed, message)
+	if !c.certificateTransparency.strict {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonSCTVerificationFailed, message)
+		return crt, false, nil
+	}
+
+	log.V(logf.InfoLevel).Info("issued certificate does not have the required SCTs, deleting CertificateRequest", "message", message)
+	// Delete the CertificateRequest before failing the issuance, so that a
+	// new one is created when the issuance is retried.
+	if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return crt, true, err
+	}
+
+	return crt, true, c.failIssueCertificate(ctx, log, crt, &cmapi.CertificateRequestCondition{
+		Reason:  reasonSCTVerificationFailed,
+		Message: message,
+	})
+}
+
+// issuedByACME returns true if the CertificateRequest references an ACME
+// Issuer or ClusterIssuer. Issuers which no longer exist are treated as not
+// being ACME issuers.
+func (c *controller) issuedByACME(req *cmapi.CertificateRequest) (bool, error) {
+	if group := req.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return false, nil
+	}
+
+	issuerObj, err := c.certificateTransparency.issuerHelper.GetGenericIssuer(req.Spec.IssuerRef, req.Namespace)
+	if apierrors.IsNotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return issuerObj.GetSpec().ACME != nil, nil
+}
+
+// certificateIssuer returns the certificate of the issuer of the first
+// certificate in the chain, which is either the next certificate in the chain
+// or the PEM encoded CA certificate. It returns nil if neither is available.
+func certificateIssuer(chain []*x509.Certificate, caPEM []byte) *x509.Certificate {
+	if len(chain) > 1 {
+		return chain[1]
+	}
+	if len(caPEM) == 0 {
+		return nil
+	}
+	ca, err := utilpki.DecodeX509CertificateBytes(caPEM)
+	if err != nil {
+		return nil
+	}
+	return ca
+}
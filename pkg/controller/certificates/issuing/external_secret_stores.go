@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
+	"github.com/cert-manager/cert-manager/pkg/secretstore"
+)
+
+// reasonExternalSecretStoreFailed is the reason used when an issued
+// certificate could not be published to an external secret store.
+const reasonExternalSecretStoreFailed = "ExternalSecretStoreFailed"
+
+// externalSecretStores publishes issued certificates to the secret stores
+// outside of the cluster that are configured on Certificates.
+type externalSecretStores struct {
+	builder secretstore.Builder
+
+	// ambient permits stores without configured credentials to use the
+	// ambient credentials of the controller.
+	ambient   bool
+	userAgent string
+}
+
+// publishToExternalSecretStores publishes the data stored in the
+// Certificate's Secret to every external secret store configured on the
+// Certificate. A Warning event is recorded for every store that the data
+// could not be published to, and an error is returned so that the issuance
+// is retried.
+func (c *controller) publishToExternalSecretStores(ctx context.Context, crt *cmapi.Certificate, data internal.SecretData) error {
+	if len(crt.Spec.ExternalSecretStores) == 0 {
+		return nil
+	}
+
+	storeData := secretstore.Data{
+		Certificate: data.Certificate,
+		PrivateKey:  data.PrivateKey,
+		CA:          data.CA,
+	}
+
+	var errs []error
+	for i := range crt.Spec.ExternalSecretStores {
+		cfg := &crt.Spec.ExternalSecretStores[i]
+		if err := c.publishToExternalSecretStore(ctx, crt, cfg, storeData); err != nil {
+			message := fmt.Sprintf("Failed to publish the certificate to %s: %v", secretstore.Describe(cfg), err)
+			c.recorder.Event(crt, corev1.EventTypeWarning, reasonExternalSecretStoreFailed, message)
+			errs = append(errs, fmt.Errorf("failed to publish the certificate to %s: %w", secretstore.Describe(cfg), err))
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+func (c *controller) publishToExternalSecretStore(ctx context.Context, crt *cmapi.Certificate, cfg *cmapi.CertificateExternalSecretStore, data secretstore.Data) error {
+	store, err := c.externalSecretStores.builder(crt.Namespace, c.secretLister, cfg,
+		c.externalSecretStores.ambient, c.externalSecretStores.userAgent)
+	if err != nil {
+		return err
+	}
+	return store.Publish(ctx, data)
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"crypto/x509"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -55,6 +56,10 @@ type SecretsManager struct {
 	// Secret resource will be automatically deleted.
 	// This option is disabled by default.
 	enableSecretOwnerReferences bool
+
+	// defaultRenewalJitter is the renewal jitter used for the renewal-at
+	// annotation of Certificates which do not set spec.renewalJitter.
+	defaultRenewalJitter time.Duration
 }
 
 // SecretData is a structure wrapping private key, Certificate and CA data
@@ -70,12 +75,14 @@ func NewSecretsManager(
 	secretLister corelisters.SecretLister,
 	fieldManager string,
 	enableSecretOwnerReferences bool,
+	defaultRenewalJitter time.Duration,
 ) *SecretsManager {
 	return &SecretsManager{
 		secretClient:                secretClient,
 		secretLister:                secretLister,
 		fieldManager:                fieldManager,
 		enableSecretOwnerReferences: enableSecretOwnerReferences,
+		defaultRenewalJitter:        defaultRenewalJitter,
 	}
 }
 
@@ -160,7 +167,7 @@ func (s *SecretsManager) setValues(crt *cmapi.Certificate, secret *corev1.Secret
 		}
 	}
 
-	secret.Annotations = certificates.AnnotationsForCertificateSecret(crt, certificate)
+	secret.Annotations = certificates.AnnotationsForCertificateSecret(crt, certificate, s.defaultRenewalJitter)
 	if secret.Labels == nil {
 		secret.Labels = make(map[string]string)
 	}
@@ -235,7 +242,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 			return fmt.Errorf("error encoding PKCS12 bundle: %w", err)
 		}
 		// always overwrite the keystore entry for now
-		secret.Data[pkcs12SecretKey] = keystoreData
+		secret.Data[cmapi.PKCS12SecretKey] = keystoreData
 
 		if len(data.CA) > 0 {
 			truststoreData, err := encodePKCS12Truststore(string(pw), data.CA)
@@ -243,7 +250,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 				return fmt.Errorf("error encoding PKCS12 trust store bundle: %w", err)
 			}
 			// always overwrite the truststore entry
-			secret.Data[pkcs12TruststoreKey] = truststoreData
+			secret.Data[cmapi.PKCS12TruststoreKey] = truststoreData
 		}
 	}
 
@@ -263,7 +270,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 			return fmt.Errorf("error encoding JKS bundle: %w", err)
 		}
 		// always overwrite the keystore entry
-		secret.Data[jksSecretKey] = keystoreData
+		secret.Data[cmapi.JKSSecretKey] = keystoreData
 
 		if len(data.CA) > 0 {
 			truststoreData, err := encodeJKSTruststore(pw, data.CA)
@@ -271,7 +278,7 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 				return fmt.Errorf("error encoding JKS trust store bundle: %w", err)
 			}
 			// always overwrite the keystore entry
-			secret.Data[jksTruststoreKey] = truststoreData
+			secret.Data[cmapi.JKSTruststoreKey] = truststoreData
 		}
 	}
 
@@ -282,13 +289,24 @@ func (s *SecretsManager) setKeystores(crt *cmapi.Certificate, secret *corev1.Sec
 // output formats according to any OutputFormats which have been configured.
 func setAdditionalOutputFormats(crt *cmapi.Certificate, secret *corev1.Secret, data SecretData) error {
 	for _, format := range crt.Spec.AdditionalOutputFormats {
+		key := certificates.OutputFormatKey(format)
 		switch format.Type {
 		case cmapi.CertificateOutputFormatDER:
 			// Store binary format of the private key
-			secret.Data[cmapi.CertificateOutputFormatDERKey] = certificates.OutputFormatDER(data.PrivateKey)
+			secret.Data[key] = certificates.OutputFormatDER(data.PrivateKey)
 		case cmapi.CertificateOutputFormatCombinedPEM:
 			// Combine tls.key and tls.crt
-			secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey] = certificates.OutputFormatCombinedPEM(data.PrivateKey, data.Certificate)
+			secret.Data[key] = certificates.OutputFormatCombinedPEM(data.PrivateKey, data.Certificate)
+		case cmapi.CertificateOutputFormatDERCertificate:
+			// Store binary format of the signed certificate
+			secret.Data[key] = certificates.OutputFormatDERCertificate(data.Certificate)
+		case cmapi.CertificateOutputFormatPKCS7:
+			// Bundle the signed certificate chain in tls.crt
+			bundle, err := certificates.OutputFormatPKCS7(data.Certificate)
+			if err != nil {
+				return fmt.Errorf("failed to encode PKCS#7 bundle: %w", err)
+			}
+			secret.Data[key] = bundle
 		default:
 			return fmt.Errorf("unknown additional output format %s", format.Type)
 		}
@@ -18,6 +18,7 @@ package internal
 
 import (
 	"context"
+	"crypto/x509"
 	"encoding/pem"
 	"errors"
 	"strings"
@@ -41,6 +42,7 @@ import (
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcoreclients "github.com/cert-manager/cert-manager/test/unit/coreclients"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
@@ -71,6 +73,8 @@ func Test_SecretsManager(t *testing.T) {
 	baseCertBundle := testcrypto.MustCreateCryptoBundle(t, gen.CertificateFrom(baseCert,
 		gen.SetCertificateDNSNames("example.com"),
 	), fixedClock)
+	expiresAt := baseCertBundle.Cert.NotAfter.UTC().Format(time.RFC3339)
+	renewalAt := certificates.RenewalTime(baseCertBundle.Cert.NotBefore, baseCertBundle.Cert.NotAfter, baseCert.Spec.RenewBefore, baseCert.Spec.RenewBeforePercentage).UTC().Format(time.RFC3339)
 
 	baseCertWithSecretTemplate := gen.CertificateFrom(baseCertBundle.Certificate,
 		gen.SetCertificateSecretTemplate(map[string]string{
@@ -93,8 +97,18 @@ func Test_SecretsManager(t *testing.T) {
 			cmapi.CertificateAdditionalOutputFormat{Type: "CombinedPEM"},
 		),
 	)
+	baseCertWithCertificateOutputFormats := gen.CertificateFrom(baseCertBundle.Certificate,
+		gen.SetCertificateAdditionalOutputFormats(
+			cmapi.CertificateAdditionalOutputFormat{Type: "DERCertificate"},
+			cmapi.CertificateAdditionalOutputFormat{Type: "PKCS7", Key: "chain.p7b"},
+		),
+	)
 	block, _ := pem.Decode(baseCertBundle.PrivateKeyBytes)
 	tlsDerContent := block.Bytes
+	pkcs7Content, err := utilpki.EncodePKCS7Certificates([]*x509.Certificate{baseCertBundle.Cert})
+	if err != nil {
+		t.Fatal(err)
+	}
 
 	tests := map[string]struct {
 		certificateOptions controllerpkg.CertificateOptions
@@ -134,8 +148,10 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.IssuerKindAnnotationKey: "Issuer", cmapi.IssuerNameAnnotationKey: "ca-issuer",
 
 								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName, cmapi.AltNamesAnnotationKey: strings.Join(baseCertBundle.Cert.DNSNames, ","),
-								cmapi.IPSANAnnotationKey:  strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
-								cmapi.URISANAnnotationKey: strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.IPSANAnnotationKey:     strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey:    strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey: expiresAt,
+								cmapi.RenewalAtAnnotationKey: renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -170,7 +186,9 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.CertificateNameKey: "test", cmapi.IssuerGroupAnnotationKey: "foo.io", cmapi.IssuerKindAnnotationKey: "Issuer",
 								cmapi.IssuerNameAnnotationKey: "ca-issuer", cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
 								cmapi.AltNamesAnnotationKey: strings.Join(baseCertBundle.Cert.DNSNames, ","), cmapi.IPSANAnnotationKey: strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
-								cmapi.URISANAnnotationKey: strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.URISANAnnotationKey:    strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey: expiresAt,
+								cmapi.RenewalAtAnnotationKey: renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{corev1.TLSCertKey: baseCertBundle.CertBytes, corev1.TLSPrivateKeyKey: []byte("test-key"), cmmeta.TLSCAKey: []byte("test-ca")}).
@@ -217,6 +235,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -262,6 +282,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -314,6 +336,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(map[string]string{"template": "label"}).
 						WithData(map[string][]byte{
@@ -353,6 +377,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(map[string]string{"template": "label"}).
 						WithData(map[string][]byte{
@@ -394,6 +420,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -431,6 +459,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -468,6 +498,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -489,6 +521,46 @@ func Test_SecretsManager(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if secret does not exist, create new Secret with additional output formats DERCertificate and PKCS7 with a custom key": {
+			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
+			certificate:        baseCertWithCertificateOutputFormats,
+			existingSecret:     nil,
+			secretData:         SecretData{Certificate: baseCertBundle.CertBytes, CA: []byte("test-ca"), PrivateKey: baseCertBundle.PrivateKeyBytes},
+			applyFn: func(t *testing.T) testcoreclients.ApplyFn {
+				return func(_ context.Context, gotCnf *applycorev1.SecretApplyConfiguration, gotOpts metav1.ApplyOptions) (*corev1.Secret, error) {
+					expCnf := applycorev1.Secret("output", gen.DefaultTestNamespace).
+						WithAnnotations(
+							map[string]string{
+								cmapi.CertificateNameKey: "test", cmapi.IssuerGroupAnnotationKey: "foo.io",
+								cmapi.IssuerKindAnnotationKey: "Issuer", cmapi.IssuerNameAnnotationKey: "ca-issuer",
+
+								cmapi.CommonNameAnnotationKey: baseCertBundle.Cert.Subject.CommonName,
+								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
+								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
+								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
+							}).
+						WithLabels(make(map[string]string)).
+						WithData(map[string][]byte{
+							corev1.TLSCertKey:       baseCertBundle.CertBytes,
+							corev1.TLSPrivateKeyKey: baseCertBundle.PrivateKeyBytes,
+							cmmeta.TLSCAKey:         []byte("test-ca"),
+							cmapi.CertificateOutputFormatDERCertificateKey: baseCertBundle.Cert.Raw,
+							"chain.p7b": pkcs7Content,
+						}).
+						WithType(corev1.SecretTypeTLS)
+					assert.Equal(t, expCnf, gotCnf)
+
+					expOpts := metav1.ApplyOptions{FieldManager: "cert-manager-test", Force: true}
+					assert.Equal(t, expOpts, gotOpts)
+
+					return nil, nil
+				}
+			},
+			expectedErr: false,
+		},
+
 		"if secret exists, with tls-combined.pem and key.der but no additional formats specified": {
 			certificateOptions: controllerpkg.CertificateOptions{EnableOwnerRef: false},
 			certificate:        baseCertBundle.Certificate,
@@ -523,6 +595,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -576,6 +650,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -630,6 +706,8 @@ func Test_SecretsManager(t *testing.T) {
 								cmapi.AltNamesAnnotationKey:   strings.Join(baseCertBundle.Cert.DNSNames, ","),
 								cmapi.IPSANAnnotationKey:      strings.Join(utilpki.IPAddressesToString(baseCertBundle.Cert.IPAddresses), ","),
 								cmapi.URISANAnnotationKey:     strings.Join(utilpki.URLsToString(baseCertBundle.Cert.URIs), ","),
+								cmapi.ExpiresAtAnnotationKey:  expiresAt,
+								cmapi.RenewalAtAnnotationKey:  renewalAt,
 							}).
 						WithLabels(make(map[string]string)).
 						WithData(map[string][]byte{
@@ -681,6 +759,7 @@ func Test_SecretsManager(t *testing.T) {
 				secretClient, secretLister,
 				"cert-manager-test",
 				test.certificateOptions.EnableOwnerRef,
+				test.certificateOptions.DefaultRenewalJitter,
 			)
 
 			err := testManager.UpdateData(context.Background(), test.certificate, test.secretData)
@@ -47,15 +47,24 @@ import (
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/secretstore"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	utilkube "github.com/cert-manager/cert-manager/pkg/util/kube"
 	utilpki "github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/lint"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
 )
 
 const (
 	ControllerName = "certificates-issuing"
+
+	// reasonLintFailed is the reason used when an issued certificate fails
+	// the configured lints.
+	reasonLintFailed = "LintFailed"
 )
 
 type localTemporarySignerFn func(crt *cmapi.Certificate, pk []byte) ([]byte, error)
@@ -88,6 +97,24 @@ type controller struct {
 
 	// localTemporarySigner signs a certificate that is stored temporarily
 	localTemporarySigner localTemporarySignerFn
+
+	// linter, if not nil, is run against certificates before they are
+	// stored. If strictLinting is true, certificates that fail the linter
+	// are not stored and are re-issued after the issuance failure back-off.
+	linter        *lint.Linter
+	strictLinting bool
+
+	// certificateTransparency, if not nil, verifies the SCTs embedded in
+	// certificates issued by ACME issuers before they are stored.
+	certificateTransparency *certificateTransparency
+
+	// externalSecretStores publishes issued certificates to the secret
+	// stores outside of the cluster configured on Certificates.
+	externalSecretStores externalSecretStores
+
+	// privateKeyEncryption encrypts private keys before they are stored, for
+	// Certificates which configure it.
+	privateKeyEncryption privateKeyEncryption
 }
 
 func NewController(
@@ -137,6 +164,7 @@ func NewController(
 	secretsManager := internal.NewSecretsManager(
 		kubeClient.CoreV1(), secretsInformer.Lister(),
 		fieldManager, certificateControllerOptions.EnableOwnerRef,
+		certificateControllerOptions.DefaultRenewalJitter,
 	)
 
 	return &controller{
@@ -150,9 +178,14 @@ func NewController(
 		postIssuancePolicyChain: policies.NewSecretPostIssuancePolicyChain(
 			certificateControllerOptions.EnableOwnerRef,
 			fieldManager,
+			certificateControllerOptions.DefaultRenewalJitter,
 		),
 		fieldManager:         fieldManager,
 		localTemporarySigner: certificates.GenerateLocallySignedTemporaryCertificate,
+		linter:               certificateControllerOptions.Linter,
+		strictLinting:        certificateControllerOptions.StrictLinting,
+		externalSecretStores: externalSecretStores{builder: secretstore.New},
+		privateKeyEncryption: privateKeyEncryption{builder: keyencryption.New},
 	}, queue, mustSync
 }
 
@@ -177,6 +210,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
 	log = logf.WithResource(log, crt)
 	ctx = logf.NewContext(ctx, log)
 
@@ -210,13 +248,31 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		logf.WithResource(log, nextPrivateKeySecret).Info("Next private key secret does not contain any private key data, waiting for keymanager controller")
 		return nil
 	}
-	pk, _, err := utilkube.ParseTLSKeyFromSecret(nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
-	if err != nil {
-		// If the private key cannot be parsed here, do nothing as the key manager will handle this.
-		logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
-		return nil
+	nextPKData := nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]
+	// pk is nil if the private key is held by an external signer, in which
+	// case the 'next private key' Secret only holds a reference to it.
+	var pk crypto.Signer
+	var publicKey crypto.PublicKey
+	if backend := certificates.PrivateKeyBackend(crt); backend != nil {
+		if !keybackend.References(nextPKData, backend) {
+			logf.WithResource(log, nextPrivateKeySecret).Info("next private key does not reference the private key held by spec.privateKey.backend, waiting for keymanager controller")
+			return nil
+		}
+		publicKey, err = keybackend.PublicKey(nextPKData)
+		if err != nil {
+			logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
+			return nil
+		}
+	} else {
+		pk, _, err = utilkube.ParseTLSKeyFromSecret(nextPrivateKeySecret, corev1.TLSPrivateKeyKey)
+		if err != nil {
+			// If the private key cannot be parsed here, do nothing as the key manager will handle this.
+			logf.WithResource(log, nextPrivateKeySecret).Error(err, "failed to parse next private key, waiting for keymanager controller")
+			return nil
+		}
+		publicKey = pk.Public()
 	}
-	pkViolations, err := certificates.PrivateKeyMatchesSpec(pk, crt.Spec)
+	pkViolations, err := certificates.PublicKeyMatchesSpec(publicKey, crt.Spec)
 	if err != nil {
 		return err
 	}
@@ -308,7 +364,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		return err
 	}
-	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(pk.Public(), csr)
+	publicKeyMatchesCSR, err := utilpki.PublicKeyMatchesCSR(publicKey, csr)
 	if err != nil {
 		return err
 	}
@@ -320,14 +376,24 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	// If the CertificateRequest is valid and ready, verify its status and issue
 	// accordingly.
 	if crReadyCond.Reason == cmapi.CertificateRequestReasonIssued {
-		return c.issueCertificate(ctx, nextRevision, crt, req, pk)
+		if rejected, err := c.lintCertificate(ctx, log, crt, req); err != nil || rejected {
+			return err
+		}
+		var rejected bool
+		if crt, rejected, err = c.verifyCertificateTransparency(ctx, log, crt, req); err != nil || rejected {
+			return err
+		}
+		return c.issueCertificate(ctx, nextRevision, crt, req, pk, nextPKData)
 	}
 
 	// Issue temporary certificate if needed. If a certificate was issued, then
 	// return early - we will sync again since the target Secret has been
-	// updated.
-	if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
-		return err
+	// updated. Temporary certificates cannot be signed with private keys held
+	// by an external signer.
+	if pk != nil {
+		if issued, err := c.ensureTemporaryCertificate(ctx, crt, pk); err != nil || issued {
+			return err
+		}
 	}
 
 	// CertificateRequest is not in a final state so do nothing.
@@ -368,18 +434,70 @@ func (c *controller) failIssueCertificate(ctx context.Context, log logr.Logger,
 	return nil
 }
 
+// lintCertificate runs the configured linter against the certificate issued
+// for the CertificateRequest, reporting any failures with a Warning event. In
+// strict mode, the CertificateRequest is also deleted and the issuance failed,
+// so that a new certificate is requested once the issuance failure back-off
+// has elapsed. It returns true if the certificate must not be stored.
+func (c *controller) lintCertificate(ctx context.Context, log logr.Logger, crt *cmapi.Certificate, req *cmapi.CertificateRequest) (bool, error) {
+	if c.linter == nil {
+		return false, nil
+	}
+
+	chain, err := utilpki.DecodeX509CertificateChainBytes(req.Status.Certificate)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("failed to decode issued certificate, skipping lints", "error", err.Error())
+		return false, nil
+	}
+	if len(req.Status.CA) > 0 {
+		ca, err := utilpki.DecodeX509CertificateBytes(req.Status.CA)
+		if err == nil && !ca.Equal(chain[len(chain)-1]) {
+			chain = append(chain, ca)
+		}
+	}
+
+	failures := c.linter.Lint(chain)
+	if len(failures) == 0 {
+		return false, nil
+	}
+
+	message := fmt.Sprintf("The issued certificate failed lints: %s", failures)
+	if !c.strictLinting {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonLintFailed, message)
+		return false, nil
+	}
+
+	log.V(logf.InfoLevel).Info("issued certificate failed lints, deleting CertificateRequest", "failures", failures.String())
+	// Delete the CertificateRequest before failing the issuance, so that a
+	// new one is created when the issuance is retried.
+	if err := c.client.CertmanagerV1().CertificateRequests(req.Namespace).Delete(ctx, req.Name, metav1.DeleteOptions{}); err != nil && !apierrors.IsNotFound(err) {
+		return true, err
+	}
+
+	return true, c.failIssueCertificate(ctx, log, crt, &cmapi.CertificateRequestCondition{
+		Reason:  reasonLintFailed,
+		Message: message,
+	})
+}
+
 // issueCertificate will ensure the public key of the CSR matches the signed
 // certificate, and then store the certificate, CA and private key into the
-// Secret in the appropriate format type.
-func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer) error {
+// Secret in the appropriate format type. If pk is nil, the private key is
+// held by an external signer and the reference to it in nextPKData is stored
+// instead.
+func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt *cmapi.Certificate, req *cmapi.CertificateRequest, pk crypto.Signer, nextPKData []byte) error {
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
 		crt.Spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
 
-	pkData, err := utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
-	if err != nil {
-		return err
+	pkData := nextPKData
+	var err error
+	if pk != nil {
+		pkData, err = utilpki.EncodePrivateKey(pk, crt.Spec.PrivateKey.Encoding)
+		if err != nil {
+			return err
+		}
 	}
 	secretData := internal.SecretData{
 		PrivateKey:  pkData,
@@ -387,7 +505,19 @@ func (c *controller) issueCertificate(ctx context.Context, nextRevision int, crt
 		CA:          req.Status.CA,
 	}
 
-	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
+	// Only the private key stored in the Secret is encrypted, as external
+	// secret stores encrypt the secrets they hold themselves.
+	storedData := secretData
+	storedData.PrivateKey, err = c.encryptPrivateKey(ctx, crt, pkData)
+	if err != nil {
+		return err
+	}
+
+	if err := c.secretsUpdateData(ctx, crt, storedData); err != nil {
+		return err
+	}
+
+	if err := c.publishToExternalSecretStores(ctx, crt, secretData); err != nil {
 		return err
 	}
 
@@ -435,7 +565,10 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 
 		var conditions []cmapi.CertificateCondition
 		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+			conditions = append(conditions, *cond)
+		}
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionCertificateTransparency); cond != nil {
+			conditions = append(conditions, *cond)
 		}
 
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
@@ -474,6 +607,33 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	)
 	c.controller = ctrl
 
+	// Certificates are namespaced, so may only use ambient credentials for
+	// external secret stores if Issuers may.
+	c.controller.externalSecretStores.ambient = ctx.IssuerOptions.IssuerAmbientCredentials
+	c.controller.externalSecretStores.userAgent = ctx.RESTConfig.UserAgent
+	c.controller.privateKeyEncryption.ambient = ctx.IssuerOptions.IssuerAmbientCredentials
+	c.controller.privateKeyEncryption.userAgent = ctx.RESTConfig.UserAgent
+
+	if ctx.CertificateOptions.CertificateTransparencyLogs != nil {
+		issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+		mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+
+		// ClusterIssuers can only be read if cert-manager is not scoped to a
+		// single namespace.
+		var clusterIssuerLister cmlisters.ClusterIssuerLister
+		if ctx.Namespace == "" {
+			clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+			mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+			clusterIssuerLister = clusterIssuerInformer.Lister()
+		}
+
+		c.controller.certificateTransparency = &certificateTransparency{
+			issuerHelper: issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+			verifier:     ctx.CertificateOptions.CertificateTransparencyLogs,
+			strict:       ctx.CertificateOptions.StrictCertificateTransparency,
+		}
+	}
+
 	return queue, mustSync, nil
 }
 
@@ -18,6 +18,8 @@ package issuing
 
 import (
 	"context"
+	"crypto/x509"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
@@ -27,15 +29,25 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	coretesting "k8s.io/client-go/testing"
 	"k8s.io/client-go/tools/cache"
 	fakeclock "k8s.io/utils/clock/testing"
 	"k8s.io/utils/pointer"
 
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+	keyencryptionfake "github.com/cert-manager/cert-manager/pkg/keyencryption/fake"
+	"github.com/cert-manager/cert-manager/pkg/secretstore"
+	secretstorefake "github.com/cert-manager/cert-manager/pkg/secretstore/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/ct"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/lint"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -51,6 +63,21 @@ func testLocalTemporarySignerFn(b []byte) localTemporarySignerFn {
 	}
 }
 
+// failingLint is a lint that always fails, to test handling of lint
+// failures independently of the certificates under test.
+type failingLint struct{}
+
+func (failingLint) Name() string                    { return "e_test_always_fails" }
+func (failingLint) Check([]*x509.Certificate) error { return errors.New("test failure") }
+
+// fakeSCTVerifier returns a fixed result, to test handling of SCT
+// verification independently of the certificates under test.
+type fakeSCTVerifier struct {
+	result ct.Result
+}
+
+func (f fakeSCTVerifier) Verify(*x509.Certificate, *x509.Certificate) ct.Result { return f.result }
+
 func TestIssuingController(t *testing.T) {
 	type testT struct {
 		builder *testpkg.Builder
@@ -58,9 +85,24 @@ func TestIssuingController(t *testing.T) {
 		certificate             *cmapi.Certificate
 		expSecretUpdateDataCall *internal.SecretData
 
+		linter        *lint.Linter
+		strictLinting bool
+
+		sctResult *ct.Result
+		strictCT  bool
+
+		secretStore     *secretstorefake.Store
+		expPublishCalls int
+
+		keyEncrypter *keyencryptionfake.Encrypter
+
 		expectedErr bool
 	}
 
+	lint.Register(failingLint{})
+	failingLinter, err := lint.NewLinter([]string{"e_test_always_fails"})
+	require.NoError(t, err)
+
 	nextPrivateKeySecretName := "next-private-key"
 
 	baseCert := gen.Certificate("test",
@@ -77,6 +119,36 @@ func TestIssuingController(t *testing.T) {
 	exampleBundleAlt := testcrypto.MustCreateCryptoBundle(t, baseCert.DeepCopy(), fixedClock)
 	metaFixedClockStart := metav1.NewTime(fixedClockStart)
 
+	// A CertificateRequest issued by an ACME issuer, with the certificate of
+	// the issuer available to verify the SCTs embedded in the certificate.
+	acmeIssuerRef := cmmeta.ObjectReference{Name: "acme-issuer", Kind: "Issuer"}
+	acmeRequestReady := gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+		gen.SetCertificateRequestIssuer(acmeIssuerRef),
+		gen.SetCertificateRequestCA(exampleBundle.CertBytes),
+		gen.AddCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+		}),
+	)
+	compliantSCTs := ct.Result{Valid: 2, Operators: 2, Required: 2}
+	nonCompliantSCTs := ct.Result{Valid: 1, Operators: 1, Required: 2}
+	nonCompliantMessage := "The issued certificate does not have the SCTs required by browsers: 1 of 2 required SCTs are valid, from 1 of 2 required log operators"
+
+	vaultSecretStore := cmapi.CertificateExternalSecretStore{
+		Vault: &cmapi.VaultSecretStore{Server: "https://vault.example.com", Mount: "secret", Path: "my-app/tls"},
+	}
+	vaultKeyEncryption := cmapi.CertificatePrivateKeyEncryption{
+		VaultTransit: &cmapi.VaultTransitKeyEncryption{Server: "https://vault.example.com", Mount: "transit", KeyName: "my-key"},
+	}
+	keyBackend := cmapi.PrivateKeyBackend{
+		AWSKMS: &cmapi.AWSKMSPrivateKeyBackend{Region: "eu-west-1", KeyID: "alias/my-key"},
+	}
+	keyBackendReference, err := keybackend.EncodeReference(&keyBackend, exampleBundle.PrivateKey.Public())
+	require.NoError(t, err)
+	expSecretStoreData := secretstore.Data{
+		Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+		PrivateKey:  exampleBundle.PrivateKeyBytes,
+	}
+
 	issuingCert := gen.CertificateFrom(baseCert.DeepCopy(),
 		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
 			Type:               cmapi.CertificateConditionIssuing,
@@ -514,6 +586,535 @@ func TestIssuingController(t *testing.T) {
 			expectedErr: false,
 		},
 
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the signed certificate, ca, and private key to a new secret and the external secret stores, and log an event": {
+			certificate: exampleBundle.Certificate,
+			secretStore: &secretstorefake.Store{
+				PublishFn: func(_ context.Context, data secretstore.Data) error {
+					assert.Equal(t, expSecretStoreData, data)
+					return nil
+				},
+			},
+			expPublishCalls: 2,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateExternalSecretStores(vaultSecretStore, vaultSecretStore),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateExternalSecretStores(vaultSecretStore, vaultSecretStore),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  exampleBundle.PrivateKeyBytes,
+				CA:          nil,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the encrypted private key to the secret and the unencrypted private key to the external secret stores": {
+			certificate: exampleBundle.Certificate,
+			keyEncrypter: &keyencryptionfake.Encrypter{
+				EncryptFn: func(_ context.Context, pkPEM []byte) ([]byte, error) {
+					assert.Equal(t, exampleBundle.PrivateKeyBytes, pkPEM)
+					return []byte("encrypted"), nil
+				},
+			},
+			secretStore: &secretstorefake.Store{
+				PublishFn: func(_ context.Context, data secretstore.Data) error {
+					assert.Equal(t, expSecretStoreData, data)
+					return nil
+				},
+			},
+			expPublishCalls: 1,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateExternalSecretStores(vaultSecretStore),
+						gen.SetCertificatePrivateKeyEncryption(vaultKeyEncryption),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateExternalSecretStores(vaultSecretStore),
+							gen.SetCertificatePrivateKeyEncryption(vaultKeyEncryption),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  []byte("encrypted"),
+				CA:          nil,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, but the private key cannot be encrypted, log a warning event and do not store the certificate": {
+			certificate: exampleBundle.Certificate,
+			keyEncrypter: &keyencryptionfake.Encrypter{
+				EncryptFn: func(context.Context, []byte) ([]byte, error) {
+					return nil, errors.New("permission denied")
+				},
+			},
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificatePrivateKeyEncryption(vaultKeyEncryption),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+				ExpectedEvents: []string{
+					"Warning PrivateKeyEncryptionFailed Failed to encrypt the private key: permission denied",
+				},
+			},
+			expectedErr: true,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the reference to the private key held by spec.privateKey.backend to the secret": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateKeyBackend(keyBackend),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: keyBackendReference,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateKeyBackend(keyBackend),
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  keyBackendReference,
+				CA:          nil,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state with temp annotation and the private key is held by spec.privateKey.backend, do not issue a temporary certificate": {
+			certificate: exampleBundle.Certificate,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateKeyBackend(keyBackend),
+						gen.AddCertificateAnnotations(map[string]string{
+							cmapi.IssueTemporaryCertificateAnnotation: "true",
+						}),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestPending,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: keyBackendReference,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, but cannot be published to an external secret store, log a warning event and do not complete the issuance": {
+			certificate: exampleBundle.Certificate,
+			secretStore: &secretstorefake.Store{
+				PublishFn: func(context.Context, secretstore.Data) error {
+					return errors.New("permission denied")
+				},
+			},
+			expPublishCalls: 1,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert,
+						gen.SetCertificateExternalSecretStores(vaultSecretStore),
+					),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{},
+				ExpectedEvents: []string{
+					`Warning ExternalSecretStoreFailed Failed to publish the certificate to Vault secret "secret/my-app/tls": permission denied`,
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  exampleBundle.PrivateKeyBytes,
+				CA:          nil,
+			},
+			expectedErr: true,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, but fails lints, log a warning event and store the signed certificate": {
+			certificate: exampleBundle.Certificate,
+			linter:      failingLinter,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateRevision(2),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Warning LintFailed The issued certificate failed lints: e_test_always_fails: test failure",
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  exampleBundle.PrivateKeyBytes,
+				CA:          nil,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests, and is ready, but fails lints in strict mode, delete the CertificateRequest and set failed state": {
+			certificate:   exampleBundle.Certificate,
+			linter:        failingLinter,
+			strictLinting: true,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert),
+					gen.CertificateRequestFrom(exampleBundle.CertificateRequestReady,
+						gen.AddCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestRevisionAnnotationKey: "2", // Current Certificate revision=1
+						}),
+					)},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						exampleBundle.CertificateRequestReady.Namespace,
+						exampleBundle.CertificateRequestReady.Name,
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "LintFailed",
+								Message:            "The certificate request has failed to complete and will be retried: The issued certificate failed lints: e_test_always_fails: test failure",
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateIssuanceAttempts(pointer.Int(1)),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Warning LintFailed The certificate request has failed to complete and will be retried: The issued certificate failed lints: e_test_always_fails: test failure",
+				},
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests from an ACME issuer, and is ready with the required SCTs, store the signed certificate and record the result": {
+			certificate: exampleBundle.Certificate,
+			sctResult:   &compliantSCTs,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateIssuer(acmeIssuerRef)),
+					acmeRequestReady,
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateIssuer(acmeIssuerRef),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionCertificateTransparency,
+								Status:             cmmeta.ConditionTrue,
+								Reason:             "SCTsVerified",
+								Message:            "The issued certificate has the SCTs required by browsers: 2 of 2 required SCTs are valid, from 2 of 2 required log operators",
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  exampleBundle.PrivateKeyBytes,
+				CA:          exampleBundle.CertBytes,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests from an ACME issuer, and is ready without the required SCTs, log a warning event and store the signed certificate": {
+			certificate: exampleBundle.Certificate,
+			sctResult:   &nonCompliantSCTs,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateIssuer(acmeIssuerRef)),
+					acmeRequestReady,
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateIssuer(acmeIssuerRef),
+							gen.SetCertificateRevision(2),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionCertificateTransparency,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "SCTVerificationFailed",
+								Message:            nonCompliantMessage,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Warning SCTVerificationFailed " + nonCompliantMessage,
+					"Normal Issuing The certificate has been successfully issued",
+				},
+			},
+			expSecretUpdateDataCall: &internal.SecretData{
+				Certificate: exampleBundle.CertificateRequestReady.Status.Certificate,
+				PrivateKey:  exampleBundle.PrivateKeyBytes,
+				CA:          exampleBundle.CertBytes,
+			},
+			expectedErr: false,
+		},
+
+		"if certificate is in Issuing state, one CertificateRequests from an ACME issuer, and is ready without the required SCTs in strict mode, delete the CertificateRequest and set failed state": {
+			certificate: exampleBundle.Certificate,
+			sctResult:   &nonCompliantSCTs,
+			strictCT:    true,
+			builder: &testpkg.Builder{
+				CertManagerObjects: []runtime.Object{
+					gen.CertificateFrom(issuingCert, gen.SetCertificateIssuer(acmeIssuerRef)),
+					acmeRequestReady,
+				},
+				KubeObjects: []runtime.Object{
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Name:      nextPrivateKeySecretName,
+							Namespace: exampleBundle.Certificate.Namespace,
+						},
+						Data: map[string][]byte{
+							corev1.TLSPrivateKeyKey: exampleBundle.PrivateKeyBytes,
+						},
+					},
+				},
+				ExpectedActions: []testpkg.Action{
+					testpkg.NewAction(coretesting.NewDeleteAction(
+						cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+						acmeRequestReady.Namespace,
+						acmeRequestReady.Name,
+					)),
+					testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+						cmapi.SchemeGroupVersion.WithResource("certificates"),
+						"status",
+						exampleBundle.Certificate.Namespace,
+						gen.CertificateFrom(exampleBundle.Certificate,
+							gen.SetCertificateIssuer(acmeIssuerRef),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionIssuing,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "SCTVerificationFailed",
+								Message:            "The certificate request has failed to complete and will be retried: " + nonCompliantMessage,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+								Type:               cmapi.CertificateConditionCertificateTransparency,
+								Status:             cmmeta.ConditionFalse,
+								Reason:             "SCTVerificationFailed",
+								Message:            nonCompliantMessage,
+								LastTransitionTime: &metaFixedClockStart,
+								ObservedGeneration: 3,
+							}),
+							gen.SetCertificateLastFailureTime(metaFixedClockStart),
+							gen.SetCertificateIssuanceAttempts(pointer.Int(1)),
+						),
+					)),
+				},
+				ExpectedEvents: []string{
+					"Warning SCTVerificationFailed The certificate request has failed to complete and will be retried: " + nonCompliantMessage,
+				},
+			},
+			expectedErr: false,
+		},
+
 		"if certificate is in Issuing state, one CertificateRequests, and is ready, store the signed certificate, ca, and private key to an existing secret, and log an event": {
 			certificate: exampleBundle.Certificate,
 			builder: &testpkg.Builder{
@@ -1076,6 +1677,36 @@ func TestIssuingController(t *testing.T) {
 			_, _, err := w.Register(test.builder.Context)
 			require.NoError(t, err)
 			w.controller.localTemporarySigner = testLocalTemporarySignerFn(exampleBundle.LocalTemporaryCertificateBytes)
+			w.controller.linter = test.linter
+			w.controller.strictLinting = test.strictLinting
+			if test.sctResult != nil {
+				w.controller.certificateTransparency = &certificateTransparency{
+					issuerHelper: &issuerfake.Helper{
+						GetGenericIssuerFunc: func(ref cmmeta.ObjectReference, ns string) (cmapi.GenericIssuer, error) {
+							return gen.Issuer(ref.Name, gen.SetIssuerACME(cmacme.ACMEIssuer{})), nil
+						},
+					},
+					verifier: fakeSCTVerifier{result: *test.sctResult},
+					strict:   test.strictCT,
+				}
+			}
+
+			var publishCalls int
+			if test.secretStore != nil {
+				w.controller.externalSecretStores.builder = func(string, corelisters.SecretLister, *cmapi.CertificateExternalSecretStore, bool, string) (secretstore.Interface, error) {
+					publishCalls++
+					return test.secretStore, nil
+				}
+			}
+			t.Cleanup(func() {
+				assert.Equal(t, test.expPublishCalls, publishCalls, "unexpected number of secret stores published to")
+			})
+
+			if test.keyEncrypter != nil {
+				w.controller.privateKeyEncryption.builder = func(string, corelisters.SecretLister, *cmapi.CertificatePrivateKeyEncryption, bool, string) (keyencryption.Interface, error) {
+					return test.keyEncrypter, nil
+				}
+			}
 
 			var secretsUpdateDataCalled bool
 			w.controller.secretsUpdateData = func(_ context.Context, _ *cmapi.Certificate, secretData internal.SecretData) error {
@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuing
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+)
+
+// reasonPrivateKeyEncryptionFailed is the reason used when a private key
+// could not be encrypted before being stored.
+const reasonPrivateKeyEncryptionFailed = "PrivateKeyEncryptionFailed"
+
+// privateKeyEncryption encrypts private keys before they are stored in the
+// Secrets of Certificates which configure spec.privateKeyEncryption.
+type privateKeyEncryption struct {
+	builder keyencryption.Builder
+
+	// ambient permits key management services without configured
+	// credentials to use the ambient credentials of the controller.
+	ambient   bool
+	userAgent string
+}
+
+// encryptPrivateKey returns the private key pkData encrypted as configured
+// on the Certificate, or pkData itself if the Certificate does not configure
+// private key encryption. A Warning event is recorded if the private key
+// could not be encrypted, and an error is returned so that the issuance is
+// retried.
+func (c *controller) encryptPrivateKey(ctx context.Context, crt *cmapi.Certificate, pkData []byte) ([]byte, error) {
+	if crt.Spec.PrivateKeyEncryption == nil {
+		return pkData, nil
+	}
+
+	encrypted, err := c.encryptPrivateKeyWithService(ctx, crt, pkData)
+	if err != nil {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonPrivateKeyEncryptionFailed, fmt.Sprintf("Failed to encrypt the private key: %v", err))
+		return nil, fmt.Errorf("failed to encrypt the private key: %w", err)
+	}
+	return encrypted, nil
+}
+
+func (c *controller) encryptPrivateKeyWithService(ctx context.Context, crt *cmapi.Certificate, pkData []byte) ([]byte, error) {
+	encrypter, err := c.privateKeyEncryption.builder(crt.Namespace, c.secretLister, crt.Spec.PrivateKeyEncryption,
+		c.privateKeyEncryption.ambient, c.privateKeyEncryption.userAgent)
+	if err != nil {
+		return nil, err
+	}
+	return encrypter.Encrypt(ctx, pkData)
+}
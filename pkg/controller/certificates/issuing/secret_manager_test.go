@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/pem"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
@@ -30,8 +31,10 @@ import (
 	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates/issuing/internal"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
 )
 
@@ -43,6 +46,14 @@ func Test_ensureSecretData(t *testing.T) {
 	block, _ := pem.Decode(pk)
 	pkDER := block.Bytes
 	combinedPEM := append(append(pk, '\n'), cert...)
+	x509Cert, err := pki.DecodeX509CertificateBytes(cert)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The expiry annotations expected on an up to date Secret for a
+	// Certificate without renewBefore.
+	expiresAt := x509Cert.NotAfter.UTC().Format(time.RFC3339)
+	renewalAt := certificates.RenewalTime(x509Cert.NotBefore, x509Cert.NotAfter, nil, nil).UTC().Format(time.RFC3339)
 
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
@@ -272,7 +283,7 @@ func Test_ensureSecretData(t *testing.T) {
 			secret: &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{
 					Namespace: "test-namespace", Name: "test-secret",
-					Annotations: map[string]string{"foo": "bar"}, Labels: map[string]string{"abc": "123"},
+					Annotations: map[string]string{"foo": "bar", cmapi.ExpiresAtAnnotationKey: expiresAt, cmapi.RenewalAtAnnotationKey: renewalAt}, Labels: map[string]string{"abc": "123"},
 					ManagedFields: []metav1.ManagedFieldsEntry{{
 						Manager: fieldManager,
 						FieldsV1: &metav1.FieldsV1{
@@ -393,6 +404,7 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			secret: &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret",
+					Annotations: map[string]string{cmapi.ExpiresAtAnnotationKey: expiresAt, cmapi.RenewalAtAnnotationKey: renewalAt},
 					ManagedFields: []metav1.ManagedFieldsEntry{{
 						Manager: fieldManager,
 						FieldsV1: &metav1.FieldsV1{
@@ -475,6 +487,7 @@ func Test_ensureSecretData(t *testing.T) {
 			},
 			secret: &corev1.Secret{
 				ObjectMeta: metav1.ObjectMeta{Namespace: "test-namespace", Name: "test-secret",
+					Annotations: map[string]string{cmapi.ExpiresAtAnnotationKey: expiresAt, cmapi.RenewalAtAnnotationKey: renewalAt},
 					OwnerReferences: []metav1.OwnerReference{
 						{APIVersion: "cert-manager.io/v1", Kind: "Certificate", Name: "test-name", UID: types.UID("uid-123"), Controller: pointer.Bool(true), BlockOwnerDeletion: pointer.Bool(true)},
 					},
@@ -523,7 +536,7 @@ func Test_ensureSecretData(t *testing.T) {
 				actionCalled = true
 				return nil
 			}
-			w.postIssuancePolicyChain = policies.NewSecretPostIssuancePolicyChain(test.enableOwnerRef, fieldManager)
+			w.postIssuancePolicyChain = policies.NewSecretPostIssuancePolicyChain(test.enableOwnerRef, fieldManager, 0)
 
 			// Start the informers and begin processing updates.
 			builder.Start()
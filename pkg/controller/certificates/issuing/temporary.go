@@ -67,9 +67,13 @@ func (c *controller) ensureTemporaryCertificate(ctx context.Context, crt *cmapi.
 	if err != nil {
 		return false, err
 	}
+	storedPKData, err := c.encryptPrivateKey(ctx, crt, pkData)
+	if err != nil {
+		return false, err
+	}
 	secretData := internal.SecretData{
 		Certificate: certData,
-		PrivateKey:  pkData,
+		PrivateKey:  storedPKData,
 	}
 	if err := c.secretsUpdateData(ctx, crt, secretData); err != nil {
 		return false, err
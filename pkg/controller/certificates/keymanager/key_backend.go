@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keymanager
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// reasonKeyBackendFailed is the reason used when the private key held by
+// spec.privateKey.backend cannot be used.
+const reasonKeyBackendFailed = "KeyBackendFailed"
+
+// keyBackend looks up the private keys held by the external signers
+// configured in spec.privateKey.backend.
+type keyBackend struct {
+	builder keybackend.Builder
+
+	// ambient permits backends without configured credentials to use the
+	// ambient credentials of the controller.
+	ambient   bool
+	userAgent string
+}
+
+// processNextPrivateKeyReference ensures that the 'next private key' Secret
+// holds a reference to the private key held by the external signer, rather
+// than a private key generated by cert-manager.
+func (c *controller) processNextPrivateKeyReference(ctx context.Context, crt *cmapi.Certificate, backend *cmapi.PrivateKeyBackend, secrets []*corev1.Secret) error {
+	log := logf.FromContext(ctx)
+
+	if len(secrets) > 1 {
+		log.V(logf.DebugLevel).Info("Cleaning up Secret resources as multiple nextPrivateKeySecretName candidates found")
+		return c.deleteSecretResources(ctx, secrets)
+	}
+
+	if len(secrets) == 1 {
+		secret := secrets[0]
+		log = logf.WithRelatedResource(log, secret)
+		ctx = logf.NewContext(ctx, log)
+
+		if crt.Status.NextPrivateKeySecretName == nil {
+			log.V(logf.DebugLevel).Info("Adopting existing private key Secret")
+			return c.setNextPrivateKeySecretName(ctx, crt, &secret.Name)
+		}
+		if *crt.Status.NextPrivateKeySecretName != secret.Name {
+			log.V(logf.DebugLevel).Info("Deleting existing private key secret as name does not match status.nextPrivateKeySecretName")
+			return c.deleteSecretResources(ctx, secrets)
+		}
+
+		pkData := secret.Data[corev1.TLSPrivateKeyKey]
+		if !keybackend.References(pkData, backend) {
+			log.V(logf.DebugLevel).Info("Deleting existing private key Secret as it does not reference the private key held by spec.privateKey.backend")
+			return c.deleteSecretResources(ctx, secrets)
+		}
+		pub, err := keybackend.PublicKey(pkData)
+		if err != nil {
+			log.Error(err, "Deleting existing private key secret due to error decoding data")
+			return c.deleteSecretResources(ctx, secrets)
+		}
+		violations, err := certificates.PublicKeyMatchesSpec(pub, crt.Spec)
+		if err != nil {
+			log.Error(err, "Internal error verifying if private key matches spec - please open an issue.")
+			return nil
+		}
+		if len(violations) > 0 {
+			log.V(logf.DebugLevel).Info("Deleting existing private key Secret due to change in fields", "violations", violations)
+			return c.deleteSecretResources(ctx, secrets)
+		}
+		return nil
+	}
+
+	signer, err := c.keyBackend.builder(ctx, crt.Namespace, c.secretLister, backend, c.keyBackend.ambient, c.keyBackend.userAgent)
+	if err != nil {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonKeyBackendFailed, "Failed to look up the private key held by spec.privateKey.backend: %v", err)
+		return err
+	}
+	violations, err := certificates.PublicKeyMatchesSpec(signer.Public(), crt.Spec)
+	if err != nil {
+		return err
+	}
+	if len(violations) > 0 {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonKeyBackendFailed, "Private key held by spec.privateKey.backend does not match requirements on Certificate resource, mismatching fields: %v", violations)
+		return nil
+	}
+
+	pkData, err := keybackend.EncodeReference(backend, signer.Public())
+	if err != nil {
+		return err
+	}
+	s, err := c.createNewPrivateKeySecretWithData(ctx, crt, pkData)
+	if err != nil {
+		return err
+	}
+
+	c.recorder.Event(crt, corev1.EventTypeNormal, "Referenced", fmt.Sprintf("Stored reference to the private key held by spec.privateKey.backend in temporary Secret resource %q", s.Name))
+
+	return c.setNextPrivateKeySecretName(ctx, crt, &s.Name)
+}
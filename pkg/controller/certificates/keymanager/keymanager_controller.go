@@ -45,6 +45,7 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -68,6 +69,10 @@ type controller struct {
 	coreClient        kubernetes.Interface
 	recorder          record.EventRecorder
 
+	// keyBackend looks up the private keys held by the external signers
+	// configured in spec.privateKey.backend.
+	keyBackend keyBackend
+
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Apply API calls.
@@ -118,6 +123,7 @@ func NewController(
 		client:            client,
 		coreClient:        coreClient,
 		recorder:          recorder,
+		keyBackend:        keyBackend{builder: keybackend.New},
 		fieldManager:      fieldManager,
 	}, queue, mustSync
 }
@@ -153,6 +159,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
 	// Discover all 'owned' secrets that have the `next-private-key` label
 	secrets, err := certificates.ListSecretsMatchingPredicates(c.secretLister.Secrets(crt.Namespace), isNextPrivateKeyLabelSelector, predicate.ResourceOwnedBy(crt))
 	if err != nil {
@@ -170,6 +181,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return c.setNextPrivateKeySecretName(ctx, crt, nil)
 	}
 
+	// private keys held by an external signer are only referenced by the
+	// Secret resource
+	if backend := certificates.PrivateKeyBackend(crt); backend != nil {
+		return c.processNextPrivateKeyReference(ctx, crt, backend, secrets)
+	}
+
 	// if there is no existing Secret resource, create a new one
 	if len(secrets) == 0 {
 		rotationPolicy := cmapi.RotationPolicyNever
@@ -332,6 +349,17 @@ func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certifi
 }
 
 func (c *controller) createNewPrivateKeySecret(ctx context.Context, crt *cmapi.Certificate, pk crypto.Signer) (*corev1.Secret, error) {
+	pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
+	if err != nil {
+		return nil, err
+	}
+	return c.createNewPrivateKeySecretWithData(ctx, crt, pkData)
+}
+
+// createNewPrivateKeySecretWithData creates the 'next private key' Secret
+// holding pkData, which is either a private key or a reference to a private
+// key held by an external signer.
+func (c *controller) createNewPrivateKeySecretWithData(ctx context.Context, crt *cmapi.Certificate, pkData []byte) (*corev1.Secret, error) {
 	// if the 'nextPrivateKeySecretName' field is already set, use this as the
 	// name of the Secret resource.
 	name := ""
@@ -339,11 +367,6 @@ func (c *controller) createNewPrivateKeySecret(ctx context.Context, crt *cmapi.C
 		name = *crt.Status.NextPrivateKeySecretName
 	}
 
-	pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
-	if err != nil {
-		return nil, err
-	}
-
 	s := &corev1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
 			Namespace:       crt.Namespace,
@@ -361,7 +384,7 @@ func (c *controller) createNewPrivateKeySecret(ctx context.Context, crt *cmapi.C
 		// TODO: handle certificate resources that have especially long names
 		s.GenerateName = crt.Name + "-"
 	}
-	s, err = c.coreClient.CoreV1().Secrets(s.Namespace).Create(ctx, s, metav1.CreateOptions{})
+	s, err := c.coreClient.CoreV1().Secrets(s.Namespace).Create(ctx, s, metav1.CreateOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -388,6 +411,11 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	)
 	c.controller = ctrl
 
+	// Certificates are namespaced, so may only use ambient credentials for
+	// private key backends if Issuers may.
+	c.controller.keyBackend.ambient = ctx.IssuerOptions.IssuerAmbientCredentials
+	c.controller.keyBackend.userAgent = ctx.RESTConfig.UserAgent
+
 	return queue, mustSync, nil
 }
 
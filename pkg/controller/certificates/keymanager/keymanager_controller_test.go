@@ -18,6 +18,8 @@ package keymanager
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -27,6 +29,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	coretesting "k8s.io/client-go/testing"
 	"k8s.io/utils/pointer"
 
@@ -34,6 +37,7 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
@@ -61,6 +65,14 @@ func mustGenerateECDSA(t *testing.T, keySize int) []byte {
 	return d
 }
 
+func mustEncodeReference(t *testing.T, backend *cmapi.PrivateKeyBackend, pk crypto.Signer) []byte {
+	d, err := keybackend.EncodeReference(backend, pk.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return d
+}
+
 func relaxedSecretMatcher(l coretesting.Action, r coretesting.Action) error {
 	objL := l.(coretesting.CreateAction).GetObject().(*corev1.Secret).DeepCopy()
 	objR := r.(coretesting.CreateAction).GetObject().(*corev1.Secret).DeepCopy()
@@ -93,6 +105,23 @@ func TestProcessItem(t *testing.T) {
 			Data: data,
 		}
 	}
+	backendKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	backend := &cmapi.PrivateKeyBackend{GCPKMS: &cmapi.GCPKMSPrivateKeyBackend{
+		KeyVersionName: "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1",
+	}}
+	otherBackend := &cmapi.PrivateKeyBackend{GCPKMS: &cmapi.GCPKMSPrivateKeyBackend{
+		KeyVersionName: "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/2",
+	}}
+	backendSpec := cmapi.CertificateSpec{
+		PrivateKey: &cmapi.CertificatePrivateKey{
+			Algorithm: cmapi.ECDSAKeyAlgorithm,
+			Backend:   backend,
+		},
+	}
+
 	tests := map[string]struct {
 		// key that should be passed to ProcessItem.
 		// if not set, the 'namespace/name' of the 'Certificate' field will be used.
@@ -108,6 +137,10 @@ func TestProcessItem(t *testing.T) {
 		// Request, if set, will exist in the apiserver before the test is run.
 		requests []*cmapi.CertificateRequest
 
+		// keyBackendSigner is returned for the private key held by
+		// spec.privateKey.backend.
+		keyBackendSigner crypto.Signer
+
 		expectedActions []testpkg.Action
 
 		expectedEvents []string
@@ -461,6 +494,144 @@ func TestProcessItem(t *testing.T) {
 				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustGenerateRSA(t, 2048)}),
 			},
 		},
+		"create a secret referencing the private key held by spec.privateKey.backend": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec:       backendSpec,
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			keyBackendSigner: backendKey,
+			expectedEvents:   []string{`Normal Referenced Stored reference to the private key held by spec.privateKey.backend in temporary Secret resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"),
+					"status",
+					"testns",
+					&cmapi.Certificate{
+						ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+						Spec:       backendSpec,
+						Status: cmapi.CertificateStatus{
+							NextPrivateKeySecretName: pointer.StringPtr("test-notrandom"),
+							Conditions: []cmapi.CertificateCondition{
+								{
+									Type:   cmapi.CertificateConditionIssuing,
+									Status: cmmeta.ConditionTrue,
+								},
+							},
+						},
+					},
+				)),
+				testpkg.NewAction(coretesting.NewCreateAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					&corev1.Secret{
+						ObjectMeta: metav1.ObjectMeta{
+							Namespace:       "testns",
+							GenerateName:    "test-",
+							Labels:          map[string]string{cmapi.IsNextPrivateKeySecretLabelKey: "true"},
+							OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(&cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"}}, certificateGvk)},
+						},
+						Data: map[string][]byte{"tls.key": mustEncodeReference(t, backend, backendKey)},
+					},
+				)),
+			},
+		},
+		"do not create a secret if the private key held by spec.privateKey.backend does not match the spec": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test"},
+				Spec: cmapi.CertificateSpec{
+					PrivateKey: &cmapi.CertificatePrivateKey{
+						Algorithm: cmapi.RSAKeyAlgorithm,
+						Backend:   backend,
+					},
+				},
+				Status: cmapi.CertificateStatus{
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			keyBackendSigner: backendKey,
+			expectedEvents:   []string{`Warning KeyBackendFailed Private key held by spec.privateKey.backend does not match requirements on Certificate resource, mismatching fields: [spec.keyAlgorithm]`},
+		},
+		"if an owned secret references the private key held by spec.privateKey.backend, do nothing": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec:       backendSpec,
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: pointer.StringPtr("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustEncodeReference(t, backend, backendKey)}),
+			},
+		},
+		"if an owned secret references a different key than spec.privateKey.backend, delete it": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec:       backendSpec,
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: pointer.StringPtr("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustEncodeReference(t, otherBackend, backendKey)}),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					"fixed-name",
+				)),
+			},
+		},
+		"if an owned secret contains a private key but spec.privateKey.backend is set, delete it": {
+			certificate: &cmapi.Certificate{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "test", UID: types.UID("test")},
+				Spec:       backendSpec,
+				Status: cmapi.CertificateStatus{
+					NextPrivateKeySecretName: pointer.StringPtr("fixed-name"),
+					Conditions: []cmapi.CertificateCondition{
+						{
+							Type:   cmapi.CertificateConditionIssuing,
+							Status: cmmeta.ConditionTrue,
+						},
+					},
+				},
+			},
+			secrets: []runtime.Object{
+				ownedSecretWithName("testns", "fixed-name", "test", map[string][]byte{"tls.key": mustGenerateECDSA(t, 256)}),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(
+					corev1.SchemeGroupVersion.WithResource("secrets"),
+					"testns",
+					"fixed-name",
+				)),
+			},
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -480,7 +651,7 @@ func TestProcessItem(t *testing.T) {
 			for _, req := range test.requests {
 				builder.CertManagerObjects = append(builder.CertManagerObjects, req)
 			}
-			builder.Init()
+			builder.InitWithRESTConfig()
 
 			// Register informers used by the controller using the registration wrapper
 			w := &controllerWrapper{}
@@ -488,6 +659,12 @@ func TestProcessItem(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
+			w.controller.keyBackend.builder = func(context.Context, string, corelisters.SecretLister, *cmapi.PrivateKeyBackend, bool, string) (crypto.Signer, error) {
+				if test.keyBackendSigner == nil {
+					return nil, errors.New("unexpected call to key backend")
+				}
+				return test.keyBackendSigner, nil
+			}
 			// Start the informers and begin processing updates
 			builder.Start()
 			defer builder.Stop()
@@ -18,6 +18,7 @@ package readiness
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -53,6 +54,8 @@ const (
 	ControllerName = "certificates-readiness"
 	// ReadyReason is the 'Ready' reason of a Certificate.
 	ReadyReason = "Ready"
+	// PausedReason is the 'Paused' reason of a Certificate.
+	PausedReason = "Paused"
 )
 
 type controller struct {
@@ -68,6 +71,10 @@ type controller struct {
 	// renewalTimeCalculator calculates renewal time of a certificate
 	renewalTimeCalculator certificates.RenewalTimeFunc
 
+	// defaultRenewalJitter is the renewal jitter applied to the renewal time
+	// of Certificates which do not set spec.renewalJitter.
+	defaultRenewalJitter time.Duration
+
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Apply API calls.
@@ -85,6 +92,7 @@ func NewController(
 	cmFactory cminformers.SharedInformerFactory,
 	chain policies.Chain,
 	renewalTimeCalculator certificates.RenewalTimeFunc,
+	defaultRenewalJitter time.Duration,
 	policyEvaluator policyEvaluatorFunc,
 	fieldManager string,
 ) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
@@ -129,6 +137,7 @@ func NewController(
 		},
 		policyEvaluator:       policyEvaluator,
 		renewalTimeCalculator: renewalTimeCalculator,
+		defaultRenewalJitter:  defaultRenewalJitter,
 		fieldManager:          fieldManager,
 	}, queue, mustSync
 }
@@ -165,6 +174,13 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	crt = crt.DeepCopy()
 	apiutil.SetCertificateCondition(crt, crt.Generation, condition.Type, condition.Status, condition.Reason, condition.Message)
 
+	if certificates.IsPaused(crt) {
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionPaused, cmmeta.ConditionTrue, PausedReason,
+			fmt.Sprintf("Reconciliation is paused by the %s annotation; the Certificate will not be renewed or re-issued", cmapi.CertificatePausedAnnotationKey))
+	} else {
+		apiutil.RemoveCertificateCondition(crt, cmapi.CertificateConditionPaused)
+	}
+
 	switch {
 	case input.Secret != nil && input.Secret.Data != nil:
 		x509cert, err := pki.DecodeX509CertificateBytes(input.Secret.Data[corev1.TLSCertKey])
@@ -179,7 +195,8 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		notBefore := metav1.NewTime(x509cert.NotBefore)
 		notAfter := metav1.NewTime(x509cert.NotAfter)
 		renewBeforeHint := crt.Spec.RenewBefore
-		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, renewBeforeHint)
+		renewalTime := c.renewalTimeCalculator(x509cert.NotBefore, x509cert.NotAfter, renewBeforeHint, crt.Spec.RenewBeforePercentage)
+		renewalTime = certificates.JitterRenewalTime(crt, renewalTime, x509cert.NotBefore, c.defaultRenewalJitter)
 
 		//update Certificate's Status
 		crt.Status.NotBefore = &notBefore
@@ -207,8 +224,10 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate) error {
 	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
 		var conditions []cmapi.CertificateCondition
-		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionReady); cond != nil {
-			conditions = []cmapi.CertificateCondition{*cond}
+		for _, conditionType := range []cmapi.CertificateConditionType{cmapi.CertificateConditionReady, cmapi.CertificateConditionPaused} {
+			if cond := apiutil.GetCertificateCondition(crt, conditionType); cond != nil {
+				conditions = append(conditions, *cond)
+			}
 		}
 		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
 			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
@@ -260,6 +279,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.SharedInformerFactory,
 		policies.NewReadinessPolicyChain(ctx.Clock),
 		certificates.RenewalTime,
+		ctx.CertificateOptions.DefaultRenewalJitter,
 		policyEvaluator,
 		ctx.FieldManager,
 	)
@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"context"
+	"crypto"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// reasonKeyBackendFailed is the reason used when the private key held by
+// spec.privateKey.backend cannot be used to sign a CSR.
+const reasonKeyBackendFailed = "KeyBackendFailed"
+
+// keyBackend constructs signers for the private keys held by the external
+// signers configured in spec.privateKey.backend.
+type keyBackend struct {
+	builder keybackend.Builder
+
+	// ambient permits backends without configured credentials to use the
+	// ambient credentials of the controller.
+	ambient   bool
+	userAgent string
+}
+
+// keyBackendSigner returns a signer for the private key held by the external
+// signer, which must still have the public key referenced by the 'next
+// private key' Secret. It returns a nil signer if the private key has been
+// replaced, such as when an alias has been updated to refer to a new key.
+func (c *controller) keyBackendSigner(ctx context.Context, crt *cmapi.Certificate, backend *cmapi.PrivateKeyBackend, pub crypto.PublicKey) (crypto.Signer, error) {
+	signer, err := c.keyBackend.builder(ctx, crt.Namespace, c.secretLister, backend, c.keyBackend.ambient, c.keyBackend.userAgent)
+	if err != nil {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonKeyBackendFailed, fmt.Sprintf("Failed to look up the private key held by spec.privateKey.backend: %v", err))
+		return nil, err
+	}
+
+	equal, err := pki.PublicKeysEqual(signer.Public(), pub)
+	if err != nil {
+		return nil, err
+	}
+	if !equal {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonKeyBackendFailed, "The private key held by spec.privateKey.backend does not match the public key in the next private key Secret, it will be looked up again once the issuance is retried")
+		return nil, nil
+	}
+
+	return signer, nil
+}
@@ -38,6 +38,7 @@ import (
 	"k8s.io/client-go/util/workqueue"
 	"k8s.io/utils/clock"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -46,7 +47,11 @@ import (
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/pkg/util/predicate"
 )
@@ -55,6 +60,7 @@ const (
 	ControllerName      = "certificates-request-manager"
 	reasonRequestFailed = "RequestFailed"
 	reasonRequested     = "Requested"
+	reasonFailover      = "Failover"
 )
 
 var (
@@ -70,6 +76,15 @@ type controller struct {
 	clock                    clock.Clock
 	copiedAnnotationPrefixes []string
 
+	// venafiPolicy validates Certificates against the zone policy of their
+	// Venafi issuer. It is nil if the VenafiPolicyPreValidation feature is
+	// disabled.
+	venafiPolicy *venafiPolicy
+
+	// keyBackend constructs signers for the private keys held by the
+	// external signers configured in spec.privateKey.backend.
+	keyBackend keyBackend
+
 	// fieldManager is the string which will be used as the Field Manager on
 	// fields created or edited by the cert-manager Kubernetes client during
 	// Create or Apply API calls.
@@ -124,6 +139,7 @@ func NewController(
 		recorder:                 recorder,
 		clock:                    clock,
 		copiedAnnotationPrefixes: certificateControllerOptions.CopiedAnnotationPrefixes,
+		keyBackend:               keyBackend{builder: keybackend.New},
 		fieldManager:             fieldManager,
 	}, queue, mustSync
 }
@@ -147,6 +163,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
 	if !apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -171,10 +192,29 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		log.V(logf.DebugLevel).Info("Next private key secret does not contain any valid data, waiting for keymanager before processing certificate")
 		return nil
 	}
-	pk, err := pki.DecodePrivateKeyBytes(nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey])
-	if err != nil {
-		log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
-		return nil
+	pkData := nextPrivateKeySecret.Data[corev1.TLSPrivateKeyKey]
+	// pk is nil if the private key is held by an external signer, which is
+	// only contacted if a CSR needs to be signed.
+	var pk crypto.Signer
+	var publicKey crypto.PublicKey
+	backend := certificates.PrivateKeyBackend(crt)
+	if backend != nil {
+		if !keybackend.References(pkData, backend) {
+			log.V(logf.DebugLevel).Info("Next private key secret does not reference the private key held by spec.privateKey.backend, waiting for keymanager before processing certificate")
+			return nil
+		}
+		publicKey, err = keybackend.PublicKey(pkData)
+		if err != nil {
+			log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
+			return nil
+		}
+	} else {
+		pk, err = pki.DecodePrivateKeyBytes(pkData)
+		if err != nil {
+			log.Error(err, "Failed to decode next private key secret data, waiting for keymanager before processing certificate")
+			return nil
+		}
+		publicKey = pk.Public()
 	}
 
 	// Discover all 'owned' CertificateRequests
@@ -200,7 +240,7 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
-	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, pk.Public(), requests...)
+	requests, err = c.deleteRequestsNotMatchingSpec(ctx, crt, publicKey, requests...)
 	if err != nil {
 		return err
 	}
@@ -224,6 +264,13 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
+	if pk == nil {
+		pk, err = c.keyBackendSigner(ctx, crt, backend, publicKey)
+		if err != nil || pk == nil {
+			return err
+		}
+	}
+
 	return c.createNewCertificateRequest(ctx, crt, pk, nextRevision, nextPrivateKeySecret.Name)
 }
 
@@ -362,6 +409,15 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 		log.Error(err, "Failed to generate CSR - will not retry")
 		return nil
 	}
+
+	violated, err := c.checkVenafiPolicy(ctx, crt, x509CSR, pk.Public())
+	if err != nil {
+		return err
+	}
+	if violated {
+		return nil
+	}
+
 	csrDER, err := pki.EncodeCSR(x509CSR, pk)
 	if err != nil {
 		return err
@@ -377,6 +433,20 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	annotations[cmapi.CertificateRequestRevisionAnnotationKey] = strconv.Itoa(nextRevision)
 	annotations[cmapi.CertificateRequestPrivateKeyAnnotationKey] = nextPrivateKeySecretName
 	annotations[cmapi.CertificateNameKey] = crt.Name
+	if len(crt.Spec.SignatureAlgorithm) > 0 {
+		annotations[cmapi.CertificateRequestSignatureAlgorithmAnnotationKey] = string(crt.Spec.SignatureAlgorithm)
+	}
+	if len(crt.Spec.ExtraExtensions) > 0 {
+		annotations[cmapi.CertificateRequestExtraExtensionsAnnotationKey] = pki.ExtraExtensionsAnnotationValue(crt.Spec.ExtraExtensions)
+	}
+	if len(crt.Spec.CertificatePolicies) > 0 {
+		annotations[cmapi.CertificateRequestCertificatePoliciesAnnotationKey] = pki.ObjectIdentifiersAnnotationValue(crt.Spec.CertificatePolicies)
+	}
+	if len(crt.Spec.ExtendedKeyUsageOIDs) > 0 {
+		annotations[cmapi.CertificateRequestExtendedKeyUsageOIDsAnnotationKey] = pki.ObjectIdentifiersAnnotationValue(crt.Spec.ExtendedKeyUsageOIDs)
+	}
+
+	issuerRef := certificates.IssuerRefForIssuance(crt)
 
 	cr := &cmapi.CertificateRequest{
 		ObjectMeta: metav1.ObjectMeta{
@@ -388,7 +458,7 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 		},
 		Spec: cmapi.CertificateRequestSpec{
 			Duration:  crt.Spec.Duration,
-			IssuerRef: crt.Spec.IssuerRef,
+			IssuerRef: issuerRef,
 			Request:   csrPEM.Bytes(),
 			IsCA:      crt.Spec.IsCA,
 			Usages:    crt.Spec.Usages,
@@ -402,6 +472,9 @@ func (c *controller) createNewCertificateRequest(ctx context.Context, crt *cmapi
 	}
 
 	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRequested, "Created new CertificateRequest resource %q", cr.Name)
+	if issuerRef != crt.Spec.IssuerRef {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonFailover, "Issuance has failed %d times, requested certificate from fallback issuer %q", *crt.Status.FailedIssuanceAttempts, issuerRef.Name)
+	}
 	if err := c.waitForCertificateRequestToExist(cr.Namespace, cr.Name); err != nil {
 		return fmt.Errorf("failed whilst waiting for CertificateRequest to exist - this may indicate an apiserver running slowly. Request will be retried")
 	}
@@ -442,6 +515,33 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	)
 	c.controller = ctrl
 
+	// Certificates are namespaced, so may only use ambient credentials for
+	// private key backends if Issuers may.
+	c.controller.keyBackend.ambient = ctx.IssuerOptions.IssuerAmbientCredentials
+	c.controller.keyBackend.userAgent = ctx.RESTConfig.UserAgent
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.VenafiPolicyPreValidation) {
+		issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+		mustSync = append(mustSync, issuerInformer.Informer().HasSynced)
+
+		// ClusterIssuers can only be read if cert-manager is not scoped to a
+		// single namespace.
+		var clusterIssuerLister cmlisters.ClusterIssuerLister
+		if ctx.Namespace == "" {
+			clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+			mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+			clusterIssuerLister = clusterIssuerInformer.Lister()
+		}
+
+		c.controller.venafiPolicy = &venafiPolicy{
+			issuerHelper:  issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister),
+			secretsLister: ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
+			issuerOptions: ctx.IssuerOptions,
+			metrics:       ctx.Metrics,
+			clientBuilder: venaficlient.New,
+		}
+	}
+
 	return queue, mustSync, nil
 }
 
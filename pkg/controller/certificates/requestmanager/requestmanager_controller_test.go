@@ -18,6 +18,8 @@ package requestmanager
 
 import (
 	"context"
+	"crypto"
+	"errors"
 	"fmt"
 	"reflect"
 	"testing"
@@ -27,6 +29,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
 	coretesting "k8s.io/client-go/testing"
 	fakeclock "k8s.io/utils/clock/testing"
 
@@ -34,6 +37,7 @@ import (
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
 	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
@@ -78,6 +82,17 @@ func TestProcessItem(t *testing.T) {
 		},
 		Spec: cmapi.CertificateSpec{CommonName: "test-bundle-2"}},
 	)
+	backend := cmapi.PrivateKeyBackend{AWSKMS: &cmapi.AWSKMSPrivateKeyBackend{Region: "eu-west-1", KeyID: "alias/my-key"}}
+	backendBundle := mustCreateCryptoBundle(t, gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("test"),
+		gen.SetCertificateCommonName("test-bundle-backend"),
+		gen.SetCertificateKeyBackend(backend),
+	))
+	backendReference, err := keybackend.EncodeReference(&backend, backendBundle.privateKey.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
 	fixedNow := metav1.NewTime(time.Now())
 	fixedClock := fakeclock.NewFakeClock(fixedNow.Time)
 	failedCRConditionPreviousIssuance := cmapi.CertificateRequestCondition{
@@ -107,6 +122,10 @@ func TestProcessItem(t *testing.T) {
 		// Request, if set, will exist in the apiserver before the test is run.
 		requests []runtime.Object
 
+		// keyBackendSigner is returned for the private key held by
+		// spec.privateKey.backend.
+		keyBackendSigner crypto.Signer
+
 		expectedActions []testpkg.Action
 
 		expectedEvents []string
@@ -185,6 +204,30 @@ func TestProcessItem(t *testing.T) {
 					)), relaxedCertificateRequestMatcher),
 			},
 		},
+		"create a CertificateRequest with the signature algorithm annotation if the Certificate sets one": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: bundle1.certificate.Namespace, Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle1.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(bundle1.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateSignatureAlgorithm(cmapi.SHA384WithRSA),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(bundle1.certificateRequest,
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey:         "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:           "1",
+							cmapi.CertificateRequestSignatureAlgorithmAnnotationKey: "SHA384WithRSA",
+						}),
+					)), relaxedCertificateRequestMatcher),
+			},
+		},
 		"delete the owned CertificateRequest and create a new one if existing one does not have the annotation": {
 			secrets: []runtime.Object{
 				&corev1.Secret{
@@ -588,6 +631,55 @@ func TestProcessItem(t *testing.T) {
 				),
 			},
 		},
+		"create a CertificateRequest signed by the private key held by spec.privateKey.backend": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: backendReference},
+				},
+			},
+			certificate: gen.CertificateFrom(backendBundle.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			keyBackendSigner: backendBundle.privateKey,
+			expectedEvents:   []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+					gen.CertificateRequestFrom(backendBundle.certificateRequest,
+						gen.SetCertificateRequestAnnotations(map[string]string{
+							cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+							cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+						}),
+					)), relaxedCertificateRequestMatcher),
+			},
+		},
+		"do nothing if the private key held by spec.privateKey.backend has been replaced": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: backendReference},
+				},
+			},
+			certificate: gen.CertificateFrom(backendBundle.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+			keyBackendSigner: bundle1.privateKey,
+			expectedEvents:   []string{"Warning KeyBackendFailed The private key held by spec.privateKey.backend does not match the public key in the next private key Secret, it will be looked up again once the issuance is retried"},
+		},
+		"do nothing if status.nextPrivateKeySecretName does not reference the private key held by spec.privateKey.backend": {
+			secrets: []runtime.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "exists"},
+					Data:       map[string][]byte{corev1.TLSPrivateKeyKey: backendBundle.privateKeyBytes},
+				},
+			},
+			certificate: gen.CertificateFrom(backendBundle.certificate,
+				gen.SetCertificateNextPrivateKeySecretName("exists"),
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+			),
+		},
 	}
 	for name, test := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -606,7 +698,7 @@ func TestProcessItem(t *testing.T) {
 				builder.KubeObjects = append(builder.KubeObjects, test.secrets...)
 			}
 			builder.CertManagerObjects = append(builder.CertManagerObjects, test.requests...)
-			builder.Init()
+			builder.InitWithRESTConfig()
 
 			// Register informers used by the controller using the registration wrapper
 			w := &controllerWrapper{}
@@ -614,6 +706,12 @@ func TestProcessItem(t *testing.T) {
 			if err != nil {
 				t.Fatal(err)
 			}
+			w.controller.keyBackend.builder = func(context.Context, string, corelisters.SecretLister, *cmapi.PrivateKeyBackend, bool, string) (crypto.Signer, error) {
+				if test.keyBackendSigner == nil {
+					return nil, errors.New("unexpected call to key backend")
+				}
+				return test.keyBackendSigner, nil
+			}
 			// Start the informers and begin processing updates
 			builder.Start()
 			defer builder.Stop()
@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"fmt"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+const (
+	reasonPolicyViolation = "PolicyViolation"
+	reasonPolicyCompliant = "PolicyCompliant"
+)
+
+// venafiPolicy validates Certificates which reference a Venafi issuer against
+// the policy of the issuer's zone, so that violations can be reported before a
+// CertificateRequest is created.
+type venafiPolicy struct {
+	issuerHelper  issuer.Helper
+	secretsLister corelisters.SecretLister
+	issuerOptions controllerpkg.IssuerOptions
+	metrics       *metrics.Metrics
+	clientBuilder venaficlient.VenafiClientBuilder
+}
+
+// violations returns the ways in which the certificate requested by the CSR
+// would violate the zone policy of the Certificate's issuer, along with the
+// name of the zone. No violations are returned for other issuer types.
+func (v *venafiPolicy) violations(ctx context.Context, crt *cmapi.Certificate, csr *x509.CertificateRequest, pub crypto.PublicKey) (string, []string, error) {
+	if crt.Spec.IssuerRef.Group != "" && crt.Spec.IssuerRef.Group != cmapi.SchemeGroupVersion.Group {
+		return "", nil, nil
+	}
+
+	issuerObj, err := v.issuerHelper.GetGenericIssuer(crt.Spec.IssuerRef, crt.Namespace)
+	if err != nil {
+		return "", nil, err
+	}
+	if issuerObj.GetSpec().Venafi == nil {
+		return "", nil, nil
+	}
+
+	resourceNamespace, err := v.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		return "", nil, err
+	}
+
+	log := logf.WithRelatedResource(logf.FromContext(ctx), issuerObj)
+	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.metrics, v.issuerOptions.CircuitBreakers, log)
+	if err != nil {
+		return "", nil, err
+	}
+	zone, err := client.ReadZoneConfiguration()
+	if err != nil {
+		return "", nil, err
+	}
+
+	return issuerObj.GetSpec().Venafi.Zone, venaficlient.ZonePolicyViolations(zone, csr, pub), nil
+}
+
+// checkVenafiPolicy validates the Certificate against the zone policy of its
+// Venafi issuer and updates its PolicyViolation condition accordingly. It
+// returns true if the Certificate violates the policy, in which case no
+// CertificateRequest must be created for it. The policy not being available is
+// not treated as a violation, as the request will still be validated by Venafi.
+// Certificates are only validated if the VenafiPolicyPreValidation feature is
+// enabled.
+func (c *controller) checkVenafiPolicy(ctx context.Context, crt *cmapi.Certificate, csr *x509.CertificateRequest, pub crypto.PublicKey) (bool, error) {
+	if c.venafiPolicy == nil {
+		return false, nil
+	}
+
+	log := logf.FromContext(ctx)
+	zone, violations, err := c.venafiPolicy.violations(ctx, crt, csr, pub)
+	if err != nil {
+		log.V(logf.WarnLevel).Info("failed to read the Venafi zone policy, skipping validation", "error", err.Error())
+		return false, nil
+	}
+
+	if len(violations) == 0 {
+		cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionPolicyViolation)
+		if cond == nil || cond.Status == cmmeta.ConditionFalse {
+			return false, nil
+		}
+		return false, c.setPolicyViolationCondition(ctx, crt, cmmeta.ConditionFalse, reasonPolicyCompliant, "Certificate is compliant with the issuer's policy")
+	}
+
+	message := fmt.Sprintf("Certificate violates the policy of Venafi zone %q: %s", zone, strings.Join(violations, ", "))
+	log.V(logf.InfoLevel).Info("not creating a CertificateRequest as the Certificate violates the Venafi zone policy", "zone", zone, "violations", violations)
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionPolicyViolation); cond == nil ||
+		cond.Status != cmmeta.ConditionTrue || cond.Message != message {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonPolicyViolation, message)
+	}
+
+	return true, c.setPolicyViolationCondition(ctx, crt, cmmeta.ConditionTrue, reasonPolicyViolation, message)
+}
+
+// setPolicyViolationCondition sets the PolicyViolation condition on the
+// Certificate. If the ServerSideApply feature is enabled, the condition is
+// applied using the relevant Patch API call.
+func (c *controller) setPolicyViolationCondition(ctx context.Context, crt *cmapi.Certificate, status cmmeta.ConditionStatus, reason, message string) error {
+	oldCrt := crt
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionPolicyViolation, status, reason, message)
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionPolicyViolation)
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: []cmapi.CertificateCondition{*cond}},
+		})
+	}
+	_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package requestmanager
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/Venafi/vcert/v4/pkg/endpoint"
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	venaficlient "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItemVenafiPolicy(t *testing.T) {
+	fixedNow := metav1.NewTime(time.Now().Truncate(time.Second))
+	fixedClock := fakeclock.NewFakeClock(fixedNow.Time)
+
+	bundle := mustCreateCryptoBundle(t, gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("test"),
+		gen.SetCertificateCommonName("www.example.org"),
+		gen.SetCertificateIssuer(cmmeta.ObjectReference{Name: "venafi", Kind: cmapi.IssuerKind}),
+	))
+	crt := gen.CertificateFrom(bundle.certificate,
+		gen.SetCertificateNextPrivateKeySecretName("exists"),
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionIssuing, Status: cmmeta.ConditionTrue}),
+	)
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "testns", Name: "exists"},
+		Data:       map[string][]byte{corev1.TLSPrivateKeyKey: bundle.privateKeyBytes},
+	}
+	issuer := gen.Issuer("venafi",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{Zone: "test-zone"}),
+	)
+
+	violationMessage := `Certificate violates the policy of Venafi zone "test-zone": common name "www.example.org" is not allowed`
+	violatedCrt := gen.CertificateFrom(crt,
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:               cmapi.CertificateConditionPolicyViolation,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "PolicyViolation",
+			Message:            violationMessage,
+			LastTransitionTime: &fixedNow,
+		}),
+	)
+	restrictedZone := &endpoint.ZoneConfiguration{
+		Policy: endpoint.Policy{SubjectCNRegexes: []string{`\.example\.com$`}},
+	}
+
+	createRequest := testpkg.NewCustomMatch(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns",
+		gen.CertificateRequestFrom(bundle.certificateRequest,
+			gen.SetCertificateRequestAnnotations(map[string]string{
+				cmapi.CertificateRequestPrivateKeyAnnotationKey: "exists",
+				cmapi.CertificateRequestRevisionAnnotationKey:   "1",
+			}),
+		)), relaxedCertificateRequestMatcher)
+
+	tests := map[string]struct {
+		certificate     *cmapi.Certificate
+		zone            *endpoint.ZoneConfiguration
+		zoneErr         error
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+	}{
+		"report a violation of the zone policy instead of creating a CertificateRequest": {
+			certificate:    crt,
+			zone:           restrictedZone,
+			expectedEvents: []string{"Warning PolicyViolation " + violationMessage},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns", violatedCrt)),
+			},
+		},
+		"do nothing if the violation has already been reported": {
+			certificate: violatedCrt,
+			zone:        restrictedZone,
+		},
+		"clear a reported violation and create a CertificateRequest once the Certificate is compliant": {
+			certificate:    violatedCrt,
+			zone:           &endpoint.ZoneConfiguration{},
+			expectedEvents: []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(crt,
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:               cmapi.CertificateConditionPolicyViolation,
+							Status:             cmmeta.ConditionFalse,
+							Reason:             "PolicyCompliant",
+							Message:            "Certificate is compliant with the issuer's policy",
+							LastTransitionTime: &fixedNow,
+						}),
+					))),
+				createRequest,
+			},
+		},
+		"create a CertificateRequest if the zone policy cannot be read": {
+			certificate:     crt,
+			zoneErr:         errors.New("connection refused"),
+			expectedEvents:  []string{`Normal Requested Created new CertificateRequest resource "test-notrandom"`},
+			expectedActions: []testpkg.Action{createRequest},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.VenafiPolicyPreValidation, true)()
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: []runtime.Object{test.certificate, issuer},
+				KubeObjects:        []runtime.Object{secret},
+				ExpectedEvents:     test.expectedEvents,
+				ExpectedActions:    test.expectedActions,
+				StringGenerator:    func(i int) string { return "notrandom" },
+				Clock:              fixedClock,
+			}
+			builder.InitWithRESTConfig()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.controller.venafiPolicy.clientBuilder = func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (venaficlient.Interface, error) {
+				return &fake.Venafi{
+					ReadZoneConfigurationFn: func() (*endpoint.ZoneConfiguration, error) {
+						return test.zone, test.zoneErr
+					},
+				}, nil
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "testns/test")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
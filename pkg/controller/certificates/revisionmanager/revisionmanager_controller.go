@@ -30,6 +30,7 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
 
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -51,6 +52,13 @@ type controller struct {
 	certificateLister        cmlisters.CertificateLister
 	certificateRequestLister cmlisters.CertificateRequestLister
 	client                   cmclient.Interface
+	queue                    workqueue.RateLimitingInterface
+	clock                    clock.Clock
+
+	// defaultRevisionHistoryTTL is the maximum age of the revisions of
+	// Certificates which do not set spec.revisionHistoryTTL. Zero disables
+	// garbage collecting these by age.
+	defaultRevisionHistoryTTL time.Duration
 }
 
 type revision struct {
@@ -58,7 +66,7 @@ type revision struct {
 	types.NamespacedName
 }
 
-func NewController(log logr.Logger, client cmclient.Interface, cmFactory cminformers.SharedInformerFactory) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+func NewController(log logr.Logger, client cmclient.Interface, cmFactory cminformers.SharedInformerFactory, clock clock.Clock, defaultRevisionHistoryTTL time.Duration) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
 	// create a queue used to queue up items to be processed
 	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
 
@@ -82,15 +90,19 @@ func NewController(log logr.Logger, client cmclient.Interface, cmFactory cminfor
 	}
 
 	return &controller{
-		certificateLister:        certificateInformer.Lister(),
-		certificateRequestLister: certificateRequestInformer.Lister(),
-		client:                   client,
+		certificateLister:         certificateInformer.Lister(),
+		certificateRequestLister:  certificateRequestInformer.Lister(),
+		client:                    client,
+		queue:                     queue,
+		clock:                     clock,
+		defaultRevisionHistoryTTL: defaultRevisionHistoryTTL,
 	}, queue, mustSync
 }
 
 // ProcessItem will attempt to garbage collect old CertificateRequests based
-// upon `spec.revisionHistoryLimit`. This controller will only act on
-// Certificates which are in a Ready state and this value is set.
+// upon `spec.revisionHistoryLimit` and `spec.revisionHistoryTTL`, or the
+// controller's default TTL. This controller will only act on Certificates
+// which are in a Ready state and either of these values is set.
 func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	log := logf.FromContext(ctx).WithValues("key", key)
 
@@ -110,11 +122,21 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return err
 	}
 
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
 	log = logf.WithResource(log, crt)
 
-	// If RevisionHistoryLimit is nil, don't attempt to garbage collect old
-	// CertificateRequests
-	if crt.Spec.RevisionHistoryLimit == nil {
+	ttl := c.defaultRevisionHistoryTTL
+	if crt.Spec.RevisionHistoryTTL != nil {
+		ttl = crt.Spec.RevisionHistoryTTL.Duration
+	}
+
+	// If neither RevisionHistoryLimit nor a TTL is set, don't attempt to
+	// garbage collect old CertificateRequests
+	if crt.Spec.RevisionHistoryLimit == nil && ttl <= 0 {
 		return nil
 	}
 
@@ -134,8 +156,24 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	}
 
 	// Fetch and delete all CertificateRequests that need to be deleted
-	limit := int(*crt.Spec.RevisionHistoryLimit)
-	toDelete := certificateRequestsToDelete(log, limit, requests)
+	var toDelete []revision
+	if crt.Spec.RevisionHistoryLimit != nil {
+		limit := int(*crt.Spec.RevisionHistoryLimit)
+		toDelete = certificateRequestsToDelete(log, limit, requests)
+	}
+	if ttl > 0 {
+		expired, nextExpiry := expiredCertificateRequests(ttl, c.clock.Now(), requests)
+		for _, rev := range expired {
+			if !containsRevision(toDelete, rev) {
+				toDelete = append(toDelete, rev)
+			}
+		}
+		// Process the Certificate again once the next of its remaining
+		// revisions has expired.
+		if nextExpiry > 0 {
+			c.queue.AddAfter(key, nextExpiry)
+		}
+	}
 
 	for _, req := range toDelete {
 		logf.WithRelatedResourceName(log, req.Name, req.Namespace, cmapi.CertificateRequestKind).
@@ -195,6 +233,69 @@ func certificateRequestsToDelete(log logr.Logger, limit int, requests []*cmapi.C
 	return revisions[:remaining]
 }
 
+// expiredCertificateRequests returns the CertificateRequests with a valid
+// revision number which were created longer than ttl ago, oldest revision
+// first. The latest revision is never returned so that the Certificate
+// always keeps its current request. The time until the next of the remaining
+// requests expires is also returned, or zero if none of them will. Requests
+// without a valid revision are skipped as they are by
+// certificateRequestsToDelete.
+func expiredCertificateRequests(ttl time.Duration, now time.Time, requests []*cmapi.CertificateRequest) ([]revision, time.Duration) {
+	type agedRevision struct {
+		revision
+		created time.Time
+	}
+
+	var revisions []agedRevision
+	latest := 0
+	for _, req := range requests {
+		rn, err := strconv.Atoi(req.Annotations[cmapi.CertificateRequestRevisionAnnotationKey])
+		if err != nil {
+			continue
+		}
+		if rn > latest {
+			latest = rn
+		}
+		revisions = append(revisions, agedRevision{
+			revision: revision{rn, types.NamespacedName{Namespace: req.Namespace, Name: req.Name}},
+			created:  req.CreationTimestamp.Time,
+		})
+	}
+
+	sort.SliceStable(revisions, func(i, j int) bool {
+		return revisions[i].rev < revisions[j].rev
+	})
+
+	var expired []revision
+	var nextExpiry time.Duration
+	for _, rev := range revisions {
+		if rev.rev == latest {
+			continue
+		}
+		remaining := ttl - now.Sub(rev.created)
+		if remaining <= 0 {
+			expired = append(expired, rev.revision)
+			continue
+		}
+		if nextExpiry == 0 || remaining < nextExpiry {
+			nextExpiry = remaining
+		}
+	}
+
+	return expired, nextExpiry
+}
+
+// containsRevision returns true if the revisions contain a request with the
+// same name as rev.
+func containsRevision(revisions []revision, rev revision) bool {
+	for _, r := range revisions {
+		if r.NamespacedName == rev.NamespacedName {
+			return true
+		}
+	}
+	return false
+}
+
 // controllerWrapper wraps the `controller` structure to make it implement
 // the controllerpkg.queueingController interface
 type controllerWrapper struct {
@@ -205,7 +306,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 	// construct a new named logger to be reused throughout the controller
 	log := logf.FromContext(ctx.RootContext, ControllerName)
 
-	ctrl, queue, mustSync := NewController(log, ctx.CMClient, ctx.SharedInformerFactory)
+	ctrl, queue, mustSync := NewController(log, ctx.CMClient, ctx.SharedInformerFactory, ctx.Clock, ctx.CertificateOptions.DefaultRevisionHistoryTTL)
 	c.controller = ctrl
 
 	return queue, mustSync, nil
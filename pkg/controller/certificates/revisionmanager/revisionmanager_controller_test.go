@@ -20,6 +20,7 @@ import (
 	"context"
 	"reflect"
 	"testing"
+	"time"
 
 	logtesting "github.com/go-logr/logr/testing"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -156,6 +157,55 @@ func TestProcessItem(t *testing.T) {
 				),
 			},
 		},
+		"delete requests older than the TTL apart from the latest revision": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}),
+				gen.SetCertificateRevisionHistoryTTL(time.Hour),
+			),
+			requests: []runtime.Object{
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-1"),
+					gen.SetCertificateRequestRevision("1"),
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(time.Now().Add(-2*time.Hour))),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-2"),
+					gen.SetCertificateRequestRevision("2"),
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(time.Now().Add(-2*time.Hour))),
+				),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "cr-1")),
+			},
+		},
+		"delete requests exceeding either the limit or the TTL": {
+			certificate: gen.CertificateFrom(baseCrt,
+				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}),
+				gen.SetCertificateRevisionHistoryLimit(2),
+				gen.SetCertificateRevisionHistoryTTL(time.Hour),
+			),
+			requests: []runtime.Object{
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-1"),
+					gen.SetCertificateRequestRevision("1"),
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(time.Now().Add(-3*time.Hour))),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-2"),
+					gen.SetCertificateRequestRevision("2"),
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(time.Now().Add(-2*time.Hour))),
+				),
+				gen.CertificateRequestFrom(baseCR,
+					gen.SetCertificateRequestName("cr-3"),
+					gen.SetCertificateRequestRevision("3"),
+					gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(time.Now())),
+				),
+			},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "cr-1")),
+				testpkg.NewAction(coretesting.NewDeleteAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "testns", "cr-2")),
+			},
+		},
 		"delete 1 request if limit is 1 and 2 requests exist": {
 			certificate: gen.CertificateFrom(baseCrt,
 				gen.SetCertificateStatusCondition(cmapi.CertificateCondition{Type: cmapi.CertificateConditionReady, Status: cmmeta.ConditionTrue}),
@@ -418,3 +468,60 @@ func TestCertificateRequestsToDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestExpiredCertificateRequests(t *testing.T) {
+	now := time.Date(2022, 1, 1, 12, 0, 0, 0, time.UTC)
+	request := func(name, rev string, age time.Duration) *cmapi.CertificateRequest {
+		return gen.CertificateRequest(name,
+			gen.SetCertificateRequestRevision(rev),
+			gen.SetCertificateRequestCreationTimestamp(metav1.NewTime(now.Add(-age))),
+		)
+	}
+	namedRevision := func(rev int, name string) revision {
+		return revision{rev, types.NamespacedName{Namespace: gen.DefaultTestNamespace, Name: name}}
+	}
+
+	tests := map[string]struct {
+		input         []*cmapi.CertificateRequest
+		expExpired    []revision
+		expNextExpiry time.Duration
+	}{
+		"an empty list of requests should return nothing": {},
+		"the latest revision is kept however old it is": {
+			input: []*cmapi.CertificateRequest{
+				request("cr-1", "1", 48*time.Hour),
+			},
+		},
+		"expired requests are returned oldest revision first with the time until the next expiry": {
+			input: []*cmapi.CertificateRequest{
+				request("cr-3", "3", 30*time.Hour),
+				request("cr-1", "1", 48*time.Hour),
+				request("cr-4", "4", 20*time.Hour),
+				request("cr-5", "5", time.Hour),
+				request("cr-6", "6", 0),
+			},
+			expExpired:    []revision{namedRevision(1, "cr-1"), namedRevision(3, "cr-3")},
+			expNextExpiry: 4 * time.Hour,
+		},
+		"requests without a valid revision are skipped": {
+			input: []*cmapi.CertificateRequest{
+				request("cr-1", "cert-manager", 48*time.Hour),
+				request("cr-2", "2", 48*time.Hour),
+				request("cr-3", "3", 0),
+			},
+			expExpired: []revision{namedRevision(2, "cr-2")},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			expired, nextExpiry := expiredCertificateRequests(24*time.Hour, now, test.input)
+			if !reflect.DeepEqual(test.expExpired, expired) {
+				t.Errorf("unexpected expired requests, exp=%v got=%v", test.expExpired, expired)
+			}
+			if nextExpiry != test.expNextExpiry {
+				t.Errorf("unexpected next expiry, exp=%s got=%s", test.expNextExpiry, nextExpiry)
+			}
+		})
+	}
+}
@@ -0,0 +1,196 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocationstatus
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+const (
+	// maxResponseSize is the maximum size of an OCSP response or CRL which
+	// will be read.
+	maxResponseSize = 16 << 20
+
+	// requestTimeout is the timeout for each request made to an OCSP
+	// responder or CRL distribution point.
+	requestTimeout = 30 * time.Second
+)
+
+// errNoRevocationSources is returned when a certificate lists neither an OCSP
+// responder nor a CRL distribution point.
+var errNoRevocationSources = errors.New("certificate does not list an OCSP responder or CRL distribution point")
+
+// revocationStatus is the revocation status of a certificate.
+type revocationStatus struct {
+	// revoked is true if the certificate has been revoked.
+	revoked bool
+	// revokedAt is the time at which the certificate was revoked.
+	revokedAt time.Time
+	// source is the URL of the OCSP responder or CRL which reported the
+	// status.
+	source string
+}
+
+// checker returns the revocation status of a certificate, given the
+// certificate of its issuer.
+type checker interface {
+	Check(ctx context.Context, cert, issuer *x509.Certificate) (revocationStatus, error)
+}
+
+// httpChecker checks the revocation status of certificates using the OCSP
+// responders and CRL distribution points listed in them. OCSP responders are
+// preferred, and CRLs are only fetched if no OCSP responder returned a
+// definitive status.
+type httpChecker struct {
+	client *http.Client
+	now    func() time.Time
+}
+
+func newHTTPChecker(now func() time.Time) *httpChecker {
+	return &httpChecker{
+		client: &http.Client{Timeout: requestTimeout},
+		now:    now,
+	}
+}
+
+func (c *httpChecker) Check(ctx context.Context, cert, issuer *x509.Certificate) (revocationStatus, error) {
+	if len(cert.OCSPServer) == 0 && len(cert.CRLDistributionPoints) == 0 {
+		return revocationStatus{}, errNoRevocationSources
+	}
+
+	var errs []error
+	for _, server := range cert.OCSPServer {
+		status, ok, err := c.checkOCSP(ctx, server, cert, issuer)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("OCSP responder %q: %w", server, err))
+			continue
+		}
+		if ok {
+			return status, nil
+		}
+	}
+
+	for _, url := range cert.CRLDistributionPoints {
+		status, err := c.checkCRL(ctx, url, cert, issuer)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("CRL %q: %w", url, err))
+			continue
+		}
+		return status, nil
+	}
+
+	if len(errs) == 0 {
+		return revocationStatus{}, errors.New("no OCSP responder returned a definitive status and the certificate does not list a CRL distribution point")
+	}
+	return revocationStatus{}, fmt.Errorf("failed to determine revocation status: %w", utilerrors.NewAggregate(errs))
+}
+
+// checkOCSP queries the OCSP responder for the status of the certificate. ok
+// is false if the responder does not know the status of the certificate.
+func (c *httpChecker) checkOCSP(ctx context.Context, server string, cert, issuer *x509.Certificate) (status revocationStatus, ok bool, err error) {
+	ocspReq, err := ocsp.CreateRequest(cert, issuer, nil)
+	if err != nil {
+		return revocationStatus{}, false, fmt.Errorf("error creating OCSP request: %w", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server, bytes.NewReader(ocspReq))
+	if err != nil {
+		return revocationStatus{}, false, err
+	}
+	req.Header.Set("Content-Type", "application/ocsp-request")
+	req.Header.Set("Accept", "application/ocsp-response")
+
+	body, err := c.do(req)
+	if err != nil {
+		return revocationStatus{}, false, err
+	}
+
+	// The signature of the response is verified against the issuer.
+	resp, err := ocsp.ParseResponseForCert(body, cert, issuer)
+	if err != nil {
+		return revocationStatus{}, false, fmt.Errorf("error parsing OCSP response: %w", err)
+	}
+	if !resp.NextUpdate.IsZero() && c.now().After(resp.NextUpdate) {
+		return revocationStatus{}, false, fmt.Errorf("OCSP response expired at %s", resp.NextUpdate.Format(time.RFC3339))
+	}
+
+	switch resp.Status {
+	case ocsp.Good:
+		return revocationStatus{source: server}, true, nil
+	case ocsp.Revoked:
+		return revocationStatus{revoked: true, revokedAt: resp.RevokedAt, source: server}, true, nil
+	default:
+		return revocationStatus{}, false, nil
+	}
+}
+
+// checkCRL fetches the CRL and looks up the certificate in it.
+func (c *httpChecker) checkCRL(ctx context.Context, url string, cert, issuer *x509.Certificate) (revocationStatus, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return revocationStatus{}, err
+	}
+
+	body, err := c.do(req)
+	if err != nil {
+		return revocationStatus{}, err
+	}
+
+	// ParseCRL accepts both PEM and DER encoded CRLs.
+	crl, err := x509.ParseCRL(body)
+	if err != nil {
+		return revocationStatus{}, fmt.Errorf("error parsing CRL: %w", err)
+	}
+	if err := issuer.CheckCRLSignature(crl); err != nil {
+		return revocationStatus{}, fmt.Errorf("error verifying CRL signature: %w", err)
+	}
+	if crl.HasExpired(c.now()) {
+		return revocationStatus{}, fmt.Errorf("CRL expired at %s", crl.TBSCertList.NextUpdate.Format(time.RFC3339))
+	}
+
+	for _, revoked := range crl.TBSCertList.RevokedCertificates {
+		if revoked.SerialNumber.Cmp(cert.SerialNumber) == 0 {
+			return revocationStatus{revoked: true, revokedAt: revoked.RevocationTime, source: url}, nil
+		}
+	}
+
+	return revocationStatus{source: url}, nil
+}
+
+func (c *httpChecker) do(req *http.Request) ([]byte, error) {
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+
+	return io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+}
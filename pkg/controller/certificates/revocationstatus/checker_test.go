@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocationstatus
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestHTTPChecker(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	revokedAt := now.Add(-time.Minute)
+
+	tests := map[string]struct {
+		ocspStatus     int
+		crlRevoked     bool
+		crlExpired     bool
+		noOCSP         bool
+		expectedStatus func(ocspURL, crlURL string) revocationStatus
+		expectedErr    bool
+	}{
+		"OCSP reports the certificate as good": {
+			ocspStatus: ocsp.Good,
+			expectedStatus: func(ocspURL, _ string) revocationStatus {
+				return revocationStatus{source: ocspURL}
+			},
+		},
+		"OCSP reports the certificate as revoked": {
+			ocspStatus: ocsp.Revoked,
+			expectedStatus: func(ocspURL, _ string) revocationStatus {
+				return revocationStatus{revoked: true, revokedAt: revokedAt, source: ocspURL}
+			},
+		},
+		"fall back to the CRL if OCSP does not know the certificate": {
+			ocspStatus: ocsp.Unknown,
+			crlRevoked: true,
+			expectedStatus: func(_, crlURL string) revocationStatus {
+				return revocationStatus{revoked: true, revokedAt: revokedAt, source: crlURL}
+			},
+		},
+		"use the CRL if there is no OCSP responder": {
+			noOCSP: true,
+			expectedStatus: func(_, crlURL string) revocationStatus {
+				return revocationStatus{source: crlURL}
+			},
+		},
+		"fail if the CRL has expired": {
+			noOCSP:      true,
+			crlExpired:  true,
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var bundle testPKI
+
+			ocspServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				resp, err := ocsp.CreateResponse(bundle.caCert, bundle.caCert, ocsp.Response{
+					Status:       test.ocspStatus,
+					SerialNumber: bundle.leaf.SerialNumber,
+					ThisUpdate:   now,
+					NextUpdate:   now.Add(time.Hour),
+					RevokedAt:    revokedAt,
+				}, bundle.caKey)
+				if err != nil {
+					t.Error(err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Write(resp)
+			}))
+			defer ocspServer.Close()
+
+			crlServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				var revoked []pki.RevokedCertificate
+				if test.crlRevoked {
+					revoked = append(revoked, pki.RevokedCertificate{SerialNumber: bundle.leaf.SerialNumber, RevocationTime: revokedAt})
+				}
+				nextUpdate := now.Add(time.Hour)
+				if test.crlExpired {
+					nextUpdate = now.Add(-time.Second)
+				}
+				crl, err := pki.CreateCRL(revoked, big.NewInt(1), now.Add(-time.Hour), nextUpdate, bundle.caCert, bundle.caKey)
+				if err != nil {
+					t.Error(err)
+					w.WriteHeader(http.StatusInternalServerError)
+					return
+				}
+				w.Write(crl)
+			}))
+			defer crlServer.Close()
+
+			var ocspServers []string
+			if !test.noOCSP {
+				ocspServers = []string{ocspServer.URL}
+			}
+			bundle = mustCreateTestPKI(t, now, ocspServers, []string{crlServer.URL})
+
+			c := newHTTPChecker(func() time.Time { return now })
+			status, err := c.Check(context.Background(), bundle.leaf, bundle.caCert)
+			if test.expectedErr != (err != nil) {
+				t.Fatalf("expected error: %v, got: %v", test.expectedErr, err)
+			}
+			if test.expectedErr {
+				return
+			}
+
+			expected := test.expectedStatus(ocspServer.URL, crlServer.URL)
+			if status.revoked != expected.revoked || status.source != expected.source || !status.revokedAt.Equal(expected.revokedAt) {
+				t.Errorf("expected status %+v, got %+v", expected, status)
+			}
+		})
+	}
+}
+
+func TestHTTPCheckerNoRevocationSources(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	bundle := mustCreateTestPKI(t, now, nil, nil)
+
+	_, err := newHTTPChecker(func() time.Time { return now }).Check(context.Background(), bundle.leaf, bundle.caCert)
+	if err != errNoRevocationSources {
+		t.Errorf("expected %v, got %v", errNoRevocationSources, err)
+	}
+}
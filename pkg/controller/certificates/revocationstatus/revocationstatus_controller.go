@@ -0,0 +1,362 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocationstatus
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate revocation status
+	// controller.
+	ControllerName = "certificates-revocation-status"
+
+	reasonRevoked    = "Revoked"
+	reasonNotRevoked = "NotRevoked"
+)
+
+// This controller periodically checks the revocation status of the
+// certificate stored in the Secret named in `spec.secretName` of every
+// Certificate, using the OCSP responders and CRL distribution points listed
+// in the certificate. A revoked certificate is reported using the `Revoked`
+// status condition and metric, and a re-issuance is triggered by adding the
+// `Issuing` status condition.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	secretLister       corelisters.SecretLister
+	client             cmclient.Interface
+	kubeClient         kubernetes.Interface
+	recorder           record.EventRecorder
+	clock              clock.Clock
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+	checker            checker
+
+	// checkInterval is how often the revocation status of each
+	// Certificate's certificate is checked.
+	checkInterval time.Duration
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate revocation status controller.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	checkInterval time.Duration,
+	fieldManager string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		client:             client,
+		kubeClient:         kubeClient,
+		recorder:           recorder,
+		clock:              clock,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		checker:            newHTTPChecker(clock.Now),
+		checkInterval:      checkInterval,
+		fieldManager:       fieldManager,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	// Ensure the Certificate is re-checked periodically, as certificates
+	// can be revoked at any time. Failures to determine the revocation
+	// status are also retried on the next check, rather than with the
+	// workqueue's back-off, to avoid overloading OCSP responders.
+	defer c.scheduledWorkQueue.Add(key, c.checkInterval)
+
+	if isIssuing(crt) {
+		// The certificate is about to be replaced.
+		return nil
+	}
+
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		// A missing Secret is handled by the trigger controller.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	cert, issuer, err := certificateAndIssuer(secret)
+	if err != nil {
+		// An invalid Secret is handled by the trigger controller.
+		log.V(logf.DebugLevel).Info("unable to check revocation status", "error", err.Error())
+		return nil
+	}
+	if c.clock.Now().After(cert.NotAfter) {
+		// Expired certificates may no longer be listed by the issuer, and
+		// are re-issued by the trigger controller.
+		return nil
+	}
+
+	status, err := c.checker.Check(ctx, cert, issuer)
+	if errors.Is(err, errNoRevocationSources) {
+		log.V(logf.DebugLevel).Info("unable to check revocation status", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		log.Error(err, "failed to check revocation status")
+		return nil
+	}
+
+	if !status.revoked {
+		cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionRevoked)
+		if cond == nil || cond.Status == cmmeta.ConditionFalse {
+			return nil
+		}
+		return c.setCondition(ctx, crt, cmmeta.ConditionFalse, reasonNotRevoked, "Certificate has not been revoked", false)
+	}
+
+	// The informer caches may be lagging behind an issuance which has just
+	// completed, so confirm that the revoked certificate is still current
+	// before triggering a re-issuance.
+	crt, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Get(ctx, crt.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	liveSecret, err := c.kubeClient.CoreV1().Secrets(crt.Namespace).Get(ctx, crt.Spec.SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if isIssuing(crt) || !bytes.Equal(liveSecret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSCertKey]) {
+		return nil
+	}
+
+	message := fmt.Sprintf("Certificate with serial number %s was revoked at %s according to %s",
+		cert.SerialNumber.Text(16), status.revokedAt.UTC().Format(time.RFC3339), status.source)
+	log.V(logf.InfoLevel).Info("certificate has been revoked", "message", message)
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionRevoked); cond == nil ||
+		cond.Status != cmmeta.ConditionTrue || cond.Message != message {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonRevoked, message)
+	}
+
+	return c.setCondition(ctx, crt, cmmeta.ConditionTrue, reasonRevoked, message, true)
+}
+
+// certificateAndIssuer returns the certificate stored in the Secret, and the
+// certificate of its issuer, which is taken from either the rest of the chain
+// or the CA certificate stored in the Secret.
+func certificateAndIssuer(secret *corev1.Secret) (*x509.Certificate, *x509.Certificate, error) {
+	chain, err := pki.DecodeX509CertificateChainBytes(secret.Data[corev1.TLSCertKey])
+	if err != nil {
+		return nil, nil, err
+	}
+	cert, candidates := chain[0], chain[1:]
+
+	if caPEM := secret.Data[cmmeta.TLSCAKey]; len(caPEM) > 0 {
+		cas, err := pki.DecodeX509CertificateChainBytes(caPEM)
+		if err != nil {
+			return nil, nil, err
+		}
+		candidates = append(candidates, cas...)
+	}
+
+	for _, candidate := range candidates {
+		if cert.CheckSignatureFrom(candidate) == nil {
+			return cert, candidate, nil
+		}
+	}
+
+	return nil, nil, errors.New("the certificate of the issuer is not stored in the Secret")
+}
+
+// setCondition sets the Revoked condition on the Certificate, and the Issuing
+// condition if reissue is true.
+func (c *controller) setCondition(ctx context.Context, crt *cmapi.Certificate, status cmmeta.ConditionStatus, reason, message string, reissue bool) error {
+	oldCrt := crt
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionRevoked, status, reason, message)
+
+	var issuingMessage string
+	if reissue {
+		issuingMessage = fmt.Sprintf("Re-issuing certificate as it has been revoked: %s", message)
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reasonRevoked, issuingMessage)
+	}
+
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+	if err := c.updateOrApplyStatus(ctx, crt, reissue); err != nil {
+		return err
+	}
+
+	if reissue {
+		c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", issuingMessage)
+	}
+	return nil
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate, reissue bool) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		var conditions []cmapi.CertificateCondition
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionRevoked); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); reissue && cond != nil {
+			conditions = append(conditions, *cond)
+		}
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: conditions},
+		})
+	} else {
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+}
+
+func isIssuing(crt *cmapi.Certificate) bool {
+	return apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionIssuing,
+		Status: cmmeta.ConditionTrue,
+	})
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		ctx.CertificateOptions.RevocationStatusCheckInterval,
+		ctx.FieldManager,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
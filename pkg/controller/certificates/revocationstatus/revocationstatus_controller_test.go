@@ -0,0 +1,256 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocationstatus
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+type fakeChecker struct {
+	status revocationStatus
+	err    error
+}
+
+func (f *fakeChecker) Check(context.Context, *x509.Certificate, *x509.Certificate) (revocationStatus, error) {
+	return f.status, f.err
+}
+
+// testPKI is a CA and a leaf certificate signed by it.
+type testPKI struct {
+	caCert  *x509.Certificate
+	caKey   crypto.Signer
+	caPEM   []byte
+	leaf    *x509.Certificate
+	leafPEM []byte
+}
+
+func mustCreateTestPKI(t *testing.T, now time.Time, ocspServers, crlDistributionPoints []string) testPKI {
+	caKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caPEM, caCert, err := pki.SignCertificate(caTmpl, caTmpl, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(0x1234),
+		Subject:               pkix.Name{CommonName: "example.com"},
+		DNSNames:              []string{"example.com"},
+		NotBefore:             now.Add(-time.Hour),
+		NotAfter:              now.Add(12 * time.Hour),
+		OCSPServer:            ocspServers,
+		CRLDistributionPoints: crlDistributionPoints,
+	}
+	leafPEM, leaf, err := pki.SignCertificate(leafTmpl, caCert, leafKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return testPKI{caCert: caCert, caKey: caKey, caPEM: caPEM, leaf: leaf, leafPEM: leafPEM}
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	fixedNow := metav1.NewTime(now)
+	revokedAt := now.Add(-time.Minute)
+
+	bundle := mustCreateTestPKI(t, now, []string{"http://ocsp.example.com"}, nil)
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	secret := gen.Secret("test-tls",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretData(map[string][]byte{
+			corev1.TLSCertKey: bundle.leafPEM,
+			cmmeta.TLSCAKey:   bundle.caPEM,
+		}),
+	)
+
+	revokedStatus := revocationStatus{revoked: true, revokedAt: revokedAt, source: "http://ocsp.example.com"}
+	revokedMessage := "Certificate with serial number 1234 was revoked at " + revokedAt.Format(time.RFC3339) + " according to http://ocsp.example.com"
+	revokedCondition := cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionRevoked,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             reasonRevoked,
+		Message:            revokedMessage,
+		LastTransitionTime: &fixedNow,
+	}
+
+	tests := map[string]struct {
+		crt            *cmapi.Certificate
+		existingKube   []runtime.Object
+		checker        *fakeChecker
+		expectedEvents []string
+		expectedAction []testpkg.Action
+	}{
+		"do nothing if an issuance is in progress": {
+			crt: gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:   cmapi.CertificateConditionIssuing,
+				Status: cmmeta.ConditionTrue,
+			})),
+			existingKube: []runtime.Object{secret},
+			checker:      &fakeChecker{status: revokedStatus},
+		},
+		"do nothing if the secret does not exist": {
+			crt:     baseCrt,
+			checker: &fakeChecker{status: revokedStatus},
+		},
+		"do nothing if the issuer certificate is not stored in the secret": {
+			crt: baseCrt,
+			existingKube: []runtime.Object{gen.SecretFrom(secret, gen.SetSecretData(map[string][]byte{
+				corev1.TLSCertKey: bundle.leafPEM,
+			}))},
+			checker: &fakeChecker{status: revokedStatus},
+		},
+		"do nothing if the revocation status cannot be determined": {
+			crt:          baseCrt,
+			existingKube: []runtime.Object{secret},
+			checker:      &fakeChecker{err: errors.New("connection refused")},
+		},
+		"do nothing if the certificate has not been revoked": {
+			crt:          baseCrt,
+			existingKube: []runtime.Object{secret},
+			checker:      &fakeChecker{status: revocationStatus{source: "http://ocsp.example.com"}},
+		},
+		"mark the certificate as revoked and trigger a re-issuance": {
+			crt:          baseCrt,
+			existingKube: []runtime.Object{secret},
+			checker:      &fakeChecker{status: revokedStatus},
+			expectedEvents: []string{
+				"Warning Revoked " + revokedMessage,
+				"Normal Issuing Re-issuing certificate as it has been revoked: " + revokedMessage,
+			},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewGetAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", "test")),
+				testpkg.NewAction(coretesting.NewGetAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", "test-tls")),
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt,
+						gen.SetCertificateStatusCondition(revokedCondition),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:               cmapi.CertificateConditionIssuing,
+							Status:             cmmeta.ConditionTrue,
+							Reason:             reasonRevoked,
+							Message:            "Re-issuing certificate as it has been revoked: " + revokedMessage,
+							LastTransitionTime: &fixedNow,
+						}),
+					),
+				)),
+			},
+		},
+		"clear the condition once the certificate is no longer revoked": {
+			crt:          gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(revokedCondition)),
+			existingKube: []runtime.Object{secret},
+			checker:      &fakeChecker{status: revocationStatus{source: "http://ocsp.example.com"}},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+						Type:               cmapi.CertificateConditionRevoked,
+						Status:             cmmeta.ConditionFalse,
+						Reason:             reasonNotRevoked,
+						Message:            "Certificate has not been revoked",
+						LastTransitionTime: &fixedNow,
+					})),
+				)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.crt},
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedAction,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.CertificateOptions.RevocationStatusCheckInterval = time.Hour
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			w.controller.checker = test.checker
+			var gotScheduled time.Duration
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.controller.ProcessItem(context.Background(), key)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != time.Hour {
+				t.Errorf("expected the certificate to be re-checked in %v, got %v", time.Hour, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdrift
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
+	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate secret drift controller.
+	ControllerName = "certificates-secret-drift"
+
+	reasonSecretDrift = "SecretDrift"
+	reasonUpToDate    = "UpToDate"
+)
+
+// This controller periodically re-checks the Secret named in
+// `spec.secretName` of every Certificate against the Certificate and the
+// CertificateRequest which issued it. Divergence which cannot be explained by
+// an issuance, such as a manually edited Secret or one restored from an old
+// backup, is reported using the `SecretDrift` status condition and metric.
+// If auto-repair is enabled, a re-issuance is also triggered by adding the
+// `Issuing` status condition.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	client             cmclient.Interface
+	kubeClient         kubernetes.Interface
+	recorder           record.EventRecorder
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+	gatherer           *policies.Gatherer
+	policyChain        policies.Chain
+
+	// checkInterval is how often each Certificate's Secret is re-checked.
+	checkInterval time.Duration
+
+	// autoRepair triggers a re-issuance of Certificates whose Secret has
+	// drifted.
+	autoRepair bool
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Apply API calls.
+	fieldManager string
+}
+
+// NewController returns a new certificate secret drift controller.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	checkInterval time.Duration,
+	autoRepair bool,
+	fieldManager string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// When a Secret resource changes, enqueue any Certificate resources that name it as spec.secretName.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+			predicate.ExtractResourceName(predicate.CertificateSecretName)),
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		client:             client,
+		kubeClient:         kubeClient,
+		recorder:           recorder,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		gatherer: &policies.Gatherer{
+			CertificateRequestLister: certificateRequestInformer.Lister(),
+			SecretLister:             secretsInformer.Lister(),
+		},
+		policyChain:   policies.NewSecretDriftPolicyChain(),
+		checkInterval: checkInterval,
+		autoRepair:    autoRepair,
+		fieldManager:  fieldManager,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("certificate not found for key", "error", err.Error())
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	// Ensure the Certificate is re-checked periodically, as drift is often
+	// caused by changes that are made whilst cert-manager is not running.
+	defer c.scheduledWorkQueue.Add(key, c.checkInterval)
+
+	if isIssuing(crt) {
+		// The Secret is expected to change whilst an issuance is in progress.
+		return nil
+	}
+
+	input, err := c.gatherer.DataForCertificate(ctx, crt)
+	if err != nil {
+		return err
+	}
+	if input.Secret == nil {
+		// A missing Secret is handled by the trigger controller.
+		return nil
+	}
+
+	reason, message, drifted := c.policyChain.Evaluate(input)
+	if drifted && !c.driftReported(crt, reason, message) {
+		// The informer caches may be lagging behind an issuance which has
+		// just completed, so confirm the drift using the current state of
+		// the Certificate and Secret before reporting it. This is only done
+		// when the Certificate's status is about to change, so that a Secret
+		// which stays drifted does not cause API requests on every check.
+		input, err = c.liveDataForCertificate(ctx, crt)
+		if err != nil {
+			return err
+		}
+		if input.Certificate == nil || input.Secret == nil || isIssuing(input.Certificate) {
+			return nil
+		}
+		crt = input.Certificate
+		reason, message, drifted = c.policyChain.Evaluate(input)
+	}
+
+	if !drifted {
+		cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionSecretDrift)
+		if cond == nil || cond.Status == cmmeta.ConditionFalse {
+			return nil
+		}
+		return c.setCondition(ctx, crt, cmmeta.ConditionFalse, reasonUpToDate, "Secret is consistent with the Certificate", false)
+	}
+
+	log.V(logf.InfoLevel).Info("Secret has drifted from the Certificate", "reason", reason, "message", message)
+	if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionSecretDrift); cond == nil ||
+		cond.Status != cmmeta.ConditionTrue || cond.Message != message {
+		c.recorder.Event(crt, corev1.EventTypeWarning, reasonSecretDrift, message)
+	}
+
+	return c.setCondition(ctx, crt, cmmeta.ConditionTrue, reason, message, c.autoRepair)
+}
+
+// driftReported returns true if the Certificate's status already reports the
+// given drift, so that reporting it again would not change the Certificate.
+func (c *controller) driftReported(crt *cmapi.Certificate, reason, message string) bool {
+	if c.autoRepair {
+		// A re-issuance would be triggered, as the Certificate is known not
+		// to be issuing.
+		return false
+	}
+	cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionSecretDrift)
+	return cond != nil && cond.Status == cmmeta.ConditionTrue && cond.Reason == reason && cond.Message == message
+}
+
+// liveDataForCertificate gathers the policy input for the Certificate using
+// the current Certificate and Secret read from the API server. A nil
+// Certificate or Secret is returned if either no longer exists.
+func (c *controller) liveDataForCertificate(ctx context.Context, crt *cmapi.Certificate) (policies.Input, error) {
+	crt, err := c.client.CertmanagerV1().Certificates(crt.Namespace).Get(ctx, crt.Name, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return policies.Input{}, nil
+	}
+	if err != nil {
+		return policies.Input{}, err
+	}
+
+	secret, err := c.kubeClient.CoreV1().Secrets(crt.Namespace).Get(ctx, crt.Spec.SecretName, metav1.GetOptions{})
+	if apierrors.IsNotFound(err) {
+		return policies.Input{Certificate: crt}, nil
+	}
+	if err != nil {
+		return policies.Input{}, err
+	}
+
+	input, err := c.gatherer.DataForCertificate(ctx, crt)
+	if err != nil {
+		return policies.Input{}, err
+	}
+	input.Secret = secret
+	return input, nil
+}
+
+// setCondition sets the SecretDrift condition on the Certificate, and the
+// Issuing condition if reissue is true.
+func (c *controller) setCondition(ctx context.Context, crt *cmapi.Certificate, status cmmeta.ConditionStatus, reason, message string, reissue bool) error {
+	oldCrt := crt
+	crt = crt.DeepCopy()
+	apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionSecretDrift, status, reason, message)
+
+	var issuingMessage string
+	if reissue {
+		issuingMessage = fmt.Sprintf("Re-issuing certificate as Secret has drifted: %s", message)
+		apiutil.SetCertificateCondition(crt, crt.Generation, cmapi.CertificateConditionIssuing, cmmeta.ConditionTrue, reasonSecretDrift, issuingMessage)
+	}
+
+	if apiequality.Semantic.DeepEqual(oldCrt.Status, crt.Status) {
+		return nil
+	}
+	if err := c.updateOrApplyStatus(ctx, crt, reissue); err != nil {
+		return err
+	}
+
+	if reissue {
+		c.recorder.Event(crt, corev1.EventTypeNormal, "Issuing", issuingMessage)
+	}
+	return nil
+}
+
+// updateOrApplyStatus will update the controller status. If the
+// ServerSideApply feature is enabled, the managed fields will instead get
+// applied using the relevant Patch API call.
+func (c *controller) updateOrApplyStatus(ctx context.Context, crt *cmapi.Certificate, reissue bool) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		var conditions []cmapi.CertificateCondition
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionSecretDrift); cond != nil {
+			conditions = append(conditions, *cond)
+		}
+		if cond := apiutil.GetCertificateCondition(crt, cmapi.CertificateConditionIssuing); reissue && cond != nil {
+			conditions = append(conditions, *cond)
+		}
+		return internalcertificates.ApplyStatus(ctx, c.client, c.fieldManager, &cmapi.Certificate{
+			ObjectMeta: metav1.ObjectMeta{Namespace: crt.Namespace, Name: crt.Name},
+			Status:     cmapi.CertificateStatus{Conditions: conditions},
+		})
+	} else {
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).UpdateStatus(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+}
+
+func isIssuing(crt *cmapi.Certificate) bool {
+	return apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
+		Type:   cmapi.CertificateConditionIssuing,
+		Status: cmmeta.ConditionTrue,
+	})
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		ctx.CertificateOptions.SecretDriftCheckInterval,
+		ctx.CertificateOptions.SecretDriftAutoRepair,
+		ctx.FieldManager,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretdrift
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/certificates/policies"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	testcrypto "github.com/cert-manager/cert-manager/test/unit/crypto"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	fixedNow := metav1.NewTime(now)
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateCommonName("example.com"),
+		gen.SetCertificateDNSNames("example.com"),
+	)
+	pk := testcrypto.MustCreatePEMPrivateKey(t)
+	cert := testcrypto.MustCreateCert(t, pk, baseCrt)
+	secret := gen.Secret("test-tls",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretData(map[string][]byte{
+			corev1.TLSPrivateKeyKey: pk,
+			corev1.TLSCertKey:       cert,
+		}),
+	)
+
+	// The Secret no longer matches a Certificate with an additional DNS name.
+	driftedCrt := gen.CertificateFrom(baseCrt, gen.SetCertificateDNSNames("example.com", "www.example.com"))
+	driftMessage := "Existing issued Secret is not up to date for spec: [spec.dnsNames]"
+	driftCondition := cmapi.CertificateCondition{
+		Type:               cmapi.CertificateConditionSecretDrift,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             policies.SecretMismatch,
+		Message:            driftMessage,
+		LastTransitionTime: &fixedNow,
+	}
+
+	getActions := []testpkg.Action{
+		testpkg.NewAction(coretesting.NewGetAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", "test")),
+		testpkg.NewAction(coretesting.NewGetAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", "test-tls")),
+	}
+
+	tests := map[string]struct {
+		crt            *cmapi.Certificate
+		existingKube   []runtime.Object
+		autoRepair     bool
+		expectedEvents []string
+		expectedAction []testpkg.Action
+	}{
+		"do nothing if an issuance is in progress": {
+			crt: gen.CertificateFrom(driftedCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:   cmapi.CertificateConditionIssuing,
+				Status: cmmeta.ConditionTrue,
+			})),
+			existingKube: []runtime.Object{secret},
+		},
+		"do nothing if the secret does not exist": {
+			crt: driftedCrt,
+		},
+		"do nothing if the secret has not drifted": {
+			crt:          baseCrt,
+			existingKube: []runtime.Object{secret},
+		},
+		"mark the certificate as drifted if the secret no longer matches": {
+			crt:            driftedCrt,
+			existingKube:   []runtime.Object{secret},
+			expectedEvents: []string{"Warning SecretDrift " + driftMessage},
+			expectedAction: append(getActions,
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(driftedCrt, gen.SetCertificateStatusCondition(driftCondition)),
+				)),
+			),
+		},
+		"do not read from the API server if the drift has already been reported": {
+			crt:          gen.CertificateFrom(driftedCrt, gen.SetCertificateStatusCondition(driftCondition)),
+			existingKube: []runtime.Object{secret},
+		},
+		"re-check the drift if its reason has changed": {
+			crt: gen.CertificateFrom(driftedCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+				Type:               cmapi.CertificateConditionSecretDrift,
+				Status:             cmmeta.ConditionTrue,
+				Reason:             policies.SecretMismatch,
+				Message:            "Existing issued Secret is not up to date for spec: [spec.commonName]",
+				LastTransitionTime: &fixedNow,
+			})),
+			existingKube:   []runtime.Object{secret},
+			expectedEvents: []string{"Warning SecretDrift " + driftMessage},
+			expectedAction: append(getActions,
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(driftedCrt, gen.SetCertificateStatusCondition(driftCondition)),
+				)),
+			),
+		},
+		"trigger a re-issuance if auto-repair is enabled": {
+			crt:          driftedCrt,
+			existingKube: []runtime.Object{secret},
+			autoRepair:   true,
+			expectedEvents: []string{
+				"Warning SecretDrift " + driftMessage,
+				"Normal Issuing Re-issuing certificate as Secret has drifted: " + driftMessage,
+			},
+			expectedAction: append(getActions,
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(driftedCrt,
+						gen.SetCertificateStatusCondition(driftCondition),
+						gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+							Type:               cmapi.CertificateConditionIssuing,
+							Status:             cmmeta.ConditionTrue,
+							Reason:             reasonSecretDrift,
+							Message:            "Re-issuing certificate as Secret has drifted: " + driftMessage,
+							LastTransitionTime: &fixedNow,
+						}),
+					),
+				)),
+			),
+		},
+		"clear the condition once the secret no longer drifts": {
+			crt:          gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(driftCondition)),
+			existingKube: []runtime.Object{secret},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificates"), "status", "testns",
+					gen.CertificateFrom(baseCrt, gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+						Type:               cmapi.CertificateConditionSecretDrift,
+						Status:             cmmeta.ConditionFalse,
+						Reason:             reasonUpToDate,
+						Message:            "Secret is consistent with the Certificate",
+						LastTransitionTime: &fixedNow,
+					})),
+				)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.crt},
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedAction,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.CertificateOptions.SecretDriftCheckInterval = time.Hour
+			builder.Context.CertificateOptions.SecretDriftAutoRepair = test.autoRepair
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			var gotScheduled time.Duration
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			key, err := controllerpkg.KeyFunc(test.crt)
+			if err != nil {
+				t.Fatal(err)
+			}
+			err = w.controller.ProcessItem(context.Background(), key)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != time.Hour {
+				t.Errorf("expected the certificate to be re-checked in %v, got %v", time.Hour, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,413 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretreplication
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/predicate"
+)
+
+const (
+	// ControllerName is the name of the certificate secret replication
+	// controller.
+	ControllerName = "certificates-secret-replication"
+
+	reasonReplicated          = "SecretReplicated"
+	reasonReplicationDenied   = "SecretReplicationDenied"
+	reasonReplicationConflict = "SecretReplicationConflict"
+)
+
+// This controller replicates the Secret named in `spec.secretName` of
+// Certificates which set `spec.secretTargets` into the targeted namespaces.
+// Owner references cannot cross namespaces, so each replica is instead
+// labelled as a replica and annotated with the Certificate it was copied from.
+// Replicas are deleted once their namespace is no longer targeted, or the
+// Certificate is deleted.
+//
+// Namespaces must opt in to receiving replicas using the
+// `cert-manager.io/allow-secret-replication-from` annotation, as otherwise
+// anyone able to create a Certificate could write Secrets into any namespace.
+type controller struct {
+	certificateLister cmlisters.CertificateLister
+	secretLister      corelisters.SecretLister
+	namespaceLister   corelisters.NamespaceLister
+	kubeClient        kubernetes.Interface
+	recorder          record.EventRecorder
+}
+
+// NewController returns a new certificate secret replication controller.
+func NewController(
+	log logr.Logger,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+	namespacesInformer := factory.Core().V1().Namespaces()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// When a Secret resource changes, enqueue any Certificate resources that
+	// name it as spec.secretName, or that it is a replica of.
+	enqueueCertificatesForSecret := certificates.EnqueueCertificatesForResourceUsingPredicates(log, queue, certificateInformer.Lister(), labels.Everything(),
+		predicate.ExtractResourceName(predicate.CertificateSecretName))
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			if key, ok := secret.Annotations[cmapi.SecretReplicaOfAnnotationKey]; ok {
+				queue.Add(key)
+				return
+			}
+			enqueueCertificatesForSecret(obj)
+		},
+	})
+
+	// When a Namespace resource changes, it may have started or stopped being
+	// targeted, so enqueue all Certificate resources with Secret targets.
+	namespacesInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			crts, err := certificateInformer.Lister().List(labels.Everything())
+			if err != nil {
+				log.Error(err, "failed listing Certificate resources")
+				return
+			}
+			for _, crt := range crts {
+				if crt.Spec.SecretTargets == nil {
+					continue
+				}
+				key, err := controllerpkg.KeyFunc(crt)
+				if err != nil {
+					log.Error(err, "error computing key for resource")
+					continue
+				}
+				queue.Add(key)
+			}
+		},
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		namespacesInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister: certificateInformer.Lister(),
+		secretLister:      secretsInformer.Lister(),
+		namespaceLister:   namespacesInformer.Lister(),
+		kubeClient:        kubeClient,
+		recorder:          recorder,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		// The Certificate has been deleted, so delete all of its replicas.
+		return c.deleteStaleReplicas(ctx, key, "", nil)
+	}
+	if err != nil {
+		return err
+	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
+	targets, err := c.targetNamespaces(ctx, crt)
+	if err != nil {
+		return err
+	}
+
+	source, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	// Only replicate the Secret once a certificate has been issued into it.
+	// Existing replicas are kept whilst the Secret is missing, as it may be
+	// about to be re-issued.
+	if source != nil && len(source.Data[corev1.TLSCertKey]) > 0 {
+		for _, ns := range targets.List() {
+			if err := c.replicate(ctx, key, crt, source, ns); err != nil {
+				return err
+			}
+		}
+	}
+
+	return c.deleteStaleReplicas(ctx, key, crt.Spec.SecretName, targets)
+}
+
+// targetNamespaces returns the namespaces that the Certificate's Secret
+// should be replicated into. Namespaces which are targeted but do not allow
+// replication from the Certificate's namespace are excluded.
+func (c *controller) targetNamespaces(ctx context.Context, crt *cmapi.Certificate) (sets.String, error) {
+	log := logf.FromContext(ctx)
+
+	targets := sets.NewString()
+	if crt.Spec.SecretTargets == nil {
+		return targets, nil
+	}
+
+	var candidates []*corev1.Namespace
+	for _, name := range crt.Spec.SecretTargets.Namespaces {
+		ns, err := c.namespaceLister.Get(name)
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		candidates = append(candidates, ns)
+	}
+	if crt.Spec.SecretTargets.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(crt.Spec.SecretTargets.NamespaceSelector)
+		if err != nil {
+			// The selector is validated by the webhook, so do not retry.
+			log.Error(err, "invalid namespaceSelector in spec.secretTargets")
+		} else {
+			namespaces, err := c.namespaceLister.List(selector)
+			if err != nil {
+				return nil, err
+			}
+			candidates = append(candidates, namespaces...)
+		}
+	}
+
+	var denied []string
+	for _, ns := range candidates {
+		if ns.Name == crt.Namespace || ns.DeletionTimestamp != nil || targets.Has(ns.Name) {
+			continue
+		}
+		if !replicationAllowed(ns, crt.Namespace) {
+			denied = append(denied, ns.Name)
+			continue
+		}
+		targets.Insert(ns.Name)
+	}
+
+	if len(denied) > 0 {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonReplicationDenied,
+			"Secret is not replicated into namespaces %s as they do not allow replication from namespace %q using the %s annotation",
+			strings.Join(sets.NewString(denied...).List(), ", "), crt.Namespace, cmapi.AllowSecretReplicationFromAnnotationKey)
+	}
+	return targets, nil
+}
+
+// replicate creates or updates the replica of the source Secret in the
+// namespace. Secrets which are not replicas of the Certificate are never
+// overwritten.
+func (c *controller) replicate(ctx context.Context, key string, crt *cmapi.Certificate, source *corev1.Secret, namespace string) error {
+	log := logf.FromContext(ctx).WithValues("namespace", namespace)
+
+	replica := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        source.Name,
+			Namespace:   namespace,
+			Labels:      make(map[string]string),
+			Annotations: make(map[string]string),
+		},
+		Type: source.Type,
+		Data: source.Data,
+	}
+	if crt.Spec.SecretTemplate != nil {
+		for k, v := range crt.Spec.SecretTemplate.Labels {
+			replica.Labels[k] = v
+		}
+		for k, v := range crt.Spec.SecretTemplate.Annotations {
+			replica.Annotations[k] = v
+		}
+	}
+	replica.Labels[cmapi.IsSecretReplicaLabelKey] = "true"
+	replica.Annotations[cmapi.SecretReplicaOfAnnotationKey] = key
+
+	existing, err := c.secretLister.Secrets(namespace).Get(source.Name)
+	if apierrors.IsNotFound(err) {
+		log.V(logf.DebugLevel).Info("creating Secret replica")
+		if _, err := c.kubeClient.CoreV1().Secrets(namespace).Create(ctx, replica, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create replica of Secret in namespace %q: %w", namespace, err)
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonReplicated, "Replicated Secret %q into namespace %q", source.Name, namespace)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if existing.Annotations[cmapi.SecretReplicaOfAnnotationKey] != key {
+		c.recorder.Eventf(crt, corev1.EventTypeWarning, reasonReplicationConflict,
+			"Secret is not replicated into namespace %q as a Secret named %q which is not a replica of this Certificate already exists", namespace, source.Name)
+		return nil
+	}
+
+	if existing.Type != replica.Type {
+		// The type of a Secret is immutable, so the replica is re-created.
+		log.V(logf.DebugLevel).Info("re-creating Secret replica as its type has changed")
+		err := c.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, existing.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(existing.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete replica of Secret in namespace %q: %w", namespace, err)
+		}
+		if _, err := c.kubeClient.CoreV1().Secrets(namespace).Create(ctx, replica, metav1.CreateOptions{}); err != nil {
+			return fmt.Errorf("failed to create replica of Secret in namespace %q: %w", namespace, err)
+		}
+		c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonReplicated, "Replicated Secret %q into namespace %q", source.Name, namespace)
+		return nil
+	}
+
+	updated := existing.DeepCopy()
+	updated.Data = replica.Data
+	if updated.Labels == nil {
+		updated.Labels = make(map[string]string)
+	}
+	for k, v := range replica.Labels {
+		updated.Labels[k] = v
+	}
+	if updated.Annotations == nil {
+		updated.Annotations = make(map[string]string)
+	}
+	for k, v := range replica.Annotations {
+		updated.Annotations[k] = v
+	}
+	if apiequality.Semantic.DeepEqual(existing, updated) {
+		return nil
+	}
+
+	log.V(logf.DebugLevel).Info("updating Secret replica")
+	if _, err := c.kubeClient.CoreV1().Secrets(namespace).Update(ctx, updated, metav1.UpdateOptions{}); err != nil {
+		return fmt.Errorf("failed to update replica of Secret in namespace %q: %w", namespace, err)
+	}
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonReplicated, "Replicated Secret %q into namespace %q", source.Name, namespace)
+	return nil
+}
+
+// deleteStaleReplicas deletes the replicas of the Certificate identified by
+// key, except for those named secretName in the target namespaces.
+func (c *controller) deleteStaleReplicas(ctx context.Context, key, secretName string, targets sets.String) error {
+	log := logf.FromContext(ctx)
+
+	replicas, err := c.secretLister.List(labels.SelectorFromSet(labels.Set{cmapi.IsSecretReplicaLabelKey: "true"}))
+	if err != nil {
+		return err
+	}
+
+	for _, replica := range replicas {
+		if replica.Annotations[cmapi.SecretReplicaOfAnnotationKey] != key {
+			continue
+		}
+		if replica.Name == secretName && targets.Has(replica.Namespace) {
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("deleting Secret replica as its namespace is no longer targeted", "namespace", replica.Namespace, "secret", replica.Name)
+		err := c.kubeClient.CoreV1().Secrets(replica.Namespace).Delete(ctx, replica.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(replica.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete replica of Secret in namespace %q: %w", replica.Namespace, err)
+		}
+	}
+	return nil
+}
+
+// replicationAllowed returns true if the namespace allows Secrets to be
+// replicated into it from the given namespace.
+func replicationAllowed(ns *corev1.Namespace, from string) bool {
+	for _, allowed := range strings.Split(ns.Annotations[cmapi.AllowSecretReplicationFromAnnotationKey], ",") {
+		allowed = strings.TrimSpace(allowed)
+		if allowed == "*" || allowed == from {
+			return true
+		}
+	}
+	return false
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
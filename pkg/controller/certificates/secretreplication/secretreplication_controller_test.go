@@ -0,0 +1,208 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretreplication
+
+import (
+	"context"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	namespace := func(name string, labels, annotations map[string]string) *corev1.Namespace {
+		return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels, Annotations: annotations}}
+	}
+	allowed := map[string]string{cmapi.AllowSecretReplicationFromAnnotationKey: "other, testns"}
+	targetNS := namespace("target", nil, allowed)
+	selectedNS := namespace("selected", map[string]string{"tls": "true"}, map[string]string{cmapi.AllowSecretReplicationFromAnnotationKey: "*"})
+	deniedNS := namespace("denied", nil, nil)
+
+	crt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.SetCertificateSecretTargets(cmapi.CertificateSecretTargets{Namespaces: []string{"target"}}),
+	)
+
+	data := map[string][]byte{corev1.TLSCertKey: []byte("cert"), corev1.TLSPrivateKeyKey: []byte("key")}
+	secret := gen.Secret("test-tls",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "test"}),
+		gen.SetSecretType(corev1.SecretTypeTLS),
+		gen.SetSecretData(data),
+	)
+	replica := func(namespace string) *corev1.Secret {
+		return gen.Secret("test-tls",
+			gen.SetSecretNamespace(namespace),
+			gen.SetSecretLabels(map[string]string{cmapi.IsSecretReplicaLabelKey: "true"}),
+			gen.SetSecretAnnotations(map[string]string{cmapi.SecretReplicaOfAnnotationKey: "testns/test"}),
+			gen.SetSecretType(corev1.SecretTypeTLS),
+			gen.SetSecretData(data),
+		)
+	}
+
+	tests := map[string]struct {
+		existingCM      []runtime.Object
+		existingKube    []runtime.Object
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+	}{
+		"replicate the secret into a listed namespace": {
+			existingCM:     []runtime.Object{crt},
+			existingKube:   []runtime.Object{targetNS, secret},
+			expectedEvents: []string{`Normal SecretReplicated Replicated Secret "test-tls" into namespace "target"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "target", replica("target"))),
+			},
+		},
+		"replicate the secret into a namespace matching the selector": {
+			existingCM: []runtime.Object{gen.CertificateFrom(crt,
+				gen.SetCertificateSecretTargets(cmapi.CertificateSecretTargets{
+					NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"tls": "true"}},
+				}),
+			)},
+			existingKube:   []runtime.Object{targetNS, selectedNS, secret},
+			expectedEvents: []string{`Normal SecretReplicated Replicated Secret "test-tls" into namespace "selected"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "selected", replica("selected"))),
+			},
+		},
+		"copy the secret template to the replica": {
+			existingCM: []runtime.Object{gen.CertificateFrom(crt,
+				gen.SetCertificateSecretTemplate(map[string]string{"foo": "bar"}, map[string]string{"app": "test"}),
+			)},
+			existingKube:   []runtime.Object{targetNS, secret},
+			expectedEvents: []string{`Normal SecretReplicated Replicated Secret "test-tls" into namespace "target"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "target", gen.SecretFrom(replica("target"),
+					gen.SetSecretLabels(map[string]string{cmapi.IsSecretReplicaLabelKey: "true", "app": "test"}),
+					gen.SetSecretAnnotations(map[string]string{cmapi.SecretReplicaOfAnnotationKey: "testns/test", "foo": "bar"}),
+				))),
+			},
+		},
+		"do not replicate the secret into a namespace which does not allow it": {
+			existingCM: []runtime.Object{gen.CertificateFrom(crt,
+				gen.SetCertificateSecretTargets(cmapi.CertificateSecretTargets{Namespaces: []string{"denied", "missing"}}),
+			)},
+			existingKube: []runtime.Object{deniedNS, secret},
+			expectedEvents: []string{`Warning SecretReplicationDenied Secret is not replicated into namespaces denied as they do not allow replication ` +
+				`from namespace "testns" using the cert-manager.io/allow-secret-replication-from annotation`},
+		},
+		"do not replicate the secret before a certificate has been issued into it": {
+			existingCM:   []runtime.Object{crt},
+			existingKube: []runtime.Object{targetNS, gen.SecretFrom(secret, gen.SetSecretData(nil))},
+		},
+		"do nothing if the replica is up to date": {
+			existingCM:   []runtime.Object{crt},
+			existingKube: []runtime.Object{targetNS, secret, replica("target")},
+		},
+		"update a replica which is out of date": {
+			existingCM: []runtime.Object{crt},
+			existingKube: []runtime.Object{targetNS, secret, gen.SecretFrom(replica("target"),
+				gen.SetSecretData(map[string][]byte{corev1.TLSCertKey: []byte("old")}),
+			)},
+			expectedEvents: []string{`Normal SecretReplicated Replicated Secret "test-tls" into namespace "target"`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "target", replica("target"))),
+			},
+		},
+		"do not overwrite a secret which is not a replica": {
+			existingCM: []runtime.Object{crt},
+			existingKube: []runtime.Object{targetNS, secret, gen.Secret("test-tls",
+				gen.SetSecretNamespace("target"),
+				gen.SetSecretData(map[string][]byte{"foo": []byte("bar")}),
+			)},
+			expectedEvents: []string{`Warning SecretReplicationConflict Secret is not replicated into namespace "target" as a Secret named "test-tls" ` +
+				`which is not a replica of this Certificate already exists`},
+		},
+		"delete a replica in a namespace which is no longer targeted": {
+			existingCM:   []runtime.Object{crt},
+			existingKube: []runtime.Object{targetNS, selectedNS, secret, replica("target"), replica("selected")},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), "selected", "test-tls")),
+			},
+		},
+		"delete the replicas of a deleted certificate": {
+			existingKube: []runtime.Object{targetNS, secret, replica("target")},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), "target", "test-tls")),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: test.existingCM,
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "testns/test")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestReplicationAllowed(t *testing.T) {
+	tests := map[string]struct {
+		annotation string
+		from       string
+		allowed    bool
+	}{
+		"no annotation":               {from: "foo", allowed: false},
+		"namespace listed":            {annotation: "foo", from: "foo", allowed: true},
+		"namespace in list":           {annotation: "bar, foo", from: "foo", allowed: true},
+		"namespace not in list":       {annotation: "bar,baz", from: "foo", allowed: false},
+		"all namespaces allowed":      {annotation: "*", from: "foo", allowed: true},
+		"namespace prefix not listed": {annotation: "foo-bar", from: "foo", allowed: false},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "target"}}
+			if test.annotation != "" {
+				ns.Annotations = map[string]string{cmapi.AllowSecretReplicationFromAnnotationKey: test.annotation}
+			}
+			if got := replicationAllowed(ns, test.from); got != test.allowed {
+				t.Errorf("expected %t, got %t", test.allowed, got)
+			}
+		})
+	}
+}
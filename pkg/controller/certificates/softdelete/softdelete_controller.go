@@ -0,0 +1,346 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+const (
+	// ControllerName is the name of the certificate soft-delete controller.
+	ControllerName = "certificates-soft-delete"
+
+	reasonRetired = "Retired"
+)
+
+// This controller implements soft-deletion of Certificates. When the
+// CertificateSoftDelete feature is enabled, it adds a finalizer to every
+// Certificate. When a Certificate with the finalizer is deleted, its Secret is
+// detached from the Certificate and annotated with a record of the deleted
+// Certificate before the finalizer is removed, so that the Secret outlives
+// the Certificate and the Certificate can be restored from it. Retired Secrets
+// are deleted once the retention period has passed.
+//
+// Secrets are only protected from garbage collection when the Certificate is
+// deleted using background deletion, which is the default.
+type controller struct {
+	certificateLister  cmlisters.CertificateLister
+	secretLister       corelisters.SecretLister
+	client             cmclient.Interface
+	kubeClient         kubernetes.Interface
+	recorder           record.EventRecorder
+	clock              clock.Clock
+	scheduledWorkQueue scheduler.ScheduledWorkQueue
+
+	// retention is how long the Secret of a deleted Certificate is kept for.
+	retention time.Duration
+}
+
+// NewController returns a new certificate soft-delete controller.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	retention time.Duration,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+
+	// When a retired Secret changes, enqueue the deleted Certificate that it
+	// was stored in so that its retention period is tracked.
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			secret, ok := obj.(*corev1.Secret)
+			if !ok {
+				return
+			}
+			name := secret.Annotations[cmapi.CertificateNameKey]
+			if _, retired := secret.Annotations[cmapi.RetiredAtAnnotationKey]; !retired || name == "" {
+				return
+			}
+			queue.Add(secret.Namespace + "/" + name)
+		},
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		secretsInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		certificateLister:  certificateInformer.Lister(),
+		secretLister:       secretsInformer.Lister(),
+		client:             client,
+		kubeClient:         kubeClient,
+		recorder:           recorder,
+		clock:              clock,
+		scheduledWorkQueue: scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		retention:          retention,
+	}, queue, mustSync
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a Certificate to be re-synced is pulled from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	crt, err := c.certificateLister.Certificates(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return c.expireRetiredSecrets(ctx, key, namespace, name)
+	}
+	if err != nil {
+		return err
+	}
+
+	if crt.DeletionTimestamp == nil {
+		if err := c.unretireSecret(ctx, crt); err != nil {
+			return err
+		}
+		if !utilfeature.DefaultFeatureGate.Enabled(feature.CertificateSoftDelete) || hasFinalizer(crt) {
+			return nil
+		}
+		crt = crt.DeepCopy()
+		crt.Finalizers = append(crt.Finalizers, cmapi.CertificateSoftDeleteFinalizer)
+		_, err := c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+		return err
+	}
+
+	// Certificates which already have the finalizer are retired even if the
+	// feature has since been disabled, so that their deletion is not blocked.
+	if !hasFinalizer(crt) {
+		return nil
+	}
+	if err := c.retireSecret(ctx, crt); err != nil {
+		return err
+	}
+
+	crt = crt.DeepCopy()
+	var finalizers []string
+	for _, f := range crt.Finalizers {
+		if f != cmapi.CertificateSoftDeleteFinalizer {
+			finalizers = append(finalizers, f)
+		}
+	}
+	crt.Finalizers = finalizers
+	_, err = c.client.CertmanagerV1().Certificates(crt.Namespace).Update(ctx, crt, metav1.UpdateOptions{})
+	return err
+}
+
+// retireSecret records the deleted Certificate on its Secret and removes the
+// Certificate's owner reference from it, so that the Secret is not garbage
+// collected along with the Certificate.
+func (c *controller) retireSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if secret.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		// The Secret was not issued for this Certificate.
+		return nil
+	}
+
+	annotations := make(map[string]string)
+	for k, v := range crt.Annotations {
+		if k != corev1.LastAppliedConfigAnnotation {
+			annotations[k] = v
+		}
+	}
+	tombstone, err := json.Marshal(&cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        crt.Name,
+			Labels:      crt.Labels,
+			Annotations: annotations,
+		},
+		Spec: crt.Spec,
+	})
+	if err != nil {
+		return err
+	}
+
+	secret = secret.DeepCopy()
+	var ownerRefs []metav1.OwnerReference
+	for _, ref := range secret.OwnerReferences {
+		if ref.UID != crt.UID {
+			ownerRefs = append(ownerRefs, ref)
+		}
+	}
+	secret.OwnerReferences = ownerRefs
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, cmapi.RetiredCertificateAnnotationKey, string(tombstone))
+	metav1.SetMetaDataAnnotation(&secret.ObjectMeta, cmapi.RetiredAtAnnotationKey, c.clock.Now().UTC().Format(time.RFC3339))
+	if _, err := c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{}); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(crt, corev1.EventTypeNormal, reasonRetired,
+		"Retained Secret %q for %s, the Certificate can be restored until then", secret.Name, c.retention)
+	return nil
+}
+
+// unretireSecret removes the record of a deleted Certificate from the Secret
+// of a Certificate that has been re-created.
+func (c *controller) unretireSecret(ctx context.Context, crt *cmapi.Certificate) error {
+	secret, err := c.secretLister.Secrets(crt.Namespace).Get(crt.Spec.SecretName)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	if _, retired := secret.Annotations[cmapi.RetiredAtAnnotationKey]; !retired || secret.Annotations[cmapi.CertificateNameKey] != crt.Name {
+		return nil
+	}
+
+	secret = secret.DeepCopy()
+	delete(secret.Annotations, cmapi.RetiredCertificateAnnotationKey)
+	delete(secret.Annotations, cmapi.RetiredAtAnnotationKey)
+	_, err = c.kubeClient.CoreV1().Secrets(secret.Namespace).Update(ctx, secret, metav1.UpdateOptions{})
+	return err
+}
+
+// expireRetiredSecrets deletes the Secrets retired by the named Certificate
+// whose retention period has passed, and schedules the Certificate to be
+// processed again once the next one will have expired.
+func (c *controller) expireRetiredSecrets(ctx context.Context, key, namespace, name string) error {
+	log := logf.FromContext(ctx)
+
+	secrets, err := c.secretLister.Secrets(namespace).List(labels.Everything())
+	if err != nil {
+		return err
+	}
+
+	var next time.Duration
+	for _, secret := range secrets {
+		retiredAt, retired := secret.Annotations[cmapi.RetiredAtAnnotationKey]
+		if !retired || secret.Annotations[cmapi.CertificateNameKey] != name {
+			continue
+		}
+		t, err := time.Parse(time.RFC3339, retiredAt)
+		if err != nil {
+			log.Error(err, "ignoring retired Secret with an invalid annotation", "secret", secret.Name, "annotation", cmapi.RetiredAtAnnotationKey)
+			continue
+		}
+
+		if remaining := t.Add(c.retention).Sub(c.clock.Now()); remaining > 0 {
+			if next == 0 || remaining < next {
+				next = remaining
+			}
+			continue
+		}
+
+		log.V(logf.InfoLevel).Info("deleting Secret of deleted Certificate as its retention period has passed", "secret", secret.Name)
+		err = c.kubeClient.CoreV1().Secrets(namespace).Delete(ctx, secret.Name, metav1.DeleteOptions{
+			Preconditions: metav1.NewUIDPreconditions(string(secret.UID)),
+		})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete retired Secret %q: %w", secret.Name, err)
+		}
+	}
+
+	if next > 0 {
+		c.scheduledWorkQueue.Add(key, next)
+	}
+	return nil
+}
+
+func hasFinalizer(crt *cmapi.Certificate) bool {
+	for _, f := range crt.Finalizers {
+		if f == cmapi.CertificateSoftDeleteFinalizer {
+			return true
+		}
+	}
+	return false
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		ctx.CertificateOptions.SoftDeleteRetention,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,204 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package softdelete
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	featuregatetesting "k8s.io/component-base/featuregate/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	retention := 24 * time.Hour
+
+	baseCrt := gen.Certificate("test",
+		gen.SetCertificateNamespace("testns"),
+		gen.SetCertificateUID("crt-uid"),
+		gen.SetCertificateSecretName("test-tls"),
+		gen.SetCertificateDNSNames("example.com"),
+		gen.AddCertificateLabels(map[string]string{"app": "test"}),
+		gen.AddCertificateAnnotations(map[string]string{corev1.LastAppliedConfigAnnotation: "{}"}),
+	)
+	finalizedCrt := gen.CertificateFrom(baseCrt, gen.SetCertificateFinalizers([]string{cmapi.CertificateSoftDeleteFinalizer}))
+	deletedCrt := gen.CertificateFrom(finalizedCrt, gen.SetCertificateDeletionTimestamp(metav1.NewTime(now)))
+
+	secret := gen.Secret("test-tls",
+		gen.SetSecretNamespace("testns"),
+		gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "test"}),
+		gen.SetSecretOwnerReferences(gen.CertificateRef("test", "crt-uid")),
+	)
+
+	tombstone, err := json.Marshal(&cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{Name: "test", Labels: map[string]string{"app": "test"}},
+		Spec:       baseCrt.Spec,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	retiredAnnotations := func(retiredAt time.Time) map[string]string {
+		return map[string]string{
+			cmapi.CertificateNameKey:              "test",
+			cmapi.RetiredCertificateAnnotationKey: string(tombstone),
+			cmapi.RetiredAtAnnotationKey:          retiredAt.Format(time.RFC3339),
+		}
+	}
+	retiredSecret := gen.SecretFrom(secret,
+		gen.SetSecretAnnotations(retiredAnnotations(now)),
+		gen.SetSecretOwnerReferences(),
+	)
+
+	tests := map[string]struct {
+		crt               *cmapi.Certificate
+		existingCM        []runtime.Object
+		existingKube      []runtime.Object
+		softDeleteEnabled bool
+		expectedEvents    []string
+		expectedActions   []testpkg.Action
+		expectedScheduled time.Duration
+	}{
+		"do nothing if the feature is disabled": {
+			crt:          baseCrt,
+			existingCM:   []runtime.Object{baseCrt},
+			existingKube: []runtime.Object{secret},
+		},
+		"add the finalizer if the feature is enabled": {
+			crt:               baseCrt,
+			existingCM:        []runtime.Object{baseCrt},
+			existingKube:      []runtime.Object{secret},
+			softDeleteEnabled: true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", finalizedCrt)),
+			},
+		},
+		"do nothing if the certificate already has the finalizer": {
+			crt:               finalizedCrt,
+			existingCM:        []runtime.Object{finalizedCrt},
+			existingKube:      []runtime.Object{secret},
+			softDeleteEnabled: true,
+		},
+		"retire the secret of a deleted certificate": {
+			crt:            deletedCrt,
+			existingCM:     []runtime.Object{deletedCrt},
+			existingKube:   []runtime.Object{secret},
+			expectedEvents: []string{`Normal Retired Retained Secret "test-tls" for 24h0m0s, the Certificate can be restored until then`},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", retiredSecret)),
+				testpkg.NewAction(coretesting.NewUpdateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns",
+					gen.CertificateFrom(deletedCrt, gen.SetCertificateFinalizers(nil)))),
+			},
+		},
+		"do not retire a secret that was not issued for the certificate": {
+			crt:        deletedCrt,
+			existingCM: []runtime.Object{deletedCrt},
+			existingKube: []runtime.Object{gen.SecretFrom(secret,
+				gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "other"}),
+			)},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns",
+					gen.CertificateFrom(deletedCrt, gen.SetCertificateFinalizers(nil)))),
+			},
+		},
+		"remove the finalizer if the secret does not exist": {
+			crt:        deletedCrt,
+			existingCM: []runtime.Object{deletedCrt},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns",
+					gen.CertificateFrom(deletedCrt, gen.SetCertificateFinalizers(nil)))),
+			},
+		},
+		"clear the record of a deleted certificate once it has been re-created": {
+			crt:          finalizedCrt,
+			existingCM:   []runtime.Object{finalizedCrt},
+			existingKube: []runtime.Object{retiredSecret},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns",
+					gen.SecretFrom(retiredSecret, gen.SetSecretAnnotations(map[string]string{cmapi.CertificateNameKey: "test"})))),
+			},
+		},
+		"schedule a retired secret to be deleted once the retention period has passed": {
+			crt:               baseCrt,
+			existingKube:      []runtime.Object{retiredSecret},
+			expectedScheduled: retention,
+		},
+		"delete a retired secret once the retention period has passed": {
+			crt: baseCrt,
+			existingKube: []runtime.Object{gen.SecretFrom(retiredSecret,
+				gen.SetSecretAnnotations(retiredAnnotations(now.Add(-retention))),
+			)},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewDeleteAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", "test-tls")),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			defer featuregatetesting.SetFeatureGateDuringTest(t, utilfeature.DefaultMutableFeatureGate, feature.CertificateSoftDelete, test.softDeleteEnabled)()
+
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: test.existingCM,
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.CertificateOptions.SoftDeleteRetention = retention
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			var gotScheduled time.Duration
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "testns/test")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != test.expectedScheduled {
+				t.Errorf("expected the certificate to be scheduled in %v, got %v", test.expectedScheduled, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
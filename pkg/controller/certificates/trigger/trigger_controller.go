@@ -158,6 +158,12 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	if err != nil {
 		return err
 	}
+
+	if certificates.IsPaused(crt) {
+		log.V(logf.DebugLevel).Info("certificate is paused, skipping")
+		return nil
+	}
+
 	if apiutil.CertificateHasCondition(crt, cmapi.CertificateCondition{
 		Type:   cmapi.CertificateConditionIssuing,
 		Status: cmmeta.ConditionTrue,
@@ -181,10 +187,11 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 		return nil
 	}
 
-	if crt.Status.RenewalTime != nil {
+	if recheckTime := nextRecheckTime(crt); recheckTime != nil {
 		// ensure a resync is scheduled in the future so that we re-check
-		// Certificate resources and trigger them near expiry time
-		c.scheduleRecheckOfCertificateIfRequired(log, key, crt.Status.RenewalTime.Time.Sub(c.clock.Now()))
+		// Certificate resources and trigger them near expiry time, or when
+		// their private key is due to be rotated
+		c.scheduleRecheckOfCertificateIfRequired(log, key, recheckTime.Sub(c.clock.Now()))
 	}
 
 	reason, message, reissue := c.shouldReissue(input)
@@ -209,6 +216,23 @@ func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	return nil
 }
 
+// nextRecheckTime returns the earlier of the Certificate's renewal time and
+// the time that its private key is due to be rotated, or nil if neither is
+// known.
+func nextRecheckTime(crt *cmapi.Certificate) *time.Time {
+	var recheckTime *time.Time
+	if crt.Status.RenewalTime != nil {
+		recheckTime = &crt.Status.RenewalTime.Time
+	}
+	if crt.Status.NotBefore != nil {
+		rotationTime := certificates.PrivateKeyRotationTime(crt.Status.NotBefore.Time, crt.Spec.PrivateKey)
+		if rotationTime != nil && (recheckTime == nil || rotationTime.Time.Before(*recheckTime)) {
+			recheckTime = &rotationTime.Time
+		}
+	}
+	return recheckTime
+}
+
 // updateOrApplyStatus will update the controller status. If the
 // ServerSideApply feature is enabled, the managed fields will instead get
 // applied using the relevant Patch API call.
@@ -344,7 +368,7 @@ func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.Rate
 		ctx.SharedInformerFactory,
 		ctx.Recorder,
 		ctx.Clock,
-		policies.NewTriggerPolicyChain(ctx.Clock).Evaluate,
+		policies.NewTriggerPolicyChain(ctx.Clock, ctx.CertificateOptions.DefaultRenewalJitter).Evaluate,
 		ctx.FieldManager,
 	)
 	c.controller = ctrl
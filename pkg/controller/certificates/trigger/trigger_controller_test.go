@@ -95,6 +95,12 @@ func Test_controller_ProcessItem(t *testing.T) {
 				}),
 			),
 		},
+		"should do nothing if the Certificate is paused": {
+			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
+				gen.SetCertificateGeneration(42),
+				gen.AddCertificateAnnotations(map[string]string{"cert-manager.io/paused": "true"}),
+			),
+		},
 		"should call shouldReissue with the correct cert, secret and current CR": {
 			existingCertificate: gen.Certificate("cert-1", gen.SetCertificateNamespace("testns"),
 				gen.SetCertificateSecretName("secret-1"),
@@ -679,3 +685,46 @@ func Test_shouldBackoffReissuingOnFailure(t *testing.T) {
 
 	}
 }
+
+func Test_nextRecheckTime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	renewalTime := metav1.NewTime(now.Add(time.Hour * 24 * 60))
+	rotatingCert := gen.Certificate("cert-1",
+		gen.SetCertificateKeyRotationPolicy(cmapi.RotationPolicyAlways),
+		gen.SetCertificateKeyRotationInterval(metav1.Duration{Duration: time.Hour * 24 * 30}),
+		gen.SetCertificateNotBefore(metav1.NewTime(now)),
+		gen.SetCertificateRenewalTime(renewalTime),
+	)
+
+	tests := map[string]struct {
+		givenCert *cmapi.Certificate
+		want      *time.Time
+	}{
+		"no renewal time or key rotation": {
+			givenCert: gen.Certificate("cert-1"),
+		},
+		"renewal time without key rotation": {
+			givenCert: gen.Certificate("cert-1", gen.SetCertificateRenewalTime(renewalTime)),
+			want:      &renewalTime.Time,
+		},
+		"key rotation before renewal time": {
+			givenCert: rotatingCert,
+			want:      pointerTime(now.Add(time.Hour * 24 * 30)),
+		},
+		"renewal time before key rotation": {
+			givenCert: gen.CertificateFrom(rotatingCert,
+				gen.SetCertificateKeyRotationInterval(metav1.Duration{Duration: time.Hour * 24 * 90}),
+			),
+			want: &renewalTime.Time,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, nextRecheckTime(test.givenCert))
+		})
+	}
+}
+
+func pointerTime(t time.Time) *time.Time {
+	return &t
+}
@@ -25,6 +25,7 @@ import (
 	"encoding/asn1"
 
 	"fmt"
+	"hash/fnv"
 	"reflect"
 	"time"
 
@@ -41,24 +42,35 @@ import (
 // doesn't match the provided spec. RSA, Ed25519 and ECDSA are supported.
 // If any error is returned, a list of violations will also be returned.
 func PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
+	var pub crypto.PublicKey
+	if signer, ok := pk.(crypto.Signer); ok {
+		pub = signer.Public()
+	}
+	return PublicKeyMatchesSpec(pub, spec)
+}
+
+// PublicKeyMatchesSpec is like PrivateKeyMatchesSpec, but checks the public
+// key of a key pair, for private keys that cannot be read such as encrypted
+// private keys.
+func PublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) ([]string, error) {
 	spec = *spec.DeepCopy()
 	if spec.PrivateKey == nil {
 		spec.PrivateKey = &cmapi.CertificatePrivateKey{}
 	}
 	switch spec.PrivateKey.Algorithm {
 	case "", cmapi.RSAKeyAlgorithm:
-		return rsaPrivateKeyMatchesSpec(pk, spec)
+		return rsaPublicKeyMatchesSpec(pub, spec)
 	case cmapi.Ed25519KeyAlgorithm:
-		return ed25519PrivateKeyMatchesSpec(pk, spec)
+		return ed25519PublicKeyMatchesSpec(pub, spec)
 	case cmapi.ECDSAKeyAlgorithm:
-		return ecdsaPrivateKeyMatchesSpec(pk, spec)
+		return ecdsaPublicKeyMatchesSpec(pub, spec)
 	default:
 		return nil, fmt.Errorf("unrecognised key algorithm type %q", spec.PrivateKey.Algorithm)
 	}
 }
 
-func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	rsaPk, ok := pk.(*rsa.PrivateKey)
+func rsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) ([]string, error) {
+	rsaPub, ok := pub.(*rsa.PublicKey)
 	if !ok {
 		return []string{"spec.keyAlgorithm"}, nil
 	}
@@ -72,14 +84,14 @@ func rsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec)
 	if spec.PrivateKey.Size > 0 {
 		keySize = spec.PrivateKey.Size
 	}
-	if rsaPk.N.BitLen() != keySize {
+	if rsaPub.N.BitLen() != keySize {
 		violations = append(violations, "spec.keySize")
 	}
 	return violations, nil
 }
 
-func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	ecdsaPk, ok := pk.(*ecdsa.PrivateKey)
+func ecdsaPublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) ([]string, error) {
+	ecdsaPub, ok := pub.(*ecdsa.PublicKey)
 	if !ok {
 		return []string{"spec.keyAlgorithm"}, nil
 	}
@@ -93,14 +105,14 @@ func ecdsaPrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec
 	if spec.PrivateKey.Size > 0 {
 		expectedKeySize = spec.PrivateKey.Size
 	}
-	if expectedKeySize != ecdsaPk.Curve.Params().BitSize {
+	if expectedKeySize != ecdsaPub.Curve.Params().BitSize {
 		violations = append(violations, "spec.keySize")
 	}
 	return violations, nil
 }
 
-func ed25519PrivateKeyMatchesSpec(pk crypto.PrivateKey, spec cmapi.CertificateSpec) ([]string, error) {
-	_, ok := pk.(ed25519.PrivateKey)
+func ed25519PublicKeyMatchesSpec(pub crypto.PublicKey, spec cmapi.CertificateSpec) ([]string, error) {
+	_, ok := pub.(ed25519.PublicKey)
 	if !ok {
 		return []string{"spec.keyAlgorithm"}, nil
 	}
@@ -141,6 +153,13 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		if !util.EqualUnsorted(x509req.EmailAddresses, spec.EmailAddresses) {
 			violations = append(violations, "spec.emailAddresses")
 		}
+		otherNames, err := pki.OtherNamesFromExtensions(x509req.Extensions)
+		if err != nil {
+			return nil, err
+		}
+		if !util.EqualUnsorted(pki.OtherNamesToString(otherNames), pki.OtherNamesToString(spec.OtherNames)) {
+			violations = append(violations, "spec.otherNames")
+		}
 		if x509req.Subject.SerialNumber != spec.Subject.SerialNumber {
 			violations = append(violations, "spec.subject.serialNumber")
 		}
@@ -175,9 +194,14 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 			spec.Duration.Duration != req.Spec.Duration.Duration {
 			violations = append(violations, "spec.duration")
 		}
-		if !reflect.DeepEqual(spec.IssuerRef, req.Spec.IssuerRef) {
+		if !IssuerRefAllowed(spec, req.Spec.IssuerRef) {
 			violations = append(violations, "spec.issuerRef")
 		}
+		constraintViolations, err := pki.CAConstraintsMatchSpec(x509req, spec)
+		if err != nil {
+			return nil, err
+		}
+		violations = append(violations, constraintViolations...)
 	} else {
 		// we have a LiteralSubject
 		// parse the subject of the csr in the same way as we parse LiteralSubject and see whether the RDN Sequences match
@@ -198,6 +222,26 @@ func RequestMatchesSpec(req *cmapi.CertificateRequest, spec cmapi.CertificateSpe
 		}
 	}
 
+	if req.Annotations[cmapi.CertificateRequestSignatureAlgorithmAnnotationKey] != string(spec.SignatureAlgorithm) {
+		violations = append(violations, "spec.signatureAlgorithm")
+	}
+
+	extraExtensionsMatch, err := pki.ExtraExtensionsMatchSpec(x509req, spec)
+	if err != nil {
+		return nil, err
+	}
+	if !extraExtensionsMatch || req.Annotations[cmapi.CertificateRequestExtraExtensionsAnnotationKey] != pki.ExtraExtensionsAnnotationValue(spec.ExtraExtensions) {
+		violations = append(violations, "spec.extraExtensions")
+	}
+
+	if req.Annotations[cmapi.CertificateRequestCertificatePoliciesAnnotationKey] != pki.ObjectIdentifiersAnnotationValue(spec.CertificatePolicies) {
+		violations = append(violations, "spec.certificatePolicies")
+	}
+
+	if req.Annotations[cmapi.CertificateRequestExtendedKeyUsageOIDsAnnotationKey] != pki.ObjectIdentifiersAnnotationValue(spec.ExtendedKeyUsageOIDs) {
+		violations = append(violations, "spec.extendedKeyUsageOIDs")
+	}
+
 	return violations, nil
 }
 
@@ -301,13 +345,15 @@ func GenerateLocallySignedTemporaryCertificate(crt *cmapi.Certificate, pkData []
 }
 
 //RenewalTimeFunc is a custom function type for calculating renewal time of a certificate.
-type RenewalTimeFunc func(time.Time, time.Time, *metav1.Duration) *metav1.Time
+type RenewalTimeFunc func(time.Time, time.Time, *metav1.Duration, *int32) *metav1.Time
 
 // RenewalTime calculates renewal time for a certificate. Default renewal time
 // is 2/3 through certificate's lifetime. If user has configured
 // spec.renewBefore, renewal time will be renewBefore period before expiry
-// (unless that is after the expiry).
-func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Duration) *metav1.Time {
+// (unless that is after the expiry). If user has configured
+// spec.renewBeforePercentage, renewal time will be that percentage of the
+// certificate's actual duration before expiry.
+func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Duration, renewBeforePercentageOverride *int32) *metav1.Time {
 
 	// 1. Calculate how long before expiry a cert should be renewed
 
@@ -322,6 +368,14 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 		renewBefore = renewBeforeOverride.Duration
 	}
 
+	// If spec.renewBeforePercentage was set, derive renewBefore from the
+	// duration the issuer actually granted, so that renewal happens at the
+	// same point in the certificate's lifetime even if the issuer shortened
+	// the requested duration.
+	if pct := renewBeforePercentageOverride; pct != nil && *pct > 0 && *pct < 100 {
+		renewBefore = actualDuration * time.Duration(*pct) / 100
+	}
+
 	// 2. Calculate when a cert should be renewed
 
 	// Truncate the renewal time to nearest second. This is important
@@ -337,3 +391,72 @@ func RenewalTime(notBefore, notAfter time.Time, renewBeforeOverride *metav1.Dura
 	rt := metav1.NewTime(notAfter.Add(-1 * renewBefore).Truncate(time.Second))
 	return &rt
 }
+
+// CertificateRenewalTime calculates the renewal time of a certificate issued
+// for the given Certificate, using its spec.renewBefore and bringing it
+// forward by up to the Certificate's renewal jitter. defaultJitter is used if
+// the Certificate does not set spec.renewalJitter.
+func CertificateRenewalTime(crt *cmapi.Certificate, notBefore, notAfter time.Time, defaultJitter time.Duration) *metav1.Time {
+	return JitterRenewalTime(crt, RenewalTime(notBefore, notAfter, crt.Spec.RenewBefore, crt.Spec.RenewBeforePercentage), notBefore, defaultJitter)
+}
+
+// JitterRenewalTime brings the given renewal time of a certificate that
+// became valid at notBefore forward by up to the Certificate's renewal
+// jitter, or defaultJitter if the Certificate does not set
+// spec.renewalJitter. The amount is derived from the Certificate's name and
+// notBefore, so that every controller computes the same renewal time for a
+// certificate, while certificates issued together are renewed at different
+// times. The renewal time is never brought forward past notBefore.
+func JitterRenewalTime(crt *cmapi.Certificate, renewalTime *metav1.Time, notBefore time.Time, defaultJitter time.Duration) *metav1.Time {
+	jitter := defaultJitter
+	if crt.Spec.RenewalJitter != nil {
+		jitter = crt.Spec.RenewalJitter.Duration
+	}
+	if renewalTime == nil || jitter < time.Second {
+		return renewalTime
+	}
+
+	maxOffset := renewalTime.Sub(notBefore.Truncate(time.Second))
+	if maxOffset <= 0 {
+		return renewalTime
+	}
+
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%s/%s/%d", crt.Namespace, crt.Name, notBefore.Unix())
+	offset := time.Duration(h.Sum64()%uint64(jitter/time.Second)) * time.Second
+	if offset > maxOffset {
+		offset = maxOffset
+	}
+
+	rt := metav1.NewTime(renewalTime.Add(-offset))
+	return &rt
+}
+
+// IsPaused returns true if reconciliation of the given Certificate has been
+// suspended using the `cert-manager.io/paused` annotation.
+func IsPaused(crt *cmapi.Certificate) bool {
+	return crt.Annotations[cmapi.CertificatePausedAnnotationKey] == "true"
+}
+
+// PrivateKeyBackend returns the external signer holding the private key of
+// the given Certificate, or nil if cert-manager generates the private key.
+func PrivateKeyBackend(crt *cmapi.Certificate) *cmapi.PrivateKeyBackend {
+	if crt.Spec.PrivateKey == nil {
+		return nil
+	}
+	return crt.Spec.PrivateKey.Backend
+}
+
+// PrivateKeyRotationTime calculates when the private key of a certificate
+// that became valid at notBefore is due to be rotated. It returns nil if the
+// private key is not rotated on a schedule, which is only done when the
+// rotation policy is Always and a key rotation interval is set.
+func PrivateKeyRotationTime(notBefore time.Time, privateKey *cmapi.CertificatePrivateKey) *metav1.Time {
+	if privateKey == nil || privateKey.RotationPolicy != cmapi.RotationPolicyAlways || privateKey.KeyRotationInterval == nil {
+		return nil
+	}
+
+	// Truncated to the nearest second for the same reason as RenewalTime.
+	rt := metav1.NewTime(notBefore.Add(privateKey.KeyRotationInterval.Duration).Truncate(time.Second))
+	return &rt
+}
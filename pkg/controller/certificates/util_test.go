@@ -26,6 +26,8 @@ import (
 	"github.com/stretchr/testify/assert"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/pointer"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/util/pki"
@@ -300,6 +302,7 @@ func TestRenewalTime(t *testing.T) {
 		notBefore           time.Time
 		notAfter            time.Time
 		renewBeforeOverride *metav1.Duration
+		renewBeforePercent  *int32
 		expectedRenewalTime *metav1.Time
 	}
 	now := time.Now().Truncate(time.Second)
@@ -352,12 +355,88 @@ func TestRenewalTime(t *testing.T) {
 			notAfter:            now.Add(time.Hour * 24).Add(time.Second * -1),
 			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 16).Add(time.Second * -1)},
 		},
+		"spec.renewBeforePercentage is set": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 24),
+			renewBeforePercent:  pointer.Int32(25),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 18)},
+		},
+		"spec.renewBeforePercentage is set and the issuer shortened the duration": {
+			notBefore:           now,
+			notAfter:            now.Add(time.Hour * 8),
+			renewBeforePercent:  pointer.Int32(25),
+			expectedRenewalTime: &metav1.Time{Time: now.Add(time.Hour * 6)},
+		},
 	}
 	for n, s := range tests {
 		t.Run(n, func(t *testing.T) {
-			renewalTime := RenewalTime(s.notBefore, s.notAfter, s.renewBeforeOverride)
+			renewalTime := RenewalTime(s.notBefore, s.notAfter, s.renewBeforeOverride, s.renewBeforePercent)
 			assert.Equal(t, s.expectedRenewalTime, renewalTime, fmt.Sprintf("Expected renewal time: %v got: %v", s.expectedRenewalTime, renewalTime))
 
 		})
 	}
 }
+
+func TestPrivateKeyRotationTime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	interval := &metav1.Duration{Duration: time.Hour * 2160}
+
+	tests := map[string]struct {
+		privateKey           *cmapi.CertificatePrivateKey
+		expectedRotationTime *metav1.Time
+	}{
+		"spec.privateKey is not set": {},
+		"keyRotationInterval is not set": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyAlways},
+		},
+		"rotationPolicy is Never": {
+			privateKey: &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyNever, KeyRotationInterval: interval},
+		},
+		"rotationPolicy is Always and keyRotationInterval is set": {
+			privateKey:           &cmapi.CertificatePrivateKey{RotationPolicy: cmapi.RotationPolicyAlways, KeyRotationInterval: interval},
+			expectedRotationTime: &metav1.Time{Time: now.Add(time.Hour * 2160)},
+		},
+	}
+	for n, s := range tests {
+		t.Run(n, func(t *testing.T) {
+			assert.Equal(t, s.expectedRotationTime, PrivateKeyRotationTime(now, s.privateKey))
+		})
+	}
+}
+
+func TestJitterRenewalTime(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	renewalTime := &metav1.Time{Time: now.Add(time.Hour * 24 * 60)}
+	crt := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: "crt"}}
+
+	// without any jitter the renewal time is unchanged
+	assert.Equal(t, renewalTime, JitterRenewalTime(crt, renewalTime, now, 0))
+
+	// the renewal time is brought forward by up to the default jitter, and
+	// is stable for the same certificate
+	jittered := JitterRenewalTime(crt, renewalTime, now, time.Hour*24)
+	assert.False(t, jittered.After(renewalTime.Time))
+	assert.True(t, jittered.After(renewalTime.Add(-time.Hour*24)))
+	assert.Equal(t, jittered, JitterRenewalTime(crt, renewalTime, now, time.Hour*24))
+
+	// spec.renewalJitter overrides the default
+	withJitter := crt.DeepCopy()
+	withJitter.Spec.RenewalJitter = &metav1.Duration{Duration: 0}
+	assert.Equal(t, renewalTime, JitterRenewalTime(withJitter, renewalTime, now, time.Hour*24))
+
+	// the renewal time is never brought forward past notBefore
+	withJitter.Spec.RenewalJitter = &metav1.Duration{Duration: time.Hour * 24 * 365}
+	for i := 0; i < 10; i++ {
+		notBefore := now.Add(time.Duration(i) * time.Minute)
+		assert.False(t, JitterRenewalTime(withJitter, renewalTime, notBefore, 0).Time.Before(notBefore))
+	}
+
+	// certificates issued together are renewed at different times
+	renewalTimes := sets.NewString()
+	for i := 0; i < 10; i++ {
+		other := &cmapi.Certificate{ObjectMeta: metav1.ObjectMeta{Namespace: "ns", Name: fmt.Sprintf("crt-%d", i)}}
+		renewalTimes.Insert(JitterRenewalTime(other, renewalTime, now, time.Hour*24).String())
+	}
+	assert.Greater(t, renewalTimes.Len(), 1)
+}
+
@@ -301,6 +301,11 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 		return nil, err
 	}
 
+	namespace, err := a.issuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		return nil, err
+	}
+
 	// Filter the annotations copied from CertificateSigningRequest to the Order.
 	annotations := controllerpkg.BuildAnnotationsToCopy(csr.Annotations, a.copiedAnnotationPrefixes)
 
@@ -309,7 +314,7 @@ func (a *ACME) buildOrder(csr *certificatesv1.CertificateSigningRequest, req *x5
 	return &cmacme.Order{
 		ObjectMeta: metav1.ObjectMeta{
 			Name:        name,
-			Namespace:   a.issuerOptions.ResourceNamespace(iss),
+			Namespace:   namespace,
 			Labels:      csr.Labels,
 			Annotations: annotations,
 			OwnerReferences: []metav1.OwnerReference{
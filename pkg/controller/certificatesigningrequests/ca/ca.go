@@ -95,7 +95,13 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	log := logf.FromContext(ctx, "sign")
 
 	secretName := issuerObj.GetSpec().CA.SecretName
-	resourceNamespace := c.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := c.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := fmt.Sprintf("Issuer is not allowed to read its credentials: %s", err)
+		log.Error(err, message)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "CredentialsNotGranted", message)
+		return nil
+	}
 
 	// get a copy of the CA certificate named on the Issuer
 	caCerts, caKey, err := kube.SecretTLSKeyPairAndCA(ctx, c.secretsLister, resourceNamespace, issuerObj.GetSpec().CA.SecretName)
@@ -118,6 +124,30 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 		return err
 	}
 
+	// complete the chain with the certificates of the CAs above the signing CA
+	if chainSecretNames := issuerObj.GetSpec().CA.ChainSecretNames; len(chainSecretNames) > 0 {
+		chain, err := kube.SecretCACertificates(ctx, c.secretsLister, resourceNamespace, chainSecretNames)
+		if apierrors.IsNotFound(err) {
+			message := fmt.Sprintf("Referenced CA chain secret not found in namespace %s", resourceNamespace)
+			c.recorder.Eventf(csr, corev1.EventTypeWarning, "SecretMissing", "%s: %s", message, err)
+			return nil
+		}
+
+		if cmerrors.IsInvalidData(err) {
+			message := fmt.Sprintf("Failed to parse CA chain certificates from secrets in namespace %s", resourceNamespace)
+			c.recorder.Eventf(csr, corev1.EventTypeWarning, "SecretInvalidData", "%s: %s", message, err)
+			return nil
+		}
+
+		if err != nil {
+			message := fmt.Sprintf("Failed to get CA chain certificates from secrets in namespace %s", resourceNamespace)
+			c.recorder.Eventf(csr, corev1.EventTypeWarning, "SecretGetError", "%s: %s", message, err)
+			return err
+		}
+
+		caCerts = append(caCerts, chain...)
+	}
+
 	template, err := c.templateGenerator(csr)
 	if err != nil {
 		message := fmt.Sprintf("Error generating certificate template: %s", err)
@@ -130,6 +160,23 @@ func (c *CA) Sign(ctx context.Context, csr *certificatesv1.CertificateSigningReq
 	template.CRLDistributionPoints = issuerObj.GetSpec().CA.CRLDistributionPoints
 	template.OCSPServer = issuerObj.GetSpec().CA.OCSPServers
 
+	// honour the path length and name constraints requested for a CA, within
+	// the path length allowed by the signing CA
+	x509csr, err := pki.DecodeX509CertificateRequestBytes(csr.Spec.Request)
+	if err == nil {
+		err = pki.ApplyCAConstraints(template, x509csr)
+	}
+	if err == nil {
+		err = pki.ConstrainPathLenToIssuer(template, caCerts[0])
+	}
+	if err != nil {
+		message := fmt.Sprintf("Error applying CA constraints to certificate template: %s", err)
+		c.recorder.Event(csr, corev1.EventTypeWarning, "SigningError", message)
+		util.CertificateSigningRequestSetFailed(csr, "SigningError", message)
+		_, err := util.UpdateOrApplyStatus(ctx, c.certClient, csr, certificatesv1.CertificateFailed, c.fieldManager)
+		return err
+	}
+
 	bundle, err := c.signingFn(caCerts, caKey, template)
 	if err != nil {
 		message := fmt.Sprintf("Error signing certificate: %s", err)
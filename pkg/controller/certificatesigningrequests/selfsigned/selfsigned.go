@@ -108,7 +108,13 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 		return err
 	}
 
-	resourceNamespace := s.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := s.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := fmt.Sprintf("Issuer is not allowed to read its credentials: %s", err)
+		log.Error(err, message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, "CredentialsNotGranted", message)
+		return nil
+	}
 
 	privatekey, err := kube.SecretTLSKey(ctx, s.secretsLister, resourceNamespace, secretName)
 	if apierrors.IsNotFound(err) {
@@ -151,6 +157,20 @@ func (s *SelfSigned) Sign(ctx context.Context, csr *certificatesv1.CertificateSi
 
 	template.CRLDistributionPoints = issuerObj.GetSpec().SelfSigned.CRLDistributionPoints
 
+	// honour the path length and name constraints requested for a CA
+	x509csr, err := pki.DecodeX509CertificateRequestBytes(csr.Spec.Request)
+	if err == nil {
+		err = pki.ApplyCAConstraints(template, x509csr)
+	}
+	if err != nil {
+		message := fmt.Sprintf("Error applying CA constraints to certificate template: %s", err)
+		log.Error(err, message)
+		s.recorder.Event(csr, corev1.EventTypeWarning, "ErrorGenerating", message)
+		util.CertificateSigningRequestSetFailed(csr, "ErrorGenerating", message)
+		_, err = util.UpdateOrApplyStatus(ctx, s.certClient, csr, certificatesv1.CertificateFailed, s.fieldManager)
+		return err
+	}
+
 	// extract the public component of the key
 	publickey, err := pki.PublicKeyForPrivateKey(privatekey)
 	if err != nil {
@@ -87,9 +87,15 @@ func (v *Vault) Sign(ctx context.Context, csr *certificatesv1.CertificateSigning
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
-	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := v.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := fmt.Sprintf("Issuer is not allowed to read its credentials: %s", err)
+		log.Error(err, message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, "CredentialsNotGranted", message)
+		return nil
+	}
 
-	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj)
+	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.issuerOptions.CircuitBreakers, v.issuerOptions.VaultTokens)
 	if apierrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 		log.Error(err, message)
@@ -97,9 +97,15 @@ func (v *Venafi) Sign(ctx context.Context, csr *certificatesv1.CertificateSignin
 	log := logf.FromContext(ctx, "sign")
 	log = logf.WithRelatedResource(log, issuerObj)
 
-	resourceNamespace := v.issuerOptions.ResourceNamespace(issuerObj)
+	resourceNamespace, err := v.issuerOptions.ResourceNamespace(issuerObj)
+	if err != nil {
+		message := fmt.Sprintf("Issuer is not allowed to read its credentials: %s", err)
+		log.Error(err, message)
+		v.recorder.Event(csr, corev1.EventTypeWarning, "CredentialsNotGranted", message)
+		return nil
+	}
 
-	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.metrics, log)
+	client, err := v.clientBuilder(resourceNamespace, v.secretsLister, issuerObj, v.metrics, v.issuerOptions.CircuitBreakers, log)
 	if apierrors.IsNotFound(err) {
 		message := "Required secret resource not found"
 		v.recorder.Event(csr, corev1.EventTypeWarning, "SecretNotFound", message)
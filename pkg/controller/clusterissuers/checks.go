@@ -33,7 +33,11 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.ClusterIssue
 
 	var affected []*v1.ClusterIssuer
 	for _, iss := range issuers {
-		if secret.Namespace != c.clusterResourceNamespace {
+		ns := iss.Spec.CredentialsNamespace
+		if ns == "" {
+			ns = c.issuerOptions.ClusterResourceNamespace
+		}
+		if secret.Namespace != ns {
 			continue
 		}
 		switch {
@@ -53,6 +57,12 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.ClusterIssue
 				affected = append(affected, iss)
 				continue
 			}
+			for _, name := range iss.Spec.CA.ChainSecretNames {
+				if name == secret.Name {
+					affected = append(affected, iss)
+					break
+				}
+			}
 		case iss.Spec.Venafi != nil:
 			if iss.Spec.Venafi.TPP != nil {
 				if iss.Spec.Venafi.TPP.CredentialsRef.Name == secret.Name {
@@ -85,6 +95,12 @@ func (c *controller) issuersForSecret(secret *corev1.Secret) ([]*v1.ClusterIssue
 					continue
 				}
 			}
+			if iss.Spec.Vault.Auth.ClientCertificate != nil {
+				if iss.Spec.Vault.Auth.ClientCertificate.SecretName == secret.Name {
+					affected = append(affected, iss)
+					continue
+				}
+			}
 		}
 	}
 
@@ -27,6 +27,9 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -55,12 +58,19 @@ type controller struct {
 	// for each ClusterIssuer resource
 	issuerFactory issuer.Factory
 
-	// clusterResourceNamespace is the namespace used to store resources
-	// referenced by ClusterIssuer resources, e.g. acme account secrets
-	clusterResourceNamespace string
+	// issuerOptions is used to find the namespace of resources referenced
+	// by ClusterIssuer resources, e.g. acme account secrets, and to check
+	// that they may be read
+	issuerOptions controllerpkg.IssuerOptions
 
 	// fieldManager is the manager name used for the Apply operations.
 	fieldManager string
+
+	// circuitBreakers holds the circuit breakers of Vault and Venafi
+	// issuers, and accountRegistry the clients of ACME issuers' accounts.
+	// Both are released when the ClusterIssuer is deleted.
+	circuitBreakers *circuitbreaker.Registry
+	accountRegistry accounts.Registry
 }
 
 // Register registers and constructs the controller using the provided context.
@@ -75,11 +85,13 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// obtain references to all the informers used by this controller
 	clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+	credentialGrantInformer := ctx.SharedInformerFactory.Certmanager().V1().CredentialGrants()
 	secretInformer := ctx.KubeSharedInformerFactory.Core().V1().Secrets()
 	// build a list of InformerSynced functions that will be returned by the Register method.
 	// the controller will only begin processing items once all of these informers have synced.
 	mustSync := []cache.InformerSynced{
 		clusterIssuerInformer.Informer().HasSynced,
+		credentialGrantInformer.Informer().HasSynced,
 		secretInformer.Informer().HasSynced,
 	}
 
@@ -89,6 +101,15 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// register handler functions
 	clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	clusterIssuerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{DeleteFunc: c.clusterIssuerDeleted})
+	credentialGrantInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{
+		AddFunc: c.credentialGrantChanged,
+		UpdateFunc: func(old, new interface{}) {
+			c.credentialGrantChanged(old)
+			c.credentialGrantChanged(new)
+		},
+		DeleteFunc: c.credentialGrantChanged,
+	})
 	secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.secretDeleted})
 
 	// instantiate additional helpers used by this controller
@@ -96,7 +117,9 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
-	c.clusterResourceNamespace = ctx.IssuerOptions.ClusterResourceNamespace
+	c.circuitBreakers = ctx.IssuerOptions.CircuitBreakers
+	c.accountRegistry = ctx.ACMEOptions.AccountRegistry
+	c.issuerOptions = ctx.IssuerOptions
 
 	return c.queue, mustSync, nil
 }
@@ -130,6 +153,24 @@ func (c *controller) secretDeleted(obj interface{}) {
 	}
 }
 
+// clusterIssuerDeleted releases the resources held for a ClusterIssuer once it has been
+// deleted, so that they are not leaked or reused if a ClusterIssuer with the same
+// name is created again.
+func (c *controller) clusterIssuerDeleted(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	iss, ok := obj.(*cmapi.ClusterIssuer)
+	if !ok {
+		c.log.Error(nil, "object was not a clusterissuer object")
+		return
+	}
+	c.circuitBreakers.Remove(circuitbreaker.KeyForIssuer(iss))
+	if c.accountRegistry != nil {
+		accounts.RemoveIssuerClients(c.accountRegistry, string(iss.GetUID()))
+	}
+}
+
 func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	log := logf.FromContext(ctx)
 
@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterissuers
+
+import (
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	reasonCredentialsNotGranted = "CredentialsNotGranted"
+)
+
+// credentialGrantChanged enqueues the ClusterIssuers named by a CredentialGrant
+// which has been added, updated or deleted, so that their Ready condition
+// reflects whether they may still read their credentials.
+func (c *controller) credentialGrantChanged(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	grant, ok := obj.(*cmapi.CredentialGrant)
+	if !ok {
+		c.log.Error(nil, "object was not a credentialgrant object")
+		return
+	}
+	for _, name := range grant.Spec.ClusterIssuers {
+		c.queue.Add(name)
+	}
+}
@@ -0,0 +1,147 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterissuers
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSyncCredentialGrants(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	nowMetaTime := metav1.NewTime(now)
+
+	grant := func(namespace string, clusterIssuers ...string) *cmapi.CredentialGrant {
+		return &cmapi.CredentialGrant{
+			ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: namespace},
+			Spec:       cmapi.CredentialGrantSpec{ClusterIssuers: clusterIssuers},
+		}
+	}
+	baseIssuer := gen.ClusterIssuer("test-issuer", gen.SetIssuerCredentialsNamespace("team-a"))
+	notGrantedMessage := `No CredentialGrant in namespace "team-a" allows this ClusterIssuer to read its credentials`
+	notGrantedIssuer := gen.ClusterIssuerFrom(baseIssuer.DeepCopy(), gen.AddIssuerCondition(cmapi.IssuerCondition{
+		Type:               cmapi.IssuerConditionReady,
+		Status:             cmmeta.ConditionFalse,
+		Reason:             reasonCredentialsNotGranted,
+		Message:            notGrantedMessage,
+		LastTransitionTime: &nowMetaTime,
+	}))
+
+	tests := map[string]struct {
+		issuer          *cmapi.ClusterIssuer
+		grants          []runtime.Object
+		expectSetup     bool
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+	}{
+		"ClusterIssuers without a credentials namespace are set up": {
+			issuer:      gen.ClusterIssuer("test-issuer"),
+			expectSetup: true,
+		},
+		"ClusterIssuers using the cluster resource namespace do not need a grant": {
+			issuer:      gen.ClusterIssuer("test-issuer", gen.SetIssuerCredentialsNamespace("cert-manager")),
+			expectSetup: true,
+		},
+		"ClusterIssuers named by a grant are set up": {
+			issuer:      baseIssuer,
+			grants:      []runtime.Object{grant("team-a", "other-issuer", "test-issuer")},
+			expectSetup: true,
+		},
+		"ClusterIssuers without a grant are not ready": {
+			issuer:         baseIssuer,
+			expectedEvents: []string{"Warning CredentialsNotGranted " + notGrantedMessage},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewRootUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("clusterissuers"),
+					"status",
+					notGrantedIssuer,
+				)),
+			},
+		},
+		"grants in other namespaces or for other ClusterIssuers are ignored": {
+			issuer: baseIssuer,
+			grants: []runtime.Object{
+				grant("team-b", "test-issuer"),
+				grant("team-a", "other-issuer"),
+			},
+			expectedEvents: []string{"Warning CredentialsNotGranted " + notGrantedMessage},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewRootUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("clusterissuers"),
+					"status",
+					notGrantedIssuer,
+				)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: append([]runtime.Object{test.issuer}, test.grants...),
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.IssuerOptions.ClusterResourceNamespace = "cert-manager"
+
+			c := &controller{}
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			setup := false
+			c.issuerFactory = &issuerfake.Factory{
+				IssuerForFunc: func(cmapi.GenericIssuer) (issuerpkg.Interface, error) {
+					return &issuerfake.Issuer{
+						SetupFunc: func(context.Context) error {
+							setup = true
+							return nil
+						},
+					}, nil
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.ProcessItem(context.Background(), test.issuer.Name)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if setup != test.expectSetup {
+				t.Errorf("expected issuer setup to be called: %v, got %v", test.expectSetup, setup)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -18,6 +18,7 @@ package clusterissuers
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
@@ -27,7 +28,9 @@ import (
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
@@ -52,6 +55,18 @@ func (c *controller) Sync(ctx context.Context, iss *cmapi.ClusterIssuer) (err er
 		}
 	}()
 
+	granted, err := c.issuerOptions.CredentialsGranted(issuerCopy)
+	if err != nil {
+		return err
+	}
+	if !granted {
+		s := fmt.Sprintf("No CredentialGrant in namespace %q allows this ClusterIssuer to read its credentials", issuerCopy.Spec.CredentialsNamespace)
+		log.V(logf.WarnLevel).Info(s)
+		c.recorder.Event(issuerCopy, corev1.EventTypeWarning, reasonCredentialsNotGranted, s)
+		apiutil.SetIssuerCondition(issuerCopy, issuerCopy.Generation, cmapi.IssuerConditionReady, cmmeta.ConditionFalse, reasonCredentialsNotGranted, s)
+		return nil
+	}
+
 	i, err := c.issuerFactory.IssuerFor(issuerCopy)
 	if err != nil {
 		return err
@@ -20,6 +20,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"text/template"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -41,15 +42,25 @@ import (
 	gwscheme "sigs.k8s.io/gateway-api/pkg/client/clientset/gateway/versioned/scheme"
 	gwinformers "sigs.k8s.io/gateway-api/pkg/client/informers/gateway/externalversions"
 
+	"github.com/cert-manager/cert-manager/internal/approvalwebhook"
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	"github.com/cert-manager/cert-manager/internal/issuancecache"
+	"github.com/cert-manager/cert-manager/internal/issuancelatency"
+	"github.com/cert-manager/cert-manager/internal/issuanceratelimit"
+	"github.com/cert-manager/cert-manager/internal/vault"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	clientset "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmscheme "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/scheme"
 	informers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/ct"
+	"github.com/cert-manager/cert-manager/pkg/util/pki/lint"
 )
 
 // This sets the informer's resync period to 10 hours
@@ -131,11 +142,16 @@ type ContextOptions struct {
 	// Metrics is used for exposing Prometheus metrics across the controllers
 	Metrics *metrics.Metrics
 
+	// ShutdownGracePeriod is how long controllers wait for items that are
+	// already being processed to complete when shutting down.
+	ShutdownGracePeriod time.Duration
+
 	IssuerOptions
 	ACMEOptions
 	IngressShimOptions
 	CertificateOptions
 	SchedulerOptions
+	ApproverOptions
 }
 
 type IssuerOptions struct {
@@ -151,6 +167,44 @@ type IssuerOptions struct {
 	// IssuerAmbientCredentials controls whether an issuer should pick up ambient
 	// credentials, such as those from metadata services, to construct clients.
 	IssuerAmbientCredentials bool
+
+	// CircuitBreakers holds the circuit breakers wrapping the HTTP clients
+	// used to talk to each issuer's upstream API, shared between controllers.
+	// If nil, circuit breaking is disabled.
+	CircuitBreakers *circuitbreaker.Registry
+
+	// IssuanceCache holds certificates recently issued by Vault and Venafi
+	// issuers, so that identical requests made in quick succession are not
+	// sent to the upstream again.
+	// If nil, issuance results are not cached.
+	IssuanceCache *issuancecache.Cache
+
+	// VaultTokens holds the tokens obtained by logging in with each Vault
+	// issuer, so that they are reused and renewed rather than logging in for
+	// every request.
+	// If nil, Vault issuers log in for every request.
+	VaultTokens *vault.TokenCache
+
+	// IssuanceLatency records the time taken by each issuer to issue
+	// CertificateRequests, to compare against the issuer's
+	// IssuanceLatencyBudget.
+	// If nil, issuance latency budgets are not evaluated.
+	IssuanceLatency *issuancelatency.Tracker
+
+	// IssuanceRateLimiter records the CertificateRequests recently sent to
+	// each issuer, to enforce the issuer's RateLimit.
+	// If nil, issuance is not rate limited.
+	IssuanceRateLimiter *issuanceratelimit.Limiter
+
+	// HealthCheckInterval is how often the connectivity and credentials of
+	// each issuer are checked.
+	HealthCheckInterval time.Duration
+
+	// CredentialGrantLister is used to check that a ClusterIssuer may read
+	// resources from its credentials namespace.
+	// If nil, ClusterIssuers may only read resources from the cluster
+	// resource namespace.
+	CredentialGrantLister cmlisters.CredentialGrantLister
 }
 
 type ACMEOptions struct {
@@ -174,6 +228,11 @@ type ACMEOptions struct {
 	// for ACME HTTP01 validations.
 	HTTP01SolverNameservers []string
 
+	// HTTP01SolverPodTemplate is an optional pod template that is applied
+	// to every ACME HTTP01 solver pod, before any pod template configured
+	// on the solver itself.
+	HTTP01SolverPodTemplate *cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate
+
 	// DNS01CheckAuthoritative is a flag for controlling if auth nss are used
 	// for checking propagation of an RR. This is the ideal scenario
 	DNS01CheckAuthoritative bool
@@ -198,6 +257,11 @@ type IngressShimOptions struct {
 	DefaultIssuerKind                 string
 	DefaultIssuerGroup                string
 	DefaultAutoCertificateAnnotations []string
+
+	// ExtraDNSNameTemplates are executed for every host of a Certificate
+	// created by ingress-shim to compute additional DNS names to include,
+	// e.g. an internal name for every external host.
+	ExtraDNSNameTemplates []*template.Template
 }
 
 type CertificateOptions struct {
@@ -207,6 +271,46 @@ type CertificateOptions struct {
 	// CopiedAnnotationPrefixes defines which annotations should be copied
 	// Certificate -> CertificateRequest, CertificateRequest -> Order.
 	CopiedAnnotationPrefixes []string
+	// Linter is run against newly issued certificates. If nil, issued
+	// certificates are not linted.
+	Linter *lint.Linter
+	// StrictLinting causes certificates that fail the Linter to be treated
+	// as failed issuances rather than only reported.
+	StrictLinting bool
+	// CertificateTransparencyLogs is used to verify the SCTs embedded in
+	// certificates issued by ACME issuers. If nil, SCTs are not verified.
+	CertificateTransparencyLogs *ct.LogList
+	// StrictCertificateTransparency causes certificates without the SCTs
+	// required by browsers to be treated as failed issuances rather than only
+	// reported.
+	StrictCertificateTransparency bool
+	// SecretDriftCheckInterval is how often each Certificate's Secret is
+	// checked for drift.
+	SecretDriftCheckInterval time.Duration
+	// SecretDriftAutoRepair causes Certificates whose Secret has drifted to
+	// be re-issued.
+	SecretDriftAutoRepair bool
+	// RevocationStatusCheckInterval is how often the revocation status of
+	// each Certificate's certificate is checked using OCSP or CRLs.
+	RevocationStatusCheckInterval time.Duration
+	// SoftDeleteRetention is how long the Secret of a deleted Certificate is
+	// kept for when the CertificateSoftDelete feature is enabled.
+	SoftDeleteRetention time.Duration
+	// DefaultRenewalJitter is the maximum amount of time by which the renewal
+	// of Certificates which do not set spec.renewalJitter is brought forward.
+	DefaultRenewalJitter time.Duration
+	// DefaultRevisionHistoryTTL is the maximum age of the CertificateRequest
+	// revisions of Certificates which do not set spec.revisionHistoryTTL.
+	// Zero disables garbage collecting these by age.
+	DefaultRevisionHistoryTTL time.Duration
+}
+
+type ApproverOptions struct {
+	// ApprovalWebhook is the external endpoint asked to approve or deny
+	// CertificateRequests which are not denied by a
+	// CertificateRequestPolicy.
+	// If nil, such CertificateRequests are approved.
+	ApprovalWebhook *approvalwebhook.Client
 }
 
 type SchedulerOptions struct {
@@ -264,6 +368,10 @@ func NewContextFactory(ctx context.Context, opts ContextOptions) (*ContextFactor
 	kubeSharedInformerFactory := kubeinformers.NewSharedInformerFactoryWithOptions(clients.kubeClient, resyncPeriod, kubeinformers.WithNamespace(opts.Namespace))
 	gwSharedInformerFactory := gwinformers.NewSharedInformerFactoryWithOptions(clients.gwClient, resyncPeriod, gwinformers.WithNamespace(opts.Namespace))
 
+	// Every controller reading a ClusterIssuer's credentials checks that
+	// they have been granted using the shared CredentialGrant informer.
+	opts.IssuerOptions.CredentialGrantLister = sharedInformerFactory.Certmanager().V1().CredentialGrants().Lister()
+
 	return &ContextFactory{
 		baseRestConfig: restConfig,
 		log:            logf.FromContext(ctx),
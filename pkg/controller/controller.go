@@ -28,6 +28,7 @@ import (
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/cert-manager/cert-manager/pkg/controller/debug"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
@@ -53,6 +54,18 @@ func NewController(
 	runDurationFuncs []runDurationFunc,
 	queue workqueue.RateLimitingInterface,
 ) Interface {
+	return newController(ctx, name, metrics, syncFunc, mustSync, runDurationFuncs, queue)
+}
+
+func newController(
+	ctx context.Context,
+	name string,
+	metrics *metrics.Metrics,
+	syncFunc func(ctx context.Context, key string) error,
+	mustSync []cache.InformerSynced,
+	runDurationFuncs []runDurationFunc,
+	queue workqueue.RateLimitingInterface,
+) *controller {
 	return &controller{
 		ctx:              ctx,
 		name:             name,
@@ -91,6 +104,10 @@ type controller struct {
 
 	// metrics is used to expose Prometheus, shared by all controllers
 	metrics *metrics.Metrics
+
+	// shutdownGracePeriod is how long items that are already being processed
+	// are given to complete once the controller has been asked to stop.
+	shutdownGracePeriod time.Duration
 }
 
 // Run starts the controller loop
@@ -105,12 +122,18 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) error {
 		return fmt.Errorf("error waiting for informer caches to sync")
 	}
 
+	// Workers use a context that is not cancelled when stopCh is closed, so
+	// that items already being processed can persist their progress before
+	// the process exits rather than being abandoned part way through.
+	workCtx, cancelWork := context.WithCancel(withoutCancel(ctx))
+	defer cancelWork()
+
 	var wg sync.WaitGroup
 	for i := 0; i < workers; i++ {
 		wg.Add(1)
 		go func() {
 			defer wg.Done()
-			c.worker(ctx)
+			c.worker(workCtx, stopCh)
 		}()
 	}
 
@@ -125,13 +148,28 @@ func (c *controller) Run(workers int, stopCh <-chan struct{}) error {
 	<-stopCh
 	log.V(logf.InfoLevel).Info("shutting down queue as workqueue signaled shutdown")
 	c.queue.ShutDown()
-	log.V(logf.DebugLevel).Info("waiting for workers to exit...")
-	wg.Wait()
+
+	workersExited := make(chan struct{})
+	go func() {
+		defer close(workersExited)
+		wg.Wait()
+	}()
+
+	log.V(logf.DebugLevel).Info("waiting for workers to exit...", "grace_period", c.shutdownGracePeriod)
+	timer := time.NewTimer(c.shutdownGracePeriod)
+	defer timer.Stop()
+	select {
+	case <-workersExited:
+	case <-timer.C:
+		log.V(logf.InfoLevel).Info("shutdown grace period expired, cancelling items still being processed", "grace_period", c.shutdownGracePeriod)
+		cancelWork()
+		<-workersExited
+	}
 	log.V(logf.DebugLevel).Info("workers exited")
 	return nil
 }
 
-func (c *controller) worker(ctx context.Context) {
+func (c *controller) worker(ctx context.Context, stopCh <-chan struct{}) {
 	log := logf.FromContext(c.ctx)
 
 	log.V(logf.DebugLevel).Info("starting worker")
@@ -141,6 +179,15 @@ func (c *controller) worker(ctx context.Context) {
 			break
 		}
 
+		// Once shutdown has begun, items left in the queue are not processed.
+		// They will be picked up again by whichever instance runs next.
+		select {
+		case <-stopCh:
+			c.queue.Done(obj)
+			return
+		default:
+		}
+
 		var key string
 		// use an inlined function so we can use defer
 		func() {
@@ -165,6 +212,7 @@ func (c *controller) worker(ctx context.Context) {
 				} else {
 					log.Error(err, "re-queuing item due to error processing")
 					c.metrics.IncrementSyncErrorCount(c.name)
+					debug.Default.RecordError(c.name, key, c.queue.NumRequeues(obj)+1, err)
 				}
 
 				c.queue.AddRateLimited(obj)
@@ -176,3 +224,18 @@ func (c *controller) worker(ctx context.Context) {
 	}
 	log.V(logf.DebugLevel).Info("exiting worker loop")
 }
+
+// withoutCancel returns a context that carries the values of parent, but is
+// never cancelled and has no deadline.
+func withoutCancel(parent context.Context) context.Context {
+	return detachedContext{parent: parent}
+}
+
+type detachedContext struct {
+	parent context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool)         { return time.Time{}, false }
+func (detachedContext) Done() <-chan struct{}               { return nil }
+func (detachedContext) Err() error                          { return nil }
+func (c detachedContext) Value(key interface{}) interface{} { return c.parent.Value(key) }
@@ -0,0 +1,113 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+)
+
+func TestRunDrainsInFlightItems(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	started := make(chan struct{})
+	release := make(chan struct{})
+	processed := make(chan string, 2)
+	ctxErr := make(chan error, 1)
+
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	c := newController(ctx, "test", metrics.New(logf.Log, clock.RealClock{}), func(ctx context.Context, key string) error {
+		processed <- key
+		if key == "in-flight" {
+			close(started)
+			<-release
+			ctxErr <- ctx.Err()
+		}
+		return nil
+	}, nil, nil, queue)
+	c.shutdownGracePeriod = time.Minute
+
+	stopCh := make(chan struct{})
+	runErr := make(chan error, 1)
+	queue.Add("in-flight")
+	go func() { runErr <- c.Run(1, stopCh) }()
+
+	<-started
+	queue.Add("queued")
+	// Cancelling the root context and closing stopCh together mirrors a
+	// SIGTERM being received by the controller.
+	cancel()
+	close(stopCh)
+	close(release)
+
+	if err := <-runErr; err != nil {
+		t.Fatalf("unexpected error from Run: %v", err)
+	}
+	if err := <-ctxErr; err != nil {
+		t.Errorf("expected in-flight item to be processed with a live context, got: %v", err)
+	}
+	close(processed)
+	var keys []string
+	for key := range processed {
+		keys = append(keys, key)
+	}
+	if len(keys) != 1 || keys[0] != "in-flight" {
+		t.Errorf("expected only the in-flight item to be processed, got: %v", keys)
+	}
+}
+
+func TestRunCancelsInFlightItemsAfterGracePeriod(t *testing.T) {
+	started := make(chan struct{})
+	ctxErr := make(chan error, 1)
+
+	queue := workqueue.NewRateLimitingQueue(workqueue.DefaultControllerRateLimiter())
+	c := newController(context.Background(), "test", metrics.New(logf.Log, clock.RealClock{}), func(ctx context.Context, key string) error {
+		close(started)
+		<-ctx.Done()
+		ctxErr <- ctx.Err()
+		return nil
+	}, nil, nil, queue)
+	c.shutdownGracePeriod = 10 * time.Millisecond
+
+	stopCh := make(chan struct{})
+	runErr := make(chan error, 1)
+	queue.Add("stuck")
+	go func() { runErr <- c.Run(1, stopCh) }()
+
+	<-started
+	close(stopCh)
+
+	select {
+	case err := <-runErr:
+		if err != nil {
+			t.Fatalf("unexpected error from Run: %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for Run to return after the grace period expired")
+	}
+	if err := <-ctxErr; err != context.Canceled {
+		t.Errorf("expected in-flight item's context to be cancelled, got: %v", err)
+	}
+}
@@ -0,0 +1,537 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crl
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+
+	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the name of the CRL controller.
+	ControllerName = "crl"
+
+	reasonPublished = "CRLPublished"
+	reasonError     = "CRLError"
+
+	// defaultValidity is the validity of CRLs for issuers which do not set
+	// one.
+	defaultValidity = 24 * time.Hour
+)
+
+// This controller maintains a certificate revocation list for each CA issuer
+// with a CRL configured. The CRL lists the unexpired certificates issued by
+// the CA whose CertificateRequest has the revoke annotation, and is published
+// to a Secret and/or ConfigMap in the issuer's resource namespace. It is
+// re-signed whenever a certificate is revoked, and before it expires.
+//
+// Revoked certificates are only listed for as long as their
+// CertificateRequest exists.
+type controller struct {
+	issuerLister             cmlisters.IssuerLister
+	clusterIssuerLister      cmlisters.ClusterIssuerLister
+	certificateRequestLister cmlisters.CertificateRequestLister
+	secretLister             corelisters.SecretLister
+	client                   cmclient.Interface
+	kubeClient               kubernetes.Interface
+	recorder                 record.EventRecorder
+	clock                    clock.Clock
+	scheduledWorkQueue       scheduler.ScheduledWorkQueue
+	issuerOptions            controllerpkg.IssuerOptions
+	fieldManager             string
+}
+
+// NewController returns a new CRL controller. ClusterIssuers are only
+// watched if namespace is empty.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	clock clock.Clock,
+	namespace string,
+	issuerOptions controllerpkg.IssuerOptions,
+	fieldManager string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+	certificateRequestInformer := cmFactory.Certmanager().V1().CertificateRequests()
+	secretsInformer := factory.Core().V1().Secrets()
+
+	c := &controller{
+		issuerLister:             issuerInformer.Lister(),
+		certificateRequestLister: certificateRequestInformer.Lister(),
+		secretLister:             secretsInformer.Lister(),
+		client:                   client,
+		kubeClient:               kubeClient,
+		recorder:                 recorder,
+		clock:                    clock,
+		scheduledWorkQueue:       scheduler.NewScheduledWorkQueue(clock, queue.Add),
+		issuerOptions:            issuerOptions,
+		fieldManager:             fieldManager,
+	}
+
+	// Issuers are keyed by namespace/name, and ClusterIssuers by name.
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			if key, ok := issuerKeyForRequest(obj); ok {
+				queue.Add(key)
+			}
+		},
+	})
+	secretsInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			for _, key := range c.issuerKeysForSecret(obj) {
+				queue.Add(key)
+			}
+		},
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+		certificateRequestInformer.Informer().HasSynced,
+		secretsInformer.Informer().HasSynced,
+	}
+
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		c.clusterIssuerLister = clusterIssuerInformer.Lister()
+		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return c, queue, mustSync
+}
+
+// issuerKeyForRequest returns the key of the issuer referenced by a revoked
+// CertificateRequest.
+func issuerKeyForRequest(obj interface{}) (string, bool) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		return "", false
+	}
+	if _, revoked := cr.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]; !revoked {
+		return "", false
+	}
+	ref := cr.Spec.IssuerRef
+	if ref.Group != "" && ref.Group != certmanager.GroupName {
+		return "", false
+	}
+	if ref.Kind == cmapi.ClusterIssuerKind {
+		return ref.Name, true
+	}
+	return cr.Namespace + "/" + ref.Name, true
+}
+
+// issuerKeysForSecret returns the keys of the issuers with a CRL configured
+// which use the Secret, either as their CA or to publish their CRL.
+func (c *controller) issuerKeysForSecret(obj interface{}) []string {
+	secret, ok := obj.(*corev1.Secret)
+	if !ok {
+		return nil
+	}
+
+	var keys []string
+	usesSecret := func(iss cmapi.GenericIssuer) bool {
+		ca := iss.GetSpec().CA
+		return ca != nil && ca.CRL != nil && (ca.SecretName == secret.Name || ca.CRL.SecretName == secret.Name)
+	}
+
+	issuers, err := c.issuerLister.Issuers(secret.Namespace).List(labels.Everything())
+	if err != nil {
+		return nil
+	}
+	for _, iss := range issuers {
+		if usesSecret(iss) {
+			keys = append(keys, iss.Namespace+"/"+iss.Name)
+		}
+	}
+
+	if c.clusterIssuerLister == nil {
+		return keys
+	}
+	clusterIssuers, err := c.clusterIssuerLister.List(labels.Everything())
+	if err != nil {
+		return keys
+	}
+	for _, iss := range clusterIssuers {
+		if ns, err := c.issuerOptions.ResourceNamespace(iss); err == nil && ns == secret.Namespace && usesSecret(iss) {
+			keys = append(keys, iss.Name)
+		}
+	}
+
+	return keys
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to an Issuer or ClusterIssuer to be re-synced is pulled from
+// the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	var iss cmapi.GenericIssuer
+	if namespace == "" {
+		if c.clusterIssuerLister == nil {
+			return nil
+		}
+		iss, err = c.clusterIssuerLister.Get(name)
+	} else {
+		iss, err = c.issuerLister.Issuers(namespace).Get(name)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ca := iss.GetSpec().CA
+	if ca == nil || ca.CRL == nil {
+		return nil
+	}
+	resourceNamespace, err := c.issuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonError, "Failed to load the CA key pair: %v", err)
+		// The issuer is re-synced when it is granted access to its
+		// credentials.
+		return nil
+	}
+
+	caCerts, caKey, err := kube.SecretTLSKeyPair(ctx, c.secretLister, resourceNamespace, ca.SecretName)
+	if err != nil {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonError, "Failed to load the CA key pair from Secret %q: %v", ca.SecretName, err)
+		if apierrors.IsNotFound(err) {
+			// The issuer is re-synced when the Secret is created.
+			return nil
+		}
+		return err
+	}
+	caCert := caCerts[0]
+	if caCert.KeyUsage&x509.KeyUsageCRLSign == 0 {
+		c.recorder.Event(iss, corev1.EventTypeWarning, reasonError, "The CA certificate does not have the crl sign key usage, no CRL can be published")
+		return nil
+	}
+
+	revoked, err := c.revokedCertificates(ctx, iss, caCert)
+	if err != nil {
+		return err
+	}
+
+	validity := defaultValidity
+	if ca.CRL.Validity != nil {
+		validity = ca.CRL.Validity.Duration
+	}
+
+	published, err := c.publishedCRLs(ctx, resourceNamespace, ca.CRL)
+	if err != nil {
+		return err
+	}
+
+	now := c.clock.Now()
+	number := big.NewInt(1)
+	if refreshAt, ok := upToDateCRL(published, revoked, caCert, validity); ok && now.Before(refreshAt) {
+		c.scheduledWorkQueue.Add(key, refreshAt.Sub(now))
+		return nil
+	}
+	for _, crlPEM := range published {
+		crl, err := pki.DecodeCRLBytes(crlPEM)
+		if err != nil || caCert.CheckCRLSignature(crl) != nil {
+			continue
+		}
+		if n, err := pki.CRLNumber(crl); err == nil && n != nil && n.Cmp(number) >= 0 {
+			number = new(big.Int).Add(n, big.NewInt(1))
+		}
+	}
+
+	crlPEM, err := pki.CreateCRL(revoked, number, now, now.Add(validity), caCert, caKey)
+	if err != nil {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonError, "Failed to sign CRL: %v", err)
+		return err
+	}
+	if err := c.publishCRL(ctx, resourceNamespace, ca.CRL, crlPEM); err != nil {
+		return err
+	}
+
+	c.recorder.Eventf(iss, corev1.EventTypeNormal, reasonPublished, "Published CRL number %s listing %d revoked certificates", number, len(revoked))
+	c.scheduledWorkQueue.Add(key, refreshDelay(validity))
+	return nil
+}
+
+// revokedCertificates returns the unexpired certificates signed by the CA
+// whose CertificateRequest references the issuer and has the revoke
+// annotation, sorted by serial number. The Revoked condition is added to any
+// of those CertificateRequests which do not have it yet, and its
+// lastTransitionTime is used as the revocation time.
+func (c *controller) revokedCertificates(ctx context.Context, iss cmapi.GenericIssuer, caCert *x509.Certificate) ([]pki.RevokedCertificate, error) {
+	log := logf.FromContext(ctx)
+
+	var (
+		requests []*cmapi.CertificateRequest
+		err      error
+	)
+	if iss.GetNamespace() == "" {
+		requests, err = c.certificateRequestLister.List(labels.Everything())
+	} else {
+		requests, err = c.certificateRequestLister.CertificateRequests(iss.GetNamespace()).List(labels.Everything())
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var revoked []pki.RevokedCertificate
+	for _, cr := range requests {
+		reason, ok := cr.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]
+		if !ok || len(cr.Status.Certificate) == 0 || !apiutil.CertificateRequestReferencesIssuer(cr, iss) {
+			continue
+		}
+		cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+		if err != nil {
+			log.Error(err, "ignoring revoked CertificateRequest with an invalid certificate", "resource_namespace", cr.Namespace, "resource_name", cr.Name)
+			continue
+		}
+		if cert.CheckSignatureFrom(caCert) != nil || !c.clock.Now().Before(cert.NotAfter) {
+			// Certificates signed by a previous CA are not listed in the
+			// CRL of the current one, and expired certificates no longer
+			// need to be listed.
+			continue
+		}
+		if reason == "" {
+			reason = pki.RevocationReasonUnspecified
+		}
+
+		cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked)
+		if cond == nil || cond.Status != cmmeta.ConditionTrue || cond.LastTransitionTime == nil {
+			cr = cr.DeepCopy()
+			apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked, cmmeta.ConditionTrue,
+				"Revoked", fmt.Sprintf("Certificate has been revoked with reason %q", reason))
+			if err := c.updateStatus(ctx, cr); err != nil {
+				return nil, err
+			}
+			cond = apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked)
+		}
+
+		revoked = append(revoked, pki.RevokedCertificate{
+			SerialNumber:   cert.SerialNumber,
+			RevocationTime: cond.LastTransitionTime.Time.Truncate(time.Second),
+			Reason:         reason,
+		})
+	}
+
+	sort.Slice(revoked, func(i, j int) bool {
+		return revoked[i].SerialNumber.Cmp(revoked[j].SerialNumber) < 0
+	})
+	return revoked, nil
+}
+
+func (c *controller) updateStatus(ctx context.Context, cr *cmapi.CertificateRequest) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificaterequests.ApplyStatus(ctx, c.client, c.fieldManager, cr)
+	}
+	_, err := c.client.CertmanagerV1().CertificateRequests(cr.Namespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
+	return err
+}
+
+// publishedCRLs returns the CRLs currently held by the Secret and ConfigMap
+// the CRL is published to. Missing CRLs are returned as nil entries, so that
+// they are not considered up to date.
+func (c *controller) publishedCRLs(ctx context.Context, namespace string, crl *cmapi.CACRL) ([][]byte, error) {
+	var published [][]byte
+	if crl.SecretName != "" {
+		secret, err := c.secretLister.Secrets(namespace).Get(crl.SecretName)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		var data []byte
+		if secret != nil {
+			data = secret.Data[cmmeta.CRLKey]
+		}
+		published = append(published, data)
+	}
+	if crl.ConfigMapName != "" {
+		configMap, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, crl.ConfigMapName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		var data []byte
+		if err == nil {
+			data = []byte(configMap.Data[cmmeta.CRLKey])
+		}
+		published = append(published, data)
+	}
+	return published, nil
+}
+
+// upToDateCRL returns true if every destination holds the same CRL, signed by
+// the CA, listing exactly the revoked certificates with the configured
+// validity, along with the time at which that CRL should be refreshed.
+func upToDateCRL(published [][]byte, revoked []pki.RevokedCertificate, caCert *x509.Certificate, validity time.Duration) (time.Time, bool) {
+	if len(published) == 0 || len(published[0]) == 0 {
+		return time.Time{}, false
+	}
+	for _, data := range published[1:] {
+		if string(data) != string(published[0]) {
+			return time.Time{}, false
+		}
+	}
+
+	crl, err := pki.DecodeCRLBytes(published[0])
+	if err != nil || caCert.CheckCRLSignature(crl) != nil || !pki.CRLListsRevokedCertificates(crl, revoked) {
+		return time.Time{}, false
+	}
+	thisUpdate, nextUpdate := crl.TBSCertList.ThisUpdate, crl.TBSCertList.NextUpdate
+	if nextUpdate.Sub(thisUpdate) != validity {
+		return time.Time{}, false
+	}
+
+	return thisUpdate.Add(refreshDelay(validity)), true
+}
+
+// refreshDelay returns how long after it is signed a CRL with the given
+// validity is replaced.
+func refreshDelay(validity time.Duration) time.Duration {
+	return validity * 2 / 3
+}
+
+// publishCRL writes the CRL to the Secret and ConfigMap it is published to,
+// creating them if they do not exist.
+func (c *controller) publishCRL(ctx context.Context, namespace string, crl *cmapi.CACRL, crlPEM []byte) error {
+	if crl.SecretName != "" {
+		secret, err := c.secretLister.Secrets(namespace).Get(crl.SecretName)
+		switch {
+		case apierrors.IsNotFound(err):
+			_, err = c.kubeClient.CoreV1().Secrets(namespace).Create(ctx, &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: crl.SecretName, Namespace: namespace},
+				Data:       map[string][]byte{cmmeta.CRLKey: crlPEM},
+			}, metav1.CreateOptions{})
+		case err == nil && string(secret.Data[cmmeta.CRLKey]) != string(crlPEM):
+			secret = secret.DeepCopy()
+			if secret.Data == nil {
+				secret.Data = make(map[string][]byte)
+			}
+			secret.Data[cmmeta.CRLKey] = crlPEM
+			_, err = c.kubeClient.CoreV1().Secrets(namespace).Update(ctx, secret, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to publish CRL to Secret %q: %w", crl.SecretName, err)
+		}
+	}
+
+	if crl.ConfigMapName != "" {
+		configMap, err := c.kubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, crl.ConfigMapName, metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+			_, err = c.kubeClient.CoreV1().ConfigMaps(namespace).Create(ctx, &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{Name: crl.ConfigMapName, Namespace: namespace},
+				Data:       map[string]string{cmmeta.CRLKey: string(crlPEM)},
+			}, metav1.CreateOptions{})
+		case err == nil && configMap.Data[cmmeta.CRLKey] != string(crlPEM):
+			configMap = configMap.DeepCopy()
+			if configMap.Data == nil {
+				configMap.Data = make(map[string]string)
+			}
+			configMap.Data[cmmeta.CRLKey] = string(crlPEM)
+			_, err = c.kubeClient.CoreV1().ConfigMaps(namespace).Update(ctx, configMap, metav1.UpdateOptions{})
+		}
+		if err != nil {
+			return fmt.Errorf("failed to publish CRL to ConfigMap %q: %w", crl.ConfigMapName, err)
+		}
+	}
+
+	return nil
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Clock,
+		ctx.Namespace,
+		ctx.IssuerOptions,
+		ctx.FieldManager,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
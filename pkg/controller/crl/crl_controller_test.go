@@ -0,0 +1,287 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crl
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	revokedAt := now.Add(-2 * time.Hour)
+
+	caKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caKeyPEM, err := pki.EncodePKCS8PrivateKey(caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	newCA := func(keyUsage x509.KeyUsage) (*x509.Certificate, *corev1.Secret) {
+		tmpl := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: "ca"},
+			NotBefore:             now.Add(-time.Hour),
+			NotAfter:              now.Add(365 * 24 * time.Hour),
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+			KeyUsage:              keyUsage,
+		}
+		certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, caKey.Public(), caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return cert, gen.Secret("ca-key-pair",
+			gen.SetSecretNamespace("testns"),
+			gen.SetSecretData(map[string][]byte{
+				corev1.TLSCertKey:       certPEM,
+				corev1.TLSPrivateKeyKey: caKeyPEM,
+			}),
+		)
+	}
+	caCert, caSecret := newCA(x509.KeyUsageCertSign | x509.KeyUsageCRLSign)
+	_, caSecretNoCRLSign := newCA(x509.KeyUsageCertSign)
+
+	leafKey, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leafCertPEM, leafCert, err := pki.SignCertificate(&x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+	}, caCert, leafKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	caIssuer := cmapi.CAIssuer{
+		SecretName: "ca-key-pair",
+		CRL:        &cmapi.CACRL{SecretName: "ca-crl"},
+	}
+	issuer := gen.Issuer("ca",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(caIssuer),
+	)
+
+	baseCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateRequestCertificate(leafCertPEM),
+	)
+	revokedCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevokeAnnotationKey: "keyCompromise",
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionRevoked,
+			Status:             cmmeta.ConditionTrue,
+			Reason:             "Revoked",
+			LastTransitionTime: &metav1.Time{Time: revokedAt},
+		}),
+	)
+	revoked := []pki.RevokedCertificate{{SerialNumber: leafCert.SerialNumber, RevocationTime: revokedAt, Reason: "keyCompromise"}}
+
+	crlSecret := func(number int64, thisUpdate time.Time) *corev1.Secret {
+		crlPEM, err := pki.CreateCRL(revoked, big.NewInt(number), thisUpdate, thisUpdate.Add(defaultValidity), caCert, caKey)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return gen.Secret("ca-crl",
+			gen.SetSecretNamespace("testns"),
+			gen.SetSecretData(map[string][]byte{cmmeta.CRLKey: crlPEM}),
+		)
+	}
+	// expectCRL matches an update of the CRL Secret with a CRL of the given
+	// number listing the revoked certificate.
+	expectCRL := func(number int64) testpkg.ActionMatchFn {
+		return func(exp, got coretesting.Action) error {
+			secret := got.(coretesting.UpdateAction).GetObject().(*corev1.Secret)
+			crl, err := pki.DecodeCRLBytes(secret.Data[cmmeta.CRLKey])
+			if err != nil {
+				return err
+			}
+			if err := caCert.CheckCRLSignature(crl); err != nil {
+				return err
+			}
+			if n, err := pki.CRLNumber(crl); err != nil || n.Int64() != number {
+				return fmt.Errorf("expected CRL number %d, got %v (%v)", number, n, err)
+			}
+			if !pki.CRLListsRevokedCertificates(crl, revoked) {
+				return fmt.Errorf("unexpected CRL entries: %v", crl.TBSCertList.RevokedCertificates)
+			}
+			return nil
+		}
+	}
+
+	tests := map[string]struct {
+		key               string
+		existingCM        []runtime.Object
+		existingKube      []runtime.Object
+		expectedEvents    []string
+		expectedActions   []testpkg.Action
+		expectedScheduled time.Duration
+	}{
+		"do nothing for a CA issuer without a CRL": {
+			key: "testns/ca",
+			existingCM: []runtime.Object{
+				gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca-key-pair"})),
+				revokedCR,
+			},
+			existingKube: []runtime.Object{caSecret},
+		},
+		"publish a CRL to a new Secret and ConfigMap and mark the CertificateRequest as revoked": {
+			key: "testns/ca",
+			existingCM: []runtime.Object{
+				gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{
+					SecretName: "ca-key-pair",
+					CRL:        &cmapi.CACRL{SecretName: "ca-crl", ConfigMapName: "ca-crl"},
+				})),
+				gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestAnnotations(map[string]string{
+					cmapi.CertificateRequestRevokeAnnotationKey: "keyCompromise",
+				})),
+				// Not revoked, so not listed.
+				gen.CertificateRequestFrom(baseCR, gen.SetCertificateRequestName("other")),
+			},
+			existingKube:   []runtime.Object{caSecret},
+			expectedEvents: []string{"Normal CRLPublished Published CRL number 1 listing 1 revoked certificates"},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateSubresourceAction(cmapi.SchemeGroupVersion.WithResource("certificaterequests"), "status", "testns", nil),
+					func(exp, got coretesting.Action) error {
+						cr := got.(coretesting.UpdateAction).GetObject().(*cmapi.CertificateRequest)
+						if len(cr.Status.Conditions) != 1 || cr.Status.Conditions[0].Type != cmapi.CertificateRequestConditionRevoked ||
+							cr.Status.Conditions[0].Status != cmmeta.ConditionTrue {
+							return fmt.Errorf("expected Revoked condition, got %v", cr.Status.Conditions)
+						}
+						return nil
+					}),
+				testpkg.NewAction(coretesting.NewGetAction(corev1.SchemeGroupVersion.WithResource("configmaps"), "testns", "ca-crl")),
+				testpkg.NewAction(coretesting.NewGetAction(corev1.SchemeGroupVersion.WithResource("configmaps"), "testns", "ca-crl")),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", nil), func(exp, got coretesting.Action) error {
+					// The revocation time is set by the status update
+					// above, so only check that the serial is listed.
+					crl, err := pki.DecodeCRLBytes(got.(coretesting.CreateAction).GetObject().(*corev1.Secret).Data[cmmeta.CRLKey])
+					if err != nil {
+						return err
+					}
+					if len(crl.TBSCertList.RevokedCertificates) != 1 || crl.TBSCertList.RevokedCertificates[0].SerialNumber.Cmp(leafCert.SerialNumber) != 0 {
+						return fmt.Errorf("unexpected CRL entries: %v", crl.TBSCertList.RevokedCertificates)
+					}
+					return nil
+				}),
+				testpkg.NewCustomMatch(coretesting.NewCreateAction(corev1.SchemeGroupVersion.WithResource("configmaps"), "testns", nil), func(exp, got coretesting.Action) error {
+					if len(got.(coretesting.CreateAction).GetObject().(*corev1.ConfigMap).Data[cmmeta.CRLKey]) == 0 {
+						return fmt.Errorf("expected ConfigMap to hold a CRL")
+					}
+					return nil
+				}),
+			},
+			expectedScheduled: 16 * time.Hour,
+		},
+		"do not republish an up to date CRL": {
+			key:               "testns/ca",
+			existingCM:        []runtime.Object{issuer, revokedCR},
+			existingKube:      []runtime.Object{caSecret, crlSecret(3, now.Add(-time.Hour))},
+			expectedScheduled: 15 * time.Hour,
+		},
+		"republish a CRL which is due to be refreshed with the next CRL number": {
+			key:            "testns/ca",
+			existingCM:     []runtime.Object{issuer, revokedCR},
+			existingKube:   []runtime.Object{caSecret, crlSecret(3, now.Add(-17*time.Hour))},
+			expectedEvents: []string{"Normal CRLPublished Published CRL number 4 listing 1 revoked certificates"},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", nil), expectCRL(4)),
+			},
+			expectedScheduled: 16 * time.Hour,
+		},
+		"republish a CRL which has been removed from its Secret": {
+			key:            "testns/ca",
+			existingCM:     []runtime.Object{issuer, revokedCR},
+			existingKube:   []runtime.Object{caSecret, gen.SecretFrom(crlSecret(1, now), gen.SetSecretData(nil))},
+			expectedEvents: []string{"Normal CRLPublished Published CRL number 1 listing 1 revoked certificates"},
+			expectedActions: []testpkg.Action{
+				testpkg.NewCustomMatch(coretesting.NewUpdateAction(corev1.SchemeGroupVersion.WithResource("secrets"), "testns", nil), expectCRL(1)),
+			},
+			expectedScheduled: 16 * time.Hour,
+		},
+		"report an error if the CA certificate cannot sign CRLs": {
+			key:            "testns/ca",
+			existingCM:     []runtime.Object{issuer, revokedCR},
+			existingKube:   []runtime.Object{caSecretNoCRLSign},
+			expectedEvents: []string{"Warning CRLError The CA certificate does not have the crl sign key usage, no CRL can be published"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: test.existingCM,
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			var gotScheduled time.Duration
+			w.controller.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), test.key)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != test.expectedScheduled {
+				t.Errorf("expected the issuer to be scheduled in %v, got %v", test.expectedScheduled, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
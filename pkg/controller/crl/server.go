@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package crl
+
+import (
+	"context"
+	"encoding/pem"
+	"net/http"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// Handler serves the CRLs published by the CRL controller in DER form, so
+// that their URL can be used as a CRL distribution point. The CRL of an
+// Issuer is served at /issuers/<namespace>/<name>, and the CRL of a
+// ClusterIssuer at /clusterissuers/<name>.
+//
+// CRLs are read from the API server rather than an informer cache, so that
+// every replica of the controller can serve them, not just the leader.
+type Handler struct {
+	Client        cmclient.Interface
+	KubeClient    kubernetes.Interface
+	IssuerOptions controllerpkg.IssuerOptions
+}
+
+var _ http.Handler = &Handler{}
+
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+
+	var (
+		iss cmapi.GenericIssuer
+		err error
+	)
+	ctx := r.Context()
+	switch parts := strings.Split(strings.Trim(r.URL.Path, "/"), "/"); {
+	case len(parts) == 3 && parts[0] == "issuers":
+		iss, err = h.Client.CertmanagerV1().Issuers(parts[1]).Get(ctx, parts[2], metav1.GetOptions{})
+	case len(parts) == 2 && parts[0] == "clusterissuers":
+		iss, err = h.Client.CertmanagerV1().ClusterIssuers().Get(ctx, parts[1], metav1.GetOptions{})
+	default:
+		http.NotFound(w, r)
+		return
+	}
+	if apierrors.IsNotFound(err) {
+		http.NotFound(w, r)
+		return
+	}
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to get issuer to serve CRL", "path", r.URL.Path)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+
+	der, err := h.crl(ctx, iss)
+	if err != nil {
+		logf.FromContext(ctx).Error(err, "failed to get published CRL", "path", r.URL.Path)
+		http.Error(w, http.StatusText(http.StatusInternalServerError), http.StatusInternalServerError)
+		return
+	}
+	if der == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/pkix-crl")
+	_, _ = w.Write(der)
+}
+
+// crl returns the DER encoded CRL published for the issuer, or nil if the
+// issuer has no CRL or it has not been published yet.
+func (h *Handler) crl(ctx context.Context, iss cmapi.GenericIssuer) ([]byte, error) {
+	ca := iss.GetSpec().CA
+	if ca == nil || ca.CRL == nil {
+		return nil, nil
+	}
+	namespace, err := h.IssuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		return nil, err
+	}
+
+	var crlPEM []byte
+	if ca.CRL.SecretName != "" {
+		secret, err := h.KubeClient.CoreV1().Secrets(namespace).Get(ctx, ca.CRL.SecretName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			crlPEM = secret.Data[cmmeta.CRLKey]
+		}
+	} else {
+		configMap, err := h.KubeClient.CoreV1().ConfigMaps(namespace).Get(ctx, ca.CRL.ConfigMapName, metav1.GetOptions{})
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, err
+		}
+		if err == nil {
+			crlPEM = []byte(configMap.Data[cmmeta.CRLKey])
+		}
+	}
+
+	block, _ := pem.Decode(crlPEM)
+	if block == nil {
+		return nil, nil
+	}
+	return block.Bytes, nil
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package debug records the state of each controller's workqueue, along
+// with the most recent errors returned when processing items, and serves
+// them as JSON at /debug/controllers.
+//
+// The workqueue state is collected by installing a Registry as the
+// workqueue metrics provider, so queues report to it regardless of which
+// controller constructs them.
+package debug
+
+import (
+	"container/list"
+	"encoding/json"
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// Path is the path that the controller state is served on.
+	Path = "/debug/controllers"
+
+	// maxRecentErrors is the number of errors kept for each controller.
+	maxRecentErrors = 10
+)
+
+// Default is the Registry that controllers record errors to.
+var Default = NewRegistry(clock.RealClock{})
+
+// ControllerStatus is the state of a single controller.
+type ControllerStatus struct {
+	// Name of the controller's workqueue.
+	Name string `json:"name"`
+
+	// QueueDepth is the number of items waiting to be processed.
+	QueueDepth int `json:"queueDepth"`
+
+	// OldestItemAgeSeconds is how long the item at the front of the queue
+	// has been waiting. It is approximate, as an item that is re-added
+	// while it is being processed is counted from when it was re-added
+	// but only queued once processing has finished.
+	OldestItemAgeSeconds float64 `json:"oldestItemAgeSeconds"`
+
+	// Adds is the total number of items added to the queue.
+	Adds int64 `json:"adds"`
+
+	// Retries is the total number of items re-queued after failing.
+	Retries int64 `json:"retries"`
+
+	// LongestRunningProcessorSeconds is how long the longest running
+	// worker has been processing its current item.
+	LongestRunningProcessorSeconds float64 `json:"longestRunningProcessorSeconds"`
+
+	// RecentErrors are the most recent errors returned when processing
+	// items, newest first.
+	RecentErrors []Error `json:"recentErrors,omitempty"`
+}
+
+// Error is an error returned when processing an item.
+type Error struct {
+	// Key of the item that failed.
+	Key string `json:"key"`
+
+	// Error message.
+	Error string `json:"error"`
+
+	// Requeues is the number of times the item has been re-queued after
+	// failing, including this time.
+	Requeues int `json:"requeues"`
+
+	// Time the error occurred.
+	Time time.Time `json:"time"`
+}
+
+// Registry records the state of controllers by name. It implements
+// workqueue.MetricsProvider.
+type Registry struct {
+	clock clock.Clock
+
+	lock        sync.Mutex
+	controllers map[string]*controllerState
+}
+
+type controllerState struct {
+	// addTimes holds the time each item in the queue was added, oldest
+	// first.
+	addTimes *list.List
+
+	adds                           int64
+	retries                        int64
+	longestRunningProcessorSeconds float64
+	recentErrors                   []Error
+}
+
+var _ workqueue.MetricsProvider = &Registry{}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry(clock clock.Clock) *Registry {
+	return &Registry{
+		clock:       clock,
+		controllers: make(map[string]*controllerState),
+	}
+}
+
+// SetWorkqueueProvider sets the Registry as the workqueue metrics provider.
+// It must be called before any workqueues are constructed, and only has an
+// effect the first time a provider is set in the process.
+func (r *Registry) SetWorkqueueProvider() {
+	workqueue.SetProvider(r)
+}
+
+// RecordError records an error returned when processing an item.
+func (r *Registry) RecordError(name, key string, requeues int, err error) {
+	r.update(name, func(s *controllerState) {
+		s.recentErrors = append([]Error{{
+			Key:      key,
+			Error:    err.Error(),
+			Requeues: requeues,
+			Time:     r.clock.Now(),
+		}}, s.recentErrors...)
+		if len(s.recentErrors) > maxRecentErrors {
+			s.recentErrors = s.recentErrors[:maxRecentErrors]
+		}
+	})
+}
+
+// Status returns the state of all controllers, sorted by name.
+func (r *Registry) Status() []ControllerStatus {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := r.clock.Now()
+	statuses := make([]ControllerStatus, 0, len(r.controllers))
+	for name, s := range r.controllers {
+		status := ControllerStatus{
+			Name:                           name,
+			QueueDepth:                     s.addTimes.Len(),
+			Adds:                           s.adds,
+			Retries:                        s.retries,
+			LongestRunningProcessorSeconds: s.longestRunningProcessorSeconds,
+			RecentErrors:                   append([]Error(nil), s.recentErrors...),
+		}
+		if front := s.addTimes.Front(); front != nil {
+			status.OldestItemAgeSeconds = now.Sub(front.Value.(time.Time)).Seconds()
+		}
+		statuses = append(statuses, status)
+	}
+	sort.Slice(statuses, func(i, j int) bool { return statuses[i].Name < statuses[j].Name })
+	return statuses
+}
+
+// ServeHTTP serves the state of all controllers as JSON.
+func (r *Registry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	if req.Method != http.MethodGet {
+		w.Header().Set("Allow", http.MethodGet)
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	_ = enc.Encode(struct {
+		Controllers []ControllerStatus `json:"controllers"`
+	}{r.Status()})
+}
+
+// Install registers the handler for the controller state with the mux.
+func Install(mux *http.ServeMux, r *Registry) {
+	mux.Handle(Path, r)
+}
+
+func (r *Registry) update(name string, fn func(*controllerState)) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	s, ok := r.controllers[name]
+	if !ok {
+		s = &controllerState{addTimes: list.New()}
+		r.controllers[name] = s
+	}
+	fn(s)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	fakeclock "k8s.io/utils/clock/testing"
+)
+
+func TestRegistry(t *testing.T) {
+	start := time.Date(2022, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := fakeclock.NewFakeClock(start)
+	r := NewRegistry(clock)
+
+	depth := r.NewDepthMetric("certificates-issuing")
+	adds := r.NewAddsMetric("certificates-issuing")
+	retries := r.NewRetriesMetric("certificates-issuing")
+	longest := r.NewLongestRunningProcessorSecondsMetric("certificates-issuing")
+	r.NewDepthMetric("orders")
+
+	for i := 0; i < 3; i++ {
+		adds.Inc()
+		depth.Inc()
+		clock.Step(time.Second)
+	}
+	// the first item is taken from the queue
+	depth.Dec()
+	retries.Inc()
+	longest.Set(1.5)
+	clock.Step(time.Second)
+
+	for i := 0; i < maxRecentErrors+1; i++ {
+		r.RecordError("certificates-issuing", fmt.Sprintf("ns/crt-%d", i), i, errors.New("failed"))
+	}
+
+	statuses := r.Status()
+	if len(statuses) != 2 {
+		t.Fatalf("expected 2 controllers, got %d", len(statuses))
+	}
+	if statuses[0].Name != "certificates-issuing" || statuses[1].Name != "orders" {
+		t.Errorf("expected controllers sorted by name, got %q, %q", statuses[0].Name, statuses[1].Name)
+	}
+
+	s := statuses[0]
+	if s.QueueDepth != 2 || s.Adds != 3 || s.Retries != 1 || s.LongestRunningProcessorSeconds != 1.5 {
+		t.Errorf("unexpected status %+v", s)
+	}
+	// the oldest remaining item was added one second after start
+	if s.OldestItemAgeSeconds != 3 {
+		t.Errorf("expected oldest item age of 3s, got %v", s.OldestItemAgeSeconds)
+	}
+	if len(s.RecentErrors) != maxRecentErrors {
+		t.Fatalf("expected %d errors, got %d", maxRecentErrors, len(s.RecentErrors))
+	}
+	if e := s.RecentErrors[0]; e.Key != fmt.Sprintf("ns/crt-%d", maxRecentErrors) || e.Requeues != maxRecentErrors || e.Error != "failed" {
+		t.Errorf("expected newest error first, got %+v", e)
+	}
+
+	if statuses[1].QueueDepth != 0 || statuses[1].OldestItemAgeSeconds != 0 {
+		t.Errorf("expected empty queue, got %+v", statuses[1])
+	}
+}
+
+func TestDepthMetricNeverNegative(t *testing.T) {
+	r := NewRegistry(fakeclock.NewFakeClock(time.Now()))
+	r.NewDepthMetric("test").Dec()
+	if depth := r.Status()[0].QueueDepth; depth != 0 {
+		t.Errorf("expected depth 0, got %d", depth)
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	r := NewRegistry(fakeclock.NewFakeClock(time.Now()))
+	r.NewAddsMetric("test").Inc()
+	r.RecordError("test", "ns/name", 1, errors.New("failed"))
+
+	mux := http.NewServeMux()
+	Install(mux, r)
+
+	rec := httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, Path, nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if ct := rec.Header().Get("Content-Type"); ct != "application/json" {
+		t.Errorf("expected JSON content type, got %q", ct)
+	}
+
+	var body struct {
+		Controllers []ControllerStatus `json:"controllers"`
+	}
+	if err := json.Unmarshal(rec.Body.Bytes(), &body); err != nil {
+		t.Fatal(err)
+	}
+	if len(body.Controllers) != 1 || body.Controllers[0].Adds != 1 || len(body.Controllers[0].RecentErrors) != 1 {
+		t.Errorf("unexpected response %s", rec.Body.String())
+	}
+
+	rec = httptest.NewRecorder()
+	mux.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, Path, nil))
+	if rec.Code != http.StatusMethodNotAllowed {
+		t.Errorf("expected status 405, got %d", rec.Code)
+	}
+}
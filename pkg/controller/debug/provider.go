@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package debug
+
+import (
+	"k8s.io/client-go/util/workqueue"
+)
+
+// depthMetric tracks the time at which each item was added to the queue.
+// Queues are first in first out, so the item taken from the queue is always
+// the oldest one.
+type depthMetric struct {
+	registry *Registry
+	name     string
+}
+
+func (m depthMetric) Inc() {
+	now := m.registry.clock.Now()
+	m.registry.update(m.name, func(s *controllerState) { s.addTimes.PushBack(now) })
+}
+
+func (m depthMetric) Dec() {
+	m.registry.update(m.name, func(s *controllerState) {
+		if front := s.addTimes.Front(); front != nil {
+			s.addTimes.Remove(front)
+		}
+	})
+}
+
+type counterMetric struct {
+	registry *Registry
+	name     string
+	inc      func(s *controllerState)
+}
+
+func (m counterMetric) Inc() {
+	m.registry.update(m.name, m.inc)
+}
+
+type longestRunningProcessorMetric struct {
+	registry *Registry
+	name     string
+}
+
+func (m longestRunningProcessorMetric) Set(v float64) {
+	m.registry.update(m.name, func(s *controllerState) { s.longestRunningProcessorSeconds = v })
+}
+
+type noopMetric struct{}
+
+func (noopMetric) Observe(float64) {}
+func (noopMetric) Set(float64)     {}
+
+// NewDepthMetric also registers the controller, as every queue creates a
+// depth metric, so that idle controllers are listed.
+func (r *Registry) NewDepthMetric(name string) workqueue.GaugeMetric {
+	r.update(name, func(*controllerState) {})
+	return depthMetric{registry: r, name: name}
+}
+
+func (r *Registry) NewAddsMetric(name string) workqueue.CounterMetric {
+	return counterMetric{registry: r, name: name, inc: func(s *controllerState) { s.adds++ }}
+}
+
+func (r *Registry) NewLatencyMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (r *Registry) NewWorkDurationMetric(name string) workqueue.HistogramMetric {
+	return noopMetric{}
+}
+
+func (r *Registry) NewUnfinishedWorkSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return noopMetric{}
+}
+
+func (r *Registry) NewLongestRunningProcessorSecondsMetric(name string) workqueue.SettableGaugeMetric {
+	return longestRunningProcessorMetric{registry: r, name: name}
+}
+
+func (r *Registry) NewRetriesMetric(name string) workqueue.CounterMetric {
+	return counterMetric{registry: r, name: name, inc: func(s *controllerState) { s.retries++ }}
+}
@@ -17,17 +17,63 @@ limitations under the License.
 package controller
 
 import (
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/labels"
+
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 // ResourceNamespace returns the Kubernetes namespace where resources
 // created or read by `iss` are located.
-func (o IssuerOptions) ResourceNamespace(iss cmapi.GenericIssuer) string {
-	ns := iss.GetObjectMeta().Namespace
+// ClusterIssuers use their credentials namespace if one is set, otherwise
+// the cluster resource namespace. An error is returned if no CredentialGrant
+// allows the ClusterIssuer to read resources from its credentials namespace.
+func (o IssuerOptions) ResourceNamespace(iss cmapi.GenericIssuer) (string, error) {
+	if ns := iss.GetObjectMeta().Namespace; ns != "" {
+		return ns, nil
+	}
+	ns := iss.GetSpec().CredentialsNamespace
 	if ns == "" {
-		ns = o.ClusterResourceNamespace
+		return o.ClusterResourceNamespace, nil
+	}
+
+	granted, err := o.CredentialsGranted(iss)
+	if err != nil {
+		return "", err
+	}
+	if !granted {
+		return "", fmt.Errorf("no CredentialGrant in namespace %q allows ClusterIssuer %q to read its credentials", ns, iss.GetObjectMeta().Name)
+	}
+	return ns, nil
+}
+
+// CredentialsGranted returns whether `iss` may read resources from its
+// credentials namespace. Issuers and ClusterIssuers using the cluster
+// resource namespace may always do so, any other namespace must contain a
+// CredentialGrant naming the ClusterIssuer.
+func (o IssuerOptions) CredentialsGranted(iss cmapi.GenericIssuer) (bool, error) {
+	ns := iss.GetSpec().CredentialsNamespace
+	if iss.GetObjectMeta().Namespace != "" || ns == "" || ns == o.ClusterResourceNamespace {
+		return true, nil
 	}
-	return ns
+	if o.CredentialGrantLister == nil {
+		return false, nil
+	}
+
+	grants, err := o.CredentialGrantLister.CredentialGrants(ns).List(labels.Everything())
+	if err != nil {
+		return false, fmt.Errorf("error listing CredentialGrants in namespace %q: %w", ns, err)
+	}
+	for _, grant := range grants {
+		for _, name := range grant.Spec.ClusterIssuers {
+			if name == iss.GetObjectMeta().Name {
+				return true, nil
+			}
+		}
+	}
+
+	return false, nil
 }
 
 // CanUseAmbientCredentials returns whether `iss` will attempt to configure itself
@@ -0,0 +1,350 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingressclassmigration
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/informers"
+	"k8s.io/client-go/kubernetes"
+	networkinglisters "k8s.io/client-go/listers/networking/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the name of the ingress class migration controller.
+	ControllerName = "ingress-class-migration"
+
+	// AnnotationIngressClass is the deprecated annotation used to select the
+	// ingress class of an Ingress.
+	AnnotationIngressClass = "kubernetes.io/ingress.class"
+
+	// migrationKey is the only key added to the workqueue. Any change to a
+	// watched resource triggers a scan of all of them, so that the report of
+	// remaining usages is always complete.
+	migrationKey = "ingress-class-migration"
+
+	reasonIngressClassMigrated   = "IngressClassMigrated"
+	reasonDeprecatedIngressClass = "DeprecatedIngressClass"
+)
+
+// Usage is a solver config or solver Ingress which still relies on the
+// deprecated `kubernetes.io/ingress.class` annotation.
+type Usage struct {
+	// Kind of the resource, one of Issuer, ClusterIssuer or Ingress.
+	Kind string
+
+	// Namespace of the resource, empty for ClusterIssuers.
+	Namespace string
+
+	// Name of the resource.
+	Name string
+
+	// Field is the path to the field setting the ingress class.
+	Field string
+
+	// Class is the ingress class that is used.
+	Class string
+}
+
+func (u Usage) String() string {
+	name := u.Name
+	if u.Namespace != "" {
+		name = u.Namespace + "/" + u.Name
+	}
+	return fmt.Sprintf("%s %s %s=%q", u.Kind, name, u.Field, u.Class)
+}
+
+// This controller migrates HTTP01 solvers from the deprecated `class` field,
+// which sets the `kubernetes.io/ingress.class` annotation, to the
+// `ingressClassName` field, along with any solver Ingresses already created
+// using the annotation.
+// A solver is only migrated once an IngressClass with the same name as the
+// ingress class exists, as otherwise ingress controllers which still rely on
+// the annotation would stop routing challenge requests.
+// Usages which cannot be migrated are reported with a Warning event and in
+// the controller logs.
+type controller struct {
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	ingressLister       networkinglisters.IngressLister
+	ingressClassLister  networkinglisters.IngressClassLister
+	cmClient            cmclient.Interface
+	kubeClient          kubernetes.Interface
+	recorder            record.EventRecorder
+
+	// fieldManager is the string which will be used as the Field Manager on
+	// fields created or edited by the cert-manager Kubernetes client during
+	// Create or Apply API calls.
+	fieldManager string
+
+	// reported is the set of usages reported by the previous scan, so that
+	// each usage is only reported once.
+	reported sets.String
+}
+
+// NewController returns a new ingress class migration controller.
+func NewController(
+	log logr.Logger,
+	cmClient cmclient.Interface,
+	kubeClient kubernetes.Interface,
+	factory informers.SharedInformerFactory,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	fieldManager string,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+	clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+	ingressInformer := factory.Networking().V1().Ingresses()
+	ingressClassInformer := factory.Networking().V1().IngressClasses()
+
+	enqueue := &controllerpkg.BlockingEventHandler{WorkFunc: func(interface{}) { queue.Add(migrationKey) }}
+	issuerInformer.Informer().AddEventHandler(enqueue)
+	clusterIssuerInformer.Informer().AddEventHandler(enqueue)
+	ingressClassInformer.Informer().AddEventHandler(enqueue)
+	ingressInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: func(obj interface{}) {
+		if ing, ok := obj.(*networkingv1.Ingress); ok && isSolverIngress(ing) {
+			queue.Add(migrationKey)
+		}
+	}})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+		clusterIssuerInformer.Informer().HasSynced,
+		ingressInformer.Informer().HasSynced,
+		ingressClassInformer.Informer().HasSynced,
+	}
+
+	return &controller{
+		issuerLister:        issuerInformer.Lister(),
+		clusterIssuerLister: clusterIssuerInformer.Lister(),
+		ingressLister:       ingressInformer.Lister(),
+		ingressClassLister:  ingressClassInformer.Lister(),
+		cmClient:            cmClient,
+		kubeClient:          kubeClient,
+		recorder:            recorder,
+		fieldManager:        fieldManager,
+		reported:            sets.NewString(),
+	}, queue, mustSync
+}
+
+// ProcessItem migrates every solver config and solver Ingress which can be
+// migrated, and reports those which remain.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx)
+
+	ingressClasses, err := c.ingressClassLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	classes := sets.NewString()
+	for _, ic := range ingressClasses {
+		classes.Insert(ic.Name)
+	}
+
+	var remaining []Usage
+	var errs []error
+
+	issuers, err := c.issuerLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, iss := range issuers {
+		iss = iss.DeepCopy()
+		migrated, usages := migrateSolvers(&iss.Spec, classes, Usage{Kind: cmapi.IssuerKind, Namespace: iss.Namespace, Name: iss.Name})
+		if len(migrated) > 0 {
+			if _, err := c.cmClient.CertmanagerV1().Issuers(iss.Namespace).Update(ctx, iss, metav1.UpdateOptions{FieldManager: c.fieldManager}); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			c.recordMigrated(iss, migrated)
+		}
+		c.recordRemaining(iss, usages)
+		remaining = append(remaining, usages...)
+	}
+
+	clusterIssuers, err := c.clusterIssuerLister.List(labels.Everything())
+	if err != nil {
+		return err
+	}
+	for _, iss := range clusterIssuers {
+		iss = iss.DeepCopy()
+		migrated, usages := migrateSolvers(&iss.Spec, classes, Usage{Kind: cmapi.ClusterIssuerKind, Name: iss.Name})
+		if len(migrated) > 0 {
+			if _, err := c.cmClient.CertmanagerV1().ClusterIssuers().Update(ctx, iss, metav1.UpdateOptions{FieldManager: c.fieldManager}); err != nil {
+				errs = append(errs, err)
+				continue
+			}
+			c.recordMigrated(iss, migrated)
+		}
+		c.recordRemaining(iss, usages)
+		remaining = append(remaining, usages...)
+	}
+
+	ingresses, err := c.ingressLister.List(labels.SelectorFromSet(labels.Set{cmacme.SolverIdentificationLabelKey: "true"}))
+	if err != nil {
+		return err
+	}
+	for _, ing := range ingresses {
+		class, ok := ing.Annotations[AnnotationIngressClass]
+		if !ok || ing.Spec.IngressClassName != nil {
+			continue
+		}
+		if !classes.Has(class) {
+			remaining = append(remaining, Usage{Kind: "Ingress", Namespace: ing.Namespace, Name: ing.Name, Field: "metadata.annotations", Class: class})
+			continue
+		}
+		ing = ing.DeepCopy()
+		delete(ing.Annotations, AnnotationIngressClass)
+		ing.Spec.IngressClassName = &class
+		if _, err := c.kubeClient.NetworkingV1().Ingresses(ing.Namespace).Update(ctx, ing, metav1.UpdateOptions{FieldManager: c.fieldManager}); err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		logf.WithResource(log, ing).Info("migrated solver ingress to ingressClassName", "ingressClassName", class)
+	}
+
+	c.report(log, remaining)
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// migrateSolvers moves the ingress class of each HTTP01 solver in the spec to
+// ingressClassName if an IngressClass of that name exists. It returns the
+// usages which were migrated, and those which remain.
+func migrateSolvers(spec *cmapi.IssuerSpec, classes sets.String, resource Usage) (migrated, remaining []Usage) {
+	if spec.ACME == nil {
+		return nil, nil
+	}
+	for i, sol := range spec.ACME.Solvers {
+		if sol.HTTP01 == nil || sol.HTTP01.Ingress == nil || sol.HTTP01.Ingress.Class == nil {
+			continue
+		}
+		ingress := sol.HTTP01.Ingress
+		usage := resource
+		usage.Field = fmt.Sprintf("spec.acme.solvers[%d].http01.ingress.class", i)
+		usage.Class = *ingress.Class
+		if !classes.Has(usage.Class) || ingress.IngressClassName != nil {
+			remaining = append(remaining, usage)
+			continue
+		}
+		ingress.IngressClassName = ingress.Class
+		ingress.Class = nil
+		migrated = append(migrated, usage)
+	}
+	return migrated, remaining
+}
+
+func (c *controller) recordMigrated(obj runtime.Object, migrated []Usage) {
+	for _, u := range migrated {
+		c.recorder.Eventf(obj, corev1.EventTypeNormal, reasonIngressClassMigrated,
+			"Migrated %s to ingressClassName %q", u.Field, u.Class)
+	}
+}
+
+func (c *controller) recordRemaining(obj runtime.Object, remaining []Usage) {
+	for _, u := range remaining {
+		if c.reported.Has(u.String()) {
+			continue
+		}
+		c.recorder.Eventf(obj, corev1.EventTypeWarning, reasonDeprecatedIngressClass,
+			"%s uses the deprecated %s annotation and could not be migrated to ingressClassName as no IngressClass named %q exists",
+			u.Field, AnnotationIngressClass, u.Class)
+	}
+}
+
+// report logs the usages which remain if they have changed since the
+// previous scan.
+func (c *controller) report(log logr.Logger, remaining []Usage) {
+	current := sets.NewString()
+	for _, u := range remaining {
+		current.Insert(u.String())
+	}
+	if current.Equal(c.reported) {
+		return
+	}
+	c.reported = current
+
+	if current.Len() == 0 {
+		log.Info("no solvers or solver ingresses remain using the deprecated ingress class annotation", "annotation", AnnotationIngressClass)
+		return
+	}
+	usages := current.List()
+	log.Info("solvers and solver ingresses remain using the deprecated ingress class annotation",
+		"annotation", AnnotationIngressClass, "count", len(usages), "usages", usages)
+}
+
+func isSolverIngress(ing *networkingv1.Ingress) bool {
+	return ing.Labels[cmacme.SolverIdentificationLabelKey] == "true"
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.Client,
+		ctx.KubeSharedInformerFactory,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.FieldManager,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingressclassmigration
+
+import (
+	"context"
+	"testing"
+
+	"github.com/go-logr/logr"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func ingressSolver(class, ingressClassName *string) cmacme.ACMEChallengeSolver {
+	return cmacme.ACMEChallengeSolver{
+		HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+			Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+				Class:            class,
+				IngressClassName: ingressClassName,
+			},
+		},
+	}
+}
+
+func solverIngress(name string, annotations map[string]string, ingressClassName *string) *networkingv1.Ingress {
+	return &networkingv1.Ingress{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "testns",
+			Labels:      map[string]string{cmacme.SolverIdentificationLabelKey: "true"},
+			Annotations: annotations,
+		},
+		Spec: networkingv1.IngressSpec{IngressClassName: ingressClassName},
+	}
+}
+
+func TestProcessItem(t *testing.T) {
+	nginx := &networkingv1.IngressClass{ObjectMeta: metav1.ObjectMeta{Name: "nginx"}}
+
+	issuer := gen.Issuer("test",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+			ingressSolver(pointer.String("nginx"), nil),
+			ingressSolver(pointer.String("legacy"), nil),
+		}),
+	)
+	clusterIssuer := gen.ClusterIssuer("test",
+		gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+			ingressSolver(pointer.String("nginx"), nil),
+		}),
+	)
+	migratedIssuer := gen.IssuerFrom(issuer,
+		gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+			ingressSolver(nil, pointer.String("nginx")),
+			ingressSolver(pointer.String("legacy"), nil),
+		}),
+	)
+	migratedClusterIssuer := gen.ClusterIssuerFrom(clusterIssuer.DeepCopy(),
+		gen.SetIssuerACMESolvers([]cmacme.ACMEChallengeSolver{
+			ingressSolver(nil, pointer.String("nginx")),
+		}),
+	)
+
+	tests := map[string]struct {
+		existingCM     []runtime.Object
+		existingKube   []runtime.Object
+		expectedEvents []string
+		expectedAction []testpkg.Action
+	}{
+		"do nothing if no solvers use the ingress class annotation": {
+			existingCM: []runtime.Object{migratedClusterIssuer},
+			existingKube: []runtime.Object{nginx,
+				solverIngress("cm-acme-http-solver-abcde", nil, pointer.String("nginx")),
+				// Ingresses which were not created by a solver are ignored.
+				&networkingv1.Ingress{ObjectMeta: metav1.ObjectMeta{
+					Name:        "app",
+					Namespace:   "testns",
+					Annotations: map[string]string{AnnotationIngressClass: "nginx"},
+				}},
+			},
+		},
+		"migrate solvers and solver ingresses using an existing ingress class": {
+			existingCM: []runtime.Object{issuer, clusterIssuer},
+			existingKube: []runtime.Object{nginx,
+				solverIngress("cm-acme-http-solver-abcde", map[string]string{AnnotationIngressClass: "nginx", "other": "annotation"}, nil),
+				solverIngress("cm-acme-http-solver-fghij", map[string]string{AnnotationIngressClass: "legacy"}, nil),
+			},
+			expectedEvents: []string{
+				`Normal IngressClassMigrated Migrated spec.acme.solvers[0].http01.ingress.class to ingressClassName "nginx"`,
+				`Warning DeprecatedIngressClass spec.acme.solvers[1].http01.ingress.class uses the deprecated kubernetes.io/ingress.class annotation and could not be migrated to ingressClassName as no IngressClass named "legacy" exists`,
+				`Normal IngressClassMigrated Migrated spec.acme.solvers[0].http01.ingress.class to ingressClassName "nginx"`,
+			},
+			expectedAction: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					cmapi.SchemeGroupVersion.WithResource("issuers"), "testns", migratedIssuer,
+				)),
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					cmapi.SchemeGroupVersion.WithResource("clusterissuers"), "", migratedClusterIssuer,
+				)),
+				testpkg.NewAction(coretesting.NewUpdateAction(
+					networkingv1.SchemeGroupVersion.WithResource("ingresses"), "testns",
+					solverIngress("cm-acme-http-solver-abcde", map[string]string{"other": "annotation"}, pointer.String("nginx")),
+				)),
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: test.existingCM,
+				KubeObjects:        test.existingKube,
+				ExpectedActions:    test.expectedAction,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), migrationKey)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
+
+func TestReport(t *testing.T) {
+	c := &controller{}
+	usage := Usage{Kind: cmapi.ClusterIssuerKind, Name: "test", Field: "spec.acme.solvers[0].http01.ingress.class", Class: "legacy"}
+	c.report(logr.Discard(), []Usage{usage})
+	if !c.reported.Has(`ClusterIssuer test spec.acme.solvers[0].http01.ingress.class="legacy"`) {
+		t.Errorf("expected usage to be reported, got %v", c.reported.List())
+	}
+	c.report(logr.Discard(), nil)
+	if c.reported.Len() != 0 {
+		t.Errorf("expected no usages to be reported, got %v", c.reported.List())
+	}
+}
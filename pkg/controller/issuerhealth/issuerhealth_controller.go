@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerhealth
+
+import (
+	"context"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	internalissuers "github.com/cert-manager/cert-manager/internal/controller/issuers"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/metrics"
+	"github.com/cert-manager/cert-manager/pkg/scheduler"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+const (
+	// ControllerName is the name of the issuer health check controller.
+	ControllerName = "issuer-health"
+
+	reasonHealthCheckSucceeded = "HealthCheckSucceeded"
+	reasonHealthCheckFailed    = "HealthCheckFailed"
+
+	messageHealthCheckSucceeded = "Issuer health check succeeded"
+
+	// checkTimeout is the maximum time a single health check may take.
+	checkTimeout = 30 * time.Second
+)
+
+// This controller periodically checks the connectivity and credentials of
+// every Issuer and ClusterIssuer whose type depends on a remote service, such
+// as ACME, Vault and Venafi issuers. The result is reported using the
+// `Healthy` status condition and the certmanager_issuer_healthy metric, so
+// that broken credentials are detected before the next renewal fails.
+// Issuer types which do not implement issuer.HealthChecker are ignored.
+type controller struct {
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	issuerFactory       issuer.Factory
+	cmClient            cmclient.Interface
+	recorder            record.EventRecorder
+	metrics             *metrics.Metrics
+	scheduledWorkQueue  scheduler.ScheduledWorkQueue
+
+	// checkInterval is how often each issuer is checked.
+	checkInterval time.Duration
+
+	// fieldManager is the manager name used for the Apply operations.
+	fieldManager string
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	// Issuers are keyed by namespace/name, and ClusterIssuers by name.
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+	issuerInformer.Informer().AddEventHandler(c.eventHandler(queue))
+	c.issuerLister = issuerInformer.Lister()
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+	}
+
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerInformer.Informer().AddEventHandler(c.eventHandler(queue))
+		c.clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	c.issuerFactory = issuer.NewFactory(ctx)
+	c.cmClient = ctx.CMClient
+	c.recorder = ctx.Recorder
+	c.metrics = ctx.Metrics
+	c.scheduledWorkQueue = scheduler.NewScheduledWorkQueue(ctx.Clock, queue.Add)
+	c.checkInterval = ctx.IssuerOptions.HealthCheckInterval
+	c.fieldManager = ctx.FieldManager
+
+	return queue, mustSync, nil
+}
+
+// eventHandler enqueues issuers when they are created and when their spec
+// changes. Status updates, including those made by this controller, do not
+// trigger a check, as issuers are otherwise re-checked every checkInterval.
+func (c *controller) eventHandler(queue workqueue.RateLimitingInterface) cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: func(obj interface{}) {
+			if key, err := controllerpkg.KeyFunc(obj); err == nil {
+				queue.Add(key)
+			}
+		},
+		UpdateFunc: func(oldObj, newObj interface{}) {
+			oldIss, ok := oldObj.(cmapi.GenericIssuer)
+			if !ok {
+				return
+			}
+			newIss, ok := newObj.(cmapi.GenericIssuer)
+			if !ok || oldIss.GetGeneration() == newIss.GetGeneration() {
+				return
+			}
+			if key, err := controllerpkg.KeyFunc(newIss); err == nil {
+				queue.Add(key)
+			}
+		},
+		DeleteFunc: func(obj interface{}) {
+			if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+				obj = tombstone.Obj
+			}
+			iss, ok := obj.(cmapi.GenericIssuer)
+			if !ok {
+				return
+			}
+			if key, err := controllerpkg.KeyFunc(iss); err == nil {
+				c.scheduledWorkQueue.Forget(key)
+			}
+			if c.metrics != nil {
+				c.metrics.RemoveIssuerHealth(issuerLabels(iss)...)
+			}
+		},
+	}
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to an Issuer or ClusterIssuer to be checked is pulled from
+// the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	var iss cmapi.GenericIssuer
+	if namespace == "" {
+		if c.clusterIssuerLister == nil {
+			return nil
+		}
+		iss, err = c.clusterIssuerLister.Get(name)
+	} else {
+		iss, err = c.issuerLister.Issuers(namespace).Get(name)
+	}
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	i, err := c.issuerFactory.IssuerFor(iss.DeepCopyObject().(cmapi.GenericIssuer))
+	if err != nil {
+		// The issuers controller reports invalid issuer configurations.
+		log.V(logf.DebugLevel).Info("skipping health check of issuer which cannot be constructed", "error", err.Error())
+		return nil
+	}
+	checker, ok := i.(issuer.HealthChecker)
+	if !ok {
+		return nil
+	}
+
+	// Ensure the issuer is checked again after checkInterval, whatever the
+	// outcome of this check.
+	defer c.scheduledWorkQueue.Add(key, c.checkInterval)
+
+	checkCtx, cancel := context.WithTimeout(ctx, checkTimeout)
+	defer cancel()
+	checkErr := checker.HealthCheck(checkCtx)
+
+	if c.metrics != nil {
+		c.metrics.SetIssuerHealthy(checkErr == nil, issuerLabels(iss)...)
+	}
+
+	status, reason, message, eventType := cmmeta.ConditionTrue, reasonHealthCheckSucceeded, messageHealthCheckSucceeded, corev1.EventTypeNormal
+	if checkErr != nil {
+		log.V(logf.WarnLevel).Info("issuer health check failed", "error", checkErr.Error())
+		status, reason, message, eventType = cmmeta.ConditionFalse, reasonHealthCheckFailed, "Issuer health check failed: "+checkErr.Error(), corev1.EventTypeWarning
+	}
+
+	// Only update the issuer when the result of the check has changed, rather
+	// than after every check.
+	if cond := healthyCondition(iss); cond != nil && cond.Status == status && cond.Message == message && cond.ObservedGeneration == iss.GetGeneration() {
+		return nil
+	}
+
+	if err := c.setHealthyCondition(ctx, iss, status, reason, message); err != nil {
+		return err
+	}
+
+	if cond := healthyCondition(iss); cond == nil || cond.Status != status {
+		c.recorder.Event(iss, eventType, reason, message)
+	}
+
+	return nil
+}
+
+// setHealthyCondition sets the Healthy condition of the issuer. When server
+// side apply is enabled, only the Healthy condition is applied, so that the
+// other conditions stay owned by the controllers which set them.
+func (c *controller) setHealthyCondition(ctx context.Context, iss cmapi.GenericIssuer, status cmmeta.ConditionStatus, reason, message string) error {
+	switch iss := iss.(type) {
+	case *cmapi.Issuer:
+		iss = iss.DeepCopy()
+		apiutil.SetIssuerCondition(iss, iss.Generation, cmapi.IssuerConditionHealthy, status, reason, message)
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+			iss.Status = cmapi.IssuerStatus{Conditions: []cmapi.IssuerCondition{*healthyCondition(iss)}}
+			return internalissuers.ApplyIssuerStatus(ctx, c.cmClient, c.fieldManager, iss)
+		}
+		_, err := c.cmClient.CertmanagerV1().Issuers(iss.Namespace).UpdateStatus(ctx, iss, metav1.UpdateOptions{})
+		return err
+
+	case *cmapi.ClusterIssuer:
+		iss = iss.DeepCopy()
+		apiutil.SetIssuerCondition(iss, iss.Generation, cmapi.IssuerConditionHealthy, status, reason, message)
+		if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+			iss.Status = cmapi.IssuerStatus{Conditions: []cmapi.IssuerCondition{*healthyCondition(iss)}}
+			return internalissuers.ApplyClusterIssuerStatus(ctx, c.cmClient, c.fieldManager, iss)
+		}
+		_, err := c.cmClient.CertmanagerV1().ClusterIssuers().UpdateStatus(ctx, iss, metav1.UpdateOptions{})
+		return err
+	}
+
+	return nil
+}
+
+func healthyCondition(iss cmapi.GenericIssuer) *cmapi.IssuerCondition {
+	for i, cond := range iss.GetStatus().Conditions {
+		if cond.Type == cmapi.IssuerConditionHealthy {
+			return &iss.GetStatus().Conditions[i]
+		}
+	}
+	return nil
+}
+
+func issuerLabels(iss cmapi.GenericIssuer) []string {
+	kind := cmapi.IssuerKind
+	if _, ok := iss.(*cmapi.ClusterIssuer); ok {
+		kind = cmapi.ClusterIssuerKind
+	}
+	return []string{kind, iss.GetNamespace(), iss.GetName()}
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controller{}).
+			Complete()
+	})
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package issuerhealth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	schedulertest "github.com/cert-manager/cert-manager/pkg/scheduler/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// healthChecker is an issuer which implements issuer.HealthChecker.
+type healthChecker struct {
+	issuerfake.Issuer
+	err error
+}
+
+func (h *healthChecker) HealthCheck(context.Context) error {
+	return h.err
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	nowMetaTime := metav1.NewTime(now)
+
+	baseIssuer := gen.Issuer("test-issuer")
+	healthyCondition := cmapi.IssuerCondition{
+		Type:               cmapi.IssuerConditionHealthy,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             reasonHealthCheckSucceeded,
+		Message:            messageHealthCheckSucceeded,
+		LastTransitionTime: &nowMetaTime,
+	}
+	unhealthyCondition := cmapi.IssuerCondition{
+		Type:               cmapi.IssuerConditionHealthy,
+		Status:             cmmeta.ConditionFalse,
+		Reason:             reasonHealthCheckFailed,
+		Message:            "Issuer health check failed: connection refused",
+		LastTransitionTime: &nowMetaTime,
+	}
+
+	tests := map[string]struct {
+		issuer            *cmapi.Issuer
+		issuerImpl        issuerpkg.Interface
+		expectedActions   []testpkg.Action
+		expectedEvents    []string
+		expectedScheduled time.Duration
+	}{
+		"issuers which cannot be health checked are ignored": {
+			issuer:     baseIssuer,
+			issuerImpl: &issuerfake.Issuer{},
+		},
+		"a successful check sets the Healthy condition": {
+			issuer:     baseIssuer,
+			issuerImpl: &healthChecker{},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("issuers"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.IssuerFrom(baseIssuer, gen.AddIssuerCondition(healthyCondition)),
+				)),
+			},
+			expectedEvents:    []string{"Normal HealthCheckSucceeded Issuer health check succeeded"},
+			expectedScheduled: time.Minute,
+		},
+		"a failed check sets the Healthy condition to False": {
+			issuer:     gen.IssuerFrom(baseIssuer, gen.AddIssuerCondition(healthyCondition)),
+			issuerImpl: &healthChecker{err: errors.New("connection refused")},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("issuers"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.IssuerFrom(baseIssuer, gen.AddIssuerCondition(unhealthyCondition)),
+				)),
+			},
+			expectedEvents:    []string{"Warning HealthCheckFailed Issuer health check failed: connection refused"},
+			expectedScheduled: time.Minute,
+		},
+		"an unchanged result does not update the issuer": {
+			issuer:            gen.IssuerFrom(baseIssuer, gen.AddIssuerCondition(unhealthyCondition)),
+			issuerImpl:        &healthChecker{err: errors.New("connection refused")},
+			expectedScheduled: time.Minute,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{test.issuer},
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+			builder.Context.IssuerOptions.HealthCheckInterval = time.Minute
+
+			c := &controller{}
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			c.issuerFactory = &issuerfake.Factory{
+				IssuerForFunc: func(cmapi.GenericIssuer) (issuerpkg.Interface, error) {
+					return test.issuerImpl, nil
+				},
+			}
+			var gotScheduled time.Duration
+			c.scheduledWorkQueue = &schedulertest.FakeScheduler{
+				AddFunc: func(obj interface{}, duration time.Duration) {
+					gotScheduled = duration
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.ProcessItem(context.Background(), gen.DefaultTestNamespace+"/"+test.issuer.Name)
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if gotScheduled != test.expectedScheduled {
+				t.Errorf("expected the issuer to be scheduled in %v, got %v", test.expectedScheduled, gotScheduled)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -27,6 +27,9 @@ import (
 	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/workqueue"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
 	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
 	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
@@ -57,6 +60,12 @@ type controller struct {
 
 	// fieldManager is the manager name used for the Apply operations.
 	fieldManager string
+
+	// circuitBreakers holds the circuit breakers of Vault and Venafi
+	// issuers, and accountRegistry the clients of ACME issuers' accounts.
+	// Both are released when the Issuer is deleted.
+	circuitBreakers *circuitbreaker.Registry
+	accountRegistry accounts.Registry
 }
 
 // Register registers and constructs the controller using the provided context.
@@ -85,6 +94,7 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 
 	// register handler functions
 	issuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: c.queue})
+	issuerInformer.Informer().AddEventHandler(cache.ResourceEventHandlerFuncs{DeleteFunc: c.issuerDeleted})
 	secretInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{WorkFunc: c.secretDeleted})
 
 	// instantiate additional helpers used by this controller
@@ -92,6 +102,8 @@ func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitin
 	c.cmClient = ctx.CMClient
 	c.fieldManager = ctx.FieldManager
 	c.recorder = ctx.Recorder
+	c.circuitBreakers = ctx.IssuerOptions.CircuitBreakers
+	c.accountRegistry = ctx.ACMEOptions.AccountRegistry
 
 	return c.queue, mustSync, nil
 }
@@ -123,6 +135,24 @@ func (c *controller) secretDeleted(obj interface{}) {
 	}
 }
 
+// issuerDeleted releases the resources held for a Issuer once it has been
+// deleted, so that they are not leaked or reused if a Issuer with the same
+// name is created again.
+func (c *controller) issuerDeleted(obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	iss, ok := obj.(*cmapi.Issuer)
+	if !ok {
+		c.log.Error(nil, "object was not a issuer object")
+		return
+	}
+	c.circuitBreakers.Remove(circuitbreaker.KeyForIssuer(iss))
+	if c.accountRegistry != nil {
+		accounts.RemoveIssuerClients(c.accountRegistry, string(iss.GetUID()))
+	}
+}
+
 func (c *controller) ProcessItem(ctx context.Context, key string) error {
 	log := logf.FromContext(ctx)
 	namespace, name, err := cache.SplitMetaNamespaceKey(key)
@@ -0,0 +1,268 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cminformers "github.com/cert-manager/cert-manager/pkg/client/informers/externalversions"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	// ControllerName is the name of the OCSP responder certificates
+	// controller.
+	ControllerName = "ocsp-responder-certificates"
+
+	reasonCreated  = "OCSPResponderCertificateCreated"
+	reasonConflict = "OCSPResponderCertificateConflict"
+)
+
+// This controller creates the Certificate of the OCSP responder of each CA
+// issuer with OCSP configured. The Certificate is issued by the CA issuer
+// itself with the `ocsp signing` usage, so that the OCSP responder can sign
+// responses on behalf of the CA without access to the CA's private key, and
+// it is renewed like any other Certificate.
+type controller struct {
+	issuerLister        cmlisters.IssuerLister
+	clusterIssuerLister cmlisters.ClusterIssuerLister
+	certificateLister   cmlisters.CertificateLister
+	client              cmclient.Interface
+	recorder            record.EventRecorder
+	issuerOptions       controllerpkg.IssuerOptions
+}
+
+// NewController returns a new OCSP responder certificates controller.
+// ClusterIssuers are only watched if namespace is empty.
+func NewController(
+	log logr.Logger,
+	client cmclient.Interface,
+	cmFactory cminformers.SharedInformerFactory,
+	recorder record.EventRecorder,
+	namespace string,
+	issuerOptions controllerpkg.IssuerOptions,
+) (*controller, workqueue.RateLimitingInterface, []cache.InformerSynced) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*1, time.Second*30), ControllerName)
+
+	// obtain references to all the informers used by this controller
+	issuerInformer := cmFactory.Certmanager().V1().Issuers()
+	certificateInformer := cmFactory.Certmanager().V1().Certificates()
+
+	// Issuers are keyed by namespace/name, and ClusterIssuers by name.
+	issuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+	certificateInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			if key, ok := issuerKeyForCertificate(obj); ok {
+				queue.Add(key)
+			}
+		},
+	})
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		issuerInformer.Informer().HasSynced,
+		certificateInformer.Informer().HasSynced,
+	}
+
+	c := &controller{
+		issuerLister:      issuerInformer.Lister(),
+		certificateLister: certificateInformer.Lister(),
+		client:            client,
+		recorder:          recorder,
+		issuerOptions:     issuerOptions,
+	}
+
+	if namespace == "" {
+		clusterIssuerInformer := cmFactory.Certmanager().V1().ClusterIssuers()
+		c.clusterIssuerLister = clusterIssuerInformer.Lister()
+		clusterIssuerInformer.Informer().AddEventHandler(&controllerpkg.QueuingEventHandler{Queue: queue})
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	return c, queue, mustSync
+}
+
+// issuerKeyForCertificate returns the key of the issuer controlling a
+// Certificate, if any.
+func issuerKeyForCertificate(obj interface{}) (string, bool) {
+	crt, ok := obj.(*cmapi.Certificate)
+	if !ok {
+		return "", false
+	}
+	ref := metav1.GetControllerOf(crt)
+	if ref == nil {
+		return "", false
+	}
+	if ref.APIVersion != cmapi.SchemeGroupVersion.String() {
+		return "", false
+	}
+	switch ref.Kind {
+	case cmapi.IssuerKind:
+		return crt.Namespace + "/" + ref.Name, true
+	case cmapi.ClusterIssuerKind:
+		return ref.Name, true
+	}
+	return "", false
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to an Issuer or ClusterIssuer to be re-synced is pulled from
+// the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	var iss cmapi.GenericIssuer
+	if namespace == "" {
+		if c.clusterIssuerLister == nil {
+			return nil
+		}
+		iss, err = c.clusterIssuerLister.Get(name)
+	} else {
+		iss, err = c.issuerLister.Issuers(namespace).Get(name)
+	}
+	if apierrors.IsNotFound(err) {
+		// The responder Certificate is garbage collected along with the
+		// issuer.
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	ca := iss.GetSpec().CA
+	if ca == nil || ca.OCSP == nil {
+		return nil
+	}
+
+	resourceNamespace, err := c.issuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		return err
+	}
+	desired := responderCertificate(iss, resourceNamespace)
+	existing, err := c.certificateLister.Certificates(desired.Namespace).Get(desired.Name)
+	if apierrors.IsNotFound(err) {
+		if _, err := c.client.CertmanagerV1().Certificates(desired.Namespace).Create(ctx, desired, metav1.CreateOptions{}); err != nil {
+			return err
+		}
+		c.recorder.Eventf(iss, corev1.EventTypeNormal, reasonCreated, "Created OCSP responder Certificate %s/%s", desired.Namespace, desired.Name)
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	if !metav1.IsControlledBy(existing, iss) {
+		c.recorder.Eventf(iss, corev1.EventTypeWarning, reasonConflict,
+			"Certificate %s/%s already exists and is not controlled by this issuer", existing.Namespace, existing.Name)
+		return nil
+	}
+	if reflect.DeepEqual(existing.Spec, desired.Spec) {
+		return nil
+	}
+
+	log.V(logf.InfoLevel).Info("updating OCSP responder Certificate", "certificate", desired.Name)
+	existing = existing.DeepCopy()
+	existing.Spec = desired.Spec
+	_, err = c.client.CertmanagerV1().Certificates(existing.Namespace).Update(ctx, existing, metav1.UpdateOptions{})
+	return err
+}
+
+// responderCertificate returns the Certificate of the OCSP responder of a CA
+// issuer, in the given namespace.
+func responderCertificate(iss cmapi.GenericIssuer, namespace string) *cmapi.Certificate {
+	kind := cmapi.IssuerKind
+	if iss.GetNamespace() == "" {
+		kind = cmapi.ClusterIssuerKind
+	}
+	name := iss.GetSpec().CA.OCSP.ResponderSecretName
+
+	return &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			OwnerReferences: []metav1.OwnerReference{
+				*metav1.NewControllerRef(iss, cmapi.SchemeGroupVersion.WithKind(kind)),
+			},
+		},
+		Spec: cmapi.CertificateSpec{
+			CommonName: fmt.Sprintf("%s OCSP responder", iss.GetName()),
+			SecretName: name,
+			Usages:     []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageOCSPSigning},
+			IssuerRef: cmmeta.ObjectReference{
+				Name:  iss.GetName(),
+				Kind:  kind,
+				Group: certmanager.GroupName,
+			},
+		},
+	}
+}
+
+// controllerWrapper wraps the `controller` structure to make it implement
+// the controllerpkg.queueingController interface
+type controllerWrapper struct {
+	*controller
+}
+
+func (c *controllerWrapper) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// construct a new named logger to be reused throughout the controller
+	log := logf.FromContext(ctx.RootContext, ControllerName)
+
+	ctrl, queue, mustSync := NewController(log,
+		ctx.CMClient,
+		ctx.SharedInformerFactory,
+		ctx.Recorder,
+		ctx.Namespace,
+		ctx.IssuerOptions,
+	)
+	c.controller = ctrl
+
+	return queue, mustSync, nil
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controllerWrapper{}).
+			Complete()
+	})
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"context"
+	"testing"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestProcessItem(t *testing.T) {
+	issuer := gen.Issuer("ca",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: "ca-key-pair",
+			OCSP:       &cmapi.CAOCSP{ResponderSecretName: "ca-ocsp"},
+		}),
+	)
+	issuer.UID = "issuer-uid"
+	responderCrt := responderCertificate(issuer, "testns")
+
+	tests := map[string]struct {
+		existingCM      []runtime.Object
+		expectedEvents  []string
+		expectedActions []testpkg.Action
+	}{
+		"do nothing for a CA issuer without OCSP": {
+			existingCM: []runtime.Object{
+				gen.IssuerFrom(issuer, gen.SetIssuerCA(cmapi.CAIssuer{SecretName: "ca-key-pair"})),
+			},
+		},
+		"create the responder Certificate": {
+			existingCM:     []runtime.Object{issuer},
+			expectedEvents: []string{"Normal OCSPResponderCertificateCreated Created OCSP responder Certificate testns/ca-ocsp"},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewCreateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", responderCrt)),
+			},
+		},
+		"do nothing if the responder Certificate is up to date": {
+			existingCM: []runtime.Object{issuer, responderCrt},
+		},
+		"update the responder Certificate if its spec has been changed": {
+			existingCM: []runtime.Object{issuer, gen.CertificateFrom(responderCrt, gen.SetCertificateDNSNames("example.com"))},
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateAction(cmapi.SchemeGroupVersion.WithResource("certificates"), "testns", responderCrt)),
+			},
+		},
+		"do not take over a Certificate which is not controlled by the issuer": {
+			existingCM:     []runtime.Object{issuer, gen.CertificateFrom(responderCrt, gen.SetCertificateOwnerReferences())},
+			expectedEvents: []string{"Warning OCSPResponderCertificateConflict Certificate testns/ca-ocsp already exists and is not controlled by this issuer"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				CertManagerObjects: test.existingCM,
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			w := &controllerWrapper{}
+			if _, _, err := w.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := w.controller.ProcessItem(context.Background(), "testns/ca")
+			if err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
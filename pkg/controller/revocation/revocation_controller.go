@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/client-go/util/workqueue"
+
+	internalcertificaterequests "github.com/cert-manager/cert-manager/internal/controller/certificaterequests"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	"github.com/cert-manager/cert-manager/pkg/apis/certmanager"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// ControllerName is the name of the certificate revocation controller.
+	ControllerName = "certificaterequests-revocation"
+
+	reasonRevoked          = "Revoked"
+	reasonRevocationFailed = "RevocationFailed"
+
+	// revokeTimeout is the maximum time a single revocation may take.
+	revokeTimeout = 30 * time.Second
+)
+
+// This controller acts on the `cert-manager.io/revoke` annotation of
+// CertificateRequests whose issuer revokes certificates through a remote
+// service, such as ACME, Vault and Venafi issuers. The certificate issued for
+// the request is revoked with the reason given by the annotation, and the
+// outcome is reported using the `Revoked` status condition and events.
+// Failed revocations are retried with a backoff.
+// Issuer types which do not implement issuer.Revoker are ignored. CA issuers
+// revoke certificates by listing them in their CRL instead.
+type controller struct {
+	certificateRequestLister cmlisters.CertificateRequestLister
+	issuerHelper             issuer.Helper
+	issuerFactory            issuer.Factory
+	cmClient                 cmclient.Interface
+	recorder                 record.EventRecorder
+
+	// fieldManager is the manager name used for the Apply operations.
+	fieldManager string
+}
+
+// Register registers and constructs the controller using the provided context.
+// It returns the workqueue to be used to enqueue items, a list of
+// InformerSynced functions that must be synced, or an error.
+func (c *controller) Register(ctx *controllerpkg.Context) (workqueue.RateLimitingInterface, []cache.InformerSynced, error) {
+	// create a queue used to queue up items to be processed
+	queue := workqueue.NewNamedRateLimitingQueue(workqueue.NewItemExponentialFailureRateLimiter(time.Second*5, time.Minute*5), ControllerName)
+
+	certificateRequestInformer := ctx.SharedInformerFactory.Certmanager().V1().CertificateRequests()
+	certificateRequestInformer.Informer().AddEventHandler(&controllerpkg.BlockingEventHandler{
+		WorkFunc: func(obj interface{}) {
+			if !revokeRequested(obj) {
+				return
+			}
+			if key, err := controllerpkg.KeyFunc(obj); err == nil {
+				queue.Add(key)
+			}
+		},
+	})
+	c.certificateRequestLister = certificateRequestInformer.Lister()
+
+	issuerInformer := ctx.SharedInformerFactory.Certmanager().V1().Issuers()
+
+	// build a list of InformerSynced functions that will be returned by the Register method.
+	// the controller will only begin processing items once all of these informers have synced.
+	mustSync := []cache.InformerSynced{
+		certificateRequestInformer.Informer().HasSynced,
+		issuerInformer.Informer().HasSynced,
+	}
+
+	var clusterIssuerLister cmlisters.ClusterIssuerLister
+	if ctx.Namespace == "" {
+		clusterIssuerInformer := ctx.SharedInformerFactory.Certmanager().V1().ClusterIssuers()
+		clusterIssuerLister = clusterIssuerInformer.Lister()
+		mustSync = append(mustSync, clusterIssuerInformer.Informer().HasSynced)
+	}
+
+	c.issuerHelper = issuer.NewHelper(issuerInformer.Lister(), clusterIssuerLister)
+	c.issuerFactory = issuer.NewFactory(ctx)
+	c.cmClient = ctx.CMClient
+	c.recorder = ctx.Recorder
+	c.fieldManager = ctx.FieldManager
+
+	return queue, mustSync, nil
+}
+
+// revokeRequested returns true if obj is a CertificateRequest with the revoke
+// annotation, so that other CertificateRequests are not queued.
+func revokeRequested(obj interface{}) bool {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok {
+		return false
+	}
+	_, ok = cr.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]
+	return ok
+}
+
+// ProcessItem is a worker function that will be called when a new key
+// corresponding to a CertificateRequest with the revoke annotation is pulled
+// from the workqueue.
+func (c *controller) ProcessItem(ctx context.Context, key string) error {
+	log := logf.FromContext(ctx).WithValues("key", key)
+
+	ctx = logf.NewContext(ctx, log)
+	namespace, name, err := cache.SplitMetaNamespaceKey(key)
+	if err != nil {
+		log.Error(err, "invalid resource key passed to ProcessItem")
+		return nil
+	}
+
+	cr, err := c.certificateRequestLister.CertificateRequests(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+
+	reason, ok := cr.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]
+	if !ok || len(cr.Status.Certificate) == 0 {
+		// CertificateRequests are queued again once their certificate has
+		// been issued.
+		return nil
+	}
+	cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked)
+	if cond != nil && cond.Status == cmmeta.ConditionTrue {
+		return nil
+	}
+	if group := cr.Spec.IssuerRef.Group; group != "" && group != certmanager.GroupName {
+		return nil
+	}
+
+	iss, err := c.issuerHelper.GetGenericIssuer(cr.Spec.IssuerRef, cr.Namespace)
+	if err != nil {
+		log.V(logf.DebugLevel).Info("skipping revocation of certificate whose issuer cannot be found", "error", err.Error())
+		return nil
+	}
+	i, err := c.issuerFactory.IssuerFor(iss.DeepCopyObject().(cmapi.GenericIssuer))
+	if err != nil {
+		// The issuers controller reports invalid issuer configurations.
+		log.V(logf.DebugLevel).Info("skipping revocation of certificate whose issuer cannot be constructed", "error", err.Error())
+		return nil
+	}
+	revoker, ok := i.(issuer.Revoker)
+	if !ok {
+		return nil
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		log.Error(err, "cannot revoke invalid certificate")
+		return nil
+	}
+	if reason == "" {
+		reason = pki.RevocationReasonUnspecified
+	}
+
+	revokeCtx, cancel := context.WithTimeout(ctx, revokeTimeout)
+	defer cancel()
+	revokeErr := revoker.Revoke(revokeCtx, cr, cert, pki.RevocationReasons[reason])
+
+	status, condReason, message, eventType := cmmeta.ConditionTrue, reasonRevoked, fmt.Sprintf("Certificate has been revoked with reason %q", reason), corev1.EventTypeNormal
+	if revokeErr != nil {
+		log.V(logf.WarnLevel).Info("failed to revoke certificate", "error", revokeErr.Error())
+		status, condReason, message, eventType = cmmeta.ConditionFalse, reasonRevocationFailed, "Failed to revoke certificate: "+revokeErr.Error(), corev1.EventTypeWarning
+	}
+
+	// Only update the CertificateRequest when the outcome has changed, so
+	// that retries of a failing revocation do not update it every time.
+	if cond == nil || cond.Status != status || cond.Message != message {
+		cr = cr.DeepCopy()
+		apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked, status, condReason, message)
+		if err := c.updateStatus(ctx, cr); err != nil {
+			return err
+		}
+		c.recorder.Event(cr, eventType, condReason, message)
+	}
+
+	// Returning the error retries the revocation with a backoff.
+	return revokeErr
+}
+
+func (c *controller) updateStatus(ctx context.Context, cr *cmapi.CertificateRequest) error {
+	if utilfeature.DefaultFeatureGate.Enabled(feature.ServerSideApply) {
+		return internalcertificaterequests.ApplyStatus(ctx, c.cmClient, c.fieldManager, cr)
+	}
+	_, err := c.cmClient.CertmanagerV1().CertificateRequests(cr.Namespace).UpdateStatus(ctx, cr, metav1.UpdateOptions{})
+	return err
+}
+
+func init() {
+	controllerpkg.Register(ControllerName, func(ctx *controllerpkg.ContextFactory) (controllerpkg.Interface, error) {
+		return controllerpkg.NewBuilder(ctx, ControllerName).
+			For(&controller{}).
+			Complete()
+	})
+}
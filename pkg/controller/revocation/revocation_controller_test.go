@@ -0,0 +1,203 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package revocation
+
+import (
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"errors"
+	"fmt"
+	"math/big"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	testpkg "github.com/cert-manager/cert-manager/pkg/controller/test"
+	issuerpkg "github.com/cert-manager/cert-manager/pkg/issuer"
+	issuerfake "github.com/cert-manager/cert-manager/pkg/issuer/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+// revoker is an issuer which implements issuer.Revoker.
+type revoker struct {
+	issuerfake.Issuer
+	err     error
+	revoked []*big.Int
+	reasons []int
+}
+
+func (r *revoker) Revoke(_ context.Context, _ *cmapi.CertificateRequest, cert *x509.Certificate, reason int) error {
+	r.revoked = append(r.revoked, cert.SerialNumber)
+	r.reasons = append(r.reasons, reason)
+	return r.err
+}
+
+func TestProcessItem(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	nowMetaTime := metav1.NewTime(now)
+
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    now.Add(-time.Hour),
+		NotAfter:     now.Add(24 * time.Hour),
+	}
+	certPEM, _, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	issuer := gen.Issuer("test-issuer")
+	baseCR := gen.CertificateRequest("test",
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "test-issuer", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateRequestCertificate(certPEM),
+	)
+	revokeCR := gen.CertificateRequestFrom(baseCR, gen.AddCertificateRequestAnnotations(map[string]string{
+		cmapi.CertificateRequestRevokeAnnotationKey: "keyCompromise",
+	}))
+	revokedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionRevoked,
+		Status:             cmmeta.ConditionTrue,
+		Reason:             reasonRevoked,
+		Message:            `Certificate has been revoked with reason "keyCompromise"`,
+		LastTransitionTime: &nowMetaTime,
+	}
+	failedCondition := cmapi.CertificateRequestCondition{
+		Type:               cmapi.CertificateRequestConditionRevoked,
+		Status:             cmmeta.ConditionFalse,
+		Reason:             reasonRevocationFailed,
+		Message:            "Failed to revoke certificate: connection refused",
+		LastTransitionTime: &nowMetaTime,
+	}
+
+	tests := map[string]struct {
+		cr              *cmapi.CertificateRequest
+		issuerImpl      issuerpkg.Interface
+		expectedActions []testpkg.Action
+		expectedEvents  []string
+		expectRevoked   bool
+		expectErr       bool
+	}{
+		"CertificateRequests without the revoke annotation are ignored": {
+			cr:         baseCR,
+			issuerImpl: &revoker{},
+		},
+		"CertificateRequests without a certificate are ignored": {
+			cr:         gen.CertificateRequestFrom(revokeCR, gen.SetCertificateRequestCertificate(nil)),
+			issuerImpl: &revoker{},
+		},
+		"issuers which cannot revoke certificates are ignored": {
+			cr:         revokeCR,
+			issuerImpl: &issuerfake.Issuer{},
+		},
+		"already revoked certificates are not revoked again": {
+			cr:         gen.CertificateRequestFrom(revokeCR, gen.SetCertificateRequestStatusCondition(revokedCondition)),
+			issuerImpl: &revoker{},
+		},
+		"a successful revocation sets the Revoked condition": {
+			cr:            revokeCR,
+			issuerImpl:    &revoker{},
+			expectRevoked: true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.CertificateRequestFrom(revokeCR, gen.SetCertificateRequestStatusCondition(revokedCondition)),
+				)),
+			},
+			expectedEvents: []string{`Normal Revoked Certificate has been revoked with reason "keyCompromise"`},
+		},
+		"a failed revocation sets the Revoked condition to False and is retried": {
+			cr:            revokeCR,
+			issuerImpl:    &revoker{err: errors.New("connection refused")},
+			expectRevoked: true,
+			expectErr:     true,
+			expectedActions: []testpkg.Action{
+				testpkg.NewAction(coretesting.NewUpdateSubresourceAction(
+					cmapi.SchemeGroupVersion.WithResource("certificaterequests"),
+					"status",
+					gen.DefaultTestNamespace,
+					gen.CertificateRequestFrom(revokeCR, gen.SetCertificateRequestStatusCondition(failedCondition)),
+				)),
+			},
+			expectedEvents: []string{"Warning RevocationFailed Failed to revoke certificate: connection refused"},
+		},
+		"a revocation which fails again does not update the CertificateRequest": {
+			cr:            gen.CertificateRequestFrom(revokeCR, gen.SetCertificateRequestStatusCondition(failedCondition)),
+			issuerImpl:    &revoker{err: errors.New("connection refused")},
+			expectRevoked: true,
+			expectErr:     true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			builder := &testpkg.Builder{
+				T:                  t,
+				Clock:              fakeclock.NewFakeClock(now),
+				CertManagerObjects: []runtime.Object{issuer, test.cr},
+				ExpectedActions:    test.expectedActions,
+				ExpectedEvents:     test.expectedEvents,
+			}
+			builder.Init()
+
+			c := &controller{}
+			if _, _, err := c.Register(builder.Context); err != nil {
+				t.Fatal(err)
+			}
+			c.issuerFactory = &issuerfake.Factory{
+				IssuerForFunc: func(cmapi.GenericIssuer) (issuerpkg.Interface, error) {
+					return test.issuerImpl, nil
+				},
+			}
+
+			builder.Start()
+			defer builder.Stop()
+
+			err := c.ProcessItem(context.Background(), gen.DefaultTestNamespace+"/"+test.cr.Name)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got %v", test.expectErr, err)
+			}
+
+			if r, ok := test.issuerImpl.(*revoker); ok {
+				gotRevoked := fmt.Sprint(r.revoked, r.reasons)
+				expRevoked := "[] []"
+				if test.expectRevoked {
+					expRevoked = "[1234] [1]"
+				}
+				if gotRevoked != expRevoked {
+					t.Errorf("expected revocations %s, got %s", expRevoked, gotRevoked)
+				}
+			}
+
+			builder.CheckAndFinish(err)
+		})
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// Volume attributes configuring the certificate of a volume. They are set in
+// the volumeAttributes of a CSI ephemeral inline volume. The common-name,
+// dns-names and uri-sans attributes may reference the pod the volume is
+// mounted into as ${POD_NAME}, ${POD_NAMESPACE}, ${POD_UID} and
+// ${SERVICE_ACCOUNT_NAME}.
+const (
+	IssuerNameKey   = "csi.cert-manager.io/issuer-name"
+	IssuerKindKey   = "csi.cert-manager.io/issuer-kind"
+	IssuerGroupKey  = "csi.cert-manager.io/issuer-group"
+	CommonNameKey   = "csi.cert-manager.io/common-name"
+	DNSNamesKey     = "csi.cert-manager.io/dns-names"
+	URISANsKey      = "csi.cert-manager.io/uri-sans"
+	IPSANsKey       = "csi.cert-manager.io/ip-sans"
+	DurationKey     = "csi.cert-manager.io/duration"
+	RenewBeforeKey  = "csi.cert-manager.io/renew-before"
+	IsCAKey         = "csi.cert-manager.io/is-ca"
+	KeyUsagesKey    = "csi.cert-manager.io/key-usages"
+	KeyAlgorithmKey = "csi.cert-manager.io/key-algorithm"
+	CertFileKey     = "csi.cert-manager.io/certificate-file"
+	CAFileKey       = "csi.cert-manager.io/ca-file"
+	KeyFileKey      = "csi.cert-manager.io/privatekey-file"
+)
+
+// Volume attributes set by the kubelet for CSI drivers with podInfoOnMount
+// enabled.
+const (
+	podNameKey            = "csi.storage.k8s.io/pod.name"
+	podNamespaceKey       = "csi.storage.k8s.io/pod.namespace"
+	podUIDKey             = "csi.storage.k8s.io/pod.uid"
+	serviceAccountNameKey = "csi.storage.k8s.io/serviceAccount.name"
+	ephemeralKey          = "csi.storage.k8s.io/ephemeral"
+)
+
+// DefaultDuration is the duration of certificates of volumes which do not
+// set the duration attribute. Certificates of volumes are short-lived, as
+// they are bound to the lifetime of a pod and renewed in place.
+const DefaultDuration = 24 * time.Hour
+
+// volumeRequest is the certificate requested by the attributes of a volume.
+type volumeRequest struct {
+	// podName, podNamespace and podUID identify the pod the volume is
+	// mounted into. The CertificateRequests of the volume are created in
+	// the namespace of the pod.
+	podName      string
+	podNamespace string
+	podUID       string
+
+	issuerRef cmmeta.ObjectReference
+	// spec holds the properties of the requested certificate.
+	spec cmapi.CertificateSpec
+
+	// certFile, caFile and keyFile are the names of the files holding the
+	// certificate, CA and private key in the volume.
+	certFile, caFile, keyFile string
+}
+
+// parseAttributes parses the volume attributes of an ephemeral inline
+// volume, as set by the kubelet when publishing the volume.
+func parseAttributes(attrs map[string]string) (*volumeRequest, error) {
+	if attrs[ephemeralKey] != "true" {
+		return nil, fmt.Errorf("only ephemeral inline volumes are supported, ensure the CSIDriver has volumeLifecycleModes Ephemeral")
+	}
+	req := &volumeRequest{
+		podName:      attrs[podNameKey],
+		podNamespace: attrs[podNamespaceKey],
+		podUID:       attrs[podUIDKey],
+	}
+	if req.podName == "" || req.podNamespace == "" || req.podUID == "" {
+		return nil, fmt.Errorf("pod information is missing from the volume attributes, ensure the CSIDriver has podInfoOnMount enabled")
+	}
+
+	req.issuerRef = cmmeta.ObjectReference{
+		Name:  attrs[IssuerNameKey],
+		Kind:  attrs[IssuerKindKey],
+		Group: attrs[IssuerGroupKey],
+	}
+	if req.issuerRef.Name == "" {
+		return nil, fmt.Errorf("%s is required", IssuerNameKey)
+	}
+	if req.issuerRef.Kind == "" {
+		req.issuerRef.Kind = cmapi.IssuerKind
+	}
+	if req.issuerRef.Group == "" {
+		req.issuerRef.Group = "cert-manager.io"
+	}
+
+	expand := podExpander(attrs)
+	var err error
+	if req.spec.CommonName, err = expand(attrs[CommonNameKey]); err != nil {
+		return nil, fmt.Errorf("%s: %w", CommonNameKey, err)
+	}
+	if req.spec.DNSNames, err = expandList(expand, attrs[DNSNamesKey]); err != nil {
+		return nil, fmt.Errorf("%s: %w", DNSNamesKey, err)
+	}
+	if req.spec.URIs, err = expandList(expand, attrs[URISANsKey]); err != nil {
+		return nil, fmt.Errorf("%s: %w", URISANsKey, err)
+	}
+	req.spec.IPAddresses = splitList(attrs[IPSANsKey])
+	if req.spec.CommonName == "" && len(req.spec.DNSNames) == 0 && len(req.spec.URIs) == 0 && len(req.spec.IPAddresses) == 0 {
+		return nil, fmt.Errorf("at least one of %s, %s, %s or %s must be set", CommonNameKey, DNSNamesKey, URISANsKey, IPSANsKey)
+	}
+
+	duration := DefaultDuration
+	if v := attrs[DurationKey]; v != "" {
+		if duration, err = time.ParseDuration(v); err != nil {
+			return nil, fmt.Errorf("%s: %w", DurationKey, err)
+		}
+		if duration < cmapi.MinimumCertificateDuration {
+			return nil, fmt.Errorf("%s must be at least %s", DurationKey, cmapi.MinimumCertificateDuration)
+		}
+	}
+	req.spec.Duration = &metav1.Duration{Duration: duration}
+	if v := attrs[RenewBeforeKey]; v != "" {
+		renewBefore, err := time.ParseDuration(v)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", RenewBeforeKey, err)
+		}
+		if renewBefore <= 0 || renewBefore >= duration {
+			return nil, fmt.Errorf("%s must be greater than zero and less than the duration %s", RenewBeforeKey, duration)
+		}
+		req.spec.RenewBefore = &metav1.Duration{Duration: renewBefore}
+	}
+
+	if v := attrs[IsCAKey]; v != "" {
+		if req.spec.IsCA, err = strconv.ParseBool(v); err != nil {
+			return nil, fmt.Errorf("%s: %w", IsCAKey, err)
+		}
+	}
+	for _, usage := range splitList(attrs[KeyUsagesKey]) {
+		req.spec.Usages = append(req.spec.Usages, cmapi.KeyUsage(usage))
+	}
+
+	req.spec.PrivateKey = &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm}
+	switch alg := cmapi.PrivateKeyAlgorithm(attrs[KeyAlgorithmKey]); alg {
+	case "":
+	case cmapi.RSAKeyAlgorithm, cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm:
+		req.spec.PrivateKey.Algorithm = alg
+	default:
+		return nil, fmt.Errorf("%s must be one of %s, %s or %s", KeyAlgorithmKey, cmapi.RSAKeyAlgorithm, cmapi.ECDSAKeyAlgorithm, cmapi.Ed25519KeyAlgorithm)
+	}
+
+	req.certFile, req.caFile, req.keyFile = "tls.crt", "ca.crt", "tls.key"
+	for key, file := range map[string]*string{CertFileKey: &req.certFile, CAFileKey: &req.caFile, KeyFileKey: &req.keyFile} {
+		if v := attrs[key]; v != "" {
+			*file = v
+		}
+		if !isFileName(*file) {
+			return nil, fmt.Errorf("%s must be a file name without a directory, got %q", key, *file)
+		}
+	}
+	if req.certFile == req.caFile || req.certFile == req.keyFile || req.caFile == req.keyFile {
+		return nil, fmt.Errorf("%s, %s and %s must be different files", CertFileKey, CAFileKey, KeyFileKey)
+	}
+
+	return req, nil
+}
+
+// podExpander returns a function which expands references to the pod of a
+// volume in an attribute value.
+func podExpander(attrs map[string]string) func(string) (string, error) {
+	vars := map[string]string{
+		"POD_NAME":             attrs[podNameKey],
+		"POD_NAMESPACE":        attrs[podNamespaceKey],
+		"POD_UID":              attrs[podUIDKey],
+		"SERVICE_ACCOUNT_NAME": attrs[serviceAccountNameKey],
+	}
+	return func(s string) (string, error) {
+		var unknown []string
+		expanded := os.Expand(s, func(name string) string {
+			v, ok := vars[name]
+			if !ok {
+				unknown = append(unknown, name)
+			}
+			return v
+		})
+		if len(unknown) > 0 {
+			return "", fmt.Errorf("unknown variables %v, only ${POD_NAME}, ${POD_NAMESPACE}, ${POD_UID} and ${SERVICE_ACCOUNT_NAME} are supported", unknown)
+		}
+		return expanded, nil
+	}
+}
+
+func expandList(expand func(string) (string, error), s string) ([]string, error) {
+	list := splitList(s)
+	for i := range list {
+		var err error
+		if list[i], err = expand(list[i]); err != nil {
+			return nil, err
+		}
+	}
+	return list, nil
+}
+
+// splitList splits a comma separated attribute value, ignoring empty
+// entries.
+func splitList(s string) []string {
+	var list []string
+	for _, v := range strings.Split(s, ",") {
+		if v = strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+	return list
+}
+
+// isFileName returns true if name is the name of a file in the volume's
+// directory, which is not one of the hidden entries used to update the
+// files atomically.
+func isFileName(name string) bool {
+	return name != "" && name != "." && !strings.ContainsRune(name, '/') && !strings.HasPrefix(name, "..")
+}
@@ -0,0 +1,376 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package driver implements a CSI driver which mounts certificates into pods
+// as ephemeral inline volumes. The certificate of each volume is requested
+// with a CertificateRequest in the namespace of the pod, configured by the
+// volume's attributes, and renewed in place before it expires. Private keys
+// never leave the node the pod runs on.
+package driver
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+	"k8s.io/utils/clock"
+
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+)
+
+// Options configures a Driver.
+type Options struct {
+	// DriverName is the name of the CSIDriver the driver serves volumes for.
+	DriverName string
+	// NodeID is the name of the node the driver runs on.
+	NodeID string
+	// DataRoot is the directory the files of volumes are stored in. It
+	// should be backed by memory so that private keys are never written to
+	// disk.
+	DataRoot string
+	// RenewalRetryPeriod is how long renewing the certificate of a volume is
+	// delayed after it failed.
+	RenewalRetryPeriod time.Duration
+}
+
+// Driver serves the CSI Identity and Node services for certificate volumes.
+// Calls to the Node service methods which are not used for ephemeral inline
+// volumes fail with the UNIMPLEMENTED code.
+type Driver struct {
+	csi.UnimplementedNodeServer
+
+	driverName string
+	nodeID     string
+	client     cmclient.Interface
+	store      *store
+	mounter    mounter
+	clock      clock.Clock
+	log        logr.Logger
+
+	// pollInterval is how often CertificateRequests are checked for
+	// completion.
+	pollInterval time.Duration
+	// retryPeriod is how long renewing the certificate of a volume is
+	// delayed after it failed.
+	retryPeriod time.Duration
+
+	lock sync.Mutex
+	// ctx is the context renewals run in, set by Run.
+	ctx context.Context
+	// renewers holds the goroutines renewing the certificate of each
+	// published volume.
+	renewers map[string]*renewer
+}
+
+// renewer is a goroutine renewing the certificate of a volume.
+type renewer struct {
+	cancel context.CancelFunc
+	done   chan struct{}
+}
+
+var _ csi.IdentityServer = &Driver{}
+var _ csi.NodeServer = &Driver{}
+
+// New returns a Driver which requests certificates using client.
+func New(opts Options, client cmclient.Interface) (*Driver, error) {
+	if opts.DriverName == "" {
+		return nil, errors.New("driver name must be set")
+	}
+	if opts.NodeID == "" {
+		return nil, errors.New("node ID must be set")
+	}
+	if opts.DataRoot == "" {
+		return nil, errors.New("data root must be set")
+	}
+	if err := os.MkdirAll(opts.DataRoot, 0700); err != nil {
+		return nil, fmt.Errorf("failed to create data root: %w", err)
+	}
+	retryPeriod := opts.RenewalRetryPeriod
+	if retryPeriod <= 0 {
+		retryPeriod = time.Minute
+	}
+	return &Driver{
+		driverName:   opts.DriverName,
+		nodeID:       opts.NodeID,
+		client:       client,
+		store:        &store{root: opts.DataRoot},
+		mounter:      newMounter(),
+		clock:        clock.RealClock{},
+		log:          logf.Log,
+		pollInterval: time.Second,
+		retryPeriod:  retryPeriod,
+		renewers:     make(map[string]*renewer),
+	}, nil
+}
+
+// Run resumes renewing the certificates of the volumes published before the
+// driver was restarted, and serves the CSI services on lis until ctx is
+// cancelled.
+func (d *Driver) Run(ctx context.Context, lis net.Listener) error {
+	d.lock.Lock()
+	d.ctx = ctx
+	d.log = logf.FromContext(ctx)
+	d.lock.Unlock()
+
+	volumes, err := d.store.list()
+	if err != nil {
+		return fmt.Errorf("failed to list published volumes: %w", err)
+	}
+	for _, meta := range volumes {
+		// Volumes without a certificate were never published, so the
+		// kubelet will publish them again.
+		if meta.NextIssuanceTime.IsZero() {
+			continue
+		}
+		d.log.V(logf.DebugLevel).Info("resuming renewal of volume", "volume_id", meta.VolumeID, "next_issuance_time", meta.NextIssuanceTime)
+		d.startRenewal(meta)
+	}
+
+	srv := grpc.NewServer()
+	csi.RegisterIdentityServer(srv, d)
+	csi.RegisterNodeServer(srv, d)
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+	d.log.V(logf.InfoLevel).Info("starting CSI driver", "driver_name", d.driverName, "node_id", d.nodeID, "address", lis.Addr())
+	err = srv.Serve(lis)
+
+	d.lock.Lock()
+	ids := make([]string, 0, len(d.renewers))
+	for id := range d.renewers {
+		ids = append(ids, id)
+	}
+	d.lock.Unlock()
+	for _, id := range ids {
+		d.stopRenewal(id)
+	}
+	return err
+}
+
+func (d *Driver) GetPluginInfo(context.Context, *csi.GetPluginInfoRequest) (*csi.GetPluginInfoResponse, error) {
+	return &csi.GetPluginInfoResponse{Name: d.driverName, VendorVersion: util.AppVersion}, nil
+}
+
+func (d *Driver) GetPluginCapabilities(context.Context, *csi.GetPluginCapabilitiesRequest) (*csi.GetPluginCapabilitiesResponse, error) {
+	return &csi.GetPluginCapabilitiesResponse{}, nil
+}
+
+func (d *Driver) Probe(context.Context, *csi.ProbeRequest) (*csi.ProbeResponse, error) {
+	return &csi.ProbeResponse{Ready: wrapperspb.Bool(true)}, nil
+}
+
+func (d *Driver) NodeGetCapabilities(context.Context, *csi.NodeGetCapabilitiesRequest) (*csi.NodeGetCapabilitiesResponse, error) {
+	return &csi.NodeGetCapabilitiesResponse{}, nil
+}
+
+func (d *Driver) NodeGetInfo(context.Context, *csi.NodeGetInfoRequest) (*csi.NodeGetInfoResponse, error) {
+	return &csi.NodeGetInfoResponse{NodeId: d.nodeID}, nil
+}
+
+// NodePublishVolume issues the certificate of a volume and mounts it at the
+// target path. It returns once the certificate has been issued, so the pod
+// does not start without it.
+func (d *Driver) NodePublishVolume(ctx context.Context, req *csi.NodePublishVolumeRequest) (*csi.NodePublishVolumeResponse, error) {
+	if !isVolumeID(req.VolumeId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid volume ID %q", req.VolumeId)
+	}
+	if req.TargetPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "target path is required")
+	}
+	if req.GetVolumeCapability().GetBlock() != nil {
+		return nil, status.Error(codes.InvalidArgument, "block volumes are not supported")
+	}
+	if _, err := parseAttributes(req.VolumeContext); err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid volume attributes: %v", err)
+	}
+	log := d.log.WithValues("volume_id", req.VolumeId, "target_path", req.TargetPath)
+
+	mounted, err := d.mounter.IsMountPoint(req.TargetPath)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.Internal, "failed to check whether target path is mounted: %v", err)
+	}
+	if mounted && d.isRenewing(req.VolumeId) {
+		log.V(logf.DebugLevel).Info("volume is already published")
+		return &csi.NodePublishVolumeResponse{}, nil
+	}
+
+	meta := &volumeMetadata{
+		VolumeID:      req.VolumeId,
+		TargetPath:    req.TargetPath,
+		VolumeContext: req.VolumeContext,
+	}
+	if err := d.publish(ctx, meta, mounted); err != nil {
+		log.Error(err, "failed to publish volume")
+		if err := d.store.remove(meta.VolumeID); err != nil {
+			log.Error(err, "failed to remove data of volume")
+		}
+		return nil, status.Errorf(codes.Internal, "failed to publish volume: %v", err)
+	}
+	log.V(logf.InfoLevel).Info("published volume", "next_issuance_time", meta.NextIssuanceTime)
+
+	d.startRenewal(meta)
+	return &csi.NodePublishVolumeResponse{}, nil
+}
+
+// publish stores the metadata and certificate of a new volume, and mounts
+// it at its target path unless it is already mounted.
+func (d *Driver) publish(ctx context.Context, meta *volumeMetadata, mounted bool) error {
+	if err := d.store.create(meta.VolumeID); err != nil {
+		return err
+	}
+	if err := d.store.writeMetadata(meta); err != nil {
+		return err
+	}
+
+	files, nextIssuanceTime, err := d.issue(ctx, meta)
+	if err != nil {
+		return err
+	}
+	if err := d.store.writeFiles(meta.VolumeID, files); err != nil {
+		return err
+	}
+	meta.NextIssuanceTime = nextIssuanceTime
+	if err := d.store.writeMetadata(meta); err != nil {
+		return err
+	}
+
+	if mounted {
+		return nil
+	}
+	if err := os.MkdirAll(meta.TargetPath, 0750); err != nil {
+		return err
+	}
+	return d.mounter.Mount(d.store.dataDir(meta.VolumeID), meta.TargetPath)
+}
+
+// NodeUnpublishVolume stops renewing the certificate of a volume, unmounts
+// it and removes its data.
+func (d *Driver) NodeUnpublishVolume(ctx context.Context, req *csi.NodeUnpublishVolumeRequest) (*csi.NodeUnpublishVolumeResponse, error) {
+	if !isVolumeID(req.VolumeId) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid volume ID %q", req.VolumeId)
+	}
+	if req.TargetPath == "" {
+		return nil, status.Error(codes.InvalidArgument, "target path is required")
+	}
+	log := d.log.WithValues("volume_id", req.VolumeId, "target_path", req.TargetPath)
+
+	d.stopRenewal(req.VolumeId)
+
+	mounted, err := d.mounter.IsMountPoint(req.TargetPath)
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to check whether target path is mounted: %v", err)
+	}
+	if mounted {
+		if err := d.mounter.Unmount(req.TargetPath); err != nil {
+			return nil, status.Error(codes.Internal, err.Error())
+		}
+	}
+	if err := os.Remove(req.TargetPath); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, status.Errorf(codes.Internal, "failed to remove target path: %v", err)
+	}
+	if err := d.store.remove(req.VolumeId); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to remove data of volume: %v", err)
+	}
+
+	log.V(logf.InfoLevel).Info("unpublished volume")
+	return &csi.NodeUnpublishVolumeResponse{}, nil
+}
+
+func (d *Driver) isRenewing(id string) bool {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	_, ok := d.renewers[id]
+	return ok
+}
+
+// startRenewal starts renewing the certificate of a volume, replacing any
+// goroutine already renewing it.
+func (d *Driver) startRenewal(meta *volumeMetadata) {
+	d.stopRenewal(meta.VolumeID)
+
+	d.lock.Lock()
+	defer d.lock.Unlock()
+	parent := d.ctx
+	if parent == nil {
+		parent = context.Background()
+	}
+	ctx, cancel := context.WithCancel(parent)
+	r := &renewer{cancel: cancel, done: make(chan struct{})}
+	d.renewers[meta.VolumeID] = r
+	go func() {
+		defer close(r.done)
+		d.renew(ctx, meta)
+	}()
+}
+
+// stopRenewal stops renewing the certificate of a volume, and waits for an
+// ongoing renewal to be aborted.
+func (d *Driver) stopRenewal(id string) {
+	d.lock.Lock()
+	r, ok := d.renewers[id]
+	delete(d.renewers, id)
+	d.lock.Unlock()
+	if !ok {
+		return
+	}
+	r.cancel()
+	<-r.done
+}
+
+// renew renews the certificate of a volume at its next issuance time until
+// ctx is cancelled. Failed renewals are retried after the retry period.
+func (d *Driver) renew(ctx context.Context, meta *volumeMetadata) {
+	log := d.log.WithValues("volume_id", meta.VolumeID)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-d.clock.After(meta.NextIssuanceTime.Sub(d.clock.Now())):
+		}
+
+		files, nextIssuanceTime, err := d.issue(ctx, meta)
+		if err == nil {
+			err = d.store.writeFiles(meta.VolumeID, files)
+		}
+		if ctx.Err() != nil {
+			return
+		}
+		if err != nil {
+			log.Error(err, "failed to renew certificate of volume, retrying", "retry_period", d.retryPeriod)
+			nextIssuanceTime = d.clock.Now().Add(d.retryPeriod)
+		} else {
+			log.V(logf.InfoLevel).Info("renewed certificate of volume", "next_issuance_time", nextIssuanceTime)
+		}
+
+		meta.NextIssuanceTime = nextIssuanceTime
+		if err := d.store.writeMetadata(meta); err != nil {
+			log.Error(err, "failed to store metadata of volume")
+		}
+	}
+}
@@ -0,0 +1,505 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/container-storage-interface/spec/lib/go/csi"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coretesting "k8s.io/client-go/testing"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+var podAttributes = map[string]string{
+	ephemeralKey:          "true",
+	podNameKey:            "my-pod",
+	podNamespaceKey:       "my-namespace",
+	podUIDKey:             "my-uid",
+	serviceAccountNameKey: "my-sa",
+}
+
+func withAttributes(attrs map[string]string) map[string]string {
+	merged := make(map[string]string)
+	for k, v := range podAttributes {
+		merged[k] = v
+	}
+	for k, v := range attrs {
+		merged[k] = v
+	}
+	return merged
+}
+
+func TestParseAttributes(t *testing.T) {
+	tests := map[string]struct {
+		attrs  map[string]string
+		exp    *volumeRequest
+		expErr bool
+	}{
+		"if only the issuer and a DNS name are set, defaults are used": {
+			attrs: withAttributes(map[string]string{
+				IssuerNameKey: "ca-issuer",
+				DNSNamesKey:   "example.com",
+			}),
+			exp: &volumeRequest{
+				podName:      "my-pod",
+				podNamespace: "my-namespace",
+				podUID:       "my-uid",
+				issuerRef:    cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer", Group: "cert-manager.io"},
+				spec: cmapi.CertificateSpec{
+					DNSNames:   []string{"example.com"},
+					Duration:   &metav1.Duration{Duration: DefaultDuration},
+					PrivateKey: &cmapi.CertificatePrivateKey{Algorithm: cmapi.ECDSAKeyAlgorithm},
+				},
+				certFile: "tls.crt",
+				caFile:   "ca.crt",
+				keyFile:  "tls.key",
+			},
+		},
+		"all attributes are parsed and references to the pod are expanded": {
+			attrs: withAttributes(map[string]string{
+				IssuerNameKey:   "ca-issuer",
+				IssuerKindKey:   "ClusterIssuer",
+				IssuerGroupKey:  "example.io",
+				CommonNameKey:   "${SERVICE_ACCOUNT_NAME}.${POD_NAMESPACE}",
+				DNSNamesKey:     "${POD_NAME}.${POD_NAMESPACE}.svc, ${POD_NAME}.${POD_NAMESPACE}.svc.cluster.local",
+				URISANsKey:      "spiffe://cluster.local/ns/${POD_NAMESPACE}/pod/${POD_UID}",
+				IPSANsKey:       "10.0.0.1",
+				DurationKey:     "2h",
+				RenewBeforeKey:  "30m",
+				IsCAKey:         "true",
+				KeyUsagesKey:    "digital signature,server auth",
+				KeyAlgorithmKey: "RSA",
+				CertFileKey:     "cert.pem",
+				CAFileKey:       "ca.pem",
+				KeyFileKey:      "key.pem",
+			}),
+			exp: &volumeRequest{
+				podName:      "my-pod",
+				podNamespace: "my-namespace",
+				podUID:       "my-uid",
+				issuerRef:    cmmeta.ObjectReference{Name: "ca-issuer", Kind: "ClusterIssuer", Group: "example.io"},
+				spec: cmapi.CertificateSpec{
+					CommonName:  "my-sa.my-namespace",
+					DNSNames:    []string{"my-pod.my-namespace.svc", "my-pod.my-namespace.svc.cluster.local"},
+					URIs:        []string{"spiffe://cluster.local/ns/my-namespace/pod/my-uid"},
+					IPAddresses: []string{"10.0.0.1"},
+					Duration:    &metav1.Duration{Duration: 2 * time.Hour},
+					RenewBefore: &metav1.Duration{Duration: 30 * time.Minute},
+					IsCA:        true,
+					Usages:      []cmapi.KeyUsage{cmapi.UsageDigitalSignature, cmapi.UsageServerAuth},
+					PrivateKey:  &cmapi.CertificatePrivateKey{Algorithm: cmapi.RSAKeyAlgorithm},
+				},
+				certFile: "cert.pem",
+				caFile:   "ca.pem",
+				keyFile:  "key.pem",
+			},
+		},
+		"if the volume is not ephemeral, expect error": {
+			attrs: map[string]string{
+				podNameKey: "my-pod", podNamespaceKey: "my-namespace", podUIDKey: "my-uid",
+				IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com",
+			},
+			expErr: true,
+		},
+		"if pod information is missing, expect error": {
+			attrs:  map[string]string{ephemeralKey: "true", IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com"},
+			expErr: true,
+		},
+		"if the issuer name is missing, expect error": {
+			attrs:  withAttributes(map[string]string{DNSNamesKey: "example.com"}),
+			expErr: true,
+		},
+		"if no subject or SANs are set, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer"}),
+			expErr: true,
+		},
+		"if an unknown variable is referenced, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "${NODE_NAME}.example.com"}),
+			expErr: true,
+		},
+		"if the duration is too short, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com", DurationKey: "10m"}),
+			expErr: true,
+		},
+		"if renew-before is not less than the duration, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com", RenewBeforeKey: "24h"}),
+			expErr: true,
+		},
+		"if the key algorithm is unknown, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com", KeyAlgorithmKey: "dsa"}),
+			expErr: true,
+		},
+		"if a file is not in the volume's directory, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com", KeyFileKey: "../tls.key"}),
+			expErr: true,
+		},
+		"if two files have the same name, expect error": {
+			attrs:  withAttributes(map[string]string{IssuerNameKey: "ca-issuer", DNSNamesKey: "example.com", CAFileKey: "tls.crt"}),
+			expErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			req, err := parseAttributes(test.attrs)
+			if test.expErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.exp, req)
+		})
+	}
+}
+
+func TestStoreWriteFiles(t *testing.T) {
+	s := &store{root: t.TempDir()}
+	require.NoError(t, s.create("csi-1"))
+
+	require.NoError(t, s.writeFiles("csi-1", map[string][]byte{"tls.crt": []byte("cert-1"), "ca.crt": []byte("ca-1")}))
+	require.NoError(t, s.writeFiles("csi-1", map[string][]byte{"tls.crt": []byte("cert-2"), "tls.key": []byte("key-2")}))
+
+	cert, err := s.readFile("csi-1", "tls.crt")
+	require.NoError(t, err)
+	assert.Equal(t, "cert-2", string(cert))
+	key, err := s.readFile("csi-1", "tls.key")
+	require.NoError(t, err)
+	assert.Equal(t, "key-2", string(key))
+	_, err = s.readFile("csi-1", "ca.crt")
+	assert.True(t, os.IsNotExist(err), "expected files which are no longer written to be removed, got %v", err)
+
+	entries, err := os.ReadDir(s.dataDir("csi-1"))
+	require.NoError(t, err)
+	var names []string
+	for _, entry := range entries {
+		names = append(names, entry.Name())
+	}
+	// ..data, the directory it points to, tls.crt and tls.key
+	assert.Len(t, names, 4, "expected previous files to be removed, got %v", names)
+}
+
+// fakeMounter records the mounts of volumes.
+type fakeMounter struct {
+	lock   sync.Mutex
+	mounts map[string]string
+}
+
+func (f *fakeMounter) Mount(source, target string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	f.mounts[target] = source
+	return nil
+}
+
+func (f *fakeMounter) Unmount(target string) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	delete(f.mounts, target)
+	return nil
+}
+
+func (f *fakeMounter) IsMountPoint(target string) (bool, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	_, ok := f.mounts[target]
+	return ok, nil
+}
+
+// fakeIssuer signs the CertificateRequests created with a fake clientset,
+// or denies them if deny is set. Certificates are valid from the current
+// time of clock.
+type fakeIssuer struct {
+	clock  *fakeclock.FakeClock
+	caKey  interface{}
+	caCert *x509.Certificate
+	caPEM  []byte
+	deny   bool
+
+	lock    sync.Mutex
+	created []*cmapi.CertificateRequest
+}
+
+func newFakeIssuer(t *testing.T) *fakeIssuer {
+	caKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * 365 * time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	caPEM, caCert, err := pki.SignCertificate(template, template, caKey.Public(), caKey)
+	require.NoError(t, err)
+	return &fakeIssuer{clock: fakeclock.NewFakeClock(time.Now()), caKey: caKey, caCert: caCert, caPEM: caPEM}
+}
+
+func (f *fakeIssuer) react(action coretesting.Action) (bool, runtime.Object, error) {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	cr := action.(coretesting.CreateAction).GetObject().(*cmapi.CertificateRequest)
+	cr.Name = fmt.Sprintf("%s%d", cr.GenerateName, len(f.created))
+	f.created = append(f.created, cr.DeepCopy())
+
+	if f.deny {
+		apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionDenied, cmmeta.ConditionTrue, "Denied", "denied by test")
+		return false, nil, nil
+	}
+	template, err := pki.GenerateTemplateFromCertificateRequest(cr)
+	if err != nil {
+		return true, nil, err
+	}
+	template.NotBefore = f.clock.Now()
+	template.NotAfter = template.NotBefore.Add(cr.Spec.Duration.Duration)
+	certPEM, _, err := pki.SignCertificate(template, f.caCert, template.PublicKey, f.caKey)
+	if err != nil {
+		return true, nil, err
+	}
+	cr.Status.Certificate = certPEM
+	cr.Status.CA = f.caPEM
+	apiutil.SetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady, cmmeta.ConditionTrue, cmapi.CertificateRequestReasonIssued, "issued by test")
+	// The modified CertificateRequest is stored by the default reactor.
+	return false, nil, nil
+}
+
+func (f *fakeIssuer) requests() []*cmapi.CertificateRequest {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+	return append([]*cmapi.CertificateRequest(nil), f.created...)
+}
+
+func newTestDriver(t *testing.T, issuer *fakeIssuer) (*Driver, *fake.Clientset, *fakeMounter, *fakeclock.FakeClock) {
+	client := fake.NewSimpleClientset()
+	client.PrependReactor("create", "certificaterequests", issuer.react)
+
+	d, err := New(Options{DriverName: "csi.cert-manager.io", NodeID: "node-1", DataRoot: t.TempDir()}, client)
+	require.NoError(t, err)
+	mounter := &fakeMounter{mounts: make(map[string]string)}
+	d.mounter = mounter
+	d.clock = issuer.clock
+	d.pollInterval = time.Millisecond
+	return d, client, mounter, issuer.clock
+}
+
+func publishRequest(t *testing.T) *csi.NodePublishVolumeRequest {
+	return &csi.NodePublishVolumeRequest{
+		VolumeId:   "csi-1234",
+		TargetPath: filepath.Join(t.TempDir(), "mount"),
+		VolumeContext: withAttributes(map[string]string{
+			IssuerNameKey: "ca-issuer",
+			DNSNamesKey:   "${POD_NAME}.${POD_NAMESPACE}.svc",
+		}),
+	}
+}
+
+// mustReadKeyPair reads the certificate and private key of a volume, and
+// checks that they belong together.
+func mustReadKeyPair(t *testing.T, d *Driver, id string) (*x509.Certificate, []byte) {
+	certPEM, err := d.store.readFile(id, "tls.crt")
+	require.NoError(t, err)
+	keyPEM, err := d.store.readFile(id, "tls.key")
+	require.NoError(t, err)
+	cert, err := pki.DecodeX509CertificateBytes(certPEM)
+	require.NoError(t, err)
+	key, err := pki.DecodePrivateKeyBytes(keyPEM)
+	require.NoError(t, err)
+	matches, err := pki.PublicKeyMatchesCertificate(key.Public(), cert)
+	require.NoError(t, err)
+	require.True(t, matches, "expected private key to match certificate")
+	return cert, keyPEM
+}
+
+func TestNodePublishUnpublishVolume(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	d, client, mounter, _ := newTestDriver(t, issuer)
+	ctx := context.Background()
+	req := publishRequest(t)
+
+	_, err := d.NodePublishVolume(ctx, req)
+	require.NoError(t, err)
+
+	cert, _ := mustReadKeyPair(t, d, req.VolumeId)
+	assert.Equal(t, []string{"my-pod.my-namespace.svc"}, cert.DNSNames)
+	ca, err := d.store.readFile(req.VolumeId, "ca.crt")
+	require.NoError(t, err)
+	assert.Equal(t, issuer.caPEM, ca)
+	ok, err := mounter.IsMountPoint(req.TargetPath)
+	require.NoError(t, err)
+	assert.True(t, ok, "expected volume to be mounted")
+	assert.Equal(t, d.store.dataDir(req.VolumeId), mounter.mounts[req.TargetPath])
+
+	requests := issuer.requests()
+	require.Len(t, requests, 1)
+	cr := requests[0]
+	assert.Equal(t, "my-namespace", cr.Namespace)
+	assert.Equal(t, cmmeta.ObjectReference{Name: "ca-issuer", Kind: "Issuer", Group: "cert-manager.io"}, cr.Spec.IssuerRef)
+	assert.Equal(t, map[string]string{VolumeIDAnnotationKey: "csi-1234", NodeIDAnnotationKey: "node-1"}, cr.Annotations)
+	require.Len(t, cr.OwnerReferences, 1)
+	assert.Equal(t, "my-pod", cr.OwnerReferences[0].Name)
+	remaining, err := client.CertmanagerV1().CertificateRequests("my-namespace").List(ctx, metav1.ListOptions{})
+	require.NoError(t, err)
+	assert.Empty(t, remaining.Items, "expected CertificateRequest to be deleted once issued")
+
+	// publishing the volume again does not request another certificate
+	_, err = d.NodePublishVolume(ctx, req)
+	require.NoError(t, err)
+	assert.Len(t, issuer.requests(), 1)
+
+	_, err = d.NodeUnpublishVolume(ctx, &csi.NodeUnpublishVolumeRequest{VolumeId: req.VolumeId, TargetPath: req.TargetPath})
+	require.NoError(t, err)
+	ok, err = mounter.IsMountPoint(req.TargetPath)
+	require.NoError(t, err)
+	assert.False(t, ok, "expected volume to be unmounted")
+	assert.False(t, d.isRenewing(req.VolumeId), "expected renewal to be stopped")
+	_, err = os.Stat(d.store.volumeDir(req.VolumeId))
+	assert.True(t, os.IsNotExist(err), "expected data of volume to be removed, got %v", err)
+	_, err = os.Stat(req.TargetPath)
+	assert.True(t, os.IsNotExist(err), "expected target path to be removed, got %v", err)
+}
+
+func TestNodePublishVolumeFailures(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	issuer.deny = true
+	d, _, mounter, _ := newTestDriver(t, issuer)
+	ctx := context.Background()
+
+	req := publishRequest(t)
+	_, err := d.NodePublishVolume(ctx, req)
+	assert.Equal(t, codes.Internal, status.Code(err), "unexpected error %v", err)
+	assert.Empty(t, mounter.mounts)
+	_, err = os.Stat(d.store.volumeDir(req.VolumeId))
+	assert.True(t, os.IsNotExist(err), "expected data of volume to be removed, got %v", err)
+
+	req = publishRequest(t)
+	delete(req.VolumeContext, IssuerNameKey)
+	_, err = d.NodePublishVolume(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+
+	req = publishRequest(t)
+	req.VolumeCapability = &csi.VolumeCapability{AccessType: &csi.VolumeCapability_Block{Block: &csi.VolumeCapability_BlockVolume{}}}
+	_, err = d.NodePublishVolume(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+
+	req = publishRequest(t)
+	req.VolumeId = "../csi-1234"
+	_, err = d.NodePublishVolume(ctx, req)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error %v", err)
+}
+
+func TestRenewal(t *testing.T) {
+	issuer := newFakeIssuer(t)
+	d, _, _, clock := newTestDriver(t, issuer)
+	ctx := context.Background()
+	req := publishRequest(t)
+
+	_, err := d.NodePublishVolume(ctx, req)
+	require.NoError(t, err)
+	defer d.NodeUnpublishVolume(ctx, &csi.NodeUnpublishVolumeRequest{VolumeId: req.VolumeId, TargetPath: req.TargetPath})
+	cert, key := mustReadKeyPair(t, d, req.VolumeId)
+
+	meta, err := d.store.readMetadata(req.VolumeId)
+	require.NoError(t, err)
+	// certificates are renewed once two thirds of their duration has passed
+	assert.WithinDuration(t, cert.NotBefore.Add(16*time.Hour), meta.NextIssuanceTime, time.Minute)
+
+	require.NoError(t, wait.PollImmediate(time.Millisecond, 5*time.Second, func() (bool, error) {
+		return clock.HasWaiters(), nil
+	}))
+	clock.SetTime(meta.NextIssuanceTime)
+
+	// The certificate and private key are replaced at once, so once the
+	// certificate has been renewed the private key has been too.
+	require.NoError(t, wait.PollImmediate(time.Millisecond, 5*time.Second, func() (bool, error) {
+		certPEM, err := d.store.readFile(req.VolumeId, "tls.crt")
+		if err != nil {
+			return false, err
+		}
+		current, err := pki.DecodeX509CertificateBytes(certPEM)
+		if err != nil {
+			return false, err
+		}
+		return !current.Equal(cert), nil
+	}))
+	renewedCert, renewedKey := mustReadKeyPair(t, d, req.VolumeId)
+	assert.Equal(t, meta.NextIssuanceTime.Truncate(time.Second), renewedCert.NotBefore.Truncate(time.Second))
+	assert.False(t, bytes.Equal(key, renewedKey), "expected a new private key to be generated on renewal")
+	assert.Len(t, issuer.requests(), 2)
+}
+
+func TestRun(t *testing.T) {
+	d, _, _, _ := newTestDriver(t, newFakeIssuer(t))
+	ctx, cancel := context.WithCancel(context.Background())
+	lis := bufconn.Listen(1 << 16)
+	errCh := make(chan error)
+	go func() { errCh <- d.Run(ctx, lis) }()
+	defer func() {
+		cancel()
+		require.NoError(t, <-errCh)
+	}()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	defer conn.Close()
+
+	info, err := csi.NewIdentityClient(conn).GetPluginInfo(ctx, &csi.GetPluginInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "csi.cert-manager.io", info.Name)
+
+	probe, err := csi.NewIdentityClient(conn).Probe(ctx, &csi.ProbeRequest{})
+	require.NoError(t, err)
+	assert.True(t, probe.GetReady().GetValue(), "expected driver to be ready")
+
+	nodeInfo, err := csi.NewNodeClient(conn).NodeGetInfo(ctx, &csi.NodeGetInfoRequest{})
+	require.NoError(t, err)
+	assert.Equal(t, "node-1", nodeInfo.NodeId)
+
+	_, err = csi.NewNodeClient(conn).NodeStageVolume(ctx, &csi.NodeStageVolumeRequest{VolumeId: "csi-1234"})
+	assert.Equal(t, codes.Unimplemented, status.Code(err), "unexpected error %v", err)
+}
@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"context"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller/certificates"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// Annotations set on the CertificateRequests of volumes to identify the
+// volume they were created for.
+const (
+	VolumeIDAnnotationKey = "csi.cert-manager.io/volume-id"
+	NodeIDAnnotationKey   = "csi.cert-manager.io/node-id"
+)
+
+// issue generates a new private key for a volume and requests a certificate
+// for it with a CertificateRequest, which is deleted once it has completed.
+// It returns the files of the volume and the time the certificate is to be
+// renewed at.
+func (d *Driver) issue(ctx context.Context, meta *volumeMetadata) (map[string][]byte, time.Time, error) {
+	req, err := parseAttributes(meta.VolumeContext)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	crt := &cmapi.Certificate{Spec: req.spec}
+	pk, err := pki.GeneratePrivateKeyForCertificate(crt)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to generate private key: %w", err)
+	}
+	pkData, err := pki.EncodePrivateKey(pk, cmapi.PKCS8)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to encode private key: %w", err)
+	}
+	template, err := pki.GenerateCSR(crt)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to generate CSR: %w", err)
+	}
+	csrDER, err := pki.EncodeCSR(template, pk)
+	if err != nil {
+		return nil, time.Time{}, err
+	}
+
+	cr := &cmapi.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: req.podName + "-",
+			Namespace:    req.podNamespace,
+			Annotations: map[string]string{
+				VolumeIDAnnotationKey: meta.VolumeID,
+				NodeIDAnnotationKey:   d.nodeID,
+			},
+			// The CertificateRequest is garbage collected with the pod if
+			// the driver fails to delete it.
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: "v1",
+				Kind:       "Pod",
+				Name:       req.podName,
+				UID:        types.UID(req.podUID),
+			}},
+		},
+		Spec: cmapi.CertificateRequestSpec{
+			Request:   pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER}),
+			IssuerRef: req.issuerRef,
+			Duration:  req.spec.Duration,
+			IsCA:      req.spec.IsCA,
+			Usages:    req.spec.Usages,
+		},
+	}
+	cr, err = d.client.CertmanagerV1().CertificateRequests(cr.Namespace).Create(ctx, cr, metav1.CreateOptions{})
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to create CertificateRequest: %w", err)
+	}
+	log := logf.WithResource(d.log, cr).WithValues("volume_id", meta.VolumeID)
+	log.V(logf.DebugLevel).Info("created CertificateRequest")
+	defer func() {
+		// The CertificateRequest is deleted even if the volume is no
+		// longer published, so its context cannot be used.
+		if err := d.client.CertmanagerV1().CertificateRequests(cr.Namespace).Delete(context.Background(), cr.Name, metav1.DeleteOptions{}); err != nil {
+			log.Error(err, "failed to delete CertificateRequest")
+		}
+	}()
+
+	if err := wait.PollImmediateUntil(d.pollInterval, func() (bool, error) {
+		latest, err := d.client.CertmanagerV1().CertificateRequests(cr.Namespace).Get(ctx, cr.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Error(err, "failed to get CertificateRequest")
+			return false, nil
+		}
+		cr = latest
+		if apiutil.CertificateRequestIsDenied(cr) {
+			return false, fmt.Errorf("CertificateRequest %s/%s has been denied", cr.Namespace, cr.Name)
+		}
+		ready := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionReady)
+		switch {
+		case ready == nil:
+			return false, nil
+		case ready.Status == cmmeta.ConditionTrue && len(cr.Status.Certificate) > 0:
+			return true, nil
+		case ready.Reason == cmapi.CertificateRequestReasonFailed:
+			return false, fmt.Errorf("CertificateRequest %s/%s has failed: %s", cr.Namespace, cr.Name, ready.Message)
+		}
+		return false, nil
+	}, ctx.Done()); err != nil {
+		return nil, time.Time{}, fmt.Errorf("waiting for CertificateRequest %s/%s to be issued: %w", cr.Namespace, cr.Name, err)
+	}
+
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		return nil, time.Time{}, fmt.Errorf("failed to decode issued certificate: %w", err)
+	}
+	renewalTime := certificates.RenewalTime(cert.NotBefore, cert.NotAfter, req.spec.RenewBefore, nil)
+
+	return map[string][]byte{
+		req.keyFile:  pkData,
+		req.certFile: cr.Status.Certificate,
+		req.caFile:   cr.Status.CA,
+	}, renewalTime.Time, nil
+}
@@ -0,0 +1,27 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+// mounter mounts the data directory of volumes into pods.
+type mounter interface {
+	// Mount read-only bind mounts source at target, which must exist.
+	Mount(source, target string) error
+	// Unmount unmounts target.
+	Unmount(target string) error
+	// IsMountPoint returns true if a file system is mounted at target.
+	IsMountPoint(target string) (bool, error)
+}
@@ -0,0 +1,102 @@
+//go:build linux
+// +build linux
+
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"syscall"
+)
+
+// bindMounter bind mounts directories using the mount system call.
+type bindMounter struct{}
+
+func newMounter() mounter {
+	return bindMounter{}
+}
+
+func (bindMounter) Mount(source, target string) error {
+	if err := syscall.Mount(source, target, "", syscall.MS_BIND, ""); err != nil {
+		return fmt.Errorf("failed to bind mount %s at %s: %w", source, target, err)
+	}
+	// The read-only flag is ignored when a bind mount is created, so it has
+	// to be remounted read-only.
+	if err := syscall.Mount("", target, "", syscall.MS_BIND|syscall.MS_REMOUNT|syscall.MS_RDONLY, ""); err != nil {
+		_ = syscall.Unmount(target, 0)
+		return fmt.Errorf("failed to remount %s read-only: %w", target, err)
+	}
+	return nil
+}
+
+func (bindMounter) Unmount(target string) error {
+	if err := syscall.Unmount(target, 0); err != nil {
+		return fmt.Errorf("failed to unmount %s: %w", target, err)
+	}
+	return nil
+}
+
+// IsMountPoint looks target up in the mount table of the process, as bind
+// mounts within the same file system cannot be detected by comparing
+// device numbers.
+func (bindMounter) IsMountPoint(target string) (bool, error) {
+	target = filepath.Clean(target)
+	f, err := os.Open("/proc/self/mountinfo")
+	if err != nil {
+		return false, err
+	}
+	defer f.Close()
+
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		// The fifth field is the mount point, see proc(5).
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 5 {
+			continue
+		}
+		if unescapeMountInfo(fields[4]) == target {
+			return true, nil
+		}
+	}
+	return false, scanner.Err()
+}
+
+// unescapeMountInfo decodes the octal escapes of whitespace and backslashes
+// in mount points listed in /proc/self/mountinfo.
+func unescapeMountInfo(s string) string {
+	if !strings.Contains(s, `\`) {
+		return s
+	}
+	var b strings.Builder
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\\' && i+3 < len(s) {
+			if c, err := strconv.ParseUint(s[i+1:i+4], 8, 8); err == nil {
+				b.WriteByte(byte(c))
+				i += 3
+				continue
+			}
+		}
+		b.WriteByte(s[i])
+	}
+	return b.String()
+}
@@ -0,0 +1,46 @@
+//go:build !linux
+// +build !linux
+
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"errors"
+)
+
+var errMountUnsupported = errors.New("mounting volumes is only supported on Linux")
+
+// unsupportedMounter is used on platforms on which volumes cannot be bind
+// mounted.
+type unsupportedMounter struct{}
+
+func newMounter() mounter {
+	return unsupportedMounter{}
+}
+
+func (unsupportedMounter) Mount(string, string) error {
+	return errMountUnsupported
+}
+
+func (unsupportedMounter) Unmount(string) error {
+	return errMountUnsupported
+}
+
+func (unsupportedMounter) IsMountPoint(string) (bool, error) {
+	return false, errMountUnsupported
+}
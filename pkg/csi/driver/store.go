@@ -0,0 +1,219 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package driver
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+const (
+	metadataFile = "metadata.json"
+	dataDir      = "data"
+
+	// currentDataLink is the symlink in the data directory of a volume
+	// pointing to the directory holding its current files. The files of a
+	// volume are symlinks into it, so that all of them are replaced at once
+	// by replacing the symlink when the certificate is renewed.
+	currentDataLink = "..data"
+)
+
+// volumeMetadata is the state of a published volume. It is persisted so
+// that volumes continue to be renewed once the driver restarts.
+type volumeMetadata struct {
+	VolumeID   string `json:"volumeID"`
+	TargetPath string `json:"targetPath"`
+	// VolumeContext holds the volume attributes the volume was published
+	// with.
+	VolumeContext map[string]string `json:"volumeContext"`
+	// NextIssuanceTime is when the certificate of the volume is to be
+	// renewed. It is zero until the first certificate has been issued.
+	NextIssuanceTime time.Time `json:"nextIssuanceTime,omitempty"`
+}
+
+// store persists the metadata and files of volumes in a directory on the
+// node, which should be backed by memory so that private keys are never
+// written to disk. The data directory of each volume is bind mounted into
+// the pod.
+type store struct {
+	root string
+}
+
+func (s *store) volumeDir(id string) string {
+	return filepath.Join(s.root, id)
+}
+
+// dataDir returns the directory holding the files of a volume.
+func (s *store) dataDir(id string) string {
+	return filepath.Join(s.root, id, dataDir)
+}
+
+// create creates the directories of a new volume.
+func (s *store) create(id string) error {
+	if !isVolumeID(id) {
+		return fmt.Errorf("invalid volume ID %q", id)
+	}
+	return os.MkdirAll(s.dataDir(id), 0755)
+}
+
+// remove removes the metadata and files of a volume. It does not fail if the
+// volume does not exist.
+func (s *store) remove(id string) error {
+	if !isVolumeID(id) {
+		return fmt.Errorf("invalid volume ID %q", id)
+	}
+	return os.RemoveAll(s.volumeDir(id))
+}
+
+func (s *store) readMetadata(id string) (*volumeMetadata, error) {
+	data, err := os.ReadFile(filepath.Join(s.volumeDir(id), metadataFile))
+	if err != nil {
+		return nil, err
+	}
+	meta := &volumeMetadata{}
+	if err := json.Unmarshal(data, meta); err != nil {
+		return nil, fmt.Errorf("failed to decode metadata of volume %q: %w", id, err)
+	}
+	return meta, nil
+}
+
+func (s *store) writeMetadata(meta *volumeMetadata) error {
+	data, err := json.Marshal(meta)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(filepath.Join(s.volumeDir(meta.VolumeID), metadataFile), data, 0600)
+}
+
+// list returns the metadata of all volumes in the store. Volumes without
+// metadata were not fully published, and are skipped.
+func (s *store) list() ([]*volumeMetadata, error) {
+	entries, err := os.ReadDir(s.root)
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var volumes []*volumeMetadata
+	for _, entry := range entries {
+		if !entry.IsDir() || !isVolumeID(entry.Name()) {
+			continue
+		}
+		meta, err := s.readMetadata(entry.Name())
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		volumes = append(volumes, meta)
+	}
+	return volumes, nil
+}
+
+// writeFiles replaces the files of a volume. The files are written to a new
+// directory, which the current data symlink is then atomically pointed at,
+// so that a pod never reads a certificate and private key which do not
+// belong together. Files which are no longer written are removed.
+func (s *store) writeFiles(id string, files map[string][]byte) error {
+	dir := s.dataDir(id)
+	newDir, err := os.MkdirTemp(dir, "..")
+	if err != nil {
+		return err
+	}
+	for name, data := range files {
+		if err := os.WriteFile(filepath.Join(newDir, name), data, 0644); err != nil {
+			os.RemoveAll(newDir)
+			return err
+		}
+	}
+	// MkdirTemp creates directories with mode 0700, which the pod would not
+	// be able to read if it does not run as root.
+	if err := os.Chmod(newDir, 0755); err != nil {
+		os.RemoveAll(newDir)
+		return err
+	}
+
+	tmpLink := filepath.Join(dir, currentDataLink+"_tmp")
+	if err := os.Remove(tmpLink); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := os.Symlink(filepath.Base(newDir), tmpLink); err != nil {
+		return err
+	}
+	if err := os.Rename(tmpLink, filepath.Join(dir, currentDataLink)); err != nil {
+		return err
+	}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		name := entry.Name()
+		_, current := files[name]
+		switch {
+		case name == currentDataLink || name == filepath.Base(newDir):
+		case strings.HasPrefix(name, ".."):
+			// a directory holding previous files of the volume
+			if err := os.RemoveAll(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		case !current:
+			if err := os.Remove(filepath.Join(dir, name)); err != nil {
+				return err
+			}
+		}
+	}
+	for name := range files {
+		link := filepath.Join(dir, name)
+		if _, err := os.Lstat(link); err == nil {
+			continue
+		}
+		if err := os.Symlink(filepath.Join(currentDataLink, name), link); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// readFile reads a file of a volume.
+func (s *store) readFile(id, name string) ([]byte, error) {
+	return os.ReadFile(filepath.Join(s.dataDir(id), name))
+}
+
+// writeFileAtomic writes a file by renaming a temporary file over it, so
+// that a partially written file is never read.
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, perm); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// isVolumeID returns true if id can safely be used as the name of the
+// directory of a volume.
+func isVolumeID(id string) bool {
+	return id != "" && id != "." && id != ".." && !strings.ContainsRune(id, '/')
+}
@@ -50,8 +50,9 @@ type Acme struct {
 	// clientBuilder builds a new ACME client.
 	clientBuilder accounts.NewClientFunc
 
-	// namespace of referenced resources when the given issuer is a ClusterIssuer
-	clusterResourceNamespace string
+	// namespace of referenced resources. For ClusterIssuers, this is their
+	// credentials namespace or else the cluster resource namespace.
+	resourceNamespace string
 	// used as a cache for ACME clients
 	accountRegistry accounts.Registry
 
@@ -70,16 +71,21 @@ func New(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, er
 
 	secretsLister := ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister()
 
+	resourceNamespace, err := ctx.IssuerOptions.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, err
+	}
+
 	a := &Acme{
-		issuer:                   issuer,
-		keyFromSecret:            newKeyFromSecret(secretsLister),
-		clientBuilder:            accounts.NewClient,
-		secretsClient:            ctx.Client.CoreV1(),
-		recorder:                 ctx.Recorder,
-		clusterResourceNamespace: ctx.IssuerOptions.ClusterResourceNamespace,
-		accountRegistry:          ctx.ACMEOptions.AccountRegistry,
-		metrics:                  ctx.Metrics,
-		userAgent:                ctx.RESTConfig.UserAgent,
+		issuer:            issuer,
+		keyFromSecret:     newKeyFromSecret(secretsLister),
+		clientBuilder:     accounts.NewClient,
+		secretsClient:     ctx.Client.CoreV1(),
+		recorder:          ctx.Recorder,
+		resourceNamespace: resourceNamespace,
+		accountRegistry:   ctx.ACMEOptions.AccountRegistry,
+		metrics:           ctx.Metrics,
+		userAgent:         ctx.RESTConfig.UserAgent,
 	}
 
 	return a, nil
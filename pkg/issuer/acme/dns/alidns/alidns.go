@@ -0,0 +1,377 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package alidns implements a DNS provider for solving the DNS-01 challenge
+// using Alibaba Cloud DNS.
+// See https://www.alibabacloud.com/help/en/alibaba-cloud-dns/latest/api-alidns-2015-01-09-dir-parsing-records
+package alidns
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	// defaultEndpoint is the endpoint of the Alibaba Cloud DNS API.
+	defaultEndpoint = "https://alidns.aliyuncs.com/"
+
+	// dnsAPIVersion is the version of the Alibaba Cloud DNS API used.
+	dnsAPIVersion = "2015-01-09"
+
+	// describePageSize is the largest page size accepted by
+	// DescribeDomainRecords.
+	describePageSize = 500
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	endpoint         string
+	creds            credentialsProvider
+	client           *rpcClient
+
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// record is a resource record as returned by DescribeDomainRecords.
+type record struct {
+	RecordID string `json:"RecordId"`
+	RR       string `json:"RR"`
+	Type     string `json:"Type"`
+	Value    string `json:"Value"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Alibaba Cloud
+// DNS. The AccessKey pair must be passed in the environment variables
+// ALICLOUD_ACCESS_KEY and ALICLOUD_SECRET_KEY. If they are not set, the RAM
+// role named by ALICLOUD_RAM_ROLE, or else the RAM role attached to the ECS
+// instance, is used if ambient credentials are permitted.
+func NewDNSProvider(ambient bool, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	return NewDNSProviderCredentials(
+		os.Getenv("ALICLOUD_ACCESS_KEY"),
+		os.Getenv("ALICLOUD_SECRET_KEY"),
+		os.Getenv("ALICLOUD_RAM_ROLE"),
+		"",
+		os.Getenv("ALICLOUD_REGION"),
+		ambient,
+		dns01Nameservers,
+		userAgent,
+	)
+}
+
+// NewDNSProviderCredentials returns a DNSProvider instance configured for
+// Alibaba Cloud DNS.
+//
+// Requests are signed with the given AccessKey pair. If no AccessKey pair is
+// given and ambient credentials are permitted, the temporary credentials of
+// the RAM role attached to the ECS instance are used instead; ramRole names
+// the role, and is discovered from the instance metadata if empty.
+//
+// If roleARN is set, the RAM role it identifies is assumed using the
+// credentials above, and the role's temporary credentials are used.
+//
+// If regionID is set, the regional endpoint of the DNS API is used.
+func NewDNSProviderCredentials(accessKeyID, accessKeySecret, ramRole, roleARN, regionID string, ambient bool, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	httpClient := &http.Client{Timeout: 30 * time.Second}
+	client := &rpcClient{client: httpClient, userAgent: userAgent, now: time.Now}
+
+	var creds credentialsProvider
+	switch {
+	case accessKeyID != "" && accessKeySecret != "":
+		creds = &staticCredentials{creds: credentials{accessKeyID: accessKeyID, accessKeySecret: accessKeySecret}}
+	case accessKeyID != "" || accessKeySecret != "":
+		// It's always an error to set one of those but not the other
+		return nil, fmt.Errorf("unable to construct alidns provider: only one of AccessKey ID and secret was provided")
+	case !ambient:
+		return nil, fmt.Errorf("unable to construct alidns provider: empty credentials; perhaps you meant to enable ambient credentials?")
+	default:
+		role := &ecsRAMRole{metadataURL: ecsMetadataURL, roleName: ramRole, client: httpClient}
+		creds = &cachedCredentials{fetch: role.fetch, now: time.Now}
+	}
+
+	if roleARN != "" {
+		assume := &assumeRole{source: creds, roleARN: roleARN, endpoint: stsEndpoint, client: client}
+		creds = &cachedCredentials{fetch: assume.fetch, now: time.Now}
+	}
+
+	endpoint := defaultEndpoint
+	if regionID != "" {
+		endpoint = fmt.Sprintf("https://alidns.%s.aliyuncs.com/", regionID)
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		endpoint:               endpoint,
+		creds:                  creds,
+		client:                 client,
+		findHostedDomainByFqdn: util.FindZoneByFqdn,
+		ttl:                    600,
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, rr, err := c.zoneAndRR(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.getTXTRecords(zone, rr)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if r.Value == value {
+			return nil
+		}
+	}
+
+	params := url.Values{}
+	params.Set("DomainName", zone)
+	params.Set("RR", rr)
+	params.Set("Type", "TXT")
+	params.Set("Value", value)
+	params.Set("TTL", fmt.Sprint(c.ttl))
+	err = c.call("AddDomainRecord", params, nil)
+	if apiErr, ok := err.(*apiError); ok && apiErr.Code == "DomainRecordDuplicate" {
+		// the record was created concurrently
+		return nil
+	}
+	return err
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, rr, err := c.zoneAndRR(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.getTXTRecords(zone, rr)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if r.Value != value {
+			continue
+		}
+		params := url.Values{}
+		params.Set("RecordId", r.RecordID)
+		err := c.call("DeleteDomainRecord", params, nil)
+		if apiErr, ok := err.(*apiError); ok && apiErr.Code == "DomainRecordNotBelongToUser" {
+			// the record was deleted concurrently
+			continue
+		}
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// zoneAndRR returns the domain name that the fqdn belongs to, along with the
+// host record (RR) of the fqdn relative to it.
+func (c *DNSProvider) zoneAndRR(fqdn string) (string, string, error) {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", "", fmt.Errorf("alidns: failed to determine zone for %q: %v", fqdn, err)
+	}
+	zone = util.UnFqdn(zone)
+	name := util.UnFqdn(fqdn)
+
+	if name == zone {
+		return zone, "@", nil
+	}
+	if !strings.HasSuffix(name, "."+zone) {
+		return "", "", fmt.Errorf("alidns: fqdn %q is not part of zone %q", fqdn, zone)
+	}
+	return zone, strings.TrimSuffix(name, "."+zone), nil
+}
+
+// getTXTRecords returns the TXT records for the host record rr. The
+// RRKeyWord filter matches host records fuzzily, so every page of results is
+// read and the records returned are filtered again here.
+func (c *DNSProvider) getTXTRecords(zone, rr string) ([]record, error) {
+	var records []record
+	for pageNumber := 1; ; pageNumber++ {
+		params := url.Values{}
+		params.Set("DomainName", zone)
+		params.Set("RRKeyWord", rr)
+		params.Set("TypeKeyWord", "TXT")
+		params.Set("PageNumber", fmt.Sprint(pageNumber))
+		params.Set("PageSize", fmt.Sprint(describePageSize))
+
+		var resp struct {
+			TotalCount    int `json:"TotalCount"`
+			DomainRecords struct {
+				Record []record `json:"Record"`
+			} `json:"DomainRecords"`
+		}
+		if err := c.call("DescribeDomainRecords", params, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, r := range resp.DomainRecords.Record {
+			if r.Type == "TXT" && strings.EqualFold(r.RR, rr) {
+				records = append(records, r)
+			}
+		}
+
+		if len(resp.DomainRecords.Record) == 0 || pageNumber*describePageSize >= resp.TotalCount {
+			return records, nil
+		}
+	}
+}
+
+func (c *DNSProvider) call(action string, params url.Values, out interface{}) error {
+	creds, err := c.creds.credentials()
+	if err != nil {
+		return fmt.Errorf("alidns: error obtaining credentials: %v", err)
+	}
+	if err := c.client.call(c.endpoint, dnsAPIVersion, action, params, creds, out); err != nil {
+		if _, ok := err.(*apiError); ok {
+			return err
+		}
+		return fmt.Errorf("alidns: %v", err)
+	}
+	return nil
+}
+
+// apiError is the body returned by Alibaba Cloud RPC APIs on failure.
+type apiError struct {
+	Action    string `json:"-"`
+	Code      string `json:"Code"`
+	Message   string `json:"Message"`
+	RequestID string `json:"RequestId"`
+}
+
+func (e *apiError) Error() string {
+	return fmt.Sprintf("alidns: %s failed: %s: %s (request id %s)", e.Action, e.Code, e.Message, e.RequestID)
+}
+
+// rpcClient calls Alibaba Cloud RPC style APIs, signing requests using
+// signature version 1.0.
+// See https://www.alibabacloud.com/help/en/alibaba-cloud-dns/latest/request-signature
+type rpcClient struct {
+	client    *http.Client
+	userAgent string
+	now       func() time.Time
+}
+
+func (r *rpcClient) call(endpoint, version, action string, params url.Values, creds *credentials, out interface{}) error {
+	nonce := make([]byte, 16)
+	if _, err := rand.Read(nonce); err != nil {
+		return err
+	}
+
+	q := url.Values{}
+	for k, v := range params {
+		q[k] = v
+	}
+	q.Set("Action", action)
+	q.Set("Version", version)
+	q.Set("Format", "JSON")
+	q.Set("AccessKeyId", creds.accessKeyID)
+	q.Set("SignatureMethod", "HMAC-SHA1")
+	q.Set("SignatureVersion", "1.0")
+	q.Set("SignatureNonce", hex.EncodeToString(nonce))
+	q.Set("Timestamp", r.now().UTC().Format("2006-01-02T15:04:05Z"))
+	if creds.securityToken != "" {
+		q.Set("SecurityToken", creds.securityToken)
+	}
+	q.Set("Signature", sign(http.MethodGet, q, creds.accessKeySecret))
+
+	req, err := http.NewRequest(http.MethodGet, endpoint+"?"+canonicalQuery(q), nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("User-Agent", r.userAgent)
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("error calling %s: %v", action, err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("error reading response of %s: %v", action, err)
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		apiErr := &apiError{Action: action}
+		if err := json.Unmarshal(b, apiErr); err != nil || apiErr.Code == "" {
+			return fmt.Errorf("error calling %s: unexpected status code %d", action, resp.StatusCode)
+		}
+		return apiErr
+	}
+
+	if out == nil {
+		return nil
+	}
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("error decoding response of %s: %v", action, err)
+	}
+	return nil
+}
+
+// sign returns the signature of a request with the given query parameters.
+func sign(method string, q url.Values, secret string) string {
+	stringToSign := method + "&" + percentEncode("/") + "&" + percentEncode(canonicalQuery(q))
+	mac := hmac.New(sha1.New, []byte(secret+"&"))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// canonicalQuery encodes query parameters sorted by key, percent-encoding
+// keys and values as required for signing.
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	parts := make([]string, 0, len(keys))
+	for _, k := range keys {
+		parts = append(parts, percentEncode(k)+"="+percentEncode(q.Get(k)))
+	}
+	return strings.Join(parts, "&")
+}
+
+// percentEncode encodes s as described in RFC 3986, which differs from
+// url.QueryEscape in its handling of spaces, '*' and '~'.
+func percentEncode(s string) string {
+	s = url.QueryEscape(s)
+	s = strings.ReplaceAll(s, "+", "%20")
+	s = strings.ReplaceAll(s, "*", "%2A")
+	return strings.ReplaceAll(s, "%7E", "~")
+}
@@ -0,0 +1,230 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alidns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// verify checks the signature of an RPC request, returning the access key ID
+// it was signed with.
+func verify(q url.Values, secrets map[string]string) (string, bool) {
+	secret, ok := secrets[q.Get("AccessKeyId")]
+	if !ok {
+		return "", false
+	}
+	signature := q.Get("Signature")
+	q.Del("Signature")
+	return q.Get("AccessKeyId"), sign(http.MethodGet, q, secret) == signature
+}
+
+func writeError(w http.ResponseWriter, status int, code, message string) {
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(apiError{Code: code, Message: message, RequestID: "req-id"})
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	_, err := NewDNSProviderCredentials("ak", "secret", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	_, err := NewDNSProviderCredentials("", "", "", "", "", false, nil, "cert-manager-test")
+	assert.EqualError(t, err, "unable to construct alidns provider: empty credentials; perhaps you meant to enable ambient credentials?")
+
+	_, err = NewDNSProviderCredentials("ak", "", "", "", "", true, nil, "cert-manager-test")
+	assert.EqualError(t, err, "unable to construct alidns provider: only one of AccessKey ID and secret was provided")
+}
+
+func TestNewDNSProviderRegionalEndpoint(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("ak", "secret", "", "", "ap-southeast-1", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	assert.Equal(t, "https://alidns.ap-southeast-1.aliyuncs.com/", provider.endpoint)
+}
+
+func TestPercentEncode(t *testing.T) {
+	assert.Equal(t, "a%20b%2Ac~d%2F%3D", percentEncode("a b*c~d/="))
+}
+
+func TestAliDNSPresentRecordOnLaterPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if q.Get("Action") != "DescribeDomainRecords" {
+			t.Errorf("unexpected action %s", q.Get("Action"))
+			return
+		}
+		switch q.Get("PageNumber") {
+		case "1":
+			// RRKeyWord matches fuzzily, so records for other names are returned
+			io.WriteString(w, `{"TotalCount":501,"DomainRecords":{"Record":[{"RecordId":"1","RR":"_acme-challenge.sub","Type":"TXT","Value":"123d=="}]}}`)
+		case "2":
+			io.WriteString(w, `{"TotalCount":501,"DomainRecords":{"Record":[{"RecordId":"2","RR":"_acme-challenge","Type":"TXT","Value":"123d=="}]}}`)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("ak", "secret", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL + "/"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestAliDNSPresentThrottled(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		writeError(w, http.StatusBadRequest, "Throttling.User", "Request was denied due to user flow control.")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("ak", "secret", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL + "/"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, "alidns: DescribeDomainRecords failed: Throttling.User: Request was denied due to user flow control. (request id req-id)")
+}
+
+func TestAliDNSPresentInvalidSecret(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if _, ok := verify(r.URL.Query(), map[string]string{"ak": "secret"}); !ok {
+			writeError(w, http.StatusBadRequest, "SignatureDoesNotMatch", "Specified signature is not matched with our calculation.")
+			return
+		}
+		t.Errorf("expected the request signature to be rejected")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("ak", "wrong", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL + "/"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, "alidns: DescribeDomainRecords failed: SignatureDoesNotMatch: Specified signature is not matched with our calculation. (request id req-id)")
+}
+
+func TestAliDNSPresentCreatedConcurrently(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Query().Get("Action") {
+		case "DescribeDomainRecords":
+			io.WriteString(w, `{"TotalCount":0,"DomainRecords":{"Record":[]}}`)
+		case "AddDomainRecord":
+			writeError(w, http.StatusBadRequest, "DomainRecordDuplicate", "The DNS record already exists.")
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("ak", "secret", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL + "/"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestAliDNSCleanUpRecordNotFound(t *testing.T) {
+	var deleted []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		switch q.Get("Action") {
+		case "DescribeDomainRecords":
+			io.WriteString(w, `{"TotalCount":1,"DomainRecords":{"Record":[{"RecordId":"1","RR":"_acme-challenge","Type":"TXT","Value":"123d=="}]}}`)
+		case "DeleteDomainRecord":
+			deleted = append(deleted, q.Get("RecordId"))
+			writeError(w, http.StatusBadRequest, "DomainRecordNotBelongToUser", "The DNS record does not exist or does not belong to the current account.")
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("ak", "secret", "", "", "", false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL + "/"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []string{"1"}, deleted)
+}
+
+func TestECSRAMRoleCredentials(t *testing.T) {
+	expiration := time.Now().Add(time.Hour).UTC().Format(time.RFC3339)
+	metadata := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/ram/security-credentials/":
+			io.WriteString(w, "cert-manager-role")
+		case "/ram/security-credentials/cert-manager-role":
+			fmt.Fprintf(w, `{"AccessKeyId":"STS.ak","AccessKeySecret":"sts-secret","SecurityToken":"sts-token","Expiration":%q,"Code":"Success"}`, expiration)
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer metadata.Close()
+
+	role := &ecsRAMRole{metadataURL: metadata.URL, client: http.DefaultClient}
+	creds := &cachedCredentials{fetch: role.fetch, now: time.Now}
+	c, err := creds.credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "STS.ak", c.accessKeyID)
+	assert.Equal(t, "sts-secret", c.accessKeySecret)
+	assert.Equal(t, "sts-token", c.securityToken)
+}
+
+func TestAssumeRoleCredentials(t *testing.T) {
+	calls := 0
+	sts := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		q := r.URL.Query()
+		if _, ok := verify(q, map[string]string{"ak": "secret"}); !ok {
+			writeError(w, http.StatusBadRequest, "SignatureDoesNotMatch", "Specified signature is not matched with our calculation.")
+			return
+		}
+		calls++
+		assert.Equal(t, "AssumeRole", q.Get("Action"))
+		assert.Equal(t, stsAPIVersion, q.Get("Version"))
+		assert.Equal(t, "acs:ram::123456789012:role/cert-manager", q.Get("RoleArn"))
+		fmt.Fprintf(w, `{"Credentials":{"AccessKeyId":"STS.assumed","AccessKeySecret":"assumed-secret","SecurityToken":"assumed-token","Expiration":%q}}`,
+			time.Now().Add(time.Hour).UTC().Format(time.RFC3339))
+	}))
+	defer sts.Close()
+
+	assume := &assumeRole{
+		source:   &staticCredentials{creds: credentials{accessKeyID: "ak", accessKeySecret: "secret"}},
+		roleARN:  "acs:ram::123456789012:role/cert-manager",
+		endpoint: sts.URL + "/",
+		client:   &rpcClient{client: http.DefaultClient, now: time.Now},
+	}
+	creds := &cachedCredentials{fetch: assume.fetch, now: time.Now}
+
+	c, err := creds.credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, "STS.assumed", c.accessKeyID)
+	assert.Equal(t, "assumed-secret", c.accessKeySecret)
+	assert.Equal(t, "assumed-token", c.securityToken)
+	// the assumed role's credentials are cached between calls
+	_, err = creds.credentials()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, calls)
+}
@@ -0,0 +1,202 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alidns
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// ecsMetadataURL is the base URL of the ECS instance metadata service.
+	ecsMetadataURL = "http://100.100.100.200/latest/meta-data"
+
+	// stsEndpoint is the endpoint of the Alibaba Cloud Security Token Service.
+	stsEndpoint = "https://sts.aliyuncs.com/"
+
+	// stsAPIVersion is the version of the STS API used to assume RAM roles.
+	stsAPIVersion = "2015-04-01"
+)
+
+// credentials is an AccessKey pair, optionally accompanied by an STS security
+// token if the pair is temporary.
+type credentials struct {
+	accessKeyID     string
+	accessKeySecret string
+	securityToken   string
+	expiry          time.Time
+}
+
+// credentialsProvider provides the credentials used to sign requests.
+type credentialsProvider interface {
+	credentials() (*credentials, error)
+}
+
+type staticCredentials struct {
+	creds credentials
+}
+
+func (s *staticCredentials) credentials() (*credentials, error) {
+	return &s.creds, nil
+}
+
+// cachedCredentials caches temporary credentials returned by fetch until
+// shortly before they expire.
+type cachedCredentials struct {
+	fetch func() (*credentials, error)
+	now   func() time.Time
+
+	lock  sync.Mutex
+	creds *credentials
+}
+
+func (c *cachedCredentials) credentials() (*credentials, error) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+
+	if c.creds != nil && c.now().Add(5*time.Minute).Before(c.creds.expiry) {
+		return c.creds, nil
+	}
+
+	creds, err := c.fetch()
+	if err != nil {
+		return nil, err
+	}
+	c.creds = creds
+	return c.creds, nil
+}
+
+// ecsRAMRole fetches temporary credentials for the RAM role attached to the
+// ECS instance that cert-manager is running on.
+type ecsRAMRole struct {
+	metadataURL string
+	roleName    string
+	client      *http.Client
+}
+
+func (e *ecsRAMRole) fetch() (*credentials, error) {
+	roleName := e.roleName
+	if roleName == "" {
+		b, err := e.getMetadata("/ram/security-credentials/")
+		if err != nil {
+			return nil, err
+		}
+		roleName = strings.TrimSpace(string(b))
+		if roleName == "" {
+			return nil, fmt.Errorf("no RAM role is attached to the ECS instance")
+		}
+	}
+
+	b, err := e.getMetadata("/ram/security-credentials/" + url.PathEscape(roleName))
+	if err != nil {
+		return nil, err
+	}
+
+	var resp struct {
+		Code            string `json:"Code"`
+		AccessKeyID     string `json:"AccessKeyId"`
+		AccessKeySecret string `json:"AccessKeySecret"`
+		SecurityToken   string `json:"SecurityToken"`
+		Expiration      string `json:"Expiration"`
+	}
+	if err := json.Unmarshal(b, &resp); err != nil {
+		return nil, fmt.Errorf("error decoding credentials for RAM role %q: %v", roleName, err)
+	}
+	if resp.Code != "Success" {
+		return nil, fmt.Errorf("error fetching credentials for RAM role %q: %s", roleName, resp.Code)
+	}
+
+	expiry, err := time.Parse(time.RFC3339, resp.Expiration)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing expiry of credentials for RAM role %q: %v", roleName, err)
+	}
+
+	return &credentials{
+		accessKeyID:     resp.AccessKeyID,
+		accessKeySecret: resp.AccessKeySecret,
+		securityToken:   resp.SecurityToken,
+		expiry:          expiry,
+	}, nil
+}
+
+func (e *ecsRAMRole) getMetadata(path string) ([]byte, error) {
+	resp, err := e.client.Get(e.metadataURL + path)
+	if err != nil {
+		return nil, fmt.Errorf("error querying ECS instance metadata service: %v", err)
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error reading ECS instance metadata: %v", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("error querying ECS instance metadata service for %q: unexpected status code %d", path, resp.StatusCode)
+	}
+	return b, nil
+}
+
+// assumeRole fetches temporary credentials for a RAM role by assuming it with
+// the source credentials.
+type assumeRole struct {
+	source   credentialsProvider
+	roleARN  string
+	endpoint string
+	client   *rpcClient
+}
+
+func (a *assumeRole) fetch() (*credentials, error) {
+	source, err := a.source.credentials()
+	if err != nil {
+		return nil, err
+	}
+
+	params := url.Values{}
+	params.Set("RoleArn", a.roleARN)
+	params.Set("RoleSessionName", "cert-manager")
+	params.Set("DurationSeconds", "3600")
+
+	var resp struct {
+		Credentials struct {
+			AccessKeyID     string `json:"AccessKeyId"`
+			AccessKeySecret string `json:"AccessKeySecret"`
+			SecurityToken   string `json:"SecurityToken"`
+			Expiration      string `json:"Expiration"`
+		} `json:"Credentials"`
+	}
+	if err := a.client.call(a.endpoint, stsAPIVersion, "AssumeRole", params, source, &resp); err != nil {
+		return nil, fmt.Errorf("error assuming RAM role %q: %v", a.roleARN, err)
+	}
+
+	expiry, err := time.Parse(time.RFC3339, resp.Credentials.Expiration)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing expiry of credentials for RAM role %q: %v", a.roleARN, err)
+	}
+
+	return &credentials{
+		accessKeyID:     resp.Credentials.AccessKeyID,
+		accessKeySecret: resp.Credentials.AccessKeySecret,
+		securityToken:   resp.Credentials.SecurityToken,
+		expiry:          expiry,
+	}, nil
+}
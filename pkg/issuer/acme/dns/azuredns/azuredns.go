@@ -12,7 +12,9 @@ package azuredns
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/url"
 	"strings"
 
 	"github.com/go-logr/logr"
@@ -38,9 +40,19 @@ type DNSProvider struct {
 	log               logr.Logger
 }
 
+// DefaultTokenAudience is the audience Azure AD expects ServiceAccount tokens
+// used for workload identity federation to be issued for.
+const DefaultTokenAudience = "api://AzureADTokenExchange"
+
+// FederatedTokenFunc returns a Kubernetes ServiceAccount token which is
+// exchanged for an Azure AD token using workload identity federation.
+type FederatedTokenFunc func() (string, error)
+
 // NewDNSProviderCredentials returns a DNSProvider instance configured for the Azure
-// DNS service using static credentials from its parameters
-func NewDNSProviderCredentials(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, zoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*DNSProvider, error) {
+// DNS service using static credentials from its parameters.
+// If federatedToken is not nil, clientID and tenantID identify the application
+// the ServiceAccount has been federated with and clientSecret is ignored.
+func NewDNSProviderCredentials(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, zoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, federatedToken FederatedTokenFunc) (*DNSProvider, error) {
 	env := azure.PublicCloud
 	if environment != "" {
 		var err error
@@ -50,7 +62,7 @@ func NewDNSProviderCredentials(environment, clientID, clientSecret, subscription
 		}
 	}
 
-	spt, err := getAuthorization(env, clientID, clientSecret, subscriptionID, tenantID, ambient, managedIdentity)
+	spt, err := getAuthorization(env, clientID, clientSecret, subscriptionID, tenantID, ambient, managedIdentity, federatedToken)
 	if err != nil {
 		return nil, err
 	}
@@ -71,7 +83,15 @@ func NewDNSProviderCredentials(environment, clientID, clientSecret, subscription
 	}, nil
 }
 
-func getAuthorization(env azure.Environment, clientID, clientSecret, subscriptionID, tenantID string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*adal.ServicePrincipalToken, error) {
+func getAuthorization(env azure.Environment, clientID, clientSecret, subscriptionID, tenantID string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, federatedToken FederatedTokenFunc) (*adal.ServicePrincipalToken, error) {
+	if federatedToken != nil {
+		logf.Log.V(logf.InfoLevel).Info("azuredns authenticating with clientID and a federated service account token")
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantID)
+		if err != nil {
+			return nil, err
+		}
+		return adal.NewServicePrincipalTokenWithSecret(*oauthConfig, clientID, env.ResourceManagerEndpoint, &federatedTokenSecret{getToken: federatedToken})
+	}
 	if clientID != "" {
 		logf.Log.V(logf.InfoLevel).Info("azuredns authenticating with clientID and secret key")
 		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, tenantID)
@@ -103,6 +123,27 @@ func getAuthorization(env azure.Environment, clientID, clientSecret, subscriptio
 	return spt, nil
 }
 
+// federatedTokenSecret implements adal.ServicePrincipalSecret by presenting a
+// freshly requested ServiceAccount token as a client assertion each time the
+// Azure AD token is refreshed.
+type federatedTokenSecret struct {
+	getToken FederatedTokenFunc
+}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	token, err := s.getToken()
+	if err != nil {
+		return fmt.Errorf("error requesting service account token: %v", err)
+	}
+	v.Set("client_assertion", token)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+func (s federatedTokenSecret) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("marshalling federatedTokenSecret is not supported")
+}
+
 // Present creates a TXT record using the specified parameters
 func (c *DNSProvider) Present(domain, fqdn, value string) error {
 	return c.createRecord(fqdn, value, 60)
@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package coredns implements a DNS provider for solving the DNS-01 challenge
+// by writing TXT records directly into a zone file that is stored in a
+// ConfigMap and served by the CoreDNS file plugin.
+// See https://coredns.io/plugins/file/
+package coredns
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/miekg/dns"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/util/retry"
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	client    corev1client.ConfigMapsGetter
+	namespace string
+	name      string
+	key       string
+	zone      string
+	ttl       uint32
+}
+
+// DefaultKey returns the default ConfigMap key holding the zone file for the
+// given zone, following the db.<zone> naming convention used in the CoreDNS
+// documentation.
+func DefaultKey(zone string) string {
+	return "db." + strings.TrimSuffix(zone, ".")
+}
+
+// NewDNSProvider returns a DNSProvider instance that manages TXT records in
+// the zone file stored at key in the named ConfigMap. If key is empty,
+// DefaultKey(zone) is used.
+func NewDNSProvider(client corev1client.ConfigMapsGetter, namespace, name, key, zone string) (*DNSProvider, error) {
+	if name == "" {
+		return nil, fmt.Errorf("CoreDNS zone ConfigMap name missing")
+	}
+	if zone == "" {
+		return nil, fmt.Errorf("CoreDNS zone name missing")
+	}
+	if _, ok := dns.IsDomainName(zone); !ok {
+		return nil, fmt.Errorf("invalid CoreDNS zone name %q", zone)
+	}
+	if key == "" {
+		key = DefaultKey(zone)
+	}
+
+	return &DNSProvider{
+		client:    client,
+		namespace: namespace,
+		name:      name,
+		key:       key,
+		zone:      dns.CanonicalName(zone),
+		ttl:       60,
+	}, nil
+}
+
+// Present adds a TXT record to the zone file to fulfil the dns-01 challenge,
+// and increments the serial of the zone's SOA record so that CoreDNS reloads
+// the zone once the updated ConfigMap has been synced to its volume.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	return c.updateZone(fqdn, func(rrs []dns.RR) ([]dns.RR, bool) {
+		for _, rr := range rrs {
+			if isChallengeRecord(rr, fqdn, value) {
+				return rrs, false
+			}
+		}
+		return append(rrs, &dns.TXT{
+			Hdr: dns.RR_Header{
+				Name:   dns.CanonicalName(fqdn),
+				Rrtype: dns.TypeTXT,
+				Class:  dns.ClassINET,
+				Ttl:    c.ttl,
+			},
+			Txt: []string{value},
+		}), true
+	})
+}
+
+// CleanUp removes the TXT record matching the specified parameters from the
+// zone file. If the zone ConfigMap no longer exists there is nothing to
+// remove.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	err := c.updateZone(fqdn, func(rrs []dns.RR) ([]dns.RR, bool) {
+		var out []dns.RR
+		for _, rr := range rrs {
+			if !isChallengeRecord(rr, fqdn, value) {
+				out = append(out, rr)
+			}
+		}
+		return out, len(out) != len(rrs)
+	})
+	if apierrors.IsNotFound(err) {
+		return nil
+	}
+	return err
+}
+
+// updateZone applies mutate to the records of the zone file, and writes the
+// zone back to the ConfigMap with an incremented SOA serial if mutate reports
+// that the records changed. Conflicting writes, for example from challenges
+// for other names being presented at the same time, are retried.
+func (c *DNSProvider) updateZone(fqdn string, mutate func([]dns.RR) ([]dns.RR, bool)) error {
+	if !dns.IsSubDomain(c.zone, dns.CanonicalName(fqdn)) {
+		return fmt.Errorf("coredns: fqdn %q is not part of zone %q", fqdn, c.zone)
+	}
+
+	ctx := context.TODO()
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm, err := c.client.ConfigMaps(c.namespace).Get(ctx, c.name, metav1.GetOptions{})
+		if err != nil {
+			return fmt.Errorf("coredns: error getting zone ConfigMap %s/%s: %w", c.namespace, c.name, err)
+		}
+		data, ok := cm.Data[c.key]
+		if !ok {
+			return fmt.Errorf("coredns: zone ConfigMap %s/%s has no key %q", c.namespace, c.name, c.key)
+		}
+
+		rrs, err := parseZone(data, c.zone)
+		if err != nil {
+			return fmt.Errorf("coredns: error parsing zone file in ConfigMap %s/%s: %v", c.namespace, c.name, err)
+		}
+
+		rrs, changed := mutate(rrs)
+		if !changed {
+			return nil
+		}
+		if err := bumpSerial(rrs); err != nil {
+			return fmt.Errorf("coredns: zone file in ConfigMap %s/%s: %v", c.namespace, c.name, err)
+		}
+
+		cm = cm.DeepCopy()
+		cm.Data[c.key] = formatZone(rrs, c.zone)
+		_, err = c.client.ConfigMaps(c.namespace).Update(ctx, cm, metav1.UpdateOptions{})
+		return err
+	})
+}
+
+func isChallengeRecord(rr dns.RR, fqdn, value string) bool {
+	txt, ok := rr.(*dns.TXT)
+	if !ok || !strings.EqualFold(txt.Hdr.Name, dns.CanonicalName(fqdn)) {
+		return false
+	}
+	return strings.Join(txt.Txt, "") == value
+}
+
+// parseZone parses the records of a zone file. Relative names in the zone file
+// are resolved against the zone's origin, as they are by CoreDNS.
+func parseZone(data, origin string) ([]dns.RR, error) {
+	zp := dns.NewZoneParser(strings.NewReader(data), origin, "")
+	var rrs []dns.RR
+	for rr, ok := zp.Next(); ok; rr, ok = zp.Next() {
+		rrs = append(rrs, rr)
+	}
+	if err := zp.Err(); err != nil {
+		return nil, err
+	}
+	return rrs, nil
+}
+
+// bumpSerial increments the serial of the zone's SOA record. CoreDNS only
+// reloads a zone file if its serial has changed.
+func bumpSerial(rrs []dns.RR) error {
+	for _, rr := range rrs {
+		if soa, ok := rr.(*dns.SOA); ok {
+			// serial number arithmetic wraps around, as described in RFC 1982
+			soa.Serial++
+			return nil
+		}
+	}
+	return fmt.Errorf("no SOA record found")
+}
+
+// formatZone writes the records back out in zone file format. Each record is
+// written using its fully qualified name, so comments and formatting in the
+// original zone file are not preserved.
+func formatZone(rrs []dns.RR, origin string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", origin)
+	for _, rr := range rrs {
+		b.WriteString(rr.String())
+		b.WriteString("\n")
+	}
+	return b.String()
+}
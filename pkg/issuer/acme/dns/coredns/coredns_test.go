@@ -0,0 +1,178 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package coredns
+
+import (
+	"context"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/fake"
+	coretesting "k8s.io/client-go/testing"
+)
+
+const zoneFixture = `$ORIGIN acme.example.com.
+@	3600 IN	SOA ns.acme.example.com. hostmaster.example.com. (
+				4294967295 ; serial
+				7200       ; refresh
+				3600       ; retry
+				1209600    ; expire
+				60 )       ; minimum
+	3600 IN NS ns.acme.example.com.
+ns	3600 IN A  10.96.0.53
+`
+
+func zoneConfigMap(zone string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "coredns-acme", Namespace: "kube-system"},
+		Data:       map[string]string{"db.acme.example.com": zone},
+	}
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	provider, err := NewDNSProvider(nil, "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+	assert.Equal(t, "db.acme.example.com", provider.key)
+	assert.Equal(t, "acme.example.com.", provider.zone)
+}
+
+func TestNewDNSProviderMissingConfig(t *testing.T) {
+	_, err := NewDNSProvider(nil, "kube-system", "", "", "acme.example.com")
+	assert.EqualError(t, err, "CoreDNS zone ConfigMap name missing")
+
+	_, err = NewDNSProvider(nil, "kube-system", "coredns-acme", "", "")
+	assert.EqualError(t, err, "CoreDNS zone name missing")
+}
+
+func TestCoreDNSPresent(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap(zoneFixture))
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d=="))
+
+	cm, err := cl.CoreV1().ConfigMaps("kube-system").Get(context.TODO(), "coredns-acme", metav1.GetOptions{})
+	assert.NoError(t, err)
+	rrs, err := parseZone(cm.Data["db.acme.example.com"], "acme.example.com.")
+	assert.NoError(t, err)
+	assert.Len(t, rrs, 4)
+	// the serial wraps around rather than overflowing
+	assert.Equal(t, uint32(0), rrs[0].(*dns.SOA).Serial)
+	assert.Equal(t, []string{"123d=="}, rrs[3].(*dns.TXT).Txt)
+}
+
+func TestCoreDNSPresentMissingConfigMap(t *testing.T) {
+	cl := fake.NewSimpleClientset()
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d==")
+	assert.EqualError(t, err, `coredns: error getting zone ConfigMap kube-system/coredns-acme: configmaps "coredns-acme" not found`)
+}
+
+func TestCoreDNSPresentMissingKey(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap(zoneFixture))
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "Corefile", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d==")
+	assert.EqualError(t, err, `coredns: zone ConfigMap kube-system/coredns-acme has no key "Corefile"`)
+}
+
+func TestCoreDNSPresentMalformedZone(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap("@ 3600 IN SOA ns.acme.example.com. (\n"))
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d==")
+	assert.Error(t, err)
+	assert.Contains(t, err.Error(), "coredns: error parsing zone file in ConfigMap kube-system/coredns-acme")
+}
+
+func TestCoreDNSPresentZoneWithoutSOA(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap("ns 3600 IN A 10.96.0.53\n"))
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d==")
+	assert.EqualError(t, err, "coredns: zone file in ConfigMap kube-system/coredns-acme: no SOA record found")
+}
+
+func TestCoreDNSPresentOutsideZone(t *testing.T) {
+	provider, err := NewDNSProvider(nil, "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("example.org", "_acme-challenge.example.org.", "123d==")
+	assert.EqualError(t, err, `coredns: fqdn "_acme-challenge.example.org." is not part of zone "acme.example.com."`)
+}
+
+func TestCoreDNSPresentUpdateForbidden(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap(zoneFixture))
+	var updates int
+	cl.PrependReactor("update", "configmaps", func(coretesting.Action) (bool, runtime.Object, error) {
+		updates++
+		return true, nil, apierrors.NewForbidden(schema.GroupResource{Resource: "configmaps"}, "coredns-acme", nil)
+	})
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	err = provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d==")
+	assert.True(t, apierrors.IsForbidden(err), "expected a forbidden error, got %v", err)
+	assert.Equal(t, 1, updates)
+}
+
+func TestCoreDNSPresentRetriesOnConflict(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap(zoneFixture))
+	conflicts := 1
+	cl.PrependReactor("update", "configmaps", func(coretesting.Action) (bool, runtime.Object, error) {
+		if conflicts == 0 {
+			return false, nil, nil
+		}
+		conflicts--
+		return true, nil, apierrors.NewConflict(schema.GroupResource{Resource: "configmaps"}, "coredns-acme", nil)
+	})
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.Present("acme.example.com", "_acme-challenge.acme.example.com.", "123d=="))
+	assert.Equal(t, 0, conflicts)
+}
+
+func TestCoreDNSCleanUpRecordNotFound(t *testing.T) {
+	cl := fake.NewSimpleClientset(zoneConfigMap(zoneFixture))
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.CleanUp("acme.example.com", "_acme-challenge.acme.example.com.", "123d=="))
+	for _, action := range cl.Actions() {
+		assert.NotEqual(t, "update", action.GetVerb(), "expected the zone not to be rewritten")
+	}
+}
+
+func TestCoreDNSCleanUpConfigMapDeleted(t *testing.T) {
+	cl := fake.NewSimpleClientset()
+	provider, err := NewDNSProvider(cl.CoreV1(), "kube-system", "coredns-acme", "", "acme.example.com")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.CleanUp("acme.example.com", "_acme-challenge.acme.example.com.", "123d=="))
+}
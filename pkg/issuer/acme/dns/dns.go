@@ -24,8 +24,12 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	authenticationv1 "k8s.io/api/authentication/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
 	corev1listers "k8s.io/client-go/listers/core/v1"
+	"k8s.io/utils/pointer"
 
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook"
 	whapi "github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
@@ -35,10 +39,19 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/akamai"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/alidns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/azuredns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/coredns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/dnsimple"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/gandi"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/ibmcis"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/linode"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/ns1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/oci"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/rfc2136"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
@@ -59,10 +72,19 @@ type solver interface {
 type dnsProviderConstructors struct {
 	cloudDNS     func(project string, serviceAccount []byte, dns01Nameservers []string, ambient bool, hostedZoneName string) (*clouddns.DNSProvider, error)
 	cloudFlare   func(email, apikey, apiToken string, dns01Nameservers []string, userAgent string) (*cloudflare.DNSProvider, error)
-	route53      func(accessKey, secretKey, hostedZoneID, region, role string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
-	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error)
+	route53      func(accessKey, secretKey, hostedZoneID, region, role string, roleChain []route53.AssumeRole, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error)
+	azureDNS     func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, federatedToken azuredns.FederatedTokenFunc) (*azuredns.DNSProvider, error)
 	acmeDNS      func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error)
 	digitalOcean func(token string, dns01Nameservers []string) (*digitalocean.DNSProvider, error)
+	gandi        func(token string, dns01Nameservers []string, userAgent string) (*gandi.DNSProvider, error)
+	linode       func(token string, userAgent string) (*linode.DNSProvider, error)
+	ns1          func(apiKey, endpoint string, dns01Nameservers []string, userAgent string) (*ns1.DNSProvider, error)
+	powerDNS     func(host, apiKey, serverID string, userAgent string) (*powerdns.DNSProvider, error)
+	coreDNS      func(client corev1client.ConfigMapsGetter, namespace, name, key, zone string) (*coredns.DNSProvider, error)
+	oci          func(region, compartmentID, authType, tenancyID, userID, fingerprint string, privateKey []byte, ambient bool, dns01Nameservers []string, userAgent string) (*oci.DNSProvider, error)
+	aliDNS       func(accessKeyID, accessKeySecret, ramRole, roleARN, regionID string, ambient bool, dns01Nameservers []string, userAgent string) (*alidns.DNSProvider, error)
+	ibmCIS       func(apiKey, crn string, dns01Nameservers []string, userAgent string) (*ibmcis.DNSProvider, error)
+	dnsimple     func(apiToken, accountID string, sandbox bool, dns01Nameservers []string, userAgent string) (*dnsimple.DNSProvider, error)
 }
 
 // Solver is a solver for the acme dns01 challenge.
@@ -94,7 +116,8 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	nameservers, _ := s.nameservers(providerConfig)
+	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), nameservers...)
 	if err != nil {
 		return err
 	}
@@ -108,15 +131,20 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	log := logf.WithResource(logf.FromContext(ctx, "Check"), ch).WithValues("domain", ch.Spec.DNSName)
 
-	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, false, s.DNS01Nameservers...)
+	dns01Config, err := extractChallengeSolverConfig(ch)
+	if err != nil {
+		return err
+	}
+	nameservers, checkAuthoritative := s.nameservers(dns01Config)
+
+	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, false, nameservers...)
 	if err != nil {
 		return err
 	}
 
-	log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", s.Context.DNS01Nameservers)
+	log.V(logf.DebugLevel).Info("checking DNS propagation", "nameservers", nameservers, "authoritative", checkAuthoritative)
 
-	ok, err := util.PreCheckDNS(fqdn, ch.Spec.Key, s.Context.DNS01Nameservers,
-		s.Context.DNS01CheckAuthoritative)
+	ok, err := util.PreCheckDNS(fqdn, ch.Spec.Key, nameservers, checkAuthoritative)
 	if err != nil {
 		return err
 	}
@@ -152,7 +180,8 @@ func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 		return err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), s.DNS01Nameservers...)
+	nameservers, _ := s.nameservers(providerConfig)
+	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(providerConfig.CNAMEStrategy), nameservers...)
 	if err != nil {
 		return err
 	}
@@ -164,6 +193,25 @@ func followCNAME(strategy cmacme.CNAMEStrategy) bool {
 	return strategy == cmacme.FollowStrategy
 }
 
+// nameservers returns the recursive nameservers used to look up and check
+// DNS01 records for the given solver configuration, and whether the self check
+// should query the authoritative nameservers of the zone. Both default to the
+// controller's configuration and can be overridden by the solver's
+// checkNameservers field.
+func (s *Solver) nameservers(cfg *cmacme.ACMEChallengeSolverDNS01) ([]string, bool) {
+	nameservers, checkAuthoritative := s.DNS01Nameservers, s.DNS01CheckAuthoritative
+	if cfg == nil || cfg.CheckNameservers == nil {
+		return nameservers, checkAuthoritative
+	}
+	if len(cfg.CheckNameservers.Nameservers) > 0 {
+		nameservers = cfg.CheckNameservers.Nameservers
+	}
+	if cfg.CheckNameservers.RecursiveOnly != nil {
+		checkAuthoritative = !*cfg.CheckNameservers.RecursiveOnly
+	}
+	return nameservers, checkAuthoritative
+}
+
 func extractChallengeSolverConfig(ch *cmacme.Challenge) (*cmacme.ACMEChallengeSolverDNS01, error) {
 	if ch.Spec.Solver.DNS01 == nil {
 		return nil, fmt.Errorf("no dns01 challenge solver configuration found")
@@ -179,13 +227,17 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 	log := logf.FromContext(ctx, "solverForChallenge")
 	dbg := log.V(logf.DebugLevel)
 
-	resourceNamespace := s.ResourceNamespace(issuer)
+	resourceNamespace, err := s.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, nil, err
+	}
 	canUseAmbientCredentials := s.CanUseAmbientCredentials(issuer)
 
 	providerConfig, err := extractChallengeSolverConfig(ch)
 	if err != nil {
 		return nil, nil, err
 	}
+	nameservers, _ := s.nameservers(providerConfig)
 
 	var impl solver
 	switch {
@@ -211,7 +263,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			string(clientToken),
 			string(clientSecret),
 			string(accessToken),
-			s.DNS01Nameservers)
+			nameservers)
 		if err != nil {
 			return nil, nil, errors.Wrap(err, "error instantiating akamai challenge solver")
 		}
@@ -236,7 +288,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		// attempt to construct the cloud dns provider
-		impl, err = s.dnsProviderConstructors.cloudDNS(providerConfig.CloudDNS.Project, keyData, s.DNS01Nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName)
+		impl, err = s.dnsProviderConstructors.cloudDNS(providerConfig.CloudDNS.Project, keyData, nameservers, s.CanUseAmbientCredentials(issuer), providerConfig.CloudDNS.HostedZoneName)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating google clouddns challenge solver: %s", err)
 		}
@@ -273,7 +325,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		}
 
 		email := providerConfig.Cloudflare.Email
-		impl, err = s.dnsProviderConstructors.cloudFlare(email, apiKey, apiToken, s.DNS01Nameservers, s.RESTConfig.UserAgent)
+		impl, err = s.dnsProviderConstructors.cloudFlare(email, apiKey, apiToken, nameservers, s.RESTConfig.UserAgent)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating cloudflare challenge solver: %s", err)
 		}
@@ -286,10 +338,144 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 
 		apiToken := string(apiTokenSecret.Data[providerConfig.DigitalOcean.Token.Key])
 
-		impl, err = s.dnsProviderConstructors.digitalOcean(strings.TrimSpace(apiToken), s.DNS01Nameservers)
+		impl, err = s.dnsProviderConstructors.digitalOcean(strings.TrimSpace(apiToken), nameservers)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating digitalocean challenge solver: %s", err.Error())
 		}
+	case providerConfig.Gandi != nil:
+		dbg.Info("preparing to create Gandi provider")
+		apiToken, err := s.loadSecretData(&providerConfig.Gandi.APIToken, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting gandi personal access token")
+		}
+
+		impl, err = s.dnsProviderConstructors.gandi(strings.TrimSpace(string(apiToken)), nameservers, s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating gandi challenge solver")
+		}
+	case providerConfig.Linode != nil:
+		dbg.Info("preparing to create Linode provider")
+		apiToken, err := s.loadSecretData(&providerConfig.Linode.APIToken, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting linode api token")
+		}
+
+		impl, err = s.dnsProviderConstructors.linode(strings.TrimSpace(string(apiToken)), s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating linode challenge solver")
+		}
+	case providerConfig.NS1 != nil:
+		dbg.Info("preparing to create NS1 provider")
+		apiKey, err := s.loadSecretData(&providerConfig.NS1.APIKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting ns1 api key")
+		}
+
+		impl, err = s.dnsProviderConstructors.ns1(strings.TrimSpace(string(apiKey)), providerConfig.NS1.Endpoint, nameservers, s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating ns1 challenge solver")
+		}
+	case providerConfig.PowerDNS != nil:
+		dbg.Info("preparing to create PowerDNS provider")
+		apiKey, err := s.loadSecretData(&providerConfig.PowerDNS.APIKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting powerdns api key")
+		}
+
+		impl, err = s.dnsProviderConstructors.powerDNS(providerConfig.PowerDNS.Host, strings.TrimSpace(string(apiKey)), providerConfig.PowerDNS.ServerID, s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating powerdns challenge solver")
+		}
+	case providerConfig.CoreDNS != nil:
+		dbg.Info("preparing to create CoreDNS provider")
+		namespace := providerConfig.CoreDNS.ConfigMapNamespace
+		if namespace == "" {
+			namespace = resourceNamespace
+		}
+		// namespaced Issuers may only manage zones stored in their own namespace
+		if issuer.GetObjectMeta().Namespace != "" && namespace != resourceNamespace {
+			return nil, nil, fmt.Errorf("coredns configMapNamespace %q may only be set on a ClusterIssuer", namespace)
+		}
+
+		impl, err = s.dnsProviderConstructors.coreDNS(s.Client.CoreV1(), namespace, providerConfig.CoreDNS.ConfigMapName, providerConfig.CoreDNS.Key, providerConfig.CoreDNS.Zone)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating coredns challenge solver")
+		}
+	case providerConfig.OCI != nil:
+		dbg.Info("preparing to create OCI provider")
+		var tenancyID, userID, fingerprint string
+		var privateKey []byte
+		if providerConfig.OCI.APIKey != nil {
+			tenancyID = providerConfig.OCI.APIKey.TenancyOCID
+			userID = providerConfig.OCI.APIKey.UserOCID
+			fingerprint = providerConfig.OCI.APIKey.Fingerprint
+			privateKey, err = s.loadSecretData(&providerConfig.OCI.APIKey.PrivateKey, resourceNamespace)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "error getting oci api private key")
+			}
+		}
+
+		impl, err = s.dnsProviderConstructors.oci(
+			providerConfig.OCI.Region,
+			providerConfig.OCI.CompartmentOCID,
+			providerConfig.OCI.AuthType,
+			tenancyID,
+			userID,
+			fingerprint,
+			privateKey,
+			canUseAmbientCredentials,
+			nameservers,
+			s.RESTConfig.UserAgent,
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating oci challenge solver")
+		}
+	case providerConfig.AliDNS != nil:
+		dbg.Info("preparing to create AliDNS provider")
+		accessKeySecret := ""
+		if providerConfig.AliDNS.AccessKeySecret != nil {
+			secret, err := s.loadSecretData(providerConfig.AliDNS.AccessKeySecret, resourceNamespace)
+			if err != nil {
+				return nil, nil, errors.Wrap(err, "error getting alidns accesskey secret")
+			}
+			accessKeySecret = string(secret)
+		}
+
+		impl, err = s.dnsProviderConstructors.aliDNS(
+			strings.TrimSpace(providerConfig.AliDNS.AccessKeyID),
+			strings.TrimSpace(accessKeySecret),
+			providerConfig.AliDNS.RAMRole,
+			providerConfig.AliDNS.RoleARN,
+			providerConfig.AliDNS.RegionID,
+			canUseAmbientCredentials,
+			nameservers,
+			s.RESTConfig.UserAgent,
+		)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating alidns challenge solver")
+		}
+	case providerConfig.IBMCIS != nil:
+		dbg.Info("preparing to create IBM CIS provider")
+		apiKey, err := s.loadSecretData(&providerConfig.IBMCIS.APIKey, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting ibmcis api key")
+		}
+
+		impl, err = s.dnsProviderConstructors.ibmCIS(strings.TrimSpace(string(apiKey)), strings.TrimSpace(providerConfig.IBMCIS.CRN), nameservers, s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating ibmcis challenge solver")
+		}
+	case providerConfig.DNSimple != nil:
+		dbg.Info("preparing to create DNSimple provider")
+		apiToken, err := s.loadSecretData(&providerConfig.DNSimple.APIToken, resourceNamespace)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error getting dnsimple api token")
+		}
+
+		impl, err = s.dnsProviderConstructors.dnsimple(strings.TrimSpace(string(apiToken)), providerConfig.DNSimple.AccountID, providerConfig.DNSimple.Sandbox, nameservers, s.RESTConfig.UserAgent)
+		if err != nil {
+			return nil, nil, errors.Wrap(err, "error instantiating dnsimple challenge solver")
+		}
 	case providerConfig.Route53 != nil:
 		dbg.Info("preparing to create Route53 provider")
 		secretAccessKey := ""
@@ -306,14 +492,20 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			secretAccessKey = string(secretAccessKeyBytes)
 		}
 
+		var roleChain []route53.AssumeRole
+		for _, role := range providerConfig.Route53.AssumeRoleChain {
+			roleChain = append(roleChain, route53.AssumeRole{Role: role.Role, ExternalID: role.ExternalID})
+		}
+
 		impl, err = s.dnsProviderConstructors.route53(
 			strings.TrimSpace(providerConfig.Route53.AccessKeyID),
 			strings.TrimSpace(secretAccessKey),
 			providerConfig.Route53.HostedZoneID,
 			providerConfig.Route53.Region,
 			providerConfig.Route53.Role,
+			roleChain,
 			canUseAmbientCredentials,
-			s.DNS01Nameservers,
+			nameservers,
 			s.RESTConfig.UserAgent,
 		)
 		if err != nil {
@@ -322,9 +514,12 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 	case providerConfig.AzureDNS != nil:
 		dbg.Info("preparing to create AzureDNS provider")
 		secret := ""
+		var federatedToken azuredns.FederatedTokenFunc
 		// if ClientID is empty, then we try to use MSI (azure metadata API for credentials)
 		// if ClientID is empty we don't even try to get the ClientSecret because it would not be used
-		if providerConfig.AzureDNS.ClientID != "" {
+		if providerConfig.AzureDNS.ServiceAccountRef != nil {
+			federatedToken = serviceAccountTokenFunc(s.Client.CoreV1(), resourceNamespace, providerConfig.AzureDNS.ServiceAccountRef)
+		} else if providerConfig.AzureDNS.ClientID != "" {
 			clientSecret, err := s.secretLister.Secrets(resourceNamespace).Get(providerConfig.AzureDNS.ClientSecret.Name)
 			if err != nil {
 				return nil, nil, fmt.Errorf("error getting azuredns client secret: %s", err)
@@ -344,9 +539,10 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 			providerConfig.AzureDNS.TenantID,
 			providerConfig.AzureDNS.ResourceGroupName,
 			providerConfig.AzureDNS.HostedZoneName,
-			s.DNS01Nameservers,
+			nameservers,
 			canUseAmbientCredentials,
 			providerConfig.AzureDNS.ManagedIdentity,
+			federatedToken,
 		)
 		if err != nil {
 			return nil, nil, fmt.Errorf("error instantiating azuredns challenge solver: %s", err)
@@ -366,7 +562,7 @@ func (s *Solver) solverForChallenge(ctx context.Context, issuer v1.GenericIssuer
 		impl, err = s.dnsProviderConstructors.acmeDNS(
 			providerConfig.AcmeDNS.Host,
 			accountSecretBytes,
-			s.DNS01Nameservers,
+			nameservers,
 		)
 		if err != nil {
 			return nil, providerConfig, fmt.Errorf("error instantiating acmedns challenge solver: %s", err)
@@ -383,23 +579,27 @@ func (s *Solver) prepareChallengeRequest(issuer v1.GenericIssuer, ch *cmacme.Cha
 	if err != nil {
 		return nil, nil, err
 	}
+	nameservers, _ := s.nameservers(dns01Config)
 
 	webhookSolver, cfg, err := s.dns01SolverForConfig(dns01Config)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(dns01Config.CNAMEStrategy), s.DNS01Nameservers...)
+	fqdn, err := util.DNS01LookupFQDN(ch.Spec.DNSName, followCNAME(dns01Config.CNAMEStrategy), nameservers...)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	zone, err := util.FindZoneByFqdn(fqdn, s.DNS01Nameservers)
+	zone, err := util.FindZoneByFqdn(fqdn, nameservers)
 	if err != nil {
 		return nil, nil, err
 	}
 
-	resourceNamespace := s.ResourceNamespace(issuer)
+	resourceNamespace, err := s.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, nil, err
+	}
 	canUseAmbientCredentials := s.CanUseAmbientCredentials(issuer)
 
 	// construct a ChallengeRequest which can be passed to DNS solvers.
@@ -447,6 +647,28 @@ func (s *Solver) dns01SolverForConfig(config *cmacme.ACMEChallengeSolverDNS01) (
 	return p, c, nil
 }
 
+// serviceAccountTokenFunc returns a function which requests a new token for
+// the referenced ServiceAccount each time it is called, so that tokens can be
+// federated without mounting them into the cert-manager Pod.
+func serviceAccountTokenFunc(client corev1client.ServiceAccountsGetter, namespace string, ref *cmacme.AzureServiceAccountRef) azuredns.FederatedTokenFunc {
+	audiences := ref.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{azuredns.DefaultTokenAudience}
+	}
+	return func() (string, error) {
+		tr, err := client.ServiceAccounts(namespace).CreateToken(context.TODO(), ref.Name, &authenticationv1.TokenRequest{
+			Spec: authenticationv1.TokenRequestSpec{
+				Audiences:         audiences,
+				ExpirationSeconds: pointer.Int64(600),
+			},
+		}, metav1.CreateOptions{})
+		if err != nil {
+			return "", fmt.Errorf("error requesting token for ServiceAccount %s/%s: %w", namespace, ref.Name, err)
+		}
+		return tr.Status.Token, nil
+	}
+}
+
 // NewSolver creates a Solver which can instantiate the appropriate DNS
 // provider.
 func NewSolver(ctx *controller.Context) (*Solver, error) {
@@ -480,6 +702,15 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 			azuredns.NewDNSProviderCredentials,
 			acmedns.NewDNSProviderHostBytes,
 			digitalocean.NewDNSProviderCredentials,
+			gandi.NewDNSProviderCredentials,
+			linode.NewDNSProviderCredentials,
+			ns1.NewDNSProviderCredentials,
+			powerdns.NewDNSProviderCredentials,
+			coredns.NewDNSProvider,
+			oci.NewDNSProvider,
+			alidns.NewDNSProviderCredentials,
+			ibmcis.NewDNSProviderCredentials,
+			dnsimple.NewDNSProviderCredentials,
 		},
 		webhookSolvers: initialized,
 	}, nil
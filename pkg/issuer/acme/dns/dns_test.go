@@ -18,13 +18,17 @@ package dns
 
 import (
 	"context"
+	"errors"
 	"reflect"
 	"testing"
 
+	authenticationv1 "k8s.io/api/authentication/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/rest"
+	coretesting "k8s.io/client-go/testing"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
@@ -32,8 +36,11 @@ import (
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/azuredns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
 )
 
 func newIssuer(name, namespace string) *v1.Issuer {
@@ -352,6 +359,682 @@ func TestSolveForDigitalOcean(t *testing.T) {
 
 }
 
+func TestSolveForClusterIssuerCredentialsNamespace(t *testing.T) {
+	issuer := gen.ClusterIssuer("test",
+		gen.SetIssuerACME(cmacme.ACMEIssuer{}),
+		gen.SetIssuerCredentialsNamespace("team-a"),
+	)
+	challenge := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			Solver: cmacme.ACMEChallengeSolver{
+				DNS01: &cmacme.ACMEChallengeSolverDNS01{
+					DigitalOcean: &cmacme.ACMEIssuerDNS01ProviderDigitalOcean{
+						Token: cmmeta.SecretKeySelector{
+							LocalObjectReference: cmmeta.LocalObjectReference{
+								Name: "digitalocean",
+							},
+							Key: "token",
+						},
+					},
+				},
+			},
+		},
+	}
+	secret := newSecret("digitalocean", "team-a", map[string][]byte{
+		"token": []byte("FAKE-TOKEN"),
+	})
+
+	tests := map[string]struct {
+		grants        []runtime.Object
+		expectErr     bool
+		expectedCalls []fakeDNSProviderCall
+	}{
+		"if a CredentialGrant names the ClusterIssuer, the Secret is read from its credentials namespace": {
+			grants: []runtime.Object{&v1.CredentialGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "team-a"},
+				Spec:       v1.CredentialGrantSpec{ClusterIssuers: []string{"test"}},
+			}},
+			expectedCalls: []fakeDNSProviderCall{
+				{
+					name: "digitalocean",
+					args: []interface{}{"FAKE-TOKEN", util.RecursiveNameservers},
+				},
+			},
+		},
+		"if a CredentialGrant names other ClusterIssuers, the Secret is not read": {
+			grants: []runtime.Object{&v1.CredentialGrant{
+				ObjectMeta: metav1.ObjectMeta{Name: "grant", Namespace: "team-a"},
+				Spec:       v1.CredentialGrantSpec{ClusterIssuers: []string{"other"}},
+			}},
+			expectErr: true,
+		},
+		"if there is no CredentialGrant, the Secret is not read": {
+			expectErr: true,
+		},
+	}
+
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			f := &solverFixture{
+				Builder: &test.Builder{
+					KubeObjects:        []runtime.Object{secret},
+					CertManagerObjects: tc.grants,
+					Context: &controller.Context{
+						ContextOptions: controller.ContextOptions{
+							IssuerOptions: controller.IssuerOptions{
+								ClusterResourceNamespace: "cert-manager",
+							},
+						},
+					},
+				},
+				Issuer:       issuer,
+				Challenge:    challenge,
+				dnsProviders: newFakeDNSProviders(),
+			}
+
+			f.Setup(t)
+			defer f.Finish(t)
+
+			_, _, err := f.Solver.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+			if tc.expectErr != (err != nil) {
+				t.Fatalf("expected error=%t, but got: %v", tc.expectErr, err)
+			}
+
+			if len(tc.expectedCalls) == 0 {
+				if len(f.dnsProviders.calls) > 0 {
+					t.Fatalf("expected no DNS provider to be constructed, but got %+v", f.dnsProviders.calls)
+				}
+				return
+			}
+			if !reflect.DeepEqual(tc.expectedCalls, f.dnsProviders.calls) {
+				t.Fatalf("expected %+v == %+v", tc.expectedCalls, f.dnsProviders.calls)
+			}
+		})
+	}
+}
+
+func TestSolveForGandi(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("gandi", "default", map[string][]byte{
+					"api-token": []byte("FAKE-TOKEN\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Gandi: &cmacme.ACMEIssuerDNS01ProviderGandi{
+							APIToken: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "gandi",
+								},
+								Key: "api-token",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedGandiCall := []fakeDNSProviderCall{
+		{
+			name: "gandi",
+			args: []interface{}{"FAKE-TOKEN", util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedGandiCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedGandiCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForLinode(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("linode", "default", map[string][]byte{
+					"api-token": []byte("FAKE-TOKEN\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						Linode: &cmacme.ACMEIssuerDNS01ProviderLinode{
+							APIToken: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "linode",
+								},
+								Key: "api-token",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedLinodeCall := []fakeDNSProviderCall{
+		{
+			name: "linode",
+			args: []interface{}{"FAKE-TOKEN"},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedLinodeCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedLinodeCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForNS1(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("ns1", "default", map[string][]byte{
+					"api-key": []byte("FAKE-KEY\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						NS1: &cmacme.ACMEIssuerDNS01ProviderNS1{
+							APIKey: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "ns1",
+								},
+								Key: "api-key",
+							},
+							Endpoint: "https://ns1.example.com/v1",
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedNS1Call := []fakeDNSProviderCall{
+		{
+			name: "ns1",
+			args: []interface{}{"FAKE-KEY", "https://ns1.example.com/v1", util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedNS1Call, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedNS1Call, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForPowerDNS(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("powerdns", "default", map[string][]byte{
+					"api-key": []byte("FAKE-KEY\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						PowerDNS: &cmacme.ACMEIssuerDNS01ProviderPowerDNS{
+							Host: "https://pdns.example.com:8081",
+							APIKey: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "powerdns",
+								},
+								Key: "api-key",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedPowerDNSCall := []fakeDNSProviderCall{
+		{
+			name: "powerdns",
+			args: []interface{}{"https://pdns.example.com:8081", "FAKE-KEY", ""},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedPowerDNSCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedPowerDNSCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForCoreDNS(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{},
+		Issuer:  newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						CoreDNS: &cmacme.ACMEIssuerDNS01ProviderCoreDNS{
+							Zone:          "example.internal",
+							ConfigMapName: "coredns-zones",
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedCoreDNSCall := []fakeDNSProviderCall{
+		{
+			name: "coredns",
+			args: []interface{}{"default", "coredns-zones", "", "example.internal"},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedCoreDNSCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedCoreDNSCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForCoreDNSIssuerOtherNamespace(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{},
+		Issuer:  newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						CoreDNS: &cmacme.ACMEIssuerDNS01ProviderCoreDNS{
+							Zone:               "example.internal",
+							ConfigMapName:      "coredns-zones",
+							ConfigMapNamespace: "kube-system",
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err == nil {
+		t.Fatalf("expected solverFor to error for a ConfigMap outside of the Issuer's namespace")
+	}
+	if len(f.dnsProviders.calls) != 0 {
+		t.Fatalf("expected no provider to be constructed, got: %+v", f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForAzureDNSServiceAccountRef(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{},
+		Issuer:  newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						AzureDNS: &cmacme.ACMEIssuerDNS01ProviderAzureDNS{
+							ClientID:          "client-id",
+							SubscriptionID:    "subscription-id",
+							TenantID:          "tenant-id",
+							ResourceGroupName: "resource-group",
+							ServiceAccountRef: &cmacme.AzureServiceAccountRef{Name: "tenant-a"},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	// no client secret is loaded when authenticating with a federated
+	// ServiceAccount token
+	expectedAzureDNSCall := []fakeDNSProviderCall{
+		{
+			name: "azuredns",
+			args: []interface{}{"client-id", "", "subscription-id", "tenant-id", "resource-group", "", util.RecursiveNameservers, false, (*cmacme.AzureManagedIdentity)(nil), true},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedAzureDNSCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedAzureDNSCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForOCI(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("oci", "default", map[string][]byte{
+					"private-key": []byte("FAKE-KEY"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{
+							Region:          "us-ashburn-1",
+							CompartmentOCID: "ocid1.compartment.oc1..ccc",
+							APIKey: &cmacme.ACMEIssuerDNS01ProviderOCIAPIKey{
+								TenancyOCID: "ocid1.tenancy.oc1..aaa",
+								UserOCID:    "ocid1.user.oc1..bbb",
+								Fingerprint: "20:3b:97:13:55:1c",
+								PrivateKey: cmmeta.SecretKeySelector{
+									LocalObjectReference: cmmeta.LocalObjectReference{
+										Name: "oci",
+									},
+									Key: "private-key",
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedOCICall := []fakeDNSProviderCall{
+		{
+			name: "oci",
+			args: []interface{}{"us-ashburn-1", "ocid1.compartment.oc1..ccc", "", "ocid1.tenancy.oc1..aaa", "ocid1.user.oc1..bbb", "20:3b:97:13:55:1c", []byte("FAKE-KEY"), false, util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedOCICall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedOCICall, f.dnsProviders.calls)
+	}
+}
+
+func TestOCIAmbientCreds(t *testing.T) {
+	for _, ambient := range []bool{true, false} {
+		f := &solverFixture{
+			Builder: &test.Builder{
+				Context: &controller.Context{
+					RESTConfig: new(rest.Config),
+					ContextOptions: controller.ContextOptions{
+						IssuerOptions: controller.IssuerOptions{
+							IssuerAmbientCredentials: ambient,
+						},
+					},
+				},
+			},
+			Issuer:       newIssuer("test", "default"),
+			dnsProviders: newFakeDNSProviders(),
+			Challenge: &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					Solver: cmacme.ACMEChallengeSolver{
+						DNS01: &cmacme.ACMEChallengeSolverDNS01{
+							OCI: &cmacme.ACMEIssuerDNS01ProviderOCI{
+								AuthType: "WorkloadIdentity",
+							},
+						},
+					},
+				},
+			},
+		}
+
+		f.Setup(t)
+		defer f.Finish(t)
+
+		s := f.Solver
+		_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+		if err != nil {
+			t.Fatalf("expected solverFor to not error, but got: %s", err)
+		}
+
+		expectedOCICall := []fakeDNSProviderCall{
+			{
+				name: "oci",
+				args: []interface{}{"", "", "WorkloadIdentity", "", "", "", []byte(nil), ambient, util.RecursiveNameservers},
+			},
+		}
+
+		if !reflect.DeepEqual(expectedOCICall, f.dnsProviders.calls) {
+			t.Fatalf("expected %+v == %+v", expectedOCICall, f.dnsProviders.calls)
+		}
+	}
+}
+
+func TestSolveForAliDNS(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("alidns", "default", map[string][]byte{
+					"accesskey-secret": []byte("FAKE-SECRET\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						AliDNS: &cmacme.ACMEIssuerDNS01ProviderAliDNS{
+							AccessKeyID: "FAKE-ID ",
+							AccessKeySecret: &cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "alidns",
+								},
+								Key: "accesskey-secret",
+							},
+							RoleARN: "acs:ram::123456789012:role/cert-manager",
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedAliDNSCall := []fakeDNSProviderCall{
+		{
+			name: "alidns",
+			args: []interface{}{"FAKE-ID", "FAKE-SECRET", "", "acs:ram::123456789012:role/cert-manager", "", false, util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedAliDNSCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedAliDNSCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForIBMCIS(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("ibmcis", "default", map[string][]byte{
+					"api-key": []byte("FAKE-KEY\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						IBMCIS: &cmacme.ACMEIssuerDNS01ProviderIBMCIS{
+							CRN: "crn:v1:bluemix:public:internet-svcs:global:a/abc123:instance-id::",
+							APIKey: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "ibmcis",
+								},
+								Key: "api-key",
+							},
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedIBMCISCall := []fakeDNSProviderCall{
+		{
+			name: "ibmcis",
+			args: []interface{}{"FAKE-KEY", "crn:v1:bluemix:public:internet-svcs:global:a/abc123:instance-id::", util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedIBMCISCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedIBMCISCall, f.dnsProviders.calls)
+	}
+}
+
+func TestSolveForDNSimple(t *testing.T) {
+	f := &solverFixture{
+		Builder: &test.Builder{
+			KubeObjects: []runtime.Object{
+				newSecret("dnsimple", "default", map[string][]byte{
+					"api-token": []byte("FAKE-TOKEN\n"),
+				}),
+			},
+		},
+		Issuer: newIssuer("test", "default"),
+		Challenge: &cmacme.Challenge{
+			Spec: cmacme.ChallengeSpec{
+				Solver: cmacme.ACMEChallengeSolver{
+					DNS01: &cmacme.ACMEChallengeSolverDNS01{
+						DNSimple: &cmacme.ACMEIssuerDNS01ProviderDNSimple{
+							APIToken: cmmeta.SecretKeySelector{
+								LocalObjectReference: cmmeta.LocalObjectReference{
+									Name: "dnsimple",
+								},
+								Key: "api-token",
+							},
+							AccountID: "1010",
+							Sandbox:   true,
+						},
+					},
+				},
+			},
+		},
+		dnsProviders: newFakeDNSProviders(),
+	}
+
+	f.Setup(t)
+	defer f.Finish(t)
+
+	s := f.Solver
+	_, _, err := s.solverForChallenge(context.Background(), f.Issuer, f.Challenge)
+	if err != nil {
+		t.Fatalf("expected solverFor to not error, but got: %s", err)
+	}
+
+	expectedDNSimpleCall := []fakeDNSProviderCall{
+		{
+			name: "dnsimple",
+			args: []interface{}{"FAKE-TOKEN", "1010", true, util.RecursiveNameservers},
+		},
+	}
+
+	if !reflect.DeepEqual(expectedDNSimpleCall, f.dnsProviders.calls) {
+		t.Fatalf("expected %+v == %+v", expectedDNSimpleCall, f.dnsProviders.calls)
+	}
+}
+
 func TestRoute53TrimCreds(t *testing.T) {
 	f := &solverFixture{
 		Builder: &test.Builder{
@@ -395,7 +1078,7 @@ func TestRoute53TrimCreds(t *testing.T) {
 	expectedR53Call := []fakeDNSProviderCall{
 		{
 			name: "route53",
-			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", false, util.RecursiveNameservers},
+			args: []interface{}{"test_with_spaces", "AKIENDINNEWLINE", "", "us-west-2", "", []route53.AssumeRole(nil), false, util.RecursiveNameservers},
 		},
 	}
 
@@ -443,7 +1126,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", []route53.AssumeRole(nil), true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -476,7 +1159,7 @@ func TestRoute53AmbientCreds(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "", []route53.AssumeRole(nil), false, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -540,7 +1223,7 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-role", true, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-role", []route53.AssumeRole(nil), true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -574,7 +1257,47 @@ func TestRoute53AssumeRole(t *testing.T) {
 			result{
 				expectedCall: &fakeDNSProviderCall{
 					name: "route53",
-					args: []interface{}{"", "", "", "us-west-2", "my-other-role", false, util.RecursiveNameservers},
+					args: []interface{}{"", "", "", "us-west-2", "my-other-role", []route53.AssumeRole(nil), false, util.RecursiveNameservers},
+				},
+			},
+		},
+		{
+			solverFixture{
+				Builder: &test.Builder{
+					Context: &controller.Context{
+						RESTConfig: new(rest.Config),
+						ContextOptions: controller.ContextOptions{
+							IssuerOptions: controller.IssuerOptions{
+								IssuerAmbientCredentials: true,
+							},
+						},
+					},
+				},
+				Issuer:       newIssuer("test", "default"),
+				dnsProviders: newFakeDNSProviders(),
+				Challenge: &cmacme.Challenge{
+					Spec: cmacme.ChallengeSpec{
+						Solver: cmacme.ACMEChallengeSolver{
+							DNS01: &cmacme.ACMEChallengeSolverDNS01{
+								Route53: &cmacme.ACMEIssuerDNS01ProviderRoute53{
+									Region: "us-west-2",
+									AssumeRoleChain: []cmacme.ACMEIssuerDNS01ProviderRoute53AssumeRole{
+										{Role: "arn:aws:iam::111111111111:role/hop"},
+										{Role: "arn:aws:iam::222222222222:role/dns", ExternalID: "cert-manager"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			result{
+				expectedCall: &fakeDNSProviderCall{
+					name: "route53",
+					args: []interface{}{"", "", "", "us-west-2", "", []route53.AssumeRole{
+						{Role: "arn:aws:iam::111111111111:role/hop"},
+						{Role: "arn:aws:iam::222222222222:role/dns", ExternalID: "cert-manager"},
+					}, true, util.RecursiveNameservers},
 				},
 			},
 		},
@@ -597,3 +1320,119 @@ func TestRoute53AssumeRole(t *testing.T) {
 		}
 	}
 }
+
+func TestNameservers(t *testing.T) {
+	recursiveOnly, authoritative := true, false
+	tests := map[string]struct {
+		cfg                   *cmacme.ACMEChallengeSolverDNS01
+		expNameservers        []string
+		expCheckAuthoritative bool
+	}{
+		"no override uses the controller's configuration": {
+			cfg:                   &cmacme.ACMEChallengeSolverDNS01{},
+			expNameservers:        []string{"8.8.8.8:53"},
+			expCheckAuthoritative: true,
+		},
+		"nameservers override the controller's nameservers": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CheckNameservers: &cmacme.ACMEChallengeSolverDNS01CheckNameservers{
+					Nameservers: []string{"10.0.0.53:53"},
+				},
+			},
+			expNameservers:        []string{"10.0.0.53:53"},
+			expCheckAuthoritative: true,
+		},
+		"recursiveOnly disables checking authoritative nameservers": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CheckNameservers: &cmacme.ACMEChallengeSolverDNS01CheckNameservers{
+					RecursiveOnly: &recursiveOnly,
+				},
+			},
+			expNameservers:        []string{"8.8.8.8:53"},
+			expCheckAuthoritative: false,
+		},
+		"recursiveOnly false keeps checking authoritative nameservers": {
+			cfg: &cmacme.ACMEChallengeSolverDNS01{
+				CheckNameservers: &cmacme.ACMEChallengeSolverDNS01CheckNameservers{
+					Nameservers:   []string{"10.0.0.53:53"},
+					RecursiveOnly: &authoritative,
+				},
+			},
+			expNameservers:        []string{"10.0.0.53:53"},
+			expCheckAuthoritative: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s := &Solver{Context: &controller.Context{}}
+			s.DNS01Nameservers = []string{"8.8.8.8:53"}
+			s.DNS01CheckAuthoritative = true
+			nameservers, checkAuthoritative := s.nameservers(test.cfg)
+			if !reflect.DeepEqual(nameservers, test.expNameservers) {
+				t.Errorf("expected nameservers %v, got %v", test.expNameservers, nameservers)
+			}
+			if checkAuthoritative != test.expCheckAuthoritative {
+				t.Errorf("expected checkAuthoritative %t, got %t", test.expCheckAuthoritative, checkAuthoritative)
+			}
+		})
+	}
+}
+
+func TestServiceAccountTokenFunc(t *testing.T) {
+	tests := map[string]struct {
+		ref          *cmacme.AzureServiceAccountRef
+		createErr    error
+		expAudiences []string
+		expErr       bool
+	}{
+		"default audience is requested if none are given": {
+			ref:          &cmacme.AzureServiceAccountRef{Name: "tenant-a"},
+			expAudiences: []string{azuredns.DefaultTokenAudience},
+		},
+		"configured audiences are requested": {
+			ref:          &cmacme.AzureServiceAccountRef{Name: "tenant-a", Audiences: []string{"api://custom"}},
+			expAudiences: []string{"api://custom"},
+		},
+		"token request errors are returned": {
+			ref:       &cmacme.AzureServiceAccountRef{Name: "tenant-a"},
+			createErr: errors.New("forbidden"),
+			expErr:    true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cl := kubefake.NewSimpleClientset()
+			var requested *authenticationv1.TokenRequest
+			cl.PrependReactor("create", "serviceaccounts", func(action coretesting.Action) (bool, runtime.Object, error) {
+				create := action.(coretesting.CreateAction)
+				if create.GetSubresource() != "token" {
+					t.Errorf("unexpected subresource %q", create.GetSubresource())
+				}
+				if create.GetNamespace() != "ns" {
+					t.Errorf("unexpected namespace %q", create.GetNamespace())
+				}
+				if test.createErr != nil {
+					return true, nil, test.createErr
+				}
+				requested = create.GetObject().(*authenticationv1.TokenRequest)
+				return true, &authenticationv1.TokenRequest{
+					Status: authenticationv1.TokenRequestStatus{Token: "token"},
+				}, nil
+			})
+
+			token, err := serviceAccountTokenFunc(cl.CoreV1(), "ns", test.ref)()
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if token != "token" {
+				t.Errorf("unexpected token %q", token)
+			}
+			if !reflect.DeepEqual(requested.Spec.Audiences, test.expAudiences) {
+				t.Errorf("unexpected audiences, exp=%v got=%v", test.expAudiences, requested.Spec.Audiences)
+			}
+		})
+	}
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package dnsimple implements a DNS provider for solving the DNS-01 challenge
+// using DNSimple.
+// See https://developer.dnsimple.com/v2/
+package dnsimple
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	// DNSimpleAPIURL is the base URL of the DNSimple production API.
+	DNSimpleAPIURL = "https://api.dnsimple.com/v2"
+
+	// DNSimpleSandboxAPIURL is the base URL of the DNSimple sandbox API, which
+	// can be used for testing without affecting production zones.
+	DNSimpleSandboxAPIURL = "https://api.sandbox.dnsimple.com/v2"
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	apiToken         string
+	userAgent        string
+
+	// accountLock guards accountID, which is discovered from the API token
+	// if not configured.
+	accountLock sync.Mutex
+	accountID   string
+
+	baseURL                string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// zoneRecord is a record in a DNSimple zone. Record names are relative to the
+// zone, with the empty string denoting the zone apex.
+type zoneRecord struct {
+	ID      int64  `json:"id,omitempty"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl,omitempty"`
+}
+
+// apiError is the body returned by the DNSimple API on failure.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// NewDNSProviderCredentials returns a DNSProvider instance configured for
+// DNSimple, authenticating with the given API token. If accountID is empty,
+// the account that the token belongs to is used; this requires an account
+// token rather than a user token. If sandbox is true, the DNSimple sandbox
+// environment is used instead of production.
+func NewDNSProviderCredentials(apiToken, accountID string, sandbox bool, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	if apiToken == "" {
+		return nil, fmt.Errorf("DNSimple API token missing")
+	}
+
+	baseURL := DNSimpleAPIURL
+	if sandbox {
+		baseURL = DNSimpleSandboxAPIURL
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		apiToken:               apiToken,
+		userAgent:              userAgent,
+		accountID:              accountID,
+		baseURL:                baseURL,
+		client:                 &http.Client{Timeout: 30 * time.Second},
+		findHostedDomainByFqdn: util.FindZoneByFqdn,
+		ttl:                    60,
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	recordsPath, name, err := c.recordsPath(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.findTXTRecords(recordsPath, name)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Content == value {
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(zoneRecord{
+		Name:    name,
+		Type:    "TXT",
+		Content: value,
+		TTL:     c.ttl,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(http.MethodPost, recordsPath, bytes.NewReader(b))
+	return err
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	recordsPath, name, err := c.recordsPath(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.findTXTRecords(recordsPath, name)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Content != value {
+			continue
+		}
+		if _, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("%s/%d", recordsPath, r.ID), nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// recordsPath returns the API path of the records in the zone that fqdn
+// belongs to, along with the name of the record relative to the zone.
+func (c *DNSProvider) recordsPath(fqdn string) (string, string, error) {
+	accountID, err := c.getAccountID()
+	if err != nil {
+		return "", "", err
+	}
+
+	authZone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", "", fmt.Errorf("dnsimple: failed to determine zone for %q: %v", fqdn, err)
+	}
+
+	zone := util.UnFqdn(authZone)
+	name := strings.TrimSuffix(strings.TrimSuffix(util.UnFqdn(fqdn), zone), ".")
+
+	return fmt.Sprintf("/%s/zones/%s/records", url.PathEscape(accountID), url.PathEscape(zone)), name, nil
+}
+
+// getAccountID returns the configured account ID, or discovers the account
+// that the API token belongs to.
+func (c *DNSProvider) getAccountID() (string, error) {
+	c.accountLock.Lock()
+	defer c.accountLock.Unlock()
+
+	if c.accountID != "" {
+		return c.accountID, nil
+	}
+
+	body, err := c.makeRequest(http.MethodGet, "/whoami", nil)
+	if err != nil {
+		return "", err
+	}
+
+	var resp struct {
+		Data struct {
+			Account *struct {
+				ID int64 `json:"id"`
+			} `json:"account"`
+		} `json:"data"`
+	}
+	if err := json.Unmarshal(body, &resp); err != nil {
+		return "", fmt.Errorf("dnsimple: error decoding whoami response: %v", err)
+	}
+	if resp.Data.Account == nil {
+		return "", fmt.Errorf("dnsimple: the API token is not an account token, the account ID must be configured")
+	}
+
+	c.accountID = fmt.Sprint(resp.Data.Account.ID)
+	return c.accountID, nil
+}
+
+func (c *DNSProvider) findTXTRecords(recordsPath, name string) ([]zoneRecord, error) {
+	var records []zoneRecord
+	for page := 1; ; page++ {
+		q := url.Values{}
+		q.Set("name", name)
+		q.Set("type", "TXT")
+		q.Set("page", fmt.Sprint(page))
+
+		body, err := c.makeRequest(http.MethodGet, recordsPath+"?"+q.Encode(), nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Data       []zoneRecord `json:"data"`
+			Pagination struct {
+				TotalPages int `json:"total_pages"`
+			} `json:"pagination"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("dnsimple: error decoding TXT records: %v", err)
+		}
+		records = append(records, resp.Data...)
+
+		if page >= resp.Pagination.TotalPages {
+			return records, nil
+		}
+	}
+}
+
+// makeRequest performs a request against the DNSimple API and returns the
+// response body. A nil body is returned without an error if the record being
+// deleted does not exist.
+func (c *DNSProvider) makeRequest(method, uri string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.baseURL+uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.apiToken)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimple: error querying the DNSimple API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("dnsimple: error reading response for %s %q: %v", method, uri, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("dnsimple: error querying the DNSimple API for %s %q: %d: %s", method, uri, resp.StatusCode, apiErr.Message)
+		}
+		return nil, fmt.Errorf("dnsimple: error querying the DNSimple API for %s %q: unexpected status code %d", method, uri, resp.StatusCode)
+	}
+
+	return respBody, nil
+}
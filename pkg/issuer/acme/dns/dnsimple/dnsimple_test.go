@@ -0,0 +1,234 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package dnsimple
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	dnsimpleLiveTest bool
+	dnsimpleToken    string
+	dnsimpleDomain   string
+)
+
+func init() {
+	dnsimpleToken = os.Getenv("DNSIMPLE_TOKEN")
+	dnsimpleDomain = os.Getenv("DNSIMPLE_DOMAIN")
+	if len(dnsimpleToken) > 0 && len(dnsimpleDomain) > 0 {
+		dnsimpleLiveTest = true
+	}
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("123", "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	assert.Equal(t, DNSimpleAPIURL, provider.baseURL)
+}
+
+func TestNewDNSProviderSandbox(t *testing.T) {
+	provider, err := NewDNSProviderCredentials("123", "", true, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	assert.Equal(t, DNSimpleSandboxAPIURL, provider.baseURL)
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	_, err := NewDNSProviderCredentials("", "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.EqualError(t, err, "DNSimple API token missing")
+}
+
+func TestDNSimplePresentDiscoversAccount(t *testing.T) {
+	var whoamiCalls int
+	var created []zoneRecord
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "Bearer 123", r.Header.Get("Authorization"))
+		switch r.Method + " " + r.URL.Path {
+		case "GET /whoami":
+			whoamiCalls++
+			io.WriteString(w, `{"data":{"user":null,"account":{"id":1010,"email":"admin@example.com"}}}`)
+		case "GET /1010/zones/example.com/records":
+			io.WriteString(w, `{"data":[],"pagination":{"current_page":1,"per_page":30,"total_entries":0,"total_pages":1}}`)
+		case "POST /1010/zones/example.com/records":
+			var rec zoneRecord
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&rec))
+			created = append(created, rec)
+			w.WriteHeader(http.StatusCreated)
+			io.WriteString(w, `{"data":{"id":1}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.Present("test.example.com", "_acme-challenge.test.example.com.", "123d=="))
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []zoneRecord{
+		{Name: "_acme-challenge.test", Type: "TXT", Content: "123d==", TTL: 60},
+		{Name: "_acme-challenge", Type: "TXT", Content: "123d==", TTL: 60},
+	}, created)
+	assert.Equal(t, 1, whoamiCalls)
+}
+
+func TestDNSimplePresentUserToken(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method+" "+r.URL.Path != "GET /whoami" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		io.WriteString(w, `{"data":{"user":{"id":1,"email":"user@example.com"},"account":null}}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, "dnsimple: the API token is not an account token, the account ID must be configured")
+}
+
+func TestDNSimplePresentRecordOnLaterPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path + "?page=" + r.URL.Query().Get("page") {
+		case "GET /1010/zones/example.com/records?page=1":
+			io.WriteString(w, `{"data":[{"id":1,"name":"_acme-challenge","type":"TXT","content":"other"}],"pagination":{"current_page":1,"per_page":1,"total_entries":2,"total_pages":2}}`)
+		case "GET /1010/zones/example.com/records?page=2":
+			io.WriteString(w, `{"data":[{"id":2,"name":"_acme-challenge","type":"TXT","content":"123d=="}],"pagination":{"current_page":2,"per_page":1,"total_entries":2,"total_pages":2}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "1010", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestDNSimplePresentRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-RateLimit-Remaining", "0")
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"message":"Your rate limit has been exceeded"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "1010", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `dnsimple: error querying the DNSimple API for GET "/1010/zones/example.com/records?name=_acme-challenge&page=1&type=TXT": 429: Your rate limit has been exceeded`)
+}
+
+func TestDNSimplePresentBadGateway(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		io.WriteString(w, "<html><body><h1>502 Bad Gateway</h1></body></html>")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "1010", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `dnsimple: error querying the DNSimple API for GET "/1010/zones/example.com/records?name=_acme-challenge&page=1&type=TXT": unexpected status code 502`)
+}
+
+func TestDNSimpleCleanUpRecordNotFound(t *testing.T) {
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /1010/zones/example.com/records":
+			io.WriteString(w, `{"data":[{"id":1,"name":"_acme-challenge","type":"TXT","content":"other"},{"id":2,"name":"_acme-challenge","type":"TXT","content":"123d=="}],"pagination":{"current_page":1,"per_page":30,"total_entries":2,"total_pages":1}}`)
+		case "DELETE /1010/zones/example.com/records/2":
+			// the record was removed after it was listed
+			deleted = true
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"message":"Record `+"`2`"+` not found"}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "1010", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.True(t, deleted)
+}
+
+func TestDNSimplePresent(t *testing.T) {
+	if !dnsimpleLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(dnsimpleToken, "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(dnsimpleDomain, "_acme-challenge."+dnsimpleDomain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestDNSimpleCleanUp(t *testing.T) {
+	if !dnsimpleLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(dnsimpleToken, "", false, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(dnsimpleDomain, "_acme-challenge."+dnsimpleDomain+".", "123d==")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package gandi implements a DNS provider for solving the DNS-01
+// challenge using Gandi LiveDNS.
+// See https://api.gandi.net/docs/livedns/
+package gandi
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// GandiAPIURL is the base URL of the Gandi LiveDNS API.
+const GandiAPIURL = "https://api.gandi.net/v5/livedns"
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	token            string
+	userAgent        string
+
+	baseURL                string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// rrset is a resource record set as returned by the LiveDNS API.
+type rrset struct {
+	Name   string   `json:"rrset_name,omitempty"`
+	Type   string   `json:"rrset_type,omitempty"`
+	TTL    int      `json:"rrset_ttl,omitempty"`
+	Values []string `json:"rrset_values"`
+}
+
+// apiError is the body returned by the LiveDNS API on failure.
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+	Cause   string `json:"cause"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Gandi LiveDNS.
+// The personal access token must be passed in the environment variable
+// GANDI_PERSONAL_ACCESS_TOKEN.
+func NewDNSProvider(dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	token := os.Getenv("GANDI_PERSONAL_ACCESS_TOKEN")
+	return NewDNSProviderCredentials(token, dns01Nameservers, userAgent)
+}
+
+// NewDNSProviderCredentials uses the supplied personal access token to return
+// a DNSProvider instance configured for Gandi LiveDNS.
+func NewDNSProviderCredentials(token string, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	if token == "" {
+		return nil, fmt.Errorf("Gandi personal access token missing")
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		token:                  token,
+		userAgent:              userAgent,
+		baseURL:                GandiAPIURL,
+		client:                 &http.Client{Timeout: 30 * time.Second},
+		findHostedDomainByFqdn: findHostedDomainByFqdn,
+		ttl:                    300,
+	}, nil
+}
+
+func findHostedDomainByFqdn(fqdn string, ns []string) (string, error) {
+	zone, err := util.FindZoneByFqdn(fqdn, ns)
+	if err != nil {
+		return "", err
+	}
+
+	return util.UnFqdn(zone), nil
+}
+
+// Present creates or updates the TXT record set to fulfil the dns-01
+// challenge. Existing values in the record set are preserved so that
+// multiple challenges for the same name may be solved concurrently.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	hostedDomain, name, err := c.recordName(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecord(hostedDomain, name)
+	if err != nil {
+		return err
+	}
+
+	var values []string
+	if existing != nil {
+		if containsValue(existing.Values, value) {
+			return nil
+		}
+		values = existing.Values
+	}
+	values = append(values, quote(value))
+
+	return c.putTXTRecord(hostedDomain, name, values)
+}
+
+// CleanUp removes the value from the TXT record set, deleting the record set
+// entirely if no other values remain.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	hostedDomain, name, err := c.recordName(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecord(hostedDomain, name)
+	if err != nil {
+		return err
+	}
+	if existing == nil || !containsValue(existing.Values, value) {
+		return nil
+	}
+
+	var remaining []string
+	for _, v := range existing.Values {
+		if unquote(v) != value {
+			remaining = append(remaining, v)
+		}
+	}
+
+	if len(remaining) == 0 {
+		_, err := c.makeRequest(http.MethodDelete, recordPath(hostedDomain, name), nil)
+		return err
+	}
+
+	return c.putTXTRecord(hostedDomain, name, remaining)
+}
+
+// recordName returns the hosted domain the fqdn belongs to, along with the
+// name of the record relative to that domain.
+func (c *DNSProvider) recordName(fqdn string) (string, string, error) {
+	hostedDomain, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", "", fmt.Errorf("gandi: failed to determine hosted domain for %q: %v", fqdn, err)
+	}
+	hostedDomain = util.UnFqdn(hostedDomain)
+
+	name := util.UnFqdn(fqdn)
+	if !strings.HasSuffix(name, "."+hostedDomain) {
+		return "", "", fmt.Errorf("gandi: fqdn %q is not part of hosted domain %q", fqdn, hostedDomain)
+	}
+
+	return hostedDomain, strings.TrimSuffix(name, "."+hostedDomain), nil
+}
+
+func (c *DNSProvider) getTXTRecord(hostedDomain, name string) (*rrset, error) {
+	body, err := c.makeRequest(http.MethodGet, recordPath(hostedDomain, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	var r rrset
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("gandi: error decoding TXT record: %v", err)
+	}
+
+	return &r, nil
+}
+
+func (c *DNSProvider) putTXTRecord(hostedDomain, name string, values []string) error {
+	b, err := json.Marshal(rrset{TTL: c.ttl, Values: values})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(http.MethodPut, recordPath(hostedDomain, name), bytes.NewReader(b))
+	return err
+}
+
+func recordPath(hostedDomain, name string) string {
+	return fmt.Sprintf("/domains/%s/records/%s/TXT", url.PathEscape(hostedDomain), url.PathEscape(name))
+}
+
+// makeRequest performs a request against the LiveDNS API. A nil body and nil
+// error are returned if the resource being read or deleted does not exist.
+func (c *DNSProvider) makeRequest(method, uri string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.baseURL+uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("gandi: error querying the LiveDNS API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("gandi: error reading response for %s %q: %v", method, uri, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound && (method == http.MethodGet || method == http.MethodDelete) {
+		return nil, nil
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("gandi: error querying the LiveDNS API for %s %q: %d %s: %s", method, uri, resp.StatusCode, apiErr.Cause, apiErr.Message)
+		}
+		return nil, fmt.Errorf("gandi: error querying the LiveDNS API for %s %q: unexpected status code %d", method, uri, resp.StatusCode)
+	}
+
+	return respBody, nil
+}
+
+func containsValue(values []string, value string) bool {
+	for _, v := range values {
+		if unquote(v) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quote(value string) string {
+	return `"` + value + `"`
+}
+
+func unquote(value string) string {
+	return strings.Trim(value, `"`)
+}
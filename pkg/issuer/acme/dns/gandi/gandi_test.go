@@ -0,0 +1,164 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package gandi
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+var (
+	gandiLiveTest bool
+	gandiToken    string
+	gandiDomain   string
+)
+
+func init() {
+	gandiToken = os.Getenv("GANDI_PERSONAL_ACCESS_TOKEN")
+	gandiDomain = os.Getenv("GANDI_DOMAIN")
+	if len(gandiToken) > 0 && len(gandiDomain) > 0 {
+		gandiLiveTest = true
+	}
+}
+
+func restoreEnv() {
+	os.Setenv("GANDI_PERSONAL_ACCESS_TOKEN", gandiToken)
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	os.Setenv("GANDI_PERSONAL_ACCESS_TOKEN", "")
+	_, err := NewDNSProviderCredentials("123", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderValidEnv(t *testing.T) {
+	os.Setenv("GANDI_PERSONAL_ACCESS_TOKEN", "123")
+	_, err := NewDNSProvider(util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	os.Setenv("GANDI_PERSONAL_ACCESS_TOKEN", "")
+	_, err := NewDNSProvider(util.RecursiveNameservers, "cert-manager-test")
+	assert.EqualError(t, err, "Gandi personal access token missing")
+	restoreEnv()
+}
+
+func TestGandiPresentRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"code":429,"message":"Rate limit exceeded, retry later","object":"HTTPTooManyRequests","cause":"Too Many Requests"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `gandi: error querying the LiveDNS API for GET "/domains/example.com/records/_acme-challenge/TXT": 429 Too Many Requests: Rate limit exceeded, retry later`)
+}
+
+func TestGandiPresentUnexpectedErrorBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+		io.WriteString(w, "<html><body>502 Bad Gateway</body></html>")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `gandi: error querying the LiveDNS API for GET "/domains/example.com/records/_acme-challenge/TXT": unexpected status code 502`)
+}
+
+func TestGandiCleanUpRecordNotFound(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"code":404,"message":"Can't find the DNS record _acme-challenge/TXT in the zone","object":"dns-record","cause":"Not Found"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []string{http.MethodGet}, requests)
+}
+
+func TestGandiCleanUpRecordDeletedConcurrently(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			io.WriteString(w, `{"rrset_name":"_acme-challenge","rrset_type":"TXT","rrset_ttl":300,"rrset_values":["\"123d==\""]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"code":404,"message":"Can't find the DNS record _acme-challenge/TXT in the zone","object":"dns-record","cause":"Not Found"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestGandiPresent(t *testing.T) {
+	if !gandiLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(gandiToken, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(gandiDomain, "_acme-challenge."+gandiDomain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestGandiCleanUp(t *testing.T) {
+	if !gandiLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(gandiToken, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(gandiDomain, "_acme-challenge."+gandiDomain+".", "123d==")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,349 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ibmcis implements a DNS provider for solving the DNS-01 challenge
+// using IBM Cloud Internet Services.
+// See https://cloud.ibm.com/apidocs/cis
+package ibmcis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	// CISAPIURL is the base URL of the IBM Cloud Internet Services API.
+	CISAPIURL = "https://api.cis.cloud.ibm.com/v1"
+
+	// IAMTokenURL is the URL used to exchange an IBM Cloud API key for an IAM
+	// access token.
+	IAMTokenURL = "https://iam.cloud.ibm.com/identity/token"
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	crn              string
+	userAgent        string
+
+	tokens                 *tokenSource
+	baseURL                string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// dnsRecord is a DNS record as returned by the CIS API.
+type dnsRecord struct {
+	ID      string `json:"id,omitempty"`
+	Type    string `json:"type"`
+	Name    string `json:"name"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl,omitempty"`
+}
+
+type zone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// apiResponse is the envelope of all CIS API responses.
+type apiResponse struct {
+	Success    bool            `json:"success"`
+	Errors     []apiError      `json:"errors"`
+	Result     json.RawMessage `json:"result"`
+	ResultInfo *resultInfo     `json:"result_info"`
+}
+
+type apiError struct {
+	Code    int    `json:"code"`
+	Message string `json:"message"`
+}
+
+type resultInfo struct {
+	Page       int `json:"page"`
+	TotalPages int `json:"total_pages"`
+}
+
+// NewDNSProviderCredentials returns a DNSProvider instance configured for the
+// IBM Cloud Internet Services instance identified by crn, authenticating
+// with the given IBM Cloud API key.
+func NewDNSProviderCredentials(apiKey, crn string, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("IBM Cloud API key missing")
+	}
+	if !strings.HasPrefix(crn, "crn:") {
+		return nil, fmt.Errorf("invalid IBM Cloud Internet Services instance CRN %q", crn)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	return &DNSProvider{
+		dns01Nameservers: dns01Nameservers,
+		crn:              crn,
+		userAgent:        userAgent,
+		tokens: &tokenSource{
+			apiKey:    apiKey,
+			tokenURL:  IAMTokenURL,
+			userAgent: userAgent,
+			client:    client,
+			now:       time.Now,
+		},
+		baseURL:                CISAPIURL,
+		client:                 client,
+		findHostedDomainByFqdn: util.FindZoneByFqdn,
+		ttl:                    120,
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zoneID, err := c.getHostedZoneID(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.findTXTRecords(zoneID, fqdn)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Content == value {
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(dnsRecord{
+		Type:    "TXT",
+		Name:    util.UnFqdn(fqdn),
+		Content: value,
+		TTL:     c.ttl,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(http.MethodPost, fmt.Sprintf("/zones/%s/dns_records", url.PathEscape(zoneID)), nil, bytes.NewReader(b))
+	return err
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zoneID, err := c.getHostedZoneID(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.findTXTRecords(zoneID, fqdn)
+	if err != nil {
+		return err
+	}
+	for _, r := range existing {
+		if r.Content != value {
+			continue
+		}
+		if _, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/zones/%s/dns_records/%s", url.PathEscape(zoneID), url.PathEscape(r.ID)), nil, nil); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// getHostedZoneID returns the ID of the zone in the CIS instance that the
+// fqdn belongs to.
+func (c *DNSProvider) getHostedZoneID(fqdn string) (string, error) {
+	authZone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", fmt.Errorf("ibmcis: failed to determine zone for %q: %v", fqdn, err)
+	}
+	name := util.UnFqdn(authZone)
+
+	for page := 1; ; page++ {
+		q := url.Values{}
+		q.Set("page", fmt.Sprint(page))
+		resp, err := c.makeRequest(http.MethodGet, "/zones", q, nil)
+		if err != nil {
+			return "", err
+		}
+
+		var zones []zone
+		if err := json.Unmarshal(resp.Result, &zones); err != nil {
+			return "", fmt.Errorf("ibmcis: error decoding zones: %v", err)
+		}
+		for _, z := range zones {
+			if z.Name == name {
+				return z.ID, nil
+			}
+		}
+
+		if resp.ResultInfo == nil || page >= resp.ResultInfo.TotalPages {
+			break
+		}
+	}
+
+	return "", fmt.Errorf("ibmcis: zone %q not found in IBM Cloud Internet Services instance %q", name, c.crn)
+}
+
+func (c *DNSProvider) findTXTRecords(zoneID, fqdn string) ([]dnsRecord, error) {
+	var records []dnsRecord
+	for page := 1; ; page++ {
+		q := url.Values{}
+		q.Set("type", "TXT")
+		q.Set("name", util.UnFqdn(fqdn))
+		q.Set("page", fmt.Sprint(page))
+
+		resp, err := c.makeRequest(http.MethodGet, fmt.Sprintf("/zones/%s/dns_records", url.PathEscape(zoneID)), q, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var pageRecords []dnsRecord
+		if err := json.Unmarshal(resp.Result, &pageRecords); err != nil {
+			return nil, fmt.Errorf("ibmcis: error decoding TXT records: %v", err)
+		}
+		records = append(records, pageRecords...)
+
+		if resp.ResultInfo == nil || page >= resp.ResultInfo.TotalPages {
+			return records, nil
+		}
+	}
+}
+
+// makeRequest performs a request against the CIS API, scoped to the
+// configured instance. A nil response is returned without an error if the
+// record being deleted does not exist.
+func (c *DNSProvider) makeRequest(method, path string, query url.Values, body io.Reader) (*apiResponse, error) {
+	token, err := c.tokens.token()
+	if err != nil {
+		return nil, fmt.Errorf("ibmcis: %v", err)
+	}
+
+	// the CRN contains '/' characters which must be escaped
+	uri := c.baseURL + "/" + url.PathEscape(c.crn) + path
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, uri, body)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("X-Auth-User-Token", "Bearer "+token)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ibmcis: error querying the CIS API for %s %q: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	if method == http.MethodDelete && resp.StatusCode == http.StatusNotFound {
+		return nil, nil
+	}
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ibmcis: error reading response for %s %q: %v", method, path, err)
+	}
+
+	var r apiResponse
+	if err := json.Unmarshal(b, &r); err != nil {
+		if resp.StatusCode >= http.StatusBadRequest {
+			// errors raised in front of the API, such as rate limiting,
+			// may not have a JSON body
+			return nil, fmt.Errorf("ibmcis: error querying the CIS API for %s %q: status code %d: %s", method, path, resp.StatusCode, strings.TrimSpace(string(b)))
+		}
+		return nil, fmt.Errorf("ibmcis: error decoding response for %s %q (status code %d): %v", method, path, resp.StatusCode, err)
+	}
+
+	if !r.Success || resp.StatusCode >= http.StatusBadRequest {
+		var msgs []string
+		for _, e := range r.Errors {
+			msgs = append(msgs, fmt.Sprintf("%d: %s", e.Code, e.Message))
+		}
+		return nil, fmt.Errorf("ibmcis: error querying the CIS API for %s %q: status code %d: %s", method, path, resp.StatusCode, strings.Join(msgs, "; "))
+	}
+
+	return &r, nil
+}
+
+// tokenSource exchanges an API key for IAM access tokens, caching each token
+// until shortly before it expires.
+type tokenSource struct {
+	apiKey    string
+	tokenURL  string
+	userAgent string
+	client    *http.Client
+	now       func() time.Time
+
+	lock        sync.Mutex
+	accessToken string
+	expiry      time.Time
+}
+
+func (t *tokenSource) token() (string, error) {
+	t.lock.Lock()
+	defer t.lock.Unlock()
+
+	if t.accessToken != "" && t.now().Add(time.Minute).Before(t.expiry) {
+		return t.accessToken, nil
+	}
+
+	form := url.Values{}
+	form.Set("grant_type", "urn:ibm:params:oauth:grant-type:apikey")
+	form.Set("apikey", t.apiKey)
+
+	req, err := http.NewRequest(http.MethodPost, t.tokenURL, strings.NewReader(form.Encode()))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", t.userAgent)
+
+	resp, err := t.client.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting IAM access token: %v", err)
+	}
+	defer resp.Body.Close()
+
+	var body struct {
+		AccessToken  string `json:"access_token"`
+		Expiration   int64  `json:"expiration"`
+		ErrorCode    string `json:"errorCode"`
+		ErrorMessage string `json:"errorMessage"`
+	}
+	if err := json.NewDecoder(resp.Body).Decode(&body); err != nil {
+		return "", fmt.Errorf("error decoding IAM access token response (status code %d): %v", resp.StatusCode, err)
+	}
+	if resp.StatusCode != http.StatusOK || body.AccessToken == "" {
+		return "", fmt.Errorf("error requesting IAM access token: status code %d: %s: %s", resp.StatusCode, body.ErrorCode, body.ErrorMessage)
+	}
+
+	t.accessToken = body.AccessToken
+	t.expiry = time.Unix(body.Expiration, 0)
+	return t.accessToken, nil
+}
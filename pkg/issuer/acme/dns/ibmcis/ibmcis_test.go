@@ -0,0 +1,295 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ibmcis
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+	"github.com/stretchr/testify/assert"
+)
+
+const crnFixture = "crn:v1:bluemix:public:internet-svcs:global:a/abc123:instance-id::"
+
+var (
+	ibmcisLiveTest bool
+	ibmcisAPIKey   string
+	ibmcisCRN      string
+	ibmcisDomain   string
+)
+
+func init() {
+	ibmcisAPIKey = os.Getenv("IBMCIS_API_KEY")
+	ibmcisCRN = os.Getenv("IBMCIS_CRN")
+	ibmcisDomain = os.Getenv("IBMCIS_DOMAIN")
+	if len(ibmcisAPIKey) > 0 && len(ibmcisCRN) > 0 && len(ibmcisDomain) > 0 {
+		ibmcisLiveTest = true
+	}
+}
+
+// instancePath is the escaped path prefix of all requests for crnFixture.
+var instancePath = "/" + url.PathEscape(crnFixture)
+
+func writeToken(w http.ResponseWriter) {
+	fmt.Fprintf(w, `{"access_token":"access-token","expiration":%d}`, time.Now().Add(time.Hour).Unix())
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	_, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	_, err := NewDNSProviderCredentials("", crnFixture, nil, "cert-manager-test")
+	assert.EqualError(t, err, "IBM Cloud API key missing")
+
+	_, err = NewDNSProviderCredentials("api-key", "instance-id", nil, "cert-manager-test")
+	assert.EqualError(t, err, `invalid IBM Cloud Internet Services instance CRN "instance-id"`)
+}
+
+func TestIBMCISPresentZoneOnLaterPage(t *testing.T) {
+	var tokenCalls int
+	var created dnsRecord
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			tokenCalls++
+			writeToken(w)
+			return
+		}
+		assert.Equal(t, "Bearer access-token", r.Header.Get("X-Auth-User-Token"))
+		switch r.Method + " " + r.URL.EscapedPath() + "?page=" + r.URL.Query().Get("page") {
+		case "GET " + instancePath + "/zones?page=1":
+			io.WriteString(w, `{"success":true,"result":[{"id":"other-id","name":"example.org"}],"result_info":{"page":1,"total_pages":2}}`)
+		case "GET " + instancePath + "/zones?page=2":
+			io.WriteString(w, `{"success":true,"result":[{"id":"zone-id","name":"example.com"}],"result_info":{"page":2,"total_pages":2}}`)
+		case "GET " + instancePath + "/zones/zone-id/dns_records?page=1":
+			assert.Equal(t, "TXT", r.URL.Query().Get("type"))
+			assert.Equal(t, "_acme-challenge.example.com", r.URL.Query().Get("name"))
+			io.WriteString(w, `{"success":true,"result":[],"result_info":{"page":1,"total_pages":1}}`)
+		case "POST " + instancePath + "/zones/zone-id/dns_records?page=":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			io.WriteString(w, `{"success":true,"result":{"id":"record-id"}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, dnsRecord{Type: "TXT", Name: "_acme-challenge.example.com", Content: "123d==", TTL: 120}, created)
+	assert.Equal(t, 1, tokenCalls)
+}
+
+func TestIBMCISPresentRecordOnLaterPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			writeToken(w)
+			return
+		}
+		switch r.Method + " " + r.URL.EscapedPath() + "?page=" + r.URL.Query().Get("page") {
+		case "GET " + instancePath + "/zones?page=1":
+			io.WriteString(w, `{"success":true,"result":[{"id":"zone-id","name":"example.com"}],"result_info":{"page":1,"total_pages":1}}`)
+		case "GET " + instancePath + "/zones/zone-id/dns_records?page=1":
+			io.WriteString(w, `{"success":true,"result":[{"id":"1","type":"TXT","name":"_acme-challenge.example.com","content":"other"}],"result_info":{"page":1,"total_pages":2}}`)
+		case "GET " + instancePath + "/zones/zone-id/dns_records?page=2":
+			io.WriteString(w, `{"success":true,"result":[{"id":"2","type":"TXT","name":"_acme-challenge.example.com","content":"123d=="}],"result_info":{"page":2,"total_pages":2}}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestIBMCISPresentZoneNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			writeToken(w)
+			return
+		}
+		io.WriteString(w, `{"success":true,"result":[{"id":"zone-id","name":"example.com"}],"result_info":{"page":1,"total_pages":1}}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.net.", nil
+	}
+
+	err = provider.Present("example.net", "_acme-challenge.example.net.", "123d==")
+	assert.EqualError(t, err, fmt.Sprintf(`ibmcis: zone "example.net" not found in IBM Cloud Internet Services instance %q`, crnFixture))
+}
+
+func TestIBMCISPresentRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			writeToken(w)
+			return
+		}
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, "Too Many Requests\n")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `ibmcis: error querying the CIS API for GET "/zones": status code 429: Too Many Requests`)
+}
+
+func TestIBMCISPresentAPIError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			writeToken(w)
+			return
+		}
+		w.WriteHeader(http.StatusForbidden)
+		io.WriteString(w, `{"success":false,"errors":[{"code":10000,"message":"Authorization error"}],"result":null}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `ibmcis: error querying the CIS API for GET "/zones": status code 403: 10000: Authorization error`)
+}
+
+func TestIBMCISPresentInvalidAPIKey(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/identity/token" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		io.WriteString(w, `{"errorCode":"BXNIM0415E","errorMessage":"Provided API key could not be found."}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("wrong-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, "ibmcis: error requesting IAM access token: status code 400: BXNIM0415E: Provided API key could not be found.")
+}
+
+func TestIBMCISCleanUpRecordNotFound(t *testing.T) {
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/identity/token" {
+			writeToken(w)
+			return
+		}
+		switch r.Method + " " + r.URL.EscapedPath() {
+		case "GET " + instancePath + "/zones":
+			io.WriteString(w, `{"success":true,"result":[{"id":"zone-id","name":"example.com"}],"result_info":{"page":1,"total_pages":1}}`)
+		case "GET " + instancePath + "/zones/zone-id/dns_records":
+			io.WriteString(w, `{"success":true,"result":[{"id":"1","type":"TXT","name":"_acme-challenge.example.com","content":"other"},{"id":"2","type":"TXT","name":"_acme-challenge.example.com","content":"123d=="}],"result_info":{"page":1,"total_pages":1}}`)
+		case "DELETE " + instancePath + "/zones/zone-id/dns_records/2":
+			// the record was removed after it was listed
+			deleted = true
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"success":false,"errors":[{"code":81044,"message":"Record does not exist."}],"result":null}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("api-key", crnFixture, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+	provider.tokens.tokenURL = srv.URL + "/identity/token"
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) {
+		return "example.com.", nil
+	}
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.True(t, deleted)
+}
+
+func TestIBMCISPresent(t *testing.T) {
+	if !ibmcisLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(ibmcisAPIKey, ibmcisCRN, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(ibmcisDomain, "_acme-challenge."+ibmcisDomain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestIBMCISCleanUp(t *testing.T) {
+	if !ibmcisLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(ibmcisAPIKey, ibmcisCRN, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(ibmcisDomain, "_acme-challenge."+ibmcisDomain+".", "123d==")
+	assert.NoError(t, err)
+}
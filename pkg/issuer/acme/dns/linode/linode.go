@@ -0,0 +1,290 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package linode implements a DNS provider for solving the DNS-01
+// challenge using Linode DNS Manager.
+// See https://www.linode.com/docs/api/domains/
+package linode
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// LinodeAPIURL is the base URL of the Linode API.
+const LinodeAPIURL = "https://api.linode.com/v4"
+
+// minTTL is the smallest TTL accepted by the Linode API. Values lower than
+// this are silently rounded up by Linode.
+const minTTL = 300
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	token     string
+	userAgent string
+
+	baseURL string
+	client  *http.Client
+}
+
+type domain struct {
+	ID     int    `json:"id"`
+	Domain string `json:"domain"`
+}
+
+type domainRecord struct {
+	ID     int    `json:"id,omitempty"`
+	Type   string `json:"type"`
+	Name   string `json:"name"`
+	Target string `json:"target"`
+	TTLSec int    `json:"ttl_sec,omitempty"`
+}
+
+// page is a single page of a paginated list response.
+type page struct {
+	Data    json.RawMessage `json:"data"`
+	Page    int             `json:"page"`
+	Pages   int             `json:"pages"`
+	Results int             `json:"results"`
+}
+
+type apiErrors struct {
+	Errors []struct {
+		Field  string `json:"field"`
+		Reason string `json:"reason"`
+	} `json:"errors"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for Linode.
+// The API token must be passed in the environment variable LINODE_TOKEN.
+func NewDNSProvider(userAgent string) (*DNSProvider, error) {
+	token := os.Getenv("LINODE_TOKEN")
+	return NewDNSProviderCredentials(token, userAgent)
+}
+
+// NewDNSProviderCredentials uses the supplied API token to return a
+// DNSProvider instance configured for Linode. The domain that a challenge
+// record belongs to is discovered from the domains in the Linode account, so
+// no recursive nameservers are required.
+func NewDNSProviderCredentials(token string, userAgent string) (*DNSProvider, error) {
+	if token == "" {
+		return nil, fmt.Errorf("Linode API token missing")
+	}
+
+	return &DNSProvider{
+		token:     token,
+		userAgent: userAgent,
+		baseURL:   LinodeAPIURL,
+		client:    &http.Client{Timeout: 30 * time.Second},
+	}, nil
+}
+
+// Present creates a TXT record to fulfil the dns-01 challenge
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, name, err := c.recordName(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.listTXTRecords(zone.ID, name)
+	if err != nil {
+		return err
+	}
+	for _, r := range records {
+		if r.Target == value {
+			// the record already exists, nothing to do
+			return nil
+		}
+	}
+
+	b, err := json.Marshal(domainRecord{
+		Type:   "TXT",
+		Name:   name,
+		Target: value,
+		TTLSec: minTTL,
+	})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(http.MethodPost, fmt.Sprintf("/domains/%d/records", zone.ID), nil, bytes.NewReader(b))
+	return err
+}
+
+// CleanUp removes the TXT record matching the specified parameters
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, name, err := c.recordName(fqdn)
+	if err != nil {
+		return err
+	}
+
+	records, err := c.listTXTRecords(zone.ID, name)
+	if err != nil {
+		return err
+	}
+
+	for _, r := range records {
+		if r.Target != value {
+			continue
+		}
+		if _, err := c.makeRequest(http.MethodDelete, fmt.Sprintf("/domains/%d/records/%d", zone.ID, r.ID), nil, nil); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// recordName discovers the Linode domain that the fqdn belongs to and
+// returns it, along with the name of the record relative to that domain.
+// The most specific domain managed in the Linode account is used, so that
+// delegated subdomains are handled correctly.
+func (c *DNSProvider) recordName(fqdn string) (*domain, string, error) {
+	domains, err := c.listDomains()
+	if err != nil {
+		return nil, "", err
+	}
+
+	name := strings.ToLower(util.UnFqdn(fqdn))
+	var zone *domain
+	for i := range domains {
+		d := strings.ToLower(domains[i].Domain)
+		if name != d && !strings.HasSuffix(name, "."+d) {
+			continue
+		}
+		if zone == nil || len(d) > len(zone.Domain) {
+			zone = &domains[i]
+		}
+	}
+	if zone == nil {
+		return nil, "", fmt.Errorf("linode: no domain found in the Linode account for %q", fqdn)
+	}
+
+	return zone, strings.TrimSuffix(strings.TrimSuffix(name, strings.ToLower(zone.Domain)), "."), nil
+}
+
+func (c *DNSProvider) listDomains() ([]domain, error) {
+	var domains []domain
+	err := c.list("/domains", nil, func(data json.RawMessage) error {
+		var d []domain
+		if err := json.Unmarshal(data, &d); err != nil {
+			return err
+		}
+		domains = append(domains, d...)
+		return nil
+	})
+	return domains, err
+}
+
+func (c *DNSProvider) listTXTRecords(domainID int, name string) ([]domainRecord, error) {
+	filter, err := json.Marshal(map[string]string{"type": "TXT", "name": name})
+	if err != nil {
+		return nil, err
+	}
+
+	var records []domainRecord
+	err = c.list(fmt.Sprintf("/domains/%d/records", domainID), map[string]string{"X-Filter": string(filter)}, func(data json.RawMessage) error {
+		var r []domainRecord
+		if err := json.Unmarshal(data, &r); err != nil {
+			return err
+		}
+		// X-Filter is not guaranteed to be honoured for every field, so
+		// filter the results again client side.
+		for _, record := range r {
+			if record.Type == "TXT" && strings.EqualFold(record.Name, name) {
+				records = append(records, record)
+			}
+		}
+		return nil
+	})
+	return records, err
+}
+
+// list iterates over every page of a paginated list endpoint, calling fn with
+// the data of each page.
+func (c *DNSProvider) list(uri string, headers map[string]string, fn func(json.RawMessage) error) error {
+	for pageNum := 1; ; pageNum++ {
+		body, err := c.makeRequest(http.MethodGet, fmt.Sprintf("%s?page=%d&page_size=500", uri, pageNum), headers, nil)
+		if err != nil {
+			return err
+		}
+
+		var p page
+		if err := json.Unmarshal(body, &p); err != nil {
+			return fmt.Errorf("linode: error decoding response for %q: %v", uri, err)
+		}
+		if err := fn(p.Data); err != nil {
+			return fmt.Errorf("linode: error decoding response for %q: %v", uri, err)
+		}
+
+		if p.Page >= p.Pages {
+			return nil
+		}
+	}
+}
+
+// makeRequest performs a request against the Linode API. A nil body and nil
+// error are returned if the resource being deleted does not exist.
+func (c *DNSProvider) makeRequest(method, uri string, headers map[string]string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.baseURL+uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Authorization", "Bearer "+c.token)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	for k, v := range headers {
+		req.Header.Set(k, v)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("linode: error querying the Linode API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("linode: error reading response for %s %q: %v", method, uri, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound && method == http.MethodDelete {
+		return nil, nil
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiErrors
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && len(apiErr.Errors) > 0 {
+			var reasons []string
+			for _, e := range apiErr.Errors {
+				reasons = append(reasons, e.Reason)
+			}
+			return nil, fmt.Errorf("linode: error querying the Linode API for %s %q: %d: %s", method, uri, resp.StatusCode, strings.Join(reasons, "; "))
+		}
+		return nil, fmt.Errorf("linode: error querying the Linode API for %s %q: unexpected status code %d", method, uri, resp.StatusCode)
+	}
+
+	return respBody, nil
+}
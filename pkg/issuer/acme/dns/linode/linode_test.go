@@ -0,0 +1,187 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package linode
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	linodeLiveTest bool
+	linodeToken    string
+	linodeDomain   string
+)
+
+func init() {
+	linodeToken = os.Getenv("LINODE_TOKEN")
+	linodeDomain = os.Getenv("LINODE_DOMAIN")
+	if len(linodeToken) > 0 && len(linodeDomain) > 0 {
+		linodeLiveTest = true
+	}
+}
+
+func restoreEnv() {
+	os.Setenv("LINODE_TOKEN", linodeToken)
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	os.Setenv("LINODE_TOKEN", "")
+	_, err := NewDNSProviderCredentials("123", "cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderValidEnv(t *testing.T) {
+	os.Setenv("LINODE_TOKEN", "123")
+	_, err := NewDNSProvider("cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	os.Setenv("LINODE_TOKEN", "")
+	_, err := NewDNSProvider("cert-manager-test")
+	assert.EqualError(t, err, "Linode API token missing")
+	restoreEnv()
+}
+
+func TestLinodePresentDomainOnLaterPage(t *testing.T) {
+	var created domainRecord
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path + "?page=" + r.URL.Query().Get("page") {
+		case "GET /domains?page=1":
+			io.WriteString(w, `{"data":[{"id":1,"domain":"example.org"}],"page":1,"pages":2,"results":2}`)
+		case "GET /domains?page=2":
+			io.WriteString(w, `{"data":[{"id":2,"domain":"example.com"}],"page":2,"pages":2,"results":2}`)
+		case "GET /domains/2/records?page=1":
+			io.WriteString(w, `{"data":[],"page":1,"pages":1,"results":0}`)
+		case "POST /domains/2/records?page=":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&created))
+			io.WriteString(w, `{"id":10}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, domainRecord{Type: "TXT", Name: "_acme-challenge", Target: "123d==", TTLSec: minTTL}, created)
+}
+
+func TestLinodePresentRecordOnLaterPage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path + "?page=" + r.URL.Query().Get("page") {
+		case "GET /domains?page=1":
+			io.WriteString(w, `{"data":[{"id":2,"domain":"example.com"}],"page":1,"pages":1,"results":1}`)
+		case "GET /domains/2/records?page=1":
+			io.WriteString(w, `{"data":[{"id":10,"type":"TXT","name":"_acme-challenge","target":"other"}],"page":1,"pages":2,"results":2}`)
+		case "GET /domains/2/records?page=2":
+			io.WriteString(w, `{"data":[{"id":11,"type":"TXT","name":"_acme-challenge","target":"123d=="}],"page":2,"pages":2,"results":2}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestLinodePresentRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"errors":[{"reason":"Too many requests"}]}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `linode: error querying the Linode API for GET "/domains?page=1&page_size=500": 429: Too many requests`)
+}
+
+func TestLinodeCleanUpRecordNotFound(t *testing.T) {
+	var deleted bool
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /domains":
+			io.WriteString(w, `{"data":[{"id":2,"domain":"example.com"}],"page":1,"pages":1,"results":1}`)
+		case "GET /domains/2/records":
+			io.WriteString(w, `{"data":[{"id":11,"type":"TXT","name":"_acme-challenge","target":"123d=="}],"page":1,"pages":1,"results":1}`)
+		case "DELETE /domains/2/records/11":
+			deleted = true
+			w.WriteHeader(http.StatusNotFound)
+			io.WriteString(w, `{"errors":[{"reason":"Not found"}]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", "cert-manager-test")
+	assert.NoError(t, err)
+	provider.baseURL = srv.URL
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.True(t, deleted)
+}
+
+func TestLinodePresent(t *testing.T) {
+	if !linodeLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(linodeToken, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(linodeDomain, "_acme-challenge."+linodeDomain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestLinodeCleanUp(t *testing.T) {
+	if !linodeLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(linodeToken, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(linodeDomain, "_acme-challenge."+linodeDomain+".", "123d==")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ns1 implements a DNS provider for solving the DNS-01 challenge
+// using NS1 managed DNS.
+// See https://ns1.com/api
+package ns1
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// NS1APIURL is the base URL of the NS1 API.
+const NS1APIURL = "https://api.nsone.net/v1"
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	apiKey           string
+	userAgent        string
+
+	baseURL                string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// record is an NS1 DNS record. In NS1's answer model, a record holds a list
+// of answers, each of which holds the rdata of a single resource record. For
+// TXT records the rdata is a single element containing the unquoted text.
+type record struct {
+	Zone    string   `json:"zone"`
+	Domain  string   `json:"domain"`
+	Type    string   `json:"type"`
+	TTL     int      `json:"ttl,omitempty"`
+	Answers []answer `json:"answers"`
+}
+
+type answer struct {
+	Answer []string `json:"answer"`
+}
+
+// apiError is the body returned by the NS1 API on failure.
+type apiError struct {
+	Message string `json:"message"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for NS1.
+// The API key must be passed in the environment variable NS1_API_KEY.
+func NewDNSProvider(dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	apiKey := os.Getenv("NS1_API_KEY")
+	return NewDNSProviderCredentials(apiKey, os.Getenv("NS1_ENDPOINT"), dns01Nameservers, userAgent)
+}
+
+// NewDNSProviderCredentials uses the supplied API key to return a DNSProvider
+// instance configured for NS1. If endpoint is empty, the public NS1 API is
+// used.
+func NewDNSProviderCredentials(apiKey, endpoint string, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	if apiKey == "" {
+		return nil, fmt.Errorf("NS1 API key missing")
+	}
+
+	baseURL := NS1APIURL
+	if endpoint != "" {
+		if _, err := url.ParseRequestURI(endpoint); err != nil {
+			return nil, fmt.Errorf("invalid NS1 endpoint %q: %v", endpoint, err)
+		}
+		baseURL = strings.TrimSuffix(endpoint, "/")
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		apiKey:                 apiKey,
+		userAgent:              userAgent,
+		baseURL:                baseURL,
+		client:                 &http.Client{Timeout: 30 * time.Second},
+		findHostedDomainByFqdn: util.FindZoneByFqdn,
+		ttl:                    60,
+	}, nil
+}
+
+// Present creates a TXT record answer to fulfil the dns-01 challenge. If the
+// record already exists, the answer is added to its existing answers.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, name, err := c.zoneAndDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getRecord(zone, name)
+	if err != nil {
+		return err
+	}
+
+	if existing == nil {
+		return c.writeRecord(http.MethodPut, &record{
+			Zone:    zone,
+			Domain:  name,
+			Type:    "TXT",
+			TTL:     c.ttl,
+			Answers: []answer{{Answer: []string{value}}},
+		})
+	}
+
+	if hasAnswer(existing.Answers, value) {
+		return nil
+	}
+	existing.Answers = append(existing.Answers, answer{Answer: []string{value}})
+	return c.writeRecord(http.MethodPost, existing)
+}
+
+// CleanUp removes the answer from the TXT record, deleting the record
+// entirely if no other answers remain.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, name, err := c.zoneAndDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getRecord(zone, name)
+	if err != nil {
+		return err
+	}
+	if existing == nil || !hasAnswer(existing.Answers, value) {
+		return nil
+	}
+
+	var remaining []answer
+	for _, a := range existing.Answers {
+		if !answerEquals(a, value) {
+			remaining = append(remaining, a)
+		}
+	}
+
+	if len(remaining) == 0 {
+		_, err := c.makeRequest(http.MethodDelete, recordPath(zone, name), nil)
+		return err
+	}
+
+	existing.Answers = remaining
+	return c.writeRecord(http.MethodPost, existing)
+}
+
+// zoneAndDomain returns the NS1 zone that the fqdn belongs to, along with the
+// fully qualified record domain (without trailing dot) as NS1 expects it.
+func (c *DNSProvider) zoneAndDomain(fqdn string) (string, string, error) {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", "", fmt.Errorf("ns1: failed to determine zone for %q: %v", fqdn, err)
+	}
+	return util.UnFqdn(zone), util.UnFqdn(fqdn), nil
+}
+
+func (c *DNSProvider) getRecord(zone, name string) (*record, error) {
+	body, err := c.makeRequest(http.MethodGet, recordPath(zone, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	if body == nil {
+		return nil, nil
+	}
+
+	var r record
+	if err := json.Unmarshal(body, &r); err != nil {
+		return nil, fmt.Errorf("ns1: error decoding TXT record: %v", err)
+	}
+	return &r, nil
+}
+
+// writeRecord creates (PUT) or updates (POST) a record.
+func (c *DNSProvider) writeRecord(method string, r *record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(method, recordPath(r.Zone, r.Domain), bytes.NewReader(b))
+	return err
+}
+
+func recordPath(zone, name string) string {
+	return fmt.Sprintf("/zones/%s/%s/TXT", url.PathEscape(zone), url.PathEscape(name))
+}
+
+// makeRequest performs a request against the NS1 API. A nil body and nil
+// error are returned if the resource being read or deleted does not exist.
+func (c *DNSProvider) makeRequest(method, uri string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.baseURL+uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-NSONE-Key", c.apiKey)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("ns1: error querying the NS1 API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("ns1: error reading response for %s %q: %v", method, uri, err)
+	}
+
+	if resp.StatusCode == http.StatusNotFound && (method == http.MethodGet || method == http.MethodDelete) {
+		return nil, nil
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
+			return nil, fmt.Errorf("ns1: error querying the NS1 API for %s %q: %d: %s", method, uri, resp.StatusCode, apiErr.Message)
+		}
+		return nil, fmt.Errorf("ns1: error querying the NS1 API for %s %q: unexpected status code %d", method, uri, resp.StatusCode)
+	}
+
+	return respBody, nil
+}
+
+func hasAnswer(answers []answer, value string) bool {
+	for _, a := range answers {
+		if answerEquals(a, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func answerEquals(a answer, value string) bool {
+	return len(a.Answer) == 1 && a.Answer[0] == value
+}
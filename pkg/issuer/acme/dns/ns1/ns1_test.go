@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ns1
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+var (
+	ns1LiveTest bool
+	ns1APIKey   string
+	ns1Domain   string
+)
+
+func init() {
+	ns1APIKey = os.Getenv("NS1_API_KEY")
+	ns1Domain = os.Getenv("NS1_DOMAIN")
+	if len(ns1APIKey) > 0 && len(ns1Domain) > 0 {
+		ns1LiveTest = true
+	}
+}
+
+func restoreEnv() {
+	os.Setenv("NS1_API_KEY", ns1APIKey)
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	os.Setenv("NS1_API_KEY", "")
+	provider, err := NewDNSProviderCredentials("123", "", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	assert.Equal(t, NS1APIURL, provider.baseURL)
+	restoreEnv()
+}
+
+func TestNewDNSProviderValidEnv(t *testing.T) {
+	os.Setenv("NS1_API_KEY", "123")
+	_, err := NewDNSProvider(util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderInvalidEndpoint(t *testing.T) {
+	_, err := NewDNSProviderCredentials("123", "not a url", util.RecursiveNameservers, "cert-manager-test")
+	assert.Error(t, err)
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	os.Setenv("NS1_API_KEY", "")
+	_, err := NewDNSProvider(util.RecursiveNameservers, "cert-manager-test")
+	assert.EqualError(t, err, "NS1 API key missing")
+	restoreEnv()
+}
+
+func TestNS1PresentAddsAnswerToExistingRecord(t *testing.T) {
+	var updated record
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			io.WriteString(w, `{"zone":"example.com","domain":"_acme-challenge.example.com","type":"TXT","ttl":60,"answers":[{"answer":["other"]}]}`)
+		case http.MethodPost:
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&updated))
+			io.WriteString(w, `{}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", srv.URL, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []answer{{Answer: []string{"other"}}, {Answer: []string{"123d=="}}}, updated.Answers)
+}
+
+func TestNS1PresentRateLimited(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"message":"rate limit exceeded"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", srv.URL, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `ns1: error querying the NS1 API for GET "/zones/example.com/_acme-challenge.example.com/TXT": 429: rate limit exceeded`)
+}
+
+func TestNS1CleanUpRecordNotFound(t *testing.T) {
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"message":"record not found"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", srv.URL, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []string{http.MethodGet}, requests)
+}
+
+func TestNS1CleanUpRecordDeletedConcurrently(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodGet {
+			io.WriteString(w, `{"zone":"example.com","domain":"_acme-challenge.example.com","type":"TXT","ttl":60,"answers":[{"answer":["123d=="]}]}`)
+			return
+		}
+		w.WriteHeader(http.StatusNotFound)
+		io.WriteString(w, `{"message":"record not found"}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials("123", srv.URL, util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestNS1Present(t *testing.T) {
+	if !ns1LiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(ns1APIKey, "", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(ns1Domain, "_acme-challenge."+ns1Domain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestNS1CleanUp(t *testing.T) {
+	if !ns1LiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(ns1APIKey, "", util.RecursiveNameservers, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(ns1Domain, "_acme-challenge."+ns1Domain+".", "123d==")
+	assert.NoError(t, err)
+}
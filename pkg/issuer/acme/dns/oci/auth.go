@@ -0,0 +1,492 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// defaultRealmDomain is the domain of the commercial OCI realm (oc1).
+	defaultRealmDomain = "oraclecloud.com"
+
+	// instanceMetadataURL is the base URL of version 2 of the OCI instance
+	// metadata service.
+	instanceMetadataURL = "http://169.254.169.254/opc/v2"
+
+	serviceAccountTokenPath = "/var/run/secrets/kubernetes.io/serviceaccount/token"
+	serviceAccountCAPath    = "/var/run/secrets/kubernetes.io/serviceaccount/ca.crt"
+
+	// okeProxymuxPort is the port on which the OKE proxymux service that
+	// exchanges service account tokens for resource principal session tokens
+	// listens on the Kubernetes API server host.
+	okeProxymuxPort = "12250"
+)
+
+// keyProvider provides the key used to sign requests to OCI APIs.
+type keyProvider interface {
+	// key returns the keyId to include in the request signature, along with
+	// the private key to sign the request with.
+	key() (string, *rsa.PrivateKey, error)
+
+	// region returns the region identifier (e.g. us-ashburn-1) and the
+	// domain of the realm that the region belongs to.
+	region() (string, string, error)
+}
+
+// apiKeyProvider signs requests with the API signing key of an OCI user.
+type apiKeyProvider struct {
+	tenancyID   string
+	userID      string
+	fingerprint string
+	privateKey  *rsa.PrivateKey
+	regionID    string
+}
+
+func (p *apiKeyProvider) key() (string, *rsa.PrivateKey, error) {
+	return p.tenancyID + "/" + p.userID + "/" + p.fingerprint, p.privateKey, nil
+}
+
+func (p *apiKeyProvider) region() (string, string, error) {
+	return p.regionID, defaultRealmDomain, nil
+}
+
+// sessionKeyProvider signs requests with an ephemeral key whose public half
+// has been exchanged for a session token by fetchToken. The token is reused
+// until shortly before it expires.
+type sessionKeyProvider struct {
+	fetchToken func(publicKey *rsa.PublicKey) (string, error)
+	regionFunc func() (string, string, error)
+	now        func() time.Time
+
+	lock       sync.Mutex
+	token      string
+	expiry     time.Time
+	sessionKey *rsa.PrivateKey
+}
+
+func (p *sessionKeyProvider) key() (string, *rsa.PrivateKey, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if p.token != "" && p.now().Add(time.Minute).Before(p.expiry) {
+		return "ST$" + p.token, p.sessionKey, nil
+	}
+
+	sessionKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		return "", nil, fmt.Errorf("error generating session key: %v", err)
+	}
+	token, err := p.fetchToken(&sessionKey.PublicKey)
+	if err != nil {
+		return "", nil, err
+	}
+	expiry, err := tokenExpiry(token)
+	if err != nil {
+		return "", nil, err
+	}
+
+	p.token, p.expiry, p.sessionKey = token, expiry, sessionKey
+	return "ST$" + p.token, p.sessionKey, nil
+}
+
+func (p *sessionKeyProvider) region() (string, string, error) {
+	return p.regionFunc()
+}
+
+// instancePrincipal obtains session tokens for the compute instance that
+// cert-manager is running on, using the instance's identity certificate from
+// the instance metadata service.
+type instancePrincipal struct {
+	metadataURL string
+	// authURL overrides the URL of the federation endpoint, which is
+	// otherwise derived from the instance's region.
+	authURL string
+	client  *http.Client
+}
+
+type instanceRegionInfo struct {
+	RealmDomainComponent string `json:"realmDomainComponent"`
+	RegionIdentifier     string `json:"regionIdentifier"`
+}
+
+func (p *instancePrincipal) region() (string, string, error) {
+	b, err := p.getMetadata("/instance/regionInfo")
+	if err != nil {
+		return "", "", err
+	}
+	var info instanceRegionInfo
+	if err := json.Unmarshal(b, &info); err != nil {
+		return "", "", fmt.Errorf("error decoding instance region info: %v", err)
+	}
+	if info.RegionIdentifier == "" || info.RealmDomainComponent == "" {
+		return "", "", fmt.Errorf("instance metadata service returned incomplete region info")
+	}
+	return info.RegionIdentifier, info.RealmDomainComponent, nil
+}
+
+// fetchToken exchanges the instance's identity certificate for a session
+// token bound to publicKey, using the X.509 federation endpoint of the OCI
+// Identity service.
+func (p *instancePrincipal) fetchToken(publicKey *rsa.PublicKey) (string, error) {
+	certPEM, err := p.getMetadata("/identity/cert.pem")
+	if err != nil {
+		return "", err
+	}
+	keyPEM, err := p.getMetadata("/identity/key.pem")
+	if err != nil {
+		return "", err
+	}
+	intermediatePEM, err := p.getMetadata("/identity/intermediate.pem")
+	if err != nil {
+		return "", err
+	}
+
+	cert, err := parseCertificate(certPEM)
+	if err != nil {
+		return "", fmt.Errorf("error parsing instance identity certificate: %v", err)
+	}
+	certKey, err := parsePrivateKey(keyPEM)
+	if err != nil {
+		return "", fmt.Errorf("error parsing instance identity key: %v", err)
+	}
+	intermediate, err := parseCertificate(intermediatePEM)
+	if err != nil {
+		return "", fmt.Errorf("error parsing instance intermediate certificate: %v", err)
+	}
+	tenancyID := tenancyFromCertificate(cert)
+	if tenancyID == "" {
+		return "", fmt.Errorf("instance identity certificate does not contain a tenancy OCID")
+	}
+
+	authURL := p.authURL
+	if authURL == "" {
+		region, realmDomain, err := p.region()
+		if err != nil {
+			return "", err
+		}
+		authURL = fmt.Sprintf("https://auth.%s.%s/v1/x509", region, realmDomain)
+	}
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(struct {
+		Certificate              string   `json:"certificate"`
+		PublicKey                string   `json:"publicKey"`
+		IntermediateCertificates []string `json:"intermediateCertificates"`
+	}{
+		Certificate:              base64.StdEncoding.EncodeToString(cert.Raw),
+		PublicKey:                base64.StdEncoding.EncodeToString(publicKeyDER),
+		IntermediateCertificates: []string{base64.StdEncoding.EncodeToString(intermediate.Raw)},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, authURL, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if err := signRequest(req, body, tenancyID+"/fed-x509/"+certificateFingerprint(cert), certKey); err != nil {
+		return "", err
+	}
+
+	respBody, err := doRequest(p.client, req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting instance principal session token: %v", err)
+	}
+
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(respBody, &resp); err != nil {
+		return "", fmt.Errorf("error decoding instance principal session token: %v", err)
+	}
+	if resp.Token == "" {
+		return "", fmt.Errorf("no instance principal session token returned")
+	}
+	return resp.Token, nil
+}
+
+func (p *instancePrincipal) getMetadata(path string) ([]byte, error) {
+	req, err := http.NewRequest(http.MethodGet, p.metadataURL+path, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Authorization", "Bearer Oracle")
+
+	b, err := doRequest(p.client, req)
+	if err != nil {
+		return nil, fmt.Errorf("error querying instance metadata service for %q: %v", path, err)
+	}
+	return b, nil
+}
+
+// workloadIdentity obtains resource principal session tokens for the
+// Kubernetes service account that cert-manager is running as, using the OKE
+// workload identity token exchange.
+type workloadIdentity struct {
+	endpoint  string
+	tokenPath string
+	regionID  string
+	client    *http.Client
+}
+
+func newWorkloadIdentity(regionID string) (*workloadIdentity, error) {
+	host := os.Getenv("KUBERNETES_SERVICE_HOST")
+	if host == "" {
+		return nil, fmt.Errorf("KUBERNETES_SERVICE_HOST is not set, OCI workload identity is only available when running on OKE")
+	}
+	if regionID == "" {
+		regionID = os.Getenv("OCI_RESOURCE_PRINCIPAL_REGION")
+	}
+	if regionID == "" {
+		return nil, fmt.Errorf("OCI region must be set when using workload identity, either in the issuer or using OCI_RESOURCE_PRINCIPAL_REGION")
+	}
+
+	caPEM, err := os.ReadFile(serviceAccountCAPath)
+	if err != nil {
+		return nil, fmt.Errorf("error reading service account CA certificate: %v", err)
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, fmt.Errorf("no certificates found in service account CA certificate %q", serviceAccountCAPath)
+	}
+
+	return &workloadIdentity{
+		endpoint:  "https://" + net.JoinHostPort(host, okeProxymuxPort) + "/resourcePrincipalSessionTokens",
+		tokenPath: serviceAccountTokenPath,
+		regionID:  regionID,
+		client: &http.Client{
+			Timeout:   30 * time.Second,
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}},
+		},
+	}, nil
+}
+
+func (p *workloadIdentity) region() (string, string, error) {
+	return p.regionID, defaultRealmDomain, nil
+}
+
+// fetchToken exchanges the pod's service account token for a resource
+// principal session token bound to publicKey.
+func (p *workloadIdentity) fetchToken(publicKey *rsa.PublicKey) (string, error) {
+	saToken, err := os.ReadFile(p.tokenPath)
+	if err != nil {
+		return "", fmt.Errorf("error reading service account token: %v", err)
+	}
+
+	publicKeyDER, err := x509.MarshalPKIXPublicKey(publicKey)
+	if err != nil {
+		return "", err
+	}
+	body, err := json.Marshal(struct {
+		PodKey string `json:"podKey"`
+	}{PodKey: base64.StdEncoding.EncodeToString(publicKeyDER)})
+	if err != nil {
+		return "", err
+	}
+
+	req, err := http.NewRequest(http.MethodPost, p.endpoint, bytes.NewReader(body))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "Bearer "+strings.TrimSpace(string(saToken)))
+
+	respBody, err := doRequest(p.client, req)
+	if err != nil {
+		return "", fmt.Errorf("error requesting workload identity session token: %v", err)
+	}
+
+	// The response is a JSON string holding the base64 encoding of a JSON
+	// object, whose token field is prefixed with "ST$".
+	var encoded string
+	if err := json.Unmarshal(respBody, &encoded); err != nil {
+		return "", fmt.Errorf("error decoding workload identity session token: %v", err)
+	}
+	decoded, err := base64.StdEncoding.DecodeString(encoded)
+	if err != nil {
+		return "", fmt.Errorf("error decoding workload identity session token: %v", err)
+	}
+	var resp struct {
+		Token string `json:"token"`
+	}
+	if err := json.Unmarshal(decoded, &resp); err != nil {
+		return "", fmt.Errorf("error decoding workload identity session token: %v", err)
+	}
+	token := strings.TrimPrefix(resp.Token, "ST$")
+	if token == "" {
+		return "", fmt.Errorf("no workload identity session token returned")
+	}
+	return token, nil
+}
+
+// signRequest signs req using the OCI request signature scheme, which is
+// based on draft-cavage-http-signatures-08.
+// See https://docs.oracle.com/en-us/iaas/Content/API/Concepts/signingrequests.htm
+func signRequest(req *http.Request, body []byte, keyID string, key *rsa.PrivateKey) error {
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	host := req.Host
+	if host == "" {
+		host = req.URL.Host
+	}
+
+	headers := []string{"date", "(request-target)", "host"}
+	values := map[string]string{
+		"date":             req.Header.Get("Date"),
+		"(request-target)": strings.ToLower(req.Method) + " " + req.URL.RequestURI(),
+		"host":             host,
+	}
+
+	switch req.Method {
+	case http.MethodPost, http.MethodPut, http.MethodPatch:
+		if req.Header.Get("Content-Type") == "" {
+			req.Header.Set("Content-Type", "application/json")
+		}
+		sum := sha256.Sum256(body)
+		req.Header.Set("X-Content-Sha256", base64.StdEncoding.EncodeToString(sum[:]))
+		req.ContentLength = int64(len(body))
+
+		headers = append(headers, "content-length", "content-type", "x-content-sha256")
+		values["content-length"] = strconv.Itoa(len(body))
+		values["content-type"] = req.Header.Get("Content-Type")
+		values["x-content-sha256"] = req.Header.Get("X-Content-Sha256")
+	}
+
+	lines := make([]string, len(headers))
+	for i, h := range headers {
+		lines[i] = h + ": " + values[h]
+	}
+	digest := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	signature, err := rsa.SignPKCS1v15(rand.Reader, key, crypto.SHA256, digest[:])
+	if err != nil {
+		return fmt.Errorf("error signing request: %v", err)
+	}
+
+	req.Header.Set("Authorization", fmt.Sprintf(`Signature version="1",keyId="%s",algorithm="rsa-sha256",headers="%s",signature="%s"`,
+		keyID, strings.Join(headers, " "), base64.StdEncoding.EncodeToString(signature)))
+	return nil
+}
+
+// doRequest performs req and returns the response body, returning an error
+// for any non-2xx response.
+func doRequest(client *http.Client, req *http.Request) ([]byte, error) {
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		return nil, fmt.Errorf("unexpected status code %d: %s", resp.StatusCode, strings.TrimSpace(string(b)))
+	}
+	return b, nil
+}
+
+// tokenExpiry returns the expiry time of a session token, which is a JWT.
+func tokenExpiry(token string) (time.Time, error) {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return time.Time{}, fmt.Errorf("session token is not a JWT")
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("error decoding session token: %v", err)
+	}
+	var claims struct {
+		Exp int64 `json:"exp"`
+	}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return time.Time{}, fmt.Errorf("error decoding session token: %v", err)
+	}
+	return time.Unix(claims.Exp, 0), nil
+}
+
+// tenancyFromCertificate returns the tenancy OCID embedded in the subject of
+// an instance identity certificate.
+func tenancyFromCertificate(cert *x509.Certificate) string {
+	for _, name := range cert.Subject.Names {
+		value, ok := name.Value.(string)
+		if ok && strings.HasPrefix(value, "opc-tenant:") {
+			return strings.TrimPrefix(value, "opc-tenant:")
+		}
+	}
+	return ""
+}
+
+// certificateFingerprint returns the colon separated SHA-1 fingerprint of a
+// certificate, as used in the keyId of X.509 federation requests.
+func certificateFingerprint(cert *x509.Certificate) string {
+	sum := sha1.Sum(cert.Raw)
+	return strings.ReplaceAll(fmt.Sprintf("% x", sum[:]), " ", ":")
+}
+
+func parseCertificate(data []byte) (*x509.Certificate, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return nil, fmt.Errorf("no PEM encoded certificate found")
+	}
+	return x509.ParseCertificate(block.Bytes)
+}
+
+// parsePrivateKey parses an unencrypted PEM encoded RSA private key in either
+// PKCS#1 or PKCS#8 form.
+func parsePrivateKey(data []byte) (*rsa.PrivateKey, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM encoded private key found")
+	}
+	if key, err := x509.ParsePKCS1PrivateKey(block.Bytes); err == nil {
+		return key, nil
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing private key: %v", err)
+	}
+	rsaKey, ok := key.(*rsa.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("private key is not an RSA key")
+	}
+	return rsaKey, nil
+}
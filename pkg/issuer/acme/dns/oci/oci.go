@@ -0,0 +1,340 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package oci implements a DNS provider for solving the DNS-01 challenge
+// using Oracle Cloud Infrastructure DNS.
+// See https://docs.oracle.com/en-us/iaas/api/#/en/dns/20180115/
+package oci
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+const (
+	// AuthTypeAPIKey authenticates using the API signing key of an OCI user.
+	AuthTypeAPIKey = "APIKey"
+	// AuthTypeInstancePrincipal authenticates as the compute instance that
+	// cert-manager is running on.
+	AuthTypeInstancePrincipal = "InstancePrincipal"
+	// AuthTypeWorkloadIdentity authenticates as the Kubernetes service account
+	// that cert-manager is running as, using OKE workload identity.
+	AuthTypeWorkloadIdentity = "WorkloadIdentity"
+)
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	dns01Nameservers []string
+	compartmentID    string
+	userAgent        string
+
+	keys keyProvider
+	// regionID overrides the region reported by keys, if set.
+	regionID string
+	// endpoint overrides the URL of the DNS API, which is otherwise derived
+	// from the region.
+	endpoint               string
+	client                 *http.Client
+	findHostedDomainByFqdn func(string, []string) (string, error)
+	ttl                    int
+}
+
+// record is a resource record as returned by the OCI DNS API. For TXT records
+// rdata holds the quoted text.
+type record struct {
+	Domain     string `json:"domain"`
+	RecordHash string `json:"recordHash"`
+	Rdata      string `json:"rdata"`
+	Rtype      string `json:"rtype"`
+	TTL        int    `json:"ttl"`
+}
+
+// recordOperation is a single change in a PatchDomainRecords request.
+type recordOperation struct {
+	Domain     string `json:"domain,omitempty"`
+	RecordHash string `json:"recordHash,omitempty"`
+	Rdata      string `json:"rdata,omitempty"`
+	Rtype      string `json:"rtype,omitempty"`
+	TTL        int    `json:"ttl,omitempty"`
+	Operation  string `json:"operation"`
+}
+
+// apiError is the body returned by the OCI APIs on failure.
+type apiError struct {
+	Code    string `json:"code"`
+	Message string `json:"message"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for OCI DNS.
+//
+// With AuthTypeAPIKey (the default if authType is empty), requests are signed
+// using the given user's API signing key and region must be set. With
+// AuthTypeInstancePrincipal or AuthTypeWorkloadIdentity, short-lived session
+// tokens are obtained for the instance or service account respectively, and
+// ambient credentials must be permitted.
+//
+// If compartmentID is set, zones are looked up in that compartment.
+func NewDNSProvider(region, compartmentID, authType, tenancyID, userID, fingerprint string, privateKey []byte, ambient bool, dns01Nameservers []string, userAgent string) (*DNSProvider, error) {
+	client := &http.Client{Timeout: 30 * time.Second}
+
+	var keys keyProvider
+	switch authType {
+	case "", AuthTypeAPIKey:
+		if tenancyID == "" || userID == "" || fingerprint == "" || len(privateKey) == 0 {
+			return nil, fmt.Errorf("OCI tenancy OCID, user OCID, key fingerprint and private key must all be set when using API key authentication")
+		}
+		if region == "" {
+			return nil, fmt.Errorf("OCI region must be set when using API key authentication")
+		}
+		key, err := parsePrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("error parsing OCI API private key: %v", err)
+		}
+		keys = &apiKeyProvider{
+			tenancyID:   tenancyID,
+			userID:      userID,
+			fingerprint: fingerprint,
+			privateKey:  key,
+			regionID:    region,
+		}
+	case AuthTypeInstancePrincipal:
+		if !ambient {
+			return nil, fmt.Errorf("unable to construct OCI provider: instance principal authentication requires ambient credentials to be enabled")
+		}
+		ip := &instancePrincipal{metadataURL: instanceMetadataURL, client: client}
+		keys = &sessionKeyProvider{fetchToken: ip.fetchToken, regionFunc: ip.region, now: time.Now}
+	case AuthTypeWorkloadIdentity:
+		if !ambient {
+			return nil, fmt.Errorf("unable to construct OCI provider: workload identity authentication requires ambient credentials to be enabled")
+		}
+		wi, err := newWorkloadIdentity(region)
+		if err != nil {
+			return nil, err
+		}
+		keys = &sessionKeyProvider{fetchToken: wi.fetchToken, regionFunc: wi.region, now: time.Now}
+	default:
+		return nil, fmt.Errorf("unknown OCI authentication type %q", authType)
+	}
+
+	return &DNSProvider{
+		dns01Nameservers:       dns01Nameservers,
+		compartmentID:          compartmentID,
+		userAgent:              userAgent,
+		keys:                   keys,
+		regionID:               region,
+		client:                 client,
+		findHostedDomainByFqdn: util.FindZoneByFqdn,
+		ttl:                    60,
+	}, nil
+}
+
+// Present adds a TXT record to fulfil the dns-01 challenge.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	zone, name, err := c.zoneAndDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecords(zone, name)
+	if err != nil {
+		return err
+	}
+	if findRecord(existing, value) != nil {
+		return nil
+	}
+
+	return c.patchRecords(zone, name, recordOperation{
+		Domain:    name,
+		Rdata:     value,
+		Rtype:     "TXT",
+		TTL:       c.ttl,
+		Operation: "ADD",
+	})
+}
+
+// CleanUp removes the TXT record matching the specified parameters.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	zone, name, err := c.zoneAndDomain(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecords(zone, name)
+	if err != nil {
+		return err
+	}
+	r := findRecord(existing, value)
+	if r == nil {
+		return nil
+	}
+
+	return c.patchRecords(zone, name, recordOperation{
+		RecordHash: r.RecordHash,
+		Operation:  "REMOVE",
+	})
+}
+
+// zoneAndDomain returns the OCI zone name that the fqdn belongs to, along with
+// the record domain, both without trailing dots as OCI expects them.
+func (c *DNSProvider) zoneAndDomain(fqdn string) (string, string, error) {
+	zone, err := c.findHostedDomainByFqdn(fqdn, c.dns01Nameservers)
+	if err != nil {
+		return "", "", fmt.Errorf("oci: failed to determine zone for %q: %v", fqdn, err)
+	}
+	return util.UnFqdn(zone), util.UnFqdn(fqdn), nil
+}
+
+// getTXTRecords returns the TXT records for name, following the opc-next-page
+// header until every page of results has been read.
+func (c *DNSProvider) getTXTRecords(zone, name string) ([]record, error) {
+	var records []record
+	page := ""
+	for {
+		q := url.Values{}
+		q.Set("rtype", "TXT")
+		if page != "" {
+			q.Set("page", page)
+		}
+
+		body, header, err := c.makeRequest(http.MethodGet, recordsPath(zone, name), q, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		var resp struct {
+			Items []record `json:"items"`
+		}
+		if err := json.Unmarshal(body, &resp); err != nil {
+			return nil, fmt.Errorf("oci: error decoding TXT records: %v", err)
+		}
+		records = append(records, resp.Items...)
+
+		page = header.Get("opc-next-page")
+		if page == "" {
+			return records, nil
+		}
+	}
+}
+
+func (c *DNSProvider) patchRecords(zone, name string, ops ...recordOperation) error {
+	b, err := json.Marshal(struct {
+		Items []recordOperation `json:"items"`
+	}{Items: ops})
+	if err != nil {
+		return err
+	}
+
+	_, _, err = c.makeRequest(http.MethodPatch, recordsPath(zone, name), nil, b)
+	return err
+}
+
+// dnsEndpoint returns the base URL of the DNS API in the configured region.
+func (c *DNSProvider) dnsEndpoint() (string, error) {
+	if c.endpoint != "" {
+		return c.endpoint, nil
+	}
+	region, realmDomain, err := c.keys.region()
+	if err != nil {
+		return "", err
+	}
+	if c.regionID != "" {
+		region = c.regionID
+	}
+	return fmt.Sprintf("https://dns.%s.oci.%s/20180115", region, realmDomain), nil
+}
+
+// makeRequest performs a signed request against the OCI DNS API and returns
+// the response body and headers.
+func (c *DNSProvider) makeRequest(method, path string, query url.Values, body []byte) ([]byte, http.Header, error) {
+	endpoint, err := c.dnsEndpoint()
+	if err != nil {
+		return nil, nil, fmt.Errorf("oci: error determining DNS API endpoint: %v", err)
+	}
+	keyID, key, err := c.keys.key()
+	if err != nil {
+		return nil, nil, fmt.Errorf("oci: error obtaining credentials: %v", err)
+	}
+
+	if c.compartmentID != "" {
+		if query == nil {
+			query = url.Values{}
+		}
+		query.Set("compartmentId", c.compartmentID)
+	}
+	uri := endpoint + path
+	if len(query) > 0 {
+		uri += "?" + query.Encode()
+	}
+
+	req, err := http.NewRequest(method, uri, bytes.NewReader(body))
+	if err != nil {
+		return nil, nil, err
+	}
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if err := signRequest(req, body, keyID, key); err != nil {
+		return nil, nil, fmt.Errorf("oci: %v", err)
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, nil, fmt.Errorf("oci: error querying the OCI DNS API for %s %q: %v", method, path, err)
+	}
+	defer resp.Body.Close()
+
+	var respBody bytes.Buffer
+	if _, err := respBody.ReadFrom(resp.Body); err != nil {
+		return nil, nil, fmt.Errorf("oci: error reading response for %s %q: %v", method, path, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if err := json.Unmarshal(respBody.Bytes(), &apiErr); err == nil && apiErr.Message != "" {
+			return nil, nil, fmt.Errorf("oci: error querying the OCI DNS API for %s %q: %d %s: %s", method, path, resp.StatusCode, apiErr.Code, apiErr.Message)
+		}
+		return nil, nil, fmt.Errorf("oci: error querying the OCI DNS API for %s %q: unexpected status code %d", method, path, resp.StatusCode)
+	}
+
+	return respBody.Bytes(), resp.Header, nil
+}
+
+func recordsPath(zone, name string) string {
+	return "/zones/" + url.PathEscape(zone) + "/records/" + url.PathEscape(name)
+}
+
+// findRecord returns the TXT record holding value, if any. OCI returns TXT
+// rdata quoted, and may split long values into multiple quoted strings.
+func findRecord(records []record, value string) *record {
+	for i := range records {
+		if records[i].Rtype != "TXT" {
+			continue
+		}
+		if strings.ReplaceAll(strings.Trim(records[i].Rdata, `"`), `" "`, "") == value {
+			return &records[i]
+		}
+	}
+	return nil
+}
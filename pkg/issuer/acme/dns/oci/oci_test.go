@@ -0,0 +1,362 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package oci
+
+import (
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+	"io"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var signatureRegexp = regexp.MustCompile(`^Signature version="1",keyId="([^"]+)",algorithm="rsa-sha256",headers="([^"]+)",signature="([^"]+)"$`)
+
+// verifySignature checks that r carries a valid OCI request signature made
+// with key, and returns the keyId it was signed with.
+func verifySignature(r *http.Request, body []byte, key *rsa.PublicKey) (string, error) {
+	m := signatureRegexp.FindStringSubmatch(r.Header.Get("Authorization"))
+	if m == nil {
+		return "", fmt.Errorf("malformed Authorization header %q", r.Header.Get("Authorization"))
+	}
+
+	var lines []string
+	for _, h := range strings.Split(m[2], " ") {
+		switch h {
+		case "(request-target)":
+			lines = append(lines, h+": "+strings.ToLower(r.Method)+" "+r.URL.RequestURI())
+		case "host":
+			lines = append(lines, h+": "+r.Host)
+		default:
+			lines = append(lines, h+": "+r.Header.Get(h))
+		}
+	}
+	if r.Method == http.MethodPatch || r.Method == http.MethodPost {
+		sum := sha256.Sum256(body)
+		if r.Header.Get("X-Content-Sha256") != base64.StdEncoding.EncodeToString(sum[:]) {
+			return "", fmt.Errorf("x-content-sha256 does not match body")
+		}
+		if !strings.Contains(m[2], "x-content-sha256") {
+			return "", fmt.Errorf("body headers not signed")
+		}
+	}
+
+	sig, err := base64.StdEncoding.DecodeString(m[3])
+	if err != nil {
+		return "", err
+	}
+	digest := sha256.Sum256([]byte(strings.Join(lines, "\n")))
+	if err := rsa.VerifyPKCS1v15(key, crypto.SHA256, digest[:], sig); err != nil {
+		return "", fmt.Errorf("invalid signature: %v", err)
+	}
+	return m[1], nil
+}
+
+func newTestKey(t *testing.T) (*rsa.PrivateKey, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+}
+
+// newTestToken returns an unsigned JWT expiring at exp, which is all that is
+// inspected of session tokens.
+func newTestToken(exp time.Time) string {
+	enc := base64.RawURLEncoding
+	return enc.EncodeToString([]byte(`{"alg":"none"}`)) + "." + enc.EncodeToString([]byte(fmt.Sprintf(`{"exp":%d}`, exp.Unix()))) + ".sig"
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	_, keyPEM := newTestKey(t)
+	_, err := NewDNSProvider("us-ashburn-1", "", AuthTypeAPIKey, "tenancy", "user", "fingerprint", keyPEM, false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	_, err := NewDNSProvider("us-ashburn-1", "", "", "tenancy", "", "fingerprint", []byte("key"), false, nil, "cert-manager-test")
+	assert.EqualError(t, err, "OCI tenancy OCID, user OCID, key fingerprint and private key must all be set when using API key authentication")
+}
+
+func TestNewDNSProviderAmbientNotPermitted(t *testing.T) {
+	_, err := NewDNSProvider("", "", AuthTypeInstancePrincipal, "", "", "", nil, false, nil, "cert-manager-test")
+	assert.EqualError(t, err, "unable to construct OCI provider: instance principal authentication requires ambient credentials to be enabled")
+
+	_, err = NewDNSProvider("", "", AuthTypeWorkloadIdentity, "", "", "", nil, false, nil, "cert-manager-test")
+	assert.EqualError(t, err, "unable to construct OCI provider: workload identity authentication requires ambient credentials to be enabled")
+}
+
+func TestOCIPresentSignsRequests(t *testing.T) {
+	key, keyPEM := newTestKey(t)
+	var ops []recordOperation
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		keyID, err := verifySignature(r, body, &key.PublicKey)
+		assert.NoError(t, err)
+		assert.Equal(t, "ocid1.tenancy.oc1..aaa/ocid1.user.oc1..bbb/20:3b:97:13:55:1c", keyID)
+		assert.Equal(t, "/zones/example.com/records/_acme-challenge.example.com", r.URL.Path)
+		assert.Equal(t, "ocid1.compartment.oc1..ccc", r.URL.Query().Get("compartmentId"))
+
+		if r.Method == http.MethodPatch {
+			var req struct {
+				Items []recordOperation `json:"items"`
+			}
+			assert.NoError(t, json.Unmarshal(body, &req))
+			ops = append(ops, req.Items...)
+		}
+		io.WriteString(w, `{"items":[]}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProvider("us-ashburn-1", "ocid1.compartment.oc1..ccc", AuthTypeAPIKey, "ocid1.tenancy.oc1..aaa", "ocid1.user.oc1..bbb", "20:3b:97:13:55:1c", keyPEM, false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []recordOperation{{
+		Domain:    "_acme-challenge.example.com",
+		Rdata:     "123d==",
+		Rtype:     "TXT",
+		TTL:       60,
+		Operation: "ADD",
+	}}, ops)
+}
+
+func TestOCIPresentRecordOnLaterPage(t *testing.T) {
+	_, keyPEM := newTestKey(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			return
+		}
+		switch r.URL.Query().Get("page") {
+		case "":
+			w.Header().Set("opc-next-page", "page-2")
+			io.WriteString(w, `{"items":[{"domain":"_acme-challenge.example.com","recordHash":"a","rdata":"\"other\"","rtype":"TXT","ttl":60}]}`)
+		case "page-2":
+			// long values are split into multiple strings by OCI
+			io.WriteString(w, `{"items":[{"domain":"_acme-challenge.example.com","recordHash":"b","rdata":"\"123\" \"d==\"","rtype":"TXT","ttl":60}]}`)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProvider("us-ashburn-1", "", AuthTypeAPIKey, "tenancy", "user", "fingerprint", keyPEM, false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.Present("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestOCIPresentRateLimited(t *testing.T) {
+	_, keyPEM := newTestKey(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusTooManyRequests)
+		io.WriteString(w, `{"code":"TooManyRequests","message":"Tenancy request-rate limit exceeded."}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProvider("us-ashburn-1", "", AuthTypeAPIKey, "tenancy", "user", "fingerprint", keyPEM, false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `oci: error querying the OCI DNS API for GET "/zones/example.com/records/_acme-challenge.example.com": 429 TooManyRequests: Tenancy request-rate limit exceeded.`)
+}
+
+func TestOCICleanUpRecordNotFound(t *testing.T) {
+	_, keyPEM := newTestKey(t)
+	var requests []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, r.Method)
+		io.WriteString(w, `{"items":[{"domain":"_acme-challenge.example.com","recordHash":"a","rdata":"\"other\"","rtype":"TXT","ttl":60}]}`)
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProvider("us-ashburn-1", "", AuthTypeAPIKey, "tenancy", "user", "fingerprint", keyPEM, false, nil, "cert-manager-test")
+	assert.NoError(t, err)
+	provider.endpoint = srv.URL
+	provider.findHostedDomainByFqdn = func(string, []string) (string, error) { return "example.com.", nil }
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+	assert.Equal(t, []string{http.MethodGet}, requests)
+}
+
+func TestSessionKeyProviderReusesToken(t *testing.T) {
+	now := time.Unix(1650000000, 0)
+	fetches := 0
+	p := &sessionKeyProvider{
+		fetchToken: func(*rsa.PublicKey) (string, error) {
+			fetches++
+			return newTestToken(now.Add(20 * time.Minute)), nil
+		},
+		now: func() time.Time { return now },
+	}
+
+	keyID, key, err := p.key()
+	assert.NoError(t, err)
+	assert.Equal(t, "ST$"+newTestToken(now.Add(20*time.Minute)), keyID)
+
+	_, key2, err := p.key()
+	assert.NoError(t, err)
+	assert.Equal(t, 1, fetches)
+	assert.True(t, key == key2)
+
+	// tokens are refreshed shortly before they expire
+	now = now.Add(19*time.Minute + 30*time.Second)
+	_, key3, err := p.key()
+	assert.NoError(t, err)
+	assert.Equal(t, 2, fetches)
+	assert.False(t, key == key3)
+}
+
+func TestInstancePrincipalFetchToken(t *testing.T) {
+	caKey, _ := newTestKey(t)
+	leafKey, leafKeyPEM := newTestKey(t)
+	ca := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "PKISVC Identity Intermediate"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, ca, ca, &caKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	leaf := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject: pkix.Name{
+			CommonName:         "ocid1.instance.oc1.iad.xxx",
+			OrganizationalUnit: []string{"opc-certtype:instance", "opc-compartment:ocid1.compartment.oc1..ccc", "opc-tenant:ocid1.tenancy.oc1..aaa"},
+		},
+		NotBefore: time.Now().Add(-time.Hour),
+		NotAfter:  time.Now().Add(time.Hour),
+	}
+	leafDER, err := x509.CreateCertificate(rand.Reader, leaf, ca, &leafKey.PublicKey, caKey)
+	assert.NoError(t, err)
+	leafCert, err := x509.ParseCertificate(leafDER)
+	assert.NoError(t, err)
+
+	metadata := map[string][]byte{
+		"/identity/cert.pem":         pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: leafDER}),
+		"/identity/key.pem":          leafKeyPEM,
+		"/identity/intermediate.pem": pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: caDER}),
+		"/instance/regionInfo":       []byte(`{"realmKey":"oc1","realmDomainComponent":"oraclecloud.com","regionKey":"IAD","regionIdentifier":"us-ashburn-1"}`),
+	}
+	metadataSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer Oracle" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		b, ok := metadata[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write(b)
+	}))
+	defer metadataSrv.Close()
+
+	sessionKey, _ := newTestKey(t)
+	token := newTestToken(time.Now().Add(20 * time.Minute))
+	authSrv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		keyID, err := verifySignature(r, body, &leafKey.PublicKey)
+		if err != nil {
+			t.Errorf("federation request not signed with instance key: %v", err)
+		}
+		assert.Equal(t, "ocid1.tenancy.oc1..aaa/fed-x509/"+certificateFingerprint(leafCert), keyID)
+
+		var req struct {
+			Certificate              string   `json:"certificate"`
+			PublicKey                string   `json:"publicKey"`
+			IntermediateCertificates []string `json:"intermediateCertificates"`
+		}
+		assert.NoError(t, json.Unmarshal(body, &req))
+		publicKeyDER, _ := x509.MarshalPKIXPublicKey(&sessionKey.PublicKey)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(leafDER), req.Certificate)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(publicKeyDER), req.PublicKey)
+		assert.Equal(t, []string{base64.StdEncoding.EncodeToString(caDER)}, req.IntermediateCertificates)
+
+		fmt.Fprintf(w, `{"token":%q}`, token)
+	}))
+	defer authSrv.Close()
+
+	ip := &instancePrincipal{metadataURL: metadataSrv.URL, authURL: authSrv.URL + "/v1/x509", client: http.DefaultClient}
+
+	got, err := ip.fetchToken(&sessionKey.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, token, got)
+
+	region, realmDomain, err := ip.region()
+	assert.NoError(t, err)
+	assert.Equal(t, "us-ashburn-1", region)
+	assert.Equal(t, "oraclecloud.com", realmDomain)
+}
+
+func TestWorkloadIdentityFetchToken(t *testing.T) {
+	tokenPath := filepath.Join(t.TempDir(), "token")
+	assert.NoError(t, os.WriteFile(tokenPath, []byte("sa-token\n"), 0600))
+
+	sessionKey, _ := newTestKey(t)
+	token := newTestToken(time.Now().Add(20 * time.Minute))
+	srv := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, "/resourcePrincipalSessionTokens", r.URL.Path)
+		assert.Equal(t, "Bearer sa-token", r.Header.Get("Authorization"))
+
+		var req struct {
+			PodKey string `json:"podKey"`
+		}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		publicKeyDER, _ := x509.MarshalPKIXPublicKey(&sessionKey.PublicKey)
+		assert.Equal(t, base64.StdEncoding.EncodeToString(publicKeyDER), req.PodKey)
+
+		resp := base64.StdEncoding.EncodeToString([]byte(fmt.Sprintf(`{"token":"ST$%s"}`, token)))
+		fmt.Fprintf(w, "%q", resp)
+	}))
+	defer srv.Close()
+
+	wi := &workloadIdentity{
+		endpoint:  srv.URL + "/resourcePrincipalSessionTokens",
+		tokenPath: tokenPath,
+		regionID:  "us-ashburn-1",
+		client:    srv.Client(),
+	}
+
+	got, err := wi.fetchToken(&sessionKey.PublicKey)
+	assert.NoError(t, err)
+	assert.Equal(t, token, got)
+}
@@ -0,0 +1,319 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package powerdns implements a DNS provider for solving the DNS-01
+// challenge using the PowerDNS Authoritative Server HTTP API.
+// See https://doc.powerdns.com/authoritative/http-api/
+package powerdns
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
+)
+
+// DefaultServerID is the ID of the server to manage zones on if none is
+// given. PowerDNS Authoritative Server always uses "localhost".
+const DefaultServerID = "localhost"
+
+// DNSProvider is an implementation of the acme.ChallengeProvider interface
+type DNSProvider struct {
+	apiKey    string
+	userAgent string
+
+	// serverURL is the base URL of the API of the configured server, e.g.
+	// https://pdns.example.com/api/v1/servers/localhost
+	serverURL string
+	client    *http.Client
+	ttl       int
+}
+
+type zone struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+type zoneWithRRSets struct {
+	RRSets []rrset `json:"rrsets"`
+}
+
+// rrset is a resource record set as used by the PowerDNS API. Record
+// contents are in zone file format, so TXT contents are quoted.
+type rrset struct {
+	Name       string   `json:"name"`
+	Type       string   `json:"type"`
+	TTL        int      `json:"ttl,omitempty"`
+	ChangeType string   `json:"changetype,omitempty"`
+	Records    []record `json:"records"`
+}
+
+type record struct {
+	Content  string `json:"content"`
+	Disabled bool   `json:"disabled"`
+}
+
+// apiError is the body returned by the PowerDNS API on failure.
+type apiError struct {
+	Error string `json:"error"`
+}
+
+// NewDNSProvider returns a DNSProvider instance configured for PowerDNS.
+// The API URL, API key and server ID must be passed in the environment
+// variables PDNS_API_URL, PDNS_API_KEY and PDNS_SERVER_ID.
+func NewDNSProvider(userAgent string) (*DNSProvider, error) {
+	return NewDNSProviderCredentials(os.Getenv("PDNS_API_URL"), os.Getenv("PDNS_API_KEY"), os.Getenv("PDNS_SERVER_ID"), userAgent)
+}
+
+// NewDNSProviderCredentials uses the supplied credentials to return a
+// DNSProvider instance configured for the PowerDNS server at host. The zone
+// that a challenge record belongs to is discovered from the zones on the
+// server, so no recursive nameservers are required. If serverID is empty,
+// DefaultServerID is used.
+func NewDNSProviderCredentials(host, apiKey, serverID string, userAgent string) (*DNSProvider, error) {
+	if host == "" {
+		return nil, fmt.Errorf("PowerDNS API URL missing")
+	}
+	if apiKey == "" {
+		return nil, fmt.Errorf("PowerDNS API key missing")
+	}
+	u, err := url.Parse(host)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid PowerDNS API URL %q", host)
+	}
+	if serverID == "" {
+		serverID = DefaultServerID
+	}
+
+	return &DNSProvider{
+		apiKey:    apiKey,
+		userAgent: userAgent,
+		serverURL: strings.TrimSuffix(host, "/") + "/api/v1/servers/" + url.PathEscape(serverID),
+		client:    &http.Client{Timeout: 30 * time.Second},
+		ttl:       120,
+	}, nil
+}
+
+// Present adds the value to the TXT record set to fulfil the dns-01
+// challenge. Existing values in the record set are preserved so that
+// multiple challenges for the same name may be solved concurrently.
+func (c *DNSProvider) Present(domain, fqdn, value string) error {
+	z, err := c.findZone(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecords(z, fqdn)
+	if err != nil {
+		return err
+	}
+	if containsValue(existing, value) {
+		return nil
+	}
+
+	records := append(existing, record{Content: quote(value)})
+	return c.patchRRSet(z, rrset{
+		Name:       util.ToFqdn(fqdn),
+		Type:       "TXT",
+		TTL:        c.ttl,
+		ChangeType: "REPLACE",
+		Records:    records,
+	})
+}
+
+// CleanUp removes the value from the TXT record set, deleting the record set
+// entirely if no other values remain.
+func (c *DNSProvider) CleanUp(domain, fqdn, value string) error {
+	z, err := c.findZone(fqdn)
+	if err != nil {
+		return err
+	}
+
+	existing, err := c.getTXTRecords(z, fqdn)
+	if err != nil {
+		return err
+	}
+	if !containsValue(existing, value) {
+		return nil
+	}
+
+	var remaining []record
+	for _, r := range existing {
+		if unquote(r.Content) != value {
+			remaining = append(remaining, r)
+		}
+	}
+
+	if len(remaining) == 0 {
+		return c.patchRRSet(z, rrset{
+			Name:       util.ToFqdn(fqdn),
+			Type:       "TXT",
+			ChangeType: "DELETE",
+			Records:    []record{},
+		})
+	}
+
+	return c.patchRRSet(z, rrset{
+		Name:       util.ToFqdn(fqdn),
+		Type:       "TXT",
+		TTL:        c.ttl,
+		ChangeType: "REPLACE",
+		Records:    remaining,
+	})
+}
+
+// findZone discovers the zone on the PowerDNS server that the fqdn belongs
+// to. The most specific zone is used, so that delegated subdomains hosted on
+// the same server are handled correctly.
+func (c *DNSProvider) findZone(fqdn string) (*zone, error) {
+	body, err := c.makeRequest(http.MethodGet, "/zones", nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var zones []zone
+	if err := json.Unmarshal(body, &zones); err != nil {
+		return nil, fmt.Errorf("powerdns: error decoding zones: %v", err)
+	}
+
+	name := strings.ToLower(util.ToFqdn(fqdn))
+	var found *zone
+	for i := range zones {
+		z := strings.ToLower(util.ToFqdn(zones[i].Name))
+		if name != z && !strings.HasSuffix(name, "."+z) {
+			continue
+		}
+		if found == nil || len(z) > len(util.ToFqdn(found.Name)) {
+			found = &zones[i]
+		}
+	}
+	if found == nil {
+		return nil, fmt.Errorf("powerdns: no zone found on the PowerDNS server for %q", fqdn)
+	}
+
+	return found, nil
+}
+
+// getTXTRecords returns the records of the TXT record set for fqdn. The
+// rrset_name and rrset_type filters are only supported by PowerDNS 4.6 and
+// later, and are ignored by earlier versions, so the record sets returned are
+// filtered again here.
+func (c *DNSProvider) getTXTRecords(z *zone, fqdn string) ([]record, error) {
+	q := url.Values{}
+	q.Set("rrset_name", util.ToFqdn(fqdn))
+	q.Set("rrset_type", "TXT")
+
+	body, err := c.makeRequest(http.MethodGet, zonePath(z)+"?"+q.Encode(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	var zr zoneWithRRSets
+	if err := json.Unmarshal(body, &zr); err != nil {
+		return nil, fmt.Errorf("powerdns: error decoding zone %q: %v", z.Name, err)
+	}
+
+	for _, rr := range zr.RRSets {
+		if rr.Type == "TXT" && strings.EqualFold(rr.Name, util.ToFqdn(fqdn)) {
+			return rr.Records, nil
+		}
+	}
+
+	return nil, nil
+}
+
+func (c *DNSProvider) patchRRSet(z *zone, rr rrset) error {
+	b, err := json.Marshal(struct {
+		RRSets []rrset `json:"rrsets"`
+	}{RRSets: []rrset{rr}})
+	if err != nil {
+		return err
+	}
+
+	_, err = c.makeRequest(http.MethodPatch, zonePath(z), bytes.NewReader(b))
+	return err
+}
+
+// zonePath returns the path of the zone relative to the server URL. Zone IDs
+// are returned by the API already escaped for use in URLs, so the ID is used
+// when present.
+func zonePath(z *zone) string {
+	if z.ID != "" {
+		return "/zones/" + z.ID
+	}
+	return "/zones/" + url.PathEscape(util.ToFqdn(z.Name))
+}
+
+// makeRequest performs a request against the PowerDNS API and returns the
+// response body.
+func (c *DNSProvider) makeRequest(method, uri string, body io.Reader) ([]byte, error) {
+	req, err := http.NewRequest(method, c.serverURL+uri, body)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("X-API-Key", c.apiKey)
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set("User-Agent", c.userAgent)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("powerdns: error querying the PowerDNS API for %s %q: %v", method, uri, err)
+	}
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, fmt.Errorf("powerdns: error reading response for %s %q: %v", method, uri, err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var apiErr apiError
+		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Error != "" {
+			return nil, fmt.Errorf("powerdns: error querying the PowerDNS API for %s %q: %d: %s", method, uri, resp.StatusCode, apiErr.Error)
+		}
+		return nil, fmt.Errorf("powerdns: error querying the PowerDNS API for %s %q: unexpected status code %d", method, uri, resp.StatusCode)
+	}
+
+	return respBody, nil
+}
+
+func containsValue(records []record, value string) bool {
+	for _, r := range records {
+		if unquote(r.Content) == value {
+			return true
+		}
+	}
+	return false
+}
+
+func quote(value string) string {
+	return `"` + value + `"`
+}
+
+func unquote(value string) string {
+	return strings.Trim(value, `"`)
+}
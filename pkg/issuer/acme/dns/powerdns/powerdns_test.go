@@ -0,0 +1,200 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package powerdns
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var (
+	pdnsLiveTest bool
+	pdnsAPIURL   string
+	pdnsAPIKey   string
+	pdnsServerID string
+	pdnsDomain   string
+)
+
+func init() {
+	pdnsAPIURL = os.Getenv("PDNS_API_URL")
+	pdnsAPIKey = os.Getenv("PDNS_API_KEY")
+	pdnsServerID = os.Getenv("PDNS_SERVER_ID")
+	pdnsDomain = os.Getenv("PDNS_DOMAIN")
+	if len(pdnsAPIURL) > 0 && len(pdnsAPIKey) > 0 && len(pdnsDomain) > 0 {
+		pdnsLiveTest = true
+	}
+}
+
+func restoreEnv() {
+	os.Setenv("PDNS_API_URL", pdnsAPIURL)
+	os.Setenv("PDNS_API_KEY", pdnsAPIKey)
+}
+
+func TestNewDNSProviderValid(t *testing.T) {
+	_, err := NewDNSProviderCredentials("https://pdns.example.com", "123", "", "cert-manager-test")
+	assert.NoError(t, err)
+}
+
+func TestNewDNSProviderValidEnv(t *testing.T) {
+	os.Setenv("PDNS_API_URL", "https://pdns.example.com")
+	os.Setenv("PDNS_API_KEY", "123")
+	_, err := NewDNSProvider("cert-manager-test")
+	assert.NoError(t, err)
+	restoreEnv()
+}
+
+func TestNewDNSProviderMissingCredErr(t *testing.T) {
+	os.Setenv("PDNS_API_URL", "https://pdns.example.com")
+	os.Setenv("PDNS_API_KEY", "")
+	_, err := NewDNSProvider("cert-manager-test")
+	assert.EqualError(t, err, "PowerDNS API key missing")
+	restoreEnv()
+}
+
+func TestNewDNSProviderInvalidURL(t *testing.T) {
+	_, err := NewDNSProviderCredentials("pdns.example.com", "123", "", "cert-manager-test")
+	assert.EqualError(t, err, `invalid PowerDNS API URL "pdns.example.com"`)
+}
+
+func TestPowerDNSPresentIgnoredRRSetFilter(t *testing.T) {
+	// PowerDNS versions before 4.6 ignore the rrset_name and rrset_type
+	// query parameters and return every record set in the zone.
+	var patched struct {
+		RRSets []rrset `json:"rrsets"`
+	}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/servers/localhost/zones":
+			io.WriteString(w, `[{"id":"example.com.","name":"example.com."},{"id":"sub.example.com.","name":"sub.example.com."}]`)
+		case "GET /api/v1/servers/localhost/zones/sub.example.com.":
+			io.WriteString(w, `{"rrsets":[`+
+				`{"name":"sub.example.com.","type":"TXT","ttl":3600,"records":[{"content":"\"v=spf1 -all\"","disabled":false}]},`+
+				`{"name":"_acme-challenge.sub.example.com.","type":"CNAME","ttl":3600,"records":[{"content":"other.example.com.","disabled":false}]}`+
+				`]}`)
+		case "PATCH /api/v1/servers/localhost/zones/sub.example.com.":
+			assert.NoError(t, json.NewDecoder(r.Body).Decode(&patched))
+			w.WriteHeader(http.StatusNoContent)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials(srv.URL, "123", "", "cert-manager-test")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.Present("sub.example.com", "_acme-challenge.sub.example.com.", "123d=="))
+	assert.Equal(t, []rrset{{
+		Name:       "_acme-challenge.sub.example.com.",
+		Type:       "TXT",
+		TTL:        120,
+		ChangeType: "REPLACE",
+		Records:    []record{{Content: `"123d=="`}},
+	}}, patched.RRSets)
+}
+
+func TestPowerDNSPresentErrorBody(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method {
+		case http.MethodGet:
+			if r.URL.Path == "/api/v1/servers/localhost/zones" {
+				io.WriteString(w, `[{"id":"example.com.","name":"example.com."}]`)
+				return
+			}
+			io.WriteString(w, `{"rrsets":[]}`)
+		case http.MethodPatch:
+			w.WriteHeader(http.StatusUnprocessableEntity)
+			io.WriteString(w, `{"error":"RRset _acme-challenge.example.com. IN TXT: Conflicts with pre-existing RRset"}`)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials(srv.URL, "123", "", "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `powerdns: error querying the PowerDNS API for PATCH "/zones/example.com.": 422: RRset _acme-challenge.example.com. IN TXT: Conflicts with pre-existing RRset`)
+}
+
+func TestPowerDNSPresentUnauthorized(t *testing.T) {
+	// An invalid API key is rejected by the webserver with a plain text body.
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusUnauthorized)
+		io.WriteString(w, "Unauthorized")
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials(srv.URL, "123", "", "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present("example.com", "_acme-challenge.example.com.", "123d==")
+	assert.EqualError(t, err, `powerdns: error querying the PowerDNS API for GET "/zones": unexpected status code 401`)
+}
+
+func TestPowerDNSCleanUpRecordNotFound(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.Method + " " + r.URL.Path {
+		case "GET /api/v1/servers/localhost/zones":
+			io.WriteString(w, `[{"id":"example.com.","name":"example.com."}]`)
+		case "GET /api/v1/servers/localhost/zones/example.com.":
+			io.WriteString(w, `{"rrsets":[]}`)
+		default:
+			t.Errorf("unexpected request %s %s", r.Method, r.URL)
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	provider, err := NewDNSProviderCredentials(srv.URL, "123", "", "cert-manager-test")
+	assert.NoError(t, err)
+
+	assert.NoError(t, provider.CleanUp("example.com", "_acme-challenge.example.com.", "123d=="))
+}
+
+func TestPowerDNSPresent(t *testing.T) {
+	if !pdnsLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	provider, err := NewDNSProviderCredentials(pdnsAPIURL, pdnsAPIKey, pdnsServerID, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.Present(pdnsDomain, "_acme-challenge."+pdnsDomain+".", "123d==")
+	assert.NoError(t, err)
+}
+
+func TestPowerDNSCleanUp(t *testing.T) {
+	if !pdnsLiveTest {
+		t.Skip("skipping live test")
+	}
+
+	time.Sleep(time.Second * 2)
+
+	provider, err := NewDNSProviderCredentials(pdnsAPIURL, pdnsAPIKey, pdnsServerID, "cert-manager-test")
+	assert.NoError(t, err)
+
+	err = provider.CleanUp(pdnsDomain, "_acme-challenge."+pdnsDomain+".", "123d==")
+	assert.NoError(t, err)
+}
@@ -0,0 +1,140 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"os"
+	"strings"
+
+	"github.com/bodgit/tsig/gss"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/miekg/dns"
+
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// gssTSIG holds the Kerberos configuration used to authenticate updates with
+// GSS-TSIG.
+type gssTSIG struct {
+	username string
+	realm    string
+	keytab   []byte
+	// krb5Config is the Kerberos configuration for the realm, in krb5.conf
+	// format.
+	krb5Config string
+}
+
+// NewDNSProviderGSSTSIG returns a DNSProvider instance configured for rfc2136
+// dynamic update authenticated with GSS-TSIG (RFC 3645), as required by
+// Active Directory integrated zones. The keytab must contain keys for
+// username@realm, and the nameserver must be a hostname as the service
+// principal DNS/<nameserver host> is used. If no KDCs are given the
+// nameserver is used.
+func NewDNSProviderGSSTSIG(nameserver, username, realm string, keytabData []byte, kdcs []string) (*DNSProvider, error) {
+	d, err := NewDNSProviderCredentials(nameserver, "", "", "")
+	if err != nil {
+		return nil, err
+	}
+
+	host, _, err := net.SplitHostPort(d.nameserver)
+	if err != nil {
+		return nil, err
+	}
+	if net.ParseIP(host) != nil {
+		return nil, errors.New("the nameserver must be a hostname to authenticate with GSS-TSIG")
+	}
+	if len(kdcs) == 0 {
+		kdcs = []string{host}
+	}
+
+	kt := keytab.New()
+	if err := kt.Unmarshal(keytabData); err != nil {
+		return nil, fmt.Errorf("error loading Kerberos credentials: %w", err)
+	}
+	if !keytabHasPrincipal(kt, username, realm) {
+		return nil, fmt.Errorf("error loading Kerberos credentials: keytab contains no keys for principal %s@%s", username, realm)
+	}
+
+	d.gss = &gssTSIG{
+		username:   username,
+		realm:      realm,
+		keytab:     keytabData,
+		krb5Config: krb5Config(realm, kdcs),
+	}
+
+	logf.V(logf.DebugLevel).Infof("            gssTSIG principal: %s@%s\n", username, realm)
+	logf.V(logf.DebugLevel).Infof("            gssTSIG kdcs:      %s\n", strings.Join(kdcs, ", "))
+
+	return d, nil
+}
+
+func keytabHasPrincipal(kt *keytab.Keytab, username, realm string) bool {
+	for _, e := range kt.Entries {
+		if e.Principal.Realm == realm && strings.Join(e.Principal.Components, "/") == username {
+			return true
+		}
+	}
+	return false
+}
+
+// krb5Config returns a Kerberos configuration which uses the given KDCs for
+// realm, rather than discovering them with DNS.
+func krb5Config(realm string, kdcs []string) string {
+	var b strings.Builder
+	fmt.Fprintf(&b, "[libdefaults]\n  default_realm = %s\n  dns_lookup_kdc = false\n  dns_lookup_realm = false\n", realm)
+	fmt.Fprintf(&b, "[realms]\n  %s = {\n", realm)
+	for _, kdc := range kdcs {
+		fmt.Fprintf(&b, "    kdc = %s\n", kdc)
+	}
+	b.WriteString("  }\n")
+	return b.String()
+}
+
+// negotiate establishes a GSS-API security context with the nameserver using
+// a TKEY exchange. It returns the name of the negotiated key, and the client
+// which signs messages with it. The client must be closed once the update
+// has been sent, which deletes the context.
+func (g *gssTSIG) negotiate(nameserver string) (string, *gss.Client, error) {
+	// The keytab can only be loaded from a file.
+	f, err := os.CreateTemp("", "cert-manager-keytab-")
+	if err != nil {
+		return "", nil, err
+	}
+	defer os.Remove(f.Name())
+	_, err = f.Write(g.keytab)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return "", nil, err
+	}
+
+	// Kerberos tokens are usually too large for UDP.
+	c, err := gss.NewClient(&dns.Client{Net: "tcp"}, gss.WithConfig(g.krb5Config))
+	if err != nil {
+		return "", nil, err
+	}
+	keyName, _, err := c.NegotiateContextWithKeytab(nameserver, g.realm, g.username, f.Name())
+	if err != nil {
+		c.Close()
+		return "", nil, err
+	}
+	return keyName, c, nil
+}
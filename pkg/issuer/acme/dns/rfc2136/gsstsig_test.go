@@ -0,0 +1,100 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rfc2136
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jcmturner/gokrb5/v8/config"
+	"github.com/jcmturner/gokrb5/v8/iana/etypeID"
+	"github.com/jcmturner/gokrb5/v8/keytab"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// newTestKeytab returns a keytab containing an AES key for principal@EXAMPLE.COM.
+func newTestKeytab(t *testing.T, principal string) []byte {
+	kt := keytab.New()
+	require.NoError(t, kt.AddEntry(principal, "EXAMPLE.COM", "password", time.Now(), 1, etypeID.AES256_CTS_HMAC_SHA1_96))
+	b, err := kt.Marshal()
+	require.NoError(t, err)
+	return b
+}
+
+func TestNewDNSProviderGSSTSIG(t *testing.T) {
+	tests := map[string]struct {
+		nameserver string
+		keytab     []byte
+		kdcs       []string
+
+		wantErr  string
+		wantKDCs []string
+	}{
+		"an IP address nameserver is rejected": {
+			nameserver: "192.0.2.1:53",
+			keytab:     newTestKeytab(t, "cert-manager"),
+			wantErr:    "the nameserver must be a hostname to authenticate with GSS-TSIG",
+		},
+		"an invalid keytab is rejected": {
+			nameserver: "dc1.example.com",
+			keytab:     []byte{0x06, 0x02},
+			wantErr:    "error loading Kerberos credentials: invalid keytab data. First byte does not equal 5",
+		},
+		"a keytab without keys for the principal is rejected": {
+			nameserver: "dc1.example.com",
+			keytab:     newTestKeytab(t, "someone-else"),
+			wantErr:    "error loading Kerberos credentials: keytab contains no keys for principal cert-manager@EXAMPLE.COM",
+		},
+		"the nameserver is used as the KDC by default": {
+			nameserver: "dc1.example.com",
+			keytab:     newTestKeytab(t, "cert-manager"),
+			wantKDCs:   []string{"dc1.example.com:88"},
+		},
+		"the given KDCs are used": {
+			nameserver: "dc1.example.com",
+			keytab:     newTestKeytab(t, "cert-manager"),
+			kdcs:       []string{"dc2.example.com", "dc3.example.com:1088"},
+			wantKDCs:   []string{"dc2.example.com:88", "dc3.example.com:1088"},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			d, err := NewDNSProviderGSSTSIG(test.nameserver, "cert-manager", "EXAMPLE.COM", test.keytab, test.kdcs)
+			if test.wantErr != "" {
+				assert.EqualError(t, err, test.wantErr)
+				return
+			}
+			require.NoError(t, err)
+
+			cfg, err := config.NewFromString(d.gss.krb5Config)
+			require.NoError(t, err)
+			assert.Equal(t, "EXAMPLE.COM", cfg.LibDefaults.DefaultRealm)
+			_, kdcs, err := cfg.GetKDCs("EXAMPLE.COM", true)
+			require.NoError(t, err)
+			assert.ElementsMatch(t, test.wantKDCs, mapValues(kdcs))
+		})
+	}
+}
+
+func mapValues(m map[int]string) []string {
+	var values []string
+	for _, v := range m {
+		values = append(values, v)
+	}
+	return values
+}
@@ -33,6 +33,10 @@ import (
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 )
 
+// defaultKeytabSecretKey is the key of the keytab in the Secret referenced by
+// the GSS-TSIG configuration if none is given.
+const defaultKeytabSecretKey = "keytab"
+
 type Solver struct {
 	secretLister corelisters.SecretLister
 
@@ -148,6 +152,14 @@ func (s *Solver) buildDNSProvider(ch *whapi.ChallengeRequest) (*DNSProvider, err
 	}
 
 	l := s.secretLister.Secrets(ch.ResourceNamespace)
+	if cfg.GSSTSIG != nil {
+		keytab, err := loadSecretKeySelector(l, cfg.GSSTSIG.KeytabSecretRef, defaultKeytabSecretKey)
+		if err != nil {
+			return nil, err
+		}
+		return NewDNSProviderGSSTSIG(cfg.Nameserver, cfg.GSSTSIG.Username, cfg.GSSTSIG.Realm, keytab, cfg.GSSTSIG.KDCs)
+	}
+
 	secret, err := loadSecretKeySelector(l, cfg.TSIGSecret, "")
 	if err != nil {
 		return nil, err
@@ -26,6 +26,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/bodgit/tsig"
 	"github.com/miekg/dns"
 
 	"github.com/cert-manager/cert-manager/internal/apis/certmanager/validation/util"
@@ -47,6 +48,10 @@ type DNSProvider struct {
 	tsigAlgorithm string
 	tsigKeyName   string
 	tsigSecret    string
+
+	// gss is set when updates are authenticated with GSS-TSIG rather than
+	// a shared TSIG key.
+	gss *gssTSIG
 }
 
 // NewDNSProviderCredentials uses the supplied credentials to return a
@@ -129,7 +134,15 @@ func (r *DNSProvider) changeRecord(action, fqdn, zone, value string, ttl int) er
 	c.TsigProvider = tsigHMACProvider(r.tsigSecret)
 	c.SingleInflight = true
 	// TSIG authentication / msg signing
-	if len(r.tsigKeyName) > 0 && len(r.tsigSecret) > 0 {
+	if r.gss != nil {
+		keyName, gssClient, err := r.gss.negotiate(r.nameserver)
+		if err != nil {
+			return fmt.Errorf("GSS-TSIG negotiation failed: %v", err)
+		}
+		defer gssClient.Close()
+		m.SetTsig(keyName, tsig.GSS, 300, time.Now().Unix())
+		c.TsigProvider = gssClient
+	} else if len(r.tsigKeyName) > 0 && len(r.tsigSecret) > 0 {
 		m.SetTsig(dns.Fqdn(r.tsigKeyName), r.tsigAlgorithm, 300, time.Now().Unix())
 		c.TsigSecret = map[string]string{dns.Fqdn(r.tsigKeyName): r.tsigSecret}
 	}
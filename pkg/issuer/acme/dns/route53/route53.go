@@ -44,12 +44,20 @@ type DNSProvider struct {
 	userAgent string
 }
 
+// AssumeRole is a role assumed as part of a role chain, along with the
+// external ID required by its trust policy, if any.
+type AssumeRole struct {
+	Role       string
+	ExternalID string
+}
+
 type sessionProvider struct {
 	AccessKeyID     string
 	SecretAccessKey string
 	Ambient         bool
 	Region          string
 	Role            string
+	RoleChain       []AssumeRole
 	StsProvider     func(*session.Session) stsiface.STSAPI
 	log             logr.Logger
 	userAgent       string
@@ -89,15 +97,26 @@ func (d *sessionProvider) GetSession() (*session.Session, error) {
 		return nil, fmt.Errorf("unable to create aws session: %s", err)
 	}
 
+	// Each role is assumed using the credentials of the previous one, so that
+	// a role in a separate DNS account can be reached through intermediate
+	// roles.
+	roles := d.RoleChain
 	if d.Role != "" {
-		d.log.V(logf.DebugLevel).WithValues("role", d.Role).Info("assuming role")
-		stsSvc := d.StsProvider(sess)
-		result, err := stsSvc.AssumeRole(&sts.AssumeRoleInput{
-			RoleArn:         aws.String(d.Role),
+		roles = append([]AssumeRole{{Role: d.Role}}, roles...)
+	}
+	for _, role := range roles {
+		d.log.V(logf.DebugLevel).WithValues("role", role.Role).Info("assuming role")
+		input := &sts.AssumeRoleInput{
+			RoleArn:         aws.String(role.Role),
 			RoleSessionName: aws.String("cert-manager"),
-		})
+		}
+		if role.ExternalID != "" {
+			input.ExternalId = aws.String(role.ExternalID)
+		}
+		stsSvc := d.StsProvider(sess)
+		result, err := stsSvc.AssumeRole(input)
 		if err != nil {
-			return nil, fmt.Errorf("unable to assume role: %s", err)
+			return nil, fmt.Errorf("unable to assume role %q: %s", role.Role, err)
 		}
 
 		creds := credentials.Value{
@@ -124,13 +143,14 @@ func (d *sessionProvider) GetSession() (*session.Session, error) {
 	return sess, nil
 }
 
-func newSessionProvider(accessKeyID, secretAccessKey, region, role string, ambient bool, userAgent string) (*sessionProvider, error) {
+func newSessionProvider(accessKeyID, secretAccessKey, region, role string, roleChain []AssumeRole, ambient bool, userAgent string) (*sessionProvider, error) {
 	return &sessionProvider{
 		AccessKeyID:     accessKeyID,
 		SecretAccessKey: secretAccessKey,
 		Ambient:         ambient,
 		Region:          region,
 		Role:            role,
+		RoleChain:       roleChain,
 		StsProvider:     defaultSTSProvider,
 		log:             logf.Log.WithName("route53-session-provider"),
 		userAgent:       userAgent,
@@ -144,12 +164,15 @@ func defaultSTSProvider(sess *session.Session) stsiface.STSAPI {
 // NewDNSProvider returns a DNSProvider instance configured for the AWS
 // Route 53 service using static credentials from its parameters or, if they're
 // unset and the 'ambient' option is set, credentials from the environment.
+// If role or roleChain are set, the roles are assumed in order before the
+// Route 53 client is created.
 func NewDNSProvider(accessKeyID, secretAccessKey, hostedZoneID, region, role string,
+	roleChain []AssumeRole,
 	ambient bool,
 	dns01Nameservers []string,
 	userAgent string,
 ) (*DNSProvider, error) {
-	provider, err := newSessionProvider(accessKeyID, secretAccessKey, region, role, ambient, userAgent)
+	provider, err := newSessionProvider(accessKeyID, secretAccessKey, region, role, roleChain, ambient, userAgent)
 	if err != nil {
 		return nil, err
 	}
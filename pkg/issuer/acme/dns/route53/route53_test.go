@@ -70,7 +70,7 @@ func TestAmbientCredentialsFromEnv(t *testing.T) {
 	os.Setenv("AWS_REGION", "us-east-1")
 	defer restoreRoute53Env()
 
-	provider, err := NewDNSProvider("", "", "", "", "", true, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider("", "", "", "", "", nil, true, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	_, err = provider.client.Config.Credentials.Get()
@@ -84,7 +84,7 @@ func TestNoCredentialsFromEnv(t *testing.T) {
 	os.Setenv("AWS_REGION", "us-east-1")
 	defer restoreRoute53Env()
 
-	_, err := NewDNSProvider("", "", "", "", "", false, util.RecursiveNameservers, "cert-manager-test")
+	_, err := NewDNSProvider("", "", "", "", "", nil, false, util.RecursiveNameservers, "cert-manager-test")
 	assert.Error(t, err, "Expected error constructing DNSProvider with no credentials and not ambient")
 }
 
@@ -92,7 +92,7 @@ func TestAmbientRegionFromEnv(t *testing.T) {
 	os.Setenv("AWS_REGION", "us-east-1")
 	defer restoreRoute53Env()
 
-	provider, err := NewDNSProvider("", "", "", "", "", true, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider("", "", "", "", "", nil, true, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	assert.Equal(t, "us-east-1", *provider.client.Config.Region, "Expected Region to be set from environment")
@@ -102,7 +102,7 @@ func TestNoRegionFromEnv(t *testing.T) {
 	os.Setenv("AWS_REGION", "us-east-1")
 	defer restoreRoute53Env()
 
-	provider, err := NewDNSProvider("marx", "swordfish", "", "", "", false, util.RecursiveNameservers, "cert-manager-test")
+	provider, err := NewDNSProvider("marx", "swordfish", "", "", "", nil, false, util.RecursiveNameservers, "cert-manager-test")
 	assert.NoError(t, err, "Expected no error constructing DNSProvider")
 
 	assert.Equal(t, "", *provider.client.Config.Region, "Expected Region to not be set from environment")
@@ -260,6 +260,73 @@ func TestAssumeRole(t *testing.T) {
 	}
 }
 
+func TestAssumeRoleChain(t *testing.T) {
+	var assumed []sts.AssumeRoleInput
+	var callerKeys []string
+	stsProvider := func(sess *session.Session) stsiface.STSAPI {
+		return &mockSTS{
+			AssumeRoleFn: func(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+				// Record the credentials each role is assumed with.
+				callerCreds, err := sess.Config.Credentials.Get()
+				if err != nil {
+					return nil, err
+				}
+				callerKeys = append(callerKeys, callerCreds.AccessKeyID)
+				assumed = append(assumed, *input)
+				return &sts.AssumeRoleOutput{
+					Credentials: &sts.Credentials{
+						AccessKeyId:     aws.String("key-" + *input.RoleArn),
+						SecretAccessKey: aws.String("secret-" + *input.RoleArn),
+						SessionToken:    aws.String("token"),
+					},
+				}, nil
+			},
+		}
+	}
+
+	provider, err := makeMockSessionProvider(stsProvider, "key", "secret", "eu-central-1", "role-a", false)
+	require.NoError(t, err)
+	provider.RoleChain = []AssumeRole{
+		{Role: "role-b", ExternalID: "external-b"},
+		{Role: "role-c"},
+	}
+
+	sess, err := provider.GetSession()
+	require.NoError(t, err)
+
+	require.Len(t, assumed, 3)
+	assert.Equal(t, "role-a", *assumed[0].RoleArn)
+	assert.Nil(t, assumed[0].ExternalId)
+	assert.Equal(t, "role-b", *assumed[1].RoleArn)
+	assert.Equal(t, "external-b", *assumed[1].ExternalId)
+	assert.Equal(t, "role-c", *assumed[2].RoleArn)
+	assert.Nil(t, assumed[2].ExternalId)
+	assert.Equal(t, []string{"key", "key-role-a", "key-role-b"}, callerKeys)
+
+	sessCreds, err := sess.Config.Credentials.Get()
+	require.NoError(t, err)
+	assert.Equal(t, "key-role-c", sessCreds.AccessKeyID)
+
+	// A failure part way through the chain is reported with the role.
+	provider.RoleChain = []AssumeRole{{Role: "role-b"}}
+	provider.StsProvider = func(sess *session.Session) stsiface.STSAPI {
+		return &mockSTS{
+			AssumeRoleFn: func(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error) {
+				if *input.RoleArn == "role-b" {
+					return nil, fmt.Errorf("access denied")
+				}
+				return &sts.AssumeRoleOutput{Credentials: &sts.Credentials{
+					AccessKeyId:     aws.String("a"),
+					SecretAccessKey: aws.String("b"),
+					SessionToken:    aws.String("c"),
+				}}, nil
+			},
+		}
+	}
+	_, err = provider.GetSession()
+	assert.EqualError(t, err, `unable to assume role "role-b": access denied`)
+}
+
 type mockSTS struct {
 	*sts.STS
 	AssumeRoleFn func(input *sts.AssumeRoleInput) (*sts.AssumeRoleOutput, error)
@@ -0,0 +1,86 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/miekg/dns"
+)
+
+// dohMediaType is the media type of DNS-over-HTTPS requests and responses, as
+// defined in RFC 8484 section 6.
+const dohMediaType = "application/dns-message"
+
+// dohClient is the HTTP client used to send DNS-over-HTTPS queries.
+var dohClient = http.DefaultClient
+
+// IsDoHNameserver returns true if the nameserver is a DNS-over-HTTPS resolver
+// URL rather than a host and port.
+func IsDoHNameserver(nameserver string) bool {
+	return strings.HasPrefix(nameserver, "https://")
+}
+
+// dohExchange sends the query to the DNS-over-HTTPS resolver at url using
+// the POST method described in RFC 8484 section 4.1.
+func dohExchange(m *dns.Msg, url string) (*dns.Msg, error) {
+	// RFC 8484 recommends an ID of 0 so that responses are cacheable.
+	query := m.Copy()
+	query.Id = 0
+	packed, err := query.Pack()
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), DNSTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(packed))
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Content-Type", dohMediaType)
+	req.Header.Set("Accept", dohMediaType)
+
+	resp, err := dohClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("DNS-over-HTTPS resolver %s returned HTTP status %d", url, resp.StatusCode)
+	}
+	if ct := resp.Header.Get("Content-Type"); ct != dohMediaType {
+		return nil, fmt.Errorf("DNS-over-HTTPS resolver %s returned unexpected content type %q", url, ct)
+	}
+
+	body, err := io.ReadAll(io.LimitReader(resp.Body, dns.MaxMsgSize))
+	if err != nil {
+		return nil, err
+	}
+	in := new(dns.Msg)
+	if err := in.Unpack(body); err != nil {
+		return nil, fmt.Errorf("error decoding response from DNS-over-HTTPS resolver %s: %v", url, err)
+	}
+	in.Id = m.Id
+	return in, nil
+}
@@ -0,0 +1,94 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package util
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/miekg/dns"
+	"github.com/stretchr/testify/assert"
+)
+
+func newDoHServer(t *testing.T, txt string) *httptest.Server {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/unavailable" {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		if r.Method != http.MethodPost || r.Header.Get("Content-Type") != dohMediaType {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		query := new(dns.Msg)
+		if err := query.Unpack(body); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		if query.Id != 0 {
+			t.Errorf("expected DoH query ID to be 0, got %d", query.Id)
+		}
+
+		reply := new(dns.Msg)
+		reply.SetReply(query)
+		reply.Answer = append(reply.Answer, &dns.TXT{
+			Hdr: dns.RR_Header{Name: query.Question[0].Name, Rrtype: dns.TypeTXT, Class: dns.ClassINET, Ttl: 60},
+			Txt: []string{txt},
+		})
+		packed, err := reply.Pack()
+		if err != nil {
+			t.Fatal(err)
+		}
+		w.Header().Set("Content-Type", dohMediaType)
+		_, _ = w.Write(packed)
+	}))
+
+	oldClient := dohClient
+	dohClient = server.Client()
+	t.Cleanup(func() {
+		dohClient = oldClient
+		server.Close()
+	})
+	return server
+}
+
+func TestDNSQueryDoH(t *testing.T) {
+	server := newDoHServer(t, "challenge-token")
+
+	in, err := DNSQuery("_acme-challenge.example.com.", dns.TypeTXT, []string{server.URL + "/dns-query"}, true)
+	assert.NoError(t, err)
+	if assert.Len(t, in.Answer, 1) {
+		assert.Equal(t, []string{"challenge-token"}, in.Answer[0].(*dns.TXT).Txt)
+	}
+
+	ok, err := checkAuthoritativeNss("_acme-challenge.example.com.", "challenge-token", []string{server.URL + "/dns-query"})
+	assert.NoError(t, err)
+	assert.True(t, ok)
+}
+
+func TestDNSQueryDoHErrorStatus(t *testing.T) {
+	server := newDoHServer(t, "challenge-token")
+
+	_, err := DNSQuery("_acme-challenge.example.com.", dns.TypeTXT, []string{server.URL + "/unavailable"}, true)
+	assert.EqualError(t, err, "DNS-over-HTTPS resolver "+server.URL+"/unavailable returned HTTP status 503")
+}
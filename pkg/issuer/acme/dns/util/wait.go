@@ -9,6 +9,7 @@ this directory.
 package util
 
 import (
+	"errors"
 	"fmt"
 	"net"
 	"strings"
@@ -41,6 +42,10 @@ const defaultResolvConf = "/etc/resolv.conf"
 const issueTag = "issue"
 const issuewildTag = "issuewild"
 
+// ErrCAARecordMismatch is returned by ValidateCAA when CAA records exist for
+// a domain but none of them authorize the given issuer identities.
+var ErrCAARecordMismatch = errors.New("CAA record does not match issuer")
+
 var defaultNameservers = []string{
 	"8.8.8.8:53",
 	"8.8.4.4:53",
@@ -158,7 +163,8 @@ func checkAuthoritativeNss(fqdn, value string, nameservers []string) (bool, erro
 }
 
 // DNSQuery will query a nameserver, iterating through the supplied servers as it retries
-// The nameserver should include a port, to facilitate testing where we talk to a mock dns server.
+// The nameserver should include a port, to facilitate testing where we talk to a mock dns server,
+// or be an https:// URL to query a DNS-over-HTTPS resolver.
 func DNSQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (in *dns.Msg, err error) {
 	m := new(dns.Msg)
 	m.SetQuestion(fqdn, rtype)
@@ -171,6 +177,13 @@ func DNSQuery(fqdn string, rtype uint16, nameservers []string, recursive bool) (
 	// Will retry the request based on the number of servers (n+1)
 	for i := 1; i <= len(nameservers)+1; i++ {
 		ns := nameservers[i%len(nameservers)]
+		if IsDoHNameserver(ns) {
+			in, err = dohExchange(m, ns)
+			if err == nil {
+				break
+			}
+			continue
+		}
 		udp := &dns.Client{Net: "udp", Timeout: DNSTimeout}
 		in, _, err = udp.Exchange(m, ns)
 
@@ -266,7 +279,7 @@ func ValidateCAA(domain string, issuerID []string, iswildcard bool, nameservers
 
 	if !matchCAA(caas, issuerSet, iswildcard) {
 		// TODO(dmo): better error message
-		return fmt.Errorf("CAA record does not match issuer")
+		return ErrCAARecordMismatch
 	}
 	return nil
 }
@@ -20,14 +20,25 @@ import (
 	"errors"
 	"testing"
 
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/acmedns"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/alidns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/azuredns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/clouddns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/cloudflare"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/coredns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/digitalocean"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/dnsimple"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/gandi"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/ibmcis"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/linode"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/ns1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/oci"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/powerdns"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/route53"
 	"github.com/cert-manager/cert-manager/pkg/issuer/acme/dns/util"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
@@ -139,12 +150,12 @@ func newFakeDNSProviders() *fakeDNSProviders {
 			}
 			return nil, nil
 		},
-		route53: func(accessKey, secretKey, hostedZoneID, region, role string, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error) {
-			f.call("route53", accessKey, secretKey, hostedZoneID, region, role, ambient, util.RecursiveNameservers)
+		route53: func(accessKey, secretKey, hostedZoneID, region, role string, roleChain []route53.AssumeRole, ambient bool, dns01Nameservers []string, userAgent string) (*route53.DNSProvider, error) {
+			f.call("route53", accessKey, secretKey, hostedZoneID, region, role, roleChain, ambient, util.RecursiveNameservers)
 			return nil, nil
 		},
-		azureDNS: func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity) (*azuredns.DNSProvider, error) {
-			f.call("azuredns", clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName, util.RecursiveNameservers, ambient, managedIdentity)
+		azureDNS: func(environment, clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName string, dns01Nameservers []string, ambient bool, managedIdentity *cmacme.AzureManagedIdentity, federatedToken azuredns.FederatedTokenFunc) (*azuredns.DNSProvider, error) {
+			f.call("azuredns", clientID, clientSecret, subscriptionID, tenantID, resourceGroupName, hostedZoneName, util.RecursiveNameservers, ambient, managedIdentity, federatedToken != nil)
 			return nil, nil
 		},
 		acmeDNS: func(host string, accountJson []byte, dns01Nameservers []string) (*acmedns.DNSProvider, error) {
@@ -155,6 +166,42 @@ func newFakeDNSProviders() *fakeDNSProviders {
 			f.call("digitalocean", token, util.RecursiveNameservers)
 			return nil, nil
 		},
+		gandi: func(token string, dns01Nameservers []string, userAgent string) (*gandi.DNSProvider, error) {
+			f.call("gandi", token, util.RecursiveNameservers)
+			return nil, nil
+		},
+		linode: func(token string, userAgent string) (*linode.DNSProvider, error) {
+			f.call("linode", token)
+			return nil, nil
+		},
+		ns1: func(apiKey, endpoint string, dns01Nameservers []string, userAgent string) (*ns1.DNSProvider, error) {
+			f.call("ns1", apiKey, endpoint, util.RecursiveNameservers)
+			return nil, nil
+		},
+		powerDNS: func(host, apiKey, serverID string, userAgent string) (*powerdns.DNSProvider, error) {
+			f.call("powerdns", host, apiKey, serverID)
+			return nil, nil
+		},
+		coreDNS: func(client corev1client.ConfigMapsGetter, namespace, name, key, zone string) (*coredns.DNSProvider, error) {
+			f.call("coredns", namespace, name, key, zone)
+			return nil, nil
+		},
+		oci: func(region, compartmentID, authType, tenancyID, userID, fingerprint string, privateKey []byte, ambient bool, dns01Nameservers []string, userAgent string) (*oci.DNSProvider, error) {
+			f.call("oci", region, compartmentID, authType, tenancyID, userID, fingerprint, privateKey, ambient, util.RecursiveNameservers)
+			return nil, nil
+		},
+		aliDNS: func(accessKeyID, accessKeySecret, ramRole, roleARN, regionID string, ambient bool, dns01Nameservers []string, userAgent string) (*alidns.DNSProvider, error) {
+			f.call("alidns", accessKeyID, accessKeySecret, ramRole, roleARN, regionID, ambient, util.RecursiveNameservers)
+			return nil, nil
+		},
+		ibmCIS: func(apiKey, crn string, dns01Nameservers []string, userAgent string) (*ibmcis.DNSProvider, error) {
+			f.call("ibmcis", apiKey, crn, util.RecursiveNameservers)
+			return nil, nil
+		},
+		dnsimple: func(apiToken, accountID string, sandbox bool, dns01Nameservers []string, userAgent string) (*dnsimple.DNSProvider, error) {
+			f.call("dnsimple", apiToken, accountID, sandbox, util.RecursiveNameservers)
+			return nil, nil
+		},
 	}
 	return f
 }
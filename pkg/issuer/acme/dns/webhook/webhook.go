@@ -18,10 +18,16 @@ package webhook
 
 import (
 	"context"
+	"crypto/tls"
+	"crypto/x509"
 	"encoding/json"
 	"errors"
 	"fmt"
+	"sync"
 
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
@@ -29,6 +35,7 @@ import (
 	"k8s.io/client-go/rest"
 
 	"github.com/cert-manager/cert-manager/pkg/acme/webhook/apis/acme/v1alpha1"
+	"github.com/cert-manager/cert-manager/pkg/acme/webhook/grpcsolver"
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/scheme"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -36,6 +43,20 @@ import (
 
 type Webhook struct {
 	restConfigShallowCopy rest.Config
+
+	// grpcConns holds a connection for each gRPC endpoint that has been
+	// called, so that connections are reused across challenges.
+	grpcConnsLock sync.Mutex
+	grpcConns     map[grpcEndpoint]*grpc.ClientConn
+}
+
+// grpcEndpoint identifies a gRPC endpoint along with the TLS settings used
+// to connect to it.
+type grpcEndpoint struct {
+	address    string
+	caBundle   string
+	serverName string
+	insecure   bool
 }
 
 func (r *Webhook) Name() string {
@@ -44,7 +65,20 @@ func (r *Webhook) Name() string {
 
 // Present creates a TXT record using the specified parameters
 func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionPresent)
+	cfg, err := loadConfig(*ch.Config)
+	if err != nil {
+		return err
+	}
+
+	if cfg.GRPC != nil {
+		cl, err := r.grpcClient(cfg.GRPC)
+		if err != nil {
+			return err
+		}
+		return cl.Present(context.TODO(), cfg.SolverName, solverRequest(ch, cfg))
+	}
+
+	cl, pl, solverName, err := r.buildPayload(ch, cfg, v1alpha1.ChallengeActionPresent)
 	if err != nil {
 		return err
 	}
@@ -80,7 +114,20 @@ func (r *Webhook) Present(ch *v1alpha1.ChallengeRequest) error {
 
 // CleanUp removes the TXT record matching the specified parameters
 func (r *Webhook) CleanUp(ch *v1alpha1.ChallengeRequest) error {
-	cl, pl, solverName, err := r.buildPayload(ch, v1alpha1.ChallengeActionCleanUp)
+	cfg, err := loadConfig(*ch.Config)
+	if err != nil {
+		return err
+	}
+
+	if cfg.GRPC != nil {
+		cl, err := r.grpcClient(cfg.GRPC)
+		if err != nil {
+			return err
+		}
+		return cl.CleanUp(context.TODO(), cfg.SolverName, solverRequest(ch, cfg))
+	}
+
+	cl, pl, solverName, err := r.buildPayload(ch, cfg, v1alpha1.ChallengeActionCleanUp)
 	if err != nil {
 		return err
 	}
@@ -130,16 +177,7 @@ func (r *Webhook) Initialize(kubeClientConfig *rest.Config, stopCh <-chan struct
 	return nil
 }
 
-func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.ChallengeAction) (*rest.RESTClient, *v1alpha1.ChallengePayload, string, error) {
-	// create a copy just to be certain we don't modify something unexpectedly
-	req := ch.DeepCopy()
-
-	// extract the complete solver config, including groupName and solverName
-	cfg, err := loadConfig(*req.Config)
-	if err != nil {
-		return nil, nil, "", err
-	}
-
+func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, cfg *cmacme.ACMEIssuerDNS01ProviderWebhook, action v1alpha1.ChallengeAction) (*rest.RESTClient, *v1alpha1.ChallengePayload, string, error) {
 	// obtain a REST client that can be used to communicate with the webhook
 	cl, err := r.restClientForGroup(cfg.GroupName)
 	if err != nil {
@@ -148,16 +186,24 @@ func (r *Webhook) buildPayload(ch *v1alpha1.ChallengeRequest, action v1alpha1.Ch
 
 	// build the ChallengePayload resource
 	pl := &v1alpha1.ChallengePayload{
-		Request: req,
+		Request: solverRequest(ch, cfg),
 	}
 	pl.Request.Action = action
+
+	return cl, pl, cfg.SolverName, nil
+}
+
+// solverRequest returns a copy of the ChallengeRequest to be sent to the
+// solver.
+func solverRequest(ch *v1alpha1.ChallengeRequest, cfg *cmacme.ACMEIssuerDNS01ProviderWebhook) *v1alpha1.ChallengeRequest {
+	// create a copy just to be certain we don't modify something unexpectedly
+	req := ch.DeepCopy()
 	// When using the webhook provider, the 'config' on the ChallengeRequest
 	// will be the complete marshaled configuration as specified on the issuer.
 	// Instead of passing all this extra config along, we instead extract out
 	// only the 'config' field and submit that to the webhook.
-	pl.Request.Config = cfg.Config
-
-	return cl, pl, cfg.SolverName, nil
+	req.Config = cfg.Config
+	return req
 }
 
 func loadConfig(cfgJSON apiextensionsv1.JSON) (*cmacme.ACMEIssuerDNS01ProviderWebhook, error) {
@@ -178,3 +224,46 @@ func (r *Webhook) restClientForGroup(g string) (*rest.RESTClient, error) {
 
 	return rest.RESTClientFor(&cfg)
 }
+
+// grpcClient returns a client for the DNS01Solver service at the configured
+// endpoint, reusing an existing connection if there is one.
+func (r *Webhook) grpcClient(cfg *cmacme.ACMEIssuerDNS01ProviderWebhookGRPC) (*grpcsolver.Client, error) {
+	endpoint := grpcEndpoint{
+		address:    cfg.Address,
+		caBundle:   string(cfg.CABundle),
+		serverName: cfg.ServerName,
+		insecure:   cfg.Insecure,
+	}
+
+	r.grpcConnsLock.Lock()
+	defer r.grpcConnsLock.Unlock()
+
+	if conn, ok := r.grpcConns[endpoint]; ok {
+		return grpcsolver.NewClient(conn), nil
+	}
+
+	creds := insecure.NewCredentials()
+	if !cfg.Insecure {
+		tlsConfig := &tls.Config{ServerName: cfg.ServerName}
+		if len(cfg.CABundle) > 0 {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(cfg.CABundle) {
+				return nil, fmt.Errorf("no certificates found in caBundle for gRPC endpoint %q", cfg.Address)
+			}
+		}
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	// Dial does not block, connection errors are returned by the first call
+	conn, err := grpc.Dial(cfg.Address, grpc.WithTransportCredentials(creds))
+	if err != nil {
+		return nil, fmt.Errorf("error connecting to gRPC endpoint %q: %v", cfg.Address, err)
+	}
+
+	if r.grpcConns == nil {
+		r.grpcConns = make(map[grpcEndpoint]*grpc.ClientConn)
+	}
+	r.grpcConns[endpoint] = conn
+
+	return grpcsolver.NewClient(conn), nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+)
+
+// HealthCheck verifies that the directory of the issuer's ACME server can be
+// fetched. The account key is not needed to fetch the directory, so the
+// check does not depend on the account having been registered.
+func (a *Acme) HealthCheck(ctx context.Context) error {
+	spec := a.issuer.GetSpec().ACME
+
+	httpClient := accounts.BuildHTTPClient(a.metrics, spec.SkipTLSVerify)
+	cl := a.clientBuilder(httpClient, *spec, nil, a.userAgent)
+	if _, err := cl.Discover(ctx); err != nil {
+		return fmt.Errorf("failed to fetch the directory of ACME server %q: %w", spec.Server, err)
+	}
+
+	return nil
+}
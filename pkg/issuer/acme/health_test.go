@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/rsa"
+	"errors"
+	"net/http"
+	"testing"
+
+	acmeapi "golang.org/x/crypto/acme"
+
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestAcme_HealthCheck(t *testing.T) {
+	tests := map[string]struct {
+		discoverErr error
+		expectErr   bool
+	}{
+		"the directory can be fetched": {},
+		"the directory cannot be fetched": {
+			discoverErr: errors.New("connection refused"),
+			expectErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			a := &Acme{
+				issuer: gen.Issuer("test-issuer", gen.SetIssuerACMEURL(acmev2Prod)),
+				clientBuilder: func(_ *http.Client, _ cmacme.ACMEIssuer, _ *rsa.PrivateKey, _ string) acmecl.Interface {
+					return &acmecl.FakeACME{
+						FakeDiscover: func(context.Context) (acmeapi.Directory, error) {
+							return acmeapi.Directory{}, test.discoverErr
+						},
+					}
+				},
+			}
+
+			err := a.HealthCheck(context.Background())
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got %v", test.expectErr, err)
+			}
+		})
+	}
+}
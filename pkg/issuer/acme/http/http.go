@@ -29,6 +29,7 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/dynamic"
 	corev1listers "k8s.io/client-go/listers/core/v1"
 	k8snet "k8s.io/utils/net"
 	gwapilisters "sigs.k8s.io/gateway-api/pkg/client/listers/gateway/apis/v1alpha2"
@@ -47,6 +48,9 @@ const (
 	HTTP01Timeout = time.Minute * 15
 	// acmeSolverListenPort is the port acmesolver should listen on
 	acmeSolverListenPort = 8089
+	// maxRedirects is the maximum number of redirects followed when
+	// validating HTTP01 challenges, taken from Boulder
+	maxRedirects = 10
 
 	loggerName = "http01"
 )
@@ -64,6 +68,7 @@ type Solver struct {
 	ingressLister        ingress.InternalIngressLister
 	ingressCreateUpdater ingress.InternalIngressCreateUpdater
 	httpRouteLister      gwapilisters.HTTPRouteLister
+	dynamicClient        dynamic.Interface
 
 	testReachability reachabilityTest
 	requiredPasses   int
@@ -81,6 +86,15 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 	if err != nil {
 		return nil, err
 	}
+	// Istio VirtualServices are managed with the dynamic client, which can
+	// only be constructed when a REST config is available.
+	var dynamicClient dynamic.Interface
+	if ctx.RESTConfig != nil {
+		dynamicClient, err = dynamic.NewForConfig(ctx.RESTConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
 	return &Solver{
 		Context:              ctx,
 		podLister:            ctx.KubeSharedInformerFactory.Core().V1().Pods().Lister(),
@@ -88,6 +102,7 @@ func NewSolver(ctx *controller.Context) (*Solver, error) {
 		ingressLister:        ingressLister,
 		ingressCreateUpdater: ingressCreateUpdater,
 		httpRouteLister:      ctx.GWShared.Gateway().V1alpha2().HTTPRoutes().Lister(),
+		dynamicClient:        dynamicClient,
 		testReachability:     testReachability,
 		requiredPasses:       5,
 	}, nil
@@ -108,7 +123,10 @@ func getServiceType(ch *cmacme.Challenge) (corev1.ServiceType, error) {
 	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.GatewayHTTPRoute != nil {
 		return ch.Spec.Solver.HTTP01.GatewayHTTPRoute.ServiceType, nil
 	}
-	return "", fmt.Errorf("neither HTTP01 Ingress nor Gateway solvers were found")
+	if ch.Spec.Solver.HTTP01 != nil && ch.Spec.Solver.HTTP01.IstioVirtualService != nil {
+		return ch.Spec.Solver.HTTP01.IstioVirtualService.ServiceType, nil
+	}
+	return "", fmt.Errorf("no HTTP01 Ingress, Gateway or Istio VirtualService solvers were found")
 }
 
 // Present will realise the resources required to solve the given HTTP01
@@ -123,7 +141,7 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 	if svcErr != nil {
 		return utilerrors.NewAggregate([]error{podErr, svcErr})
 	}
-	var ingressErr, gatewayErr error
+	var ingressErr, gatewayErr, virtualServiceErr error
 	if ch.Spec.Solver.HTTP01 != nil {
 		if ch.Spec.Solver.HTTP01.Ingress != nil {
 			_, ingressErr = s.ensureIngress(ctx, ch, svc.Name)
@@ -133,6 +151,10 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 			_, gatewayErr = s.ensureGatewayHTTPRoute(ctx, ch, svc.Name)
 			return utilerrors.NewAggregate([]error{podErr, svcErr, gatewayErr})
 		}
+		if ch.Spec.Solver.HTTP01.IstioVirtualService != nil {
+			_, virtualServiceErr = s.ensureIstioVirtualService(ctx, ch, svc.Name)
+			return utilerrors.NewAggregate([]error{podErr, svcErr, virtualServiceErr})
+		}
 	}
 	return utilerrors.NewAggregate(
 		[]error{
@@ -140,7 +162,8 @@ func (s *Solver) Present(ctx context.Context, issuer v1.GenericIssuer, ch *cmacm
 			svcErr,
 			ingressErr,
 			gatewayErr,
-			fmt.Errorf("couldn't Present challenge %s/%s: no Ingress nor Gateway HTTP01 solvers were specified", ch.Namespace, ch.Name),
+			virtualServiceErr,
+			fmt.Errorf("couldn't Present challenge %s/%s: no Ingress, Gateway or Istio VirtualService HTTP01 solvers were specified", ch.Namespace, ch.Name),
 		},
 	)
 }
@@ -184,13 +207,14 @@ func (s *Solver) Check(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.
 	return nil
 }
 
-// CleanUp will ensure the created service, ingress and pod are clean/deleted of any
+// CleanUp will ensure the created service, ingress, virtualservice and pod are clean/deleted of any
 // cert-manager created data.
 func (s *Solver) CleanUp(ctx context.Context, issuer v1.GenericIssuer, ch *cmacme.Challenge) error {
 	var errs []error
 	errs = append(errs, s.cleanupPods(ctx, ch))
 	errs = append(errs, s.cleanupServices(ctx, ch))
 	errs = append(errs, s.cleanupIngresses(ctx, ch))
+	errs = append(errs, s.cleanupIstioVirtualServices(ctx, ch))
 	return utilerrors.NewAggregate(errs)
 }
 
@@ -228,10 +252,9 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 	// For further reading, the spec also discusses redirect following in section 10.2:
 	// https://datatracker.ietf.org/doc/html/rfc8555#section-10.2
 
-	// TODO: Since Let's Encrypt will only accept redirects to port 80 and port 443, and we follow
-	// any redirect here, this could lead to a failure mode where we determine that the endpoint is reachable
-	// but it'll certainly fail when tried by the actual verifier; it's an edge case, but we might be able
-	// to handle this better.
+	// Let's Encrypt will only accept redirects to port 80 and port 443, so we refuse to follow any
+	// other redirect in checkRedirect. Otherwise we could determine that the endpoint is reachable
+	// when it'll certainly fail when tried by the actual verifier.
 
 	// The timeouts here are inspired by the timeouts used by Boulder - i.e., Let's Encrypt - when
 	// validating HTTP01 challenges for real.
@@ -291,8 +314,9 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 		}
 	}
 	client := &http.Client{
-		Transport: transport,
-		Timeout:   time.Second * 10,
+		Transport:     transport,
+		CheckRedirect: checkRedirect,
+		Timeout:       time.Second * 10,
 	}
 
 	response, err := client.Do(req)
@@ -303,7 +327,7 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 
 	if response.StatusCode != http.StatusOK {
 		log.V(logf.DebugLevel).Info("received HTTP status code was not StatusOK (200)", "code", response.StatusCode)
-		return fmt.Errorf("wrong status code '%d', expected '%d'", response.StatusCode, http.StatusOK)
+		return fmt.Errorf("wrong status code '%d', expected '%d'%s", response.StatusCode, http.StatusOK, describeRouting(url, response))
 	}
 
 	defer response.Body.Close()
@@ -322,10 +346,45 @@ func testReachability(ctx context.Context, url *url.URL, key string, dnsServers
 			keyToPrint = strings.TrimSpace(keyToPrint[:24]) + "... (truncated)"
 		}
 		log.V(logf.DebugLevel).Info("key returned by server did not match expected", "actual", keyToPrint, "expected", key)
-		return fmt.Errorf("did not get expected response when querying endpoint, expected %q but got: %s", key, keyToPrint)
+		return fmt.Errorf("did not get expected response when querying endpoint, expected %q but got: %s%s", key, keyToPrint, describeRouting(url, response))
 	}
 
 	log.V(logf.DebugLevel).Info("reachability test succeeded")
 
 	return nil
 }
+
+// checkRedirect refuses to follow redirects that an ACME server would not
+// follow, so that the self check fails with the reason rather than passing
+// and leaving the ACME server to fail the challenge.
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	from := via[len(via)-1].URL
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("stopped after %d redirects, ACME servers do not follow more than %d", len(via), maxRedirects)
+	}
+	if req.URL.Scheme != "http" && req.URL.Scheme != "https" {
+		return fmt.Errorf("%s redirects to %s, ACME servers only follow redirects to http and https URLs", from, req.URL)
+	}
+	if port := req.URL.Port(); port != "" && port != "80" && port != "443" {
+		return fmt.Errorf("%s redirects to %s, ACME servers only follow redirects to ports 80 and 443", from, req.URL)
+	}
+	return nil
+}
+
+// describeRouting explains why a self check request that did not return the
+// expected key may have been misrouted. It returns an empty string if there
+// is nothing to add.
+func describeRouting(url *url.URL, response *http.Response) string {
+	var reasons []string
+	final := response.Request.URL
+	if url.Scheme == "http" && final.Scheme == "https" {
+		reasons = append(reasons, fmt.Sprintf("the request was redirected from HTTP to HTTPS (%s), so the HTTPS ingress must also route %s to the solver", final, solver.HTTPChallengePath))
+	}
+	if response.Header.Get(solver.HTTPSolverHeader) == "" {
+		reasons = append(reasons, fmt.Sprintf("the response did not come from the cert-manager HTTP01 solver, check that %s on %s is routed to the solver's service", solver.HTTPChallengePath, final.Host))
+	}
+	if len(reasons) == 0 {
+		return ""
+	}
+	return ": " + strings.Join(reasons, "; ")
+}
@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"net/http"
+	"net/http/httptest"
 	"net/url"
 	"strings"
 	"sync/atomic"
@@ -30,6 +32,7 @@ import (
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer/acme/http/solver"
 )
 
 // countReachabilityTestCalls is a wrapper function that allows us to count the number
@@ -190,3 +193,126 @@ func TestReachabilityCustomDnsServers(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckRedirect(t *testing.T) {
+	via := func(n int) []*http.Request {
+		reqs := make([]*http.Request, n)
+		for i := range reqs {
+			reqs[i] = httptest.NewRequest(http.MethodGet, "http://example.com/.well-known/acme-challenge/token", nil)
+		}
+		return reqs
+	}
+
+	tests := map[string]struct {
+		target string
+		via    int
+		err    string
+	}{
+		"https on the default port": {
+			target: "https://example.com/.well-known/acme-challenge/token",
+			via:    1,
+		},
+		"http on port 80": {
+			target: "http://www.example.com:80/.well-known/acme-challenge/token",
+			via:    1,
+		},
+		"https on port 443": {
+			target: "https://example.com:443/.well-known/acme-challenge/token",
+			via:    1,
+		},
+		"non-standard port": {
+			target: "https://example.com:8443/.well-known/acme-challenge/token",
+			via:    1,
+			err:    "http://example.com/.well-known/acme-challenge/token redirects to https://example.com:8443/.well-known/acme-challenge/token, ACME servers only follow redirects to ports 80 and 443",
+		},
+		"unsupported scheme": {
+			target: "ftp://example.com/token",
+			via:    1,
+			err:    "http://example.com/.well-known/acme-challenge/token redirects to ftp://example.com/token, ACME servers only follow redirects to http and https URLs",
+		},
+		"too many redirects": {
+			target: "https://example.com/.well-known/acme-challenge/token",
+			via:    10,
+			err:    "stopped after 10 redirects, ACME servers do not follow more than 10",
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := checkRedirect(httptest.NewRequest(http.MethodGet, test.target, nil), via(test.via))
+			if test.err == "" && err != nil {
+				t.Errorf("unexpected error: %v", err)
+			}
+			if test.err != "" && (err == nil || err.Error() != test.err) {
+				t.Errorf("expected error %q, got %v", test.err, err)
+			}
+		})
+	}
+}
+
+func TestReachabilityRouting(t *testing.T) {
+	const key = "key"
+
+	tests := map[string]struct {
+		handler http.HandlerFunc
+		err     string
+	}{
+		"served by the solver": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(solver.HTTPSolverHeader, "http01")
+				fmt.Fprint(w, key)
+			},
+		},
+		"not found from the solver": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set(solver.HTTPSolverHeader, "http01")
+				http.NotFound(w, r)
+			},
+			err: "wrong status code '404', expected '200'",
+		},
+		"not found from another backend": {
+			handler: http.NotFound,
+			err:     "wrong status code '404', expected '200': the response did not come from the cert-manager HTTP01 solver",
+		},
+		"wrong key from another backend": {
+			handler: func(w http.ResponseWriter, r *http.Request) {
+				fmt.Fprint(w, "default backend")
+			},
+			err: `expected "key" but got: default backend: the response did not come from the cert-manager HTTP01 solver`,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			server := httptest.NewServer(test.handler)
+			defer server.Close()
+
+			u, err := url.Parse(server.URL + solver.HTTPChallengePath + "/token")
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			err = testReachability(context.Background(), u, key, nil, "cert-manager-test")
+			switch {
+			case test.err == "" && err != nil:
+				t.Errorf("unexpected error: %v", err)
+			case test.err != "" && (err == nil || !strings.Contains(err.Error(), test.err)):
+				t.Errorf("expected error containing %q, got %v", test.err, err)
+			case test.err != "" && !strings.Contains(test.err, "did not come from") && strings.Contains(err.Error(), "did not come from"):
+				t.Errorf("unexpected routing diagnosis in %v", err)
+			}
+		})
+	}
+}
+
+func TestDescribeRoutingHTTPSRedirect(t *testing.T) {
+	u, _ := url.Parse("http://example.com/.well-known/acme-challenge/token")
+	resp := &http.Response{
+		Header:  http.Header{},
+		Request: httptest.NewRequest(http.MethodGet, "https://example.com/.well-known/acme-challenge/token", nil),
+	}
+
+	expected := ": the request was redirected from HTTP to HTTPS (https://example.com/.well-known/acme-challenge/token), so the HTTPS ingress must also route /.well-known/acme-challenge to the solver; " +
+		"the response did not come from the cert-manager HTTP01 solver, check that /.well-known/acme-challenge on example.com is routed to the solver's service"
+	if got := describeRouting(u, resp); got != expected {
+		t.Errorf("expected %q, got %q", expected, got)
+	}
+}
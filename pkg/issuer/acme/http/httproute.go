@@ -19,6 +19,7 @@ package http
 import (
 	"context"
 	"fmt"
+	"net"
 	"reflect"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -89,17 +90,12 @@ func (s *Solver) getGatewayHTTPRoute(ctx context.Context, ch *cmacme.Challenge)
 }
 
 func (s *Solver) createGatewayHTTPRoute(ctx context.Context, ch *cmacme.Challenge, svcName string) (*gwapi.HTTPRoute, error) {
-	labels := podLabels(ch)
-	if ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels != nil {
-		for k, v := range ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels {
-			labels[k] = v
-		}
-	}
 	httpRoute := &gwapi.HTTPRoute{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName:    "cm-acme-http-solver",
 			Namespace:       ch.Namespace,
-			Labels:          labels,
+			Labels:          generateHTTPRouteLabels(ch),
+			Annotations:     generateHTTPRouteAnnotations(ch),
 			OwnerReferences: []metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)},
 		},
 		Spec: generateHTTPRouteSpec(ch, svcName),
@@ -115,14 +111,11 @@ func (s *Solver) checkAndUpdateGatewayHTTPRoute(ctx context.Context, ch *cmacme.
 	log := logf.FromContext(ctx, "checkAndUpdateGatewayHTTPRoute")
 	expectedSpec := generateHTTPRouteSpec(ch, svcName)
 	actualSpec := httpRoute.Spec
-	expectedLabels := podLabels(ch)
-	if ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels != nil {
-		for k, v := range ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels {
-			expectedLabels[k] = v
-		}
-	}
-	actualLabels := ch.Labels
-	if reflect.DeepEqual(expectedSpec, actualSpec) && reflect.DeepEqual(expectedLabels, actualLabels) {
+	expectedLabels := generateHTTPRouteLabels(ch)
+	actualLabels := httpRoute.Labels
+	expectedAnnotations := generateHTTPRouteAnnotations(ch)
+	if reflect.DeepEqual(expectedSpec, actualSpec) && reflect.DeepEqual(expectedLabels, actualLabels) &&
+		containsAnnotations(httpRoute.Annotations, expectedAnnotations) {
 		return httpRoute, nil
 	}
 	log.Info("HTTPRoute is out of date, updating", "name", httpRoute.Name, "namespace", httpRoute.Namespace)
@@ -136,6 +129,14 @@ func (s *Solver) checkAndUpdateGatewayHTTPRoute(ctx context.Context, ch *cmacme.
 		newHTTPRoute := oldHTTPRoute.DeepCopy()
 		newHTTPRoute.Spec = expectedSpec
 		newHTTPRoute.Labels = expectedLabels
+		// annotations may also be set by the Gateway implementation, so only
+		// the configured annotations are overwritten
+		if len(expectedAnnotations) > 0 && newHTTPRoute.Annotations == nil {
+			newHTTPRoute.Annotations = make(map[string]string, len(expectedAnnotations))
+		}
+		for k, v := range expectedAnnotations {
+			newHTTPRoute.Annotations[k] = v
+		}
 		ret, err = s.GWClient.GatewayV1alpha2().HTTPRoutes(newHTTPRoute.Namespace).Update(ctx, newHTTPRoute, metav1.UpdateOptions{})
 		if err != nil {
 			return err
@@ -147,14 +148,48 @@ func (s *Solver) checkAndUpdateGatewayHTTPRoute(ctx context.Context, ch *cmacme.
 	return ret, nil
 }
 
+func generateHTTPRouteLabels(ch *cmacme.Challenge) map[string]string {
+	labels := podLabels(ch)
+	for k, v := range ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func generateHTTPRouteAnnotations(ch *cmacme.Challenge) map[string]string {
+	if len(ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Annotations) == 0 {
+		return nil
+	}
+	annotations := make(map[string]string, len(ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Annotations))
+	for k, v := range ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Annotations {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// containsAnnotations returns true if all of the expected annotations are
+// set to the expected values in actual.
+func containsAnnotations(actual, expected map[string]string) bool {
+	for k, v := range expected {
+		if got, ok := actual[k]; !ok || got != v {
+			return false
+		}
+	}
+	return true
+}
+
 func generateHTTPRouteSpec(ch *cmacme.Challenge, svcName string) gwapi.HTTPRouteSpec {
+	// HTTPRoute hostnames cannot be IP addresses, so when verifying ownership
+	// of an IP the route matches all hostnames of its parent Gateways.
+	var hostnames []gwapi.Hostname
+	if net.ParseIP(ch.Spec.DNSName) == nil {
+		hostnames = []gwapi.Hostname{gwapi.Hostname(ch.Spec.DNSName)}
+	}
 	return gwapi.HTTPRouteSpec{
 		CommonRouteSpec: gwapi.CommonRouteSpec{
 			ParentRefs: ch.Spec.Solver.HTTP01.GatewayHTTPRoute.ParentRefs,
 		},
-		Hostnames: []gwapi.Hostname{
-			gwapi.Hostname(ch.Spec.DNSName),
-		},
+		Hostnames: hostnames,
 		Rules: []gwapi.HTTPRouteRule{
 			{
 				Matches: []gwapi.HTTPRouteMatch{
@@ -0,0 +1,114 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"reflect"
+	"testing"
+
+	gwapi "sigs.k8s.io/gateway-api/apis/v1alpha2"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func TestGenerateHTTPRouteMetadata(t *testing.T) {
+	sectionName := gwapi.SectionName("http")
+	namespace := gwapi.Namespace("gateways")
+	ch := &cmacme.Challenge{
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					GatewayHTTPRoute: &cmacme.ACMEChallengeSolverHTTP01GatewayHTTPRoute{
+						Labels:      map[string]string{"tenant": "a"},
+						Annotations: map[string]string{"projectcontour.io/ingress.class": "contour"},
+						ParentRefs: []gwapi.ParentRef{
+							{Name: "shared", Namespace: &namespace, SectionName: &sectionName},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	labels := generateHTTPRouteLabels(ch)
+	if labels["tenant"] != "a" || labels[cmacme.SolverIdentificationLabelKey] != "true" {
+		t.Errorf("unexpected labels %v", labels)
+	}
+
+	annotations := generateHTTPRouteAnnotations(ch)
+	if !reflect.DeepEqual(annotations, map[string]string{"projectcontour.io/ingress.class": "contour"}) {
+		t.Errorf("unexpected annotations %v", annotations)
+	}
+	// the generated annotations must not alias the solver configuration
+	annotations["other"] = "value"
+	if _, ok := ch.Spec.Solver.HTTP01.GatewayHTTPRoute.Annotations["other"]; ok {
+		t.Errorf("generated annotations modified the solver configuration")
+	}
+
+	spec := generateHTTPRouteSpec(ch, "svc")
+	if !reflect.DeepEqual(spec.ParentRefs, ch.Spec.Solver.HTTP01.GatewayHTTPRoute.ParentRefs) {
+		t.Errorf("unexpected parentRefs %v", spec.ParentRefs)
+	}
+	if !reflect.DeepEqual(spec.Hostnames, []gwapi.Hostname{"example.com"}) {
+		t.Errorf("unexpected hostnames %v", spec.Hostnames)
+	}
+
+	// HTTPRoute hostnames cannot be IP addresses
+	ch.Spec.DNSName = "10.0.0.1"
+	spec = generateHTTPRouteSpec(ch, "svc")
+	if len(spec.Hostnames) != 0 {
+		t.Errorf("expected no hostnames for an IP address, got %v", spec.Hostnames)
+	}
+}
+
+func TestContainsAnnotations(t *testing.T) {
+	tests := map[string]struct {
+		actual, expected map[string]string
+		contains         bool
+	}{
+		"nothing expected": {
+			actual:   map[string]string{"a": "b"},
+			contains: true,
+		},
+		"extra annotations are ignored": {
+			actual:   map[string]string{"a": "b", "c": "d"},
+			expected: map[string]string{"a": "b"},
+			contains: true,
+		},
+		"missing annotation": {
+			expected: map[string]string{"a": "b"},
+		},
+		"different value": {
+			actual:   map[string]string{"a": "c"},
+			expected: map[string]string{"a": "b"},
+		},
+		"empty value is not missing": {
+			actual:   map[string]string{"a": ""},
+			expected: map[string]string{"a": ""},
+			contains: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := containsAnnotations(test.actual, test.expected); got != test.contains {
+				t.Errorf("expected %v, got %v", test.contains, got)
+			}
+		})
+	}
+}
@@ -159,17 +159,14 @@ func buildIngressResource(ch *cmacme.Challenge, svcName string) (*networkingv1.I
 	// if the `kubernetes.io/ingress.class` annotation is present, it takes precedence over the
 	// `spec.IngressClassName` field.
 	// See discussion in https://github.com/cert-manager/cert-manager/issues/4537.
+	// Solvers using the ingressClassName field set `spec.ingressClassName`
+	// instead.
 	if http01IngressCfg.Class != nil {
 		ingAnnotations[annotationIngressClass] = *http01IngressCfg.Class
 	}
 
 	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
 
-	httpHost := ch.Spec.DNSName
-	// if we need to verify ownership of an IP the challenge should propagate on all hosts
-	if net.ParseIP(httpHost) != nil {
-		httpHost = ""
-	}
 	return &networkingv1.Ingress{
 		ObjectMeta: metav1.ObjectMeta{
 			GenerateName:    "cm-acme-http-solver-",
@@ -180,10 +177,10 @@ func buildIngressResource(ch *cmacme.Challenge, svcName string) (*networkingv1.I
 		},
 		Spec: networkingv1.IngressSpec{
 			// https://github.com/cert-manager/cert-manager/issues/4537
-			IngressClassName: nil,
+			IngressClassName: http01IngressCfg.IngressClassName,
 			Rules: []networkingv1.IngressRule{
 				{
-					Host: httpHost,
+					Host: ingressHost(ch),
 					IngressRuleValue: networkingv1.IngressRuleValue{
 						HTTP: &networkingv1.HTTPIngressRuleValue{
 							Paths: []networkingv1.HTTPIngressPath{
@@ -240,9 +237,10 @@ func (s *Solver) addChallengePathToIngress(ctx context.Context, ch *cmacme.Chall
 	}
 
 	ingPathToAdd := ingressPath(ch.Spec.Token, svcName)
+	httpHost := ingressHost(ch)
 	// check for an existing Rule for the given domain on the ingress resource
 	for _, rule := range ing.Spec.Rules {
-		if rule.Host == ch.Spec.DNSName {
+		if rule.Host == httpHost {
 			if rule.HTTP == nil {
 				rule.HTTP = &networkingv1.HTTPIngressRuleValue{}
 			}
@@ -266,7 +264,7 @@ func (s *Solver) addChallengePathToIngress(ctx context.Context, ch *cmacme.Chall
 
 	// if one doesn't exist, create a new IngressRule
 	ing.Spec.Rules = append(ing.Spec.Rules, networkingv1.IngressRule{
-		Host: ch.Spec.DNSName,
+		Host: httpHost,
 		IngressRuleValue: networkingv1.IngressRuleValue{
 			HTTP: &networkingv1.HTTPIngressRuleValue{
 				Paths: []networkingv1.HTTPIngressPath{ingPathToAdd},
@@ -328,10 +326,11 @@ func (s *Solver) cleanupIngresses(ctx context.Context, ch *cmacme.Challenge) err
 
 	log.V(logf.DebugLevel).Info("attempting to clean up automatically added solver paths on ingress resource")
 	ingPathToDel := solverPathFn(ch.Spec.Token)
+	httpHost := ingressHost(ch)
 	var ingRules []networkingv1.IngressRule
 	for _, rule := range ing.Spec.Rules {
 		// always retain rules that are not for the same DNSName
-		if rule.Host != ch.Spec.DNSName {
+		if rule.Host != httpHost {
 			ingRules = append(ingRules, rule)
 			continue
 		}
@@ -389,3 +388,14 @@ func ingressPath(token, serviceName string) networkingv1.HTTPIngressPath {
 var solverPathFn = func(token string) string {
 	return fmt.Sprintf("%s/%s", solver.HTTPChallengePath, token)
 }
+
+// ingressHost returns the host of the Ingress rule used to solve the
+// challenge. Ingress hosts cannot be IP addresses, so when verifying
+// ownership of an IP the rule has no host and the challenge is served on all
+// hosts.
+func ingressHost(ch *cmacme.Challenge) string {
+	if net.ParseIP(ch.Spec.DNSName) != nil {
+		return ""
+	}
+	return ch.Spec.DNSName
+}
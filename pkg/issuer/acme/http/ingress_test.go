@@ -644,3 +644,46 @@ func TestOverrideNginxIngressWhitelistAnnotation(t *testing.T) {
 		})
 	}
 }
+
+func TestBuildIngressResourceIngressClass(t *testing.T) {
+	tests := map[string]struct {
+		ingress              *cmacme.ACMEChallengeSolverHTTP01Ingress
+		expectedAnnotation   *string
+		expectedIngressClass *string
+	}{
+		"class sets the ingress class annotation": {
+			ingress:            &cmacme.ACMEChallengeSolverHTTP01Ingress{Class: strPtr("nginx")},
+			expectedAnnotation: strPtr("nginx"),
+		},
+		"ingressClassName sets spec.ingressClassName": {
+			ingress:              &cmacme.ACMEChallengeSolverHTTP01Ingress{IngressClassName: strPtr("nginx")},
+			expectedIngressClass: strPtr("nginx"),
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			ch := &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					DNSName: "example.com",
+					Solver: cmacme.ACMEChallengeSolver{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{Ingress: test.ingress},
+					},
+				},
+			}
+			ing, err := buildIngressResource(ch, "fakeservice")
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			annotation, ok := ing.Annotations[annotationIngressClass]
+			if test.expectedAnnotation == nil && ok {
+				t.Errorf("expected no ingress class annotation, got %q", annotation)
+			}
+			if test.expectedAnnotation != nil && annotation != *test.expectedAnnotation {
+				t.Errorf("expected ingress class annotation %q, got %q", *test.expectedAnnotation, annotation)
+			}
+			if !reflect.DeepEqual(ing.Spec.IngressClassName, test.expectedIngressClass) {
+				t.Errorf("expected spec.ingressClassName %v, got %v", test.expectedIngressClass, ing.Spec.IngressClassName)
+			}
+		})
+	}
+}
@@ -144,6 +144,10 @@ func (s *Solver) createPod(ctx context.Context, ch *cmacme.Challenge) (*corev1.P
 func (s *Solver) buildPod(ch *cmacme.Challenge) *corev1.Pod {
 	pod := s.buildDefaultPod(ch)
 
+	// Apply the cluster-wide pod template configured on the controller
+	// before any template specified on the solver itself.
+	pod = s.mergePodObjectMetaWithPodTemplate(pod, s.ACMEOptions.HTTP01SolverPodTemplate)
+
 	// Override defaults if they have changed in the pod template.
 	if ch.Spec.Solver.HTTP01 != nil {
 		if ch.Spec.Solver.HTTP01.Ingress != nil {
@@ -207,6 +211,9 @@ func (s *Solver) buildDefaultPod(ch *cmacme.Challenge) *corev1.Pod {
 					},
 					SecurityContext: &corev1.SecurityContext{
 						AllowPrivilegeEscalation: pointer.BoolPtr(false),
+						Capabilities: &corev1.Capabilities{
+							Drop: []corev1.Capability{"ALL"},
+						},
 					},
 				},
 			},
@@ -262,5 +269,42 @@ func (s *Solver) mergePodObjectMetaWithPodTemplate(pod *corev1.Pod, podTempl *cm
 		pod.Spec.ServiceAccountName = podTempl.Spec.ServiceAccountName
 	}
 
+	if podTempl.Spec.SecurityContext != nil {
+		pod.Spec.SecurityContext = podTempl.Spec.SecurityContext.DeepCopy()
+	}
+
+	pod.Spec.TopologySpreadConstraints = append(pod.Spec.TopologySpreadConstraints, podTempl.Spec.TopologySpreadConstraints...)
+
+	if podTempl.Spec.RuntimeClassName != "" {
+		pod.Spec.RuntimeClassName = pointer.StringPtr(podTempl.Spec.RuntimeClassName)
+	}
+
+	pod.Spec.ImagePullSecrets = append(pod.Spec.ImagePullSecrets, podTempl.Spec.ImagePullSecrets...)
+
+	if podTempl.Spec.Resources != nil {
+		for i := range pod.Spec.Containers {
+			mergeResourceRequirements(&pod.Spec.Containers[i].Resources, podTempl.Spec.Resources)
+		}
+	}
+
 	return pod
 }
+
+// mergeResourceRequirements overrides the requests and limits in res with
+// any that are set in override. Resources that are not mentioned in override
+// keep their existing values.
+func mergeResourceRequirements(res *corev1.ResourceRequirements, override *corev1.ResourceRequirements) {
+	if len(override.Requests) > 0 && res.Requests == nil {
+		res.Requests = make(corev1.ResourceList)
+	}
+	for k, v := range override.Requests {
+		res.Requests[k] = v.DeepCopy()
+	}
+
+	if len(override.Limits) > 0 && res.Limits == nil {
+		res.Limits = make(corev1.ResourceList)
+	}
+	for k, v := range override.Limits {
+		res.Limits[k] = v.DeepCopy()
+	}
+}
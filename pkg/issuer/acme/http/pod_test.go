@@ -22,9 +22,11 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	coretesting "k8s.io/client-go/testing"
+	"k8s.io/utils/pointer"
 
 	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 )
@@ -353,6 +355,148 @@ func TestMergePodObjectMetaWithPodTemplate(t *testing.T) {
 				}
 			},
 		},
+		"should apply resources, security context and scheduling options from template": {
+			Challenge: &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					DNSName: "example.com",
+					Solver: cmacme.ACMEChallengeSolver{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+								PodTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate{
+									Spec: cmacme.ACMEChallengeSolverHTTP01IngressPodSpec{
+										Resources: &corev1.ResourceRequirements{
+											Limits: corev1.ResourceList{
+												corev1.ResourceMemory: resource.MustParse("128Mi"),
+											},
+										},
+										SecurityContext: &corev1.PodSecurityContext{
+											RunAsNonRoot: pointer.BoolPtr(true),
+											RunAsUser:    pointer.Int64Ptr(1000),
+											SeccompProfile: &corev1.SeccompProfile{
+												Type: corev1.SeccompProfileTypeRuntimeDefault,
+											},
+										},
+										TopologySpreadConstraints: []corev1.TopologySpreadConstraint{
+											{
+												MaxSkew:           1,
+												TopologyKey:       "topology.kubernetes.io/zone",
+												WhenUnsatisfiable: corev1.ScheduleAnyway,
+											},
+										},
+										RuntimeClassName: "gvisor",
+										ImagePullSecrets: []corev1.LocalObjectReference{
+											{Name: "registry-credentials"},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			PreFn: func(t *testing.T, s *solverFixture) {
+				resultingPod := s.Solver.buildDefaultPod(s.Challenge)
+				resultingPod.Spec.Containers[0].Resources.Limits[corev1.ResourceMemory] = resource.MustParse("128Mi")
+				resultingPod.Spec.SecurityContext = &corev1.PodSecurityContext{
+					RunAsNonRoot: pointer.BoolPtr(true),
+					RunAsUser:    pointer.Int64Ptr(1000),
+					SeccompProfile: &corev1.SeccompProfile{
+						Type: corev1.SeccompProfileTypeRuntimeDefault,
+					},
+				}
+				resultingPod.Spec.TopologySpreadConstraints = []corev1.TopologySpreadConstraint{
+					{
+						MaxSkew:           1,
+						TopologyKey:       "topology.kubernetes.io/zone",
+						WhenUnsatisfiable: corev1.ScheduleAnyway,
+					},
+				}
+				resultingPod.Spec.RuntimeClassName = pointer.StringPtr("gvisor")
+				resultingPod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+					{Name: "registry-credentials"},
+				}
+				s.testResources[createdPodKey] = resultingPod
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resultingPod := s.testResources[createdPodKey].(*corev1.Pod)
+
+				resp, ok := args[0].(*corev1.Pod)
+				if !ok {
+					t.Errorf("expected pod to be returned, but got %v", args[0])
+					t.Fail()
+					return
+				}
+
+				// ignore pointer differences here
+				resultingPod.OwnerReferences = resp.OwnerReferences
+
+				if resp.String() != resultingPod.String() {
+					t.Errorf("unexpected pod generated from merge\nexp=%s\ngot=%s",
+						resultingPod, resp)
+					t.Fail()
+				}
+			},
+		},
+		"should apply the controller pod template before the solver pod template": {
+			Challenge: &cmacme.Challenge{
+				Spec: cmacme.ChallengeSpec{
+					DNSName: "example.com",
+					Solver: cmacme.ACMEChallengeSolver{
+						HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+							Ingress: &cmacme.ACMEChallengeSolverHTTP01Ingress{
+								PodTemplate: &cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate{
+									Spec: cmacme.ACMEChallengeSolverHTTP01IngressPodSpec{
+										RuntimeClassName: "kata",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			PreFn: func(t *testing.T, s *solverFixture) {
+				s.Solver.ACMEOptions.HTTP01SolverPodTemplate = &cmacme.ACMEChallengeSolverHTTP01IngressPodTemplate{
+					Spec: cmacme.ACMEChallengeSolverHTTP01IngressPodSpec{
+						PriorityClassName: "system-cluster-critical",
+						RuntimeClassName:  "gvisor",
+						ImagePullSecrets: []corev1.LocalObjectReference{
+							{Name: "registry-credentials"},
+						},
+					},
+				}
+
+				resultingPod := s.Solver.buildDefaultPod(s.Challenge)
+				resultingPod.Spec.PriorityClassName = "system-cluster-critical"
+				resultingPod.Spec.RuntimeClassName = pointer.StringPtr("kata")
+				resultingPod.Spec.ImagePullSecrets = []corev1.LocalObjectReference{
+					{Name: "registry-credentials"},
+				}
+				s.testResources[createdPodKey] = resultingPod
+
+				s.Builder.Sync()
+			},
+			CheckFn: func(t *testing.T, s *solverFixture, args ...interface{}) {
+				resultingPod := s.testResources[createdPodKey].(*corev1.Pod)
+
+				resp, ok := args[0].(*corev1.Pod)
+				if !ok {
+					t.Errorf("expected pod to be returned, but got %v", args[0])
+					t.Fail()
+					return
+				}
+
+				// ignore pointer differences here
+				resultingPod.OwnerReferences = resp.OwnerReferences
+
+				if resp.String() != resultingPod.String() {
+					t.Errorf("unexpected pod generated from merge\nexp=%s\ngot=%s",
+						resultingPod, resp)
+					t.Fail()
+				}
+			},
+		},
 		"should use default if nothing has changed in template": {
 			Challenge: &cmacme.Challenge{
 				Spec: cmacme.ChallengeSpec{
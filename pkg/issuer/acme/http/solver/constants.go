@@ -19,4 +19,9 @@ package solver
 const (
 	// HTTPChallengePath is the path prefix used for http-01 challenge requests
 	HTTPChallengePath = "/.well-known/acme-challenge"
+
+	// HTTPSolverHeader is set on every response served by the http-01
+	// solver, so that the self check can tell whether a request was routed
+	// to the solver.
+	HTTPSolverHeader = "X-Cert-Manager-Solver"
 )
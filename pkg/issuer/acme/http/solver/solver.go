@@ -44,6 +44,8 @@ func (h *HTTP01Solver) Listen(log logr.Logger) error {
 	)
 
 	handler := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set(HTTPSolverHeader, "http01")
+
 		// extract vars from the request
 		host := strings.Split(r.Host, ":")[0]
 		basePath := path.Dir(r.URL.EscapedPath())
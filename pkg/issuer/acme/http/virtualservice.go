@@ -0,0 +1,244 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/retry"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// virtualServiceGVR is the Istio VirtualService resource. Istio's API types
+// are not vendored, so VirtualServices are managed using the dynamic client.
+var virtualServiceGVR = schema.GroupVersionResource{
+	Group:    "networking.istio.io",
+	Version:  "v1beta1",
+	Resource: "virtualservices",
+}
+
+// ensureIstioVirtualService ensures that the VirtualService needed to solve a challenge exists.
+func (s *Solver) ensureIstioVirtualService(ctx context.Context, ch *cmacme.Challenge, svcName string) (*unstructured.Unstructured, error) {
+	if ch == nil {
+		return nil, fmt.Errorf("ensureIstioVirtualService received nil *acme.Challenge")
+	}
+	if s.dynamicClient == nil {
+		return nil, fmt.Errorf("cannot create Istio VirtualService: no dynamic client configured")
+	}
+	log := logf.FromContext(ctx).WithName("ensureIstioVirtualService")
+
+	virtualService, err := s.getIstioVirtualService(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+
+	if virtualService == nil {
+		log.Info("creating VirtualService for challenge", "name", ch.Name, "namespace", ch.Namespace)
+		return s.createIstioVirtualService(ctx, ch, svcName)
+	}
+
+	log.V(logf.DebugLevel).Info("found existing VirtualService for challenge", "name", ch.Name, "namespace", ch.Namespace)
+
+	return s.checkAndUpdateIstioVirtualService(ctx, ch, svcName, virtualService)
+}
+
+func (s *Solver) listIstioVirtualServices(ctx context.Context, ch *cmacme.Challenge) ([]unstructured.Unstructured, error) {
+	list, err := s.dynamicClient.Resource(virtualServiceGVR).Namespace(ch.Namespace).List(ctx, metav1.ListOptions{
+		LabelSelector: labels.Set(podLabels(ch)).AsSelector().String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+func (s *Solver) getIstioVirtualService(ctx context.Context, ch *cmacme.Challenge) (*unstructured.Unstructured, error) {
+	log := logf.FromContext(ctx).WithName("getIstioVirtualService")
+	virtualServices, err := s.listIstioVirtualServices(ctx, ch)
+	if err != nil {
+		return nil, err
+	}
+	switch len(virtualServices) {
+	case 0:
+		return nil, nil
+	case 1:
+		return &virtualServices[0], nil
+	default:
+		// It should not be possible for multiple VirtualServices for this challenge to exist
+		// If we find this, try to delete them.
+		for _, virtualService := range virtualServices[1:] {
+			log.Info("deleting extra VirtualService", "name", virtualService.GetName(), "namespace", virtualService.GetNamespace())
+			err := s.dynamicClient.Resource(virtualServiceGVR).Namespace(virtualService.GetNamespace()).Delete(ctx, virtualService.GetName(), metav1.DeleteOptions{})
+			if err != nil {
+				return nil, err
+			}
+		}
+		return nil, fmt.Errorf("multiple VirtualServices found")
+	}
+}
+
+func (s *Solver) createIstioVirtualService(ctx context.Context, ch *cmacme.Challenge, svcName string) (*unstructured.Unstructured, error) {
+	virtualService := &unstructured.Unstructured{}
+	virtualService.SetAPIVersion(virtualServiceGVR.GroupVersion().String())
+	virtualService.SetKind("VirtualService")
+	virtualService.SetGenerateName("cm-acme-http-solver-")
+	virtualService.SetNamespace(ch.Namespace)
+	virtualService.SetLabels(generateVirtualServiceLabels(ch))
+	virtualService.SetAnnotations(generateVirtualServiceAnnotations(ch))
+	virtualService.SetOwnerReferences([]metav1.OwnerReference{*metav1.NewControllerRef(ch, challengeGvk)})
+	virtualService.Object["spec"] = generateVirtualServiceSpec(ch, svcName)
+
+	return s.dynamicClient.Resource(virtualServiceGVR).Namespace(ch.Namespace).Create(ctx, virtualService, metav1.CreateOptions{})
+}
+
+func (s *Solver) checkAndUpdateIstioVirtualService(ctx context.Context, ch *cmacme.Challenge, svcName string, virtualService *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	log := logf.FromContext(ctx, "checkAndUpdateIstioVirtualService")
+	expectedSpec := generateVirtualServiceSpec(ch, svcName)
+	expectedLabels := generateVirtualServiceLabels(ch)
+	expectedAnnotations := generateVirtualServiceAnnotations(ch)
+	if reflect.DeepEqual(expectedSpec, virtualService.Object["spec"]) && reflect.DeepEqual(expectedLabels, virtualService.GetLabels()) &&
+		containsAnnotations(virtualService.GetAnnotations(), expectedAnnotations) {
+		return virtualService, nil
+	}
+	log.Info("VirtualService is out of date, updating", "name", virtualService.GetName(), "namespace", virtualService.GetNamespace())
+	client := s.dynamicClient.Resource(virtualServiceGVR).Namespace(virtualService.GetNamespace())
+	var ret *unstructured.Unstructured
+	if err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		oldVirtualService, err := client.Get(ctx, virtualService.GetName(), metav1.GetOptions{})
+		if err != nil {
+			return err
+		}
+		newVirtualService := oldVirtualService.DeepCopy()
+		newVirtualService.Object["spec"] = expectedSpec
+		newVirtualService.SetLabels(expectedLabels)
+		// annotations may also be set by the mesh, so only the configured
+		// annotations are overwritten
+		annotations := newVirtualService.GetAnnotations()
+		if len(expectedAnnotations) > 0 && annotations == nil {
+			annotations = make(map[string]string, len(expectedAnnotations))
+		}
+		for k, v := range expectedAnnotations {
+			annotations[k] = v
+		}
+		newVirtualService.SetAnnotations(annotations)
+		ret, err = client.Update(ctx, newVirtualService, metav1.UpdateOptions{})
+		return err
+	}); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func generateVirtualServiceLabels(ch *cmacme.Challenge) map[string]string {
+	labels := podLabels(ch)
+	for k, v := range ch.Spec.Solver.HTTP01.IstioVirtualService.Labels {
+		labels[k] = v
+	}
+	return labels
+}
+
+func generateVirtualServiceAnnotations(ch *cmacme.Challenge) map[string]string {
+	if len(ch.Spec.Solver.HTTP01.IstioVirtualService.Annotations) == 0 {
+		return nil
+	}
+	annotations := make(map[string]string, len(ch.Spec.Solver.HTTP01.IstioVirtualService.Annotations))
+	for k, v := range ch.Spec.Solver.HTTP01.IstioVirtualService.Annotations {
+		annotations[k] = v
+	}
+	return annotations
+}
+
+// generateVirtualServiceSpec builds the spec of a VirtualService routing
+// requests for the challenge token on the challenge's domain to the solver
+// service. Values use the types produced when decoding JSON so that the spec
+// can be compared against VirtualServices read back from the API server.
+func generateVirtualServiceSpec(ch *cmacme.Challenge, svcName string) map[string]interface{} {
+	gateways := make([]interface{}, len(ch.Spec.Solver.HTTP01.IstioVirtualService.Gateways))
+	for i, gateway := range ch.Spec.Solver.HTTP01.IstioVirtualService.Gateways {
+		gateways[i] = gateway
+	}
+	// Istio matches hosts against the Host header, which for IP addresses
+	// may include a port, so IPs are served on all hosts of the gateways.
+	host := ch.Spec.DNSName
+	if net.ParseIP(host) != nil {
+		host = "*"
+	}
+	return map[string]interface{}{
+		"hosts":    []interface{}{host},
+		"gateways": gateways,
+		"http": []interface{}{
+			map[string]interface{}{
+				"match": []interface{}{
+					map[string]interface{}{
+						"uri": map[string]interface{}{
+							"exact": fmt.Sprintf("/.well-known/acme-challenge/%s", ch.Spec.Token),
+						},
+					},
+				},
+				"route": []interface{}{
+					map[string]interface{}{
+						"destination": map[string]interface{}{
+							// short names are resolved relative to the namespace
+							// of the VirtualService, which is the namespace of
+							// the solver service
+							"host": svcName,
+							"port": map[string]interface{}{
+								"number": int64(acmeSolverListenPort),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (s *Solver) cleanupIstioVirtualServices(ctx context.Context, ch *cmacme.Challenge) error {
+	if ch.Spec.Solver.HTTP01 == nil || ch.Spec.Solver.HTTP01.IstioVirtualService == nil || s.dynamicClient == nil {
+		return nil
+	}
+	log := logf.FromContext(ctx, "cleanupIstioVirtualServices")
+
+	virtualServices, err := s.listIstioVirtualServices(ctx, ch)
+	if err != nil {
+		return err
+	}
+	var errs []error
+	for _, virtualService := range virtualServices {
+		log := log.WithValues("name", virtualService.GetName(), "namespace", virtualService.GetNamespace())
+		log.V(logf.DebugLevel).Info("deleting VirtualService resource")
+
+		err := s.dynamicClient.Resource(virtualServiceGVR).Namespace(virtualService.GetNamespace()).Delete(ctx, virtualService.GetName(), metav1.DeleteOptions{})
+		if err != nil {
+			log.V(logf.WarnLevel).Info("failed to delete VirtualService resource", "error", err)
+			errs = append(errs, err)
+			continue
+		}
+		log.V(logf.DebugLevel).Info("successfully deleted VirtualService resource")
+	}
+	return utilerrors.NewAggregate(errs)
+}
@@ -0,0 +1,130 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package http
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	dynamicfake "k8s.io/client-go/dynamic/fake"
+	coretesting "k8s.io/client-go/testing"
+
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+)
+
+func newVirtualServiceChallenge() *cmacme.Challenge {
+	return &cmacme.Challenge{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-challenge",
+			Namespace: defaultTestNamespace,
+		},
+		Spec: cmacme.ChallengeSpec{
+			DNSName: "example.com",
+			Token:   "token",
+			Solver: cmacme.ACMEChallengeSolver{
+				HTTP01: &cmacme.ACMEChallengeSolverHTTP01{
+					IstioVirtualService: &cmacme.ACMEChallengeSolverHTTP01IstioVirtualService{
+						Gateways:    []string{"istio-system/ingressgateway"},
+						Labels:      map[string]string{"tenant": "a"},
+						Annotations: map[string]string{"example.com/owner": "team-a"},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestEnsureIstioVirtualService(t *testing.T) {
+	ctx := context.Background()
+	client := dynamicfake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		virtualServiceGVR: "VirtualServiceList",
+	})
+	s := &Solver{dynamicClient: client}
+	ch := newVirtualServiceChallenge()
+
+	vs, err := s.ensureIstioVirtualService(ctx, ch, "cm-acme-http-solver-abcde")
+	if err != nil {
+		t.Fatalf("unexpected error creating VirtualService: %v", err)
+	}
+	if vs.GetKind() != "VirtualService" || vs.GetNamespace() != ch.Namespace {
+		t.Errorf("unexpected VirtualService %s %s/%s", vs.GetKind(), vs.GetNamespace(), vs.GetName())
+	}
+	if vs.GetLabels()["tenant"] != "a" || vs.GetLabels()[cmacme.SolverIdentificationLabelKey] != "true" {
+		t.Errorf("unexpected labels %v", vs.GetLabels())
+	}
+	if !reflect.DeepEqual(vs.GetAnnotations(), map[string]string{"example.com/owner": "team-a"}) {
+		t.Errorf("unexpected annotations %v", vs.GetAnnotations())
+	}
+
+	hosts, _, _ := unstructured.NestedStringSlice(vs.Object, "spec", "hosts")
+	if !reflect.DeepEqual(hosts, []string{"example.com"}) {
+		t.Errorf("unexpected hosts %v", hosts)
+	}
+	gateways, _, _ := unstructured.NestedStringSlice(vs.Object, "spec", "gateways")
+	if !reflect.DeepEqual(gateways, []string{"istio-system/ingressgateway"}) {
+		t.Errorf("unexpected gateways %v", gateways)
+	}
+
+	// an up to date VirtualService should not be updated
+	client.ClearActions()
+	if _, err := s.ensureIstioVirtualService(ctx, ch, "cm-acme-http-solver-abcde"); err != nil {
+		t.Fatalf("unexpected error ensuring VirtualService: %v", err)
+	}
+	for _, action := range client.Actions() {
+		if action.GetVerb() != "list" {
+			t.Errorf("unexpected %s action for up to date VirtualService", action.GetVerb())
+		}
+	}
+
+	// changing the service name should update the existing VirtualService
+	client.ClearActions()
+	vs, err = s.ensureIstioVirtualService(ctx, ch, "cm-acme-http-solver-fghij")
+	if err != nil {
+		t.Fatalf("unexpected error updating VirtualService: %v", err)
+	}
+	if !hasAction(client.Actions(), "update") {
+		t.Errorf("expected VirtualService to be updated, got actions %v", client.Actions())
+	}
+	if !reflect.DeepEqual(vs.Object["spec"], generateVirtualServiceSpec(ch, "cm-acme-http-solver-fghij")) {
+		t.Errorf("unexpected spec after update %v", vs.Object["spec"])
+	}
+
+	if err := s.cleanupIstioVirtualServices(ctx, ch); err != nil {
+		t.Fatalf("unexpected error cleaning up VirtualServices: %v", err)
+	}
+	remaining, err := s.listIstioVirtualServices(ctx, ch)
+	if err != nil {
+		t.Fatalf("unexpected error listing VirtualServices: %v", err)
+	}
+	if len(remaining) != 0 {
+		t.Errorf("expected all VirtualServices to be deleted, %d remaining", len(remaining))
+	}
+}
+
+func hasAction(actions []coretesting.Action, verb string) bool {
+	for _, action := range actions {
+		if action.GetVerb() == verb {
+			return true
+		}
+	}
+	return false
+}
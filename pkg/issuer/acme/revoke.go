@@ -0,0 +1,52 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	"golang.org/x/crypto/acme"
+
+	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// problemAlreadyRevoked is the ACME problem type returned when revoking a
+// certificate which has already been revoked.
+const problemAlreadyRevoked = "urn:ietf:params:acme:error:alreadyRevoked"
+
+// Revoke asks the ACME server to revoke the certificate. The request is
+// signed with the key of the account the certificate was obtained with.
+// Certificates which have already been revoked are not an error.
+func (a *Acme) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, cert *x509.Certificate, reason int) error {
+	cl, err := accounts.GetClientForAccount(a.accountRegistry, string(a.issuer.GetUID()), cr.Annotations[cmacme.ACMECertificateAccountEmailAnnotationKey])
+	if err != nil {
+		return err
+	}
+
+	err = cl.RevokeCert(ctx, nil, cert.Raw, acme.CRLReasonCode(reason))
+	if acmeErr, ok := err.(*acme.Error); ok && acmeErr.ProblemType == problemAlreadyRevoked {
+		return nil
+	}
+	if err != nil {
+		return fmt.Errorf("failed to revoke certificate with ACME server %q: %w", a.issuer.GetSpec().ACME.Server, err)
+	}
+	return nil
+}
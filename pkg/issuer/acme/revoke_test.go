@@ -0,0 +1,99 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package acme
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"errors"
+	"testing"
+
+	acmeapi "golang.org/x/crypto/acme"
+
+	accountstest "github.com/cert-manager/cert-manager/pkg/acme/accounts/test"
+	acmecl "github.com/cert-manager/cert-manager/pkg/acme/client"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestAcme_Revoke(t *testing.T) {
+	cert := &x509.Certificate{Raw: []byte("certificate")}
+
+	tests := map[string]struct {
+		annotations map[string]string
+		revokeErr   error
+		expectedKey string
+		expectErr   bool
+	}{
+		"the certificate is revoked with the primary account": {
+			expectedKey: "issuer-uid",
+		},
+		"the certificate is revoked with the account it was obtained with": {
+			annotations: map[string]string{cmacme.ACMECertificateAccountEmailAnnotationKey: "team@example.com"},
+			expectedKey: "issuer-uid/team@example.com",
+		},
+		"certificates which have already been revoked are not an error": {
+			revokeErr:   &acmeapi.Error{ProblemType: problemAlreadyRevoked},
+			expectedKey: "issuer-uid",
+		},
+		"errors from the ACME server are returned": {
+			revokeErr:   errors.New("unauthorized"),
+			expectedKey: "issuer-uid",
+			expectErr:   true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var gotKey string
+			var gotReason acmeapi.CRLReasonCode
+			a := &Acme{
+				issuer: gen.Issuer("test-issuer", gen.SetIssuerACMEURL(acmev2Prod), func(iss cmapi.GenericIssuer) {
+					iss.GetObjectMeta().UID = "issuer-uid"
+				}),
+				accountRegistry: &accountstest.FakeRegistry{
+					GetClientFunc: func(uid string) (acmecl.Interface, error) {
+						gotKey = uid
+						return &acmecl.FakeACME{
+							FakeRevokeCert: func(_ context.Context, _ crypto.Signer, der []byte, reason acmeapi.CRLReasonCode) error {
+								if string(der) != string(cert.Raw) {
+									t.Errorf("unexpected certificate revoked: %q", der)
+								}
+								gotReason = reason
+								return test.revokeErr
+							},
+						}, nil
+					},
+				},
+			}
+
+			cr := gen.CertificateRequest("test-cr", gen.AddCertificateRequestAnnotations(test.annotations))
+			err := a.Revoke(context.Background(), cr, cert, 1)
+			if (err != nil) != test.expectErr {
+				t.Errorf("expected error %t, got %v", test.expectErr, err)
+			}
+			if gotKey != test.expectedKey {
+				t.Errorf("expected client %q to be used, got %q", test.expectedKey, gotKey)
+			}
+			if gotReason != acmeapi.CRLReasonKeyCompromise {
+				t.Errorf("expected reason %v, got %v", acmeapi.CRLReasonKeyCompromise, gotReason)
+			}
+		})
+	}
+}
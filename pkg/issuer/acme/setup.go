@@ -21,6 +21,7 @@ import (
 	"crypto/rsa"
 	"encoding/base64"
 	"fmt"
+	"net/http"
 	"net/url"
 	"strings"
 
@@ -28,11 +29,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
 
 	"github.com/cert-manager/cert-manager/pkg/acme"
 	"github.com/cert-manager/cert-manager/pkg/acme/accounts"
 	"github.com/cert-manager/cert-manager/pkg/acme/client"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmacme "github.com/cert-manager/cert-manager/pkg/apis/acme/v1"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
@@ -44,6 +47,7 @@ const (
 	errorAccountRegistrationFailed = "ErrRegisterACMEAccount"
 	errorAccountVerificationFailed = "ErrVerifyACMEAccount"
 	errorAccountUpdateFailed       = "ErrUpdateACMEAccount"
+	errorAdditionalAccountFailed   = "ErrAdditionalACMEAccount"
 	errorInvalidConfig             = "InvalidConfig"
 	errorInvalidURL                = "InvalidURL"
 
@@ -63,6 +67,7 @@ const (
 	messageTemplateFailedToParseURL        = "Failed to parse existing ACME server URI %q: %v"
 	messageTemplateFailedToParseAccountURL = "Failed to parse existing ACME account URI %q: %v"
 	messageTemplateFailedToGetEABKey       = "failed to get External Account Binding key from secret: %v"
+	messageTemplateAdditionalAccountFailed = "Failed to set up additional ACME account for %q: %v"
 )
 
 // Setup will verify an existing ACME registration, or create one if not
@@ -92,11 +97,9 @@ func (a *Acme) Setup(ctx context.Context) error {
 	}
 
 	// if the namespace field is not set, we are working on a ClusterIssuer resource
-	// therefore we should check for the ACME private key in the 'cluster resource namespace'.
-	ns := a.issuer.GetObjectMeta().Namespace
-	if ns == "" {
-		ns = a.clusterResourceNamespace
-	}
+	// therefore we should check for the ACME private key in its credentials
+	// namespace, or else the 'cluster resource namespace'.
+	ns := a.resourceNamespace
 
 	log = logf.WithRelatedResourceName(log, a.issuer.GetSpec().ACME.PrivateKey.Name, ns, "Secret")
 
@@ -186,19 +189,16 @@ func (a *Acme) Setup(ctx context.Context) error {
 		return nil
 	}
 
-	hasReadyCondition := apiutil.IssuerHasCondition(a.issuer, v1.IssuerCondition{
-		Type:   v1.IssuerConditionReady,
-		Status: cmmeta.ConditionTrue,
-	})
+	var specEABKeyID string
+	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
+		specEABKeyID = eabObj.KeyID
+	}
 
 	// If the Host components of the server URL and the account URL match,
-	// and the cached email matches the registered email, then
-	// we skip re-checking the account status to save excess calls to the
-	// ACME api.
-	if hasReadyCondition &&
-		a.issuer.GetStatus().ACMEStatus().URI != "" &&
-		parsedAccountURL.Host == parsedServerURL.Host &&
-		a.issuer.GetStatus().ACMEStatus().LastRegisteredEmail == a.issuer.GetSpec().ACME.Email {
+	// and the cached email and External Account Binding key ID match the
+	// registered ones, then we skip re-checking the account status to save
+	// excess calls to the ACME api.
+	if CachedRegistrationIsCurrent(a.issuer) {
 		log.V(logf.InfoLevel).Info("skipping re-verifying ACME account as cached registration " +
 			"details look sufficient")
 
@@ -212,7 +212,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 
 		// ensure the cached client in the account registry is up to date
 		a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
-		return nil
+		return a.setupAdditionalAccounts(ctx, ns, httpClient, false)
 	}
 
 	if parsedAccountURL.Host != parsedServerURL.Host {
@@ -221,6 +221,16 @@ func (a *Acme) Setup(ctx context.Context) error {
 		a.issuer.GetStatus().ACMEStatus().URI = ""
 	}
 
+	// Some CAs expire External Account Binding credentials periodically.
+	// When the key ID changes, the account is registered again with the new
+	// credentials. The account private key is unchanged, so the ACME server
+	// returns the existing account rather than creating a new one and the
+	// account's orders are kept.
+	if lastKeyID := a.issuer.GetStatus().ACMEStatus().LastRegisteredEABKeyID; specEABKeyID != "" && lastKeyID != "" && lastKeyID != specEABKeyID {
+		log.V(logf.InfoLevel).Info("External Account Binding key ID has changed. Re-registering ACME account",
+			"old_key_id", lastKeyID, "new_key_id", specEABKeyID)
+	}
+
 	var eabAccount *acmeapi.ExternalAccountBinding
 	if eabObj := a.issuer.GetSpec().ACME.ExternalAccountBinding; eabObj != nil {
 		eabKey, err := a.getEABKey(ctx, ns)
@@ -249,7 +259,7 @@ func (a *Acme) Setup(ctx context.Context) error {
 	}
 
 	// register an ACME account or retrieve it if it already exists.
-	account, err := a.registerAccount(ctx, cl, eabAccount)
+	account, err := a.registerAccount(ctx, cl, a.issuer.GetSpec().ACME.Email, eabAccount)
 	if err != nil {
 		// TODO: this error could be from an account registration or an attempt
 		// to retrieve an existing account- perhaps we should log different
@@ -312,9 +322,151 @@ func (a *Acme) Setup(ctx context.Context) error {
 	msg = messageAccountRegistered
 	a.issuer.GetStatus().ACMEStatus().URI = account.URI
 	a.issuer.GetStatus().ACMEStatus().LastRegisteredEmail = registeredEmail
+	a.issuer.GetStatus().ACMEStatus().LastRegisteredEABKeyID = specEABKeyID
 	// ensure the cached client in the account registry is up to date
 	a.accountRegistry.AddClient(httpClient, string(a.issuer.GetUID()), *a.issuer.GetSpec().ACME, rsaPk, a.userAgent)
 
+	return a.setupAdditionalAccounts(ctx, ns, httpClient, true)
+}
+
+// CachedRegistrationIsCurrent returns true if iss is Ready and the ACME
+// account recorded in its status was registered with the server, email
+// address and External Account Binding key ID in its spec, in which case the
+// account does not need to be verified with the ACME server again.
+// iss is not modified, so objects from an informer cache may be passed.
+func CachedRegistrationIsCurrent(iss v1.GenericIssuer) bool {
+	spec, status := iss.GetSpec().ACME, iss.GetStatus().ACME
+	if spec == nil || status == nil || status.URI == "" {
+		return false
+	}
+	if !apiutil.IssuerHasCondition(iss, v1.IssuerCondition{
+		Type:   v1.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		return false
+	}
+
+	parsedServerURL, err := url.Parse(spec.Server)
+	if err != nil {
+		return false
+	}
+	parsedAccountURL, err := url.Parse(status.URI)
+	if err != nil {
+		return false
+	}
+
+	var specEABKeyID string
+	if spec.ExternalAccountBinding != nil {
+		specEABKeyID = spec.ExternalAccountBinding.KeyID
+	}
+
+	return parsedAccountURL.Host == parsedServerURL.Host &&
+		status.LastRegisteredEmail == spec.Email &&
+		status.LastRegisteredEABKeyID == specEABKeyID
+}
+
+// setupAdditionalAccounts ensures that a client for each of the issuer's
+// additional ACME accounts is stored in the account registry, registering
+// the accounts with the ACME server where needed. Clients for accounts that
+// have been removed from the issuer are removed from the registry.
+// If reverify is false, accounts that already have a client in the registry
+// are not re-checked with the ACME server.
+// Failing to set up an additional account does not affect the Ready
+// condition of the issuer, as Certificates using the primary account can
+// still be issued.
+func (a *Acme) setupAdditionalAccounts(ctx context.Context, ns string, httpClient *http.Client, reverify bool) error {
+	log := logf.FromContext(ctx)
+	uid := string(a.issuer.GetUID())
+
+	wanted := make(map[string]struct{})
+	var errs []error
+	for _, acc := range a.issuer.GetSpec().ACME.AdditionalAccounts {
+		key := accounts.ClientKey(uid, acc.Email)
+		wanted[key] = struct{}{}
+
+		if !reverify {
+			if _, err := a.accountRegistry.GetClient(key); err == nil {
+				continue
+			}
+		}
+
+		if err := a.setupAdditionalAccount(ctx, ns, httpClient, acc); err != nil {
+			msg := fmt.Sprintf(messageTemplateAdditionalAccountFailed, acc.Email, err)
+			log.Error(err, "failed to set up additional ACME account", "email", acc.Email)
+			a.recorder.Event(a.issuer, corev1.EventTypeWarning, errorAdditionalAccountFailed, msg)
+
+			// Do not retry if retrying will not resolve the error, for
+			// example if the account private key is invalid or the ACME
+			// server rejected the registration.
+			if acmeErr, ok := err.(*acmeapi.Error); ok && acmeErr.StatusCode >= 400 && acmeErr.StatusCode < 500 {
+				continue
+			}
+			if apierrors.IsNotFound(err) || errors.IsInvalidData(err) {
+				continue
+			}
+			errs = append(errs, err)
+		}
+	}
+
+	for key := range a.accountRegistry.ListClients() {
+		if !strings.HasPrefix(key, uid+"/") {
+			continue
+		}
+		if _, ok := wanted[key]; !ok {
+			a.accountRegistry.RemoveClient(key)
+		}
+	}
+
+	return utilerrors.NewAggregate(errs)
+}
+
+// setupAdditionalAccount registers a single additional ACME account with the
+// ACME server and stores a client for it in the account registry. The
+// account uses the same server and External Account Binding as the issuer's
+// primary account.
+func (a *Acme) setupAdditionalAccount(ctx context.Context, ns string, httpClient *http.Client, acc cmacme.ACMEAdditionalAccount) error {
+	spec := a.issuer.GetSpec().ACME
+
+	sel := acme.PrivateKeySelector(acc.PrivateKey)
+	pk, err := a.keyFromSecret(ctx, ns, sel.Name, sel.Key)
+	switch {
+	case !spec.DisableAccountKeyGeneration && apierrors.IsNotFound(err):
+		pk, err = a.createAccountPrivateKey(ctx, sel, ns)
+		if err != nil {
+			return err
+		}
+	case err != nil:
+		return err
+	}
+	rsaPk, ok := pk.(*rsa.PrivateKey)
+	if !ok {
+		return errors.NewInvalidData(messageTemplateNotRSA, sel.Name)
+	}
+
+	var eabAccount *acmeapi.ExternalAccountBinding
+	if eabObj := spec.ExternalAccountBinding; eabObj != nil {
+		eabKey, err := a.getEABKey(ctx, ns)
+		if err != nil {
+			return err
+		}
+		eabAccount = &acmeapi.ExternalAccountBinding{
+			KID: eabObj.KeyID,
+			Key: eabKey,
+		}
+	}
+
+	cl := a.clientBuilder(httpClient, *spec, rsaPk, a.userAgent)
+	account, err := a.registerAccount(ctx, cl, acc.Email, eabAccount)
+	if err != nil {
+		return err
+	}
+	// the account is registered with a lowercase contact, so compare against
+	// the lowercase email to avoid updating the account on every sync
+	if _, _, err := ensureEmailUpToDate(ctx, cl, account, strings.ToLower(acc.Email)); err != nil {
+		return err
+	}
+
+	a.accountRegistry.AddClient(httpClient, accounts.ClientKey(string(a.issuer.GetUID()), acc.Email), *spec, rsaPk, a.userAgent)
 	return nil
 }
 
@@ -349,14 +501,15 @@ func ensureEmailUpToDate(ctx context.Context, cl client.Interface, acc *acmeapi.
 	return acc, registeredEmail, nil
 }
 
-// registerAccount will register a new ACME account with the server. If an
-// account with the clients private key already exists, it will attempt to look
-// up and verify the corresponding account, and will return that. If this fails
-// due to a not found error it will register a new account with the given key.
-func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, eabAccount *acmeapi.ExternalAccountBinding) (*acmeapi.Account, error) {
+// registerAccount will register a new ACME account with the server using the
+// given contact email. If an account with the clients private key already
+// exists, it will attempt to look up and verify the corresponding account, and
+// will return that. If this fails due to a not found error it will register a
+// new account with the given key.
+func (a *Acme) registerAccount(ctx context.Context, cl client.Interface, email string, eabAccount *acmeapi.ExternalAccountBinding) (*acmeapi.Account, error) {
 	emailurl := []string(nil)
-	if a.issuer.GetSpec().ACME.Email != "" {
-		emailurl = []string{fmt.Sprintf("mailto:%s", strings.ToLower(a.issuer.GetSpec().ACME.Email))}
+	if email != "" {
+		emailurl = []string{fmt.Sprintf("mailto:%s", strings.ToLower(email))}
 	}
 
 	acc := &acmeapi.Account{
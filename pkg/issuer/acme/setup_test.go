@@ -135,7 +135,10 @@ func TestAcme_Setup(t *testing.T) {
 		// expected issuer conditions after Setup has been called.
 		expectedConditions []cmapi.IssuerCondition
 		expectedEvents     []string
-		wantsErr           bool
+		// expected EAB key ID in the issuer's status after Setup has been
+		// called. Not checked if empty.
+		expectedEABKeyID string
+		wantsErr         bool
 	}{
 		"LetsEncrypt ACME v1 prod URL specified, return early": {
 			issuer: gen.IssuerFrom(baseIssuer,
@@ -263,6 +266,57 @@ func TestAcme_Setup(t *testing.T) {
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
 		},
+		"ACME Issuer is ready, URL, email and EAB key ID are matching": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMEEAB(someString, someString),
+				gen.SetIssuerACMELastRegisteredEABKeyID(someString),
+				gen.AddIssuerCondition(
+					*gen.IssuerConditionFrom(readyTrueCondition,
+						gen.SetIssuerConditionStatus(cmmeta.ConditionTrue)))),
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionMessage(messageAccountRegistered),
+					gen.SetIssuerConditionReason(successAccountRegistered)),
+			},
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			expectedEABKeyID:           someString,
+		},
+		"ACME Issuer is ready, but EAB key ID has changed, should re-register the existing account": {
+			issuer: gen.IssuerFrom(baseIssuer,
+				gen.SetIssuerACMEAccountURL(acmev2Prod),
+				gen.SetIssuerACMEEmail(someEmail),
+				gen.SetIssuerACMELastRegisteredEmail(someEmail),
+				gen.SetIssuerACMEEAB("new-key-id", someString),
+				gen.SetIssuerACMELastRegisteredEABKeyID("old-key-id"),
+				gen.AddIssuerCondition(
+					*gen.IssuerConditionFrom(readyTrueCondition,
+						gen.SetIssuerConditionStatus(cmmeta.ConditionTrue)))),
+			kfsKey:                     rsaPrivKey,
+			removeClientShouldBeCalled: true,
+			addClientShouldBeCalled:    true,
+			eabSecret:                  eabSecret,
+			registerErr:                acmeapi.ErrAccountAlreadyExists,
+			getRegAcc:                  &acmeapi.Account{URI: acmev2Prod, Contact: []string{someEmailURL}},
+			expectedRegisteredAcc: &acmeapi.Account{ExternalAccountBinding: &acmeapi.ExternalAccountBinding{
+				KID: "new-key-id",
+				Key: []byte(eabKey),
+			},
+				Contact: []string{someEmailURL},
+			},
+			expectedConditions: []cmapi.IssuerCondition{
+				*gen.IssuerConditionFrom(readyTrueCondition,
+					gen.SetIssuerConditionStatus(cmmeta.ConditionTrue),
+					gen.SetIssuerConditionReason(successAccountRegistered),
+					gen.SetIssuerConditionMessage(messageAccountRegistered)),
+			},
+			expectedEABKeyID: "new-key-id",
+		},
 		"EAB for issuer specified, but the corresponding secret is not found": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
@@ -346,6 +400,7 @@ func TestAcme_Setup(t *testing.T) {
 		"ACME account with EAB registered successfully": {
 			issuer: gen.IssuerFrom(baseIssuer,
 				gen.SetIssuerACMEEAB(someString, someString)),
+			expectedEABKeyID:           someString,
 			kfsKey:                     rsaPrivKey,
 			removeClientShouldBeCalled: true,
 			addClientShouldBeCalled:    true,
@@ -414,6 +469,9 @@ func TestAcme_Setup(t *testing.T) {
 				AddClientFunc: func(string, cmacme.ACMEIssuer, *rsa.PrivateKey, string) {
 					addClientWasCalled = true
 				},
+				ListClientsFunc: func() map[string]acmecl.Interface {
+					return nil
+				},
 			}
 
 			// Mock ACME client.
@@ -479,6 +537,15 @@ func TestAcme_Setup(t *testing.T) {
 					test.expectedConditions, gotConditions)
 			}
 
+			// Verify that the EAB key ID the account is registered with is
+			// reported.
+			if test.expectedEABKeyID != "" {
+				if got := a.issuer.GetStatus().ACMEStatus().LastRegisteredEABKeyID; got != test.expectedEABKeyID {
+					t.Errorf("Expected issuer's last registered EAB key ID: %q, got: %q",
+						test.expectedEABKeyID, got)
+				}
+			}
+
 			// Verify that the expected events were recorded.
 			if !util.EqualSorted(test.expectedEvents, recorder.Events) {
 				t.Errorf("Expected events:\n%+#v\ngot:%+#v",
@@ -489,6 +556,148 @@ func TestAcme_Setup(t *testing.T) {
 	}
 }
 
+func TestAcme_SetupAdditionalAccounts(t *testing.T) {
+	const uid = "issuer-uid"
+	var (
+		rsaPrivKey = mustGenerateRSAKey(t)
+
+		teamA = cmacme.ACMEAdditionalAccount{
+			Email:      "Team-A@example.com",
+			PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "team-a"}},
+		}
+		teamB = cmacme.ACMEAdditionalAccount{
+			Email:      "team-b@example.com",
+			PrivateKey: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "team-b"}},
+		}
+		teamAKey = accounts.ClientKey(uid, teamA.Email)
+		teamBKey = accounts.ClientKey(uid, teamB.Email)
+		staleKey = accounts.ClientKey(uid, "old@example.com")
+
+		acmeErr403 = &acmeapi.Error{StatusCode: 403}
+		acmeErr500 = &acmeapi.Error{StatusCode: 500}
+	)
+
+	tests := map[string]struct {
+		accounts []cmacme.ACMEAdditionalAccount
+		reverify bool
+		// clients already stored in the registry
+		existingClients []string
+		registerErr     error
+
+		expectedRegisteredContacts [][]string
+		expectedAddedClients       []string
+		expectedRemovedClients     []string
+		expectedEvents             []string
+		wantsErr                   bool
+	}{
+		"registers each additional account with its own contact": {
+			accounts:                   []cmacme.ACMEAdditionalAccount{teamA, teamB},
+			reverify:                   true,
+			expectedRegisteredContacts: [][]string{{"mailto:team-a@example.com"}, {"mailto:team-b@example.com"}},
+			expectedAddedClients:       []string{teamAKey, teamBKey},
+		},
+		"does not re-register accounts with an existing client unless re-verifying": {
+			accounts:                   []cmacme.ACMEAdditionalAccount{teamA, teamB},
+			existingClients:            []string{uid, teamAKey},
+			expectedRegisteredContacts: [][]string{{"mailto:team-b@example.com"}},
+			expectedAddedClients:       []string{teamBKey},
+		},
+		"removes clients for accounts no longer listed on the issuer": {
+			accounts:                   []cmacme.ACMEAdditionalAccount{teamA},
+			reverify:                   true,
+			existingClients:            []string{uid, "other-issuer-uid/old@example.com", teamAKey, staleKey},
+			expectedRegisteredContacts: [][]string{{"mailto:team-a@example.com"}},
+			expectedAddedClients:       []string{teamAKey},
+			expectedRemovedClients:     []string{staleKey},
+		},
+		"registration rejected by the ACME server is not retried": {
+			accounts:                   []cmacme.ACMEAdditionalAccount{teamB},
+			reverify:                   true,
+			registerErr:                acmeErr403,
+			expectedRegisteredContacts: [][]string{{"mailto:team-b@example.com"}},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAdditionalAccountFailed, fmt.Sprintf(messageTemplateAdditionalAccountFailed, teamB.Email, acmeErr403)),
+			},
+		},
+		"registration failing with a server error is retried": {
+			accounts:                   []cmacme.ACMEAdditionalAccount{teamB},
+			reverify:                   true,
+			registerErr:                acmeErr500,
+			expectedRegisteredContacts: [][]string{{"mailto:team-b@example.com"}},
+			expectedEvents: []string{
+				fmt.Sprintf("%s %s %s", corev1.EventTypeWarning, errorAdditionalAccountFailed, fmt.Sprintf(messageTemplateAdditionalAccountFailed, teamB.Email, acmeErr500)),
+			},
+			wantsErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			issuer := gen.Issuer("test-issuer", gen.SetIssuerACMEURL(acmev2Prod))
+			issuer.UID = uid
+			issuer.Spec.ACME.AdditionalAccounts = test.accounts
+
+			clients := make(map[string]acmecl.Interface)
+			for _, key := range test.existingClients {
+				clients[key] = &acmecl.FakeACME{}
+			}
+			var added, removed []string
+			ar := &fakeregistry.FakeRegistry{
+				AddClientFunc: func(key string, _ cmacme.ACMEIssuer, _ *rsa.PrivateKey, _ string) {
+					added = append(added, key)
+				},
+				RemoveClientFunc: func(key string) {
+					removed = append(removed, key)
+				},
+				GetClientFunc: func(key string) (acmecl.Interface, error) {
+					if cl, ok := clients[key]; ok {
+						return cl, nil
+					}
+					return nil, accounts.ErrNotFound
+				},
+				ListClientsFunc: func() map[string]acmecl.Interface {
+					return clients
+				},
+			}
+
+			var contacts [][]string
+			cl := acmecl.FakeACME{
+				FakeRegister: func(_ context.Context, a *acmeapi.Account, _ func(string) bool) (*acmeapi.Account, error) {
+					contacts = append(contacts, a.Contact)
+					return a, test.registerErr
+				},
+			}
+
+			kfsWasCalled := false
+			recorder := new(controllertest.FakeRecorder)
+			a := Acme{
+				issuer:          issuer,
+				secretsClient:   coreclients.NewFakeSecretsGetter(),
+				accountRegistry: ar,
+				keyFromSecret:   keyFromSecretMockBuilder(&kfsWasCalled, rsaPrivKey, nil),
+				clientBuilder:   clientBuilderMock(&cl),
+				recorder:        recorder,
+			}
+
+			err := a.setupAdditionalAccounts(context.Background(), "default", nil, test.reverify)
+			if (err != nil) != test.wantsErr {
+				t.Errorf("Expected error: %v, got: %v", test.wantsErr, err)
+			}
+			if !reflect.DeepEqual(contacts, test.expectedRegisteredContacts) {
+				t.Errorf("Expected registered contacts %v, got %v", test.expectedRegisteredContacts, contacts)
+			}
+			if !reflect.DeepEqual(added, test.expectedAddedClients) {
+				t.Errorf("Expected added clients %v, got %v", test.expectedAddedClients, added)
+			}
+			if !reflect.DeepEqual(removed, test.expectedRemovedClients) {
+				t.Errorf("Expected removed clients %v, got %v", test.expectedRemovedClients, removed)
+			}
+			if !util.EqualSorted(test.expectedEvents, recorder.Events) {
+				t.Errorf("Expected events:\n%+#v\ngot:%+#v", test.expectedEvents, recorder.Events)
+			}
+		})
+	}
+}
+
 // keyFromSecretMockBuilder returns a mock implementation of keyFromSecretFunc.
 func keyFromSecretMockBuilder(wasCalled *bool, key crypto.Signer, err error) keyFromSecretFunc {
 	return func(context.Context, string, string, string) (crypto.Signer, error) {
@@ -0,0 +1,125 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/arn"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Builder constructs an AWS Private CA client for an issuer. Static
+// credentials are read from Secrets in namespace; if none are configured,
+// ambient credentials are used when ambient is true.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, ambient bool, userAgent string) (acmpcaiface.ACMPCAAPI, error)
+
+// New constructs an AWS Private CA client for the given issuer.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, ambient bool, userAgent string) (acmpcaiface.ACMPCAAPI, error) {
+	cfg := issuer.GetSpec().AWSPCA
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have an AWS Private CA configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	sessionOpts := session.Options{
+		Config: *aws.NewConfig(),
+	}
+
+	useAmbientCredentials := cfg.Auth == nil
+	if useAmbientCredentials {
+		if !ambient {
+			return nil, fmt.Errorf("no credentials configured and ambient credentials are not permitted for this issuer")
+		}
+		// Leaving credentials unset results in the default credential chain
+		// being used.
+	} else {
+		accessKeyID, err := readSecretKey(secretsLister, namespace, cfg.Auth.AccessKeyIDSecretRef.Name, cfg.Auth.AccessKeyIDSecretRef.Key)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := readSecretKey(secretsLister, namespace, cfg.Auth.SecretAccessKeySecretRef.Name, cfg.Auth.SecretAccessKeySecretRef.Key)
+		if err != nil {
+			return nil, err
+		}
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentials(accessKeyID, secretAccessKey, "")
+		// also disable 'ambient' region sources
+		sessionOpts.SharedConfigState = session.SharedConfigDisable
+	}
+
+	// The region defaults to the region of the certificate authority so that
+	// it does not need to be configured twice.
+	region := cfg.Region
+	if region == "" {
+		caArn, err := arn.Parse(cfg.Arn)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse certificate authority ARN %q: %v", cfg.Arn, err)
+		}
+		region = caArn.Region
+	}
+	sessionOpts.Config.WithRegion(region)
+
+	sess, err := session.NewSessionWithOptions(sessionOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %v", err)
+	}
+
+	if cfg.Role != "" {
+		result, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+			RoleArn:         aws.String(cfg.Role),
+			RoleSessionName: aws.String("cert-manager"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to assume role %q: %v", cfg.Role, err)
+		}
+
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentialsFromCreds(credentials.Value{
+			AccessKeyID:     *result.Credentials.AccessKeyId,
+			SecretAccessKey: *result.Credentials.SecretAccessKey,
+			SessionToken:    *result.Credentials.SessionToken,
+		})
+		sess, err = session.NewSessionWithOptions(sessionOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create aws session: %v", err)
+		}
+	}
+
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(userAgent))
+	return acmpca.New(sess), nil
+}
+
+func readSecretKey(secretsLister corelisters.SecretLister, namespace, name, key string) (string, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(name)
+	if err != nil {
+		return "", err
+	}
+	value, ok := secret.Data[key]
+	if !ok {
+		return "", fmt.Errorf("no data for %q in secret '%s/%s'", key, namespace, name)
+	}
+	return strings.TrimSpace(string(value)), nil
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awspca
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified  = "AWSPCAVerified"
+	messageVerified  = "Private CA verified"
+	errorAWSPCA      = "AWSPCAError"
+	messageNotActive = "Private CA is not active"
+)
+
+// Setup verifies that the configured certificate authority exists, can be
+// described with the configured credentials and is able to issue
+// certificates.
+func (a *AWSPCA) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup AWS Private CA issuer"
+			a.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(a.issuer, a.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorAWSPCA, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	cfg := a.issuer.GetSpec().AWSPCA
+	client, err := a.clientBuilder(a.resourceNamespace, a.secretsLister, a.issuer, a.IssuerOptions.CanUseAmbientCredentials(a.issuer), a.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	out, err := client.DescribeCertificateAuthorityWithContext(ctx, &acmpca.DescribeCertificateAuthorityInput{
+		CertificateAuthorityArn: aws.String(cfg.Arn),
+	})
+	if err != nil {
+		return fmt.Errorf("error describing certificate authority: %v", err)
+	}
+
+	if status := aws.StringValue(out.CertificateAuthority.Status); status != acmpca.CertificateAuthorityStatusActive {
+		return fmt.Errorf("%s, status is %q", messageNotActive, status)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(a.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		a.Recorder.Event(a.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	a.log.V(logf.DebugLevel).Info("AWS Private CA issuer verified")
+	apiutil.SetIssuerCondition(a.issuer, a.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package awspca
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/service/acmpca"
+	"github.com/aws/aws-sdk-go/service/acmpca/acmpcaiface"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/awspca/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/awspca/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	const caArn = "arn:aws:acm-pca:eu-west-1:123456789012:certificate-authority/12345678-1234-1234-1234-123456789012"
+	baseIssuer := gen.Issuer("test-issuer", gen.SetIssuerAWSPCA(cmapi.AWSPCAIssuer{Arn: caArn}))
+
+	clientWithStatus := func(status string) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (acmpcaiface.ACMPCAAPI, error) {
+			return &fake.ACMPCA{
+				DescribeCertificateAuthorityFn: func(in *acmpca.DescribeCertificateAuthorityInput) (*acmpca.DescribeCertificateAuthorityOutput, error) {
+					if aws.StringValue(in.CertificateAuthorityArn) != caArn {
+						t.Errorf("unexpected certificate authority ARN %q", aws.StringValue(in.CertificateAuthorityArn))
+					}
+					return &acmpca.DescribeCertificateAuthorityOutput{
+						CertificateAuthority: &acmpca.CertificateAuthority{Status: aws.String(status)},
+					}, nil
+				},
+			}, nil
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (acmpcaiface.ACMPCAAPI, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAWSPCA,
+				Message: "Failed to setup AWS Private CA issuer: error building client: this is an error",
+			},
+		},
+		"if the certificate authority cannot be described then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (acmpcaiface.ACMPCAAPI, error) {
+				return &fake.ACMPCA{
+					DescribeCertificateAuthorityFn: func(*acmpca.DescribeCertificateAuthorityInput) (*acmpca.DescribeCertificateAuthorityOutput, error) {
+						return nil, errors.New("access denied")
+					},
+				}, nil
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAWSPCA,
+				Message: "Failed to setup AWS Private CA issuer: error describing certificate authority: access denied",
+			},
+		},
+		"if the certificate authority is not active then should error": {
+			clientBuilder: clientWithStatus(acmpca.CertificateAuthorityStatusDisabled),
+			expectedErr:   true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAWSPCA,
+				Message: `Failed to setup AWS Private CA issuer: Private CA is not active, status is "DISABLED"`,
+			},
+		},
+		"if the certificate authority is active then should set condition": {
+			clientBuilder:  clientWithStatus(acmpca.CertificateAuthorityStatusActive),
+			expectedEvents: []string{"Normal AWSPCAVerified Private CA verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := baseIssuer.DeepCopy()
+
+			a := &AWSPCA{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("awspca"),
+			}
+
+			err := a.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
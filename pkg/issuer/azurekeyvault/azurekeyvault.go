@@ -0,0 +1,67 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurekeyvault
+
+import (
+	"github.com/go-logr/logr"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	"github.com/cert-manager/cert-manager/pkg/issuer"
+	"github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// AzureKeyVault is an issuer which signs certificates using the key of a CA
+// certificate stored in Azure Key Vault.
+type AzureKeyVault struct {
+	issuer cmapi.GenericIssuer
+	*controller.Context
+
+	serviceAccounts corev1client.ServiceAccountsGetter
+
+	// Namespace in which to read resources related to this Issuer from.
+	// For Issuers, this will be the namespace of the Issuer.
+	// For ClusterIssuers, this will be the cluster resource namespace.
+	resourceNamespace string
+
+	clientBuilder client.Builder
+
+	log logr.Logger
+}
+
+func NewAzureKeyVault(ctx *controller.Context, issuer cmapi.GenericIssuer) (issuer.Interface, error) {
+	resourceNamespace, err := ctx.IssuerOptions.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, err
+	}
+
+	return &AzureKeyVault{
+		issuer:            issuer,
+		serviceAccounts:   ctx.Client.CoreV1(),
+		resourceNamespace: resourceNamespace,
+		clientBuilder:     client.New,
+		Context:           ctx,
+		log:               logf.Log.WithName("azurekeyvault"),
+	}, nil
+}
+
+func init() {
+	issuer.RegisterIssuer(apiutil.IssuerAzureKeyVault, NewAzureKeyVault)
+}
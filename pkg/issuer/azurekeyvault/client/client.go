@@ -0,0 +1,198 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+	"github.com/Azure/go-autorest/autorest"
+	"github.com/Azure/go-autorest/autorest/adal"
+	"github.com/Azure/go-autorest/autorest/azure"
+	"github.com/Azure/go-autorest/autorest/to"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// DefaultTokenAudience is the audience Azure AD expects ServiceAccount tokens
+// used for workload identity federation to be issued for.
+const DefaultTokenAudience = "api://AzureADTokenExchange"
+
+// Interface is the subset of the Azure Key Vault API used by the issuer.
+type Interface interface {
+	// GetCertificate returns the current version of the named certificate
+	// and the ID of its key.
+	GetCertificate(ctx context.Context, name string) (*x509.Certificate, string, error)
+
+	// Sign signs digest with the key with the given ID.
+	Sign(ctx context.Context, keyID string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error)
+}
+
+// Builder constructs an Azure Key Vault client for an issuer. Tokens for
+// workload identity federation are requested for ServiceAccounts in
+// namespace; if no ServiceAccount is configured, the managed identity of the
+// Pod is used when ambient is true.
+type Builder func(namespace string, serviceAccounts corev1client.ServiceAccountsGetter,
+	issuer cmapi.GenericIssuer, ambient bool, userAgent string) (Interface, error)
+
+// New constructs an Azure Key Vault client for the given issuer.
+func New(namespace string, serviceAccounts corev1client.ServiceAccountsGetter, issuer cmapi.GenericIssuer, ambient bool, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().AzureKeyVault
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have an Azure Key Vault configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	env := azure.PublicCloud
+	if cfg.Environment != "" {
+		var err error
+		env, err = azure.EnvironmentFromName(cfg.Environment)
+		if err != nil {
+			return nil, err
+		}
+	}
+	resource := strings.TrimSuffix(env.ResourceIdentifiers.KeyVault, "/")
+
+	var spt *adal.ServicePrincipalToken
+	if cfg.ServiceAccountRef != nil {
+		oauthConfig, err := adal.NewOAuthConfig(env.ActiveDirectoryEndpoint, cfg.TenantID)
+		if err != nil {
+			return nil, err
+		}
+		spt, err = adal.NewServicePrincipalTokenWithSecret(*oauthConfig, cfg.ClientID, resource, &federatedTokenSecret{
+			serviceAccounts: serviceAccounts,
+			namespace:       namespace,
+			ref:             cfg.ServiceAccountRef,
+		})
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		if !ambient {
+			return nil, fmt.Errorf("no service account configured and ambient credentials are not permitted for this issuer")
+		}
+		var err error
+		spt, err = adal.NewServicePrincipalTokenFromManagedIdentity(resource, &adal.ManagedIdentityOptions{ClientID: cfg.ClientID})
+		if err != nil {
+			return nil, fmt.Errorf("failed to create the managed service identity token: %v", err)
+		}
+	}
+
+	kv := keyvault.New()
+	kv.Authorizer = autorest.NewBearerAuthorizer(spt)
+	if err := kv.AddToUserAgent(userAgent); err != nil {
+		return nil, err
+	}
+
+	return &client{
+		kv:       kv,
+		vaultURL: strings.TrimSuffix(cfg.VaultURL, "/"),
+	}, nil
+}
+
+type client struct {
+	kv       keyvault.BaseClient
+	vaultURL string
+}
+
+func (c *client) GetCertificate(ctx context.Context, name string) (*x509.Certificate, string, error) {
+	bundle, err := c.kv.GetCertificate(ctx, c.vaultURL, name, "")
+	if err != nil {
+		return nil, "", err
+	}
+	if bundle.Cer == nil || bundle.Kid == nil {
+		return nil, "", fmt.Errorf("certificate %q has no certificate data or key", name)
+	}
+	cert, err := x509.ParseCertificate(*bundle.Cer)
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to parse certificate %q: %v", name, err)
+	}
+	return cert, *bundle.Kid, nil
+}
+
+func (c *client) Sign(ctx context.Context, keyID string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error) {
+	name, version, err := parseKeyID(keyID)
+	if err != nil {
+		return nil, err
+	}
+	result, err := c.kv.Sign(ctx, c.vaultURL, name, version, keyvault.KeySignParameters{
+		Algorithm: algorithm,
+		Value:     to.StringPtr(base64.RawURLEncoding.EncodeToString(digest)),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if result.Result == nil {
+		return nil, fmt.Errorf("key %q returned no signature", keyID)
+	}
+	return base64.RawURLEncoding.DecodeString(*result.Result)
+}
+
+// parseKeyID returns the name and version of the key identified by keyID,
+// which has the form https://<vault>/keys/<name>/<version>.
+func parseKeyID(keyID string) (string, string, error) {
+	u, err := url.Parse(keyID)
+	if err != nil {
+		return "", "", fmt.Errorf("invalid key ID %q: %v", keyID, err)
+	}
+	parts := strings.Split(strings.Trim(u.Path, "/"), "/")
+	if len(parts) != 3 || parts[0] != "keys" {
+		return "", "", fmt.Errorf("invalid key ID %q", keyID)
+	}
+	return parts[1], parts[2], nil
+}
+
+// federatedTokenSecret implements adal.ServicePrincipalSecret by presenting a
+// freshly requested ServiceAccount token as a client assertion each time the
+// Azure AD token is refreshed.
+type federatedTokenSecret struct {
+	serviceAccounts corev1client.ServiceAccountsGetter
+	namespace       string
+	ref             *cmapi.AzureKeyVaultServiceAccountRef
+}
+
+func (s *federatedTokenSecret) SetAuthenticationValues(_ *adal.ServicePrincipalToken, v *url.Values) error {
+	audiences := s.ref.Audiences
+	if len(audiences) == 0 {
+		audiences = []string{DefaultTokenAudience}
+	}
+	tr, err := s.serviceAccounts.ServiceAccounts(s.namespace).CreateToken(context.TODO(), s.ref.Name, &authenticationv1.TokenRequest{
+		Spec: authenticationv1.TokenRequestSpec{
+			Audiences:         audiences,
+			ExpirationSeconds: pointer.Int64(600),
+		},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		return fmt.Errorf("error requesting token for ServiceAccount %s/%s: %w", s.namespace, s.ref.Name, err)
+	}
+	v.Set("client_assertion", tr.Status.Token)
+	v.Set("client_assertion_type", "urn:ietf:params:oauth:client-assertion-type:jwt-bearer")
+	return nil
+}
+
+func (s federatedTokenSecret) MarshalJSON() ([]byte, error) {
+	return nil, errors.New("marshalling federatedTokenSecret is not supported")
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"crypto/x509"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+)
+
+// KeyVault is a fake Azure Key Vault client. Calling a method which has not
+// been stubbed out will panic.
+type KeyVault struct {
+	GetCertificateFn func(name string) (*x509.Certificate, string, error)
+	SignFn           func(keyID string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error)
+}
+
+func (k *KeyVault) GetCertificate(_ context.Context, name string) (*x509.Certificate, string, error) {
+	return k.GetCertificateFn(name)
+}
+
+func (k *KeyVault) Sign(_ context.Context, keyID string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error) {
+	return k.SignFn(keyID, algorithm, digest)
+}
@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"encoding/asn1"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+)
+
+// Signer is a crypto.Signer which signs digests using a key held in Azure Key
+// Vault, so that the private key never leaves Key Vault.
+type Signer struct {
+	ctx    context.Context
+	client Interface
+	keyID  string
+	public crypto.PublicKey
+}
+
+// NewSigner returns a Signer for the Key Vault key with the given ID, whose
+// public key is public. ctx is used for the Key Vault sign operations.
+func NewSigner(ctx context.Context, client Interface, keyID string, public crypto.PublicKey) *Signer {
+	return &Signer{
+		ctx:    ctx,
+		client: client,
+		keyID:  keyID,
+		public: public,
+	}
+}
+
+func (s *Signer) Public() crypto.PublicKey {
+	return s.public
+}
+
+// Sign signs digest using the Key Vault key. Key Vault returns ECDSA
+// signatures as the concatenation of r and s, so they are re-encoded in the
+// ASN.1 form expected by crypto/x509.
+func (s *Signer) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	algorithm, err := signatureAlgorithm(s.public, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	sig, err := s.client.Sign(s.ctx, s.keyID, algorithm, digest)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, ok := s.public.(*ecdsa.PublicKey); ok {
+		if len(sig) == 0 || len(sig)%2 != 0 {
+			return nil, fmt.Errorf("invalid ECDSA signature of length %d", len(sig))
+		}
+		half := len(sig) / 2
+		return asn1.Marshal(struct {
+			R, S *big.Int
+		}{
+			R: new(big.Int).SetBytes(sig[:half]),
+			S: new(big.Int).SetBytes(sig[half:]),
+		})
+	}
+
+	return sig, nil
+}
+
+// signatureAlgorithm returns the Key Vault signature algorithm for the key
+// type of public and the hash and padding requested by opts.
+func signatureAlgorithm(public crypto.PublicKey, opts crypto.SignerOpts) (keyvault.JSONWebKeySignatureAlgorithm, error) {
+	switch public.(type) {
+	case *rsa.PublicKey:
+		_, pss := opts.(*rsa.PSSOptions)
+		switch opts.HashFunc() {
+		case crypto.SHA256:
+			if pss {
+				return keyvault.PS256, nil
+			}
+			return keyvault.RS256, nil
+		case crypto.SHA384:
+			if pss {
+				return keyvault.PS384, nil
+			}
+			return keyvault.RS384, nil
+		case crypto.SHA512:
+			if pss {
+				return keyvault.PS512, nil
+			}
+			return keyvault.RS512, nil
+		}
+	case *ecdsa.PublicKey:
+		switch opts.HashFunc() {
+		case crypto.SHA256:
+			return keyvault.ES256, nil
+		case crypto.SHA384:
+			return keyvault.ES384, nil
+		case crypto.SHA512:
+			return keyvault.ES512, nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported key type %T", public)
+	}
+	return "", fmt.Errorf("unsupported hash function %v for key type %T", opts.HashFunc(), public)
+}
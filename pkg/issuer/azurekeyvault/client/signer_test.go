@@ -0,0 +1,146 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/Azure/azure-sdk-for-go/services/keyvault/v7.1/keyvault"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestSignerSignsCertificates(t *testing.T) {
+	ecKey, err := ecdsa.GenerateKey(elliptic.P384(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rsaKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		key               crypto.Signer
+		expectedAlgorithm keyvault.JSONWebKeySignatureAlgorithm
+		// keyVaultSign signs like Key Vault does using the local key.
+		keyVaultSign func(digest []byte) ([]byte, error)
+	}{
+		"ECDSA P-384 key": {
+			key:               ecKey,
+			expectedAlgorithm: keyvault.ES384,
+			keyVaultSign: func(digest []byte) ([]byte, error) {
+				r, s, err := ecdsa.Sign(rand.Reader, ecKey, digest)
+				if err != nil {
+					return nil, err
+				}
+				// Key Vault returns the fixed size concatenation of r and s.
+				sig := make([]byte, 96)
+				r.FillBytes(sig[:48])
+				s.FillBytes(sig[48:])
+				return sig, nil
+			},
+		},
+		"RSA key": {
+			key:               rsaKey,
+			expectedAlgorithm: keyvault.RS256,
+			keyVaultSign: func(digest []byte) ([]byte, error) {
+				return rsa.SignPKCS1v15(rand.Reader, rsaKey, crypto.SHA256, digest)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			caTmpl := &x509.Certificate{
+				SerialNumber:          big.NewInt(1),
+				Subject:               pkix.Name{CommonName: "key-vault-ca"},
+				NotBefore:             time.Now(),
+				NotAfter:              time.Now().Add(time.Hour),
+				IsCA:                  true,
+				BasicConstraintsValid: true,
+				KeyUsage:              x509.KeyUsageCertSign,
+			}
+			_, caCert, err := pki.SignCertificate(caTmpl, caTmpl, test.key.Public(), test.key)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			kv := &fake.KeyVault{
+				SignFn: func(keyID string, algorithm keyvault.JSONWebKeySignatureAlgorithm, digest []byte) ([]byte, error) {
+					if keyID != "https://vault/keys/ca/1" {
+						t.Errorf("unexpected key ID %q", keyID)
+					}
+					if algorithm != test.expectedAlgorithm {
+						t.Errorf("unexpected algorithm, exp=%q got=%q", test.expectedAlgorithm, algorithm)
+					}
+					return test.keyVaultSign(digest)
+				},
+			}
+			signer := NewSigner(context.TODO(), kv, "https://vault/keys/ca/1", caCert.PublicKey)
+
+			leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+			if err != nil {
+				t.Fatal(err)
+			}
+			leafTmpl := &x509.Certificate{
+				SerialNumber: big.NewInt(2),
+				Subject:      pkix.Name{CommonName: "leaf"},
+				NotBefore:    time.Now(),
+				NotAfter:     time.Now().Add(time.Hour),
+				PublicKey:    leafKey.Public(),
+			}
+			bundle, err := pki.SignCSRTemplate([]*x509.Certificate{caCert}, signer, leafTmpl)
+			if err != nil {
+				t.Fatalf("failed to sign certificate: %v", err)
+			}
+
+			leaf, err := pki.DecodeX509CertificateBytes(bundle.ChainPEM)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if err := leaf.CheckSignatureFrom(caCert); err != nil {
+				t.Errorf("certificate signature does not verify: %v", err)
+			}
+		})
+	}
+}
+
+func TestParseKeyID(t *testing.T) {
+	name, version, err := parseKeyID("https://my-vault.vault.azure.net/keys/my-ca/0123456789abcdef")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "my-ca" || version != "0123456789abcdef" {
+		t.Errorf("unexpected name %q and version %q", name, version)
+	}
+
+	if _, _, err := parseKeyID("https://my-vault.vault.azure.net/secrets/my-ca/0123456789abcdef"); err == nil {
+		t.Errorf("expected an error for a secret ID")
+	}
+}
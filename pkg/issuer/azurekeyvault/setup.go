@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurekeyvault
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified    = "AzureKeyVaultVerified"
+	messageVerified    = "Key Vault CA certificate verified"
+	errorAzureKeyVault = "AzureKeyVaultError"
+	messageNotCA       = "Key Vault certificate is not a CA"
+	messageExpired     = "Key Vault CA certificate has expired"
+)
+
+// Setup verifies that the configured Key Vault certificate can be read with
+// the configured credentials, and that it is a CA certificate which has not
+// expired.
+func (a *AzureKeyVault) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup Azure Key Vault issuer"
+			a.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(a.issuer, a.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorAzureKeyVault, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	cfg := a.issuer.GetSpec().AzureKeyVault
+	client, err := a.clientBuilder(a.resourceNamespace, a.serviceAccounts, a.issuer, a.IssuerOptions.CanUseAmbientCredentials(a.issuer), a.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	caCert, _, err := client.GetCertificate(ctx, cfg.CertificateName)
+	if err != nil {
+		return fmt.Errorf("error getting certificate %q: %v", cfg.CertificateName, err)
+	}
+
+	if !caCert.IsCA {
+		return fmt.Errorf("%s: %q", messageNotCA, cfg.CertificateName)
+	}
+	if a.Clock.Now().After(caCert.NotAfter) {
+		return fmt.Errorf("%s: %q expired at %s", messageExpired, cfg.CertificateName, caCert.NotAfter)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(a.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		a.Recorder.Event(a.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	a.log.V(logf.DebugLevel).Info("Azure Key Vault issuer verified")
+	apiutil.SetIssuerCondition(a.issuer, a.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
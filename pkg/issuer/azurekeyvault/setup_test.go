@@ -0,0 +1,166 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package azurekeyvault
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"testing"
+	"time"
+
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/azurekeyvault/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	now := time.Now()
+	baseIssuer := gen.Issuer("test-issuer", gen.SetIssuerAzureKeyVault(cmapi.AzureKeyVaultIssuer{
+		VaultURL:        "https://my-vault.vault.azure.net/",
+		CertificateName: "my-ca",
+	}))
+
+	clientWithCertificate := func(cert *x509.Certificate) client.Builder {
+		return func(string, corev1client.ServiceAccountsGetter, cmapi.GenericIssuer, bool, string) (client.Interface, error) {
+			return &fake.KeyVault{
+				GetCertificateFn: func(name string) (*x509.Certificate, string, error) {
+					if name != "my-ca" {
+						t.Errorf("unexpected certificate name %q", name)
+					}
+					return cert, "https://my-vault.vault.azure.net/keys/my-ca/1", nil
+				},
+			}, nil
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corev1client.ServiceAccountsGetter, cmapi.GenericIssuer, bool, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAzureKeyVault,
+				Message: "Failed to setup Azure Key Vault issuer: error building client: this is an error",
+			},
+		},
+		"if the certificate cannot be read then should error": {
+			clientBuilder: func(string, corev1client.ServiceAccountsGetter, cmapi.GenericIssuer, bool, string) (client.Interface, error) {
+				return &fake.KeyVault{
+					GetCertificateFn: func(string) (*x509.Certificate, string, error) {
+						return nil, "", errors.New("forbidden")
+					},
+				}, nil
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAzureKeyVault,
+				Message: `Failed to setup Azure Key Vault issuer: error getting certificate "my-ca": forbidden`,
+			},
+		},
+		"if the certificate is not a CA then should error": {
+			clientBuilder: clientWithCertificate(&x509.Certificate{NotAfter: now.Add(time.Hour)}),
+			expectedErr:   true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAzureKeyVault,
+				Message: `Failed to setup Azure Key Vault issuer: Key Vault certificate is not a CA: "my-ca"`,
+			},
+		},
+		"if the certificate has expired then should error": {
+			clientBuilder: clientWithCertificate(&x509.Certificate{IsCA: true, NotAfter: now.Add(-time.Hour)}),
+			expectedErr:   true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorAzureKeyVault,
+				Message: `Failed to setup Azure Key Vault issuer: Key Vault CA certificate has expired: "my-ca" expired at ` + now.Add(-time.Hour).String(),
+			},
+		},
+		"if the certificate is a valid CA then should set condition": {
+			clientBuilder:  clientWithCertificate(&x509.Certificate{IsCA: true, NotAfter: now.Add(time.Hour)}),
+			expectedEvents: []string{"Normal AzureKeyVaultVerified Key Vault CA certificate verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := baseIssuer.DeepCopy()
+
+			a := &AzureKeyVault{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+					ContextOptions: controller.ContextOptions{
+						Clock: fakeclock.NewFakeClock(now),
+					},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("azurekeyvault"),
+			}
+
+			err := a.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
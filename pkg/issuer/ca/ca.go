@@ -17,12 +17,15 @@ limitations under the License.
 package ca
 
 import (
+	"k8s.io/client-go/dynamic"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	"github.com/cert-manager/cert-manager/pkg/issuer"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 )
 
 // CA is a simple CA implementation backed by the Kubernetes API server.
@@ -33,6 +36,10 @@ type CA struct {
 	issuer        v1.GenericIssuer
 	secretsLister corelisters.SecretLister
 
+	// dynamicClient is used to publish the CA as a ClusterTrustBundle, as the
+	// ClusterTrustBundle API types are not vendored.
+	dynamicClient dynamic.Interface
+
 	// Namespace in which to read resources related to this Issuer from.
 	// For Issuers, this will be the namespace of the Issuer.
 	// For ClusterIssuers, this will be the cluster resource namespace.
@@ -42,11 +49,28 @@ type CA struct {
 func NewCA(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, error) {
 	secretsLister := ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister()
 
+	// The dynamic client can only be constructed when a REST config is
+	// available.
+	var dynamicClient dynamic.Interface
+	if ctx.RESTConfig != nil && utilfeature.DefaultFeatureGate.Enabled(feature.PublishClusterTrustBundles) {
+		var err error
+		dynamicClient, err = dynamic.NewForConfig(ctx.RESTConfig)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resourceNamespace, err := ctx.IssuerOptions.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, err
+	}
+
 	return &CA{
 		Context:           ctx,
 		issuer:            issuer,
 		secretsLister:     secretsLister,
-		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		dynamicClient:     dynamicClient,
+		resourceNamespace: resourceNamespace,
 	}, nil
 }
 
@@ -18,23 +18,36 @@ package ca
 
 import (
 	"context"
+	"crypto/x509"
+	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
 
+	"github.com/cert-manager/cert-manager/internal/clustertrustbundle"
+	"github.com/cert-manager/cert-manager/internal/controller/feature"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
 )
 
 const (
 	errorGetKeyPair     = "ErrGetKeyPair"
 	errorInvalidKeyPair = "ErrInvalidKeyPair"
+	errorGetChain       = "ErrGetChain"
+	errorInvalidChain   = "ErrInvalidChain"
+
+	errorPublishClusterTrustBundle = "ErrPublishClusterTrustBundle"
 
 	successKeyPairVerified = "KeyPairVerified"
 
 	messageErrorGetKeyPair = "Error getting keypair for CA issuer: "
+	messageErrorGetChain   = "Error getting certificate chain for CA issuer: "
+
+	messageErrorPublishClusterTrustBundle = "Error publishing CA as a ClusterTrustBundle: "
 
 	messageKeyPairVerified = "Signing CA verified"
 )
@@ -71,9 +84,81 @@ func (c *CA) Setup(ctx context.Context) error {
 		return nil
 	}
 
+	var chain []*x509.Certificate
+	if chainSecretNames := c.issuer.GetSpec().CA.ChainSecretNames; len(chainSecretNames) > 0 {
+		chain, err = kube.SecretCACertificates(ctx, c.secretsLister, c.resourceNamespace, chainSecretNames)
+		if err != nil {
+			log.Error(err, "error getting CA chain certificates")
+			s := messageErrorGetChain + err.Error()
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorGetChain, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorGetChain, s)
+			return err
+		}
+
+		// the chain secrets must complete an unbroken chain from the signing CA
+		if _, err := pki.ParseSingleCertificateChain(append([]*x509.Certificate{cert}, chain...)); err != nil {
+			s := messageErrorGetChain + err.Error()
+			log.Error(err, "CA chain certificates do not form a chain with the signing CA")
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorInvalidChain, s)
+			apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionFalse, errorInvalidChain, s)
+			// Don't return an error here as there is nothing more we can do
+			return nil
+		}
+	}
+
 	log.V(logf.DebugLevel).Info("signing CA verified")
 	c.Recorder.Event(c.issuer, corev1.EventTypeNormal, successKeyPairVerified, messageKeyPairVerified)
 	apiutil.SetIssuerCondition(c.issuer, c.issuer.GetGeneration(), v1.IssuerConditionReady, cmmeta.ConditionTrue, successKeyPairVerified, messageKeyPairVerified)
 
+	// Failing to publish the CA does not stop the issuer from signing
+	// certificates, so the issuer is left Ready and the error is retried.
+	if utilfeature.DefaultFeatureGate.Enabled(feature.PublishClusterTrustBundles) {
+		if err := c.publishClusterTrustBundle(ctx, append([]*x509.Certificate{cert}, chain...)); err != nil {
+			log.Error(err, "error publishing CA as a ClusterTrustBundle")
+			c.Recorder.Event(c.issuer, corev1.EventTypeWarning, errorPublishClusterTrustBundle, messageErrorPublishClusterTrustBundle+err.Error())
+			return err
+		}
+	}
+
 	return nil
 }
+
+// publishClusterTrustBundle publishes the root CA of the chain as a
+// ClusterTrustBundle named after the issuer.
+func (c *CA) publishClusterTrustBundle(ctx context.Context, chain []*x509.Certificate) error {
+	if c.dynamicClient == nil {
+		return fmt.Errorf("no dynamic client configured")
+	}
+
+	bundle, err := pki.ParseSingleCertificateChain(chain)
+	if err != nil {
+		return err
+	}
+	// The top of the chain is only a root CA if the chain is complete,
+	// otherwise the topmost intermediate CA is published.
+	caPEM := bundle.CAPEM
+	if len(caPEM) == 0 {
+		caPEM = bundle.ChainPEM
+	}
+
+	kind := v1.IssuerKind
+	if c.issuer.GetObjectMeta().Namespace == "" {
+		kind = v1.ClusterIssuerKind
+	}
+	labels := map[string]string{
+		v1.IssuerNameAnnotationKey: c.issuer.GetObjectMeta().Name,
+		v1.IssuerKindAnnotationKey: kind,
+	}
+	return clustertrustbundle.Publish(ctx, c.dynamicClient, clusterTrustBundleName(c.issuer), labels, string(caPEM))
+}
+
+// clusterTrustBundleName returns the name of the ClusterTrustBundle the CA of
+// the issuer is published as. Namespaces cannot contain dots, so the names of
+// Issuers in different namespaces never collide.
+func clusterTrustBundleName(iss v1.GenericIssuer) string {
+	meta := iss.GetObjectMeta()
+	if meta.Namespace == "" {
+		return fmt.Sprintf("cert-manager.clusterissuer.%s", meta.Name)
+	}
+	return fmt.Sprintf("cert-manager.issuer.%s.%s", meta.Namespace, meta.Name)
+}
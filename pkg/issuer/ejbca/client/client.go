@@ -0,0 +1,272 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// requestTimeout is the timeout of a single request to the EJBCA REST API.
+	requestTimeout = 30 * time.Second
+
+	// maxResponseSize limits the size of responses read from the EJBCA REST
+	// API.
+	maxResponseSize = 1 << 20
+)
+
+// Interface is the subset of the EJBCA REST API used by the issuer.
+type Interface interface {
+	// ListCertificateAuthorities returns the CAs that the authenticated
+	// client has access to.
+	ListCertificateAuthorities(ctx context.Context) ([]CertificateAuthority, error)
+
+	// EnrollPKCS10 enrolls a certificate for a PKCS#10 certificate signing
+	// request, creating or updating the end entity named in req.
+	EnrollPKCS10(ctx context.Context, req *EnrollPKCS10Request) (*EnrollPKCS10Response, error)
+}
+
+// Builder constructs an EJBCA client for an issuer. Client certificates and
+// tokens are read from Secrets in namespace.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, userAgent string) (Interface, error)
+
+// CertificateAuthority is a CA as returned by the EJBCA REST API.
+type CertificateAuthority struct {
+	ID        int    `json:"id"`
+	Name      string `json:"name"`
+	SubjectDN string `json:"subject_dn"`
+	IssuerDN  string `json:"issuer_dn"`
+}
+
+// EnrollPKCS10Request is the body of a PKCS#10 enrollment request.
+type EnrollPKCS10Request struct {
+	CertificateRequest       string `json:"certificate_request"`
+	CertificateProfileName   string `json:"certificate_profile_name"`
+	EndEntityProfileName     string `json:"end_entity_profile_name"`
+	CertificateAuthorityName string `json:"certificate_authority_name"`
+	Username                 string `json:"username"`
+	Password                 string `json:"password"`
+	IncludeChain             bool   `json:"include_chain"`
+}
+
+// EnrollPKCS10Response is the response to a PKCS#10 enrollment request.
+type EnrollPKCS10Response struct {
+	Certificate      string   `json:"certificate"`
+	SerialNumber     string   `json:"serial_number"`
+	ResponseFormat   string   `json:"response_format"`
+	CertificateChain []string `json:"certificate_chain"`
+}
+
+// Certificates decodes the issued certificate followed by its chain.
+func (r *EnrollPKCS10Response) Certificates() ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, c := range append([]string{r.Certificate}, r.CertificateChain...) {
+		var der []byte
+		switch r.ResponseFormat {
+		case "PEM":
+			block, _ := pem.Decode([]byte(c))
+			if block == nil {
+				return nil, errors.New("failed to decode PEM certificate")
+			}
+			der = block.Bytes
+		case "DER", "":
+			var err error
+			der, err = base64.StdEncoding.DecodeString(c)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decode certificate: %v", err)
+			}
+		default:
+			return nil, fmt.Errorf("unsupported response format %q", r.ResponseFormat)
+		}
+		cert, err := x509.ParseCertificate(der)
+		if err != nil {
+			return nil, err
+		}
+		certs = append(certs, cert)
+	}
+	return certs, nil
+}
+
+// Error is an error response from the EJBCA REST API.
+type Error struct {
+	StatusCode int    `json:"error_code"`
+	Message    string `json:"error_message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("EJBCA returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsBadRequest returns true if err is an error response from the EJBCA REST
+// API rejecting the request itself, for example because the CSR does not
+// satisfy the certificate or end entity profile. Retrying such a request
+// will not succeed.
+func IsBadRequest(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusBadRequest
+}
+
+// New constructs an EJBCA client for the given issuer.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().EJBCA
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have an EJBCA configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New("no certificates could be parsed from the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	c := &client{
+		baseURL:   strings.TrimSuffix(cfg.URL, "/"),
+		userAgent: userAgent,
+	}
+
+	switch {
+	case cfg.Auth.ClientCertSecretRef != nil:
+		name := cfg.Auth.ClientCertSecretRef.Name
+		secret, err := secretsLister.Secrets(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("unable to load client certificate from secret '%s/%s': %v", namespace, name, err)
+		}
+		tlsConfig.Certificates = []tls.Certificate{cert}
+	case cfg.Auth.TokenSecretRef != nil:
+		ref := cfg.Auth.TokenSecretRef
+		secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		token, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+		}
+		c.token = strings.TrimSpace(string(token))
+	default:
+		return nil, errors.New("no EJBCA authentication method configured")
+	}
+
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+	c.httpClient = &http.Client{Transport: transport, Timeout: requestTimeout}
+
+	return c, nil
+}
+
+type client struct {
+	baseURL    string
+	userAgent  string
+	token      string
+	httpClient *http.Client
+}
+
+func (c *client) ListCertificateAuthorities(ctx context.Context) ([]CertificateAuthority, error) {
+	var resp struct {
+		CertificateAuthorities []CertificateAuthority `json:"certificate_authorities"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/v1/ca", nil, &resp); err != nil {
+		return nil, err
+	}
+	return resp.CertificateAuthorities, nil
+}
+
+func (c *client) EnrollPKCS10(ctx context.Context, req *EnrollPKCS10Request) (*EnrollPKCS10Response, error) {
+	resp := &EnrollPKCS10Response{}
+	if err := c.do(ctx, http.MethodPost, "/v1/certificate/pkcs10enroll", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// do sends a request to the EJBCA REST API, encoding in as the JSON body of
+// the request and decoding the JSON response into out.
+func (c *client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+	if c.token != "" {
+		req.Header.Set("Authorization", "Bearer "+c.token)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &Error{}
+		if err := json.Unmarshal(b, e); err != nil || e.Message == "" {
+			e.Message = http.StatusText(resp.StatusCode)
+		}
+		e.StatusCode = resp.StatusCode
+		return e
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestEnrollPKCS10(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	leaf := &x509.Certificate{
+		Version:               3,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		PublicKeyAlgorithm:    x509.ECDSA,
+		PublicKey:             key.Public(),
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+	}
+	_, cert, err := pki.SignCertificate(leaf, leaf, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/ejbca-rest-api/v1/certificate/pkcs10enroll" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		if got := r.Header.Get("Authorization"); got != "Bearer token" {
+			t.Errorf("unexpected Authorization header %q", got)
+		}
+		var req EnrollPKCS10Request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Fatal(err)
+		}
+		if req.CertificateAuthorityName != "ManagementCA" {
+			t.Errorf("unexpected request body %+v", req)
+		}
+		if req.Username == "rejected" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"error_code":400,"error_message":"Wrong username or password"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(&EnrollPKCS10Response{
+			Certificate:      base64.StdEncoding.EncodeToString(cert.Raw),
+			ResponseFormat:   "DER",
+			CertificateChain: []string{base64.StdEncoding.EncodeToString(cert.Raw)},
+		})
+	}))
+	defer srv.Close()
+
+	c := &client{baseURL: srv.URL + "/ejbca-rest-api", token: "token", httpClient: srv.Client()}
+
+	resp, err := c.EnrollPKCS10(context.Background(), &EnrollPKCS10Request{
+		Username:                 "test",
+		CertificateAuthorityName: "ManagementCA",
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	certs, err := resp.Certificates()
+	if err != nil {
+		t.Fatalf("unexpected error decoding certificates: %v", err)
+	}
+	if len(certs) != 2 || !certs[0].Equal(cert) {
+		t.Errorf("unexpected certificates %v", certs)
+	}
+
+	_, err = c.EnrollPKCS10(context.Background(), &EnrollPKCS10Request{
+		Username:                 "rejected",
+		CertificateAuthorityName: "ManagementCA",
+	})
+	if !IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+	if err != nil && err.Error() != "EJBCA returned status 400: Wrong username or password" {
+		t.Errorf("unexpected error message %q", err.Error())
+	}
+}
@@ -0,0 +1,38 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client"
+)
+
+// EJBCA is a fake EJBCA client. Calling a method which has not been stubbed
+// out will panic.
+type EJBCA struct {
+	ListCertificateAuthoritiesFn func() ([]client.CertificateAuthority, error)
+	EnrollPKCS10Fn               func(req *client.EnrollPKCS10Request) (*client.EnrollPKCS10Response, error)
+}
+
+func (e *EJBCA) ListCertificateAuthorities(_ context.Context) ([]client.CertificateAuthority, error) {
+	return e.ListCertificateAuthoritiesFn()
+}
+
+func (e *EJBCA) EnrollPKCS10(_ context.Context, req *client.EnrollPKCS10Request) (*client.EnrollPKCS10Response, error) {
+	return e.EnrollPKCS10Fn(req)
+}
@@ -0,0 +1,82 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ejbca
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified = "EJBCAVerified"
+	messageVerified = "EJBCA certificate authority verified"
+	errorEJBCA      = "EJBCAError"
+)
+
+// Setup verifies that the configured credentials are accepted by the EJBCA
+// REST API and that the configured CA is available to them.
+func (e *EJBCA) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup EJBCA issuer"
+			e.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(e.issuer, e.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorEJBCA, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	cfg := e.issuer.GetSpec().EJBCA
+	ejbca, err := e.clientBuilder(e.resourceNamespace, e.secretsLister, e.issuer, e.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	cas, err := ejbca.ListCertificateAuthorities(ctx)
+	if err != nil {
+		return fmt.Errorf("error listing certificate authorities: %v", err)
+	}
+	found := false
+	for _, ca := range cas {
+		if ca.Name == cfg.CertificateAuthorityName {
+			found = true
+			break
+		}
+	}
+	if !found {
+		return fmt.Errorf("certificate authority %q not found", cfg.CertificateAuthorityName)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(e.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		e.Recorder.Event(e.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	e.log.V(logf.DebugLevel).Info("EJBCA issuer verified")
+	apiutil.SetIssuerCondition(e.issuer, e.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
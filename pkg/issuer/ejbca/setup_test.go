@@ -0,0 +1,152 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ejbca
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/ejbca/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := cmapi.EJBCAIssuer{
+		URL:                      "https://ejbca.example.com/ejbca/ejbca-rest-api",
+		CertificateAuthorityName: "ManagementCA",
+		CertificateProfileName:   "SERVER",
+		EndEntityProfileName:     "TLS",
+	}
+
+	withClient := func(ejbca *fake.EJBCA) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+			return ejbca, nil
+		}
+	}
+	listCAs := func(names ...string) func() ([]client.CertificateAuthority, error) {
+		return func() ([]client.CertificateAuthority, error) {
+			var cas []client.CertificateAuthority
+			for i, name := range names {
+				cas = append(cas, client.CertificateAuthority{ID: i, Name: name})
+			}
+			return cas, nil
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorEJBCA,
+				Message: "Failed to setup EJBCA issuer: error building client: this is an error",
+			},
+		},
+		"if the certificate authorities cannot be listed then should error": {
+			clientBuilder: withClient(&fake.EJBCA{
+				ListCertificateAuthoritiesFn: func() ([]client.CertificateAuthority, error) {
+					return nil, &client.Error{StatusCode: 403, Message: "Not authorized"}
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorEJBCA,
+				Message: "Failed to setup EJBCA issuer: error listing certificate authorities: EJBCA returned status 403: Not authorized",
+			},
+		},
+		"if the certificate authority does not exist then should error": {
+			clientBuilder: withClient(&fake.EJBCA{ListCertificateAuthoritiesFn: listCAs("IssuingCA")}),
+			expectedErr:   true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorEJBCA,
+				Message: `Failed to setup EJBCA issuer: certificate authority "ManagementCA" not found`,
+			},
+		},
+		"if the certificate authority exists then should set condition": {
+			clientBuilder:  withClient(&fake.EJBCA{ListCertificateAuthoritiesFn: listCAs("IssuingCA", "ManagementCA")}),
+			expectedEvents: []string{"Normal EJBCAVerified EJBCA certificate authority verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := gen.Issuer("test-issuer", gen.SetIssuerEJBCA(cfg))
+
+			e := &EJBCA{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("ejbca"),
+			}
+
+			err := e.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/option"
+	privateca "google.golang.org/api/privateca/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Interface is the subset of the Google CA Service API used by the issuer.
+type Interface interface {
+	// GetCaPool returns the CA pool with the given full resource name.
+	GetCaPool(ctx context.Context, name string) (*privateca.CaPool, error)
+
+	// GetCertificateAuthority returns the certificate authority with the
+	// given full resource name.
+	GetCertificateAuthority(ctx context.Context, name string) (*privateca.CertificateAuthority, error)
+
+	// CreateCertificate requests a certificate from the CA pool parent. If
+	// issuingCertificateAuthorityID is empty, Google CA Service chooses the
+	// certificate authority. requestID makes retries idempotent.
+	CreateCertificate(ctx context.Context, parent, certificateID, issuingCertificateAuthorityID, requestID string, cert *privateca.Certificate) (*privateca.Certificate, error)
+
+	// GetCertificate returns the certificate with the given full resource
+	// name.
+	GetCertificate(ctx context.Context, name string) (*privateca.Certificate, error)
+}
+
+// Builder constructs a Google CA Service client for an issuer. A service
+// account key is read from Secrets in namespace; if none is configured,
+// ambient credentials are used when ambient is true.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, ambient bool, userAgent string) (Interface, error)
+
+// CaPoolName returns the full resource name of the CA pool of cfg.
+func CaPoolName(cfg *cmapi.GoogleCASIssuer) string {
+	return fmt.Sprintf("projects/%s/locations/%s/caPools/%s", cfg.Project, cfg.Location, cfg.CAPoolID)
+}
+
+// CertificateAuthorityName returns the full resource name of the certificate
+// authority of cfg.
+func CertificateAuthorityName(cfg *cmapi.GoogleCASIssuer) string {
+	return fmt.Sprintf("%s/certificateAuthorities/%s", CaPoolName(cfg), cfg.CertificateAuthorityID)
+}
+
+// New constructs a Google CA Service client for the given issuer.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, ambient bool, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().GoogleCAS
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have a Google CA Service configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	ctx := context.Background()
+	opts := []option.ClientOption{option.WithUserAgent(userAgent)}
+
+	if cfg.ServiceAccountKeySecretRef == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no service account key configured and ambient credentials are not permitted for this issuer")
+		}
+		// Leaving credentials unset results in the application default
+		// credentials being used, which includes GKE workload identity.
+	} else {
+		ref := cfg.ServiceAccountKeySecretRef
+		secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		key, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+		}
+		creds, err := google.CredentialsFromJSON(ctx, key, privateca.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse service account key in secret '%s/%s': %v", namespace, ref.Name, err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	svc, err := privateca.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google CA Service client: %v", err)
+	}
+	return &client{svc: svc}, nil
+}
+
+type client struct {
+	svc *privateca.Service
+}
+
+func (c *client) GetCaPool(ctx context.Context, name string) (*privateca.CaPool, error) {
+	return c.svc.Projects.Locations.CaPools.Get(name).Context(ctx).Do()
+}
+
+func (c *client) GetCertificateAuthority(ctx context.Context, name string) (*privateca.CertificateAuthority, error) {
+	return c.svc.Projects.Locations.CaPools.CertificateAuthorities.Get(name).Context(ctx).Do()
+}
+
+func (c *client) CreateCertificate(ctx context.Context, parent, certificateID, issuingCertificateAuthorityID, requestID string, cert *privateca.Certificate) (*privateca.Certificate, error) {
+	call := c.svc.Projects.Locations.CaPools.Certificates.Create(parent, cert).
+		CertificateId(certificateID).
+		RequestId(requestID)
+	if issuingCertificateAuthorityID != "" {
+		call = call.IssuingCertificateAuthorityId(issuingCertificateAuthorityID)
+	}
+	return call.Context(ctx).Do()
+}
+
+func (c *client) GetCertificate(ctx context.Context, name string) (*privateca.Certificate, error) {
+	return c.svc.Projects.Locations.CaPools.Certificates.Get(name).Context(ctx).Do()
+}
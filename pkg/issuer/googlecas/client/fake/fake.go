@@ -0,0 +1,48 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	privateca "google.golang.org/api/privateca/v1"
+)
+
+// GoogleCAS is a fake Google CA Service client. Calling a method which has
+// not been stubbed out will panic.
+type GoogleCAS struct {
+	GetCaPoolFn               func(name string) (*privateca.CaPool, error)
+	GetCertificateAuthorityFn func(name string) (*privateca.CertificateAuthority, error)
+	CreateCertificateFn       func(parent, certificateID, issuingCertificateAuthorityID, requestID string, cert *privateca.Certificate) (*privateca.Certificate, error)
+	GetCertificateFn          func(name string) (*privateca.Certificate, error)
+}
+
+func (g *GoogleCAS) GetCaPool(_ context.Context, name string) (*privateca.CaPool, error) {
+	return g.GetCaPoolFn(name)
+}
+
+func (g *GoogleCAS) GetCertificateAuthority(_ context.Context, name string) (*privateca.CertificateAuthority, error) {
+	return g.GetCertificateAuthorityFn(name)
+}
+
+func (g *GoogleCAS) CreateCertificate(_ context.Context, parent, certificateID, issuingCertificateAuthorityID, requestID string, cert *privateca.Certificate) (*privateca.Certificate, error) {
+	return g.CreateCertificateFn(parent, certificateID, issuingCertificateAuthorityID, requestID, cert)
+}
+
+func (g *GoogleCAS) GetCertificate(_ context.Context, name string) (*privateca.Certificate, error) {
+	return g.GetCertificateFn(name)
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified   = "GoogleCASVerified"
+	messageVerified   = "CA pool verified"
+	errorGoogleCAS    = "GoogleCASError"
+	messageNotEnabled = "Certificate authority is not enabled"
+
+	// stateEnabled is the state of a certificate authority which is able to
+	// issue certificates.
+	stateEnabled = "ENABLED"
+)
+
+// Setup verifies that the configured CA pool exists and can be read with the
+// configured credentials. If a certificate authority is configured, it must
+// also be enabled.
+func (g *GoogleCAS) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup Google CA Service issuer"
+			g.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(g.issuer, g.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorGoogleCAS, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	cfg := g.issuer.GetSpec().GoogleCAS
+	cas, err := g.clientBuilder(g.resourceNamespace, g.secretsLister, g.issuer, g.IssuerOptions.CanUseAmbientCredentials(g.issuer), g.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	if _, err := cas.GetCaPool(ctx, client.CaPoolName(cfg)); err != nil {
+		return fmt.Errorf("error getting CA pool: %v", err)
+	}
+
+	if cfg.CertificateAuthorityID != "" {
+		ca, err := cas.GetCertificateAuthority(ctx, client.CertificateAuthorityName(cfg))
+		if err != nil {
+			return fmt.Errorf("error getting certificate authority: %v", err)
+		}
+		if ca.State != stateEnabled {
+			return fmt.Errorf("%s, state is %q", messageNotEnabled, ca.State)
+		}
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(g.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		g.Recorder.Event(g.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	g.log.V(logf.DebugLevel).Info("Google CA Service issuer verified")
+	apiutil.SetIssuerCondition(g.issuer, g.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
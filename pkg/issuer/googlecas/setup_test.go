@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package googlecas
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	privateca "google.golang.org/api/privateca/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/googlecas/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	const (
+		poolName = "projects/my-project/locations/europe-west1/caPools/my-pool"
+		caName   = poolName + "/certificateAuthorities/my-ca"
+	)
+	cfg := cmapi.GoogleCASIssuer{
+		Project:  "my-project",
+		Location: "europe-west1",
+		CAPoolID: "my-pool",
+	}
+	cfgWithCA := cfg
+	cfgWithCA.CertificateAuthorityID = "my-ca"
+
+	withClient := func(cas *fake.GoogleCAS) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (client.Interface, error) {
+			return cas, nil
+		}
+	}
+	getPool := func(name string) (*privateca.CaPool, error) {
+		if name != poolName {
+			t.Errorf("unexpected CA pool %q", name)
+		}
+		return &privateca.CaPool{Name: name}, nil
+	}
+	caWithState := func(state string) func(string) (*privateca.CertificateAuthority, error) {
+		return func(name string) (*privateca.CertificateAuthority, error) {
+			if name != caName {
+				t.Errorf("unexpected certificate authority %q", name)
+			}
+			return &privateca.CertificateAuthority{Name: name, State: state}, nil
+		}
+	}
+
+	tests := map[string]struct {
+		cfg           cmapi.GoogleCASIssuer
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			cfg: cfg,
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, bool, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorGoogleCAS,
+				Message: "Failed to setup Google CA Service issuer: error building client: this is an error",
+			},
+		},
+		"if the CA pool cannot be read then should error": {
+			cfg: cfg,
+			clientBuilder: withClient(&fake.GoogleCAS{
+				GetCaPoolFn: func(string) (*privateca.CaPool, error) {
+					return nil, errors.New("permission denied")
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorGoogleCAS,
+				Message: "Failed to setup Google CA Service issuer: error getting CA pool: permission denied",
+			},
+		},
+		"if the CA pool exists then should set condition": {
+			cfg:            cfg,
+			clientBuilder:  withClient(&fake.GoogleCAS{GetCaPoolFn: getPool}),
+			expectedEvents: []string{"Normal GoogleCASVerified CA pool verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+		"if the certificate authority is not enabled then should error": {
+			cfg: cfgWithCA,
+			clientBuilder: withClient(&fake.GoogleCAS{
+				GetCaPoolFn:               getPool,
+				GetCertificateAuthorityFn: caWithState("DISABLED"),
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorGoogleCAS,
+				Message: `Failed to setup Google CA Service issuer: Certificate authority is not enabled, state is "DISABLED"`,
+			},
+		},
+		"if the certificate authority is enabled then should set condition": {
+			cfg: cfgWithCA,
+			clientBuilder: withClient(&fake.GoogleCAS{
+				GetCaPoolFn:               getPool,
+				GetCertificateAuthorityFn: caWithState(stateEnabled),
+			}),
+			expectedEvents: []string{"Normal GoogleCASVerified CA pool verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := gen.Issuer("test-issuer", gen.SetIssuerGoogleCAS(test.cfg))
+
+			g := &GoogleCAS{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("googlecas"),
+			}
+
+			err := g.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
@@ -18,6 +18,9 @@ package issuer
 
 import (
 	"context"
+	"crypto/x509"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 )
 
 type Interface interface {
@@ -27,6 +30,26 @@ type Interface interface {
 	Setup(ctx context.Context) error
 }
 
+// HealthChecker is implemented by issuers which depend on a remote service.
+// It is used to periodically check that the issuer can still reach the
+// service and authenticate with it, so that broken credentials are detected
+// before a certificate next needs to be issued.
+type HealthChecker interface {
+	// HealthCheck returns an error describing why the issuer is unhealthy,
+	// or nil if it is healthy. It must not modify the issuer.
+	HealthCheck(ctx context.Context) error
+}
+
+// Revoker is implemented by issuers which can ask a remote service to revoke
+// the certificates they have issued. It is used to act on the
+// `cert-manager.io/revoke` annotation of CertificateRequests.
+type Revoker interface {
+	// Revoke revokes cert, the certificate issued for the CertificateRequest,
+	// with the given RFC 5280 reason code. It must not modify the
+	// CertificateRequest.
+	Revoke(ctx context.Context, cr *cmapi.CertificateRequest, cert *x509.Certificate, reason int) error
+}
+
 type IssueResponse struct {
 	// Certificate is the certificate resource that should be stored in the
 	// target secret.
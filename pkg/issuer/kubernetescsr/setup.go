@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetescsr
+
+import (
+	"context"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+const (
+	successReady = "IsReady"
+)
+
+// Setup marks the issuer as ready. Signers of the certificates.k8s.io API
+// cannot be discovered, so a misconfigured signer name only surfaces once a
+// CertificateSigningRequest is left unsigned.
+func (k *KubernetesCSR) Setup(ctx context.Context) error {
+	apiutil.SetIssuerCondition(k.issuer, k.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successReady, "")
+	return nil
+}
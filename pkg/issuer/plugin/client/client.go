@@ -0,0 +1,143 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/pluginapi"
+)
+
+// requestTimeout is the timeout of a single call to the plugin.
+const requestTimeout = 30 * time.Second
+
+// Interface calls the issuer plugin configured on an issuer. The plugin name
+// and configuration of the issuer are added to every request.
+type Interface interface {
+	// Sign asks the plugin to sign a certificate signing request.
+	Sign(ctx context.Context, req *pluginapi.SignRequest) (*pluginapi.SignResponse, error)
+
+	// Check asks the plugin whether it is ready to sign certificates.
+	Check(ctx context.Context) (*pluginapi.CheckResponse, error)
+
+	// Capabilities asks the plugin which features it supports.
+	Capabilities(ctx context.Context) (*pluginapi.CapabilitiesResponse, error)
+
+	// Close closes the connection to the plugin.
+	Close() error
+}
+
+// Builder constructs an issuer plugin client for an issuer. The client
+// certificate is read from Secrets in namespace.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, userAgent string) (Interface, error)
+
+// New constructs an issuer plugin client for the given issuer. The
+// connection is established lazily by the first call.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().Plugin
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have a plugin configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	creds := insecure.NewCredentials()
+	if !cfg.Insecure {
+		tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+
+		if len(cfg.CABundle) > 0 {
+			tlsConfig.RootCAs = x509.NewCertPool()
+			if !tlsConfig.RootCAs.AppendCertsFromPEM(cfg.CABundle) {
+				return nil, errors.New("no certificates found in caBundle")
+			}
+		}
+
+		if cfg.ClientCertSecretRef != nil {
+			secret, err := secretsLister.Secrets(namespace).Get(cfg.ClientCertSecretRef.Name)
+			if err != nil {
+				return nil, err
+			}
+			cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+			if err != nil {
+				return nil, fmt.Errorf("unable to load client certificate from secret '%s/%s': %v", namespace, cfg.ClientCertSecretRef.Name, err)
+			}
+			tlsConfig.Certificates = []tls.Certificate{cert}
+		}
+
+		creds = credentials.NewTLS(tlsConfig)
+	}
+
+	conn, err := grpc.Dial(cfg.Address,
+		grpc.WithTransportCredentials(creds),
+		grpc.WithUserAgent(userAgent),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to issuer plugin %q: %v", cfg.Address, err)
+	}
+	return &client{
+		conn:   conn,
+		api:    pluginapi.NewIssuerPluginClient(conn),
+		plugin: cfg.Name,
+		config: cfg.Config,
+	}, nil
+}
+
+type client struct {
+	conn *grpc.ClientConn
+	api  pluginapi.IssuerPluginClient
+
+	plugin string
+	config map[string]string
+}
+
+func (c *client) Sign(ctx context.Context, req *pluginapi.SignRequest) (*pluginapi.SignResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	req.Plugin = c.plugin
+	req.Config = c.config
+	return c.api.Sign(ctx, req)
+}
+
+func (c *client) Check(ctx context.Context) (*pluginapi.CheckResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	return c.api.Check(ctx, &pluginapi.CheckRequest{Plugin: c.plugin, Config: c.config})
+}
+
+func (c *client) Capabilities(ctx context.Context) (*pluginapi.CapabilitiesResponse, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	return c.api.Capabilities(ctx, &pluginapi.CapabilitiesRequest{Plugin: c.plugin})
+}
+
+func (c *client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"net"
+	"path/filepath"
+	"testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/pluginapi"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+type fakePlugin struct {
+	got []*pluginapi.CheckRequest
+}
+
+func (f *fakePlugin) Name() string { return "test" }
+
+func (f *fakePlugin) Sign(_ context.Context, req *pluginapi.SignRequest) (*pluginapi.SignResponse, error) {
+	return &pluginapi.SignResponse{Certificate: []byte(req.Config["cert"])}, nil
+}
+
+func (f *fakePlugin) Check(_ context.Context, req *pluginapi.CheckRequest) (*pluginapi.CheckResponse, error) {
+	f.got = append(f.got, req)
+	return &pluginapi.CheckResponse{Ready: true}, nil
+}
+
+func (f *fakePlugin) Capabilities(context.Context, *pluginapi.CapabilitiesRequest) (*pluginapi.CapabilitiesResponse, error) {
+	return &pluginapi.CapabilitiesResponse{Ca: true}, nil
+}
+
+func TestClient(t *testing.T) {
+	socket := filepath.Join(t.TempDir(), "plugin.sock")
+	lis, err := net.Listen("unix", socket)
+	if err != nil {
+		t.Fatal(err)
+	}
+	plugin := &fakePlugin{}
+	srv := pluginapi.NewServer([]pluginapi.Plugin{plugin})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	iss := gen.Issuer("plugin", gen.SetIssuerPlugin(cmapi.PluginIssuer{
+		Address:  "unix://" + socket,
+		Name:     "test",
+		Config:   map[string]string{"cert": "signed"},
+		Insecure: true,
+	}))
+	cl, err := New("ns", nil, iss, "test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer cl.Close()
+	ctx := context.Background()
+
+	if _, err := cl.Check(ctx); err != nil {
+		t.Fatalf("unexpected Check error: %v", err)
+	}
+	if len(plugin.got) != 1 || plugin.got[0].Plugin != "test" || plugin.got[0].Config["cert"] != "signed" {
+		t.Errorf("expected the plugin name and config to be sent, got %+v", plugin.got)
+	}
+
+	resp, err := cl.Sign(ctx, &pluginapi.SignRequest{Request: []byte("csr")})
+	if err != nil {
+		t.Fatalf("unexpected Sign error: %v", err)
+	}
+	if string(resp.Certificate) != "signed" {
+		t.Errorf("unexpected Sign response %+v", resp)
+	}
+
+	caps, err := cl.Capabilities(ctx)
+	if err != nil {
+		t.Fatalf("unexpected Capabilities error: %v", err)
+	}
+	if !caps.Ca {
+		t.Errorf("unexpected Capabilities response %+v", caps)
+	}
+}
+
+func TestNewInvalidCABundle(t *testing.T) {
+	iss := gen.Issuer("plugin", gen.SetIssuerPlugin(cmapi.PluginIssuer{
+		Address:  "plugin:8443",
+		Name:     "test",
+		CABundle: []byte("not a certificate"),
+	}))
+	if _, err := New("ns", nil, iss, "test"); err == nil {
+		t.Errorf("expected an error for an invalid caBundle")
+	}
+}
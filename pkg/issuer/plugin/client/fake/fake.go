@@ -0,0 +1,47 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/pluginapi"
+)
+
+// Plugin is a fake issuer plugin client. Calling a method which has not
+// been stubbed out will panic.
+type Plugin struct {
+	SignFn         func(req *pluginapi.SignRequest) (*pluginapi.SignResponse, error)
+	CheckFn        func() (*pluginapi.CheckResponse, error)
+	CapabilitiesFn func() (*pluginapi.CapabilitiesResponse, error)
+}
+
+func (p *Plugin) Sign(_ context.Context, req *pluginapi.SignRequest) (*pluginapi.SignResponse, error) {
+	return p.SignFn(req)
+}
+
+func (p *Plugin) Check(_ context.Context) (*pluginapi.CheckResponse, error) {
+	return p.CheckFn()
+}
+
+func (p *Plugin) Capabilities(_ context.Context) (*pluginapi.CapabilitiesResponse, error) {
+	return p.CapabilitiesFn()
+}
+
+func (p *Plugin) Close() error {
+	return nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+type fakePlugin struct {
+	name string
+	got  []*SignRequest
+}
+
+var _ Plugin = &fakePlugin{}
+
+func (f *fakePlugin) Name() string { return f.name }
+
+func (f *fakePlugin) Sign(_ context.Context, req *SignRequest) (*SignResponse, error) {
+	f.got = append(f.got, req)
+	if req.IsCa {
+		return nil, status.Error(codes.InvalidArgument, "CA certificates are not supported")
+	}
+	return &SignResponse{Certificate: []byte("cert"), Ca: []byte("ca")}, nil
+}
+
+func (f *fakePlugin) Check(_ context.Context, req *CheckRequest) (*CheckResponse, error) {
+	return &CheckResponse{Ready: req.Config["ready"] == "true", Message: "checked"}, nil
+}
+
+func (f *fakePlugin) Capabilities(context.Context, *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	// an empty response must be sent as the default message
+	return nil, nil
+}
+
+func TestClientServer(t *testing.T) {
+	plugin := &fakePlugin{name: "test"}
+
+	lis := bufconn.Listen(1 << 16)
+	srv := NewServer([]Plugin{plugin})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	cl := NewIssuerPluginClient(conn)
+	ctx := context.Background()
+
+	signResp, err := cl.Sign(ctx, &SignRequest{Plugin: "test", Request: []byte("csr"), DurationSeconds: 3600})
+	if err != nil {
+		t.Fatalf("unexpected Sign error: %v", err)
+	}
+	if string(signResp.Certificate) != "cert" || string(signResp.Ca) != "ca" {
+		t.Errorf("unexpected response %+v", signResp)
+	}
+	if len(plugin.got) != 1 || string(plugin.got[0].Request) != "csr" || plugin.got[0].DurationSeconds != 3600 {
+		t.Errorf("unexpected requests %+v", plugin.got)
+	}
+
+	_, err = cl.Sign(ctx, &SignRequest{Plugin: "test", IsCa: true})
+	if status.Code(err) != codes.InvalidArgument {
+		t.Errorf("expected InvalidArgument error, got %v", err)
+	}
+
+	checkResp, err := cl.Check(ctx, &CheckRequest{Plugin: "test", Config: map[string]string{"ready": "true"}})
+	if err != nil {
+		t.Fatalf("unexpected Check error: %v", err)
+	}
+	if !checkResp.Ready || checkResp.Message != "checked" {
+		t.Errorf("unexpected response %+v", checkResp)
+	}
+
+	capsResp, err := cl.Capabilities(ctx, &CapabilitiesRequest{Plugin: "test"})
+	if err != nil {
+		t.Fatalf("unexpected Capabilities error: %v", err)
+	}
+	if capsResp.Ca || capsResp.Duration {
+		t.Errorf("unexpected response %+v", capsResp)
+	}
+
+	_, err = cl.Check(ctx, &CheckRequest{Plugin: "missing"})
+	if status.Code(err) != codes.NotFound {
+		t.Errorf("expected NotFound error, got %v", err)
+	}
+}
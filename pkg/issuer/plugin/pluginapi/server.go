@@ -0,0 +1,118 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pluginapi
+
+import (
+	"context"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Plugin is implemented by issuer plugins. Errors returned by a Plugin
+// should carry a gRPC status, see issuerplugin.proto for the meaning of the
+// status codes returned by Sign. Errors without a status are reported with
+// the UNKNOWN code.
+type Plugin interface {
+	// Name returns the name of the plugin, which issuers select it by.
+	Name() string
+
+	Sign(ctx context.Context, req *SignRequest) (*SignResponse, error)
+	Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error)
+	Capabilities(ctx context.Context, req *CapabilitiesRequest) (*CapabilitiesResponse, error)
+}
+
+// NewServer returns a gRPC server serving the IssuerPlugin service for the
+// given plugins. Requests are routed to the plugin whose Name matches the
+// plugin name in the request.
+func NewServer(plugins []Plugin, opts ...grpc.ServerOption) *grpc.Server {
+	srv := grpc.NewServer(opts...)
+	Register(srv, plugins...)
+	return srv
+}
+
+// Register registers the IssuerPlugin service for the given plugins with a
+// gRPC server.
+func Register(srv grpc.ServiceRegistrar, plugins ...Plugin) {
+	s := &server{plugins: make(map[string]Plugin, len(plugins))}
+	for _, plugin := range plugins {
+		s.plugins[plugin.Name()] = plugin
+	}
+	RegisterIssuerPluginServer(srv, s)
+}
+
+type server struct {
+	UnimplementedIssuerPluginServer
+
+	plugins map[string]Plugin
+}
+
+var _ IssuerPluginServer = &server{}
+
+func (s *server) plugin(name string) (Plugin, error) {
+	plugin, ok := s.plugins[name]
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "no plugin named %q", name)
+	}
+	return plugin, nil
+}
+
+func (s *server) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	plugin, err := s.plugin(req.Plugin)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := plugin.Sign(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		resp = &SignResponse{}
+	}
+	return resp, nil
+}
+
+func (s *server) Check(ctx context.Context, req *CheckRequest) (*CheckResponse, error) {
+	plugin, err := s.plugin(req.Plugin)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := plugin.Check(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		resp = &CheckResponse{}
+	}
+	return resp, nil
+}
+
+func (s *server) Capabilities(ctx context.Context, req *CapabilitiesRequest) (*CapabilitiesResponse, error) {
+	plugin, err := s.plugin(req.Plugin)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := plugin.Capabilities(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		resp = &CapabilitiesResponse{}
+	}
+	return resp, nil
+}
@@ -0,0 +1,85 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified = "PluginVerified"
+	messageVerified = "Issuer plugin verified"
+	errorPlugin     = "PluginError"
+)
+
+// Setup asks the issuer plugin whether it is ready to sign certificates with
+// the configuration of the issuer.
+func (p *Plugin) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup issuer plugin"
+			p.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(p.issuer, p.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorPlugin, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	plugin, err := p.clientBuilder(p.resourceNamespace, p.secretsLister, p.issuer, p.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+	defer plugin.Close()
+
+	resp, err := plugin.Check(ctx)
+	if err != nil {
+		return fmt.Errorf("error checking plugin: %v", err)
+	}
+	if !resp.Ready {
+		message := resp.Message
+		if message == "" {
+			message = "plugin is not ready"
+		}
+		return errors.New(message)
+	}
+
+	message := messageVerified
+	if resp.Message != "" {
+		message = fmt.Sprintf("%s: %s", messageVerified, resp.Message)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(p.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		p.Recorder.Event(p.issuer, corev1.EventTypeNormal, successVerified, message)
+	}
+	p.log.V(logf.DebugLevel).Info("issuer plugin verified")
+	apiutil.SetIssuerCondition(p.issuer, p.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, message)
+
+	return nil
+}
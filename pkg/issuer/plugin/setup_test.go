@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugin
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/client/fake"
+	"github.com/cert-manager/cert-manager/pkg/issuer/plugin/pluginapi"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := cmapi.PluginIssuer{
+		Address: "my-plugin.my-namespace.svc:8443",
+		Name:    "my-plugin",
+	}
+
+	withClient := func(plugin *fake.Plugin) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+			return plugin, nil
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorPlugin,
+				Message: "Failed to setup issuer plugin: error building client: this is an error",
+			},
+		},
+		"if the check fails then should error": {
+			clientBuilder: withClient(&fake.Plugin{
+				CheckFn: func() (*pluginapi.CheckResponse, error) {
+					return nil, errors.New("connection refused")
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorPlugin,
+				Message: "Failed to setup issuer plugin: error checking plugin: connection refused",
+			},
+		},
+		"if the plugin is not ready then should error with its message": {
+			clientBuilder: withClient(&fake.Plugin{
+				CheckFn: func() (*pluginapi.CheckResponse, error) {
+					return &pluginapi.CheckResponse{Message: "missing credentials"}, nil
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorPlugin,
+				Message: "Failed to setup issuer plugin: missing credentials",
+			},
+		},
+		"if the plugin is ready then should set condition": {
+			clientBuilder: withClient(&fake.Plugin{
+				CheckFn: func() (*pluginapi.CheckResponse, error) {
+					return &pluginapi.CheckResponse{Ready: true, Message: "connected to CA"}, nil
+				},
+			}),
+			expectedEvents: []string{"Normal PluginVerified Issuer plugin verified: connected to CA"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: "Issuer plugin verified: connected to CA",
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := gen.Issuer("test-issuer", gen.SetIssuerPlugin(cfg))
+
+			p := &Plugin{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("plugin"),
+			}
+
+			err := p.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net/url"
+	"time"
+
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	svidv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/svid/v1"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// requestTimeout is the timeout of a single call to the SPIRE server.
+	requestTimeout = 30 * time.Second
+)
+
+// Interface is the subset of the SPIRE server API used by the issuer.
+type Interface interface {
+	// GetBundle returns the bundle of the trust domain of the server.
+	GetBundle(ctx context.Context) (*Bundle, error)
+
+	// MintX509SVID mints an X.509 SVID for the ASN.1 DER encoded csr. The
+	// SPIFFE ID of the SVID is the URI SAN of csr.
+	MintX509SVID(ctx context.Context, csr []byte, ttl time.Duration) (*X509SVID, error)
+
+	// Close closes the connection to the server.
+	Close() error
+}
+
+// Builder constructs a SPIRE server client for an issuer. The client
+// certificate is read from Secrets in namespace.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, userAgent string) (Interface, error)
+
+// Bundle is the X.509 part of a SPIFFE trust bundle.
+type Bundle struct {
+	TrustDomain string
+	// X509Authorities are the ASN.1 DER encoded root certificates of the
+	// trust domain.
+	X509Authorities [][]byte
+}
+
+// X509SVID is an X.509 SVID minted by the server.
+type X509SVID struct {
+	// ID is the SPIFFE ID of the SVID.
+	ID string
+	// CertChain is the ASN.1 DER encoded certificate chain of the SVID,
+	// leaf first.
+	CertChain [][]byte
+	ExpiresAt time.Time
+}
+
+// ServerID returns the SPIFFE ID the SPIRE server of cfg must present.
+func ServerID(cfg *cmapi.SPIREIssuer) string {
+	if cfg.ServerSPIFFEID != "" {
+		return cfg.ServerSPIFFEID
+	}
+	return fmt.Sprintf("spiffe://%s/spire/server", cfg.TrustDomain)
+}
+
+// New constructs a SPIRE server client for the given issuer. The
+// connection is established lazily by the first call.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().SPIRE
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have a SPIRE configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	secret, err := secretsLister.Secrets(namespace).Get(cfg.ClientCertSecretRef.Name)
+	if err != nil {
+		return nil, err
+	}
+	cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+	if err != nil {
+		return nil, fmt.Errorf("unable to load client certificate from secret '%s/%s': %v", namespace, cfg.ClientCertSecretRef.Name, err)
+	}
+
+	roots := x509.NewCertPool()
+	if !roots.AppendCertsFromPEM(cfg.CABundle) {
+		return nil, errors.New("no certificates found in caBundle")
+	}
+
+	tlsConfig := &tls.Config{
+		Certificates: []tls.Certificate{cert},
+		MinVersion:   tls.VersionTLS12,
+		// The SPIRE server presents an X.509 SVID, which identifies the
+		// server by its SPIFFE ID rather than a DNS name, so the standard
+		// hostname verification is replaced by verifyServerCertificate.
+		InsecureSkipVerify:    true,
+		VerifyPeerCertificate: verifyServerCertificate(roots, ServerID(cfg)),
+	}
+
+	conn, err := grpc.Dial(cfg.Address,
+		grpc.WithTransportCredentials(credentials.NewTLS(tlsConfig)),
+		grpc.WithUserAgent(userAgent),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("unable to connect to SPIRE server %q: %v", cfg.Address, err)
+	}
+	return newClient(conn), nil
+}
+
+// verifyServerCertificate returns a function that verifies that the server
+// certificate chains to roots and carries the SPIFFE ID serverID.
+func verifyServerCertificate(roots *x509.CertPool, serverID string) func([][]byte, [][]*x509.Certificate) error {
+	return func(rawCerts [][]byte, _ [][]*x509.Certificate) error {
+		if len(rawCerts) == 0 {
+			return errors.New("server did not present a certificate")
+		}
+		var certs []*x509.Certificate
+		for _, raw := range rawCerts {
+			cert, err := x509.ParseCertificate(raw)
+			if err != nil {
+				return fmt.Errorf("unable to parse server certificate: %v", err)
+			}
+			certs = append(certs, cert)
+		}
+
+		intermediates := x509.NewCertPool()
+		for _, cert := range certs[1:] {
+			intermediates.AddCert(cert)
+		}
+		if _, err := certs[0].Verify(x509.VerifyOptions{
+			Roots:         roots,
+			Intermediates: intermediates,
+			KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+		}); err != nil {
+			return fmt.Errorf("unable to verify server certificate: %v", err)
+		}
+
+		if len(certs[0].URIs) != 1 || certs[0].URIs[0].String() != serverID {
+			return fmt.Errorf("server certificate does not have SPIFFE ID %q", serverID)
+		}
+		return nil
+	}
+}
+
+type client struct {
+	conn   *grpc.ClientConn
+	bundle bundlev1.BundleClient
+	svid   svidv1.SVIDClient
+}
+
+func newClient(conn *grpc.ClientConn) *client {
+	return &client{
+		conn:   conn,
+		bundle: bundlev1.NewBundleClient(conn),
+		svid:   svidv1.NewSVIDClient(conn),
+	}
+}
+
+func (c *client) GetBundle(ctx context.Context) (*Bundle, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	// No output mask is set, so that all fields are returned.
+	resp, err := c.bundle.GetBundle(ctx, &bundlev1.GetBundleRequest{})
+	if err != nil {
+		return nil, err
+	}
+	bundle := &Bundle{TrustDomain: resp.TrustDomain}
+	for _, authority := range resp.X509Authorities {
+		bundle.X509Authorities = append(bundle.X509Authorities, authority.Asn1)
+	}
+	return bundle, nil
+}
+
+func (c *client) MintX509SVID(ctx context.Context, csr []byte, ttl time.Duration) (*X509SVID, error) {
+	ctx, cancel := context.WithTimeout(ctx, requestTimeout)
+	defer cancel()
+
+	resp, err := c.svid.MintX509SVID(ctx, &svidv1.MintX509SVIDRequest{Csr: csr, Ttl: int32(ttl.Seconds())})
+	if err != nil {
+		return nil, err
+	}
+	svid := resp.GetSvid()
+	if len(svid.GetCertChain()) == 0 {
+		return nil, errors.New("SPIRE server returned an empty certificate chain")
+	}
+	id := url.URL{Scheme: "spiffe", Host: svid.GetId().GetTrustDomain(), Path: svid.GetId().GetPath()}
+	return &X509SVID{
+		ID:        id.String(),
+		CertChain: svid.CertChain,
+		ExpiresAt: time.Unix(svid.ExpiresAt, 0),
+	}, nil
+}
+
+func (c *client) Close() error {
+	return c.conn.Close()
+}
@@ -0,0 +1,182 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+	"time"
+
+	bundlev1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/bundle/v1"
+	svidv1 "github.com/spiffe/spire-api-sdk/proto/spire/api/server/svid/v1"
+	"github.com/spiffe/spire-api-sdk/proto/spire/api/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+type fakeBundleServer struct {
+	bundlev1.UnimplementedBundleServer
+}
+
+func (fakeBundleServer) GetBundle(context.Context, *bundlev1.GetBundleRequest) (*types.Bundle, error) {
+	return &types.Bundle{
+		TrustDomain: "example.org",
+		X509Authorities: []*types.X509Certificate{
+			{Asn1: []byte("root-1")},
+			{Asn1: []byte("root-2")},
+		},
+		JwtAuthorities: []*types.JWTKey{{KeyId: "jwt"}},
+	}, nil
+}
+
+type fakeSVIDServer struct {
+	svidv1.UnimplementedSVIDServer
+}
+
+func (fakeSVIDServer) MintX509SVID(_ context.Context, req *svidv1.MintX509SVIDRequest) (*svidv1.MintX509SVIDResponse, error) {
+	if string(req.Csr) != "csr" || req.Ttl != 3600 {
+		return nil, status.Errorf(codes.InvalidArgument, "unexpected request %v", req)
+	}
+	return &svidv1.MintX509SVIDResponse{
+		Svid: &types.X509SVID{
+			Id:        &types.SPIFFEID{TrustDomain: "example.org", Path: "/ns/default/sa/app"},
+			CertChain: [][]byte{[]byte("leaf"), []byte("intermediate")},
+			ExpiresAt: 1700000000,
+		},
+	}, nil
+}
+
+func TestClient(t *testing.T) {
+	lis := bufconn.Listen(1024 * 1024)
+	srv := grpc.NewServer()
+	bundlev1.RegisterBundleServer(srv, fakeBundleServer{})
+	svidv1.RegisterSVIDServer(srv, fakeSVIDServer{})
+	go srv.Serve(lis)
+	defer srv.Stop()
+
+	conn, err := grpc.Dial("bufnet",
+		grpc.WithContextDialer(func(context.Context, string) (net.Conn, error) { return lis.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := newClient(conn)
+	defer c.Close()
+
+	bundle, err := c.GetBundle(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	expBundle := &Bundle{
+		TrustDomain:     "example.org",
+		X509Authorities: [][]byte{[]byte("root-1"), []byte("root-2")},
+	}
+	if !reflect.DeepEqual(bundle, expBundle) {
+		t.Errorf("unexpected bundle, exp=%+v got=%+v", expBundle, bundle)
+	}
+
+	svid, err := c.MintX509SVID(context.Background(), []byte("csr"), time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expSVID := &X509SVID{
+		ID:        "spiffe://example.org/ns/default/sa/app",
+		CertChain: [][]byte{[]byte("leaf"), []byte("intermediate")},
+		ExpiresAt: time.Unix(1700000000, 0),
+	}
+	if !reflect.DeepEqual(svid, expSVID) {
+		t.Errorf("unexpected SVID, exp=%+v got=%+v", expSVID, svid)
+	}
+}
+
+func TestVerifyServerCertificate(t *testing.T) {
+	rootPK, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rootTmpl := &x509.Certificate{
+		Version:               2,
+		BasicConstraintsValid: true,
+		SerialNumber:          big.NewInt(1),
+		IsCA:                  true,
+		Subject:               pkix.Name{CommonName: "root"},
+		NotBefore:             time.Now().Add(-time.Minute),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	_, root, err := pki.SignCertificate(rootTmpl, rootTmpl, rootPK.Public(), rootPK)
+	if err != nil {
+		t.Fatal(err)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(root)
+
+	serverCert := func(id string) [][]byte {
+		pk, err := pki.GenerateECPrivateKey(256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		serial, err := rand.Int(rand.Reader, big.NewInt(1<<62))
+		if err != nil {
+			t.Fatal(err)
+		}
+		u, err := url.Parse(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl := &x509.Certificate{
+			Version:      2,
+			SerialNumber: serial,
+			NotBefore:    time.Now().Add(-time.Minute),
+			NotAfter:     time.Now().Add(time.Hour),
+			KeyUsage:     x509.KeyUsageDigitalSignature,
+			ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+			URIs:         []*url.URL{u},
+		}
+		_, cert, err := pki.SignCertificate(tmpl, root, pk.Public(), rootPK)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return [][]byte{cert.Raw}
+	}
+
+	verify := verifyServerCertificate(roots, "spiffe://example.org/spire/server")
+	if err := verify(serverCert("spiffe://example.org/spire/server"), nil); err != nil {
+		t.Errorf("expected the server certificate to be accepted, got: %v", err)
+	}
+	if err := verify(serverCert("spiffe://example.org/workload"), nil); err == nil {
+		t.Errorf("expected a certificate with another SPIFFE ID to be rejected")
+	}
+
+	verify = verifyServerCertificate(x509.NewCertPool(), "spiffe://example.org/spire/server")
+	if err := verify(serverCert("spiffe://example.org/spire/server"), nil); err == nil {
+		t.Errorf("expected a certificate from an untrusted root to be rejected")
+	}
+}
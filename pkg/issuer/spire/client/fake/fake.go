@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+	"time"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/spire/client"
+)
+
+// SPIRE is a fake SPIRE server client. Calling a method which has not been
+// stubbed out will panic.
+type SPIRE struct {
+	GetBundleFn    func() (*client.Bundle, error)
+	MintX509SVIDFn func(csr []byte, ttl time.Duration) (*client.X509SVID, error)
+}
+
+func (s *SPIRE) GetBundle(_ context.Context) (*client.Bundle, error) {
+	return s.GetBundleFn()
+}
+
+func (s *SPIRE) MintX509SVID(_ context.Context, csr []byte, ttl time.Duration) (*client.X509SVID, error) {
+	return s.MintX509SVIDFn(csr, ttl)
+}
+
+func (s *SPIRE) Close() error {
+	return nil
+}
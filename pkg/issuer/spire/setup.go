@@ -0,0 +1,75 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spire
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified = "SPIREVerified"
+	messageVerified = "SPIRE server verified"
+	errorSPIRE      = "SPIREError"
+)
+
+// Setup verifies that the SPIRE server can be reached with the configured
+// credentials and that it serves the configured trust domain.
+func (s *SPIRE) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup SPIRE issuer"
+			s.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorSPIRE, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	spire, err := s.clientBuilder(s.resourceNamespace, s.secretsLister, s.issuer, s.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+	defer spire.Close()
+
+	bundle, err := spire.GetBundle(ctx)
+	if err != nil {
+		return fmt.Errorf("error getting trust bundle: %v", err)
+	}
+	if trustDomain := s.issuer.GetSpec().SPIRE.TrustDomain; bundle.TrustDomain != trustDomain {
+		return fmt.Errorf("SPIRE server serves trust domain %q, expected %q", bundle.TrustDomain, trustDomain)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(s.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		s.Recorder.Event(s.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	s.log.V(logf.DebugLevel).Info("SPIRE issuer verified")
+	apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
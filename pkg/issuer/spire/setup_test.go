@@ -0,0 +1,151 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package spire
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/spire/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/spire/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := cmapi.SPIREIssuer{
+		Address:             "spire-server.spire.svc:8081",
+		TrustDomain:         "example.org",
+		CABundle:            []byte("bundle"),
+		ClientCertSecretRef: cmmeta.LocalObjectReference{Name: "spire-admin"},
+	}
+
+	withClient := func(spire *fake.SPIRE) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+			return spire, nil
+		}
+	}
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorSPIRE,
+				Message: "Failed to setup SPIRE issuer: error building client: this is an error",
+			},
+		},
+		"if the bundle cannot be fetched then should error": {
+			clientBuilder: withClient(&fake.SPIRE{
+				GetBundleFn: func() (*client.Bundle, error) {
+					return nil, errors.New("permission denied")
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorSPIRE,
+				Message: "Failed to setup SPIRE issuer: error getting trust bundle: permission denied",
+			},
+		},
+		"if the server serves another trust domain then should error": {
+			clientBuilder: withClient(&fake.SPIRE{
+				GetBundleFn: func() (*client.Bundle, error) {
+					return &client.Bundle{TrustDomain: "example.com"}, nil
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorSPIRE,
+				Message: `Failed to setup SPIRE issuer: SPIRE server serves trust domain "example.com", expected "example.org"`,
+			},
+		},
+		"if the server serves the trust domain then should set condition": {
+			clientBuilder: withClient(&fake.SPIRE{
+				GetBundleFn: func() (*client.Bundle, error) {
+					return &client.Bundle{TrustDomain: "example.org"}, nil
+				},
+			}),
+			expectedEvents: []string{"Normal SPIREVerified SPIRE server verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := gen.Issuer("test-issuer", gen.SetIssuerSPIRE(cfg))
+
+			s := &SPIRE{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("spire"),
+			}
+
+			err := s.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
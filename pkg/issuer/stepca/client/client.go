@@ -0,0 +1,303 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const (
+	// requestTimeout is the timeout of a single request to step-ca.
+	requestTimeout = 30 * time.Second
+
+	// maxResponseSize limits the size of responses read from step-ca.
+	maxResponseSize = 1 << 20
+)
+
+// Interface is the subset of the step-ca API used by the issuer.
+type Interface interface {
+	// Health returns an error if the CA is not healthy.
+	Health(ctx context.Context) error
+
+	// Token returns a one-time token from the configured provisioner,
+	// authorising a single certificate for subject and sans to be signed.
+	Token(ctx context.Context, subject string, sans []string) (string, error)
+
+	// Sign signs a certificate signing request authorised by a one-time
+	// token.
+	Sign(ctx context.Context, req *SignRequest) (*SignResponse, error)
+}
+
+// Builder constructs a step-ca client for an issuer. Provisioner
+// credentials are read from Secrets in namespace.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	issuer cmapi.GenericIssuer, userAgent string) (Interface, error)
+
+// SignRequest is the body of a request to the sign endpoint.
+type SignRequest struct {
+	CSR      string `json:"csr"`
+	OTT      string `json:"ott"`
+	NotAfter string `json:"notAfter,omitempty"`
+}
+
+// SignResponse is the response of the sign endpoint.
+type SignResponse struct {
+	Certificate      string   `json:"crt"`
+	CA               string   `json:"ca"`
+	CertificateChain []string `json:"certChain"`
+}
+
+// PEM returns the signed certificate followed by its chain.
+func (r *SignResponse) PEM() []byte {
+	chain := r.CertificateChain
+	if len(chain) == 0 {
+		chain = []string{r.Certificate, r.CA}
+	}
+	return []byte(strings.Join(chain, "\n"))
+}
+
+// Error is an error response from step-ca.
+type Error struct {
+	StatusCode int    `json:"status"`
+	Message    string `json:"message"`
+}
+
+func (e *Error) Error() string {
+	return fmt.Sprintf("step-ca returned status %d: %s", e.StatusCode, e.Message)
+}
+
+// IsBadRequest returns true if err is an error response from step-ca
+// rejecting the certificate signing request itself, for example because it
+// is not allowed by the policy of the provisioner. Retrying such a request
+// will not succeed.
+func IsBadRequest(err error) bool {
+	var e *Error
+	return errors.As(err, &e) && e.StatusCode == http.StatusBadRequest
+}
+
+type provisioner struct {
+	Type         string          `json:"type"`
+	Name         string          `json:"name"`
+	Key          jose.JSONWebKey `json:"key"`
+	EncryptedKey string          `json:"encryptedKey"`
+}
+
+// New constructs a step-ca client for the given issuer.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, userAgent string) (Interface, error) {
+	cfg := issuer.GetSpec().StepCA
+	if cfg == nil {
+		return nil, fmt.Errorf("issuer %s/%s does not have a step-ca configuration", issuer.GetNamespace(), issuer.GetName())
+	}
+
+	tlsConfig := &tls.Config{MinVersion: tls.VersionTLS12}
+	if len(cfg.CABundle) > 0 {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(cfg.CABundle) {
+			return nil, errors.New("no certificates could be parsed from the CA bundle")
+		}
+		tlsConfig.RootCAs = pool
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.TLSClientConfig = tlsConfig
+
+	c := &client{
+		baseURL:     strings.TrimSuffix(cfg.URL, "/"),
+		userAgent:   userAgent,
+		httpClient:  &http.Client{Transport: transport, Timeout: requestTimeout},
+		provisioner: cfg.Provisioner.Name,
+		now:         time.Now,
+	}
+
+	switch p := cfg.Provisioner; {
+	case p.JWK != nil:
+		ref := p.JWK.PasswordSecretRef
+		secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+		if err != nil {
+			return nil, err
+		}
+		password, ok := secret.Data[ref.Key]
+		if !ok {
+			return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+		}
+		c.keyID = p.JWK.KeyID
+		c.password = bytes.TrimSpace(password)
+	case p.X5C != nil:
+		name := p.X5C.SecretRef.Name
+		secret, err := secretsLister.Secrets(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+		cert, err := tls.X509KeyPair(secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey])
+		if err != nil {
+			return nil, fmt.Errorf("unable to load X5C certificate from secret '%s/%s': %v", namespace, name, err)
+		}
+		c.x5c = &cert
+	default:
+		return nil, errors.New("no step-ca provisioner type configured")
+	}
+
+	return c, nil
+}
+
+type client struct {
+	baseURL    string
+	userAgent  string
+	httpClient *http.Client
+
+	provisioner string
+	// keyID and password select and decrypt the key of a JWK provisioner.
+	keyID    string
+	password []byte
+	// x5c is the certificate and key used to sign X5C provisioner tokens.
+	x5c *tls.Certificate
+
+	now func() time.Time
+}
+
+func (c *client) Health(ctx context.Context) error {
+	var resp struct {
+		Status string `json:"status"`
+	}
+	if err := c.do(ctx, http.MethodGet, "/health", nil, &resp); err != nil {
+		return err
+	}
+	if resp.Status != "ok" {
+		return fmt.Errorf("step-ca is not healthy, status is %q", resp.Status)
+	}
+	return nil
+}
+
+func (c *client) Sign(ctx context.Context, req *SignRequest) (*SignResponse, error) {
+	resp := &SignResponse{}
+	if err := c.do(ctx, http.MethodPost, "/1.0/sign", req, resp); err != nil {
+		return nil, err
+	}
+	return resp, nil
+}
+
+// provisionerKey returns the decrypted key of the configured JWK
+// provisioner, paging through the provisioners of the CA to find it.
+func (c *client) provisionerKey(ctx context.Context) (*jose.JSONWebKey, error) {
+	cursor := ""
+	for {
+		var resp struct {
+			Provisioners []provisioner `json:"provisioners"`
+			NextCursor   string        `json:"nextCursor"`
+		}
+		path := "/1.0/provisioners"
+		if cursor != "" {
+			path += "?cursor=" + url.QueryEscape(cursor)
+		}
+		if err := c.do(ctx, http.MethodGet, path, nil, &resp); err != nil {
+			return nil, err
+		}
+
+		for _, p := range resp.Provisioners {
+			if p.Type != "JWK" || p.Name != c.provisioner || (c.keyID != "" && p.Key.KeyID != c.keyID) {
+				continue
+			}
+			if p.EncryptedKey == "" {
+				return nil, fmt.Errorf("JWK provisioner %q does not have an encrypted key", c.provisioner)
+			}
+			jwe, err := jose.ParseEncrypted(p.EncryptedKey)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse encrypted provisioner key: %v", err)
+			}
+			data, err := jwe.Decrypt(c.password)
+			if err != nil {
+				return nil, fmt.Errorf("failed to decrypt provisioner key: %v", err)
+			}
+			key := &jose.JSONWebKey{}
+			if err := json.Unmarshal(data, key); err != nil {
+				return nil, fmt.Errorf("failed to parse provisioner key: %v", err)
+			}
+			return key, nil
+		}
+
+		if resp.NextCursor == "" {
+			return nil, fmt.Errorf("JWK provisioner %q not found", c.provisioner)
+		}
+		cursor = resp.NextCursor
+	}
+}
+
+// do sends a request to step-ca, encoding in as the JSON body of the request
+// and decoding the JSON response into out.
+func (c *client) do(ctx context.Context, method, path string, in, out interface{}) error {
+	var body io.Reader
+	if in != nil {
+		b, err := json.Marshal(in)
+		if err != nil {
+			return err
+		}
+		body = bytes.NewReader(b)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, c.baseURL+path, body)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if c.userAgent != "" {
+		req.Header.Set("User-Agent", c.userAgent)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseSize))
+	if err != nil {
+		return err
+	}
+
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		e := &Error{}
+		if err := json.Unmarshal(b, e); err != nil || e.Message == "" {
+			e.Message = http.StatusText(resp.StatusCode)
+		}
+		e.StatusCode = resp.StatusCode
+		return e
+	}
+
+	if err := json.Unmarshal(b, out); err != nil {
+		return fmt.Errorf("failed to decode response: %v", err)
+	}
+	return nil
+}
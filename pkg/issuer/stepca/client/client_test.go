@@ -0,0 +1,206 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestTokenJWK(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(256)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwk := jose.JSONWebKey{Key: key, KeyID: "key-id", Algorithm: string(jose.ES256)}
+	data, err := json.Marshal(jwk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encrypter, err := jose.NewEncrypter(jose.A128GCM, jose.Recipient{
+		Algorithm:  jose.PBES2_HS256_A128KW,
+		Key:        []byte("password"),
+		PBES2Count: 1000,
+	}, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	jwe, err := encrypter.Encrypt(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	encryptedKey, err := jwe.CompactSerialize()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1.0/provisioners" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		// The provisioner is on the second page of results.
+		if r.URL.Query().Get("cursor") == "" {
+			w.Write([]byte(`{"provisioners":[{"type":"ACME","name":"acme"}],"nextCursor":"next"}`))
+			return
+		}
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"provisioners": []provisioner{{
+				Type:         "JWK",
+				Name:         "admin",
+				Key:          jwk.Public(),
+				EncryptedKey: encryptedKey,
+			}},
+		})
+	}))
+	defer srv.Close()
+
+	now := time.Now()
+	c := &client{
+		baseURL:     srv.URL,
+		httpClient:  srv.Client(),
+		provisioner: "admin",
+		password:    []byte("password"),
+		now:         func() time.Time { return now },
+	}
+
+	token, err := c.Token(context.Background(), "example.com", []string{"example.com", "www.example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if kid := parsed.Headers[0].KeyID; kid != "key-id" {
+		t.Errorf("unexpected key ID %q", kid)
+	}
+	var claims tokenClaims
+	if err := parsed.Claims(key.Public(), &claims); err != nil {
+		t.Fatalf("failed to verify token: %v", err)
+	}
+	if err := claims.Validate(jwt.Expected{
+		Issuer:   "admin",
+		Subject:  "example.com",
+		Audience: jwt.Audience{srv.URL + "/1.0/sign"},
+		Time:     now,
+	}); err != nil {
+		t.Errorf("unexpected claims: %v", err)
+	}
+	if exp := []string{"example.com", "www.example.com"}; !reflect.DeepEqual(claims.SANs, exp) {
+		t.Errorf("unexpected SANs, exp=%v got=%v", exp, claims.SANs)
+	}
+	if claims.ID == "" {
+		t.Errorf("expected the token to have an ID")
+	}
+}
+
+func TestTokenX5C(t *testing.T) {
+	key, err := pki.GenerateECPrivateKey(384)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tmpl := &x509.Certificate{
+		Version:      3,
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: "x5c"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+	}
+	_, cert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	now := time.Now()
+	c := &client{
+		baseURL:     "https://ca.example.com",
+		provisioner: "x5c",
+		x5c:         &tls.Certificate{Certificate: [][]byte{cert.Raw}, PrivateKey: key},
+		now:         func() time.Time { return now },
+	}
+
+	token, err := c.Token(context.Background(), "example.com", []string{"example.com"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	parsed, err := jwt.ParseSigned(token)
+	if err != nil {
+		t.Fatal(err)
+	}
+	header := parsed.Headers[0]
+	if header.Algorithm != string(jose.ES384) {
+		t.Errorf("unexpected algorithm %q", header.Algorithm)
+	}
+	roots := x509.NewCertPool()
+	roots.AddCert(cert)
+	chains, err := header.Certificates(x509.VerifyOptions{Roots: roots})
+	if err != nil {
+		t.Fatalf("failed to verify x5c header: %v", err)
+	}
+	if !chains[0][0].Equal(cert) {
+		t.Errorf("unexpected x5c certificate %v", chains[0][0].Subject)
+	}
+	var claims tokenClaims
+	if err := parsed.Claims(key.Public(), &claims); err != nil {
+		t.Fatalf("failed to verify token: %v", err)
+	}
+	if err := claims.Validate(jwt.Expected{
+		Issuer:   "x5c",
+		Subject:  "example.com",
+		Audience: jwt.Audience{"https://ca.example.com/1.0/sign"},
+		Time:     now,
+	}); err != nil {
+		t.Errorf("unexpected claims: %v", err)
+	}
+}
+
+func TestSignError(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost || r.URL.Path != "/1.0/sign" {
+			t.Errorf("unexpected request %s %s", r.Method, r.URL.Path)
+		}
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"status":400,"message":"certificate request does not contain the valid DNS names"}`))
+	}))
+	defer srv.Close()
+
+	c := &client{baseURL: srv.URL, httpClient: srv.Client()}
+	_, err := c.Sign(context.Background(), &SignRequest{CSR: "csr", OTT: "token"})
+	if !IsBadRequest(err) {
+		t.Errorf("expected a bad request error, got %v", err)
+	}
+	if err != nil && err.Error() != "step-ca returned status 400: certificate request does not contain the valid DNS names" {
+		t.Errorf("unexpected error message %q", err.Error())
+	}
+}
@@ -0,0 +1,43 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package fake
+
+import (
+	"context"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/stepca/client"
+)
+
+// StepCA is a fake step-ca client. Calling a method which has not been
+// stubbed out will panic.
+type StepCA struct {
+	HealthFn func() error
+	TokenFn  func(subject string, sans []string) (string, error)
+	SignFn   func(req *client.SignRequest) (*client.SignResponse, error)
+}
+
+func (s *StepCA) Health(_ context.Context) error {
+	return s.HealthFn()
+}
+
+func (s *StepCA) Token(_ context.Context, subject string, sans []string) (string, error) {
+	return s.TokenFn(subject, sans)
+}
+
+func (s *StepCA) Sign(_ context.Context, req *client.SignRequest) (*client.SignResponse, error) {
+	return s.SignFn(req)
+}
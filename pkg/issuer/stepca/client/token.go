@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	jose "gopkg.in/square/go-jose.v2"
+	"gopkg.in/square/go-jose.v2/jwt"
+)
+
+// tokenLifetime is how long a one-time token is valid for. Tokens are used
+// immediately, so this only needs to allow for clock skew.
+const tokenLifetime = 5 * time.Minute
+
+// tokenClaims are the claims of a step-ca one-time token.
+type tokenClaims struct {
+	jwt.Claims
+	SANs []string `json:"sans"`
+}
+
+// Token signs a one-time token for the sign endpoint with the key of the
+// configured provisioner. X5C tokens carry the certificate chain of the
+// signing key in their header.
+func (c *client) Token(ctx context.Context, subject string, sans []string) (string, error) {
+	opts := (&jose.SignerOptions{}).WithType("JWT")
+	var key jose.SigningKey
+	if c.x5c != nil {
+		signer, ok := c.x5c.PrivateKey.(crypto.Signer)
+		if !ok {
+			return "", fmt.Errorf("unsupported X5C private key type %T", c.x5c.PrivateKey)
+		}
+		alg, err := signatureAlgorithm(signer.Public())
+		if err != nil {
+			return "", err
+		}
+		chain := make([]string, len(c.x5c.Certificate))
+		for i, der := range c.x5c.Certificate {
+			chain[i] = base64.StdEncoding.EncodeToString(der)
+		}
+		opts = opts.WithHeader("x5c", chain)
+		key = jose.SigningKey{Algorithm: alg, Key: c.x5c.PrivateKey}
+	} else {
+		jwk, err := c.provisionerKey(ctx)
+		if err != nil {
+			return "", err
+		}
+		alg := jose.SignatureAlgorithm(jwk.Algorithm)
+		if alg == "" {
+			if alg, err = signatureAlgorithm(jwk.Public().Key); err != nil {
+				return "", err
+			}
+		}
+		// Signing with a JSONWebKey sets the kid header to its key ID.
+		key = jose.SigningKey{Algorithm: alg, Key: jwk}
+	}
+
+	signer, err := jose.NewSigner(key, opts)
+	if err != nil {
+		return "", fmt.Errorf("failed to create token signer: %v", err)
+	}
+
+	id := make([]byte, 32)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	now := c.now()
+	claims := tokenClaims{
+		Claims: jwt.Claims{
+			ID:        hex.EncodeToString(id),
+			Issuer:    c.provisioner,
+			Subject:   subject,
+			Audience:  jwt.Audience{c.baseURL + "/1.0/sign"},
+			IssuedAt:  jwt.NewNumericDate(now),
+			NotBefore: jwt.NewNumericDate(now),
+			Expiry:    jwt.NewNumericDate(now.Add(tokenLifetime)),
+		},
+		SANs: sans,
+	}
+	return jwt.Signed(signer).Claims(claims).CompactSerialize()
+}
+
+// signatureAlgorithm returns the JWS algorithm used to sign tokens with a
+// key of the type of pub.
+func signatureAlgorithm(pub crypto.PublicKey) (jose.SignatureAlgorithm, error) {
+	switch k := pub.(type) {
+	case *ecdsa.PublicKey:
+		switch k.Curve.Params().Name {
+		case "P-256":
+			return jose.ES256, nil
+		case "P-384":
+			return jose.ES384, nil
+		case "P-521":
+			return jose.ES512, nil
+		}
+		return "", fmt.Errorf("unsupported elliptic curve %s", k.Curve.Params().Name)
+	case *rsa.PublicKey:
+		return jose.RS256, nil
+	case ed25519.PublicKey:
+		return jose.EdDSA, nil
+	}
+	return "", fmt.Errorf("unsupported key type %T", pub)
+}
@@ -0,0 +1,78 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stepca
+
+import (
+	"context"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+const (
+	successVerified = "StepCAVerified"
+	messageVerified = "step-ca provisioner verified"
+	errorStepCA     = "StepCAError"
+
+	// setupTokenSubject is the subject of the token signed to verify the
+	// provisioner credentials. The token is never sent to the CA.
+	setupTokenSubject = "cert-manager-setup"
+)
+
+// Setup verifies that the CA is healthy and that a one-time token can be
+// signed with the configured provisioner credentials.
+func (s *StepCA) Setup(ctx context.Context) (err error) {
+	defer func() {
+		if err != nil {
+			errorMessage := "Failed to setup step-ca issuer"
+			s.log.Error(err, errorMessage)
+			apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionFalse, errorStepCA, fmt.Sprintf("%s: %v", errorMessage, err))
+			err = fmt.Errorf("%s: %v", errorMessage, err)
+		}
+	}()
+
+	stepca, err := s.clientBuilder(s.resourceNamespace, s.secretsLister, s.issuer, s.RESTConfig.UserAgent)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+
+	if err := stepca.Health(ctx); err != nil {
+		return fmt.Errorf("error checking CA health: %v", err)
+	}
+
+	if _, err := stepca.Token(ctx, setupTokenSubject, nil); err != nil {
+		return fmt.Errorf("error signing provisioner token: %v", err)
+	}
+
+	// If it does not already have a 'ready' condition, we'll also log an event
+	// to make it really clear to users that this Issuer is ready.
+	if !apiutil.IssuerHasCondition(s.issuer, cmapi.IssuerCondition{
+		Type:   cmapi.IssuerConditionReady,
+		Status: cmmeta.ConditionTrue,
+	}) {
+		s.Recorder.Event(s.issuer, corev1.EventTypeNormal, successVerified, messageVerified)
+	}
+	s.log.V(logf.DebugLevel).Info("step-ca issuer verified")
+	apiutil.SetIssuerCondition(s.issuer, s.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, successVerified, messageVerified)
+
+	return nil
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package stepca
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/rest"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/controller"
+	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
+	"github.com/cert-manager/cert-manager/pkg/issuer/stepca/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/stepca/client/fake"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := cmapi.StepCAIssuer{
+		URL: "https://ca.example.com",
+		Provisioner: cmapi.StepCAProvisioner{
+			Name: "admin",
+			JWK: &cmapi.StepCAJWKProvisioner{
+				PasswordSecretRef: cmmeta.SecretKeySelector{LocalObjectReference: cmmeta.LocalObjectReference{Name: "step-password"}, Key: "password"},
+			},
+		},
+	}
+
+	withClient := func(stepca *fake.StepCA) client.Builder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+			return stepca, nil
+		}
+	}
+	healthy := func() error { return nil }
+
+	tests := map[string]struct {
+		clientBuilder client.Builder
+
+		expectedErr       bool
+		expectedEvents    []string
+		expectedCondition cmapi.IssuerCondition
+	}{
+		"if the client builder fails then should error": {
+			clientBuilder: func(string, corelisters.SecretLister, cmapi.GenericIssuer, string) (client.Interface, error) {
+				return nil, errors.New("this is an error")
+			},
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorStepCA,
+				Message: "Failed to setup step-ca issuer: error building client: this is an error",
+			},
+		},
+		"if the CA is not healthy then should error": {
+			clientBuilder: withClient(&fake.StepCA{
+				HealthFn: func() error { return errors.New("connection refused") },
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorStepCA,
+				Message: "Failed to setup step-ca issuer: error checking CA health: connection refused",
+			},
+		},
+		"if a token cannot be signed then should error": {
+			clientBuilder: withClient(&fake.StepCA{
+				HealthFn: healthy,
+				TokenFn: func(string, []string) (string, error) {
+					return "", errors.New("failed to decrypt provisioner key")
+				},
+			}),
+			expectedErr: true,
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionFalse,
+				Reason:  errorStepCA,
+				Message: "Failed to setup step-ca issuer: error signing provisioner token: failed to decrypt provisioner key",
+			},
+		},
+		"if a token can be signed then should set condition": {
+			clientBuilder: withClient(&fake.StepCA{
+				HealthFn: healthy,
+				TokenFn: func(subject string, _ []string) (string, error) {
+					if subject != setupTokenSubject {
+						t.Errorf("unexpected token subject %q", subject)
+					}
+					return "token", nil
+				},
+			}),
+			expectedEvents: []string{"Normal StepCAVerified step-ca provisioner verified"},
+			expectedCondition: cmapi.IssuerCondition{
+				Status:  cmmeta.ConditionTrue,
+				Reason:  successVerified,
+				Message: messageVerified,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			rec := &controllertest.FakeRecorder{}
+			iss := gen.Issuer("test-issuer", gen.SetIssuerStepCA(cfg))
+
+			s := &StepCA{
+				issuer:            iss,
+				resourceNamespace: "test-namespace",
+				Context: &controller.Context{
+					Recorder:   rec,
+					RESTConfig: &rest.Config{},
+				},
+				clientBuilder: test.clientBuilder,
+				log:           logf.Log.WithName("stepca"),
+			}
+
+			err := s.Setup(context.TODO())
+			if err != nil && !test.expectedErr {
+				t.Errorf("expected to not get an error, but got: %v", err)
+			}
+			if err == nil && test.expectedErr {
+				t.Errorf("expected to get an error but did not get one")
+			}
+
+			if !util.EqualSorted(test.expectedEvents, rec.Events) {
+				t.Errorf("got unexpected events, exp='%s' got='%s'", test.expectedEvents, rec.Events)
+			}
+
+			conditions := iss.GetStatus().Conditions
+			if len(conditions) != 1 {
+				t.Fatalf("expected one condition, got=%+v", conditions)
+			}
+			c := conditions[0]
+			if c.Status != test.expectedCondition.Status ||
+				c.Reason != test.expectedCondition.Reason ||
+				c.Message != test.expectedCondition.Message {
+				t.Errorf("unexpected condition, exp=%+v got=%+v", test.expectedCondition, c)
+			}
+		})
+	}
+}
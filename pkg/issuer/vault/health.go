@@ -0,0 +1,44 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
+)
+
+// HealthCheck logs in to Vault, unless a cached token is available, and
+// verifies that Vault is unsealed and still accepts the token.
+func (v *Vault) HealthCheck(ctx context.Context) error {
+	if v.issuer.GetSpec().Vault == nil {
+		return errors.New(messageVaultConfigRequired)
+	}
+
+	client, err := vaultinternal.New(v.resourceNamespace, v.secretsLister, v.issuer, v.IssuerOptions.CircuitBreakers, v.IssuerOptions.VaultTokens)
+	if err != nil {
+		return fmt.Errorf("%s%v", messageVaultClientInitFailed, err)
+	}
+
+	if err := client.IsVaultInitializedAndUnsealed(); err != nil {
+		return fmt.Errorf("%s: %v", messageVaultStatusVerificationFailed, err)
+	}
+
+	return client.CheckToken()
+}
@@ -0,0 +1,42 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package vault
+
+import (
+	"context"
+	"crypto/x509"
+	"errors"
+	"fmt"
+
+	vaultinternal "github.com/cert-manager/cert-manager/internal/vault"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Revoke asks Vault to revoke the certificate. Vault does not record a
+// reason for revocations, so the reason is ignored.
+func (v *Vault) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, cert *x509.Certificate, reason int) error {
+	if v.issuer.GetSpec().Vault == nil {
+		return errors.New(messageVaultConfigRequired)
+	}
+
+	client, err := vaultinternal.New(v.resourceNamespace, v.secretsLister, v.issuer, v.IssuerOptions.CircuitBreakers, v.IssuerOptions.VaultTokens)
+	if err != nil {
+		return fmt.Errorf("%s%v", messageVaultClientInitFailed, err)
+	}
+
+	return client.Revoke(cert.SerialNumber)
+}
@@ -42,11 +42,16 @@ type Vault struct {
 func NewVault(ctx *controller.Context, issuer v1.GenericIssuer) (issuer.Interface, error) {
 	secretsLister := ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister()
 
+	resourceNamespace, err := ctx.IssuerOptions.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Vault{
 		Context:           ctx,
 		issuer:            issuer,
 		secretsLister:     secretsLister,
-		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		resourceNamespace: resourceNamespace,
 	}, nil
 }
 
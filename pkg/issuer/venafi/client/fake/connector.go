@@ -30,6 +30,7 @@ type Connector struct {
 	RetrieveCertificateFunc   func(*certificate.Request) (*certificate.PEMCollection, error)
 	RequestCertificateFunc    func(*certificate.Request) (string, error)
 	RenewCertificateFunc      func(*certificate.RenewalRequest) (string, error)
+	RevokeCertificateFunc     func(*certificate.RevocationRequest) error
 }
 
 func (f Connector) Default() *Connector {
@@ -73,3 +74,10 @@ func (f *Connector) RenewCertificate(req *certificate.RenewalRequest) (requestID
 	}
 	return f.Connector.RenewCertificate(req)
 }
+
+func (f *Connector) RevokeCertificate(req *certificate.RevocationRequest) error {
+	if f.RevokeCertificateFunc != nil {
+		return f.RevokeCertificateFunc(req)
+	}
+	return f.Connector.RevokeCertificate(req)
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package fake
 
 import (
+	"errors"
 	"time"
 
 	"github.com/Venafi/vcert/v4/pkg/endpoint"
@@ -30,6 +31,9 @@ type Venafi struct {
 	RetrieveCertificateFn   func(pickupID string, csrPEM []byte, duration time.Duration, customFields []api.CustomField) ([]byte, error)
 	ReadZoneConfigurationFn func() (*endpoint.ZoneConfiguration, error)
 	VerifyCredentialsFn     func() error
+	AccessTokenExpiryFn     func() (time.Time, error)
+	RefreshAccessTokenFn    func(refreshToken, clientID string) (*api.AccessToken, error)
+	RetireCertificateFn     func(certDER []byte, reason int) error
 }
 
 func (v *Venafi) Ping() error {
@@ -58,3 +62,26 @@ func (v *Venafi) VerifyCredentials() error {
 
 	return nil
 }
+
+// AccessTokenExpiry will return AccessTokenExpiryFn if set, otherwise an
+// error as the client is not using an access token.
+func (v *Venafi) AccessTokenExpiry() (time.Time, error) {
+	if v.AccessTokenExpiryFn != nil {
+		return v.AccessTokenExpiryFn()
+	}
+
+	return time.Time{}, errors.New("client is not using a TPP access token")
+}
+
+func (v *Venafi) RefreshAccessToken(refreshToken, clientID string) (*api.AccessToken, error) {
+	return v.RefreshAccessTokenFn(refreshToken, clientID)
+}
+
+// RetireCertificate will return RetireCertificateFn if set, otherwise nil.
+func (v *Venafi) RetireCertificate(certDER []byte, reason int) error {
+	if v.RetireCertificateFn != nil {
+		return v.RetireCertificateFn(certDER, reason)
+	}
+
+	return nil
+}
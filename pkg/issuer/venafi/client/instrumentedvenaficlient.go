@@ -87,3 +87,12 @@ func (ic instrumentedConnector) RenewCertificate(req *certificate.RenewalRequest
 	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
 	return reqID, err
 }
+
+func (ic instrumentedConnector) RevokeCertificate(req *certificate.RevocationRequest) error {
+	start := time.Now()
+	ic.logger.V(logf.TraceLevel).Info("calling RevokeCertificate")
+	err := ic.conn.RevokeCertificate(req)
+	labels := []string{"revoke_certificate"}
+	ic.metrics.ObserveVenafiRequestDuration(time.Since(start), labels...)
+	return err
+}
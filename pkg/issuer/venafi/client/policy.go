@@ -0,0 +1,163 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/Venafi/vcert/v4/pkg/certificate"
+	"github.com/Venafi/vcert/v4/pkg/endpoint"
+)
+
+// ZonePolicyViolations returns a description of each way in which a
+// certificate requested using the given CSR and public key would violate the
+// policy of a Venafi zone. This allows a request to be rejected up front,
+// rather than with an error from the Venafi API once it has been submitted.
+// Zone policies do not limit the number of SANs directly, instead every SAN
+// must match one of the patterns allowed for its type.
+func ZonePolicyViolations(zone *endpoint.ZoneConfiguration, csr *x509.CertificateRequest, pub crypto.PublicKey) []string {
+	var violations []string
+
+	policy := zone.Policy
+	if cn := csr.Subject.CommonName; cn != "" {
+		if !matchesAny(policy.SubjectCNRegexes, cn) {
+			violations = append(violations, fmt.Sprintf("common name %q is not allowed", cn))
+		}
+		if !policy.AllowWildcards && strings.HasPrefix(cn, "*") {
+			violations = append(violations, fmt.Sprintf("wildcard common name %q is not allowed", cn))
+		}
+	}
+	for _, name := range csr.DNSNames {
+		if !matchesAny(policy.DnsSanRegExs, name) {
+			violations = append(violations, fmt.Sprintf("DNS name %q is not allowed", name))
+		}
+		if !policy.AllowWildcards && strings.HasPrefix(name, "*") {
+			violations = append(violations, fmt.Sprintf("wildcard DNS name %q is not allowed", name))
+		}
+	}
+	for _, ip := range csr.IPAddresses {
+		if !matchesAny(policy.IpSanRegExs, ip.String()) {
+			violations = append(violations, fmt.Sprintf("IP address %q is not allowed", ip))
+		}
+	}
+	for _, email := range csr.EmailAddresses {
+		if !matchesAny(policy.EmailSanRegExs, email) {
+			violations = append(violations, fmt.Sprintf("email address %q is not allowed", email))
+		}
+	}
+	for _, uri := range csr.URIs {
+		if !matchesAny(policy.UriSanRegExs, uri.String()) {
+			violations = append(violations, fmt.Sprintf("URI %q is not allowed", uri))
+		}
+	}
+
+	if key, ok := keyAllowed(policy.AllowedKeyConfigurations, pub); !ok {
+		violations = append(violations, fmt.Sprintf("%s key is not allowed", key))
+	}
+
+	return violations
+}
+
+// matchesAny returns true if value matches one of the given patterns, or if
+// no patterns are given. Invalid patterns never match.
+func matchesAny(patterns []string, value string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+	for _, pattern := range patterns {
+		if matched, err := regexp.MatchString(pattern, value); err == nil && matched {
+			return true
+		}
+	}
+	return false
+}
+
+// keyAllowed returns true if the public key is permitted by one of the allowed
+// key configurations, or if no key configurations are given. Key types which
+// zone policies cannot describe are always allowed. A description of the key
+// is also returned.
+func keyAllowed(allowed []endpoint.AllowedKeyConfiguration, pub crypto.PublicKey) (string, bool) {
+	var keyType certificate.KeyType
+	var size int
+	var curve certificate.EllipticCurve
+	var description string
+	switch pub := pub.(type) {
+	case *rsa.PublicKey:
+		keyType, size = certificate.KeyTypeRSA, pub.N.BitLen()
+		description = fmt.Sprintf("%d bit RSA", size)
+	case *ecdsa.PublicKey:
+		switch pub.Curve {
+		case elliptic.P256():
+			curve = certificate.EllipticCurveP256
+		case elliptic.P384():
+			curve = certificate.EllipticCurveP384
+		case elliptic.P521():
+			curve = certificate.EllipticCurveP521
+		default:
+			return "", true
+		}
+		keyType = certificate.KeyTypeECDSA
+		description = fmt.Sprintf("%s ECDSA", pub.Curve.Params().Name)
+	default:
+		return "", true
+	}
+
+	if len(allowed) == 0 {
+		return description, true
+	}
+	for _, cfg := range allowed {
+		if cfg.KeyType != keyType {
+			continue
+		}
+		switch keyType {
+		case certificate.KeyTypeRSA:
+			if len(cfg.KeySizes) == 0 || containsInt(cfg.KeySizes, size) {
+				return description, true
+			}
+		case certificate.KeyTypeECDSA:
+			if len(cfg.KeyCurves) == 0 || containsCurve(cfg.KeyCurves, curve) {
+				return description, true
+			}
+		}
+	}
+	return description, false
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
+
+func containsCurve(curves []certificate.EllipticCurve, curve certificate.EllipticCurve) bool {
+	for _, c := range curves {
+		if c == curve {
+			return true
+		}
+	}
+	return false
+}
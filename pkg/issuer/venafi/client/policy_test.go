@@ -0,0 +1,128 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"net"
+	"net/url"
+	"reflect"
+	"testing"
+
+	"github.com/Venafi/vcert/v4/pkg/certificate"
+	"github.com/Venafi/vcert/v4/pkg/endpoint"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestZonePolicyViolations(t *testing.T) {
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ecdsaKey, err := pki.GenerateECPrivateKey(384)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	restrictedZone := &endpoint.ZoneConfiguration{
+		Policy: endpoint.Policy{
+			SubjectCNRegexes: []string{`^[a-z.-]+\.example\.com$`},
+			DnsSanRegExs:     []string{`^[a-z.-]+\.example\.com$`},
+			IpSanRegExs:      []string{`^10\.`},
+			EmailSanRegExs:   []string{`@example\.com$`},
+			UriSanRegExs:     []string{`^spiffe://example\.com/`},
+			AllowedKeyConfigurations: []endpoint.AllowedKeyConfiguration{
+				{KeyType: certificate.KeyTypeRSA, KeySizes: []int{2048, 4096}},
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		zone       *endpoint.ZoneConfiguration
+		csr        *x509.CertificateRequest
+		pub        crypto.PublicKey
+		violations []string
+	}{
+		"an unrestricted zone allows any certificate": {
+			zone: &endpoint.ZoneConfiguration{Policy: endpoint.Policy{AllowWildcards: true}},
+			csr: &x509.CertificateRequest{
+				Subject:  pkix.Name{CommonName: "*.example.org"},
+				DNSNames: []string{"*.example.org", "example.net"},
+			},
+			pub: ecdsaKey.Public(),
+		},
+		"a certificate matching the zone policy is allowed": {
+			zone: restrictedZone,
+			csr: &x509.CertificateRequest{
+				Subject:        pkix.Name{CommonName: "www.example.com"},
+				DNSNames:       []string{"www.example.com", "api.example.com"},
+				IPAddresses:    []net.IP{net.ParseIP("10.0.0.1")},
+				EmailAddresses: []string{"admin@example.com"},
+				URIs:           []*url.URL{{Scheme: "spiffe", Host: "example.com", Path: "/app"}},
+			},
+			pub: rsaKey.Public(),
+		},
+		"every violation is reported": {
+			zone: restrictedZone,
+			csr: &x509.CertificateRequest{
+				Subject:        pkix.Name{CommonName: "*.example.com"},
+				DNSNames:       []string{"www.example.com", "www.example.org"},
+				IPAddresses:    []net.IP{net.ParseIP("192.168.0.1")},
+				EmailAddresses: []string{"admin@example.org"},
+				URIs:           []*url.URL{{Scheme: "spiffe", Host: "example.org", Path: "/app"}},
+			},
+			pub: ecdsaKey.Public(),
+			violations: []string{
+				`common name "*.example.com" is not allowed`,
+				`wildcard common name "*.example.com" is not allowed`,
+				`DNS name "www.example.org" is not allowed`,
+				`IP address "192.168.0.1" is not allowed`,
+				`email address "admin@example.org" is not allowed`,
+				`URI "spiffe://example.org/app" is not allowed`,
+				`P-384 ECDSA key is not allowed`,
+			},
+		},
+		"an RSA key of a size not allowed by the zone is reported": {
+			zone: restrictedZone,
+			csr:  &x509.CertificateRequest{Subject: pkix.Name{CommonName: "www.example.com"}},
+			pub:  mustGenerateRSAPublicKey(t, 3072),
+			violations: []string{
+				`3072 bit RSA key is not allowed`,
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			violations := ZonePolicyViolations(test.zone, test.csr, test.pub)
+			if !reflect.DeepEqual(violations, test.violations) {
+				t.Errorf("unexpected violations, exp=%q got=%q", test.violations, violations)
+			}
+		})
+	}
+}
+
+func mustGenerateRSAPublicKey(t *testing.T, size int) crypto.PublicKey {
+	key, err := pki.GenerateRSAPrivateKey(size)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return key.Public()
+}
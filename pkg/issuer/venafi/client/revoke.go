@@ -0,0 +1,58 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha1"
+	"fmt"
+	"strings"
+
+	"github.com/Venafi/vcert/v4/pkg/certificate"
+)
+
+// vcertRevocationReasons maps RFC 5280 reason codes to the names of the
+// revocation reasons understood by vcert. Other reason codes are sent as
+// "none".
+var vcertRevocationReasons = map[int]string{
+	1: "key-compromise",
+	2: "ca-compromise",
+	3: "affiliation-changed",
+	4: "superseded",
+	5: "cessation-of-operation",
+}
+
+// RetireCertificate revokes the given DER encoded certificate with the RFC
+// 5280 reason code, and disables it so that it is not renewed by Venafi.
+// The certificate is identified by its SHA-1 thumbprint.
+func (v *Venafi) RetireCertificate(certDER []byte, reason int) error {
+	vcertReason, ok := vcertRevocationReasons[reason]
+	if !ok {
+		vcertReason = "none"
+	}
+
+	err := v.vcertClient.RevokeCertificate(&certificate.RevocationRequest{
+		Thumbprint: strings.ToUpper(fmt.Sprintf("%x", sha1.Sum(certDER))),
+		Reason:     vcertReason,
+		Comments:   "Revoked by cert-manager",
+		Disable:    true,
+	})
+	if err != nil {
+		return fmt.Errorf("error revoking certificate: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package client
+
+import (
+	"crypto/sha1"
+	"errors"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/Venafi/vcert/v4/pkg/certificate"
+
+	internalfake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
+)
+
+func TestVenafi_RetireCertificate(t *testing.T) {
+	certDER := []byte("certificate")
+	thumbprint := strings.ToUpper(fmt.Sprintf("%x", sha1.Sum(certDER)))
+
+	tests := map[string]struct {
+		reason         int
+		revokeErr      error
+		expectedReason string
+		wantErr        bool
+	}{
+		"known reasons are sent by name": {
+			reason:         1,
+			expectedReason: "key-compromise",
+		},
+		"reasons vcert does not know are sent as none": {
+			reason:         9,
+			expectedReason: "none",
+		},
+		"errors revoking the certificate are returned": {
+			reason:         0,
+			revokeErr:      errors.New("not found"),
+			expectedReason: "none",
+			wantErr:        true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			var got *certificate.RevocationRequest
+			v := &Venafi{
+				vcertClient: internalfake.Connector{
+					RevokeCertificateFunc: func(req *certificate.RevocationRequest) error {
+						got = req
+						return test.revokeErr
+					},
+				}.Default(),
+			}
+
+			err := v.RetireCertificate(certDER, test.reason)
+			if (err != nil) != test.wantErr {
+				t.Fatalf("RetireCertificate() error = %v, wantErr %v", err, test.wantErr)
+			}
+			if got == nil {
+				t.Fatal("expected the certificate to be revoked")
+			}
+			if got.Thumbprint != thumbprint || got.Reason != test.expectedReason || !got.Disable {
+				t.Errorf("unexpected revocation request: %+v", got)
+			}
+		})
+	}
+}
@@ -17,7 +17,10 @@ limitations under the License.
 package client
 
 import (
+	"crypto/tls"
+	"crypto/x509"
 	"fmt"
+	"net/http"
 	"time"
 
 	vcert "github.com/Venafi/vcert/v4"
@@ -28,21 +31,32 @@ import (
 	"github.com/go-logr/logr"
 	corelisters "k8s.io/client-go/listers/core/v1"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 )
 
 const (
-	tppUsernameKey    = "username"
-	tppPasswordKey    = "password"
-	tppAccessTokenKey = "access-token"
+	tppUsernameKey = "username"
+	tppPasswordKey = "password"
+
+	// TPPAccessTokenKey is the key of the TPP access token in an issuer's
+	// credentials Secret.
+	TPPAccessTokenKey = "access-token"
+	// TPPRefreshTokenKey is the key of the refresh token used to obtain new
+	// TPP access tokens in an issuer's credentials Secret.
+	TPPRefreshTokenKey = "refresh-token"
+	// TPPClientIDKey is the key of the ID of the TPP API integration the
+	// access token was issued to in an issuer's credentials Secret. It is
+	// required to refresh the access token.
+	TPPClientIDKey = "client-id"
 
 	defaultAPIKeyKey = "api-key"
 )
 
 type VenafiClientBuilder func(namespace string, secretsLister corelisters.SecretLister,
-	issuer cmapi.GenericIssuer, metrics *metrics.Metrics, logger logr.Logger) (Interface, error)
+	issuer cmapi.GenericIssuer, metrics *metrics.Metrics, breakers *circuitbreaker.Registry, logger logr.Logger) (Interface, error)
 
 // Interface implements a Venafi client
 type Interface interface {
@@ -52,6 +66,9 @@ type Interface interface {
 	ReadZoneConfiguration() (*endpoint.ZoneConfiguration, error)
 	SetClient(endpoint.Connector)
 	VerifyCredentials() error
+	AccessTokenExpiry() (time.Time, error)
+	RefreshAccessToken(refreshToken, clientID string) (*api.AccessToken, error)
+	RetireCertificate(certDER []byte, reason int) error
 }
 
 // Venafi is a implementation of vcert library to manager certificates from TPP or Venafi Cloud
@@ -77,16 +94,28 @@ type connector interface {
 	RetrieveCertificate(req *certificate.Request) (certificates *certificate.PEMCollection, err error)
 	// TODO: (irbekrm) this method is never used- can it be removed?
 	RenewCertificate(req *certificate.RenewalRequest) (requestID string, err error)
+	RevokeCertificate(req *certificate.RevocationRequest) error
 }
 
-// New constructs a Venafi client Interface. Errors may be network errors and
-// should be considered for retrying.
-func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, metrics *metrics.Metrics, logger logr.Logger) (Interface, error) {
+// New constructs a Venafi client Interface. Requests to Venafi are made
+// through the issuer's circuit breaker in breakers, which may be nil to
+// disable circuit breaking. Errors may be network errors and should be
+// considered for retrying.
+func New(namespace string, secretsLister corelisters.SecretLister, issuer cmapi.GenericIssuer, metrics *metrics.Metrics, breakers *circuitbreaker.Registry, logger logr.Logger) (Interface, error) {
 	cfg, err := configForIssuer(issuer, secretsLister, namespace)
 	if err != nil {
 		return nil, err
 	}
 
+	if breakers != nil {
+		client, err := httpClientForTrust(cfg.ConnectionTrust)
+		if err != nil {
+			return nil, err
+		}
+		client.Transport = breakers.RoundTripper(circuitbreaker.KeyForIssuer(issuer), client.Transport)
+		cfg.Client = client
+	}
+
 	vcertClient, err := vcert.NewClient(cfg)
 	if err != nil {
 		return nil, fmt.Errorf("error creating Venafi client: %s", err.Error())
@@ -134,7 +163,7 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister corelisters.SecretLi
 
 		username := string(tppSecret.Data[tppUsernameKey])
 		password := string(tppSecret.Data[tppPasswordKey])
-		accessToken := string(tppSecret.Data[tppAccessTokenKey])
+		accessToken := string(tppSecret.Data[TPPAccessTokenKey])
 		caBundle := string(tpp.CABundle)
 
 		return &vcert.Config{
@@ -187,6 +216,29 @@ func configForIssuer(iss cmapi.GenericIssuer, secretsLister corelisters.SecretLi
 	return nil, fmt.Errorf("neither Venafi Cloud or TPP configuration found")
 }
 
+// httpClientForTrust returns an HTTP client equivalent to the one vcert
+// constructs by default, trusting the given PEM encoded CA bundle if set.
+// vcert ignores the configured ConnectionTrust when a client is provided, so
+// it must be applied here instead.
+func httpClientForTrust(caBundle string) (*http.Client, error) {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if caBundle != "" {
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM([]byte(caBundle)) {
+			return nil, fmt.Errorf("error loading Venafi CA bundle")
+		}
+		if transport.TLSClientConfig == nil {
+			transport.TLSClientConfig = &tls.Config{}
+		}
+		transport.TLSClientConfig.RootCAs = pool
+	}
+
+	return &http.Client{
+		Timeout:   30 * time.Second,
+		Transport: transport,
+	}, nil
+}
+
 func (v *Venafi) Ping() error {
 	return v.vcertClient.Ping()
 }
@@ -245,3 +297,55 @@ func (v *Venafi) VerifyCredentials() error {
 
 	return fmt.Errorf("neither tppClient or cloudClient have been set")
 }
+
+// AccessTokenExpiry returns the time at which the TPP access token the client
+// authenticates with expires.
+func (v *Venafi) AccessTokenExpiry() (time.Time, error) {
+	if v.tppClient == nil || v.config.Credentials == nil || v.config.Credentials.AccessToken == "" {
+		return time.Time{}, fmt.Errorf("client is not using a TPP access token")
+	}
+
+	resp, err := v.tppClient.VerifyAccessToken(&endpoint.Authentication{
+		AccessToken: v.config.Credentials.AccessToken,
+	})
+	if err != nil {
+		return time.Time{}, fmt.Errorf("tppClient.VerifyAccessToken: %v", err)
+	}
+
+	expires, err := time.Parse(time.RFC3339, resp.Expires)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("failed to parse access token expiry %q: %v", resp.Expires, err)
+	}
+
+	return expires, nil
+}
+
+// RefreshAccessToken obtains a new TPP access token using the refresh token
+// grant, and authenticates the client with it.
+func (v *Venafi) RefreshAccessToken(refreshToken, clientID string) (*api.AccessToken, error) {
+	if v.tppClient == nil {
+		return nil, fmt.Errorf("access tokens can only be refreshed for Venafi TPP")
+	}
+
+	resp, err := v.tppClient.RefreshAccessToken(&endpoint.Authentication{
+		RefreshToken: refreshToken,
+		ClientId:     clientID,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tppClient.RefreshAccessToken: %v", err)
+	}
+
+	err = v.tppClient.Authenticate(&endpoint.Authentication{
+		AccessToken: resp.Access_token,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("tppClient.Authenticate: %v", err)
+	}
+	v.config.Credentials.AccessToken = resp.Access_token
+
+	return &api.AccessToken{
+		AccessToken:  resp.Access_token,
+		RefreshToken: resp.Refresh_token,
+		Expires:      time.Unix(int64(resp.Expires), 0),
+	}, nil
+}
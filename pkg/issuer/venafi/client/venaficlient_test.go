@@ -131,7 +131,7 @@ func TestConfigForIssuerT(t *testing.T) {
 			iss: tppIssuer,
 			secretsLister: generateSecretLister(&corev1.Secret{
 				Data: map[string][]byte{
-					tppAccessTokenKey: []byte(accessToken),
+					TPPAccessTokenKey: []byte(accessToken),
 				},
 			}, nil),
 			CheckFn: func(t *testing.T, cnf *vcert.Config) {
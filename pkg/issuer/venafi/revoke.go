@@ -0,0 +1,35 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"crypto/x509"
+	"fmt"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Revoke asks Venafi to revoke and retire the certificate, so that it is
+// no longer renewed by Venafi either.
+func (v *Venafi) Revoke(ctx context.Context, cr *cmapi.CertificateRequest, cert *x509.Certificate, reason int) error {
+	client, err := v.clientBuilder(v.resourceNamespace, v.secretsLister, v.issuer, v.Metrics, v.IssuerOptions.CircuitBreakers, v.log)
+	if err != nil {
+		return fmt.Errorf("error building client: %v", err)
+	}
+	return client.RetireCertificate(cert.Raw, reason)
+}
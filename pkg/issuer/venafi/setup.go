@@ -19,6 +19,7 @@ package venafi
 import (
 	"context"
 	"fmt"
+	"time"
 
 	corev1 "k8s.io/api/core/v1"
 
@@ -38,7 +39,7 @@ func (v *Venafi) Setup(ctx context.Context) (err error) {
 		}
 	}()
 
-	client, err := v.clientBuilder(v.resourceNamespace, v.secretsLister, v.issuer, v.Metrics, v.log)
+	client, err := v.clientBuilder(v.resourceNamespace, v.secretsLister, v.issuer, v.Metrics, v.IssuerOptions.CircuitBreakers, v.log)
 	if err != nil {
 		return fmt.Errorf("error building client: %v", err)
 	}
@@ -47,6 +48,14 @@ func (v *Venafi) Setup(ctx context.Context) (err error) {
 		return fmt.Errorf("error pinging Venafi API: %v", err)
 	}
 
+	var accessTokenExpires *time.Time
+	if venCfg := v.issuer.GetSpec().Venafi; venCfg != nil && venCfg.TPP != nil {
+		accessTokenExpires, err = v.refreshTPPAccessToken(ctx, client)
+		if err != nil {
+			return fmt.Errorf("error refreshing TPP access token: %v", err)
+		}
+	}
+
 	err = client.VerifyCredentials()
 	if err != nil {
 		return fmt.Errorf("client.VerifyCredentials: %v", err)
@@ -61,7 +70,11 @@ func (v *Venafi) Setup(ctx context.Context) (err error) {
 		v.Recorder.Eventf(v.issuer, corev1.EventTypeNormal, "Ready", "Verified issuer with Venafi server")
 	}
 	v.log.V(logf.DebugLevel).Info("Venafi issuer started")
-	apiutil.SetIssuerCondition(v.issuer, v.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, "Venafi issuer started", "Venafi issuer started")
+	message := "Venafi issuer started"
+	if accessTokenExpires != nil {
+		message = fmt.Sprintf("%s, TPP access token expires at %s", message, accessTokenExpires.UTC().Format(time.RFC3339))
+	}
+	apiutil.SetIssuerCondition(v.issuer, v.issuer.GetGeneration(), cmapi.IssuerConditionReady, cmmeta.ConditionTrue, "Venafi issuer started", message)
 
 	return nil
 }
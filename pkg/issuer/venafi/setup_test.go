@@ -20,34 +20,46 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"reflect"
 	"testing"
+	"time"
 
 	"github.com/go-logr/logr"
 
+	"github.com/cert-manager/cert-manager/internal/circuitbreaker"
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
 	"github.com/cert-manager/cert-manager/pkg/metrics"
 
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
 	corelisters "k8s.io/client-go/listers/core/v1"
+	fakeclock "k8s.io/utils/clock/testing"
 
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
 	"github.com/cert-manager/cert-manager/pkg/controller"
 	controllertest "github.com/cert-manager/cert-manager/pkg/controller/test"
 	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/api"
 	internalvenafifake "github.com/cert-manager/cert-manager/pkg/issuer/venafi/client/fake"
 	"github.com/cert-manager/cert-manager/pkg/util"
 	"github.com/cert-manager/cert-manager/test/unit/gen"
+	"github.com/cert-manager/cert-manager/test/unit/listers"
 )
 
+var fixedClockStart = time.Date(2022, 6, 1, 12, 0, 0, 0, time.UTC)
+
 func TestSetup(t *testing.T) {
 	baseIssuer := gen.Issuer("test-issuer")
 
 	failingClientBuilder := func(string, corelisters.SecretLister,
-		cmapi.GenericIssuer, *metrics.Metrics, logr.Logger) (client.Interface, error) {
+		cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
 		return nil, errors.New("this is an error")
 	}
 
 	failingPingClient := func(string, corelisters.SecretLister,
-		cmapi.GenericIssuer, *metrics.Metrics, logr.Logger) (client.Interface, error) {
+		cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
 		return &internalvenafifake.Venafi{
 			PingFn: func() error {
 				return errors.New("this is a ping error")
@@ -56,7 +68,7 @@ func TestSetup(t *testing.T) {
 	}
 
 	pingClient := func(string, corelisters.SecretLister,
-		cmapi.GenericIssuer, *metrics.Metrics, logr.Logger) (client.Interface, error) {
+		cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
 		return &internalvenafifake.Venafi{
 			PingFn: func() error {
 				return nil
@@ -64,7 +76,7 @@ func TestSetup(t *testing.T) {
 		}, nil
 	}
 
-	verifyCredentialsClient := func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, logr.Logger) (client.Interface, error) {
+	verifyCredentialsClient := func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
 		return &internalvenafifake.Venafi{
 			PingFn: func() error {
 				return nil
@@ -75,7 +87,7 @@ func TestSetup(t *testing.T) {
 		}, nil
 	}
 
-	failingVerifyCredentialsClient := func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, logr.Logger) (client.Interface, error) {
+	failingVerifyCredentialsClient := func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
 		return &internalvenafifake.Venafi{
 			PingFn: func() error {
 				return nil
@@ -86,6 +98,47 @@ func TestSetup(t *testing.T) {
 		}, nil
 	}
 
+	tppIssuer := gen.Issuer("test-issuer",
+		gen.SetIssuerVenafi(cmapi.VenafiIssuer{
+			TPP: &cmapi.VenafiTPP{
+				CredentialsRef: cmmeta.LocalObjectReference{Name: "tpp-credentials"},
+			},
+		}),
+	)
+
+	tppSecret := func(data map[string]string) *corev1.Secret {
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "tpp-credentials", Namespace: "test-namespace"},
+			Data:       map[string][]byte{},
+		}
+		for k, v := range data {
+			secret.Data[k] = []byte(v)
+		}
+		return secret
+	}
+
+	accessTokenClient := func(expires time.Time, refresh func(refreshToken, clientID string) (*api.AccessToken, error)) client.VenafiClientBuilder {
+		return func(string, corelisters.SecretLister, cmapi.GenericIssuer, *metrics.Metrics, *circuitbreaker.Registry, logr.Logger) (client.Interface, error) {
+			return &internalvenafifake.Venafi{
+				PingFn: func() error {
+					return nil
+				},
+				AccessTokenExpiryFn: func() (time.Time, error) {
+					return expires, nil
+				},
+				RefreshAccessTokenFn: refresh,
+			}, nil
+		}
+	}
+
+	refreshedExpiry := fixedClockStart.Add(90 * 24 * time.Hour)
+	refreshAccessToken := func(refreshToken, clientID string) (*api.AccessToken, error) {
+		if refreshToken != "refresh-1" || clientID != "cert-manager" {
+			return nil, fmt.Errorf("unexpected refresh token %q or client ID %q", refreshToken, clientID)
+		}
+		return &api.AccessToken{AccessToken: "access-2", RefreshToken: "refresh-2", Expires: refreshedExpiry}, nil
+	}
+
 	tests := map[string]testSetupT{
 		"if client builder fails then should error": {
 			clientBuilder: failingClientBuilder,
@@ -146,6 +199,96 @@ func TestSetup(t *testing.T) {
 				Status:  "False",
 			},
 		},
+
+		"an access token which expires soon is refreshed and stored in the credentials secret": {
+			clientBuilder: accessTokenClient(fixedClockStart.Add(time.Hour), refreshAccessToken),
+			iss:           tppIssuer.DeepCopy(),
+			secret: tppSecret(map[string]string{
+				client.TPPAccessTokenKey:  "access-1",
+				client.TPPRefreshTokenKey: "refresh-1",
+				client.TPPClientIDKey:     "cert-manager",
+			}),
+			expectedSecretData: map[string][]byte{
+				client.TPPAccessTokenKey:  []byte("access-2"),
+				client.TPPRefreshTokenKey: []byte("refresh-2"),
+				client.TPPClientIDKey:     []byte("cert-manager"),
+			},
+			expectedCondition: &cmapi.IssuerCondition{
+				Message: "Venafi issuer started, TPP access token expires at 2022-08-30T12:00:00Z",
+				Reason:  "Venafi issuer started",
+				Status:  "True",
+			},
+			expectedEvents: []string{
+				"Normal AccessTokenRefreshed Refreshed TPP access token, which expires at 2022-08-30T12:00:00Z",
+				"Normal Ready Verified issuer with Venafi server",
+			},
+		},
+
+		"an access token which is valid for longer than the refresh window is not refreshed": {
+			clientBuilder: accessTokenClient(fixedClockStart.Add(72*time.Hour), nil),
+			iss:           tppIssuer.DeepCopy(),
+			secret: tppSecret(map[string]string{
+				client.TPPAccessTokenKey:  "access-1",
+				client.TPPRefreshTokenKey: "refresh-1",
+				client.TPPClientIDKey:     "cert-manager",
+			}),
+			expectedSecretData: map[string][]byte{
+				client.TPPAccessTokenKey:  []byte("access-1"),
+				client.TPPRefreshTokenKey: []byte("refresh-1"),
+				client.TPPClientIDKey:     []byte("cert-manager"),
+			},
+			expectedCondition: &cmapi.IssuerCondition{
+				Message: "Venafi issuer started, TPP access token expires at 2022-06-04T12:00:00Z",
+				Reason:  "Venafi issuer started",
+				Status:  "True",
+			},
+			expectedEvents: []string{
+				"Normal Ready Verified issuer with Venafi server",
+			},
+		},
+
+		"an access token which expires soon without a refresh token raises a warning": {
+			clientBuilder: accessTokenClient(fixedClockStart.Add(time.Hour), nil),
+			iss:           tppIssuer.DeepCopy(),
+			secret: tppSecret(map[string]string{
+				client.TPPAccessTokenKey: "access-1",
+			}),
+			expectedSecretData: map[string][]byte{
+				client.TPPAccessTokenKey: []byte("access-1"),
+			},
+			expectedCondition: &cmapi.IssuerCondition{
+				Message: "Venafi issuer started, TPP access token expires at 2022-06-01T13:00:00Z",
+				Reason:  "Venafi issuer started",
+				Status:  "True",
+			},
+			expectedEvents: []string{
+				`Warning AccessTokenExpiring TPP access token expires at 2022-06-01T13:00:00Z and no "refresh-token" is set in Secret "tpp-credentials" to refresh it`,
+				"Normal Ready Verified issuer with Venafi server",
+			},
+		},
+
+		"if the access token cannot be refreshed we should set condition to False": {
+			clientBuilder: accessTokenClient(fixedClockStart.Add(-time.Hour), func(string, string) (*api.AccessToken, error) {
+				return nil, errors.New("refresh token expired")
+			}),
+			iss: tppIssuer.DeepCopy(),
+			secret: tppSecret(map[string]string{
+				client.TPPAccessTokenKey:  "access-1",
+				client.TPPRefreshTokenKey: "refresh-1",
+				client.TPPClientIDKey:     "cert-manager",
+			}),
+			expectedSecretData: map[string][]byte{
+				client.TPPAccessTokenKey:  []byte("access-1"),
+				client.TPPRefreshTokenKey: []byte("refresh-1"),
+				client.TPPClientIDKey:     []byte("cert-manager"),
+			},
+			expectedErr: true,
+			expectedCondition: &cmapi.IssuerCondition{
+				Reason:  "ErrorSetup",
+				Message: "Failed to setup Venafi issuer: error refreshing TPP access token: refresh token expired",
+				Status:  "False",
+			},
+		},
 	}
 
 	for name, test := range tests {
@@ -158,10 +301,12 @@ func TestSetup(t *testing.T) {
 type testSetupT struct {
 	clientBuilder client.VenafiClientBuilder
 	iss           cmapi.GenericIssuer
+	secret        *corev1.Secret
 
-	expectedErr       bool
-	expectedEvents    []string
-	expectedCondition *cmapi.IssuerCondition
+	expectedErr        bool
+	expectedEvents     []string
+	expectedCondition  *cmapi.IssuerCondition
+	expectedSecretData map[string][]byte
 }
 
 func (s *testSetupT) runTest(t *testing.T) {
@@ -171,11 +316,20 @@ func (s *testSetupT) runTest(t *testing.T) {
 		resourceNamespace: "test-namespace",
 		Context: &controller.Context{
 			Recorder: rec,
+			ContextOptions: controller.ContextOptions{
+				Clock: fakeclock.NewFakeClock(fixedClockStart),
+			},
 		},
 		issuer:        s.iss,
 		clientBuilder: s.clientBuilder,
 		log:           logf.Log.WithName("venafi"),
 	}
+	if s.secret != nil {
+		v.secretsLister = listers.FakeSecretListerFrom(listers.NewFakeSecretLister(),
+			listers.SetFakeSecretNamespaceListerGet(s.secret, nil),
+		)
+		v.Client = kubefake.NewSimpleClientset(s.secret)
+	}
 
 	err := v.Setup(context.TODO())
 	if err != nil && !s.expectedErr {
@@ -190,6 +344,17 @@ func (s *testSetupT) runTest(t *testing.T) {
 			s.expectedEvents, rec.Events)
 	}
 
+	if s.secret != nil {
+		secret, err := v.Client.CoreV1().Secrets(s.secret.Namespace).Get(context.TODO(), s.secret.Name, metav1.GetOptions{})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !reflect.DeepEqual(s.expectedSecretData, secret.Data) {
+			t.Errorf("unexpected credentials secret data, exp=%s got=%s",
+				s.expectedSecretData, secret.Data)
+		}
+	}
+
 	conditions := s.iss.GetStatus().Conditions
 	if s.expectedCondition == nil &&
 		len(conditions) > 0 {
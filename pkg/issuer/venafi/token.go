@@ -0,0 +1,93 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package venafi
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	"github.com/cert-manager/cert-manager/pkg/issuer/venafi/client"
+)
+
+// tppAccessTokenRefreshWindow is how long before it expires a TPP access token
+// is refreshed.
+const tppAccessTokenRefreshWindow = 24 * time.Hour
+
+// refreshTPPAccessToken keeps the TPP access token in the issuer's credentials
+// Secret valid. If the Secret holds a refresh token and client ID, an access
+// token which has expired or expires within tppAccessTokenRefreshWindow is
+// refreshed using the refresh token grant, and the new tokens are written
+// back to the Secret. TPP invalidates a refresh token once it has been used,
+// so the Secret must be writable by cert-manager.
+// It returns the expiry of the access token in use, or nil if the issuer does
+// not authenticate with an access token or its expiry cannot be determined.
+func (v *Venafi) refreshTPPAccessToken(ctx context.Context, venafiClient client.Interface) (*time.Time, error) {
+	secretName := v.issuer.GetSpec().Venafi.TPP.CredentialsRef.Name
+	secret, err := v.secretsLister.Secrets(v.resourceNamespace).Get(secretName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(secret.Data[client.TPPAccessTokenKey]) == 0 {
+		return nil, nil
+	}
+
+	expires, expiryErr := venafiClient.AccessTokenExpiry()
+	refreshToken := string(secret.Data[client.TPPRefreshTokenKey])
+	clientID := string(secret.Data[client.TPPClientIDKey])
+	if refreshToken == "" || clientID == "" {
+		// The access token cannot be refreshed, so leave it to the
+		// credentials check to report whether it is still valid.
+		if expiryErr != nil {
+			return nil, nil
+		}
+		if v.Clock.Now().Add(tppAccessTokenRefreshWindow).After(expires) {
+			v.Recorder.Eventf(v.issuer, corev1.EventTypeWarning, "AccessTokenExpiring",
+				"TPP access token expires at %s and no %q is set in Secret %q to refresh it",
+				expires.UTC().Format(time.RFC3339), client.TPPRefreshTokenKey, secretName)
+		}
+		return &expires, nil
+	}
+
+	if expiryErr == nil && v.Clock.Now().Add(tppAccessTokenRefreshWindow).Before(expires) {
+		return &expires, nil
+	}
+
+	token, err := venafiClient.RefreshAccessToken(refreshToken, clientID)
+	if err != nil {
+		return nil, err
+	}
+
+	secret = secret.DeepCopy()
+	secret.Data[client.TPPAccessTokenKey] = []byte(token.AccessToken)
+	if token.RefreshToken != "" {
+		secret.Data[client.TPPRefreshTokenKey] = []byte(token.RefreshToken)
+	}
+	_, err = v.Client.CoreV1().Secrets(v.resourceNamespace).Update(ctx, secret, metav1.UpdateOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store refreshed access token in Secret %q: %v", secretName, err)
+	}
+
+	v.Recorder.Eventf(v.issuer, corev1.EventTypeNormal, "AccessTokenRefreshed",
+		"Refreshed TPP access token, which expires at %s", token.Expires.UTC().Format(time.RFC3339))
+
+	return &token.Expires, nil
+}
@@ -50,10 +50,15 @@ type Venafi struct {
 }
 
 func NewVenafi(ctx *controller.Context, issuer cmapi.GenericIssuer) (issuer.Interface, error) {
+	resourceNamespace, err := ctx.IssuerOptions.ResourceNamespace(issuer)
+	if err != nil {
+		return nil, err
+	}
+
 	return &Venafi{
 		issuer:            issuer,
 		secretsLister:     ctx.KubeSharedInformerFactory.Core().V1().Secrets().Lister(),
-		resourceNamespace: ctx.IssuerOptions.ResourceNamespace(issuer),
+		resourceNamespace: resourceNamespace,
 		clientBuilder:     client.New,
 		Context:           ctx,
 		log:               logf.Log.WithName("venafi"),
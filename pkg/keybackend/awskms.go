@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keybackend
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// awsKMS signs digests with an asymmetric AWS KMS key.
+type awsKMS struct {
+	ctx    context.Context
+	client kmsiface.KMSAPI
+	keyID  string
+	public crypto.PublicKey
+}
+
+func newAWSKMS(ctx context.Context, namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.AWSKMSPrivateKeyBackend, ambient bool, userAgent string) (crypto.Signer, error) {
+	sessionOpts := session.Options{
+		Config: *aws.NewConfig().WithRegion(cfg.Region),
+	}
+
+	if cfg.Auth == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no credentials configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the default credential chain
+		// being used.
+	} else {
+		accessKeyID, err := readSecretKey(secretsLister, namespace, cfg.Auth.AccessKeyIDSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := readSecretKey(secretsLister, namespace, cfg.Auth.SecretAccessKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentials(
+			strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretAccessKey)), "")
+		// also disable 'ambient' region sources
+		sessionOpts.SharedConfigState = session.SharedConfigDisable
+	}
+
+	sess, err := session.NewSessionWithOptions(sessionOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %v", err)
+	}
+
+	if cfg.Role != "" {
+		result, err := sts.New(sess).AssumeRoleWithContext(ctx, &sts.AssumeRoleInput{
+			RoleArn:         aws.String(cfg.Role),
+			RoleSessionName: aws.String("cert-manager"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to assume role %q: %v", cfg.Role, err)
+		}
+
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentialsFromCreds(credentials.Value{
+			AccessKeyID:     *result.Credentials.AccessKeyId,
+			SecretAccessKey: *result.Credentials.SecretAccessKey,
+			SessionToken:    *result.Credentials.SessionToken,
+		})
+		sess, err = session.NewSessionWithOptions(sessionOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create aws session: %v", err)
+		}
+	}
+
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(userAgent))
+	return newAWSKMSWithClient(ctx, kms.New(sess), cfg.KeyID)
+}
+
+// newAWSKMSWithClient fetches the public key of the given key, which must be
+// usable for signing.
+func newAWSKMSWithClient(ctx context.Context, client kmsiface.KMSAPI, keyID string) (*awsKMS, error) {
+	out, err := client.GetPublicKeyWithContext(ctx, &kms.GetPublicKeyInput{
+		KeyId: aws.String(keyID),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of AWS KMS key %q: %v", keyID, err)
+	}
+	if usage := aws.StringValue(out.KeyUsage); usage != kms.KeyUsageTypeSignVerify {
+		return nil, fmt.Errorf("AWS KMS key %q has key usage %q, but %q is required", keyID, usage, kms.KeyUsageTypeSignVerify)
+	}
+	pub, err := x509.ParsePKIXPublicKey(out.PublicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key of AWS KMS key %q: %v", keyID, err)
+	}
+	return &awsKMS{ctx: ctx, client: client, keyID: keyID, public: pub}, nil
+}
+
+func (a *awsKMS) Public() crypto.PublicKey {
+	return a.public
+}
+
+func (a *awsKMS) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	algorithm, err := awsSigningAlgorithm(a.public, opts)
+	if err != nil {
+		return nil, err
+	}
+	out, err := a.client.SignWithContext(a.ctx, &kms.SignInput{
+		KeyId:            aws.String(a.keyID),
+		Message:          digest,
+		MessageType:      aws.String(kms.MessageTypeDigest),
+		SigningAlgorithm: aws.String(algorithm),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with AWS KMS key %q: %v", a.keyID, err)
+	}
+	// ECDSA signatures are returned DER encoded, as expected of a
+	// crypto.Signer.
+	return out.Signature, nil
+}
+
+// awsSigningAlgorithm returns the AWS KMS signing algorithm producing the
+// signature requested by opts with a key of the type of pub.
+func awsSigningAlgorithm(pub crypto.PublicKey, opts crypto.SignerOpts) (string, error) {
+	_, pss := opts.(*rsa.PSSOptions)
+	hash := opts.HashFunc()
+	switch pub.(type) {
+	case *rsa.PublicKey:
+		switch {
+		case hash == crypto.SHA256 && pss:
+			return kms.SigningAlgorithmSpecRsassaPssSha256, nil
+		case hash == crypto.SHA384 && pss:
+			return kms.SigningAlgorithmSpecRsassaPssSha384, nil
+		case hash == crypto.SHA512 && pss:
+			return kms.SigningAlgorithmSpecRsassaPssSha512, nil
+		case hash == crypto.SHA256:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256, nil
+		case hash == crypto.SHA384:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha384, nil
+		case hash == crypto.SHA512:
+			return kms.SigningAlgorithmSpecRsassaPkcs1V15Sha512, nil
+		}
+	case *ecdsa.PublicKey:
+		switch hash {
+		case crypto.SHA256:
+			return kms.SigningAlgorithmSpecEcdsaSha256, nil
+		case crypto.SHA384:
+			return kms.SigningAlgorithmSpecEcdsaSha384, nil
+		case crypto.SHA512:
+			return kms.SigningAlgorithmSpecEcdsaSha512, nil
+		}
+	default:
+		return "", fmt.Errorf("unsupported public key type %T", pub)
+	}
+	return "", fmt.Errorf("unsupported hash function %v for %T", hash, pub)
+}
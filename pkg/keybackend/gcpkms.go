@@ -0,0 +1,165 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keybackend
+
+import (
+	"context"
+	"crypto"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"golang.org/x/oauth2/google"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// gcpKMSClient is the subset of the Google Cloud KMS API used to sign with
+// asymmetric keys.
+type gcpKMSClient interface {
+	// GetPublicKey returns the PEM encoded public key and the algorithm of
+	// the key version with the given full resource name.
+	GetPublicKey(ctx context.Context, name string) (string, string, error)
+
+	// AsymmetricSign signs digest with the key version with the given full
+	// resource name.
+	AsymmetricSign(ctx context.Context, name string, digest *cloudkms.Digest) ([]byte, error)
+}
+
+// gcpKMS signs digests with an asymmetric Google Cloud KMS key version.
+type gcpKMS struct {
+	ctx            context.Context
+	client         gcpKMSClient
+	keyVersionName string
+	algorithm      string
+	public         crypto.PublicKey
+}
+
+func newGCPKMS(ctx context.Context, namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.GCPKMSPrivateKeyBackend, ambient bool, userAgent string) (crypto.Signer, error) {
+	opts := []option.ClientOption{option.WithUserAgent(userAgent)}
+
+	if cfg.ServiceAccountKeySecretRef == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no service account key configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the application default
+		// credentials being used, which includes GKE workload identity.
+	} else {
+		key, err := readSecretKey(secretsLister, namespace, *cfg.ServiceAccountKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := google.CredentialsFromJSON(ctx, key, cloudkms.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse service account key in secret '%s/%s': %v", namespace, cfg.ServiceAccountKeySecretRef.Name, err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	svc, err := cloudkms.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google Cloud KMS client: %v", err)
+	}
+
+	return newGCPKMSWithClient(ctx, &gcpKMSService{svc: svc}, cfg.KeyVersionName)
+}
+
+// newGCPKMSWithClient fetches the public key and algorithm of the given key
+// version.
+func newGCPKMSWithClient(ctx context.Context, client gcpKMSClient, keyVersionName string) (*gcpKMS, error) {
+	pubPEM, algorithm, err := client.GetPublicKey(ctx, keyVersionName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get public key of Google Cloud KMS key version %q: %v", keyVersionName, err)
+	}
+	block, _ := pem.Decode([]byte(pubPEM))
+	if block == nil {
+		return nil, fmt.Errorf("failed to decode public key of Google Cloud KMS key version %q", keyVersionName)
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key of Google Cloud KMS key version %q: %v", keyVersionName, err)
+	}
+	return &gcpKMS{ctx: ctx, client: client, keyVersionName: keyVersionName, algorithm: algorithm, public: pub}, nil
+}
+
+func (g *gcpKMS) Public() crypto.PublicKey {
+	return g.public
+}
+
+func (g *gcpKMS) Sign(_ io.Reader, digest []byte, opts crypto.SignerOpts) ([]byte, error) {
+	// The algorithm of a key version fixes the padding and hash function,
+	// e.g. RSA_SIGN_PSS_2048_SHA256 or EC_SIGN_P384_SHA384.
+	_, pss := opts.(*rsa.PSSOptions)
+	if pss != strings.HasPrefix(g.algorithm, "RSA_SIGN_PSS_") {
+		return nil, fmt.Errorf("Google Cloud KMS key version %q with algorithm %s cannot create the requested signature", g.keyVersionName, g.algorithm)
+	}
+
+	encoded := base64.StdEncoding.EncodeToString(digest)
+	var d cloudkms.Digest
+	switch opts.HashFunc() {
+	case crypto.SHA256:
+		d.Sha256 = encoded
+	case crypto.SHA384:
+		d.Sha384 = encoded
+	case crypto.SHA512:
+		d.Sha512 = encoded
+	default:
+		return nil, fmt.Errorf("unsupported hash function %v", opts.HashFunc())
+	}
+	if !strings.HasSuffix(g.algorithm, "_"+strings.ReplaceAll(opts.HashFunc().String(), "-", "")) {
+		return nil, fmt.Errorf("Google Cloud KMS key version %q with algorithm %s cannot sign a %v digest, the signature algorithm of the Certificate must match the key version", g.keyVersionName, g.algorithm, opts.HashFunc())
+	}
+
+	signature, err := g.client.AsymmetricSign(g.ctx, g.keyVersionName, &d)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign with Google Cloud KMS key version %q: %v", g.keyVersionName, err)
+	}
+	return signature, nil
+}
+
+type gcpKMSService struct {
+	svc *cloudkms.Service
+}
+
+func (g *gcpKMSService) GetPublicKey(ctx context.Context, name string) (string, string, error) {
+	resp, err := g.svc.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.GetPublicKey(name).Context(ctx).Do()
+	if err != nil {
+		return "", "", err
+	}
+	return resp.Pem, resp.Algorithm, nil
+}
+
+func (g *gcpKMSService) AsymmetricSign(ctx context.Context, name string, digest *cloudkms.Digest) ([]byte, error) {
+	resp, err := g.svc.Projects.Locations.KeyRings.CryptoKeys.CryptoKeyVersions.AsymmetricSign(name, &cloudkms.AsymmetricSignRequest{
+		Digest: digest,
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	if resp.Signature == "" {
+		return nil, errors.New("empty signature returned")
+	}
+	return base64.StdEncoding.DecodeString(resp.Signature)
+}
@@ -0,0 +1,173 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keybackend implements private keys which are held by an external
+// signer, such as a key management service, so that they are never stored
+// in Secrets. The Secrets of Certificates using such a key instead hold a
+// reference to the key, which also contains its public key.
+package keybackend
+
+import (
+	"context"
+	"crypto"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// PEMBlockType is the type of the PEM block referencing a private key held by
+// an external signer.
+const PEMBlockType = "EXTERNAL PRIVATE KEY"
+
+// Headers of the PEM block referencing a private key. The block's bytes are
+// the DER encoded public key in PKIX format.
+const (
+	headerBackend = "Backend"
+	headerRegion  = "Region"
+	headerKey     = "Key"
+)
+
+// Values of the Backend header.
+const (
+	backendAWSKMS = "aws-kms"
+	backendGCPKMS = "gcp-kms"
+)
+
+// Builder constructs a signer for the private key held by the backend
+// configured on a Certificate. Credentials are read from Secrets in
+// namespace; if none are configured, ambient credentials are used when
+// ambient is true. The public key is fetched when the signer is constructed,
+// and ctx is used for all requests made by the signer.
+type Builder func(ctx context.Context, namespace string, secretsLister corelisters.SecretLister,
+	cfg *cmapi.PrivateKeyBackend, ambient bool, userAgent string) (crypto.Signer, error)
+
+var _ Builder = New
+
+// New constructs a signer for the private key held by the given backend.
+func New(ctx context.Context, namespace string, secretsLister corelisters.SecretLister,
+	cfg *cmapi.PrivateKeyBackend, ambient bool, userAgent string) (crypto.Signer, error) {
+	switch {
+	case cfg.AWSKMS != nil:
+		return newAWSKMS(ctx, namespace, secretsLister, cfg.AWSKMS, ambient, userAgent)
+	case cfg.GCPKMS != nil:
+		return newGCPKMS(ctx, namespace, secretsLister, cfg.GCPKMS, ambient, userAgent)
+	}
+	return nil, errors.New("no private key backend configured")
+}
+
+// EncodeReference returns a PEM block of type PEMBlockType referencing the
+// key with the given public key held by the given backend.
+func EncodeReference(cfg *cmapi.PrivateKeyBackend, pub crypto.PublicKey) ([]byte, error) {
+	headers := referenceHeaders(cfg)
+	if headers == nil {
+		return nil, errors.New("no private key backend configured")
+	}
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %v", err)
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: PEMBlockType, Headers: headers, Bytes: der}), nil
+}
+
+// referenceHeaders returns the headers identifying the key held by the given
+// backend, or nil if no backend is configured.
+func referenceHeaders(cfg *cmapi.PrivateKeyBackend) map[string]string {
+	switch {
+	case cfg == nil:
+		return nil
+	case cfg.AWSKMS != nil:
+		return map[string]string{
+			headerBackend: backendAWSKMS,
+			headerRegion:  cfg.AWSKMS.Region,
+			headerKey:     cfg.AWSKMS.KeyID,
+		}
+	case cfg.GCPKMS != nil:
+		return map[string]string{
+			headerBackend: backendGCPKMS,
+			headerKey:     cfg.GCPKMS.KeyVersionName,
+		}
+	}
+	return nil
+}
+
+// IsReference returns true if data is a PEM block referencing a private key
+// held by an external signer.
+func IsReference(data []byte) bool {
+	block, _ := pem.Decode(data)
+	return block != nil && block.Type == PEMBlockType
+}
+
+// References returns true if data is a PEM block referencing the key held by
+// the given backend.
+func References(data []byte, cfg *cmapi.PrivateKeyBackend) bool {
+	block, err := decode(data)
+	if err != nil {
+		return false
+	}
+	want := referenceHeaders(cfg)
+	if want == nil || len(block.Headers) != len(want) {
+		return false
+	}
+	for k, v := range want {
+		if block.Headers[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// PublicKey returns the public key contained in a PEM block referencing a
+// private key held by an external signer.
+func PublicKey(data []byte) (crypto.PublicKey, error) {
+	block, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	pub, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode public key: %v", err)
+	}
+	return pub, nil
+}
+
+func decode(data []byte) (*pem.Block, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("failed to decode PEM block")
+	}
+	if block.Type != PEMBlockType {
+		return nil, fmt.Errorf("unexpected PEM block type %q", block.Type)
+	}
+	return block, nil
+}
+
+func readSecretKey(secretsLister corelisters.SecretLister, namespace string, ref cmmeta.SecretKeySelector) ([]byte, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return value, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keybackend
+
+import (
+	"context"
+	"crypto"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// fakeAWSKMS implements the AWS KMS API used by awsKMS with a local key.
+type fakeAWSKMS struct {
+	kmsiface.KMSAPI
+
+	key      crypto.Signer
+	keyUsage string
+}
+
+func (f *fakeAWSKMS) GetPublicKeyWithContext(_ aws.Context, _ *kms.GetPublicKeyInput, _ ...request.Option) (*kms.GetPublicKeyOutput, error) {
+	der, err := x509.MarshalPKIXPublicKey(f.key.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &kms.GetPublicKeyOutput{PublicKey: der, KeyUsage: aws.String(f.keyUsage)}, nil
+}
+
+func (f *fakeAWSKMS) SignWithContext(_ aws.Context, in *kms.SignInput, _ ...request.Option) (*kms.SignOutput, error) {
+	var opts crypto.SignerOpts
+	switch aws.StringValue(in.SigningAlgorithm) {
+	case kms.SigningAlgorithmSpecEcdsaSha256, kms.SigningAlgorithmSpecRsassaPkcs1V15Sha256:
+		opts = crypto.SHA256
+	case kms.SigningAlgorithmSpecEcdsaSha384:
+		opts = crypto.SHA384
+	case kms.SigningAlgorithmSpecRsassaPssSha512:
+		opts = &rsa.PSSOptions{SaltLength: rsa.PSSSaltLengthEqualsHash, Hash: crypto.SHA512}
+	default:
+		return nil, errors.New("unexpected signing algorithm")
+	}
+	sig, err := f.key.Sign(rand.Reader, in.Message, opts)
+	if err != nil {
+		return nil, err
+	}
+	return &kms.SignOutput{Signature: sig}, nil
+}
+
+// fakeGCPKMS implements gcpKMSClient with a local key.
+type fakeGCPKMS struct {
+	key       crypto.Signer
+	algorithm string
+}
+
+func (f *fakeGCPKMS) GetPublicKey(_ context.Context, _ string) (string, string, error) {
+	der, err := x509.MarshalPKIXPublicKey(f.key.Public())
+	if err != nil {
+		return "", "", err
+	}
+	return string(pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: der})), f.algorithm, nil
+}
+
+func (f *fakeGCPKMS) AsymmetricSign(_ context.Context, _ string, digest *cloudkms.Digest) ([]byte, error) {
+	if digest.Sha256 == "" {
+		return nil, errors.New("unexpected digest")
+	}
+	d, err := base64.StdEncoding.DecodeString(digest.Sha256)
+	if err != nil {
+		return nil, err
+	}
+	return f.key.Sign(rand.Reader, d, crypto.SHA256)
+}
+
+// signCSR signs a CSR with signer using the given signature algorithm and
+// verifies the resulting signature.
+func signCSR(t *testing.T, signer crypto.Signer, algorithm x509.SignatureAlgorithm) error {
+	der, err := x509.CreateCertificateRequest(rand.Reader, &x509.CertificateRequest{
+		Subject:            pkix.Name{CommonName: "example.com"},
+		SignatureAlgorithm: algorithm,
+	}, signer)
+	if err != nil {
+		return err
+	}
+	csr, err := x509.ParseCertificateRequest(der)
+	require.NoError(t, err)
+	return csr.CheckSignature()
+}
+
+func TestAWSKMS(t *testing.T) {
+	ecKey, err := pki.GenerateECPrivateKey(pki.ECCurve384)
+	require.NoError(t, err)
+	rsaKey, err := pki.GenerateRSAPrivateKey(2048)
+	require.NoError(t, err)
+
+	signer, err := newAWSKMSWithClient(context.Background(), &fakeAWSKMS{key: ecKey, keyUsage: kms.KeyUsageTypeSignVerify}, "my-key")
+	require.NoError(t, err)
+	assert.True(t, ecKey.PublicKey.Equal(signer.Public()))
+	assert.NoError(t, signCSR(t, signer, x509.ECDSAWithSHA384))
+
+	signer, err = newAWSKMSWithClient(context.Background(), &fakeAWSKMS{key: rsaKey, keyUsage: kms.KeyUsageTypeSignVerify}, "my-key")
+	require.NoError(t, err)
+	assert.NoError(t, signCSR(t, signer, x509.SHA256WithRSA))
+	assert.NoError(t, signCSR(t, signer, x509.SHA512WithRSAPSS))
+
+	_, err = newAWSKMSWithClient(context.Background(), &fakeAWSKMS{key: rsaKey, keyUsage: kms.KeyUsageTypeEncryptDecrypt}, "my-key")
+	assert.EqualError(t, err, `AWS KMS key "my-key" has key usage "ENCRYPT_DECRYPT", but "SIGN_VERIFY" is required`)
+}
+
+func TestGCPKMS(t *testing.T) {
+	ecKey, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+
+	signer, err := newGCPKMSWithClient(context.Background(), &fakeGCPKMS{key: ecKey, algorithm: "EC_SIGN_P256_SHA256"}, "my-key-version")
+	require.NoError(t, err)
+	assert.True(t, ecKey.PublicKey.Equal(signer.Public()))
+	assert.NoError(t, signCSR(t, signer, x509.ECDSAWithSHA256))
+
+	// The signature algorithm must match the algorithm of the key version.
+	assert.Error(t, signCSR(t, signer, x509.ECDSAWithSHA384))
+}
+
+func TestReference(t *testing.T) {
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+
+	awsBackend := &cmapi.PrivateKeyBackend{AWSKMS: &cmapi.AWSKMSPrivateKeyBackend{
+		Region: "eu-west-1",
+		KeyID:  "arn:aws:kms:eu-west-1:123456789012:key/1234abcd-12ab-34cd-56ef-1234567890ab",
+	}}
+	gcpBackend := &cmapi.PrivateKeyBackend{GCPKMS: &cmapi.GCPKMSPrivateKeyBackend{
+		KeyVersionName: "projects/p/locations/global/keyRings/r/cryptoKeys/k/cryptoKeyVersions/1",
+	}}
+
+	data, err := EncodeReference(awsBackend, pk.Public())
+	require.NoError(t, err)
+
+	assert.True(t, IsReference(data))
+	assert.True(t, References(data, awsBackend))
+	assert.False(t, References(data, gcpBackend))
+	otherRegion := awsBackend.DeepCopy()
+	otherRegion.AWSKMS.Region = "us-east-1"
+	assert.False(t, References(data, otherRegion))
+	assert.False(t, References(data, nil))
+
+	pub, err := PublicKey(data)
+	require.NoError(t, err)
+	assert.True(t, pk.PublicKey.Equal(pub))
+
+	pkData, err := pki.EncodePKCS8PrivateKey(pk)
+	require.NoError(t, err)
+	assert.False(t, IsReference(pkData))
+	assert.False(t, References(pkData, awsBackend))
+	_, err = PublicKey(pkData)
+	assert.Error(t, err)
+}
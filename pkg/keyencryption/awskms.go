@@ -0,0 +1,131 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyencryption
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/kms"
+	"github.com/aws/aws-sdk-go/service/kms/kmsiface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+type awsKMS struct {
+	client kmsiface.KMSAPI
+	keyID  string
+}
+
+func newAWSKMS(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.AWSKMSKeyEncryption, ambient bool, userAgent string) (keyWrapper, error) {
+	sessionOpts := session.Options{
+		Config: *aws.NewConfig().WithRegion(cfg.Region),
+	}
+
+	if cfg.Auth == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no credentials configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the default credential chain
+		// being used.
+	} else {
+		accessKeyID, err := readSecretKey(secretsLister, namespace, cfg.Auth.AccessKeyIDSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := readSecretKey(secretsLister, namespace, cfg.Auth.SecretAccessKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentials(
+			strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretAccessKey)), "")
+		// also disable 'ambient' region sources
+		sessionOpts.SharedConfigState = session.SharedConfigDisable
+	}
+
+	sess, err := session.NewSessionWithOptions(sessionOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %v", err)
+	}
+
+	if cfg.Role != "" {
+		result, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+			RoleArn:         aws.String(cfg.Role),
+			RoleSessionName: aws.String("cert-manager"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to assume role %q: %v", cfg.Role, err)
+		}
+
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentialsFromCreds(credentials.Value{
+			AccessKeyID:     *result.Credentials.AccessKeyId,
+			SecretAccessKey: *result.Credentials.SecretAccessKey,
+			SessionToken:    *result.Credentials.SessionToken,
+		})
+		sess, err = session.NewSessionWithOptions(sessionOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create aws session: %v", err)
+		}
+	}
+
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(userAgent))
+	return &awsKMS{client: kms.New(sess), keyID: cfg.KeyID}, nil
+}
+
+// newAmbientAWSKMS constructs a client for the given key using the default
+// credential chain, such as the credentials of an IAM role for the service
+// account of a workload.
+func newAmbientAWSKMS(region, keyID, userAgent string) (keyWrapper, error) {
+	sess, err := session.NewSessionWithOptions(session.Options{
+		Config: *aws.NewConfig().WithRegion(region),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %v", err)
+	}
+
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(userAgent))
+	return &awsKMS{client: kms.New(sess), keyID: keyID}, nil
+}
+
+func (a *awsKMS) wrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	out, err := a.client.EncryptWithContext(ctx, &kms.EncryptInput{
+		KeyId:     aws.String(a.keyID),
+		Plaintext: dataKey,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.CiphertextBlob, nil
+}
+
+func (a *awsKMS) unwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	out, err := a.client.DecryptWithContext(ctx, &kms.DecryptInput{
+		KeyId:          aws.String(a.keyID),
+		CiphertextBlob: wrapped,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return out.Plaintext, nil
+}
@@ -0,0 +1,40 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake contains a fake private key encrypter for use in tests.
+package fake
+
+import (
+	"context"
+
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+)
+
+// Encrypter is a fake private key encrypter. Encrypt returns the private key
+// unchanged unless EncryptFn is set.
+type Encrypter struct {
+	EncryptFn func(context.Context, []byte) ([]byte, error)
+}
+
+var _ keyencryption.Interface = &Encrypter{}
+
+// Encrypt implements keyencryption.Interface.
+func (e *Encrypter) Encrypt(ctx context.Context, pkPEM []byte) ([]byte, error) {
+	if e.EncryptFn != nil {
+		return e.EncryptFn(ctx, pkPEM)
+	}
+	return pkPEM, nil
+}
@@ -0,0 +1,119 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+
+	"golang.org/x/oauth2/google"
+	cloudkms "google.golang.org/api/cloudkms/v1"
+	"google.golang.org/api/option"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// gcpKMSClient is the subset of the Google Cloud KMS API used to encrypt
+// data keys.
+type gcpKMSClient interface {
+	// Encrypt encrypts plaintext with the key with the given full resource
+	// name.
+	Encrypt(ctx context.Context, name string, plaintext []byte) ([]byte, error)
+
+	// Decrypt decrypts ciphertext with the key with the given full resource
+	// name.
+	Decrypt(ctx context.Context, name string, ciphertext []byte) ([]byte, error)
+}
+
+type gcpKMS struct {
+	client  gcpKMSClient
+	keyName string
+}
+
+func newGCPKMS(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.GCPKMSKeyEncryption, ambient bool, userAgent string) (keyWrapper, error) {
+	ctx := context.Background()
+	opts := []option.ClientOption{option.WithUserAgent(userAgent)}
+
+	if cfg.ServiceAccountKeySecretRef == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no service account key configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the application default
+		// credentials being used, which includes GKE workload identity.
+	} else {
+		key, err := readSecretKey(secretsLister, namespace, *cfg.ServiceAccountKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := google.CredentialsFromJSON(ctx, key, cloudkms.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse service account key in secret '%s/%s': %v", namespace, cfg.ServiceAccountKeySecretRef.Name, err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	return newGCPKMSWithOptions(ctx, cfg.KeyName, opts...)
+}
+
+// newAmbientGCPKMS constructs a client for the given key using the
+// application default credentials, such as GKE workload identity.
+func newAmbientGCPKMS(keyName, userAgent string) (keyWrapper, error) {
+	return newGCPKMSWithOptions(context.Background(), keyName, option.WithUserAgent(userAgent))
+}
+
+func newGCPKMSWithOptions(ctx context.Context, keyName string, opts ...option.ClientOption) (keyWrapper, error) {
+	svc, err := cloudkms.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google Cloud KMS client: %v", err)
+	}
+
+	return &gcpKMS{client: &gcpKMSService{svc: svc}, keyName: keyName}, nil
+}
+
+func (g *gcpKMS) wrapKey(ctx context.Context, dataKey []byte) ([]byte, error) {
+	return g.client.Encrypt(ctx, g.keyName, dataKey)
+}
+
+func (g *gcpKMS) unwrapKey(ctx context.Context, wrapped []byte) ([]byte, error) {
+	return g.client.Decrypt(ctx, g.keyName, wrapped)
+}
+
+type gcpKMSService struct {
+	svc *cloudkms.Service
+}
+
+func (g *gcpKMSService) Encrypt(ctx context.Context, name string, plaintext []byte) ([]byte, error) {
+	resp, err := g.svc.Projects.Locations.KeyRings.CryptoKeys.Encrypt(name, &cloudkms.EncryptRequest{
+		Plaintext: base64.StdEncoding.EncodeToString(plaintext),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Ciphertext)
+}
+
+func (g *gcpKMSService) Decrypt(ctx context.Context, name string, ciphertext []byte) ([]byte, error) {
+	resp, err := g.svc.Projects.Locations.KeyRings.CryptoKeys.Decrypt(name, &cloudkms.DecryptRequest{
+		Ciphertext: base64.StdEncoding.EncodeToString(ciphertext),
+	}).Context(ctx).Do()
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(resp.Plaintext)
+}
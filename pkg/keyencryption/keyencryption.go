@@ -0,0 +1,323 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package keyencryption encrypts the private keys stored in Certificate
+// Secrets with a data key which is itself encrypted by a key management
+// service (envelope encryption), for clusters where encryption of Secrets in
+// etcd is not sufficient.
+package keyencryption
+
+import (
+	"context"
+	"crypto"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"fmt"
+
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// PEMBlockType is the type of the PEM block holding an encrypted private key.
+const PEMBlockType = "ENVELOPE ENCRYPTED PRIVATE KEY"
+
+// Headers of the PEM block holding an encrypted private key. The block's
+// bytes are the PEM encoded private key, encrypted with AES-256-GCM using
+// the data key, with the public key as additional authenticated data.
+const (
+	headerProvider  = "Provider"
+	headerRegion    = "Region"
+	headerMount     = "Mount"
+	headerKey       = "Key"
+	headerDataKey   = "Data-Key"
+	headerNonce     = "Nonce"
+	headerPublicKey = "Public-Key"
+)
+
+// Values of the Provider header.
+const (
+	providerAWSKMS       = "aws-kms"
+	providerGCPKMS       = "gcp-kms"
+	providerVaultTransit = "vault-transit"
+)
+
+// dataKeySize is the size of data keys in bytes, selecting AES-256.
+const dataKeySize = 32
+
+// Interface encrypts private keys using a single key management service key.
+type Interface interface {
+	// Encrypt encrypts the PEM encoded private key pkPEM with a new data
+	// key, and returns the result as a PEM block of type PEMBlockType.
+	Encrypt(ctx context.Context, pkPEM []byte) ([]byte, error)
+}
+
+// Builder constructs a client for the key management service configured on a
+// Certificate. Credentials are read from Secrets in namespace; if none are
+// configured, ambient credentials are used when ambient is true.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	cfg *cmapi.CertificatePrivateKeyEncryption, ambient bool, userAgent string) (Interface, error)
+
+var _ Builder = New
+
+// keyWrapper encrypts and decrypts data keys with a key held by a key
+// management service.
+type keyWrapper interface {
+	wrapKey(ctx context.Context, dataKey []byte) ([]byte, error)
+	unwrapKey(ctx context.Context, wrapped []byte) ([]byte, error)
+}
+
+type encrypter struct {
+	wrapper keyWrapper
+	headers map[string]string
+}
+
+// New constructs a client for the given key management service.
+func New(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.CertificatePrivateKeyEncryption, ambient bool, userAgent string) (Interface, error) {
+	var (
+		wrapper keyWrapper
+		err     error
+	)
+	switch {
+	case cfg.AWSKMS != nil:
+		wrapper, err = newAWSKMS(namespace, secretsLister, cfg.AWSKMS, ambient, userAgent)
+	case cfg.GCPKMS != nil:
+		wrapper, err = newGCPKMS(namespace, secretsLister, cfg.GCPKMS, ambient, userAgent)
+	case cfg.VaultTransit != nil:
+		wrapper, err = newVaultTransit(namespace, secretsLister, cfg.VaultTransit)
+	default:
+		return nil, errors.New("no key management service configured")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &encrypter{wrapper: wrapper, headers: keyHeaders(cfg)}, nil
+}
+
+// keyHeaders returns the headers identifying the key management service key
+// configured by cfg.
+func keyHeaders(cfg *cmapi.CertificatePrivateKeyEncryption) map[string]string {
+	switch {
+	case cfg.AWSKMS != nil:
+		return map[string]string{
+			headerProvider: providerAWSKMS,
+			headerRegion:   cfg.AWSKMS.Region,
+			headerKey:      cfg.AWSKMS.KeyID,
+		}
+	case cfg.GCPKMS != nil:
+		return map[string]string{
+			headerProvider: providerGCPKMS,
+			headerKey:      cfg.GCPKMS.KeyName,
+		}
+	case cfg.VaultTransit != nil:
+		return map[string]string{
+			headerProvider: providerVaultTransit,
+			headerMount:    cfg.VaultTransit.Mount,
+			headerKey:      cfg.VaultTransit.KeyName,
+		}
+	}
+	return nil
+}
+
+func (e *encrypter) Encrypt(ctx context.Context, pkPEM []byte) ([]byte, error) {
+	pk, err := pki.DecodePrivateKeyBytes(pkPEM)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode private key: %w", err)
+	}
+	publicKey, err := x509.MarshalPKIXPublicKey(pk.Public())
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode public key: %w", err)
+	}
+
+	dataKey := make([]byte, dataKeySize)
+	if _, err := rand.Read(dataKey); err != nil {
+		return nil, err
+	}
+	wrapped, err := e.wrapper.wrapKey(ctx, dataKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encrypt data key: %w", err)
+	}
+
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+
+	block := &pem.Block{
+		Type:    PEMBlockType,
+		Headers: map[string]string{},
+		Bytes:   aead.Seal(nil, nonce, pkPEM, publicKey),
+	}
+	for k, v := range e.headers {
+		block.Headers[k] = v
+	}
+	block.Headers[headerDataKey] = base64.StdEncoding.EncodeToString(wrapped)
+	block.Headers[headerNonce] = base64.StdEncoding.EncodeToString(nonce)
+	block.Headers[headerPublicKey] = base64.StdEncoding.EncodeToString(publicKey)
+
+	return pem.EncodeToMemory(block), nil
+}
+
+// IsEncrypted returns true if data is an encrypted private key.
+func IsEncrypted(data []byte) bool {
+	block, _ := pem.Decode(data)
+	return block != nil && block.Type == PEMBlockType
+}
+
+// EncryptedWith returns true if data is a private key encrypted using the key
+// management service key configured by cfg.
+func EncryptedWith(data []byte, cfg *cmapi.CertificatePrivateKeyEncryption) bool {
+	block, err := decode(data)
+	if err != nil {
+		return false
+	}
+	for k, v := range keyHeaders(cfg) {
+		if block.Headers[k] != v {
+			return false
+		}
+	}
+	return true
+}
+
+// PublicKey returns the public key of an encrypted private key, which can be
+// read without decrypting it.
+func PublicKey(data []byte) (crypto.PublicKey, error) {
+	block, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+	der, err := headerBytes(block, headerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+	return x509.ParsePKIXPublicKey(der)
+}
+
+// Decrypt decrypts an encrypted private key, returning the PEM encoded
+// private key. The key management service is accessed using ambient
+// credentials, or for Vault using the standard VAULT_* environment
+// variables.
+func Decrypt(ctx context.Context, data []byte, userAgent string) ([]byte, error) {
+	block, err := decode(data)
+	if err != nil {
+		return nil, err
+	}
+
+	var wrapper keyWrapper
+	switch block.Headers[headerProvider] {
+	case providerAWSKMS:
+		wrapper, err = newAmbientAWSKMS(block.Headers[headerRegion], block.Headers[headerKey], userAgent)
+	case providerGCPKMS:
+		wrapper, err = newAmbientGCPKMS(block.Headers[headerKey], userAgent)
+	case providerVaultTransit:
+		wrapper, err = newEnvironmentVaultTransit(block.Headers[headerMount], block.Headers[headerKey])
+	default:
+		return nil, fmt.Errorf("unknown key management service %q", block.Headers[headerProvider])
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return decrypt(ctx, wrapper, block)
+}
+
+func decrypt(ctx context.Context, wrapper keyWrapper, block *pem.Block) ([]byte, error) {
+	wrapped, err := headerBytes(block, headerDataKey)
+	if err != nil {
+		return nil, err
+	}
+	nonce, err := headerBytes(block, headerNonce)
+	if err != nil {
+		return nil, err
+	}
+	publicKey, err := headerBytes(block, headerPublicKey)
+	if err != nil {
+		return nil, err
+	}
+
+	dataKey, err := wrapper.unwrapKey(ctx, wrapped)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt data key: %w", err)
+	}
+	aead, err := newAEAD(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return nil, fmt.Errorf("invalid %s header", headerNonce)
+	}
+
+	pkPEM, err := aead.Open(nil, nonce, block.Bytes, publicKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt private key: %w", err)
+	}
+	return pkPEM, nil
+}
+
+func decode(data []byte) (*pem.Block, error) {
+	block, _ := pem.Decode(data)
+	if block == nil || block.Type != PEMBlockType {
+		return nil, fmt.Errorf("data is not a PEM block of type %q", PEMBlockType)
+	}
+	return block, nil
+}
+
+func headerBytes(block *pem.Block, name string) ([]byte, error) {
+	value, ok := block.Headers[name]
+	if !ok {
+		return nil, fmt.Errorf("missing %s header", name)
+	}
+	b, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return nil, fmt.Errorf("invalid %s header: %w", name, err)
+	}
+	return b, nil
+}
+
+func newAEAD(dataKey []byte) (cipher.AEAD, error) {
+	if len(dataKey) != dataKeySize {
+		return nil, fmt.Errorf("data key has size %d, expected %d", len(dataKey), dataKeySize)
+	}
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func readSecretKey(secretsLister corelisters.SecretLister, namespace string, ref cmmeta.SecretKeySelector) ([]byte, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return value, nil
+}
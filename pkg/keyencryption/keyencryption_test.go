@@ -0,0 +1,159 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/pem"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	vaultfake "github.com/cert-manager/cert-manager/internal/vault/fake"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+var testConfig = &cmapi.CertificatePrivateKeyEncryption{
+	VaultTransit: &cmapi.VaultTransitKeyEncryption{Mount: "transit", KeyName: "my-key"},
+}
+
+// newTestEncrypter returns an encrypter using a fake Vault transit secrets
+// engine, which "encrypts" data keys by base64 encoding them.
+func newTestEncrypter() *encrypter {
+	client := vaultfake.New()
+	client.TransitEncryptFn = func(mount, keyName string, plaintext []byte) (string, error) {
+		return "vault:v1:" + base64.StdEncoding.EncodeToString(plaintext), nil
+	}
+	client.TransitDecryptFn = func(mount, keyName, ciphertext string) ([]byte, error) {
+		if !strings.HasPrefix(ciphertext, "vault:v1:") {
+			return nil, errors.New("invalid ciphertext")
+		}
+		return base64.StdEncoding.DecodeString(strings.TrimPrefix(ciphertext, "vault:v1:"))
+	}
+
+	return &encrypter{
+		wrapper: &vaultTransit{client: client, mount: "transit", keyName: "my-key"},
+		headers: keyHeaders(testConfig),
+	}
+}
+
+func mustEncodedPrivateKey(t *testing.T) []byte {
+	pk, err := pki.GenerateECPrivateKey(pki.ECCurve256)
+	require.NoError(t, err)
+	pkPEM, err := pki.EncodePKCS8PrivateKey(pk)
+	require.NoError(t, err)
+	return pkPEM
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEncrypter()
+	pkPEM := mustEncodedPrivateKey(t)
+
+	data, err := e.Encrypt(ctx, pkPEM)
+	require.NoError(t, err)
+
+	assert.True(t, IsEncrypted(data))
+	assert.False(t, IsEncrypted(pkPEM))
+	assert.NotContains(t, string(data), string(pkPEM))
+
+	block, err := decode(data)
+	require.NoError(t, err)
+	assert.Equal(t, "vault-transit", block.Headers["Provider"])
+	assert.Equal(t, "transit", block.Headers["Mount"])
+	assert.Equal(t, "my-key", block.Headers["Key"])
+
+	pk, err := pki.DecodePrivateKeyBytes(pkPEM)
+	require.NoError(t, err)
+	pub, err := PublicKey(data)
+	require.NoError(t, err)
+	equal, err := pki.PublicKeysEqual(pk.Public(), pub)
+	require.NoError(t, err)
+	assert.True(t, equal, "expected public key of encrypted private key to match")
+
+	decrypted, err := decrypt(ctx, e.wrapper, block)
+	require.NoError(t, err)
+	assert.Equal(t, pkPEM, decrypted)
+}
+
+func TestDecryptRejectsTampering(t *testing.T) {
+	ctx := context.Background()
+	e := newTestEncrypter()
+
+	data, err := e.Encrypt(ctx, mustEncodedPrivateKey(t))
+	require.NoError(t, err)
+	block, err := decode(data)
+	require.NoError(t, err)
+
+	// Replacing the public key must be detected, as it is checked by
+	// cert-manager in place of the private key.
+	otherData, err := e.Encrypt(ctx, mustEncodedPrivateKey(t))
+	require.NoError(t, err)
+	otherBlock, err := decode(otherData)
+	require.NoError(t, err)
+	block.Headers["Public-Key"] = otherBlock.Headers["Public-Key"]
+
+	_, err = decrypt(ctx, e.wrapper, block)
+	assert.Error(t, err)
+
+	_, err = decrypt(ctx, e.wrapper, &pem.Block{Type: PEMBlockType, Headers: map[string]string{}})
+	assert.Error(t, err)
+}
+
+func TestEncryptedWith(t *testing.T) {
+	data, err := newTestEncrypter().Encrypt(context.Background(), mustEncodedPrivateKey(t))
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		data []byte
+		cfg  *cmapi.CertificatePrivateKeyEncryption
+		want bool
+	}{
+		"same key": {
+			data: data,
+			cfg:  testConfig,
+			want: true,
+		},
+		"different Vault key": {
+			data: data,
+			cfg: &cmapi.CertificatePrivateKeyEncryption{
+				VaultTransit: &cmapi.VaultTransitKeyEncryption{Mount: "transit", KeyName: "other-key"},
+			},
+		},
+		"different key management service": {
+			data: data,
+			cfg: &cmapi.CertificatePrivateKeyEncryption{
+				GCPKMS: &cmapi.GCPKMSKeyEncryption{KeyName: "my-key"},
+			},
+		},
+		"unencrypted private key": {
+			data: mustEncodedPrivateKey(t),
+			cfg:  testConfig,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.want, EncryptedWith(test.data, test.cfg))
+		})
+	}
+}
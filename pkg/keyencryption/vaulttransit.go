@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package keyencryption
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"path"
+
+	vault "github.com/hashicorp/vault/api"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	internalvault "github.com/cert-manager/cert-manager/internal/vault"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// transitClient is the subset of the Vault client used to encrypt data keys.
+type transitClient interface {
+	TransitEncrypt(mount, keyName string, plaintext []byte) (string, error)
+	TransitDecrypt(mount, keyName, ciphertext string) ([]byte, error)
+}
+
+type vaultTransit struct {
+	client  transitClient
+	mount   string
+	keyName string
+}
+
+func newVaultTransit(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.VaultTransitKeyEncryption) (keyWrapper, error) {
+	// The Vault client authenticates in the same way as a Vault issuer, so
+	// the key is presented to it as an issuer in the Certificate's
+	// namespace.
+	issuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{
+				Vault: &cmapi.VaultIssuer{
+					Auth:      cfg.Auth,
+					Server:    cfg.Server,
+					Namespace: cfg.Namespace,
+					CABundle:  cfg.CABundle,
+				},
+			},
+		},
+	}
+
+	client, err := internalvault.New(namespace, secretsLister, issuer, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vaultTransit{client: client, mount: cfg.Mount, keyName: cfg.KeyName}, nil
+}
+
+// newEnvironmentVaultTransit constructs a client for the given key which is
+// configured by the standard VAULT_ADDR, VAULT_TOKEN, VAULT_NAMESPACE and
+// VAULT_CACERT environment variables.
+func newEnvironmentVaultTransit(mount, keyName string) (keyWrapper, error) {
+	cfg := vault.DefaultConfig()
+	if cfg.Error != nil {
+		return nil, fmt.Errorf("error reading Vault configuration: %v", cfg.Error)
+	}
+	client, err := vault.NewClient(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("error initializing Vault client: %v", err)
+	}
+
+	return &vaultTransit{client: &environmentTransitClient{client: client}, mount: mount, keyName: keyName}, nil
+}
+
+func (v *vaultTransit) wrapKey(_ context.Context, dataKey []byte) ([]byte, error) {
+	ciphertext, err := v.client.TransitEncrypt(v.mount, v.keyName, dataKey)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(ciphertext), nil
+}
+
+func (v *vaultTransit) unwrapKey(_ context.Context, wrapped []byte) ([]byte, error) {
+	return v.client.TransitDecrypt(v.mount, v.keyName, string(wrapped))
+}
+
+// environmentTransitClient uses a Vault client which is configured from the
+// environment rather than from an issuer.
+type environmentTransitClient struct {
+	client *vault.Client
+}
+
+func (e *environmentTransitClient) TransitEncrypt(mount, keyName string, plaintext []byte) (string, error) {
+	secret, err := e.client.Logical().Write(path.Join(mount, "encrypt", keyName), map[string]interface{}{
+		"plaintext": base64.StdEncoding.EncodeToString(plaintext),
+	})
+	if err != nil {
+		return "", err
+	}
+	if secret == nil {
+		return "", errors.New("empty response from vault")
+	}
+	ciphertext, _ := secret.Data["ciphertext"].(string)
+	return ciphertext, nil
+}
+
+func (e *environmentTransitClient) TransitDecrypt(mount, keyName, ciphertext string) ([]byte, error) {
+	secret, err := e.client.Logical().Write(path.Join(mount, "decrypt", keyName), map[string]interface{}{
+		"ciphertext": ciphertext,
+	})
+	if err != nil {
+		return nil, err
+	}
+	if secret == nil {
+		return nil, errors.New("empty response from vault")
+	}
+	plaintext, _ := secret.Data["plaintext"].(string)
+	return base64.StdEncoding.DecodeString(plaintext)
+}
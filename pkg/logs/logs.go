@@ -21,6 +21,7 @@ import (
 	"flag"
 	"fmt"
 	"log"
+	"strconv"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -80,6 +81,13 @@ func FlushLogs() {
 	klog.Flush()
 }
 
+// SetVerbosity changes the verbosity of logs while the process is running,
+// as if the -v flag had been set to the given level.
+func SetVerbosity(level int32) error {
+	var l klog.Level
+	return l.Set(strconv.Itoa(int(level)))
+}
+
 const (
 	ResourceNameKey      = "resource_name"
 	ResourceNamespaceKey = "resource_namespace"
@@ -40,6 +40,8 @@ func (m *Metrics) UpdateCertificate(ctx context.Context, crt *cmapi.Certificate)
 	m.updateCertificateStatus(key, crt)
 	m.updateCertificateExpiry(ctx, key, crt)
 	m.updateCertificateRenewalTime(crt)
+	m.updateCertificateSecretDriftStatus(crt)
+	m.updateCertificateRevokedStatus(crt)
 }
 
 // updateCertificateExpiry updates the expiry time of a certificate
@@ -98,6 +100,38 @@ func (m *Metrics) updateCertificateReadyStatus(crt *cmapi.Certificate, current c
 	}
 }
 
+// updateCertificateSecretDriftStatus will update the metric reporting whether
+// the Certificate's Secret has drifted, based on its SecretDrift condition
+func (m *Metrics) updateCertificateSecretDriftStatus(crt *cmapi.Certificate) {
+	value := 0.0
+
+	for _, c := range crt.Status.Conditions {
+		if c.Type == cmapi.CertificateConditionSecretDrift && c.Status == cmmeta.ConditionTrue {
+			value = 1.0
+		}
+	}
+
+	m.certificateSecretDriftStatus.With(prometheus.Labels{
+		"name":      crt.Name,
+		"namespace": crt.Namespace}).Set(value)
+}
+
+// updateCertificateRevokedStatus will update the metric reporting whether the
+// Certificate's certificate has been revoked, based on its Revoked condition
+func (m *Metrics) updateCertificateRevokedStatus(crt *cmapi.Certificate) {
+	value := 0.0
+
+	for _, c := range crt.Status.Conditions {
+		if c.Type == cmapi.CertificateConditionRevoked && c.Status == cmmeta.ConditionTrue {
+			value = 1.0
+		}
+	}
+
+	m.certificateRevokedStatus.With(prometheus.Labels{
+		"name":      crt.Name,
+		"namespace": crt.Namespace}).Set(value)
+}
+
 // RemoveCertificate will delete the Certificate metrics from continuing to be
 // exposed.
 func (m *Metrics) RemoveCertificate(key string) {
@@ -109,6 +143,8 @@ func (m *Metrics) RemoveCertificate(key string) {
 
 	m.certificateExpiryTimeSeconds.DeleteLabelValues(name, namespace)
 	m.certificateRenewalTimeSeconds.DeleteLabelValues(name, namespace)
+	m.certificateSecretDriftStatus.DeleteLabelValues(name, namespace)
+	m.certificateRevokedStatus.DeleteLabelValues(name, namespace)
 	for _, condition := range readyConditionStatuses {
 		m.certificateReadyStatus.DeleteLabelValues(name, namespace, string(condition))
 	}
@@ -42,6 +42,16 @@ const renewalTimeMetadata = `
 	# TYPE certmanager_certificate_renewal_timestamp_seconds gauge
 `
 
+const secretDriftMetadata = `
+	# HELP certmanager_certificate_secret_drift_status Whether the certificate's Secret has diverged from the certificate in a way that cannot be explained by an issuance.
+	# TYPE certmanager_certificate_secret_drift_status gauge
+`
+
+const revokedMetadata = `
+	# HELP certmanager_certificate_revoked_status Whether the certificate stored in the certificate's Secret has been revoked, as reported by OCSP or a CRL.
+	# TYPE certmanager_certificate_revoked_status gauge
+`
+
 const readyMetadata = `
   # HELP certmanager_certificate_ready_status The ready status of the certificate.
   # TYPE certmanager_certificate_ready_status gauge
@@ -196,6 +206,78 @@ func TestCertificateMetrics(t *testing.T) {
 	}
 }
 
+func TestCertificateSecretDriftMetric(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	m.UpdateCertificate(context.TODO(), gen.Certificate("crt1"))
+	m.UpdateCertificate(context.TODO(), gen.Certificate("crt2",
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionSecretDrift,
+			Status: cmmeta.ConditionTrue,
+		}),
+	))
+	m.UpdateCertificate(context.TODO(), gen.Certificate("crt3",
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionSecretDrift,
+			Status: cmmeta.ConditionFalse,
+		}),
+	))
+
+	if err := testutil.CollectAndCompare(m.certificateSecretDriftStatus,
+		strings.NewReader(secretDriftMetadata+`
+        certmanager_certificate_secret_drift_status{name="crt1",namespace="default-unit-test-ns"} 0
+        certmanager_certificate_secret_drift_status{name="crt2",namespace="default-unit-test-ns"} 1
+        certmanager_certificate_secret_drift_status{name="crt3",namespace="default-unit-test-ns"} 0
+`),
+		"certmanager_certificate_secret_drift_status",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveCertificate("default-unit-test-ns/crt2")
+	if err := testutil.CollectAndCompare(m.certificateSecretDriftStatus,
+		strings.NewReader(secretDriftMetadata+`
+        certmanager_certificate_secret_drift_status{name="crt1",namespace="default-unit-test-ns"} 0
+        certmanager_certificate_secret_drift_status{name="crt3",namespace="default-unit-test-ns"} 0
+`),
+		"certmanager_certificate_secret_drift_status",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
+func TestCertificateRevokedMetric(t *testing.T) {
+	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
+
+	m.UpdateCertificate(context.TODO(), gen.Certificate("crt1"))
+	m.UpdateCertificate(context.TODO(), gen.Certificate("crt2",
+		gen.SetCertificateStatusCondition(cmapi.CertificateCondition{
+			Type:   cmapi.CertificateConditionRevoked,
+			Status: cmmeta.ConditionTrue,
+		}),
+	))
+
+	if err := testutil.CollectAndCompare(m.certificateRevokedStatus,
+		strings.NewReader(revokedMetadata+`
+        certmanager_certificate_revoked_status{name="crt1",namespace="default-unit-test-ns"} 0
+        certmanager_certificate_revoked_status{name="crt2",namespace="default-unit-test-ns"} 1
+`),
+		"certmanager_certificate_revoked_status",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+
+	m.RemoveCertificate("default-unit-test-ns/crt2")
+	if err := testutil.CollectAndCompare(m.certificateRevokedStatus,
+		strings.NewReader(revokedMetadata+`
+        certmanager_certificate_revoked_status{name="crt1",namespace="default-unit-test-ns"} 0
+`),
+		"certmanager_certificate_revoked_status",
+	); err != nil {
+		t.Errorf("unexpected collecting result:\n%s", err)
+	}
+}
+
 func TestCertificateCache(t *testing.T) {
 	m := New(logtesting.NewTestLogger(t), clock.RealClock{})
 
@@ -0,0 +1,36 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// SetIssuerCircuitBreakerState sets the current state of the circuit breaker
+// for the issuer identified by the given kind, namespace and name.
+func (m *Metrics) SetIssuerCircuitBreakerState(state float64, labels ...string) {
+	m.issuerCircuitBreakerState.WithLabelValues(labels...).Set(state)
+}
+
+// IncrementIssuerCircuitBreakerRejectedCount increases the count of requests
+// rejected by the circuit breaker for an issuer.
+func (m *Metrics) IncrementIssuerCircuitBreakerRejectedCount(labels ...string) {
+	m.issuerCircuitBreakerRejectedCount.WithLabelValues(labels...).Inc()
+}
+
+// RemoveIssuerCircuitBreaker removes the circuit breaker metrics for the
+// issuer identified by the given kind, namespace and name.
+func (m *Metrics) RemoveIssuerCircuitBreaker(labels ...string) {
+	m.issuerCircuitBreakerState.DeleteLabelValues(labels...)
+	m.issuerCircuitBreakerRejectedCount.DeleteLabelValues(labels...)
+}
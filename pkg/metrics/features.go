@@ -0,0 +1,66 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
+)
+
+var featureEnabledDesc = prometheus.NewDesc(
+	prometheus.BuildFQName(namespace, "", "feature_enabled"),
+	"Whether a feature gate is enabled (1) or disabled (0).",
+	[]string{"name", "stage"},
+	nil,
+)
+
+// featureGateCollector exposes the state of all feature gates. The state is
+// read when the metrics are collected so that feature gates toggled at
+// runtime are reflected without needing to update the metric.
+type featureGateCollector struct{}
+
+func (featureGateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- featureEnabledDesc
+}
+
+func (featureGateCollector) Collect(ch chan<- prometheus.Metric) {
+	for _, s := range utilfeature.Statuses() {
+		var v float64
+		if s.Enabled {
+			v = 1
+		}
+		ch <- prometheus.MustNewConstMetric(featureEnabledDesc, prometheus.GaugeValue, v, s.Name, s.PreRelease)
+	}
+}
+
+// handleLivez reports that the process is alive. If the verbose query
+// parameter is set, the state of all feature gates is included in the
+// response.
+func handleLivez(w http.ResponseWriter, req *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	w.WriteHeader(http.StatusOK)
+
+	if _, verbose := req.URL.Query()["verbose"]; verbose {
+		_ = utilfeature.WriteStatuses(w)
+		_, _ = w.Write([]byte("livez check passed\n"))
+		return
+	}
+	_, _ = w.Write([]byte("ok"))
+}
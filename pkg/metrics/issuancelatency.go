@@ -0,0 +1,37 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+import "time"
+
+// ObserveIssuerIssuanceLatency records the time taken by the issuer
+// identified by the given kind, namespace and name to issue a
+// CertificateRequest.
+func (m *Metrics) ObserveIssuerIssuanceLatency(latency time.Duration, labels ...string) {
+	m.issuerIssuanceLatencySeconds.WithLabelValues(labels...).Observe(latency.Seconds())
+}
+
+// SetIssuerIssuanceLatencyBudgetExceeded sets whether the issuance latency of
+// the issuer identified by the given kind, namespace and name exceeds its
+// configured budget.
+func (m *Metrics) SetIssuerIssuanceLatencyBudgetExceeded(exceeded bool, labels ...string) {
+	value := 0.0
+	if exceeded {
+		value = 1.0
+	}
+	m.issuerIssuanceLatencyBudgetStatus.WithLabelValues(labels...).Set(value)
+}
@@ -0,0 +1,33 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package metrics
+
+// SetIssuerHealthy sets whether the most recent health check of the issuer
+// identified by the given kind, namespace and name succeeded.
+func (m *Metrics) SetIssuerHealthy(healthy bool, labels ...string) {
+	value := 0.0
+	if healthy {
+		value = 1.0
+	}
+	m.issuerHealthStatus.WithLabelValues(labels...).Set(value)
+}
+
+// RemoveIssuerHealth removes the health metric for the issuer identified by
+// the given kind, namespace and name.
+func (m *Metrics) RemoveIssuerHealth(labels ...string) {
+	m.issuerHealthStatus.DeleteLabelValues(labels...)
+}
@@ -22,7 +22,10 @@ limitations under the License.
 // acme_client_request_count{"scheme", "host", "path", "method", "status"}
 // acme_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
 // venafi_client_request_duration_seconds{"scheme", "host", "path", "method", "status"}
+// issuer_circuit_breaker_state{"kind", "namespace", "name"}
+// issuer_circuit_breaker_rejected_requests_count{"kind", "namespace", "name", "reason"}
 // controller_sync_call_count{"controller"}
+// feature_enabled{"name", "stage"}
 package metrics
 
 import (
@@ -57,9 +60,16 @@ type Metrics struct {
 	certificateExpiryTimeSeconds       *prometheus.GaugeVec
 	certificateRenewalTimeSeconds      *prometheus.GaugeVec
 	certificateReadyStatus             *prometheus.GaugeVec
+	certificateSecretDriftStatus       *prometheus.GaugeVec
+	certificateRevokedStatus           *prometheus.GaugeVec
 	acmeClientRequestDurationSeconds   *prometheus.SummaryVec
 	acmeClientRequestCount             *prometheus.CounterVec
 	venafiClientRequestDurationSeconds *prometheus.SummaryVec
+	issuerCircuitBreakerState          *prometheus.GaugeVec
+	issuerCircuitBreakerRejectedCount  *prometheus.CounterVec
+	issuerIssuanceLatencySeconds       *prometheus.HistogramVec
+	issuerIssuanceLatencyBudgetStatus  *prometheus.GaugeVec
+	issuerHealthStatus                 *prometheus.GaugeVec
 	controllerSyncCallCount            *prometheus.CounterVec
 	controllerSyncErrorCount           *prometheus.CounterVec
 }
@@ -127,6 +137,24 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"name", "namespace", "condition"},
 		)
 
+		certificateSecretDriftStatus = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "certificate_secret_drift_status",
+				Help:      "Whether the certificate's Secret has diverged from the certificate in a way that cannot be explained by an issuance.",
+			},
+			[]string{"name", "namespace"},
+		)
+
+		certificateRevokedStatus = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "certificate_revoked_status",
+				Help:      "Whether the certificate stored in the certificate's Secret has been revoked, as reported by OCSP or a CRL.",
+			},
+			[]string{"name", "namespace"},
+		)
+
 		// acmeClientRequestCount is a Prometheus summary to collect the number of
 		// requests made to each endpoint with the ACME client.
 		acmeClientRequestCount = prometheus.NewCounterVec(
@@ -167,6 +195,57 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 			[]string{"api_call"},
 		)
 
+		// issuerCircuitBreakerState is a Prometheus gauge exposing the state
+		// of the circuit breaker wrapping each issuer's upstream API client.
+		issuerCircuitBreakerState = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_circuit_breaker_state",
+				Help:      "The state of the circuit breaker for an issuer's upstream API client (0 = closed, 1 = half-open, 2 = open).",
+			},
+			[]string{"kind", "namespace", "name"},
+		)
+
+		issuerCircuitBreakerRejectedCount = prometheus.NewCounterVec(
+			prometheus.CounterOpts{
+				Namespace: namespace,
+				Name:      "issuer_circuit_breaker_rejected_requests_count",
+				Help:      "The number of requests to an issuer's upstream API that were rejected without being sent.",
+			},
+			[]string{"kind", "namespace", "name", "reason"},
+		)
+
+		// issuerIssuanceLatencySeconds is a Prometheus histogram of the time
+		// taken between a CertificateRequest being created and it being
+		// issued, for each issuer.
+		issuerIssuanceLatencySeconds = prometheus.NewHistogramVec(
+			prometheus.HistogramOpts{
+				Namespace: namespace,
+				Name:      "issuer_issuance_latency_seconds",
+				Help:      "The time taken between a CertificateRequest being created and it being issued.",
+				Buckets:   []float64{1, 5, 15, 30, 60, 120, 300, 600, 1800, 3600},
+			},
+			[]string{"kind", "namespace", "name"},
+		)
+
+		issuerIssuanceLatencyBudgetStatus = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_issuance_latency_budget_exceeded",
+				Help:      "Whether the issuance latency of an issuer exceeds its configured budget (1 = exceeded, 0 = within budget).",
+			},
+			[]string{"kind", "namespace", "name"},
+		)
+
+		issuerHealthStatus = prometheus.NewGaugeVec(
+			prometheus.GaugeOpts{
+				Namespace: namespace,
+				Name:      "issuer_healthy",
+				Help:      "Whether the most recent health check of an issuer's connectivity and credentials succeeded (1 = healthy, 0 = unhealthy).",
+			},
+			[]string{"kind", "namespace", "name"},
+		)
+
 		controllerSyncCallCount = prometheus.NewCounterVec(
 			prometheus.CounterOpts{
 				Namespace: namespace,
@@ -196,9 +275,16 @@ func New(log logr.Logger, c clock.Clock) *Metrics {
 		certificateExpiryTimeSeconds:       certificateExpiryTimeSeconds,
 		certificateRenewalTimeSeconds:      certificateRenewalTimeSeconds,
 		certificateReadyStatus:             certificateReadyStatus,
+		certificateSecretDriftStatus:       certificateSecretDriftStatus,
+		certificateRevokedStatus:           certificateRevokedStatus,
 		acmeClientRequestCount:             acmeClientRequestCount,
 		acmeClientRequestDurationSeconds:   acmeClientRequestDurationSeconds,
 		venafiClientRequestDurationSeconds: venafiClientRequestDurationSeconds,
+		issuerCircuitBreakerState:          issuerCircuitBreakerState,
+		issuerCircuitBreakerRejectedCount:  issuerCircuitBreakerRejectedCount,
+		issuerIssuanceLatencySeconds:       issuerIssuanceLatencySeconds,
+		issuerIssuanceLatencyBudgetStatus:  issuerIssuanceLatencyBudgetStatus,
+		issuerHealthStatus:                 issuerHealthStatus,
 		controllerSyncCallCount:            controllerSyncCallCount,
 		controllerSyncErrorCount:           controllerSyncErrorCount,
 	}
@@ -213,14 +299,23 @@ func (m *Metrics) NewServer(ln net.Listener) *http.Server {
 	m.registry.MustRegister(m.certificateExpiryTimeSeconds)
 	m.registry.MustRegister(m.certificateRenewalTimeSeconds)
 	m.registry.MustRegister(m.certificateReadyStatus)
+	m.registry.MustRegister(m.certificateSecretDriftStatus)
+	m.registry.MustRegister(m.certificateRevokedStatus)
 	m.registry.MustRegister(m.acmeClientRequestDurationSeconds)
 	m.registry.MustRegister(m.venafiClientRequestDurationSeconds)
 	m.registry.MustRegister(m.acmeClientRequestCount)
+	m.registry.MustRegister(m.issuerCircuitBreakerState)
+	m.registry.MustRegister(m.issuerCircuitBreakerRejectedCount)
+	m.registry.MustRegister(m.issuerIssuanceLatencySeconds)
+	m.registry.MustRegister(m.issuerIssuanceLatencyBudgetStatus)
+	m.registry.MustRegister(m.issuerHealthStatus)
 	m.registry.MustRegister(m.controllerSyncCallCount)
 	m.registry.MustRegister(m.controllerSyncErrorCount)
+	m.registry.MustRegister(featureGateCollector{})
 
 	mux := http.NewServeMux()
 	mux.Handle("/metrics", promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{}))
+	mux.HandleFunc("/livez", handleLivez)
 
 	server := &http.Server{
 		Addr:           ln.Addr().String(),
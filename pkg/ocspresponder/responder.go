@@ -0,0 +1,284 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ocspresponder implements an OCSP responder for CA issuers. The
+// status of a certificate is looked up from the CertificateRequest it was
+// issued for: certificates whose CertificateRequest has the revoke annotation
+// are reported as revoked, other certificates issued by the CA as good, and
+// certificates with no CertificateRequest as unknown.
+package ocspresponder
+
+import (
+	"bytes"
+	"context"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"golang.org/x/crypto/ocsp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/utils/clock"
+
+	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	controllerpkg "github.com/cert-manager/cert-manager/pkg/controller"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	"github.com/cert-manager/cert-manager/pkg/util/kube"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+const (
+	// SerialNumberIndex is the name of the CertificateRequest index keyed by
+	// the serial number of the issued certificate.
+	SerialNumberIndex = "serialNumber"
+
+	// defaultResponseValidity is the validity of responses for issuers which
+	// do not set one.
+	defaultResponseValidity = time.Hour
+
+	// maxRequestSize is the maximum size of an OCSP request. Requests are
+	// usually around a hundred bytes.
+	maxRequestSize = 4096
+)
+
+// IndexBySerialNumber indexes CertificateRequests by the hex encoded serial
+// number of the certificate issued for them.
+func IndexBySerialNumber(obj interface{}) ([]string, error) {
+	cr, ok := obj.(*cmapi.CertificateRequest)
+	if !ok || len(cr.Status.Certificate) == 0 {
+		return nil, nil
+	}
+	cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+	if err != nil {
+		return nil, nil
+	}
+	return []string{cert.SerialNumber.Text(16)}, nil
+}
+
+// Responder answers OCSP requests for the certificates issued by CA issuers
+// with OCSP configured. Requests for an Issuer are served at
+// /issuers/<namespace>/<name>, and for a ClusterIssuer at
+// /clusterissuers/<name>, using either the POST or GET method of RFC 6960
+// appendix A.
+type Responder struct {
+	IssuerLister cmlisters.IssuerLister
+	// ClusterIssuerLister is nil if the responder is scoped to a single
+	// namespace.
+	ClusterIssuerLister cmlisters.ClusterIssuerLister
+	// CertificateRequests must have the SerialNumberIndex.
+	CertificateRequests cache.Indexer
+	SecretLister        corelisters.SecretLister
+
+	// IssuerOptions is used to find the namespace the Secrets of
+	// ClusterIssuers are stored in.
+	IssuerOptions controllerpkg.IssuerOptions
+
+	Clock clock.Clock
+}
+
+var _ http.Handler = &Responder{}
+
+func (r *Responder) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	ctx := req.Context()
+	log := logf.FromContext(ctx).WithValues("path", req.URL.Path)
+
+	path := strings.TrimPrefix(req.URL.Path, "/")
+	var (
+		iss     cmapi.GenericIssuer
+		encoded string
+		err     error
+	)
+	switch {
+	case strings.HasPrefix(path, "issuers/"):
+		parts := strings.SplitN(path, "/", 4)
+		if len(parts) < 3 {
+			http.NotFound(w, req)
+			return
+		}
+		iss, err = r.IssuerLister.Issuers(parts[1]).Get(parts[2])
+		if len(parts) == 4 {
+			encoded = parts[3]
+		}
+	case strings.HasPrefix(path, "clusterissuers/") && r.ClusterIssuerLister != nil:
+		parts := strings.SplitN(path, "/", 3)
+		iss, err = r.ClusterIssuerLister.Get(parts[1])
+		if len(parts) == 3 {
+			encoded = parts[2]
+		}
+	default:
+		http.NotFound(w, req)
+		return
+	}
+	if err != nil && !apierrors.IsNotFound(err) {
+		log.Error(err, "failed to get issuer")
+		writeResponse(w, ocsp.InternalErrorErrorResponse)
+		return
+	}
+	if err != nil || iss.GetSpec().CA == nil || iss.GetSpec().CA.OCSP == nil {
+		writeResponse(w, ocsp.UnauthorizedErrorResponse)
+		return
+	}
+
+	var der []byte
+	switch req.Method {
+	case http.MethodGet:
+		// The request is base64 encoded and then URL encoded, and the
+		// path has already been unescaped.
+		der, err = base64.StdEncoding.DecodeString(encoded)
+	case http.MethodPost:
+		der, err = io.ReadAll(io.LimitReader(req.Body, maxRequestSize))
+	default:
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	if err != nil {
+		writeResponse(w, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+	ocspReq, err := ocsp.ParseRequest(der)
+	if err != nil {
+		writeResponse(w, ocsp.MalformedRequestErrorResponse)
+		return
+	}
+
+	writeResponse(w, r.respond(logf.NewContext(ctx, log), iss, ocspReq))
+}
+
+// respond returns the DER encoded response to an OCSP request for a
+// certificate issued by the issuer.
+func (r *Responder) respond(ctx context.Context, iss cmapi.GenericIssuer, req *ocsp.Request) []byte {
+	log := logf.FromContext(ctx)
+
+	ca := iss.GetSpec().CA
+	namespace, err := r.IssuerOptions.ResourceNamespace(iss)
+	if err != nil {
+		log.Error(err, "failed to find the namespace of the CA certificate", "secret", ca.SecretName)
+		return ocsp.UnauthorizedErrorResponse
+	}
+
+	caCert, err := kube.SecretTLSCert(ctx, r.SecretLister, namespace, ca.SecretName)
+	if err != nil {
+		log.Error(err, "failed to load CA certificate", "secret", ca.SecretName)
+		return ocsp.TryLaterErrorResponse
+	}
+	if !issuedByKey(req, caCert) {
+		// The request is for a certificate issued by a different CA.
+		return ocsp.UnauthorizedErrorResponse
+	}
+
+	responderCerts, responderKey, err := kube.SecretTLSKeyPair(ctx, r.SecretLister, namespace, ca.OCSP.ResponderSecretName)
+	if err != nil {
+		// The responder certificate may not have been issued yet.
+		log.Error(err, "failed to load OCSP responder key pair", "secret", ca.OCSP.ResponderSecretName)
+		return ocsp.TryLaterErrorResponse
+	}
+	responderCert := responderCerts[0]
+	if err := responderCert.CheckSignatureFrom(caCert); err != nil {
+		log.Error(err, "OCSP responder certificate was not issued by the CA", "secret", ca.OCSP.ResponderSecretName)
+		return ocsp.TryLaterErrorResponse
+	}
+
+	validity := defaultResponseValidity
+	if ca.OCSP.ResponseValidity != nil {
+		validity = ca.OCSP.ResponseValidity.Duration
+	}
+	now := r.Clock.Now().UTC()
+	template := ocsp.Response{
+		Status:       ocsp.Unknown,
+		SerialNumber: req.SerialNumber,
+		ThisUpdate:   now,
+		NextUpdate:   now.Add(validity),
+		Certificate:  responderCert,
+		IssuerHash:   req.HashAlgorithm,
+	}
+
+	cr, err := r.certificateRequest(iss, caCert, req)
+	if err != nil {
+		log.Error(err, "failed to look up CertificateRequest")
+		return ocsp.InternalErrorErrorResponse
+	}
+	if cr != nil {
+		template.Status = ocsp.Good
+		if reason, revoked := cr.Annotations[cmapi.CertificateRequestRevokeAnnotationKey]; revoked {
+			template.Status = ocsp.Revoked
+			template.RevokedAt = now
+			if cond := apiutil.GetCertificateRequestCondition(cr, cmapi.CertificateRequestConditionRevoked); cond != nil && cond.LastTransitionTime != nil {
+				template.RevokedAt = cond.LastTransitionTime.Time
+			}
+			template.RevocationReason = pki.RevocationReasons[reason]
+		}
+	}
+
+	resp, err := ocsp.CreateResponse(caCert, responderCert, template, responderKey)
+	if err != nil {
+		log.Error(err, "failed to sign OCSP response")
+		return ocsp.InternalErrorErrorResponse
+	}
+	return resp
+}
+
+// certificateRequest returns the CertificateRequest referencing the issuer
+// whose certificate has the requested serial number and was signed by the
+// CA, or nil if there is none.
+func (r *Responder) certificateRequest(iss cmapi.GenericIssuer, caCert *x509.Certificate, req *ocsp.Request) (*cmapi.CertificateRequest, error) {
+	objs, err := r.CertificateRequests.ByIndex(SerialNumberIndex, req.SerialNumber.Text(16))
+	if err != nil {
+		return nil, err
+	}
+	for _, obj := range objs {
+		cr, ok := obj.(*cmapi.CertificateRequest)
+		if !ok || !apiutil.CertificateRequestReferencesIssuer(cr, iss) {
+			continue
+		}
+		cert, err := pki.DecodeX509CertificateBytes(cr.Status.Certificate)
+		if err != nil || cert.CheckSignatureFrom(caCert) != nil {
+			continue
+		}
+		return cr, nil
+	}
+	return nil, nil
+}
+
+// issuedByKey returns true if the request's issuer key hash matches the
+// public key of the CA certificate.
+func issuedByKey(req *ocsp.Request, caCert *x509.Certificate) bool {
+	if !req.HashAlgorithm.Available() {
+		return false
+	}
+	var spki struct {
+		Algorithm pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+	if _, err := asn1.Unmarshal(caCert.RawSubjectPublicKeyInfo, &spki); err != nil {
+		return false
+	}
+	h := req.HashAlgorithm.New()
+	h.Write(spki.PublicKey.RightAlign())
+	return bytes.Equal(h.Sum(nil), req.IssuerKeyHash)
+}
+
+func writeResponse(w http.ResponseWriter, resp []byte) {
+	w.Header().Set("Content-Type", "application/ocsp-response")
+	_, _ = w.Write(resp)
+}
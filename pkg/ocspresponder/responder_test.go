@@ -0,0 +1,195 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocspresponder
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/base64"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/ocsp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+	fakeclock "k8s.io/utils/clock/testing"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmlisters "github.com/cert-manager/cert-manager/pkg/client/listers/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+	"github.com/cert-manager/cert-manager/test/unit/gen"
+)
+
+func TestResponder(t *testing.T) {
+	now := time.Now().UTC().Truncate(time.Second)
+	revokedAt := now.Add(-time.Hour)
+
+	newCA := func(name string) (*x509.Certificate, interface{}, *corev1.Secret) {
+		key, err := pki.GenerateECPrivateKey(256)
+		require.NoError(t, err)
+		tmpl := &x509.Certificate{
+			SerialNumber:          big.NewInt(1),
+			Subject:               pkix.Name{CommonName: name},
+			NotBefore:             now.Add(-time.Hour),
+			NotAfter:              now.Add(24 * time.Hour),
+			BasicConstraintsValid: true,
+			IsCA:                  true,
+			KeyUsage:              x509.KeyUsageCertSign,
+		}
+		certPEM, cert, err := pki.SignCertificate(tmpl, tmpl, key.Public(), key)
+		require.NoError(t, err)
+		keyPEM, err := pki.EncodePKCS8PrivateKey(key)
+		require.NoError(t, err)
+		return cert, key, gen.Secret(name, gen.SetSecretNamespace("testns"), gen.SetSecretData(map[string][]byte{
+			corev1.TLSCertKey:       certPEM,
+			corev1.TLSPrivateKeyKey: keyPEM,
+		}))
+	}
+	caCert, caKey, caSecret := newCA("ca")
+	otherCACert, otherCAKey, _ := newCA("other-ca")
+
+	// issue returns a certificate signed by the CA, and the PEM encoding of
+	// it and its private key.
+	issue := func(serial int64, parent *x509.Certificate, parentKey interface{}, extKeyUsage ...x509.ExtKeyUsage) (*x509.Certificate, []byte, []byte) {
+		key, err := pki.GenerateECPrivateKey(256)
+		require.NoError(t, err)
+		certPEM, cert, err := pki.SignCertificate(&x509.Certificate{
+			SerialNumber: big.NewInt(serial),
+			Subject:      pkix.Name{CommonName: "leaf"},
+			NotBefore:    now.Add(-time.Hour),
+			NotAfter:     now.Add(time.Hour),
+			ExtKeyUsage:  extKeyUsage,
+		}, parent, key.Public(), parentKey)
+		require.NoError(t, err)
+		keyPEM, err := pki.EncodePKCS8PrivateKey(key)
+		require.NoError(t, err)
+		return cert, certPEM, keyPEM
+	}
+	_, responderPEM, responderKeyPEM := issue(2, caCert, caKey, x509.ExtKeyUsageOCSPSigning)
+	responderSecret := gen.Secret("ca-ocsp", gen.SetSecretNamespace("testns"), gen.SetSecretData(map[string][]byte{
+		corev1.TLSCertKey:       responderPEM,
+		corev1.TLSPrivateKeyKey: responderKeyPEM,
+	}))
+	goodCert, goodPEM, _ := issue(10, caCert, caKey)
+	revokedCert, revokedPEM, _ := issue(11, caCert, caKey)
+	unknownCert, _, _ := issue(12, caCert, caKey)
+	otherCert, _, _ := issue(10, otherCACert, otherCAKey)
+
+	issuer := gen.Issuer("ca",
+		gen.SetIssuerNamespace("testns"),
+		gen.SetIssuerCA(cmapi.CAIssuer{
+			SecretName: "ca",
+			OCSP:       &cmapi.CAOCSP{ResponderSecretName: "ca-ocsp"},
+		}),
+	)
+	baseCR := gen.CertificateRequest("good",
+		gen.SetCertificateRequestNamespace("testns"),
+		gen.SetCertificateRequestIssuer(cmmeta.ObjectReference{Name: "ca", Kind: cmapi.IssuerKind}),
+		gen.SetCertificateRequestCertificate(goodPEM),
+	)
+	revokedCR := gen.CertificateRequestFrom(baseCR,
+		gen.SetCertificateRequestName("revoked"),
+		gen.SetCertificateRequestCertificate(revokedPEM),
+		gen.SetCertificateRequestAnnotations(map[string]string{
+			cmapi.CertificateRequestRevokeAnnotationKey: "superseded",
+		}),
+		gen.SetCertificateRequestStatusCondition(cmapi.CertificateRequestCondition{
+			Type:               cmapi.CertificateRequestConditionRevoked,
+			Status:             cmmeta.ConditionTrue,
+			LastTransitionTime: &metav1.Time{Time: revokedAt},
+		}),
+	)
+
+	issuers := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, issuers.Add(issuer))
+	secrets := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	require.NoError(t, secrets.Add(caSecret))
+	require.NoError(t, secrets.Add(responderSecret))
+	requests := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{SerialNumberIndex: IndexBySerialNumber})
+	require.NoError(t, requests.Add(baseCR))
+	require.NoError(t, requests.Add(revokedCR))
+
+	responder := &Responder{
+		IssuerLister:        cmlisters.NewIssuerLister(issuers),
+		CertificateRequests: requests,
+		SecretLister:        corelisters.NewSecretLister(secrets),
+		Clock:               fakeclock.NewFakeClock(now),
+	}
+
+	post := func(path string, cert, issuerCert *x509.Certificate) []byte {
+		ocspReq, err := ocsp.CreateRequest(cert, issuerCert, nil)
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		responder.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, path, bytes.NewReader(ocspReq)))
+		assert.Equal(t, "application/ocsp-response", rec.Header().Get("Content-Type"))
+		return rec.Body.Bytes()
+	}
+
+	t.Run("good certificate", func(t *testing.T) {
+		resp, err := ocsp.ParseResponseForCert(post("/issuers/testns/ca", goodCert, caCert), goodCert, caCert)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Good, resp.Status)
+		assert.True(t, now.Add(time.Hour).Equal(resp.NextUpdate))
+	})
+
+	t.Run("revoked certificate", func(t *testing.T) {
+		resp, err := ocsp.ParseResponseForCert(post("/issuers/testns/ca", revokedCert, caCert), revokedCert, caCert)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Revoked, resp.Status)
+		assert.Equal(t, ocsp.Superseded, resp.RevocationReason)
+		assert.True(t, revokedAt.Equal(resp.RevokedAt))
+	})
+
+	t.Run("certificate without a CertificateRequest", func(t *testing.T) {
+		resp, err := ocsp.ParseResponseForCert(post("/issuers/testns/ca", unknownCert, caCert), unknownCert, caCert)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Unknown, resp.Status)
+	})
+
+	t.Run("certificate issued by a different CA", func(t *testing.T) {
+		assert.Equal(t, ocsp.UnauthorizedErrorResponse, post("/issuers/testns/ca", otherCert, otherCACert))
+	})
+
+	t.Run("unknown issuer", func(t *testing.T) {
+		assert.Equal(t, ocsp.UnauthorizedErrorResponse, post("/issuers/testns/missing", goodCert, caCert))
+	})
+
+	t.Run("GET request", func(t *testing.T) {
+		ocspReq, err := ocsp.CreateRequest(goodCert, caCert, nil)
+		require.NoError(t, err)
+		rec := httptest.NewRecorder()
+		responder.ServeHTTP(rec, httptest.NewRequest(http.MethodGet, "/issuers/testns/ca/"+base64.StdEncoding.EncodeToString(ocspReq), nil))
+		resp, err := ocsp.ParseResponseForCert(rec.Body.Bytes(), goodCert, caCert)
+		require.NoError(t, err)
+		assert.Equal(t, ocsp.Good, resp.Status)
+	})
+
+	t.Run("malformed request", func(t *testing.T) {
+		rec := httptest.NewRecorder()
+		responder.ServeHTTP(rec, httptest.NewRequest(http.MethodPost, "/issuers/testns/ca", bytes.NewReader([]byte("invalid"))))
+		assert.Equal(t, ocsp.MalformedRequestErrorResponse, rec.Body.Bytes())
+	})
+}
@@ -0,0 +1,56 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// +groupName=requests.cert-manager.io
+
+// Package requests contains type definitions for the certificate request
+// portal API, as well as the Namespace annotations used to configure which
+// certificates may be requested through it.
+package requests
+
+const (
+	GroupName = "requests.cert-manager.io"
+)
+
+// Annotations on a Namespace which configure the certificates that may be
+// requested in it through the portal API. The portal API is only enabled for
+// Namespaces which have the IssuerNameAnnotationKey annotation.
+const (
+	// IssuerNameAnnotationKey is the name of the issuer used for all
+	// Certificates requested in the Namespace.
+	IssuerNameAnnotationKey = "requests.cert-manager.io/issuer-name"
+
+	// IssuerKindAnnotationKey is the kind of the issuer, defaulting to Issuer.
+	IssuerKindAnnotationKey = "requests.cert-manager.io/issuer-kind"
+
+	// IssuerGroupAnnotationKey is the API group of the issuer, defaulting to
+	// cert-manager.io.
+	IssuerGroupAnnotationKey = "requests.cert-manager.io/issuer-group"
+
+	// AllowedDNSZonesAnnotationKey is a comma separated list of DNS zones.
+	// Every requested DNS name must be equal to or a subdomain of one of
+	// these zones. If not set, no DNS names may be requested.
+	AllowedDNSZonesAnnotationKey = "requests.cert-manager.io/allowed-dns-zones"
+
+	// MaxDurationAnnotationKey is the maximum duration that may be requested,
+	// as a Go duration string such as "2160h". If not set, any duration may
+	// be requested.
+	MaxDurationAnnotationKey = "requests.cert-manager.io/max-duration"
+
+	// RequestedByAnnotationKey is set on Certificates created through the
+	// portal API to the name of the user that requested it.
+	RequestedByAnnotationKey = "requests.cert-manager.io/requested-by"
+)
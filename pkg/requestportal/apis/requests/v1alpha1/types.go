@@ -0,0 +1,77 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// PortalRequest is a simplified request for a certificate.
+// Creating a PortalRequest validates it against the policy configured on its
+// Namespace and creates a Certificate of the same name on behalf of the
+// user, so that users can request certificates without being granted write
+// access to Certificate resources.
+// PortalRequests are not persisted; the created Certificate should be
+// inspected to follow the progress of the request.
+type PortalRequest struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec describes the requested certificate.
+	Spec PortalRequestSpec `json:"spec"`
+
+	// Status is set in the response to a successful request.
+	// +optional
+	Status PortalRequestStatus `json:"status,omitempty"`
+}
+
+// PortalRequestSpec describes the requested certificate. Any setting not
+// listed here, such as the issuer and private key, is decided by the
+// Namespace's policy.
+type PortalRequestSpec struct {
+	// CommonName is the requested common name. If set, it must also be
+	// listed in DNSNames.
+	// +optional
+	CommonName string `json:"commonName,omitempty"`
+
+	// DNSNames is the list of DNS names to request. Each must be within one
+	// of the DNS zones allowed by the Namespace's policy.
+	DNSNames []string `json:"dnsNames"`
+
+	// Duration is the requested duration of the certificate. It may not be
+	// longer than the maximum duration allowed by the Namespace's policy.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// SecretName is the name of the Secret the certificate will be stored
+	// in. Defaults to the name of the PortalRequest with a "-tls" suffix.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
+
+// PortalRequestStatus describes the result of a PortalRequest.
+type PortalRequestStatus struct {
+	// CertificateName is the name of the Certificate created for the request.
+	// +optional
+	CertificateName string `json:"certificateName,omitempty"`
+
+	// SecretName is the name of the Secret the certificate will be stored in.
+	// +optional
+	SecretName string `json:"secretName,omitempty"`
+}
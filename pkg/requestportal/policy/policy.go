@@ -0,0 +1,150 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package policy implements the Namespace policies which constrain the
+// certificates that can be requested through the certificate request portal.
+package policy
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests/v1alpha1"
+)
+
+// ErrNotEnabled is returned by FromNamespace if the certificate request
+// portal has not been enabled for the Namespace.
+var ErrNotEnabled = errors.New("certificate requests are not enabled for this namespace")
+
+// Policy constrains the certificates that can be requested in a Namespace.
+type Policy struct {
+	// IssuerRef is the issuer used for all requested certificates.
+	IssuerRef cmmeta.ObjectReference
+
+	// AllowedDNSZones are the DNS zones that requested DNS names must be
+	// within.
+	AllowedDNSZones []string
+
+	// MaxDuration is the maximum duration that may be requested. Zero means
+	// that any duration may be requested.
+	MaxDuration time.Duration
+}
+
+// FromNamespace returns the Policy configured by the annotations on the given
+// Namespace. ErrNotEnabled is returned if the Namespace does not have an
+// issuer configured.
+func FromNamespace(ns *corev1.Namespace) (*Policy, error) {
+	annotations := ns.Annotations
+	issuerName := annotations[requests.IssuerNameAnnotationKey]
+	if issuerName == "" {
+		return nil, ErrNotEnabled
+	}
+
+	p := &Policy{
+		IssuerRef: cmmeta.ObjectReference{
+			Name:  issuerName,
+			Kind:  annotations[requests.IssuerKindAnnotationKey],
+			Group: annotations[requests.IssuerGroupAnnotationKey],
+		},
+	}
+
+	for _, zone := range strings.Split(annotations[requests.AllowedDNSZonesAnnotationKey], ",") {
+		zone = strings.ToLower(strings.Trim(strings.TrimSpace(zone), "."))
+		if zone != "" {
+			p.AllowedDNSZones = append(p.AllowedDNSZones, zone)
+		}
+	}
+
+	if d, ok := annotations[requests.MaxDurationAnnotationKey]; ok {
+		maxDuration, err := time.ParseDuration(d)
+		if err != nil {
+			return nil, fmt.Errorf("invalid %s annotation on namespace %q: %w", requests.MaxDurationAnnotationKey, ns.Name, err)
+		}
+		p.MaxDuration = maxDuration
+	}
+
+	return p, nil
+}
+
+// Validate returns the ways in which the given request violates the policy.
+func (p *Policy) Validate(spec *v1alpha1.PortalRequestSpec, fldPath *field.Path) field.ErrorList {
+	var el field.ErrorList
+
+	if len(spec.DNSNames) == 0 {
+		el = append(el, field.Required(fldPath.Child("dnsNames"), "at least one DNS name must be requested"))
+	}
+	for i, name := range spec.DNSNames {
+		if errs := validation.IsDNS1123Subdomain(strings.TrimPrefix(name, "*.")); len(errs) > 0 {
+			el = append(el, field.Invalid(fldPath.Child("dnsNames").Index(i), name, strings.Join(errs, ", ")))
+			continue
+		}
+		if !p.dnsNameAllowed(name) {
+			el = append(el, field.Forbidden(fldPath.Child("dnsNames").Index(i),
+				fmt.Sprintf("%q is not within the DNS zones allowed for this namespace: %s", name, strings.Join(p.AllowedDNSZones, ", "))))
+		}
+	}
+
+	if spec.CommonName != "" && !contains(spec.DNSNames, spec.CommonName) {
+		el = append(el, field.Invalid(fldPath.Child("commonName"), spec.CommonName, "must also be listed in dnsNames"))
+	}
+
+	if spec.Duration != nil {
+		switch {
+		case spec.Duration.Duration <= 0:
+			el = append(el, field.Invalid(fldPath.Child("duration"), spec.Duration.Duration.String(), "must be positive"))
+		case p.MaxDuration > 0 && spec.Duration.Duration > p.MaxDuration:
+			el = append(el, field.Forbidden(fldPath.Child("duration"),
+				fmt.Sprintf("may not be longer than %s, the maximum allowed for this namespace", p.MaxDuration)))
+		}
+	}
+
+	if spec.SecretName != "" {
+		for _, msg := range validation.IsDNS1123Subdomain(spec.SecretName) {
+			el = append(el, field.Invalid(fldPath.Child("secretName"), spec.SecretName, msg))
+		}
+	}
+
+	return el
+}
+
+// dnsNameAllowed returns true if the given DNS name is equal to, or a
+// subdomain of, one of the allowed DNS zones.
+func (p *Policy) dnsNameAllowed(name string) bool {
+	name = strings.ToLower(strings.TrimPrefix(name, "*."))
+	for _, zone := range p.AllowedDNSZones {
+		if name == zone || strings.HasSuffix(name, "."+zone) {
+			return true
+		}
+	}
+	return false
+}
+
+func contains(s []string, v string) bool {
+	for _, e := range s {
+		if e == v {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package policy
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests/v1alpha1"
+)
+
+func namespace(annotations map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Annotations: annotations}}
+}
+
+func TestFromNamespace(t *testing.T) {
+	tests := map[string]struct {
+		annotations map[string]string
+		expPolicy   *Policy
+		expErr      error
+		expAnyErr   bool
+	}{
+		"no issuer annotation means the portal is not enabled": {
+			annotations: map[string]string{"requests.cert-manager.io/allowed-dns-zones": "example.com"},
+			expErr:      ErrNotEnabled,
+		},
+		"all annotations set": {
+			annotations: map[string]string{
+				"requests.cert-manager.io/issuer-name":       "internal-ca",
+				"requests.cert-manager.io/issuer-kind":       "ClusterIssuer",
+				"requests.cert-manager.io/issuer-group":      "cert-manager.io",
+				"requests.cert-manager.io/allowed-dns-zones": " Team-A.Example.com. , ,apps.example.com",
+				"requests.cert-manager.io/max-duration":      "720h",
+			},
+			expPolicy: &Policy{
+				IssuerRef:       cmmeta.ObjectReference{Name: "internal-ca", Kind: "ClusterIssuer", Group: "cert-manager.io"},
+				AllowedDNSZones: []string{"team-a.example.com", "apps.example.com"},
+				MaxDuration:     720 * time.Hour,
+			},
+		},
+		"invalid max duration": {
+			annotations: map[string]string{
+				"requests.cert-manager.io/issuer-name":  "internal-ca",
+				"requests.cert-manager.io/max-duration": "a month",
+			},
+			expAnyErr: true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			p, err := FromNamespace(namespace(test.annotations))
+			switch {
+			case test.expErr != nil:
+				assert.ErrorIs(t, err, test.expErr)
+			case test.expAnyErr:
+				assert.Error(t, err)
+			default:
+				assert.NoError(t, err)
+				assert.Equal(t, test.expPolicy, p)
+			}
+		})
+	}
+}
+
+func TestValidate(t *testing.T) {
+	p := &Policy{
+		AllowedDNSZones: []string{"team-a.example.com"},
+		MaxDuration:     720 * time.Hour,
+	}
+	fldPath := field.NewPath("spec")
+
+	tests := map[string]struct {
+		spec v1alpha1.PortalRequestSpec
+		errs field.ErrorList
+	}{
+		"names within the allowed zones": {
+			spec: v1alpha1.PortalRequestSpec{
+				CommonName: "team-a.example.com",
+				DNSNames:   []string{"team-a.example.com", "api.team-a.example.com", "*.apps.team-a.example.com"},
+				Duration:   &metav1.Duration{Duration: 720 * time.Hour},
+				SecretName: "api-tls",
+			},
+		},
+		"no DNS names": {
+			spec: v1alpha1.PortalRequestSpec{},
+			errs: field.ErrorList{
+				field.Required(fldPath.Child("dnsNames"), "at least one DNS name must be requested"),
+			},
+		},
+		"name outside the allowed zones": {
+			spec: v1alpha1.PortalRequestSpec{
+				DNSNames: []string{"api.team-a.example.com", "evilteam-a.example.com"},
+			},
+			errs: field.ErrorList{
+				field.Forbidden(fldPath.Child("dnsNames").Index(1), `"evilteam-a.example.com" is not within the DNS zones allowed for this namespace: team-a.example.com`),
+			},
+		},
+		"common name not in DNS names": {
+			spec: v1alpha1.PortalRequestSpec{
+				CommonName: "www.team-a.example.com",
+				DNSNames:   []string{"api.team-a.example.com"},
+			},
+			errs: field.ErrorList{
+				field.Invalid(fldPath.Child("commonName"), "www.team-a.example.com", "must also be listed in dnsNames"),
+			},
+		},
+		"duration longer than allowed": {
+			spec: v1alpha1.PortalRequestSpec{
+				DNSNames: []string{"api.team-a.example.com"},
+				Duration: &metav1.Duration{Duration: 8760 * time.Hour},
+			},
+			errs: field.ErrorList{
+				field.Forbidden(fldPath.Child("duration"), "may not be longer than 720h0m0s, the maximum allowed for this namespace"),
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.errs, p.Validate(&test.spec, fldPath))
+		})
+	}
+}
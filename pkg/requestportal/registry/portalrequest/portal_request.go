@@ -0,0 +1,149 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portalrequest
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	"k8s.io/apiserver/pkg/registry/rest"
+	corev1client "k8s.io/client-go/kubernetes/typed/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmclient "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/typed/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests/v1alpha1"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/policy"
+)
+
+// REST creates Certificates for PortalRequests that comply with the policy
+// of their Namespace. Users only need permission to create PortalRequests;
+// Certificates are created using the API server's own credentials.
+type REST struct {
+	namespaces   corev1client.NamespacesGetter
+	certificates cmclient.CertificatesGetter
+}
+
+var _ rest.Creater = &REST{}
+var _ rest.Scoper = &REST{}
+var _ rest.GroupVersionKindProvider = &REST{}
+
+func NewREST(namespaces corev1client.NamespacesGetter, certificates cmclient.CertificatesGetter) *REST {
+	return &REST{
+		namespaces:   namespaces,
+		certificates: certificates,
+	}
+}
+
+func (r *REST) New() runtime.Object {
+	return &v1alpha1.PortalRequest{}
+}
+
+func (r *REST) GroupVersionKind(containingGV schema.GroupVersion) schema.GroupVersionKind {
+	return v1alpha1.SchemeGroupVersion.WithKind("PortalRequest")
+}
+
+func (r *REST) NamespaceScoped() bool {
+	return true
+}
+
+func (r *REST) Create(ctx context.Context, obj runtime.Object, createValidation rest.ValidateObjectFunc, _ *metav1.CreateOptions) (runtime.Object, error) {
+	req, ok := obj.(*v1alpha1.PortalRequest)
+	if !ok {
+		return nil, fmt.Errorf("resource is not of type PortalRequest")
+	}
+
+	namespace, ok := genericapirequest.NamespaceFrom(ctx)
+	if !ok || namespace == "" {
+		return nil, apierrors.NewBadRequest("namespace is required")
+	}
+	if req.Name == "" {
+		return nil, apierrors.NewBadRequest("name is required")
+	}
+	if createValidation != nil {
+		if err := createValidation(ctx, obj); err != nil {
+			return nil, err
+		}
+	}
+
+	ns, err := r.namespaces.Namespaces().Get(ctx, namespace, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	p, err := policy.FromNamespace(ns)
+	if errors.Is(err, policy.ErrNotEnabled) {
+		return nil, apierrors.NewForbidden(v1alpha1.Resource("portalrequests"), req.Name, err)
+	}
+	if err != nil {
+		return nil, apierrors.NewInternalError(err)
+	}
+
+	if el := p.Validate(&req.Spec, field.NewPath("spec")); len(el) > 0 {
+		return nil, apierrors.NewInvalid(v1alpha1.SchemeGroupVersion.WithKind("PortalRequest").GroupKind(), req.Name, el)
+	}
+
+	crt := certificateForRequest(ctx, req, namespace, p)
+	crt, err = r.certificates.Certificates(namespace).Create(ctx, crt, metav1.CreateOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	req = req.DeepCopy()
+	req.Namespace = namespace
+	req.CreationTimestamp = crt.CreationTimestamp
+	req.Status = v1alpha1.PortalRequestStatus{
+		CertificateName: crt.Name,
+		SecretName:      crt.Spec.SecretName,
+	}
+	return req, nil
+}
+
+// certificateForRequest returns the Certificate to create for the given
+// request, using the issuer from the Namespace's policy.
+func certificateForRequest(ctx context.Context, req *v1alpha1.PortalRequest, namespace string, p *policy.Policy) *cmapi.Certificate {
+	secretName := req.Spec.SecretName
+	if secretName == "" {
+		secretName = req.Name + "-tls"
+	}
+
+	annotations := map[string]string{}
+	if user, ok := genericapirequest.UserFrom(ctx); ok {
+		annotations[requests.RequestedByAnnotationKey] = user.GetName()
+	}
+
+	return &cmapi.Certificate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        req.Name,
+			Namespace:   namespace,
+			Annotations: annotations,
+		},
+		Spec: cmapi.CertificateSpec{
+			CommonName: req.Spec.CommonName,
+			DNSNames:   req.Spec.DNSNames,
+			Duration:   req.Spec.Duration,
+			SecretName: secretName,
+			IssuerRef:  p.IssuerRef,
+		},
+	}
+}
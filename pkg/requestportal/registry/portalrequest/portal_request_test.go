@@ -0,0 +1,104 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package portalrequest
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apiserver/pkg/authentication/user"
+	genericapirequest "k8s.io/apiserver/pkg/endpoints/request"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	cmfake "github.com/cert-manager/cert-manager/pkg/client/clientset/versioned/fake"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests/v1alpha1"
+)
+
+func TestCreate(t *testing.T) {
+	enabled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Annotations: map[string]string{
+		"requests.cert-manager.io/issuer-name":       "internal-ca",
+		"requests.cert-manager.io/issuer-kind":       "ClusterIssuer",
+		"requests.cert-manager.io/allowed-dns-zones": "team-a.example.com",
+	}}}
+	disabled := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-b"}}
+
+	tests := map[string]struct {
+		namespace string
+		request   *v1alpha1.PortalRequest
+		expErr    func(error) bool
+	}{
+		"compliant request creates a Certificate": {
+			namespace: "team-a",
+			request: &v1alpha1.PortalRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "api"},
+				Spec:       v1alpha1.PortalRequestSpec{DNSNames: []string{"api.team-a.example.com"}},
+			},
+		},
+		"request violating the policy is invalid": {
+			namespace: "team-a",
+			request: &v1alpha1.PortalRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "api"},
+				Spec:       v1alpha1.PortalRequestSpec{DNSNames: []string{"api.example.org"}},
+			},
+			expErr: apierrors.IsInvalid,
+		},
+		"request in a namespace without a policy is forbidden": {
+			namespace: "team-b",
+			request: &v1alpha1.PortalRequest{
+				ObjectMeta: metav1.ObjectMeta{Name: "api"},
+				Spec:       v1alpha1.PortalRequestSpec{DNSNames: []string{"api.team-a.example.com"}},
+			},
+			expErr: apierrors.IsForbidden,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			kubeClient := kubefake.NewSimpleClientset(enabled, disabled)
+			cmClient := cmfake.NewSimpleClientset()
+			r := NewREST(kubeClient.CoreV1(), cmClient.CertmanagerV1())
+
+			ctx := genericapirequest.WithNamespace(context.Background(), test.namespace)
+			ctx = genericapirequest.WithUser(ctx, &user.DefaultInfo{Name: "alice"})
+			obj, err := r.Create(ctx, test.request, nil, &metav1.CreateOptions{})
+			if test.expErr != nil {
+				assert.True(t, test.expErr(err), "unexpected error: %v", err)
+				crts, err := cmClient.CertmanagerV1().Certificates(test.namespace).List(ctx, metav1.ListOptions{})
+				require.NoError(t, err)
+				assert.Empty(t, crts.Items)
+				return
+			}
+			require.NoError(t, err)
+
+			resp := obj.(*v1alpha1.PortalRequest)
+			assert.Equal(t, "api", resp.Status.CertificateName)
+			assert.Equal(t, "api-tls", resp.Status.SecretName)
+
+			crt, err := cmClient.CertmanagerV1().Certificates(test.namespace).Get(ctx, "api", metav1.GetOptions{})
+			require.NoError(t, err)
+			assert.Equal(t, cmmeta.ObjectReference{Name: "internal-ca", Kind: "ClusterIssuer"}, crt.Spec.IssuerRef)
+			assert.Equal(t, []string{"api.team-a.example.com"}, crt.Spec.DNSNames)
+			assert.Equal(t, "api-tls", crt.Spec.SecretName)
+			assert.Equal(t, "alice", crt.Annotations["requests.cert-manager.io/requested-by"])
+		})
+	}
+}
@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package server
+
+import (
+	"fmt"
+	"io"
+	"net"
+
+	"github.com/spf13/cobra"
+	genericapiserver "k8s.io/apiserver/pkg/server"
+	genericoptions "k8s.io/apiserver/pkg/server/options"
+
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apis/requests/v1alpha1"
+	"github.com/cert-manager/cert-manager/pkg/requestportal/apiserver"
+)
+
+const defaultEtcdPathPrefix = "/registry/requests.cert-manager.io"
+
+type PortalServerOptions struct {
+	RecommendedOptions *genericoptions.RecommendedOptions
+
+	StdOut io.Writer
+	StdErr io.Writer
+}
+
+func NewPortalServerOptions(out, errOut io.Writer) *PortalServerOptions {
+	o := &PortalServerOptions{
+		RecommendedOptions: genericoptions.NewRecommendedOptions(
+			defaultEtcdPathPrefix,
+			apiserver.Codecs.LegacyCodec(v1alpha1.SchemeGroupVersion),
+		),
+
+		StdOut: out,
+		StdErr: errOut,
+	}
+	// PortalRequests are not persisted, so no storage is needed.
+	o.RecommendedOptions.Etcd = nil
+	o.RecommendedOptions.Admission = nil
+
+	return o
+}
+
+func NewCommandStartPortalServer(out, errOut io.Writer, stopCh <-chan struct{}) *cobra.Command {
+	o := NewPortalServerOptions(out, errOut)
+
+	cmd := &cobra.Command{
+		Use:   "requestportal",
+		Short: "Launch the cert-manager certificate request portal API server",
+		Long: `Launch the cert-manager certificate request portal API server.
+
+The request portal serves the requests.cert-manager.io aggregated API. Users
+create PortalRequests, which are validated against the policy configured by
+annotations on their Namespace, and a Certificate is created on their behalf.
+This allows developer portals to request certificates without users being
+granted write access to Certificate resources.`,
+		RunE: func(c *cobra.Command, args []string) error {
+			if err := o.Complete(); err != nil {
+				return err
+			}
+			if err := o.Validate(args); err != nil {
+				return err
+			}
+			if err := o.RunPortalServer(stopCh); err != nil {
+				return err
+			}
+			return nil
+		},
+	}
+
+	flags := cmd.Flags()
+	o.RecommendedOptions.AddFlags(flags)
+
+	return cmd
+}
+
+func (o PortalServerOptions) Validate(args []string) error {
+	return nil
+}
+
+func (o *PortalServerOptions) Complete() error {
+	return nil
+}
+
+func (o PortalServerOptions) Config() (*apiserver.Config, error) {
+	if err := o.RecommendedOptions.SecureServing.MaybeDefaultWithSelfSignedCerts("localhost", nil, []net.IP{net.ParseIP("127.0.0.1")}); err != nil {
+		return nil, fmt.Errorf("error creating self-signed certificates: %v", err)
+	}
+
+	serverConfig := genericapiserver.NewRecommendedConfig(apiserver.Codecs)
+	if err := o.RecommendedOptions.ApplyTo(serverConfig); err != nil {
+		return nil, err
+	}
+
+	return &apiserver.Config{
+		GenericConfig: serverConfig,
+		RestConfig:    serverConfig.ClientConfig,
+	}, nil
+}
+
+func (o PortalServerOptions) RunPortalServer(stopCh <-chan struct{}) error {
+	config, err := o.Config()
+	if err != nil {
+		return err
+	}
+
+	server, err := config.Complete().New()
+	if err != nil {
+		return err
+	}
+	return server.GenericAPIServer.PrepareRun().Run(stopCh)
+}
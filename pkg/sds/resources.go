@@ -0,0 +1,180 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"strings"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+)
+
+// CAResourcePrefix is prefixed to the name of a resource to request the CA
+// certificate of a Secret as a validation context, rather than its
+// certificate and private key.
+const CAResourcePrefix = "ca:"
+
+// parseResourceName returns the namespace and name of the Secret a resource
+// is read from, and whether the resource is its CA certificate.
+func (s *Server) parseResourceName(resourceName string) (namespace, name string, ca bool, err error) {
+	name = resourceName
+	if strings.HasPrefix(name, CAResourcePrefix) {
+		name = strings.TrimPrefix(name, CAResourcePrefix)
+		ca = true
+	}
+
+	namespace = s.namespace
+	if i := strings.Index(name, "/"); i >= 0 {
+		namespace, name = name[:i], name[i+1:]
+		if s.namespace != "" && namespace != s.namespace {
+			return "", "", false, fmt.Errorf("only secrets in namespace %q are served", s.namespace)
+		}
+	}
+	if namespace == "" {
+		return "", "", false, fmt.Errorf("resource name %q must be of the form <namespace>/<secret name>", resourceName)
+	}
+	if errs := validation.IsDNS1123Label(namespace); len(errs) > 0 {
+		return "", "", false, fmt.Errorf("invalid namespace %q: %s", namespace, strings.Join(errs, ", "))
+	}
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return "", "", false, fmt.Errorf("invalid secret name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return namespace, name, ca, nil
+}
+
+// resource returns a resource, and the key of the Secret it is read from,
+// which is returned whenever the resource name is valid. Errors carry a gRPC
+// status.
+func (s *Server) resource(ctx context.Context, resourceName string) (*tlsv3.Secret, string, error) {
+	namespace, name, ca, err := s.parseResourceName(resourceName)
+	if err != nil {
+		return nil, "", status.Error(codes.InvalidArgument, err.Error())
+	}
+	key := namespace + "/" + name
+
+	secret, err := s.secretsLister.Secrets(namespace).Get(name)
+	if apierrors.IsNotFound(err) {
+		return nil, key, status.Errorf(codes.NotFound, "secret %s not found", key)
+	}
+	if err != nil {
+		return nil, key, status.Errorf(codes.Internal, "failed to get secret %s: %v", key, err)
+	}
+	// Only the Secrets of Certificates are served, so that other Secrets
+	// cannot be read by anyone with access to the socket.
+	if _, ok := secret.Annotations[cmapi.CertificateNameKey]; !ok {
+		return nil, key, status.Errorf(codes.NotFound, "secret %s is not managed by cert-manager", key)
+	}
+
+	if ca {
+		caData := secret.Data[cmmeta.TLSCAKey]
+		if len(caData) == 0 {
+			return nil, key, status.Errorf(codes.NotFound, "secret %s has no CA certificate", key)
+		}
+		return &tlsv3.Secret{
+			Name: resourceName,
+			Type: &tlsv3.Secret_ValidationContext{
+				ValidationContext: &tlsv3.CertificateValidationContext{TrustedCa: inlineBytes(caData)},
+			},
+		}, key, nil
+	}
+
+	certData, keyData := secret.Data[corev1.TLSCertKey], secret.Data[corev1.TLSPrivateKeyKey]
+	if len(certData) == 0 || len(keyData) == 0 {
+		return nil, key, status.Errorf(codes.NotFound, "secret %s has no certificate", key)
+	}
+	keyData, err = s.privateKey(ctx, key, keyData)
+	if err != nil {
+		return nil, key, err
+	}
+	return &tlsv3.Secret{
+		Name: resourceName,
+		Type: &tlsv3.Secret_TlsCertificate{
+			TlsCertificate: &tlsv3.TlsCertificate{
+				CertificateChain: inlineBytes(certData),
+				PrivateKey:       inlineBytes(keyData),
+			},
+		},
+	}, key, nil
+}
+
+// privateKey returns the PEM encoded private key stored in the Secret with
+// the given key, decrypting it if it is encrypted.
+func (s *Server) privateKey(ctx context.Context, key string, data []byte) ([]byte, error) {
+	switch {
+	case keybackend.IsReference(data):
+		return nil, status.Errorf(codes.FailedPrecondition, "the private key of secret %s is held in a key management service and cannot be served", key)
+	case keyencryption.IsEncrypted(data):
+		s.lock.Lock()
+		cached, ok := s.keys[key]
+		s.lock.Unlock()
+		if ok && bytes.Equal(cached.encrypted, data) {
+			return cached.key, nil
+		}
+
+		decrypted, err := s.decrypt(ctx, data)
+		if err != nil {
+			return nil, status.Errorf(codes.Unavailable, "failed to decrypt private key of secret %s: %v", key, err)
+		}
+		s.lock.Lock()
+		s.keys[key] = decryptedKey{encrypted: data, key: decrypted}
+		s.lock.Unlock()
+		return decrypted, nil
+	default:
+		return data, nil
+	}
+}
+
+func inlineBytes(data []byte) *corev3.DataSource {
+	return &corev3.DataSource{Specifier: &corev3.DataSource_InlineBytes{InlineBytes: data}}
+}
+
+// response returns a response holding the given Secrets, with a version
+// which changes whenever they change.
+func response(secrets []*tlsv3.Secret) (*discoveryv3.DiscoveryResponse, error) {
+	resp := &discoveryv3.DiscoveryResponse{TypeUrl: resourcev3.SecretType}
+	h := sha256.New()
+	for _, secret := range secrets {
+		// Secrets are marshalled deterministically, so that their encoding
+		// only changes if they do.
+		resource := &anypb.Any{}
+		if err := anypb.MarshalFrom(resource, secret, proto.MarshalOptions{Deterministic: true}); err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to marshal secret %s: %v", secret.Name, err)
+		}
+		resp.Resources = append(resp.Resources, resource)
+		fmt.Fprintf(h, "%x\x00", sha256.Sum256(resource.Value))
+	}
+	resp.VersionInfo = fmt.Sprintf("%x", h.Sum(nil)[:8])
+	return resp, nil
+}
@@ -0,0 +1,312 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package sds serves the certificates stored in the Secrets of cert-manager
+// Certificates to Envoy proxies with the Secret Discovery Service (SDS), so
+// that proxies pick up renewed certificates as soon as they are stored,
+// without the Secrets being mounted or the proxies restarted.
+package sds
+
+import (
+	"context"
+	"errors"
+	"io"
+	"net"
+	"sort"
+	"strconv"
+	"sync"
+
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	secretv3 "github.com/envoyproxy/go-control-plane/envoy/service/secret/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/go-logr/logr"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+	logf "github.com/cert-manager/cert-manager/pkg/logs"
+)
+
+// Server serves the Secret Discovery Service. The name of a resource is the
+// name of the Secret of a Certificate, prefixed with its namespace and a
+// slash unless it is in the server's namespace. Resources are served as TLS
+// certificates holding the Secret's certificate and private key, or, if
+// their name is prefixed with CAResourcePrefix, as validation contexts
+// holding its CA certificate. Only Secrets managed by cert-manager are
+// served.
+// Only state-of-the-world subscriptions are supported, so Envoy must be
+// configured with the GRPC api_type; calls to DeltaSecrets fail with the
+// UNIMPLEMENTED code.
+type Server struct {
+	secretv3.UnimplementedSecretDiscoveryServiceServer
+
+	secretsLister corelisters.SecretLister
+	namespace     string
+	decrypt       func(ctx context.Context, data []byte) ([]byte, error)
+
+	lock sync.Mutex
+	// ctx is the context the server is run with, which ends all streams
+	// once it is done.
+	ctx context.Context
+	log logr.Logger
+	// watchers are the watchers of the open streams.
+	watchers map[*watcher]struct{}
+	// keys are the decrypted private keys of Secrets, by the key of the
+	// Secret, which are cached so that the key management service is not
+	// called for every response.
+	keys map[string]decryptedKey
+}
+
+// watcher is notified of changes to the Secrets the resources of a stream
+// are read from.
+type watcher struct {
+	// secrets are the keys of the Secrets, which must only be accessed
+	// holding the lock of the server.
+	secrets map[string]struct{}
+	updates chan struct{}
+}
+
+type decryptedKey struct {
+	encrypted []byte
+	key       []byte
+}
+
+var _ secretv3.SecretDiscoveryServiceServer = &Server{}
+
+// New returns a Server serving the Secrets listed by secretsLister. If
+// namespace is set, only Secrets in that namespace are served. Encrypted
+// private keys are decrypted using ambient credentials, as by
+// keyencryption.Decrypt.
+func New(secretsLister corelisters.SecretLister, namespace, userAgent string) *Server {
+	return &Server{
+		secretsLister: secretsLister,
+		namespace:     namespace,
+		decrypt: func(ctx context.Context, data []byte) ([]byte, error) {
+			return keyencryption.Decrypt(ctx, data, userAgent)
+		},
+		ctx:      context.Background(),
+		log:      logf.Log,
+		watchers: make(map[*watcher]struct{}),
+		keys:     make(map[string]decryptedKey),
+	}
+}
+
+// Run serves the Secret Discovery Service on lis until ctx is done.
+func (s *Server) Run(ctx context.Context, lis net.Listener) error {
+	s.lock.Lock()
+	s.ctx = ctx
+	s.log = logf.FromContext(ctx)
+	s.lock.Unlock()
+
+	srv := grpc.NewServer()
+	secretv3.RegisterSecretDiscoveryServiceServer(srv, s)
+	go func() {
+		<-ctx.Done()
+		srv.GracefulStop()
+	}()
+	s.log.V(logf.InfoLevel).Info("starting SDS server", "namespace", s.namespace, "address", lis.Addr())
+	return srv.Serve(lis)
+}
+
+// EventHandler returns the handler of the events of an informer of Secrets,
+// which pushes the changes to Secrets to the streams their resources are
+// subscribed to on.
+func (s *Server) EventHandler() cache.ResourceEventHandler {
+	return cache.ResourceEventHandlerFuncs{
+		AddFunc: s.secretChanged,
+		UpdateFunc: func(_, obj interface{}) {
+			s.secretChanged(obj)
+		},
+		DeleteFunc: func(obj interface{}) {
+			if key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj); err == nil {
+				s.lock.Lock()
+				delete(s.keys, key)
+				s.lock.Unlock()
+			}
+			s.secretChanged(obj)
+		},
+	}
+}
+
+func (s *Server) secretChanged(obj interface{}) {
+	key, err := cache.DeletionHandlingMetaNamespaceKeyFunc(obj)
+	if err != nil {
+		return
+	}
+
+	s.lock.Lock()
+	defer s.lock.Unlock()
+	for w := range s.watchers {
+		if _, ok := w.secrets[key]; !ok {
+			continue
+		}
+		// The stream reads all of its Secrets once notified, so one
+		// pending notification is enough.
+		select {
+		case w.updates <- struct{}{}:
+		default:
+		}
+	}
+}
+
+func (s *Server) FetchSecrets(ctx context.Context, req *discoveryv3.DiscoveryRequest) (*discoveryv3.DiscoveryResponse, error) {
+	if req.TypeUrl != "" && req.TypeUrl != resourcev3.SecretType {
+		return nil, status.Errorf(codes.InvalidArgument, "unsupported resource type %q", req.TypeUrl)
+	}
+
+	var secrets []*tlsv3.Secret
+	for _, name := range req.ResourceNames {
+		secret, _, err := s.resource(ctx, name)
+		if err != nil {
+			return nil, err
+		}
+		secrets = append(secrets, secret)
+	}
+	return response(secrets)
+}
+
+// StreamSecrets sends the resources subscribed to on the stream whenever the
+// subscription or the Secrets they are read from change. Resources which
+// cannot be served are left out of responses, so that Envoy keeps waiting
+// for them, until their Secret changes.
+func (s *Server) StreamSecrets(stream secretv3.SecretDiscoveryService_StreamSecretsServer) error {
+	ctx := stream.Context()
+	s.lock.Lock()
+	serverCtx, log := s.ctx, s.log
+	w := &watcher{updates: make(chan struct{}, 1)}
+	s.watchers[w] = struct{}{}
+	s.lock.Unlock()
+	defer func() {
+		s.lock.Lock()
+		delete(s.watchers, w)
+		s.lock.Unlock()
+	}()
+
+	reqs := make(chan *discoveryv3.DiscoveryRequest)
+	errs := make(chan error, 1)
+	go func() {
+		for {
+			req, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case reqs <- req:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	var (
+		names []string
+		// nonce and version are those of the last response sent.
+		nonce   int
+		version string
+	)
+	for {
+		// force is set if a response must be sent even if the resources
+		// have not changed, as the client requested a new subscription.
+		force := false
+		select {
+		case <-serverCtx.Done():
+			return nil
+		case <-ctx.Done():
+			return nil
+		case err := <-errs:
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		case req := <-reqs:
+			if req.TypeUrl != "" && req.TypeUrl != resourcev3.SecretType {
+				return status.Errorf(codes.InvalidArgument, "unsupported resource type %q", req.TypeUrl)
+			}
+			if req.ResponseNonce != "" {
+				// Requests replying to a response which has since been
+				// superseded are ignored, as the client will reply to
+				// the newer response too.
+				if req.ResponseNonce != strconv.Itoa(nonce) {
+					continue
+				}
+				if req.ErrorDetail != nil {
+					log.Error(errors.New(req.ErrorDetail.Message), "proxy rejected secrets", "node_id", req.GetNode().GetId(), "version", version, "resource_names", names)
+				}
+				if equalNames(names, req.ResourceNames) {
+					continue
+				}
+			}
+			names = req.ResourceNames
+			force = true
+		case <-w.updates:
+		}
+
+		var secrets []*tlsv3.Secret
+		keys := make(map[string]struct{})
+		for _, name := range names {
+			secret, key, err := s.resource(ctx, name)
+			if key != "" {
+				keys[key] = struct{}{}
+			}
+			if err != nil {
+				log.Error(err, "failed to read secret", "resource_name", name)
+				continue
+			}
+			secrets = append(secrets, secret)
+		}
+		s.lock.Lock()
+		w.secrets = keys
+		s.lock.Unlock()
+
+		resp, err := response(secrets)
+		if err != nil {
+			return err
+		}
+		if resp.VersionInfo == version && !force {
+			continue
+		}
+		nonce++
+		resp.Nonce = strconv.Itoa(nonce)
+		if err := stream.Send(resp); err != nil {
+			return err
+		}
+		version = resp.VersionInfo
+		log.V(logf.DebugLevel).Info("sent secrets", "version", version, "resource_names", names)
+	}
+}
+
+// equalNames returns true if a and b hold the same resource names, in any
+// order.
+func equalNames(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	a = append([]string(nil), a...)
+	b = append([]string(nil), b...)
+	sort.Strings(a)
+	sort.Strings(b)
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,420 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package sds
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"io"
+	"testing"
+	"time"
+
+	corev3 "github.com/envoyproxy/go-control-plane/envoy/config/core/v3"
+	tlsv3 "github.com/envoyproxy/go-control-plane/envoy/extensions/transport_sockets/tls/v3"
+	discoveryv3 "github.com/envoyproxy/go-control-plane/envoy/service/discovery/v3"
+	resourcev3 "github.com/envoyproxy/go-control-plane/pkg/resource/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	rpcstatus "google.golang.org/genproto/googleapis/rpc/status"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/proto"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+	"k8s.io/client-go/tools/cache"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+	"github.com/cert-manager/cert-manager/pkg/keybackend"
+	"github.com/cert-manager/cert-manager/pkg/keyencryption"
+)
+
+var (
+	encryptedKey = pem.EncodeToMemory(&pem.Block{Type: keyencryption.PEMBlockType, Bytes: []byte("encrypted")})
+	keyReference = pem.EncodeToMemory(&pem.Block{Type: keybackend.PEMBlockType, Bytes: []byte("reference")})
+)
+
+func newSecret(namespace, name string, managed bool, data map[string][]byte) *corev1.Secret {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+		Data:       data,
+	}
+	if managed {
+		secret.Annotations = map[string]string{cmapi.CertificateNameKey: name}
+	}
+	return secret
+}
+
+func newTestServer(t *testing.T, namespace string, secrets ...*corev1.Secret) (*Server, cache.Indexer) {
+	indexer := cache.NewIndexer(cache.MetaNamespaceKeyFunc, cache.Indexers{cache.NamespaceIndex: cache.MetaNamespaceIndexFunc})
+	for _, secret := range secrets {
+		require.NoError(t, indexer.Add(secret))
+	}
+	s := New(corelisters.NewSecretLister(indexer), namespace, "test")
+	s.decrypt = func(_ context.Context, data []byte) ([]byte, error) {
+		return []byte("decrypted"), nil
+	}
+	return s, indexer
+}
+
+func TestResource(t *testing.T) {
+	secrets := []*corev1.Secret{
+		newSecret("default", "tls", true, map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+			cmmeta.TLSCAKey:         []byte("ca"),
+		}),
+		newSecret("other", "tls", true, map[string][]byte{
+			corev1.TLSCertKey:       []byte("other cert"),
+			corev1.TLSPrivateKeyKey: []byte("other key"),
+		}),
+		newSecret("default", "unmanaged", false, map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		}),
+		newSecret("default", "encrypted", true, map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: encryptedKey,
+		}),
+		newSecret("default", "backend", true, map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: keyReference,
+		}),
+	}
+
+	tests := map[string]struct {
+		namespace    string
+		resourceName string
+		expSecret    *tlsv3.Secret
+		expKey       string
+		expCode      codes.Code
+	}{
+		"a certificate is served with its private key": {
+			resourceName: "default/tls",
+			expSecret:    tlsCertificateSecret("default/tls", "cert", "key"),
+			expKey:       "default/tls",
+		},
+		"a CA certificate is served as a validation context": {
+			resourceName: "ca:default/tls",
+			expSecret: &tlsv3.Secret{
+				Name: "ca:default/tls",
+				Type: &tlsv3.Secret_ValidationContext{
+					ValidationContext: &tlsv3.CertificateValidationContext{TrustedCa: inlineBytes([]byte("ca"))},
+				},
+			},
+			expKey: "default/tls",
+		},
+		"the namespace may be left out if the server has one": {
+			namespace:    "other",
+			resourceName: "tls",
+			expSecret:    tlsCertificateSecret("tls", "other cert", "other key"),
+			expKey:       "other/tls",
+		},
+		"encrypted private keys are decrypted": {
+			resourceName: "default/encrypted",
+			expSecret:    tlsCertificateSecret("default/encrypted", "cert", "decrypted"),
+			expKey:       "default/encrypted",
+		},
+		"the namespace must be set if the server has none": {
+			resourceName: "tls",
+			expCode:      codes.InvalidArgument,
+		},
+		"secrets outside the server's namespace are not served": {
+			namespace:    "other",
+			resourceName: "default/tls",
+			expCode:      codes.InvalidArgument,
+		},
+		"invalid secret names are rejected": {
+			resourceName: "default/Invalid_Name",
+			expCode:      codes.InvalidArgument,
+		},
+		"missing secrets are not found": {
+			resourceName: "default/missing",
+			expKey:       "default/missing",
+			expCode:      codes.NotFound,
+		},
+		"secrets not managed by cert-manager are not served": {
+			resourceName: "default/unmanaged",
+			expKey:       "default/unmanaged",
+			expCode:      codes.NotFound,
+		},
+		"a missing CA certificate is not found": {
+			resourceName: "ca:other/tls",
+			expKey:       "other/tls",
+			expCode:      codes.NotFound,
+		},
+		"private keys held in a key management service are not served": {
+			resourceName: "default/backend",
+			expKey:       "default/backend",
+			expCode:      codes.FailedPrecondition,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			s, _ := newTestServer(t, test.namespace, secrets...)
+			secret, key, err := s.resource(context.Background(), test.resourceName)
+			assert.Equal(t, test.expCode, status.Code(err), "unexpected error: %v", err)
+			assert.True(t, proto.Equal(test.expSecret, secret), "unexpected secret: %v", secret)
+			assert.Equal(t, test.expKey, key)
+		})
+	}
+}
+
+func tlsCertificateSecret(name, cert, key string) *tlsv3.Secret {
+	return &tlsv3.Secret{
+		Name: name,
+		Type: &tlsv3.Secret_TlsCertificate{
+			TlsCertificate: &tlsv3.TlsCertificate{
+				CertificateChain: inlineBytes([]byte(cert)),
+				PrivateKey:       inlineBytes([]byte(key)),
+			},
+		},
+	}
+}
+
+// responseSecrets unmarshals the Secrets held in a response.
+func responseSecrets(t *testing.T, resp *discoveryv3.DiscoveryResponse) []*tlsv3.Secret {
+	t.Helper()
+	var secrets []*tlsv3.Secret
+	for _, resource := range resp.Resources {
+		secret := &tlsv3.Secret{}
+		require.NoError(t, resource.UnmarshalTo(secret))
+		secrets = append(secrets, secret)
+	}
+	return secrets
+}
+
+func TestPrivateKeyDecryptionIsCached(t *testing.T) {
+	secret := newSecret("default", "tls", true, map[string][]byte{
+		corev1.TLSCertKey:       []byte("cert"),
+		corev1.TLSPrivateKeyKey: encryptedKey,
+	})
+	s, indexer := newTestServer(t, "", secret)
+	decrypted := 0
+	s.decrypt = func(_ context.Context, data []byte) ([]byte, error) {
+		decrypted++
+		return []byte("decrypted"), nil
+	}
+
+	for i := 0; i < 2; i++ {
+		_, _, err := s.resource(context.Background(), "default/tls")
+		require.NoError(t, err)
+	}
+	assert.Equal(t, 1, decrypted)
+
+	// the key is decrypted again once it changes
+	secret = secret.DeepCopy()
+	secret.Data[corev1.TLSPrivateKeyKey] = pem.EncodeToMemory(&pem.Block{Type: keyencryption.PEMBlockType, Bytes: []byte("renewed")})
+	require.NoError(t, indexer.Update(secret))
+	_, _, err := s.resource(context.Background(), "default/tls")
+	require.NoError(t, err)
+	assert.Equal(t, 2, decrypted)
+
+	s.decrypt = func(context.Context, []byte) ([]byte, error) {
+		return nil, errors.New("kms unavailable")
+	}
+	s.EventHandler().OnDelete(secret)
+	_, _, err = s.resource(context.Background(), "default/tls")
+	assert.Equal(t, codes.Unavailable, status.Code(err), "unexpected error: %v", err)
+}
+
+func TestFetchSecrets(t *testing.T) {
+	s, _ := newTestServer(t, "", newSecret("default", "tls", true, map[string][]byte{
+		corev1.TLSCertKey:       []byte("cert"),
+		corev1.TLSPrivateKeyKey: []byte("key"),
+		cmmeta.TLSCAKey:         []byte("ca"),
+	}))
+	ctx := context.Background()
+
+	resp, err := s.FetchSecrets(ctx, &discoveryv3.DiscoveryRequest{ResourceNames: []string{"default/tls", "ca:default/tls"}, TypeUrl: resourcev3.SecretType})
+	require.NoError(t, err)
+	secrets := responseSecrets(t, resp)
+	require.Len(t, secrets, 2)
+	assert.Equal(t, "default/tls", secrets[0].Name)
+	assert.Equal(t, "ca:default/tls", secrets[1].Name)
+	assert.Equal(t, resourcev3.SecretType, resp.TypeUrl)
+	assert.NotEmpty(t, resp.VersionInfo)
+
+	_, err = s.FetchSecrets(ctx, &discoveryv3.DiscoveryRequest{ResourceNames: []string{"default/tls", "default/missing"}})
+	assert.Equal(t, codes.NotFound, status.Code(err), "unexpected error: %v", err)
+
+	_, err = s.FetchSecrets(ctx, &discoveryv3.DiscoveryRequest{ResourceNames: []string{"default/tls"}, TypeUrl: "type.googleapis.com/envoy.config.cluster.v3.Cluster"})
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error: %v", err)
+}
+
+type fakeStream struct {
+	grpc.ServerStream
+
+	ctx   context.Context
+	reqs  chan *discoveryv3.DiscoveryRequest
+	resps chan *discoveryv3.DiscoveryResponse
+}
+
+func (f *fakeStream) Context() context.Context {
+	return f.ctx
+}
+
+func (f *fakeStream) Send(resp *discoveryv3.DiscoveryResponse) error {
+	f.resps <- resp
+	return nil
+}
+
+func (f *fakeStream) Recv() (*discoveryv3.DiscoveryRequest, error) {
+	req, ok := <-f.reqs
+	if !ok {
+		return nil, io.EOF
+	}
+	return req, nil
+}
+
+func (f *fakeStream) receive(t *testing.T) *discoveryv3.DiscoveryResponse {
+	t.Helper()
+	select {
+	case resp := <-f.resps:
+		return resp
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for response")
+		return nil
+	}
+}
+
+func TestStreamSecrets(t *testing.T) {
+	tlsSecret := newSecret("default", "tls", true, map[string][]byte{
+		corev1.TLSCertKey:       []byte("cert"),
+		corev1.TLSPrivateKeyKey: []byte("key"),
+		cmmeta.TLSCAKey:         []byte("ca"),
+	})
+	otherSecret := newSecret("default", "other", true, map[string][]byte{
+		corev1.TLSCertKey:       []byte("other cert"),
+		corev1.TLSPrivateKeyKey: []byte("other key"),
+	})
+	s, indexer := newTestServer(t, "default", tlsSecret, otherSecret)
+	handler := s.EventHandler()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	stream := &fakeStream{
+		ctx:   ctx,
+		reqs:  make(chan *discoveryv3.DiscoveryRequest),
+		resps: make(chan *discoveryv3.DiscoveryResponse),
+	}
+	errCh := make(chan error, 1)
+	go func() {
+		errCh <- s.StreamSecrets(stream)
+	}()
+
+	// The initial request is answered with the resources that can be
+	// served.
+	stream.reqs <- &discoveryv3.DiscoveryRequest{Node: &corev3.Node{Id: "node"}, ResourceNames: []string{"tls", "ca:tls", "missing"}, TypeUrl: resourcev3.SecretType}
+	resp := stream.receive(t)
+	assert.Equal(t, "1", resp.Nonce)
+	secrets := responseSecrets(t, resp)
+	require.Len(t, secrets, 2)
+	assert.Equal(t, []byte("cert"), secrets[0].GetTlsCertificate().GetCertificateChain().GetInlineBytes())
+	assert.Equal(t, []byte("ca"), secrets[1].GetValidationContext().GetTrustedCa().GetInlineBytes())
+	version := resp.VersionInfo
+	stream.reqs <- &discoveryv3.DiscoveryRequest{VersionInfo: version, ResourceNames: []string{"tls", "ca:tls", "missing"}, TypeUrl: resourcev3.SecretType, ResponseNonce: "1"}
+
+	// Changes to Secrets that are not subscribed to, or that do not change
+	// the resources served, are not sent.
+	otherSecret = otherSecret.DeepCopy()
+	otherSecret.Data[corev1.TLSCertKey] = []byte("renewed other cert")
+	require.NoError(t, indexer.Update(otherSecret))
+	handler.OnUpdate(otherSecret, otherSecret)
+	handler.OnUpdate(tlsSecret, tlsSecret)
+
+	// Renewed certificates are pushed.
+	tlsSecret = tlsSecret.DeepCopy()
+	tlsSecret.Data[corev1.TLSCertKey] = []byte("renewed cert")
+	tlsSecret.Data[corev1.TLSPrivateKeyKey] = []byte("renewed key")
+	require.NoError(t, indexer.Update(tlsSecret))
+	handler.OnUpdate(tlsSecret, tlsSecret)
+	resp = stream.receive(t)
+	assert.Equal(t, "2", resp.Nonce)
+	assert.NotEqual(t, version, resp.VersionInfo)
+	secrets = responseSecrets(t, resp)
+	require.Len(t, secrets, 2)
+	assert.True(t, proto.Equal(tlsCertificateSecret("tls", "renewed cert", "renewed key"), secrets[0]), "unexpected secret: %v", secrets[0])
+	version = resp.VersionInfo
+
+	// Secrets which could not be served are pushed once they are created.
+	missingSecret := newSecret("default", "missing", true, map[string][]byte{
+		corev1.TLSCertKey:       []byte("missing cert"),
+		corev1.TLSPrivateKeyKey: []byte("missing key"),
+	})
+	require.NoError(t, indexer.Add(missingSecret))
+	handler.OnAdd(missingSecret)
+	resp = stream.receive(t)
+	assert.Equal(t, "3", resp.Nonce)
+	secrets = responseSecrets(t, resp)
+	require.Len(t, secrets, 3)
+	assert.Equal(t, "missing", secrets[2].Name)
+
+	// Replies to superseded responses are ignored, and rejections are only
+	// logged.
+	stream.reqs <- &discoveryv3.DiscoveryRequest{VersionInfo: version, ResourceNames: []string{"tls"}, TypeUrl: resourcev3.SecretType, ResponseNonce: "2"}
+	stream.reqs <- &discoveryv3.DiscoveryRequest{
+		VersionInfo:   version,
+		ResourceNames: []string{"tls", "ca:tls", "missing"},
+		TypeUrl:       resourcev3.SecretType,
+		ResponseNonce: "3",
+		ErrorDetail:   &rpcstatus.Status{Code: int32(codes.InvalidArgument), Message: "bad certificate"},
+	}
+
+	// Changing the subscription is answered even if the resources did not
+	// change.
+	stream.reqs <- &discoveryv3.DiscoveryRequest{VersionInfo: version, ResourceNames: []string{"tls"}, TypeUrl: resourcev3.SecretType, ResponseNonce: "3"}
+	resp = stream.receive(t)
+	assert.Equal(t, "4", resp.Nonce)
+	secrets = responseSecrets(t, resp)
+	require.Len(t, secrets, 1)
+	assert.Equal(t, "tls", secrets[0].Name)
+
+	// Secrets which are no longer subscribed to are not pushed.
+	require.NoError(t, indexer.Delete(missingSecret))
+	handler.OnDelete(missingSecret)
+	require.NoError(t, indexer.Delete(tlsSecret))
+	handler.OnDelete(tlsSecret)
+	resp = stream.receive(t)
+	assert.Equal(t, "5", resp.Nonce)
+	assert.Empty(t, resp.Resources)
+
+	close(stream.reqs)
+	select {
+	case err := <-errCh:
+		assert.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for stream to end")
+	}
+}
+
+func TestStreamSecretsInvalidType(t *testing.T) {
+	s, _ := newTestServer(t, "default")
+	stream := &fakeStream{
+		ctx:   context.Background(),
+		reqs:  make(chan *discoveryv3.DiscoveryRequest, 1),
+		resps: make(chan *discoveryv3.DiscoveryResponse, 1),
+	}
+	stream.reqs <- &discoveryv3.DiscoveryRequest{ResourceNames: []string{"tls"}, TypeUrl: "type.googleapis.com/envoy.config.cluster.v3.Cluster"}
+	close(stream.reqs)
+	err := s.StreamSecrets(stream)
+	assert.Equal(t, codes.InvalidArgument, status.Code(err), "unexpected error: %v", err)
+}
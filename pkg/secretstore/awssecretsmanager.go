@@ -0,0 +1,122 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/aws/aws-sdk-go/service/sts"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+type awsSecretsManagerStore struct {
+	client   secretsmanageriface.SecretsManagerAPI
+	secretID string
+}
+
+func newAWSSecretsManager(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.AWSSecretsManagerSecretStore, ambient bool, userAgent string) (Interface, error) {
+	sessionOpts := session.Options{
+		Config: *aws.NewConfig().WithRegion(cfg.Region),
+	}
+
+	if cfg.Auth == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no credentials configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the default credential chain
+		// being used.
+	} else {
+		accessKeyID, err := readSecretKey(secretsLister, namespace, cfg.Auth.AccessKeyIDSecretRef)
+		if err != nil {
+			return nil, err
+		}
+		secretAccessKey, err := readSecretKey(secretsLister, namespace, cfg.Auth.SecretAccessKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentials(
+			strings.TrimSpace(string(accessKeyID)), strings.TrimSpace(string(secretAccessKey)), "")
+		// also disable 'ambient' region sources
+		sessionOpts.SharedConfigState = session.SharedConfigDisable
+	}
+
+	sess, err := session.NewSessionWithOptions(sessionOpts)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create aws session: %v", err)
+	}
+
+	if cfg.Role != "" {
+		result, err := sts.New(sess).AssumeRole(&sts.AssumeRoleInput{
+			RoleArn:         aws.String(cfg.Role),
+			RoleSessionName: aws.String("cert-manager"),
+		})
+		if err != nil {
+			return nil, fmt.Errorf("unable to assume role %q: %v", cfg.Role, err)
+		}
+
+		sessionOpts.Config.Credentials = credentials.NewStaticCredentialsFromCreds(credentials.Value{
+			AccessKeyID:     *result.Credentials.AccessKeyId,
+			SecretAccessKey: *result.Credentials.SecretAccessKey,
+			SessionToken:    *result.Credentials.SessionToken,
+		})
+		sess, err = session.NewSessionWithOptions(sessionOpts)
+		if err != nil {
+			return nil, fmt.Errorf("unable to create aws session: %v", err)
+		}
+	}
+
+	sess.Handlers.Build.PushBack(request.WithAppendUserAgent(userAgent))
+	return &awsSecretsManagerStore{client: secretsmanager.New(sess), secretID: cfg.SecretID}, nil
+}
+
+// Publish puts data as a new version of the secret, creating the secret if
+// it does not exist yet.
+func (a *awsSecretsManagerStore) Publish(ctx context.Context, data Data) error {
+	value, err := data.marshal()
+	if err != nil {
+		return err
+	}
+
+	_, err = a.client.PutSecretValueWithContext(ctx, &secretsmanager.PutSecretValueInput{
+		SecretId:     aws.String(a.secretID),
+		SecretString: aws.String(string(value)),
+	})
+	var aerr awserr.Error
+	if errors.As(err, &aerr) && aerr.Code() == secretsmanager.ErrCodeResourceNotFoundException {
+		_, err = a.client.CreateSecretWithContext(ctx, &secretsmanager.CreateSecretInput{
+			Name:         aws.String(a.secretID),
+			SecretString: aws.String(string(value)),
+		})
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write secret to AWS Secrets Manager: %v", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,39 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package fake contains a fake secret store for use in tests.
+package fake
+
+import (
+	"context"
+
+	"github.com/cert-manager/cert-manager/pkg/secretstore"
+)
+
+// Store is a fake secret store. Publish returns nil unless PublishFn is set.
+type Store struct {
+	PublishFn func(context.Context, secretstore.Data) error
+}
+
+var _ secretstore.Interface = &Store{}
+
+// Publish implements secretstore.Interface.
+func (s *Store) Publish(ctx context.Context, data secretstore.Data) error {
+	if s.PublishFn != nil {
+		return s.PublishFn(ctx, data)
+	}
+	return nil
+}
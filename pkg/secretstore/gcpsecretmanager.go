@@ -0,0 +1,126 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"golang.org/x/oauth2/google"
+	"google.golang.org/api/googleapi"
+	"google.golang.org/api/option"
+	secretmanager "google.golang.org/api/secretmanager/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// gcpSecretManagerClient is the subset of the Google Cloud Secret Manager API
+// used to publish certificates.
+type gcpSecretManagerClient interface {
+	// AddSecretVersion adds payload as a new version of the secret with the
+	// given full resource name.
+	AddSecretVersion(ctx context.Context, name string, payload []byte) error
+
+	// CreateSecret creates a secret with automatic replication in project.
+	CreateSecret(ctx context.Context, project, secretID string) error
+}
+
+type gcpSecretManagerStore struct {
+	client gcpSecretManagerClient
+	cfg    *cmapi.GCPSecretManagerSecretStore
+}
+
+// gcpSecretName returns the full resource name of the secret of cfg.
+func gcpSecretName(cfg *cmapi.GCPSecretManagerSecretStore) string {
+	return fmt.Sprintf("projects/%s/secrets/%s", cfg.Project, cfg.SecretID)
+}
+
+func newGCPSecretManager(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.GCPSecretManagerSecretStore, ambient bool, userAgent string) (Interface, error) {
+	ctx := context.Background()
+	opts := []option.ClientOption{option.WithUserAgent(userAgent)}
+
+	if cfg.ServiceAccountKeySecretRef == nil {
+		if !ambient {
+			return nil, fmt.Errorf("no service account key configured and ambient credentials are not permitted")
+		}
+		// Leaving credentials unset results in the application default
+		// credentials being used, which includes GKE workload identity.
+	} else {
+		key, err := readSecretKey(secretsLister, namespace, *cfg.ServiceAccountKeySecretRef)
+		if err != nil {
+			return nil, err
+		}
+		creds, err := google.CredentialsFromJSON(ctx, key, secretmanager.CloudPlatformScope)
+		if err != nil {
+			return nil, fmt.Errorf("unable to parse service account key in secret '%s/%s': %v", namespace, cfg.ServiceAccountKeySecretRef.Name, err)
+		}
+		opts = append(opts, option.WithCredentials(creds))
+	}
+
+	svc, err := secretmanager.NewService(ctx, opts...)
+	if err != nil {
+		return nil, fmt.Errorf("unable to create Google Cloud Secret Manager client: %v", err)
+	}
+
+	return &gcpSecretManagerStore{client: &gcpSecretManagerService{svc: svc}, cfg: cfg}, nil
+}
+
+// Publish adds data as a new version of the secret, creating the secret if
+// it does not exist yet.
+func (g *gcpSecretManagerStore) Publish(ctx context.Context, data Data) error {
+	value, err := data.marshal()
+	if err != nil {
+		return err
+	}
+
+	name := gcpSecretName(g.cfg)
+	err = g.client.AddSecretVersion(ctx, name, value)
+	var gerr *googleapi.Error
+	if errors.As(err, &gerr) && gerr.Code == http.StatusNotFound {
+		if err := g.client.CreateSecret(ctx, g.cfg.Project, g.cfg.SecretID); err != nil {
+			return fmt.Errorf("failed to create secret in Google Cloud Secret Manager: %v", err)
+		}
+		err = g.client.AddSecretVersion(ctx, name, value)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write secret to Google Cloud Secret Manager: %v", err)
+	}
+
+	return nil
+}
+
+type gcpSecretManagerService struct {
+	svc *secretmanager.Service
+}
+
+func (g *gcpSecretManagerService) AddSecretVersion(ctx context.Context, name string, payload []byte) error {
+	_, err := g.svc.Projects.Secrets.AddVersion(name, &secretmanager.AddSecretVersionRequest{
+		Payload: &secretmanager.SecretPayload{Data: base64.StdEncoding.EncodeToString(payload)},
+	}).Context(ctx).Do()
+	return err
+}
+
+func (g *gcpSecretManagerService) CreateSecret(ctx context.Context, project, secretID string) error {
+	_, err := g.svc.Projects.Secrets.Create("projects/"+project, &secretmanager.Secret{
+		Replication: &secretmanager.Replication{Automatic: &secretmanager.Automatic{}},
+	}).SecretId(secretID).Context(ctx).Do()
+	return err
+}
@@ -0,0 +1,110 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package secretstore publishes issued certificates to secret stores outside
+// of the cluster, so that workloads running elsewhere can share the same PKI.
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path"
+
+	corev1 "k8s.io/api/core/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
+)
+
+// Data is the content of a Certificate's Secret that is published to secret
+// stores.
+type Data struct {
+	Certificate []byte
+	PrivateKey  []byte
+	CA          []byte
+}
+
+// Interface publishes certificates to a single secret store.
+type Interface interface {
+	// Publish writes data as a new version of the configured secret,
+	// creating the secret if the store requires it.
+	Publish(ctx context.Context, data Data) error
+}
+
+// Builder constructs a client for a secret store configured on a Certificate.
+// Credentials are read from Secrets in namespace; if none are configured,
+// ambient credentials are used when ambient is true.
+type Builder func(namespace string, secretsLister corelisters.SecretLister,
+	store *cmapi.CertificateExternalSecretStore, ambient bool, userAgent string) (Interface, error)
+
+var _ Builder = New
+
+// New constructs a client for the given secret store.
+func New(namespace string, secretsLister corelisters.SecretLister, store *cmapi.CertificateExternalSecretStore, ambient bool, userAgent string) (Interface, error) {
+	switch {
+	case store.Vault != nil:
+		return newVault(namespace, secretsLister, store.Vault)
+	case store.AWSSecretsManager != nil:
+		return newAWSSecretsManager(namespace, secretsLister, store.AWSSecretsManager, ambient, userAgent)
+	case store.GCPSecretManager != nil:
+		return newGCPSecretManager(namespace, secretsLister, store.GCPSecretManager, ambient, userAgent)
+	}
+	return nil, errors.New("no secret store configured")
+}
+
+// Describe returns a short description of the secret a store publishes to,
+// for use in events and log messages.
+func Describe(store *cmapi.CertificateExternalSecretStore) string {
+	switch {
+	case store.Vault != nil:
+		return fmt.Sprintf("Vault secret %q", path.Join(store.Vault.Mount, store.Vault.Path))
+	case store.AWSSecretsManager != nil:
+		return fmt.Sprintf("AWS Secrets Manager secret %q", store.AWSSecretsManager.SecretID)
+	case store.GCPSecretManager != nil:
+		return fmt.Sprintf("Google Cloud Secret Manager secret %q", gcpSecretName(store.GCPSecretManager))
+	}
+	return "unknown secret store"
+}
+
+// values returns data keyed in the same way as in the Certificate's Secret.
+func (d Data) values() map[string]string {
+	return map[string]string{
+		corev1.TLSCertKey:       string(d.Certificate),
+		corev1.TLSPrivateKeyKey: string(d.PrivateKey),
+		cmmeta.TLSCAKey:         string(d.CA),
+	}
+}
+
+// marshal returns data as a JSON object, for stores that hold a single value
+// per secret.
+func (d Data) marshal() ([]byte, error) {
+	return json.Marshal(d.values())
+}
+
+func readSecretKey(secretsLister corelisters.SecretLister, namespace string, ref cmmeta.SecretKeySelector) ([]byte, error) {
+	secret, err := secretsLister.Secrets(namespace).Get(ref.Name)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[ref.Key]
+	if !ok {
+		return nil, fmt.Errorf("no data for %q in secret '%s/%s'", ref.Key, namespace, ref.Name)
+	}
+	return value, nil
+}
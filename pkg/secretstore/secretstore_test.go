@@ -0,0 +1,225 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/awserr"
+	"github.com/aws/aws-sdk-go/aws/request"
+	"github.com/aws/aws-sdk-go/service/secretsmanager"
+	"github.com/aws/aws-sdk-go/service/secretsmanager/secretsmanageriface"
+	"github.com/stretchr/testify/assert"
+	"google.golang.org/api/googleapi"
+
+	vaultfake "github.com/cert-manager/cert-manager/internal/vault/fake"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var testData = Data{
+	Certificate: []byte("cert"),
+	PrivateKey:  []byte("key"),
+	CA:          []byte("ca"),
+}
+
+var testValues = map[string]string{
+	"tls.crt": "cert",
+	"tls.key": "key",
+	"ca.crt":  "ca",
+}
+
+func TestVaultPublish(t *testing.T) {
+	var gotMount, gotPath string
+	var gotData map[string]string
+	client := vaultfake.New()
+	client.WriteKVFn = func(mount, secretPath string, data map[string]string) error {
+		gotMount, gotPath, gotData = mount, secretPath, data
+		return nil
+	}
+
+	store := &vaultStore{client: client, mount: "secret", path: "my-app/tls"}
+	if err := store.Publish(context.Background(), testData); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	assert.Equal(t, "secret", gotMount)
+	assert.Equal(t, "my-app/tls", gotPath)
+	assert.Equal(t, testValues, gotData)
+}
+
+type fakeSecretsManager struct {
+	secretsmanageriface.SecretsManagerAPI
+
+	putErr  error
+	put     []string
+	created []string
+}
+
+func (f *fakeSecretsManager) PutSecretValueWithContext(_ aws.Context, in *secretsmanager.PutSecretValueInput, _ ...request.Option) (*secretsmanager.PutSecretValueOutput, error) {
+	if f.putErr != nil {
+		return nil, f.putErr
+	}
+	f.put = append(f.put, *in.SecretString)
+	return &secretsmanager.PutSecretValueOutput{}, nil
+}
+
+func (f *fakeSecretsManager) CreateSecretWithContext(_ aws.Context, in *secretsmanager.CreateSecretInput, _ ...request.Option) (*secretsmanager.CreateSecretOutput, error) {
+	f.created = append(f.created, *in.Name)
+	return &secretsmanager.CreateSecretOutput{}, nil
+}
+
+func TestAWSSecretsManagerPublish(t *testing.T) {
+	tests := map[string]struct {
+		putErr      error
+		expPut      bool
+		expCreated  []string
+		expectedErr bool
+	}{
+		"an existing secret gets a new version": {
+			expPut: true,
+		},
+		"a missing secret is created": {
+			putErr:     awserr.New(secretsmanager.ErrCodeResourceNotFoundException, "not found", nil),
+			expCreated: []string{"my-app/tls"},
+		},
+		"other errors are returned": {
+			putErr:      awserr.New(secretsmanager.ErrCodeInternalServiceError, "internal error", nil),
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			client := &fakeSecretsManager{putErr: test.putErr}
+			store := &awsSecretsManagerStore{client: client, secretID: "my-app/tls"}
+
+			err := store.Publish(context.Background(), testData)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("expected error %t, got %v", test.expectedErr, err)
+			}
+
+			assert.Equal(t, test.expCreated, client.created)
+			if test.expPut {
+				var got map[string]string
+				if assert.Len(t, client.put, 1) && assert.NoError(t, json.Unmarshal([]byte(client.put[0]), &got)) {
+					assert.Equal(t, testValues, got)
+				}
+			}
+		})
+	}
+}
+
+type fakeGCPSecretManager struct {
+	exists    bool
+	addErr    error
+	versions  [][]byte
+	createdIn string
+}
+
+func (f *fakeGCPSecretManager) AddSecretVersion(_ context.Context, name string, payload []byte) error {
+	if f.addErr != nil {
+		return f.addErr
+	}
+	if !f.exists {
+		return &googleapi.Error{Code: http.StatusNotFound}
+	}
+	f.versions = append(f.versions, payload)
+	return nil
+}
+
+func (f *fakeGCPSecretManager) CreateSecret(_ context.Context, project, secretID string) error {
+	f.exists = true
+	f.createdIn = project + "/" + secretID
+	return nil
+}
+
+func TestGCPSecretManagerPublish(t *testing.T) {
+	tests := map[string]struct {
+		client      *fakeGCPSecretManager
+		expCreated  string
+		expVersions int
+		expectedErr bool
+	}{
+		"an existing secret gets a new version": {
+			client:      &fakeGCPSecretManager{exists: true},
+			expVersions: 1,
+		},
+		"a missing secret is created before adding the version": {
+			client:      &fakeGCPSecretManager{},
+			expCreated:  "my-project/my-app-tls",
+			expVersions: 1,
+		},
+		"other errors are returned": {
+			client:      &fakeGCPSecretManager{exists: true, addErr: errors.New("internal error")},
+			expectedErr: true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			store := &gcpSecretManagerStore{
+				client: test.client,
+				cfg:    &cmapi.GCPSecretManagerSecretStore{Project: "my-project", SecretID: "my-app-tls"},
+			}
+
+			err := store.Publish(context.Background(), testData)
+			if (err != nil) != test.expectedErr {
+				t.Fatalf("expected error %t, got %v", test.expectedErr, err)
+			}
+
+			assert.Equal(t, test.expCreated, test.client.createdIn)
+			assert.Len(t, test.client.versions, test.expVersions)
+			for _, v := range test.client.versions {
+				var got map[string]string
+				if assert.NoError(t, json.Unmarshal(v, &got)) {
+					assert.Equal(t, testValues, got)
+				}
+			}
+		})
+	}
+}
+
+func TestDescribe(t *testing.T) {
+	tests := map[string]struct {
+		store *cmapi.CertificateExternalSecretStore
+		exp   string
+	}{
+		"vault": {
+			store: &cmapi.CertificateExternalSecretStore{Vault: &cmapi.VaultSecretStore{Mount: "secret", Path: "my-app/tls"}},
+			exp:   `Vault secret "secret/my-app/tls"`,
+		},
+		"aws secrets manager": {
+			store: &cmapi.CertificateExternalSecretStore{AWSSecretsManager: &cmapi.AWSSecretsManagerSecretStore{SecretID: "my-app/tls"}},
+			exp:   `AWS Secrets Manager secret "my-app/tls"`,
+		},
+		"gcp secret manager": {
+			store: &cmapi.CertificateExternalSecretStore{GCPSecretManager: &cmapi.GCPSecretManagerSecretStore{Project: "my-project", SecretID: "my-app-tls"}},
+			exp:   `Google Cloud Secret Manager secret "projects/my-project/secrets/my-app-tls"`,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.exp, Describe(test.store))
+		})
+	}
+}
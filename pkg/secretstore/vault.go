@@ -0,0 +1,69 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package secretstore
+
+import (
+	"context"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	corelisters "k8s.io/client-go/listers/core/v1"
+
+	internalvault "github.com/cert-manager/cert-manager/internal/vault"
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// kvWriter is the subset of the Vault client used to publish certificates.
+type kvWriter interface {
+	WriteKV(mount, secretPath string, data map[string]string) error
+}
+
+type vaultStore struct {
+	client kvWriter
+	mount  string
+	path   string
+}
+
+func newVault(namespace string, secretsLister corelisters.SecretLister, cfg *cmapi.VaultSecretStore) (Interface, error) {
+	// The Vault client authenticates in the same way as a Vault issuer, so
+	// the store is presented to it as an issuer in the Certificate's
+	// namespace. Tokens are not cached, as certificates are published
+	// infrequently.
+	issuer := &cmapi.Issuer{
+		ObjectMeta: metav1.ObjectMeta{Namespace: namespace},
+		Spec: cmapi.IssuerSpec{
+			IssuerConfig: cmapi.IssuerConfig{
+				Vault: &cmapi.VaultIssuer{
+					Auth:      cfg.Auth,
+					Server:    cfg.Server,
+					Namespace: cfg.Namespace,
+					CABundle:  cfg.CABundle,
+				},
+			},
+		},
+	}
+
+	client, err := internalvault.New(namespace, secretsLister, issuer, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return &vaultStore{client: client, mount: cfg.Mount, path: cfg.Path}, nil
+}
+
+func (v *vaultStore) Publish(_ context.Context, data Data) error {
+	return v.client.WriteKV(v.mount, v.path, data.values())
+}
@@ -0,0 +1,97 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/component-base/featuregate"
+)
+
+var (
+	runtimeToggleableLock sync.RWMutex
+	runtimeToggleable     = map[featuregate.Feature]bool{}
+)
+
+// SetRuntimeToggleable marks the given features as safe to enable or disable
+// while a component is running. Only features that do not affect the API, or
+// any state that is computed once at startup (such as which controllers or
+// informers are started), should be marked as runtime toggleable.
+func SetRuntimeToggleable(features ...featuregate.Feature) {
+	runtimeToggleableLock.Lock()
+	defer runtimeToggleableLock.Unlock()
+	for _, f := range features {
+		runtimeToggleable[f] = true
+	}
+}
+
+// IsRuntimeToggleable returns true if the given feature may be changed while a
+// component is running.
+func IsRuntimeToggleable(f featuregate.Feature) bool {
+	runtimeToggleableLock.RLock()
+	defer runtimeToggleableLock.RUnlock()
+	return runtimeToggleable[f]
+}
+
+// SetRuntimeFromMap applies the values of the runtime toggleable features in m
+// to DefaultMutableFeatureGate, resetting runtime toggleable features that are
+// not present in m to their default. Any other feature whose value in m differs
+// from its current value is left unchanged, and is reported in the returned
+// error as requiring a restart to take effect.
+func SetRuntimeFromMap(m map[string]bool) error {
+	known := DefaultMutableFeatureGate.GetAll()
+
+	want := make(map[string]bool, len(m))
+	for f, spec := range known {
+		if _, ok := m[string(f)]; !ok && IsRuntimeToggleable(f) {
+			want[string(f)] = spec.Default
+		}
+	}
+	for name, enabled := range m {
+		want[name] = enabled
+	}
+
+	toggle := make(map[string]bool)
+	var restartRequired []string
+	for name, enabled := range want {
+		f := featuregate.Feature(name)
+		if _, ok := known[f]; !ok {
+			return fmt.Errorf("unrecognized feature gate: %s", name)
+		}
+		if DefaultMutableFeatureGate.Enabled(f) == enabled {
+			continue
+		}
+		if !IsRuntimeToggleable(f) {
+			restartRequired = append(restartRequired, name)
+			continue
+		}
+		toggle[name] = enabled
+	}
+
+	if err := DefaultMutableFeatureGate.SetFromMap(toggle); err != nil {
+		return err
+	}
+
+	if len(restartRequired) > 0 {
+		sort.Strings(restartRequired)
+		return fmt.Errorf("changes to feature gates %s require a restart to take effect", strings.Join(restartRequired, ", "))
+	}
+	return nil
+}
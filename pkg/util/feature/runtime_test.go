@@ -0,0 +1,84 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"testing"
+
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	testSafeFeature   featuregate.Feature = "TestSafeFeature"
+	testUnsafeFeature featuregate.Feature = "TestUnsafeFeature"
+)
+
+func init() {
+	if err := DefaultMutableFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		testSafeFeature:   {Default: false, PreRelease: featuregate.Alpha},
+		testUnsafeFeature: {Default: false, PreRelease: featuregate.Beta},
+	}); err != nil {
+		panic(err)
+	}
+	SetRuntimeToggleable(testSafeFeature)
+}
+
+func TestSetRuntimeFromMap(t *testing.T) {
+	defer func() {
+		if err := DefaultMutableFeatureGate.SetFromMap(map[string]bool{
+			string(testSafeFeature):   false,
+			string(testUnsafeFeature): false,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}()
+
+	err := SetRuntimeFromMap(map[string]bool{
+		string(testSafeFeature):   true,
+		string(testUnsafeFeature): true,
+	})
+	if err == nil || err.Error() != "changes to feature gates TestUnsafeFeature require a restart to take effect" {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if !DefaultFeatureGate.Enabled(testSafeFeature) {
+		t.Errorf("expected %s to be enabled", testSafeFeature)
+	}
+	if DefaultFeatureGate.Enabled(testUnsafeFeature) {
+		t.Errorf("expected %s to not be changed at runtime", testUnsafeFeature)
+	}
+
+	// Setting a feature that is not runtime toggleable to its current value
+	// is not an error.
+	if err := SetRuntimeFromMap(map[string]bool{string(testSafeFeature): true, string(testUnsafeFeature): false}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+
+	if err := SetRuntimeFromMap(map[string]bool{"DoesNotExist": true}); err == nil {
+		t.Errorf("expected error for unknown feature gate")
+	}
+	if !DefaultFeatureGate.Enabled(testSafeFeature) {
+		t.Errorf("expected %s to be unchanged when the map contains an unknown feature gate", testSafeFeature)
+	}
+
+	// Removing a runtime toggleable feature resets it to its default.
+	if err := SetRuntimeFromMap(map[string]bool{}); err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+	if DefaultFeatureGate.Enabled(testSafeFeature) {
+		t.Errorf("expected %s to be reset to its default", testSafeFeature)
+	}
+}
@@ -0,0 +1,64 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"fmt"
+	"io"
+	"sort"
+)
+
+// Status describes the current state of a single feature gate.
+type Status struct {
+	Name              string
+	Enabled           bool
+	PreRelease        string
+	RuntimeToggleable bool
+}
+
+// Statuses returns the status of all features known to
+// DefaultMutableFeatureGate, sorted by name.
+func Statuses() []Status {
+	var statuses []Status
+	for f, spec := range DefaultMutableFeatureGate.GetAll() {
+		statuses = append(statuses, Status{
+			Name:              string(f),
+			Enabled:           DefaultMutableFeatureGate.Enabled(f),
+			PreRelease:        string(spec.PreRelease),
+			RuntimeToggleable: IsRuntimeToggleable(f),
+		})
+	}
+	sort.Slice(statuses, func(i, j int) bool {
+		return statuses[i].Name < statuses[j].Name
+	})
+	return statuses
+}
+
+// WriteStatuses writes a human readable summary of all feature gates to w, one
+// per line, for use in verbose health check output.
+func WriteStatuses(w io.Writer) error {
+	for _, s := range Statuses() {
+		line := fmt.Sprintf("[feature-gate] %s=%t (%s", s.Name, s.Enabled, s.PreRelease)
+		if s.RuntimeToggleable {
+			line += ", runtime toggleable"
+		}
+		if _, err := fmt.Fprintln(w, line+")"); err != nil {
+			return err
+		}
+	}
+	return nil
+}
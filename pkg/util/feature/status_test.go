@@ -0,0 +1,54 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package feature
+
+import (
+	"bytes"
+	"testing"
+
+	"k8s.io/component-base/featuregate"
+)
+
+const (
+	testAlphaFeature featuregate.Feature = "TestAlphaFeature"
+	testBetaFeature  featuregate.Feature = "TestBetaFeature"
+)
+
+func init() {
+	if err := DefaultMutableFeatureGate.Add(map[featuregate.Feature]featuregate.FeatureSpec{
+		testAlphaFeature: {Default: false, PreRelease: featuregate.Alpha},
+		testBetaFeature:  {Default: false, PreRelease: featuregate.Beta},
+	}); err != nil {
+		panic(err)
+	}
+}
+
+func TestWriteStatuses(t *testing.T) {
+	var buf bytes.Buffer
+	if err := WriteStatuses(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{
+		"[feature-gate] TestAlphaFeature=false (ALPHA)\n",
+		"[feature-gate] TestBetaFeature=false (BETA)\n",
+		"[feature-gate] TestSafeFeature=false (ALPHA, runtime toggleable)\n",
+	} {
+		if !bytes.Contains(buf.Bytes(), []byte(line)) {
+			t.Errorf("expected output to contain %q, got:\n%s", line, buf.String())
+		}
+	}
+}
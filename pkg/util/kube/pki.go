@@ -114,6 +114,39 @@ func SecretTLSKeyPairAndCA(ctx context.Context, secretLister corelisters.SecretL
 	return append(certs, ca), key, nil
 }
 
+// SecretCACertificates returns the X.509 certificates stored in the tls.crt and
+// ca.crt fields of each of the named Secrets in the target namespace, in the
+// order the Secrets are named. Each Secret must contain at least one of these
+// fields.
+func SecretCACertificates(ctx context.Context, secretLister corelisters.SecretLister, namespace string, names []string) ([]*x509.Certificate, error) {
+	var certs []*x509.Certificate
+	for _, name := range names {
+		secret, err := secretLister.Secrets(namespace).Get(name)
+		if err != nil {
+			return nil, err
+		}
+
+		found := false
+		for _, key := range []string{corev1.TLSCertKey, cmmeta.TLSCAKey} {
+			certBytes, ok := secret.Data[key]
+			if !ok || len(certBytes) == 0 {
+				continue
+			}
+			chain, err := pki.DecodeX509CertificateChainBytes(certBytes)
+			if err != nil {
+				return nil, errors.NewInvalidData("failed to decode %q in secret '%s/%s': %s", key, namespace, name, err)
+			}
+			certs = append(certs, chain...)
+			found = true
+		}
+		if !found {
+			return nil, errors.NewInvalidData("no certificate data for %q or %q in secret '%s/%s'", corev1.TLSCertKey, cmmeta.TLSCAKey, namespace, name)
+		}
+	}
+
+	return certs, nil
+}
+
 func SecretTLSKeyPair(ctx context.Context, secretLister corelisters.SecretLister, namespace, name string) ([]*x509.Certificate, crypto.Signer, error) {
 	secret, err := secretLister.Secrets(namespace).Get(name)
 	if err != nil {
@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"fmt"
+	"strings"
+)
+
+const (
+	// ChainSelectorSHA256Prefix is the prefix used to select a chain by the
+	// SHA-256 fingerprint of one of its certificates.
+	ChainSelectorSHA256Prefix = "sha256:"
+
+	// ChainSelectorSKIPrefix is the prefix used to select a chain by the
+	// Subject Key Identifier of its root CA.
+	ChainSelectorSKIPrefix = "ski:"
+)
+
+// ChainSelector matches certificates in an alternative chain against a
+// preferred chain expression.
+// An expression is either the Common Name of the issuing CA, a SHA-256
+// fingerprint prefixed with "sha256:" or a Subject Key Identifier prefixed
+// with "ski:". Fingerprints and key identifiers are hex encoded and may
+// optionally be separated by colons.
+type ChainSelector struct {
+	commonName  string
+	fingerprint []byte
+	keyID       []byte
+}
+
+// ParseChainSelector parses a preferred chain expression into a ChainSelector.
+func ParseChainSelector(s string) (*ChainSelector, error) {
+	lower := strings.ToLower(s)
+	switch {
+	case strings.HasPrefix(lower, ChainSelectorSHA256Prefix):
+		fingerprint, err := decodeHexID(s[len(ChainSelectorSHA256Prefix):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid SHA-256 fingerprint: %w", err)
+		}
+		if len(fingerprint) != sha256.Size {
+			return nil, fmt.Errorf("invalid SHA-256 fingerprint: expected %d bytes but got %d", sha256.Size, len(fingerprint))
+		}
+		return &ChainSelector{fingerprint: fingerprint}, nil
+	case strings.HasPrefix(lower, ChainSelectorSKIPrefix):
+		keyID, err := decodeHexID(s[len(ChainSelectorSKIPrefix):])
+		if err != nil {
+			return nil, fmt.Errorf("invalid subject key identifier: %w", err)
+		}
+		if len(keyID) == 0 {
+			return nil, fmt.Errorf("invalid subject key identifier: must not be empty")
+		}
+		return &ChainSelector{keyID: keyID}, nil
+	default:
+		return &ChainSelector{commonName: s}, nil
+	}
+}
+
+// MatchesChain returns true if the given chain, ordered from leaf to root, is
+// the one selected.
+// A Common Name selector matches a chain containing a certificate issued by a
+// CA with that Common Name. A fingerprint selector matches a chain containing
+// the certificate with that fingerprint. A key identifier selector matches a
+// chain whose root has that Subject Key Identifier. Only the top certificate
+// of the chain is considered: if it is self-signed its own Subject Key
+// Identifier is used, otherwise the root has been omitted and its Authority
+// Key Identifier is used instead. This means that a cross-signed certificate
+// for a root does not cause the cross-signed chain to be selected by that
+// root's key identifier.
+func (s *ChainSelector) MatchesChain(chain []*x509.Certificate) bool {
+	if len(chain) == 0 {
+		return false
+	}
+	if s.keyID != nil {
+		return bytes.Equal(rootKeyID(chain[len(chain)-1]), s.keyID)
+	}
+	for _, cert := range chain {
+		if s.matches(cert) {
+			return true
+		}
+	}
+	return false
+}
+
+func (s *ChainSelector) matches(cert *x509.Certificate) bool {
+	if s.fingerprint != nil {
+		sum := sha256.Sum256(cert.Raw)
+		return bytes.Equal(sum[:], s.fingerprint)
+	}
+	return cert.Issuer.CommonName == s.commonName
+}
+
+// rootKeyID returns the Subject Key Identifier of the root of a chain whose
+// top certificate is top.
+func rootKeyID(top *x509.Certificate) []byte {
+	if bytes.Equal(top.RawSubject, top.RawIssuer) {
+		return top.SubjectKeyId
+	}
+	return top.AuthorityKeyId
+}
+
+// String returns the expression the selector was parsed from in its canonical
+// form.
+func (s *ChainSelector) String() string {
+	switch {
+	case s.fingerprint != nil:
+		return ChainSelectorSHA256Prefix + hex.EncodeToString(s.fingerprint)
+	case s.keyID != nil:
+		return ChainSelectorSKIPrefix + hex.EncodeToString(s.keyID)
+	default:
+		return s.commonName
+	}
+}
+
+func decodeHexID(s string) ([]byte, error) {
+	return hex.DecodeString(strings.ReplaceAll(s, ":", ""))
+}
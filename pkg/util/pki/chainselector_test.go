@@ -0,0 +1,175 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/hex"
+	"strings"
+	"testing"
+)
+
+func TestChainSelector(t *testing.T) {
+	var (
+		dstKeyID  = []byte{0xd5, 0x7e}
+		isrgKeyID = []byte{0xab, 0xcd, 0xef}
+		r3KeyID   = []byte{0x01, 0x02, 0x03}
+	)
+	leaf := &x509.Certificate{
+		Raw:            []byte("leaf"),
+		RawSubject:     []byte("leaf"),
+		RawIssuer:      []byte("R3"),
+		Issuer:         pkix.Name{CommonName: "R3"},
+		AuthorityKeyId: r3KeyID,
+	}
+	r3 := &x509.Certificate{
+		Raw:            []byte("R3 signed by ISRG Root X1"),
+		RawSubject:     []byte("R3"),
+		RawIssuer:      []byte("ISRG Root X1"),
+		Issuer:         pkix.Name{CommonName: "ISRG Root X1"},
+		SubjectKeyId:   r3KeyID,
+		AuthorityKeyId: isrgKeyID,
+	}
+	isrgRoot := &x509.Certificate{
+		Raw:            []byte("ISRG Root X1"),
+		RawSubject:     []byte("ISRG Root X1"),
+		RawIssuer:      []byte("ISRG Root X1"),
+		Issuer:         pkix.Name{CommonName: "ISRG Root X1"},
+		SubjectKeyId:   isrgKeyID,
+		AuthorityKeyId: isrgKeyID,
+	}
+	// isrgCrossSigned has the same subject and key as isrgRoot, but is
+	// signed by DST Root CA X3.
+	isrgCrossSigned := &x509.Certificate{
+		Raw:            []byte("ISRG Root X1 signed by DST Root CA X3"),
+		RawSubject:     []byte("ISRG Root X1"),
+		RawIssuer:      []byte("DST Root CA X3"),
+		Issuer:         pkix.Name{CommonName: "DST Root CA X3"},
+		SubjectKeyId:   isrgKeyID,
+		AuthorityKeyId: dstKeyID,
+	}
+
+	shortChain := []*x509.Certificate{leaf, r3}
+	shortChainWithRoot := []*x509.Certificate{leaf, r3, isrgRoot}
+	crossSignedChain := []*x509.Certificate{leaf, r3, isrgCrossSigned}
+
+	sum := sha256.Sum256(r3.Raw)
+	fingerprint := hex.EncodeToString(sum[:])
+
+	tests := map[string]struct {
+		expression string
+		chain      []*x509.Certificate
+		expErr     bool
+		expMatch   bool
+	}{
+		"issuer common name matches": {
+			expression: "ISRG Root X1",
+			chain:      shortChain,
+			expMatch:   true,
+		},
+		"issuer common name does not match": {
+			expression: "DST Root CA X3",
+			chain:      shortChain,
+			expMatch:   false,
+		},
+		"issuer common name matches cross-signed chain": {
+			expression: "DST Root CA X3",
+			chain:      crossSignedChain,
+			expMatch:   true,
+		},
+		"fingerprint matches": {
+			expression: "sha256:" + fingerprint,
+			chain:      shortChain,
+			expMatch:   true,
+		},
+		"upper case colon separated fingerprint matches": {
+			expression: "SHA256:" + strings.ToUpper(colonSeparate(fingerprint)),
+			chain:      shortChain,
+			expMatch:   true,
+		},
+		"fingerprint does not match": {
+			expression: "sha256:" + strings.Repeat("00", sha256.Size),
+			chain:      shortChain,
+			expMatch:   false,
+		},
+		"fingerprint with wrong length": {
+			expression: "sha256:0102",
+			expErr:     true,
+		},
+		"fingerprint which is not hex": {
+			expression: "sha256:zz",
+			expErr:     true,
+		},
+		"root key identifier matches chain with root omitted": {
+			expression: "ski:AB:CD:EF",
+			chain:      shortChain,
+			expMatch:   true,
+		},
+		"root key identifier matches chain with self-signed root": {
+			expression: "ski:abcdef",
+			chain:      shortChainWithRoot,
+			expMatch:   true,
+		},
+		"root key identifier does not match chain where root is cross-signed": {
+			expression: "ski:abcdef",
+			chain:      crossSignedChain,
+			expMatch:   false,
+		},
+		"cross-signing root key identifier matches cross-signed chain": {
+			expression: "ski:d57e",
+			chain:      crossSignedChain,
+			expMatch:   true,
+		},
+		"intermediate key identifier does not match": {
+			expression: "ski:010203",
+			chain:      shortChain,
+			expMatch:   false,
+		},
+		"key identifier does not match empty chain": {
+			expression: "ski:abcdef",
+			expMatch:   false,
+		},
+		"empty key identifier": {
+			expression: "ski:",
+			expErr:     true,
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			selector, err := ParseChainSelector(test.expression)
+			if test.expErr != (err != nil) {
+				t.Fatalf("unexpected error, exp=%t got=%v", test.expErr, err)
+			}
+			if err != nil {
+				return
+			}
+			if match := selector.MatchesChain(test.chain); match != test.expMatch {
+				t.Errorf("unexpected match, exp=%t got=%t", test.expMatch, match)
+			}
+		})
+	}
+}
+
+func colonSeparate(s string) string {
+	var parts []string
+	for i := 0; i < len(s); i += 2 {
+		parts = append(parts, s[i:i+2])
+	}
+	return strings.Join(parts, ":")
+}
@@ -0,0 +1,316 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"net"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// Copied from x509.go
+var (
+	OIDExtensionBasicConstraints = []int{2, 5, 29, 19}
+	OIDExtensionNameConstraints  = []int{2, 5, 29, 30}
+)
+
+// Tags of the GeneralName CHOICE, RFC 5280, 4.2.1.6
+const (
+	nameTypeOther = 0
+	nameTypeEmail = 1
+	nameTypeDNS   = 2
+	nameTypeURI   = 6
+	nameTypeIP    = 7
+)
+
+// RFC 5280, 4.2.1.9
+type basicConstraints struct {
+	IsCA       bool `asn1:"optional"`
+	MaxPathLen int  `asn1:"optional,default:-1"`
+}
+
+// RFC 5280, 4.2.1.10
+type nameConstraints struct {
+	Permitted []generalSubtree `asn1:"optional,omitempty,tag:0"`
+	Excluded  []generalSubtree `asn1:"optional,omitempty,tag:1"`
+}
+
+type generalSubtree struct {
+	Name asn1.RawValue
+}
+
+// buildCAConstraintsExtensionsForCertificate returns the basicConstraints and
+// nameConstraints extensions requested by a CA Certificate. No extensions are
+// returned for non-CA Certificates, or if neither maxPathLen nor
+// nameConstraints are set.
+func buildCAConstraintsExtensionsForCertificate(crt *v1.Certificate) ([]pkix.Extension, error) {
+	if !crt.Spec.IsCA {
+		return nil, nil
+	}
+
+	var extensions []pkix.Extension
+	if crt.Spec.MaxPathLen != nil {
+		value, err := asn1.Marshal(basicConstraints{IsCA: true, MaxPathLen: int(*crt.Spec.MaxPathLen)})
+		if err != nil {
+			return nil, fmt.Errorf("failed to asn1 encode basic constraints: %w", err)
+		}
+		extensions = append(extensions, pkix.Extension{Id: OIDExtensionBasicConstraints, Critical: true, Value: value})
+	}
+
+	if nc := crt.Spec.NameConstraints; nc != nil {
+		ext, err := MarshalNameConstraints(nc)
+		if err != nil {
+			return nil, err
+		}
+		if ext != nil {
+			extensions = append(extensions, *ext)
+		}
+	}
+
+	return extensions, nil
+}
+
+// MarshalNameConstraints encodes the given NameConstraints as an x509
+// nameConstraints extension. nil is returned if no names are constrained.
+func MarshalNameConstraints(nc *v1.NameConstraints) (*pkix.Extension, error) {
+	permitted, err := buildGeneralSubtrees(nc.Permitted)
+	if err != nil {
+		return nil, fmt.Errorf("invalid permitted name constraints: %w", err)
+	}
+	excluded, err := buildGeneralSubtrees(nc.Excluded)
+	if err != nil {
+		return nil, fmt.Errorf("invalid excluded name constraints: %w", err)
+	}
+	if len(permitted) == 0 && len(excluded) == 0 {
+		return nil, nil
+	}
+
+	value, err := asn1.Marshal(nameConstraints{Permitted: permitted, Excluded: excluded})
+	if err != nil {
+		return nil, fmt.Errorf("failed to asn1 encode name constraints: %w", err)
+	}
+
+	return &pkix.Extension{Id: OIDExtensionNameConstraints, Critical: nc.Critical, Value: value}, nil
+}
+
+func buildGeneralSubtrees(item *v1.NameConstraintItem) ([]generalSubtree, error) {
+	if item == nil {
+		return nil, nil
+	}
+
+	var subtrees []generalSubtree
+	add := func(tag int, value []byte) {
+		subtrees = append(subtrees, generalSubtree{
+			Name: asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: tag, Bytes: value},
+		})
+	}
+	for _, domain := range item.DNSDomains {
+		add(nameTypeDNS, []byte(domain))
+	}
+	for _, cidr := range item.IPRanges {
+		_, ipNet, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		ip := ipNet.IP
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		add(nameTypeIP, append(append([]byte{}, ip...), ipNet.Mask...))
+	}
+	for _, email := range item.EmailAddresses {
+		add(nameTypeEmail, []byte(email))
+	}
+	for _, domain := range item.URIDomains {
+		add(nameTypeURI, []byte(domain))
+	}
+
+	return subtrees, nil
+}
+
+// ApplyCAConstraints copies the path length and name constraints requested in
+// the basicConstraints and nameConstraints extensions of the given x509
+// certificate request to a CA certificate template. The template is left
+// untouched if it is not a CA.
+func ApplyCAConstraints(template *x509.Certificate, csr *x509.CertificateRequest) error {
+	if !template.IsCA {
+		return nil
+	}
+
+	for _, ext := range csr.Extensions {
+		switch {
+		case ext.Id.Equal(OIDExtensionBasicConstraints):
+			var constraints basicConstraints
+			if rest, err := asn1.Unmarshal(ext.Value, &constraints); err != nil {
+				return fmt.Errorf("failed to decode basic constraints: %w", err)
+			} else if len(rest) != 0 {
+				return fmt.Errorf("trailing data after basic constraints")
+			}
+			if constraints.MaxPathLen >= 0 {
+				template.MaxPathLen = constraints.MaxPathLen
+				template.MaxPathLenZero = constraints.MaxPathLen == 0
+			}
+
+		case ext.Id.Equal(OIDExtensionNameConstraints):
+			var constraints nameConstraints
+			if rest, err := asn1.Unmarshal(ext.Value, &constraints); err != nil {
+				return fmt.Errorf("failed to decode name constraints: %w", err)
+			} else if len(rest) != 0 {
+				return fmt.Errorf("trailing data after name constraints")
+			}
+			template.PermittedDNSDomainsCritical = ext.Critical
+			for _, subtree := range constraints.Permitted {
+				if err := addNameConstraint(template, subtree.Name, true); err != nil {
+					return err
+				}
+			}
+			for _, subtree := range constraints.Excluded {
+				if err := addNameConstraint(template, subtree.Name, false); err != nil {
+					return err
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// ConstrainPathLenToIssuer checks that the path length of a CA certificate
+// template is allowed by the path length of the CA certificate that signs it.
+// If the template does not request a path length but the issuer is
+// constrained, the template is given the longest path length the issuer
+// allows. The template is left untouched if it is not a CA.
+func ConstrainPathLenToIssuer(template, issuer *x509.Certificate) error {
+	if !template.IsCA || !hasMaxPathLen(issuer) {
+		return nil
+	}
+
+	if issuer.MaxPathLen == 0 {
+		return fmt.Errorf("issuer %q has a path length of 0 and cannot sign CA certificates", issuer.Subject)
+	}
+
+	if !hasMaxPathLen(template) {
+		template.MaxPathLen = issuer.MaxPathLen - 1
+		template.MaxPathLenZero = template.MaxPathLen == 0
+		return nil
+	}
+
+	if template.MaxPathLen >= issuer.MaxPathLen {
+		return fmt.Errorf("requested path length %d must be less than the path length %d of issuer %q", template.MaxPathLen, issuer.MaxPathLen, issuer.Subject)
+	}
+
+	return nil
+}
+
+// hasMaxPathLen returns true if the pathLenConstraint of the given x509
+// certificate is set, following the conventions of the x509 package.
+func hasMaxPathLen(cert *x509.Certificate) bool {
+	return cert.MaxPathLen > 0 || (cert.MaxPathLen == 0 && cert.MaxPathLenZero)
+}
+
+func addNameConstraint(template *x509.Certificate, name asn1.RawValue, permitted bool) error {
+	if name.Class != asn1.ClassContextSpecific {
+		return fmt.Errorf("unexpected name constraint of class %d", name.Class)
+	}
+
+	switch name.Tag {
+	case nameTypeDNS:
+		if permitted {
+			template.PermittedDNSDomains = append(template.PermittedDNSDomains, string(name.Bytes))
+		} else {
+			template.ExcludedDNSDomains = append(template.ExcludedDNSDomains, string(name.Bytes))
+		}
+
+	case nameTypeIP:
+		l := len(name.Bytes)
+		if l != 2*net.IPv4len && l != 2*net.IPv6len {
+			return fmt.Errorf("IP range name constraint has invalid length %d", l)
+		}
+		ipNet := &net.IPNet{IP: net.IP(name.Bytes[:l/2]), Mask: net.IPMask(name.Bytes[l/2:])}
+		if permitted {
+			template.PermittedIPRanges = append(template.PermittedIPRanges, ipNet)
+		} else {
+			template.ExcludedIPRanges = append(template.ExcludedIPRanges, ipNet)
+		}
+
+	case nameTypeEmail:
+		if permitted {
+			template.PermittedEmailAddresses = append(template.PermittedEmailAddresses, string(name.Bytes))
+		} else {
+			template.ExcludedEmailAddresses = append(template.ExcludedEmailAddresses, string(name.Bytes))
+		}
+
+	case nameTypeURI:
+		if permitted {
+			template.PermittedURIDomains = append(template.PermittedURIDomains, string(name.Bytes))
+		} else {
+			template.ExcludedURIDomains = append(template.ExcludedURIDomains, string(name.Bytes))
+		}
+
+	default:
+		return fmt.Errorf("unsupported name constraint of type %d", name.Tag)
+	}
+
+	return nil
+}
+
+// CAConstraintsMatchSpec compares the basicConstraints and nameConstraints
+// extensions of the given x509 certificate request with the ones which would
+// be requested for the given Certificate spec, and returns the names of the
+// Certificate fields that do not match.
+func CAConstraintsMatchSpec(csr *x509.CertificateRequest, spec v1.CertificateSpec) ([]string, error) {
+	expected, err := buildCAConstraintsExtensionsForCertificate(&v1.Certificate{Spec: spec})
+	if err != nil {
+		return nil, err
+	}
+
+	var violations []string
+	for _, field := range []struct {
+		name string
+		oid  asn1.ObjectIdentifier
+	}{
+		{"spec.maxPathLen", OIDExtensionBasicConstraints},
+		{"spec.nameConstraints", OIDExtensionNameConstraints},
+	} {
+		if !extensionsEqual(findExtension(expected, field.oid), findExtension(csr.Extensions, field.oid)) {
+			violations = append(violations, field.name)
+		}
+	}
+
+	return violations, nil
+}
+
+func findExtension(extensions []pkix.Extension, oid asn1.ObjectIdentifier) *pkix.Extension {
+	for i := range extensions {
+		if extensions[i].Id.Equal(oid) {
+			return &extensions[i]
+		}
+	}
+	return nil
+}
+
+func extensionsEqual(a, b *pkix.Extension) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Critical == b.Critical && bytes.Equal(a.Value, b.Value)
+}
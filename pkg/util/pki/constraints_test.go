@@ -0,0 +1,255 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"k8s.io/utils/pointer"
+
+	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestApplyCAConstraints(t *testing.T) {
+	nameConstraints := &cmapi.NameConstraints{
+		Critical: true,
+		Permitted: &cmapi.NameConstraintItem{
+			DNSDomains:     []string{"example.com"},
+			IPRanges:       []string{"10.0.0.0/8", "2001:db8::/32"},
+			EmailAddresses: []string{"example.com"},
+			URIDomains:     []string{".example.com"},
+		},
+		Excluded: &cmapi.NameConstraintItem{
+			DNSDomains: []string{"internal.example.com"},
+		},
+	}
+
+	tests := map[string]struct {
+		spec   cmapi.CertificateSpec
+		verify func(t *testing.T, cert *x509.Certificate)
+	}{
+		"no constraints are added by default": {
+			spec: cmapi.CertificateSpec{CommonName: "ca", IsCA: true},
+			verify: func(t *testing.T, cert *x509.Certificate) {
+				assert.Equal(t, -1, cert.MaxPathLen)
+				assert.Empty(t, cert.PermittedDNSDomains)
+			},
+		},
+		"a path length of zero is preserved": {
+			spec: cmapi.CertificateSpec{CommonName: "ca", IsCA: true, MaxPathLen: pointer.Int32(0)},
+			verify: func(t *testing.T, cert *x509.Certificate) {
+				assert.Equal(t, 0, cert.MaxPathLen)
+				assert.True(t, cert.MaxPathLenZero)
+			},
+		},
+		"a non-zero path length is preserved": {
+			spec: cmapi.CertificateSpec{CommonName: "ca", IsCA: true, MaxPathLen: pointer.Int32(2)},
+			verify: func(t *testing.T, cert *x509.Certificate) {
+				assert.Equal(t, 2, cert.MaxPathLen)
+				assert.False(t, cert.MaxPathLenZero)
+			},
+		},
+		"name constraints are preserved": {
+			spec: cmapi.CertificateSpec{CommonName: "ca", IsCA: true, NameConstraints: nameConstraints},
+			verify: func(t *testing.T, cert *x509.Certificate) {
+				assert.True(t, cert.PermittedDNSDomainsCritical)
+				assert.Equal(t, []string{"example.com"}, cert.PermittedDNSDomains)
+				assert.Equal(t, []string{"internal.example.com"}, cert.ExcludedDNSDomains)
+				assert.Equal(t, []string{"example.com"}, cert.PermittedEmailAddresses)
+				assert.Equal(t, []string{".example.com"}, cert.PermittedURIDomains)
+				require.Len(t, cert.PermittedIPRanges, 2)
+				assert.Equal(t, "10.0.0.0/8", cert.PermittedIPRanges[0].String())
+				assert.Equal(t, "2001:db8::/32", cert.PermittedIPRanges[1].String())
+			},
+		},
+		"constraints are ignored for non-CA certificates": {
+			spec: cmapi.CertificateSpec{CommonName: "leaf", MaxPathLen: pointer.Int32(0), NameConstraints: nameConstraints},
+			verify: func(t *testing.T, cert *x509.Certificate) {
+				assert.False(t, cert.IsCA)
+				assert.False(t, cert.MaxPathLenZero)
+				assert.Empty(t, cert.PermittedDNSDomains)
+			},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			crt := &cmapi.Certificate{Spec: test.spec}
+			pk, err := GenerateRSAPrivateKey(2048)
+			require.NoError(t, err)
+
+			csrTemplate, err := GenerateCSR(crt)
+			require.NoError(t, err)
+			csrDER, err := EncodeCSR(csrTemplate, pk)
+			require.NoError(t, err)
+			csr, err := x509.ParseCertificateRequest(csrDER)
+			require.NoError(t, err)
+
+			violations, err := CAConstraintsMatchSpec(csr, crt.Spec)
+			require.NoError(t, err)
+			assert.Empty(t, violations, "CSR should match the spec it was generated from")
+
+			keyUsage, extKeyUsage, err := BuildKeyUsages(crt.Spec.Usages, crt.Spec.IsCA)
+			require.NoError(t, err)
+			csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+			template, err := GenerateTemplateFromCSRPEMWithUsages(csrPEM, time.Hour, crt.Spec.IsCA, keyUsage, extKeyUsage)
+			require.NoError(t, err)
+			require.NoError(t, ApplyCAConstraints(template, csr))
+
+			_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+			require.NoError(t, err)
+			test.verify(t, cert)
+		})
+	}
+}
+
+func TestConstrainPathLenToIssuer(t *testing.T) {
+	unconstrained := &x509.Certificate{IsCA: true, MaxPathLen: -1}
+	pathLen := func(n int) *x509.Certificate {
+		return &x509.Certificate{IsCA: true, MaxPathLen: n, MaxPathLenZero: n == 0}
+	}
+
+	tests := map[string]struct {
+		template        *x509.Certificate
+		issuer          *x509.Certificate
+		expectedPathLen int
+		expectErr       bool
+	}{
+		"an unconstrained issuer leaves the template untouched": {
+			template:        pathLen(5),
+			issuer:          unconstrained,
+			expectedPathLen: 5,
+		},
+		"a path length shorter than the issuer's is allowed": {
+			template:        pathLen(0),
+			issuer:          pathLen(1),
+			expectedPathLen: 0,
+		},
+		"a template without a path length is constrained by the issuer": {
+			template:        &x509.Certificate{IsCA: true, MaxPathLen: -1},
+			issuer:          pathLen(2),
+			expectedPathLen: 1,
+		},
+		"a path length as long as the issuer's is rejected": {
+			template:  pathLen(1),
+			issuer:    pathLen(1),
+			expectErr: true,
+		},
+		"an issuer with a path length of zero cannot sign CAs": {
+			template:  &x509.Certificate{IsCA: true, MaxPathLen: -1},
+			issuer:    pathLen(0),
+			expectErr: true,
+		},
+		"non-CA templates are ignored": {
+			template:        &x509.Certificate{MaxPathLen: -1},
+			issuer:          pathLen(0),
+			expectedPathLen: -1,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			err := ConstrainPathLenToIssuer(test.template, test.issuer)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedPathLen, test.template.MaxPathLen)
+			assert.Equal(t, test.expectedPathLen == 0, test.template.MaxPathLenZero)
+		})
+	}
+}
+
+func TestCAConstraintsMatchSpec(t *testing.T) {
+	spec := cmapi.CertificateSpec{
+		CommonName: "ca",
+		IsCA:       true,
+		MaxPathLen: pointer.Int32(1),
+		NameConstraints: &cmapi.NameConstraints{
+			Permitted: &cmapi.NameConstraintItem{IPRanges: []string{"10.1.2.3/8"}},
+		},
+	}
+	csrTemplate, err := GenerateCSR(&cmapi.Certificate{Spec: spec})
+	require.NoError(t, err)
+	csr := &x509.CertificateRequest{Extensions: csrTemplate.ExtraExtensions}
+
+	tests := map[string]struct {
+		mutate func(spec *cmapi.CertificateSpec)
+		want   []string
+	}{
+		"matches the same spec": {
+			mutate: func(*cmapi.CertificateSpec) {},
+		},
+		"matches an equivalent IP range": {
+			mutate: func(spec *cmapi.CertificateSpec) {
+				spec.NameConstraints = &cmapi.NameConstraints{
+					Permitted: &cmapi.NameConstraintItem{IPRanges: []string{"10.0.0.0/8"}},
+				}
+			},
+		},
+		"does not match a changed path length": {
+			mutate: func(spec *cmapi.CertificateSpec) { spec.MaxPathLen = pointer.Int32(0) },
+			want:   []string{"spec.maxPathLen"},
+		},
+		"does not match a removed path length": {
+			mutate: func(spec *cmapi.CertificateSpec) { spec.MaxPathLen = nil },
+			want:   []string{"spec.maxPathLen"},
+		},
+		"does not match changed criticality": {
+			mutate: func(spec *cmapi.CertificateSpec) {
+				spec.NameConstraints = &cmapi.NameConstraints{
+					Critical:  true,
+					Permitted: &cmapi.NameConstraintItem{IPRanges: []string{"10.0.0.0/8"}},
+				}
+			},
+			want: []string{"spec.nameConstraints"},
+		},
+		"does not match changed name constraints": {
+			mutate: func(spec *cmapi.CertificateSpec) {
+				spec.NameConstraints = &cmapi.NameConstraints{
+					Permitted: &cmapi.NameConstraintItem{IPRanges: []string{"192.168.0.0/16"}},
+				}
+			},
+			want: []string{"spec.nameConstraints"},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			mutated := *spec.DeepCopy()
+			test.mutate(&mutated)
+			got, err := CAConstraintsMatchSpec(csr, mutated)
+			require.NoError(t, err)
+			assert.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestMarshalNameConstraintsInvalidIPRange(t *testing.T) {
+	_, err := MarshalNameConstraints(&cmapi.NameConstraints{
+		Permitted: &cmapi.NameConstraintItem{IPRanges: []string{"10.0.0.1"}},
+	})
+	var parseErr *net.ParseError
+	assert.ErrorAs(t, err, &parseErr)
+}
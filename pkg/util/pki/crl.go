@@ -0,0 +1,185 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// RevocationReasonUnspecified is the name of the RFC 5280 reason code used
+// when no reason is given for a revocation.
+const RevocationReasonUnspecified = "unspecified"
+
+// RevocationReasons maps the names of the RFC 5280 CRL reason codes which a
+// certificate can be permanently revoked with to their values.
+var RevocationReasons = map[string]int{
+	RevocationReasonUnspecified: 0,
+	"keyCompromise":             1,
+	"cACompromise":              2,
+	"affiliationChanged":        3,
+	"superseded":                4,
+	"cessationOfOperation":      5,
+	"privilegeWithdrawn":        9,
+	"aACompromise":              10,
+}
+
+// RevocationReasonNames returns the sorted names of the RevocationReasons.
+func RevocationReasonNames() []string {
+	names := make([]string, 0, len(RevocationReasons))
+	for name := range RevocationReasons {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+var (
+	// oidExtensionReasonCode is the OID of the CRL entry reason code extension.
+	oidExtensionReasonCode = asn1.ObjectIdentifier{2, 5, 29, 21}
+	// oidExtensionCRLNumber is the OID of the CRL number extension.
+	oidExtensionCRLNumber = asn1.ObjectIdentifier{2, 5, 29, 20}
+)
+
+// RevokedCertificate is a certificate to be listed in a CRL.
+type RevokedCertificate struct {
+	SerialNumber   *big.Int
+	RevocationTime time.Time
+	// Reason is the name of one of the RevocationReasons. An empty reason is
+	// equivalent to RevocationReasonUnspecified.
+	Reason string
+}
+
+// CreateCRL returns a PEM encoded CRL listing the revoked certificates, signed
+// by the given CA. The CA certificate must have the CRL signing key usage.
+func CreateCRL(revoked []RevokedCertificate, number *big.Int, thisUpdate, nextUpdate time.Time, caCert *x509.Certificate, caKey crypto.Signer) ([]byte, error) {
+	entries, err := crlEntries(revoked)
+	if err != nil {
+		return nil, err
+	}
+
+	der, err := x509.CreateRevocationList(entropySource(), &x509.RevocationList{
+		RevokedCertificates: entries,
+		Number:              number,
+		ThisUpdate:          thisUpdate.UTC(),
+		NextUpdate:          nextUpdate.UTC(),
+	}, caCert, caKey)
+	if err != nil {
+		return nil, fmt.Errorf("error creating CRL: %w", err)
+	}
+
+	crlPEM := bytes.NewBuffer([]byte{})
+	if err := pem.Encode(crlPEM, &pem.Block{Type: "X509 CRL", Bytes: der}); err != nil {
+		return nil, err
+	}
+
+	return crlPEM.Bytes(), nil
+}
+
+// crlEntries converts the revoked certificates to CRL entries.
+func crlEntries(revoked []RevokedCertificate) ([]pkix.RevokedCertificate, error) {
+	entries := make([]pkix.RevokedCertificate, 0, len(revoked))
+	for _, r := range revoked {
+		entry := pkix.RevokedCertificate{
+			SerialNumber:   r.SerialNumber,
+			RevocationTime: r.RevocationTime.UTC(),
+		}
+
+		// RFC 5280 section 5.3.1: the reason code extension should be
+		// absent instead of using the unspecified reason code.
+		if r.Reason != "" && r.Reason != RevocationReasonUnspecified {
+			code, ok := RevocationReasons[r.Reason]
+			if !ok {
+				return nil, fmt.Errorf("unknown revocation reason %q", r.Reason)
+			}
+			value, err := asn1.Marshal(asn1.Enumerated(code))
+			if err != nil {
+				return nil, err
+			}
+			entry.Extensions = []pkix.Extension{{Id: oidExtensionReasonCode, Value: value}}
+		}
+
+		entries = append(entries, entry)
+	}
+
+	return entries, nil
+}
+
+// CRLListsRevokedCertificates returns true if the CRL lists exactly the given
+// revoked certificates, in the same order and with the same revocation times
+// and reasons.
+func CRLListsRevokedCertificates(crl *pkix.CertificateList, revoked []RevokedCertificate) bool {
+	entries, err := crlEntries(revoked)
+	if err != nil {
+		return false
+	}
+
+	listed := crl.TBSCertList.RevokedCertificates
+	if len(listed) != len(entries) {
+		return false
+	}
+	for i := range entries {
+		if listed[i].SerialNumber.Cmp(entries[i].SerialNumber) != 0 ||
+			!listed[i].RevocationTime.Equal(entries[i].RevocationTime.Truncate(time.Second)) ||
+			len(listed[i].Extensions) != len(entries[i].Extensions) {
+			return false
+		}
+		for j := range entries[i].Extensions {
+			if !listed[i].Extensions[j].Id.Equal(entries[i].Extensions[j].Id) ||
+				!bytes.Equal(listed[i].Extensions[j].Value, entries[i].Extensions[j].Value) {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// CRLNumber returns the number of the CRL, or nil if it has none.
+func CRLNumber(crl *pkix.CertificateList) (*big.Int, error) {
+	for _, ext := range crl.TBSCertList.Extensions {
+		if !ext.Id.Equal(oidExtensionCRLNumber) {
+			continue
+		}
+		number := new(big.Int)
+		if _, err := asn1.Unmarshal(ext.Value, &number); err != nil {
+			return nil, fmt.Errorf("error decoding CRL number: %w", err)
+		}
+		return number, nil
+	}
+
+	return nil, nil
+}
+
+// DecodeCRLBytes decodes a PEM encoded CRL. The signature of the CRL is not
+// verified.
+func DecodeCRLBytes(crlPEM []byte) (*pkix.CertificateList, error) {
+	block, _ := pem.Decode(crlPEM)
+	if block == nil || block.Type != "X509 CRL" {
+		return nil, fmt.Errorf("error decoding CRL PEM block")
+	}
+
+	return x509.ParseDERCRL(block.Bytes)
+}
@@ -0,0 +1,73 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestCreateCRL(t *testing.T) {
+	caKey, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+	}
+	_, caCert, err := SignCertificate(caTmpl, caTmpl, caKey.Public(), caKey)
+	require.NoError(t, err)
+
+	now := time.Now().Truncate(time.Second)
+	revoked := []RevokedCertificate{
+		{SerialNumber: big.NewInt(10), RevocationTime: now.Add(-time.Hour)},
+		{SerialNumber: big.NewInt(20), RevocationTime: now, Reason: "keyCompromise"},
+	}
+
+	crlPEM, err := CreateCRL(revoked, big.NewInt(5), now, now.Add(24*time.Hour), caCert, caKey)
+	require.NoError(t, err)
+
+	crl, err := DecodeCRLBytes(crlPEM)
+	require.NoError(t, err)
+	require.NoError(t, caCert.CheckCRLSignature(crl))
+
+	number, err := CRLNumber(crl)
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(5), number)
+	assert.True(t, now.Add(24*time.Hour).Equal(crl.TBSCertList.NextUpdate))
+
+	assert.True(t, CRLListsRevokedCertificates(crl, revoked))
+	assert.False(t, CRLListsRevokedCertificates(crl, revoked[:1]))
+	assert.False(t, CRLListsRevokedCertificates(crl, []RevokedCertificate{
+		revoked[0],
+		{SerialNumber: big.NewInt(20), RevocationTime: now, Reason: "superseded"},
+	}))
+
+	_, err = CreateCRL([]RevokedCertificate{{SerialNumber: big.NewInt(1), RevocationTime: now, Reason: "unknown"}},
+		big.NewInt(6), now, now.Add(time.Hour), caCert, caKey)
+	assert.EqualError(t, err, `unknown revocation reason "unknown"`)
+}
@@ -199,8 +199,8 @@ func GenerateCSR(crt *v1.Certificate) (*x509.CertificateRequest, error) {
 		return nil, err
 	}
 
-	if len(commonName) == 0 && len(dnsNames) == 0 && len(uriNames) == 0 && len(crt.Spec.EmailAddresses) == 0 && len(crt.Spec.IPAddresses) == 0 {
-		return nil, fmt.Errorf("no common name, DNS name, URI SAN, or Email SAN specified on certificate")
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(uriNames) == 0 && len(crt.Spec.EmailAddresses) == 0 && len(crt.Spec.IPAddresses) == 0 && len(crt.Spec.OtherNames) == 0 {
+		return nil, fmt.Errorf("no common name, DNS name, URI SAN, Email SAN, or otherName SAN specified on certificate")
 	}
 
 	pubKeyAlgo, sigAlgo, err := SignatureAlgorithm(crt)
@@ -216,6 +216,38 @@ func GenerateCSR(crt *v1.Certificate) (*x509.CertificateRequest, error) {
 		}
 	}
 
+	caConstraints, err := buildCAConstraintsExtensionsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	extraExtensions = append(extraExtensions, caConstraints...)
+
+	policies, err := CertificatePoliciesForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	if len(policies) > 0 {
+		policiesExtension, err := marshalCertificatePolicies(policies)
+		if err != nil {
+			return nil, err
+		}
+		extraExtensions = append(extraExtensions, policiesExtension)
+	}
+
+	if len(crt.Spec.OtherNames) > 0 {
+		sans, err := MarshalSANs(dnsNames, crt.Spec.EmailAddresses, iPAddresses, uriNames, crt.Spec.OtherNames)
+		if err != nil {
+			return nil, err
+		}
+		extraExtensions = append(extraExtensions, sans)
+	}
+
+	requestedExtensions, err := buildExtraExtensionsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	extraExtensions = append(extraExtensions, requestedExtensions...)
+
 	if isLiteralCertificateSubjectEnabled() && len(crt.Spec.LiteralSubject) > 0 {
 		rawSubject, err := ParseSubjectStringToRawDerBytes(crt.Spec.LiteralSubject)
 		if err != nil {
@@ -282,9 +314,14 @@ func buildKeyUsagesExtensionsForCertificate(crt *v1.Certificate) ([]pkix.Extensi
 			asn1ExtendedUsages = append(asn1ExtendedUsages, oid)
 		}
 	}
+	extKeyUsageOIDs, err := ExtKeyUsageOIDsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	asn1ExtendedUsages = append(asn1ExtendedUsages, extKeyUsageOIDs...)
 
 	extraExtensions := []pkix.Extension{usage}
-	if len(ekus) > 0 {
+	if len(asn1ExtendedUsages) > 0 {
 		extendedUsage := pkix.Extension{
 			Id: OIDExtensionExtendedKeyUsage,
 		}
@@ -320,23 +357,57 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 	if err != nil {
 		return nil, err
 	}
+	extKeyUsageOIDs, err := ExtKeyUsageOIDsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	policies, err := CertificatePoliciesForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+
+	var maxPathLen int
+	if crt.Spec.IsCA && crt.Spec.MaxPathLen != nil {
+		maxPathLen = int(*crt.Spec.MaxPathLen)
+	}
 
-	if len(commonName) == 0 && len(dnsNames) == 0 && len(ipAddresses) == 0 && len(uris) == 0 && len(crt.Spec.EmailAddresses) == 0 {
+	if len(commonName) == 0 && len(dnsNames) == 0 && len(ipAddresses) == 0 && len(uris) == 0 && len(crt.Spec.EmailAddresses) == 0 && len(crt.Spec.OtherNames) == 0 {
 		return nil, fmt.Errorf("no common name or subject alt names requested on certificate")
 	}
 
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	var extraExtensions []pkix.Extension
+	if len(crt.Spec.OtherNames) > 0 {
+		sans, err := MarshalSANs(dnsNames, crt.Spec.EmailAddresses, ipAddresses, uris, crt.Spec.OtherNames)
+		if err != nil {
+			return nil, err
+		}
+		extraExtensions = append(extraExtensions, sans)
+	}
+
+	requestedExtensions, err := buildExtraExtensionsForCertificate(crt)
+	if err != nil {
+		return nil, err
+	}
+	extraExtensions = append(extraExtensions, requestedExtensions...)
+
+	serialNumber, err := rand.Int(entropySource(), serialNumberLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate serial number: %s", err.Error())
 	}
 
 	certDuration := apiutil.DefaultCertDuration(crt.Spec.Duration)
 
-	pubKeyAlgo, _, err := SignatureAlgorithm(crt)
+	pubKeyAlgo, sigAlgo, err := SignatureAlgorithm(crt)
 	if err != nil {
 		return nil, err
 	}
 
+	// Leave the signature algorithm to be chosen based on the signer's key
+	// unless one has been explicitly requested.
+	if len(crt.Spec.SignatureAlgorithm) == 0 {
+		sigAlgo = x509.UnknownSignatureAlgorithm
+	}
+
 	if isLiteralCertificateSubjectEnabled() && len(crt.Spec.LiteralSubject) > 0 {
 		rawSubject, err := ParseSubjectStringToRawDerBytes(crt.Spec.LiteralSubject)
 		if err != nil {
@@ -352,17 +423,23 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 			BasicConstraintsValid: true,
 			SerialNumber:          serialNumber,
 			PublicKeyAlgorithm:    pubKeyAlgo,
+			SignatureAlgorithm:    sigAlgo,
 			IsCA:                  crt.Spec.IsCA,
+			MaxPathLen:            maxPathLen,
+			MaxPathLenZero:        crt.Spec.IsCA && crt.Spec.MaxPathLen != nil && maxPathLen == 0,
 			RawSubject:            rawSubject,
 			NotBefore:             time.Now(),
 			NotAfter:              time.Now().Add(certDuration),
 			// see http://golang.org/pkg/crypto/x509/#KeyUsage
-			KeyUsage:       keyUsages,
-			ExtKeyUsage:    extKeyUsages,
-			DNSNames:       dnsNames,
-			IPAddresses:    ipAddresses,
-			URIs:           uris,
-			EmailAddresses: crt.Spec.EmailAddresses,
+			KeyUsage:           keyUsages,
+			ExtKeyUsage:        extKeyUsages,
+			UnknownExtKeyUsage: extKeyUsageOIDs,
+			PolicyIdentifiers:  policies,
+			DNSNames:           dnsNames,
+			IPAddresses:        ipAddresses,
+			URIs:               uris,
+			EmailAddresses:     crt.Spec.EmailAddresses,
+			ExtraExtensions:    extraExtensions,
 		}, nil
 	} else {
 
@@ -375,7 +452,10 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 			BasicConstraintsValid: true,
 			SerialNumber:          serialNumber,
 			PublicKeyAlgorithm:    pubKeyAlgo,
+			SignatureAlgorithm:    sigAlgo,
 			IsCA:                  crt.Spec.IsCA,
+			MaxPathLen:            maxPathLen,
+			MaxPathLenZero:        crt.Spec.IsCA && crt.Spec.MaxPathLen != nil && maxPathLen == 0,
 			Subject: pkix.Name{
 				Country:            subject.Countries,
 				Organization:       organization,
@@ -390,12 +470,15 @@ func GenerateTemplate(crt *v1.Certificate) (*x509.Certificate, error) {
 			NotBefore: time.Now(),
 			NotAfter:  time.Now().Add(certDuration),
 			// see http://golang.org/pkg/crypto/x509/#KeyUsage
-			KeyUsage:       keyUsages,
-			ExtKeyUsage:    extKeyUsages,
-			DNSNames:       dnsNames,
-			IPAddresses:    ipAddresses,
-			URIs:           uris,
-			EmailAddresses: crt.Spec.EmailAddresses,
+			KeyUsage:           keyUsages,
+			ExtKeyUsage:        extKeyUsages,
+			UnknownExtKeyUsage: extKeyUsageOIDs,
+			PolicyIdentifiers:  policies,
+			DNSNames:           dnsNames,
+			IPAddresses:        ipAddresses,
+			URIs:               uris,
+			EmailAddresses:     crt.Spec.EmailAddresses,
+			ExtraExtensions:    extraExtensions,
 		}, nil
 	}
 }
@@ -408,7 +491,38 @@ func GenerateTemplateFromCertificateRequest(cr *v1.CertificateRequest) (*x509.Ce
 	if err != nil {
 		return nil, err
 	}
-	return GenerateTemplateFromCSRPEMWithUsages(cr.Spec.Request, certDuration, cr.Spec.IsCA, keyUsage, extKeyUsage)
+	template, err := GenerateTemplateFromCSRPEMWithUsages(cr.Spec.Request, certDuration, cr.Spec.IsCA, keyUsage, extKeyUsage)
+	if err != nil {
+		return nil, err
+	}
+
+	if alg := cr.Annotations[v1.CertificateRequestSignatureAlgorithmAnnotationKey]; len(alg) > 0 {
+		template.SignatureAlgorithm, _, err = ParseSignatureAlgorithm(v1.SignatureAlgorithm(alg))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	template.PolicyIdentifiers, err = ParseObjectIdentifiersAnnotation(cr.Annotations[v1.CertificateRequestCertificatePoliciesAnnotationKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate policy: %w", err)
+	}
+	template.UnknownExtKeyUsage, err = ParseObjectIdentifiersAnnotation(cr.Annotations[v1.CertificateRequestExtendedKeyUsageOIDsAnnotationKey])
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended key usage: %w", err)
+	}
+
+	if oids := cr.Annotations[v1.CertificateRequestExtraExtensionsAnnotationKey]; len(oids) > 0 {
+		csr, err := DecodeX509CertificateRequestBytes(cr.Spec.Request)
+		if err != nil {
+			return nil, err
+		}
+		if err := ApplyExtraExtensions(template, csr, oids); err != nil {
+			return nil, err
+		}
+	}
+
+	return template, nil
 }
 
 func GenerateTemplateFromCSRPEM(csrPEM []byte, duration time.Duration, isCA bool) (*x509.Certificate, error) {
@@ -434,11 +548,20 @@ func GenerateTemplateFromCSRPEMWithUsages(csrPEM []byte, duration time.Duration,
 		return nil, err
 	}
 
-	serialNumber, err := rand.Int(rand.Reader, serialNumberLimit)
+	serialNumber, err := rand.Int(entropySource(), serialNumberLimit)
 	if err != nil {
 		return nil, fmt.Errorf("failed to generate serial number: %s", err.Error())
 	}
 
+	var extraExtensions []pkix.Extension
+	sans, err := otherNameSANsExtension(csr)
+	if err != nil {
+		return nil, err
+	}
+	if sans != nil {
+		extraExtensions = append(extraExtensions, *sans)
+	}
+
 	return &x509.Certificate{
 		// Version must be 2 according to RFC5280.
 		// A version value of 2 confusingly means version 3.
@@ -455,12 +578,13 @@ func GenerateTemplateFromCSRPEMWithUsages(csrPEM []byte, duration time.Duration,
 		NotBefore:             time.Now(),
 		NotAfter:              time.Now().Add(duration),
 		// see http://golang.org/pkg/crypto/x509/#KeyUsage
-		KeyUsage:       keyUsage,
-		ExtKeyUsage:    extKeyUsage,
-		DNSNames:       csr.DNSNames,
-		IPAddresses:    csr.IPAddresses,
-		EmailAddresses: csr.EmailAddresses,
-		URIs:           csr.URIs,
+		KeyUsage:        keyUsage,
+		ExtKeyUsage:     extKeyUsage,
+		DNSNames:        csr.DNSNames,
+		IPAddresses:     csr.IPAddresses,
+		EmailAddresses:  csr.EmailAddresses,
+		URIs:            csr.URIs,
+		ExtraExtensions: extraExtensions,
 	}, nil
 }
 
@@ -471,7 +595,7 @@ func GenerateTemplateFromCSRPEMWithUsages(csrPEM []byte, duration time.Duration,
 // It returns a PEM encoded copy of the Certificate as well as a *x509.Certificate
 // which can be used for reading the encoded values.
 func SignCertificate(template *x509.Certificate, issuerCert *x509.Certificate, publicKey crypto.PublicKey, signerKey interface{}) ([]byte, *x509.Certificate, error) {
-	derBytes, err := x509.CreateCertificate(rand.Reader, template, issuerCert, publicKey, signerKey)
+	derBytes, err := x509.CreateCertificate(entropySource(), template, issuerCert, publicKey, signerKey)
 
 	if err != nil {
 		return nil, nil, fmt.Errorf("error creating x509 certificate: %s", err.Error())
@@ -518,7 +642,7 @@ func SignCSRTemplate(caCerts []*x509.Certificate, caKey crypto.Signer, template
 // EncodeCSR calls x509.CreateCertificateRequest to sign the given CSR template.
 // It returns a DER encoded signed CSR.
 func EncodeCSR(template *x509.CertificateRequest, key crypto.Signer) ([]byte, error) {
-	derBytes, err := x509.CreateCertificateRequest(rand.Reader, template, key)
+	derBytes, err := x509.CreateCertificateRequest(entropySource(), template, key)
 	if err != nil {
 		return nil, fmt.Errorf("error creating x509 certificate: %s", err.Error())
 	}
@@ -612,11 +736,53 @@ func SignatureAlgorithm(crt *v1.Certificate) (x509.PublicKeyAlgorithm, x509.Sign
 			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported ecdsa keysize specified: %d", crt.Spec.PrivateKey.Size)
 		}
 	default:
-		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa' or 'rsa", crt.Spec.PrivateKey.Algorithm)
+		return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("unsupported algorithm specified: %s. should be either 'ecdsa', 'ed25519' or 'rsa'", crt.Spec.PrivateKey.Algorithm)
 	}
+
+	if len(crt.Spec.SignatureAlgorithm) > 0 {
+		requestedSigAlgo, requiredPubKeyAlgo, err := ParseSignatureAlgorithm(crt.Spec.SignatureAlgorithm)
+		if err != nil {
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, err
+		}
+		if requiredPubKeyAlgo != pubKeyAlgo {
+			return x509.UnknownPublicKeyAlgorithm, x509.UnknownSignatureAlgorithm, fmt.Errorf("signature algorithm %s cannot be used with a %s private key", crt.Spec.SignatureAlgorithm, pubKeyAlgo)
+		}
+		sigAlgo = requestedSigAlgo
+	}
+
 	return pubKeyAlgo, sigAlgo, nil
 }
 
+// signatureAlgorithms maps each signature algorithm that may be requested
+// on a Certificate to its x509 equivalent and the public key algorithm
+// that it requires.
+var signatureAlgorithms = map[v1.SignatureAlgorithm]struct {
+	sigAlgo    x509.SignatureAlgorithm
+	pubKeyAlgo x509.PublicKeyAlgorithm
+}{
+	v1.SHA256WithRSA:    {x509.SHA256WithRSA, x509.RSA},
+	v1.SHA384WithRSA:    {x509.SHA384WithRSA, x509.RSA},
+	v1.SHA512WithRSA:    {x509.SHA512WithRSA, x509.RSA},
+	v1.SHA256WithRSAPSS: {x509.SHA256WithRSAPSS, x509.RSA},
+	v1.SHA384WithRSAPSS: {x509.SHA384WithRSAPSS, x509.RSA},
+	v1.SHA512WithRSAPSS: {x509.SHA512WithRSAPSS, x509.RSA},
+	v1.ECDSAWithSHA256:  {x509.ECDSAWithSHA256, x509.ECDSA},
+	v1.ECDSAWithSHA384:  {x509.ECDSAWithSHA384, x509.ECDSA},
+	v1.ECDSAWithSHA512:  {x509.ECDSAWithSHA512, x509.ECDSA},
+	v1.PureEd25519:      {x509.PureEd25519, x509.Ed25519},
+}
+
+// ParseSignatureAlgorithm returns the x509 signature algorithm for the given
+// API signature algorithm, together with the public key algorithm that the
+// signing key must use.
+func ParseSignatureAlgorithm(alg v1.SignatureAlgorithm) (x509.SignatureAlgorithm, x509.PublicKeyAlgorithm, error) {
+	algs, ok := signatureAlgorithms[alg]
+	if !ok {
+		return x509.UnknownSignatureAlgorithm, x509.UnknownPublicKeyAlgorithm, fmt.Errorf("unsupported signature algorithm specified: %q", alg)
+	}
+	return algs.sigAlgo, algs.pubKeyAlgo, nil
+}
+
 func extractCommonName(spec v1.CertificateSpec) (string, error) {
 	var commonName = spec.CommonName
 	if isLiteralCertificateSubjectEnabled() && len(spec.LiteralSubject) > 0 {
@@ -22,6 +22,7 @@ import (
 	"crypto/x509"
 	"crypto/x509/pkix"
 	"encoding/asn1"
+	"encoding/pem"
 	"math/big"
 	"reflect"
 	"testing"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	featuregatetesting "k8s.io/component-base/featuregate/testing"
 
 	"github.com/cert-manager/cert-manager/internal/controller/feature"
@@ -232,6 +234,7 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 		name            string
 		keyAlgo         cmapi.PrivateKeyAlgorithm
 		keySize         int
+		sigAlgo         cmapi.SignatureAlgorithm
 		expectErr       bool
 		expectedSigAlgo x509.SignatureAlgorithm
 		expectedKeyType x509.PublicKeyAlgorithm
@@ -321,11 +324,47 @@ func TestSignatureAlgorithmForCertificate(t *testing.T) {
 			keyAlgo:   cmapi.PrivateKeyAlgorithm("blah"),
 			expectErr: true,
 		},
+		{
+			name:            "certificate with KeyAlgorithm rsa and size 2048 and SignatureAlgorithm SHA512WithRSA",
+			keyAlgo:         cmapi.RSAKeyAlgorithm,
+			keySize:         2048,
+			sigAlgo:         cmapi.SHA512WithRSA,
+			expectedSigAlgo: x509.SHA512WithRSA,
+			expectedKeyType: x509.RSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm not set and SignatureAlgorithm SHA384WithRSAPSS",
+			sigAlgo:         cmapi.SHA384WithRSAPSS,
+			expectedSigAlgo: x509.SHA384WithRSAPSS,
+			expectedKeyType: x509.RSA,
+		},
+		{
+			name:            "certificate with KeyAlgorithm ecdsa and size 256 and SignatureAlgorithm ECDSAWithSHA384",
+			keyAlgo:         cmapi.ECDSAKeyAlgorithm,
+			keySize:         256,
+			sigAlgo:         cmapi.ECDSAWithSHA384,
+			expectedSigAlgo: x509.ECDSAWithSHA384,
+			expectedKeyType: x509.ECDSA,
+		},
+		{
+			name:      "certificate with KeyAlgorithm ecdsa and SignatureAlgorithm SHA256WithRSA",
+			keyAlgo:   cmapi.ECDSAKeyAlgorithm,
+			sigAlgo:   cmapi.SHA256WithRSA,
+			expectErr: true,
+		},
+		{
+			name:      "certificate with SignatureAlgorithm set to unknown signature algo",
+			keyAlgo:   cmapi.RSAKeyAlgorithm,
+			sigAlgo:   cmapi.SignatureAlgorithm("MD5WithRSA"),
+			expectErr: true,
+		},
 	}
 
 	testFn := func(test testT) func(*testing.T) {
 		return func(t *testing.T) {
-			actualPKAlgo, actualSigAlgo, err := SignatureAlgorithm(buildCertificateWithKeyParams(test.keyAlgo, test.keySize))
+			crt := buildCertificateWithKeyParams(test.keyAlgo, test.keySize)
+			crt.Spec.SignatureAlgorithm = test.sigAlgo
+			actualPKAlgo, actualSigAlgo, err := SignatureAlgorithm(crt)
 			if test.expectErr && err == nil {
 				t.Error("expected err, but got no error")
 				return
@@ -809,3 +848,57 @@ func TestEncodeX509Chain(t *testing.T) {
 		})
 	}
 }
+
+func TestGenerateTemplateFromCertificateRequestSignatureAlgorithm(t *testing.T) {
+	pk, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	require.NoError(t, err)
+
+	csr, err := GenerateCSR(buildCertificate("test"))
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csr, pk)
+	require.NoError(t, err)
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	tests := map[string]struct {
+		annotation      string
+		expectErr       bool
+		expectedSigAlgo x509.SignatureAlgorithm
+	}{
+		"no annotation leaves the signature algorithm to the signer": {
+			expectedSigAlgo: x509.UnknownSignatureAlgorithm,
+		},
+		"annotation sets the signature algorithm": {
+			annotation:      string(cmapi.SHA384WithRSAPSS),
+			expectedSigAlgo: x509.SHA384WithRSAPSS,
+		},
+		"unknown signature algorithm annotation errors": {
+			annotation: "MD5WithRSA",
+			expectErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cr := &cmapi.CertificateRequest{Spec: cmapi.CertificateRequestSpec{Request: csrPEM}}
+			if len(test.annotation) > 0 {
+				cr.ObjectMeta = metav1.ObjectMeta{Annotations: map[string]string{
+					cmapi.CertificateRequestSignatureAlgorithmAnnotationKey: test.annotation,
+				}}
+			}
+
+			template, err := GenerateTemplateFromCertificateRequest(cr)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expectedSigAlgo, template.SignatureAlgorithm)
+
+			_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+			require.NoError(t, err)
+			if test.expectedSigAlgo != x509.UnknownSignatureAlgorithm {
+				assert.Equal(t, test.expectedSigAlgo, cert.SignatureAlgorithm)
+			}
+		})
+	}
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ct
+
+import (
+	"bytes"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+	"strings"
+	"testing"
+	"time"
+
+	ctgo "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+)
+
+type testLog struct {
+	operator string
+	key      *ecdsa.PrivateKey
+	state    string
+}
+
+func newTestLog(t *testing.T, operator, state string) testLog {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return testLog{operator: operator, key: key, state: state}
+}
+
+func (l testLog) id(t *testing.T) [sha256.Size]byte {
+	der, err := x509.MarshalPKIXPublicKey(l.key.Public())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sha256.Sum256(der)
+}
+
+func mustLogList(t *testing.T, logs ...testLog) []byte {
+	var operators []string
+	for i, l := range logs {
+		der, err := x509.MarshalPKIXPublicKey(l.key.Public())
+		if err != nil {
+			t.Fatal(err)
+		}
+		state := ""
+		if l.state != "" {
+			state = fmt.Sprintf(`, "state": {%q: {"timestamp": "2022-01-01T00:00:00Z"}}`, l.state)
+		}
+		operators = append(operators, fmt.Sprintf(`{"name": %q, "logs": [{"description": "log %d", "key": %q%s}]}`,
+			l.operator, i, base64.StdEncoding.EncodeToString(der), state))
+	}
+	return []byte(`{"operators": [` + strings.Join(operators, ",") + `]}`)
+}
+
+// mustCreateCertificate returns a CA certificate and a certificate issued by
+// it with SCTs from the given logs embedded.
+func mustCreateCertificate(t *testing.T, lifetime time.Duration, logs ...testLog) (*x509.Certificate, *x509.Certificate) {
+	caKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(24 * time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	caDER, err := x509.CreateCertificate(rand.Reader, caTmpl, caTmpl, caKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ca, err := x509.ParseCertificate(caDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	leafKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	notBefore := time.Now().Add(-time.Hour).Truncate(time.Second)
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    notBefore,
+		NotAfter:     notBefore.Add(lifetime),
+	}
+	precertDER, err := x509.CreateCertificate(rand.Reader, tmpl, ca, leafKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	precert, err := x509.ParseCertificate(precertDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entry := ctgo.LogEntry{
+		Leaf: ctgo.MerkleTreeLeaf{
+			Version:  ctgo.V1,
+			LeafType: ctgo.TimestampedEntryLeafType,
+			TimestampedEntry: &ctgo.TimestampedEntry{
+				EntryType: ctgo.PrecertLogEntryType,
+				PrecertEntry: &ctgo.PreCert{
+					IssuerKeyHash:  sha256.Sum256(ca.RawSubjectPublicKeyInfo),
+					TBSCertificate: precert.RawTBSCertificate,
+				},
+			},
+		},
+	}
+	var list ctx509.SignedCertificateTimestampList
+	for _, l := range logs {
+		s := ctgo.SignedCertificateTimestamp{
+			SCTVersion: ctgo.V1,
+			LogID:      ctgo.LogID{KeyID: l.id(t)},
+			Timestamp:  uint64(time.Now().UnixMilli()),
+		}
+		data, err := ctgo.SerializeSCTSignatureInput(s, entry)
+		if err != nil {
+			t.Fatal(err)
+		}
+		digest := sha256.Sum256(data)
+		signature, err := l.key.Sign(rand.Reader, digest[:], crypto.SHA256)
+		if err != nil {
+			t.Fatal(err)
+		}
+		s.Signature = ctgo.DigitallySigned{
+			Algorithm: cttls.SignatureAndHashAlgorithm{Hash: cttls.SHA256, Signature: cttls.ECDSA},
+			Signature: signature,
+		}
+		serialized, err := cttls.Marshal(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		list.SCTList = append(list.SCTList, ctx509.SerializedSCT{Val: serialized})
+	}
+	if len(list.SCTList) > 0 {
+		listBytes, err := cttls.Marshal(list)
+		if err != nil {
+			t.Fatal(err)
+		}
+		extValue, err := asn1.Marshal(listBytes)
+		if err != nil {
+			t.Fatal(err)
+		}
+		tmpl.ExtraExtensions = []pkix.Extension{{Id: oidExtensionSCTList, Value: extValue}}
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, tmpl, ca, leafKey.Public(), caKey)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cert, err := x509.ParseCertificate(certDER)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// The precertificate's TBSCertificate must be recoverable from the
+	// certificate for the SCTs to be verifiable.
+	tbs, err := precertTBSCertificate(cert.RawTBSCertificate)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(tbs, precert.RawTBSCertificate) {
+		t.Fatal("precertificate TBSCertificate was not recovered from the certificate")
+	}
+
+	return cert, ca
+}
+
+func TestVerify(t *testing.T) {
+	google := newTestLog(t, "Google", "usable")
+	google2 := newTestLog(t, "Google", "")
+	cloudflare := newTestLog(t, "Cloudflare", "usable")
+	digicert := newTestLog(t, "DigiCert", "qualified")
+	unknown := newTestLog(t, "Unknown", "")
+	rejected := newTestLog(t, "Rejected", "rejected")
+
+	logs, err := ParseLogList(mustLogList(t, google, google2, cloudflare, digicert, rejected))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := map[string]struct {
+		lifetime  time.Duration
+		logs      []testLog
+		expected  Result
+		compliant bool
+	}{
+		"two SCTs from distinct operators are enough for short lived certificates": {
+			lifetime:  90 * 24 * time.Hour,
+			logs:      []testLog{google, cloudflare},
+			expected:  Result{Valid: 2, Operators: 2, Required: 2},
+			compliant: true,
+		},
+		"long lived certificates need three SCTs": {
+			lifetime: 365 * 24 * time.Hour,
+			logs:     []testLog{google, cloudflare},
+			expected: Result{Valid: 2, Operators: 2, Required: 3},
+		},
+		"three SCTs are enough for long lived certificates": {
+			lifetime:  365 * 24 * time.Hour,
+			logs:      []testLog{google, cloudflare, digicert},
+			expected:  Result{Valid: 3, Operators: 3, Required: 3},
+			compliant: true,
+		},
+		"SCTs must come from distinct operators": {
+			lifetime: 90 * 24 * time.Hour,
+			logs:     []testLog{google, google2},
+			expected: Result{Valid: 2, Operators: 1, Required: 2},
+		},
+		"SCTs from unknown and rejected logs are not counted": {
+			lifetime: 90 * 24 * time.Hour,
+			logs:     []testLog{google, unknown, rejected},
+			expected: Result{Valid: 1, Operators: 1, Required: 2, Errors: []string{
+				fmt.Sprintf("SCT from unknown log %x", unknown.id(t)),
+				`SCT from log "log 4", which is rejected`,
+			}},
+		},
+		"certificates without SCTs are not compliant": {
+			lifetime: 90 * 24 * time.Hour,
+			expected: Result{Required: 2, Errors: []string{"the certificate does not contain any SCTs"}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			cert, issuer := mustCreateCertificate(t, test.lifetime, test.logs...)
+
+			result := logs.Verify(cert, issuer)
+			if result.String() != test.expected.String() {
+				t.Errorf("expected %q, got %q", test.expected, result)
+			}
+			if result.Compliant() != test.compliant {
+				t.Errorf("expected compliant to be %t, got %t", test.compliant, result.Compliant())
+			}
+		})
+	}
+}
+
+func TestVerifyInvalidSignature(t *testing.T) {
+	google := newTestLog(t, "Google", "")
+	cloudflare := newTestLog(t, "Cloudflare", "")
+
+	logs, err := ParseLogList(mustLogList(t, google, cloudflare))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// SCTs issued for a certificate from a different issuer are invalid.
+	cert, _ := mustCreateCertificate(t, 90*24*time.Hour, google, cloudflare)
+	_, otherIssuer := mustCreateCertificate(t, 90*24*time.Hour)
+
+	result := logs.Verify(cert, otherIssuer)
+	if result.Compliant() || result.Valid != 0 || len(result.Errors) != 2 {
+		t.Errorf("expected both SCTs to be invalid, got %q", result)
+	}
+}
+
+func TestParseLogList(t *testing.T) {
+	if _, err := ParseLogList([]byte(`{"operators": []}`)); err == nil {
+		t.Error("expected an error for a log list without logs")
+	}
+	if _, err := ParseLogList([]byte(`{"operators": [{"name": "test", "logs": [{"key": "bm90IGEga2V5"}]}]}`)); err == nil {
+		t.Error("expected an error for a log with an invalid key")
+	}
+}
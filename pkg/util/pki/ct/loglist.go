@@ -0,0 +1,133 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package ct verifies the Signed Certificate Timestamps (SCTs) embedded in
+// certificates against a list of Certificate Transparency logs, as described
+// in RFC 6962, and checks that a certificate has the SCTs required by the CT
+// policies of browsers.
+package ct
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Log states, as used in version 3 of the Chrome log list. SCTs from logs in
+// any other state, such as pending or rejected, are not accepted.
+const (
+	logStateQualified = "qualified"
+	logStateUsable    = "usable"
+	logStateReadOnly  = "readonly"
+	logStateRetired   = "retired"
+)
+
+// Log is a Certificate Transparency log.
+type Log struct {
+	// ID is the SHA-256 hash of the log's public key.
+	ID [sha256.Size]byte
+	// Description is a human readable description of the log.
+	Description string
+	// Operator is the name of the organisation operating the log.
+	Operator string
+	// Key is the public key of the log.
+	Key crypto.PublicKey
+
+	// State is the state of the log, and StateTime the time at which the log
+	// entered it. An empty State is treated as usable.
+	State     string
+	StateTime time.Time
+}
+
+// acceptsAt returns true if SCTs issued by the log at the given time count
+// towards the CT policy.
+func (l *Log) acceptsAt(timestamp time.Time) bool {
+	switch l.State {
+	case "", logStateQualified, logStateUsable, logStateReadOnly:
+		return true
+	case logStateRetired:
+		return timestamp.Before(l.StateTime)
+	default:
+		return false
+	}
+}
+
+// LogList is a list of Certificate Transparency logs.
+type LogList struct {
+	logs map[[sha256.Size]byte]*Log
+}
+
+// logListJSON is version 3 of the Chrome log list format, as published at
+// https://www.gstatic.com/ct/log_list/v3/log_list.json.
+type logListJSON struct {
+	Operators []struct {
+		Name string `json:"name"`
+		Logs []struct {
+			Description string `json:"description"`
+			Key         []byte `json:"key"`
+			State       map[string]struct {
+				Timestamp time.Time `json:"timestamp"`
+			} `json:"state"`
+		} `json:"logs"`
+	} `json:"operators"`
+}
+
+// ParseLogList parses a log list in version 3 of the Chrome log list format.
+func ParseLogList(data []byte) (*LogList, error) {
+	var list logListJSON
+	if err := json.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("error decoding CT log list: %w", err)
+	}
+
+	logs := &LogList{logs: map[[sha256.Size]byte]*Log{}}
+	for _, operator := range list.Operators {
+		for _, l := range operator.Logs {
+			key, err := x509.ParsePKIXPublicKey(l.Key)
+			if err != nil {
+				return nil, fmt.Errorf("error parsing key of CT log %q: %w", l.Description, err)
+			}
+			log := &Log{
+				ID:          sha256.Sum256(l.Key),
+				Description: l.Description,
+				Operator:    operator.Name,
+				Key:         key,
+			}
+			for state, details := range l.State {
+				log.State, log.StateTime = state, details.Timestamp
+			}
+			logs.logs[log.ID] = log
+		}
+	}
+
+	if len(logs.logs) == 0 {
+		return nil, fmt.Errorf("CT log list does not contain any logs")
+	}
+
+	return logs, nil
+}
+
+// LoadLogList reads and parses the log list stored in the named file.
+func LoadLogList(path string) (*LogList, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return ParseLogList(data)
+}
@@ -0,0 +1,260 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ct
+
+import (
+	"crypto"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/asn1"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	ctgo "github.com/google/certificate-transparency-go"
+	cttls "github.com/google/certificate-transparency-go/tls"
+	ctx509 "github.com/google/certificate-transparency-go/x509"
+	"golang.org/x/crypto/cryptobyte"
+	cbasn1 "golang.org/x/crypto/cryptobyte/asn1"
+)
+
+var (
+	// oidExtensionSCTList is the OID of the X.509 extension holding the list
+	// of SCTs embedded in a certificate.
+	oidExtensionSCTList = asn1.ObjectIdentifier(ctx509.OIDExtensionCTSCT)
+
+	// extensionsTag is the tag of the extensions of a TBSCertificate.
+	extensionsTag = cbasn1.Tag(3).Constructed().ContextSpecific()
+)
+
+const (
+	// shortLivedCertificateLifetime is the maximum lifetime of certificates
+	// which only need two SCTs.
+	shortLivedCertificateLifetime = 180 * 24 * time.Hour
+
+	// minimumDistinctOperators is the number of distinct log operators that
+	// a certificate needs SCTs from.
+	minimumDistinctOperators = 2
+)
+
+// Result is the result of verifying the SCTs embedded in a certificate.
+type Result struct {
+	// Valid is the number of embedded SCTs which were verified.
+	Valid int
+	// Operators is the number of distinct log operators with a verified SCT.
+	Operators int
+	// Required is the number of verified SCTs the certificate needs to
+	// comply with the CT policies of browsers.
+	Required int
+	// Errors describes why embedded SCTs could not be verified.
+	Errors []string
+}
+
+// Compliant returns true if the certificate has enough verified SCTs, from
+// enough distinct log operators, to comply with the CT policies of browsers.
+func (r Result) Compliant() bool {
+	return r.Valid >= r.Required && r.Operators >= minimumDistinctOperators
+}
+
+func (r Result) String() string {
+	s := fmt.Sprintf("%d of %d required SCTs are valid, from %d of %d required log operators",
+		r.Valid, r.Required, r.Operators, minimumDistinctOperators)
+	if len(r.Errors) > 0 {
+		s += ": " + strings.Join(r.Errors, "; ")
+	}
+	return s
+}
+
+// Verify verifies the SCTs embedded in the certificate, which was issued by
+// issuer. The number of SCTs required follows the CT policies of Chrome and
+// Apple: certificates valid for up to 180 days need two SCTs, and longer
+// lived certificates three, and the SCTs must come from logs run by at least
+// two distinct operators.
+func (l *LogList) Verify(cert, issuer *x509.Certificate) Result {
+	result := Result{Required: 2}
+	if cert.NotAfter.Sub(cert.NotBefore) > shortLivedCertificateLifetime {
+		result.Required = 3
+	}
+
+	scts, err := embeddedSCTs(cert)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+	if len(scts) == 0 {
+		result.Errors = append(result.Errors, "the certificate does not contain any SCTs")
+		return result
+	}
+
+	tbs, err := precertTBSCertificate(cert.RawTBSCertificate)
+	if err != nil {
+		result.Errors = append(result.Errors, err.Error())
+		return result
+	}
+	entry := ctgo.LogEntry{
+		Leaf: ctgo.MerkleTreeLeaf{
+			Version:  ctgo.V1,
+			LeafType: ctgo.TimestampedEntryLeafType,
+			TimestampedEntry: &ctgo.TimestampedEntry{
+				EntryType: ctgo.PrecertLogEntryType,
+				PrecertEntry: &ctgo.PreCert{
+					IssuerKeyHash:  sha256.Sum256(issuer.RawSubjectPublicKeyInfo),
+					TBSCertificate: tbs,
+				},
+			},
+		},
+	}
+
+	operators := map[string]bool{}
+	for _, s := range scts {
+		log, ok := l.logs[s.LogID.KeyID]
+		if !ok {
+			result.Errors = append(result.Errors, fmt.Sprintf("SCT from unknown log %x", s.LogID.KeyID))
+			continue
+		}
+		if !log.acceptsAt(time.UnixMilli(int64(s.Timestamp)).UTC()) {
+			result.Errors = append(result.Errors, fmt.Sprintf("SCT from log %q, which is %s", log.Description, log.State))
+			continue
+		}
+		if err := verifySCT(log.Key, s, entry); err != nil {
+			result.Errors = append(result.Errors, fmt.Sprintf("SCT from log %q is invalid: %s", log.Description, err))
+			continue
+		}
+		result.Valid++
+		operators[log.Operator] = true
+	}
+	result.Operators = len(operators)
+
+	return result
+}
+
+// embeddedSCTs returns the SCTs embedded in the certificate.
+func embeddedSCTs(cert *x509.Certificate) ([]*ctgo.SignedCertificateTimestamp, error) {
+	var extValue []byte
+	for _, ext := range cert.Extensions {
+		if ext.Id.Equal(oidExtensionSCTList) {
+			extValue = ext.Value
+			break
+		}
+	}
+	if extValue == nil {
+		return nil, nil
+	}
+
+	// The extension holds the TLS encoded SignedCertificateTimestampList
+	// wrapped in an OCTET STRING.
+	var raw []byte
+	if rest, err := asn1.Unmarshal(extValue, &raw); err != nil || len(rest) > 0 {
+		return nil, errors.New("malformed SCT list extension")
+	}
+	var list ctx509.SignedCertificateTimestampList
+	if rest, err := cttls.Unmarshal(raw, &list); err != nil || len(rest) > 0 {
+		return nil, errors.New("malformed SCT list")
+	}
+
+	var scts []*ctgo.SignedCertificateTimestamp
+	for _, serialized := range list.SCTList {
+		var s ctgo.SignedCertificateTimestamp
+		if rest, err := cttls.Unmarshal(serialized.Val, &s); err != nil || len(rest) > 0 {
+			return nil, errors.New("malformed SCT")
+		}
+		if s.SCTVersion != ctgo.V1 {
+			return nil, fmt.Errorf("unsupported SCT version %d", s.SCTVersion)
+		}
+		scts = append(scts, &s)
+	}
+
+	return scts, nil
+}
+
+// precertTBSCertificate returns the TBSCertificate of the precertificate
+// which was submitted to the CT logs, which is the certificate's
+// TBSCertificate without the SCT list extension.
+// The version of certificate-transparency-go used only builds precertificate
+// TBSCertificates from precertificates with a CT poison extension, rather
+// than from certificates with SCTs embedded.
+func precertTBSCertificate(rawTBS []byte) ([]byte, error) {
+	input := cryptobyte.String(rawTBS)
+	var tbs cryptobyte.String
+	if !input.ReadASN1(&tbs, cbasn1.SEQUENCE) || !input.Empty() {
+		return nil, errors.New("malformed TBSCertificate")
+	}
+
+	var b cryptobyte.Builder
+	b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+		for !tbs.Empty() {
+			var element cryptobyte.String
+			var tag cbasn1.Tag
+			if !tbs.ReadAnyASN1Element(&element, &tag) {
+				b.SetError(errors.New("malformed TBSCertificate"))
+				return
+			}
+			if tag != extensionsTag {
+				b.AddBytes(element)
+				continue
+			}
+
+			var explicit, extensions cryptobyte.String
+			if !element.ReadASN1(&explicit, extensionsTag) || !explicit.ReadASN1(&extensions, cbasn1.SEQUENCE) {
+				b.SetError(errors.New("malformed TBSCertificate extensions"))
+				return
+			}
+			var kept [][]byte
+			for !extensions.Empty() {
+				var ext, extBody cryptobyte.String
+				var oid asn1.ObjectIdentifier
+				if !extensions.ReadASN1Element(&ext, cbasn1.SEQUENCE) {
+					b.SetError(errors.New("malformed TBSCertificate extension"))
+					return
+				}
+				if extCopy := ext; !extCopy.ReadASN1(&extBody, cbasn1.SEQUENCE) || !extBody.ReadASN1ObjectIdentifier(&oid) {
+					b.SetError(errors.New("malformed TBSCertificate extension"))
+					return
+				}
+				if !oid.Equal(oidExtensionSCTList) {
+					kept = append(kept, ext)
+				}
+			}
+			if len(kept) == 0 {
+				continue
+			}
+			b.AddASN1(extensionsTag, func(b *cryptobyte.Builder) {
+				b.AddASN1(cbasn1.SEQUENCE, func(b *cryptobyte.Builder) {
+					for _, ext := range kept {
+						b.AddBytes(ext)
+					}
+				})
+			})
+		}
+	})
+
+	return b.Bytes()
+}
+
+// verifySCT verifies the signature of the SCT over the precertificate log
+// entry using the log's public key.
+func verifySCT(key crypto.PublicKey, s *ctgo.SignedCertificateTimestamp, entry ctgo.LogEntry) error {
+	verifier, err := ctgo.NewSignatureVerifier(key)
+	if err != nil {
+		return err
+	}
+	if err := verifier.VerifySCTSignature(*s, entry); err != nil {
+		return errors.New("invalid signature")
+	}
+	return nil
+}
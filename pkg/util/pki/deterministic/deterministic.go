@@ -0,0 +1,174 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package deterministic provides an entropy source for pkg/util/pki which
+// derives private keys and serial numbers from a seed, so that test
+// environments can reproduce the same certificates. Keys derived this way are
+// predictable, so this package must only be imported by tests and by
+// controllers built with the deterministic_issuance build tag.
+package deterministic
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+// entropySource is a pki.EntropySource which returns a stream of bytes derived
+// from a seed by hashing it with an incrementing counter.
+type entropySource struct {
+	lock    sync.Mutex
+	seed    []byte
+	counter uint64
+	buf     []byte
+}
+
+var _ pki.KeyGenerator = &entropySource{}
+
+// NewEntropySource returns a pki.EntropySource which produces the same
+// sequence of bytes, and so the same private keys and serial numbers, for the
+// same seed. Keys and serial numbers are only reproducible if they are
+// requested in the same order. Certificates are only reproducible if they are
+// also issued at the same time and signed with RSA or Ed25519 keys, as ECDSA
+// signatures are always randomised by the standard library.
+func NewEntropySource(seed []byte) pki.EntropySource {
+	return &entropySource{
+		seed: append([]byte(nil), seed...),
+	}
+}
+
+func (d *entropySource) Read(p []byte) (int, error) {
+	d.lock.Lock()
+	defer d.lock.Unlock()
+
+	n := 0
+	for n < len(p) {
+		if len(d.buf) == 0 {
+			var counter [8]byte
+			binary.BigEndian.PutUint64(counter[:], d.counter)
+			d.counter++
+			block := sha256.Sum256(append(append([]byte(nil), d.seed...), counter[:]...))
+			d.buf = block[:]
+		}
+		c := copy(p[n:], d.buf)
+		d.buf = d.buf[c:]
+		n += c
+	}
+	return n, nil
+}
+
+// GenerateRSAKey derives an RSA private key from the next bytes of the
+// stream.
+func (d *entropySource) GenerateRSAKey(bits int) (*rsa.PrivateKey, error) {
+	return deriveRSAPrivateKey(d, bits)
+}
+
+// GenerateECKey derives an ECDSA private key on the given curve from the next
+// bytes of the stream.
+func (d *entropySource) GenerateECKey(curve elliptic.Curve) (*ecdsa.PrivateKey, error) {
+	return deriveECPrivateKey(d, curve)
+}
+
+var one = big.NewInt(1)
+
+// deriveRSAPrivateKey generates an RSA private key using only the bytes read
+// from r, so that the same input always results in the same key.
+func deriveRSAPrivateKey(r io.Reader, keySize int) (*rsa.PrivateKey, error) {
+	e := big.NewInt(65537)
+	for {
+		p, err := derivePrime(r, keySize-keySize/2)
+		if err != nil {
+			return nil, err
+		}
+		q, err := derivePrime(r, keySize/2)
+		if err != nil {
+			return nil, err
+		}
+		if p.Cmp(q) == 0 {
+			continue
+		}
+
+		n := new(big.Int).Mul(p, q)
+		if n.BitLen() != keySize {
+			continue
+		}
+		totient := new(big.Int).Mul(new(big.Int).Sub(p, one), new(big.Int).Sub(q, one))
+		d := new(big.Int).ModInverse(e, totient)
+		if d == nil {
+			continue
+		}
+
+		key := &rsa.PrivateKey{
+			PublicKey: rsa.PublicKey{N: n, E: int(e.Int64())},
+			D:         d,
+			Primes:    []*big.Int{p, q},
+		}
+		key.Precompute()
+		return key, nil
+	}
+}
+
+// derivePrime returns the first probable prime of the given bit length
+// found by reading candidates from r.
+func derivePrime(r io.Reader, bits int) (*big.Int, error) {
+	if bits < 2 {
+		return nil, errors.New("prime size must be at least 2 bits")
+	}
+	b := make([]byte, (bits+7)/8)
+	for {
+		if _, err := io.ReadFull(r, b); err != nil {
+			return nil, err
+		}
+		p := new(big.Int).SetBytes(b)
+		// Truncate to the requested size, and set the top two bits so the
+		// product of two primes has exactly twice as many bits.
+		p.Rsh(p, uint(len(b)*8-bits))
+		p.SetBit(p, bits-1, 1)
+		p.SetBit(p, bits-2, 1)
+		p.SetBit(p, 0, 1)
+		if p.ProbablyPrime(20) {
+			return p, nil
+		}
+	}
+}
+
+// deriveECPrivateKey generates an ECDSA private key on the given curve using
+// only the bytes read from r, following the method in FIPS 186-4 B.4.1.
+func deriveECPrivateKey(r io.Reader, curve elliptic.Curve) (*ecdsa.PrivateKey, error) {
+	params := curve.Params()
+	b := make([]byte, params.BitSize/8+8)
+	if _, err := io.ReadFull(r, b); err != nil {
+		return nil, err
+	}
+
+	k := new(big.Int).SetBytes(b)
+	n := new(big.Int).Sub(params.N, one)
+	k.Mod(k, n)
+	k.Add(k, one)
+
+	key := &ecdsa.PrivateKey{D: k}
+	key.PublicKey.Curve = curve
+	key.PublicKey.X, key.PublicKey.Y = curve.ScalarBaseMult(k.Bytes())
+	return key, nil
+}
@@ -0,0 +1,72 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package deterministic
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+	"github.com/cert-manager/cert-manager/pkg/util/pki"
+)
+
+func TestEntropySource(t *testing.T) {
+	generate := func(seed string) ([][]byte, string) {
+		pki.SetEntropySource(NewEntropySource([]byte(seed)))
+		defer pki.SetEntropySource(nil)
+
+		// keys are generated in a fixed order as each one consumes bytes
+		// from the same stream
+		var keys [][]byte
+		for _, spec := range []v1.CertificatePrivateKey{
+			{Algorithm: v1.RSAKeyAlgorithm, Size: 2048},
+			{Algorithm: v1.ECDSAKeyAlgorithm, Size: 256},
+			{Algorithm: v1.Ed25519KeyAlgorithm},
+		} {
+			spec := spec
+			key, err := pki.GeneratePrivateKeyForCertificate(&v1.Certificate{Spec: v1.CertificateSpec{PrivateKey: &spec}})
+			require.NoError(t, err)
+			pem, err := pki.EncodePrivateKey(key, v1.PKCS8)
+			require.NoError(t, err)
+			keys = append(keys, pem)
+		}
+
+		template, err := pki.GenerateTemplate(&v1.Certificate{Spec: v1.CertificateSpec{CommonName: "example.com"}})
+		require.NoError(t, err)
+		return keys, template.SerialNumber.String()
+	}
+
+	keys, serial := generate("seed")
+	sameKeys, sameSerial := generate("seed")
+	otherKeys, otherSerial := generate("other-seed")
+
+	assert.Equal(t, keys, sameKeys)
+	assert.Equal(t, serial, sameSerial)
+	for i := range keys {
+		assert.NotEqual(t, keys[i], otherKeys[i])
+	}
+	assert.NotEqual(t, serial, otherSerial)
+}
+
+func TestDeriveRSAPrivateKeyIsValid(t *testing.T) {
+	key, err := deriveRSAPrivateKey(NewEntropySource([]byte("seed")), 2048)
+	require.NoError(t, err)
+	assert.Equal(t, 2048, key.N.BitLen())
+	assert.NoError(t, key.Validate())
+}
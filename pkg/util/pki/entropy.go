@@ -0,0 +1,62 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"io"
+	"sync"
+)
+
+// EntropySource provides the randomness used when generating private keys,
+// serial numbers and signatures.
+type EntropySource io.Reader
+
+// KeyGenerator may be implemented by an EntropySource which needs to generate
+// private keys itself. The standard library key generators deliberately
+// consume a varying number of bytes from their reader, so an EntropySource
+// that must produce the same keys from the same input cannot rely on them.
+type KeyGenerator interface {
+	GenerateRSAKey(bits int) (*rsa.PrivateKey, error)
+	GenerateECKey(curve elliptic.Curve) (*ecdsa.PrivateKey, error)
+}
+
+var (
+	entropyLock sync.RWMutex
+	entropy     EntropySource = rand.Reader
+)
+
+// SetEntropySource replaces the source of randomness used by this package.
+// Passing nil restores crypto/rand. This is only intended to be used by tests,
+// and by controllers built with the deterministic_issuance build tag.
+func SetEntropySource(s EntropySource) {
+	entropyLock.Lock()
+	defer entropyLock.Unlock()
+	if s == nil {
+		s = rand.Reader
+	}
+	entropy = s
+}
+
+func entropySource() EntropySource {
+	entropyLock.RLock()
+	defer entropyLock.RUnlock()
+	return entropy
+}
@@ -0,0 +1,120 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"strings"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// reservedExtensions are the extensions that cert-manager or the signer sets
+// itself, and which therefore cannot be requested as extra extensions.
+var reservedExtensions = []asn1.ObjectIdentifier{
+	OIDExtensionKeyUsage,
+	OIDExtensionExtendedKeyUsage,
+	OIDExtensionSubjectAltName,
+	OIDExtensionBasicConstraints,
+	OIDExtensionNameConstraints,
+	OIDExtensionCertificatePolicies,
+	{2, 5, 29, 14},              // subjectKeyIdentifier
+	{2, 5, 29, 31},              // cRLDistributionPoints
+	{2, 5, 29, 35},              // authorityKeyIdentifier
+	{1, 3, 6, 1, 5, 5, 7, 1, 1}, // authorityInfoAccess
+}
+
+// IsReservedExtension returns true if the extension with the given OID is
+// set by cert-manager or the signer, and so cannot be requested as an extra
+// extension.
+func IsReservedExtension(oid asn1.ObjectIdentifier) bool {
+	for _, reserved := range reservedExtensions {
+		if oid.Equal(reserved) {
+			return true
+		}
+	}
+	return false
+}
+
+// buildExtraExtensionsForCertificate returns the extra extensions requested by
+// the given Certificate.
+func buildExtraExtensionsForCertificate(crt *v1.Certificate) ([]pkix.Extension, error) {
+	var extensions []pkix.Extension
+	for _, ext := range crt.Spec.ExtraExtensions {
+		oid, err := ParseObjectIdentifier(ext.OID)
+		if err != nil {
+			return nil, fmt.Errorf("invalid extra extension: %w", err)
+		}
+		if IsReservedExtension(oid) {
+			return nil, fmt.Errorf("extension %s cannot be requested as an extra extension", oid)
+		}
+		extensions = append(extensions, pkix.Extension{Id: oid, Critical: ext.Critical, Value: ext.Value})
+	}
+	return extensions, nil
+}
+
+// ExtraExtensionsAnnotationValue returns the value of the
+// `cert-manager.io/extra-extensions` CertificateRequest annotation for the
+// given extra extensions.
+func ExtraExtensionsAnnotationValue(extensions []v1.CertificateExtension) string {
+	oids := make([]string, len(extensions))
+	for i, ext := range extensions {
+		oids[i] = ext.OID
+	}
+	return strings.Join(oids, ",")
+}
+
+// ApplyExtraExtensions copies the extensions of the given x509 certificate
+// request whose OIDs are listed in the `cert-manager.io/extra-extensions`
+// annotation value to a certificate template.
+func ApplyExtraExtensions(template *x509.Certificate, csr *x509.CertificateRequest, annotation string) error {
+	for _, s := range strings.Split(annotation, ",") {
+		oid, err := ParseObjectIdentifier(strings.TrimSpace(s))
+		if err != nil {
+			return fmt.Errorf("invalid extra extension: %w", err)
+		}
+		if IsReservedExtension(oid) {
+			return fmt.Errorf("extension %s cannot be requested as an extra extension", oid)
+		}
+		ext := findExtension(csr.Extensions, oid)
+		if ext == nil {
+			return fmt.Errorf("extra extension %s is not present in the CSR", oid)
+		}
+		template.ExtraExtensions = append(template.ExtraExtensions, *ext)
+	}
+	return nil
+}
+
+// ExtraExtensionsMatchSpec returns true if the given x509 certificate request
+// contains every extra extension requested by the given Certificate spec.
+func ExtraExtensionsMatchSpec(csr *x509.CertificateRequest, spec v1.CertificateSpec) (bool, error) {
+	expected, err := buildExtraExtensionsForCertificate(&v1.Certificate{Spec: spec})
+	if err != nil {
+		return false, err
+	}
+
+	for i := range expected {
+		if !extensionsEqual(&expected[i], findExtension(csr.Extensions, expected[i].Id)) {
+			return false, nil
+		}
+	}
+
+	return true, nil
+}
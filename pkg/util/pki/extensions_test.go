@@ -0,0 +1,156 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+var testExtraExtensions = []v1.CertificateExtension{
+	{OID: "1.3.6.1.4.1.11129.2.4.3", Critical: true, Value: []byte{0x05, 0x00}},
+	{OID: "1.2.3.4", Value: []byte{0x0c, 0x02, 'h', 'i'}},
+}
+
+func extraExtensionsCSR(t *testing.T, extensions []v1.CertificateExtension) *x509.CertificateRequest {
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+
+	extra, err := buildExtraExtensionsForCertificate(&v1.Certificate{Spec: v1.CertificateSpec{ExtraExtensions: extensions}})
+	require.NoError(t, err)
+
+	csrDER, err := EncodeCSR(&x509.CertificateRequest{DNSNames: []string{"example.com"}, ExtraExtensions: extra}, pk)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+	return csr
+}
+
+func TestIsReservedExtension(t *testing.T) {
+	assert.True(t, IsReservedExtension(OIDExtensionSubjectAltName))
+	assert.True(t, IsReservedExtension(asn1.ObjectIdentifier{2, 5, 29, 14}))
+	assert.False(t, IsReservedExtension(asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}))
+}
+
+func TestBuildExtraExtensionsForCertificate(t *testing.T) {
+	extensions, err := buildExtraExtensionsForCertificate(&v1.Certificate{Spec: v1.CertificateSpec{ExtraExtensions: testExtraExtensions}})
+	require.NoError(t, err)
+	assert.Equal(t, []pkix.Extension{
+		{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}, Critical: true, Value: []byte{0x05, 0x00}},
+		{Id: asn1.ObjectIdentifier{1, 2, 3, 4}, Value: []byte{0x0c, 0x02, 'h', 'i'}},
+	}, extensions)
+
+	_, err = buildExtraExtensionsForCertificate(&v1.Certificate{Spec: v1.CertificateSpec{ExtraExtensions: []v1.CertificateExtension{
+		{OID: "2.5.29.17", Value: []byte{0x05, 0x00}},
+	}}})
+	assert.Error(t, err)
+
+	_, err = buildExtraExtensionsForCertificate(&v1.Certificate{Spec: v1.CertificateSpec{ExtraExtensions: []v1.CertificateExtension{
+		{OID: "ext", Value: []byte{0x05, 0x00}},
+	}}})
+	assert.Error(t, err)
+}
+
+func TestApplyExtraExtensions(t *testing.T) {
+	csr := extraExtensionsCSR(t, testExtraExtensions)
+
+	tests := map[string]struct {
+		annotation string
+		expected   []pkix.Extension
+		expectErr  bool
+	}{
+		"only the extensions listed in the annotation are copied": {
+			annotation: "1.2.3.4",
+			expected: []pkix.Extension{
+				{Id: asn1.ObjectIdentifier{1, 2, 3, 4}, Value: []byte{0x0c, 0x02, 'h', 'i'}},
+			},
+		},
+		"all listed extensions are copied": {
+			annotation: ExtraExtensionsAnnotationValue(testExtraExtensions),
+			expected: []pkix.Extension{
+				{Id: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 11129, 2, 4, 3}, Critical: true, Value: []byte{0x05, 0x00}},
+				{Id: asn1.ObjectIdentifier{1, 2, 3, 4}, Value: []byte{0x0c, 0x02, 'h', 'i'}},
+			},
+		},
+		"reserved extensions cannot be copied": {
+			annotation: "2.5.29.17",
+			expectErr:  true,
+		},
+		"extensions missing from the CSR error": {
+			annotation: "1.2.3.5",
+			expectErr:  true,
+		},
+		"invalid OIDs error": {
+			annotation: "1.2.3.4,ext",
+			expectErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			template := &x509.Certificate{}
+			err := ApplyExtraExtensions(template, csr, test.annotation)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, template.ExtraExtensions)
+		})
+	}
+}
+
+func TestExtraExtensionsMatchSpec(t *testing.T) {
+	csr := extraExtensionsCSR(t, testExtraExtensions)
+
+	tests := map[string]struct {
+		extensions []v1.CertificateExtension
+		expected   bool
+	}{
+		"no extra extensions requested": {
+			expected: true,
+		},
+		"all extra extensions present": {
+			extensions: testExtraExtensions,
+			expected:   true,
+		},
+		"extension value differs": {
+			extensions: []v1.CertificateExtension{{OID: "1.2.3.4", Value: []byte{0x0c, 0x02, 'h', 'o'}}},
+		},
+		"extension criticality differs": {
+			extensions: []v1.CertificateExtension{{OID: "1.2.3.4", Critical: true, Value: []byte{0x0c, 0x02, 'h', 'i'}}},
+		},
+		"extension missing from the CSR": {
+			extensions: []v1.CertificateExtension{{OID: "1.2.3.5", Value: []byte{0x05, 0x00}}},
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			matches, err := ExtraExtensionsMatchSpec(csr, v1.CertificateSpec{ExtraExtensions: test.extensions})
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, matches)
+		})
+	}
+}
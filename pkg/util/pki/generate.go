@@ -21,7 +21,6 @@ import (
 	"crypto/ecdsa"
 	"crypto/ed25519"
 	"crypto/elliptic"
-	"crypto/rand"
 	"crypto/rsa"
 	"crypto/x509"
 	"encoding/pem"
@@ -50,7 +49,7 @@ const (
 // GeneratePrivateKeyForCertificate will generate a private key suitable for
 // the provided cert-manager Certificate resource, taking into account the
 // parameters on the provided resource.
-// The returned key will either be RSA or ECDSA.
+// The returned key will either be RSA, ECDSA or Ed25519.
 func GeneratePrivateKeyForCertificate(crt *v1.Certificate) (crypto.Signer, error) {
 	crt = crt.DeepCopy()
 	if crt.Spec.PrivateKey == nil {
@@ -92,7 +91,11 @@ func GenerateRSAPrivateKey(keySize int) (*rsa.PrivateKey, error) {
 		return nil, fmt.Errorf("rsa key size specified too big: %d. maximum key size: %d", keySize, MaxRSAKeySize)
 	}
 
-	return rsa.GenerateKey(rand.Reader, keySize)
+	source := entropySource()
+	if g, ok := source.(KeyGenerator); ok {
+		return g.GenerateRSAKey(keySize)
+	}
+	return rsa.GenerateKey(source, keySize)
 }
 
 // GenerateECPrivateKey will generate an ECDSA private key of the given size.
@@ -111,20 +114,22 @@ func GenerateECPrivateKey(keySize int) (*ecdsa.PrivateKey, error) {
 		return nil, fmt.Errorf("unsupported ecdsa key size specified: %d", keySize)
 	}
 
-	return ecdsa.GenerateKey(ecCurve, rand.Reader)
+	source := entropySource()
+	if g, ok := source.(KeyGenerator); ok {
+		return g.GenerateECKey(ecCurve)
+	}
+	return ecdsa.GenerateKey(ecCurve, source)
 }
 
 // GenerateEd25519PrivateKey will generate an Ed25519 private key
 func GenerateEd25519PrivateKey() (ed25519.PrivateKey, error) {
-
-	_, prvkey, err := ed25519.GenerateKey(rand.Reader)
-
+	_, prvkey, err := ed25519.GenerateKey(entropySource())
 	return prvkey, err
 }
 
 // EncodePrivateKey will encode a given crypto.PrivateKey by first inspecting
 // the type of key encoding and then inspecting the type of key provided.
-// It only supports encoding RSA or ECDSA keys.
+// It supports encoding RSA, ECDSA and Ed25519 keys.
 func EncodePrivateKey(pk crypto.PrivateKey, keyEncoding v1.PrivateKeyEncoding) ([]byte, error) {
 	switch keyEncoding {
 	case v1.PrivateKeyEncoding(""), v1.PKCS1:
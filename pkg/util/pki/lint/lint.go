@@ -0,0 +1,135 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package lint runs checks against issued certificates to catch
+// certificates that were mis-issued, for example by a misconfigured private
+// CA.
+//
+// Lints are identified by name. The built-in lints use the names of the
+// equivalent zlint (https://github.com/zmap/zlint) lints so that a
+// zlint-backed implementation can be registered in their place, and
+// additional zlint lints can be made available by registering an adapter
+// with Register.
+package lint
+
+import (
+	"crypto/x509"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// A Lint is a single check run against an issued certificate chain.
+type Lint interface {
+	// Name uniquely identifies the lint.
+	Name() string
+
+	// Check returns an error describing the problem if the chain fails the
+	// lint. The first certificate in the chain is the issued certificate,
+	// followed by the certificates of its issuers, if known.
+	Check(chain []*x509.Certificate) error
+}
+
+var (
+	registryLock sync.RWMutex
+	registry     = map[string]Lint{}
+)
+
+// Register makes a lint available to NewLinter, replacing any lint already
+// registered with the same name.
+func Register(l Lint) {
+	registryLock.Lock()
+	defer registryLock.Unlock()
+	registry[l.Name()] = l
+}
+
+// Names returns the names of all registered lints, sorted alphabetically.
+func Names() []string {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	names := make([]string, 0, len(registry))
+	for name := range registry {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Failure records a lint that a certificate chain did not pass.
+type Failure struct {
+	// Lint is the name of the failed lint.
+	Lint string
+
+	// Message describes why the lint failed.
+	Message string
+}
+
+// Failures is a list of failed lints.
+type Failures []Failure
+
+func (f Failures) String() string {
+	msgs := make([]string, len(f))
+	for i, failure := range f {
+		msgs[i] = fmt.Sprintf("%s: %s", failure.Lint, failure.Message)
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// Linter runs a fixed set of lints.
+type Linter struct {
+	lints []Lint
+}
+
+// NewLinter returns a Linter running the registered lints with the given
+// names. An error is returned if any of the names is not registered.
+func NewLinter(names []string) (*Linter, error) {
+	registryLock.RLock()
+	defer registryLock.RUnlock()
+
+	var unknown []string
+	linter := &Linter{}
+	for _, name := range names {
+		l, ok := registry[name]
+		if !ok {
+			unknown = append(unknown, name)
+			continue
+		}
+		linter.lints = append(linter.lints, l)
+	}
+	if len(unknown) > 0 {
+		return nil, fmt.Errorf("unknown certificate lints: %s", strings.Join(unknown, ", "))
+	}
+
+	return linter, nil
+}
+
+// Lint runs all of the Linter's lints against the chain, returning the ones
+// that failed in the order they were configured.
+func (l *Linter) Lint(chain []*x509.Certificate) Failures {
+	if len(chain) == 0 {
+		return nil
+	}
+
+	var failures Failures
+	for _, lint := range l.lints {
+		if err := lint.Check(chain); err != nil {
+			failures = append(failures, Failure{Lint: lint.Name(), Message: err.Error()})
+		}
+	}
+	return failures
+}
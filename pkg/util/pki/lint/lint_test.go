@@ -0,0 +1,221 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"math/big"
+	"net"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+)
+
+func leafCert(mod func(*x509.Certificate)) *x509.Certificate {
+	now := time.Now()
+	cert := &x509.Certificate{
+		SerialNumber: big.NewInt(1234),
+		Subject:      pkix.Name{CommonName: "example.com"},
+		DNSNames:     []string{"example.com"},
+		NotBefore:    now,
+		NotAfter:     now.Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		PublicKey:    &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 2047), E: 65537},
+	}
+	if mod != nil {
+		mod(cert)
+	}
+	return cert
+}
+
+func caCert(mod func(*x509.Certificate)) *x509.Certificate {
+	cert := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "Example CA"},
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	if mod != nil {
+		mod(cert)
+	}
+	return cert
+}
+
+func TestLints(t *testing.T) {
+	tests := map[string]struct {
+		lint  string
+		chain []*x509.Certificate
+		err   string
+	}{
+		"san present": {
+			lint:  "e_ext_san_missing",
+			chain: []*x509.Certificate{leafCert(nil)},
+		},
+		"san missing": {
+			lint:  "e_ext_san_missing",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.DNSNames = nil })},
+			err:   "certificate has no subject alternative names",
+		},
+		"san missing on CA certificate is ignored": {
+			lint:  "e_ext_san_missing",
+			chain: []*x509.Certificate{caCert(nil)},
+		},
+		"common name matches DNS name case insensitively": {
+			lint:  "e_subject_common_name_not_from_san",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.Subject.CommonName = "EXAMPLE.com" })},
+		},
+		"common name matches IP address": {
+			lint: "e_subject_common_name_not_from_san",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) {
+				c.Subject.CommonName = "10.0.0.1"
+				c.IPAddresses = []net.IP{net.ParseIP("10.0.0.1")}
+			})},
+		},
+		"common name not in SANs": {
+			lint:  "e_subject_common_name_not_from_san",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.Subject.CommonName = "other.example.com" })},
+			err:   `common name "other.example.com" is not a DNS or IP subject alternative name`,
+		},
+		"cert sign on leaf": {
+			lint:  "e_ext_key_usage_cert_sign_without_ca",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.KeyUsage |= x509.KeyUsageCertSign })},
+			err:   "certificate has the cert sign key usage but is not a CA",
+		},
+		"cert sign on CA": {
+			lint:  "e_ext_key_usage_cert_sign_without_ca",
+			chain: []*x509.Certificate{leafCert(nil), caCert(nil)},
+		},
+		"issuer is CA": {
+			lint:  "e_ca_is_ca",
+			chain: []*x509.Certificate{leafCert(nil), caCert(nil)},
+		},
+		"issuer is not CA": {
+			lint:  "e_ca_is_ca",
+			chain: []*x509.Certificate{leafCert(nil), caCert(func(c *x509.Certificate) { c.IsCA = false })},
+			err:   `issuer certificate "CN=Example CA" is not a CA`,
+		},
+		"2048 bit RSA key": {
+			lint:  "e_rsa_mod_less_than_2048_bits",
+			chain: []*x509.Certificate{leafCert(nil)},
+		},
+		"1024 bit RSA key": {
+			lint: "e_rsa_mod_less_than_2048_bits",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) {
+				c.PublicKey = &rsa.PublicKey{N: new(big.Int).Lsh(big.NewInt(1), 1023), E: 65537}
+			})},
+			err: "RSA modulus is 1024 bits, must be at least 2048",
+		},
+		"zero serial number": {
+			lint:  "e_serial_number_not_positive",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.SerialNumber = big.NewInt(0) })},
+			err:   "serial number 0 is not positive",
+		},
+		"20 octet serial number": {
+			lint:  "e_serial_number_longer_than_20_octets",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.SerialNumber = new(big.Int).Lsh(big.NewInt(1), 159) })},
+		},
+		"21 octet serial number": {
+			lint:  "e_serial_number_longer_than_20_octets",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.SerialNumber = new(big.Int).Lsh(big.NewInt(1), 160) })},
+			err:   "serial number is 21 octets long, must be at most 20",
+		},
+		"expires before it is valid": {
+			lint:  "e_validity_time_not_positive",
+			chain: []*x509.Certificate{leafCert(func(c *x509.Certificate) { c.NotAfter = c.NotBefore.Add(-time.Second) })},
+			err:   "notAfter",
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			linter, err := NewLinter([]string{test.lint})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			failures := linter.Lint(test.chain)
+			if test.err == "" {
+				if len(failures) > 0 {
+					t.Errorf("unexpected failures: %s", failures)
+				}
+				return
+			}
+
+			if len(failures) != 1 {
+				t.Fatalf("expected a single failure, got %v", failures)
+			}
+			if failures[0].Lint != test.lint || !strings.Contains(failures[0].Message, test.err) {
+				t.Errorf("expected %s failure containing %q, got %s", test.lint, test.err, failures)
+			}
+		})
+	}
+}
+
+func TestNewLinterUnknownLint(t *testing.T) {
+	_, err := NewLinter([]string{"e_ext_san_missing", "e_does_not_exist", "w_nor_this"})
+	if err == nil || err.Error() != "unknown certificate lints: e_does_not_exist, w_nor_this" {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestLinterReportsAllFailuresInOrder(t *testing.T) {
+	linter, err := NewLinter([]string{"e_serial_number_not_positive", "e_ext_san_missing"})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	failures := linter.Lint([]*x509.Certificate{leafCert(func(c *x509.Certificate) {
+		c.SerialNumber = big.NewInt(-1)
+		c.DNSNames = nil
+	})})
+
+	expected := "e_serial_number_not_positive: serial number -1 is not positive; e_ext_san_missing: certificate has no subject alternative names"
+	if failures.String() != expected {
+		t.Errorf("expected %q, got %q", expected, failures.String())
+	}
+}
+
+type testLint struct{}
+
+func (testLint) Name() string                    { return "e_test_plugin" }
+func (testLint) Check([]*x509.Certificate) error { return nil }
+
+func TestRegister(t *testing.T) {
+	Register(testLint{})
+	defer func() {
+		registryLock.Lock()
+		delete(registry, "e_test_plugin")
+		registryLock.Unlock()
+	}()
+
+	names := Names()
+	found := false
+	for _, name := range names {
+		found = found || name == "e_test_plugin"
+	}
+	if !found {
+		t.Errorf("expected registered lint in %v", names)
+	}
+
+	if !reflect.DeepEqual(names[:2], []string{"e_ca_is_ca", "e_ext_key_usage_cert_sign_without_ca"}) {
+		t.Errorf("expected names to be sorted, got %v", names)
+	}
+}
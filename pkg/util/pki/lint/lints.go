@@ -0,0 +1,153 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package lint
+
+import (
+	"crypto/rsa"
+	"crypto/x509"
+	"fmt"
+	"strings"
+)
+
+// funcLint adapts a function to the Lint interface.
+type funcLint struct {
+	name  string
+	check func(chain []*x509.Certificate) error
+}
+
+func (f funcLint) Name() string                          { return f.name }
+func (f funcLint) Check(chain []*x509.Certificate) error { return f.check(chain) }
+
+func init() {
+	for _, l := range []funcLint{
+		{name: "e_ext_san_missing", check: extSANMissing},
+		{name: "e_subject_common_name_not_from_san", check: subjectCommonNameNotFromSAN},
+		{name: "e_ext_key_usage_cert_sign_without_ca", check: keyUsageCertSignWithoutCA},
+		{name: "e_ca_is_ca", check: caIsCA},
+		{name: "e_rsa_mod_less_than_2048_bits", check: rsaModLessThan2048Bits},
+		{name: "e_serial_number_not_positive", check: serialNumberNotPositive},
+		{name: "e_serial_number_longer_than_20_octets", check: serialNumberLongerThan20Octets},
+		{name: "e_validity_time_not_positive", check: validityTimeNotPositive},
+	} {
+		Register(l)
+	}
+}
+
+// extSANMissing checks that end-entity certificates have a subject
+// alternative name.
+func extSANMissing(chain []*x509.Certificate) error {
+	cert := chain[0]
+	if cert.IsCA {
+		return nil
+	}
+	if len(cert.DNSNames) == 0 && len(cert.IPAddresses) == 0 && len(cert.URIs) == 0 && len(cert.EmailAddresses) == 0 {
+		return fmt.Errorf("certificate has no subject alternative names")
+	}
+	return nil
+}
+
+// subjectCommonNameNotFromSAN checks that the common name of end-entity
+// certificates is one of its DNS or IP subject alternative names.
+func subjectCommonNameNotFromSAN(chain []*x509.Certificate) error {
+	cert := chain[0]
+	cn := cert.Subject.CommonName
+	if cert.IsCA || cn == "" {
+		return nil
+	}
+	for _, name := range cert.DNSNames {
+		if strings.EqualFold(name, cn) {
+			return nil
+		}
+	}
+	for _, ip := range cert.IPAddresses {
+		if ip.String() == cn {
+			return nil
+		}
+	}
+	return fmt.Errorf("common name %q is not a DNS or IP subject alternative name", cn)
+}
+
+// keyUsageCertSignWithoutCA checks that no certificate in the chain has the
+// certificate signing key usage without being a CA.
+func keyUsageCertSignWithoutCA(chain []*x509.Certificate) error {
+	for i, cert := range chain {
+		if cert.KeyUsage&x509.KeyUsageCertSign != 0 && !(cert.BasicConstraintsValid && cert.IsCA) {
+			return fmt.Errorf("%s has the cert sign key usage but is not a CA", describe(i, cert))
+		}
+	}
+	return nil
+}
+
+// caIsCA checks that the issuers in the chain are CAs.
+func caIsCA(chain []*x509.Certificate) error {
+	for i, cert := range chain[1:] {
+		if !cert.BasicConstraintsValid || !cert.IsCA {
+			return fmt.Errorf("%s is not a CA", describe(i+1, cert))
+		}
+	}
+	return nil
+}
+
+// rsaModLessThan2048Bits checks that the issued certificate does not have
+// a weak RSA key.
+func rsaModLessThan2048Bits(chain []*x509.Certificate) error {
+	pub, ok := chain[0].PublicKey.(*rsa.PublicKey)
+	if !ok {
+		return nil
+	}
+	if size := pub.N.BitLen(); size < 2048 {
+		return fmt.Errorf("RSA modulus is %d bits, must be at least 2048", size)
+	}
+	return nil
+}
+
+// serialNumberNotPositive checks that the issued certificate has a positive
+// serial number.
+func serialNumberNotPositive(chain []*x509.Certificate) error {
+	if chain[0].SerialNumber.Sign() <= 0 {
+		return fmt.Errorf("serial number %s is not positive", chain[0].SerialNumber)
+	}
+	return nil
+}
+
+// serialNumberLongerThan20Octets checks that the issued certificate's
+// serial number is no longer than the maximum allowed by RFC 5280.
+func serialNumberLongerThan20Octets(chain []*x509.Certificate) error {
+	if n := len(chain[0].SerialNumber.Bytes()); n > 20 {
+		return fmt.Errorf("serial number is %d octets long, must be at most 20", n)
+	}
+	return nil
+}
+
+// validityTimeNotPositive checks that the issued certificate does not
+// expire before it becomes valid.
+func validityTimeNotPositive(chain []*x509.Certificate) error {
+	cert := chain[0]
+	if cert.NotAfter.Before(cert.NotBefore) {
+		return fmt.Errorf("notAfter %s is before notBefore %s", cert.NotAfter, cert.NotBefore)
+	}
+	return nil
+}
+
+// describe returns a human readable reference to the certificate at index i
+// of a chain.
+func describe(i int, cert *x509.Certificate) string {
+	if i == 0 {
+		return "certificate"
+	}
+	return fmt.Sprintf("issuer certificate %q", cert.Subject.String())
+}
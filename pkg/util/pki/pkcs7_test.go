@@ -0,0 +1,87 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncodePKCS7Certificates(t *testing.T) {
+	caKey, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	caTmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "ca"},
+		NotBefore:             time.Now(),
+		NotAfter:              time.Now().Add(time.Hour),
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	_, caCert, err := SignCertificate(caTmpl, caTmpl, caKey.Public(), caKey)
+	require.NoError(t, err)
+
+	leafKey, err := GenerateECPrivateKey(256)
+	require.NoError(t, err)
+	leafTmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: "leaf"},
+		NotBefore:    time.Now(),
+		NotAfter:     time.Now().Add(time.Hour),
+	}
+	_, leafCert, err := SignCertificate(leafTmpl, caCert, leafKey.Public(), caKey)
+	require.NoError(t, err)
+
+	t.Run("encodes the certificates in order", func(t *testing.T) {
+		der, err := EncodePKCS7Certificates([]*x509.Certificate{leafCert, caCert})
+		require.NoError(t, err)
+
+		var contentInfo pkcs7ContentInfo
+		rest, err := asn1.Unmarshal(der, &contentInfo)
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+		assert.True(t, contentInfo.ContentType.Equal(oidPKCS7SignedData))
+		assert.Equal(t, asn1.ClassContextSpecific, contentInfo.Content.Class)
+
+		var signedData pkcs7SignedData
+		rest, err = asn1.Unmarshal(contentInfo.Content.Bytes, &signedData)
+		require.NoError(t, err)
+		assert.Empty(t, rest)
+		assert.Equal(t, 1, signedData.Version)
+		assert.True(t, signedData.ContentInfo.ContentType.Equal(oidPKCS7Data))
+		assert.Empty(t, signedData.SignerInfos)
+
+		certs, err := x509.ParseCertificates(signedData.Certificates.Bytes)
+		require.NoError(t, err)
+		require.Len(t, certs, 2)
+		assert.True(t, certs[0].Equal(leafCert))
+		assert.True(t, certs[1].Equal(caCert))
+	})
+
+	t.Run("errors without certificates", func(t *testing.T) {
+		_, err := EncodePKCS7Certificates(nil)
+		assert.Error(t, err)
+	})
+}
@@ -0,0 +1,109 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"strings"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// OIDExtensionCertificatePolicies is the OID of the certificatePolicies
+// extension, RFC 5280, 4.2.1.4.
+var OIDExtensionCertificatePolicies = asn1.ObjectIdentifier{2, 5, 29, 32}
+
+// policyInformation is a PolicyInformation without policy qualifiers.
+//
+//	PolicyInformation ::= SEQUENCE {
+//	     policyIdentifier   CertPolicyId,
+//	     policyQualifiers   SEQUENCE SIZE (1..MAX) OF
+//	                             PolicyQualifierInfo OPTIONAL }
+type policyInformation struct {
+	Policy asn1.ObjectIdentifier
+}
+
+// ParseObjectIdentifiers parses a list of OIDs in dotted decimal notation.
+func ParseObjectIdentifiers(oids []string) ([]asn1.ObjectIdentifier, error) {
+	var parsed []asn1.ObjectIdentifier
+	for _, s := range oids {
+		oid, err := ParseObjectIdentifier(s)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, oid)
+	}
+	return parsed, nil
+}
+
+// ParseObjectIdentifiersAnnotation parses the value of an annotation which
+// lists OIDs separated by commas, such as
+// `cert-manager.io/certificate-policies`.
+func ParseObjectIdentifiersAnnotation(value string) ([]asn1.ObjectIdentifier, error) {
+	if len(value) == 0 {
+		return nil, nil
+	}
+	oids := strings.Split(value, ",")
+	for i := range oids {
+		oids[i] = strings.TrimSpace(oids[i])
+	}
+	return ParseObjectIdentifiers(oids)
+}
+
+// ObjectIdentifiersAnnotationValue returns the value of an annotation which
+// lists the given OIDs separated by commas.
+func ObjectIdentifiersAnnotationValue(oids []string) string {
+	return strings.Join(oids, ",")
+}
+
+// CertificatePoliciesForCertificate returns the certificate policy OIDs
+// requested by the given Certificate.
+func CertificatePoliciesForCertificate(crt *v1.Certificate) ([]asn1.ObjectIdentifier, error) {
+	policies, err := ParseObjectIdentifiers(crt.Spec.CertificatePolicies)
+	if err != nil {
+		return nil, fmt.Errorf("invalid certificate policy: %w", err)
+	}
+	return policies, nil
+}
+
+// ExtKeyUsageOIDsForCertificate returns the extended key usage OIDs requested
+// by the given Certificate in addition to its named usages.
+func ExtKeyUsageOIDsForCertificate(crt *v1.Certificate) ([]asn1.ObjectIdentifier, error) {
+	oids, err := ParseObjectIdentifiers(crt.Spec.ExtendedKeyUsageOIDs)
+	if err != nil {
+		return nil, fmt.Errorf("invalid extended key usage: %w", err)
+	}
+	return oids, nil
+}
+
+// marshalCertificatePolicies encodes the given policy OIDs as a
+// certificatePolicies extension. Go's x509 package does not encode
+// certificate policies in certificate requests, so this extension is
+// marshalled by hand.
+func marshalCertificatePolicies(policies []asn1.ObjectIdentifier) (pkix.Extension, error) {
+	info := make([]policyInformation, len(policies))
+	for i, policy := range policies {
+		info[i].Policy = policy
+	}
+	value, err := asn1.Marshal(info)
+	if err != nil {
+		return pkix.Extension{}, fmt.Errorf("failed to asn1 encode certificate policies: %w", err)
+	}
+	return pkix.Extension{Id: OIDExtensionCertificatePolicies, Value: value}, nil
+}
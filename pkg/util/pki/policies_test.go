@@ -0,0 +1,132 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/asn1"
+	"encoding/pem"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+func TestParseObjectIdentifiersAnnotation(t *testing.T) {
+	oids, err := ParseObjectIdentifiersAnnotation("")
+	require.NoError(t, err)
+	assert.Empty(t, oids)
+
+	oids, err = ParseObjectIdentifiersAnnotation(ObjectIdentifiersAnnotationValue([]string{"2.23.140.1.2.1", "1.2.3.4"}))
+	require.NoError(t, err)
+	assert.Equal(t, []asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 1}, {1, 2, 3, 4}}, oids)
+
+	_, err = ParseObjectIdentifiersAnnotation("1.2.3.4,policy")
+	assert.Error(t, err)
+}
+
+func TestMarshalCertificatePolicies(t *testing.T) {
+	policies := []asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 1}, {1, 2, 3, 4}}
+	ext, err := marshalCertificatePolicies(policies)
+	require.NoError(t, err)
+	assert.True(t, ext.Id.Equal(OIDExtensionCertificatePolicies))
+
+	// The extension must be encoded the same way as Go encodes the
+	// certificatePolicies of a certificate.
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber:      big.NewInt(1),
+		NotBefore:         time.Now(),
+		NotAfter:          time.Now().Add(time.Hour),
+		PolicyIdentifiers: policies,
+	}
+	certDER, err := x509.CreateCertificate(rand.Reader, template, template, pk.Public(), pk)
+	require.NoError(t, err)
+	cert, err := x509.ParseCertificate(certDER)
+	require.NoError(t, err)
+	assert.Equal(t, policies, cert.PolicyIdentifiers)
+	assert.Equal(t, findExtension(cert.Extensions, OIDExtensionCertificatePolicies).Value, ext.Value)
+}
+
+func TestGenerateCSRCertificatePoliciesAndExtKeyUsageOIDs(t *testing.T) {
+	crt := buildCertificate("test")
+	crt.Spec.Usages = []v1.KeyUsage{v1.UsageDigitalSignature, v1.UsageServerAuth}
+	crt.Spec.CertificatePolicies = []string{"2.23.140.1.2.1"}
+	crt.Spec.ExtendedKeyUsageOIDs = []string{"1.3.6.1.4.1.99999.3.1"}
+
+	csr, err := GenerateCSR(crt)
+	require.NoError(t, err)
+
+	policies := findExtension(csr.ExtraExtensions, OIDExtensionCertificatePolicies)
+	require.NotNil(t, policies)
+	expectedPolicies, err := marshalCertificatePolicies([]asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 1}})
+	require.NoError(t, err)
+	assert.Equal(t, expectedPolicies.Value, policies.Value)
+
+	ekus := findExtension(csr.ExtraExtensions, OIDExtensionExtendedKeyUsage)
+	require.NotNil(t, ekus)
+	var oids []asn1.ObjectIdentifier
+	_, err = asn1.Unmarshal(ekus.Value, &oids)
+	require.NoError(t, err)
+	assert.Equal(t, []asn1.ObjectIdentifier{oidExtKeyUsageServerAuth, {1, 3, 6, 1, 4, 1, 99999, 3, 1}}, oids)
+
+	crt.Spec.ExtendedKeyUsageOIDs = []string{"clientAuth"}
+	_, err = GenerateCSR(crt)
+	assert.Error(t, err)
+}
+
+func TestGenerateTemplateFromCertificateRequestPoliciesAndExtKeyUsageOIDs(t *testing.T) {
+	pk, err := GenerateRSAPrivateKey(MinRSAKeySize)
+	require.NoError(t, err)
+
+	csr, err := GenerateCSR(buildCertificate("test"))
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csr, pk)
+	require.NoError(t, err)
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	cr := &v1.CertificateRequest{
+		ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{
+			v1.CertificateRequestCertificatePoliciesAnnotationKey:  "2.23.140.1.2.1",
+			v1.CertificateRequestExtendedKeyUsageOIDsAnnotationKey: "1.3.6.1.4.1.99999.3.1",
+		}},
+		Spec: v1.CertificateRequestSpec{
+			Request: csrPEM,
+			Usages:  []v1.KeyUsage{v1.UsageDigitalSignature, v1.UsageServerAuth},
+		},
+	}
+
+	template, err := GenerateTemplateFromCertificateRequest(cr)
+	require.NoError(t, err)
+
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+	assert.Equal(t, []asn1.ObjectIdentifier{{2, 23, 140, 1, 2, 1}}, cert.PolicyIdentifiers)
+	assert.Equal(t, []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth}, cert.ExtKeyUsage)
+	assert.Equal(t, []asn1.ObjectIdentifier{{1, 3, 6, 1, 4, 1, 99999, 3, 1}}, cert.UnknownExtKeyUsage)
+
+	cr.Annotations[v1.CertificateRequestCertificatePoliciesAnnotationKey] = "policy"
+	_, err = GenerateTemplateFromCertificateRequest(cr)
+	assert.Error(t, err)
+}
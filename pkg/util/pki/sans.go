@@ -0,0 +1,239 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"bytes"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"fmt"
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+// OIDExtensionSubjectAltName is the OID of the subjectAltName extension,
+// RFC 5280, 4.2.1.6.
+var OIDExtensionSubjectAltName = asn1.ObjectIdentifier{2, 5, 29, 17}
+
+// emptyRDNSequence is the DER encoding of an empty subject.
+var emptyRDNSequence = []byte{0x30, 0}
+
+// MarshalSANs encodes the given names as an x509 subjectAltName extension.
+// Go's x509 package does not support otherName SANs, so this extension is
+// used in place of the one it would generate whenever otherNames are
+// requested.
+func MarshalSANs(dnsNames, emailAddresses []string, ipAddresses []net.IP, uris []*url.URL, otherNames []v1.OtherName) (pkix.Extension, error) {
+	var names []asn1.RawValue
+	for _, name := range dnsNames {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: nameTypeDNS, Bytes: []byte(name)})
+	}
+	for _, email := range emailAddresses {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: nameTypeEmail, Bytes: []byte(email)})
+	}
+	for _, ip := range ipAddresses {
+		if ip4 := ip.To4(); ip4 != nil {
+			ip = ip4
+		}
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: nameTypeIP, Bytes: ip})
+	}
+	for _, uri := range uris {
+		names = append(names, asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: nameTypeURI, Bytes: []byte(uri.String())})
+	}
+	for _, otherName := range otherNames {
+		name, err := marshalOtherName(otherName)
+		if err != nil {
+			return pkix.Extension{}, err
+		}
+		names = append(names, name)
+	}
+
+	value, err := asn1.Marshal(names)
+	if err != nil {
+		return pkix.Extension{}, fmt.Errorf("failed to asn1 encode subject alternative names: %w", err)
+	}
+
+	return pkix.Extension{Id: OIDExtensionSubjectAltName, Value: value}, nil
+}
+
+// marshalOtherName encodes an otherName GeneralName with a UTF8String value:
+//
+//	OtherName ::= SEQUENCE {
+//	     type-id    OBJECT IDENTIFIER,
+//	     value      [0] EXPLICIT ANY DEFINED BY type-id }
+func marshalOtherName(otherName v1.OtherName) (asn1.RawValue, error) {
+	oid, err := ParseObjectIdentifier(otherName.OID)
+	if err != nil {
+		return asn1.RawValue{}, fmt.Errorf("invalid otherName: %w", err)
+	}
+	typeID, err := asn1.Marshal(oid)
+	if err != nil {
+		return asn1.RawValue{}, fmt.Errorf("failed to asn1 encode otherName type %s: %w", oid, err)
+	}
+	utf8Value, err := asn1.MarshalWithParams(otherName.UTF8Value, "utf8")
+	if err != nil {
+		return asn1.RawValue{}, fmt.Errorf("failed to asn1 encode value of otherName %s: %w", oid, err)
+	}
+	value, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: utf8Value})
+	if err != nil {
+		return asn1.RawValue{}, fmt.Errorf("failed to asn1 encode value of otherName %s: %w", oid, err)
+	}
+
+	return asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: nameTypeOther, IsCompound: true, Bytes: append(typeID, value...)}, nil
+}
+
+// OtherNamesFromExtensions returns the otherName SANs of the subjectAltName
+// extension in the given list of extensions, if any. Only otherNames with a
+// UTF8String value are supported.
+func OtherNamesFromExtensions(extensions []pkix.Extension) ([]v1.OtherName, error) {
+	ext := findExtension(extensions, OIDExtensionSubjectAltName)
+	if ext == nil {
+		return nil, nil
+	}
+
+	names, err := parseGeneralNames(ext.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	var otherNames []v1.OtherName
+	for _, name := range names {
+		if name.Class != asn1.ClassContextSpecific || name.Tag != nameTypeOther {
+			continue
+		}
+		otherName, err := parseOtherName(name.Bytes)
+		if err != nil {
+			return nil, err
+		}
+		otherNames = append(otherNames, otherName)
+	}
+
+	return otherNames, nil
+}
+
+// OtherNamesToString returns the given otherNames in the form `<oid>=<value>`.
+func OtherNamesToString(otherNames []v1.OtherName) []string {
+	var names []string
+	for _, otherName := range otherNames {
+		names = append(names, otherName.OID+"="+otherName.UTF8Value)
+	}
+	return names
+}
+
+func parseGeneralNames(value []byte) ([]asn1.RawValue, error) {
+	var seq asn1.RawValue
+	if rest, err := asn1.Unmarshal(value, &seq); err != nil {
+		return nil, fmt.Errorf("failed to decode subject alternative names: %w", err)
+	} else if len(rest) != 0 {
+		return nil, fmt.Errorf("trailing data after subject alternative names")
+	}
+	if seq.Class != asn1.ClassUniversal || seq.Tag != asn1.TagSequence || !seq.IsCompound {
+		return nil, fmt.Errorf("subject alternative names are not a sequence")
+	}
+
+	var names []asn1.RawValue
+	for rest := seq.Bytes; len(rest) > 0; {
+		var name asn1.RawValue
+		var err error
+		if rest, err = asn1.Unmarshal(rest, &name); err != nil {
+			return nil, fmt.Errorf("failed to decode subject alternative name: %w", err)
+		}
+		names = append(names, name)
+	}
+
+	return names, nil
+}
+
+func parseOtherName(der []byte) (v1.OtherName, error) {
+	var oid asn1.ObjectIdentifier
+	rest, err := asn1.Unmarshal(der, &oid)
+	if err != nil {
+		return v1.OtherName{}, fmt.Errorf("failed to decode otherName type: %w", err)
+	}
+
+	var value asn1.RawValue
+	if rest, err = asn1.Unmarshal(rest, &value); err != nil {
+		return v1.OtherName{}, fmt.Errorf("failed to decode value of otherName %s: %w", oid, err)
+	} else if len(rest) != 0 {
+		return v1.OtherName{}, fmt.Errorf("trailing data after otherName %s", oid)
+	}
+	if value.Class != asn1.ClassContextSpecific || value.Tag != 0 || !value.IsCompound {
+		return v1.OtherName{}, fmt.Errorf("otherName %s has an invalid value", oid)
+	}
+
+	var utf8Value string
+	if rest, err := asn1.UnmarshalWithParams(value.Bytes, &utf8Value, "utf8"); err != nil || len(rest) != 0 {
+		return v1.OtherName{}, fmt.Errorf("otherName %s does not have a UTF8String value", oid)
+	}
+
+	return v1.OtherName{OID: oid.String(), UTF8Value: utf8Value}, nil
+}
+
+// otherNameSANsExtension returns the subjectAltName extension of the given
+// certificate request if it contains any otherName SANs, which would otherwise
+// be dropped when a certificate is signed from it. The extension is marked
+// critical if the subject is empty, as required by RFC 5280.
+func otherNameSANsExtension(csr *x509.CertificateRequest) (*pkix.Extension, error) {
+	ext := findExtension(csr.Extensions, OIDExtensionSubjectAltName)
+	if ext == nil {
+		return nil, nil
+	}
+
+	names, err := parseGeneralNames(ext.Value)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, name := range names {
+		if name.Class == asn1.ClassContextSpecific && name.Tag == nameTypeOther {
+			return &pkix.Extension{
+				Id:       OIDExtensionSubjectAltName,
+				Critical: ext.Critical || bytes.Equal(csr.RawSubject, emptyRDNSequence),
+				Value:    ext.Value,
+			}, nil
+		}
+	}
+
+	return nil, nil
+}
+
+// ParseObjectIdentifier parses an object identifier in dotted decimal
+// notation, e.g. `1.3.6.1.4.1.311.20.2.3`.
+func ParseObjectIdentifier(s string) (asn1.ObjectIdentifier, error) {
+	parts := strings.Split(s, ".")
+	if len(parts) < 2 {
+		return nil, fmt.Errorf("%q is not an object identifier in dotted decimal notation", s)
+	}
+
+	oid := make(asn1.ObjectIdentifier, len(parts))
+	for i, part := range parts {
+		n, err := strconv.Atoi(part)
+		if err != nil || n < 0 || strconv.Itoa(n) != part {
+			return nil, fmt.Errorf("%q is not an object identifier in dotted decimal notation", s)
+		}
+		oid[i] = n
+	}
+	if oid[0] > 2 || (oid[0] < 2 && oid[1] >= 40) {
+		return nil, fmt.Errorf("%q is not a valid object identifier", s)
+	}
+
+	return oid, nil
+}
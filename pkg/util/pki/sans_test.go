@@ -0,0 +1,169 @@
+/*
+Copyright 2022 The cert-manager Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pki
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	v1 "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
+)
+
+const oidUPN = "1.3.6.1.4.1.311.20.2.3"
+
+func TestMarshalSANs(t *testing.T) {
+	otherNames := []v1.OtherName{
+		{OID: oidUPN, UTF8Value: "user@example.com"},
+		{OID: "1.2.3.4", UTF8Value: "ünicode"},
+	}
+	ext, err := MarshalSANs([]string{"example.com"}, []string{"user@example.com"}, []net.IP{net.ParseIP("10.0.0.1")}, nil, otherNames)
+	require.NoError(t, err)
+	assert.True(t, ext.Id.Equal(OIDExtensionSubjectAltName))
+
+	parsed, err := OtherNamesFromExtensions([]pkix.Extension{ext})
+	require.NoError(t, err)
+	assert.Equal(t, otherNames, parsed)
+
+	// Go must still be able to parse the other names in the extension.
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(&x509.CertificateRequest{ExtraExtensions: []pkix.Extension{ext}}, pk)
+	require.NoError(t, err)
+	csr, err := x509.ParseCertificateRequest(csrDER)
+	require.NoError(t, err)
+	assert.Equal(t, []string{"example.com"}, csr.DNSNames)
+	assert.Equal(t, []string{"user@example.com"}, csr.EmailAddresses)
+	assert.Equal(t, "10.0.0.1", csr.IPAddresses[0].String())
+
+	_, err = MarshalSANs(nil, nil, nil, nil, []v1.OtherName{{OID: "not-an-oid", UTF8Value: "value"}})
+	assert.Error(t, err)
+}
+
+func TestOtherNamesFromExtensions(t *testing.T) {
+	integerValue, err := asn1.Marshal(asn1.RawValue{Class: asn1.ClassContextSpecific, Tag: 0, IsCompound: true, Bytes: mustMarshal(t, 42)})
+	require.NoError(t, err)
+	nonUTF8, err := asn1.Marshal([]asn1.RawValue{{
+		Class: asn1.ClassContextSpecific, Tag: nameTypeOther, IsCompound: true,
+		Bytes: append(mustMarshal(t, asn1.ObjectIdentifier{1, 2, 3}), integerValue...),
+	}})
+	require.NoError(t, err)
+
+	tests := map[string]struct {
+		extensions []pkix.Extension
+		expected   []v1.OtherName
+		expectErr  bool
+	}{
+		"no subjectAltName extension": {},
+		"subjectAltName extension without otherNames": {
+			extensions: []pkix.Extension{{Id: OIDExtensionSubjectAltName, Value: mustMarshal(t, []asn1.RawValue{{Class: asn1.ClassContextSpecific, Tag: nameTypeDNS, Bytes: []byte("example.com")}})}},
+		},
+		"otherName without a UTF8String value": {
+			extensions: []pkix.Extension{{Id: OIDExtensionSubjectAltName, Value: nonUTF8}},
+			expectErr:  true,
+		},
+		"invalid subjectAltName extension": {
+			extensions: []pkix.Extension{{Id: OIDExtensionSubjectAltName, Value: []byte{0x01}}},
+			expectErr:  true,
+		},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			otherNames, err := OtherNamesFromExtensions(test.extensions)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, otherNames)
+		})
+	}
+}
+
+func TestOtherNamesAreCopiedFromCSRToCertificate(t *testing.T) {
+	pk, err := GenerateECPrivateKey(ECCurve256)
+	require.NoError(t, err)
+
+	crt := &v1.Certificate{Spec: v1.CertificateSpec{
+		PrivateKey: &v1.CertificatePrivateKey{Algorithm: v1.ECDSAKeyAlgorithm},
+		DNSNames:   []string{"example.com"},
+		OtherNames: []v1.OtherName{{OID: oidUPN, UTF8Value: "user@example.com"}},
+	}}
+	csr, err := GenerateCSR(crt)
+	require.NoError(t, err)
+	csrDER, err := EncodeCSR(csr, pk)
+	require.NoError(t, err)
+	csrPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE REQUEST", Bytes: csrDER})
+
+	template, err := GenerateTemplateFromCSRPEM(csrPEM, time.Hour, false)
+	require.NoError(t, err)
+	_, cert, err := SignCertificate(template, template, pk.Public(), pk)
+	require.NoError(t, err)
+
+	assert.Equal(t, []string{"example.com"}, cert.DNSNames)
+	otherNames, err := OtherNamesFromExtensions(cert.Extensions)
+	require.NoError(t, err)
+	assert.Equal(t, crt.Spec.OtherNames, otherNames)
+	// The subject is empty, so the subjectAltName extension must be critical.
+	assert.True(t, findExtension(cert.Extensions, OIDExtensionSubjectAltName).Critical)
+}
+
+func TestParseObjectIdentifier(t *testing.T) {
+	tests := map[string]struct {
+		oid       string
+		expected  asn1.ObjectIdentifier
+		expectErr bool
+	}{
+		"UPN":                  {oid: oidUPN, expected: asn1.ObjectIdentifier{1, 3, 6, 1, 4, 1, 311, 20, 2, 3}},
+		"two arcs":             {oid: "2.5", expected: asn1.ObjectIdentifier{2, 5}},
+		"empty":                {oid: "", expectErr: true},
+		"single arc":           {oid: "1", expectErr: true},
+		"non numeric arc":      {oid: "1.2.a", expectErr: true},
+		"negative arc":         {oid: "1.2.-3", expectErr: true},
+		"leading zero":         {oid: "1.02.3", expectErr: true},
+		"trailing dot":         {oid: "1.2.", expectErr: true},
+		"first arc too large":  {oid: "3.1", expectErr: true},
+		"second arc too large": {oid: "1.40", expectErr: true},
+		"large second arc":     {oid: "2.100.3", expected: asn1.ObjectIdentifier{2, 100, 3}},
+	}
+
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			oid, err := ParseObjectIdentifier(test.oid)
+			if test.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, test.expected, oid)
+		})
+	}
+}
+
+func mustMarshal(t *testing.T, v interface{}) []byte {
+	der, err := asn1.Marshal(v)
+	require.NoError(t, err)
+	return der
+}
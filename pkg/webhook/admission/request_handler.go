@@ -95,16 +95,21 @@ func (rh *RequestHandler) Validate(ctx context.Context, admissionSpec *admission
 		return status
 	}
 
-	// decode new version of object
-	obj, err := rh.deseralizeToInternalVersion(admissionSpec.Object.Raw)
-	if err != nil {
-		return badRequestError(status, err)
+	// decode new version of object, which is not set for DELETE requests
+	var obj runtime.Object
+	if len(admissionSpec.Object.Raw) > 0 {
+		var err error
+		obj, err = rh.deseralizeToInternalVersion(admissionSpec.Object.Raw)
+		if err != nil {
+			return badRequestError(status, err)
+		}
 	}
 
 	// attempt to decode old object
 	var oldObj runtime.Object
 	if len(admissionSpec.OldObject.Raw) > 0 {
-		oldObj, err = rh.deseralizeToInternalVersion(admissionSpec.OldObject.Raw)
+		var err error
+		oldObj, err = rh.decodeOldObject(admissionSpec.Kind, admissionSpec.OldObject.Raw)
 		if err != nil {
 			return badRequestError(status, err)
 		}
@@ -224,6 +229,22 @@ func (rh *RequestHandler) deseralizeToInternalVersion(bytes []byte) (runtime.Obj
 	return rh.scheme.ConvertToVersion(obj, runtime.InternalGroupVersioner)
 }
 
+// decodeOldObject will decode the old version of an object into its internal
+// version. Resources whose kind is not registered in the scheme, such as
+// Secrets that are being deleted, are decoded as PartialObjectMetadata so that
+// validation plugins can still inspect their labels and annotations.
+func (rh *RequestHandler) decodeOldObject(kind metav1.GroupVersionKind, bytes []byte) (runtime.Object, error) {
+	if rh.scheme.Recognizes(schema.GroupVersionKind{Group: kind.Group, Version: kind.Version, Kind: kind.Kind}) {
+		return rh.deseralizeToInternalVersion(bytes)
+	}
+
+	obj := &metav1.PartialObjectMetadata{}
+	if err := json.Unmarshal(bytes, obj); err != nil {
+		return nil, err
+	}
+	return obj, nil
+}
+
 func badRequestError(status *admissionv1.AdmissionResponse, err error) *admissionv1.AdmissionResponse {
 	status.Allowed = false
 	status.Result = &metav1.Status{
@@ -280,6 +280,79 @@ func TestRequestHandler_ValidateReturnsErrorsAndWarnings(t *testing.T) {
 	}
 }
 
+// Tests to ensure that objects being deleted are passed to validation plugins,
+// including those whose kind is not registered in the scheme.
+func TestRequestHandler_ValidateDecodesDeletedObjects(t *testing.T) {
+	scheme := runtime.NewScheme()
+	install.Install(scheme)
+
+	tests := map[string]struct {
+		kind      metav1.GroupVersionKind
+		oldObject string
+		check     func(t *testing.T, oldObj runtime.Object)
+	}{
+		"objects registered in the scheme are decoded into their internal version": {
+			kind: metav1.GroupVersionKind{Group: "testgroup.testing.cert-manager.io", Version: "v1", Kind: "TestType"},
+			oldObject: `{
+	"apiVersion": "testgroup.testing.cert-manager.io/v1",
+	"kind": "TestType",
+	"metadata": {"name": "testing", "namespace": "abc"}
+}`,
+			check: func(t *testing.T, oldObj runtime.Object) {
+				if obj, ok := oldObj.(*testgroup.TestType); !ok || obj.Name != "testing" {
+					t.Errorf("unexpected old object: %#v", oldObj)
+				}
+			},
+		},
+		"other objects are decoded as PartialObjectMetadata": {
+			kind: metav1.GroupVersionKind{Group: "", Version: "v1", Kind: "Secret"},
+			oldObject: `{
+	"apiVersion": "v1",
+	"kind": "Secret",
+	"metadata": {"name": "testing", "namespace": "abc", "labels": {"a": "b"}},
+	"data": {"tls.crt": "YWJj"}
+}`,
+			check: func(t *testing.T, oldObj runtime.Object) {
+				obj, ok := oldObj.(*metav1.PartialObjectMetadata)
+				if !ok || obj.Name != "testing" || obj.Labels["a"] != "b" {
+					t.Errorf("unexpected old object: %#v", oldObj)
+				}
+			},
+		},
+	}
+	for name, test := range tests {
+		t.Run(name, func(t *testing.T) {
+			called := false
+			rh := admission.NewRequestHandler(scheme, validatingImplementation{
+				handles: func(operation admissionv1.Operation) bool {
+					return operation == admissionv1.Delete
+				},
+				validate: func(_ context.Context, _ admissionv1.AdmissionRequest, oldObj, obj runtime.Object) ([]string, error) {
+					called = true
+					if obj != nil {
+						t.Errorf("expected no object to be decoded for a DELETE request but got %#v", obj)
+					}
+					test.check(t, oldObj)
+					return nil, nil
+				},
+			}, nil)
+
+			resp := rh.Validate(context.TODO(), &admissionv1.AdmissionRequest{
+				UID:       types.UID("abc"),
+				Operation: admissionv1.Delete,
+				Kind:      test.kind,
+				OldObject: runtime.RawExtension{Raw: []byte(test.oldObject)},
+			})
+			if !called {
+				t.Errorf("expected validator to be called")
+			}
+			if !resp.Allowed {
+				t.Errorf("expected request to be allowed but got %v", resp.Result)
+			}
+		})
+	}
+}
+
 func responseForOperations(ops ...jsonpatch.JsonPatchOperation) []byte {
 	b, err := json.Marshal(ops)
 	if err != nil {
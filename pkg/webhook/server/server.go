@@ -39,6 +39,7 @@ import (
 	ciphers "k8s.io/component-base/cli/flag"
 
 	logf "github.com/cert-manager/cert-manager/pkg/logs"
+	utilfeature "github.com/cert-manager/cert-manager/pkg/util/feature"
 	"github.com/cert-manager/cert-manager/pkg/util/profiling"
 	"github.com/cert-manager/cert-manager/pkg/webhook/handlers"
 	servertls "github.com/cert-manager/cert-manager/pkg/webhook/server/tls"
@@ -349,4 +350,11 @@ func (s *Server) handleLivez(w http.ResponseWriter, req *http.Request) {
 	defer req.Body.Close()
 
 	w.WriteHeader(http.StatusOK)
+
+	// include the state of all feature gates when verbose output is requested
+	if _, verbose := req.URL.Query()["verbose"]; verbose {
+		if err := utilfeature.WriteStatuses(w); err != nil {
+			s.log.Error(err, "failed to write feature gate statuses")
+		}
+	}
 }
@@ -80,7 +80,7 @@ func (h *Helper) ValidateIssuedCertificateRequest(cr *cmapi.CertificateRequest,
 		return nil, fmt.Errorf("failed to decode CertificateRequest's Spec.Request: %s", err)
 	}
 
-	// validate private key is of the correct type (rsa or ecdsa)
+	// validate private key is of the correct type (rsa, ecdsa or ed25519)
 	switch csr.PublicKeyAlgorithm {
 	case x509.RSA:
 		_, ok := key.(*rsa.PrivateKey)
@@ -29,6 +29,7 @@ import (
 	"github.com/kr/pretty"
 	corev1 "k8s.io/api/core/v1"
 
+	internalcertificates "github.com/cert-manager/cert-manager/internal/controller/certificates"
 	apiutil "github.com/cert-manager/cert-manager/pkg/api/util"
 	cmapi "github.com/cert-manager/cert-manager/pkg/apis/certmanager/v1"
 	cmmeta "github.com/cert-manager/cert-manager/pkg/apis/meta/v1"
@@ -41,7 +42,7 @@ type ValidationFunc func(certificate *cmapi.Certificate, secret *corev1.Secret)
 
 // ExpectValidKeysInSecret checks that the secret contains valid keys
 func ExpectValidKeysInSecret(_ *cmapi.Certificate, secret *corev1.Secret) error {
-	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey}
+	validKeys := []string{corev1.TLSPrivateKeyKey, corev1.TLSCertKey, cmmeta.TLSCAKey, cmapi.CertificateOutputFormatDERKey, cmapi.CertificateOutputFormatCombinedPEMKey, cmapi.CertificateOutputFormatDERCertificateKey, cmapi.CertificateOutputFormatPKCS7Key}
 	nbValidKeys := 0
 	for k := range secret.Data {
 		for _, k2 := range validKeys {
@@ -381,27 +382,38 @@ func ExpectValidBasicConstraints(certificate *cmapi.Certificate, secret *corev1.
 func ExpectValidAdditionalOutputFormats(certificate *cmapi.Certificate, secret *corev1.Secret) error {
 	if len(certificate.Spec.AdditionalOutputFormats) > 0 {
 		for _, f := range certificate.Spec.AdditionalOutputFormats {
+			key := internalcertificates.OutputFormatKey(f)
+			value, ok := secret.Data[key]
+			if !ok {
+				return fmt.Errorf("expected additional output format %s key %s to be present in secret", f.Type, key)
+			}
+
 			switch f.Type {
 			case cmapi.CertificateOutputFormatDER:
-				if derKey, ok := secret.Data[cmapi.CertificateOutputFormatDERKey]; ok {
-					privateKey := secret.Data[corev1.TLSPrivateKeyKey]
-					block, _ := pem.Decode(privateKey)
-					if !bytes.Equal(derKey, block.Bytes) {
-						return fmt.Errorf("expected additional output Format DER %s to contain the binary formated private Key", cmapi.CertificateOutputFormatDERKey)
-					}
-				} else {
-					return fmt.Errorf("expected additional output format DER key %s to be present in secret", cmapi.CertificateOutputFormatDERKey)
+				privateKey := secret.Data[corev1.TLSPrivateKeyKey]
+				block, _ := pem.Decode(privateKey)
+				if !bytes.Equal(value, block.Bytes) {
+					return fmt.Errorf("expected additional output Format DER %s to contain the binary formated private Key", key)
 				}
 			case cmapi.CertificateOutputFormatCombinedPEM:
-				if combinedPem, ok := secret.Data[cmapi.CertificateOutputFormatCombinedPEMKey]; ok {
-					privateKey := secret.Data[corev1.TLSPrivateKeyKey]
-					certificate := secret.Data[corev1.TLSCertKey]
-					expectedCombinedPem := []byte(strings.Join([]string{string(privateKey), string(certificate)}, "\n"))
-					if !bytes.Equal(combinedPem, expectedCombinedPem) {
-						return fmt.Errorf("expected additional output format CombinedPEM %s to contain the combination of privateKey and certificate", cmapi.CertificateOutputFormatCombinedPEMKey)
-					}
-				} else {
-					return fmt.Errorf("expected additional output format CombinedPEM key %s to be present in secret", cmapi.CertificateOutputFormatCombinedPEMKey)
+				privateKey := secret.Data[corev1.TLSPrivateKeyKey]
+				certificate := secret.Data[corev1.TLSCertKey]
+				expectedCombinedPem := []byte(strings.Join([]string{string(privateKey), string(certificate)}, "\n"))
+				if !bytes.Equal(value, expectedCombinedPem) {
+					return fmt.Errorf("expected additional output format CombinedPEM %s to contain the combination of privateKey and certificate", key)
+				}
+			case cmapi.CertificateOutputFormatDERCertificate:
+				block, _ := pem.Decode(secret.Data[corev1.TLSCertKey])
+				if !bytes.Equal(value, block.Bytes) {
+					return fmt.Errorf("expected additional output format DERCertificate %s to contain the binary formatted certificate", key)
+				}
+			case cmapi.CertificateOutputFormatPKCS7:
+				expectedPKCS7, err := internalcertificates.OutputFormatPKCS7(secret.Data[corev1.TLSCertKey])
+				if err != nil {
+					return err
+				}
+				if !bytes.Equal(value, expectedPKCS7) {
+					return fmt.Errorf("expected additional output format PKCS7 %s to contain the certificate chain", key)
 				}
 
 			default:
@@ -185,6 +185,12 @@ certmanager_certificate_ready_status{condition="Unknown",name="testcrt",namespac
 # HELP certmanager_certificate_renewal_timestamp_seconds The number of seconds before expiration time the certificate should renew.
 # TYPE certmanager_certificate_renewal_timestamp_seconds gauge
 certmanager_certificate_renewal_timestamp_seconds{name="testcrt",namespace="testns"} 0
+# HELP certmanager_certificate_revoked_status Whether the certificate stored in the certificate's Secret has been revoked, as reported by OCSP or a CRL.
+# TYPE certmanager_certificate_revoked_status gauge
+certmanager_certificate_revoked_status{name="testcrt",namespace="testns"} 0
+# HELP certmanager_certificate_secret_drift_status Whether the certificate's Secret has diverged from the certificate in a way that cannot be explained by an issuance.
+# TYPE certmanager_certificate_secret_drift_status gauge
+certmanager_certificate_secret_drift_status{name="testcrt",namespace="testns"} 0
 ` + clockCounterMetric + clockGaugeMetric + `
 # HELP certmanager_controller_sync_call_count The number of sync() calls made by a controller.
 # TYPE certmanager_controller_sync_call_count counter
@@ -221,6 +227,12 @@ certmanager_certificate_ready_status{condition="Unknown",name="testcrt",namespac
 # HELP certmanager_certificate_renewal_timestamp_seconds The number of seconds before expiration time the certificate should renew.
 # TYPE certmanager_certificate_renewal_timestamp_seconds gauge
 certmanager_certificate_renewal_timestamp_seconds{name="testcrt",namespace="testns"} 100
+# HELP certmanager_certificate_revoked_status Whether the certificate stored in the certificate's Secret has been revoked, as reported by OCSP or a CRL.
+# TYPE certmanager_certificate_revoked_status gauge
+certmanager_certificate_revoked_status{name="testcrt",namespace="testns"} 0
+# HELP certmanager_certificate_secret_drift_status Whether the certificate's Secret has diverged from the certificate in a way that cannot be explained by an issuance.
+# TYPE certmanager_certificate_secret_drift_status gauge
+certmanager_certificate_secret_drift_status{name="testcrt",namespace="testns"} 0
 ` + clockCounterMetric + clockGaugeMetric + `
 # HELP certmanager_controller_sync_call_count The number of sync() calls made by a controller.
 # TYPE certmanager_controller_sync_call_count counter
@@ -68,7 +68,7 @@ func TestTriggerController(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
-	shouldReissue := policies.NewTriggerPolicyChain(fakeClock).Evaluate
+	shouldReissue := policies.NewTriggerPolicyChain(fakeClock, 0).Evaluate
 	ctrl, queue, mustSync := trigger.NewController(logf.Log, cmCl, factory,
 		cmFactory, framework.NewEventRecorder(t), fakeClock, shouldReissue,
 		"cert-manage-certificates-trigger-test")
@@ -111,7 +111,7 @@ func TestTriggerController_RenewNearExpiry(t *testing.T) {
 	// Only use the 'current certificate nearing expiry' policy chain during the
 	// test as we want to test the very specific cases of triggering/not
 	// triggering depending on whether a renewal is required.
-	shoudReissue := policies.Chain{policies.CurrentCertificateNearingExpiry(fakeClock)}.Evaluate
+	shoudReissue := policies.Chain{policies.CurrentCertificateNearingExpiry(fakeClock, 0)}.Evaluate
 	// Build, instantiate and run the trigger controller.
 	kubeClient, factory, cmCl, cmFactory := framework.NewClients(t, config)
 
@@ -223,7 +223,7 @@ func TestTriggerController_ExpBackoff(t *testing.T) {
 	// Issuing condition will be applied because SecretDoesNotExist policy
 	// will evaluate to true. However, this is not what we are testing in
 	// this test.
-	shoudReissue := policies.NewTriggerPolicyChain(fakeClock).Evaluate
+	shoudReissue := policies.NewTriggerPolicyChain(fakeClock, 0).Evaluate
 	// Build, instantiate and run the trigger controller.
 	kubeClient, factory, cmCl, cmFactory := framework.NewClients(t, config)
 
@@ -98,6 +98,12 @@ func SetCertificateKeyAlgorithm(keyAlgorithm v1.PrivateKeyAlgorithm) Certificate
 	}
 }
 
+func SetCertificateSignatureAlgorithm(signatureAlgorithm v1.SignatureAlgorithm) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.SignatureAlgorithm = signatureAlgorithm
+	}
+}
+
 func SetCertificateKeySize(keySize int) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.PrivateKey.Size = keySize
@@ -110,6 +116,24 @@ func SetCertificateKeyEncoding(keyEncoding v1.PrivateKeyEncoding) CertificateMod
 	}
 }
 
+func SetCertificateKeyRotationPolicy(rotationPolicy v1.PrivateKeyRotationPolicy) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.RotationPolicy = rotationPolicy
+	}
+}
+
+func SetCertificateKeyRotationInterval(interval metav1.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.KeyRotationInterval = &interval
+	}
+}
+
+func SetCertificateKeyBackend(backend v1.PrivateKeyBackend) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKey.Backend = &backend
+	}
+}
+
 func SetCertificateSecretName(secretName string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.SecretName = secretName
@@ -126,6 +150,24 @@ func SetCertificateSecretTemplate(annotations, labels map[string]string) Certifi
 	}
 }
 
+func SetCertificateSecretTargets(targets v1.CertificateSecretTargets) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.SecretTargets = &targets
+	}
+}
+
+func SetCertificateExternalSecretStores(stores ...v1.CertificateExternalSecretStore) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.ExternalSecretStores = stores
+	}
+}
+
+func SetCertificatePrivateKeyEncryption(encryption v1.CertificatePrivateKeyEncryption) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.PrivateKeyEncryption = &encryption
+	}
+}
+
 func SetCertificateDuration(duration time.Duration) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.Duration = &metav1.Duration{Duration: duration}
@@ -219,12 +261,30 @@ func SetCertificateUID(uid types.UID) CertificateModifier {
 	}
 }
 
+func SetCertificateOwnerReferences(ownerReferences ...metav1.OwnerReference) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.OwnerReferences = ownerReferences
+	}
+}
+
 func SetCertificateGeneration(gen int64) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Generation = gen
 	}
 }
 
+func SetCertificateFinalizers(finalizers []string) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Finalizers = finalizers
+	}
+}
+
+func SetCertificateDeletionTimestamp(ts metav1.Time) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.DeletionTimestamp = &ts
+	}
+}
+
 func AddCertificateAnnotations(annotations map[string]string) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		if crt.Annotations == nil {
@@ -273,8 +333,20 @@ func SetCertificateRevisionHistoryLimit(limit int32) CertificateModifier {
 	}
 }
 
+func SetCertificateRevisionHistoryTTL(ttl time.Duration) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.RevisionHistoryTTL = &metav1.Duration{Duration: ttl}
+	}
+}
+
 func SetCertificateAdditionalOutputFormats(additionalOutputFormats ...v1.CertificateAdditionalOutputFormat) CertificateModifier {
 	return func(crt *v1.Certificate) {
 		crt.Spec.AdditionalOutputFormats = additionalOutputFormats
 	}
 }
+
+func SetCertificateCanaryRenewal(canary bool) CertificateModifier {
+	return func(crt *v1.Certificate) {
+		crt.Spec.CanaryRenewal = canary
+	}
+}
@@ -160,6 +160,12 @@ func DeleteCertificateRequestAnnotation(key string) CertificateRequestModifier {
 	}
 }
 
+func SetCertificateRequestCreationTimestamp(t metav1.Time) CertificateRequestModifier {
+	return func(cr *v1.CertificateRequest) {
+		cr.CreationTimestamp = t
+	}
+}
+
 func SetCertificateRequestFailureTime(p metav1.Time) CertificateRequestModifier {
 	return func(cr *v1.CertificateRequest) {
 		cr.Status.FailureTime = &p
@@ -226,6 +226,16 @@ func SetIssuerACMELastRegisteredEmail(email string) IssuerModifier {
 	}
 }
 
+func SetIssuerACMELastRegisteredEABKeyID(keyID string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		status := iss.GetStatus()
+		if status.ACME == nil {
+			status.ACME = &cmacme.ACMEIssuerStatus{}
+		}
+		status.ACME.LastRegisteredEABKeyID = keyID
+	}
+}
+
 func SetIssuerCA(a v1.CAIssuer) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetSpec().CA = &a
@@ -340,6 +350,72 @@ func SetIssuerVenafi(a v1.VenafiIssuer) IssuerModifier {
 	}
 }
 
+func SetIssuerAWSPCA(a v1.AWSPCAIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().AWSPCA = &a
+	}
+}
+
+func SetIssuerGoogleCAS(a v1.GoogleCASIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().GoogleCAS = &a
+	}
+}
+
+func SetIssuerAzureKeyVault(a v1.AzureKeyVaultIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().AzureKeyVault = &a
+	}
+}
+
+func SetIssuerEJBCA(e v1.EJBCAIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().EJBCA = &e
+	}
+}
+
+func SetIssuerStepCA(s v1.StepCAIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().StepCA = &s
+	}
+}
+
+func SetIssuerKubernetesCSR(k v1.KubernetesCSRIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().KubernetesCSR = &k
+	}
+}
+
+func SetIssuerSPIRE(s v1.SPIREIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().SPIRE = &s
+	}
+}
+
+func SetIssuerPlugin(p v1.PluginIssuer) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().Plugin = &p
+	}
+}
+
+func SetIssuerIssuanceLatencyBudget(b v1.IssuanceLatencyBudget) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().IssuanceLatencyBudget = &b
+	}
+}
+
+func SetIssuerRateLimit(l v1.IssuanceRateLimit) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().RateLimit = &l
+	}
+}
+
+func SetIssuerCredentialsNamespace(ns string) IssuerModifier {
+	return func(iss v1.GenericIssuer) {
+		iss.GetSpec().CredentialsNamespace = ns
+	}
+}
+
 func AddIssuerCondition(c v1.IssuerCondition) IssuerModifier {
 	return func(iss v1.GenericIssuer) {
 		iss.GetStatus().Conditions = append(iss.GetStatus().Conditions, c)
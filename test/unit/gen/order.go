@@ -88,6 +88,12 @@ func SetOrderStatus(s cmacme.OrderStatus) OrderModifier {
 	}
 }
 
+func SetOrderRetryAfter(t metav1.Time) OrderModifier {
+	return func(order *cmacme.Order) {
+		order.Status.RetryAfter = &t
+	}
+}
+
 func SetOrderCertificate(d []byte) OrderModifier {
 	return func(order *cmacme.Order) {
 		order.Status.Certificate = d
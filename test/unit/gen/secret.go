@@ -18,6 +18,7 @@ package gen
 
 import (
 	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 type SecretModifier func(*corev1.Secret)
@@ -63,3 +64,24 @@ func SetSecretData(data map[string][]byte) SecretModifier {
 		}
 	}
 }
+
+func SetSecretOwnerReferences(ownerReferences ...metav1.OwnerReference) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.OwnerReferences = ownerReferences
+	}
+}
+
+func SetSecretLabels(labels map[string]string) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.Labels = make(map[string]string)
+		for k, v := range labels {
+			sec.Labels[k] = v
+		}
+	}
+}
+
+func SetSecretType(secretType corev1.SecretType) SecretModifier {
+	return func(sec *corev1.Secret) {
+		sec.Type = secretType
+	}
+}